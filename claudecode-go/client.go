@@ -450,6 +450,14 @@ func (s *Session) Kill() error {
 	return nil
 }
 
+// PID returns the process ID of the claude process, or 0 if it has not started
+func (s *Session) PID() int {
+	if s.cmd != nil && s.cmd.Process != nil {
+		return s.cmd.Process.Pid
+	}
+	return 0
+}
+
 // Interrupt sends a SIGINT signal to the session process
 func (s *Session) Interrupt() error {
 	if s.cmd.Process != nil {
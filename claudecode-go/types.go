@@ -90,6 +90,12 @@ type StreamEvent struct {
 	PermissionMode string `json:"permissionMode,omitempty"`
 	APIKeySource   string `json:"apiKeySource,omitempty"`
 
+	// Compaction fields (when type="system" and subtype="compact_boundary")
+	CompactMetadata *CompactMetadata `json:"compact_metadata,omitempty"`
+
+	// Set on the synthetic user message carrying a compaction summary
+	IsCompactSummary bool `json:"isCompactSummary,omitempty"`
+
 	// Result event fields (when type="result")
 	CostUSD           float64                     `json:"total_cost_usd,omitempty"`
 	IsError           bool                        `json:"is_error,omitempty"`
@@ -104,6 +110,13 @@ type StreamEvent struct {
 	UUID              string                      `json:"uuid,omitempty"`
 }
 
+// CompactMetadata describes a conversation compaction
+type CompactMetadata struct {
+	Trigger    string `json:"trigger"` // "auto" or "manual"
+	PreTokens  int    `json:"pre_tokens"`
+	PostTokens int    `json:"post_tokens,omitempty"`
+}
+
 // MCPStatus represents the status of an MCP server
 type MCPStatus struct {
 	Name   string `json:"name"`
@@ -120,6 +133,30 @@ type Message struct {
 	Usage   *Usage    `json:"usage,omitempty"`
 }
 
+// UnmarshalJSON implements custom unmarshaling to accept content given as a
+// plain string, as in synthetic user messages, as well as an array of blocks
+func (m *Message) UnmarshalJSON(data []byte) error {
+	type messageAlias Message
+	var raw struct {
+		messageAlias
+		Content json.RawMessage `json:"content"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*m = Message(raw.messageAlias)
+
+	if len(raw.Content) == 0 || string(raw.Content) == "null" {
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(raw.Content, &text); err == nil {
+		m.Content = []Content{{Type: "text", Text: text}}
+		return nil
+	}
+	return json.Unmarshal(raw.Content, &m.Content)
+}
+
 // ContentField handles both string and array content formats
 type ContentField struct {
 	Value string
@@ -208,6 +208,71 @@ func TestContentStructWithContentField(t *testing.T) {
 	}
 }
 
+func TestMessageUnmarshalContent(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantTexts []string
+		wantErr   bool
+	}{
+		{
+			name:      "array content",
+			input:     `{"id": "msg_1", "role": "assistant", "content": [{"type": "text", "text": "hello"}]}`,
+			wantTexts: []string{"hello"},
+		},
+		{
+			name:      "string content",
+			input:     `{"role": "user", "content": "This session is being continued"}`,
+			wantTexts: []string{"This session is being continued"},
+		},
+		{
+			name:  "missing content",
+			input: `{"role": "user"}`,
+		},
+		{
+			name:    "invalid content",
+			input:   `{"role": "user", "content": 42}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m Message
+			err := json.Unmarshal([]byte(tt.input), &m)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Message unmarshal error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(m.Content) != len(tt.wantTexts) {
+				t.Fatalf("len(Message.Content) = %d, want %d", len(m.Content), len(tt.wantTexts))
+			}
+			for i, text := range tt.wantTexts {
+				if m.Content[i].Type != "text" || m.Content[i].Text != text {
+					t.Errorf("Message.Content[%d] = %+v, want text %q", i, m.Content[i], text)
+				}
+			}
+		})
+	}
+}
+
+func TestCompactBoundaryEventUnmarshal(t *testing.T) {
+	input := `{"type":"system","subtype":"compact_boundary","session_id":"abc","compact_metadata":{"trigger":"auto","pre_tokens":155000}}`
+
+	var event StreamEvent
+	if err := json.Unmarshal([]byte(input), &event); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if event.CompactMetadata == nil {
+		t.Fatal("CompactMetadata is nil")
+	}
+	if event.CompactMetadata.Trigger != "auto" || event.CompactMetadata.PreTokens != 155000 {
+		t.Errorf("CompactMetadata = %+v", *event.CompactMetadata)
+	}
+}
+
 func TestPermissionDenialsUnmarshal(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/mapper"
+	"github.com/humanlayer/humanlayer/hld/backup"
+)
+
+// BackupHandlers handles database backup and restore endpoints
+type BackupHandlers struct {
+	backups *backup.Service
+	mapper  *mapper.Mapper
+}
+
+// NewBackupHandlers creates a new backup handler
+func NewBackupHandlers(backups *backup.Service) *BackupHandlers {
+	return &BackupHandlers{
+		backups: backups,
+		mapper:  &mapper.Mapper{},
+	}
+}
+
+// ListBackups lists the database backups, newest first
+func (h *BackupHandlers) ListBackups(ctx context.Context, req api.ListBackupsRequestObject) (api.ListBackupsResponseObject, error) {
+	backups, err := h.backups.List()
+	if err != nil {
+		slog.Error("Failed to list backups",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListBackups",
+		)
+		return api.ListBackups500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	data := make([]api.Backup, 0, len(backups))
+	for _, b := range backups {
+		data = append(data, h.mapper.BackupToAPI(b))
+	}
+	return api.ListBackups200JSONResponse{Data: data}, nil
+}
+
+// CreateBackup backs up the database while the daemon keeps running
+func (h *BackupHandlers) CreateBackup(ctx context.Context, req api.CreateBackupRequestObject) (api.CreateBackupResponseObject, error) {
+	b, err := h.backups.Create(ctx)
+	if err != nil {
+		slog.Error("Failed to create backup",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "CreateBackup",
+		)
+		return api.CreateBackup500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.CreateBackup201JSONResponse{Data: h.mapper.BackupToAPI(*b)}, nil
+}
+
+// RestoreBackup validates a backup and stages it to replace the database on
+// the next daemon start
+func (h *BackupHandlers) RestoreBackup(ctx context.Context, req api.RestoreBackupRequestObject) (api.RestoreBackupResponseObject, error) {
+	b, err := h.backups.StageRestore(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, backup.ErrNotFound) {
+			return api.RestoreBackup404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: err.Error()},
+				},
+			}, nil
+		}
+		if errors.Is(err, backup.ErrInvalidBackup) || errors.Is(err, backup.ErrUnsupported) {
+			return api.RestoreBackup400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+				},
+			}, nil
+		}
+		slog.Error("Failed to stage backup restore",
+			"error", fmt.Sprintf("%v", err),
+			"name", req.Name,
+			"operation", "RestoreBackup",
+		)
+		return api.RestoreBackup500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	var resp api.RestoreBackup200JSONResponse
+	resp.Data.Backup = h.mapper.BackupToAPI(*b)
+	resp.Data.RestartRequired = true
+	return resp, nil
+}
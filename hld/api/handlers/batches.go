@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/mapper"
+	"github.com/humanlayer/humanlayer/hld/batch"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// BatchHandlers handles batch launch endpoints
+type BatchHandlers struct {
+	batches *batch.Service
+	mapper  *mapper.Mapper
+}
+
+// NewBatchHandlers creates a new batch handler
+func NewBatchHandlers(batches *batch.Service) *BatchHandlers {
+	return &BatchHandlers{
+		batches: batches,
+		mapper:  &mapper.Mapper{},
+	}
+}
+
+// ListBatches lists all batch groups
+func (h *BatchHandlers) ListBatches(ctx context.Context, req api.ListBatchesRequestObject) (api.ListBatchesResponseObject, error) {
+	groups, err := h.batches.List(ctx)
+	if err != nil {
+		slog.Error("Failed to list batches",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListBatches",
+		)
+		return api.ListBatches500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.ListBatches200JSONResponse{
+		Data: h.mapper.BatchGroupsToAPI(groups),
+	}, nil
+}
+
+// LaunchBatch launches one session per cell of the request's matrix
+func (h *BatchHandlers) LaunchBatch(ctx context.Context, req api.LaunchBatchRequestObject) (api.LaunchBatchResponseObject, error) {
+	matrix, err := h.mapper.BatchMatrixFromAPI(req.Body.Matrix)
+	if err != nil {
+		return api.LaunchBatch400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	batchReq := &batch.Request{
+		Query:  req.Body.Query,
+		Matrix: matrix,
+	}
+	if req.Body.Name != nil {
+		batchReq.Name = *req.Body.Name
+	}
+	if req.Body.WorkingDir != nil {
+		batchReq.WorkingDir = *req.Body.WorkingDir
+	}
+	if req.Body.MaxTurns != nil {
+		batchReq.MaxTurns = *req.Body.MaxTurns
+	}
+	if req.Body.AllowedTools != nil {
+		batchReq.AllowedTools = *req.Body.AllowedTools
+	}
+	if req.Body.AutoAcceptEdits != nil {
+		batchReq.AutoAcceptEdits = *req.Body.AutoAcceptEdits
+	}
+
+	group, err := h.batches.Launch(ctx, batchReq)
+	if err != nil {
+		if validation.IsError(err) {
+			return api.LaunchBatch400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+				},
+			}, nil
+		}
+		slog.Error("Failed to launch batch",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "LaunchBatch",
+		)
+		return api.LaunchBatch500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.LaunchBatch201JSONResponse{
+		Data: h.mapper.BatchGroupToAPI(group),
+	}, nil
+}
+
+// GetBatch retrieves a batch group with member statistics
+func (h *BatchHandlers) GetBatch(ctx context.Context, req api.GetBatchRequestObject) (api.GetBatchResponseObject, error) {
+	group, err := h.batches.Get(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.GetBatch404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Batch not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to get batch",
+			"error", fmt.Sprintf("%v", err),
+			"batch_id", req.Id,
+			"operation", "GetBatch",
+		)
+		return api.GetBatch500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.GetBatch200JSONResponse{
+		Data: h.mapper.BatchGroupToAPI(group),
+	}, nil
+}
+
+// CompareBatch lines up the results of a batch's members
+func (h *BatchHandlers) CompareBatch(ctx context.Context, req api.CompareBatchRequestObject) (api.CompareBatchResponseObject, error) {
+	comparison, err := h.batches.Compare(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.CompareBatch404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Batch not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to compare batch",
+			"error", fmt.Sprintf("%v", err),
+			"batch_id", req.Id,
+			"operation", "CompareBatch",
+		)
+		return api.CompareBatch500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.CompareBatch200JSONResponse{
+		Data: h.mapper.BatchComparisonToAPI(comparison),
+	}, nil
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/bundle"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// BundleHandlers handles session bundle export and import endpoints
+type BundleHandlers struct {
+	bundles *bundle.Service
+}
+
+// NewBundleHandlers creates a new bundle handler
+func NewBundleHandlers(bundles *bundle.Service) *BundleHandlers {
+	return &BundleHandlers{
+		bundles: bundles,
+	}
+}
+
+// ExportSessionBundle streams a session's continuation tree as an archive
+func (h *BundleHandlers) ExportSessionBundle(ctx context.Context, req api.ExportSessionBundleRequestObject) (api.ExportSessionBundleResponseObject, error) {
+	opts := bundle.ExportOptions{}
+	if req.Params.IncludeRawEvents != nil {
+		opts.IncludeRawEvents = *req.Params.IncludeRawEvents
+	}
+
+	b, err := h.bundles.Export(ctx, string(req.Id), opts)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.ExportSessionBundle404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Session not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to export session bundle",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", req.Id,
+			"operation", "ExportSessionBundle",
+		)
+		return api.ExportSessionBundle500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	// Encode while the response is written rather than buffering the archive
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(b.Write(pw))
+	}()
+
+	return api.ExportSessionBundle200ApplicationgzipResponse{
+		Body: pr,
+		Headers: api.ExportSessionBundle200ResponseHeaders{
+			ContentDisposition: fmt.Sprintf(`attachment; filename="session-%s.tar.gz"`, req.Id),
+		},
+	}, nil
+}
+
+// ImportSessionBundle stores the sessions of an uploaded bundle under new IDs
+func (h *BundleHandlers) ImportSessionBundle(ctx context.Context, req api.ImportSessionBundleRequestObject) (api.ImportSessionBundleResponseObject, error) {
+	if req.Body == nil {
+		return api.ImportSessionBundle400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: "Request body is required"},
+			},
+		}, nil
+	}
+
+	b, err := bundle.Read(req.Body)
+	var result *bundle.ImportResult
+	if err == nil {
+		result, err = h.bundles.Import(ctx, b)
+	}
+	if err != nil {
+		if errors.Is(err, bundle.ErrInvalidBundle) {
+			return api.ImportSessionBundle400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+				},
+			}, nil
+		}
+		slog.Error("Failed to import session bundle",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ImportSessionBundle",
+		)
+		return api.ImportSessionBundle500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	var resp api.ImportSessionBundle201JSONResponse
+	resp.Data.SessionId = result.SessionID
+	resp.Data.RootSessionId = result.RootSessionID
+	resp.Data.Sessions = result.Sessions
+	return resp, nil
+}
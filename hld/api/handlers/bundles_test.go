@@ -0,0 +1,72 @@
+package handlers_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/handlers"
+	"github.com/humanlayer/humanlayer/hld/bundle"
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundleHandlers_ExportImport(t *testing.T) {
+	sqliteStore, err := store.NewSQLiteStore(testutil.DatabasePath(t, "bundle-handlers"))
+	require.NoError(t, err)
+	defer func() { _ = sqliteStore.Close() }()
+
+	ctx := context.Background()
+	require.NoError(t, sqliteStore.CreateSession(ctx, &store.Session{ID: "parent", RunID: "run-parent", Status: store.SessionStatusCompleted}))
+	require.NoError(t, sqliteStore.CreateSession(ctx, &store.Session{ID: "child", RunID: "run-child", ParentSessionID: "parent", Status: store.SessionStatusCompleted}))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	serverImpl := handlers.NewServerImpl(handlers.Handlers{Bundles: handlers.NewBundleHandlers(bundle.New(sqliteStore))})
+	api.RegisterHandlersWithOptions(router, api.NewStrictHandler(serverImpl, nil), api.GinServerOptions{
+		BaseURL: "/api/v1",
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/sessions/child/bundle", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, bundle.ContentType, w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="session-child.tar.gz"`, w.Header().Get("Content-Disposition"))
+	archive := w.Body.Bytes()
+
+	req := httptest.NewRequest("POST", "/api/v1/sessions/import", bytes.NewReader(archive))
+	req.Header.Set("Content-Type", bundle.ContentType)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusCreated, w.Code, w.Body.String())
+
+	var resp api.ImportSessionBundleResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, resp.Data.Sessions, 2)
+	assert.Equal(t, resp.Data.Sessions["child"], resp.Data.SessionId)
+
+	imported, err := sqliteStore.GetSession(ctx, resp.Data.SessionId)
+	require.NoError(t, err)
+	assert.Equal(t, resp.Data.RootSessionId, imported.ParentSessionID)
+
+	t.Run("not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/sessions/missing/bundle", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid archive", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/api/v1/sessions/import", bytes.NewReader([]byte("not a bundle")))
+		req.Header.Set("Content-Type", bundle.ContentType)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
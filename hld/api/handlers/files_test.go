@@ -270,9 +270,8 @@ func setupTestRouterFiles(t *testing.T, files *handlers.FileHandlers) *gin.Engin
 	router := gin.New()
 
 	// Create server implementation with file handlers
-	// Pass nil for handlers we don't need in these tests
 	settingsHandlers := handlers.NewSettingsHandlers(nil)
-	serverImpl := handlers.NewServerImpl(nil, nil, files, nil, settingsHandlers, nil)
+	serverImpl := handlers.NewServerImpl(handlers.Handlers{Files: files, Settings: settingsHandlers})
 	strictHandler := api.NewStrictHandler(serverImpl, nil)
 
 	api.RegisterHandlersWithOptions(router, strictHandler,
@@ -0,0 +1,313 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// ListLabels lists all labels
+func (h *SessionHandlers) ListLabels(ctx context.Context, req api.ListLabelsRequestObject) (api.ListLabelsResponseObject, error) {
+	labels, err := h.store.ListLabels(ctx)
+	if err != nil {
+		slog.Error("Failed to list labels",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListLabels",
+		)
+		return api.ListLabels500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.ListLabels200JSONResponse{
+		Data: h.mapper.LabelsToAPI(labels),
+	}, nil
+}
+
+// CreateLabel creates a new label
+func (h *SessionHandlers) CreateLabel(ctx context.Context, req api.CreateLabelRequestObject) (api.CreateLabelResponseObject, error) {
+	label := &store.Label{
+		ID:   uuid.New().String(),
+		Name: strings.TrimSpace(req.Body.Name),
+	}
+	if req.Body.Color != nil {
+		label.Color = *req.Body.Color
+	}
+	if label.Name == "" {
+		return api.CreateLabel400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: "label name is required"},
+			},
+		}, nil
+	}
+
+	if err := h.store.CreateLabel(ctx, label); err != nil {
+		if errors.Is(err, store.ErrAlreadyExists) {
+			return api.CreateLabel400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3002", Message: err.Error()},
+				},
+			}, nil
+		}
+		slog.Error("Failed to create label",
+			"error", fmt.Sprintf("%v", err),
+			"name", label.Name,
+			"operation", "CreateLabel",
+		)
+		return api.CreateLabel500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.CreateLabel201JSONResponse{
+		Data: h.mapper.LabelToAPI(*label),
+	}, nil
+}
+
+// DeleteLabel deletes a label and removes it from every session
+func (h *SessionHandlers) DeleteLabel(ctx context.Context, req api.DeleteLabelRequestObject) (api.DeleteLabelResponseObject, error) {
+	if err := h.store.DeleteLabel(ctx, req.Id); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.DeleteLabel404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Label not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to delete label",
+			"error", fmt.Sprintf("%v", err),
+			"label_id", req.Id,
+			"operation", "DeleteLabel",
+		)
+		return api.DeleteLabel500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.DeleteLabel204Response{}, nil
+}
+
+// SetSessionLabels replaces the labels of a session
+func (h *SessionHandlers) SetSessionLabels(ctx context.Context, req api.SetSessionLabelsRequestObject) (api.SetSessionLabelsResponseObject, error) {
+	sessionID := string(req.Id)
+	if err := h.store.SetSessionLabels(ctx, sessionID, req.Body.Labels); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.SetSessionLabels404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Session not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to set session labels",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", sessionID,
+			"operation", "SetSessionLabels",
+		)
+		return api.SetSessionLabels500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	labels, err := h.store.GetSessionLabels(ctx, sessionID)
+	if err != nil {
+		slog.Error("Failed to get session labels",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", sessionID,
+			"operation", "SetSessionLabels",
+		)
+		return api.SetSessionLabels500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+	if labels == nil {
+		labels = []string{}
+	}
+
+	return api.SetSessionLabels200JSONResponse{Data: labels}, nil
+}
+
+// ListProjects lists the projects sessions are grouped into
+func (h *SessionHandlers) ListProjects(ctx context.Context, req api.ListProjectsRequestObject) (api.ListProjectsResponseObject, error) {
+	projects, err := h.store.ListProjects(ctx)
+	if err != nil {
+		slog.Error("Failed to list projects",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListProjects",
+		)
+		return api.ListProjects500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.ListProjects200JSONResponse{
+		Data: h.mapper.ProjectsToAPI(projects),
+	}, nil
+}
+
+// savedFilterFromRequest validates a saved filter request
+func (h *SessionHandlers) savedFilterFromRequest(req api.SavedFilterRequest) (*store.SavedFilter, *api.ErrorDetail) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, &api.ErrorDetail{Code: "HLD-3001", Message: "filter name is required"}
+	}
+	filter, err := h.mapper.SessionFilterFromAPI(req.Filter)
+	if err != nil {
+		return nil, &api.ErrorDetail{Code: "HLD-3001", Message: err.Error()}
+	}
+	return &store.SavedFilter{Name: name, Filter: filter}, nil
+}
+
+// ListSavedFilters lists all saved session filters
+func (h *SessionHandlers) ListSavedFilters(ctx context.Context, req api.ListSavedFiltersRequestObject) (api.ListSavedFiltersResponseObject, error) {
+	filters, err := h.store.ListSavedFilters(ctx)
+	if err != nil {
+		slog.Error("Failed to list saved filters",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListSavedFilters",
+		)
+		return api.ListSavedFilters500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.ListSavedFilters200JSONResponse{
+		Data: h.mapper.SavedFiltersToAPI(filters),
+	}, nil
+}
+
+// CreateSavedFilter saves a new session filter
+func (h *SessionHandlers) CreateSavedFilter(ctx context.Context, req api.CreateSavedFilterRequestObject) (api.CreateSavedFilterResponseObject, error) {
+	saved, detail := h.savedFilterFromRequest(*req.Body)
+	if detail != nil {
+		return api.CreateSavedFilter400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{Error: *detail},
+		}, nil
+	}
+	saved.ID = uuid.New().String()
+
+	if err := h.store.CreateSavedFilter(ctx, saved); err != nil {
+		if errors.Is(err, store.ErrAlreadyExists) {
+			return api.CreateSavedFilter400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3002", Message: err.Error()},
+				},
+			}, nil
+		}
+		slog.Error("Failed to create saved filter",
+			"error", fmt.Sprintf("%v", err),
+			"name", saved.Name,
+			"operation", "CreateSavedFilter",
+		)
+		return api.CreateSavedFilter500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.CreateSavedFilter201JSONResponse{
+		Data: h.mapper.SavedFilterToAPI(*saved),
+	}, nil
+}
+
+// UpdateSavedFilter replaces a saved session filter
+func (h *SessionHandlers) UpdateSavedFilter(ctx context.Context, req api.UpdateSavedFilterRequestObject) (api.UpdateSavedFilterResponseObject, error) {
+	saved, detail := h.savedFilterFromRequest(*req.Body)
+	if detail != nil {
+		return api.UpdateSavedFilter400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{Error: *detail},
+		}, nil
+	}
+	saved.ID = req.Id
+
+	if err := h.store.UpdateSavedFilter(ctx, saved); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.UpdateSavedFilter404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Saved filter not found"},
+				},
+			}, nil
+		}
+		if errors.Is(err, store.ErrAlreadyExists) {
+			return api.UpdateSavedFilter400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3002", Message: err.Error()},
+				},
+			}, nil
+		}
+		slog.Error("Failed to update saved filter",
+			"error", fmt.Sprintf("%v", err),
+			"saved_filter_id", req.Id,
+			"operation", "UpdateSavedFilter",
+		)
+		return api.UpdateSavedFilter500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	// Re-read for the stored creation time
+	updated, err := h.store.GetSavedFilter(ctx, req.Id)
+	if err != nil {
+		slog.Error("Failed to get saved filter",
+			"error", fmt.Sprintf("%v", err),
+			"saved_filter_id", req.Id,
+			"operation", "UpdateSavedFilter",
+		)
+		return api.UpdateSavedFilter500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.UpdateSavedFilter200JSONResponse{
+		Data: h.mapper.SavedFilterToAPI(*updated),
+	}, nil
+}
+
+// DeleteSavedFilter deletes a saved session filter
+func (h *SessionHandlers) DeleteSavedFilter(ctx context.Context, req api.DeleteSavedFilterRequestObject) (api.DeleteSavedFilterResponseObject, error) {
+	if err := h.store.DeleteSavedFilter(ctx, req.Id); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.DeleteSavedFilter404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Saved filter not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to delete saved filter",
+			"error", fmt.Sprintf("%v", err),
+			"saved_filter_id", req.Id,
+			"operation", "DeleteSavedFilter",
+		)
+		return api.DeleteSavedFilter500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.DeleteSavedFilter204Response{}, nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/mapper"
+	"github.com/humanlayer/humanlayer/hld/maintenance"
+)
+
+// MaintenanceHandlers handles database maintenance endpoints
+type MaintenanceHandlers struct {
+	maintenance *maintenance.Service
+	mapper      *mapper.Mapper
+}
+
+// NewMaintenanceHandlers creates a new maintenance handler
+func NewMaintenanceHandlers(maintenance *maintenance.Service) *MaintenanceHandlers {
+	return &MaintenanceHandlers{
+		maintenance: maintenance,
+		mapper:      &mapper.Mapper{},
+	}
+}
+
+// RunMaintenance prunes, vacuums and analyzes the database, or reports what
+// would be pruned for a dry run
+func (h *MaintenanceHandlers) RunMaintenance(ctx context.Context, req api.RunMaintenanceRequestObject) (api.RunMaintenanceResponseObject, error) {
+	var opts maintenance.Options
+	if req.Body != nil {
+		if req.Body.DryRun != nil {
+			opts.DryRun = *req.Body.DryRun
+		}
+		if req.Body.Vacuum != nil {
+			opts.Vacuum = string(*req.Body.Vacuum)
+		}
+	}
+
+	report, err := h.maintenance.Run(ctx, opts)
+	if err != nil {
+		if errors.Is(err, maintenance.ErrInvalidVacuumMode) {
+			return api.RunMaintenance400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+				},
+			}, nil
+		}
+		slog.Error("Failed to run database maintenance",
+			"error", fmt.Sprintf("%v", err),
+			"dry_run", opts.DryRun,
+			"operation", "RunMaintenance",
+		)
+		return api.RunMaintenance500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.RunMaintenance200JSONResponse{
+		Data: h.mapper.MaintenanceReportToAPI(*report),
+	}, nil
+}
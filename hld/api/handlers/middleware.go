@@ -26,8 +26,9 @@ func RequestIDMiddleware() gin.HandlerFunc {
 // Skip compression for SSE endpoints as they need raw streaming
 func CompressionMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Skip compression for SSE endpoints
-		if strings.Contains(c.Request.URL.Path, "/events") {
+		// Skip compression for SSE endpoints and session bundles, which are
+		// already gzipped archives
+		if strings.Contains(c.Request.URL.Path, "/events") || strings.HasSuffix(c.Request.URL.Path, "/bundle") {
 			c.Next()
 			return
 		}
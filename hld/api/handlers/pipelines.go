@@ -0,0 +1,214 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/mapper"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/pipeline"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// PipelineHandlers handles multi-step pipeline endpoints
+type PipelineHandlers struct {
+	runner *pipeline.Runner
+	mapper *mapper.Mapper
+}
+
+// NewPipelineHandlers creates a new pipeline handler
+func NewPipelineHandlers(runner *pipeline.Runner) *PipelineHandlers {
+	return &PipelineHandlers{
+		runner: runner,
+		mapper: &mapper.Mapper{},
+	}
+}
+
+// ListPipelineRuns lists all pipeline runs
+func (h *PipelineHandlers) ListPipelineRuns(ctx context.Context, req api.ListPipelineRunsRequestObject) (api.ListPipelineRunsResponseObject, error) {
+	runs, err := h.runner.ListRuns(ctx)
+	if err != nil {
+		slog.Error("Failed to list pipeline runs",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListPipelineRuns",
+		)
+		return api.ListPipelineRuns500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.ListPipelineRuns200JSONResponse{
+		Data: h.mapper.PipelineRunsToAPI(runs),
+	}, nil
+}
+
+// StartPipelineRun parses a pipeline definition and starts running it
+func (h *PipelineHandlers) StartPipelineRun(ctx context.Context, req api.StartPipelineRunRequestObject) (api.StartPipelineRunResponseObject, error) {
+	var data []byte
+	switch {
+	case req.Body.Definition != nil:
+		encoded, err := h.mapper.PipelineDefinitionFromAPI(*req.Body.Definition)
+		if err != nil {
+			return api.StartPipelineRun400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+			}, nil
+		}
+		data = encoded
+	case req.Body.DefinitionYaml != nil:
+		data = []byte(*req.Body.DefinitionYaml)
+	default:
+		return api.StartPipelineRun400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: "definition or definition_yaml is required"},
+			},
+		}, nil
+	}
+
+	def, err := pipeline.ParseDefinition(data)
+	if err != nil {
+		return api.StartPipelineRun400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+		}, nil
+	}
+
+	workingDir := ""
+	if req.Body.WorkingDir != nil {
+		workingDir = *req.Body.WorkingDir
+	}
+
+	run, err := h.runner.StartRun(ctx, def, workingDir)
+	if err != nil {
+		if validation.IsError(err) {
+			return api.StartPipelineRun400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+			}, nil
+		}
+		slog.Error("Failed to start pipeline run",
+			"error", fmt.Sprintf("%v", err),
+			"name", def.Name,
+			"operation", "StartPipelineRun",
+		)
+		return api.StartPipelineRun500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	run, steps, err := h.runner.GetRun(ctx, run.ID)
+	if err != nil {
+		slog.Error("Failed to load started pipeline run",
+			"error", fmt.Sprintf("%v", err),
+			"pipeline_run_id", run.ID,
+			"operation", "StartPipelineRun",
+		)
+		return api.StartPipelineRun500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.StartPipelineRun201JSONResponse{
+		Data: h.mapper.PipelineRunToAPI(*run, steps),
+	}, nil
+}
+
+// GetPipelineRun retrieves a pipeline run with its steps
+func (h *PipelineHandlers) GetPipelineRun(ctx context.Context, req api.GetPipelineRunRequestObject) (api.GetPipelineRunResponseObject, error) {
+	run, steps, err := h.runner.GetRun(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.GetPipelineRun404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Pipeline run not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to get pipeline run",
+			"error", fmt.Sprintf("%v", err),
+			"pipeline_run_id", req.Id,
+			"operation", "GetPipelineRun",
+		)
+		return api.GetPipelineRun500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.GetPipelineRun200JSONResponse{
+		Data: h.mapper.PipelineRunToAPI(*run, steps),
+	}, nil
+}
+
+// PausePipelineRun stops a pipeline from launching further steps
+func (h *PipelineHandlers) PausePipelineRun(ctx context.Context, req api.PausePipelineRunRequestObject) (api.PausePipelineRunResponseObject, error) {
+	if _, err := h.runner.Pause(ctx, string(req.Id)); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.PausePipelineRun404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Pipeline run not found"},
+				},
+			}, nil
+		}
+		if validation.IsError(err) {
+			return api.PausePipelineRun400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+			}, nil
+		}
+		slog.Error("Failed to pause pipeline run",
+			"error", fmt.Sprintf("%v", err),
+			"pipeline_run_id", req.Id,
+			"operation", "PausePipelineRun",
+		)
+		return api.PausePipelineRun500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	run, steps, err := h.runner.GetRun(ctx, string(req.Id))
+	if err != nil {
+		return api.PausePipelineRun500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.PausePipelineRun200JSONResponse{
+		Data: h.mapper.PipelineRunToAPI(*run, steps),
+	}, nil
+}
+
+// ResumePipelineRun continues a paused pipeline
+func (h *PipelineHandlers) ResumePipelineRun(ctx context.Context, req api.ResumePipelineRunRequestObject) (api.ResumePipelineRunResponseObject, error) {
+	if _, err := h.runner.Resume(ctx, string(req.Id)); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.ResumePipelineRun404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Pipeline run not found"},
+				},
+			}, nil
+		}
+		if validation.IsError(err) {
+			return api.ResumePipelineRun400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+			}, nil
+		}
+		slog.Error("Failed to resume pipeline run",
+			"error", fmt.Sprintf("%v", err),
+			"pipeline_run_id", req.Id,
+			"operation", "ResumePipelineRun",
+		)
+		return api.ResumePipelineRun500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	run, steps, err := h.runner.GetRun(ctx, string(req.Id))
+	if err != nil {
+		return api.ResumePipelineRun500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.ResumePipelineRun200JSONResponse{
+		Data: h.mapper.PipelineRunToAPI(*run, steps),
+	}, nil
+}
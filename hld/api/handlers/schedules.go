@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/mapper"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/scheduler"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// ScheduleHandlers handles recurring session schedule endpoints
+type ScheduleHandlers struct {
+	scheduler *scheduler.Scheduler
+	mapper    *mapper.Mapper
+}
+
+// NewScheduleHandlers creates a new schedule handler
+func NewScheduleHandlers(scheduler *scheduler.Scheduler) *ScheduleHandlers {
+	return &ScheduleHandlers{
+		scheduler: scheduler,
+		mapper:    &mapper.Mapper{},
+	}
+}
+
+func scheduleErrorDetail(code string, err error) api.ErrorDetail {
+	return api.ErrorDetail{Code: code, Message: err.Error()}
+}
+
+// ListSchedules lists all schedules
+func (h *ScheduleHandlers) ListSchedules(ctx context.Context, req api.ListSchedulesRequestObject) (api.ListSchedulesResponseObject, error) {
+	schedules, err := h.scheduler.ListSchedules(ctx)
+	if err != nil {
+		slog.Error("Failed to list schedules",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListSchedules",
+		)
+		return api.ListSchedules500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.ListSchedules200JSONResponse{
+		Data: h.mapper.SchedulesToAPI(schedules),
+	}, nil
+}
+
+// CreateSchedule creates a new schedule
+func (h *ScheduleHandlers) CreateSchedule(ctx context.Context, req api.CreateScheduleRequestObject) (api.CreateScheduleResponseObject, error) {
+	launchConfig, err := h.mapper.ScheduleLaunchConfigFromAPI(req.Body.LaunchConfig)
+	if err != nil {
+		return api.CreateSchedule400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+		}, nil
+	}
+
+	sched := &store.Schedule{
+		Name:         req.Body.Name,
+		CronExpr:     req.Body.CronExpression,
+		LaunchConfig: launchConfig,
+		Enabled:      req.Body.Enabled == nil || *req.Body.Enabled,
+	}
+	if req.Body.Timezone != nil {
+		sched.Timezone = *req.Body.Timezone
+	}
+	if req.Body.WorkingDir != nil {
+		sched.WorkingDir = *req.Body.WorkingDir
+	}
+	if req.Body.OverlapPolicy != nil {
+		sched.OverlapPolicy = string(*req.Body.OverlapPolicy)
+	}
+	if req.Body.CatchUpPolicy != nil {
+		sched.CatchUpPolicy = string(*req.Body.CatchUpPolicy)
+	}
+
+	created, err := h.scheduler.CreateSchedule(ctx, sched)
+	if err != nil {
+		if validation.IsError(err) {
+			return api.CreateSchedule400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+			}, nil
+		}
+		slog.Error("Failed to create schedule",
+			"error", fmt.Sprintf("%v", err),
+			"name", req.Body.Name,
+			"operation", "CreateSchedule",
+		)
+		return api.CreateSchedule500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.CreateSchedule201JSONResponse{
+		Data: h.mapper.ScheduleToAPI(*created),
+	}, nil
+}
+
+// GetSchedule retrieves a schedule by ID
+func (h *ScheduleHandlers) GetSchedule(ctx context.Context, req api.GetScheduleRequestObject) (api.GetScheduleResponseObject, error) {
+	sched, err := h.scheduler.GetSchedule(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.GetSchedule404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Schedule not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to get schedule",
+			"error", fmt.Sprintf("%v", err),
+			"schedule_id", req.Id,
+			"operation", "GetSchedule",
+		)
+		return api.GetSchedule500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.GetSchedule200JSONResponse{
+		Data: h.mapper.ScheduleToAPI(*sched),
+	}, nil
+}
+
+// UpdateSchedule updates a schedule
+func (h *ScheduleHandlers) UpdateSchedule(ctx context.Context, req api.UpdateScheduleRequestObject) (api.UpdateScheduleResponseObject, error) {
+	updates := store.ScheduleUpdate{
+		Name:       req.Body.Name,
+		CronExpr:   req.Body.CronExpression,
+		Timezone:   req.Body.Timezone,
+		WorkingDir: req.Body.WorkingDir,
+		Enabled:    req.Body.Enabled,
+	}
+	if req.Body.LaunchConfig != nil {
+		launchConfig, err := h.mapper.ScheduleLaunchConfigFromAPI(*req.Body.LaunchConfig)
+		if err != nil {
+			return api.UpdateSchedule400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+			}, nil
+		}
+		updates.LaunchConfig = &launchConfig
+	}
+	if req.Body.OverlapPolicy != nil {
+		policy := string(*req.Body.OverlapPolicy)
+		updates.OverlapPolicy = &policy
+	}
+	if req.Body.CatchUpPolicy != nil {
+		policy := string(*req.Body.CatchUpPolicy)
+		updates.CatchUpPolicy = &policy
+	}
+
+	sched, err := h.scheduler.UpdateSchedule(ctx, string(req.Id), updates)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.UpdateSchedule404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Schedule not found"},
+				},
+			}, nil
+		}
+		if validation.IsError(err) {
+			return api.UpdateSchedule400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+			}, nil
+		}
+		slog.Error("Failed to update schedule",
+			"error", fmt.Sprintf("%v", err),
+			"schedule_id", req.Id,
+			"operation", "UpdateSchedule",
+		)
+		return api.UpdateSchedule500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.UpdateSchedule200JSONResponse{
+		Data: h.mapper.ScheduleToAPI(*sched),
+	}, nil
+}
+
+// DeleteSchedule deletes a schedule and its run history
+func (h *ScheduleHandlers) DeleteSchedule(ctx context.Context, req api.DeleteScheduleRequestObject) (api.DeleteScheduleResponseObject, error) {
+	if err := h.scheduler.DeleteSchedule(ctx, string(req.Id)); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.DeleteSchedule404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Schedule not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to delete schedule",
+			"error", fmt.Sprintf("%v", err),
+			"schedule_id", req.Id,
+			"operation", "DeleteSchedule",
+		)
+		return api.DeleteSchedule500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.DeleteSchedule204Response{}, nil
+}
+
+// ListScheduleRuns returns a schedule's run history
+func (h *ScheduleHandlers) ListScheduleRuns(ctx context.Context, req api.ListScheduleRunsRequestObject) (api.ListScheduleRunsResponseObject, error) {
+	limit := 50
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+	}
+
+	runs, err := h.scheduler.ListRuns(ctx, string(req.Id), limit)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.ListScheduleRuns404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Schedule not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to list schedule runs",
+			"error", fmt.Sprintf("%v", err),
+			"schedule_id", req.Id,
+			"operation", "ListScheduleRuns",
+		)
+		return api.ListScheduleRuns500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.ListScheduleRuns200JSONResponse{
+		Data: h.mapper.ScheduleRunsToAPI(runs),
+	}, nil
+}
+
+// TriggerSchedule fires a schedule immediately
+func (h *ScheduleHandlers) TriggerSchedule(ctx context.Context, req api.TriggerScheduleRequestObject) (api.TriggerScheduleResponseObject, error) {
+	run, err := h.scheduler.TriggerSchedule(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.TriggerSchedule404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Schedule not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to trigger schedule",
+			"error", fmt.Sprintf("%v", err),
+			"schedule_id", req.Id,
+			"operation", "TriggerSchedule",
+		)
+		return api.TriggerSchedule500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.TriggerSchedule200JSONResponse{
+		Data: h.mapper.ScheduleRunToAPI(*run),
+	}, nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// SearchConversations handles GET /conversations/search
+func (h *SessionHandlers) SearchConversations(ctx context.Context, req api.SearchConversationsRequestObject) (api.SearchConversationsResponseObject, error) {
+	search := conversationSearchFromParams(req.Params)
+	if err := search.Validate(); err != nil {
+		return api.SearchConversations400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	results, err := h.store.SearchConversations(ctx, search)
+	if err != nil {
+		slog.Error("Failed to search conversations",
+			"error", fmt.Sprintf("%v", err),
+			"query", search.Query,
+			"operation", "SearchConversations",
+		)
+		return api.SearchConversations500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.SearchConversations200JSONResponse{
+		Data: h.mapper.ConversationSearchResultsToAPI(results),
+	}, nil
+}
+
+// conversationSearchFromParams builds a store search from query parameters
+func conversationSearchFromParams(params api.SearchConversationsParams) store.ConversationSearch {
+	search := store.ConversationSearch{
+		Query:  params.Q,
+		After:  params.After,
+		Before: params.Before,
+	}
+	if params.Role != nil {
+		search.Roles = *params.Role
+	}
+	if params.EventType != nil {
+		search.EventTypes = *params.EventType
+	}
+	if params.ToolName != nil {
+		search.ToolNames = *params.ToolName
+	}
+	if params.SessionId != nil {
+		search.SessionID = *params.SessionId
+	}
+	if params.ProjectId != nil {
+		search.ProjectID = *params.ProjectId
+	}
+	if params.Limit != nil {
+		search.Limit = *params.Limit
+	}
+	if params.MatchesPerSession != nil {
+		search.MatchesPerSession = *params.MatchesPerSession
+	}
+	return search
+}
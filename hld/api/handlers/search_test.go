@@ -0,0 +1,68 @@
+package handlers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/handlers"
+	"github.com/humanlayer/humanlayer/hld/approval"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSessionHandlers_SearchConversations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := session.NewMockSessionManager(ctrl)
+	mockStore := store.NewMockConversationStore(ctrl)
+	mockApprovalManager := approval.NewMockManager(ctrl)
+
+	handlers := handlers.NewSessionHandlers(mockManager, mockStore, mockApprovalManager)
+	router := setupTestRouter(t, handlers, nil, nil)
+
+	t.Run("filters and results", func(t *testing.T) {
+		after := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		mockStore.EXPECT().SearchConversations(gomock.Any(), store.ConversationSearch{
+			Query:      "payments.go",
+			EventTypes: []string{"tool_call", "tool_result"},
+			ToolNames:  []string{"Edit"},
+			After:      &after,
+			Limit:      5,
+		}).Return([]*store.ConversationSearchResult{{
+			Session: &store.Session{ID: "sess-1", RunID: "run-1", Status: "completed", Title: "Fix refunds"},
+			Matches: []store.ConversationMatch{{
+				EventID:         42,
+				ClaudeSessionID: "claude-1",
+				Sequence:        7,
+				EventType:       "tool_call",
+				ToolName:        "Edit",
+				Snippet:         `{"file_path":"/src/payments.go"}`,
+				Highlights:      []store.TextRange{{Start: 19, End: 30}},
+			}},
+		}}, nil)
+
+		w := makeRequest(t, router, "GET", "/api/v1/conversations/search?q=payments.go"+
+			"&eventType=tool_call&eventType=tool_result&toolName=Edit&after=2026-03-01T00:00:00Z&limit=5", nil)
+
+		var resp api.ConversationSearchResponse
+		assertJSONResponse(t, w, 200, &resp)
+		require.Len(t, resp.Data, 1)
+		assert.Equal(t, "sess-1", resp.Data[0].Session.Id)
+		require.Len(t, resp.Data[0].Matches, 1)
+		match := resp.Data[0].Matches[0]
+		assert.Equal(t, 7, match.Sequence)
+		assert.Equal(t, "Edit", *match.ToolName)
+		assert.Nil(t, match.Role)
+		assert.Equal(t, []api.TextRange{{Start: 19, End: 30}}, match.Highlights)
+	})
+
+	t.Run("blank query", func(t *testing.T) {
+		w := makeRequest(t, router, "GET", "/api/v1/conversations/search?q=%20%20", nil)
+		assert.Equal(t, 400, w.Code)
+	})
+}
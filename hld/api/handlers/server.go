@@ -12,17 +12,52 @@ type ServerImpl struct {
 	*SSEHandler
 	*SettingsHandlers
 	*AgentHandlers
+	*ScheduleHandlers
+	*PipelineHandlers
+	*BatchHandlers
+	*TemplateHandlers
+	*MaintenanceHandlers
+	*BackupHandlers
+	*BundleHandlers
+	*WebhookHandlers
+}
+
+// Handlers are the handler groups a server is built from. Groups a caller
+// never routes requests to, as in tests, may be left nil.
+type Handlers struct {
+	Sessions    *SessionHandlers
+	Approvals   *ApprovalHandlers
+	Files       *FileHandlers
+	SSE         *SSEHandler
+	Settings    *SettingsHandlers
+	Agents      *AgentHandlers
+	Schedules   *ScheduleHandlers
+	Pipelines   *PipelineHandlers
+	Batches     *BatchHandlers
+	Templates   *TemplateHandlers
+	Maintenance *MaintenanceHandlers
+	Backups     *BackupHandlers
+	Bundles     *BundleHandlers
+	Webhooks    *WebhookHandlers
 }
 
 // NewServerImpl creates a new server implementation
-func NewServerImpl(sessions *SessionHandlers, approvals *ApprovalHandlers, files *FileHandlers, sse *SSEHandler, settings *SettingsHandlers, agents *AgentHandlers) api.StrictServerInterface {
+func NewServerImpl(h Handlers) api.StrictServerInterface {
 	return &ServerImpl{
-		SessionHandlers:  sessions,
-		ApprovalHandlers: approvals,
-		FileHandlers:     files,
-		SSEHandler:       sse,
-		SettingsHandlers: settings,
-		AgentHandlers:    agents,
+		SessionHandlers:     h.Sessions,
+		ApprovalHandlers:    h.Approvals,
+		FileHandlers:        h.Files,
+		SSEHandler:          h.SSE,
+		SettingsHandlers:    h.Settings,
+		AgentHandlers:       h.Agents,
+		ScheduleHandlers:    h.Schedules,
+		PipelineHandlers:    h.Pipelines,
+		BatchHandlers:       h.Batches,
+		TemplateHandlers:    h.Templates,
+		MaintenanceHandlers: h.Maintenance,
+		BackupHandlers:      h.Backups,
+		BundleHandlers:      h.Bundles,
+		WebhookHandlers:     h.Webhooks,
 	}
 }
 
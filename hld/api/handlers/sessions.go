@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
@@ -17,10 +18,12 @@ import (
 	"github.com/humanlayer/humanlayer/hld/api"
 	"github.com/humanlayer/humanlayer/hld/api/mapper"
 	"github.com/humanlayer/humanlayer/hld/approval"
+	"github.com/humanlayer/humanlayer/hld/bus"
 	"github.com/humanlayer/humanlayer/hld/config"
 	"github.com/humanlayer/humanlayer/hld/internal/version"
 	"github.com/humanlayer/humanlayer/hld/session"
 	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/humanlayer/humanlayer/hld/transcript"
 	"github.com/sahilm/fuzzy"
 )
 
@@ -32,6 +35,7 @@ type SessionHandlers struct {
 	version         string
 	config          *config.Config
 	sessionManager  session.SessionManager // Add reference to session manager for Claude status checks
+	eventBus        bus.EventBus           // Reports subscriber statistics in debug info, if set
 }
 
 func NewSessionHandlers(manager session.SessionManager, store store.ConversationStore, approvalManager approval.Manager) *SessionHandlers {
@@ -57,6 +61,11 @@ func NewSessionHandlersWithConfig(manager session.SessionManager, store store.Co
 	}
 }
 
+// SetEventBus sets the event bus whose subscribers debug info reports on
+func (h *SessionHandlers) SetEventBus(eventBus bus.EventBus) {
+	h.eventBus = eventBus
+}
+
 // expandTilde expands ~ to the user's home directory
 func expandTilde(path string) string {
 	if len(path) > 0 && path[0] == '~' {
@@ -184,7 +193,24 @@ func (h *SessionHandlers) CreateSession(ctx context.Context, req api.CreateSessi
 		}
 	}
 
+	// Handle stall watchdog thresholds
+	if negativeStallThreshold(req.Body.StallThresholdMs, req.Body.StallInterruptThresholdMs) {
+		return api.CreateSession400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-3001",
+					Message: "stall thresholds must not be negative",
+				},
+			},
+		}, nil
+	}
+	config.StallThresholdMs = req.Body.StallThresholdMs
+	config.StallInterruptThresholdMs = req.Body.StallInterruptThresholdMs
+
 	// Handle optional fields
+	if req.Body.Runner != nil {
+		config.Runner = *req.Body.Runner
+	}
 	if req.Body.Title != nil {
 		config.Title = *req.Body.Title
 	}
@@ -263,6 +289,16 @@ func (h *SessionHandlers) CreateSession(ctx context.Context, req api.CreateSessi
 				RequiresCreation: true,
 			}, nil
 		}
+		if errors.Is(err, session.ErrUnknownRunner) || session.IsProjectConfigError(err) {
+			return api.CreateSession400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{
+						Code:    "HLD-3001",
+						Message: err.Error(),
+					},
+				},
+			}, nil
+		}
 		slog.Error("Failed to launch session",
 			"error", fmt.Sprintf("%v", err),
 			"query", config.Query,
@@ -287,112 +323,94 @@ func (h *SessionHandlers) CreateSession(ctx context.Context, req api.CreateSessi
 
 // ListSessions implements GET /sessions
 func (h *SessionHandlers) ListSessions(ctx context.Context, req api.ListSessionsRequestObject) (api.ListSessionsResponseObject, error) {
-	// NEW: leavesOnly parameter (renamed from leafOnly, default true)
-	leavesOnly := true
-	if req.Params.LeavesOnly != nil {
-		leavesOnly = *req.Params.LeavesOnly
-	}
-
-	// NEW: filter parameter logic
-	var filterType string
-	if req.Params.Filter != nil {
-		filterType = string(*req.Params.Filter)
-	}
-	// When filter is nil, return ALL sessions (no filtering)
-
-	// Get all sessions from manager
-	sessionInfos := h.manager.ListSessions()
-
-	// Determine which sessions to use for counting
-	var sessionsForCounting []session.Info
-
-	if leavesOnly {
-		// Build parent-to-children map
-		childrenMap := make(map[string][]string)
-		for _, s := range sessionInfos {
-			if s.ParentSessionID != "" {
-				childrenMap[s.ParentSessionID] = append(childrenMap[s.ParentSessionID], s.ID)
-			}
-		}
-
-		// When leavesOnly, only count leaf sessions
-		for _, s := range sessionInfos {
-			if len(childrenMap[s.ID]) == 0 {
-				// This is a leaf session
-				sessionsForCounting = append(sessionsForCounting, s)
+	// Start from the saved filter, if any, and let request parameters override it
+	var filter store.SessionFilter
+	if req.Params.SavedFilterId != nil {
+		saved, err := h.store.GetSavedFilter(ctx, *req.Params.SavedFilterId)
+		if err != nil {
+			if errors.Is(err, store.ErrNotFound) {
+				return api.ListSessions404JSONResponse{
+					NotFoundJSONResponse: api.NotFoundJSONResponse{
+						Error: api.ErrorDetail{Code: "HLD-1002", Message: "Saved filter not found"},
+					},
+				}, nil
 			}
+			slog.Error("Failed to get saved filter",
+				"error", fmt.Sprintf("%v", err),
+				"saved_filter_id", *req.Params.SavedFilterId,
+				"operation", "ListSessions",
+			)
+			return api.ListSessions500JSONResponse{
+				InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+				},
+			}, nil
 		}
-	} else {
-		// When not leavesOnly, count all sessions
-		sessionsForCounting = sessionInfos
-	}
-
-	// Calculate counts based on the appropriate set of sessions
-	var normalCount, archivedCount, draftCount int
-	for _, s := range sessionsForCounting {
-		if s.Archived {
-			archivedCount++
-		} else if s.Status == session.StatusDraft {
-			draftCount++
-		} else if s.Status != session.StatusDiscarded {
-			normalCount++
-		}
+		filter = saved.Filter
+	}
+	filter = filter.Merge(sessionFilterFromParams(req.Params))
+	if err := filter.Validate(); err != nil {
+		return api.ListSessions400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+			},
+		}, nil
 	}
 
-	// Apply filters for the returned list
-	var filtered []session.Info
-
-	for _, s := range sessionsForCounting {
-		// Apply filter logic
-		if !shouldIncludeSession(s, filterType) {
-			continue
+	limit, err := pageLimit(req.Params.Limit, maxSessionsPageSize)
+	if err != nil {
+		return api.ListSessions400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+			},
+		}, nil
+	}
+	if req.Params.Cursor != nil {
+		filter.After, err = store.ParseSessionCursor(*req.Params.Cursor)
+		if err != nil {
+			return api.ListSessions400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+				},
+			}, nil
 		}
-
-		filtered = append(filtered, s)
+	}
+	// Fetch one extra session to learn whether another page follows
+	if limit > 0 {
+		filter.Limit = limit + 1
 	}
 
-	// Sort by last activity (newest first)
-	sort.Slice(filtered, func(i, j int) bool {
-		return filtered[i].LastActivityAt.After(filtered[j].LastActivityAt)
-	})
-
-	// Convert to API sessions
-	sessions := make([]api.Session, len(filtered))
-	for i, info := range filtered {
-		// Convert Info to store.Session for mapper
-		storeSession := store.Session{
-			ID:                                  info.ID,
-			RunID:                               info.RunID,
-			ClaudeSessionID:                     info.ClaudeSessionID,
-			ParentSessionID:                     info.ParentSessionID,
-			Status:                              string(info.Status),
-			Query:                               info.Query,
-			Summary:                             info.Summary,
-			Title:                               info.Title,
-			Model:                               info.Model,
-			WorkingDir:                          info.WorkingDir,
-			CreatedAt:                           info.StartTime,
-			LastActivityAt:                      info.LastActivityAt,
-			CompletedAt:                         info.EndTime,
-			ErrorMessage:                        info.Error,
-			AutoAcceptEdits:                     info.AutoAcceptEdits,
-			DangerouslySkipPermissions:          info.DangerouslySkipPermissions,
-			DangerouslySkipPermissionsExpiresAt: info.DangerouslySkipPermissionsExpiresAt,
-			Archived:                            info.Archived,
-			EditorState:                         info.EditorState,
-			ProxyEnabled:                        info.ProxyEnabled,
-			ProxyBaseURL:                        info.ProxyBaseURL,
-			ProxyModelOverride:                  info.ProxyModelOverride,
-			ProxyAPIKey:                         info.ProxyAPIKey,
-		}
+	// Counts cover every kind so the UI can show them next to each tab
+	counts, err := h.store.CountSessionsByKind(ctx, filter)
+	if err != nil {
+		slog.Error("Failed to count sessions",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListSessions",
+		)
+		return api.ListSessions500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
 
-		// Copy result data if available
-		if info.Result != nil {
-			storeSession.CostUSD = &info.Result.CostUSD
-			storeSession.DurationMS = &info.Result.DurationMS
-		}
+	dbSessions, err := h.store.QuerySessions(ctx, filter)
+	if err != nil {
+		slog.Error("Failed to list sessions",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListSessions",
+		)
+		return api.ListSessions500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
 
-		sessions[i] = h.mapper.SessionToAPI(storeSession)
+	dbSessions, nextCursor := store.PageSessions(dbSessions, limit)
+	sessions := make([]api.Session, len(dbSessions))
+	for i, dbSession := range dbSessions {
+		sessions[i] = h.mapper.SessionToAPI(*dbSession)
 	}
 
 	resp := api.SessionsResponse{
@@ -402,30 +420,64 @@ func (h *SessionHandlers) ListSessions(ctx context.Context, req api.ListSessions
 			Draft    *int `json:"draft,omitempty"`
 			Normal   *int `json:"normal,omitempty"`
 		}{
-			Normal:   &normalCount,
-			Archived: &archivedCount,
-			Draft:    &draftCount,
+			Normal:   &counts.Normal,
+			Archived: &counts.Archived,
+			Draft:    &counts.Draft,
 		},
 	}
+	if nextCursor != "" {
+		resp.NextCursor = &nextCursor
+	}
 	return api.ListSessions200JSONResponse(resp), nil
 }
 
-// shouldIncludeSession helper function for filter logic
-func shouldIncludeSession(s session.Info, filterType string) bool {
-	switch filterType {
-	case "normal":
-		return !s.Archived && s.Status != session.StatusDraft && s.Status != session.StatusDiscarded
-	case "archived":
-		return s.Archived
-	case "draft":
-		return s.Status == session.StatusDraft && !s.Archived
-	case "":
-		// No filter specified - include ALL sessions
-		return true
-	default:
-		// Unknown filter - include all (graceful degradation)
-		return true
+const (
+	maxSessionsPageSize     = 1000
+	maxConversationPageSize = 10000
+)
+
+// pageLimit validates an optional page size, returning 0 when every item was requested
+func pageLimit(limit *int, max int) (int, error) {
+	if limit == nil {
+		return 0, nil
+	}
+	if *limit < 1 || *limit > max {
+		return 0, fmt.Errorf("limit must be between 1 and %d", max)
+	}
+	return *limit, nil
+}
+
+// sessionFilterFromParams builds a session filter from list query parameters
+func sessionFilterFromParams(params api.ListSessionsParams) store.SessionFilter {
+	filter := store.SessionFilter{
+		// Leaf sessions (sessions with no children) are listed by default
+		LeavesOnly:    params.LeavesOnly == nil || *params.LeavesOnly,
+		CreatedAfter:  params.CreatedAfter,
+		CreatedBefore: params.CreatedBefore,
+		MinCostUSD:    params.MinCostUsd,
+		MaxCostUSD:    params.MaxCostUsd,
+	}
+	if params.Filter != nil {
+		switch kind := string(*params.Filter); kind {
+		case store.SessionKindNormal, store.SessionKindArchived, store.SessionKindDraft:
+			filter.Kind = kind
+		default:
+			// Unknown filter - include all (graceful degradation)
+		}
+	}
+	if params.Status != nil {
+		filter.Statuses = *params.Status
+	}
+	if params.Label != nil {
+		filter.Labels = *params.Label
+	}
+	if params.ProjectId != nil {
+		filter.ProjectID = *params.ProjectId
+	}
+	if params.Model != nil {
+		filter.Models = *params.Model
 	}
+	return filter
 }
 
 // GetSession retrieves details for a specific session
@@ -604,6 +656,18 @@ func (h *SessionHandlers) UpdateSession(ctx context.Context, req api.UpdateSessi
 			"workingDir", *req.Body.WorkingDir)
 	}
 
+	// Update stall watchdog thresholds if specified
+	if negativeStallThreshold(req.Body.StallThresholdMs, req.Body.StallInterruptThresholdMs) {
+		return api.UpdateSession400JSONResponse{
+			Error: api.ErrorDetail{
+				Code:    "HLD-3001",
+				Message: "stall thresholds must not be negative",
+			},
+		}, nil
+	}
+	update.StallThresholdMs = req.Body.StallThresholdMs
+	update.StallInterruptThresholdMs = req.Body.StallInterruptThresholdMs
+
 	// Update editor state if specified
 	if req.Body.EditorState != nil {
 		update.EditorState = req.Body.EditorState
@@ -1127,6 +1191,98 @@ func (h *SessionHandlers) ContinueSession(ctx context.Context, req api.ContinueS
 	return api.ContinueSession201JSONResponse(resp), nil
 }
 
+// CompactSession continues a session with a request to compact its conversation
+func (h *SessionHandlers) CompactSession(ctx context.Context, req api.CompactSessionRequestObject) (api.CompactSessionResponseObject, error) {
+	parentSession, err := h.store.GetSession(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.CompactSession404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{
+						Code:    "HLD-1002",
+						Message: "Session not found",
+					},
+				},
+			}, nil
+		}
+		slog.Error("Failed to get session for compaction",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", req.Id,
+			"operation", "CompactSession",
+		)
+		return api.CompactSession500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	// A conversation must exist before it can be compacted
+	if parentSession.Status == store.SessionStatusDraft || parentSession.ClaudeSessionID == "" {
+		return api.CompactSession400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-3001",
+					Message: "Session has no conversation to compact",
+				},
+			},
+		}, nil
+	}
+
+	var instructions string
+	if req.Body != nil && req.Body.Instructions != nil {
+		instructions = *req.Body.Instructions
+	}
+
+	result, err := h.manager.ContinueSession(ctx, session.ContinueSessionConfig{
+		ParentSessionID: string(req.Id),
+		Query:           session.CompactCommand(instructions),
+	})
+	if err != nil {
+		slog.Error("Failed to compact session",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", req.Id,
+			"operation", "CompactSession",
+		)
+		return api.CompactSession500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	newSession, err := h.store.GetSession(ctx, result.ID)
+	if err != nil {
+		slog.Error("Failed to get created session details",
+			"error", fmt.Sprintf("%v", err),
+			"parent_session_id", req.Id,
+			"new_session_id", result.ID,
+			"operation", "CompactSession",
+		)
+		return api.CompactSession500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: "Failed to get created session details",
+				},
+			},
+		}, nil
+	}
+
+	resp := api.ContinueSessionResponse{}
+	resp.Data.SessionId = result.ID
+	resp.Data.RunId = result.RunID
+	resp.Data.ClaudeSessionId = newSession.ClaudeSessionID
+	resp.Data.ParentSessionId = string(req.Id)
+	return api.CompactSession201JSONResponse(resp), nil
+}
+
 // InterruptSession sends an interrupt signal to a running session
 func (h *SessionHandlers) InterruptSession(ctx context.Context, req api.InterruptSessionRequestObject) (api.InterruptSessionResponseObject, error) {
 	session, err := h.store.GetSession(ctx, string(req.Id))
@@ -1191,9 +1347,43 @@ func (h *SessionHandlers) InterruptSession(ctx context.Context, req api.Interrup
 
 // GetSessionMessages retrieves conversation history for a session
 func (h *SessionHandlers) GetSessionMessages(ctx context.Context, req api.GetSessionMessagesRequestObject) (api.GetSessionMessagesResponseObject, error) {
-	events, err := h.store.GetSessionConversation(ctx, string(req.Id))
+	limit, err := pageLimit(req.Params.Limit, maxConversationPageSize)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		return api.GetSessionMessages400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+			},
+		}, nil
+	}
+	page := store.ConversationPage{}
+	if req.Params.Cursor != nil {
+		page.After, err = store.ParseConversationCursor(*req.Params.Cursor)
+		if err != nil {
+			return api.GetSessionMessages400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+				},
+			}, nil
+		}
+	}
+	if req.Params.SinceSequence != nil {
+		page.SinceSequence = *req.Params.SinceSequence
+	}
+	// Fetch one extra event to learn whether another page follows
+	if limit > 0 {
+		page.Limit = limit + 1
+	}
+
+	events, err := h.store.GetSessionConversationPage(ctx, string(req.Id), page)
+	if err != nil {
+		if errors.Is(err, store.ErrInvalidCursor) {
+			return api.GetSessionMessages400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+				},
+			}, nil
+		}
+		if errors.Is(err, store.ErrNotFound) || errors.Is(err, sql.ErrNoRows) {
 			return api.GetSessionMessages404JSONResponse{
 				NotFoundJSONResponse: api.NotFoundJSONResponse{
 					Error: api.ErrorDetail{
@@ -1218,15 +1408,22 @@ func (h *SessionHandlers) GetSessionMessages(ctx context.Context, req api.GetSes
 		}, nil
 	}
 
+	events, nextCursor, hasMore := store.PageConversation(events, limit)
+
 	// Convert to API events
 	apiEvents := make([]api.ConversationEvent, len(events))
 	for i, event := range events {
 		apiEvents[i] = h.mapper.ConversationEventToAPI(*event)
 	}
 
-	return api.GetSessionMessages200JSONResponse{
-		Data: apiEvents,
-	}, nil
+	resp := api.GetSessionMessages200JSONResponse{
+		Data:    apiEvents,
+		HasMore: &hasMore,
+	}
+	if nextCursor != "" {
+		resp.NextCursor = &nextCursor
+	}
+	return resp, nil
 }
 
 // GetSessionSnapshots retrieves file snapshots for a session
@@ -1544,6 +1741,229 @@ func (h *SessionHandlers) persistClaudePath(path string) error {
 	return nil
 }
 
+// GetSessionResources returns the resource usage and recent samples of a session's process tree
+func (h *SessionHandlers) GetSessionResources(ctx context.Context, req api.GetSessionResourcesRequestObject) (api.GetSessionResourcesResponseObject, error) {
+	sess, err := h.store.GetSession(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return api.GetSessionResources404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{
+						Code:    "HLD-1002",
+						Message: "Session not found",
+					},
+				},
+			}, nil
+		}
+		slog.Error("Failed to get session for resource usage",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", req.Id,
+			"operation", "GetSessionResources",
+		)
+		return api.GetSessionResources500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	limit := 240
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+	}
+
+	samples, err := h.store.GetResourceSamples(ctx, string(req.Id), limit)
+	if err != nil {
+		slog.Error("Failed to get resource samples",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", req.Id,
+			"operation", "GetSessionResources",
+		)
+		return api.GetSessionResources500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	resp := api.SessionResourcesResponse{}
+	resp.Data.Resources = h.mapper.SessionResourcesToAPI(*sess)
+	resp.Data.Samples = h.mapper.ResourceSamplesToAPI(samples)
+	return api.GetSessionResources200JSONResponse(resp), nil
+}
+
+// GetSessionEffectiveConfig implements GET /sessions/{id}/effective-config
+func (h *SessionHandlers) GetSessionEffectiveConfig(ctx context.Context, req api.GetSessionEffectiveConfigRequestObject) (api.GetSessionEffectiveConfigResponseObject, error) {
+	config, err := h.store.GetSessionEffectiveConfig(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.GetSessionEffectiveConfig404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{
+						Code:    "HLD-1002",
+						Message: "Session not found",
+					},
+				},
+			}, nil
+		}
+		slog.Error("Failed to get session effective config",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", req.Id,
+			"operation", "GetSessionEffectiveConfig",
+		)
+		return api.GetSessionEffectiveConfig500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	// Sessions created before effective configs were recorded have none
+	if config == "" {
+		return api.GetSessionEffectiveConfig404JSONResponse{
+			NotFoundJSONResponse: api.NotFoundJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-1002",
+					Message: "No effective configuration recorded for session",
+				},
+			},
+		}, nil
+	}
+
+	effective, err := h.mapper.EffectiveConfigToAPI(config)
+	if err != nil {
+		slog.Error("Failed to decode session effective config",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", req.Id,
+			"operation", "GetSessionEffectiveConfig",
+		)
+		return api.GetSessionEffectiveConfig500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+	return api.GetSessionEffectiveConfig200JSONResponse(api.EffectiveConfigResponse{Data: effective}), nil
+}
+
+// ValidateProjectConfig implements POST /project-config/validate
+func (h *SessionHandlers) ValidateProjectConfig(ctx context.Context, req api.ValidateProjectConfigRequestObject) (api.ValidateProjectConfigResponseObject, error) {
+	if strings.TrimSpace(req.Body.WorkingDir) == "" {
+		return api.ValidateProjectConfig400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-3001",
+					Message: "working_dir is required",
+				},
+			},
+		}, nil
+	}
+
+	result, err := session.ValidateProjectConfig(req.Body.WorkingDir, req.Body.Content)
+	if err != nil {
+		slog.Error("Failed to validate project config",
+			"error", fmt.Sprintf("%v", err),
+			"working_dir", req.Body.WorkingDir,
+			"operation", "ValidateProjectConfig",
+		)
+		return api.ValidateProjectConfig500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	trusted, err := session.ProjectConfigTrusted(ctx, h.store, req.Body.WorkingDir)
+	if err != nil {
+		slog.Error("Failed to check project config trust",
+			"error", fmt.Sprintf("%v", err),
+			"working_dir", req.Body.WorkingDir,
+			"operation", "ValidateProjectConfig",
+		)
+		return api.ValidateProjectConfig500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	resp := api.ValidateProjectConfigResponse{}
+	resp.Data.Path = result.Path
+	resp.Data.Exists = result.Exists
+	resp.Data.Valid = result.Valid
+	resp.Data.Trusted = trusted
+	resp.Data.Issues = make([]api.ProjectConfigIssue, len(result.Issues))
+	for i, issue := range result.Issues {
+		resp.Data.Issues[i] = api.ProjectConfigIssue{Field: issue.Field, Message: issue.Message}
+	}
+	return api.ValidateProjectConfig200JSONResponse(resp), nil
+}
+
+// TrustProjectConfig implements POST /project-config/trust
+func (h *SessionHandlers) TrustProjectConfig(ctx context.Context, req api.TrustProjectConfigRequestObject) (api.TrustProjectConfigResponseObject, error) {
+	if strings.TrimSpace(req.Body.WorkingDir) == "" {
+		return api.TrustProjectConfig400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-3001",
+					Message: "working_dir is required",
+				},
+			},
+		}, nil
+	}
+
+	trust, err := session.TrustProjectConfig(ctx, h.store, req.Body.WorkingDir, req.Body.Trusted)
+	if session.IsProjectConfigError(err) {
+		return api.TrustProjectConfig400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-3001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+	if err != nil {
+		slog.Error("Failed to trust project config",
+			"error", fmt.Sprintf("%v", err),
+			"working_dir", req.Body.WorkingDir,
+			"operation", "TrustProjectConfig",
+		)
+		return api.TrustProjectConfig500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	data := api.ProjectConfigTrust{Path: trust.Path, Trusted: trust.Trusted}
+	if trust.Digest != "" {
+		data.Digest = &trust.Digest
+	}
+	return api.TrustProjectConfig200JSONResponse(api.TrustProjectConfigResponse{Data: data}), nil
+}
+
 // GetDebugInfo returns debug information about the daemon
 func (h *SessionHandlers) GetDebugInfo(ctx context.Context, req api.GetDebugInfoRequestObject) (api.GetDebugInfoResponseObject, error) {
 	stats := make(map[string]int64)
@@ -1552,7 +1972,7 @@ func (h *SessionHandlers) GetDebugInfo(ctx context.Context, req api.GetDebugInfo
 
 	// Get database path from config if available
 	dbPath := ""
-	if h.config != nil {
+	if h.config != nil && h.config.DatabaseURL == "" {
 		dbPath = h.config.DatabasePath
 	}
 
@@ -1595,6 +2015,32 @@ func (h *SessionHandlers) GetDebugInfo(ctx context.Context, req api.GetDebugInfo
 		response.LastModified = lastModified
 	}
 
+	// Report resource usage of active sessions
+	if active, err := h.store.GetSessionsByStatus(ctx, []string{
+		store.SessionStatusStarting,
+		store.SessionStatusRunning,
+		store.SessionStatusWaitingInput,
+	}); err == nil {
+		sessionResources := make([]api.ActiveSessionResources, 0, len(active))
+		for _, sess := range active {
+			entry := api.ActiveSessionResources{
+				SessionId: sess.ID,
+				Status:    api.SessionStatus(sess.Status),
+				Resources: h.mapper.SessionResourcesToAPI(*sess),
+			}
+			if sess.Title != "" {
+				entry.Title = &sess.Title
+			}
+			sessionResources = append(sessionResources, entry)
+		}
+		response.SessionResources = &sessionResources
+	}
+
+	if h.eventBus != nil {
+		subscribers := h.mapper.SubscriberStatsToAPI(h.eventBus.GetSubscriberStats())
+		response.EventSubscribers = &subscribers
+	}
+
 	return response, nil
 }
 
@@ -1803,3 +2249,101 @@ func (h *SessionHandlers) SearchSessions(ctx context.Context, req api.SearchSess
 		Data: apiSessions,
 	}, nil
 }
+
+// negativeStallThreshold reports whether any of the given thresholds is negative
+func negativeStallThreshold(thresholds ...*int64) bool {
+	for _, threshold := range thresholds {
+		if threshold != nil && *threshold < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ExportSessionConversation streams a session's conversation as a transcript
+func (h *SessionHandlers) ExportSessionConversation(ctx context.Context, req api.ExportSessionConversationRequestObject) (api.ExportSessionConversationResponseObject, error) {
+	opts := transcript.DefaultOptions()
+	if req.Params.Format != nil {
+		opts.Format = string(*req.Params.Format)
+	}
+	if req.Params.MaxToolResultBytes != nil {
+		opts.MaxToolResultBytes = *req.Params.MaxToolResultBytes
+	}
+	if req.Params.MaxToolResultLines != nil {
+		opts.MaxToolResultLines = *req.Params.MaxToolResultLines
+	}
+	switch opts.Format {
+	case transcript.FormatMarkdown, transcript.FormatHTML, transcript.FormatJSONL:
+	default:
+		return api.ExportSessionConversation400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-3001",
+					Message: fmt.Sprintf("Unsupported format %q", opts.Format),
+				},
+			},
+		}, nil
+	}
+	if opts.MaxToolResultBytes < 0 || opts.MaxToolResultLines < 0 {
+		return api.ExportSessionConversation400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-3001",
+					Message: "Tool result limits must not be negative",
+				},
+			},
+		}, nil
+	}
+
+	// Check the session up front, once streaming starts the status is sent
+	if _, err := h.store.GetSession(ctx, string(req.Id)); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.ExportSessionConversation404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{
+						Code:    "HLD-1002",
+						Message: "Session not found",
+					},
+				},
+			}, nil
+		}
+		slog.Error("Failed to get session for export",
+			"error", fmt.Sprintf("%v", err),
+			"session_id", req.Id,
+			"operation", "ExportSessionConversation",
+		)
+		return api.ExportSessionConversation500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{
+					Code:    "HLD-4001",
+					Message: err.Error(),
+				},
+			},
+		}, nil
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		err := transcript.Write(ctx, h.store, pw, string(req.Id), opts)
+		if err != nil && ctx.Err() == nil {
+			slog.Error("Failed to export session conversation",
+				"error", fmt.Sprintf("%v", err),
+				"session_id", req.Id,
+				"operation", "ExportSessionConversation",
+			)
+		}
+		pw.CloseWithError(err)
+	}()
+
+	headers := api.ExportSessionConversation200ResponseHeaders{
+		ContentDisposition: fmt.Sprintf(`attachment; filename="session-%s.%s"`, req.Id, transcript.FileExtension(opts.Format)),
+	}
+	switch opts.Format {
+	case transcript.FormatHTML:
+		return api.ExportSessionConversation200TexthtmlResponse{Body: pr, Headers: headers}, nil
+	case transcript.FormatJSONL:
+		return api.ExportSessionConversation200ApplicationxNdjsonResponse{Body: pr, Headers: headers}, nil
+	default:
+		return api.ExportSessionConversation200TextmarkdownResponse{Body: pr, Headers: headers}, nil
+	}
+}
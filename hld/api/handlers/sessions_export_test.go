@@ -0,0 +1,68 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/handlers"
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportSessionConversation(t *testing.T) {
+	sqliteStore, err := store.NewSQLiteStore(testutil.DatabasePath(t, "export-handlers"))
+	require.NoError(t, err)
+	defer func() { _ = sqliteStore.Close() }()
+
+	ctx := context.Background()
+	require.NoError(t, sqliteStore.CreateSession(ctx, &store.Session{
+		ID: "sess-1", RunID: "run-1", ClaudeSessionID: "claude-1", Query: "Tidy up", Status: store.SessionStatusCompleted,
+	}))
+	require.NoError(t, sqliteStore.AddConversationEvent(ctx, &store.ConversationEvent{
+		SessionID: "sess-1", ClaudeSessionID: "claude-1", EventType: store.EventTypeMessage, Role: "user", Content: "Tidy up",
+	}))
+	require.NoError(t, sqliteStore.AddConversationEvent(ctx, &store.ConversationEvent{
+		SessionID: "sess-1", ClaudeSessionID: "claude-1", EventType: store.EventTypeToolResult, ToolResultForID: "toolu_1",
+		ToolResultContent: strings.Repeat("x", 100),
+	}))
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	serverImpl := handlers.NewServerImpl(handlers.Handlers{Sessions: handlers.NewSessionHandlers(nil, sqliteStore, nil)})
+	api.RegisterHandlersWithOptions(router, api.NewStrictHandler(serverImpl, nil), api.GinServerOptions{
+		BaseURL: "/api/v1",
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/sessions/sess-1/export", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "text/markdown", w.Header().Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="session-sess-1.md"`, w.Header().Get("Content-Disposition"))
+	assert.Contains(t, w.Body.String(), "# Tidy up\n")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/sessions/sess-1/export?format=jsonl&max_tool_result_bytes=10", nil))
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "application/x-ndjson", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), `"content":"xxxxxxxxxx","tool_id":"toolu_1"`)
+	assert.Contains(t, w.Body.String(), `"truncated":{"omitted_bytes":90,"omitted_lines":0}`)
+
+	t.Run("not found", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/sessions/missing/export", nil))
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/sessions/sess-1/export?format=pdf", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -67,6 +68,11 @@ func (m *MockStore) GetExpiredDangerousPermissionsSessions(ctx context.Context)
 	return args.Get(0).([]*store.Session), args.Error(1)
 }
 
+func (m *MockStore) GetSessionsByStatus(ctx context.Context, statuses []string) ([]*store.Session, error) {
+	args := m.Called(ctx, statuses)
+	return args.Get(0).([]*store.Session), args.Error(1)
+}
+
 func (m *MockStore) AddConversationEvent(ctx context.Context, event *store.ConversationEvent) error {
 	args := m.Called(ctx, event)
 	return args.Error(0)
@@ -82,6 +88,21 @@ func (m *MockStore) GetSessionConversation(ctx context.Context, sessionID string
 	return args.Get(0).([]*store.ConversationEvent), args.Error(1)
 }
 
+func (m *MockStore) SearchConversations(ctx context.Context, search store.ConversationSearch) ([]*store.ConversationSearchResult, error) {
+	args := m.Called(ctx, search)
+	return args.Get(0).([]*store.ConversationSearchResult), args.Error(1)
+}
+
+func (m *MockStore) GetConversationPage(ctx context.Context, claudeSessionID string, page store.ConversationPage) ([]*store.ConversationEvent, error) {
+	args := m.Called(ctx, claudeSessionID, page)
+	return args.Get(0).([]*store.ConversationEvent), args.Error(1)
+}
+
+func (m *MockStore) GetSessionConversationPage(ctx context.Context, sessionID string, page store.ConversationPage) ([]*store.ConversationEvent, error) {
+	args := m.Called(ctx, sessionID, page)
+	return args.Get(0).([]*store.ConversationEvent), args.Error(1)
+}
+
 func (m *MockStore) GetPendingToolCall(ctx context.Context, sessionID string, toolName string) (*store.ConversationEvent, error) {
 	args := m.Called(ctx, sessionID, toolName)
 	if args.Get(0) == nil {
@@ -197,6 +218,336 @@ func (m *MockStore) UpdateUserSettings(ctx context.Context, settings store.UserS
 	return args.Error(0)
 }
 
+func (m *MockStore) CreateSchedule(ctx context.Context, schedule *store.Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetSchedule(ctx context.Context, id string) (*store.Schedule, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*store.Schedule), args.Error(1)
+}
+
+func (m *MockStore) ListSchedules(ctx context.Context) ([]*store.Schedule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*store.Schedule), args.Error(1)
+}
+
+func (m *MockStore) UpdateSchedule(ctx context.Context, id string, updates store.ScheduleUpdate) error {
+	args := m.Called(ctx, id, updates)
+	return args.Error(0)
+}
+
+func (m *MockStore) DeleteSchedule(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockStore) CreateScheduleRun(ctx context.Context, run *store.ScheduleRun) error {
+	args := m.Called(ctx, run)
+	return args.Error(0)
+}
+
+func (m *MockStore) ListScheduleRuns(ctx context.Context, scheduleID string, limit int) ([]*store.ScheduleRun, error) {
+	args := m.Called(ctx, scheduleID, limit)
+	return args.Get(0).([]*store.ScheduleRun), args.Error(1)
+}
+
+func (m *MockStore) CreateWebhook(ctx context.Context, webhook *store.Webhook) error {
+	args := m.Called(ctx, webhook)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetWebhook(ctx context.Context, id string) (*store.Webhook, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*store.Webhook), args.Error(1)
+}
+
+func (m *MockStore) ListWebhooks(ctx context.Context) ([]*store.Webhook, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*store.Webhook), args.Error(1)
+}
+
+func (m *MockStore) UpdateWebhook(ctx context.Context, id string, updates store.WebhookUpdate) error {
+	args := m.Called(ctx, id, updates)
+	return args.Error(0)
+}
+
+func (m *MockStore) DeleteWebhook(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockStore) CreateWebhookDelivery(ctx context.Context, delivery *store.WebhookDelivery, keep int) error {
+	args := m.Called(ctx, delivery, keep)
+	return args.Error(0)
+}
+
+func (m *MockStore) UpdateWebhookDelivery(ctx context.Context, id int64, updates store.WebhookDeliveryUpdate) error {
+	args := m.Called(ctx, id, updates)
+	return args.Error(0)
+}
+
+func (m *MockStore) ListWebhookDeliveries(ctx context.Context, webhookID string, limit int) ([]*store.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, limit)
+	return args.Get(0).([]*store.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockStore) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*store.WebhookDelivery, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]*store.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockStore) CreatePipelineRun(ctx context.Context, run *store.PipelineRun) error {
+	args := m.Called(ctx, run)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetPipelineRun(ctx context.Context, id string) (*store.PipelineRun, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*store.PipelineRun), args.Error(1)
+}
+
+func (m *MockStore) ListPipelineRuns(ctx context.Context) ([]*store.PipelineRun, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*store.PipelineRun), args.Error(1)
+}
+
+func (m *MockStore) UpdatePipelineRun(ctx context.Context, id string, updates store.PipelineRunUpdate) error {
+	args := m.Called(ctx, id, updates)
+	return args.Error(0)
+}
+
+func (m *MockStore) SavePipelineStep(ctx context.Context, step *store.PipelineStep) error {
+	args := m.Called(ctx, step)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetPipelineSteps(ctx context.Context, runID string) ([]*store.PipelineStep, error) {
+	args := m.Called(ctx, runID)
+	return args.Get(0).([]*store.PipelineStep), args.Error(1)
+}
+
+func (m *MockStore) CreateBatchGroup(ctx context.Context, group *store.BatchGroup) error {
+	args := m.Called(ctx, group)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetBatchGroup(ctx context.Context, id string) (*store.BatchGroup, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*store.BatchGroup), args.Error(1)
+}
+
+func (m *MockStore) ListBatchGroups(ctx context.Context) ([]*store.BatchGroup, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*store.BatchGroup), args.Error(1)
+}
+
+func (m *MockStore) AddBatchMember(ctx context.Context, member *store.BatchMember) error {
+	args := m.Called(ctx, member)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetBatchMembers(ctx context.Context, groupID string) ([]*store.BatchMember, error) {
+	args := m.Called(ctx, groupID)
+	return args.Get(0).([]*store.BatchMember), args.Error(1)
+}
+
+func (m *MockStore) CreateSessionTemplate(ctx context.Context, template *store.SessionTemplate) error {
+	args := m.Called(ctx, template)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetSessionTemplate(ctx context.Context, id string) (*store.SessionTemplate, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*store.SessionTemplate), args.Error(1)
+}
+
+func (m *MockStore) GetSessionTemplateByName(ctx context.Context, name string) (*store.SessionTemplate, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(*store.SessionTemplate), args.Error(1)
+}
+
+func (m *MockStore) ListSessionTemplates(ctx context.Context) ([]*store.SessionTemplate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*store.SessionTemplate), args.Error(1)
+}
+
+func (m *MockStore) UpdateSessionTemplate(ctx context.Context, id string, updates store.SessionTemplateUpdate) error {
+	args := m.Called(ctx, id, updates)
+	return args.Error(0)
+}
+
+func (m *MockStore) DeleteSessionTemplate(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockStore) RecordResourceSample(ctx context.Context, sample *store.ResourceSample) error {
+	args := m.Called(ctx, sample)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetResourceSamples(ctx context.Context, sessionID string, limit int) ([]*store.ResourceSample, error) {
+	args := m.Called(ctx, sessionID, limit)
+	return args.Get(0).([]*store.ResourceSample), args.Error(1)
+}
+
+func (m *MockStore) UpdateCompactionEvent(ctx context.Context, eventID int64, updates store.CompactionUpdate) error {
+	args := m.Called(ctx, eventID, updates)
+	return args.Error(0)
+}
+
+func (m *MockStore) SetSessionEffectiveConfig(ctx context.Context, sessionID string, config string) error {
+	args := m.Called(ctx, sessionID, config)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetSessionEffectiveConfig(ctx context.Context, sessionID string) (string, error) {
+	args := m.Called(ctx, sessionID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockStore) SetProjectConfigTrust(ctx context.Context, projectRoot string, digest string) error {
+	args := m.Called(ctx, projectRoot, digest)
+	return args.Error(0)
+}
+
+func (m *MockStore) DeleteProjectConfigTrust(ctx context.Context, projectRoot string) error {
+	args := m.Called(ctx, projectRoot)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetProjectConfigTrust(ctx context.Context, projectRoot string) (string, error) {
+	args := m.Called(ctx, projectRoot)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockStore) QuerySessions(ctx context.Context, filter store.SessionFilter) ([]*store.Session, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*store.Session), args.Error(1)
+}
+
+func (m *MockStore) CountSessionsByKind(ctx context.Context, filter store.SessionFilter) (*store.SessionCounts, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(*store.SessionCounts), args.Error(1)
+}
+
+func (m *MockStore) ListLabels(ctx context.Context) ([]*store.Label, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*store.Label), args.Error(1)
+}
+
+func (m *MockStore) CreateLabel(ctx context.Context, label *store.Label) error {
+	args := m.Called(ctx, label)
+	return args.Error(0)
+}
+
+func (m *MockStore) DeleteLabel(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockStore) SetSessionLabels(ctx context.Context, sessionID string, names []string) error {
+	args := m.Called(ctx, sessionID, names)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetSessionLabels(ctx context.Context, sessionID string) ([]string, error) {
+	args := m.Called(ctx, sessionID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockStore) UpsertProject(ctx context.Context, rootPath string) (*store.Project, error) {
+	args := m.Called(ctx, rootPath)
+	return args.Get(0).(*store.Project), args.Error(1)
+}
+
+func (m *MockStore) ListProjects(ctx context.Context) ([]*store.Project, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*store.Project), args.Error(1)
+}
+
+func (m *MockStore) GetUnassignedWorkingDirs(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockStore) AssignProjectByWorkingDir(ctx context.Context, workingDir string, projectID string) error {
+	args := m.Called(ctx, workingDir, projectID)
+	return args.Error(0)
+}
+
+func (m *MockStore) CreateSavedFilter(ctx context.Context, filter *store.SavedFilter) error {
+	args := m.Called(ctx, filter)
+	return args.Error(0)
+}
+
+func (m *MockStore) GetSavedFilter(ctx context.Context, id string) (*store.SavedFilter, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*store.SavedFilter), args.Error(1)
+}
+
+func (m *MockStore) ListSavedFilters(ctx context.Context) ([]*store.SavedFilter, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*store.SavedFilter), args.Error(1)
+}
+
+func (m *MockStore) UpdateSavedFilter(ctx context.Context, filter *store.SavedFilter) error {
+	args := m.Called(ctx, filter)
+	return args.Error(0)
+}
+
+func (m *MockStore) DeleteSavedFilter(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockStore) AppendEventLog(ctx context.Context, entry *store.EventLogEntry) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+func (m *MockStore) TrimEventLog(ctx context.Context, keep int) (int64, error) {
+	args := m.Called(ctx, keep)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockStore) GetRecentEventLog(ctx context.Context, limit int) ([]*store.EventLogEntry, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*store.EventLogEntry), args.Error(1)
+}
+
+func (m *MockStore) RunMaintenance(ctx context.Context, opts store.MaintenanceOptions) (*store.MaintenanceReport, error) {
+	args := m.Called(ctx, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*store.MaintenanceReport), args.Error(1)
+}
+
+func (m *MockStore) GetSessionRecords(ctx context.Context, sessionID string, includeRawEvents bool) (*store.SessionRecords, error) {
+	args := m.Called(ctx, sessionID, includeRawEvents)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*store.SessionRecords), args.Error(1)
+}
+
+func (m *MockStore) ImportSessionRecords(ctx context.Context, records []*store.SessionRecords) error {
+	args := m.Called(ctx, records)
+	return args.Error(0)
+}
+
 func (m *MockStore) Close() error {
 	args := m.Called()
 	return args.Error(0)
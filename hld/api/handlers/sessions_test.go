@@ -155,31 +155,19 @@ func TestSessionHandlers_ListSessions(t *testing.T) {
 	handlers := handlers.NewSessionHandlers(mockManager, mockStore, mockApprovalManager)
 	router := setupTestRouter(t, handlers, nil, nil)
 
-	// Test data - using session.Info which is what ListSessions returns
-	sessionInfos := []session.Info{
-		{
-			ID:             "sess-1",
-			RunID:          "run-1",
-			Status:         "completed",
-			Query:          "First query",
-			StartTime:      time.Now().Add(-2 * time.Hour),
-			LastActivityAt: time.Now().Add(-1 * time.Hour),
-			EndTime:        timePtr(time.Now().Add(-1 * time.Hour)),
-			Summary:        "Completed first task",
-			Model:          "claude-3-sonnet",
-			WorkingDir:     "/home/user/project1",
-			Archived:       false,
-		},
+	// Filtering happens in SQL, so the store returns what matched
+	leaves := []*store.Session{
 		{
 			ID:             "sess-2",
 			RunID:          "run-2",
 			Status:         "running",
 			Query:          "Second query",
-			StartTime:      time.Now().Add(-30 * time.Minute),
+			CreatedAt:      time.Now().Add(-30 * time.Minute),
 			LastActivityAt: time.Now(),
 			Model:          "claude-3-opus",
 			WorkingDir:     "/tmp/project",
-			Archived:       false,
+			Labels:         []string{"bug"},
+			ProjectID:      "proj-1",
 		},
 		{
 			ID:              "sess-3",
@@ -187,39 +175,36 @@ func TestSessionHandlers_ListSessions(t *testing.T) {
 			Status:          "completed",
 			Query:           "Child query",
 			ParentSessionID: "sess-1",
-			StartTime:       time.Now().Add(-15 * time.Minute),
+			CreatedAt:       time.Now().Add(-15 * time.Minute),
 			LastActivityAt:  time.Now().Add(-10 * time.Minute),
-			Archived:        false,
 		},
 	}
+	counts := &store.SessionCounts{Normal: 2, Archived: 1}
+
+	expectQuery := func(filter store.SessionFilter, sessions []*store.Session) {
+		mockStore.EXPECT().CountSessionsByKind(gomock.Any(), filter).Return(counts, nil)
+		mockStore.EXPECT().QuerySessions(gomock.Any(), filter).Return(sessions, nil)
+	}
 
 	t.Run("list leaf sessions (default)", func(t *testing.T) {
-		mockManager.EXPECT().
-			ListSessions().
-			Return(sessionInfos)
+		expectQuery(store.SessionFilter{LeavesOnly: true}, leaves)
 
 		w := makeRequest(t, router, "GET", "/api/v1/sessions", nil)
 
-		var resp struct {
-			Data []api.Session `json:"data"`
-		}
+		var resp api.SessionsResponse
 		assertJSONResponse(t, w, 200, &resp)
 
-		// Should only return leaf sessions (sess-2 and sess-3, not sess-1 which has children)
-		assert.Len(t, resp.Data, 2)
-		foundIDs := make(map[string]bool)
-		for _, s := range resp.Data {
-			foundIDs[s.Id] = true
-		}
-		assert.True(t, foundIDs["sess-2"])
-		assert.True(t, foundIDs["sess-3"])
-		assert.False(t, foundIDs["sess-1"]) // Not a leaf
+		require.Len(t, resp.Data, 2)
+		assert.Equal(t, "sess-2", resp.Data[0].Id)
+		assert.Equal(t, []string{"bug"}, *resp.Data[0].Labels)
+		assert.Equal(t, "proj-1", *resp.Data[0].ProjectId)
+		assert.Equal(t, 2, *resp.Counts.Normal)
+		assert.Equal(t, 1, *resp.Counts.Archived)
 	})
 
 	t.Run("list all sessions with leavesOnly=false", func(t *testing.T) {
-		mockManager.EXPECT().
-			ListSessions().
-			Return(sessionInfos)
+		parent := &store.Session{ID: "sess-1", RunID: "run-1", Status: "completed"}
+		expectQuery(store.SessionFilter{}, append([]*store.Session{parent}, leaves...))
 
 		w := makeRequest(t, router, "GET", "/api/v1/sessions?leavesOnly=false", nil)
 
@@ -232,32 +217,93 @@ func TestSessionHandlers_ListSessions(t *testing.T) {
 	})
 
 	t.Run("filter normal sessions", func(t *testing.T) {
-		archivedSessions := append(sessionInfos, session.Info{
-			ID:             "sess-archived",
-			RunID:          "run-archived",
-			Status:         "completed",
-			Query:          "Archived query",
-			StartTime:      time.Now().Add(-2 * time.Hour),
-			LastActivityAt: time.Now().Add(-1 * time.Hour),
-			Archived:       true,
-		})
+		expectQuery(store.SessionFilter{Kind: store.SessionKindNormal, LeavesOnly: true}, leaves)
 
-		mockManager.EXPECT().
-			ListSessions().
-			Return(archivedSessions)
-
-		// filter=normal should exclude archived
 		w := makeRequest(t, router, "GET", "/api/v1/sessions?filter=normal", nil)
+		assert.Equal(t, 200, w.Code)
+	})
 
-		var resp struct {
-			Data []api.Session `json:"data"`
-		}
+	t.Run("query parameters become the store filter", func(t *testing.T) {
+		minCost := 0.5
+		after := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		expectQuery(store.SessionFilter{
+			Statuses:     []string{"running", "failed"},
+			Labels:       []string{"bug", "ui"},
+			ProjectID:    "proj-1",
+			Models:       []string{"opus"},
+			CreatedAfter: &after,
+			MinCostUSD:   &minCost,
+			LeavesOnly:   true,
+		}, leaves[:1])
+
+		w := makeRequest(t, router, "GET", "/api/v1/sessions?status=running&status=failed&label=bug&label=ui"+
+			"&projectId=proj-1&model=opus&createdAfter=2026-01-02T03:04:05Z&minCostUsd=0.5", nil)
+		assert.Equal(t, 200, w.Code)
+	})
+
+	t.Run("saved filters are combined with parameters", func(t *testing.T) {
+		maxCost := 10.0
+		mockStore.EXPECT().GetSavedFilter(gomock.Any(), "filter-1").Return(&store.SavedFilter{
+			ID:     "filter-1",
+			Name:   "Cheap bugs",
+			Filter: store.SessionFilter{Labels: []string{"bug"}, MaxCostUSD: &maxCost, Kind: store.SessionKindNormal},
+		}, nil)
+		expectQuery(store.SessionFilter{
+			Kind:       store.SessionKindNormal,
+			Labels:     []string{"urgent"},
+			MaxCostUSD: &maxCost,
+		}, leaves)
+
+		w := makeRequest(t, router, "GET", "/api/v1/sessions?savedFilterId=filter-1&label=urgent&leavesOnly=false", nil)
+		assert.Equal(t, 200, w.Code)
+	})
+
+	t.Run("unknown saved filter", func(t *testing.T) {
+		mockStore.EXPECT().GetSavedFilter(gomock.Any(), "missing").
+			Return(nil, &store.NotFoundError{Type: "saved filter", ID: "missing"})
+
+		w := makeRequest(t, router, "GET", "/api/v1/sessions?savedFilterId=missing", nil)
+		assert.Equal(t, 404, w.Code)
+	})
+
+	t.Run("contradictory filters are rejected", func(t *testing.T) {
+		w := makeRequest(t, router, "GET", "/api/v1/sessions?minCostUsd=5&maxCostUsd=1", nil)
+		assert.Equal(t, 400, w.Code)
+	})
+
+	t.Run("pages continue from the cursor", func(t *testing.T) {
+		cursor := store.SessionCursor{LastActivityAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), ID: "sess-1"}
+		// One extra session is requested to detect the next page
+		expectQuery(store.SessionFilter{LeavesOnly: true, After: &cursor, Limit: 2}, leaves)
+
+		w := makeRequest(t, router, "GET", "/api/v1/sessions?limit=1&cursor="+cursor.Encode(), nil)
+
+		var resp api.SessionsResponse
 		assertJSONResponse(t, w, 200, &resp)
+		require.Len(t, resp.Data, 1)
+		assert.Equal(t, "sess-2", resp.Data[0].Id)
+		require.NotNil(t, resp.NextCursor)
+		next, err := store.ParseSessionCursor(*resp.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "sess-2", next.ID)
+	})
 
-		// Should not include archived session
-		for _, s := range resp.Data {
-			assert.NotEqual(t, "sess-archived", s.Id)
-		}
+	t.Run("last page has no cursor", func(t *testing.T) {
+		expectQuery(store.SessionFilter{LeavesOnly: true, Limit: 3}, leaves)
+
+		w := makeRequest(t, router, "GET", "/api/v1/sessions?limit=2", nil)
+
+		var resp api.SessionsResponse
+		assertJSONResponse(t, w, 200, &resp)
+		assert.Len(t, resp.Data, 2)
+		assert.Nil(t, resp.NextCursor)
+	})
+
+	t.Run("invalid pagination is rejected", func(t *testing.T) {
+		w := makeRequest(t, router, "GET", "/api/v1/sessions?cursor=bogus", nil)
+		assert.Equal(t, 400, w.Code)
+		w = makeRequest(t, router, "GET", "/api/v1/sessions?limit=0", nil)
+		assert.Equal(t, 400, w.Code)
 	})
 }
 
@@ -326,6 +372,73 @@ func TestSessionHandlers_GetSession(t *testing.T) {
 	})
 }
 
+func TestSessionHandlers_GetSessionMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := session.NewMockSessionManager(ctrl)
+	mockStore := store.NewMockConversationStore(ctrl)
+	mockApprovalManager := approval.NewMockManager(ctrl)
+
+	handlers := handlers.NewSessionHandlers(mockManager, mockStore, mockApprovalManager)
+	router := setupTestRouter(t, handlers, nil, nil)
+
+	events := []*store.ConversationEvent{
+		{ID: 1, SessionID: "sess-1", ClaudeSessionID: "claude-1", Sequence: 4, EventType: "message", Role: "user", Content: "hi"},
+		{ID: 2, SessionID: "sess-1", ClaudeSessionID: "claude-1", Sequence: 5, EventType: "message", Role: "assistant", Content: "hello"},
+	}
+
+	t.Run("incremental fetch by sequence", func(t *testing.T) {
+		mockStore.EXPECT().
+			GetSessionConversationPage(gomock.Any(), "sess-1", store.ConversationPage{SinceSequence: 3}).
+			Return(events, nil)
+
+		w := makeRequest(t, router, "GET", "/api/v1/sessions/sess-1/messages?since_sequence=3", nil)
+
+		var resp api.ConversationResponse
+		assertJSONResponse(t, w, 200, &resp)
+		assert.Len(t, resp.Data, 2)
+		assert.False(t, *resp.HasMore)
+		require.NotNil(t, resp.NextCursor)
+		cursor, err := store.ParseConversationCursor(*resp.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, store.ConversationCursor{ClaudeSessionID: "claude-1", Sequence: 5}, *cursor)
+	})
+
+	t.Run("limited page", func(t *testing.T) {
+		after := store.ConversationCursor{ClaudeSessionID: "claude-1", Sequence: 3}
+		mockStore.EXPECT().
+			GetSessionConversationPage(gomock.Any(), "sess-1", store.ConversationPage{After: &after, Limit: 2}).
+			Return(events, nil)
+
+		w := makeRequest(t, router, "GET", "/api/v1/sessions/sess-1/messages?limit=1&cursor="+after.Encode(), nil)
+
+		var resp api.ConversationResponse
+		assertJSONResponse(t, w, 200, &resp)
+		require.Len(t, resp.Data, 1)
+		assert.True(t, *resp.HasMore)
+	})
+
+	t.Run("cursor from another conversation", func(t *testing.T) {
+		mockStore.EXPECT().
+			GetSessionConversationPage(gomock.Any(), "sess-1", gomock.Any()).
+			Return(nil, fmt.Errorf("%w: not part of this conversation", store.ErrInvalidCursor))
+
+		w := makeRequest(t, router, "GET", "/api/v1/sessions/sess-1/messages?cursor="+
+			store.ConversationCursor{ClaudeSessionID: "other", Sequence: 1}.Encode(), nil)
+		assert.Equal(t, 400, w.Code)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		mockStore.EXPECT().
+			GetSessionConversationPage(gomock.Any(), "missing", store.ConversationPage{}).
+			Return(nil, &store.NotFoundError{Type: "session", ID: "missing"})
+
+		w := makeRequest(t, router, "GET", "/api/v1/sessions/missing/messages", nil)
+		assert.Equal(t, 404, w.Code)
+	})
+}
+
 func TestSessionHandlers_UpdateSession(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"github.com/humanlayer/humanlayer/hld/api"
@@ -39,8 +40,16 @@ func (h *SettingsHandlers) GetUserSettings(ctx context.Context, req api.GetUserS
 		Data: api.UserSettings{
 			AdvancedProviders: settings.AdvancedProviders,
 			OptInTelemetry:    settings.OptInTelemetry,
-			CreatedAt:         settings.CreatedAt,
-			UpdatedAt:         settings.UpdatedAt,
+			AutoTitleMode:     api.AutoTitleMode(settings.AutoTitleMode),
+			AutoTitleModel:    settings.AutoTitleModel,
+
+			RawEventRetentionDays:        settings.RawEventRetentionDays,
+			ArchivedSessionRetentionDays: settings.ArchivedSessionRetentionDays,
+			FileSnapshotRetentionDays:    settings.FileSnapshotRetentionDays,
+			DedupeFileSnapshots:          settings.DedupeFileSnapshots,
+
+			CreatedAt: settings.CreatedAt,
+			UpdatedAt: settings.UpdatedAt,
 		},
 	}, nil
 }
@@ -68,6 +77,52 @@ func (h *SettingsHandlers) UpdateUserSettings(ctx context.Context, req api.Updat
 	if req.Body.OptInTelemetry != nil {
 		current.OptInTelemetry = req.Body.OptInTelemetry
 	}
+	if req.Body.AutoTitleMode != nil {
+		switch *req.Body.AutoTitleMode {
+		case api.Off, api.Heuristic, api.Model:
+			current.AutoTitleMode = string(*req.Body.AutoTitleMode)
+		default:
+			return api.UpdateUserSettings400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{
+						Code:    "HLD-3001",
+						Message: fmt.Sprintf("invalid auto_title_mode: %s", *req.Body.AutoTitleMode),
+					},
+				},
+			}, nil
+		}
+	}
+	if req.Body.AutoTitleModel != nil {
+		current.AutoTitleModel = *req.Body.AutoTitleModel
+	}
+	retention := []struct {
+		name  string
+		value *int
+		dest  *int
+	}{
+		{"raw_event_retention_days", req.Body.RawEventRetentionDays, &current.RawEventRetentionDays},
+		{"archived_session_retention_days", req.Body.ArchivedSessionRetentionDays, &current.ArchivedSessionRetentionDays},
+		{"file_snapshot_retention_days", req.Body.FileSnapshotRetentionDays, &current.FileSnapshotRetentionDays},
+	}
+	for _, r := range retention {
+		if r.value == nil {
+			continue
+		}
+		if *r.value < 0 {
+			return api.UpdateUserSettings400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{
+						Code:    "HLD-3001",
+						Message: fmt.Sprintf("invalid %s: must not be negative", r.name),
+					},
+				},
+			}, nil
+		}
+		*r.dest = *r.value
+	}
+	if req.Body.DedupeFileSnapshots != nil {
+		current.DedupeFileSnapshots = *req.Body.DedupeFileSnapshots
+	}
 
 	// Save updated settings
 	err = h.store.UpdateUserSettings(ctx, *current)
@@ -101,8 +156,16 @@ func (h *SettingsHandlers) UpdateUserSettings(ctx context.Context, req api.Updat
 		Data: api.UserSettings{
 			AdvancedProviders: updated.AdvancedProviders,
 			OptInTelemetry:    updated.OptInTelemetry,
-			CreatedAt:         updated.CreatedAt,
-			UpdatedAt:         updated.UpdatedAt,
+			AutoTitleMode:     api.AutoTitleMode(updated.AutoTitleMode),
+			AutoTitleModel:    updated.AutoTitleModel,
+
+			RawEventRetentionDays:        updated.RawEventRetentionDays,
+			ArchivedSessionRetentionDays: updated.ArchivedSessionRetentionDays,
+			FileSnapshotRetentionDays:    updated.FileSnapshotRetentionDays,
+			DedupeFileSnapshots:          updated.DedupeFileSnapshots,
+
+			CreatedAt: updated.CreatedAt,
+			UpdatedAt: updated.UpdatedAt,
 		},
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -33,6 +34,12 @@ func parseEventTypes(types []string) []bus.EventType {
 			eventTypes = append(eventTypes, bus.EventConversationUpdated)
 		case "session_settings_changed":
 			eventTypes = append(eventTypes, bus.EventSessionSettingsChanged)
+		case "session_stalled":
+			eventTypes = append(eventTypes, bus.EventSessionStalled)
+		case "session_resource_warning":
+			eventTypes = append(eventTypes, bus.EventSessionResourceWarning)
+		case "budget_exceeded":
+			eventTypes = append(eventTypes, bus.EventBudgetExceeded)
 		}
 		// Ignore unknown event types
 	}
@@ -66,8 +73,38 @@ func (h *SSEHandler) StreamEvents(c *gin.Context) {
 		return
 	}
 
-	// Subscribe to events
-	subscriber := h.eventBus.Subscribe(r.Context(), filter)
+	// Slow clients lose events by default; they can pick how instead
+	opts := bus.SubscribeOptions{
+		Overflow: bus.OverflowPolicy(r.URL.Query().Get("overflow")),
+	}
+	if !opts.Overflow.IsValid() {
+		http.Error(w, "invalid overflow policy "+strconv.Quote(string(opts.Overflow)), http.StatusBadRequest)
+		return
+	}
+	if bufferSize := r.URL.Query().Get("bufferSize"); bufferSize != "" {
+		size, err := strconv.Atoi(bufferSize)
+		if err != nil || size <= 0 {
+			http.Error(w, "invalid buffer size "+strconv.Quote(bufferSize), http.StatusBadRequest)
+			return
+		}
+		opts.BufferSize = size
+	}
+
+	// Resume after the last event the client saw: EventSource sends it in
+	// Last-Event-ID when it reconnects, and clients can pass it as since
+	resumeFrom := r.Header.Get("Last-Event-ID")
+	if resumeFrom == "" {
+		resumeFrom = r.URL.Query().Get("since")
+	}
+	if resumeFrom != "" {
+		since, err := strconv.ParseUint(resumeFrom, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid event ID "+strconv.Quote(resumeFrom), http.StatusBadRequest)
+			return
+		}
+		opts.Since = &since
+	}
+	subscriber := h.eventBus.SubscribeWith(r.Context(), filter, opts)
 	defer h.eventBus.Unsubscribe(subscriber.ID)
 
 	// Create ticker for keepalive
@@ -79,8 +116,18 @@ func (h *SSEHandler) StreamEvents(c *gin.Context) {
 		case <-r.Context().Done():
 			return
 
-		case event := <-subscriber.Channel:
+		case event, ok := <-subscriber.Channel:
+			if !ok {
+				// Disconnected for falling behind, the client resumes from
+				// the last event ID when it reconnects
+				return
+			}
 			data, _ := json.Marshal(event)
+			if event.Seq > 0 {
+				if _, err := fmt.Fprintf(w, "id: %d\n", event.Seq); err != nil {
+					return
+				}
+			}
 			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
 				// Client disconnected
 				return
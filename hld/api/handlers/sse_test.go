@@ -4,14 +4,19 @@ import (
 	"context"
 	"fmt"
 	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/humanlayer/humanlayer/hld/api"
 	"github.com/humanlayer/humanlayer/hld/api/handlers"
 	"github.com/humanlayer/humanlayer/hld/bus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -36,7 +41,7 @@ func TestSSEHandler_StreamEvents(t *testing.T) {
 		}
 
 		mockEventBus.EXPECT().
-			Subscribe(gomock.Any(), gomock.Any()).
+			SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(subscriber)
 
 		mockEventBus.EXPECT().
@@ -52,7 +57,11 @@ func TestSSEHandler_StreamEvents(t *testing.T) {
 		req = req.WithContext(ctx)
 
 		// Start handler in goroutine
-		go router.ServeHTTP(w, req)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			router.ServeHTTP(w, req)
+		}()
 
 		// Give handler time to start
 		time.Sleep(50 * time.Millisecond)
@@ -81,8 +90,8 @@ func TestSSEHandler_StreamEvents(t *testing.T) {
 			close(eventChan)
 		}()
 
-		// Wait for context to timeout
-		<-ctx.Done()
+		// The handler ends the stream when the subscription is closed
+		<-done
 
 		// Verify SSE response
 		assert.Equal(t, 200, w.Code)
@@ -98,8 +107,8 @@ func TestSSEHandler_StreamEvents(t *testing.T) {
 		}
 
 		mockEventBus.EXPECT().
-			Subscribe(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, filter bus.EventFilter) *bus.Subscriber {
+			SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filter bus.EventFilter, opts bus.SubscribeOptions) *bus.Subscriber {
 				// Verify filter was passed correctly
 				assert.Equal(t, "sess-789", filter.SessionID)
 				return subscriber
@@ -132,8 +141,8 @@ func TestSSEHandler_StreamEvents(t *testing.T) {
 		}
 
 		mockEventBus.EXPECT().
-			Subscribe(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, filter bus.EventFilter) *bus.Subscriber {
+			SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filter bus.EventFilter, opts bus.SubscribeOptions) *bus.Subscriber {
 				// Verify event types were parsed correctly
 				assert.Len(t, filter.Types, 2)
 				assert.Contains(t, filter.Types, bus.EventNewApproval)
@@ -165,8 +174,8 @@ func TestSSEHandler_StreamEvents(t *testing.T) {
 		}
 
 		mockEventBus.EXPECT().
-			Subscribe(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, filter bus.EventFilter) *bus.Subscriber {
+			SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filter bus.EventFilter, opts bus.SubscribeOptions) *bus.Subscriber {
 				assert.Equal(t, "run-123", filter.RunID)
 				return subscriber
 			})
@@ -199,8 +208,8 @@ func TestSSEHandler_StreamEvents(t *testing.T) {
 		}
 
 		mockEventBus.EXPECT().
-			Subscribe(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, filter bus.EventFilter) *bus.Subscriber {
+			SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filter bus.EventFilter, opts bus.SubscribeOptions) *bus.Subscriber {
 				assert.Equal(t, "sess-999", filter.SessionID)
 				assert.Equal(t, "run-999", filter.RunID)
 				assert.Len(t, filter.Types, 1)
@@ -232,7 +241,7 @@ func TestSSEHandler_StreamEvents(t *testing.T) {
 		}
 
 		mockEventBus.EXPECT().
-			Subscribe(gomock.Any(), gomock.Any()).
+			SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(subscriber)
 
 		mockEventBus.EXPECT().
@@ -265,8 +274,8 @@ func TestSSEHandler_StreamEvents(t *testing.T) {
 		}
 
 		mockEventBus.EXPECT().
-			Subscribe(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, filter bus.EventFilter) *bus.Subscriber {
+			SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filter bus.EventFilter, opts bus.SubscribeOptions) *bus.Subscriber {
 				// Verify unknown event types were ignored
 				assert.Empty(t, filter.Types)
 				return subscriber
@@ -308,8 +317,8 @@ func TestSSEHandler_ConnectionHandling(t *testing.T) {
 		}
 
 		mockEventBus.EXPECT().
-			Subscribe(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, filter bus.EventFilter) *bus.Subscriber {
+			SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filter bus.EventFilter, opts bus.SubscribeOptions) *bus.Subscriber {
 				subscriberCtx = ctx
 				return subscriber
 			})
@@ -386,9 +395,9 @@ func TestSSEHandler_ConnectionHandling(t *testing.T) {
 
 		var subscriberIndex int32
 		mockEventBus.EXPECT().
-			Subscribe(gomock.Any(), gomock.Any()).
+			SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
 			Times(numClients).
-			DoAndReturn(func(ctx context.Context, filter bus.EventFilter) *bus.Subscriber {
+			DoAndReturn(func(ctx context.Context, filter bus.EventFilter, opts bus.SubscribeOptions) *bus.Subscriber {
 				idx := atomic.AddInt32(&subscriberIndex, 1) - 1
 				return subscribers[idx]
 			})
@@ -459,3 +468,126 @@ func TestSSEHandler_ConnectionHandling(t *testing.T) {
 
 // Note: Removed nonFlushableResponseWriter and mockGinResponseWriter as they were not needed
 // Modern web frameworks always support flushing, so testing the "no flusher" case is not practical
+
+func TestSSEHandler_Resume(t *testing.T) {
+	eventBus := bus.NewEventBus()
+	sseHandler := handlers.NewSSEHandler(eventBus)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/api/v1/events", sseHandler.StreamEvents)
+
+	for _, status := range []string{"starting", "running", "completed"} {
+		eventBus.Publish(bus.Event{
+			Type: bus.EventSessionStatusChanged,
+			Data: map[string]interface{}{"session_id": "sess-1", "new_status": status},
+		})
+	}
+
+	stream := func(t *testing.T, target string, lastEventID string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("GET", target, nil)
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req.WithContext(ctx))
+		return w
+	}
+
+	t.Run("replays events after Last-Event-ID", func(t *testing.T) {
+		w := stream(t, "/api/v1/events", "1")
+		assert.Equal(t, 200, w.Code)
+		body := w.Body.String()
+		assert.NotContains(t, body, "id: 1\n")
+		assert.Contains(t, body, "id: 2\ndata: ")
+		assert.Contains(t, body, "id: 3\ndata: ")
+		assert.NotContains(t, body, string(bus.EventReplayGap))
+	})
+
+	t.Run("since query parameter", func(t *testing.T) {
+		w := stream(t, "/api/v1/events?since=2", "")
+		body := w.Body.String()
+		assert.NotContains(t, body, "id: 2\n")
+		assert.Contains(t, body, "id: 3\n")
+	})
+
+	t.Run("gap after a restart", func(t *testing.T) {
+		w := stream(t, "/api/v1/events", "500")
+		assert.Contains(t, w.Body.String(), `"type":"replay_gap"`)
+	})
+
+	t.Run("invalid event ID", func(t *testing.T) {
+		w := stream(t, "/api/v1/events", "abc")
+		assert.Equal(t, 400, w.Code)
+	})
+}
+
+func TestSSEHandler_SubscribeOptions(t *testing.T) {
+	eventBus := bus.NewEventBus()
+	sseHandler := handlers.NewSSEHandler(eventBus)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/api/v1/events", sseHandler.StreamEvents)
+
+	t.Run("applies buffer size and overflow policy", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			req := httptest.NewRequest("GET", "/api/v1/events?bufferSize=5&overflow=coalesce", nil)
+			router.ServeHTTP(httptest.NewRecorder(), req.WithContext(ctx))
+		}()
+
+		assert.Eventually(t, func() bool {
+			stats := eventBus.GetSubscriberStats()
+			return len(stats) == 1 && stats[0].BufferSize == 5 && stats[0].Overflow == bus.OverflowCoalesce
+		}, time.Second, 10*time.Millisecond)
+
+		cancel()
+		<-done
+	})
+
+	for _, query := range []string{"overflow=block", "bufferSize=0", "bufferSize=many"} {
+		t.Run("rejects "+query, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/events?"+query, nil))
+			assert.Equal(t, 400, w.Code)
+		})
+	}
+}
+
+// TestEventPayloadSchemas checks that the published schema of each event
+// payload has the fields the bus sends
+func TestEventPayloadSchemas(t *testing.T) {
+	spec, err := api.GetSwagger()
+	require.NoError(t, err)
+
+	for _, payload := range bus.Payloads() {
+		payloadType := reflect.TypeOf(payload)
+		t.Run(string(payload.Type()), func(t *testing.T) {
+			schema, ok := spec.Components.Schemas[payloadType.Name()]
+			require.True(t, ok, "no schema for %s", payloadType.Name())
+
+			var properties, required []string
+			for i := 0; i < payloadType.NumField(); i++ {
+				name, opts, _ := strings.Cut(payloadType.Field(i).Tag.Get("json"), ",")
+				properties = append(properties, name)
+				if opts != "omitempty" {
+					required = append(required, name)
+				}
+			}
+
+			var schemaProperties []string
+			for name := range schema.Value.Properties {
+				schemaProperties = append(schemaProperties, name)
+			}
+			sort.Strings(properties)
+			sort.Strings(schemaProperties)
+			assert.Equal(t, properties, schemaProperties)
+			assert.ElementsMatch(t, required, schema.Value.Required)
+		})
+	}
+}
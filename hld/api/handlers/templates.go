@@ -0,0 +1,295 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/mapper"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/humanlayer/humanlayer/hld/templates"
+)
+
+// TemplateHandlers handles session template endpoints
+type TemplateHandlers struct {
+	templates *templates.Service
+	mapper    *mapper.Mapper
+}
+
+// NewTemplateHandlers creates a new template handler
+func NewTemplateHandlers(templates *templates.Service) *TemplateHandlers {
+	return &TemplateHandlers{
+		templates: templates,
+		mapper:    &mapper.Mapper{},
+	}
+}
+
+// templateBadRequest maps validation and duplicate name errors to a bad
+// request detail, reporting whether err was one of them
+func templateBadRequest(err error) (api.ErrorDetail, bool) {
+	switch {
+	case validation.IsError(err):
+		return api.ErrorDetail{Code: "HLD-3001", Message: err.Error()}, true
+	case errors.Is(err, store.ErrAlreadyExists):
+		return api.ErrorDetail{Code: "HLD-3002", Message: err.Error()}, true
+	}
+	return api.ErrorDetail{}, false
+}
+
+// ListTemplates lists all session templates
+func (h *TemplateHandlers) ListTemplates(ctx context.Context, req api.ListTemplatesRequestObject) (api.ListTemplatesResponseObject, error) {
+	list, err := h.templates.List(ctx)
+	if err != nil {
+		slog.Error("Failed to list templates",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListTemplates",
+		)
+		return api.ListTemplates500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.ListTemplates200JSONResponse{
+		Data: h.mapper.SessionTemplatesToAPI(list),
+	}, nil
+}
+
+// CreateTemplate creates a session template
+func (h *TemplateHandlers) CreateTemplate(ctx context.Context, req api.CreateTemplateRequestObject) (api.CreateTemplateResponseObject, error) {
+	tmpl, err := h.mapper.SessionTemplateFromAPI(*req.Body)
+	if err != nil {
+		return api.CreateTemplate400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	created, err := h.templates.Create(ctx, tmpl)
+	if err != nil {
+		if detail, ok := templateBadRequest(err); ok {
+			return api.CreateTemplate400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: detail},
+			}, nil
+		}
+		slog.Error("Failed to create template",
+			"error", fmt.Sprintf("%v", err),
+			"name", tmpl.Name,
+			"operation", "CreateTemplate",
+		)
+		return api.CreateTemplate500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.CreateTemplate201JSONResponse{
+		Data: h.mapper.SessionTemplateToAPI(created),
+	}, nil
+}
+
+// ImportTemplate imports a session template from the contents of a template file
+func (h *TemplateHandlers) ImportTemplate(ctx context.Context, req api.ImportTemplateRequestObject) (api.ImportTemplateResponseObject, error) {
+	overwrite := req.Body.Overwrite != nil && *req.Body.Overwrite
+
+	imported, err := h.templates.Import(ctx, []byte(req.Body.Content), overwrite)
+	if err != nil {
+		if detail, ok := templateBadRequest(err); ok {
+			return api.ImportTemplate400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: detail},
+			}, nil
+		}
+		slog.Error("Failed to import template",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ImportTemplate",
+		)
+		return api.ImportTemplate500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.ImportTemplate201JSONResponse{
+		Data: h.mapper.SessionTemplateToAPI(imported),
+	}, nil
+}
+
+// GetTemplate retrieves a session template
+func (h *TemplateHandlers) GetTemplate(ctx context.Context, req api.GetTemplateRequestObject) (api.GetTemplateResponseObject, error) {
+	tmpl, err := h.templates.Get(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.GetTemplate404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Template not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to get template",
+			"error", fmt.Sprintf("%v", err),
+			"template_id", req.Id,
+			"operation", "GetTemplate",
+		)
+		return api.GetTemplate500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.GetTemplate200JSONResponse{
+		Data: h.mapper.SessionTemplateToAPI(tmpl),
+	}, nil
+}
+
+// UpdateTemplate replaces a session template's configuration
+func (h *TemplateHandlers) UpdateTemplate(ctx context.Context, req api.UpdateTemplateRequestObject) (api.UpdateTemplateResponseObject, error) {
+	tmpl, err := h.mapper.SessionTemplateFromAPI(*req.Body)
+	if err != nil {
+		return api.UpdateTemplate400JSONResponse{
+			BadRequestJSONResponse: api.BadRequestJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	updated, err := h.templates.Update(ctx, string(req.Id), tmpl)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.UpdateTemplate404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Template not found"},
+				},
+			}, nil
+		}
+		if detail, ok := templateBadRequest(err); ok {
+			return api.UpdateTemplate400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: detail},
+			}, nil
+		}
+		slog.Error("Failed to update template",
+			"error", fmt.Sprintf("%v", err),
+			"template_id", req.Id,
+			"operation", "UpdateTemplate",
+		)
+		return api.UpdateTemplate500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.UpdateTemplate200JSONResponse{
+		Data: h.mapper.SessionTemplateToAPI(updated),
+	}, nil
+}
+
+// DeleteTemplate deletes a session template
+func (h *TemplateHandlers) DeleteTemplate(ctx context.Context, req api.DeleteTemplateRequestObject) (api.DeleteTemplateResponseObject, error) {
+	if err := h.templates.Delete(ctx, string(req.Id)); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.DeleteTemplate404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Template not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to delete template",
+			"error", fmt.Sprintf("%v", err),
+			"template_id", req.Id,
+			"operation", "DeleteTemplate",
+		)
+		return api.DeleteTemplate500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	return api.DeleteTemplate204Response{}, nil
+}
+
+// LaunchTemplate launches a session from a template
+func (h *TemplateHandlers) LaunchTemplate(ctx context.Context, req api.LaunchTemplateRequestObject) (api.LaunchTemplateResponseObject, error) {
+	var opts templates.LaunchOptions
+	if req.Body.Variables != nil {
+		opts.Variables = *req.Body.Variables
+	}
+	if req.Body.WorkingDir != nil {
+		opts.WorkingDir = *req.Body.WorkingDir
+	}
+	if req.Body.Title != nil {
+		opts.Title = *req.Body.Title
+	}
+
+	sess, err := h.templates.Launch(ctx, string(req.Id), opts)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.LaunchTemplate404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Template not found"},
+				},
+			}, nil
+		}
+		var dirNotFound *session.DirectoryNotFoundError
+		if validation.IsError(err) || errors.As(err, &dirNotFound) {
+			return api.LaunchTemplate400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-3001", Message: err.Error()},
+				},
+			}, nil
+		}
+		slog.Error("Failed to launch session from template",
+			"error", fmt.Sprintf("%v", err),
+			"template_id", req.Id,
+			"operation", "LaunchTemplate",
+		)
+		return api.LaunchTemplate500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-1001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	resp := api.CreateSessionResponse{}
+	resp.Data.SessionId = sess.ID
+	resp.Data.RunId = sess.RunID
+	return api.LaunchTemplate201JSONResponse(resp), nil
+}
+
+// ExportTemplate exports a session template as a YAML file
+func (h *TemplateHandlers) ExportTemplate(ctx context.Context, req api.ExportTemplateRequestObject) (api.ExportTemplateResponseObject, error) {
+	filename, content, err := h.templates.Export(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.ExportTemplate404JSONResponse{
+				NotFoundJSONResponse: api.NotFoundJSONResponse{
+					Error: api.ErrorDetail{Code: "HLD-1002", Message: "Template not found"},
+				},
+			}, nil
+		}
+		slog.Error("Failed to export template",
+			"error", fmt.Sprintf("%v", err),
+			"template_id", req.Id,
+			"operation", "ExportTemplate",
+		)
+		return api.ExportTemplate500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{
+				Error: api.ErrorDetail{Code: "HLD-4001", Message: err.Error()},
+			},
+		}, nil
+	}
+
+	resp := api.TemplateExportResponse{}
+	resp.Data.Filename = filename
+	resp.Data.Content = string(content)
+	return api.ExportTemplate200JSONResponse(resp), nil
+}
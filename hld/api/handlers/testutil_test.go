@@ -23,8 +23,14 @@ func setupTestRouter(t *testing.T, sessionHandlers *handlers.SessionHandlers, ap
 	settingsHandlers := handlers.NewSettingsHandlers(nil)
 	fileHandlers := handlers.NewFileHandlers()
 
-	// Create server implementation (pass nil for AgentHandlers)
-	serverImpl := handlers.NewServerImpl(sessionHandlers, approvalHandlers, fileHandlers, sseHandler, settingsHandlers, nil)
+	// Create server implementation with only the handlers these tests use
+	serverImpl := handlers.NewServerImpl(handlers.Handlers{
+		Sessions:  sessionHandlers,
+		Approvals: approvalHandlers,
+		Files:     fileHandlers,
+		SSE:       sseHandler,
+		Settings:  settingsHandlers,
+	})
 
 	// Create strict handler
 	strictHandler := api.NewStrictHandler(serverImpl, nil)
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/api/mapper"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/humanlayer/humanlayer/hld/webhook"
+)
+
+// WebhookHandlers handles outbound webhook endpoints
+type WebhookHandlers struct {
+	webhooks *webhook.Service
+	mapper   *mapper.Mapper
+}
+
+// NewWebhookHandlers creates a new webhook handler
+func NewWebhookHandlers(webhooks *webhook.Service) *WebhookHandlers {
+	return &WebhookHandlers{
+		webhooks: webhooks,
+		mapper:   &mapper.Mapper{},
+	}
+}
+
+func webhookNotFound() api.NotFoundJSONResponse {
+	return api.NotFoundJSONResponse{
+		Error: api.ErrorDetail{Code: "HLD-1002", Message: "Webhook not found"},
+	}
+}
+
+// ListWebhooks lists all webhooks
+func (h *WebhookHandlers) ListWebhooks(ctx context.Context, req api.ListWebhooksRequestObject) (api.ListWebhooksResponseObject, error) {
+	webhooks, err := h.webhooks.ListWebhooks(ctx)
+	if err != nil {
+		slog.Error("Failed to list webhooks",
+			"error", fmt.Sprintf("%v", err),
+			"operation", "ListWebhooks",
+		)
+		return api.ListWebhooks500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.ListWebhooks200JSONResponse{
+		Data: h.mapper.WebhooksToAPI(webhooks),
+	}, nil
+}
+
+// CreateWebhook creates a new webhook. The response is the only one that
+// includes the signing secret.
+func (h *WebhookHandlers) CreateWebhook(ctx context.Context, req api.CreateWebhookRequestObject) (api.CreateWebhookResponseObject, error) {
+	hook := &store.Webhook{
+		Name:    req.Body.Name,
+		URL:     req.Body.Url,
+		Enabled: req.Body.Enabled == nil || *req.Body.Enabled,
+	}
+	if req.Body.Secret != nil {
+		hook.Secret = *req.Body.Secret
+	}
+	if req.Body.EventTypes != nil {
+		hook.EventTypes = h.mapper.WebhookEventTypesFromAPI(*req.Body.EventTypes)
+	}
+	if req.Body.Labels != nil {
+		hook.Labels = *req.Body.Labels
+	}
+	if req.Body.WorkingDir != nil {
+		hook.WorkingDir = *req.Body.WorkingDir
+	}
+
+	created, err := h.webhooks.CreateWebhook(ctx, hook)
+	if err != nil {
+		if validation.IsError(err) {
+			return api.CreateWebhook400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+			}, nil
+		}
+		slog.Error("Failed to create webhook",
+			"error", fmt.Sprintf("%v", err),
+			"name", req.Body.Name,
+			"operation", "CreateWebhook",
+		)
+		return api.CreateWebhook500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	data := h.mapper.WebhookToAPI(*created)
+	data.Secret = &created.Secret
+	return api.CreateWebhook201JSONResponse{Data: data}, nil
+}
+
+// GetWebhook retrieves a webhook by ID
+func (h *WebhookHandlers) GetWebhook(ctx context.Context, req api.GetWebhookRequestObject) (api.GetWebhookResponseObject, error) {
+	hook, err := h.webhooks.GetWebhook(ctx, string(req.Id))
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.GetWebhook404JSONResponse{NotFoundJSONResponse: webhookNotFound()}, nil
+		}
+		slog.Error("Failed to get webhook",
+			"error", fmt.Sprintf("%v", err),
+			"webhook_id", req.Id,
+			"operation", "GetWebhook",
+		)
+		return api.GetWebhook500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.GetWebhook200JSONResponse{
+		Data: h.mapper.WebhookToAPI(*hook),
+	}, nil
+}
+
+// UpdateWebhook updates a webhook
+func (h *WebhookHandlers) UpdateWebhook(ctx context.Context, req api.UpdateWebhookRequestObject) (api.UpdateWebhookResponseObject, error) {
+	updates := store.WebhookUpdate{
+		Name:       req.Body.Name,
+		URL:        req.Body.Url,
+		Secret:     req.Body.Secret,
+		Labels:     req.Body.Labels,
+		WorkingDir: req.Body.WorkingDir,
+		Enabled:    req.Body.Enabled,
+	}
+	if req.Body.EventTypes != nil {
+		eventTypes := h.mapper.WebhookEventTypesFromAPI(*req.Body.EventTypes)
+		updates.EventTypes = &eventTypes
+	}
+
+	hook, err := h.webhooks.UpdateWebhook(ctx, string(req.Id), updates)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.UpdateWebhook404JSONResponse{NotFoundJSONResponse: webhookNotFound()}, nil
+		}
+		if validation.IsError(err) {
+			return api.UpdateWebhook400JSONResponse{
+				BadRequestJSONResponse: api.BadRequestJSONResponse{Error: scheduleErrorDetail("HLD-3001", err)},
+			}, nil
+		}
+		slog.Error("Failed to update webhook",
+			"error", fmt.Sprintf("%v", err),
+			"webhook_id", req.Id,
+			"operation", "UpdateWebhook",
+		)
+		return api.UpdateWebhook500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.UpdateWebhook200JSONResponse{
+		Data: h.mapper.WebhookToAPI(*hook),
+	}, nil
+}
+
+// DeleteWebhook deletes a webhook and its delivery log
+func (h *WebhookHandlers) DeleteWebhook(ctx context.Context, req api.DeleteWebhookRequestObject) (api.DeleteWebhookResponseObject, error) {
+	if err := h.webhooks.DeleteWebhook(ctx, string(req.Id)); err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.DeleteWebhook404JSONResponse{NotFoundJSONResponse: webhookNotFound()}, nil
+		}
+		slog.Error("Failed to delete webhook",
+			"error", fmt.Sprintf("%v", err),
+			"webhook_id", req.Id,
+			"operation", "DeleteWebhook",
+		)
+		return api.DeleteWebhook500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.DeleteWebhook204Response{}, nil
+}
+
+// ListWebhookDeliveries returns a webhook's delivery log
+func (h *WebhookHandlers) ListWebhookDeliveries(ctx context.Context, req api.ListWebhookDeliveriesRequestObject) (api.ListWebhookDeliveriesResponseObject, error) {
+	limit := 50
+	if req.Params.Limit != nil {
+		limit = *req.Params.Limit
+	}
+
+	deliveries, err := h.webhooks.ListDeliveries(ctx, string(req.Id), limit)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return api.ListWebhookDeliveries404JSONResponse{NotFoundJSONResponse: webhookNotFound()}, nil
+		}
+		slog.Error("Failed to list webhook deliveries",
+			"error", fmt.Sprintf("%v", err),
+			"webhook_id", req.Id,
+			"operation", "ListWebhookDeliveries",
+		)
+		return api.ListWebhookDeliveries500JSONResponse{
+			InternalErrorJSONResponse: api.InternalErrorJSONResponse{Error: scheduleErrorDetail("HLD-4001", err)},
+		}, nil
+	}
+
+	return api.ListWebhookDeliveries200JSONResponse{
+		Data: h.mapper.WebhookDeliveriesToAPI(deliveries),
+	}, nil
+}
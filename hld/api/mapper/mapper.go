@@ -4,8 +4,12 @@ import (
 	"encoding/json"
 	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
 	"github.com/humanlayer/humanlayer/hld/api"
+	"github.com/humanlayer/humanlayer/hld/backup"
+	"github.com/humanlayer/humanlayer/hld/batch"
+	"github.com/humanlayer/humanlayer/hld/bus"
 	"github.com/humanlayer/humanlayer/hld/rpc"
 	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/humanlayer/humanlayer/hld/templates"
 )
 
 // Mapper handles conversions between API types and domain types
@@ -101,15 +105,93 @@ func (m *Mapper) SessionToAPI(s store.Session) api.Session {
 	if s.ProxyModelOverride != "" {
 		session.ProxyModelOverride = &s.ProxyModelOverride
 	}
+	session.ProxyApiKeySet = s.ProxyAPIKey != ""
 
 	// Editor state for draft sessions
 	if s.EditorState != nil && *s.EditorState != "" {
 		session.EditorState = s.EditorState
 	}
 
+	// Stall watchdog thresholds
+	session.StallThresholdMs = s.StallThresholdMs
+	session.StallInterruptThresholdMs = s.StallInterruptThresholdMs
+
+	session.Resources = m.SessionResourcesToAPI(s)
+
+	if s.Runner != "" {
+		session.Runner = &s.Runner
+	}
+	if s.ProjectID != "" {
+		session.ProjectId = &s.ProjectID
+	}
+	if s.Labels != nil {
+		session.Labels = &s.Labels
+	}
+
 	return session
 }
 
+// SessionResourcesToAPI converts a session's resource usage to API format,
+// returning nil if it has never been sampled
+func (m *Mapper) SessionResourcesToAPI(s store.Session) *api.SessionResources {
+	if s.ResourcesSampledAt == nil {
+		return nil
+	}
+	return &api.SessionResources{
+		CpuTimeMs:      s.CPUTimeMs,
+		CpuPercent:     s.CPUPercent,
+		RssBytes:       s.RSSBytes,
+		OpenFds:        s.OpenFDs,
+		PeakCpuPercent: s.PeakCPUPercent,
+		PeakRssBytes:   s.PeakRSSBytes,
+		PeakOpenFds:    s.PeakOpenFDs,
+		SampledAt:      *s.ResourcesSampledAt,
+	}
+}
+
+// ResourceSamplesToAPI converts resource samples to API format
+func (m *Mapper) ResourceSamplesToAPI(samples []*store.ResourceSample) []api.ResourceSample {
+	result := make([]api.ResourceSample, len(samples))
+	for i, sample := range samples {
+		result[i] = api.ResourceSample{
+			SampledAt:    sample.SampledAt,
+			CpuTimeMs:    sample.CPUTimeMs,
+			CpuPercent:   sample.CPUPercent,
+			RssBytes:     sample.RSSBytes,
+			OpenFds:      sample.OpenFDs,
+			ProcessCount: sample.ProcessCount,
+		}
+	}
+	return result
+}
+
+// SubscriberStatsToAPI converts event bus subscriber statistics to API format
+func (m *Mapper) SubscriberStatsToAPI(stats []bus.SubscriberStats) []api.EventSubscriberStats {
+	result := make([]api.EventSubscriberStats, len(stats))
+	for i, s := range stats {
+		result[i] = api.EventSubscriberStats{
+			Id:         s.ID,
+			BufferSize: s.BufferSize,
+			Overflow:   api.OverflowPolicy(s.Overflow),
+			Delivered:  int64(s.Delivered),
+			Dropped:    int64(s.Dropped),
+			Lag:        s.Lag,
+		}
+		if len(s.Filter.Types) > 0 {
+			eventTypes := make([]string, len(s.Filter.Types))
+			for j, t := range s.Filter.Types {
+				eventTypes[j] = string(t)
+			}
+			result[i].EventTypes = &eventTypes
+		}
+		if s.Filter.SessionID != "" {
+			sessionID := s.Filter.SessionID
+			result[i].SessionId = &sessionID
+		}
+	}
+	return result
+}
+
 func (m *Mapper) SessionsToAPI(sessions []store.Session) []api.Session {
 	result := make([]api.Session, len(sessions))
 	for i, s := range sessions {
@@ -202,6 +284,13 @@ func (m *Mapper) ConversationEventToAPI(e store.ConversationEvent) api.Conversat
 		event.ApprovalId = &e.ApprovalID
 	}
 
+	// Compaction fields
+	if e.CompactionTrigger != "" {
+		event.CompactionTrigger = &e.CompactionTrigger
+	}
+	event.PreCompactionTokens = e.PreCompactionTokens
+	event.PostCompactionTokens = e.PostCompactionTokens
+
 	return event
 }
 
@@ -289,3 +378,517 @@ func (m *Mapper) RecentPathsToAPI(paths []store.RecentPath) []api.RecentPath {
 	}
 	return result
 }
+
+// Schedule conversions
+func (m *Mapper) ScheduleToAPI(s store.Schedule) api.Schedule {
+	schedule := api.Schedule{
+		Id:             s.ID,
+		Name:           s.Name,
+		CronExpression: s.CronExpr,
+		Timezone:       s.Timezone,
+		OverlapPolicy:  api.ScheduleOverlapPolicy(s.OverlapPolicy),
+		CatchUpPolicy:  api.ScheduleCatchUpPolicy(s.CatchUpPolicy),
+		Enabled:        s.Enabled,
+		NextRunAt:      s.NextRunAt,
+		LastRunAt:      s.LastRunAt,
+		CreatedAt:      s.CreatedAt,
+		UpdatedAt:      s.UpdatedAt,
+	}
+	if s.WorkingDir != "" {
+		schedule.WorkingDir = &s.WorkingDir
+	}
+	// The stored template uses the same JSON field names as the API schema
+	_ = json.Unmarshal([]byte(s.LaunchConfig), &schedule.LaunchConfig)
+	schedule.ProxyApiKeySet = schedule.LaunchConfig.ProxyApiKey != nil && *schedule.LaunchConfig.ProxyApiKey != ""
+	schedule.LaunchConfig.ProxyApiKey = nil
+	return schedule
+}
+
+func (m *Mapper) SchedulesToAPI(schedules []*store.Schedule) []api.Schedule {
+	result := make([]api.Schedule, len(schedules))
+	for i, s := range schedules {
+		result[i] = m.ScheduleToAPI(*s)
+	}
+	return result
+}
+
+// ScheduleLaunchConfigFromAPI encodes an API launch config for storage
+func (m *Mapper) ScheduleLaunchConfigFromAPI(config api.ScheduleLaunchConfig) (string, error) {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (m *Mapper) ScheduleRunToAPI(r store.ScheduleRun) api.ScheduleRun {
+	run := api.ScheduleRun{
+		Id:           r.ID,
+		ScheduleId:   r.ScheduleID,
+		ScheduledFor: r.ScheduledFor,
+		Status:       api.ScheduleRunStatus(r.Status),
+		CatchUp:      r.CatchUp,
+		CreatedAt:    r.CreatedAt,
+	}
+	if r.SessionID != "" {
+		run.SessionId = &r.SessionID
+	}
+	if r.SessionStatus != "" {
+		status := api.SessionStatus(r.SessionStatus)
+		run.SessionStatus = &status
+	}
+	if r.Reason != "" {
+		run.Reason = &r.Reason
+	}
+	return run
+}
+
+func (m *Mapper) ScheduleRunsToAPI(runs []*store.ScheduleRun) []api.ScheduleRun {
+	result := make([]api.ScheduleRun, len(runs))
+	for i, r := range runs {
+		result[i] = m.ScheduleRunToAPI(*r)
+	}
+	return result
+}
+
+// Webhook conversions
+func (m *Mapper) WebhookToAPI(w store.Webhook) api.Webhook {
+	webhook := api.Webhook{
+		Id:         w.ID,
+		Name:       w.Name,
+		Url:        w.URL,
+		EventTypes: make([]api.WebhookEventType, len(w.EventTypes)),
+		Labels:     w.Labels,
+		Enabled:    w.Enabled,
+		CreatedAt:  w.CreatedAt,
+		UpdatedAt:  w.UpdatedAt,
+	}
+	for i, eventType := range w.EventTypes {
+		webhook.EventTypes[i] = api.WebhookEventType(eventType)
+	}
+	if webhook.Labels == nil {
+		webhook.Labels = []string{}
+	}
+	if w.WorkingDir != "" {
+		webhook.WorkingDir = &w.WorkingDir
+	}
+	return webhook
+}
+
+func (m *Mapper) WebhooksToAPI(webhooks []*store.Webhook) []api.Webhook {
+	result := make([]api.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		result[i] = m.WebhookToAPI(*w)
+	}
+	return result
+}
+
+// WebhookEventTypesFromAPI converts API event types for storage
+func (m *Mapper) WebhookEventTypesFromAPI(eventTypes []api.WebhookEventType) []string {
+	result := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		result[i] = string(eventType)
+	}
+	return result
+}
+
+func (m *Mapper) WebhookDeliveryToAPI(d store.WebhookDelivery) api.WebhookDelivery {
+	delivery := api.WebhookDelivery{
+		Id:            d.ID,
+		WebhookId:     d.WebhookID,
+		EventType:     api.WebhookEventType(d.EventType),
+		Status:        api.WebhookDeliveryStatus(d.Status),
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+		CreatedAt:     d.CreatedAt,
+		DeliveredAt:   d.DeliveredAt,
+	}
+	if d.EventSeq != 0 {
+		delivery.EventSeq = &d.EventSeq
+	}
+	// The payload is stored exactly as it was posted
+	_ = json.Unmarshal([]byte(d.Payload), &delivery.Payload)
+	if d.ResponseStatus != 0 {
+		delivery.ResponseStatus = &d.ResponseStatus
+	}
+	if d.Error != "" {
+		delivery.Error = &d.Error
+	}
+	return delivery
+}
+
+func (m *Mapper) WebhookDeliveriesToAPI(deliveries []*store.WebhookDelivery) []api.WebhookDelivery {
+	result := make([]api.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = m.WebhookDeliveryToAPI(*d)
+	}
+	return result
+}
+
+// Pipeline conversions
+func (m *Mapper) PipelineRunToAPI(r store.PipelineRun, steps []*store.PipelineStep) api.PipelineRun {
+	run := api.PipelineRun{
+		Id:          r.ID,
+		Name:        r.Name,
+		Status:      api.PipelineRunStatus(r.Status),
+		CurrentStep: r.CurrentStep,
+		Steps:       make([]api.PipelineStep, len(steps)),
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+		CompletedAt: r.CompletedAt,
+	}
+	if r.WorkingDir != "" {
+		run.WorkingDir = &r.WorkingDir
+	}
+	if r.ErrorMessage != "" {
+		run.ErrorMessage = &r.ErrorMessage
+	}
+	// The stored definition uses the same JSON field names as the API schema
+	_ = json.Unmarshal([]byte(r.Definition), &run.Definition)
+
+	for i, s := range steps {
+		step := api.PipelineStep{
+			Index:       s.StepIndex,
+			Name:        s.Name,
+			Status:      api.PipelineStepStatus(s.Status),
+			StartedAt:   s.StartedAt,
+			CompletedAt: s.CompletedAt,
+		}
+		if s.SessionID != "" {
+			step.SessionId = &s.SessionID
+		}
+		if s.Reason != "" {
+			step.Reason = &s.Reason
+		}
+		run.Steps[i] = step
+	}
+	return run
+}
+
+// PipelineRunsToAPI converts pipeline runs without their steps
+func (m *Mapper) PipelineRunsToAPI(runs []*store.PipelineRun) []api.PipelineRun {
+	result := make([]api.PipelineRun, len(runs))
+	for i, r := range runs {
+		result[i] = m.PipelineRunToAPI(*r, nil)
+	}
+	return result
+}
+
+// PipelineDefinitionFromAPI encodes an API pipeline definition as JSON for parsing
+func (m *Mapper) PipelineDefinitionFromAPI(def api.PipelineDefinition) ([]byte, error) {
+	return json.Marshal(def)
+}
+
+// Batch conversions
+func (m *Mapper) BatchGroupToAPI(g *batch.Group) api.BatchGroup {
+	group := api.BatchGroup{
+		Id:           g.ID,
+		Name:         g.Name,
+		Query:        g.Query,
+		Status:       api.BatchStatus(g.Status),
+		TotalCostUsd: g.TotalCostUSD,
+		DurationMs:   g.DurationMS,
+		Members:      make([]api.BatchMember, len(g.Members)),
+		CreatedAt:    g.CreatedAt,
+	}
+	// The stored matrix uses the same JSON field names as the API schema
+	if data, err := json.Marshal(g.Matrix); err == nil {
+		_ = json.Unmarshal(data, &group.Matrix)
+	}
+
+	for i, mem := range g.Members {
+		member := api.BatchMember{
+			Index:      mem.Index,
+			Label:      mem.Label,
+			Status:     mem.Status,
+			CostUsd:    mem.CostUSD,
+			DurationMs: mem.DurationMS,
+			NumTurns:   mem.NumTurns,
+		}
+		if mem.DiffStats != nil {
+			member.DiffStats = &api.BatchDiffStats{
+				FilesChanged: mem.DiffStats.FilesChanged,
+				LinesAdded:   mem.DiffStats.LinesAdded,
+				LinesRemoved: mem.DiffStats.LinesRemoved,
+			}
+		}
+		if mem.SessionID != "" {
+			member.SessionId = &mem.SessionID
+		}
+		if mem.Model != "" {
+			member.Model = &mem.Model
+		}
+		if mem.Provider != "" {
+			member.Provider = &mem.Provider
+		}
+		if mem.WorkingDir != "" {
+			member.WorkingDir = &mem.WorkingDir
+		}
+		if mem.Error != "" {
+			member.Error = &mem.Error
+		}
+		if mem.Result != "" {
+			member.Result = &mem.Result
+		}
+		group.Members[i] = member
+	}
+	return group
+}
+
+func (m *Mapper) BatchGroupsToAPI(groups []*batch.Group) []api.BatchGroup {
+	result := make([]api.BatchGroup, len(groups))
+	for i, g := range groups {
+		result[i] = m.BatchGroupToAPI(g)
+	}
+	return result
+}
+
+func (m *Mapper) BatchComparisonToAPI(c *batch.Comparison) api.BatchComparison {
+	comparison := api.BatchComparison{
+		Batch: m.BatchGroupToAPI(c.Group),
+	}
+	if c.CheapestSessionID != "" {
+		comparison.CheapestSessionId = &c.CheapestSessionID
+	}
+	if c.FastestSessionID != "" {
+		comparison.FastestSessionId = &c.FastestSessionID
+	}
+	return comparison
+}
+
+// BatchMatrixFromAPI converts an API launch matrix, including provider API keys
+func (m *Mapper) BatchMatrixFromAPI(matrix api.BatchMatrix) (batch.Matrix, error) {
+	var result batch.Matrix
+	data, err := json.Marshal(matrix)
+	if err != nil {
+		return result, err
+	}
+	err = json.Unmarshal(data, &result)
+	return result, err
+}
+
+// Session template conversions. Templates use the same JSON field names as
+// the API schemas, except that the proxy API key is write-only.
+func (m *Mapper) SessionTemplateToAPI(t *templates.Template) api.SessionTemplate {
+	var result api.SessionTemplate
+	if data, err := json.Marshal(t); err == nil {
+		_ = json.Unmarshal(data, &result)
+	}
+	result.Id = t.ID
+	result.ProxyApiKeySet = t.ProxyAPIKey != ""
+	result.Placeholders = t.Placeholders()
+	result.CreatedAt = t.CreatedAt
+	result.UpdatedAt = t.UpdatedAt
+	return result
+}
+
+func (m *Mapper) SessionTemplatesToAPI(list []*templates.Template) []api.SessionTemplate {
+	result := make([]api.SessionTemplate, len(list))
+	for i, t := range list {
+		result[i] = m.SessionTemplateToAPI(t)
+	}
+	return result
+}
+
+// SessionTemplateFromAPI converts an API template spec, including the proxy API key
+func (m *Mapper) SessionTemplateFromAPI(spec api.SessionTemplateSpec) (*templates.Template, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var result templates.Template
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// EffectiveConfigToAPI converts a session's recorded effective configuration,
+// which is stored with the API's JSON field names
+func (m *Mapper) EffectiveConfigToAPI(config string) (api.EffectiveConfig, error) {
+	var result api.EffectiveConfig
+	if err := json.Unmarshal([]byte(config), &result); err != nil {
+		return api.EffectiveConfig{}, err
+	}
+	if result.Sources == nil {
+		result.Sources = map[string]string{}
+	}
+	return result, nil
+}
+
+// Label conversions
+func (m *Mapper) LabelToAPI(l store.Label) api.Label {
+	return api.Label{
+		Id:           l.ID,
+		Name:         l.Name,
+		Color:        l.Color,
+		SessionCount: l.SessionCount,
+		CreatedAt:    l.CreatedAt,
+	}
+}
+
+func (m *Mapper) LabelsToAPI(labels []*store.Label) []api.Label {
+	result := make([]api.Label, len(labels))
+	for i, l := range labels {
+		result[i] = m.LabelToAPI(*l)
+	}
+	return result
+}
+
+// Project conversions
+func (m *Mapper) ProjectsToAPI(projects []*store.Project) []api.Project {
+	result := make([]api.Project, len(projects))
+	for i, p := range projects {
+		result[i] = api.Project{
+			Id:             p.ID,
+			Name:           p.Name,
+			RootPath:       p.RootPath,
+			SessionCount:   p.SessionCount,
+			LastActivityAt: p.LastActivityAt,
+			CreatedAt:      p.CreatedAt,
+		}
+	}
+	return result
+}
+
+// SessionFilterFromAPI converts and validates a session filter
+func (m *Mapper) SessionFilterFromAPI(f api.SessionFilter) (store.SessionFilter, error) {
+	filter := store.SessionFilter{
+		CreatedAfter:  f.CreatedAfter,
+		CreatedBefore: f.CreatedBefore,
+		MinCostUSD:    f.MinCostUsd,
+		MaxCostUSD:    f.MaxCostUsd,
+	}
+	if f.Kind != nil {
+		filter.Kind = string(*f.Kind)
+	}
+	if f.Statuses != nil {
+		filter.Statuses = *f.Statuses
+	}
+	if f.Labels != nil {
+		filter.Labels = *f.Labels
+	}
+	if f.ProjectId != nil {
+		filter.ProjectID = *f.ProjectId
+	}
+	if f.Models != nil {
+		filter.Models = *f.Models
+	}
+	return filter, filter.Validate()
+}
+
+func (m *Mapper) SessionFilterToAPI(filter store.SessionFilter) api.SessionFilter {
+	result := api.SessionFilter{
+		CreatedAfter:  filter.CreatedAfter,
+		CreatedBefore: filter.CreatedBefore,
+		MinCostUsd:    filter.MinCostUSD,
+		MaxCostUsd:    filter.MaxCostUSD,
+	}
+	if filter.Kind != "" {
+		kind := api.SessionFilterKind(filter.Kind)
+		result.Kind = &kind
+	}
+	if len(filter.Statuses) > 0 {
+		result.Statuses = &filter.Statuses
+	}
+	if len(filter.Labels) > 0 {
+		result.Labels = &filter.Labels
+	}
+	if filter.ProjectID != "" {
+		result.ProjectId = &filter.ProjectID
+	}
+	if len(filter.Models) > 0 {
+		result.Models = &filter.Models
+	}
+	return result
+}
+
+func (m *Mapper) SavedFilterToAPI(f store.SavedFilter) api.SavedFilter {
+	return api.SavedFilter{
+		Id:        f.ID,
+		Name:      f.Name,
+		Filter:    m.SessionFilterToAPI(f.Filter),
+		CreatedAt: f.CreatedAt,
+		UpdatedAt: f.UpdatedAt,
+	}
+}
+
+func (m *Mapper) SavedFiltersToAPI(filters []*store.SavedFilter) []api.SavedFilter {
+	result := make([]api.SavedFilter, len(filters))
+	for i, f := range filters {
+		result[i] = m.SavedFilterToAPI(*f)
+	}
+	return result
+}
+
+// ConversationSearchResultsToAPI converts search results, keeping their order
+func (m *Mapper) ConversationSearchResultsToAPI(results []*store.ConversationSearchResult) []api.ConversationSearchResult {
+	apiResults := make([]api.ConversationSearchResult, len(results))
+	for i, r := range results {
+		matches := make([]api.ConversationSearchMatch, len(r.Matches))
+		for j, match := range r.Matches {
+			highlights := make([]api.TextRange, len(match.Highlights))
+			for k, h := range match.Highlights {
+				highlights[k] = api.TextRange{Start: h.Start, End: h.End}
+			}
+			matches[j] = api.ConversationSearchMatch{
+				EventId:         match.EventID,
+				ClaudeSessionId: match.ClaudeSessionID,
+				Sequence:        match.Sequence,
+				EventType:       match.EventType,
+				CreatedAt:       match.CreatedAt,
+				Snippet:         match.Snippet,
+				Highlights:      highlights,
+			}
+			if match.Role != "" {
+				matches[j].Role = &match.Role
+			}
+			if match.ToolName != "" {
+				matches[j].ToolName = &match.ToolName
+			}
+		}
+		apiResults[i] = api.ConversationSearchResult{
+			Session: m.SessionToAPI(*r.Session),
+			Matches: matches,
+		}
+	}
+	return apiResults
+}
+
+// MaintenanceReportToAPI converts a store maintenance report to API format
+func (m *Mapper) MaintenanceReportToAPI(r store.MaintenanceReport) api.MaintenanceReport {
+	count := func(c store.PruneCount) api.MaintenancePruneCount {
+		return api.MaintenancePruneCount{Rows: c.Rows, Bytes: c.Bytes}
+	}
+	return api.MaintenanceReport{
+		DryRun:                 r.DryRun,
+		RawEvents:              count(r.RawEvents),
+		ArchivedSessions:       count(r.ArchivedSessions),
+		ExpiredFileSnapshots:   count(r.ExpiredFileSnapshots),
+		DuplicateFileSnapshots: count(r.DuplicateFileSnapshots),
+		AutoVacuum:             r.AutoVacuum,
+		Vacuum:                 api.VacuumMode(r.Vacuum),
+		Analyzed:               r.Analyzed,
+		SizeBeforeBytes:        r.SizeBeforeBytes,
+		SizeAfterBytes:         r.SizeAfterBytes,
+		ReclaimedBytes:         r.ReclaimedBytes,
+		FreeBytes:              r.FreeBytes,
+		StartedAt:              r.StartedAt,
+		CompletedAt:            r.CompletedAt,
+	}
+}
+
+// BackupToAPI converts a database backup to its API representation
+func (m *Mapper) BackupToAPI(b backup.Backup) api.Backup {
+	result := api.Backup{
+		Name:          b.Name,
+		Path:          b.Path,
+		SizeBytes:     b.SizeBytes,
+		CreatedAt:     b.CreatedAt,
+		SchemaVersion: b.SchemaVersion,
+	}
+	if b.Reason != "" {
+		result.Reason = &b.Reason
+	}
+	return result
+}
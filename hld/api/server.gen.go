@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -35,6 +36,21 @@ const (
 	ApprovalStatusPending  ApprovalStatus = "pending"
 )
 
+// Defines values for AutoTitleMode.
+const (
+	Heuristic AutoTitleMode = "heuristic"
+	Model     AutoTitleMode = "model"
+	Off       AutoTitleMode = "off"
+)
+
+// Defines values for BatchStatus.
+const (
+	BatchStatusCompleted BatchStatus = "completed"
+	BatchStatusFailed    BatchStatus = "failed"
+	BatchStatusPartial   BatchStatus = "partial"
+	BatchStatusRunning   BatchStatus = "running"
+)
+
 // Defines values for ConversationEventApprovalStatus.
 const (
 	ConversationEventApprovalStatusApproved ConversationEventApprovalStatus = "approved"
@@ -45,6 +61,7 @@ const (
 
 // Defines values for ConversationEventEventType.
 const (
+	ConversationEventEventTypeCompaction ConversationEventEventType = "compaction"
 	ConversationEventEventTypeMessage    ConversationEventEventType = "message"
 	ConversationEventEventTypeSystem     ConversationEventEventType = "system"
 	ConversationEventEventTypeThinking   ConversationEventEventType = "thinking"
@@ -59,6 +76,26 @@ const (
 	ConversationEventRoleUser      ConversationEventRole = "user"
 )
 
+// Defines values for ConversationUpdatedPayloadContentType.
+const (
+	ConversationUpdatedPayloadContentTypeCompaction ConversationUpdatedPayloadContentType = "compaction"
+	ConversationUpdatedPayloadContentTypeSystem     ConversationUpdatedPayloadContentType = "system"
+	ConversationUpdatedPayloadContentTypeText       ConversationUpdatedPayloadContentType = "text"
+	ConversationUpdatedPayloadContentTypeThinking   ConversationUpdatedPayloadContentType = "thinking"
+	ConversationUpdatedPayloadContentTypeToolResult ConversationUpdatedPayloadContentType = "tool_result"
+	ConversationUpdatedPayloadContentTypeToolUse    ConversationUpdatedPayloadContentType = "tool_use"
+)
+
+// Defines values for ConversationUpdatedPayloadEventType.
+const (
+	ConversationUpdatedPayloadEventTypeCompaction ConversationUpdatedPayloadEventType = "compaction"
+	ConversationUpdatedPayloadEventTypeMessage    ConversationUpdatedPayloadEventType = "message"
+	ConversationUpdatedPayloadEventTypeSystem     ConversationUpdatedPayloadEventType = "system"
+	ConversationUpdatedPayloadEventTypeThinking   ConversationUpdatedPayloadEventType = "thinking"
+	ConversationUpdatedPayloadEventTypeToolCall   ConversationUpdatedPayloadEventType = "tool_call"
+	ConversationUpdatedPayloadEventTypeToolResult ConversationUpdatedPayloadEventType = "tool_result"
+)
+
 // Defines values for CreateSessionRequestModel.
 const (
 	Haiku  CreateSessionRequestModel = "haiku"
@@ -74,11 +111,15 @@ const (
 
 // Defines values for EventType.
 const (
-	ApprovalResolved       EventType = "approval_resolved"
-	ConversationUpdated    EventType = "conversation_updated"
-	NewApproval            EventType = "new_approval"
-	SessionSettingsChanged EventType = "session_settings_changed"
-	SessionStatusChanged   EventType = "session_status_changed"
+	EventTypeApprovalResolved       EventType = "approval_resolved"
+	EventTypeBudgetExceeded         EventType = "budget_exceeded"
+	EventTypeConversationUpdated    EventType = "conversation_updated"
+	EventTypeNewApproval            EventType = "new_approval"
+	EventTypeReplayGap              EventType = "replay_gap"
+	EventTypeSessionResourceWarning EventType = "session_resource_warning"
+	EventTypeSessionSettingsChanged EventType = "session_settings_changed"
+	EventTypeSessionStalled         EventType = "session_stalled"
+	EventTypeSessionStatusChanged   EventType = "session_status_changed"
 )
 
 // Defines values for HealthResponseStatus.
@@ -92,6 +133,85 @@ const (
 	InterruptSessionResponseDataStatusInterrupting InterruptSessionResponseDataStatus = "interrupting"
 )
 
+// Defines values for OverflowPolicy.
+const (
+	Coalesce   OverflowPolicy = "coalesce"
+	Disconnect OverflowPolicy = "disconnect"
+	DropNewest OverflowPolicy = "drop_newest"
+	DropOldest OverflowPolicy = "drop_oldest"
+)
+
+// Defines values for PipelineRunStatus.
+const (
+	PipelineRunStatusCompleted PipelineRunStatus = "completed"
+	PipelineRunStatusFailed    PipelineRunStatus = "failed"
+	PipelineRunStatusPaused    PipelineRunStatus = "paused"
+	PipelineRunStatusRunning   PipelineRunStatus = "running"
+)
+
+// Defines values for PipelineStepDefinitionMode.
+const (
+	Continue PipelineStepDefinitionMode = "continue"
+	Fresh    PipelineStepDefinitionMode = "fresh"
+)
+
+// Defines values for PipelineStepDefinitionWhen.
+const (
+	Always  PipelineStepDefinitionWhen = "always"
+	Failure PipelineStepDefinitionWhen = "failure"
+	Success PipelineStepDefinitionWhen = "success"
+)
+
+// Defines values for PipelineStepStatus.
+const (
+	PipelineStepStatusCompleted PipelineStepStatus = "completed"
+	PipelineStepStatusFailed    PipelineStepStatus = "failed"
+	PipelineStepStatusPending   PipelineStepStatus = "pending"
+	PipelineStepStatusRunning   PipelineStepStatus = "running"
+	PipelineStepStatusSkipped   PipelineStepStatus = "skipped"
+)
+
+// Defines values for ScheduleCatchUpPolicy.
+const (
+	ScheduleCatchUpPolicyAll  ScheduleCatchUpPolicy = "all"
+	ScheduleCatchUpPolicyNone ScheduleCatchUpPolicy = "none"
+	ScheduleCatchUpPolicyOnce ScheduleCatchUpPolicy = "once"
+)
+
+// Defines values for ScheduleOverlapPolicy.
+const (
+	Allow     ScheduleOverlapPolicy = "allow"
+	Interrupt ScheduleOverlapPolicy = "interrupt"
+	Skip      ScheduleOverlapPolicy = "skip"
+)
+
+// Defines values for ScheduleRunStatus.
+const (
+	ScheduleRunStatusFailed   ScheduleRunStatus = "failed"
+	ScheduleRunStatusLaunched ScheduleRunStatus = "launched"
+	ScheduleRunStatusSkipped  ScheduleRunStatus = "skipped"
+)
+
+// Defines values for SessionFilterKind.
+const (
+	SessionFilterKindArchived SessionFilterKind = "archived"
+	SessionFilterKindDraft    SessionFilterKind = "draft"
+	SessionFilterKindNormal   SessionFilterKind = "normal"
+)
+
+// Defines values for SessionResourceWarningPayloadResource.
+const (
+	CpuPercent SessionResourceWarningPayloadResource = "cpu_percent"
+	OpenFds    SessionResourceWarningPayloadResource = "open_fds"
+	RssBytes   SessionResourceWarningPayloadResource = "rss_bytes"
+)
+
+// Defines values for SessionSettingsChangedPayloadReason.
+const (
+	AutoTitle SessionSettingsChangedPayloadReason = "auto_title"
+	Expired   SessionSettingsChangedPayloadReason = "expired"
+)
+
 // Defines values for SessionStatus.
 const (
 	SessionStatusCompleted    SessionStatus = "completed"
@@ -105,19 +225,66 @@ const (
 	SessionStatusWaitingInput SessionStatus = "waiting_input"
 )
 
+// Defines values for SessionStatusChangedPayloadReason.
+const (
+	TokenUpdate SessionStatusChangedPayloadReason = "token_update"
+)
+
 // Defines values for SlashCommandSource.
 const (
 	SlashCommandSourceGlobal SlashCommandSource = "global"
 	SlashCommandSourceLocal  SlashCommandSource = "local"
 )
 
+// Defines values for VacuumMode.
+const (
+	VacuumModeFull        VacuumMode = "full"
+	VacuumModeIncremental VacuumMode = "incremental"
+	VacuumModeNone        VacuumMode = "none"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	Delivered WebhookDeliveryStatus = "delivered"
+	Failed    WebhookDeliveryStatus = "failed"
+	Pending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeApprovalResolved WebhookEventType = "approval_resolved"
+	WebhookEventTypeBudgetExceeded   WebhookEventType = "budget_exceeded"
+	WebhookEventTypeNewApproval      WebhookEventType = "new_approval"
+	WebhookEventTypeSessionCompleted WebhookEventType = "session_completed"
+	WebhookEventTypeSessionFailed    WebhookEventType = "session_failed"
+	WebhookEventTypeSessionStalled   WebhookEventType = "session_stalled"
+)
+
 // Defines values for ListSessionsParamsFilter.
 const (
-	Archived ListSessionsParamsFilter = "archived"
-	Draft    ListSessionsParamsFilter = "draft"
-	Normal   ListSessionsParamsFilter = "normal"
+	ListSessionsParamsFilterArchived ListSessionsParamsFilter = "archived"
+	ListSessionsParamsFilterDraft    ListSessionsParamsFilter = "draft"
+	ListSessionsParamsFilterNormal   ListSessionsParamsFilter = "normal"
+)
+
+// Defines values for ExportSessionConversationParamsFormat.
+const (
+	Html     ExportSessionConversationParamsFormat = "html"
+	Jsonl    ExportSessionConversationParamsFormat = "jsonl"
+	Markdown ExportSessionConversationParamsFormat = "markdown"
 )
 
+// ActiveSessionResources defines model for ActiveSessionResources.
+type ActiveSessionResources struct {
+	// Resources Latest and peak resource usage of the session's process tree, absent until first sampled
+	Resources *SessionResources `json:"resources,omitempty"`
+	SessionId string            `json:"session_id"`
+
+	// Status Current status of the session
+	Status SessionStatus `json:"status"`
+	Title  *string       `json:"title,omitempty"`
+}
+
 // Agent defines model for Agent.
 type Agent struct {
 	// Description Optional description from YAML frontmatter
@@ -166,6 +333,19 @@ type Approval struct {
 	ToolName string `json:"tool_name"`
 }
 
+// ApprovalResolvedPayload Data of an approval_resolved event
+type ApprovalResolvedPayload struct {
+	ApprovalId string `json:"approval_id"`
+	Approved   bool   `json:"approved"`
+
+	// ResponseText Comment given with the decision
+	ResponseText string `json:"response_text"`
+	SessionId    string `json:"session_id"`
+
+	// ToolUseId Tool use the approval was requested for through MCP
+	ToolUseId *string `json:"tool_use_id,omitempty"`
+}
+
 // ApprovalResponse defines model for ApprovalResponse.
 type ApprovalResponse struct {
 	Data Approval `json:"data"`
@@ -179,6 +359,144 @@ type ApprovalsResponse struct {
 	Data []Approval `json:"data"`
 }
 
+// AutoTitleMode How sessions without a title get one after the first assistant reply.
+// off leaves them untitled, heuristic extracts a title from the query and
+// model asks a cheap model, falling back to the heuristic on failure.
+type AutoTitleMode string
+
+// Backup defines model for Backup.
+type Backup struct {
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+	Path      string    `json:"path"`
+
+	// Reason Why the backup was taken when not on request or schedule
+	Reason *string `json:"reason,omitempty"`
+
+	// SchemaVersion Schema version of the backed up database
+	SchemaVersion int   `json:"schema_version"`
+	SizeBytes     int64 `json:"size_bytes"`
+}
+
+// BackupResponse defines model for BackupResponse.
+type BackupResponse struct {
+	Data Backup `json:"data"`
+}
+
+// BackupsResponse defines model for BackupsResponse.
+type BackupsResponse struct {
+	Data []Backup `json:"data"`
+}
+
+// BatchComparison defines model for BatchComparison.
+type BatchComparison struct {
+	Batch BatchGroup `json:"batch"`
+
+	// CheapestSessionId Cheapest completed member
+	CheapestSessionId *string `json:"cheapest_session_id,omitempty"`
+
+	// FastestSessionId Fastest completed member
+	FastestSessionId *string `json:"fastest_session_id,omitempty"`
+}
+
+// BatchComparisonResponse defines model for BatchComparisonResponse.
+type BatchComparisonResponse struct {
+	Data BatchComparison `json:"data"`
+}
+
+// BatchDiffStats defines model for BatchDiffStats.
+type BatchDiffStats struct {
+	FilesChanged int `json:"files_changed"`
+	LinesAdded   int `json:"lines_added"`
+	LinesRemoved int `json:"lines_removed"`
+}
+
+// BatchGroup defines model for BatchGroup.
+type BatchGroup struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// DurationMs Wall clock time from the first launch to the last completion
+	DurationMs int64         `json:"duration_ms"`
+	Id         string        `json:"id"`
+	Matrix     BatchMatrix   `json:"matrix"`
+	Members    []BatchMember `json:"members"`
+	Name       string        `json:"name"`
+	Query      string        `json:"query"`
+
+	// Status Aggregate status; partial means every member finished but not all succeeded
+	Status       BatchStatus `json:"status"`
+	TotalCostUsd float64     `json:"total_cost_usd"`
+}
+
+// BatchGroupResponse defines model for BatchGroupResponse.
+type BatchGroupResponse struct {
+	Data BatchGroup `json:"data"`
+}
+
+// BatchGroupsResponse defines model for BatchGroupsResponse.
+type BatchGroupsResponse struct {
+	Data []BatchGroup `json:"data"`
+}
+
+// BatchMatrix defines model for BatchMatrix.
+type BatchMatrix struct {
+	// Models Claude models to run directly (opus, sonnet or haiku)
+	Models      *[]string        `json:"models,omitempty"`
+	Providers   *[]BatchProvider `json:"providers,omitempty"`
+	WorkingDirs *[]string        `json:"working_dirs,omitempty"`
+}
+
+// BatchMember defines model for BatchMember.
+type BatchMember struct {
+	CostUsd    *float64        `json:"cost_usd,omitempty"`
+	DiffStats  *BatchDiffStats `json:"diff_stats,omitempty"`
+	DurationMs *int64          `json:"duration_ms,omitempty"`
+	Error      *string         `json:"error,omitempty"`
+	Index      int             `json:"index"`
+	Label      string          `json:"label"`
+	Model      *string         `json:"model,omitempty"`
+	NumTurns   *int            `json:"num_turns,omitempty"`
+	Provider   *string         `json:"provider,omitempty"`
+
+	// Result Final result text (comparison only)
+	Result *string `json:"result,omitempty"`
+
+	// SessionId Session launched for this cell (absent when the launch failed)
+	SessionId *string `json:"session_id,omitempty"`
+
+	// Status Session status, launch_failed, or missing once the session was deleted
+	Status     string  `json:"status"`
+	WorkingDir *string `json:"working_dir,omitempty"`
+}
+
+// BatchProvider defines model for BatchProvider.
+type BatchProvider struct {
+	// ApiKey API key for the provider. Never returned by the API.
+	ApiKey  *string `json:"api_key,omitempty"`
+	BaseUrl string  `json:"base_url"`
+
+	// Models Models to run through this provider; the provider default is used when empty
+	Models *[]string `json:"models,omitempty"`
+	Name   string    `json:"name"`
+}
+
+// BatchStatus Aggregate status; partial means every member finished but not all succeeded
+type BatchStatus string
+
+// BudgetExceededPayload Data of a budget_exceeded event
+type BudgetExceededPayload struct {
+	CostUsd    float64 `json:"cost_usd"`
+	MaxCostUsd float64 `json:"max_cost_usd"`
+
+	// PipelineRunId Set for sessions run as a pipeline step
+	PipelineRunId *string `json:"pipeline_run_id,omitempty"`
+	RunId         string  `json:"run_id"`
+	SessionId     string  `json:"session_id"`
+
+	// Step Pipeline step name, set with pipeline_run_id
+	Step *string `json:"step,omitempty"`
+}
+
 // BulkArchiveRequest defines model for BulkArchiveRequest.
 type BulkArchiveRequest struct {
 	// Archived True to archive, false to unarchive
@@ -212,6 +530,12 @@ type BulkRestoreDraftsResponse struct {
 	} `json:"data"`
 }
 
+// CompactSessionRequest defines model for CompactSessionRequest.
+type CompactSessionRequest struct {
+	// Instructions Optional instructions focusing what the summary keeps
+	Instructions *string `json:"instructions,omitempty"`
+}
+
 // ConfigResponse defines model for ConfigResponse.
 type ConfigResponse struct {
 	// ClaudeAvailable Whether Claude is available at the configured path
@@ -271,6 +595,9 @@ type ConversationEvent struct {
 	ApprovalStatus  *ConversationEventApprovalStatus `json:"approval_status"`
 	ClaudeSessionId *string                          `json:"claude_session_id,omitempty"`
 
+	// CompactionTrigger What started the compaction, manual or auto (for compaction events)
+	CompactionTrigger *string `json:"compaction_trigger,omitempty"`
+
 	// Content Message content
 	Content   *string   `json:"content,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
@@ -285,6 +612,12 @@ type ConversationEvent struct {
 	// ParentToolUseId Parent tool use ID for nested calls
 	ParentToolUseId *string `json:"parent_tool_use_id,omitempty"`
 
+	// PostCompactionTokens Context size after compaction, once known (for compaction events)
+	PostCompactionTokens *int `json:"post_compaction_tokens"`
+
+	// PreCompactionTokens Context size before compaction (for compaction events)
+	PreCompactionTokens *int `json:"pre_compaction_tokens"`
+
 	// Role Message role (for message events)
 	Role *ConversationEventRole `json:"role,omitempty"`
 
@@ -320,8 +653,80 @@ type ConversationEventRole string
 // ConversationResponse defines model for ConversationResponse.
 type ConversationResponse struct {
 	Data []ConversationEvent `json:"data"`
+
+	// HasMore Whether more events remain after this page
+	HasMore *bool `json:"has_more,omitempty"`
+
+	// NextCursor Cursor positioned after the last returned event. Present whenever
+	// events were returned, so it can also be used to poll for new events.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ConversationSearchMatch defines model for ConversationSearchMatch.
+type ConversationSearchMatch struct {
+	// ClaudeSessionId Claude session the event belongs to; with sequence it locates the event in the conversation
+	ClaudeSessionId string    `json:"claude_session_id"`
+	CreatedAt       time.Time `json:"created_at"`
+	EventId         int64     `json:"event_id"`
+	EventType       string    `json:"event_type"`
+
+	// Highlights Matched words within the snippet
+	Highlights []TextRange `json:"highlights"`
+	Role       *string     `json:"role,omitempty"`
+	Sequence   int         `json:"sequence"`
+
+	// Snippet Excerpt of the matching text
+	Snippet string `json:"snippet"`
+
+	// ToolName Tool of the call, or for a tool result the tool that produced it
+	ToolName *string `json:"tool_name,omitempty"`
+}
+
+// ConversationSearchResponse defines model for ConversationSearchResponse.
+type ConversationSearchResponse struct {
+	Data []ConversationSearchResult `json:"data"`
+}
+
+// ConversationSearchResult defines model for ConversationSearchResult.
+type ConversationSearchResult struct {
+	// Matches Matching events, most recent first
+	Matches []ConversationSearchMatch `json:"matches"`
+	Session Session                   `json:"session"`
+}
+
+// ConversationUpdatedPayload Data of a conversation_updated event. Which fields are set depends on
+// event_type.
+type ConversationUpdatedPayload struct {
+	ClaudeSessionId string                                `json:"claude_session_id"`
+	Content         *string                               `json:"content,omitempty"`
+	ContentType     ConversationUpdatedPayloadContentType `json:"content_type"`
+	EventType       ConversationUpdatedPayloadEventType   `json:"event_type"`
+	ParentToolUseId *string                               `json:"parent_tool_use_id,omitempty"`
+
+	// PostTokens Context size after a compaction
+	PostTokens *int `json:"post_tokens,omitempty"`
+
+	// PreTokens Context size before a compaction
+	PreTokens         *int                    `json:"pre_tokens,omitempty"`
+	Role              *string                 `json:"role,omitempty"`
+	SessionId         string                  `json:"session_id"`
+	Subtype           *string                 `json:"subtype,omitempty"`
+	ToolId            *string                 `json:"tool_id,omitempty"`
+	ToolInput         *map[string]interface{} `json:"tool_input,omitempty"`
+	ToolName          *string                 `json:"tool_name,omitempty"`
+	ToolResultContent *string                 `json:"tool_result_content,omitempty"`
+	ToolResultForId   *string                 `json:"tool_result_for_id,omitempty"`
+
+	// Trigger What triggered a compaction, auto or manual
+	Trigger *string `json:"trigger,omitempty"`
 }
 
+// ConversationUpdatedPayloadContentType defines model for ConversationUpdatedPayload.ContentType.
+type ConversationUpdatedPayloadContentType string
+
+// ConversationUpdatedPayloadEventType defines model for ConversationUpdatedPayload.EventType.
+type ConversationUpdatedPayloadEventType string
+
 // CreateApprovalRequest defines model for CreateApprovalRequest.
 type CreateApprovalRequest struct {
 	// RunId Run ID for the approval
@@ -342,6 +747,45 @@ type CreateApprovalResponse struct {
 	} `json:"data"`
 }
 
+// CreateLabelRequest defines model for CreateLabelRequest.
+type CreateLabelRequest struct {
+	Color *string `json:"color,omitempty"`
+
+	// Name Label name, unique regardless of case
+	Name string `json:"name"`
+}
+
+// CreateScheduleRequest defines model for CreateScheduleRequest.
+type CreateScheduleRequest struct {
+	// CatchUpPolicy What to do with runs missed while the daemon was not running:
+	// - none: drop them, record a skipped run
+	// - once: launch a single run for all missed occurrences
+	// - all: launch every missed occurrence (at most 10)
+	CatchUpPolicy *ScheduleCatchUpPolicy `json:"catch_up_policy,omitempty"`
+
+	// CronExpression Standard 5-field cron expression or @daily-style macro
+	CronExpression string `json:"cron_expression"`
+	Enabled        *bool  `json:"enabled,omitempty"`
+
+	// LaunchConfig Launch config template used for every session a schedule creates
+	LaunchConfig ScheduleLaunchConfig `json:"launch_config"`
+
+	// Name Human-readable schedule name
+	Name string `json:"name"`
+
+	// OverlapPolicy What to do when the schedule fires while its previous session is still active:
+	// - skip: do not launch, record a skipped run
+	// - allow: launch alongside the previous session
+	// - interrupt: interrupt the previous session, then launch
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlap_policy,omitempty"`
+
+	// Timezone IANA timezone, defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+
+	// WorkingDir Working directory for scheduled sessions
+	WorkingDir *string `json:"working_dir,omitempty"`
+}
+
 // CreateSessionRequest defines model for CreateSessionRequest.
 type CreateSessionRequest struct {
 	// AdditionalDirectories Additional directories Claude can access
@@ -399,6 +843,15 @@ type CreateSessionRequest struct {
 	// Query Initial query for Claude
 	Query string `json:"query"`
 
+	// Runner Runner backend to launch with, as registered in the daemon configuration. Defaults to the Claude Code CLI.
+	Runner *string `json:"runner,omitempty"`
+
+	// StallInterruptThresholdMs Idle time in milliseconds before a session is interrupted, whether or not its stall is reported. Null uses the daemon default and 0 disables interrupts.
+	StallInterruptThresholdMs *int64 `json:"stall_interrupt_threshold_ms"`
+
+	// StallThresholdMs Idle time in milliseconds before the session is reported as stalled. Null uses the daemon default and 0 disables the check.
+	StallThresholdMs *int64 `json:"stall_threshold_ms"`
+
 	// SystemPrompt Override system prompt
 	SystemPrompt *string `json:"system_prompt,omitempty"`
 
@@ -426,17 +879,46 @@ type CreateSessionResponse struct {
 	} `json:"data"`
 }
 
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EventTypes Event types to deliver; empty means all
+	EventTypes *[]WebhookEventType `json:"event_types,omitempty"`
+
+	// Labels Only deliver events of sessions with all of these labels
+	Labels *[]string `json:"labels,omitempty"`
+
+	// Name Human-readable webhook name
+	Name string `json:"name"`
+
+	// Secret Signing secret, generated when not given
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Absolute http or https URL events are POSTed to
+	Url string `json:"url"`
+
+	// WorkingDir Only deliver events of sessions in this directory or below it
+	WorkingDir *string `json:"working_dir,omitempty"`
+}
+
 // DebugInfoResponse defines model for DebugInfoResponse.
 type DebugInfoResponse struct {
 	// CliCommand CLI command configured for MCP servers
 	CliCommand string `json:"cli_command"`
 
+	// EventSubscribers Delivery statistics of event bus subscribers, oldest first
+	EventSubscribers *[]EventSubscriberStats `json:"event_subscribers,omitempty"`
+
 	// LastModified Last modification time of the database file
 	LastModified *time.Time `json:"last_modified,omitempty"`
 
 	// Path Path to the SQLite database file
 	Path string `json:"path"`
 
+	// SessionResources Resource usage of active sessions' process trees
+	SessionResources *[]ActiveSessionResources `json:"session_resources,omitempty"`
+
 	// Size Size of the database file in bytes
 	Size int64 `json:"size"`
 
@@ -483,6 +965,42 @@ type DirectoryNotFoundResponse struct {
 	RequiresCreation bool `json:"requiresCreation"`
 }
 
+// EffectiveConfig defines model for EffectiveConfig.
+type EffectiveConfig struct {
+	AdditionalDirectories      *[]string `json:"additional_directories,omitempty"`
+	AllowedTools               *[]string `json:"allowed_tools,omitempty"`
+	AppendSystemPrompt         *string   `json:"append_system_prompt,omitempty"`
+	AutoAcceptEdits            bool      `json:"auto_accept_edits"`
+	DangerouslySkipPermissions bool      `json:"dangerously_skip_permissions"`
+	DisallowedTools            *[]string `json:"disallowed_tools,omitempty"`
+
+	// Ignored Project settings left out because the project configuration is not
+	// trusted: MCP servers, directories outside the project and
+	// auto-accepting edits
+	Ignored  *[]ProjectConfigIssue `json:"ignored,omitempty"`
+	MaxTurns *int                  `json:"max_turns,omitempty"`
+
+	// McpServers Names of the configured MCP servers
+	McpServers *[]string `json:"mcp_servers,omitempty"`
+	Model      *string   `json:"model,omitempty"`
+
+	// ProjectConfigPath Project configuration file merged in, absent when there was none
+	ProjectConfigPath *string `json:"project_config_path,omitempty"`
+
+	// ProjectConfigTrusted Whether the daemon trusted the project configuration file
+	ProjectConfigTrusted *bool `json:"project_config_trusted,omitempty"`
+
+	// Sources Where each set field came from, one of request, project or merged
+	Sources                   map[string]string `json:"sources"`
+	StallInterruptThresholdMs *int64            `json:"stall_interrupt_threshold_ms,omitempty"`
+	StallThresholdMs          *int64            `json:"stall_threshold_ms,omitempty"`
+}
+
+// EffectiveConfigResponse defines model for EffectiveConfigResponse.
+type EffectiveConfigResponse struct {
+	Data EffectiveConfig `json:"data"`
+}
+
 // ErrorDetail defines model for ErrorDetail.
 type ErrorDetail struct {
 	// Code Error code (e.g., HLD-101)
@@ -502,9 +1020,21 @@ type ErrorResponse struct {
 
 // Event defines model for Event.
 type Event struct {
-	// Data Event-specific data
+	// Data Event-specific data. Each event type has its own schema:
+	// NewApprovalPayload for new_approval, ApprovalResolvedPayload for
+	// approval_resolved, SessionStatusChangedPayload for
+	// session_status_changed, ConversationUpdatedPayload for
+	// conversation_updated, SessionSettingsChangedPayload for
+	// session_settings_changed, SessionStalledPayload for
+	// session_stalled, SessionResourceWarningPayload for
+	// session_resource_warning, BudgetExceededPayload for
+	// budget_exceeded and ReplayGapPayload for replay_gap.
 	Data map[string]interface{} `json:"data"`
 
+	// Seq Sequence number, increasing with every event. Absent on replay_gap
+	// events and on events the daemon failed to log.
+	Seq *int64 `json:"seq,omitempty"`
+
 	// Timestamp Event timestamp
 	Timestamp time.Time `json:"timestamp"`
 
@@ -512,6 +1042,33 @@ type Event struct {
 	Type EventType `json:"type"`
 }
 
+// EventSubscriberStats defines model for EventSubscriberStats.
+type EventSubscriberStats struct {
+	// BufferSize Number of events buffered for the subscriber
+	BufferSize int `json:"buffer_size"`
+
+	// Delivered Events the subscriber received
+	Delivered int64 `json:"delivered"`
+
+	// Dropped Events dropped because the subscriber fell behind
+	Dropped int64 `json:"dropped"`
+
+	// EventTypes Event types the subscriber receives, all of them if empty
+	EventTypes *[]string `json:"event_types,omitempty"`
+
+	// Id Subscriber ID
+	Id string `json:"id"`
+
+	// Lag Events buffered and not yet received
+	Lag int `json:"lag"`
+
+	// Overflow What happens to an event that does not fit a subscriber's buffer
+	Overflow OverflowPolicy `json:"overflow"`
+
+	// SessionId Session the subscriber receives events of
+	SessionId *string `json:"session_id,omitempty"`
+}
+
 // EventType Type of system event
 type EventType string
 
@@ -599,6 +1156,29 @@ type HealthResponse struct {
 // HealthResponseStatus defines model for HealthResponse.Status.
 type HealthResponseStatus string
 
+// ImportSessionBundleResponse defines model for ImportSessionBundleResponse.
+type ImportSessionBundleResponse struct {
+	Data struct {
+		// RootSessionId New ID of the root of the imported tree
+		RootSessionId string `json:"root_session_id"`
+
+		// SessionId New ID of the session the bundle was exported for
+		SessionId string `json:"session_id"`
+
+		// Sessions Session IDs in the bundle mapped to their new IDs
+		Sessions map[string]string `json:"sessions"`
+	} `json:"data"`
+}
+
+// ImportTemplateRequest defines model for ImportTemplateRequest.
+type ImportTemplateRequest struct {
+	// Content Contents of a YAML or JSON template file
+	Content string `json:"content"`
+
+	// Overwrite Replace an existing template with the same name
+	Overwrite *bool `json:"overwrite,omitempty"`
+}
+
 // InterruptSessionResponse defines model for InterruptSessionResponse.
 type InterruptSessionResponse struct {
 	Data struct {
@@ -611,6 +1191,54 @@ type InterruptSessionResponse struct {
 // InterruptSessionResponseDataStatus defines model for InterruptSessionResponse.Data.Status.
 type InterruptSessionResponseDataStatus string
 
+// Label defines model for Label.
+type Label struct {
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+
+	// SessionCount Number of sessions carrying the label
+	SessionCount int `json:"session_count"`
+}
+
+// LabelResponse defines model for LabelResponse.
+type LabelResponse struct {
+	Data Label `json:"data"`
+}
+
+// LabelsResponse defines model for LabelsResponse.
+type LabelsResponse struct {
+	Data []Label `json:"data"`
+}
+
+// LaunchBatchRequest defines model for LaunchBatchRequest.
+type LaunchBatchRequest struct {
+	AllowedTools    *[]string   `json:"allowed_tools,omitempty"`
+	AutoAcceptEdits *bool       `json:"auto_accept_edits,omitempty"`
+	Matrix          BatchMatrix `json:"matrix"`
+	MaxTurns        *int        `json:"max_turns,omitempty"`
+
+	// Name Batch name, defaults to the start of the query
+	Name *string `json:"name,omitempty"`
+
+	// Query Prompt sent to every member
+	Query string `json:"query"`
+
+	// WorkingDir Working directory used when the matrix has no working_dirs
+	WorkingDir *string `json:"working_dir,omitempty"`
+}
+
+// LaunchTemplateRequest defines model for LaunchTemplateRequest.
+type LaunchTemplateRequest struct {
+	// Title Overrides the template's title
+	Title     *string            `json:"title,omitempty"`
+	Variables *map[string]string `json:"variables,omitempty"`
+
+	// WorkingDir Overrides the template's working directory
+	WorkingDir *string `json:"working_dir,omitempty"`
+}
+
 // MCPConfig defines model for MCPConfig.
 type MCPConfig struct {
 	// McpServers Map of server name to configuration
@@ -638,60 +1266,488 @@ type MCPServer struct {
 	Url *string `json:"url,omitempty"`
 }
 
-// RecentPath defines model for RecentPath.
-type RecentPath struct {
-	// LastUsed Last time this path was used
-	LastUsed time.Time `json:"last_used"`
-
-	// Path Directory path
-	Path string `json:"path"`
+// MaintenancePruneCount defines model for MaintenancePruneCount.
+type MaintenancePruneCount struct {
+	// Bytes Approximate payload size of those rows
+	Bytes int64 `json:"bytes"`
 
-	// UsageCount Number of times used
-	UsageCount int `json:"usage_count"`
+	// Rows Rows removed, or that would be removed in a dry run
+	Rows int64 `json:"rows"`
 }
 
-// RecentPathsResponse defines model for RecentPathsResponse.
-type RecentPathsResponse struct {
-	Data []RecentPath `json:"data"`
-}
+// MaintenanceReport defines model for MaintenanceReport.
+type MaintenanceReport struct {
+	// Analyzed Whether planner statistics were refreshed
+	Analyzed         bool                  `json:"analyzed"`
+	ArchivedSessions MaintenancePruneCount `json:"archived_sessions"`
 
-// SearchMetadata defines model for SearchMetadata.
-type SearchMetadata struct {
-	// DurationMs Search duration in milliseconds
-	DurationMs int `json:"durationMs"`
+	// AutoVacuum The database's auto_vacuum mode (none, full or incremental)
+	AutoVacuum             string                `json:"auto_vacuum"`
+	CompletedAt            time.Time             `json:"completed_at"`
+	DryRun                 bool                  `json:"dry_run"`
+	DuplicateFileSnapshots MaintenancePruneCount `json:"duplicate_file_snapshots"`
+	ExpiredFileSnapshots   MaintenancePruneCount `json:"expired_file_snapshots"`
 
-	// TimedOut True if search was terminated due to timeout
-	TimedOut bool `json:"timedOut"`
+	// FreeBytes Unused space left inside the database file
+	FreeBytes int64                 `json:"free_bytes"`
+	RawEvents MaintenancePruneCount `json:"raw_events"`
 
-	// TotalMatches Total matches found before applying limit
-	TotalMatches int `json:"totalMatches"`
+	// ReclaimedBytes Bytes returned to the filesystem
+	ReclaimedBytes  int64     `json:"reclaimed_bytes"`
+	SizeAfterBytes  int64     `json:"size_after_bytes"`
+	SizeBeforeBytes int64     `json:"size_before_bytes"`
+	StartedAt       time.Time `json:"started_at"`
 
-	// TotalScanned Total number of files/folders scanned
-	TotalScanned int `json:"totalScanned"`
+	// Vacuum incremental returns free pages to the filesystem in small steps and
+	// only works once the database uses incremental auto-vacuum. full
+	// rebuilds the file, blocking writes while it runs, and switches the
+	// database to incremental auto-vacuum.
+	Vacuum VacuumMode `json:"vacuum"`
 }
 
-// Session defines model for Session.
-type Session struct {
-	// AdditionalDirectories Additional directories Claude can access
-	AdditionalDirectories *[]string `json:"additional_directories,omitempty"`
+// MaintenanceRequest defines model for MaintenanceRequest.
+type MaintenanceRequest struct {
+	// DryRun Report what would be pruned without changing anything
+	DryRun *bool `json:"dry_run,omitempty"`
 
-	// Archived Whether session is archived
-	Archived *bool `json:"archived,omitempty"`
+	// Vacuum incremental returns free pages to the filesystem in small steps and
+	// only works once the database uses incremental auto-vacuum. full
+	// rebuilds the file, blocking writes while it runs, and switches the
+	// database to incremental auto-vacuum.
+	Vacuum *VacuumMode `json:"vacuum,omitempty"`
+}
 
-	// AutoAcceptEdits Whether edit tools are auto-accepted
-	AutoAcceptEdits *bool `json:"auto_accept_edits,omitempty"`
+// MaintenanceResponse defines model for MaintenanceResponse.
+type MaintenanceResponse struct {
+	Data MaintenanceReport `json:"data"`
+}
 
-	// CacheCreationInputTokens Number of cache creation input tokens
-	CacheCreationInputTokens *int `json:"cache_creation_input_tokens"`
+// NewApprovalPayload Data of a new_approval event
+type NewApprovalPayload struct {
+	ApprovalId string `json:"approval_id"`
+	SessionId  string `json:"session_id"`
+	ToolName   string `json:"tool_name"`
+}
 
-	// CacheReadInputTokens Number of cache read input tokens
-	CacheReadInputTokens *int `json:"cache_read_input_tokens"`
+// OverflowPolicy What happens to an event that does not fit a subscriber's buffer
+type OverflowPolicy string
 
-	// ClaudeSessionId Claude's internal session ID
-	ClaudeSessionId *string `json:"claude_session_id,omitempty"`
+// PipelineDefinition Multi-step pipeline. Pipeline-level settings are defaults for every step.
+type PipelineDefinition struct {
+	AllowedTools    *[]string `json:"allowed_tools,omitempty"`
+	AutoAcceptEdits *bool     `json:"auto_accept_edits,omitempty"`
+	Description     *string   `json:"description,omitempty"`
 
-	// CompletedAt Session completion timestamp
-	CompletedAt *time.Time `json:"completed_at"`
+	// Model Default model for steps (opus, sonnet or haiku)
+	Model *string                  `json:"model,omitempty"`
+	Name  string                   `json:"name"`
+	Steps []PipelineStepDefinition `json:"steps"`
+
+	// WorkingDir Default working directory for the pipeline's sessions
+	WorkingDir *string `json:"working_dir,omitempty"`
+}
+
+// PipelineRun defines model for PipelineRun.
+type PipelineRun struct {
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+
+	// CurrentStep Index of the step being executed or evaluated next
+	CurrentStep int `json:"current_step"`
+
+	// Definition Multi-step pipeline. Pipeline-level settings are defaults for every step.
+	Definition   PipelineDefinition `json:"definition"`
+	ErrorMessage *string            `json:"error_message,omitempty"`
+	Id           string             `json:"id"`
+	Name         string             `json:"name"`
+	Status       PipelineRunStatus  `json:"status"`
+	Steps        []PipelineStep     `json:"steps"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+	WorkingDir   *string            `json:"working_dir,omitempty"`
+}
+
+// PipelineRunResponse defines model for PipelineRunResponse.
+type PipelineRunResponse struct {
+	Data PipelineRun `json:"data"`
+}
+
+// PipelineRunStatus defines model for PipelineRunStatus.
+type PipelineRunStatus string
+
+// PipelineRunsResponse defines model for PipelineRunsResponse.
+type PipelineRunsResponse struct {
+	Data []PipelineRun `json:"data"`
+}
+
+// PipelineStep defines model for PipelineStep.
+type PipelineStep struct {
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	Index       int        `json:"index"`
+	Name        string     `json:"name"`
+
+	// Reason Why the step was skipped or failed
+	Reason *string `json:"reason,omitempty"`
+
+	// SessionId Session launched for this step
+	SessionId *string            `json:"session_id,omitempty"`
+	StartedAt *time.Time         `json:"started_at,omitempty"`
+	Status    PipelineStepStatus `json:"status"`
+}
+
+// PipelineStepDefinition defines model for PipelineStepDefinition.
+type PipelineStepDefinition struct {
+	AllowedTools       *[]string `json:"allowed_tools,omitempty"`
+	AppendSystemPrompt *string   `json:"append_system_prompt,omitempty"`
+	DisallowedTools    *[]string `json:"disallowed_tools,omitempty"`
+
+	// MaxCostUsd Budget for the step; exceeding it interrupts the session and fails the step
+	MaxCostUsd *float64 `json:"max_cost_usd,omitempty"`
+	MaxTurns   *int     `json:"max_turns,omitempty"`
+
+	// Mode Continue the previous step's session or start a new one
+	Mode *PipelineStepDefinitionMode `json:"mode,omitempty"`
+
+	// Model Model override for this step (opus, sonnet or haiku)
+	Model *string `json:"model,omitempty"`
+
+	// Name Step name, defaults to step-N
+	Name *string `json:"name,omitempty"`
+
+	// OutputMatches Regular expression the previous step's result must match
+	OutputMatches *string `json:"output_matches,omitempty"`
+
+	// Prompt Prompt for the step. Fresh steps replace {{previous_result}} with the
+	// previous step's result, or append the result when the placeholder is absent.
+	Prompt       string  `json:"prompt"`
+	SystemPrompt *string `json:"system_prompt,omitempty"`
+
+	// When Which outcome of the previous executed step runs this step
+	When *PipelineStepDefinitionWhen `json:"when,omitempty"`
+}
+
+// PipelineStepDefinitionMode Continue the previous step's session or start a new one
+type PipelineStepDefinitionMode string
+
+// PipelineStepDefinitionWhen Which outcome of the previous executed step runs this step
+type PipelineStepDefinitionWhen string
+
+// PipelineStepStatus defines model for PipelineStepStatus.
+type PipelineStepStatus string
+
+// Project defines model for Project.
+type Project struct {
+	CreatedAt      time.Time  `json:"created_at"`
+	Id             string     `json:"id"`
+	LastActivityAt *time.Time `json:"last_activity_at,omitempty"`
+
+	// Name Name of the repository root directory
+	Name         string `json:"name"`
+	RootPath     string `json:"root_path"`
+	SessionCount int    `json:"session_count"`
+}
+
+// ProjectConfigIssue defines model for ProjectConfigIssue.
+type ProjectConfigIssue struct {
+	// Field Path of the invalid field, empty for problems with the whole file
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ProjectConfigTrust defines model for ProjectConfigTrust.
+type ProjectConfigTrust struct {
+	// Digest SHA-256 of the trusted file content; editing the file revokes trust
+	Digest *string `json:"digest,omitempty"`
+
+	// Path Project configuration file the trust applies to
+	Path    string `json:"path"`
+	Trusted bool   `json:"trusted"`
+}
+
+// ProjectsResponse defines model for ProjectsResponse.
+type ProjectsResponse struct {
+	Data []Project `json:"data"`
+}
+
+// RecentPath defines model for RecentPath.
+type RecentPath struct {
+	// LastUsed Last time this path was used
+	LastUsed time.Time `json:"last_used"`
+
+	// Path Directory path
+	Path string `json:"path"`
+
+	// UsageCount Number of times used
+	UsageCount int `json:"usage_count"`
+}
+
+// RecentPathsResponse defines model for RecentPathsResponse.
+type RecentPathsResponse struct {
+	Data []RecentPath `json:"data"`
+}
+
+// ReplayGapPayload Data of a replay_gap event
+type ReplayGapPayload struct {
+	// FirstSeq Oldest sequence still kept, 0 if none
+	FirstSeq int64 `json:"first_seq"`
+
+	// Since Sequence the subscriber resumed from
+	Since int64 `json:"since"`
+}
+
+// ResourceSample defines model for ResourceSample.
+type ResourceSample struct {
+	CpuPercent float64 `json:"cpu_percent"`
+	CpuTimeMs  int64   `json:"cpu_time_ms"`
+	OpenFds    int64   `json:"open_fds"`
+
+	// ProcessCount Number of processes in the tree
+	ProcessCount int       `json:"process_count"`
+	RssBytes     int64     `json:"rss_bytes"`
+	SampledAt    time.Time `json:"sampled_at"`
+}
+
+// RestoreBackupResponse defines model for RestoreBackupResponse.
+type RestoreBackupResponse struct {
+	Data struct {
+		Backup Backup `json:"backup"`
+
+		// RestartRequired The restore takes effect when the daemon next starts
+		RestartRequired bool `json:"restart_required"`
+	} `json:"data"`
+}
+
+// SavedFilter defines model for SavedFilter.
+type SavedFilter struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Filter Criteria a session must all meet. Omitted fields do not filter.
+	Filter    SessionFilter `json:"filter"`
+	Id        string        `json:"id"`
+	Name      string        `json:"name"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+// SavedFilterRequest defines model for SavedFilterRequest.
+type SavedFilterRequest struct {
+	// Filter Criteria a session must all meet. Omitted fields do not filter.
+	Filter SessionFilter `json:"filter"`
+	Name   string        `json:"name"`
+}
+
+// SavedFilterResponse defines model for SavedFilterResponse.
+type SavedFilterResponse struct {
+	Data SavedFilter `json:"data"`
+}
+
+// SavedFiltersResponse defines model for SavedFiltersResponse.
+type SavedFiltersResponse struct {
+	Data []SavedFilter `json:"data"`
+}
+
+// Schedule defines model for Schedule.
+type Schedule struct {
+	// CatchUpPolicy What to do with runs missed while the daemon was not running:
+	// - none: drop them, record a skipped run
+	// - once: launch a single run for all missed occurrences
+	// - all: launch every missed occurrence (at most 10)
+	CatchUpPolicy ScheduleCatchUpPolicy `json:"catch_up_policy"`
+	CreatedAt     time.Time             `json:"created_at"`
+
+	// CronExpression Standard 5-field cron expression or @daily-style macro
+	CronExpression string `json:"cron_expression"`
+
+	// Enabled Whether the schedule fires
+	Enabled bool `json:"enabled"`
+
+	// Id Schedule ID
+	Id string `json:"id"`
+
+	// LastRunAt Last time the schedule fired
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+
+	// LaunchConfig Launch config template used for every session a schedule creates
+	LaunchConfig ScheduleLaunchConfig `json:"launch_config"`
+
+	// Name Human-readable schedule name
+	Name string `json:"name"`
+
+	// NextRunAt Next time the schedule will fire
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+
+	// OverlapPolicy What to do when the schedule fires while its previous session is still active:
+	// - skip: do not launch, record a skipped run
+	// - allow: launch alongside the previous session
+	// - interrupt: interrupt the previous session, then launch
+	OverlapPolicy ScheduleOverlapPolicy `json:"overlap_policy"`
+
+	// ProxyApiKeySet Whether the launch config has a proxy API key. The key itself is never returned.
+	ProxyApiKeySet bool `json:"proxy_api_key_set"`
+
+	// Timezone IANA timezone the cron expression is evaluated in
+	Timezone  string    `json:"timezone"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// WorkingDir Working directory for scheduled sessions
+	WorkingDir *string `json:"working_dir,omitempty"`
+}
+
+// ScheduleCatchUpPolicy What to do with runs missed while the daemon was not running:
+// - none: drop them, record a skipped run
+// - once: launch a single run for all missed occurrences
+// - all: launch every missed occurrence (at most 10)
+type ScheduleCatchUpPolicy string
+
+// ScheduleLaunchConfig Launch config template used for every session a schedule creates
+type ScheduleLaunchConfig struct {
+	// AdditionalDirectories Additional directories Claude can access
+	AdditionalDirectories *[]string `json:"additional_directories,omitempty"`
+
+	// AllowedTools Whitelist of allowed tools
+	AllowedTools *[]string `json:"allowed_tools,omitempty"`
+
+	// AppendSystemPrompt Text to append to system prompt
+	AppendSystemPrompt *string `json:"append_system_prompt,omitempty"`
+
+	// AutoAcceptEdits Enable auto-accept for edit tools
+	AutoAcceptEdits *bool `json:"auto_accept_edits,omitempty"`
+
+	// CreateDirectoryIfNotExists Create the working directory if it does not exist
+	CreateDirectoryIfNotExists *bool `json:"create_directory_if_not_exists,omitempty"`
+
+	// CustomInstructions Custom instructions for Claude
+	CustomInstructions *string `json:"custom_instructions,omitempty"`
+
+	// DangerouslySkipPermissions Launch sessions with dangerously skip permissions enabled
+	DangerouslySkipPermissions *bool `json:"dangerously_skip_permissions,omitempty"`
+
+	// DangerouslySkipPermissionsTimeout Optional timeout in milliseconds for dangerously skip permissions
+	DangerouslySkipPermissionsTimeout *int64 `json:"dangerously_skip_permissions_timeout,omitempty"`
+
+	// DisallowedTools Blacklist of disallowed tools
+	DisallowedTools *[]string `json:"disallowed_tools,omitempty"`
+
+	// MaxTurns Maximum conversation turns
+	MaxTurns  *int       `json:"max_turns,omitempty"`
+	McpConfig *MCPConfig `json:"mcp_config,omitempty"`
+
+	// Model Model to use for the session (opus, sonnet or haiku)
+	Model *string `json:"model,omitempty"`
+
+	// PermissionPromptTool MCP tool for permission prompts
+	PermissionPromptTool *string `json:"permission_prompt_tool,omitempty"`
+
+	// ProxyApiKey API key for proxy authentication. Schedules returned by the API
+	// leave it out and set proxy_api_key_set instead, and an update
+	// without it keeps the stored key.
+	ProxyApiKey *string `json:"proxy_api_key,omitempty"`
+
+	// ProxyBaseUrl Base URL for proxy service
+	ProxyBaseUrl *string `json:"proxy_base_url,omitempty"`
+
+	// ProxyEnabled Enable proxy routing for scheduled sessions
+	ProxyEnabled *bool `json:"proxy_enabled,omitempty"`
+
+	// ProxyModelOverride Model identifier for proxy routing
+	ProxyModelOverride *string `json:"proxy_model_override,omitempty"`
+
+	// Query Query sent to Claude on every run
+	Query string `json:"query"`
+
+	// SystemPrompt Override system prompt
+	SystemPrompt *string `json:"system_prompt,omitempty"`
+
+	// Title Session title. Defaults to the schedule name and run time.
+	Title *string `json:"title,omitempty"`
+}
+
+// ScheduleOverlapPolicy What to do when the schedule fires while its previous session is still active:
+// - skip: do not launch, record a skipped run
+// - allow: launch alongside the previous session
+// - interrupt: interrupt the previous session, then launch
+type ScheduleOverlapPolicy string
+
+// ScheduleResponse defines model for ScheduleResponse.
+type ScheduleResponse struct {
+	Data Schedule `json:"data"`
+}
+
+// ScheduleRun defines model for ScheduleRun.
+type ScheduleRun struct {
+	// CatchUp Whether the run made up for occurrences missed during downtime
+	CatchUp   bool      `json:"catch_up"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
+
+	// Reason Why the run was skipped or failed
+	Reason     *string `json:"reason,omitempty"`
+	ScheduleId string  `json:"schedule_id"`
+
+	// ScheduledFor Occurrence this run was fired for
+	ScheduledFor time.Time `json:"scheduled_for"`
+
+	// SessionId Session created by this run (absent for skipped or failed runs)
+	SessionId *string `json:"session_id,omitempty"`
+
+	// SessionStatus Current status of the session
+	SessionStatus *SessionStatus    `json:"session_status,omitempty"`
+	Status        ScheduleRunStatus `json:"status"`
+}
+
+// ScheduleRunStatus defines model for ScheduleRun.Status.
+type ScheduleRunStatus string
+
+// ScheduleRunResponse defines model for ScheduleRunResponse.
+type ScheduleRunResponse struct {
+	Data ScheduleRun `json:"data"`
+}
+
+// ScheduleRunsResponse defines model for ScheduleRunsResponse.
+type ScheduleRunsResponse struct {
+	Data []ScheduleRun `json:"data"`
+}
+
+// SchedulesResponse defines model for SchedulesResponse.
+type SchedulesResponse struct {
+	Data []Schedule `json:"data"`
+}
+
+// SearchMetadata defines model for SearchMetadata.
+type SearchMetadata struct {
+	// DurationMs Search duration in milliseconds
+	DurationMs int `json:"durationMs"`
+
+	// TimedOut True if search was terminated due to timeout
+	TimedOut bool `json:"timedOut"`
+
+	// TotalMatches Total matches found before applying limit
+	TotalMatches int `json:"totalMatches"`
+
+	// TotalScanned Total number of files/folders scanned
+	TotalScanned int `json:"totalScanned"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	// AdditionalDirectories Additional directories Claude can access
+	AdditionalDirectories *[]string `json:"additional_directories,omitempty"`
+
+	// Archived Whether session is archived
+	Archived *bool `json:"archived,omitempty"`
+
+	// AutoAcceptEdits Whether edit tools are auto-accepted
+	AutoAcceptEdits *bool `json:"auto_accept_edits,omitempty"`
+
+	// CacheCreationInputTokens Number of cache creation input tokens
+	CacheCreationInputTokens *int `json:"cache_creation_input_tokens"`
+
+	// CacheReadInputTokens Number of cache read input tokens
+	CacheReadInputTokens *int `json:"cache_read_input_tokens"`
+
+	// ClaudeSessionId Claude's internal session ID
+	ClaudeSessionId *string `json:"claude_session_id,omitempty"`
+
+	// CompletedAt Session completion timestamp
+	CompletedAt *time.Time `json:"completed_at"`
 
 	// ContextLimit Context window limit for the model
 	ContextLimit *int `json:"context_limit"`
@@ -726,6 +1782,9 @@ type Session struct {
 	// InputTokens Number of input tokens
 	InputTokens *int `json:"input_tokens"`
 
+	// Labels Names of the labels attached to the session
+	Labels *[]string `json:"labels,omitempty"`
+
 	// LastActivityAt Last activity timestamp
 	LastActivityAt time.Time `json:"last_activity_at"`
 
@@ -741,6 +1800,12 @@ type Session struct {
 	// ParentSessionId Parent session ID if this is a forked session
 	ParentSessionId *string `json:"parent_session_id,omitempty"`
 
+	// ProjectId Project the session's working directory belongs to
+	ProjectId *string `json:"project_id,omitempty"`
+
+	// ProxyApiKeySet Whether a proxy API key is stored. The key itself is never returned.
+	ProxyApiKeySet bool `json:"proxy_api_key_set"`
+
 	// ProxyBaseUrl Base URL of the proxy server
 	ProxyBaseUrl *string `json:"proxy_base_url,omitempty"`
 
@@ -753,9 +1818,21 @@ type Session struct {
 	// Query Initial query that started the session
 	Query string `json:"query"`
 
+	// Resources Latest and peak resource usage of the session's process tree, absent until first sampled
+	Resources *SessionResources `json:"resources,omitempty"`
+
 	// RunId Unique run identifier
 	RunId string `json:"run_id"`
 
+	// Runner Runner backend the session was launched with. Empty means the Claude Code CLI.
+	Runner *string `json:"runner,omitempty"`
+
+	// StallInterruptThresholdMs Idle time in milliseconds before a session is interrupted, whether or not its stall is reported. Null uses the daemon default and 0 disables interrupts.
+	StallInterruptThresholdMs *int64 `json:"stall_interrupt_threshold_ms"`
+
+	// StallThresholdMs Idle time in milliseconds before the session is reported as stalled. Null uses the daemon default and 0 disables the check.
+	StallThresholdMs *int64 `json:"stall_threshold_ms"`
+
 	// Status Current status of the session
 	Status SessionStatus `json:"status"`
 
@@ -769,6 +1846,73 @@ type Session struct {
 	WorkingDir *string `json:"working_dir,omitempty"`
 }
 
+// SessionFilter Criteria a session must all meet. Omitted fields do not filter.
+type SessionFilter struct {
+	CreatedAfter  *time.Time         `json:"created_after,omitempty"`
+	CreatedBefore *time.Time         `json:"created_before,omitempty"`
+	Kind          *SessionFilterKind `json:"kind,omitempty"`
+
+	// Labels Sessions must carry every label
+	Labels     *[]string `json:"labels,omitempty"`
+	MaxCostUsd *float64  `json:"max_cost_usd,omitempty"`
+	MinCostUsd *float64  `json:"min_cost_usd,omitempty"`
+
+	// Models Model aliases or full model IDs
+	Models    *[]string `json:"models,omitempty"`
+	ProjectId *string   `json:"project_id,omitempty"`
+	Statuses  *[]string `json:"statuses,omitempty"`
+}
+
+// SessionFilterKind defines model for SessionFilter.Kind.
+type SessionFilterKind string
+
+// SessionLabelsResponse defines model for SessionLabelsResponse.
+type SessionLabelsResponse struct {
+	Data []string `json:"data"`
+}
+
+// SessionResourceWarningPayload Data of a session_resource_warning event
+type SessionResourceWarningPayload struct {
+	Limit     float64                               `json:"limit"`
+	Resource  SessionResourceWarningPayloadResource `json:"resource"`
+	RunId     string                                `json:"run_id"`
+	SessionId string                                `json:"session_id"`
+	Value     float64                               `json:"value"`
+}
+
+// SessionResourceWarningPayloadResource defines model for SessionResourceWarningPayload.Resource.
+type SessionResourceWarningPayloadResource string
+
+// SessionResources Latest and peak resource usage of the session's process tree, absent until first sampled
+type SessionResources struct {
+	// CpuPercent CPU use since the previous sample, 100 per fully used core
+	CpuPercent float64 `json:"cpu_percent"`
+
+	// CpuTimeMs Cumulative CPU time of the process tree
+	CpuTimeMs int64 `json:"cpu_time_ms"`
+
+	// OpenFds Open file descriptors across the process tree
+	OpenFds        int64   `json:"open_fds"`
+	PeakCpuPercent float64 `json:"peak_cpu_percent"`
+	PeakOpenFds    int64   `json:"peak_open_fds"`
+	PeakRssBytes   int64   `json:"peak_rss_bytes"`
+
+	// RssBytes Resident memory of the process tree
+	RssBytes int64 `json:"rss_bytes"`
+
+	// SampledAt When the latest sample was taken
+	SampledAt time.Time `json:"sampled_at"`
+}
+
+// SessionResourcesResponse defines model for SessionResourcesResponse.
+type SessionResourcesResponse struct {
+	Data struct {
+		// Resources Latest and peak resource usage of the session's process tree, absent until first sampled
+		Resources *SessionResources `json:"resources,omitempty"`
+		Samples   []ResourceSample  `json:"samples"`
+	} `json:"data"`
+}
+
 // SessionResponse defines model for SessionResponse.
 type SessionResponse struct {
 	Data Session `json:"data"`
@@ -779,9 +1923,137 @@ type SessionSearchResponse struct {
 	Data []Session `json:"data"`
 }
 
+// SessionSettingsChangedPayload Data of a session_settings_changed event, holding only the settings that changed
+type SessionSettingsChangedPayload struct {
+	AutoAcceptEdits                     *bool  `json:"auto_accept_edits,omitempty"`
+	DangerouslySkipPermissions          *bool  `json:"dangerously_skip_permissions,omitempty"`
+	DangerouslySkipPermissionsTimeoutMs *int64 `json:"dangerously_skip_permissions_timeout_ms,omitempty"`
+
+	// EventType settings_updated when a client changed the settings
+	EventType *string `json:"event_type,omitempty"`
+
+	// ExpiredAt When dangerous skip permissions expired, with reason expired
+	ExpiredAt                 *time.Time                           `json:"expired_at,omitempty"`
+	Reason                    *SessionSettingsChangedPayloadReason `json:"reason,omitempty"`
+	RunId                     *string                              `json:"run_id,omitempty"`
+	SessionId                 string                               `json:"session_id"`
+	StallInterruptThresholdMs *int64                               `json:"stall_interrupt_threshold_ms,omitempty"`
+	StallThresholdMs          *int64                               `json:"stall_threshold_ms,omitempty"`
+	Title                     *string                              `json:"title,omitempty"`
+}
+
+// SessionSettingsChangedPayloadReason defines model for SessionSettingsChangedPayload.Reason.
+type SessionSettingsChangedPayloadReason string
+
+// SessionStalledPayload Data of a session_stalled event
+type SessionStalledPayload struct {
+	IdleMs int64 `json:"idle_ms"`
+
+	// InterruptThresholdMs Set when the session is interrupted automatically
+	InterruptThresholdMs *int64    `json:"interrupt_threshold_ms,omitempty"`
+	LastActivityAt       time.Time `json:"last_activity_at"`
+	RunId                string    `json:"run_id"`
+	SessionId            string    `json:"session_id"`
+	ThresholdMs          int64     `json:"threshold_ms"`
+}
+
 // SessionStatus Current status of the session
 type SessionStatus string
 
+// SessionStatusChangedPayload Data of a session_status_changed event. A status change has both
+// statuses, a title change only title, and a context size update the
+// unchanged status twice with reason token_update.
+type SessionStatusChangedPayload struct {
+	NewStatus       *string                            `json:"new_status,omitempty"`
+	OldStatus       *string                            `json:"old_status,omitempty"`
+	ParentSessionId *string                            `json:"parent_session_id,omitempty"`
+	Reason          *SessionStatusChangedPayloadReason `json:"reason,omitempty"`
+	RunId           *string                            `json:"run_id,omitempty"`
+	SessionId       string                             `json:"session_id"`
+	Title           *string                            `json:"title,omitempty"`
+}
+
+// SessionStatusChangedPayloadReason defines model for SessionStatusChangedPayload.Reason.
+type SessionStatusChangedPayloadReason string
+
+// SessionTemplate defines model for SessionTemplate.
+type SessionTemplate struct {
+	AdditionalDirectories             *[]string                     `json:"additional_directories,omitempty"`
+	AllowedTools                      *[]string                     `json:"allowed_tools,omitempty"`
+	AppendSystemPrompt                *string                       `json:"append_system_prompt,omitempty"`
+	AutoAcceptEdits                   *bool                         `json:"auto_accept_edits,omitempty"`
+	CreatedAt                         time.Time                     `json:"created_at"`
+	CustomInstructions                *string                       `json:"custom_instructions,omitempty"`
+	DangerouslySkipPermissions        *bool                         `json:"dangerously_skip_permissions,omitempty"`
+	DangerouslySkipPermissionsTimeout *int64                        `json:"dangerously_skip_permissions_timeout,omitempty"`
+	Description                       *string                       `json:"description,omitempty"`
+	DisallowedTools                   *[]string                     `json:"disallowed_tools,omitempty"`
+	Id                                string                        `json:"id"`
+	MaxTurns                          *int                          `json:"max_turns,omitempty"`
+	McpServers                        *map[string]TemplateMCPServer `json:"mcp_servers,omitempty"`
+	Model                             *string                       `json:"model,omitempty"`
+	Name                              string                        `json:"name"`
+
+	// Placeholders Names of all placeholders used by the template
+	Placeholders []string `json:"placeholders"`
+
+	// ProxyApiKeySet Whether a proxy API key is stored. The key itself is never returned.
+	ProxyApiKeySet     bool                `json:"proxy_api_key_set"`
+	ProxyBaseUrl       *string             `json:"proxy_base_url,omitempty"`
+	ProxyEnabled       *bool               `json:"proxy_enabled,omitempty"`
+	ProxyModelOverride *string             `json:"proxy_model_override,omitempty"`
+	Query              string              `json:"query"`
+	SystemPrompt       *string             `json:"system_prompt,omitempty"`
+	Title              *string             `json:"title,omitempty"`
+	UpdatedAt          time.Time           `json:"updated_at"`
+	Variables          *[]TemplateVariable `json:"variables,omitempty"`
+	WorkingDir         *string             `json:"working_dir,omitempty"`
+}
+
+// SessionTemplateResponse defines model for SessionTemplateResponse.
+type SessionTemplateResponse struct {
+	Data SessionTemplate `json:"data"`
+}
+
+// SessionTemplateSpec defines model for SessionTemplateSpec.
+type SessionTemplateSpec struct {
+	AdditionalDirectories      *[]string `json:"additional_directories,omitempty"`
+	AllowedTools               *[]string `json:"allowed_tools,omitempty"`
+	AppendSystemPrompt         *string   `json:"append_system_prompt,omitempty"`
+	AutoAcceptEdits            *bool     `json:"auto_accept_edits,omitempty"`
+	CustomInstructions         *string   `json:"custom_instructions,omitempty"`
+	DangerouslySkipPermissions *bool     `json:"dangerously_skip_permissions,omitempty"`
+
+	// DangerouslySkipPermissionsTimeout Timeout in milliseconds
+	DangerouslySkipPermissionsTimeout *int64                        `json:"dangerously_skip_permissions_timeout,omitempty"`
+	Description                       *string                       `json:"description,omitempty"`
+	DisallowedTools                   *[]string                     `json:"disallowed_tools,omitempty"`
+	MaxTurns                          *int                          `json:"max_turns,omitempty"`
+	McpServers                        *map[string]TemplateMCPServer `json:"mcp_servers,omitempty"`
+
+	// Model Claude model (opus, sonnet or haiku)
+	Model *string `json:"model,omitempty"`
+
+	// Name Unique template name
+	Name               string  `json:"name"`
+	ProxyApiKey        *string `json:"proxy_api_key,omitempty"`
+	ProxyBaseUrl       *string `json:"proxy_base_url,omitempty"`
+	ProxyEnabled       *bool   `json:"proxy_enabled,omitempty"`
+	ProxyModelOverride *string `json:"proxy_model_override,omitempty"`
+	Query              string  `json:"query"`
+	SystemPrompt       *string `json:"system_prompt,omitempty"`
+	Title              *string `json:"title,omitempty"`
+
+	// Variables Declared variables. Undeclared placeholders are required variables.
+	Variables  *[]TemplateVariable `json:"variables,omitempty"`
+	WorkingDir *string             `json:"working_dir,omitempty"`
+}
+
+// SessionTemplatesResponse defines model for SessionTemplatesResponse.
+type SessionTemplatesResponse struct {
+	Data []SessionTemplate `json:"data"`
+}
+
 // SessionsResponse defines model for SessionsResponse.
 type SessionsResponse struct {
 	// Counts Session counts by category
@@ -796,6 +2068,15 @@ type SessionsResponse struct {
 		Normal *int `json:"normal,omitempty"`
 	} `json:"counts,omitempty"`
 	Data []Session `json:"data"`
+
+	// NextCursor Cursor for the next page, present only when more sessions remain
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// SetSessionLabelsRequest defines model for SetSessionLabelsRequest.
+type SetSessionLabelsRequest struct {
+	// Labels Label names replacing the session's current labels
+	Labels []string `json:"labels"`
 }
 
 // SlashCommand defines model for SlashCommand.
@@ -820,16 +2101,103 @@ type SnapshotsResponse struct {
 	Data []FileSnapshot `json:"data"`
 }
 
-// UpdateConfigRequest defines model for UpdateConfigRequest.
-type UpdateConfigRequest struct {
-	// ClaudePath Path to Claude binary (empty string for auto-detection)
-	ClaudePath *string `json:"claude_path"`
-}
+// StartPipelineRunRequest defines model for StartPipelineRunRequest.
+type StartPipelineRunRequest struct {
+	// Definition Multi-step pipeline. Pipeline-level settings are defaults for every step.
+	Definition *PipelineDefinition `json:"definition,omitempty"`
 
-// UpdateSessionRequest defines model for UpdateSessionRequest.
-type UpdateSessionRequest struct {
-	// AdditionalDirectories Update additional directories Claude can access
-	AdditionalDirectories *[]string `json:"additional_directories,omitempty"`
+	// DefinitionYaml Pipeline definition as YAML or JSON text, used when definition is absent
+	DefinitionYaml *string `json:"definition_yaml,omitempty"`
+
+	// WorkingDir Overrides the definition's working directory
+	WorkingDir *string `json:"working_dir,omitempty"`
+}
+
+// TemplateExportResponse defines model for TemplateExportResponse.
+type TemplateExportResponse struct {
+	Data struct {
+		// Content YAML template file contents
+		Content  string `json:"content"`
+		Filename string `json:"filename"`
+	} `json:"data"`
+}
+
+// TemplateMCPServer defines model for TemplateMCPServer.
+type TemplateMCPServer struct {
+	Args    *[]string          `json:"args,omitempty"`
+	Command *string            `json:"command,omitempty"`
+	Env     *map[string]string `json:"env,omitempty"`
+	Headers *map[string]string `json:"headers,omitempty"`
+
+	// Type Server type (http for HTTP servers, omit for stdio)
+	Type *string `json:"type,omitempty"`
+	Url  *string `json:"url,omitempty"`
+}
+
+// TemplateVariable defines model for TemplateVariable.
+type TemplateVariable struct {
+	// Default Value used when the launch request does not supply one
+	Default     *string `json:"default,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+
+	// Required Whether the launch request must supply a value when there is no default
+	Required *bool `json:"required,omitempty"`
+}
+
+// TextRange Half-open range of character (Unicode code point) offsets
+type TextRange struct {
+	End   int `json:"end"`
+	Start int `json:"start"`
+}
+
+// TrustProjectConfigRequest defines model for TrustProjectConfigRequest.
+type TrustProjectConfigRequest struct {
+	// Trusted True to trust the file as it is now, false to revoke trust
+	Trusted bool `json:"trusted"`
+
+	// WorkingDir Project directory whose configuration is trusted
+	WorkingDir string `json:"working_dir"`
+}
+
+// TrustProjectConfigResponse defines model for TrustProjectConfigResponse.
+type TrustProjectConfigResponse struct {
+	Data ProjectConfigTrust `json:"data"`
+}
+
+// UpdateConfigRequest defines model for UpdateConfigRequest.
+type UpdateConfigRequest struct {
+	// ClaudePath Path to Claude binary (empty string for auto-detection)
+	ClaudePath *string `json:"claude_path"`
+}
+
+// UpdateScheduleRequest defines model for UpdateScheduleRequest.
+type UpdateScheduleRequest struct {
+	// CatchUpPolicy What to do with runs missed while the daemon was not running:
+	// - none: drop them, record a skipped run
+	// - once: launch a single run for all missed occurrences
+	// - all: launch every missed occurrence (at most 10)
+	CatchUpPolicy  *ScheduleCatchUpPolicy `json:"catch_up_policy,omitempty"`
+	CronExpression *string                `json:"cron_expression,omitempty"`
+	Enabled        *bool                  `json:"enabled,omitempty"`
+
+	// LaunchConfig Launch config template used for every session a schedule creates
+	LaunchConfig *ScheduleLaunchConfig `json:"launch_config,omitempty"`
+	Name         *string               `json:"name,omitempty"`
+
+	// OverlapPolicy What to do when the schedule fires while its previous session is still active:
+	// - skip: do not launch, record a skipped run
+	// - allow: launch alongside the previous session
+	// - interrupt: interrupt the previous session, then launch
+	OverlapPolicy *ScheduleOverlapPolicy `json:"overlap_policy,omitempty"`
+	Timezone      *string                `json:"timezone,omitempty"`
+	WorkingDir    *string                `json:"working_dir,omitempty"`
+}
+
+// UpdateSessionRequest defines model for UpdateSessionRequest.
+type UpdateSessionRequest struct {
+	// AdditionalDirectories Update additional directories Claude can access
+	AdditionalDirectories *[]string `json:"additional_directories,omitempty"`
 
 	// Archived Archive/unarchive the session
 	Archived *bool `json:"archived,omitempty"`
@@ -864,6 +2232,12 @@ type UpdateSessionRequest struct {
 	// ProxyModelOverride Model identifier for proxy routing
 	ProxyModelOverride *string `json:"proxy_model_override,omitempty"`
 
+	// StallInterruptThresholdMs Idle time in milliseconds before a session is interrupted, whether or not its stall is reported. Null uses the daemon default and 0 disables interrupts.
+	StallInterruptThresholdMs *int64 `json:"stall_interrupt_threshold_ms"`
+
+	// StallThresholdMs Idle time in milliseconds before the session is reported as stalled. Null uses the daemon default and 0 disables the check.
+	StallThresholdMs *int64 `json:"stall_threshold_ms"`
+
 	// Status Current status of the session
 	Status *SessionStatus `json:"status,omitempty"`
 
@@ -879,19 +2253,72 @@ type UpdateUserSettingsRequest struct {
 	// AdvancedProviders Enable or disable advanced provider options
 	AdvancedProviders *bool `json:"advanced_providers,omitempty"`
 
+	// ArchivedSessionRetentionDays Days after their last activity to delete archived sessions. 0 keeps them forever.
+	ArchivedSessionRetentionDays *int `json:"archived_session_retention_days,omitempty"`
+
+	// AutoTitleMode How sessions without a title get one after the first assistant reply.
+	// off leaves them untitled, heuristic extracts a title from the query and
+	// model asks a cheap model, falling back to the heuristic on failure.
+	AutoTitleMode *AutoTitleMode `json:"auto_title_mode,omitempty"`
+
+	// AutoTitleModel Model used when auto_title_mode is model. Empty uses haiku.
+	AutoTitleModel *string `json:"auto_title_model,omitempty"`
+
+	// DedupeFileSnapshots Drop file snapshots identical to the next snapshot of the same file
+	DedupeFileSnapshots *bool `json:"dedupe_file_snapshots,omitempty"`
+
+	// FileSnapshotRetentionDays Days to keep file snapshots. 0 keeps them forever.
+	FileSnapshotRetentionDays *int `json:"file_snapshot_retention_days,omitempty"`
+
 	// OptInTelemetry Opt-in or opt-out of performance and error reporting
 	OptInTelemetry *bool `json:"opt_in_telemetry,omitempty"`
+
+	// RawEventRetentionDays Days to keep raw stream events. 0 keeps them forever.
+	RawEventRetentionDays *int `json:"raw_event_retention_days,omitempty"`
+}
+
+// UpdateWebhookRequest defines model for UpdateWebhookRequest.
+type UpdateWebhookRequest struct {
+	Enabled    *bool               `json:"enabled,omitempty"`
+	EventTypes *[]WebhookEventType `json:"event_types,omitempty"`
+	Labels     *[]string           `json:"labels,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+
+	// Secret New signing secret
+	Secret     *string `json:"secret,omitempty"`
+	Url        *string `json:"url,omitempty"`
+	WorkingDir *string `json:"working_dir,omitempty"`
 }
 
 // UserSettings defines model for UserSettings.
 type UserSettings struct {
 	// AdvancedProviders Enable advanced provider options like OpenRouter
-	AdvancedProviders bool      `json:"advanced_providers"`
-	CreatedAt         time.Time `json:"created_at"`
+	AdvancedProviders bool `json:"advanced_providers"`
+
+	// ArchivedSessionRetentionDays Days after their last activity to delete archived sessions. 0 keeps them forever.
+	ArchivedSessionRetentionDays int `json:"archived_session_retention_days"`
+
+	// AutoTitleMode How sessions without a title get one after the first assistant reply.
+	// off leaves them untitled, heuristic extracts a title from the query and
+	// model asks a cheap model, falling back to the heuristic on failure.
+	AutoTitleMode AutoTitleMode `json:"auto_title_mode"`
+
+	// AutoTitleModel Model used when auto_title_mode is model. Empty uses haiku.
+	AutoTitleModel string    `json:"auto_title_model"`
+	CreatedAt      time.Time `json:"created_at"`
+
+	// DedupeFileSnapshots Drop file snapshots identical to the next snapshot of the same file
+	DedupeFileSnapshots bool `json:"dedupe_file_snapshots"`
+
+	// FileSnapshotRetentionDays Days to keep file snapshots. 0 keeps them forever.
+	FileSnapshotRetentionDays int `json:"file_snapshot_retention_days"`
 
 	// OptInTelemetry Opt-in for performance and error reporting
-	OptInTelemetry *bool     `json:"opt_in_telemetry,omitempty"`
-	UpdatedAt      time.Time `json:"updated_at"`
+	OptInTelemetry *bool `json:"opt_in_telemetry,omitempty"`
+
+	// RawEventRetentionDays Days to keep raw stream events. 0 keeps them forever.
+	RawEventRetentionDays int       `json:"raw_event_retention_days"`
+	UpdatedAt             time.Time `json:"updated_at"`
 }
 
 // UserSettingsResponse defines model for UserSettingsResponse.
@@ -899,6 +2326,12 @@ type UserSettingsResponse struct {
 	Data UserSettings `json:"data"`
 }
 
+// VacuumMode incremental returns free pages to the filesystem in small steps and
+// only works once the database uses incremental auto-vacuum. full
+// rebuilds the file, blocking writes while it runs, and switches the
+// database to incremental auto-vacuum.
+type VacuumMode string
+
 // ValidateDirectoryRequest defines model for ValidateDirectoryRequest.
 type ValidateDirectoryRequest struct {
 	// Path Directory path to validate
@@ -923,12 +2356,140 @@ type ValidateDirectoryResponse struct {
 	IsDirectory *bool `json:"isDirectory,omitempty"`
 }
 
+// ValidateProjectConfigRequest defines model for ValidateProjectConfigRequest.
+type ValidateProjectConfigRequest struct {
+	// Content Configuration to validate instead of the file on disk
+	Content *string `json:"content,omitempty"`
+
+	// WorkingDir Project directory whose configuration is validated
+	WorkingDir string `json:"working_dir"`
+}
+
+// ValidateProjectConfigResponse defines model for ValidateProjectConfigResponse.
+type ValidateProjectConfigResponse struct {
+	Data struct {
+		// Exists Whether a configuration file exists at path
+		Exists bool                 `json:"exists"`
+		Issues []ProjectConfigIssue `json:"issues"`
+
+		// Path Where the project configuration is read from
+		Path string `json:"path"`
+
+		// Trusted Whether the file on disk is trusted as it is
+		Trusted bool `json:"trusted"`
+		Valid   bool `json:"valid"`
+	} `json:"data"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Enabled Whether events are delivered
+	Enabled bool `json:"enabled"`
+
+	// EventTypes Event types to deliver; empty means all
+	EventTypes []WebhookEventType `json:"event_types"`
+
+	// Id Webhook ID
+	Id string `json:"id"`
+
+	// Labels Only deliver events of sessions with all of these labels
+	Labels []string `json:"labels"`
+
+	// Name Human-readable webhook name
+	Name string `json:"name"`
+
+	// Secret Signing secret, only returned when the webhook is created
+	Secret    *string   `json:"secret,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Url URL events are POSTed to
+	Url string `json:"url"`
+
+	// WorkingDir Only deliver events of sessions in this directory or below it
+	WorkingDir *string `json:"working_dir,omitempty"`
+}
+
+// WebhookDeliveriesResponse defines model for WebhookDeliveriesResponse.
+type WebhookDeliveriesResponse struct {
+	Data []WebhookDelivery `json:"data"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Number of attempts made so far
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"created_at"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+
+	// Error Why the last attempt failed
+	Error *string `json:"error,omitempty"`
+
+	// EventSeq Sequence number of the event the delivery was made from
+	EventSeq *int64 `json:"event_seq,omitempty"`
+
+	// EventType Type of event delivered to webhooks
+	EventType WebhookEventType `json:"event_type"`
+
+	// Id Delivery ID, sent in the X-HumanLayer-Delivery header
+	Id int64 `json:"id"`
+
+	// NextAttemptAt When a pending delivery is tried next
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+
+	// Payload JSON body posted to the webhook
+	Payload map[string]interface{} `json:"payload"`
+
+	// ResponseStatus HTTP status of the last attempt, absent if there was no response
+	ResponseStatus *int                  `json:"response_status,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	WebhookId      string                `json:"webhook_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookEventType Type of event delivered to webhooks
+type WebhookEventType string
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	Data Webhook `json:"data"`
+}
+
+// WebhooksResponse defines model for WebhooksResponse.
+type WebhooksResponse struct {
+	Data []Webhook `json:"data"`
+}
+
 // ApprovalId defines model for approvalId.
 type ApprovalId = string
 
+// BatchId defines model for batchId.
+type BatchId = string
+
+// LabelId defines model for labelId.
+type LabelId = string
+
+// PipelineRunId defines model for pipelineRunId.
+type PipelineRunId = string
+
+// SavedFilterId defines model for savedFilterId.
+type SavedFilterId = string
+
+// ScheduleId defines model for scheduleId.
+type ScheduleId = string
+
 // SessionId defines model for sessionId.
 type SessionId = string
 
+// TemplateId defines model for templateId.
+type TemplateId = string
+
+// WebhookId defines model for webhookId.
+type WebhookId = string
+
 // BadRequest defines model for BadRequest.
 type BadRequest = ErrorResponse
 
@@ -950,6 +2511,39 @@ type ListApprovalsParams struct {
 	SessionId *string `form:"sessionId,omitempty" json:"sessionId,omitempty"`
 }
 
+// SearchConversationsParams defines parameters for SearchConversations.
+type SearchConversationsParams struct {
+	// Q Words to search for
+	Q string `form:"q" json:"q"`
+
+	// Role Only events with one of these roles
+	Role *[]string `form:"role,omitempty" json:"role,omitempty"`
+
+	// EventType Only events of one of these types (message, tool_call, tool_result, ...)
+	EventType *[]string `form:"eventType,omitempty" json:"eventType,omitempty"`
+
+	// ToolName Only tool calls, and their results, for one of these tools
+	ToolName *[]string `form:"toolName,omitempty" json:"toolName,omitempty"`
+
+	// SessionId Only events of this session
+	SessionId *string `form:"sessionId,omitempty" json:"sessionId,omitempty"`
+
+	// ProjectId Only events of sessions in this project
+	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+
+	// After Only events at or after this time
+	After *time.Time `form:"after,omitempty" json:"after,omitempty"`
+
+	// Before Only events before this time
+	Before *time.Time `form:"before,omitempty" json:"before,omitempty"`
+
+	// Limit Maximum number of sessions to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// MatchesPerSession Maximum number of matching events returned per session
+	MatchesPerSession *int `form:"matchesPerSession,omitempty" json:"matchesPerSession,omitempty"`
+}
+
 // CreateDirectoryJSONBody defines parameters for CreateDirectory.
 type CreateDirectoryJSONBody struct {
 	// Path The directory path to create
@@ -962,6 +2556,12 @@ type GetRecentPathsParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListScheduleRunsParams defines parameters for ListScheduleRuns.
+type ListScheduleRunsParams struct {
+	// Limit Maximum number of runs to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListSessionsParams defines parameters for ListSessions.
 type ListSessionsParams struct {
 	// LeavesOnly Return only leaf sessions (sessions with no children)
@@ -969,6 +2569,41 @@ type ListSessionsParams struct {
 
 	// Filter Filter sessions by type
 	Filter *ListSessionsParamsFilter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// Status Only sessions with one of these statuses
+	Status *[]string `form:"status,omitempty" json:"status,omitempty"`
+
+	// Label Only sessions carrying every one of these labels (case-insensitive)
+	Label *[]string `form:"label,omitempty" json:"label,omitempty"`
+
+	// ProjectId Only sessions in this project
+	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+
+	// Model Only sessions using one of these models, by alias or full model ID
+	Model *[]string `form:"model,omitempty" json:"model,omitempty"`
+
+	// CreatedAfter Only sessions created at or after this time
+	CreatedAfter *time.Time `form:"createdAfter,omitempty" json:"createdAfter,omitempty"`
+
+	// CreatedBefore Only sessions created before this time
+	CreatedBefore *time.Time `form:"createdBefore,omitempty" json:"createdBefore,omitempty"`
+
+	// MinCostUsd Only sessions that cost at least this much
+	MinCostUsd *float64 `form:"minCostUsd,omitempty" json:"minCostUsd,omitempty"`
+
+	// MaxCostUsd Only sessions that cost at most this much
+	MaxCostUsd *float64 `form:"maxCostUsd,omitempty" json:"maxCostUsd,omitempty"`
+
+	// SavedFilterId Start from a saved filter. Other filter parameters given in the
+	// same request replace the saved filter's value for that field.
+	SavedFilterId *string `form:"savedFilterId,omitempty" json:"savedFilterId,omitempty"`
+
+	// Limit Maximum number of sessions to return. When omitted all matching
+	// sessions are returned.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continue after the last session of a previous page, from its next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
 // ListSessionsParamsFilter defines parameters for ListSessions.
@@ -976,13 +2611,34 @@ type ListSessionsParamsFilter string
 
 // SearchSessionsParams defines parameters for SearchSessions.
 type SearchSessionsParams struct {
-	// Query Search query for title matching (uses SQL LIKE)
+	// Query Search query for matching against title, summary, or query fields (uses SQL LIKE)
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
 
 	// Limit Maximum number of results to return
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ExportSessionBundleParams defines parameters for ExportSessionBundle.
+type ExportSessionBundleParams struct {
+	// IncludeRawEvents Include the raw Claude stream events
+	IncludeRawEvents *bool `form:"include_raw_events,omitempty" json:"include_raw_events,omitempty"`
+}
+
+// ExportSessionConversationParams defines parameters for ExportSessionConversation.
+type ExportSessionConversationParams struct {
+	// Format Transcript format
+	Format *ExportSessionConversationParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// MaxToolResultBytes Truncate tool results longer than this many bytes. Defaults to 8192, 0 disables the limit.
+	MaxToolResultBytes *int `form:"max_tool_result_bytes,omitempty" json:"max_tool_result_bytes,omitempty"`
+
+	// MaxToolResultLines Truncate tool results longer than this many lines. Defaults to 200, 0 disables the limit.
+	MaxToolResultLines *int `form:"max_tool_result_lines,omitempty" json:"max_tool_result_lines,omitempty"`
+}
+
+// ExportSessionConversationParamsFormat defines parameters for ExportSessionConversation.
+type ExportSessionConversationParamsFormat string
+
 // LaunchDraftSessionJSONBody defines parameters for LaunchDraftSession.
 type LaunchDraftSessionJSONBody struct {
 	// CreateDirectoryIfNotExists Create working directory if it doesn't exist
@@ -992,6 +2648,25 @@ type LaunchDraftSessionJSONBody struct {
 	Prompt string `json:"prompt"`
 }
 
+// GetSessionMessagesParams defines parameters for GetSessionMessages.
+type GetSessionMessagesParams struct {
+	// Limit Maximum number of events to return. When omitted all events are returned.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Continue after the last event of a previous page, from its next_cursor
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// SinceSequence Only events of the session's own Claude session with a higher
+	// sequence number. Ignored when cursor is given.
+	SinceSequence *int `form:"since_sequence,omitempty" json:"since_sequence,omitempty"`
+}
+
+// GetSessionResourcesParams defines parameters for GetSessionResources.
+type GetSessionResourcesParams struct {
+	// Limit Maximum number of most recent samples to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetSlashCommandsParams defines parameters for GetSlashCommands.
 type GetSlashCommandsParams struct {
 	// WorkingDir Working directory to search for commands
@@ -1001,6 +2676,12 @@ type GetSlashCommandsParams struct {
 	Query *string `form:"query,omitempty" json:"query,omitempty"`
 }
 
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// Limit Maximum number of deliveries to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // DiscoverAgentsJSONRequestBody defines body for DiscoverAgents for application/json ContentType.
 type DiscoverAgentsJSONRequestBody DiscoverAgentsJSONBody
 
@@ -1010,6 +2691,9 @@ type CreateApprovalJSONRequestBody = CreateApprovalRequest
 // DecideApprovalJSONRequestBody defines body for DecideApproval for application/json ContentType.
 type DecideApprovalJSONRequestBody = DecideApprovalRequest
 
+// LaunchBatchJSONRequestBody defines body for LaunchBatch for application/json ContentType.
+type LaunchBatchJSONRequestBody = LaunchBatchRequest
+
 // UpdateConfigJSONRequestBody defines body for UpdateConfig for application/json ContentType.
 type UpdateConfigJSONRequestBody = UpdateConfigRequest
 
@@ -1019,6 +2703,33 @@ type CreateDirectoryJSONRequestBody CreateDirectoryJSONBody
 // FuzzySearchFilesJSONRequestBody defines body for FuzzySearchFiles for application/json ContentType.
 type FuzzySearchFilesJSONRequestBody = FuzzySearchFilesRequest
 
+// CreateLabelJSONRequestBody defines body for CreateLabel for application/json ContentType.
+type CreateLabelJSONRequestBody = CreateLabelRequest
+
+// RunMaintenanceJSONRequestBody defines body for RunMaintenance for application/json ContentType.
+type RunMaintenanceJSONRequestBody = MaintenanceRequest
+
+// StartPipelineRunJSONRequestBody defines body for StartPipelineRun for application/json ContentType.
+type StartPipelineRunJSONRequestBody = StartPipelineRunRequest
+
+// TrustProjectConfigJSONRequestBody defines body for TrustProjectConfig for application/json ContentType.
+type TrustProjectConfigJSONRequestBody = TrustProjectConfigRequest
+
+// ValidateProjectConfigJSONRequestBody defines body for ValidateProjectConfig for application/json ContentType.
+type ValidateProjectConfigJSONRequestBody = ValidateProjectConfigRequest
+
+// CreateScheduleJSONRequestBody defines body for CreateSchedule for application/json ContentType.
+type CreateScheduleJSONRequestBody = CreateScheduleRequest
+
+// UpdateScheduleJSONRequestBody defines body for UpdateSchedule for application/json ContentType.
+type UpdateScheduleJSONRequestBody = UpdateScheduleRequest
+
+// CreateSavedFilterJSONRequestBody defines body for CreateSavedFilter for application/json ContentType.
+type CreateSavedFilterJSONRequestBody = SavedFilterRequest
+
+// UpdateSavedFilterJSONRequestBody defines body for UpdateSavedFilter for application/json ContentType.
+type UpdateSavedFilterJSONRequestBody = SavedFilterRequest
+
 // CreateSessionJSONRequestBody defines body for CreateSession for application/json ContentType.
 type CreateSessionJSONRequestBody = CreateSessionRequest
 
@@ -1031,18 +2742,42 @@ type BulkRestoreDraftsJSONRequestBody = BulkRestoreDraftsRequest
 // UpdateSessionJSONRequestBody defines body for UpdateSession for application/json ContentType.
 type UpdateSessionJSONRequestBody = UpdateSessionRequest
 
+// CompactSessionJSONRequestBody defines body for CompactSession for application/json ContentType.
+type CompactSessionJSONRequestBody = CompactSessionRequest
+
 // ContinueSessionJSONRequestBody defines body for ContinueSession for application/json ContentType.
 type ContinueSessionJSONRequestBody = ContinueSessionRequest
 
+// SetSessionLabelsJSONRequestBody defines body for SetSessionLabels for application/json ContentType.
+type SetSessionLabelsJSONRequestBody = SetSessionLabelsRequest
+
 // LaunchDraftSessionJSONRequestBody defines body for LaunchDraftSession for application/json ContentType.
 type LaunchDraftSessionJSONRequestBody LaunchDraftSessionJSONBody
 
+// CreateTemplateJSONRequestBody defines body for CreateTemplate for application/json ContentType.
+type CreateTemplateJSONRequestBody = SessionTemplateSpec
+
+// ImportTemplateJSONRequestBody defines body for ImportTemplate for application/json ContentType.
+type ImportTemplateJSONRequestBody = ImportTemplateRequest
+
+// UpdateTemplateJSONRequestBody defines body for UpdateTemplate for application/json ContentType.
+type UpdateTemplateJSONRequestBody = SessionTemplateSpec
+
+// LaunchTemplateJSONRequestBody defines body for LaunchTemplate for application/json ContentType.
+type LaunchTemplateJSONRequestBody = LaunchTemplateRequest
+
 // UpdateUserSettingsJSONRequestBody defines body for UpdateUserSettings for application/json ContentType.
 type UpdateUserSettingsJSONRequestBody = UpdateUserSettingsRequest
 
 // ValidateDirectoryJSONRequestBody defines body for ValidateDirectory for application/json ContentType.
 type ValidateDirectoryJSONRequestBody = ValidateDirectoryRequest
 
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = UpdateWebhookRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Discover available agents
@@ -1060,12 +2795,36 @@ type ServerInterface interface {
 	// Decide on approval request
 	// (POST /approvals/{id}/decide)
 	DecideApproval(c *gin.Context, id ApprovalId)
+	// List database backups
+	// (GET /backups)
+	ListBackups(c *gin.Context)
+	// Back up the database
+	// (POST /backups)
+	CreateBackup(c *gin.Context)
+	// Restore a database backup
+	// (POST /backups/{name}/restore)
+	RestoreBackup(c *gin.Context, name string)
+	// List batch launches
+	// (GET /batches)
+	ListBatches(c *gin.Context)
+	// Launch a batch
+	// (POST /batches)
+	LaunchBatch(c *gin.Context)
+	// Get batch details
+	// (GET /batches/{id})
+	GetBatch(c *gin.Context, id BatchId)
+	// Compare batch results
+	// (GET /batches/{id}/compare)
+	CompareBatch(c *gin.Context, id BatchId)
 	// Get daemon configuration
 	// (GET /config)
 	GetConfig(c *gin.Context)
 	// Update daemon configuration
 	// (PATCH /config)
 	UpdateConfig(c *gin.Context)
+	// Search conversation content
+	// (GET /conversations/search)
+	SearchConversations(c *gin.Context, params SearchConversationsParams)
 	// Get debug information
 	// (GET /debug-info)
 	GetDebugInfo(c *gin.Context)
@@ -1078,9 +2837,78 @@ type ServerInterface interface {
 	// Health check
 	// (GET /health)
 	GetHealth(c *gin.Context)
+	// List labels
+	// (GET /labels)
+	ListLabels(c *gin.Context)
+	// Create a label
+	// (POST /labels)
+	CreateLabel(c *gin.Context)
+	// Delete a label
+	// (DELETE /labels/{id})
+	DeleteLabel(c *gin.Context, id LabelId)
+	// Run database maintenance
+	// (POST /maintenance)
+	RunMaintenance(c *gin.Context)
+	// List pipeline runs
+	// (GET /pipeline-runs)
+	ListPipelineRuns(c *gin.Context)
+	// Start a pipeline run
+	// (POST /pipeline-runs)
+	StartPipelineRun(c *gin.Context)
+	// Get pipeline run details
+	// (GET /pipeline-runs/{id})
+	GetPipelineRun(c *gin.Context, id PipelineRunId)
+	// Pause a pipeline run
+	// (POST /pipeline-runs/{id}/pause)
+	PausePipelineRun(c *gin.Context, id PipelineRunId)
+	// Resume a pipeline run
+	// (POST /pipeline-runs/{id}/resume)
+	ResumePipelineRun(c *gin.Context, id PipelineRunId)
+	// Trust a project configuration
+	// (POST /project-config/trust)
+	TrustProjectConfig(c *gin.Context)
+	// Validate a project configuration
+	// (POST /project-config/validate)
+	ValidateProjectConfig(c *gin.Context)
+	// List projects
+	// (GET /projects)
+	ListProjects(c *gin.Context)
 	// Get recent working directories
 	// (GET /recent-paths)
 	GetRecentPaths(c *gin.Context, params GetRecentPathsParams)
+	// List schedules
+	// (GET /schedules)
+	ListSchedules(c *gin.Context)
+	// Create a schedule
+	// (POST /schedules)
+	CreateSchedule(c *gin.Context)
+	// Delete a schedule
+	// (DELETE /schedules/{id})
+	DeleteSchedule(c *gin.Context, id ScheduleId)
+	// Get schedule details
+	// (GET /schedules/{id})
+	GetSchedule(c *gin.Context, id ScheduleId)
+	// Update a schedule
+	// (PATCH /schedules/{id})
+	UpdateSchedule(c *gin.Context, id ScheduleId)
+	// List schedule runs
+	// (GET /schedules/{id}/runs)
+	ListScheduleRuns(c *gin.Context, id ScheduleId, params ListScheduleRunsParams)
+	// Run a schedule now
+	// (POST /schedules/{id}/trigger)
+	TriggerSchedule(c *gin.Context, id ScheduleId)
+	// List saved session filters
+	// (GET /session-filters)
+	ListSavedFilters(c *gin.Context)
+	// Save a session filter
+	// (POST /session-filters)
+	CreateSavedFilter(c *gin.Context)
+	// Delete a saved session filter
+	// (DELETE /session-filters/{id})
+	DeleteSavedFilter(c *gin.Context, id SavedFilterId)
+	// Replace a saved session filter
+	// (PUT /session-filters/{id})
+	UpdateSavedFilter(c *gin.Context, id SavedFilterId)
 	// List sessions
 	// (GET /sessions)
 	ListSessions(c *gin.Context, params ListSessionsParams)
@@ -1090,10 +2918,13 @@ type ServerInterface interface {
 	// Bulk archive/unarchive sessions
 	// (POST /sessions/archive)
 	BulkArchiveSessions(c *gin.Context)
+	// Import a session bundle
+	// (POST /sessions/import)
+	ImportSessionBundle(c *gin.Context)
 	// Restore multiple discarded draft sessions
 	// (POST /sessions/restore)
 	BulkRestoreDrafts(c *gin.Context)
-	// Search sessions by title
+	// Search sessions
 	// (GET /sessions/search)
 	SearchSessions(c *gin.Context, params SearchSessionsParams)
 	// Get session details
@@ -1102,15 +2933,30 @@ type ServerInterface interface {
 	// Update session settings
 	// (PATCH /sessions/{id})
 	UpdateSession(c *gin.Context, id SessionId)
+	// Export a session bundle
+	// (GET /sessions/{id}/bundle)
+	ExportSessionBundle(c *gin.Context, id SessionId, params ExportSessionBundleParams)
+	// Compact a session's conversation
+	// (POST /sessions/{id}/compact)
+	CompactSession(c *gin.Context, id SessionId)
 	// Continue or fork a session
 	// (POST /sessions/{id}/continue)
 	ContinueSession(c *gin.Context, id SessionId)
+	// Get session effective configuration
+	// (GET /sessions/{id}/effective-config)
+	GetSessionEffectiveConfig(c *gin.Context, id SessionId)
+	// Export a session conversation
+	// (GET /sessions/{id}/export)
+	ExportSessionConversation(c *gin.Context, id SessionId, params ExportSessionConversationParams)
 	// Permanently delete an empty draft session
 	// (DELETE /sessions/{id}/hard-delete-empty)
 	HardDeleteEmptyDraftSession(c *gin.Context, id SessionId)
 	// Interrupt a running session
 	// (POST /sessions/{id}/interrupt)
 	InterruptSession(c *gin.Context, id SessionId)
+	// Replace a session's labels
+	// (PUT /sessions/{id}/labels)
+	SetSessionLabels(c *gin.Context, id SessionId)
 	// Delete a draft session
 	// (DELETE /sessions/{id}/launch)
 	DeleteDraftSession(c *gin.Context, id SessionId)
@@ -1119,13 +2965,40 @@ type ServerInterface interface {
 	LaunchDraftSession(c *gin.Context, id SessionId)
 	// Get conversation messages
 	// (GET /sessions/{id}/messages)
-	GetSessionMessages(c *gin.Context, id SessionId)
+	GetSessionMessages(c *gin.Context, id SessionId, params GetSessionMessagesParams)
+	// Get session resource usage
+	// (GET /sessions/{id}/resources)
+	GetSessionResources(c *gin.Context, id SessionId, params GetSessionResourcesParams)
 	// Get file snapshots
 	// (GET /sessions/{id}/snapshots)
 	GetSessionSnapshots(c *gin.Context, id SessionId)
 	// Get available slash commands
 	// (GET /slash-commands)
 	GetSlashCommands(c *gin.Context, params GetSlashCommandsParams)
+	// List session templates
+	// (GET /templates)
+	ListTemplates(c *gin.Context)
+	// Create a session template
+	// (POST /templates)
+	CreateTemplate(c *gin.Context)
+	// Import a session template
+	// (POST /templates/import)
+	ImportTemplate(c *gin.Context)
+	// Delete a session template
+	// (DELETE /templates/{id})
+	DeleteTemplate(c *gin.Context, id TemplateId)
+	// Get a session template
+	// (GET /templates/{id})
+	GetTemplate(c *gin.Context, id TemplateId)
+	// Replace a session template
+	// (PUT /templates/{id})
+	UpdateTemplate(c *gin.Context, id TemplateId)
+	// Export a session template
+	// (GET /templates/{id}/export)
+	ExportTemplate(c *gin.Context, id TemplateId)
+	// Launch a session from a template
+	// (POST /templates/{id}/sessions)
+	LaunchTemplate(c *gin.Context, id TemplateId)
 	// Get user settings
 	// (GET /user-settings)
 	GetUserSettings(c *gin.Context)
@@ -1135,6 +3008,24 @@ type ServerInterface interface {
 	// Validate directory existence
 	// (POST /validate-directory)
 	ValidateDirectory(c *gin.Context)
+	// List webhooks
+	// (GET /webhooks)
+	ListWebhooks(c *gin.Context)
+	// Create a webhook
+	// (POST /webhooks)
+	CreateWebhook(c *gin.Context)
+	// Delete a webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(c *gin.Context, id WebhookId)
+	// Get webhook details
+	// (GET /webhooks/{id})
+	GetWebhook(c *gin.Context, id WebhookId)
+	// Update a webhook
+	// (PATCH /webhooks/{id})
+	UpdateWebhook(c *gin.Context, id WebhookId)
+	// List webhook deliveries
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(c *gin.Context, id WebhookId, params ListWebhookDeliveriesParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -1246,8 +3137,8 @@ func (siw *ServerInterfaceWrapper) DecideApproval(c *gin.Context) {
 	siw.Handler.DecideApproval(c, id)
 }
 
-// GetConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetConfig(c *gin.Context) {
+// ListBackups operation middleware
+func (siw *ServerInterfaceWrapper) ListBackups(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1256,11 +3147,11 @@ func (siw *ServerInterfaceWrapper) GetConfig(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetConfig(c)
+	siw.Handler.ListBackups(c)
 }
 
-// UpdateConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateConfig(c *gin.Context) {
+// CreateBackup operation middleware
+func (siw *ServerInterfaceWrapper) CreateBackup(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1269,24 +3160,22 @@ func (siw *ServerInterfaceWrapper) UpdateConfig(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateConfig(c)
+	siw.Handler.CreateBackup(c)
 }
 
-// GetDebugInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetDebugInfo(c *gin.Context) {
+// RestoreBackup operation middleware
+func (siw *ServerInterfaceWrapper) RestoreBackup(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	var err error
 
-	siw.Handler.GetDebugInfo(c)
-}
+	// ------------- Path parameter "name" -------------
+	var name string
 
-// CreateDirectory operation middleware
-func (siw *ServerInterfaceWrapper) CreateDirectory(c *gin.Context) {
+	err = runtime.BindStyledParameterWithOptions("simple", "name", c.Param("name"), &name, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter name: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1295,11 +3184,11 @@ func (siw *ServerInterfaceWrapper) CreateDirectory(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateDirectory(c)
+	siw.Handler.RestoreBackup(c, name)
 }
 
-// FuzzySearchFiles operation middleware
-func (siw *ServerInterfaceWrapper) FuzzySearchFiles(c *gin.Context) {
+// ListBatches operation middleware
+func (siw *ServerInterfaceWrapper) ListBatches(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1308,11 +3197,11 @@ func (siw *ServerInterfaceWrapper) FuzzySearchFiles(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.FuzzySearchFiles(c)
+	siw.Handler.ListBatches(c)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+// LaunchBatch operation middleware
+func (siw *ServerInterfaceWrapper) LaunchBatch(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1321,22 +3210,20 @@ func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetHealth(c)
+	siw.Handler.LaunchBatch(c)
 }
 
-// GetRecentPaths operation middleware
-func (siw *ServerInterfaceWrapper) GetRecentPaths(c *gin.Context) {
+// GetBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetBatch(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRecentPathsParams
-
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "id" -------------
+	var id BatchId
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1347,30 +3234,20 @@ func (siw *ServerInterfaceWrapper) GetRecentPaths(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetRecentPaths(c, params)
+	siw.Handler.GetBatch(c, id)
 }
 
-// ListSessions operation middleware
-func (siw *ServerInterfaceWrapper) ListSessions(c *gin.Context) {
+// CompareBatch operation middleware
+func (siw *ServerInterfaceWrapper) CompareBatch(c *gin.Context) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListSessionsParams
-
-	// ------------- Optional query parameter "leavesOnly" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "leavesOnly", c.Request.URL.Query(), &params.LeavesOnly)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter leavesOnly: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "filter" -------------
+	// ------------- Path parameter "id" -------------
+	var id BatchId
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1381,11 +3258,11 @@ func (siw *ServerInterfaceWrapper) ListSessions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListSessions(c, params)
+	siw.Handler.CompareBatch(c, id)
 }
 
-// CreateSession operation middleware
-func (siw *ServerInterfaceWrapper) CreateSession(c *gin.Context) {
+// GetConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetConfig(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1394,11 +3271,11 @@ func (siw *ServerInterfaceWrapper) CreateSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateSession(c)
+	siw.Handler.GetConfig(c)
 }
 
-// BulkArchiveSessions operation middleware
-func (siw *ServerInterfaceWrapper) BulkArchiveSessions(c *gin.Context) {
+// UpdateConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateConfig(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1407,35 +3284,85 @@ func (siw *ServerInterfaceWrapper) BulkArchiveSessions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.BulkArchiveSessions(c)
+	siw.Handler.UpdateConfig(c)
 }
 
-// BulkRestoreDrafts operation middleware
-func (siw *ServerInterfaceWrapper) BulkRestoreDrafts(c *gin.Context) {
+// SearchConversations operation middleware
+func (siw *ServerInterfaceWrapper) SearchConversations(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	var err error
 
-	siw.Handler.BulkRestoreDrafts(c)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchConversationsParams
 
-// SearchSessions operation middleware
-func (siw *ServerInterfaceWrapper) SearchSessions(c *gin.Context) {
+	// ------------- Required query parameter "q" -------------
 
-	var err error
+	if paramValue := c.Query("q"); paramValue != "" {
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params SearchSessionsParams
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-	// ------------- Optional query parameter "query" -------------
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "query", c.Request.URL.Query(), &params.Query)
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", c.Request.URL.Query(), &params.Role)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter query: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "eventType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventType", c.Request.URL.Query(), &params.EventType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter eventType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "toolName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "toolName", c.Request.URL.Query(), &params.ToolName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter toolName: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sessionId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sessionId", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", c.Request.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter projectId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", c.Request.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter after: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", c.Request.URL.Query(), &params.Before)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter before: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1447,6 +3374,14 @@ func (siw *ServerInterfaceWrapper) SearchSessions(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "matchesPerSession" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "matchesPerSession", c.Request.URL.Query(), &params.MatchesPerSession)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter matchesPerSession: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1454,22 +3389,24 @@ func (siw *ServerInterfaceWrapper) SearchSessions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SearchSessions(c, params)
+	siw.Handler.SearchConversations(c, params)
 }
 
-// GetSession operation middleware
-func (siw *ServerInterfaceWrapper) GetSession(c *gin.Context) {
+// GetDebugInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetDebugInfo(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id SessionId
+	siw.Handler.GetDebugInfo(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// CreateDirectory operation middleware
+func (siw *ServerInterfaceWrapper) CreateDirectory(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1478,22 +3415,24 @@ func (siw *ServerInterfaceWrapper) GetSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSession(c, id)
+	siw.Handler.CreateDirectory(c)
 }
 
-// UpdateSession operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSession(c *gin.Context) {
+// FuzzySearchFiles operation middleware
+func (siw *ServerInterfaceWrapper) FuzzySearchFiles(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id SessionId
+	siw.Handler.FuzzySearchFiles(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1502,22 +3441,24 @@ func (siw *ServerInterfaceWrapper) UpdateSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateSession(c, id)
+	siw.Handler.GetHealth(c)
 }
 
-// ContinueSession operation middleware
-func (siw *ServerInterfaceWrapper) ContinueSession(c *gin.Context) {
+// ListLabels operation middleware
+func (siw *ServerInterfaceWrapper) ListLabels(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id SessionId
+	siw.Handler.ListLabels(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
-	}
+// CreateLabel operation middleware
+func (siw *ServerInterfaceWrapper) CreateLabel(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1526,16 +3467,16 @@ func (siw *ServerInterfaceWrapper) ContinueSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ContinueSession(c, id)
+	siw.Handler.CreateLabel(c)
 }
 
-// HardDeleteEmptyDraftSession operation middleware
-func (siw *ServerInterfaceWrapper) HardDeleteEmptyDraftSession(c *gin.Context) {
+// DeleteLabel operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLabel(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id SessionId
+	var id LabelId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1550,23 +3491,38 @@ func (siw *ServerInterfaceWrapper) HardDeleteEmptyDraftSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.HardDeleteEmptyDraftSession(c, id)
+	siw.Handler.DeleteLabel(c, id)
 }
 
-// InterruptSession operation middleware
-func (siw *ServerInterfaceWrapper) InterruptSession(c *gin.Context) {
+// RunMaintenance operation middleware
+func (siw *ServerInterfaceWrapper) RunMaintenance(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id SessionId
+	siw.Handler.RunMaintenance(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
+// ListPipelineRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListPipelineRuns(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
+	siw.Handler.ListPipelineRuns(c)
+}
+
+// StartPipelineRun operation middleware
+func (siw *ServerInterfaceWrapper) StartPipelineRun(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1574,16 +3530,16 @@ func (siw *ServerInterfaceWrapper) InterruptSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.InterruptSession(c, id)
+	siw.Handler.StartPipelineRun(c)
 }
 
-// DeleteDraftSession operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDraftSession(c *gin.Context) {
+// GetPipelineRun operation middleware
+func (siw *ServerInterfaceWrapper) GetPipelineRun(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id SessionId
+	var id PipelineRunId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1598,16 +3554,16 @@ func (siw *ServerInterfaceWrapper) DeleteDraftSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteDraftSession(c, id)
+	siw.Handler.GetPipelineRun(c, id)
 }
 
-// LaunchDraftSession operation middleware
-func (siw *ServerInterfaceWrapper) LaunchDraftSession(c *gin.Context) {
+// PausePipelineRun operation middleware
+func (siw *ServerInterfaceWrapper) PausePipelineRun(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id SessionId
+	var id PipelineRunId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1622,16 +3578,16 @@ func (siw *ServerInterfaceWrapper) LaunchDraftSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.LaunchDraftSession(c, id)
+	siw.Handler.PausePipelineRun(c, id)
 }
 
-// GetSessionMessages operation middleware
-func (siw *ServerInterfaceWrapper) GetSessionMessages(c *gin.Context) {
+// ResumePipelineRun operation middleware
+func (siw *ServerInterfaceWrapper) ResumePipelineRun(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id SessionId
+	var id PipelineRunId
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -1646,23 +3602,38 @@ func (siw *ServerInterfaceWrapper) GetSessionMessages(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSessionMessages(c, id)
+	siw.Handler.ResumePipelineRun(c, id)
 }
 
-// GetSessionSnapshots operation middleware
-func (siw *ServerInterfaceWrapper) GetSessionSnapshots(c *gin.Context) {
+// TrustProjectConfig operation middleware
+func (siw *ServerInterfaceWrapper) TrustProjectConfig(c *gin.Context) {
 
-	var err error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id SessionId
+	siw.Handler.TrustProjectConfig(c)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
+// ValidateProjectConfig operation middleware
+func (siw *ServerInterfaceWrapper) ValidateProjectConfig(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
+	siw.Handler.ValidateProjectConfig(c)
+}
+
+// ListProjects operation middleware
+func (siw *ServerInterfaceWrapper) ListProjects(c *gin.Context) {
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1670,39 +3641,37 @@ func (siw *ServerInterfaceWrapper) GetSessionSnapshots(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSessionSnapshots(c, id)
+	siw.Handler.ListProjects(c)
 }
 
-// GetSlashCommands operation middleware
-func (siw *ServerInterfaceWrapper) GetSlashCommands(c *gin.Context) {
+// GetRecentPaths operation middleware
+func (siw *ServerInterfaceWrapper) GetRecentPaths(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSlashCommandsParams
-
-	// ------------- Required query parameter "working_dir" -------------
+	var params GetRecentPathsParams
 
-	if paramValue := c.Query("working_dir"); paramValue != "" {
+	// ------------- Optional query parameter "limit" -------------
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument working_dir is required, but not found"), http.StatusBadRequest)
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "working_dir", c.Request.URL.Query(), &params.WorkingDir)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter working_dir: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "query" -------------
+	siw.Handler.GetRecentPaths(c, params)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "query", c.Request.URL.Query(), &params.Query)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter query: %w", err), http.StatusBadRequest)
-		return
-	}
+// ListSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListSchedules(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1711,11 +3680,11 @@ func (siw *ServerInterfaceWrapper) GetSlashCommands(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSlashCommands(c, params)
+	siw.Handler.ListSchedules(c)
 }
 
-// GetUserSettings operation middleware
-func (siw *ServerInterfaceWrapper) GetUserSettings(c *gin.Context) {
+// CreateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateSchedule(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1724,11 +3693,22 @@ func (siw *ServerInterfaceWrapper) GetUserSettings(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUserSettings(c)
+	siw.Handler.CreateSchedule(c)
 }
 
-// UpdateUserSettings operation middleware
-func (siw *ServerInterfaceWrapper) UpdateUserSettings(c *gin.Context) {
+// DeleteSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSchedule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScheduleId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1737,11 +3717,22 @@ func (siw *ServerInterfaceWrapper) UpdateUserSettings(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateUserSettings(c)
+	siw.Handler.DeleteSchedule(c, id)
 }
 
-// ValidateDirectory operation middleware
-func (siw *ServerInterfaceWrapper) ValidateDirectory(c *gin.Context) {
+// GetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetSchedule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ScheduleId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1750,1144 +3741,4312 @@ func (siw *ServerInterfaceWrapper) ValidateDirectory(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ValidateDirectory(c)
+	siw.Handler.GetSchedule(c, id)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// UpdateSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchedule(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
+	// ------------- Path parameter "id" -------------
+	var id ScheduleId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	router.POST(options.BaseURL+"/agents/discover", wrapper.DiscoverAgents)
-	router.GET(options.BaseURL+"/approvals", wrapper.ListApprovals)
-	router.POST(options.BaseURL+"/approvals", wrapper.CreateApproval)
-	router.GET(options.BaseURL+"/approvals/:id", wrapper.GetApproval)
-	router.POST(options.BaseURL+"/approvals/:id/decide", wrapper.DecideApproval)
-	router.GET(options.BaseURL+"/config", wrapper.GetConfig)
-	router.PATCH(options.BaseURL+"/config", wrapper.UpdateConfig)
-	router.GET(options.BaseURL+"/debug-info", wrapper.GetDebugInfo)
-	router.POST(options.BaseURL+"/directories", wrapper.CreateDirectory)
-	router.POST(options.BaseURL+"/fuzzy-search/files", wrapper.FuzzySearchFiles)
-	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
-	router.GET(options.BaseURL+"/recent-paths", wrapper.GetRecentPaths)
-	router.GET(options.BaseURL+"/sessions", wrapper.ListSessions)
-	router.POST(options.BaseURL+"/sessions", wrapper.CreateSession)
-	router.POST(options.BaseURL+"/sessions/archive", wrapper.BulkArchiveSessions)
-	router.POST(options.BaseURL+"/sessions/restore", wrapper.BulkRestoreDrafts)
-	router.GET(options.BaseURL+"/sessions/search", wrapper.SearchSessions)
-	router.GET(options.BaseURL+"/sessions/:id", wrapper.GetSession)
-	router.PATCH(options.BaseURL+"/sessions/:id", wrapper.UpdateSession)
-	router.POST(options.BaseURL+"/sessions/:id/continue", wrapper.ContinueSession)
-	router.DELETE(options.BaseURL+"/sessions/:id/hard-delete-empty", wrapper.HardDeleteEmptyDraftSession)
-	router.POST(options.BaseURL+"/sessions/:id/interrupt", wrapper.InterruptSession)
-	router.DELETE(options.BaseURL+"/sessions/:id/launch", wrapper.DeleteDraftSession)
-	router.POST(options.BaseURL+"/sessions/:id/launch", wrapper.LaunchDraftSession)
-	router.GET(options.BaseURL+"/sessions/:id/messages", wrapper.GetSessionMessages)
-	router.GET(options.BaseURL+"/sessions/:id/snapshots", wrapper.GetSessionSnapshots)
-	router.GET(options.BaseURL+"/slash-commands", wrapper.GetSlashCommands)
-	router.GET(options.BaseURL+"/user-settings", wrapper.GetUserSettings)
-	router.PATCH(options.BaseURL+"/user-settings", wrapper.UpdateUserSettings)
-	router.POST(options.BaseURL+"/validate-directory", wrapper.ValidateDirectory)
+	siw.Handler.UpdateSchedule(c, id)
 }
 
-type BadRequestJSONResponse ErrorResponse
+// ListScheduleRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListScheduleRuns(c *gin.Context) {
 
-type InternalErrorJSONResponse ErrorResponse
+	var err error
 
-type NotFoundJSONResponse ErrorResponse
+	// ------------- Path parameter "id" -------------
+	var id ScheduleId
 
-type DiscoverAgentsRequestObject struct {
-	Body *DiscoverAgentsJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DiscoverAgentsResponseObject interface {
-	VisitDiscoverAgentsResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListScheduleRunsParams
 
-type DiscoverAgents200JSONResponse struct {
-	Agents []Agent `json:"agents"`
-}
+	// ------------- Optional query parameter "limit" -------------
 
-func (response DiscoverAgents200JSONResponse) VisitDiscoverAgentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListScheduleRuns(c, id, params)
 }
 
-type DiscoverAgents400JSONResponse struct{ BadRequestJSONResponse }
+// TriggerSchedule operation middleware
+func (siw *ServerInterfaceWrapper) TriggerSchedule(c *gin.Context) {
 
-func (response DiscoverAgents400JSONResponse) VisitDiscoverAgentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id ScheduleId
 
-type DiscoverAgents500JSONResponse struct{ InternalErrorJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DiscoverAgents500JSONResponse) VisitDiscoverAgentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.TriggerSchedule(c, id)
 }
 
-type ListApprovalsRequestObject struct {
-	Params ListApprovalsParams
-}
+// ListSavedFilters operation middleware
+func (siw *ServerInterfaceWrapper) ListSavedFilters(c *gin.Context) {
 
-type ListApprovalsResponseObject interface {
-	VisitListApprovalsResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListSavedFilters(c)
 }
 
-type ListApprovals200JSONResponse ApprovalsResponse
+// CreateSavedFilter operation middleware
+func (siw *ServerInterfaceWrapper) CreateSavedFilter(c *gin.Context) {
 
-func (response ListApprovals200JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreateSavedFilter(c)
 }
 
-type ListApprovals500JSONResponse struct{ InternalErrorJSONResponse }
+// DeleteSavedFilter operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSavedFilter(c *gin.Context) {
 
-func (response ListApprovals500JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SavedFilterId
 
-type CreateApprovalRequestObject struct {
-	Body *CreateApprovalJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreateApprovalResponseObject interface {
-	VisitCreateApprovalResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteSavedFilter(c, id)
 }
 
-type CreateApproval201JSONResponse CreateApprovalResponse
+// UpdateSavedFilter operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSavedFilter(c *gin.Context) {
 
-func (response CreateApproval201JSONResponse) VisitCreateApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SavedFilterId
 
-type CreateApproval400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response CreateApproval400JSONResponse) VisitCreateApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UpdateSavedFilter(c, id)
 }
 
-type CreateApproval500JSONResponse struct{ InternalErrorJSONResponse }
+// ListSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListSessions(c *gin.Context) {
 
-func (response CreateApproval500JSONResponse) VisitCreateApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSessionsParams
 
-type GetApprovalRequestObject struct {
-	Id ApprovalId `json:"id"`
-}
+	// ------------- Optional query parameter "leavesOnly" -------------
 
-type GetApprovalResponseObject interface {
-	VisitGetApprovalResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "leavesOnly", c.Request.URL.Query(), &params.LeavesOnly)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter leavesOnly: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetApproval200JSONResponse ApprovalResponse
+	// ------------- Optional query parameter "filter" -------------
 
-func (response GetApproval200JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "status" -------------
 
-type GetApproval404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetApproval404JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "label" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "label", c.Request.URL.Query(), &params.Label)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter label: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetApproval500JSONResponse struct{ InternalErrorJSONResponse }
+	// ------------- Optional query parameter "projectId" -------------
 
-func (response GetApproval500JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "projectId", c.Request.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter projectId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "model" -------------
 
-type DecideApprovalRequestObject struct {
-	Id   ApprovalId `json:"id"`
-	Body *DecideApprovalJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "model", c.Request.URL.Query(), &params.Model)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter model: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DecideApprovalResponseObject interface {
-	VisitDecideApprovalResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "createdAfter" -------------
 
-type DecideApproval200JSONResponse DecideApprovalResponse
-
-func (response DecideApproval200JSONResponse) VisitDecideApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "createdAfter", c.Request.URL.Query(), &params.CreatedAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdAfter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DecideApproval400JSONResponse ErrorResponse
+	// ------------- Optional query parameter "createdBefore" -------------
 
-func (response DecideApproval400JSONResponse) VisitDecideApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "createdBefore", c.Request.URL.Query(), &params.CreatedBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter createdBefore: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "minCostUsd" -------------
 
-type DecideApproval404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "minCostUsd", c.Request.URL.Query(), &params.MinCostUsd)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minCostUsd: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DecideApproval404JSONResponse) VisitDecideApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "maxCostUsd" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "maxCostUsd", c.Request.URL.Query(), &params.MaxCostUsd)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter maxCostUsd: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DecideApproval500JSONResponse struct{ InternalErrorJSONResponse }
+	// ------------- Optional query parameter "savedFilterId" -------------
 
-func (response DecideApproval500JSONResponse) VisitDecideApprovalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "savedFilterId", c.Request.URL.Query(), &params.SavedFilterId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter savedFilterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetConfigRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetConfigResponseObject interface {
-	VisitGetConfigResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type GetConfig200JSONResponse ConfigResponse
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetConfig200JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListSessions(c, params)
 }
 
-type GetConfig500JSONResponse struct{ InternalErrorJSONResponse }
-
-func (response GetConfig500JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// CreateSession operation middleware
+func (siw *ServerInterfaceWrapper) CreateSession(c *gin.Context) {
 
-type UpdateConfigRequestObject struct {
-	Body *UpdateConfigJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type UpdateConfigResponseObject interface {
-	VisitUpdateConfigResponse(w http.ResponseWriter) error
+	siw.Handler.CreateSession(c)
 }
 
-type UpdateConfig200JSONResponse ConfigResponse
+// BulkArchiveSessions operation middleware
+func (siw *ServerInterfaceWrapper) BulkArchiveSessions(c *gin.Context) {
 
-func (response UpdateConfig200JSONResponse) VisitUpdateConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.BulkArchiveSessions(c)
 }
 
-type UpdateConfig400JSONResponse struct{ BadRequestJSONResponse }
+// ImportSessionBundle operation middleware
+func (siw *ServerInterfaceWrapper) ImportSessionBundle(c *gin.Context) {
 
-func (response UpdateConfig400JSONResponse) VisitUpdateConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ImportSessionBundle(c)
 }
 
-type UpdateConfig500JSONResponse struct{ InternalErrorJSONResponse }
+// BulkRestoreDrafts operation middleware
+func (siw *ServerInterfaceWrapper) BulkRestoreDrafts(c *gin.Context) {
 
-func (response UpdateConfig500JSONResponse) VisitUpdateConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.BulkRestoreDrafts(c)
 }
 
-type GetDebugInfoRequestObject struct {
-}
+// SearchSessions operation middleware
+func (siw *ServerInterfaceWrapper) SearchSessions(c *gin.Context) {
 
-type GetDebugInfoResponseObject interface {
-	VisitGetDebugInfoResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetDebugInfo200JSONResponse DebugInfoResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchSessionsParams
 
-func (response GetDebugInfo200JSONResponse) VisitGetDebugInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "query" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "query", c.Request.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter query: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreateDirectoryRequestObject struct {
-	Body *CreateDirectoryJSONRequestBody
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type CreateDirectoryResponseObject interface {
-	VisitCreateDirectoryResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreateDirectory200JSONResponse struct {
-	// Created Whether the directory was created
-	Created *bool `json:"created,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	// Path The created directory path
-	Path *string `json:"path,omitempty"`
+	siw.Handler.SearchSessions(c, params)
 }
 
-func (response CreateDirectory200JSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetSession operation middleware
+func (siw *ServerInterfaceWrapper) GetSession(c *gin.Context) {
 
-type CreateDirectory500JSONResponse struct{ InternalErrorJSONResponse }
+	var err error
 
-func (response CreateDirectory500JSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type FuzzySearchFilesRequestObject struct {
-	Body *FuzzySearchFilesJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type FuzzySearchFilesResponseObject interface {
-	VisitFuzzySearchFilesResponse(w http.ResponseWriter) error
+	siw.Handler.GetSession(c, id)
 }
 
-type FuzzySearchFiles200JSONResponse FuzzySearchFilesResponse
+// UpdateSession operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSession(c *gin.Context) {
 
-func (response FuzzySearchFiles200JSONResponse) VisitFuzzySearchFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-type FuzzySearchFiles400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response FuzzySearchFiles400JSONResponse) VisitFuzzySearchFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UpdateSession(c, id)
 }
 
-type FuzzySearchFiles500JSONResponse struct{ InternalErrorJSONResponse }
+// ExportSessionBundle operation middleware
+func (siw *ServerInterfaceWrapper) ExportSessionBundle(c *gin.Context) {
 
-func (response FuzzySearchFiles500JSONResponse) VisitFuzzySearchFilesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-type GetHealthRequestObject struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportSessionBundleParams
 
-type GetHealth200JSONResponse HealthResponse
+	// ------------- Optional query parameter "include_raw_events" -------------
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "include_raw_events", c.Request.URL.Query(), &params.IncludeRawEvents)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_raw_events: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetRecentPathsRequestObject struct {
-	Params GetRecentPathsParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetRecentPathsResponseObject interface {
-	VisitGetRecentPathsResponse(w http.ResponseWriter) error
+	siw.Handler.ExportSessionBundle(c, id, params)
 }
 
-type GetRecentPaths200JSONResponse RecentPathsResponse
-
-func (response GetRecentPaths200JSONResponse) VisitGetRecentPathsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// CompactSession operation middleware
+func (siw *ServerInterfaceWrapper) CompactSession(c *gin.Context) {
 
-type GetRecentPaths500JSONResponse struct{ InternalErrorJSONResponse }
+	var err error
 
-func (response GetRecentPaths500JSONResponse) VisitGetRecentPathsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ListSessionsRequestObject struct {
-	Params ListSessionsParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ListSessionsResponseObject interface {
-	VisitListSessionsResponse(w http.ResponseWriter) error
+	siw.Handler.CompactSession(c, id)
 }
 
-type ListSessions200JSONResponse SessionsResponse
+// ContinueSession operation middleware
+func (siw *ServerInterfaceWrapper) ContinueSession(c *gin.Context) {
 
-func (response ListSessions200JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-type ListSessions500JSONResponse struct{ InternalErrorJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListSessions500JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ContinueSession(c, id)
 }
 
-type CreateSessionRequestObject struct {
-	Body *CreateSessionJSONRequestBody
-}
+// GetSessionEffectiveConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionEffectiveConfig(c *gin.Context) {
 
-type CreateSessionResponseObject interface {
-	VisitCreateSessionResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type CreateSession201JSONResponse CreateSessionResponse
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-func (response CreateSession201JSONResponse) VisitCreateSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CreateSession400JSONResponse struct{ BadRequestJSONResponse }
+	siw.Handler.GetSessionEffectiveConfig(c, id)
+}
 
-func (response CreateSession400JSONResponse) VisitCreateSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ExportSessionConversation operation middleware
+func (siw *ServerInterfaceWrapper) ExportSessionConversation(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CreateSession422JSONResponse DirectoryNotFoundResponse
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-func (response CreateSession422JSONResponse) VisitCreateSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportSessionConversationParams
 
-type CreateSession500JSONResponse struct{ InternalErrorJSONResponse }
+	// ------------- Optional query parameter "format" -------------
 
-func (response CreateSession500JSONResponse) VisitCreateSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "max_tool_result_bytes" -------------
 
-type BulkArchiveSessionsRequestObject struct {
-	Body *BulkArchiveSessionsJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "max_tool_result_bytes", c.Request.URL.Query(), &params.MaxToolResultBytes)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter max_tool_result_bytes: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type BulkArchiveSessionsResponseObject interface {
-	VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "max_tool_result_lines" -------------
 
-type BulkArchiveSessions200JSONResponse BulkArchiveResponse
+	err = runtime.BindQueryParameter("form", true, false, "max_tool_result_lines", c.Request.URL.Query(), &params.MaxToolResultLines)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter max_tool_result_lines: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response BulkArchiveSessions200JSONResponse) VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ExportSessionConversation(c, id, params)
 }
 
-type BulkArchiveSessions207JSONResponse BulkArchiveResponse
+// HardDeleteEmptyDraftSession operation middleware
+func (siw *ServerInterfaceWrapper) HardDeleteEmptyDraftSession(c *gin.Context) {
 
-func (response BulkArchiveSessions207JSONResponse) VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(207)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-type BulkArchiveSessions400JSONResponse struct{ BadRequestJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response BulkArchiveSessions400JSONResponse) VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.HardDeleteEmptyDraftSession(c, id)
 }
 
-type BulkArchiveSessions500JSONResponse struct{ InternalErrorJSONResponse }
+// InterruptSession operation middleware
+func (siw *ServerInterfaceWrapper) InterruptSession(c *gin.Context) {
 
-func (response BulkArchiveSessions500JSONResponse) VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-type BulkRestoreDraftsRequestObject struct {
-	Body *BulkRestoreDraftsJSONRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type BulkRestoreDraftsResponseObject interface {
-	VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.InterruptSession(c, id)
 }
 
-type BulkRestoreDrafts200JSONResponse BulkRestoreDraftsResponse
+// SetSessionLabels operation middleware
+func (siw *ServerInterfaceWrapper) SetSessionLabels(c *gin.Context) {
 
-func (response BulkRestoreDrafts200JSONResponse) VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-type BulkRestoreDrafts207JSONResponse BulkRestoreDraftsResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response BulkRestoreDrafts207JSONResponse) VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(207)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.SetSessionLabels(c, id)
 }
 
-type BulkRestoreDrafts400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response BulkRestoreDrafts400JSONResponse) VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DeleteDraftSession operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDraftSession(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type BulkRestoreDrafts500JSONResponse struct{ InternalErrorJSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-func (response BulkRestoreDrafts500JSONResponse) VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type SearchSessionsRequestObject struct {
-	Params SearchSessionsParams
+	siw.Handler.DeleteDraftSession(c, id)
 }
 
-type SearchSessionsResponseObject interface {
-	VisitSearchSessionsResponse(w http.ResponseWriter) error
-}
+// LaunchDraftSession operation middleware
+func (siw *ServerInterfaceWrapper) LaunchDraftSession(c *gin.Context) {
 
-type SearchSessions200JSONResponse SessionSearchResponse
+	var err error
 
-func (response SearchSessions200JSONResponse) VisitSearchSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-	return json.NewEncoder(w).Encode(response)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.LaunchDraftSession(c, id)
 }
 
-type SearchSessions400JSONResponse struct{ BadRequestJSONResponse }
+// GetSessionMessages operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionMessages(c *gin.Context) {
 
-func (response SearchSessions400JSONResponse) VisitSearchSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-type SearchSessions500JSONResponse struct{ InternalErrorJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response SearchSessions500JSONResponse) VisitSearchSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSessionMessagesParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type GetSessionRequestObject struct {
-	Id SessionId `json:"id"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetSessionResponseObject interface {
-	VisitGetSessionResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "cursor" -------------
 
-type GetSession200JSONResponse SessionResponse
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetSession200JSONResponse) VisitGetSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "since_sequence" -------------
 
-	return json.NewEncoder(w).Encode(response)
+	err = runtime.BindQueryParameter("form", true, false, "since_sequence", c.Request.URL.Query(), &params.SinceSequence)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since_sequence: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSessionMessages(c, id, params)
 }
 
-type GetSession404JSONResponse struct{ NotFoundJSONResponse }
+// GetSessionResources operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionResources(c *gin.Context) {
 
-func (response GetSession404JSONResponse) VisitGetSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-type GetSession500JSONResponse struct{ InternalErrorJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetSession500JSONResponse) VisitGetSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSessionResourcesParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type UpdateSessionRequestObject struct {
-	Id   SessionId `json:"id"`
-	Body *UpdateSessionJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type UpdateSessionResponseObject interface {
-	VisitUpdateSessionResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSessionResources(c, id, params)
 }
 
-type UpdateSession200JSONResponse SessionResponse
+// GetSessionSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionSnapshots(c *gin.Context) {
 
-func (response UpdateSession200JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id SessionId
 
-type UpdateSession400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdateSession400JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetSessionSnapshots(c, id)
 }
 
-type UpdateSession404JSONResponse struct{ NotFoundJSONResponse }
+// GetSlashCommands operation middleware
+func (siw *ServerInterfaceWrapper) GetSlashCommands(c *gin.Context) {
 
-func (response UpdateSession404JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSlashCommandsParams
 
-type UpdateSession500JSONResponse struct{ InternalErrorJSONResponse }
+	// ------------- Required query parameter "working_dir" -------------
 
-func (response UpdateSession500JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	if paramValue := c.Query("working_dir"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument working_dir is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type ContinueSessionRequestObject struct {
-	Id   SessionId `json:"id"`
-	Body *ContinueSessionJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, true, "working_dir", c.Request.URL.Query(), &params.WorkingDir)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter working_dir: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ContinueSessionResponseObject interface {
-	VisitContinueSessionResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "query" -------------
 
-type ContinueSession201JSONResponse ContinueSessionResponse
+	err = runtime.BindQueryParameter("form", true, false, "query", c.Request.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter query: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ContinueSession201JSONResponse) VisitContinueSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetSlashCommands(c, params)
 }
 
-type ContinueSession404JSONResponse struct{ NotFoundJSONResponse }
+// ListTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListTemplates(c *gin.Context) {
 
-func (response ContinueSession404JSONResponse) VisitContinueSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListTemplates(c)
 }
 
-type ContinueSession500JSONResponse struct{ InternalErrorJSONResponse }
+// CreateTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateTemplate(c *gin.Context) {
 
-func (response ContinueSession500JSONResponse) VisitContinueSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreateTemplate(c)
 }
 
-type HardDeleteEmptyDraftSessionRequestObject struct {
-	Id SessionId `json:"id"`
-}
+// ImportTemplate operation middleware
+func (siw *ServerInterfaceWrapper) ImportTemplate(c *gin.Context) {
 
-type HardDeleteEmptyDraftSessionResponseObject interface {
-	VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type HardDeleteEmptyDraftSession204Response struct {
+	siw.Handler.ImportTemplate(c)
 }
 
-func (response HardDeleteEmptyDraftSession204Response) VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// DeleteTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTemplate(c *gin.Context) {
 
-type HardDeleteEmptyDraftSession400JSONResponse ErrorResponse
-
-func (response HardDeleteEmptyDraftSession400JSONResponse) VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id TemplateId
 
-type HardDeleteEmptyDraftSession404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response HardDeleteEmptyDraftSession404JSONResponse) VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DeleteTemplate(c, id)
 }
 
-type HardDeleteEmptyDraftSession500JSONResponse struct{ InternalErrorJSONResponse }
+// GetTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplate(c *gin.Context) {
 
-func (response HardDeleteEmptyDraftSession500JSONResponse) VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id TemplateId
 
-type InterruptSessionRequestObject struct {
-	Id SessionId `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type InterruptSessionResponseObject interface {
-	VisitInterruptSessionResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTemplate(c, id)
 }
 
-type InterruptSession200JSONResponse InterruptSessionResponse
+// UpdateTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTemplate(c *gin.Context) {
 
-func (response InterruptSession200JSONResponse) VisitInterruptSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id TemplateId
 
-type InterruptSession400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response InterruptSession400JSONResponse) VisitInterruptSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UpdateTemplate(c, id)
 }
 
-type InterruptSession404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response InterruptSession404JSONResponse) VisitInterruptSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// ExportTemplate operation middleware
+func (siw *ServerInterfaceWrapper) ExportTemplate(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type InterruptSession500JSONResponse struct{ InternalErrorJSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id TemplateId
 
-func (response InterruptSession500JSONResponse) VisitInterruptSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type DeleteDraftSessionRequestObject struct {
-	Id SessionId `json:"id"`
+	siw.Handler.ExportTemplate(c, id)
 }
 
-type DeleteDraftSessionResponseObject interface {
-	VisitDeleteDraftSessionResponse(w http.ResponseWriter) error
-}
+// LaunchTemplate operation middleware
+func (siw *ServerInterfaceWrapper) LaunchTemplate(c *gin.Context) {
 
-type DeleteDraftSession204Response struct {
-}
+	var err error
 
-func (response DeleteDraftSession204Response) VisitDeleteDraftSessionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Path parameter "id" -------------
+	var id TemplateId
 
-type DeleteDraftSession400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DeleteDraftSession400JSONResponse) VisitDeleteDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.LaunchTemplate(c, id)
 }
 
-type DeleteDraftSession404JSONResponse struct{ NotFoundJSONResponse }
+// GetUserSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSettings(c *gin.Context) {
 
-func (response DeleteDraftSession404JSONResponse) VisitDeleteDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetUserSettings(c)
 }
 
-type DeleteDraftSession500JSONResponse struct{ InternalErrorJSONResponse }
+// UpdateUserSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUserSettings(c *gin.Context) {
 
-func (response DeleteDraftSession500JSONResponse) VisitDeleteDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UpdateUserSettings(c)
 }
 
-type LaunchDraftSessionRequestObject struct {
-	Id   SessionId `json:"id"`
-	Body *LaunchDraftSessionJSONRequestBody
-}
+// ValidateDirectory operation middleware
+func (siw *ServerInterfaceWrapper) ValidateDirectory(c *gin.Context) {
 
-type LaunchDraftSessionResponseObject interface {
-	VisitLaunchDraftSessionResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ValidateDirectory(c)
 }
 
-type LaunchDraftSession200JSONResponse SessionResponse
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(c *gin.Context) {
 
-func (response LaunchDraftSession200JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.ListWebhooks(c)
 }
 
-type LaunchDraftSession400JSONResponse ErrorResponse
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(c *gin.Context) {
 
-func (response LaunchDraftSession400JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.CreateWebhook(c)
 }
 
-type LaunchDraftSession404JSONResponse struct{ NotFoundJSONResponse }
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(c *gin.Context) {
 
-func (response LaunchDraftSession404JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id WebhookId
 
-type LaunchDraftSession422JSONResponse DirectoryNotFoundResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response LaunchDraftSession422JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DeleteWebhook(c, id)
 }
 
-type LaunchDraftSession500JSONResponse struct{ InternalErrorJSONResponse }
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(c *gin.Context) {
 
-func (response LaunchDraftSession500JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id WebhookId
 
-type GetSessionMessagesRequestObject struct {
-	Id SessionId `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetSessionMessagesResponseObject interface {
-	VisitGetSessionMessagesResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWebhook(c, id)
 }
 
-type GetSessionMessages200JSONResponse ConversationResponse
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(c *gin.Context) {
 
-func (response GetSessionMessages200JSONResponse) VisitGetSessionMessagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id WebhookId
 
-type GetSessionMessages404JSONResponse struct{ NotFoundJSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetSessionMessages404JSONResponse) VisitGetSessionMessagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.UpdateWebhook(c, id)
 }
 
-type GetSessionMessages500JSONResponse struct{ InternalErrorJSONResponse }
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(c *gin.Context) {
 
-func (response GetSessionMessages500JSONResponse) VisitGetSessionMessagesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "id" -------------
+	var id WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListWebhookDeliveries(c, id, params)
 }
 
-type GetSessionSnapshotsRequestObject struct {
-	Id SessionId `json:"id"`
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
 }
 
-type GetSessionSnapshotsResponseObject interface {
-	VisitGetSessionSnapshotsResponse(w http.ResponseWriter) error
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
 }
 
-type GetSessionSnapshots200JSONResponse SnapshotsResponse
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
 
-func (response GetSessionSnapshots200JSONResponse) VisitGetSessionSnapshotsResponse(w http.ResponseWriter) error {
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.POST(options.BaseURL+"/agents/discover", wrapper.DiscoverAgents)
+	router.GET(options.BaseURL+"/approvals", wrapper.ListApprovals)
+	router.POST(options.BaseURL+"/approvals", wrapper.CreateApproval)
+	router.GET(options.BaseURL+"/approvals/:id", wrapper.GetApproval)
+	router.POST(options.BaseURL+"/approvals/:id/decide", wrapper.DecideApproval)
+	router.GET(options.BaseURL+"/backups", wrapper.ListBackups)
+	router.POST(options.BaseURL+"/backups", wrapper.CreateBackup)
+	router.POST(options.BaseURL+"/backups/:name/restore", wrapper.RestoreBackup)
+	router.GET(options.BaseURL+"/batches", wrapper.ListBatches)
+	router.POST(options.BaseURL+"/batches", wrapper.LaunchBatch)
+	router.GET(options.BaseURL+"/batches/:id", wrapper.GetBatch)
+	router.GET(options.BaseURL+"/batches/:id/compare", wrapper.CompareBatch)
+	router.GET(options.BaseURL+"/config", wrapper.GetConfig)
+	router.PATCH(options.BaseURL+"/config", wrapper.UpdateConfig)
+	router.GET(options.BaseURL+"/conversations/search", wrapper.SearchConversations)
+	router.GET(options.BaseURL+"/debug-info", wrapper.GetDebugInfo)
+	router.POST(options.BaseURL+"/directories", wrapper.CreateDirectory)
+	router.POST(options.BaseURL+"/fuzzy-search/files", wrapper.FuzzySearchFiles)
+	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
+	router.GET(options.BaseURL+"/labels", wrapper.ListLabels)
+	router.POST(options.BaseURL+"/labels", wrapper.CreateLabel)
+	router.DELETE(options.BaseURL+"/labels/:id", wrapper.DeleteLabel)
+	router.POST(options.BaseURL+"/maintenance", wrapper.RunMaintenance)
+	router.GET(options.BaseURL+"/pipeline-runs", wrapper.ListPipelineRuns)
+	router.POST(options.BaseURL+"/pipeline-runs", wrapper.StartPipelineRun)
+	router.GET(options.BaseURL+"/pipeline-runs/:id", wrapper.GetPipelineRun)
+	router.POST(options.BaseURL+"/pipeline-runs/:id/pause", wrapper.PausePipelineRun)
+	router.POST(options.BaseURL+"/pipeline-runs/:id/resume", wrapper.ResumePipelineRun)
+	router.POST(options.BaseURL+"/project-config/trust", wrapper.TrustProjectConfig)
+	router.POST(options.BaseURL+"/project-config/validate", wrapper.ValidateProjectConfig)
+	router.GET(options.BaseURL+"/projects", wrapper.ListProjects)
+	router.GET(options.BaseURL+"/recent-paths", wrapper.GetRecentPaths)
+	router.GET(options.BaseURL+"/schedules", wrapper.ListSchedules)
+	router.POST(options.BaseURL+"/schedules", wrapper.CreateSchedule)
+	router.DELETE(options.BaseURL+"/schedules/:id", wrapper.DeleteSchedule)
+	router.GET(options.BaseURL+"/schedules/:id", wrapper.GetSchedule)
+	router.PATCH(options.BaseURL+"/schedules/:id", wrapper.UpdateSchedule)
+	router.GET(options.BaseURL+"/schedules/:id/runs", wrapper.ListScheduleRuns)
+	router.POST(options.BaseURL+"/schedules/:id/trigger", wrapper.TriggerSchedule)
+	router.GET(options.BaseURL+"/session-filters", wrapper.ListSavedFilters)
+	router.POST(options.BaseURL+"/session-filters", wrapper.CreateSavedFilter)
+	router.DELETE(options.BaseURL+"/session-filters/:id", wrapper.DeleteSavedFilter)
+	router.PUT(options.BaseURL+"/session-filters/:id", wrapper.UpdateSavedFilter)
+	router.GET(options.BaseURL+"/sessions", wrapper.ListSessions)
+	router.POST(options.BaseURL+"/sessions", wrapper.CreateSession)
+	router.POST(options.BaseURL+"/sessions/archive", wrapper.BulkArchiveSessions)
+	router.POST(options.BaseURL+"/sessions/import", wrapper.ImportSessionBundle)
+	router.POST(options.BaseURL+"/sessions/restore", wrapper.BulkRestoreDrafts)
+	router.GET(options.BaseURL+"/sessions/search", wrapper.SearchSessions)
+	router.GET(options.BaseURL+"/sessions/:id", wrapper.GetSession)
+	router.PATCH(options.BaseURL+"/sessions/:id", wrapper.UpdateSession)
+	router.GET(options.BaseURL+"/sessions/:id/bundle", wrapper.ExportSessionBundle)
+	router.POST(options.BaseURL+"/sessions/:id/compact", wrapper.CompactSession)
+	router.POST(options.BaseURL+"/sessions/:id/continue", wrapper.ContinueSession)
+	router.GET(options.BaseURL+"/sessions/:id/effective-config", wrapper.GetSessionEffectiveConfig)
+	router.GET(options.BaseURL+"/sessions/:id/export", wrapper.ExportSessionConversation)
+	router.DELETE(options.BaseURL+"/sessions/:id/hard-delete-empty", wrapper.HardDeleteEmptyDraftSession)
+	router.POST(options.BaseURL+"/sessions/:id/interrupt", wrapper.InterruptSession)
+	router.PUT(options.BaseURL+"/sessions/:id/labels", wrapper.SetSessionLabels)
+	router.DELETE(options.BaseURL+"/sessions/:id/launch", wrapper.DeleteDraftSession)
+	router.POST(options.BaseURL+"/sessions/:id/launch", wrapper.LaunchDraftSession)
+	router.GET(options.BaseURL+"/sessions/:id/messages", wrapper.GetSessionMessages)
+	router.GET(options.BaseURL+"/sessions/:id/resources", wrapper.GetSessionResources)
+	router.GET(options.BaseURL+"/sessions/:id/snapshots", wrapper.GetSessionSnapshots)
+	router.GET(options.BaseURL+"/slash-commands", wrapper.GetSlashCommands)
+	router.GET(options.BaseURL+"/templates", wrapper.ListTemplates)
+	router.POST(options.BaseURL+"/templates", wrapper.CreateTemplate)
+	router.POST(options.BaseURL+"/templates/import", wrapper.ImportTemplate)
+	router.DELETE(options.BaseURL+"/templates/:id", wrapper.DeleteTemplate)
+	router.GET(options.BaseURL+"/templates/:id", wrapper.GetTemplate)
+	router.PUT(options.BaseURL+"/templates/:id", wrapper.UpdateTemplate)
+	router.GET(options.BaseURL+"/templates/:id/export", wrapper.ExportTemplate)
+	router.POST(options.BaseURL+"/templates/:id/sessions", wrapper.LaunchTemplate)
+	router.GET(options.BaseURL+"/user-settings", wrapper.GetUserSettings)
+	router.PATCH(options.BaseURL+"/user-settings", wrapper.UpdateUserSettings)
+	router.POST(options.BaseURL+"/validate-directory", wrapper.ValidateDirectory)
+	router.GET(options.BaseURL+"/webhooks", wrapper.ListWebhooks)
+	router.POST(options.BaseURL+"/webhooks", wrapper.CreateWebhook)
+	router.DELETE(options.BaseURL+"/webhooks/:id", wrapper.DeleteWebhook)
+	router.GET(options.BaseURL+"/webhooks/:id", wrapper.GetWebhook)
+	router.PATCH(options.BaseURL+"/webhooks/:id", wrapper.UpdateWebhook)
+	router.GET(options.BaseURL+"/webhooks/:id/deliveries", wrapper.ListWebhookDeliveries)
+}
+
+type BadRequestJSONResponse ErrorResponse
+
+type InternalErrorJSONResponse ErrorResponse
+
+type NotFoundJSONResponse ErrorResponse
+
+type DiscoverAgentsRequestObject struct {
+	Body *DiscoverAgentsJSONRequestBody
+}
+
+type DiscoverAgentsResponseObject interface {
+	VisitDiscoverAgentsResponse(w http.ResponseWriter) error
+}
+
+type DiscoverAgents200JSONResponse struct {
+	Agents []Agent `json:"agents"`
+}
+
+func (response DiscoverAgents200JSONResponse) VisitDiscoverAgentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSessionSnapshots404JSONResponse struct{ NotFoundJSONResponse }
+type DiscoverAgents400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetSessionSnapshots404JSONResponse) VisitGetSessionSnapshotsResponse(w http.ResponseWriter) error {
+func (response DiscoverAgents400JSONResponse) VisitDiscoverAgentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSessionSnapshots500JSONResponse struct{ InternalErrorJSONResponse }
+type DiscoverAgents500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response GetSessionSnapshots500JSONResponse) VisitGetSessionSnapshotsResponse(w http.ResponseWriter) error {
+func (response DiscoverAgents500JSONResponse) VisitDiscoverAgentsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSlashCommandsRequestObject struct {
-	Params GetSlashCommandsParams
+type ListApprovalsRequestObject struct {
+	Params ListApprovalsParams
 }
 
-type GetSlashCommandsResponseObject interface {
-	VisitGetSlashCommandsResponse(w http.ResponseWriter) error
+type ListApprovalsResponseObject interface {
+	VisitListApprovalsResponse(w http.ResponseWriter) error
 }
 
-type GetSlashCommands200JSONResponse SlashCommandsResponse
+type ListApprovals200JSONResponse ApprovalsResponse
 
-func (response GetSlashCommands200JSONResponse) VisitGetSlashCommandsResponse(w http.ResponseWriter) error {
+func (response ListApprovals200JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSlashCommands400JSONResponse struct{ BadRequestJSONResponse }
+type ListApprovals500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response GetSlashCommands400JSONResponse) VisitGetSlashCommandsResponse(w http.ResponseWriter) error {
+func (response ListApprovals500JSONResponse) VisitListApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApprovalRequestObject struct {
+	Body *CreateApprovalJSONRequestBody
+}
+
+type CreateApprovalResponseObject interface {
+	VisitCreateApprovalResponse(w http.ResponseWriter) error
+}
+
+type CreateApproval201JSONResponse CreateApprovalResponse
+
+func (response CreateApproval201JSONResponse) VisitCreateApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateApproval400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateApproval400JSONResponse) VisitCreateApprovalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSlashCommands500JSONResponse struct{ InternalErrorJSONResponse }
+type CreateApproval500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response GetSlashCommands500JSONResponse) VisitGetSlashCommandsResponse(w http.ResponseWriter) error {
+func (response CreateApproval500JSONResponse) VisitCreateApprovalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUserSettingsRequestObject struct {
+type GetApprovalRequestObject struct {
+	Id ApprovalId `json:"id"`
 }
 
-type GetUserSettingsResponseObject interface {
-	VisitGetUserSettingsResponse(w http.ResponseWriter) error
+type GetApprovalResponseObject interface {
+	VisitGetApprovalResponse(w http.ResponseWriter) error
 }
 
-type GetUserSettings200JSONResponse UserSettingsResponse
+type GetApproval200JSONResponse ApprovalResponse
 
-func (response GetUserSettings200JSONResponse) VisitGetUserSettingsResponse(w http.ResponseWriter) error {
+func (response GetApproval200JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUserSettings500JSONResponse struct{ InternalErrorJSONResponse }
+type GetApproval404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetUserSettings500JSONResponse) VisitGetUserSettingsResponse(w http.ResponseWriter) error {
+func (response GetApproval404JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApproval500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetApproval500JSONResponse) VisitGetApprovalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUserSettingsRequestObject struct {
-	Body *UpdateUserSettingsJSONRequestBody
+type DecideApprovalRequestObject struct {
+	Id   ApprovalId `json:"id"`
+	Body *DecideApprovalJSONRequestBody
 }
 
-type UpdateUserSettingsResponseObject interface {
-	VisitUpdateUserSettingsResponse(w http.ResponseWriter) error
+type DecideApprovalResponseObject interface {
+	VisitDecideApprovalResponse(w http.ResponseWriter) error
 }
 
-type UpdateUserSettings200JSONResponse UserSettingsResponse
+type DecideApproval200JSONResponse DecideApprovalResponse
 
-func (response UpdateUserSettings200JSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+func (response DecideApproval200JSONResponse) VisitDecideApprovalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUserSettings400JSONResponse struct{ BadRequestJSONResponse }
+type DecideApproval400JSONResponse ErrorResponse
 
-func (response UpdateUserSettings400JSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+func (response DecideApproval400JSONResponse) VisitDecideApprovalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateUserSettings500JSONResponse struct{ InternalErrorJSONResponse }
+type DecideApproval404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UpdateUserSettings500JSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+func (response DecideApproval404JSONResponse) VisitDecideApprovalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DecideApproval500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DecideApproval500JSONResponse) VisitDecideApprovalResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ValidateDirectoryRequestObject struct {
-	Body *ValidateDirectoryJSONRequestBody
+type ListBackupsRequestObject struct {
 }
 
-type ValidateDirectoryResponseObject interface {
-	VisitValidateDirectoryResponse(w http.ResponseWriter) error
+type ListBackupsResponseObject interface {
+	VisitListBackupsResponse(w http.ResponseWriter) error
 }
 
-type ValidateDirectory200JSONResponse ValidateDirectoryResponse
+type ListBackups200JSONResponse BackupsResponse
 
-func (response ValidateDirectory200JSONResponse) VisitValidateDirectoryResponse(w http.ResponseWriter) error {
+func (response ListBackups200JSONResponse) VisitListBackupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ValidateDirectory500JSONResponse struct{ InternalErrorJSONResponse }
+type ListBackups500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response ValidateDirectory500JSONResponse) VisitValidateDirectoryResponse(w http.ResponseWriter) error {
+func (response ListBackups500JSONResponse) VisitListBackupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Discover available agents
-	// (POST /agents/discover)
-	DiscoverAgents(ctx context.Context, request DiscoverAgentsRequestObject) (DiscoverAgentsResponseObject, error)
-	// List approval requests
-	// (GET /approvals)
-	ListApprovals(ctx context.Context, request ListApprovalsRequestObject) (ListApprovalsResponseObject, error)
-	// Create approval request
-	// (POST /approvals)
-	CreateApproval(ctx context.Context, request CreateApprovalRequestObject) (CreateApprovalResponseObject, error)
-	// Get approval details
+type CreateBackupRequestObject struct {
+}
+
+type CreateBackupResponseObject interface {
+	VisitCreateBackupResponse(w http.ResponseWriter) error
+}
+
+type CreateBackup201JSONResponse BackupResponse
+
+func (response CreateBackup201JSONResponse) VisitCreateBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBackup500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateBackup500JSONResponse) VisitCreateBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreBackupRequestObject struct {
+	Name string `json:"name"`
+}
+
+type RestoreBackupResponseObject interface {
+	VisitRestoreBackupResponse(w http.ResponseWriter) error
+}
+
+type RestoreBackup200JSONResponse RestoreBackupResponse
+
+func (response RestoreBackup200JSONResponse) VisitRestoreBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreBackup400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RestoreBackup400JSONResponse) VisitRestoreBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreBackup404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestoreBackup404JSONResponse) VisitRestoreBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreBackup500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RestoreBackup500JSONResponse) VisitRestoreBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBatchesRequestObject struct {
+}
+
+type ListBatchesResponseObject interface {
+	VisitListBatchesResponse(w http.ResponseWriter) error
+}
+
+type ListBatches200JSONResponse BatchGroupsResponse
+
+func (response ListBatches200JSONResponse) VisitListBatchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBatches500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListBatches500JSONResponse) VisitListBatchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchBatchRequestObject struct {
+	Body *LaunchBatchJSONRequestBody
+}
+
+type LaunchBatchResponseObject interface {
+	VisitLaunchBatchResponse(w http.ResponseWriter) error
+}
+
+type LaunchBatch201JSONResponse BatchGroupResponse
+
+func (response LaunchBatch201JSONResponse) VisitLaunchBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchBatch400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response LaunchBatch400JSONResponse) VisitLaunchBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchBatch500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response LaunchBatch500JSONResponse) VisitLaunchBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBatchRequestObject struct {
+	Id BatchId `json:"id"`
+}
+
+type GetBatchResponseObject interface {
+	VisitGetBatchResponse(w http.ResponseWriter) error
+}
+
+type GetBatch200JSONResponse BatchGroupResponse
+
+func (response GetBatch200JSONResponse) VisitGetBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBatch404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetBatch404JSONResponse) VisitGetBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBatch500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetBatch500JSONResponse) VisitGetBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareBatchRequestObject struct {
+	Id BatchId `json:"id"`
+}
+
+type CompareBatchResponseObject interface {
+	VisitCompareBatchResponse(w http.ResponseWriter) error
+}
+
+type CompareBatch200JSONResponse BatchComparisonResponse
+
+func (response CompareBatch200JSONResponse) VisitCompareBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareBatch404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CompareBatch404JSONResponse) VisitCompareBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareBatch500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CompareBatch500JSONResponse) VisitCompareBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConfigRequestObject struct {
+}
+
+type GetConfigResponseObject interface {
+	VisitGetConfigResponse(w http.ResponseWriter) error
+}
+
+type GetConfig200JSONResponse ConfigResponse
+
+func (response GetConfig200JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConfig500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetConfig500JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConfigRequestObject struct {
+	Body *UpdateConfigJSONRequestBody
+}
+
+type UpdateConfigResponseObject interface {
+	VisitUpdateConfigResponse(w http.ResponseWriter) error
+}
+
+type UpdateConfig200JSONResponse ConfigResponse
+
+func (response UpdateConfig200JSONResponse) VisitUpdateConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConfig400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateConfig400JSONResponse) VisitUpdateConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConfig500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateConfig500JSONResponse) VisitUpdateConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchConversationsRequestObject struct {
+	Params SearchConversationsParams
+}
+
+type SearchConversationsResponseObject interface {
+	VisitSearchConversationsResponse(w http.ResponseWriter) error
+}
+
+type SearchConversations200JSONResponse ConversationSearchResponse
+
+func (response SearchConversations200JSONResponse) VisitSearchConversationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchConversations400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchConversations400JSONResponse) VisitSearchConversationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchConversations500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response SearchConversations500JSONResponse) VisitSearchConversationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDebugInfoRequestObject struct {
+}
+
+type GetDebugInfoResponseObject interface {
+	VisitGetDebugInfoResponse(w http.ResponseWriter) error
+}
+
+type GetDebugInfo200JSONResponse DebugInfoResponse
+
+func (response GetDebugInfo200JSONResponse) VisitGetDebugInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectoryRequestObject struct {
+	Body *CreateDirectoryJSONRequestBody
+}
+
+type CreateDirectoryResponseObject interface {
+	VisitCreateDirectoryResponse(w http.ResponseWriter) error
+}
+
+type CreateDirectory200JSONResponse struct {
+	// Created Whether the directory was created
+	Created *bool `json:"created,omitempty"`
+
+	// Path The created directory path
+	Path *string `json:"path,omitempty"`
+}
+
+func (response CreateDirectory200JSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDirectory500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateDirectory500JSONResponse) VisitCreateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FuzzySearchFilesRequestObject struct {
+	Body *FuzzySearchFilesJSONRequestBody
+}
+
+type FuzzySearchFilesResponseObject interface {
+	VisitFuzzySearchFilesResponse(w http.ResponseWriter) error
+}
+
+type FuzzySearchFiles200JSONResponse FuzzySearchFilesResponse
+
+func (response FuzzySearchFiles200JSONResponse) VisitFuzzySearchFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FuzzySearchFiles400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response FuzzySearchFiles400JSONResponse) VisitFuzzySearchFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FuzzySearchFiles500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response FuzzySearchFiles500JSONResponse) VisitFuzzySearchFilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse HealthResponse
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLabelsRequestObject struct {
+}
+
+type ListLabelsResponseObject interface {
+	VisitListLabelsResponse(w http.ResponseWriter) error
+}
+
+type ListLabels200JSONResponse LabelsResponse
+
+func (response ListLabels200JSONResponse) VisitListLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLabels500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListLabels500JSONResponse) VisitListLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLabelRequestObject struct {
+	Body *CreateLabelJSONRequestBody
+}
+
+type CreateLabelResponseObject interface {
+	VisitCreateLabelResponse(w http.ResponseWriter) error
+}
+
+type CreateLabel201JSONResponse LabelResponse
+
+func (response CreateLabel201JSONResponse) VisitCreateLabelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLabel400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateLabel400JSONResponse) VisitCreateLabelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLabel500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateLabel500JSONResponse) VisitCreateLabelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLabelRequestObject struct {
+	Id LabelId `json:"id"`
+}
+
+type DeleteLabelResponseObject interface {
+	VisitDeleteLabelResponse(w http.ResponseWriter) error
+}
+
+type DeleteLabel204Response struct {
+}
+
+func (response DeleteLabel204Response) VisitDeleteLabelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteLabel404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteLabel404JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLabel500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteLabel500JSONResponse) VisitDeleteLabelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMaintenanceRequestObject struct {
+	Body *RunMaintenanceJSONRequestBody
+}
+
+type RunMaintenanceResponseObject interface {
+	VisitRunMaintenanceResponse(w http.ResponseWriter) error
+}
+
+type RunMaintenance200JSONResponse MaintenanceResponse
+
+func (response RunMaintenance200JSONResponse) VisitRunMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMaintenance400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RunMaintenance400JSONResponse) VisitRunMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunMaintenance500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response RunMaintenance500JSONResponse) VisitRunMaintenanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineRunsRequestObject struct {
+}
+
+type ListPipelineRunsResponseObject interface {
+	VisitListPipelineRunsResponse(w http.ResponseWriter) error
+}
+
+type ListPipelineRuns200JSONResponse PipelineRunsResponse
+
+func (response ListPipelineRuns200JSONResponse) VisitListPipelineRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineRuns500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListPipelineRuns500JSONResponse) VisitListPipelineRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartPipelineRunRequestObject struct {
+	Body *StartPipelineRunJSONRequestBody
+}
+
+type StartPipelineRunResponseObject interface {
+	VisitStartPipelineRunResponse(w http.ResponseWriter) error
+}
+
+type StartPipelineRun201JSONResponse PipelineRunResponse
+
+func (response StartPipelineRun201JSONResponse) VisitStartPipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartPipelineRun400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response StartPipelineRun400JSONResponse) VisitStartPipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartPipelineRun500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response StartPipelineRun500JSONResponse) VisitStartPipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineRunRequestObject struct {
+	Id PipelineRunId `json:"id"`
+}
+
+type GetPipelineRunResponseObject interface {
+	VisitGetPipelineRunResponse(w http.ResponseWriter) error
+}
+
+type GetPipelineRun200JSONResponse PipelineRunResponse
+
+func (response GetPipelineRun200JSONResponse) VisitGetPipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineRun404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetPipelineRun404JSONResponse) VisitGetPipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineRun500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetPipelineRun500JSONResponse) VisitGetPipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PausePipelineRunRequestObject struct {
+	Id PipelineRunId `json:"id"`
+}
+
+type PausePipelineRunResponseObject interface {
+	VisitPausePipelineRunResponse(w http.ResponseWriter) error
+}
+
+type PausePipelineRun200JSONResponse PipelineRunResponse
+
+func (response PausePipelineRun200JSONResponse) VisitPausePipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PausePipelineRun400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PausePipelineRun400JSONResponse) VisitPausePipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PausePipelineRun404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PausePipelineRun404JSONResponse) VisitPausePipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PausePipelineRun500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PausePipelineRun500JSONResponse) VisitPausePipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumePipelineRunRequestObject struct {
+	Id PipelineRunId `json:"id"`
+}
+
+type ResumePipelineRunResponseObject interface {
+	VisitResumePipelineRunResponse(w http.ResponseWriter) error
+}
+
+type ResumePipelineRun200JSONResponse PipelineRunResponse
+
+func (response ResumePipelineRun200JSONResponse) VisitResumePipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumePipelineRun400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ResumePipelineRun400JSONResponse) VisitResumePipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumePipelineRun404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ResumePipelineRun404JSONResponse) VisitResumePipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResumePipelineRun500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ResumePipelineRun500JSONResponse) VisitResumePipelineRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrustProjectConfigRequestObject struct {
+	Body *TrustProjectConfigJSONRequestBody
+}
+
+type TrustProjectConfigResponseObject interface {
+	VisitTrustProjectConfigResponse(w http.ResponseWriter) error
+}
+
+type TrustProjectConfig200JSONResponse TrustProjectConfigResponse
+
+func (response TrustProjectConfig200JSONResponse) VisitTrustProjectConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrustProjectConfig400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response TrustProjectConfig400JSONResponse) VisitTrustProjectConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TrustProjectConfig500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response TrustProjectConfig500JSONResponse) VisitTrustProjectConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateProjectConfigRequestObject struct {
+	Body *ValidateProjectConfigJSONRequestBody
+}
+
+type ValidateProjectConfigResponseObject interface {
+	VisitValidateProjectConfigResponse(w http.ResponseWriter) error
+}
+
+type ValidateProjectConfig200JSONResponse ValidateProjectConfigResponse
+
+func (response ValidateProjectConfig200JSONResponse) VisitValidateProjectConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateProjectConfig400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ValidateProjectConfig400JSONResponse) VisitValidateProjectConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateProjectConfig500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ValidateProjectConfig500JSONResponse) VisitValidateProjectConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjectsRequestObject struct {
+}
+
+type ListProjectsResponseObject interface {
+	VisitListProjectsResponse(w http.ResponseWriter) error
+}
+
+type ListProjects200JSONResponse ProjectsResponse
+
+func (response ListProjects200JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProjects500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListProjects500JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecentPathsRequestObject struct {
+	Params GetRecentPathsParams
+}
+
+type GetRecentPathsResponseObject interface {
+	VisitGetRecentPathsResponse(w http.ResponseWriter) error
+}
+
+type GetRecentPaths200JSONResponse RecentPathsResponse
+
+func (response GetRecentPaths200JSONResponse) VisitGetRecentPathsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecentPaths500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetRecentPaths500JSONResponse) VisitGetRecentPathsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSchedulesRequestObject struct {
+}
+
+type ListSchedulesResponseObject interface {
+	VisitListSchedulesResponse(w http.ResponseWriter) error
+}
+
+type ListSchedules200JSONResponse SchedulesResponse
+
+func (response ListSchedules200JSONResponse) VisitListSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSchedules500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListSchedules500JSONResponse) VisitListSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateScheduleRequestObject struct {
+	Body *CreateScheduleJSONRequestBody
+}
+
+type CreateScheduleResponseObject interface {
+	VisitCreateScheduleResponse(w http.ResponseWriter) error
+}
+
+type CreateSchedule201JSONResponse ScheduleResponse
+
+func (response CreateSchedule201JSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchedule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateSchedule400JSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchedule500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateSchedule500JSONResponse) VisitCreateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteScheduleRequestObject struct {
+	Id ScheduleId `json:"id"`
+}
+
+type DeleteScheduleResponseObject interface {
+	VisitDeleteScheduleResponse(w http.ResponseWriter) error
+}
+
+type DeleteSchedule204Response struct {
+}
+
+func (response DeleteSchedule204Response) VisitDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSchedule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteSchedule404JSONResponse) VisitDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSchedule500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteSchedule500JSONResponse) VisitDeleteScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScheduleRequestObject struct {
+	Id ScheduleId `json:"id"`
+}
+
+type GetScheduleResponseObject interface {
+	VisitGetScheduleResponse(w http.ResponseWriter) error
+}
+
+type GetSchedule200JSONResponse ScheduleResponse
+
+func (response GetSchedule200JSONResponse) VisitGetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchedule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSchedule404JSONResponse) VisitGetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchedule500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSchedule500JSONResponse) VisitGetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateScheduleRequestObject struct {
+	Id   ScheduleId `json:"id"`
+	Body *UpdateScheduleJSONRequestBody
+}
+
+type UpdateScheduleResponseObject interface {
+	VisitUpdateScheduleResponse(w http.ResponseWriter) error
+}
+
+type UpdateSchedule200JSONResponse ScheduleResponse
+
+func (response UpdateSchedule200JSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchedule400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateSchedule400JSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchedule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateSchedule404JSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSchedule500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateSchedule500JSONResponse) VisitUpdateScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduleRunsRequestObject struct {
+	Id     ScheduleId `json:"id"`
+	Params ListScheduleRunsParams
+}
+
+type ListScheduleRunsResponseObject interface {
+	VisitListScheduleRunsResponse(w http.ResponseWriter) error
+}
+
+type ListScheduleRuns200JSONResponse ScheduleRunsResponse
+
+func (response ListScheduleRuns200JSONResponse) VisitListScheduleRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduleRuns404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListScheduleRuns404JSONResponse) VisitListScheduleRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListScheduleRuns500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListScheduleRuns500JSONResponse) VisitListScheduleRunsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerScheduleRequestObject struct {
+	Id ScheduleId `json:"id"`
+}
+
+type TriggerScheduleResponseObject interface {
+	VisitTriggerScheduleResponse(w http.ResponseWriter) error
+}
+
+type TriggerSchedule200JSONResponse ScheduleRunResponse
+
+func (response TriggerSchedule200JSONResponse) VisitTriggerScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerSchedule404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response TriggerSchedule404JSONResponse) VisitTriggerScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerSchedule500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response TriggerSchedule500JSONResponse) VisitTriggerScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedFiltersRequestObject struct {
+}
+
+type ListSavedFiltersResponseObject interface {
+	VisitListSavedFiltersResponse(w http.ResponseWriter) error
+}
+
+type ListSavedFilters200JSONResponse SavedFiltersResponse
+
+func (response ListSavedFilters200JSONResponse) VisitListSavedFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavedFilters500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListSavedFilters500JSONResponse) VisitListSavedFiltersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedFilterRequestObject struct {
+	Body *CreateSavedFilterJSONRequestBody
+}
+
+type CreateSavedFilterResponseObject interface {
+	VisitCreateSavedFilterResponse(w http.ResponseWriter) error
+}
+
+type CreateSavedFilter201JSONResponse SavedFilterResponse
+
+func (response CreateSavedFilter201JSONResponse) VisitCreateSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedFilter400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateSavedFilter400JSONResponse) VisitCreateSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavedFilter500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateSavedFilter500JSONResponse) VisitCreateSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedFilterRequestObject struct {
+	Id SavedFilterId `json:"id"`
+}
+
+type DeleteSavedFilterResponseObject interface {
+	VisitDeleteSavedFilterResponse(w http.ResponseWriter) error
+}
+
+type DeleteSavedFilter204Response struct {
+}
+
+func (response DeleteSavedFilter204Response) VisitDeleteSavedFilterResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSavedFilter404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteSavedFilter404JSONResponse) VisitDeleteSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavedFilter500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteSavedFilter500JSONResponse) VisitDeleteSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedFilterRequestObject struct {
+	Id   SavedFilterId `json:"id"`
+	Body *UpdateSavedFilterJSONRequestBody
+}
+
+type UpdateSavedFilterResponseObject interface {
+	VisitUpdateSavedFilterResponse(w http.ResponseWriter) error
+}
+
+type UpdateSavedFilter200JSONResponse SavedFilterResponse
+
+func (response UpdateSavedFilter200JSONResponse) VisitUpdateSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedFilter400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateSavedFilter400JSONResponse) VisitUpdateSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedFilter404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateSavedFilter404JSONResponse) VisitUpdateSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavedFilter500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateSavedFilter500JSONResponse) VisitUpdateSavedFilterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSessionsRequestObject struct {
+	Params ListSessionsParams
+}
+
+type ListSessionsResponseObject interface {
+	VisitListSessionsResponse(w http.ResponseWriter) error
+}
+
+type ListSessions200JSONResponse SessionsResponse
+
+func (response ListSessions200JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSessions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListSessions400JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSessions404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListSessions404JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSessions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListSessions500JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSessionRequestObject struct {
+	Body *CreateSessionJSONRequestBody
+}
+
+type CreateSessionResponseObject interface {
+	VisitCreateSessionResponse(w http.ResponseWriter) error
+}
+
+type CreateSession201JSONResponse CreateSessionResponse
+
+func (response CreateSession201JSONResponse) VisitCreateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSession400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateSession400JSONResponse) VisitCreateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSession422JSONResponse DirectoryNotFoundResponse
+
+func (response CreateSession422JSONResponse) VisitCreateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSession500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateSession500JSONResponse) VisitCreateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkArchiveSessionsRequestObject struct {
+	Body *BulkArchiveSessionsJSONRequestBody
+}
+
+type BulkArchiveSessionsResponseObject interface {
+	VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error
+}
+
+type BulkArchiveSessions200JSONResponse BulkArchiveResponse
+
+func (response BulkArchiveSessions200JSONResponse) VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkArchiveSessions207JSONResponse BulkArchiveResponse
+
+func (response BulkArchiveSessions207JSONResponse) VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(207)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkArchiveSessions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response BulkArchiveSessions400JSONResponse) VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkArchiveSessions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response BulkArchiveSessions500JSONResponse) VisitBulkArchiveSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSessionBundleRequestObject struct {
+	Body io.Reader
+}
+
+type ImportSessionBundleResponseObject interface {
+	VisitImportSessionBundleResponse(w http.ResponseWriter) error
+}
+
+type ImportSessionBundle201JSONResponse ImportSessionBundleResponse
+
+func (response ImportSessionBundle201JSONResponse) VisitImportSessionBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSessionBundle400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ImportSessionBundle400JSONResponse) VisitImportSessionBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportSessionBundle500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ImportSessionBundle500JSONResponse) VisitImportSessionBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkRestoreDraftsRequestObject struct {
+	Body *BulkRestoreDraftsJSONRequestBody
+}
+
+type BulkRestoreDraftsResponseObject interface {
+	VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error
+}
+
+type BulkRestoreDrafts200JSONResponse BulkRestoreDraftsResponse
+
+func (response BulkRestoreDrafts200JSONResponse) VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkRestoreDrafts207JSONResponse BulkRestoreDraftsResponse
+
+func (response BulkRestoreDrafts207JSONResponse) VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(207)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkRestoreDrafts400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response BulkRestoreDrafts400JSONResponse) VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkRestoreDrafts500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response BulkRestoreDrafts500JSONResponse) VisitBulkRestoreDraftsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSessionsRequestObject struct {
+	Params SearchSessionsParams
+}
+
+type SearchSessionsResponseObject interface {
+	VisitSearchSessionsResponse(w http.ResponseWriter) error
+}
+
+type SearchSessions200JSONResponse SessionSearchResponse
+
+func (response SearchSessions200JSONResponse) VisitSearchSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSessions400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SearchSessions400JSONResponse) VisitSearchSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchSessions500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response SearchSessions500JSONResponse) VisitSearchSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionRequestObject struct {
+	Id SessionId `json:"id"`
+}
+
+type GetSessionResponseObject interface {
+	VisitGetSessionResponse(w http.ResponseWriter) error
+}
+
+type GetSession200JSONResponse SessionResponse
+
+func (response GetSession200JSONResponse) VisitGetSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSession404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSession404JSONResponse) VisitGetSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSession500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSession500JSONResponse) VisitGetSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSessionRequestObject struct {
+	Id   SessionId `json:"id"`
+	Body *UpdateSessionJSONRequestBody
+}
+
+type UpdateSessionResponseObject interface {
+	VisitUpdateSessionResponse(w http.ResponseWriter) error
+}
+
+type UpdateSession200JSONResponse SessionResponse
+
+func (response UpdateSession200JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSession400JSONResponse ErrorResponse
+
+func (response UpdateSession400JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSession404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateSession404JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSession500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateSession500JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSessionBundleRequestObject struct {
+	Id     SessionId `json:"id"`
+	Params ExportSessionBundleParams
+}
+
+type ExportSessionBundleResponseObject interface {
+	VisitExportSessionBundleResponse(w http.ResponseWriter) error
+}
+
+type ExportSessionBundle200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportSessionBundle200ApplicationgzipResponse struct {
+	Body          io.Reader
+	Headers       ExportSessionBundle200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSessionBundle200ApplicationgzipResponse) VisitExportSessionBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/gzip")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSessionBundle404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportSessionBundle404JSONResponse) VisitExportSessionBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSessionBundle500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ExportSessionBundle500JSONResponse) VisitExportSessionBundleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompactSessionRequestObject struct {
+	Id   SessionId `json:"id"`
+	Body *CompactSessionJSONRequestBody
+}
+
+type CompactSessionResponseObject interface {
+	VisitCompactSessionResponse(w http.ResponseWriter) error
+}
+
+type CompactSession201JSONResponse ContinueSessionResponse
+
+func (response CompactSession201JSONResponse) VisitCompactSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompactSession400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CompactSession400JSONResponse) VisitCompactSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompactSession404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CompactSession404JSONResponse) VisitCompactSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompactSession500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CompactSession500JSONResponse) VisitCompactSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ContinueSessionRequestObject struct {
+	Id   SessionId `json:"id"`
+	Body *ContinueSessionJSONRequestBody
+}
+
+type ContinueSessionResponseObject interface {
+	VisitContinueSessionResponse(w http.ResponseWriter) error
+}
+
+type ContinueSession201JSONResponse ContinueSessionResponse
+
+func (response ContinueSession201JSONResponse) VisitContinueSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ContinueSession404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ContinueSession404JSONResponse) VisitContinueSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ContinueSession500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ContinueSession500JSONResponse) VisitContinueSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionEffectiveConfigRequestObject struct {
+	Id SessionId `json:"id"`
+}
+
+type GetSessionEffectiveConfigResponseObject interface {
+	VisitGetSessionEffectiveConfigResponse(w http.ResponseWriter) error
+}
+
+type GetSessionEffectiveConfig200JSONResponse EffectiveConfigResponse
+
+func (response GetSessionEffectiveConfig200JSONResponse) VisitGetSessionEffectiveConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionEffectiveConfig404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSessionEffectiveConfig404JSONResponse) VisitGetSessionEffectiveConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionEffectiveConfig500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSessionEffectiveConfig500JSONResponse) VisitGetSessionEffectiveConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSessionConversationRequestObject struct {
+	Id     SessionId `json:"id"`
+	Params ExportSessionConversationParams
+}
+
+type ExportSessionConversationResponseObject interface {
+	VisitExportSessionConversationResponse(w http.ResponseWriter) error
+}
+
+type ExportSessionConversation200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type ExportSessionConversation200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       ExportSessionConversation200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSessionConversation200ApplicationxNdjsonResponse) VisitExportSessionConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSessionConversation200TexthtmlResponse struct {
+	Body          io.Reader
+	Headers       ExportSessionConversation200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSessionConversation200TexthtmlResponse) VisitExportSessionConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/html")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSessionConversation200TextmarkdownResponse struct {
+	Body          io.Reader
+	Headers       ExportSessionConversation200ResponseHeaders
+	ContentLength int64
+}
+
+func (response ExportSessionConversation200TextmarkdownResponse) VisitExportSessionConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/markdown")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportSessionConversation400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ExportSessionConversation400JSONResponse) VisitExportSessionConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSessionConversation404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportSessionConversation404JSONResponse) VisitExportSessionConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportSessionConversation500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ExportSessionConversation500JSONResponse) VisitExportSessionConversationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HardDeleteEmptyDraftSessionRequestObject struct {
+	Id SessionId `json:"id"`
+}
+
+type HardDeleteEmptyDraftSessionResponseObject interface {
+	VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error
+}
+
+type HardDeleteEmptyDraftSession204Response struct {
+}
+
+func (response HardDeleteEmptyDraftSession204Response) VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type HardDeleteEmptyDraftSession400JSONResponse ErrorResponse
+
+func (response HardDeleteEmptyDraftSession400JSONResponse) VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HardDeleteEmptyDraftSession404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response HardDeleteEmptyDraftSession404JSONResponse) VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HardDeleteEmptyDraftSession500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response HardDeleteEmptyDraftSession500JSONResponse) VisitHardDeleteEmptyDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InterruptSessionRequestObject struct {
+	Id SessionId `json:"id"`
+}
+
+type InterruptSessionResponseObject interface {
+	VisitInterruptSessionResponse(w http.ResponseWriter) error
+}
+
+type InterruptSession200JSONResponse InterruptSessionResponse
+
+func (response InterruptSession200JSONResponse) VisitInterruptSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InterruptSession400JSONResponse ErrorResponse
+
+func (response InterruptSession400JSONResponse) VisitInterruptSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InterruptSession404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response InterruptSession404JSONResponse) VisitInterruptSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InterruptSession500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response InterruptSession500JSONResponse) VisitInterruptSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSessionLabelsRequestObject struct {
+	Id   SessionId `json:"id"`
+	Body *SetSessionLabelsJSONRequestBody
+}
+
+type SetSessionLabelsResponseObject interface {
+	VisitSetSessionLabelsResponse(w http.ResponseWriter) error
+}
+
+type SetSessionLabels200JSONResponse SessionLabelsResponse
+
+func (response SetSessionLabels200JSONResponse) VisitSetSessionLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSessionLabels404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response SetSessionLabels404JSONResponse) VisitSetSessionLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSessionLabels500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response SetSessionLabels500JSONResponse) VisitSetSessionLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDraftSessionRequestObject struct {
+	Id SessionId `json:"id"`
+}
+
+type DeleteDraftSessionResponseObject interface {
+	VisitDeleteDraftSessionResponse(w http.ResponseWriter) error
+}
+
+type DeleteDraftSession204Response struct {
+}
+
+func (response DeleteDraftSession204Response) VisitDeleteDraftSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteDraftSession400JSONResponse ErrorResponse
+
+func (response DeleteDraftSession400JSONResponse) VisitDeleteDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDraftSession404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteDraftSession404JSONResponse) VisitDeleteDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDraftSession500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteDraftSession500JSONResponse) VisitDeleteDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchDraftSessionRequestObject struct {
+	Id   SessionId `json:"id"`
+	Body *LaunchDraftSessionJSONRequestBody
+}
+
+type LaunchDraftSessionResponseObject interface {
+	VisitLaunchDraftSessionResponse(w http.ResponseWriter) error
+}
+
+type LaunchDraftSession200JSONResponse SessionResponse
+
+func (response LaunchDraftSession200JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchDraftSession400JSONResponse ErrorResponse
+
+func (response LaunchDraftSession400JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchDraftSession404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response LaunchDraftSession404JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchDraftSession422JSONResponse DirectoryNotFoundResponse
+
+func (response LaunchDraftSession422JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchDraftSession500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response LaunchDraftSession500JSONResponse) VisitLaunchDraftSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionMessagesRequestObject struct {
+	Id     SessionId `json:"id"`
+	Params GetSessionMessagesParams
+}
+
+type GetSessionMessagesResponseObject interface {
+	VisitGetSessionMessagesResponse(w http.ResponseWriter) error
+}
+
+type GetSessionMessages200JSONResponse ConversationResponse
+
+func (response GetSessionMessages200JSONResponse) VisitGetSessionMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionMessages400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetSessionMessages400JSONResponse) VisitGetSessionMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionMessages404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSessionMessages404JSONResponse) VisitGetSessionMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionMessages500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSessionMessages500JSONResponse) VisitGetSessionMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionResourcesRequestObject struct {
+	Id     SessionId `json:"id"`
+	Params GetSessionResourcesParams
+}
+
+type GetSessionResourcesResponseObject interface {
+	VisitGetSessionResourcesResponse(w http.ResponseWriter) error
+}
+
+type GetSessionResources200JSONResponse SessionResourcesResponse
+
+func (response GetSessionResources200JSONResponse) VisitGetSessionResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionResources404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSessionResources404JSONResponse) VisitGetSessionResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionResources500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSessionResources500JSONResponse) VisitGetSessionResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionSnapshotsRequestObject struct {
+	Id SessionId `json:"id"`
+}
+
+type GetSessionSnapshotsResponseObject interface {
+	VisitGetSessionSnapshotsResponse(w http.ResponseWriter) error
+}
+
+type GetSessionSnapshots200JSONResponse SnapshotsResponse
+
+func (response GetSessionSnapshots200JSONResponse) VisitGetSessionSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionSnapshots404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSessionSnapshots404JSONResponse) VisitGetSessionSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionSnapshots500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSessionSnapshots500JSONResponse) VisitGetSessionSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSlashCommandsRequestObject struct {
+	Params GetSlashCommandsParams
+}
+
+type GetSlashCommandsResponseObject interface {
+	VisitGetSlashCommandsResponse(w http.ResponseWriter) error
+}
+
+type GetSlashCommands200JSONResponse SlashCommandsResponse
+
+func (response GetSlashCommands200JSONResponse) VisitGetSlashCommandsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSlashCommands400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetSlashCommands400JSONResponse) VisitGetSlashCommandsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSlashCommands500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetSlashCommands500JSONResponse) VisitGetSlashCommandsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTemplatesRequestObject struct {
+}
+
+type ListTemplatesResponseObject interface {
+	VisitListTemplatesResponse(w http.ResponseWriter) error
+}
+
+type ListTemplates200JSONResponse SessionTemplatesResponse
+
+func (response ListTemplates200JSONResponse) VisitListTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTemplates500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListTemplates500JSONResponse) VisitListTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTemplateRequestObject struct {
+	Body *CreateTemplateJSONRequestBody
+}
+
+type CreateTemplateResponseObject interface {
+	VisitCreateTemplateResponse(w http.ResponseWriter) error
+}
+
+type CreateTemplate201JSONResponse SessionTemplateResponse
+
+func (response CreateTemplate201JSONResponse) VisitCreateTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTemplate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateTemplate400JSONResponse) VisitCreateTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTemplate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateTemplate500JSONResponse) VisitCreateTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTemplateRequestObject struct {
+	Body *ImportTemplateJSONRequestBody
+}
+
+type ImportTemplateResponseObject interface {
+	VisitImportTemplateResponse(w http.ResponseWriter) error
+}
+
+type ImportTemplate201JSONResponse SessionTemplateResponse
+
+func (response ImportTemplate201JSONResponse) VisitImportTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTemplate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ImportTemplate400JSONResponse) VisitImportTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportTemplate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ImportTemplate500JSONResponse) VisitImportTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTemplateRequestObject struct {
+	Id TemplateId `json:"id"`
+}
+
+type DeleteTemplateResponseObject interface {
+	VisitDeleteTemplateResponse(w http.ResponseWriter) error
+}
+
+type DeleteTemplate204Response struct {
+}
+
+func (response DeleteTemplate204Response) VisitDeleteTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTemplate404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteTemplate404JSONResponse) VisitDeleteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTemplate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteTemplate500JSONResponse) VisitDeleteTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTemplateRequestObject struct {
+	Id TemplateId `json:"id"`
+}
+
+type GetTemplateResponseObject interface {
+	VisitGetTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetTemplate200JSONResponse SessionTemplateResponse
+
+func (response GetTemplate200JSONResponse) VisitGetTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTemplate404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetTemplate404JSONResponse) VisitGetTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTemplate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTemplate500JSONResponse) VisitGetTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTemplateRequestObject struct {
+	Id   TemplateId `json:"id"`
+	Body *UpdateTemplateJSONRequestBody
+}
+
+type UpdateTemplateResponseObject interface {
+	VisitUpdateTemplateResponse(w http.ResponseWriter) error
+}
+
+type UpdateTemplate200JSONResponse SessionTemplateResponse
+
+func (response UpdateTemplate200JSONResponse) VisitUpdateTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTemplate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateTemplate400JSONResponse) VisitUpdateTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTemplate404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateTemplate404JSONResponse) VisitUpdateTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTemplate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateTemplate500JSONResponse) VisitUpdateTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTemplateRequestObject struct {
+	Id TemplateId `json:"id"`
+}
+
+type ExportTemplateResponseObject interface {
+	VisitExportTemplateResponse(w http.ResponseWriter) error
+}
+
+type ExportTemplate200JSONResponse TemplateExportResponse
+
+func (response ExportTemplate200JSONResponse) VisitExportTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTemplate404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ExportTemplate404JSONResponse) VisitExportTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportTemplate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ExportTemplate500JSONResponse) VisitExportTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchTemplateRequestObject struct {
+	Id   TemplateId `json:"id"`
+	Body *LaunchTemplateJSONRequestBody
+}
+
+type LaunchTemplateResponseObject interface {
+	VisitLaunchTemplateResponse(w http.ResponseWriter) error
+}
+
+type LaunchTemplate201JSONResponse CreateSessionResponse
+
+func (response LaunchTemplate201JSONResponse) VisitLaunchTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchTemplate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response LaunchTemplate400JSONResponse) VisitLaunchTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchTemplate404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response LaunchTemplate404JSONResponse) VisitLaunchTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LaunchTemplate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response LaunchTemplate500JSONResponse) VisitLaunchTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSettingsRequestObject struct {
+}
+
+type GetUserSettingsResponseObject interface {
+	VisitGetUserSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetUserSettings200JSONResponse UserSettingsResponse
+
+func (response GetUserSettings200JSONResponse) VisitGetUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSettings500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetUserSettings500JSONResponse) VisitGetUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserSettingsRequestObject struct {
+	Body *UpdateUserSettingsJSONRequestBody
+}
+
+type UpdateUserSettingsResponseObject interface {
+	VisitUpdateUserSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateUserSettings200JSONResponse UserSettingsResponse
+
+func (response UpdateUserSettings200JSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserSettings400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateUserSettings400JSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateUserSettings500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateUserSettings500JSONResponse) VisitUpdateUserSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateDirectoryRequestObject struct {
+	Body *ValidateDirectoryJSONRequestBody
+}
+
+type ValidateDirectoryResponseObject interface {
+	VisitValidateDirectoryResponse(w http.ResponseWriter) error
+}
+
+type ValidateDirectory200JSONResponse ValidateDirectoryResponse
+
+func (response ValidateDirectory200JSONResponse) VisitValidateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ValidateDirectory500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ValidateDirectory500JSONResponse) VisitValidateDirectoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse WebhooksResponse
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Body *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse WebhookResponse
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	Id WebhookId `json:"id"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhookRequestObject struct {
+	Id WebhookId `json:"id"`
+}
+
+type GetWebhookResponseObject interface {
+	VisitGetWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetWebhook200JSONResponse WebhookResponse
+
+func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetWebhook404JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhook500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetWebhook500JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookRequestObject struct {
+	Id   WebhookId `json:"id"`
+	Body *UpdateWebhookJSONRequestBody
+}
+
+type UpdateWebhookResponseObject interface {
+	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+}
+
+type UpdateWebhook200JSONResponse WebhookResponse
+
+func (response UpdateWebhook200JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateWebhook400JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateWebhook404JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhook500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response UpdateWebhook500JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	Id     WebhookId `json:"id"`
+	Params ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse WebhookDeliveriesResponse
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Discover available agents
+	// (POST /agents/discover)
+	DiscoverAgents(ctx context.Context, request DiscoverAgentsRequestObject) (DiscoverAgentsResponseObject, error)
+	// List approval requests
+	// (GET /approvals)
+	ListApprovals(ctx context.Context, request ListApprovalsRequestObject) (ListApprovalsResponseObject, error)
+	// Create approval request
+	// (POST /approvals)
+	CreateApproval(ctx context.Context, request CreateApprovalRequestObject) (CreateApprovalResponseObject, error)
+	// Get approval details
 	// (GET /approvals/{id})
 	GetApproval(ctx context.Context, request GetApprovalRequestObject) (GetApprovalResponseObject, error)
 	// Decide on approval request
 	// (POST /approvals/{id}/decide)
 	DecideApproval(ctx context.Context, request DecideApprovalRequestObject) (DecideApprovalResponseObject, error)
+	// List database backups
+	// (GET /backups)
+	ListBackups(ctx context.Context, request ListBackupsRequestObject) (ListBackupsResponseObject, error)
+	// Back up the database
+	// (POST /backups)
+	CreateBackup(ctx context.Context, request CreateBackupRequestObject) (CreateBackupResponseObject, error)
+	// Restore a database backup
+	// (POST /backups/{name}/restore)
+	RestoreBackup(ctx context.Context, request RestoreBackupRequestObject) (RestoreBackupResponseObject, error)
+	// List batch launches
+	// (GET /batches)
+	ListBatches(ctx context.Context, request ListBatchesRequestObject) (ListBatchesResponseObject, error)
+	// Launch a batch
+	// (POST /batches)
+	LaunchBatch(ctx context.Context, request LaunchBatchRequestObject) (LaunchBatchResponseObject, error)
+	// Get batch details
+	// (GET /batches/{id})
+	GetBatch(ctx context.Context, request GetBatchRequestObject) (GetBatchResponseObject, error)
+	// Compare batch results
+	// (GET /batches/{id}/compare)
+	CompareBatch(ctx context.Context, request CompareBatchRequestObject) (CompareBatchResponseObject, error)
 	// Get daemon configuration
 	// (GET /config)
 	GetConfig(ctx context.Context, request GetConfigRequestObject) (GetConfigResponseObject, error)
 	// Update daemon configuration
 	// (PATCH /config)
 	UpdateConfig(ctx context.Context, request UpdateConfigRequestObject) (UpdateConfigResponseObject, error)
+	// Search conversation content
+	// (GET /conversations/search)
+	SearchConversations(ctx context.Context, request SearchConversationsRequestObject) (SearchConversationsResponseObject, error)
 	// Get debug information
 	// (GET /debug-info)
 	GetDebugInfo(ctx context.Context, request GetDebugInfoRequestObject) (GetDebugInfoResponseObject, error)
@@ -2900,9 +8059,78 @@ type StrictServerInterface interface {
 	// Health check
 	// (GET /health)
 	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// List labels
+	// (GET /labels)
+	ListLabels(ctx context.Context, request ListLabelsRequestObject) (ListLabelsResponseObject, error)
+	// Create a label
+	// (POST /labels)
+	CreateLabel(ctx context.Context, request CreateLabelRequestObject) (CreateLabelResponseObject, error)
+	// Delete a label
+	// (DELETE /labels/{id})
+	DeleteLabel(ctx context.Context, request DeleteLabelRequestObject) (DeleteLabelResponseObject, error)
+	// Run database maintenance
+	// (POST /maintenance)
+	RunMaintenance(ctx context.Context, request RunMaintenanceRequestObject) (RunMaintenanceResponseObject, error)
+	// List pipeline runs
+	// (GET /pipeline-runs)
+	ListPipelineRuns(ctx context.Context, request ListPipelineRunsRequestObject) (ListPipelineRunsResponseObject, error)
+	// Start a pipeline run
+	// (POST /pipeline-runs)
+	StartPipelineRun(ctx context.Context, request StartPipelineRunRequestObject) (StartPipelineRunResponseObject, error)
+	// Get pipeline run details
+	// (GET /pipeline-runs/{id})
+	GetPipelineRun(ctx context.Context, request GetPipelineRunRequestObject) (GetPipelineRunResponseObject, error)
+	// Pause a pipeline run
+	// (POST /pipeline-runs/{id}/pause)
+	PausePipelineRun(ctx context.Context, request PausePipelineRunRequestObject) (PausePipelineRunResponseObject, error)
+	// Resume a pipeline run
+	// (POST /pipeline-runs/{id}/resume)
+	ResumePipelineRun(ctx context.Context, request ResumePipelineRunRequestObject) (ResumePipelineRunResponseObject, error)
+	// Trust a project configuration
+	// (POST /project-config/trust)
+	TrustProjectConfig(ctx context.Context, request TrustProjectConfigRequestObject) (TrustProjectConfigResponseObject, error)
+	// Validate a project configuration
+	// (POST /project-config/validate)
+	ValidateProjectConfig(ctx context.Context, request ValidateProjectConfigRequestObject) (ValidateProjectConfigResponseObject, error)
+	// List projects
+	// (GET /projects)
+	ListProjects(ctx context.Context, request ListProjectsRequestObject) (ListProjectsResponseObject, error)
 	// Get recent working directories
 	// (GET /recent-paths)
 	GetRecentPaths(ctx context.Context, request GetRecentPathsRequestObject) (GetRecentPathsResponseObject, error)
+	// List schedules
+	// (GET /schedules)
+	ListSchedules(ctx context.Context, request ListSchedulesRequestObject) (ListSchedulesResponseObject, error)
+	// Create a schedule
+	// (POST /schedules)
+	CreateSchedule(ctx context.Context, request CreateScheduleRequestObject) (CreateScheduleResponseObject, error)
+	// Delete a schedule
+	// (DELETE /schedules/{id})
+	DeleteSchedule(ctx context.Context, request DeleteScheduleRequestObject) (DeleteScheduleResponseObject, error)
+	// Get schedule details
+	// (GET /schedules/{id})
+	GetSchedule(ctx context.Context, request GetScheduleRequestObject) (GetScheduleResponseObject, error)
+	// Update a schedule
+	// (PATCH /schedules/{id})
+	UpdateSchedule(ctx context.Context, request UpdateScheduleRequestObject) (UpdateScheduleResponseObject, error)
+	// List schedule runs
+	// (GET /schedules/{id}/runs)
+	ListScheduleRuns(ctx context.Context, request ListScheduleRunsRequestObject) (ListScheduleRunsResponseObject, error)
+	// Run a schedule now
+	// (POST /schedules/{id}/trigger)
+	TriggerSchedule(ctx context.Context, request TriggerScheduleRequestObject) (TriggerScheduleResponseObject, error)
+	// List saved session filters
+	// (GET /session-filters)
+	ListSavedFilters(ctx context.Context, request ListSavedFiltersRequestObject) (ListSavedFiltersResponseObject, error)
+	// Save a session filter
+	// (POST /session-filters)
+	CreateSavedFilter(ctx context.Context, request CreateSavedFilterRequestObject) (CreateSavedFilterResponseObject, error)
+	// Delete a saved session filter
+	// (DELETE /session-filters/{id})
+	DeleteSavedFilter(ctx context.Context, request DeleteSavedFilterRequestObject) (DeleteSavedFilterResponseObject, error)
+	// Replace a saved session filter
+	// (PUT /session-filters/{id})
+	UpdateSavedFilter(ctx context.Context, request UpdateSavedFilterRequestObject) (UpdateSavedFilterResponseObject, error)
 	// List sessions
 	// (GET /sessions)
 	ListSessions(ctx context.Context, request ListSessionsRequestObject) (ListSessionsResponseObject, error)
@@ -2912,10 +8140,13 @@ type StrictServerInterface interface {
 	// Bulk archive/unarchive sessions
 	// (POST /sessions/archive)
 	BulkArchiveSessions(ctx context.Context, request BulkArchiveSessionsRequestObject) (BulkArchiveSessionsResponseObject, error)
+	// Import a session bundle
+	// (POST /sessions/import)
+	ImportSessionBundle(ctx context.Context, request ImportSessionBundleRequestObject) (ImportSessionBundleResponseObject, error)
 	// Restore multiple discarded draft sessions
 	// (POST /sessions/restore)
 	BulkRestoreDrafts(ctx context.Context, request BulkRestoreDraftsRequestObject) (BulkRestoreDraftsResponseObject, error)
-	// Search sessions by title
+	// Search sessions
 	// (GET /sessions/search)
 	SearchSessions(ctx context.Context, request SearchSessionsRequestObject) (SearchSessionsResponseObject, error)
 	// Get session details
@@ -2924,15 +8155,30 @@ type StrictServerInterface interface {
 	// Update session settings
 	// (PATCH /sessions/{id})
 	UpdateSession(ctx context.Context, request UpdateSessionRequestObject) (UpdateSessionResponseObject, error)
+	// Export a session bundle
+	// (GET /sessions/{id}/bundle)
+	ExportSessionBundle(ctx context.Context, request ExportSessionBundleRequestObject) (ExportSessionBundleResponseObject, error)
+	// Compact a session's conversation
+	// (POST /sessions/{id}/compact)
+	CompactSession(ctx context.Context, request CompactSessionRequestObject) (CompactSessionResponseObject, error)
 	// Continue or fork a session
 	// (POST /sessions/{id}/continue)
 	ContinueSession(ctx context.Context, request ContinueSessionRequestObject) (ContinueSessionResponseObject, error)
+	// Get session effective configuration
+	// (GET /sessions/{id}/effective-config)
+	GetSessionEffectiveConfig(ctx context.Context, request GetSessionEffectiveConfigRequestObject) (GetSessionEffectiveConfigResponseObject, error)
+	// Export a session conversation
+	// (GET /sessions/{id}/export)
+	ExportSessionConversation(ctx context.Context, request ExportSessionConversationRequestObject) (ExportSessionConversationResponseObject, error)
 	// Permanently delete an empty draft session
 	// (DELETE /sessions/{id}/hard-delete-empty)
 	HardDeleteEmptyDraftSession(ctx context.Context, request HardDeleteEmptyDraftSessionRequestObject) (HardDeleteEmptyDraftSessionResponseObject, error)
 	// Interrupt a running session
 	// (POST /sessions/{id}/interrupt)
 	InterruptSession(ctx context.Context, request InterruptSessionRequestObject) (InterruptSessionResponseObject, error)
+	// Replace a session's labels
+	// (PUT /sessions/{id}/labels)
+	SetSessionLabels(ctx context.Context, request SetSessionLabelsRequestObject) (SetSessionLabelsResponseObject, error)
 	// Delete a draft session
 	// (DELETE /sessions/{id}/launch)
 	DeleteDraftSession(ctx context.Context, request DeleteDraftSessionRequestObject) (DeleteDraftSessionResponseObject, error)
@@ -2942,12 +8188,39 @@ type StrictServerInterface interface {
 	// Get conversation messages
 	// (GET /sessions/{id}/messages)
 	GetSessionMessages(ctx context.Context, request GetSessionMessagesRequestObject) (GetSessionMessagesResponseObject, error)
+	// Get session resource usage
+	// (GET /sessions/{id}/resources)
+	GetSessionResources(ctx context.Context, request GetSessionResourcesRequestObject) (GetSessionResourcesResponseObject, error)
 	// Get file snapshots
 	// (GET /sessions/{id}/snapshots)
 	GetSessionSnapshots(ctx context.Context, request GetSessionSnapshotsRequestObject) (GetSessionSnapshotsResponseObject, error)
 	// Get available slash commands
 	// (GET /slash-commands)
 	GetSlashCommands(ctx context.Context, request GetSlashCommandsRequestObject) (GetSlashCommandsResponseObject, error)
+	// List session templates
+	// (GET /templates)
+	ListTemplates(ctx context.Context, request ListTemplatesRequestObject) (ListTemplatesResponseObject, error)
+	// Create a session template
+	// (POST /templates)
+	CreateTemplate(ctx context.Context, request CreateTemplateRequestObject) (CreateTemplateResponseObject, error)
+	// Import a session template
+	// (POST /templates/import)
+	ImportTemplate(ctx context.Context, request ImportTemplateRequestObject) (ImportTemplateResponseObject, error)
+	// Delete a session template
+	// (DELETE /templates/{id})
+	DeleteTemplate(ctx context.Context, request DeleteTemplateRequestObject) (DeleteTemplateResponseObject, error)
+	// Get a session template
+	// (GET /templates/{id})
+	GetTemplate(ctx context.Context, request GetTemplateRequestObject) (GetTemplateResponseObject, error)
+	// Replace a session template
+	// (PUT /templates/{id})
+	UpdateTemplate(ctx context.Context, request UpdateTemplateRequestObject) (UpdateTemplateResponseObject, error)
+	// Export a session template
+	// (GET /templates/{id}/export)
+	ExportTemplate(ctx context.Context, request ExportTemplateRequestObject) (ExportTemplateResponseObject, error)
+	// Launch a session from a template
+	// (POST /templates/{id}/sessions)
+	LaunchTemplate(ctx context.Context, request LaunchTemplateRequestObject) (LaunchTemplateResponseObject, error)
 	// Get user settings
 	// (GET /user-settings)
 	GetUserSettings(ctx context.Context, request GetUserSettingsRequestObject) (GetUserSettingsResponseObject, error)
@@ -2957,25 +8230,1494 @@ type StrictServerInterface interface {
 	// Validate directory existence
 	// (POST /validate-directory)
 	ValidateDirectory(ctx context.Context, request ValidateDirectoryRequestObject) (ValidateDirectoryResponseObject, error)
+	// List webhooks
+	// (GET /webhooks)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	// Create a webhook
+	// (POST /webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Delete a webhook
+	// (DELETE /webhooks/{id})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// Get webhook details
+	// (GET /webhooks/{id})
+	GetWebhook(ctx context.Context, request GetWebhookRequestObject) (GetWebhookResponseObject, error)
+	// Update a webhook
+	// (PATCH /webhooks/{id})
+	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
+	// List webhook deliveries
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// DiscoverAgents operation middleware
+func (sh *strictHandler) DiscoverAgents(ctx *gin.Context) {
+	var request DiscoverAgentsRequestObject
+
+	var body DiscoverAgentsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DiscoverAgents(ctx, request.(DiscoverAgentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiscoverAgents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DiscoverAgentsResponseObject); ok {
+		if err := validResponse.VisitDiscoverAgentsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListApprovals operation middleware
+func (sh *strictHandler) ListApprovals(ctx *gin.Context, params ListApprovalsParams) {
+	var request ListApprovalsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListApprovals(ctx, request.(ListApprovalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListApprovals")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListApprovalsResponseObject); ok {
+		if err := validResponse.VisitListApprovalsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateApproval operation middleware
+func (sh *strictHandler) CreateApproval(ctx *gin.Context) {
+	var request CreateApprovalRequestObject
+
+	var body CreateApprovalJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateApproval(ctx, request.(CreateApprovalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateApproval")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateApprovalResponseObject); ok {
+		if err := validResponse.VisitCreateApprovalResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetApproval operation middleware
+func (sh *strictHandler) GetApproval(ctx *gin.Context, id ApprovalId) {
+	var request GetApprovalRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApproval(ctx, request.(GetApprovalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApproval")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApprovalResponseObject); ok {
+		if err := validResponse.VisitGetApprovalResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DecideApproval operation middleware
+func (sh *strictHandler) DecideApproval(ctx *gin.Context, id ApprovalId) {
+	var request DecideApprovalRequestObject
+
+	request.Id = id
+
+	var body DecideApprovalJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DecideApproval(ctx, request.(DecideApprovalRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DecideApproval")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DecideApprovalResponseObject); ok {
+		if err := validResponse.VisitDecideApprovalResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListBackups operation middleware
+func (sh *strictHandler) ListBackups(ctx *gin.Context) {
+	var request ListBackupsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBackups(ctx, request.(ListBackupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBackups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListBackupsResponseObject); ok {
+		if err := validResponse.VisitListBackupsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateBackup operation middleware
+func (sh *strictHandler) CreateBackup(ctx *gin.Context) {
+	var request CreateBackupRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateBackup(ctx, request.(CreateBackupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateBackup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateBackupResponseObject); ok {
+		if err := validResponse.VisitCreateBackupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreBackup operation middleware
+func (sh *strictHandler) RestoreBackup(ctx *gin.Context, name string) {
+	var request RestoreBackupRequestObject
+
+	request.Name = name
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreBackup(ctx, request.(RestoreBackupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreBackup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RestoreBackupResponseObject); ok {
+		if err := validResponse.VisitRestoreBackupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListBatches operation middleware
+func (sh *strictHandler) ListBatches(ctx *gin.Context) {
+	var request ListBatchesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBatches(ctx, request.(ListBatchesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBatches")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListBatchesResponseObject); ok {
+		if err := validResponse.VisitListBatchesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LaunchBatch operation middleware
+func (sh *strictHandler) LaunchBatch(ctx *gin.Context) {
+	var request LaunchBatchRequestObject
+
+	var body LaunchBatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.LaunchBatch(ctx, request.(LaunchBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LaunchBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(LaunchBatchResponseObject); ok {
+		if err := validResponse.VisitLaunchBatchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBatch operation middleware
+func (sh *strictHandler) GetBatch(ctx *gin.Context, id BatchId) {
+	var request GetBatchRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBatch(ctx, request.(GetBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetBatchResponseObject); ok {
+		if err := validResponse.VisitGetBatchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CompareBatch operation middleware
+func (sh *strictHandler) CompareBatch(ctx *gin.Context, id BatchId) {
+	var request CompareBatchRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CompareBatch(ctx, request.(CompareBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CompareBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CompareBatchResponseObject); ok {
+		if err := validResponse.VisitCompareBatchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetConfig operation middleware
+func (sh *strictHandler) GetConfig(ctx *gin.Context) {
+	var request GetConfigRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConfig")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
+		if err := validResponse.VisitGetConfigResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateConfig operation middleware
+func (sh *strictHandler) UpdateConfig(ctx *gin.Context) {
+	var request UpdateConfigRequestObject
+
+	var body UpdateConfigJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateConfig(ctx, request.(UpdateConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateConfig")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateConfigResponseObject); ok {
+		if err := validResponse.VisitUpdateConfigResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SearchConversations operation middleware
+func (sh *strictHandler) SearchConversations(ctx *gin.Context, params SearchConversationsParams) {
+	var request SearchConversationsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchConversations(ctx, request.(SearchConversationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchConversations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SearchConversationsResponseObject); ok {
+		if err := validResponse.VisitSearchConversationsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDebugInfo operation middleware
+func (sh *strictHandler) GetDebugInfo(ctx *gin.Context) {
+	var request GetDebugInfoRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDebugInfo(ctx, request.(GetDebugInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDebugInfo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetDebugInfoResponseObject); ok {
+		if err := validResponse.VisitGetDebugInfoResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateDirectory operation middleware
+func (sh *strictHandler) CreateDirectory(ctx *gin.Context) {
+	var request CreateDirectoryRequestObject
+
+	var body CreateDirectoryJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateDirectory(ctx, request.(CreateDirectoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateDirectory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateDirectoryResponseObject); ok {
+		if err := validResponse.VisitCreateDirectoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// FuzzySearchFiles operation middleware
+func (sh *strictHandler) FuzzySearchFiles(ctx *gin.Context) {
+	var request FuzzySearchFilesRequestObject
+
+	var body FuzzySearchFilesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.FuzzySearchFiles(ctx, request.(FuzzySearchFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "FuzzySearchFiles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(FuzzySearchFilesResponseObject); ok {
+		if err := validResponse.VisitFuzzySearchFilesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(ctx *gin.Context) {
+	var request GetHealthRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListLabels operation middleware
+func (sh *strictHandler) ListLabels(ctx *gin.Context) {
+	var request ListLabelsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListLabels(ctx, request.(ListLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListLabels")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListLabelsResponseObject); ok {
+		if err := validResponse.VisitListLabelsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateLabel operation middleware
+func (sh *strictHandler) CreateLabel(ctx *gin.Context) {
+	var request CreateLabelRequestObject
+
+	var body CreateLabelJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLabel(ctx, request.(CreateLabelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLabel")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateLabelResponseObject); ok {
+		if err := validResponse.VisitCreateLabelResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteLabel operation middleware
+func (sh *strictHandler) DeleteLabel(ctx *gin.Context, id LabelId) {
+	var request DeleteLabelRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLabel(ctx, request.(DeleteLabelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLabel")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteLabelResponseObject); ok {
+		if err := validResponse.VisitDeleteLabelResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RunMaintenance operation middleware
+func (sh *strictHandler) RunMaintenance(ctx *gin.Context) {
+	var request RunMaintenanceRequestObject
+
+	var body RunMaintenanceJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RunMaintenance(ctx, request.(RunMaintenanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunMaintenance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RunMaintenanceResponseObject); ok {
+		if err := validResponse.VisitRunMaintenanceResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListPipelineRuns operation middleware
+func (sh *strictHandler) ListPipelineRuns(ctx *gin.Context) {
+	var request ListPipelineRunsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPipelineRuns(ctx, request.(ListPipelineRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPipelineRuns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListPipelineRunsResponseObject); ok {
+		if err := validResponse.VisitListPipelineRunsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StartPipelineRun operation middleware
+func (sh *strictHandler) StartPipelineRun(ctx *gin.Context) {
+	var request StartPipelineRunRequestObject
+
+	var body StartPipelineRunJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.StartPipelineRun(ctx, request.(StartPipelineRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StartPipelineRun")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(StartPipelineRunResponseObject); ok {
+		if err := validResponse.VisitStartPipelineRunResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPipelineRun operation middleware
+func (sh *strictHandler) GetPipelineRun(ctx *gin.Context, id PipelineRunId) {
+	var request GetPipelineRunRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPipelineRun(ctx, request.(GetPipelineRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPipelineRun")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPipelineRunResponseObject); ok {
+		if err := validResponse.VisitGetPipelineRunResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PausePipelineRun operation middleware
+func (sh *strictHandler) PausePipelineRun(ctx *gin.Context, id PipelineRunId) {
+	var request PausePipelineRunRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PausePipelineRun(ctx, request.(PausePipelineRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PausePipelineRun")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PausePipelineRunResponseObject); ok {
+		if err := validResponse.VisitPausePipelineRunResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResumePipelineRun operation middleware
+func (sh *strictHandler) ResumePipelineRun(ctx *gin.Context, id PipelineRunId) {
+	var request ResumePipelineRunRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ResumePipelineRun(ctx, request.(ResumePipelineRunRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResumePipelineRun")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ResumePipelineRunResponseObject); ok {
+		if err := validResponse.VisitResumePipelineRunResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TrustProjectConfig operation middleware
+func (sh *strictHandler) TrustProjectConfig(ctx *gin.Context) {
+	var request TrustProjectConfigRequestObject
+
+	var body TrustProjectConfigJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TrustProjectConfig(ctx, request.(TrustProjectConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TrustProjectConfig")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(TrustProjectConfigResponseObject); ok {
+		if err := validResponse.VisitTrustProjectConfigResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ValidateProjectConfig operation middleware
+func (sh *strictHandler) ValidateProjectConfig(ctx *gin.Context) {
+	var request ValidateProjectConfigRequestObject
+
+	var body ValidateProjectConfigJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ValidateProjectConfig(ctx, request.(ValidateProjectConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ValidateProjectConfig")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ValidateProjectConfigResponseObject); ok {
+		if err := validResponse.VisitValidateProjectConfigResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProjects operation middleware
+func (sh *strictHandler) ListProjects(ctx *gin.Context) {
+	var request ListProjectsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProjects(ctx, request.(ListProjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProjects")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListProjectsResponseObject); ok {
+		if err := validResponse.VisitListProjectsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRecentPaths operation middleware
+func (sh *strictHandler) GetRecentPaths(ctx *gin.Context, params GetRecentPathsParams) {
+	var request GetRecentPathsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecentPaths(ctx, request.(GetRecentPathsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecentPaths")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetRecentPathsResponseObject); ok {
+		if err := validResponse.VisitGetRecentPathsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListSchedules operation middleware
+func (sh *strictHandler) ListSchedules(ctx *gin.Context) {
+	var request ListSchedulesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSchedules(ctx, request.(ListSchedulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSchedules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListSchedulesResponseObject); ok {
+		if err := validResponse.VisitListSchedulesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateSchedule operation middleware
+func (sh *strictHandler) CreateSchedule(ctx *gin.Context) {
+	var request CreateScheduleRequestObject
+
+	var body CreateScheduleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSchedule(ctx, request.(CreateScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateScheduleResponseObject); ok {
+		if err := validResponse.VisitCreateScheduleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSchedule operation middleware
+func (sh *strictHandler) DeleteSchedule(ctx *gin.Context, id ScheduleId) {
+	var request DeleteScheduleRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSchedule(ctx, request.(DeleteScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteScheduleResponseObject); ok {
+		if err := validResponse.VisitDeleteScheduleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSchedule operation middleware
+func (sh *strictHandler) GetSchedule(ctx *gin.Context, id ScheduleId) {
+	var request GetScheduleRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSchedule(ctx, request.(GetScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetScheduleResponseObject); ok {
+		if err := validResponse.VisitGetScheduleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateSchedule operation middleware
+func (sh *strictHandler) UpdateSchedule(ctx *gin.Context, id ScheduleId) {
+	var request UpdateScheduleRequestObject
+
+	request.Id = id
+
+	var body UpdateScheduleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateSchedule(ctx, request.(UpdateScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateSchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateScheduleResponseObject); ok {
+		if err := validResponse.VisitUpdateScheduleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListScheduleRuns operation middleware
+func (sh *strictHandler) ListScheduleRuns(ctx *gin.Context, id ScheduleId, params ListScheduleRunsParams) {
+	var request ListScheduleRunsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListScheduleRuns(ctx, request.(ListScheduleRunsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListScheduleRuns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListScheduleRunsResponseObject); ok {
+		if err := validResponse.VisitListScheduleRunsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TriggerSchedule operation middleware
+func (sh *strictHandler) TriggerSchedule(ctx *gin.Context, id ScheduleId) {
+	var request TriggerScheduleRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TriggerSchedule(ctx, request.(TriggerScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TriggerSchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(TriggerScheduleResponseObject); ok {
+		if err := validResponse.VisitTriggerScheduleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListSavedFilters operation middleware
+func (sh *strictHandler) ListSavedFilters(ctx *gin.Context) {
+	var request ListSavedFiltersRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSavedFilters(ctx, request.(ListSavedFiltersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSavedFilters")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListSavedFiltersResponseObject); ok {
+		if err := validResponse.VisitListSavedFiltersResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateSavedFilter operation middleware
+func (sh *strictHandler) CreateSavedFilter(ctx *gin.Context) {
+	var request CreateSavedFilterRequestObject
+
+	var body CreateSavedFilterJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSavedFilter(ctx, request.(CreateSavedFilterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSavedFilter")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateSavedFilterResponseObject); ok {
+		if err := validResponse.VisitCreateSavedFilterResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSavedFilter operation middleware
+func (sh *strictHandler) DeleteSavedFilter(ctx *gin.Context, id SavedFilterId) {
+	var request DeleteSavedFilterRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSavedFilter(ctx, request.(DeleteSavedFilterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSavedFilter")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteSavedFilterResponseObject); ok {
+		if err := validResponse.VisitDeleteSavedFilterResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateSavedFilter operation middleware
+func (sh *strictHandler) UpdateSavedFilter(ctx *gin.Context, id SavedFilterId) {
+	var request UpdateSavedFilterRequestObject
+
+	request.Id = id
+
+	var body UpdateSavedFilterJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateSavedFilter(ctx, request.(UpdateSavedFilterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateSavedFilter")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateSavedFilterResponseObject); ok {
+		if err := validResponse.VisitUpdateSavedFilterResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListSessions operation middleware
+func (sh *strictHandler) ListSessions(ctx *gin.Context, params ListSessionsParams) {
+	var request ListSessionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSessions(ctx, request.(ListSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListSessionsResponseObject); ok {
+		if err := validResponse.VisitListSessionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateSession operation middleware
+func (sh *strictHandler) CreateSession(ctx *gin.Context) {
+	var request CreateSessionRequestObject
+
+	var body CreateSessionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSession(ctx, request.(CreateSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateSessionResponseObject); ok {
+		if err := validResponse.VisitCreateSessionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BulkArchiveSessions operation middleware
+func (sh *strictHandler) BulkArchiveSessions(ctx *gin.Context) {
+	var request BulkArchiveSessionsRequestObject
+
+	var body BulkArchiveSessionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BulkArchiveSessions(ctx, request.(BulkArchiveSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BulkArchiveSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BulkArchiveSessionsResponseObject); ok {
+		if err := validResponse.VisitBulkArchiveSessionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportSessionBundle operation middleware
+func (sh *strictHandler) ImportSessionBundle(ctx *gin.Context) {
+	var request ImportSessionBundleRequestObject
+
+	request.Body = ctx.Request.Body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportSessionBundle(ctx, request.(ImportSessionBundleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportSessionBundle")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ImportSessionBundleResponseObject); ok {
+		if err := validResponse.VisitImportSessionBundleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BulkRestoreDrafts operation middleware
+func (sh *strictHandler) BulkRestoreDrafts(ctx *gin.Context) {
+	var request BulkRestoreDraftsRequestObject
+
+	var body BulkRestoreDraftsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BulkRestoreDrafts(ctx, request.(BulkRestoreDraftsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BulkRestoreDrafts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BulkRestoreDraftsResponseObject); ok {
+		if err := validResponse.VisitBulkRestoreDraftsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+// SearchSessions operation middleware
+func (sh *strictHandler) SearchSessions(ctx *gin.Context, params SearchSessionsParams) {
+	var request SearchSessionsRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchSessions(ctx, request.(SearchSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SearchSessionsResponseObject); ok {
+		if err := validResponse.VisitSearchSessionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+// GetSession operation middleware
+func (sh *strictHandler) GetSession(ctx *gin.Context, id SessionId) {
+	var request GetSessionRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSession(ctx, request.(GetSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSessionResponseObject); ok {
+		if err := validResponse.VisitGetSessionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// DiscoverAgents operation middleware
-func (sh *strictHandler) DiscoverAgents(ctx *gin.Context) {
-	var request DiscoverAgentsRequestObject
+// UpdateSession operation middleware
+func (sh *strictHandler) UpdateSession(ctx *gin.Context, id SessionId) {
+	var request UpdateSessionRequestObject
 
-	var body DiscoverAgentsJSONRequestBody
+	request.Id = id
+
+	var body UpdateSessionJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -2984,10 +9726,10 @@ func (sh *strictHandler) DiscoverAgents(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DiscoverAgents(ctx, request.(DiscoverAgentsRequestObject))
+		return sh.ssi.UpdateSession(ctx, request.(UpdateSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DiscoverAgents")
+		handler = middleware(handler, "UpdateSession")
 	}
 
 	response, err := handler(ctx, request)
@@ -2995,8 +9737,8 @@ func (sh *strictHandler) DiscoverAgents(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DiscoverAgentsResponseObject); ok {
-		if err := validResponse.VisitDiscoverAgentsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateSessionResponseObject); ok {
+		if err := validResponse.VisitUpdateSessionResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3004,17 +9746,18 @@ func (sh *strictHandler) DiscoverAgents(ctx *gin.Context) {
 	}
 }
 
-// ListApprovals operation middleware
-func (sh *strictHandler) ListApprovals(ctx *gin.Context, params ListApprovalsParams) {
-	var request ListApprovalsRequestObject
+// ExportSessionBundle operation middleware
+func (sh *strictHandler) ExportSessionBundle(ctx *gin.Context, id SessionId, params ExportSessionBundleParams) {
+	var request ExportSessionBundleRequestObject
 
+	request.Id = id
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListApprovals(ctx, request.(ListApprovalsRequestObject))
+		return sh.ssi.ExportSessionBundle(ctx, request.(ExportSessionBundleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListApprovals")
+		handler = middleware(handler, "ExportSessionBundle")
 	}
 
 	response, err := handler(ctx, request)
@@ -3022,8 +9765,8 @@ func (sh *strictHandler) ListApprovals(ctx *gin.Context, params ListApprovalsPar
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListApprovalsResponseObject); ok {
-		if err := validResponse.VisitListApprovalsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ExportSessionBundleResponseObject); ok {
+		if err := validResponse.VisitExportSessionBundleResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3031,11 +9774,13 @@ func (sh *strictHandler) ListApprovals(ctx *gin.Context, params ListApprovalsPar
 	}
 }
 
-// CreateApproval operation middleware
-func (sh *strictHandler) CreateApproval(ctx *gin.Context) {
-	var request CreateApprovalRequestObject
+// CompactSession operation middleware
+func (sh *strictHandler) CompactSession(ctx *gin.Context, id SessionId) {
+	var request CompactSessionRequestObject
 
-	var body CreateApprovalJSONRequestBody
+	request.Id = id
+
+	var body CompactSessionJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3044,10 +9789,10 @@ func (sh *strictHandler) CreateApproval(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateApproval(ctx, request.(CreateApprovalRequestObject))
+		return sh.ssi.CompactSession(ctx, request.(CompactSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateApproval")
+		handler = middleware(handler, "CompactSession")
 	}
 
 	response, err := handler(ctx, request)
@@ -3055,8 +9800,8 @@ func (sh *strictHandler) CreateApproval(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateApprovalResponseObject); ok {
-		if err := validResponse.VisitCreateApprovalResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CompactSessionResponseObject); ok {
+		if err := validResponse.VisitCompactSessionResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3064,17 +9809,25 @@ func (sh *strictHandler) CreateApproval(ctx *gin.Context) {
 	}
 }
 
-// GetApproval operation middleware
-func (sh *strictHandler) GetApproval(ctx *gin.Context, id ApprovalId) {
-	var request GetApprovalRequestObject
+// ContinueSession operation middleware
+func (sh *strictHandler) ContinueSession(ctx *gin.Context, id SessionId) {
+	var request ContinueSessionRequestObject
 
 	request.Id = id
 
+	var body ContinueSessionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetApproval(ctx, request.(GetApprovalRequestObject))
+		return sh.ssi.ContinueSession(ctx, request.(ContinueSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetApproval")
+		handler = middleware(handler, "ContinueSession")
 	}
 
 	response, err := handler(ctx, request)
@@ -3082,8 +9835,8 @@ func (sh *strictHandler) GetApproval(ctx *gin.Context, id ApprovalId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetApprovalResponseObject); ok {
-		if err := validResponse.VisitGetApprovalResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ContinueSessionResponseObject); ok {
+		if err := validResponse.VisitContinueSessionResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3091,25 +9844,45 @@ func (sh *strictHandler) GetApproval(ctx *gin.Context, id ApprovalId) {
 	}
 }
 
-// DecideApproval operation middleware
-func (sh *strictHandler) DecideApproval(ctx *gin.Context, id ApprovalId) {
-	var request DecideApprovalRequestObject
+// GetSessionEffectiveConfig operation middleware
+func (sh *strictHandler) GetSessionEffectiveConfig(ctx *gin.Context, id SessionId) {
+	var request GetSessionEffectiveConfigRequestObject
 
 	request.Id = id
 
-	var body DecideApprovalJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSessionEffectiveConfig(ctx, request.(GetSessionEffectiveConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSessionEffectiveConfig")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSessionEffectiveConfigResponseObject); ok {
+		if err := validResponse.VisitGetSessionEffectiveConfigResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// ExportSessionConversation operation middleware
+func (sh *strictHandler) ExportSessionConversation(ctx *gin.Context, id SessionId, params ExportSessionConversationParams) {
+	var request ExportSessionConversationRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DecideApproval(ctx, request.(DecideApprovalRequestObject))
+		return sh.ssi.ExportSessionConversation(ctx, request.(ExportSessionConversationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DecideApproval")
+		handler = middleware(handler, "ExportSessionConversation")
 	}
 
 	response, err := handler(ctx, request)
@@ -3117,8 +9890,8 @@ func (sh *strictHandler) DecideApproval(ctx *gin.Context, id ApprovalId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DecideApprovalResponseObject); ok {
-		if err := validResponse.VisitDecideApprovalResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ExportSessionConversationResponseObject); ok {
+		if err := validResponse.VisitExportSessionConversationResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3126,15 +9899,17 @@ func (sh *strictHandler) DecideApproval(ctx *gin.Context, id ApprovalId) {
 	}
 }
 
-// GetConfig operation middleware
-func (sh *strictHandler) GetConfig(ctx *gin.Context) {
-	var request GetConfigRequestObject
+// HardDeleteEmptyDraftSession operation middleware
+func (sh *strictHandler) HardDeleteEmptyDraftSession(ctx *gin.Context, id SessionId) {
+	var request HardDeleteEmptyDraftSessionRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+		return sh.ssi.HardDeleteEmptyDraftSession(ctx, request.(HardDeleteEmptyDraftSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConfig")
+		handler = middleware(handler, "HardDeleteEmptyDraftSession")
 	}
 
 	response, err := handler(ctx, request)
@@ -3142,8 +9917,8 @@ func (sh *strictHandler) GetConfig(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
-		if err := validResponse.VisitGetConfigResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(HardDeleteEmptyDraftSessionResponseObject); ok {
+		if err := validResponse.VisitHardDeleteEmptyDraftSessionResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3151,11 +9926,40 @@ func (sh *strictHandler) GetConfig(ctx *gin.Context) {
 	}
 }
 
-// UpdateConfig operation middleware
-func (sh *strictHandler) UpdateConfig(ctx *gin.Context) {
-	var request UpdateConfigRequestObject
+// InterruptSession operation middleware
+func (sh *strictHandler) InterruptSession(ctx *gin.Context, id SessionId) {
+	var request InterruptSessionRequestObject
 
-	var body UpdateConfigJSONRequestBody
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.InterruptSession(ctx, request.(InterruptSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InterruptSession")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(InterruptSessionResponseObject); ok {
+		if err := validResponse.VisitInterruptSessionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetSessionLabels operation middleware
+func (sh *strictHandler) SetSessionLabels(ctx *gin.Context, id SessionId) {
+	var request SetSessionLabelsRequestObject
+
+	request.Id = id
+
+	var body SetSessionLabelsJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3164,10 +9968,10 @@ func (sh *strictHandler) UpdateConfig(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateConfig(ctx, request.(UpdateConfigRequestObject))
+		return sh.ssi.SetSessionLabels(ctx, request.(SetSessionLabelsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateConfig")
+		handler = middleware(handler, "SetSessionLabels")
 	}
 
 	response, err := handler(ctx, request)
@@ -3175,8 +9979,8 @@ func (sh *strictHandler) UpdateConfig(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdateConfigResponseObject); ok {
-		if err := validResponse.VisitUpdateConfigResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(SetSessionLabelsResponseObject); ok {
+		if err := validResponse.VisitSetSessionLabelsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3184,15 +9988,17 @@ func (sh *strictHandler) UpdateConfig(ctx *gin.Context) {
 	}
 }
 
-// GetDebugInfo operation middleware
-func (sh *strictHandler) GetDebugInfo(ctx *gin.Context) {
-	var request GetDebugInfoRequestObject
+// DeleteDraftSession operation middleware
+func (sh *strictHandler) DeleteDraftSession(ctx *gin.Context, id SessionId) {
+	var request DeleteDraftSessionRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDebugInfo(ctx, request.(GetDebugInfoRequestObject))
+		return sh.ssi.DeleteDraftSession(ctx, request.(DeleteDraftSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDebugInfo")
+		handler = middleware(handler, "DeleteDraftSession")
 	}
 
 	response, err := handler(ctx, request)
@@ -3200,8 +10006,8 @@ func (sh *strictHandler) GetDebugInfo(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetDebugInfoResponseObject); ok {
-		if err := validResponse.VisitGetDebugInfoResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteDraftSessionResponseObject); ok {
+		if err := validResponse.VisitDeleteDraftSessionResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3209,11 +10015,13 @@ func (sh *strictHandler) GetDebugInfo(ctx *gin.Context) {
 	}
 }
 
-// CreateDirectory operation middleware
-func (sh *strictHandler) CreateDirectory(ctx *gin.Context) {
-	var request CreateDirectoryRequestObject
+// LaunchDraftSession operation middleware
+func (sh *strictHandler) LaunchDraftSession(ctx *gin.Context, id SessionId) {
+	var request LaunchDraftSessionRequestObject
 
-	var body CreateDirectoryJSONRequestBody
+	request.Id = id
+
+	var body LaunchDraftSessionJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3222,10 +10030,10 @@ func (sh *strictHandler) CreateDirectory(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateDirectory(ctx, request.(CreateDirectoryRequestObject))
+		return sh.ssi.LaunchDraftSession(ctx, request.(LaunchDraftSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateDirectory")
+		handler = middleware(handler, "LaunchDraftSession")
 	}
 
 	response, err := handler(ctx, request)
@@ -3233,8 +10041,8 @@ func (sh *strictHandler) CreateDirectory(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateDirectoryResponseObject); ok {
-		if err := validResponse.VisitCreateDirectoryResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(LaunchDraftSessionResponseObject); ok {
+		if err := validResponse.VisitLaunchDraftSessionResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3242,23 +10050,18 @@ func (sh *strictHandler) CreateDirectory(ctx *gin.Context) {
 	}
 }
 
-// FuzzySearchFiles operation middleware
-func (sh *strictHandler) FuzzySearchFiles(ctx *gin.Context) {
-	var request FuzzySearchFilesRequestObject
+// GetSessionMessages operation middleware
+func (sh *strictHandler) GetSessionMessages(ctx *gin.Context, id SessionId, params GetSessionMessagesParams) {
+	var request GetSessionMessagesRequestObject
 
-	var body FuzzySearchFilesJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.FuzzySearchFiles(ctx, request.(FuzzySearchFilesRequestObject))
+		return sh.ssi.GetSessionMessages(ctx, request.(GetSessionMessagesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "FuzzySearchFiles")
+		handler = middleware(handler, "GetSessionMessages")
 	}
 
 	response, err := handler(ctx, request)
@@ -3266,8 +10069,8 @@ func (sh *strictHandler) FuzzySearchFiles(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(FuzzySearchFilesResponseObject); ok {
-		if err := validResponse.VisitFuzzySearchFilesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetSessionMessagesResponseObject); ok {
+		if err := validResponse.VisitGetSessionMessagesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3275,15 +10078,18 @@ func (sh *strictHandler) FuzzySearchFiles(ctx *gin.Context) {
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(ctx *gin.Context) {
-	var request GetHealthRequestObject
+// GetSessionResources operation middleware
+func (sh *strictHandler) GetSessionResources(ctx *gin.Context, id SessionId, params GetSessionResourcesParams) {
+	var request GetSessionResourcesRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.GetSessionResources(ctx, request.(GetSessionResourcesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "GetSessionResources")
 	}
 
 	response, err := handler(ctx, request)
@@ -3291,8 +10097,8 @@ func (sh *strictHandler) GetHealth(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetSessionResourcesResponseObject); ok {
+		if err := validResponse.VisitGetSessionResourcesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3300,17 +10106,17 @@ func (sh *strictHandler) GetHealth(ctx *gin.Context) {
 	}
 }
 
-// GetRecentPaths operation middleware
-func (sh *strictHandler) GetRecentPaths(ctx *gin.Context, params GetRecentPathsParams) {
-	var request GetRecentPathsRequestObject
+// GetSessionSnapshots operation middleware
+func (sh *strictHandler) GetSessionSnapshots(ctx *gin.Context, id SessionId) {
+	var request GetSessionSnapshotsRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRecentPaths(ctx, request.(GetRecentPathsRequestObject))
+		return sh.ssi.GetSessionSnapshots(ctx, request.(GetSessionSnapshotsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRecentPaths")
+		handler = middleware(handler, "GetSessionSnapshots")
 	}
 
 	response, err := handler(ctx, request)
@@ -3318,8 +10124,8 @@ func (sh *strictHandler) GetRecentPaths(ctx *gin.Context, params GetRecentPathsP
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetRecentPathsResponseObject); ok {
-		if err := validResponse.VisitGetRecentPathsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetSessionSnapshotsResponseObject); ok {
+		if err := validResponse.VisitGetSessionSnapshotsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3327,17 +10133,17 @@ func (sh *strictHandler) GetRecentPaths(ctx *gin.Context, params GetRecentPathsP
 	}
 }
 
-// ListSessions operation middleware
-func (sh *strictHandler) ListSessions(ctx *gin.Context, params ListSessionsParams) {
-	var request ListSessionsRequestObject
+// GetSlashCommands operation middleware
+func (sh *strictHandler) GetSlashCommands(ctx *gin.Context, params GetSlashCommandsParams) {
+	var request GetSlashCommandsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListSessions(ctx, request.(ListSessionsRequestObject))
+		return sh.ssi.GetSlashCommands(ctx, request.(GetSlashCommandsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListSessions")
+		handler = middleware(handler, "GetSlashCommands")
 	}
 
 	response, err := handler(ctx, request)
@@ -3345,8 +10151,8 @@ func (sh *strictHandler) ListSessions(ctx *gin.Context, params ListSessionsParam
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListSessionsResponseObject); ok {
-		if err := validResponse.VisitListSessionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetSlashCommandsResponseObject); ok {
+		if err := validResponse.VisitGetSlashCommandsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3354,23 +10160,15 @@ func (sh *strictHandler) ListSessions(ctx *gin.Context, params ListSessionsParam
 	}
 }
 
-// CreateSession operation middleware
-func (sh *strictHandler) CreateSession(ctx *gin.Context) {
-	var request CreateSessionRequestObject
-
-	var body CreateSessionJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+// ListTemplates operation middleware
+func (sh *strictHandler) ListTemplates(ctx *gin.Context) {
+	var request ListTemplatesRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateSession(ctx, request.(CreateSessionRequestObject))
+		return sh.ssi.ListTemplates(ctx, request.(ListTemplatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateSession")
+		handler = middleware(handler, "ListTemplates")
 	}
 
 	response, err := handler(ctx, request)
@@ -3378,8 +10176,8 @@ func (sh *strictHandler) CreateSession(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateSessionResponseObject); ok {
-		if err := validResponse.VisitCreateSessionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListTemplatesResponseObject); ok {
+		if err := validResponse.VisitListTemplatesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3387,11 +10185,11 @@ func (sh *strictHandler) CreateSession(ctx *gin.Context) {
 	}
 }
 
-// BulkArchiveSessions operation middleware
-func (sh *strictHandler) BulkArchiveSessions(ctx *gin.Context) {
-	var request BulkArchiveSessionsRequestObject
+// CreateTemplate operation middleware
+func (sh *strictHandler) CreateTemplate(ctx *gin.Context) {
+	var request CreateTemplateRequestObject
 
-	var body BulkArchiveSessionsJSONRequestBody
+	var body CreateTemplateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3400,10 +10198,10 @@ func (sh *strictHandler) BulkArchiveSessions(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.BulkArchiveSessions(ctx, request.(BulkArchiveSessionsRequestObject))
+		return sh.ssi.CreateTemplate(ctx, request.(CreateTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BulkArchiveSessions")
+		handler = middleware(handler, "CreateTemplate")
 	}
 
 	response, err := handler(ctx, request)
@@ -3411,8 +10209,8 @@ func (sh *strictHandler) BulkArchiveSessions(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(BulkArchiveSessionsResponseObject); ok {
-		if err := validResponse.VisitBulkArchiveSessionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateTemplateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3420,11 +10218,11 @@ func (sh *strictHandler) BulkArchiveSessions(ctx *gin.Context) {
 	}
 }
 
-// BulkRestoreDrafts operation middleware
-func (sh *strictHandler) BulkRestoreDrafts(ctx *gin.Context) {
-	var request BulkRestoreDraftsRequestObject
+// ImportTemplate operation middleware
+func (sh *strictHandler) ImportTemplate(ctx *gin.Context) {
+	var request ImportTemplateRequestObject
 
-	var body BulkRestoreDraftsJSONRequestBody
+	var body ImportTemplateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3433,10 +10231,10 @@ func (sh *strictHandler) BulkRestoreDrafts(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.BulkRestoreDrafts(ctx, request.(BulkRestoreDraftsRequestObject))
+		return sh.ssi.ImportTemplate(ctx, request.(ImportTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BulkRestoreDrafts")
+		handler = middleware(handler, "ImportTemplate")
 	}
 
 	response, err := handler(ctx, request)
@@ -3444,8 +10242,8 @@ func (sh *strictHandler) BulkRestoreDrafts(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(BulkRestoreDraftsResponseObject); ok {
-		if err := validResponse.VisitBulkRestoreDraftsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ImportTemplateResponseObject); ok {
+		if err := validResponse.VisitImportTemplateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3453,17 +10251,17 @@ func (sh *strictHandler) BulkRestoreDrafts(ctx *gin.Context) {
 	}
 }
 
-// SearchSessions operation middleware
-func (sh *strictHandler) SearchSessions(ctx *gin.Context, params SearchSessionsParams) {
-	var request SearchSessionsRequestObject
+// DeleteTemplate operation middleware
+func (sh *strictHandler) DeleteTemplate(ctx *gin.Context, id TemplateId) {
+	var request DeleteTemplateRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SearchSessions(ctx, request.(SearchSessionsRequestObject))
+		return sh.ssi.DeleteTemplate(ctx, request.(DeleteTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SearchSessions")
+		handler = middleware(handler, "DeleteTemplate")
 	}
 
 	response, err := handler(ctx, request)
@@ -3471,8 +10269,8 @@ func (sh *strictHandler) SearchSessions(ctx *gin.Context, params SearchSessionsP
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(SearchSessionsResponseObject); ok {
-		if err := validResponse.VisitSearchSessionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteTemplateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3480,17 +10278,17 @@ func (sh *strictHandler) SearchSessions(ctx *gin.Context, params SearchSessionsP
 	}
 }
 
-// GetSession operation middleware
-func (sh *strictHandler) GetSession(ctx *gin.Context, id SessionId) {
-	var request GetSessionRequestObject
+// GetTemplate operation middleware
+func (sh *strictHandler) GetTemplate(ctx *gin.Context, id TemplateId) {
+	var request GetTemplateRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSession(ctx, request.(GetSessionRequestObject))
+		return sh.ssi.GetTemplate(ctx, request.(GetTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSession")
+		handler = middleware(handler, "GetTemplate")
 	}
 
 	response, err := handler(ctx, request)
@@ -3498,8 +10296,8 @@ func (sh *strictHandler) GetSession(ctx *gin.Context, id SessionId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetSessionResponseObject); ok {
-		if err := validResponse.VisitGetSessionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetTemplateResponseObject); ok {
+		if err := validResponse.VisitGetTemplateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3507,13 +10305,13 @@ func (sh *strictHandler) GetSession(ctx *gin.Context, id SessionId) {
 	}
 }
 
-// UpdateSession operation middleware
-func (sh *strictHandler) UpdateSession(ctx *gin.Context, id SessionId) {
-	var request UpdateSessionRequestObject
+// UpdateTemplate operation middleware
+func (sh *strictHandler) UpdateTemplate(ctx *gin.Context, id TemplateId) {
+	var request UpdateTemplateRequestObject
 
 	request.Id = id
 
-	var body UpdateSessionJSONRequestBody
+	var body UpdateTemplateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3522,10 +10320,10 @@ func (sh *strictHandler) UpdateSession(ctx *gin.Context, id SessionId) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateSession(ctx, request.(UpdateSessionRequestObject))
+		return sh.ssi.UpdateTemplate(ctx, request.(UpdateTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateSession")
+		handler = middleware(handler, "UpdateTemplate")
 	}
 
 	response, err := handler(ctx, request)
@@ -3533,8 +10331,8 @@ func (sh *strictHandler) UpdateSession(ctx *gin.Context, id SessionId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdateSessionResponseObject); ok {
-		if err := validResponse.VisitUpdateSessionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateTemplateResponseObject); ok {
+		if err := validResponse.VisitUpdateTemplateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3542,25 +10340,17 @@ func (sh *strictHandler) UpdateSession(ctx *gin.Context, id SessionId) {
 	}
 }
 
-// ContinueSession operation middleware
-func (sh *strictHandler) ContinueSession(ctx *gin.Context, id SessionId) {
-	var request ContinueSessionRequestObject
+// ExportTemplate operation middleware
+func (sh *strictHandler) ExportTemplate(ctx *gin.Context, id TemplateId) {
+	var request ExportTemplateRequestObject
 
 	request.Id = id
 
-	var body ContinueSessionJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ContinueSession(ctx, request.(ContinueSessionRequestObject))
+		return sh.ssi.ExportTemplate(ctx, request.(ExportTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ContinueSession")
+		handler = middleware(handler, "ExportTemplate")
 	}
 
 	response, err := handler(ctx, request)
@@ -3568,8 +10358,8 @@ func (sh *strictHandler) ContinueSession(ctx *gin.Context, id SessionId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ContinueSessionResponseObject); ok {
-		if err := validResponse.VisitContinueSessionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ExportTemplateResponseObject); ok {
+		if err := validResponse.VisitExportTemplateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3577,17 +10367,25 @@ func (sh *strictHandler) ContinueSession(ctx *gin.Context, id SessionId) {
 	}
 }
 
-// HardDeleteEmptyDraftSession operation middleware
-func (sh *strictHandler) HardDeleteEmptyDraftSession(ctx *gin.Context, id SessionId) {
-	var request HardDeleteEmptyDraftSessionRequestObject
+// LaunchTemplate operation middleware
+func (sh *strictHandler) LaunchTemplate(ctx *gin.Context, id TemplateId) {
+	var request LaunchTemplateRequestObject
 
 	request.Id = id
 
+	var body LaunchTemplateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.HardDeleteEmptyDraftSession(ctx, request.(HardDeleteEmptyDraftSessionRequestObject))
+		return sh.ssi.LaunchTemplate(ctx, request.(LaunchTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "HardDeleteEmptyDraftSession")
+		handler = middleware(handler, "LaunchTemplate")
 	}
 
 	response, err := handler(ctx, request)
@@ -3595,8 +10393,8 @@ func (sh *strictHandler) HardDeleteEmptyDraftSession(ctx *gin.Context, id Sessio
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(HardDeleteEmptyDraftSessionResponseObject); ok {
-		if err := validResponse.VisitHardDeleteEmptyDraftSessionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(LaunchTemplateResponseObject); ok {
+		if err := validResponse.VisitLaunchTemplateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3604,17 +10402,15 @@ func (sh *strictHandler) HardDeleteEmptyDraftSession(ctx *gin.Context, id Sessio
 	}
 }
 
-// InterruptSession operation middleware
-func (sh *strictHandler) InterruptSession(ctx *gin.Context, id SessionId) {
-	var request InterruptSessionRequestObject
-
-	request.Id = id
+// GetUserSettings operation middleware
+func (sh *strictHandler) GetUserSettings(ctx *gin.Context) {
+	var request GetUserSettingsRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.InterruptSession(ctx, request.(InterruptSessionRequestObject))
+		return sh.ssi.GetUserSettings(ctx, request.(GetUserSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "InterruptSession")
+		handler = middleware(handler, "GetUserSettings")
 	}
 
 	response, err := handler(ctx, request)
@@ -3622,8 +10418,8 @@ func (sh *strictHandler) InterruptSession(ctx *gin.Context, id SessionId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(InterruptSessionResponseObject); ok {
-		if err := validResponse.VisitInterruptSessionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUserSettingsResponseObject); ok {
+		if err := validResponse.VisitGetUserSettingsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3631,17 +10427,23 @@ func (sh *strictHandler) InterruptSession(ctx *gin.Context, id SessionId) {
 	}
 }
 
-// DeleteDraftSession operation middleware
-func (sh *strictHandler) DeleteDraftSession(ctx *gin.Context, id SessionId) {
-	var request DeleteDraftSessionRequestObject
+// UpdateUserSettings operation middleware
+func (sh *strictHandler) UpdateUserSettings(ctx *gin.Context) {
+	var request UpdateUserSettingsRequestObject
 
-	request.Id = id
+	var body UpdateUserSettingsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteDraftSession(ctx, request.(DeleteDraftSessionRequestObject))
+		return sh.ssi.UpdateUserSettings(ctx, request.(UpdateUserSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteDraftSession")
+		handler = middleware(handler, "UpdateUserSettings")
 	}
 
 	response, err := handler(ctx, request)
@@ -3649,8 +10451,8 @@ func (sh *strictHandler) DeleteDraftSession(ctx *gin.Context, id SessionId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteDraftSessionResponseObject); ok {
-		if err := validResponse.VisitDeleteDraftSessionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateUserSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateUserSettingsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3658,13 +10460,11 @@ func (sh *strictHandler) DeleteDraftSession(ctx *gin.Context, id SessionId) {
 	}
 }
 
-// LaunchDraftSession operation middleware
-func (sh *strictHandler) LaunchDraftSession(ctx *gin.Context, id SessionId) {
-	var request LaunchDraftSessionRequestObject
-
-	request.Id = id
+// ValidateDirectory operation middleware
+func (sh *strictHandler) ValidateDirectory(ctx *gin.Context) {
+	var request ValidateDirectoryRequestObject
 
-	var body LaunchDraftSessionJSONRequestBody
+	var body ValidateDirectoryJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3673,10 +10473,10 @@ func (sh *strictHandler) LaunchDraftSession(ctx *gin.Context, id SessionId) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.LaunchDraftSession(ctx, request.(LaunchDraftSessionRequestObject))
+		return sh.ssi.ValidateDirectory(ctx, request.(ValidateDirectoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LaunchDraftSession")
+		handler = middleware(handler, "ValidateDirectory")
 	}
 
 	response, err := handler(ctx, request)
@@ -3684,8 +10484,8 @@ func (sh *strictHandler) LaunchDraftSession(ctx *gin.Context, id SessionId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(LaunchDraftSessionResponseObject); ok {
-		if err := validResponse.VisitLaunchDraftSessionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ValidateDirectoryResponseObject); ok {
+		if err := validResponse.VisitValidateDirectoryResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3693,17 +10493,15 @@ func (sh *strictHandler) LaunchDraftSession(ctx *gin.Context, id SessionId) {
 	}
 }
 
-// GetSessionMessages operation middleware
-func (sh *strictHandler) GetSessionMessages(ctx *gin.Context, id SessionId) {
-	var request GetSessionMessagesRequestObject
-
-	request.Id = id
+// ListWebhooks operation middleware
+func (sh *strictHandler) ListWebhooks(ctx *gin.Context) {
+	var request ListWebhooksRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSessionMessages(ctx, request.(GetSessionMessagesRequestObject))
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSessionMessages")
+		handler = middleware(handler, "ListWebhooks")
 	}
 
 	response, err := handler(ctx, request)
@@ -3711,8 +10509,8 @@ func (sh *strictHandler) GetSessionMessages(ctx *gin.Context, id SessionId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetSessionMessagesResponseObject); ok {
-		if err := validResponse.VisitGetSessionMessagesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3720,17 +10518,23 @@ func (sh *strictHandler) GetSessionMessages(ctx *gin.Context, id SessionId) {
 	}
 }
 
-// GetSessionSnapshots operation middleware
-func (sh *strictHandler) GetSessionSnapshots(ctx *gin.Context, id SessionId) {
-	var request GetSessionSnapshotsRequestObject
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(ctx *gin.Context) {
+	var request CreateWebhookRequestObject
 
-	request.Id = id
+	var body CreateWebhookJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSessionSnapshots(ctx, request.(GetSessionSnapshotsRequestObject))
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSessionSnapshots")
+		handler = middleware(handler, "CreateWebhook")
 	}
 
 	response, err := handler(ctx, request)
@@ -3738,8 +10542,8 @@ func (sh *strictHandler) GetSessionSnapshots(ctx *gin.Context, id SessionId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetSessionSnapshotsResponseObject); ok {
-		if err := validResponse.VisitGetSessionSnapshotsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3747,17 +10551,17 @@ func (sh *strictHandler) GetSessionSnapshots(ctx *gin.Context, id SessionId) {
 	}
 }
 
-// GetSlashCommands operation middleware
-func (sh *strictHandler) GetSlashCommands(ctx *gin.Context, params GetSlashCommandsParams) {
-	var request GetSlashCommandsRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(ctx *gin.Context, id WebhookId) {
+	var request DeleteWebhookRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSlashCommands(ctx, request.(GetSlashCommandsRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSlashCommands")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(ctx, request)
@@ -3765,8 +10569,8 @@ func (sh *strictHandler) GetSlashCommands(ctx *gin.Context, params GetSlashComma
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetSlashCommandsResponseObject); ok {
-		if err := validResponse.VisitGetSlashCommandsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3774,15 +10578,17 @@ func (sh *strictHandler) GetSlashCommands(ctx *gin.Context, params GetSlashComma
 	}
 }
 
-// GetUserSettings operation middleware
-func (sh *strictHandler) GetUserSettings(ctx *gin.Context) {
-	var request GetUserSettingsRequestObject
+// GetWebhook operation middleware
+func (sh *strictHandler) GetWebhook(ctx *gin.Context, id WebhookId) {
+	var request GetWebhookRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUserSettings(ctx, request.(GetUserSettingsRequestObject))
+		return sh.ssi.GetWebhook(ctx, request.(GetWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUserSettings")
+		handler = middleware(handler, "GetWebhook")
 	}
 
 	response, err := handler(ctx, request)
@@ -3790,8 +10596,8 @@ func (sh *strictHandler) GetUserSettings(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetUserSettingsResponseObject); ok {
-		if err := validResponse.VisitGetUserSettingsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetWebhookResponseObject); ok {
+		if err := validResponse.VisitGetWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3799,11 +10605,13 @@ func (sh *strictHandler) GetUserSettings(ctx *gin.Context) {
 	}
 }
 
-// UpdateUserSettings operation middleware
-func (sh *strictHandler) UpdateUserSettings(ctx *gin.Context) {
-	var request UpdateUserSettingsRequestObject
+// UpdateWebhook operation middleware
+func (sh *strictHandler) UpdateWebhook(ctx *gin.Context, id WebhookId) {
+	var request UpdateWebhookRequestObject
 
-	var body UpdateUserSettingsJSONRequestBody
+	request.Id = id
+
+	var body UpdateWebhookJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3812,10 +10620,10 @@ func (sh *strictHandler) UpdateUserSettings(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateUserSettings(ctx, request.(UpdateUserSettingsRequestObject))
+		return sh.ssi.UpdateWebhook(ctx, request.(UpdateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateUserSettings")
+		handler = middleware(handler, "UpdateWebhook")
 	}
 
 	response, err := handler(ctx, request)
@@ -3823,8 +10631,8 @@ func (sh *strictHandler) UpdateUserSettings(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdateUserSettingsResponseObject); ok {
-		if err := validResponse.VisitUpdateUserSettingsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateWebhookResponseObject); ok {
+		if err := validResponse.VisitUpdateWebhookResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3832,23 +10640,18 @@ func (sh *strictHandler) UpdateUserSettings(ctx *gin.Context) {
 	}
 }
 
-// ValidateDirectory operation middleware
-func (sh *strictHandler) ValidateDirectory(ctx *gin.Context) {
-	var request ValidateDirectoryRequestObject
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(ctx *gin.Context, id WebhookId, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
 
-	var body ValidateDirectoryJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ValidateDirectory(ctx, request.(ValidateDirectoryRequestObject))
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ValidateDirectory")
+		handler = middleware(handler, "ListWebhookDeliveries")
 	}
 
 	response, err := handler(ctx, request)
@@ -3856,8 +10659,8 @@ func (sh *strictHandler) ValidateDirectory(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ValidateDirectoryResponseObject); ok {
-		if err := validResponse.VisitValidateDirectoryResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3868,154 +10671,395 @@ func (sh *strictHandler) ValidateDirectory(ctx *gin.Context) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+R9a28bOZboXyF0L9A2IFm243R6fHGBm9jpbt91HhOnZ3a3HQhUFSVxXCKrSZZtdZD5",
-	"7QseklVkFeshPzO7+RSr+Dg8PDw8b34dJXydc0aYkqPjr6McC7wmigj4C+e54Nc4O0v1XymRiaC5opyN",
-	"jkev7Td0djoaj8gtXucZGR1Dn9nt5s9XP/1lNB5R3TTHajUajxhe6wY0HY1HgvxRUEHS0bESBRmPZLIi",
-	"a6xnUZtct5JKULYcffs2HkkiJeUsBsSF+VSHQfeY4XmSksXB4Yujlz8+CCTfdGOZcyYJYOcNTj+RPwoi",
-	"lf4r4UwRpizaMppgDeP0H1ID+rUC7uuICMGF6ZLqCX49P5282D8YjUdrIiVe6t/eUSkpWyIHHVpQkqXo",
-	"hz8KIjY/GLSUgP5vQRaj49H/mlZ7OTVf5fStnuyTBdssIkThG5zCLHoZ38ajM6aIYDh7WwF5n3UdwbpS",
-	"ojDNAGlK4ITMaKopZZ4cHL7Qk1brdtMjScQ1EciM+YDLbZlgPHrP1c+8YOn913ywfxjspSNSxhVawBQP",
-	"uJ5PRPJCJCQ6OmD89dIuJRc8J0JRQ73BMPVz9QH+gzPk/YwWgq/Rf7x+d67/x9QaK0XEaFw/J3rpTHf4",
-	"TG5Vc2j9K1IcFZKgBRfINpbBAf5/WAM90UidY0kmGU+w4tHJzFlucCfdH+lvrWBXsw2ZxmC5OdHfV0St",
-	"iEAAMKLSTKcHyhAXaJnxuUYjFSRRXGz0vKxYj45/H0Gb0Xhkmoy+jCOsr2JOv5uFhsgtwao68/k/SAIn",
-	"2THo5tYnfL22NBHj6UT8IJFr4+PJfk7RDVUrlOACukWQlQiCFUlnODLHif6myUnRNZEKr/PReLTgYq0b",
-	"j1KsyER/iQ1LIzfAb4z+URDkbipEU42fBa1tMdxKluFERjZ8PW0B2Z2/fpBZkWV4rmc0l0lzooLNYst4",
-	"LSVPqEYaEkXjPtO9yiu1SZqGv/SNKzvuypQszC3ZHFxhVcg+NuVo7cK0/jYeKc6zGWV5YbhomlLDUT56",
-	"lGhwVGMPnGcI+iFPFhn7PFeTJtaMeiTWaCIWaKrW+VTZC6xxDgCSOJeAyezlp29bR0UBgsgtSQpFZm7a",
-	"vnNqpAqzz8HmlMgMDogPYIC2rjNd3ghNto4VHrpbDdChc9e8FyU11A51IYTmf2aBiC+QWpEAnZbp5YSl",
-	"GmljK1uSFMQDRkka4YDVxLJ/xVSRtRy+9HIyLATeDEfFmyK7ei2SFb0mnvQXgoTN98h5/CwKom8/22KM",
-	"FjiT8EvB7G8Vgc05zwhm4RmXrVKw9Aae+sOVtPy7Oe2GCcJ/9an/Mq5w17zLKTszHw96MOaDOK5Q0IvD",
-	"vn0Nf11gmpF0ZifrRMYKK2SaA35zzagj2NBctRMF4arHI1kkCZEykAQDdl/uWx1DtmMTJdsQ3yciFRfk",
-	"VOCFkq0k2Ekw0NddCEA2wgxqpJeUygSLVN8ZjmE9PQkNXP4TUY/Fz784+ZxwtqDLdqQlGS5SMsPXmFox",
-	"pk3cPYGWWt4tGyOsgOsnMEmhlVarbjfZmZ0oJYok+h6Ehk3hpVB8jRVNcJZtkGvs5tZ90M4ab1BKFwsi",
-	"DO1Ws+9GJVMzcXw+e4tlG38N3my9V78/+riJzZYtUZQVxBJe+5WSZfyGpDMtIETo9rX5jOAzyqjUgsVw",
-	"msS5vphnciMVWc9ywdd5XD0gDI6DaYhswxieC6n4ekaZVKJIVPywnUAjFDSKjJVS2bP607LFXRGwxrcz",
-	"VYgYlO/wraaHayKkVVygHfA1utZiTcXWKFNkScCesE7ymSGjPpnk3clHczB1t5yINTVc0GAX1hyB6uQj",
-	"rBV06KpTFIFgNGoO8Z7cIPikdzSxdAi6XSD/vuc3CKepsZSgFWZppmVlxeG0mwGjmkM3MX24JkLQlPTR",
-	"Uu2ImbUMOknbXQ32tIbKlGcjqD7PkhXN0tiSc6z5R+sY0Nm0adNDi2Yv/RvM2Kahdc0GHaOTtV69vvbS",
-	"REpskfe6kMpz9fY6aqdySkSfeosDe3SvIl4OK1tUmtK+bXUafc7gwOnbSA5UacCywDMrB/cCtQUNthCQ",
-	"Z7ms8QtjjkSuQa/VZphJhuhNm5mfG7rOJidaFQyYJ3TwsOfMpFb11ch1/xdEFhkYuYBD6J9XlF3pmb+0",
-	"WodKbB0cvjjyrDSUqR+PRjFGTaVW7fOMKKewLbCe9xhUs3GLAFSSAlphiQRJiNZ2UAlzU+ax5waWVkgS",
-	"peeP0MYMXkiCzk6B7hiRmsQd5TXZBo/Jam7L9Ve0Y2yt5hfYBLnrbUMhwWCGpaRSYeZh/UuU5fxREBYz",
-	"h17YL4gV6zkRiLJg+/2L5WVsMzqZWbv9zphN0hYLD2XX3JjwNUJ3ypNcoaFlQJYXauas/uHA///iw3tk",
-	"2oO5ozJbleMDMfdO0mGZAvP1lsMZApy18gFr8tKNuniBP9aCi3bcAlBnp0itqHTjUuCWwwxloX3M0VXA",
-	"WALO1HeLPJCdqHkx3dlgBAZvUlnuWgT8NsvwJzAHm+unZlMbaB9+aFPsNhbW95qErTlQPYa1tRRVtrCi",
-	"1ndkO0GxUyAxQ9elkZofgpGbISKZP9E9RCyAqFe9LKli5nxVMT/h6HXZDnntnJKcYIawMVIEhpJ/TvdW",
-	"xRqzDG+ImGZ8qb9PrzH8f7re4DzfzobSow/+fUUV0TqgJr1AMwzhEgSnswXNNM3cCKqI+ePLw6vOzuuJ",
-	"h6vQuFB8prGZqxlJqZL9wslbZgwxheIT0xP4hu5dLj9ijAEKOXUuyrPFe67e3lI5ZEZDXXC8b7jQslnl",
-	"60R0gahCKScSvNPk1mjlEQjuaC2A1RnaixoOMFsSwQuZbWbyiuYzX0/uXdo5LliyKt1m4PP0RkR6RF/z",
-	"RgSwn0ZX2AXKTAvXvFABSH/Z1//G7X55aIdsVy1nrWmWUUkSzlKDmC5gRxHRuEU98aSzfkvMmwwnV+7k",
-	"pTWzTHj46px+q1OXCrxQg8nT7SFlKDWmb6V/1luqkZfBTmvardOSt4PdFiK6LtZxK1GlkOw/kslozVMS",
-	"sxDpn/1ICzDXGEx4kj/PwcAvOWNEn84VpldFVOq/p2nKsrqoApMLfruZ4ZzOrkjEUvX64xm6IhszoG6q",
-	"WdyKMGUjc9qHnGNJZoWIQPkGS4J++3TuDSqJuKZJYOQfrZTK5fF0ynPCBC8UEXuYTnFOp9cH7dM6VjCU",
-	"Y5v59fiaCs1mUentVkSdhIlg72fc2tLaiKAKivBWa2cLVqtXiel0mavJ0RaWxDNGFcWZtSYGTLka+1eS",
-	"5WhNEFy0CKOPG7XizBoQNZ3mgmvRAZ1c/A3pe1g+olVxPFJUxZTmksPC99i5KRek4fxoYNa7dtFqCb0m",
-	"Ys4lGUwNtj3ihdIibGz37WWrhbWI+NO4ibuWMV3xNZkWkohpLjiIjfcwwobS5naSdZsK5ITqlsgYRm4G",
-	"mUbjg3aFxQwU1GO207sL7KdkXizP2IJ3+eloeW02F3Z+huxH34+lSUBzZhP3GIbbrbJNNOgtw1JpFqNZ",
-	"R2SmcywVMp+TKqbLqXt6gZr9IitgV9Md7h8eTfYPJgcvPx/sH7/YP97f/8/BQWBx191HrFbOJXHx13PN",
-	"Ydrn9yje10tSTNac7aXzKCnRP2PmLvpnfL1a1JhvFKlJAEc/vXz14yCrpJZQZLu+/nXIGDUnmYNPD02l",
-	"okktrsrpm3J0fPDSWmDk6PjwxavyJMnR8dFhNMhKM65ZwouYzem9sQVqPOlmUiPHx1iPVbB2cKx3FTYk",
-	"nNhhbRwckPgZS2jab5NpDZQsbwnbAu1Ugdpa8iZssxuQ3DnnVxJJvCDlTUeiLqSUJFRGY3JLh0TZpBLi",
-	"rOfBOB42/bGk5RBDkLMdEy8jomtXG3gPnQGaLmxsRfSoPV+ARKkEu2jw9tV3rhOiwf39L6/iGeNqZuK0",
-	"o5HTNmi8Puyvmk1NBMEpSAjEx2YwUVMLD/Vv5DE/Rm4mrVd+G6f9rA9uOTgEYUCsTEPNj/LbnintJkkX",
-	"JBwTNVN92RAboVNBkri4YrBD270eb0lBZlPHnlfKcpsGYDHqgb0/hVyHGC+JyecVuaAdsrfcGyOTQXAQ",
-	"so8qrSDCMMrciuGmXc+MRywETJmI8saq7k+TzQSI3kAac37cYK3IHnA8e7Mr7IbFSSE6c9xR7djh8F2A",
-	"gSYyJ4mWoOA6jJrXy6jzJvlcg7tw60h657DtRI4e+7NuWEeN9cr407Zy1GqUVv+wVdXqnmFGbmaei6C0",
-	"hpce9Uq+Ny76WbLCbAkffEvMzER+Bu2J0qpv1SNm7viZZuQdVskqstVU5hnefIwyyE8kw4pe2wA1EAdM",
-	"cy0k2E+KowUVUiFJsEhWpildIJttNM9IeP6lSKYQeUOEnC6KP//cXEDHvSWPJkrI8iJrCUGmC2NhoBLh",
-	"iom6cGQNtNPASyCsxhqzjKlkRdIzlpLbmM/gZIUFThQRKOeSGsMtXyDbzRoNEtcotBIevhi/OBi/+HH8",
-	"4tX4xU/jF3+JWAk9ibduJmyJK5xLnhXK7pDiJSggueu18yytJZBMf5Ma9ym5dlrydMtNkQkXMQuNnhv9",
-	"UeCMqg2CRmhnRZcrIvTuzIlSRATU8NNgGdmnUwdAY79CcomdYX0SLhjO5YpHheQWV7Pu5nzMCCsk7RCo",
-	"jSvdJQBFb9msXyfs0gHdfq4xZXv55l7xBSCUJM604HDmT1zGfwyxLLh5/XVWQT69jvGfK6LUm9EeLQ6H",
-	"/QPLNv02qk9EFYIhzrKN4RFjRG6TrEiJ7xGMGq0yuqah0f6w4eFwtnRW6owmqMBGqeu5gYRvrR0dnCTd",
-	"ZnWNtWjQqC/EwviWG99QtaLMjx/t4gNROR7fupD3/c4A+FaTKmyddz0oIkKzneE80Mwg5JywpT4Ghy9/",
-	"hCnd3wct+W4kUb9QRZesZEt2U2Kiys800wycF8ps+tSwSGlYp1Y49pZuMAdujAiihkS3RcNIuE3iWxOF",
-	"h2Q/mcHeudYGG5rCWnizvReqJUsu9Omeb5AgGbnGJmBlUFhJJVP0hZM4mMbVumLo+ZXgTK06dHSSE5YS",
-	"lti/YzGvkWiAwQkAc8qw2AR5ANGjP9QqUOUVaGXBH7M3eLL7EqjBu9hubC1MRtXRcFjbzKlyl6ODvf29",
-	"g4P9y9HuFrPMhiLLTZesSHJVGVR65qlHmXSkJ8QsfVW8bOlBvAK701Lg1IjSnj/patSNzarp/t7B3n6/",
-	"qd0lJLkxYocCcvxFkas7+iHuGITYxAx1gNiY1Wqo4Mtj2MDimad3t4xVHucm403yC+tU6LBX97izzQhN",
-	"q/U7nIOOaKo1QESkyVYAv0YjqNSKMiZ0FTIxl1KvawJ2iomWWvTyqhTidZJPzOATr2eE8r/FkWLhjqSA",
-	"LmOqkPXLYLEs1hoFJrxTqpRy55nZDcMkfMjHnti6XbxEu7fIQqQ4sgEZfSC1oCwWHM6uuygiYsoKnaHX",
-	"VHAG5vVrLKhxHfQA93V0+vbNb79oOVsUJJoPviI47aHVHsh+/fz5I7LDaMRRZuRfgA0+xkH794llSJOz",
-	"U8tO9B+2CErT7hO1mhiCQ/oj2lkplaP6rGPE19REfgGidhsBDbHNigZJwLCEpTmnTEG0RPcaYfTj6RRq",
-	"W6y4VMevXr16ZcMlpuskjzL4xso/kYQw5cwr4cECn2QhW/2R4IIE2wZo9zdYImh9P/9iqCz0aJLShjTG",
-	"sKzv7gF+MromJdyV/3Cw4l8hKZzySyeyHyrJ3tu+O0dN16T0JkCW+b+LJvGC1uSa1IPjQpT+GFMZNf7T",
-	"D4Vqt545VRFLpIhYUwYaf2qy+11A3xDrmeIKZ0bRkDH7gsKZtU9JYzxHc7LQWhbO82yjNS+jVntzHR1G",
-	"16SHukgwY9HCBDBRpXXXVB7bLcDc0YtXvfQYTFpb7NjfRA/ncXKQTmT81w5cDipDDEk0KmMXJSo7x+jo",
-	"DuHCbooqPhhhEYQPt8yV4GRFZs7jZvNkFL8isejIiqtBN89RB6kFtlsQJrE/JBjVAAEh3NsBoLu0Tv7S",
-	"hN0OmD6WqxdTDn+QiFZFx6LBRoMS+2yKWrRCkfOw2VaDyiv1ZyMan+DMM9uFkiN8RjeUpfzGcKEy0MzE",
-	"pPqb+uNPQxHL4e5q5VH6u2bpv10ESNzf23/prXSRcSiu0zKfYXR9tapKtN69ZtX9YtD/viIMAeAIZ5mX",
-	"f1oe1Ko+Avarc/FCafEBHLsyyPEaGpRObnMqiIzi5eziQ4UKdKOB7IyM19SA7IBoh9vgmd07U6a7N2br",
-	"9hIeg67/o5cDiVKzSC7A0UhakgHnGZ9rJmOa2hBzcP8F1VaCg//10hnzL0fH8H/JM7KX8eXO5eXlaEWy",
-	"jOv/7P6fy9H4cpQUQnLx0XrRLkfHh0ffhuCLLBYkUfSazNyZbuOV5oiZrwhkRpPrf4NF6oIEghMf8M6D",
-	"gawbDFyz1sCChqHLsc32mKGO0nDlBRqvDBerFdocfuAF03GlDUIMyO1YbxVVm+jRAx3HtbgDP+pMFtAa",
-	"Qyz63MOWSxOIDxy9Bn8ussxcCG17YO6/Cc8LOTmaHEwO9w9f7v+0/zI2jwmKHrAXpmH8ih+yF9FiDtF0",
-	"7epWD/3qCy6uqgjjJtV1loIYnL9gw0+rFIYach85g8EJkWZ+WqZBPXwWg01lgUSscsVt6QtcysnB4f78",
-	"zlkM4MmVCoOvpy123uU0CLLAWqUwC7bRS4OLP1pGJYpWJtVTAHJQjUZ7L1YlGmWxXuMYIl6fTZaEEWGc",
-	"2KaVI7MYFj7Z1ZO0lpejT32RkS3SL36TREz0DQohZe5gmcb+lO826Gydc6EwU+gzllE/w/MmSdTqQDrH",
-	"hXN5BiUgG3y/Qwe+X+1Hp0hvYYgxZAP2jgcyEJVA3N065NPywHKUzdw3k0UImyOsJ0YUjJn/VUVBxqNS",
-	"8Kj5bco/4eMNpvp3m3kO4Temjl40vsyVmutI89Dyl+zS9vR3NN+gBCuyNOWFh1airC5K18YXUSPJBFXG",
-	"ZXyYhpjbHINpESXrGsS0QDuMs4mDa4z0XzD8btf4MUvyE5NlhuXqpHK1hHsRr5DgHDDg2jKeBM2WpB4K",
-	"5YIs6G3IiQzjmOUZZtsUqr4wZcLtWXAJQhNbqnpHkJzvehWrd0B71Fxvt7NmdQWY+zaoinVH3WofiQ9l",
-	"kQ425u7ba+O5HgqqIK7uzlD9BuGtrrRjWyZLV93DeIzEDlnnauNq3OjLEQyDpgwj5Sx0/EwLKYzbZzqn",
-	"bJq4/M/+YISWBT1UxQozGsLfo/03ELrqhXtrd9Zgi28zoXSaUnmXwhD9tqtI8qqJd4b/9tqE7lwqIWr4",
-	"8VJ371YUwcF0h8oI37V9aDsjgFWzdrQ2PkZG4R/rbTX8wOS3gUa4+3SmgReTlxMzweRw//DoYP/w8HHq",
-	"CHjruZpwMdnb2/u+qwvcpZpAT0jRIxUXwEytBM9pMnWbuuc29QG12jbF0lwD7RqlaZCCMone47j1rFOj",
-	"tFPEa+F0KpcmpvgffMV6QwjaL0w9yIVNbOm4NSFeNZ3lgl9TFwfTx8hdL+R6IWPDj18bPFczymaKZGRN",
-	"VMzA8CFXE8r0DDxXE82q+UIzYmC8LCFIi6Ymn0zLpSKMkvND35q48LBwr+W3rhll9IqgDzlhn+DEdtRU",
-	"2i6VYTDebF2VLbE1HtlEqC2AqseKNtFXM2R4U3zp2Z372TGCfR4sK/8NZzT1i121HpQhYUD6qry2I94p",
-	"4TUWvDMQ7FabAWamskV76LYKMni16DsnZc7KDiR2SKIQXZhUXjBmg+l3916h3YAxi65uZw7xio/1L8C2",
-	"joJ2m2OWkvRjayaza2GDxahaoX8iL8PwLknMndl3/hpM1l+QgdeGf31R7/bnU5S4CFYeifoFn9aCu/wt",
-	"nKjKQmESe8+1yoMuilxzlJGNDywFlkor2kvJdTNE8tPbi89Ii1sQLliNZ2psIE2xQAVybPkr2Dzs5bzG",
-	"DC/JmjA1vmRlJUV9py4yfiPHwPAEwRlwLZM4qvVUgtd6mATneE4zqo/E3iXoTkYm8Bd2agBxcHoR5ccQ",
-	"tb9vODJhOKej49ELG51e5hJN4cEvqVWrhLsIYC5VtDg9tJD2jbCULCizaZDwZMGeEYfsiLUsqhJTZ6k3",
-	"FjxvJm1eOpHqDU83Ax6uq96cC5mGFVdOY1KNMzbGRRpjPbILs8BtehmdN1+cNsNHGesPLx7u799jsQbN",
-	"w18MWg6p/moHja+mhlATob8o4FELizOSIjvEt/HoyKwvBlWJh6n3+uS38ejlkC7h247ffBdQSVn+ex6O",
-	"yBQ2QfSW6r7ontNSmp+BxD/9WjlKv0Gwr+H8Gr/QvCou83W0JDHPNpWqqptqCVsanuxCRqpIAMhHM4JO",
-	"eET0MOWrUaZcffmi6e9f42lt800YmQVvhTpHjWWK1SukXW+EfrknqQ55u0p2vQd57qqelih4COqI741P",
-	"GuV0X7T6GGWEthgjRozcNAYDbgK3ChLkmpKbxsaGVXvvwfs66z5HizUP4kkHjwZE+26XNYGs+PZc3MNt",
-	"bW1TWwgk4AfTrzT91soUfiH6wlTmHSYtsWidRZ9TPNdqI0Zl9YzI3CH9/EKURzw1thBbetVk6j2E/CRH",
-	"fNCeu8ovsOdH/RtYvnD7EDuuNwbXIRm63dMUSky1y0z2pVFka2khzPr3Nyxbdf8tfnjmEq869ggCzzZA",
-	"tBPaqS0ShgRJOLixK+7yIKAMeKwZ9MWy4pmmh5IOcCYITjfI0FL6PMfAYBNxtg3vq2r8RnneJ6IEJdcE",
-	"JTaMwSpNQdKj5yoO3XY2A6jB+2z25iNSVu11uch+ngQrEHadKZKeSPxg3CmGNW9TSuPRF5P0laxaLbqi",
-	"YKBoRvdBFskKYTlkF3xP7SPJLzFn8BMzmG3JwJoMG0TwHHKM3fDhpKOPc0rmxXLizCkdYsy8WEZkGFMJ",
-	"EyasznTqF+uUxuBhqbAOVeOklwVkR496jdSr1EZvkPqS28588/TWu/r4ty8TGeyHzv8e1aOyXkAaNtsg",
-	"RjQY5swabtthfzkJn0p4MAPM8FKL3Ir6dzUmP7Z1xSkiA423N1iWukv80aw2xDiDdVrPzO11mEXoNKwi",
-	"+Rg3UpMCPYKGyjaWnqGQ2MSkl05NEbZWsv5onEASQaeqFo8xkBjHkEnhtDWOTGUjpzR52DOmUlPbSZb5",
-	"ppFKN/btf1etbZKRa5KhFV2uMrpcgcPZO7R7l+wSAlZJoqRfImi+cUEEe8im67p4yxLKl8gFboBnC0C7",
-	"ZDkWEKXtykKZcHAb8QG+CGPzDQ9uvYzQI12/bQW3nvgKbi2aFDNHhtj/Pu7hoPpVWY3Qo2fZcnpWUA+p",
-	"9R4+gUo5dBFcuhLZoF8Y34ywiV2sptjSY96qtXJO0e2CKBINtYM0RJ0ZwtQEarszBSToT0pnRrcaYlpn",
-	"G5MeVPcDUGICq/4oaHJVBdE1kOeVGeizyjZrwJUV2soKcDETrUtIq3AdFJrzi8Z114x7VBtPrN5CZKNN",
-	"M7PyB9OJzFbG9jAQb20kmiEW/7H0DsN9llUPyoc2+9JWv4felGzfMXRTSDAjuMzyk5dsJxyJcQTv2ArC",
-	"dvV1oXT7a1Ow8P+aiqWKoyUJoYhdAxrUiyrQrpMK/UKHAXyoA7w2yizhjZNnW2mnFn9FOf98g2wx3tis",
-	"BvG1Gf3hJjbe/hi1xNt7ezIp8wSOmxkDgCXdBnod10Ia7VdIZuZrqpSew+3/6/NzD7OMV+Sye+nnatjc",
-	"AS+E1uUkRKrdP+b5beRtdHhhyrPzYE4YP/+heV77XC8sNSlt1gljbRYnPPUD02Iqz0X59fG8LrWI72dx",
-	"utRzrKI3sFcU4GHkpaPDw4dTzFufMOhUfGqvBEBGCiEpXLpVeNDD0LF5WM+QoBfg3n39TO2573Aa2Lh5",
-	"LlAVRL8uMkXzKpcQHh/BSFK2zEgVh9Ig+zdFdmUH9C6MxyB+b6ZnUhcCCNqJRTerMFZpDJooDvdfPTU4",
-	"H60i6CoePpOqAljBjeSNbj4dELYgUtkavHHC/mQaVLRcJhbWL9o5Tq70ia1eOSxklLTtkKe63WMSdjDP",
-	"M5J3DY4O72qWGezBa9YmqbnO4R+a2AcD952Q/GB6HED8Rsdv1S0uKhNASeSFeW7vr+fo/Ozf3kK6vlZC",
-	"8RJTJo093T7eR0mWemYtxXNPJhUphF3NN6iefY00DISlWmW5ZOdaq4QnTNHhPlpzqSqN2L2NVg1bi82O",
-	"KSFmTUPVEIuB6mlFs7bS1rdTSCJLbLQpIO7Piii9iuGgDzfMpl/vVJl9S738wNfLXz6nWh7Pc283mLkC",
-	"3c90Bi0UgSZoc1r6jtwDBdy0KQy/EFVpC9vFYFQxdk+x1UOE/GePsZE1QNrUvk4HthvEvXtTOq39hFAo",
-	"0sWFp9yD+LB3yT5ASHrpMwC2KtENzTKtGlj/bYzTBZm896aGx/KW30XvfBZi7PGUP21Mjq2toQRmJt1U",
-	"046X3mHSQp7l3LRQ/UDWONV4pKwgA9zJngZrn36xfW1oP2ZGn/ayG8aXjLIVEdT4ypQMnw1fUS1bbWKn",
-	"6cSO/f2epxqEz2XJqUPRTszvvf0LQmifmmQdzOb1KXGlL9rBlhGg2hUW6SQlWiOfQKa2IVv9d9RxvMbM",
-	"yLCmDcJWdjdpn1acL8PtNTEHj9zSBaLKZiZlG5MbvnfJXvv1ahPOJDVStskdN51WWCLG0ZpgRtlyUWTl",
-	"I1E7jBspZqwbgBQ5Lh0GkI0MH/wM+t3YSfkVi/QUlvVWzwuK1aPIJEeRvDdYaaAHQY2BEN1PH0t5Ue0L",
-	"mPkATC7MOysG3mlVsOhZDkGMKpmFNEDo0DNRlmdqZ+UXBOKAUNkUSbqEIhIc4dIx7Hg3SrDRPqFuxiVz",
-	"NjC0FDghcCHH6LH+IMn3Khi3PpzSRU9VCaznFUz8V0Ypq7YOSnI8Cz2X6GxS0lAKzsBW3sXKT0P2HUgj",
-	"htMqNCeEITMUSdGGxILn9ShPyihPA3gtW/w+SMjySMo8Qyp5rgDz2PZu5/0rHS7BGGNPdrcsDa5500jx",
-	"2glquNJh0AelmIeIpEzCCM2zxXuu3nr55F2lTK1Y38x0NXJLyolkP1QPXEfLpqxz1V5Z1Hw3Lw2aN3dO",
-	"XJ2snmBOM/BThHM+kK5acpv/Zgf6f6ir9k7il5cC3BNiBk+0FlkW1YVN7bmKbZVR8pfMzTD2SuOb8gDw",
-	"t7XRxkSyykr5zkH5nQplJx5KerIqKtSVqH82w2USBWcg5biXcweQDgQ6l+1RgnNVCHiKB+oWejWXxkiu",
-	"+A3QDfwKBdjcUzfwQLEzbcNzVxApoOiadJNPWRTyu7V2N6pWRojn5wCLz0c14W52kEuG5Wpiq5gOoBJT",
-	"TtW194oc6D3WxFBatxu3f3Tv/RqlfR68ZqXp6qlhzdqSapyY98yvOVa/7LtKEYw7nxR2kwxzFj5pfF20",
-	"/mtXkF2wt8/lkIOE6JKsajC10zFUrZlCCRtXKqOQREykV8Osm7ShUG8uyIIIwhIbJe/ZvBvEG5TOesSN",
-	"jBb7iuyjble5ph45K7TwJ7tbOuh2CG8W53vU1M9YFcAn1hKG7rtr8z1mgA4gk29QCdgUZpukfsWvFqeR",
-	"yz3BjeJlQEE3NkGOqlpNtgZJNcrBPRJFtVbLe2KCai9/16knec5Iowg8CIE4YOqbSMwz8I2kJN3ZvY7c",
-	"EA3OoXyWTUQq326pSq21PaUKEqOdqmHRhkwfmx3kQr5VIct3XGV109usoKasUKrxdEGSTQIBR64om9e9",
-	"Cm9vvBxbrDGbUDZRKzLJOM9Rs5BbNdBrr1pR86JrKfRWdX97bUtnxSvUmpK05fKNPpnBFit6TZBfzdKO",
-	"+BHelokmYBBkXjx2kpQp3n9Nly6Q2A5hKKA5xOuwWBr0jyHXVtv69uXbfwUAAP//hJb016W/AAA=",
+	"H4sIAAAAAAAC/+y9C3PbtrYo/FcwOt9M028kWXaezZ47c9Mk3fV3kzYnTnfPPccdDURCErYpkAVA22on",
+	"57d/sxYeBEmQomQ5Tve9s2d2YxGPBWBhYb3Xn6Mk3xS5YEKr0cs/RwWVdMM0k/gXLQqZX9PsPIW/UqYS",
+	"yQvNczF6OXplv5HzN6PxiN3STZGx0UvsM7/d/vH8xXej8YhD04Lq9Wg8EnQDDXg6Go8k+73kkqWjl1qW",
+	"bDxSyZptKMyitwW0UlpysRp9/jweLahO1jEQvocPZCXzsmhC8Zh9R0+T54vJ0/RsOXmyeMEm9Fkym3y3",
+	"PGVn6ePkyeIpPRJ4GV2w6A69gw9NwM6SJ+wZfbGYzNLT5eQJfZxMvmNPF5Pn6XfLU/o4ecqeL44EWMEL",
+	"lnHBPpYiBt4H+5nIUjShfJ6eLV4sn7DJafKYTp6wp8vJC/rdYjJLTtMz9nj5hD49FpSKXrP0B55pJmNQ",
+	"XsBnssTvTSif0ufJd+x0MXmcPoVDfk4nL5IZm5wtn9BnyQs2W5ylx4IyWbO0zFgURPutCd5s+SJ5Sp+e",
+	"TZ7QUzZ5snjCJt+lswTBm7HT9Dt6enos8JhSPI8e84X51AQOeszpIknZ8vTs8ZOnz44EiWabIqOa9YHi",
+	"2rSwbnG6nCVnDK5FarDuO7gqp8lZ+pg9WT6lz46FdTdssc7zqxiUv5pPLWRLTtlzgOu7BVCU9BmbvKCz",
+	"ZHK6PGOP0yfJ08Wz41CUz9BYFblQDInw9zT9yH4vmdLwV5ILzYS21DnjCQWwT/6pAPY/K3j/HDEpc2m6",
+	"pDDBj+/eTB7PAOM2TCm6gt/ec6W4WBEHHVlylqXkm99LJrffeMw3gP4/ki1HL0f/dlI9GSfmqzp5C5N9",
+	"tGCbRTRpdUqkXcbn8ehcaCYFzd5WQN5lXU9wXSnTlGe4aVrShM15Ono5oovk9Ozx6HO4bjc9UUxeM0nM",
+	"mEdcbscE49FPuf4hL0V69zWfzs5qZ+lul8g1WeIUR1zPR6byUiYsOjru+KtE82tmgXDN8Ush84JJzc1f",
+	"MvzUB1NrqIrO4cG2bs54pDTV5dCBL0xjoFlcZywy4Ofw2v5XOLmf6rex65Qv/skSxO1XK3uo9YXXNrTx",
+	"5+hn/AfNSPAzWcp8Q/73q/fv4F9Cb6jWTI7G7XVvmIAOn9itbg8NvxKdk1IxsswlsY1Vjbr9TwpATwC9",
+	"FlSxSZYnVOfRyQxVa7GD0J/At06wq9mGTGNOPUKe10yvmSQIMOHKTAcDZSSXZJXlC9hGLlmic7mFeUW5",
+	"gfPDNqPxyDQZ/daatHHeuND65nqwouduOeL20Sf5ZmNxIsZEM/mNIq5NuE/2c0puuF6ThJbYLbJZiWRU",
+	"s3ROI3O8hm/47PINU5puitF4tMzlBhqPUqrZBL7EhuWR5/EXwX8vGXGiAeEp7M+SN44YxQBLeiMjmxcu",
+	"7QDZUaLdIIsyy+giY+5ZbU9UOmrR2Hml8oTDpsX4X+jlZZg2ataoUOe4qofzStnS8FwH0jCHawERy/Ns",
+	"zkVRmvckTbmhKB8CTDR71CAPeZ4R7EcC4W8cvj6AmhSerJHckIlckhO9KU60fcpb9wAhiVMJnMyyAcB3",
+	"OCyqbRC7ZUmp2dxNu+ueGv6qdIQ5QqVrFyQEsLZtfXcaXqHsmqUf6DbLaeTc31BNSb4kVPhFzaXtRNi1",
+	"udl1quDbdbxm1N7+4OMizzNGxSjgEec6SvVfG3JCVvyaCUM/9JqRlCVcdRCRHY8r7lSpWBTt8WDhhYFJ",
+	"PG24ocqdNvCWuSR6LfNytSbvX3/Yea7h/jTO1W9Ncx92nCG2jDzNVNOhN64FJnbum/fC3+jGEZVSwhEZ",
+	"JAXkCTcveLgKJlLYoNq6UyY4SyOvWDWx2r1irtlGDV+6n4xKSbd7bEWp809cZ+w98q/Nnfgxv3HUUiGu",
+	"5qUmlCBfRlZMk1wwQpeaSdyiJZdKE6oUV5oKTSQrsu30UuTLJckYvWYKmm1IKXCEdEzWrJRcaZ4Qdgui",
+	"gVZ+eGQfYFSUeQgV6aXY5CnLCFVX0CxZM1oQ/GlMljTLgHAtaHIFTBV0rAYHno3yrJRseimCA8yXy9F4",
+	"5NuNxiMcbvRbSPbCz60j/Z4mV2UR4SxqD/+wV92R5mrqlLJNLiZns7Nns8ez00+nZ7PZbDadzWb/OU0X",
+	"sTFQwo1RCcmoijG4v663uFkLXAhSBk2vgDStmZFZcuFoBfBxTuFSexkKySYbvpK0iw8yGDu/ZlJF2ewL",
+	"/E7sd3flACaWkrIggMDAmFZjc6HZiqHopvgfbL7YaqZqe82FfvYk0iHOTVrVQDBW43FqLCF2mwwy3I2c",
+	"mTGG32DT/lgUxc1+KD1Bze/rfFNQyS261aFBnfFuKHSy/juoj2FMvOdM6Xkfe/faNgJ2vcgYPGsbtlnE",
+	"xbIlVXrXgD+YNgPGa+yNWeGAzbkrntR3er8jesOXS3j+InqAJc+YmidrKlY1Fie4cBkXTM1pmvY3kGyT",
+	"X8ebNICtz1mfoDla55oMwhyFEqelIWXzTYQ9+JVmGUmyHB4avgkeKvP8ZbQUydq9QRmtcMjQxp30yYl3",
+	"bW0C1ZLfDkKM96YpdEKsVXtQAOiOndpkoHqjWsDhK30H5Q/OG0pNmmbzJFd6Xqq0fnJ5uciCYxPlZhHB",
+	"KeRHLXE3wPkdDOSPxjz1o692r/YW9GPgEa61JX173Wjsc7xnICTAd3gK3nuUrUODbFaM9c5omTLD1Cm4",
+	"Q6ADMDqjbEse5UWpxkTlQjBkRtaUX5XfhqzIf43MVwDJL7UtMjWwGthonu59TT7YbrEhb3J5xcVqnvLG",
+	"qDuA+dy5leZORnRYe9yR8Sjly+VcOdK/c4nVQ9EmiwMomdePt1bNRcpuO14PsM/WGeG8YELmpWbyJfyT",
+	"8pNVoSdP8qjSFVn42Jyi3Mx1KYWKz+uwoIN/VmUWked/4KAdNl8JiLrkUeJfZZKLbPvtvqoqZzAwL4kX",
+	"0LkiCcsy8oguFBPaMOjmjYF2KOGw9Nt+3VV8JvN9bEeam5HGcMM21hCVi8ToECzgKCikDDmj2IwB+u9W",
+	"4BtccAffq8Ov37vWZaAFn1+xbUT99+GcXLGt3UpG3FlPyU8M7ECSAWKwlCyMOPTqw/k0ti4QQualbKDn",
+	"WutCvTw5qdB0SvkJLfjJ9WknikbO432N7jmdDB69A/hvNfBJypYUMI8rUiqWGqRgm0Jv61Sxfmv2Io5t",
+	"sbRa5migrt5vW+eZdiljXq1Wkq2oZhZJ/wbaUM1pRjaMCkXg9LaWNydLLriCG7MoNcquwKqpMkkYM6yk",
+	"E/xlKYTR3Hj+HlgzxHsUBnGKqBLn+zJdMf321oy5W+9IFthhzmyPDqXjnmR8Q2/ne3ZxbifzLtX7BdN4",
+	"P7zGB5CQgqrFdSVKs2LUq83fV3uJI3b7wMBntF6NiWLa6EubCxnvYx/0fQKer7aZUQwts6tXMlnzaxaY",
+	"/Bukx3yP6WBlyeBO2xaorFL4Synsb6NxRJNcwd1NulUw8Ek4XMgRMaXmxt6D/wQDRy8F2HBxbj6e7uD+",
+	"QhDH1Rbs3MNdXGr9V3Mvnbjeuxlrqu1DiPtbpFTHdgMMSHsRQSQiStXoYM2y5c+tuUO2Y3tLBjPSZXb1",
+	"kSmdS/ZG0qVWnSjYizDYt7rb8MaYQY0Am3KVUAn0yctGXx6FBi7/C2GP3Z+/OPqgjijR3mujA3e4UFqW",
+	"iY5vkXeDCJuRZZ6UyB3ewMYhd1huNlRuyRVjRQ2FRv+LscJxViRliq+EN3qpKAmPrEQs+ar7+BMUHuf0",
+	"mnJre+7yUbBiJlfENyZ2BQlOUkqWEqsSbhNmO1HKNEtAJ+CU7g3OpdT5hmqe0CzbEtfYzQ19yKMN3RKQ",
+	"yJg0t7CaPcrH24nj81mzVbYN1xDMtvOdDEcft3czjlxCc1GyXdhFsyy/YekcbJUxJs98JviZZFzp0T63",
+	"ixYFE+lcbZVmm3kh800R9+lgAi+2aUhsw9g+l0rnm3n/nXiNjWo3IjZWytWO1b/xLQ7dAGBgvGDbECno",
+	"LeDDNZPKeptgO6TQfFNuQgIdyMKbpJgbNNqlJnj/+oO5mNCtYBIlxlzYY8A1R6B6/QHXivxm1Sm6gV6z",
+	"WB/iJ3ZjTYM6J4nFQ2QQa3Tnp/yG0DQ1jn5kTUWKdkKrnjUDxmbdgUw/XzMpecp24VLjipm1DLpJ+z1y",
+	"9rbWme1qF4LP82TNszRuO5RM6M4xsLNp0+U8VLZ7wW84Y5dbTd9s2DE62TAWv7UpsUXe5Wmt7tXb66hz",
+	"YcOXpNMnidaiNnZ6T/lhuzQ7PgrENDB6D7hw8BqpgT4M45HzlBn9NgCoPXCwA4ESw6tAby35asVke2W/",
+	"Aq+hNJWwbebFdp3GZENFafwNaalz8giWXX03orf6tu4QV+o8Dot3Am7QLuPZS1yDnW5/w2xOCNvc/NyS",
+	"ILcFA2VCjZA7PYI7SedxbD2C4KDdv63y0lE1+HnNxVWlATH7E9V3NI4RghKGGbHUvNKt4IpQUzV6idLv",
+	"uIMz8zhK1uijlDAQKIlfQJsZsxe61wvqA7Yh2jlDnb/BCyGM/5O7Em2CCDqBECfzKxblBAATbjUB5wHr",
+	"DhMiJepOr0R+I3oQsuN21dTTbG9gFmyZy/CK3AkCmWes+z7AVzO8xcRgbIejpWJyNB55F6EKJX+Lvg2/",
+	"l0zEnI0v7Bdi1FuEi9rdCC/409hKel+dbu9YRLJONzsurnMTKgAI9siT3GobOgYEL8f5P6PuOf/fxc8/",
+	"EdMevWIqp1A/Pt70nZP0+H3Cp32HMxdy3kkkcWDTqI9QhmMtc9m9twjU+RujC7fjcnzWhrmh1r1PHV7V",
+	"qO5OG2/43B/J0NrmICLc/Zqq+SaXPfIsfLVHRiTbUC68Vx5XpLCvQot0Cnar50kpVS6j4qQC5jxX6LDM",
+	"Uj+k9WrwJhOceEo+SObtUuyayUthIbphkvnWYL4lXJMEHHIzlZMFM5YLnZMizzJLmG/scoy/Xv8BD+LP",
+	"LhhoJ98796MB3HPUMq1cqNzabjhZsCwXK0V0/jejnnbYBavEOAqmguZcODVDSK2OyEHwdKhttsZwtEZb",
+	"89U646u1jgqVGg2TN7lMjWOoXZYSvChYTXjtQ3+I3fhIxYrF0N69Nr3PQntZDoQW0GCykYV2zoUbWAOK",
+	"giaAZG+yaccByoSmUkBcaii0MwevmfkbFYuFzNMyYSnhu+VEf5RxIWYAAas2onaUw67JPVA3PzDwcQc7",
+	"lXSO2AIUj5d14S6cuyEwY7LJkZglcDvRhWso9naRl5j+15zewAi4LvF2NPbL2rU5v6DlY4h5MqREc2Mw",
+	"8ST91zVP1ib2VBEqGdrgUgYyoyK5uBQV9hlCPYCs9glaXd88lXJ8pLuzlRTj+P9ukWeHnMOuYxMFQlVj",
+	"rn75asdkcaElLoDsIXXQgLMfdckQ+wgOOwfsIdK9h67KRefLE3DZPQzzrmiq/vCnoVztQI613axXfWG/",
+	"srS2wWOjtMilVWPsp/WKvRP1xyG8S1H6ga9HFZXTocvvch/4iOF63sMmGkLWH7937FC5fSLgfgIhyD7p",
+	"+j6i4SpnheFRbs0T2U8n3Kt7NEM3FY+NOFHBboZoX8OJ7qBNRYgwOUsn7iV51uFPGD9VHM36j5QmQFay",
+	"FZVpxhRGdiW1oJI+J6ZukF2ekW6o4dGel8W8yDOebHdyAXa819Dtl+KD6YRCQS7m7LaQFTfRUItoKlIq",
+	"U/J0YhJGQA9S9QDa8j9TyrPtROltBkxwIvN6chRyRv5f+F9UwhCgIKqr8+Jm7PHI+hIOMyK5Jb/DTpU9",
+	"KX6qP5YbKiaS0RTA8SFJxF2qJtj5NZMZ3Xf7fza9qu3XfMP+yEUEoPNXP70i7vPYOeWhk8Uvn14P8I9s",
+	"vBHmYxUsb9yyLFw+gFkN9b5r4k3zcHpQe5dd19PouQM2llVh9Mq3I0E7Z51GXYDxc6j5Wvz3yXQNR53R",
+	"LZMnWb6C7yfXFP99stnSotjPDWOHIfbXNdcs4wplxJpJtg4XYN4cYlZG49GN5JqZP347vs3a5Yigw23X",
+	"wEPMYTcLPWcpd+J7n/L9rTAeEKXOJ6YnIhz09stvX3AjbL5xOHq+/CnXb2+5GjKjwS58bG9ayM6XhGuS",
+	"5kyhDye7NebwCAQHmulxdQb3ohZ70EjIvFTZdq6ueDEPDdQ7l2ZIWOUnDSqhYEQCI4Ymb+KIamyFfaDM",
+	"geDkpa6B9B0EiM7G3VlMsB2xXUEbteFZxhVLcpGajekDNha/tNtusNsF4vuMJlfu5qVc9Vy+Jt+1161L",
+	"wYNsMHq6M+SCpMZ7TsPPLhDaEFHA3SYuBSfY75oBHhhx94zK4Da7J18NHycR8UEP89IEXv9h6HSBPoI2",
+	"2gaU1PyqjMu6d/MJsaQubqCT+e12PsjpH5sCiVszoW1Gp+4hQzf/BqZSxcgvH98Fgyomr3lSj4jeNyLA",
+	"TBvjr/ootpkfxgcs9GEi1WlFzKU4EZ79PLdOLF1IUKWQCVZrZ6utNowu2MOF51xw9OTHz3WiXI39I8sK",
+	"smEEH1pCyYetXufCeu6g1UDmCVOKvL74B8FA0g7XFBETxz/i7ybS3DywNpoGLvmYoA16xZVGYd0quU1U",
+	"vveyQ1SakjcB0wetLG/zOof/e3c+rS0q6Xx8lKYZiISaSVnCXVlLptZ5lkZjUc/TzCTjaVFyr8DxREwR",
+	"PypLx+TG2o7A3JJrwrUiODdBA1uRS83SKfmpzNBkrsKVu1eEipTMkFwvMhYMr2qLPX1h36QDXg6zG3fc",
+	"gzBsKVgcHC6Ov+86oUWyZslVbZnP7rDK4/iaBQnTOp5/m1mjTdQ9YsIl+mAuFJCUi07/uGsmF7lig0mV",
+	"bU/yUhdlnJ07QCbqWsbJOt+wk1IxeVLIHGWaO7jm1UWh/ZQwXdoyp3/pSHIl2M0gh7n4oH0ZrgbqdGIe",
+	"dXfV7dgMnp3S5D7qhUq1GSEJaEon+BHIccoyfg2hcxgYZwPHjPp+kKHHwo2jgitWjLPE2MWY57zItg4A",
+	"Z6LPl/WsOgCL1T0qRuxIh4To9apJbGrVTi2JYomM2U0v+Api5Yj5PiYrJphENPMpYjCZVWzMKAv1aqHy",
+	"rNSMAJOEYdxaFwqZKrtBVDLy4eeLTygB7K0+2bXl+IxzFZCSXKIZ/2aIadZuX1cw4xu2KFfnYpn3xSlw",
+	"L720r/C7c2I/hn78QOyAQTZpS+uhFetsK7v9AVS5gPFdEoiGGdDs0hYlG0xyhFtlPRtKRYLeY5JnKVN7",
+	"Gknx0lz4UXwsefv+KA1sKbCb0ZzdShPzOamyJjqFvUsPRKxSJkjrPTt7MpmdTk6ffjqdvXw8ezmb/efg",
+	"NIvxOIsPELlhmbyLf3/Hdd/8wUMU6rIMg9GRw8mR3lpG1o60ryU62oG6CtO8eiT/xrPFWjKmhp5WR7LY",
+	"yHmBlTBGK/6IHwpcOpdWKRBtn7x4+vzZIHdSn7IgbhYa5PHSNoEjfBXu19MrOrMGBLE9tddJjV6ePX7u",
+	"z0iNXj45i+ZaBJI7T/Iy5hz3k3FahH1ynHNtx3a4LzYIUpC5alSf2O3auEZy4lQr4elu019nvlTPYdoW",
+	"5FGVuRpUSkxs617X7/L8ShFFl8yLcPFEAj4xYreLu29SaSfM0THjyr7dnVLWDzFkc/ZjAH0KjAaLImXg",
+	"KcuXxAefR2zlDxY86LW7Lj129+p714npscPz92/vXOR6bhJXRxMo2yzaO3gbFu5mbaK2ermuWCYBhRbs",
+	"ZtIpLnQ9B5/WLBi8wMcBbPwt/XX0UdgxpT0k5XIFx3QoKXc+hlQHkCS2ixF57VmP98Qgc6jjwA3GUpsW",
+	"YDHsebtcMnxQXnvt5FDz0R1sOne3wQyzqeynre/owdUd4OcrkcsYt/TBYBRRTIOuTpGMLTWI3mTBEurS",
+	"wVq8qyuyAF1Eri+FlqXSLH0Zsp3jmvUuL7XiaX0sTNMZGJLg6uGOoXvYIB7EAm9w5lypku0MeYzrxC3Q",
+	"cV8Pn9c1YLLrDPYe4ZedGYfstlj1fEfg7ofoOSDXtGFyhYrHMWlk/ZEMU/CIXDQYTiUTp/OosZz2tynG",
+	"GYx3gmqPv9vnPNCT2bY9SGX54vYFCDjcLsYuwhI04JGMMIo2N20LZSQu3/0YU9TmS+fOM/YA5tLubp3p",
+	"a9/IkW9mz9ltVTTJ9i797TBWN8v279h0ymnRrB0EqjqMAbT8blnuGoMN50eQm3iD5URi3GnMlFExIOQR",
+	"m66mY2KKdJzWGdKqckcE33z5kuE+aYHHA7MQiLqnebWqu3M57RojO4P9DUfmBuvc7AEM384CJvbA4sxF",
+	"dOZ4MK1DreGngANNVMESUByggDUlb4FWMK8ixEhDrhWBAD0D98tL8RO7cRy/daJ2kSlzJxeOSUcWemh5",
+	"KVrp5sekVuHktUl3WuvjRH8Ttesyoo5Jt2u36Rdz4q6ms1xA74S2TTVlBWuWdXUy33xbpzD4lUrQGEb7",
+	"OKXG/MY0GpNoQi3TqZk6C5RiH1mR0e3faREei8Qf5yta1AKGArLMft8ZRTgmXCSSUZPSBPSyJrmYdYZ/",
+	"ZR7gXASz+RgngMxHU4ZvY5VOJstXBrgBT0BVX6NLt713zRDzwwBlndFwN+4q9g7hGvcQ6ZjKr50JuoS0",
+	"J/O4HqnSkNgdNa19LkQWKCbrGqVZXPGDas4YO/O2OrFqSB94XBv7LGrei8wm86Lomct+r/HiwdxLlmVk",
+	"wdZc1KYfNvdws0h0wWoc2CI2oJZwuQT3kEpiqeWqmdAm1Roko6vO/fJnD5cMXrgt09ETehzbkfyayWWW",
+	"3+zC/Z9tu8rfc0iOzo5trMwOw8JTw8sQgBxiboVXZrc6b96n3hQC1obcTB4QvmujIL+Ee7uCENr622QC",
+	"C1qPT9i+8bTUh8qy2i/N5wH3pvYIjMajiv5GHY5+4BnrCPRMuYKuH6Jy2EeWUVSioxIH9ZamOWgz7Sed",
+	"2zTfCgO+TFO+JLZO3CJjdbYSpDFMOsOkOlmWf/yxNZFi01XUrsWV17h15BHkS2O84orQStvjcgoC0M4H",
+	"xgOBn+K+aRjGeQ7pV2P04vWaSppoVkUBo9Rsu1m3ncQ1qvvpnT0ePz4dP342fvx8/PjF+PF3ET+98MFr",
+	"ZmSOp9RylsPC2mAcKCgsw9rBRCXrAvEvCvY+ZddeLN7zUFQSDb5GFCO/lzTjekuwEXkEoZZMwuksmNZM",
+	"1rDhxWBlfoinDoDWedXRJUYP4CZcCFqodR7V5ncE70M3F7VPqCbKDkG6eItDopXhyOa7LWx9FjV3nhDw",
+	"Pi22d8rYgNrTxPlPuD0LJ/bBekPcJ9y84TqrUMedqQZ+qJASDqM75SNedjB473bE+YgR+JiS2tCIMWG3",
+	"SVamLNTqRbU0Gd/wutvs2Wzc4c0qPOtmouRsqkmY26RatZ6ss9lOx1bYtWi+tFDbjuNbamwi0UNupY8O",
+	"RA0O9NblrZz1ZrHsdGrEowueB81k3TfJUB5sZjbkHRMruAZnT5/hlO7v0476fCzRf+faKH8jvjKtu6zh",
+	"OEptDv3EkEhVxb9PV24wB24MCaLeUu6IhqFwlyJhwzQdojWyMdautU/K3pOgoL5kZfz+FlsiWcauqYmg",
+	"H6SUrniKXbHrDqZxta7Y9vzIaKb7Au0xypqJhMeKtVr/0dbvw3NfLrigcltLgRm9+kPNl1VKTcy7HYy5",
+	"M29Y/yPQgHe539idFafqw9pmTkN4OTqdzqanp7PL0bd7zDIfulluOvQirSy/O+ZpKnh7MnN2aKZtqjjv",
+	"w3+FEsZK0tSkeAs8uq9G/btZNZ1NT6ez3f6EZvZqjNilON8UuXSpar8vgU7u7W+Z5/2FnSBv5PkbZ/2B",
+	"5u7ffGMdg7VkbF/Xy/qwYZqYBa4DrTXs1s6w7KitG+QoPtAcUpWV924lFoANRa2D4au4SbJz/iYSJ93r",
+	"CdrY3gDmw70MzLF/stXne7xPOvjV1+aDMgktsMJxLgmmzvIl7esWqHpsKIYWxOTBIqMJI1QYG75JFmPH",
+	"85U7FZia6j6VXY+mW0B0D5zF6EA/4wMzmrWJgjddQYsaTah9uQ8/lfHAUt5D8eqdKyTTEUReLe3f2NMn",
+	"L56kx5JtOpJCtCtpLMpVH6HZ6T/mfVoTKuUWEXRtPYh3WwjDCllmT5oz75RTbLT+XWyB5pD2O9Nj1bmy",
+	"cx+ajciEemL5kuGZr/dwTxnmdXJYWbh+94m4QzmOYbMppI1wJ8zI6p4/Jx0MDQT7gK43RJkknbWaLkcI",
+	"nq8K49i8X5LfotFP5CQYSg0MEfE73o0SO5+yrkAdG+lj1PPurflGmcCdKEdGJUcf0r1YhsDfgaODzcsR",
+	"5HWNOjT0u9p3AdxyuxuW4r8KUG1LiUlxUTn0dC11R/SrGaHNNb2nhSGn8Bkx3GYVr3xY6m4iKFiaZE8A",
+	"jVwpQJAJ2uonoGIB7Kjqs2+SYmIGnwQ9I1vQsSkW7jZ1wYljNcbBVkLlqtwgY4TZPZVOeW7XqBpV80LI",
+	"x4GObb/w6u6oBgsRXG4Tv70LpI4tiyYlub4Dv/xWXHOZC9gm4i/TLuD+HL15+/0vfx+9HGlZc0+rDm3N",
+	"aLoDV3dA9uOnTx+IHQY2jgujrEPY8GMctP+YWBZycv7GMoDwBzCAMUDjGacNwhn/iEcYp9OcdUzyDdfE",
+	"b9S3rfjnwQFBOCwTaZFzoTEOqH+NOPrLkxPIrpmtc6VfPn/+/LmNrj7ZJMVAYkO50ExQkbAPshTsteO2",
+	"GoZqV1k64n5+yzcUzRHGFUH58IdcMSLzGzXM3I8t2/JHfoPpXPNrW4wPddQ3eZmB7dh9ASmPklRuIZRw",
+	"yHRNfZWB0qzyt/5t+oihsxEyJGi2/aPPW7DIKAZaB3FGNi3sUjK17kh/4QpK1Yr39JL46JE6XuqaJmW5",
+	"6XDhtrEX3ygStMVKpOSRwLQ+S4gRzqXxEgGCQbNvu7LYZ8xyzcNrDsstFDTr8BMu0bxoM83MnX3i8P1g",
+	"twUgwLGGW0oWlGCv7+4vAhkwVYAsjY7IXHi/4Wbk1JDLQm/mLhbnQHAlSzLKNyztgvl7+LnKcBzYovw7",
+	"NABSLCaPmRn3KE9vu5nY9f36maIIe2FddSX6NvIf2AqSQrSFI4u2tXOJ3dxOnOvB7vq99dCOK4IT263I",
+	"xrePvIaztb1rXOCdJLGDxQ/u8y67HBBVclMj7gXga+pTzaDrBKYiFFswca2i5PKgw+xf3F0k+/bDMViy",
+	"bntf9qWwDZ1XOsprNnIh7puutC93aE8yxIZCqxoltuaGA1KIN+j6A5H7TMHSIhlF1xjLYqpBCufcWgtD",
+	"WnJNaOCr9I1zrAq8gBrTwF8m5hcvBc2YQotZylWSCwFwx/xvXPHON2zJBY+HLb0vM80nWN3TlfOcEtdx",
+	"krFrllXRK1SySt8AnCGzMcusmI7GX1zhUlvJnz0FqBv4alZgiptbvpkVqqeq+QAF4jn8F4UXEK8ku+bs",
+	"Jq7nZcXw6ubuIC40K4JT/LzDIt6rKnDLv+lM5OEQ4Ru1d4ZDs7zYrXJL+WhIcSue9QBO7RCNcGKK883j",
+	"5W7Ro8fbjuBSLBjskZWVU4I4T7MS5iWiFkZQc3MNL9yQA64fLpov50E0wr6K7R7zwhBoPpbWNf5uGBu7",
+	"2tYtca8z26+GeaBMD85hXBk+ayjg1tfI2R+AuQOZ7/Y0BwMNf5TbxxSYjaqK2gUFfj9eWrvvufhYimPp",
+	"9WvLO1S7X0OpI5EOU+m+V+Xe6iMZjZYG+nW9regFWJchoqswxKInmHyAU7NJgcbSIKNcR93vQ0SO/WgC",
+	"7L4jCs0rh5s5rt6ADnNh/SjrXMnxeIehscR3C/lt1n1viK3oKl3FSGhW/I0Yx2l4TrgOcrTV/BSAeQCc",
+	"Ub7faDykpvyuKFwfkecYWdT2jMYRAz6W7zQRpOya56VBuooXILm0RiZk+IkNfLXkxxUANXKdWkcpTW/G",
+	"S5cMsY70h3BnjUtVVbAPbWYw+OSn2DAmQ9q8s4DJR7YqMyrDtN6xbbM1aDal0sbXryPoN5pyzhrkQkSa",
+	"kh9gYy3TKq1vxJ9/unltUYTPn71rxKWIw4S6TJfReM0cpN5ChyOv0WcP/dMw7ipaCCqSOq/9iq9ZXQgP",
+	"3A2aBJUna/CUTPIqtZBfgufFEC1kKVSFJQEiVoPDfSolG41HNLuhW7U7C4ldxS4K1n58qyKe1TMceX3H",
+	"I/tExN9h6xXbfuqO5wGBWZ4wVRHX270G3F21QTKMUgCJAr26QtNjYDrwEfGxWaCjd0qP+g5j7EDvGC3n",
+	"jT3cMKr593bFiKRNiHiLsyztcLW0u8jFNc14auLoxzZjns3+usjYRlWeTzfrPIs46Jt4HTWt3ob+xC5V",
+	"T6RVoKtYMCLYCiNudkqAZk39kcy1vfkky6i+jq+sHq9Bv398NTl7+sztj8t1sAxiJP6GKS6c9w1+kew6",
+	"v2LKNN8jt1h3Kgg/uYk1grGj0SpB4oYdblcWz1yHno07Gl/eSpywJ0/+kSVMaBe+VYcEaQuKHvHscZgw",
+	"zhZE1GvkmK2gcpdscPVghB2RKsoWLYiMjoncBiQM4xvm4a5CUwcHFlWbVJ+yf7OPdf7ViHdBgXr8d59i",
+	"uAoU7FALYyzfPBoc/rNJduirOSrNs4xcsUKPyQycuG3qlUEGnf4itq0gUlVugMTIfHOAQdfMNg7WFt9G",
+	"E2h54f0GGo9+Uc4LJhPrcDtAFoAegJ7DE53kBRPzZTq0uc1ouPuS2IZVYr26Q3cwpFRqPxMb7tY+rFDz",
+	"cKoB6js2ru14CFmwT8096DhYnUv2PU2uymJfL+IF9trtS4itcGkoks2rJcZs69KARDSFJ5Fh8peK3beJ",
+	"EgTWnoPRBoQfWTgjABzuLXxBr1lqwqWOwwMv/VgDaj7aifdxHn57WzCh+DUjVtSIclr7az57+FO7pP20",
+	"lsHG9sUxHrJXw0xydeB3gngXxWow0EGYd6yXtgbHoU+tq4R1nxXNDjClfMEqaKeTpzvroHUlR/NlyZZc",
+	"dsTVRpWwrltXogylwa/Ablk3n9sAYDif+3CF26r9/4mv1hqzeNsoyC3RktNVFGAsY961JT/Bs9Lekhtg",
+	"5mBfBm/LkYrI1UrWzBXT/UhkDsPKg+i0Tm0dFlvcZkrghYUqN1wrli0xApNdIxtpvJemUdQbWMwOgWje",
+	"H64CaySvRze/LYFQnHzPZMbFcd6j41XLu1OBiFq4TKuint/P5gWKHXkLm8YtiloRmT0f2yip7ShDm5M0",
+	"N/oc1GduuDKhEk7fYFkzk2hSE6tcfHkpJij9vMRURtByMyaSJblMCfVmKFkKaJiLhL10eEyJ4mKVMfiI",
+	"xwTZhuy0eWIspAlT0I9mme9mA0Ka7cgjqk357tPZt5dh/mcrm+VGEqJZFtV4RolXhKiGV9AHAKJTY+CM",
+	"4iwpFY0xx6baHirHL5r4ZUogfr3VDY9VzXBe5aXmS0xNzYKKhv9CFQy7KxaqL1aysKNE0fEqE95HJcL9",
+	"TLWHVP77Wor97WP6/Hpr/E2Jo/KBP/fCeE68+nB+KTJGrxlcV8A6sIMrpknrycbbx2g6xiZUEPMMXwrn",
+	"ocs1uWKscAb0XLIU2bPL41cZHFI8cFCxwL6KwvdTMnBoQOi/l+ZNNfGg9sUz+TV9oEvFzH1C0QAOEFkj",
+	"EuZOwfPKC4Yvc7nZ0Hhg6n2XYPP5AuFzu1hgTRJCkIFBAlo4vUPhsrgQ0ssLOqVcXXi1LCHXKnAzqIQB",
+	"oxo3FWiQOQTi/BLGg9fP8HDdDCJS2IpDzHKxCtLK12eD9t6F5WX1z2jjMfzqvJlq7CFAMLK80GhcJTbo",
+	"5RLvqBiyo+yvfYl7rlpxoV96BCTa0JSR0sTvBSy246bTUiLvkt+IuvTV4o0OcQAYEs6zw71NlkYAGebd",
+	"Zves083ffk/ny1iinp8r0QINhW7upatkM1hbMMTNzm6reY3sbI9smn8kz80VQwv1bd90w3zrammo41lA",
+	"nAtg4DPS48QZk5TDo2hufOgV6xB5p4NDcB+OcxX3cn4NOh1NRxvCcVcd7bGBugNE9TRtbXCsW8N7Fbsa",
+	"0Je4Jk0poG7zftaVOTv9udTd6VNdrkCqiGZyw4XhGUoM+XcCypD0qTrXNHvf5ab3Cb7aBKXKJOX3RXmL",
+	"IsNkLSavYjDXk7PommCoi4QKEbWw4URV2sVGzjvbrbZzTx4/b8/TymAZTNpY7Dg8xGDP4+jg1fT3rgYJ",
+	"Ehr8d634SZav4PvJNcV/WzeMvXIbuIjG3QF97gUOOCTfORrlHNdpDJmiUmpgkFSg8+iYK6HJms1dbag5",
+	"F+BqqvMrJlSfRR27BSWloBux3Zrp13fXOzZASEbTPQGALp2TP53NBk6PmNObJM4g1ze2pjWgXkdJ3WCs",
+	"eUeKrWakQAczYFq5ypa9uf135iO0tUbmQd7WVr60W01uuEjzG0OFvPRvNAXhoT57MXRjOx3TDY2C70DS",
+	"f7mobeJsOnsarHSZ5ajo7pgvcDipsaU9PNagTT1AibbjhmJhJGYy6qPSI6FZcFE3VHP4ZUtstcIq3LdU",
+	"DAvGKOMLsqeSzcRZq+i+nF/8XG2FEfd6NX2ADcQOCAohQ4i/PRgz3bsRreTuDm3I8//k6UCkZCnXuUTO",
+	"OCKXY5LARZYvgMiYppijwmjDUkmXOm5A+vPSpfO7HL3Ef6s8Y9MsXz26vLwcrVmW5fCPb/92ORpfjpJS",
+	"qlx+sGnUL0cvz558HrJfzFVKmrs73UUrzRUzXwm6B6FDan4DlvAkcuNrtPN0IOluhQjuyHTqyGa3yBYj",
+	"v78I/nsZFO33SqV2OXO6SFK2hOxV8XLpQx+Ynidt0MZ01f+u1ZozjQjVmmJ8ldP+tCvY/5dNDbiUiGTp",
+	"frxKzM0+4ingWhxAHHv1yd40ZgIk2qsbGZ1y58DRN/kHSL+yaWgZI4/xBNTWkyeT08nZ7Ozp7MXsaU+U",
+	"zW7EMA3j/MYQxCioifrs4TY+YJOAxahXeVjm8qrS0bavgJmhg/twZf14T5nIAAdjqduwNrpYdTmc7+HR",
+	"0PBdMLrDXLL0UC+GwXp0H83jVOlMtjJKqZcnJ3nBBKirmZxSbjNKDVS5D+HXzfzcW9Bit+RuCnhry/FB",
+	"Gti3tlRYIuUnq0JPcqUmp2ezxR6a+XPBNaeZLf6BKSZsEGgXKRv9yLKCbDDXE020W7AtQBfLcF/VhByg",
+	"xapVKQcvoJ4HBTRsHbQDet5u/3j+4rsoUKUQLKIw/Ii/E3BHZaK2A6hf8IG0cBxT8haDaTaMCmMmsiLs",
+	"a+CxXr87n0aIWUcAbW+JycaBpRkzDlBNk6rTRoSCqh+VpWNyY7E2l6jI5xquK3CzHAMAMZX1lPwEdLlU",
+	"rFb2zF4HNGXM0I5qC53b4VVtsacgYUSra+2mr/FqmXvuQXhwweIItSved53QApO715b57E6rPEixay1e",
+	"baXK+WTFBJOm3Ilp1UheXsPGj/busrRhX4UXuYznJu0whUEs3YSlXBsfwNAwVpvy/ZaYxOBUaPKJqqsj",
+	"eYZ1LfFQlzBLcwKdtssTW/PbavFksZezR4FWeaY3HVG4ZpLT4B5jHB16VjGmp+TnDdcmZI1lqXJmOeMN",
+	"3c6f44Fe2un2S4piLtTwfldcpKHhQUCvLMggNhqPUAyL2ua6uO4L58uCW4HJsa3t2OXGPjzkfkhYPBd7",
+	"d4HXW3W96jTjVDGFpqCKDTbZ+4evpM4LdiRm2K/++uduhN07U/fuqQbaH3qKkfZFi3XVoOuIHfPKtQGn",
+	"64YMMb0jwicMA/otzoocmMQMXHbZIIB7K0A4cufX5EZ2daIGHErUC00zZR7SgtEr4oYnGKbYeJu+US7I",
+	"i2jJmK+ODlqPzFXoM6FWbQJXj2xrUNMPvyD/jEF0Da8CHG9MTmczUjBzEW1ycVufLeBnnk6fjg8ImmsA",
+	"U25KW3cQ4IJ2gSDjV183IM0GVikNg++avnjMxhy733OpCE1krlTv5M+eDJoZjnfeOIVqAbPZoI3DQcI1",
+	"hHVah4NRCwD0Q5ydPnn+5MXjZ09eDBqpNkhDQGAKBQ6yYZu8YrG6dvDp42cvns++Oz0b7x+NGNM/o84J",
+	"75Vpa6ye9IqJgZqeZgWTAyIWm6fd2vnmYdYWNoSY7F0j6C4ipoFN7RHzXAu03fWsueHvEMc4tIDMgJXv",
+	"Pasx4B/LF8EBcUdWIF4AfQgr0Kxda1iBMQFJEzgDLKZo3iXT0NaR9JVu66sfmmByh+XpMKfr4XHZVRHp",
+	"9h75HbFxKMaGREmScSb8ymt7Eo2gs7mAO0mXX1HE+9z0HdvIFfQfcz8OVmBXbmeOH6tGwGMyAulxObBd",
+	"mpthSZ6z7JCOXhrfEaBeraDvRhmlyD43yfTo4KV5mu2RN2Co6uuCBWHmcSVX3RI7LIjg8CRGh2PO3gc+",
+	"iIV3294YP7LEflzQZWT3X5t8l8SIlRHlkk86DNK9zSU4KItVoxBZcJyj8eiGYioe41likxVTmXYkvaqt",
+	"4YD3oVYL3SD3lLxyaza/Y9jmItfrS2F+xzL7RuPlmpiHBH6xEQbeZIs1JQyxNUnVSmH6pG4WfcMTViOG",
+	"aKyyFNpEINSvGyTMrjSKbdtYlvZ9jlq0BhDYEKojE9ajEjdXvWkfl7U7BODdQ47JgXzGYYmMoxFre3vP",
+	"HB44NiTMa0eG7rvl4OzAwV25MJNiru5WwMohZq2QVQuLvWl+eJrmIONinwsDqJTDpkb5YYOpXGDsvhrJ",
+	"r8h2vNvKO9w2221PPSCHZRd5OyyavlYzbpAg5hDvH7Zn7CgPzpbtS4mHSBiPmt8rEL5OzI8iDrvB9pZA",
+	"XceLgiX/+s/Kgz8RDc+4eEjxwIjhe31Kvro3I1okHb/ePROydcLwGRRaWV+W/HZi6lEOiQOutxiPsIrz",
+	"zyLbGiv6A1L4akU/8FuCKyL/9uef+I/Pn0fj4z4BNXLeEFhYklHJ0qqy4ZT8IlL3a+0tp5IRR9KC9uFz",
+	"/kWfiNrrMIC0quMqHStSf0flYw9c6KWr+mIC4DswWAnVbGVSGTeejlpUStyD0bWJRHmHhA7F8J5hWs7Q",
+	"7TGs8b5nENMCK+pNHFxjAn/h8N/2jR8jXMfRJ9scUsZJO6rJULn0ziPQlhR0xcakkAztjii+o6ppk0uv",
+	"5MDqjTSWBmk4DumGNb0jf16XIwR2Q0rrcra7NMWVJdUWJrHeyXvw741V2P7RdWRUrV9X1WHrwMefC9sc",
+	"gbfFTwF2BUPBxi/5bd1/x6ZwKTIaTxXvLfCN24a/O/2ULWFLJgSri5JHkhX5t/DcrbJ8AT9gcMY637Bv",
+	"AyUWNh6NR6ZRvW6++zaI3lkod23i0ahdeDCHkzpXse9IUP3AM+bGvANUmkpdK5nTVarvjnWTqv7zLd1E",
+	"6J/rRqqWhCryv1+9fweYhUEooOwbB7XKg6a+CMLeHnD1+tzVkIdX6Hbv4tvbIpd6X8urDZlpA4pb4TnD",
+	"MLm66kitytoJUT3/OMVj2J053g4y9nAdbnVt89mdxboPqqd957LXR6lQfa/Vo7uKRe+BlZ77jN1x45/f",
+	"hPUf4LsU3Lsg66I0BKNK5KVKiNy2pWf6y3cPyuDbKex051OOJId0YKKvowWREvTJ8kuSDNViuXNY3p1i",
+	"ubNOJWRt+wiCeCS/J82WE0y2I41VYwn2DUkTzSR59IvgSZ4y9PwnWOv7W5Ivl4rpdo48VsP6ZpHbAVU1",
+	"TLvxyMZOtVchS6VrdSE6n4egokIko4DObVkGX/uBKqy0BNt9MyYYDwKtTEmIZkWIQNDspeQW1MCX+QaL",
+	"jNcrRXDlClTUWSMlk8FOzSEY/dUhYnt4p6p07TIdg+nvL6gN3HGSNlq7o/YG1esg29SCC/CJf2QqoJi9",
+	"MikrIcg+ZZqhaqtemP6kVNKUpT9ZcHHiYzh2xHl+7lxQlXyoa0lHy8Tcyqrcl/a4jb1HTRt8X9l3w6y3",
+	"+9d77Doj53DVcURDE12Y0Qj9GvNd1GJHzJeTUtg2DcP+4AwX7SR1JzaCZt/snfslvDRzAeftptsZA39w",
+	"qsuoY8qds106mA6IJPqq4+H3izO2sY41xTQWl6uqVll259svF338ePJ0YiaA+OMnp7Ozs3tIa1lfz9Uk",
+	"l5PpdHr0VJNHDZAdlJOyK2S8hs73k5yyWiwVei3zgicn7lCn7lD/b0Dm/w3IvENAZldMpHn6u4Mhf7He",
+	"thAHSX6iB6TJ/8W7kPWUYI/GRZrih//M12JnHbNuJgkGcX7YPZzSNRUJS8EQds3j3ijtx9v1Iq4XMXlq",
+	"4qyC42vmVbiXZgKVdyndxoxodKsIRkPCFnFJsnruDJCpM6ZZ284yJbMqKfAGNpldm5BLn+N5FsOtyv94",
+	"7iro9iHZq1Lnn6A1ULlI//48HcZ/u94Drhz2dDHreMvQ3juNaz7SsmBzkH7nyumCIzsp88KIyL6RpckJ",
+	"pq6prCvuu/caBRuArTTZPtLavMPOU+d4Mg1oDj2wvNBzLuaaZWzDdCze+udCTzhWnskh70KJKyuYRMoj",
+	"EpPzl2H2HEPravmSg7VKejM3/vl7rVPSG6K0ZHRjnFQPXGr3/f6VLdZ5ftV5tXtlxyrgYLhPkp3wLXT9",
+	"BCNG8+A4q9hwuadTBFUskSxaYeaGKL7CSFHbZrAu8yChM6CkdyKhnXSTZPyKEQgD/Iic3tdLR6sccn9F",
+	"Ohpw1/BTX1j9Pv59//rUuP/YB1NjW4TgK6TBfoGPoyu8c02/CJVoX5fIBehZ+26KsAM1uhB3P7fPOp95",
+	"F210ONJwPfQ/aFKWm/e5k0Jd0XkuEsk2TGh0BahjSfDN+iwrspSMoZOJrxEA22LLD3BB1AbkPlOMn4r0",
+	"UhgXlFxeKSy5ZGUiTUHyN3QnnAb1a9cI6xQj2S+FZIuSQ4IQN9kYFEEJSgroW1eVAcBE5CZQRd1wk+EY",
+	"Y1P8hDrvnC5WqKm+OwBPNEDkH1CanGrmiy53shtDijUDkNd2xK5MMILdTIYaTnDOOE60wO50CaPCVBbq",
+	"t/xVkhvogxfM55J/hGiABUuWpu4QJpFDI9S3UWKGBG9AJkfcMbtd/Rkdu0omxRdgW0dBuy2oSCEgK3aY",
+	"4PXvWtiS3hAC9d9EMpVn1yw96EzHI678OfWvAefEDH3Vajr2X8syuv0NDPJ7UVt5H0oNs2B2ul28rlkP",
+	"g/vg6t24Jx9fZlCv8APyMA22XbrZj2S93GPj9nNj2YXhNFbA32ID1a5SfBvh0R9A7VtO36zhHPpGY2yi",
+	"t+dX9AmwuSjwdFqngdm2bRHyttjUZRQPb0iINYFt2lvIo5uASBCTE+MV7f2dMf0qyPx2Hu7UY2XM45SD",
+	"3lk01jCF6IidsoxfM9mRxL0hLjcIN3xE1xtlZSoY6W+EBckHaZYN9eseImbH7CW2X2cV27i7KrjvO5Dd",
+	"fuTLRmk6YHwMWVJsf6fVgbVpbyz8rSCFV5ism2a4u8maxi0CHbqCi5qeYGz8hn1ZMu9/5Cbnyj3sx6qk",
+	"GjUBgfUnQL4PP198wuTEUQMQLHlqf54m+eYEIFUnlfF5fyfFHYeORfy5Cp6PXGJO2hvCddcjf0hVV9ic",
+	"+u3ymHpoOVZ7Dd6Y1fGjRSnUx90e7iTbHKgFFtWaYW2+vggD28YUm1I5WVIZDRA4TJ1haeFevTrYWldV",
+	"yuieDNh9rOy1iXT/PXKVgdkSCQsqr8DAzJDftafhW8y5hBtj39K9M7DsS59j9NgdMDl/MzaF9bihNf8x",
+	"Qcr3Dq7uxLcyzqHDgMXYCbuX3QldKCmYQA9+vzHIEXCWopZpcOaWokrPEPdbNSa7mF9Dnm5JkSMLovOQ",
+	"0o4iN0PamxqkQmi8GOjPWstuEaKVT0qHybyZZLaOMnEDj/rtik5Etts2Cm5CXy2u8ciuKZ4rIUb+gg41",
+	"1Kv2Okhu6unBzoJdLdRsi3DbAp1EzaXxy4PDsTCpUFfAbubUvr6j8cj9cx7Ie07zFKYNcb/5W95ISTMa",
+	"jxZlumJQ6zdhrCtTiDdw3EWnZAfZmzwf+dk49LmAZlwscydT0kRX8Tujio6Qi7Iocqntm1oxDxWXME3Z",
+	"dctpevTx7cUnjPBHf/FqPGvch3WjeKLG1mhhmCmb9pYKukIt0vhSOOxAldgyy2+svkoymiFpsUhnFLIw",
+	"TEILuuAZh421ZVuNsT5c2BsDiIMTxA4mjcPk6HQ6m85cakVa8NHL0ePp6XQ2MjIYHs4JXeGBpFwluYsS",
+	"yJWO6apMC0WwSxC8ofAhIVPjm2JHDH0FTQI+s1PnaTDWq5UNqLAu49/n6bahH4DaZNbH6OSfNoGLwZ42",
+	"6lmu7k2MqXMxjHFfAxNbZRdmgdsOle3fREX7emMbAexILYJ7NpvdYbFmmwffNNzqnffMDhpfTWNDS/T6",
+	"NFlH3Z6BLG2G+DwePZnNuqDy+3DyPU2dmujzePR0SJdzWwMLVYK4BJ9W3GMWodeUZ8as6JBMUzBQ/tfI",
+	"Yt1v0PPEu1bN0f3q5M8qJ8/nk+vTE6txhP3F5k7aAjBXMZHqHYcn1912i9hWUnT1iqoyNJj02rym9SsC",
+	"w3jRDm+spBum0X76Xy1nQBwGAnNrZcE4fHPpLCxRtA3OXRFMg1pNPP/tjqjai4luVf4NiWDXO1fy3zU+",
+	"CnbEzyZEDT/db5/HHYTQVtqnRLCb1mBITfBVgfgKzm5aB2u6v6r4hkNpX98e1yfxF2wITTq9NyC6T9u1",
+	"8dqFB6Ie7mgbh9qBIDV6cPInTz93EoW/M3gwtamaCxwLCBYYdbnAMu9EFSzhS57E5q7jz9+ZDpCnQRZi",
+	"S6+aeGjP09EXueKDztzsi30xnuw+wJ9y/UNeivQoJw4HQ5uQDD3uk5Ql1r03TipMd+McyMSWULH7fN/g",
+	"mMc74uMTlzqEexGX2b0B0Y1o0BLfRFPwvUZdjgIK4lUfBOcCbQEkdZDkssIDmklG0y0xuJQ+zDUwu0ly",
+	"sQ/tgypDZbGDE7KNnHbH/Flx32N4RfHd5DJyGWCI7+0094hMdoq+M3RQHI0N8a4JC78+t9Furm4e5FfJ",
+	"kQVJcqG40pjpOC98Onc/tnGQCNzRjXuPzWc6vhTahPajnxs0y7OUBae2YNvc1pFy5jiWOg2j9SMysmmM",
+	"yTHrGN0ji2Fm2H1uIWNx5+ODIUlZ1HY6enrBJTn5E/jvzzCDtvVo4ifr7MHBbflGEbNe4+Ci0QFCmxBe",
+	"TJlUg2R6KcATwR6xO3eIfFB13MgLJsZEGb2jhQvdB+A0WIq4YfztUD0RIBHGMiuT59CCkFbjckWuWKFt",
+	"1/xScG3tZKSQbOJmUuVyyW9jyPPRtPDY0yv62PNFy27LNmbghbinZ7PHs9NPp1CNYjadzWb/OU0XTkKy",
+	"hlsrINlB6i/ZQ8lKta3oQ/OPblcBOw7ln7/8u+PApk1y2HOffH376KPzKssItiErmQMJM6i3Wkm2gmtl",
+	"9MZjU/sZ7pMr7jvoJXLl5u/xJdLJ+u8I+RDxOFzp8Z4mM6otFlh/mMwGdD9MJoCa5AL9OMCYZeu0ULKh",
+	"WvJbgNrUlhrbgDfv8mn8BpsKOs7U2JBvjgUOqqikgkmSsCybktcsy2ypA9CqXwqdW/BtGjnD+AF/A2QM",
+	"98sXt1Y6LwqXhSrXayZVjCqZleEO3JPQHszwQBJ7hX39T2qAHg8mq1tMowZbo0ga0It+8fxVeJEMxSiY",
+	"nGwY8jkhyRhXxcCRePDlEr+rmJzukGU/CQ5BuW8JfZ+TNrvy4GK6OaK2jN593jgPlazz3N9xwRwnZw7b",
+	"5JW2cymQiRZb819XwZZLsuQCBSRVZvpSmERZgA02nnfrojtp4SqILakyVY+80tzNF+WeDdhfO/oYMLnK",
+	"xW4cSnzbh0Egu6X2YM3RdSNRlTQkijYfmZacXTOfqdDyxQ2PRZ8isJ7BxbKbLWphs47c46k1nEsjh1X3",
+	"v5V2nWmAt9n2aBc6tmvBkfhYh9+M02iy7gwilqVAGSV6DqqEZ0INOYUwac89PfKxvEBfWHW2Lxq4ykpN",
+	"JHiIV98e+HDUsdcZDNfYSp0oLAnWebkhscbEVDrBhgTNiS7wwBB7necZwYIuyLSavy1RmV6Kt8bNKpfe",
+	"Xd1UJ8eEaBvA5L/hV+PeKHJNCiqVzSaAk14KtRWa3k7JRxt/g08UdA08DNSYbHKliWQJlvSDz0Z8MbWo",
+	"LsWar9YZX63x+AQvCuYgxuB66zGWLwmjyboa39SNibxMppza63A/dwnov+JCde62c5nLDtPk771i94be",
+	"vmNipdejl08hR8KGC/f3acRQH3XqtM6cxhYrWOW1K/OMqQ6w4FvNWDo8HW0fEPmyDoLxkH5kEc3g2Byq",
+	"QNl/GvQak+l0+m0HpMx7Nh0RXMRtgMM6rBgWyCL7GO2d9XXYpEgxAOGbTRZxD9vZSNVyuPV710QtT+DK",
+	"uTc2qf16l0kpJrh3wcpcEeuMGJsOW9WmGhaQ2Te/z4LSP7VpdoS539NbiPkPfFn9nqPaE2hiBwym5m8I",
+	"gg96hEKsGzPy6OWpJSL2r1hugd1Q1SmmqlznCyZ3oOLGcJwfmLzw7SIwPw5APtsF8W/3yzV4ot+oqhnz",
+	"DsIWntF+IG7BQhG+/y6Nb41bgFaWV0jZolxNnFNhjzF/Ua4ilvxAS17x/163iZoC4/ZnOdYmB9OSCt7A",
+	"ROcAzr0aU+0k/XbU5pK75IM2p9/sGu4+hhC73a/nI9zhgFP58MGWUrElggEYhr83klmPF6IZ502Qb/o4",
+	"bohFZ3ho2or3NXapQ0N579vH0FnNBobOgj95Kz4ozI3WuTG2V2ODhuRziuCpH8ONenTptY2BAUJDsngn",
+	"eSzLP/7YTgzne4IB891o/cFkflAEO1VPi4vXRD0SFkfBzTFsLBfOdSjYPeMw7CQH+9AQZTKWLbZEsoxh",
+	"vgUcokqJPMnYNcuIFxq4qF3a6aW4RFUPS7Qi0xXXfCVyiSoy+15NiSe5xuHdQ/mUuIxquSuEpi5FQaXm",
+	"XpdmGvsklAx2PiaF/AAbZCYym30/onpzmgcS19tg7Hx2fbjBVyGz4wIC8Q/RWQX4rDpuz5rRTHdL6q8h",
+	"f56NZvGPrnduwPHNCNvYw/qjGfweD87M0H9cmNgSoHaQ1rfODGEyBXa9mVXsaqdB1DQhuUzRRXuxRVP5",
+	"2Ou0Y3x2qWATQS8QtYW+c3GI97Z9rsLMbiuo3YGj2T99jKXbb7vY0OwZYyWw2b169OIMD2QctHP3HAc0",
+	"+FpceM0hxs6wujPeKGgcoKJBiqwazMbrbMCjk2sT9MJQ1VdJek1fTujv0GI/Ow5OGbfjPOmqq5SyLqr/",
+	"5R0KM7b7GDaUC80EFUmPR9QHWQrjtkQKausa+FRNvqj/mJh0PlYUoNn2D1bPAWT8lew7QTOVw0sBz1At",
+	"M1BBlSIFkzxPTQ32KfkVVamp3M5lKez0Zg9sXi5IREQ1ucnLLCULRgqAOEVIRK6RiTMlsqPWvo+leB/s",
+	"w/2Qj2CGgHzcJ9tSm7GbaATN7G4+FOX4WIpKUt/UTsQhb3hOBoMLW0lpArjU+wa7ljZX1U5no6BQ1L0+",
+	"s+E8Qx7b2jqO9+bWh6223IHX53SEhbXAw6jMNJ8ozQo/nL30Ve0qm59qxa8Z1ryiWO3qUhh5ErWsphDW",
+	"ia+CBWreRkmtKXkLBhOcyvlJEXopfMJkoAeMo4wMp8RFaZM+FxAWlJcK+37j9dXE5LWXWl2KpWRqXfFm",
+	"zR5GVgIwbUn+qKGmUWvsnshKV0mzL8ya1CDoRuEPAY6Z3X44PsXhbIj3HWjfojO7nJnCMQ0emczsNjdf",
+	"mFkbkXJbOXNFBKU6Fu3HxBRV3/t2STkEBx7cp6mIQbMPFpwUtFQ9zNOFzgtCvUzs50Pm1Zw6/L4sJdIq",
+	"xJEpeYX/MFSMq0vhYlTcMFyRjC3RBR3IolrHSNAHgOxfGHlw5/86rtZ4HHchODBPuenBtdf2oYNJcG8a",
+	"6HZjyr5Zj9sIsfmIE/wLo4zZwb+Ue3652QdpjKV7YqxaJ6a0XCe+fES3bFutzsaHOY86e0TOfyasJWUn",
+	"mcJxES4uBW0ndRgbLTMWuOMaioxrnsE6OnILXgqbpW+MT+X710FhyLDoVV5qdAYN8xSi13pQHwoAwaJS",
+	"6N1jVOTplLxNuXZe5qjPN+ApnBBnjxHRdkm7e+LkuusPfmF1d08Rv2jci3XtN7j2QMwcwtyFXjVLs2G6",
+	"4tfF5/7tprCo8d5xIUjXfUBtiBF87Em5FDRFLrVVZRUyX2SY/LsUaQwjowlL7wkpe7PKfmG87E/UGkHN",
+	"f1S5iY3I9lDY6SA/GEG7FRt2M5SNEfBCxWJr9WRFrjjaYo3bWAsxLwUkThQrRXQ+Je8rb8Zsa0oxMKMi",
+	"iUbkcE8o7ldDYucYpB1x8BxPMVKtMKpDNds18Rmd+j3W/eaaGhHtWCs00P1e8uSqKuvYEgk/4igfcMod",
+	"7p9tnymE9Au6cd1vfKbfiB2vFBMmz7I6muxojjJ2ht0XWtlCpLvSF2UZDF9KGeYzqzrHbuJF8PXe9ttP",
+	"MuQuVvAe7TKGW+C32P82IGdQsKu2m5GyKyUiphXy+aFAbsJ32zSwhLuqTG8ebVO5TiuSyFyQqmou0M64",
+	"0cEA5EC/V5tls2TwF9YNVtP32OHdWXR5DD2oJVNVpxTDudq9Hm7S9PiHkWsa/SbImit4lcGNx7k4e9xM",
+	"UZ6B0P5ph6EzQKf9xHYHy2Bzpz+wr9DiueO4xo7utl7Ve9q+2cNcpQfXraomJJ0kuze2rDrQKUGX+Mrf",
+	"bskZZNW44ZBzgLk4qSl5DcZeK+tfiiZNziVxZb+tmmKCubxtBz/dGOPWN0WpmQqScaANGui9i1/HDBxb",
+	"6L3hCrg6WYooza9XcL87lt1XcNxBD8YDYfkRY+O+/C1pYfjgF+ak19z9sXpJUJygAU6Hdu8xybi4cm5m",
+	"BrPzeqldHTgUd/Oc1kZ+OD4PCPWAFd9FankaSi1PH1RqCbdtEJYHrMHDYGqN+W66COxAVS35atWX2fgH",
+	"LmsMEd9sWMqpZtl2TNa5yEtk2IFHyq+ZzGhBijzjyRadCy6F6/iNshSarcqMyopSc4VBnsYHKI2reRHG",
+	"vw4H0G/0+FjWdV5f3G5RivBARX7Thy6G1kxMLt6QqkUIDr1m6Q+24X3uczDPIFEX2hO3guPdOBqUPfXD",
+	"7+0XG6zmvvxQqhkeSswMIeghqcFBPbSrLMASFPdfugOKqhkbtyQiZ0YlwtrJ70nTqr7D5cJwe79G2TBy",
+	"oTruUxm5TpY/PvKmfj3Xcfag19Hy8n8h67zJ/jgcrYKLPEADXK9y5nPX+5z1U/K9D/zydWKxgljGaBVJ",
+	"cike1UcSOUnWPEslE9+CpklD+2umQLr+H1gZFPjsFatD0WUB8hruHYYIEw4XgY/0gNfF5nt447y+yV3R",
+	"rhfYkbe/ZjaDTvFZ/bmGM4bDTYjI5YZmL4nIxcSVPx7jX6mkSx2cycSXNX/ZLnCOuwRtsNdLUu9sv2IV",
+	"pXzDNbpNuPN/9e5dsLMir9Dl20ahXbkxZXPs5KPxCKeJFLvpyE3QwM8wCYVJ2daZ0sMXDzpaFgoPS0Kl",
+	"3NqMAHJbh8qGYT1KqGITLhQTioOJsxPNbOzE8aG8/8QVjTiy2j64/I+LLaEZpxgSCq+z+dBdu8LV/L6H",
+	"U7N6/33ybNg+r46ZbqMF0MDEG7b598fKv1EHBi1UJmEq0moMwuGKbMpk3QHQhovXudK/qLQDmrxchPX6",
+	"jZ5lT1A2+RBI6O2RIDEu22iSozWJa0p+Ru9Z8xepniEbZoC3DRQFdMN8oZAwd3M42DdYdrhkqDnD5aKa",
+	"2Tx/UWJWY+n2uqlD0qxMSUjo8Ul2oek+3kHZNKsak59ML4erx0I7/t75WCqHU3thbcE7H1OxJLQKnygw",
+	"rRIeH9dGVTNPSqk681H5jw+T+9kxNoPkf9v2L8O7IuCqYt0i/gq7bOkmF7rU1mRu0/u9zlPWGY9p1RH+",
+	"6z0avc0cD1p5x8PQF4BubsoR7d5Pzs6Ol5fGuYk53OvNT+MakzRnRuOKtbgRUwRjJgvdgh21FoDPQhx4",
+	"bfS439g/TyzT21M5xjQAbqQUtrWJbysyVuPjKAEGK2NVMcIW2n9fZld2wEBaug/kD2Z6IMG/BkFPVtoy",
+	"u6p2rEqYAUhxNnv+pcH5YPOg2Pv3UBpB3BWLbScV3vXT6Rpi8w2G8Hbi9Tl+r0egmYzLpUjBVp/fCKjw",
+	"ykylf7Cb59LJ+N9jG59g0w4wBkPL2NF/+6MJA3e1bFaY9hLvKJQbtjFwl8KGZhoc0JL54hWBAwyyYDdM",
+	"MqI0GPqtVyqV7FKY1ZrEnRz2VZaFtpHfrrow9fXZqSIpEzxuATIbU1vo4Du6+oMXdZT03K3Jdhth+b/o",
+	"exRZXP/lRFxwu/tQt8HiaqUhX7hj2XULdlZ5cXUvPEVPuUooBjI0dC1QDgPeLfuzU1y0Cbwd8g20u0/y",
+	"XpvnAYl8A46eQnNZZnZPuSo3bT7n2CR/MHBfCeEfjI8DkH9HfuWLKg9UQ0d08e/vyLvz//UWsyVzplzp",
+	"ECx7PCYWWkO+TUJl44A1vRSoJHAqyEurXLwcNRW9ItckVItqszr7T7fkcV1DXWVS03kQ4hAkUwKhc47v",
+	"AtfbOdUEVmzI//RSvAOh1xQRP5uFCZuzLSi8jCuZHxb2pTCZ4KhIWHcS5qF6b7vfdsNyWSWWoyvKhdKt",
+	"/c2la43bSx6Viil/Ol3KSvdnNGMzeukfoI5weeHu4HdzWve7eUi3G3Nif7HkqXvc/COVQO2S3sFF1n/a",
+	"0+zp8z5/iRMeInE/vHtsA5AuHUyvc6wbxGVh8sUWgnhY1OqjWt2bmZCLcWR7pz9ttyvrkbDh3hxZD1AC",
+	"PQgy7vBi/bJVUg12EC0pmseMx/R1FUzJDEI/oMNsE+sHksYTKz90Ucg3VvatecDqfGVS7aKRs5ZuDrQ/",
+	"GPQTSLEQyM4Y3kACYmEBjAf1d8+kQ7J/BEUh3Ygyv1FjlIurhNkmtfm4EmTHJn5dCVqodW4TWlch85ei",
+	"Fl1q+DXnPZDZwFB6Q5SWjG7s8FPyAYvBvfpwTq7YFnKO1AYlTFxzmYsNE9rYR4zNQWmJi4wRibe3MZH6",
+	"YFLRYlfOMcE38wtyGohwXR38iskNzuaS3sx9wwjzgt4QEU+C/R6xA/UDcVphFTUWh0bj0ZrR1PpNvjbz",
+	"T95whYG/vEkgWrM8yDU2iHGIYF9VFUv0oEQobgZbANV2hV/ss+P4bv6H9TFu3D6dk6VkWKXMpfvCOxyM",
+	"xFVV4ZBiGrLgI2KXy64RKKm/UbW0+J1lyBL99b6ydQB78xoe0bZiD3fA8/q6Ogabbcwo7INj+MsY7Oxa",
+	"CO1AoOGXx2zfgPT+wTY5u7/pq6wRXhgDTxCjPL4UXKyZNKlWuK7D6MMbo8heO9avEtsbiPcwpsXh6P9T",
+	"cH41V+cvj7uWHoOfUS6vKiQeirVsuWSo958MLQ0Ylky3lDzgs6BSgg+rNW+Dc2G4FNbt6hvVneUF+m+Y",
+	"XCFFsTFTZjz/rhgOyuTcwvAq41WS0I1JxzW97JOw37oF+/wuX6XA3QCzDxt90wonw+N5eEHc49jQ7CwN",
+	"FL11lrcOxBSp9ZGJE/BxULCmZp7jAeGF5KT5Zgxsxnsqr8BaN4bbpKlIaZYLRn789P4dutrAZTN6WP4H",
+	"SzHLKoF6qyD3fwpqijkVnzHK2XhAKmEXsowWii/AFCT8fNgQZhlfiirNllrjJ4UVeVXd+peyhFdeSmaV",
+	"9tphXC2XwE1tjBgCvBWKnrh3wFsZft4a+fAXiXsJkb6wkEsRLuFGcq2ZLdlhcdzEjZlLCopKA4iWpUi6",
+	"FBw12eV1/Z09ngTzqVqolQg6PI/dx4iIMtrYgwmce4Of1nqTjcYjuNbZINfeT3ZbakUVCeQIwi2k1nN1",
+	"A+V+FlvN1JS8MbCgmvjF6XdnYzIDbT5dZExVuz7tdhCcBxX25jhoba1eYzwb4pW2zwIweV59AWez2d3g",
+	"xzGHw78feb6diLRNoneLk+MRiC4niA2HdfU4dVdB9nVNvPI34CiS7F+AkW8Jv4dw8Wsq04mJr5qwTaG3",
+	"fSlHPjC5ocIYvFIXCWXMirkMLI3NpDdVNna+tJRXyxKKEsKM00vxynfh+JYpbkxy+N12WlNFRE42jELm",
+	"Wii8bTEbgxOs6UvkxuI19uEs6GqOH+CNMcm5Nfs2Rqp/pDI1AV5vYV60+d6LnSIS74Yz1k20pGhtd/rF",
+	"dbkX1bmgHx6CiQyBdmd/4g/+gfLhRrBSWEhrGzr0TngHoJ5szAyLU1S+QkTxFcRU6TxI0uz9mhJqDONc",
+	"E50brQ7CuZI0Yaikj3oSucG/cmNZE85B+BQ4WT2oscIBBAjNRXV0mmr2MPjst7ONSUMxuKokZaNPm2vW",
+	"VtBcsMy67dkBpsTEGBo9TZoH3rdbpg07bzQA04g7g8MAX1Pqa1O9NEF8WIve7sJYn2pSXlUe60FjVJvw",
+	"7IhPdSgJSo1BCc3qryAionn8NVkwJip9y5bpjgRmX/TtflODtztY/eGebS4Ct0P2wKHzA97krogR76Rf",
+	"G2McmJjtK4ucp2mk8wZRb8Ue46BHxZhjFJ9N6kVtz5c/5fotEGIVMyxGNe+tJL2WlU5zpsQ3lq7HC7zK",
+	"fFPEvL4FRy9H8x32VgEnpHNrM91d/9YM/CUq4B7JpcJTm3+xC/1/aHjPQRLBhilFV0wNsxZgBHTMXOVy",
+	"qDmy5fW0l8LNMCY6UKlSkdaUT1PyLher2tjKFsK6FEumEU+5QLWtjaZHjRMOZKIgzaBJxlGducyzLL9B",
+	"RS3J+DWrCl/BqDiiSbQAJjxbGh+HVVwkbK6A0nU4t1YmiPdu946p8Wy7mFrw+uJdbZNajOthEa5HC3FF",
+	"kL5AgGs8HNtuiC3OUXF2oINvxOFYxweoKQ2uQe7o7QFMybmpjwGGKmFxjXAbON0T81zDo/tSdu5tGfXX",
+	"q98roGpHPIn4q6gPwWKVRFcwkChKpvJSJkOpYkY1s0S+YPSKvP7wy5hs2MZV/Uc7i+ufS1IqtD0tTcbU",
+	"CjULmSdMKaIlY2MCtqpVVRrNplJXFBQsqp8sffTw3y9dCgIFHGB3Spr/JPSDP3vx4ivwhPdbOYSFcXhj",
+	"TvjhzbUNeAZiv3ea3I39DSfLhBa6BEqZmnSUAXqP0fyJDAH+qqmGK+DqrOvKtb7IORBubhJT9iP6hQf1",
+	"a/W2dwD2oc8PtV18OLSpn2YPumRUrSdgj6YiHYAl2J649oReU55RazJHZPDe9S2xLnr2MNxrN/uO0KJf",
+	"myMayc7HdyXVODFqZQGap1yOmlLcXjzJD+Uff2zdxG6SYTFKXzShR7i3g7J61M72oeKAAHsrtGrA1I3H",
+	"6DVxYp2bX/4Jv7mqFf2Fkr0Gz7UOQ+3wIGNp4D75se//3fJzDTnEatHHzpsSDF0dQ7UPg6qRlMqcaswl",
+	"akr+3RhFrZXUKGEwPqBUGqQMobQsEyNPAjMW1hHa0C0MpykX5M8/r6nkMNPnz5cCNcIQe8CkNRhQic+d",
+	"iQwzogANbbtOmdJdycQt+77yy9YP/qJgyRdPMFsHoVf9b9s8dIJZj2dNhO3A1xqNGJpKgvpRK8eByu8K",
+	"BGSo5k1ySWxBb9eYYyqJV9UvvuI25ukCSmOcvRBfU6PQQNzMr5kEPy/8rpjuzudwz2hZn+ShMh8fgJhf",
+	"XWqHvTBzcI0d1yVIJ+L1wlZPs7OoToBC+3HhbvLBBil/Ol9j4uRh59RdXOeetnH2oNfowYOI9ziYqEtB",
+	"ZR52/b9RTSbkVaWMLSBGcE4LPr9iW3LFWKGcyItKxCu27Y4WPh4GfEX8xcPi3184Y/ahdH+Xn713r3Td",
+	"TCwu8iCoAzCRTGh8QTHKmhlEStSaSuRxP5nq0lU4LNrvGIbB3pqHc0x64mOxIrVxZg1jZSVLadLrb/7V",
+	"E0kHoAG3N9zDblSd4ftKXG73x7owa3tXFZssc0qfgJg6wcsIaJmzZ3q/rfdcKdQcOkrT6FGKKwFmneBX",
+	"RKV/si5UMjbTr5na1iH8q+TodJzjXyfHawPZXOrkAdhfKiYnPr3CTiUoNCeFZEsmmUgs5vruETXnL4rJ",
+	"i+r7vdGrcJ6+M4Z2HmAi7braHPhRmLYynKymwbM/7c77st+Gm06tPb+vtCv1TX8QT82h5+7aHLOO4LGy",
+	"nAxAE7iqNjMLm1R2he7Q7jVLrsCbjAY2A/TiscFza5PrhFccUkf1P1fT/k1gzLgPjGrN80AIFYFjiKtU",
+	"kDanqsd2ZwRxwDQP0fpiOEQB05vDkhu2WOf51YCqM3mpF/AEEdcFlCeJZNYBx7DBoRtO2xbwq5vsHg/E",
+	"zTHEBOAXfzQLwE21QrfbftED9P62u5FFPvx88UkRxTLk6EhK2cZn/TGBKb98fDclmHPXsIlMmcBYvhIs",
+	"dTznf0x+hGD1dxCsPrmAsBZdSkasGGKCbS9Hak3Pnj77H5cjl4SXrNkt+fH9q9eTix9fnT195pyKasN9",
+	"4humNN0UTqyBoOOCSZ6nfpxFnm7HICy5iHr40S70G1gf4JANGoN/opcRE4A7zv1I5IJ556O/4QDuTOBn",
+	"+NuUvrH11glXFSZ2mibswdxrunk7xwOxsn727qtgm3ydBdZv/AlFLlNIvYZrft0Nc8XVUwZ+knJLsnw1",
+	"JR9sbmj7K7dSlcg1UUx0qoIrTNpPpLLADFYEu8P6CvXAvUfVrf29l52bPcQFenCV700DkK4XaEdRdTvM",
+	"0JrqHTLFsc71vkSRQ+jyg6DVv0Yd8/0I+UlFfrtzMgZ021iT7RD1SuZT8oNJbEu1ZpuichaXnKWXAvkR",
+	"dmtWBYEwkNU9Xy5JKTTPTKy7mwjrjOelce62o3XVPrSre1Ot4w4XYYBPavBe/WtUQm9t4DASXOHEA1ZP",
+	"uqmDw1kHLcauaCSI+fC9yxOaOabfNBuNR6XMRi9Ha62LlycnGTRZ50q/fP78+fMTWvCT61MknHa2VqDw",
+	"Vmm2AdY/02ujGjI5XZlIjQdqhSembQT7vNqTL1myTTJGNlTQFdswoYPuVeGr5gAoP0y4mOg1m2R5XlQZ",
+	"gcDzcJnlNwEcr+y32EgfGc2wdJ4NuzC+beAw6Lu/hQ+xvu+xpKGx5vjlm4ieDA8YMz7B3Dw1xbXtiJgP",
+	"dRStGcqIMjtsXR5hhwW95iuXw8MOYQTw9hCvVrCKlKskRzyG/rHNxXbxDUlKKYMIc19rPTxZ/1NkV6DO",
+	"wERp5hPqk4IXzKWucVvgf2qP8D3wF04n7ksFuMqSfj+bbmnV4DgAi6+u4RUX+tnZ3p8Cr75OzKULBwxY",
+	"Swws9Urtfrx3Lka7I30/HFQ97sLRv+AqQMvIEG9cGhXJoIerjrOhHEagRmljB3kf/NgzErxfZWFW5Iqd",
+	"BDuLHyP9f27qdvAi1DQO1TCehH3+7fP/PwDQov4efxICAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
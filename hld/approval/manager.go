@@ -242,38 +242,28 @@ func (m *manager) correlateApproval(ctx context.Context, approval *store.Approva
 // publishNewApprovalEvent publishes an event when a new approval is created
 func (m *manager) publishNewApprovalEvent(approval *store.Approval) {
 	if m.eventBus != nil {
-		event := bus.Event{
-			Type:      bus.EventNewApproval,
-			Timestamp: time.Now(),
-			Data: map[string]interface{}{
-				"approval_id": approval.ID,
-				"session_id":  approval.SessionID,
-				"tool_name":   approval.ToolName,
-			},
-		}
-		m.eventBus.Publish(event)
+		m.eventBus.Publish(bus.NewEvent(bus.NewApprovalPayload{
+			ApprovalID: approval.ID,
+			SessionID:  approval.SessionID,
+			ToolName:   approval.ToolName,
+		}))
 	}
 }
 
 // publishApprovalResolvedEvent publishes an event when an approval is resolved
 func (m *manager) publishApprovalResolvedEvent(approval *store.Approval, approved bool, responseText string) {
 	if m.eventBus != nil {
-		eventData := map[string]interface{}{
-			"approval_id":   approval.ID,
-			"session_id":    approval.SessionID,
-			"approved":      approved,
-			"response_text": responseText,
+		payload := bus.ApprovalResolvedPayload{
+			ApprovalID:   approval.ID,
+			SessionID:    approval.SessionID,
+			Approved:     approved,
+			ResponseText: responseText,
 		}
 		// Include tool_use_id if present
 		if approval.ToolUseID != nil {
-			eventData["tool_use_id"] = *approval.ToolUseID
-		}
-		event := bus.Event{
-			Type:      bus.EventApprovalResolved,
-			Timestamp: time.Now(),
-			Data:      eventData,
+			payload.ToolUseID = *approval.ToolUseID
 		}
-		m.eventBus.Publish(event)
+		m.eventBus.Publish(bus.NewEvent(payload))
 	}
 }
 
@@ -0,0 +1,419 @@
+// Package backup takes consistent copies of the daemon database while it is
+// running, rotates them, and restores them after validating their schema.
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+const (
+	// fileTimeFormat is the UTC timestamp embedded in backup file names
+	fileTimeFormat = "20060102T150405.000Z"
+
+	// stagedSuffix marks a validated backup waiting to replace the database on
+	// the next daemon start
+	stagedSuffix = ".restore"
+)
+
+// backupName matches the files this package writes, with an optional reason
+// such as pre-migration
+var backupName = regexp.MustCompile(`^daemon-(\d{8}T\d{6}\.\d{3}Z)(?:-([a-z-]+))?\.db$`)
+
+var (
+	// ErrNotFound is returned for a backup name that does not exist
+	ErrNotFound = errors.New("backup not found")
+
+	// ErrInvalidBackup is returned for a backup that fails schema validation
+	ErrInvalidBackup = errors.New("backup failed validation")
+
+	// ErrUnsupported is returned when the daemon uses a database server, whose
+	// backups are managed with the server's own tools
+	ErrUnsupported = errors.New("backups are only supported for SQLite databases")
+)
+
+// Backup describes one backup file
+type Backup struct {
+	Name          string    `json:"name"`
+	Path          string    `json:"path"`
+	SizeBytes     int64     `json:"size_bytes"`
+	CreatedAt     time.Time `json:"created_at"`
+	Reason        string    `json:"reason,omitempty"` // Why the backup was taken when not on request or schedule
+	SchemaVersion int       `json:"schema_version"`
+}
+
+// Config configures where backups go and how many are kept
+type Config struct {
+	Dir      string        // Defaults to a backups directory next to the database
+	Keep     int           // Newest backups to keep, 0 keeps all of them
+	Interval time.Duration // Time between scheduled backups, 0 disables them
+}
+
+// Service creates, lists and restores backups of the daemon database
+type Service struct {
+	dbPath   string
+	dir      string
+	keep     int
+	interval time.Duration
+	now      func() time.Time
+
+	// mu serializes backups so rotation sees every finished file
+	mu          sync.Mutex
+	lastAttempt time.Time
+}
+
+// New creates a backup service for the database at dbPath. An empty dbPath
+// creates a service that reports backups as unsupported.
+func New(dbPath string, cfg Config) *Service {
+	dir := cfg.Dir
+	if dir == "" && dbPath != "" {
+		dir = filepath.Join(filepath.Dir(dbPath), "backups")
+	}
+	return &Service{
+		dbPath:   dbPath,
+		dir:      dir,
+		keep:     cfg.Keep,
+		interval: cfg.Interval,
+		now:      time.Now,
+	}
+}
+
+// Dir returns the directory backups are written to
+func (s *Service) Dir() string {
+	return s.dir
+}
+
+// Start takes a backup whenever the newest one is older than the interval,
+// until ctx is cancelled
+func (s *Service) Start(ctx context.Context) {
+	if s.interval <= 0 || s.dbPath == "" {
+		slog.Info("scheduled database backups disabled")
+		return
+	}
+	slog.Info("starting scheduled database backups", "interval", s.interval, "dir", s.dir, "keep", s.keep)
+
+	for {
+		timer := time.NewTimer(s.untilDue())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			slog.Info("scheduled database backups shutting down")
+			return
+		case <-timer.C:
+		}
+
+		if _, err := s.create(ctx, ""); err != nil && ctx.Err() == nil {
+			slog.Error("scheduled database backup failed", "error", err)
+		}
+	}
+}
+
+// untilDue returns how long to wait before the next scheduled backup. Failed
+// attempts count as backups so errors are retried an interval later.
+func (s *Service) untilDue() time.Duration {
+	s.mu.Lock()
+	last := s.lastAttempt
+	s.mu.Unlock()
+
+	if backups, err := s.List(); err == nil && len(backups) > 0 && backups[0].CreatedAt.After(last) {
+		last = backups[0].CreatedAt
+	}
+	return max(last.Add(s.interval).Sub(s.now()), 0)
+}
+
+// Create backs up the database now and rotates old backups
+func (s *Service) Create(ctx context.Context) (*Backup, error) {
+	return s.create(ctx, "")
+}
+
+// BeforeMigration backs up the database if opening it would migrate it to a
+// newer schema, so a bad migration can be rolled back. It does nothing for a
+// new or current database.
+func (s *Service) BeforeMigration(ctx context.Context) (*Backup, error) {
+	if s.dbPath == "" {
+		return nil, nil
+	}
+	if _, err := os.Stat(s.dbPath); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	version, err := store.DatabaseSchemaVersion(ctx, s.dbPath)
+	if err != nil {
+		return nil, err
+	}
+	if version == 0 || version >= store.LatestSchemaVersion {
+		return nil, nil
+	}
+
+	slog.Info("backing up database before migrating it",
+		"from_version", version,
+		"to_version", store.LatestSchemaVersion)
+	return s.create(ctx, "pre-migration")
+}
+
+// BeforeRollback backs up the database before migrations are rolled back,
+// since rolling back drops the tables and columns they added
+func (s *Service) BeforeRollback(ctx context.Context) (*Backup, error) {
+	return s.create(ctx, "pre-rollback")
+}
+
+func (s *Service) create(ctx context.Context, reason string) (*Backup, error) {
+	if s.dbPath == "" {
+		return nil, ErrUnsupported
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastAttempt = s.now()
+
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+
+	name := "daemon-" + s.now().UTC().Format(fileTimeFormat)
+	if reason != "" {
+		name += "-" + reason
+	}
+	path := filepath.Join(s.dir, name+".db")
+	if err := store.BackupDatabase(ctx, s.dbPath, path); err != nil {
+		return nil, err
+	}
+
+	backup, err := s.describe(filepath.Base(path))
+	if err != nil {
+		return nil, err
+	}
+	slog.Info("database backup created", "path", backup.Path, "size_bytes", backup.SizeBytes)
+
+	if err := s.rotate(); err != nil {
+		slog.Warn("failed to rotate database backups", "error", err)
+	}
+	return backup, nil
+}
+
+// rotate deletes the oldest backups beyond the number to keep
+func (s *Service) rotate() error {
+	if s.keep <= 0 {
+		return nil
+	}
+	backups, err := s.List()
+	if err != nil {
+		return err
+	}
+	for _, b := range backups[min(s.keep, len(backups)):] {
+		if err := os.Remove(b.Path); err != nil {
+			return fmt.Errorf("failed to remove backup %s: %w", b.Name, err)
+		}
+		slog.Info("removed old database backup", "path", b.Path)
+	}
+	return nil
+}
+
+// List returns the backups in the backup directory, newest first
+func (s *Service) List() ([]Backup, error) {
+	if s.dbPath == "" {
+		return []Backup{}, nil
+	}
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Backup{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup directory: %w", err)
+	}
+
+	backups := make([]Backup, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !backupName.MatchString(entry.Name()) {
+			continue
+		}
+		backup, err := s.describe(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, *backup)
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].CreatedAt.After(backups[j].CreatedAt)
+	})
+	return backups, nil
+}
+
+// Get returns the backup with the given file name
+func (s *Service) Get(name string) (*Backup, error) {
+	if !backupName.MatchString(name) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	if _, err := os.Stat(filepath.Join(s.dir, name)); errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return s.describe(name)
+}
+
+// describe reads a backup file's metadata
+func (s *Service) describe(name string) (*Backup, error) {
+	match := backupName.FindStringSubmatch(name)
+	if match == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	createdAt, err := time.Parse(fileTimeFormat, match[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup name %s: %w", name, err)
+	}
+
+	path := filepath.Join(s.dir, name)
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat backup: %w", err)
+	}
+	version, err := store.DatabaseSchemaVersion(context.Background(), path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup %s: %w", name, err)
+	}
+	return &Backup{
+		Name:          name,
+		Path:          path,
+		SizeBytes:     info.Size(),
+		CreatedAt:     createdAt,
+		Reason:        match[2],
+		SchemaVersion: version,
+	}, nil
+}
+
+// StageRestore validates a backup and stages it to replace the database the
+// next time the daemon starts. The running daemon keeps the database open, so
+// it cannot be swapped in place.
+func (s *Service) StageRestore(ctx context.Context, name string) (*Backup, error) {
+	if s.dbPath == "" {
+		return nil, ErrUnsupported
+	}
+
+	backup, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	staged := s.dbPath + stagedSuffix
+	if err := copyFile(backup.Path, staged); err != nil {
+		return nil, err
+	}
+	version, err := store.ValidateDatabase(ctx, staged)
+	if err != nil {
+		_ = os.Remove(staged)
+		return nil, fmt.Errorf("%w: %s: %w", ErrInvalidBackup, name, err)
+	}
+	backup.SchemaVersion = version
+
+	slog.Info("staged database restore for next start", "backup", backup.Path, "schema_version", version)
+	return backup, nil
+}
+
+// ApplyStagedRestore swaps in a backup staged by StageRestore. It must run
+// before the database is opened and reports whether a restore was applied. A
+// staged file that fails validation is renamed with a .rejected suffix.
+func ApplyStagedRestore(ctx context.Context, dbPath string) (bool, error) {
+	staged := dbPath + stagedSuffix
+	if _, err := os.Stat(staged); errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	if _, err := store.ValidateDatabase(ctx, staged); err != nil {
+		// Set the file aside so the daemon still starts on the current database
+		_ = os.Rename(staged, staged+".rejected")
+		return false, fmt.Errorf("%w: staged restore: %w", ErrInvalidBackup, err)
+	}
+	previous, err := swapIn(dbPath, staged)
+	if err != nil {
+		return false, err
+	}
+
+	slog.Info("restored database from staged backup", "previous", previous)
+	return true, nil
+}
+
+// Restore replaces the database at dbPath with a validated copy of the backup
+// at backupPath. The daemon must not be running. It returns the path the
+// replaced database was moved to, if there was one.
+func Restore(ctx context.Context, dbPath, backupPath string) (string, error) {
+	tmp := dbPath + stagedSuffix + ".tmp"
+	if err := copyFile(backupPath, tmp); err != nil {
+		return "", err
+	}
+	defer func() { _ = os.Remove(tmp) }()
+
+	if _, err := store.ValidateDatabase(ctx, tmp); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidBackup, err)
+	}
+	return swapIn(dbPath, tmp)
+}
+
+// swapIn moves the validated database file at src to dbPath. The current
+// database and its WAL files are kept under a pre-restore name rather than
+// deleted, and a stale WAL is never applied to the restored file.
+func swapIn(dbPath, src string) (string, error) {
+	previous := fmt.Sprintf("%s.pre-restore-%s", dbPath, time.Now().UTC().Format(fileTimeFormat))
+	if _, err := os.Stat(dbPath); errors.Is(err, os.ErrNotExist) {
+		previous = ""
+	} else if err := os.Rename(dbPath, previous); err != nil {
+		return "", fmt.Errorf("failed to move current database aside: %w", err)
+	}
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if _, err := os.Stat(dbPath + suffix); err != nil {
+			continue
+		}
+		var err error
+		if previous == "" {
+			err = os.Remove(dbPath + suffix)
+		} else {
+			err = os.Rename(dbPath+suffix, previous+suffix)
+		}
+		if err != nil {
+			return previous, fmt.Errorf("failed to move %s aside: %w", strings.TrimPrefix(suffix, "-"), err)
+		}
+	}
+	if err := os.Rename(src, dbPath); err != nil {
+		return previous, fmt.Errorf("failed to move restored database into place: %w", err)
+	}
+	return previous, nil
+}
+
+// copyFile copies src to dst and syncs it to disk
+func copyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open backup: %w", err)
+	}
+	defer func() { _ = in.Close() }()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", dst, err)
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("failed to close %s: %w", dst, closeErr)
+		}
+		if err != nil {
+			_ = os.Remove(dst)
+		}
+	}()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return fmt.Errorf("failed to copy backup: %w", err)
+	}
+	if err := out.Sync(); err != nil {
+		return fmt.Errorf("failed to sync %s: %w", dst, err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDatabase creates a migrated database holding one session
+func newDatabase(t *testing.T, sessionID string) string {
+	t.Helper()
+	dbPath := testutil.DatabasePath(t, "backup")
+	s, err := store.NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	require.NoError(t, s.CreateSession(context.Background(), &store.Session{
+		ID: sessionID, RunID: "run-" + sessionID, Status: store.SessionStatusCompleted,
+	}))
+	require.NoError(t, s.Close())
+	return dbPath
+}
+
+func sessionExists(t *testing.T, dbPath, sessionID string) bool {
+	t.Helper()
+	s, err := store.NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+	_, err = s.GetSession(context.Background(), sessionID)
+	return err == nil
+}
+
+func TestService_CreateListRotate(t *testing.T) {
+	dbPath := newDatabase(t, "sess-1")
+	s := New(dbPath, Config{Keep: 2})
+	assert.Equal(t, filepath.Join(filepath.Dir(dbPath), "backups"), s.Dir())
+
+	backups, err := s.List()
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+
+	var names []string
+	for range 3 {
+		b, err := s.Create(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, store.LatestSchemaVersion, b.SchemaVersion)
+		assert.Positive(t, b.SizeBytes)
+		names = append(names, b.Name)
+		now = now.Add(time.Hour)
+	}
+	assert.Equal(t, "daemon-20260301T120000.000Z.db", names[0])
+
+	// Only the newest two are kept
+	backups, err = s.List()
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	assert.Equal(t, names[2], backups[0].Name)
+	assert.Equal(t, names[1], backups[1].Name)
+
+	// The next scheduled backup is due an interval after the newest
+	s.interval = 2 * time.Hour
+	assert.Equal(t, time.Hour, s.untilDue())
+
+	_, err = s.Get(names[0])
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = s.Get("../daemon.db")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestService_BeforeMigration(t *testing.T) {
+	dbPath := newDatabase(t, "sess-1")
+	s := New(dbPath, Config{})
+
+	// A current database needs no backup
+	b, err := s.BeforeMigration(context.Background())
+	require.NoError(t, err)
+	assert.Nil(t, b)
+
+	db, err := sql.Open("sqlite3", dbPath)
+	require.NoError(t, err)
+	_, err = db.Exec(`DELETE FROM schema_version WHERE version = ?`, store.LatestSchemaVersion)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	b, err = s.BeforeMigration(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, b)
+	assert.Equal(t, "pre-migration", b.Reason)
+	assert.Equal(t, store.LatestSchemaVersion-1, b.SchemaVersion)
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	dbPath := newDatabase(t, "before-backup")
+	s := New(dbPath, Config{})
+	b, err := s.Create(ctx)
+	require.NoError(t, err)
+
+	// Work done after the backup is replaced by the restore
+	st, err := store.NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	require.NoError(t, st.CreateSession(ctx, &store.Session{ID: "after-backup", RunID: "run-after", Status: store.SessionStatusRunning}))
+	require.NoError(t, st.Close())
+
+	previous, err := Restore(ctx, dbPath, b.Path)
+	require.NoError(t, err)
+	assert.FileExists(t, previous)
+	assert.True(t, sessionExists(t, dbPath, "before-backup"))
+	assert.False(t, sessionExists(t, dbPath, "after-backup"))
+	assert.True(t, sessionExists(t, previous, "after-backup"))
+
+	t.Run("rejects a newer schema", func(t *testing.T) {
+		db, err := sql.Open("sqlite3", b.Path)
+		require.NoError(t, err)
+		_, err = db.Exec(`INSERT INTO schema_version (version, description) VALUES (?, 'from the future')`,
+			store.LatestSchemaVersion+1)
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+
+		_, err = Restore(ctx, dbPath, b.Path)
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+		_, err = s.StageRestore(ctx, b.Name)
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+		assert.NoFileExists(t, dbPath+stagedSuffix)
+		assert.True(t, sessionExists(t, dbPath, "before-backup"))
+	})
+}
+
+func TestStagedRestore(t *testing.T) {
+	ctx := context.Background()
+	dbPath := newDatabase(t, "before-backup")
+	s := New(dbPath, Config{})
+	b, err := s.Create(ctx)
+	require.NoError(t, err)
+
+	applied, err := ApplyStagedRestore(ctx, dbPath)
+	require.NoError(t, err)
+	assert.False(t, applied)
+
+	_, err = s.StageRestore(ctx, "daemon-20200101T000000.000Z.db")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// The daemon keeps writing until it restarts
+	st, err := store.NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	staged, err := s.StageRestore(ctx, b.Name)
+	require.NoError(t, err)
+	assert.Equal(t, store.LatestSchemaVersion, staged.SchemaVersion)
+	require.NoError(t, st.CreateSession(ctx, &store.Session{ID: "after-backup", RunID: "run-after", Status: store.SessionStatusRunning}))
+	require.NoError(t, st.Close())
+
+	applied, err = ApplyStagedRestore(ctx, dbPath)
+	require.NoError(t, err)
+	assert.True(t, applied)
+	assert.NoFileExists(t, dbPath+stagedSuffix)
+	assert.False(t, sessionExists(t, dbPath, "after-backup"))
+
+	t.Run("sets aside an invalid staged file", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(dbPath+stagedSuffix, []byte("not a database"), 0600))
+
+		applied, err := ApplyStagedRestore(ctx, dbPath)
+		assert.ErrorIs(t, err, ErrInvalidBackup)
+		assert.False(t, applied)
+		assert.FileExists(t, dbPath+stagedSuffix+".rejected")
+		assert.True(t, sessionExists(t, dbPath, "before-backup"))
+	})
+}
@@ -0,0 +1,483 @@
+// Package batch launches one prompt across a matrix of models, proxy
+// providers and working directories, and compares the resulting sessions.
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// MaxCells bounds how many sessions a single batch may launch
+const MaxCells = 16
+
+// Aggregate batch statuses
+const (
+	StatusRunning   = "running"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+	StatusPartial   = "partial" // Every member finished, some unsuccessfully
+)
+
+const (
+	// MemberStatusLaunchFailed marks a member whose session could not be launched
+	MemberStatusLaunchFailed = "launch_failed"
+	// MemberStatusMissing marks a member whose session has since been deleted
+	MemberStatusMissing = "missing"
+)
+
+// Provider is a proxy provider, such as OpenRouter, that members can be routed
+// through. Each of its models becomes a member; with no models the provider's
+// default model is used.
+type Provider struct {
+	Name    string   `json:"name"`
+	BaseURL string   `json:"base_url"`
+	APIKey  string   `json:"api_key,omitempty"`
+	Models  []string `json:"models,omitempty"`
+}
+
+// Matrix describes the cells of a batch. Claude models and proxy provider
+// models form one axis and working directories the other.
+type Matrix struct {
+	Models      []string   `json:"models,omitempty"`
+	Providers   []Provider `json:"providers,omitempty"`
+	WorkingDirs []string   `json:"working_dirs,omitempty"`
+}
+
+// Request is a batch launch request
+type Request struct {
+	Name            string
+	Query           string
+	WorkingDir      string // Used when the matrix has no working directories
+	Matrix          Matrix
+	MaxTurns        int
+	AllowedTools    []string
+	AutoAcceptEdits bool
+}
+
+// Cell is a single combination of the matrix
+type Cell struct {
+	Label      string
+	Model      string
+	WorkingDir string
+	Provider   *Provider
+}
+
+// Group is a batch group with aggregate statistics over its members
+type Group struct {
+	ID           string
+	Name         string
+	Query        string
+	Matrix       Matrix
+	Status       string
+	TotalCostUSD float64
+	DurationMS   int64 // Wall clock time from the first launch to the last completion
+	CreatedAt    time.Time
+	Members      []Member
+}
+
+// Member is a batch member joined with its session's outcome
+type Member struct {
+	Index      int
+	SessionID  string
+	Label      string
+	Model      string
+	Provider   string
+	WorkingDir string
+	Status     string
+	CostUSD    *float64
+	DurationMS *int64
+	NumTurns   *int
+	DiffStats  *DiffStats // Not computed for lists, which would read every conversation
+	Error      string
+	Result     string // Only populated for comparisons
+}
+
+// Comparison lines up the results of a batch's members
+type Comparison struct {
+	Group             *Group
+	CheapestSessionID string
+	FastestSessionID  string
+}
+
+// Service launches batches and reports on them
+type Service struct {
+	store    store.ConversationStore
+	sessions session.SessionManager
+	now      func() time.Time
+}
+
+// New creates a new batch service
+func New(store store.ConversationStore, sessions session.SessionManager) *Service {
+	return &Service{
+		store:    store,
+		sessions: sessions,
+		now:      time.Now,
+	}
+}
+
+// Cells expands the matrix into its cells. An empty model axis yields a single
+// cell using the default model, and an empty directory axis uses defaultDir.
+func (m Matrix) Cells(defaultDir string) []Cell {
+	dirs := m.WorkingDirs
+	if len(dirs) == 0 {
+		dirs = []string{defaultDir}
+	}
+
+	var models []Cell
+	for _, model := range m.Models {
+		models = append(models, Cell{Label: model, Model: model})
+	}
+	for i := range m.Providers {
+		provider := &m.Providers[i]
+		if len(provider.Models) == 0 {
+			models = append(models, Cell{Label: provider.Name, Provider: provider})
+			continue
+		}
+		for _, model := range provider.Models {
+			models = append(models, Cell{Label: provider.Name + ":" + model, Model: model, Provider: provider})
+		}
+	}
+	if len(models) == 0 {
+		models = []Cell{{Label: "default"}}
+	}
+
+	cells := make([]Cell, 0, len(dirs)*len(models))
+	for _, dir := range dirs {
+		for _, cell := range models {
+			cell.WorkingDir = dir
+			if len(dirs) > 1 {
+				cell.Label += " @ " + dir
+			}
+			cells = append(cells, cell)
+		}
+	}
+	return cells
+}
+
+// Validate checks a batch request
+func Validate(req *Request) error {
+	if req.Query == "" {
+		return &validation.Error{Field: "query", Message: "must not be empty"}
+	}
+
+	for _, model := range req.Matrix.Models {
+		switch claudecode.Model(model) {
+		case claudecode.ModelOpus, claudecode.ModelSonnet, claudecode.ModelHaiku:
+		default:
+			return &validation.Error{Field: "matrix.models", Message: fmt.Sprintf("unknown model %q; route other models through a provider", model)}
+		}
+	}
+
+	names := make(map[string]bool)
+	for i, provider := range req.Matrix.Providers {
+		field := fmt.Sprintf("matrix.providers[%d]", i)
+		if provider.Name == "" {
+			return &validation.Error{Field: field + ".name", Message: "must not be empty"}
+		}
+		if names[provider.Name] {
+			return &validation.Error{Field: field + ".name", Message: fmt.Sprintf("duplicate provider %q", provider.Name)}
+		}
+		names[provider.Name] = true
+		if provider.BaseURL == "" {
+			return &validation.Error{Field: field + ".base_url", Message: "must not be empty"}
+		}
+	}
+
+	if len(req.Matrix.WorkingDirs) == 0 && req.WorkingDir == "" {
+		return &validation.Error{Field: "working_dir", Message: "must be set when the matrix has no working directories"}
+	}
+	for _, dir := range req.Matrix.WorkingDirs {
+		if dir == "" {
+			return &validation.Error{Field: "matrix.working_dirs", Message: "must not contain empty directories"}
+		}
+	}
+
+	if n := len(req.Matrix.Cells(req.WorkingDir)); n > MaxCells {
+		return &validation.Error{Field: "matrix", Message: fmt.Sprintf("expands to %d sessions, at most %d are allowed", n, MaxCells)}
+	}
+	return nil
+}
+
+// Launch validates a batch request, records the group and launches one
+// session per matrix cell. A cell that fails to launch is recorded with its
+// error and does not stop the remaining cells.
+func (s *Service) Launch(ctx context.Context, req *Request) (*Group, error) {
+	if err := Validate(req); err != nil {
+		return nil, err
+	}
+
+	name := req.Name
+	if name == "" {
+		name = truncate(req.Query, 60)
+	}
+
+	// API keys are only needed for launching and must not be persisted with the group
+	stored := req.Matrix
+	stored.Providers = make([]Provider, len(req.Matrix.Providers))
+	for i, provider := range req.Matrix.Providers {
+		provider.APIKey = ""
+		stored.Providers[i] = provider
+	}
+	matrixJSON, err := json.Marshal(stored)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode batch matrix: %w", err)
+	}
+
+	group := &store.BatchGroup{
+		ID:     uuid.New().String(),
+		Name:   name,
+		Query:  req.Query,
+		Matrix: string(matrixJSON),
+	}
+	if err := s.store.CreateBatchGroup(ctx, group); err != nil {
+		return nil, err
+	}
+
+	for i, cell := range req.Matrix.Cells(req.WorkingDir) {
+		member := &store.BatchMember{
+			GroupID:     group.ID,
+			MemberIndex: i,
+			Label:       cell.Label,
+			Model:       cell.Model,
+			WorkingDir:  cell.WorkingDir,
+		}
+		if cell.Provider != nil {
+			member.Provider = cell.Provider.Name
+		}
+
+		sess, err := s.sessions.LaunchSession(ctx, buildLaunchConfig(req, name, cell), false)
+		if err != nil {
+			slog.Warn("failed to launch batch member",
+				"batch_id", group.ID,
+				"label", cell.Label,
+				"error", err)
+			member.ErrorMessage = err.Error()
+		} else {
+			member.SessionID = sess.ID
+		}
+
+		if err := s.store.AddBatchMember(ctx, member); err != nil {
+			return nil, err
+		}
+	}
+
+	slog.Info("launched batch",
+		"batch_id", group.ID,
+		"name", name)
+
+	return s.Get(ctx, group.ID)
+}
+
+func buildLaunchConfig(req *Request, name string, cell Cell) session.LaunchSessionConfig {
+	config := session.LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{
+			Query:        req.Query,
+			OutputFormat: claudecode.OutputStreamJSON,
+			WorkingDir:   cell.WorkingDir,
+			MaxTurns:     req.MaxTurns,
+			AllowedTools: req.AllowedTools,
+		},
+		Title:           fmt.Sprintf("%s [%s]", name, cell.Label),
+		AutoAcceptEdits: req.AutoAcceptEdits,
+	}
+
+	if cell.Provider != nil {
+		config.ProxyEnabled = true
+		config.ProxyBaseURL = cell.Provider.BaseURL
+		config.ProxyAPIKey = cell.Provider.APIKey
+		config.ProxyModelOverride = cell.Model
+	} else {
+		config.Model = claudecode.Model(cell.Model)
+	}
+	return config
+}
+
+// Get returns a batch group with its members, their diff stats and aggregate
+// statistics
+func (s *Service) Get(ctx context.Context, id string) (*Group, error) {
+	return s.load(ctx, id, loadDiffStats)
+}
+
+// List returns all batch groups with aggregate statistics, newest first.
+// Members' diff stats are left out.
+func (s *Service) List(ctx context.Context) ([]*Group, error) {
+	groups, err := s.store.ListBatchGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Group, 0, len(groups))
+	for _, g := range groups {
+		group, err := s.load(ctx, g.ID, 0)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, group)
+	}
+	return result, nil
+}
+
+// Compare returns a batch group with each member's result text, and picks out
+// the cheapest and fastest successful members
+func (s *Service) Compare(ctx context.Context, id string) (*Comparison, error) {
+	group, err := s.load(ctx, id, loadDiffStats|loadResults)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := &Comparison{Group: group}
+	var cheapest *float64
+	var fastest *int64
+	for _, member := range group.Members {
+		if member.Status != store.SessionStatusCompleted {
+			continue
+		}
+		if member.CostUSD != nil && (cheapest == nil || *member.CostUSD < *cheapest) {
+			cheapest = member.CostUSD
+			comparison.CheapestSessionID = member.SessionID
+		}
+		if member.DurationMS != nil && (fastest == nil || *member.DurationMS < *fastest) {
+			fastest = member.DurationMS
+			comparison.FastestSessionID = member.SessionID
+		}
+	}
+	return comparison, nil
+}
+
+// What load reads beyond the members' sessions
+const (
+	loadDiffStats = 1 << iota // Diff stats, from each member's conversation
+	loadResults               // Each member's result text
+)
+
+func (s *Service) load(ctx context.Context, id string, details int) (*Group, error) {
+	g, err := s.store.GetBatchGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.store.GetBatchMembers(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &Group{
+		ID:        g.ID,
+		Name:      g.Name,
+		Query:     g.Query,
+		CreatedAt: g.CreatedAt,
+	}
+	_ = json.Unmarshal([]byte(g.Matrix), &group.Matrix)
+
+	var firstStart, lastEnd time.Time
+	active, succeeded := 0, 0
+	for _, m := range members {
+		member := Member{
+			Index:      m.MemberIndex,
+			SessionID:  m.SessionID,
+			Label:      m.Label,
+			Model:      m.Model,
+			Provider:   m.Provider,
+			WorkingDir: m.WorkingDir,
+			Error:      m.ErrorMessage,
+		}
+
+		if m.SessionID == "" {
+			member.Status = MemberStatusLaunchFailed
+			group.Members = append(group.Members, member)
+			continue
+		}
+
+		sess, err := s.store.GetSession(ctx, m.SessionID)
+		if errors.Is(err, store.ErrNotFound) {
+			member.Status = MemberStatusMissing
+			group.Members = append(group.Members, member)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get batch member session: %w", err)
+		}
+
+		member.Status = sess.Status
+		member.CostUSD = sess.CostUSD
+		member.NumTurns = sess.NumTurns
+		if member.Error == "" {
+			member.Error = sess.ErrorMessage
+		}
+		if details&loadResults != 0 {
+			member.Result = sess.ResultContent
+		}
+
+		end := s.now()
+		if sess.CompletedAt != nil {
+			end = *sess.CompletedAt
+		}
+		duration := end.Sub(sess.CreatedAt).Milliseconds()
+		if sess.DurationMS != nil {
+			duration = int64(*sess.DurationMS)
+		}
+		member.DurationMS = &duration
+
+		if firstStart.IsZero() || sess.CreatedAt.Before(firstStart) {
+			firstStart = sess.CreatedAt
+		}
+		if end.After(lastEnd) {
+			lastEnd = end
+		}
+
+		switch sess.Status {
+		case store.SessionStatusCompleted:
+			succeeded++
+		case store.SessionStatusFailed, store.SessionStatusInterrupted, store.SessionStatusDiscarded:
+		default:
+			active++
+		}
+		if sess.CostUSD != nil {
+			group.TotalCostUSD += *sess.CostUSD
+		}
+
+		if details&loadDiffStats != 0 {
+			events, err := s.store.GetSessionConversation(ctx, m.SessionID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get batch member conversation: %w", err)
+			}
+			stats := ComputeDiffStats(events)
+			member.DiffStats = &stats
+		}
+
+		group.Members = append(group.Members, member)
+	}
+
+	if !firstStart.IsZero() {
+		group.DurationMS = lastEnd.Sub(firstStart).Milliseconds()
+	}
+
+	switch {
+	case active > 0:
+		group.Status = StatusRunning
+	case succeeded == len(members):
+		group.Status = StatusCompleted
+	case succeeded == 0:
+		group.Status = StatusFailed
+	default:
+		group.Status = StatusPartial
+	}
+	return group, nil
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max]) + "..."
+}
@@ -0,0 +1,191 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMatrix_Cells(t *testing.T) {
+	matrix := Matrix{
+		Models: []string{"sonnet"},
+		Providers: []Provider{
+			{Name: "openrouter", BaseURL: "https://openrouter.ai/api/v1", Models: []string{"openai/gpt-4o", "google/gemini-2.5-pro"}},
+			{Name: "local", BaseURL: "http://localhost:4000"},
+		},
+	}
+
+	cells := matrix.Cells("/tmp/project")
+	require.Len(t, cells, 4)
+	assert.Equal(t, "sonnet", cells[0].Label)
+	assert.Nil(t, cells[0].Provider)
+	assert.Equal(t, "openrouter:openai/gpt-4o", cells[1].Label)
+	assert.Equal(t, "openai/gpt-4o", cells[1].Model)
+	assert.Equal(t, "local", cells[3].Label)
+	assert.Empty(t, cells[3].Model)
+	for _, cell := range cells {
+		assert.Equal(t, "/tmp/project", cell.WorkingDir)
+	}
+
+	cells = Matrix{Models: []string{"opus", "haiku"}, WorkingDirs: []string{"/a", "/b"}}.Cells("")
+	require.Len(t, cells, 4)
+	assert.Equal(t, "opus @ /a", cells[0].Label)
+	assert.Equal(t, "haiku @ /b", cells[3].Label)
+
+	cells = Matrix{}.Cells("/tmp/project")
+	require.Len(t, cells, 1)
+	assert.Equal(t, "default", cells[0].Label)
+}
+
+func TestValidate(t *testing.T) {
+	tooMany := make([]string, MaxCells+1)
+	for i := range tooMany {
+		tooMany[i] = "/dir"
+	}
+
+	tests := []struct {
+		name  string
+		req   Request
+		field string
+	}{
+		{"empty query", Request{WorkingDir: "/p"}, "query"},
+		{"unknown model", Request{Query: "q", WorkingDir: "/p", Matrix: Matrix{Models: []string{"gpt-4o"}}}, "matrix.models"},
+		{"provider without url", Request{Query: "q", WorkingDir: "/p", Matrix: Matrix{Providers: []Provider{{Name: "or"}}}}, "matrix.providers[0].base_url"},
+		{"duplicate provider", Request{Query: "q", WorkingDir: "/p", Matrix: Matrix{Providers: []Provider{{Name: "or", BaseURL: "u"}, {Name: "or", BaseURL: "u"}}}}, "matrix.providers[1].name"},
+		{"no working dir", Request{Query: "q"}, "working_dir"},
+		{"too many cells", Request{Query: "q", Matrix: Matrix{WorkingDirs: tooMany}}, "matrix"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&tt.req)
+			require.Error(t, err)
+			var validationErr *validation.Error
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestService_LaunchAndCompare(t *testing.T) {
+	sqliteStore, err := store.NewSQLiteStore(":memory:")
+	require.NoError(t, err)
+	defer func() { _ = sqliteStore.Close() }()
+
+	ctrl := gomock.NewController(t)
+	sessions := session.NewMockSessionManager(ctrl)
+	s := New(sqliteStore, sessions)
+	ctx := context.Background()
+
+	start := time.Date(2025, 3, 14, 10, 0, 0, 0, time.UTC)
+	createSession := func(id string) {
+		require.NoError(t, sqliteStore.CreateSession(ctx, &store.Session{
+			ID: id, RunID: "run-" + id, ClaudeSessionID: "claude-" + id, Query: "q",
+			Status: store.SessionStatusRunning, CreatedAt: start, LastActivityAt: start,
+		}))
+	}
+
+	gomock.InOrder(
+		sessions.EXPECT().
+			LaunchSession(gomock.Any(), gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, cfg session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+				assert.Equal(t, "sonnet", string(cfg.Model))
+				assert.False(t, cfg.ProxyEnabled)
+				assert.Equal(t, "eval [sonnet]", cfg.Title)
+				createSession("sess-sonnet")
+				return &session.Session{ID: "sess-sonnet"}, nil
+			}),
+		sessions.EXPECT().
+			LaunchSession(gomock.Any(), gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, cfg session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+				assert.True(t, cfg.ProxyEnabled)
+				assert.Equal(t, "https://openrouter.ai/api/v1", cfg.ProxyBaseURL)
+				assert.Equal(t, "sk-secret", cfg.ProxyAPIKey)
+				assert.Equal(t, "openai/gpt-4o", cfg.ProxyModelOverride)
+				assert.Empty(t, string(cfg.Model))
+				createSession("sess-gpt")
+				return &session.Session{ID: "sess-gpt"}, nil
+			}),
+		sessions.EXPECT().
+			LaunchSession(gomock.Any(), gomock.Any(), false).
+			Return(nil, errors.New("claude binary not found")),
+	)
+
+	group, err := s.Launch(ctx, &Request{
+		Name:       "eval",
+		Query:      "Fix the flaky test",
+		WorkingDir: "/tmp/project",
+		Matrix: Matrix{
+			Models: []string{"sonnet"},
+			Providers: []Provider{{
+				Name:    "openrouter",
+				BaseURL: "https://openrouter.ai/api/v1",
+				APIKey:  "sk-secret",
+				Models:  []string{"openai/gpt-4o", "google/gemini-2.5-pro"},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, StatusRunning, group.Status)
+	require.Len(t, group.Members, 3)
+	assert.Equal(t, MemberStatusLaunchFailed, group.Members[2].Status)
+	assert.Contains(t, group.Members[2].Error, "claude binary not found")
+
+	stored, err := sqliteStore.GetBatchGroup(ctx, group.ID)
+	require.NoError(t, err)
+	assert.NotContains(t, stored.Matrix, "sk-secret")
+
+	// Finish both sessions; sonnet is cheaper, gpt-4o is faster
+	finish := func(id string, cost float64, duration int, result string) {
+		status := store.SessionStatusCompleted
+		completedAt := start.Add(time.Duration(duration) * time.Millisecond)
+		require.NoError(t, sqliteStore.UpdateSession(ctx, id, store.SessionUpdate{
+			Status: &status, CostUSD: &cost, DurationMS: &duration, ResultContent: &result, CompletedAt: &completedAt,
+		}))
+	}
+	finish("sess-sonnet", 0.20, 90000, "Fixed by adding a retry")
+	finish("sess-gpt", 0.50, 30000, "Fixed by increasing the timeout")
+
+	require.NoError(t, sqliteStore.AddConversationEvent(ctx, &store.ConversationEvent{
+		SessionID: "sess-sonnet", ClaudeSessionID: "claude-sess-sonnet", EventType: store.EventTypeToolCall,
+		ToolID: "tool-1", ToolName: "Write", ToolInputJSON: `{"file_path":"/tmp/project/a_test.go","content":"a\nb\n"}`,
+	}))
+
+	comparison, err := s.Compare(ctx, group.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StatusPartial, comparison.Group.Status)
+	assert.InDelta(t, 0.70, comparison.Group.TotalCostUSD, 0.0001)
+	assert.Equal(t, int64(90000), comparison.Group.DurationMS)
+	assert.Equal(t, "sess-sonnet", comparison.CheapestSessionID)
+	assert.Equal(t, "sess-gpt", comparison.FastestSessionID)
+
+	sonnet := comparison.Group.Members[0]
+	assert.Equal(t, "Fixed by adding a retry", sonnet.Result)
+	assert.Equal(t, &DiffStats{FilesChanged: 1, LinesAdded: 2}, sonnet.DiffStats)
+
+	// Results are only included in comparisons
+	group, err = s.Get(ctx, group.ID)
+	require.NoError(t, err)
+	assert.Empty(t, group.Members[0].Result)
+	assert.NotNil(t, group.Members[0].DiffStats)
+
+	_, err = s.Get(ctx, "missing")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+
+	// A deleted member session leaves the rest of the batch loadable
+	require.NoError(t, sqliteStore.HardDeleteSession(ctx, "sess-gpt"))
+	groups, err := s.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	assert.Nil(t, groups[0].Members[0].DiffStats, "lists skip reading conversations")
+	assert.Equal(t, MemberStatusMissing, groups[0].Members[1].Status)
+	assert.Equal(t, store.SessionStatusCompleted, groups[0].Members[0].Status)
+}
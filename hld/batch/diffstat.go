@@ -0,0 +1,130 @@
+package batch
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// DiffStats summarizes the file changes a session made through its edit tools
+type DiffStats struct {
+	FilesChanged int `json:"files_changed"`
+	LinesAdded   int `json:"lines_added"`
+	LinesRemoved int `json:"lines_removed"`
+}
+
+type editInput struct {
+	OldString string `json:"old_string"`
+	NewString string `json:"new_string"`
+}
+
+type editToolInput struct {
+	FilePath  string      `json:"file_path"`
+	OldString string      `json:"old_string"`
+	NewString string      `json:"new_string"`
+	Content   string      `json:"content"`
+	Edits     []editInput `json:"edits"`
+}
+
+// ComputeDiffStats derives diff stats from a session's Edit, MultiEdit and
+// Write tool calls. Denied tool calls are ignored. Writes count every line of
+// the new content as added since the previous content is not known.
+func ComputeDiffStats(events []*store.ConversationEvent) DiffStats {
+	var stats DiffStats
+	files := make(map[string]struct{})
+
+	for _, event := range events {
+		if event.EventType != store.EventTypeToolCall || event.ApprovalStatus == store.ApprovalStatusDenied {
+			continue
+		}
+
+		var input editToolInput
+		switch event.ToolName {
+		case "Edit", "MultiEdit", "Write":
+			if err := json.Unmarshal([]byte(event.ToolInputJSON), &input); err != nil {
+				continue
+			}
+		default:
+			continue
+		}
+
+		switch event.ToolName {
+		case "Edit":
+			added, removed := lineDelta(input.OldString, input.NewString)
+			stats.LinesAdded += added
+			stats.LinesRemoved += removed
+		case "MultiEdit":
+			for _, edit := range input.Edits {
+				added, removed := lineDelta(edit.OldString, edit.NewString)
+				stats.LinesAdded += added
+				stats.LinesRemoved += removed
+			}
+		case "Write":
+			stats.LinesAdded += len(splitLines(input.Content))
+		}
+
+		if input.FilePath != "" {
+			files[input.FilePath] = struct{}{}
+		}
+	}
+
+	stats.FilesChanged = len(files)
+	return stats
+}
+
+// maxLCSCells bounds the work spent aligning a single edit's lines. Larger
+// edits fall back to counting every differing line after the common prefix
+// and suffix.
+const maxLCSCells = 250000
+
+// lineDelta counts added and removed lines between two snippets
+func lineDelta(oldText, newText string) (added, removed int) {
+	oldLines := splitLines(oldText)
+	newLines := splitLines(newText)
+
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+	oldLines = oldLines[prefix : len(oldLines)-suffix]
+	newLines = newLines[prefix : len(newLines)-suffix]
+
+	common := 0
+	if len(oldLines)*len(newLines) <= maxLCSCells {
+		common = longestCommonSubsequence(oldLines, newLines)
+	}
+	return len(newLines) - common, len(oldLines) - common
+}
+
+// longestCommonSubsequence returns the number of lines a and b share in order
+func longestCommonSubsequence(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			switch {
+			case a[i] == b[j]:
+				curr[j+1] = prev[j] + 1
+			case prev[j+1] >= curr[j]:
+				curr[j+1] = prev[j+1]
+			default:
+				curr[j+1] = curr[j]
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,74 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeDiffStats(t *testing.T) {
+	events := []*store.ConversationEvent{
+		{
+			EventType:     store.EventTypeToolCall,
+			ToolName:      "Edit",
+			ToolInputJSON: `{"file_path":"/p/a.go","old_string":"func a() {\n\treturn 1\n}","new_string":"func a() {\n\treturn 2\n}\n\nfunc b() {}"}`,
+		},
+		{
+			EventType:     store.EventTypeToolCall,
+			ToolName:      "MultiEdit",
+			ToolInputJSON: `{"file_path":"/p/a.go","edits":[{"old_string":"x\ny","new_string":"x"}]}`,
+		},
+		{
+			EventType:     store.EventTypeToolCall,
+			ToolName:      "Write",
+			ToolInputJSON: `{"file_path":"/p/b.go","content":"package p\n\nvar v = 1\n"}`,
+		},
+		{
+			// Denied edits never touched the file
+			EventType:      store.EventTypeToolCall,
+			ToolName:       "Write",
+			ToolInputJSON:  `{"file_path":"/p/c.go","content":"a\nb\n"}`,
+			ApprovalStatus: store.ApprovalStatusDenied,
+		},
+		{
+			EventType:     store.EventTypeToolCall,
+			ToolName:      "Read",
+			ToolInputJSON: `{"file_path":"/p/d.go"}`,
+		},
+		{
+			EventType: store.EventTypeMessage,
+			Content:   "done",
+		},
+	}
+
+	stats := ComputeDiffStats(events)
+	assert.Equal(t, 2, stats.FilesChanged)
+	// Edit: 1 changed line plus 2 new lines; MultiEdit: 1 removed; Write: 3 lines
+	assert.Equal(t, 6, stats.LinesAdded)
+	assert.Equal(t, 2, stats.LinesRemoved)
+}
+
+func TestLineDelta(t *testing.T) {
+	tests := []struct {
+		name           string
+		oldText        string
+		newText        string
+		added, removed int
+	}{
+		{"identical", "a\nb", "a\nb", 0, 0},
+		{"insert", "", "a\nb", 2, 0},
+		{"delete", "a\nb", "", 0, 2},
+		{"replace middle", "a\nb\nc", "a\nx\ny\nc", 2, 1},
+		{"append", "a", "a\nb", 1, 0},
+		{"interleaved", "a\nb\nc\nd", "a\nc\nx\nd\ny", 2, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := lineDelta(tt.oldText, tt.newText)
+			assert.Equal(t, tt.added, added)
+			assert.Equal(t, tt.removed, removed)
+		})
+	}
+}
@@ -0,0 +1,250 @@
+// Package bundle exports a session together with every session in its
+// continuation tree as a portable archive, and imports such archives under
+// new IDs. Bundles carry the conversation, approvals, file snapshots and MCP
+// server configuration, and optionally raw stream events, with secrets
+// stripped so they can be attached to bug reports.
+package bundle
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// FormatVersion is the archive layout version this build writes. Bundles with
+// a newer format are rejected.
+const FormatVersion = 1
+
+// ContentType is the media type of a bundle archive
+const ContentType = "application/gzip"
+
+const (
+	manifestFile = "manifest.json"
+	sessionsDir  = "sessions"
+
+	sessionFile       = "session.json"
+	eventsFile        = "events.jsonl"
+	approvalsFile     = "approvals.jsonl"
+	fileSnapshotsFile = "file_snapshots.jsonl"
+	mcpServersFile    = "mcp_servers.jsonl"
+	rawEventsFile     = "raw_events.jsonl"
+
+	// maxEntryBytes bounds each archive entry read during import
+	maxEntryBytes = 512 << 20
+)
+
+// ErrInvalidBundle is returned for an archive that is not a readable bundle
+var ErrInvalidBundle = errors.New("invalid session bundle")
+
+// Manifest describes a bundle's contents
+type Manifest struct {
+	FormatVersion int       `json:"format_version"`
+	SchemaVersion int       `json:"schema_version"` // Database schema of the exporting daemon
+	ExportedAt    time.Time `json:"exported_at"`
+	SessionID     string    `json:"session_id"` // The session the export was requested for
+	RootSessionID string    `json:"root_session_id"`
+	Sessions      []string  `json:"sessions"` // Parents before the sessions continuing from them
+	RawEvents     bool      `json:"raw_events"`
+}
+
+// Bundle is a decoded session bundle
+type Bundle struct {
+	Manifest Manifest
+	Sessions []*store.SessionRecords // In manifest order
+}
+
+// Write encodes the bundle as a gzipped tar archive
+func (b *Bundle) Write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	modTime := b.Manifest.ExportedAt
+
+	if err := writeJSON(tw, manifestFile, modTime, b.Manifest); err != nil {
+		return err
+	}
+	for _, r := range b.Sessions {
+		dir := path.Join(sessionsDir, r.Session.ID)
+
+		session := *r.Session
+		session.Labels = r.Labels
+		if err := writeJSON(tw, path.Join(dir, sessionFile), modTime, session); err != nil {
+			return err
+		}
+		if err := writeJSONL(tw, path.Join(dir, eventsFile), modTime, r.Events); err != nil {
+			return err
+		}
+		if err := writeJSONL(tw, path.Join(dir, approvalsFile), modTime, r.Approvals); err != nil {
+			return err
+		}
+		if err := writeJSONL(tw, path.Join(dir, fileSnapshotsFile), modTime, r.FileSnapshots); err != nil {
+			return err
+		}
+		if err := writeJSONL(tw, path.Join(dir, mcpServersFile), modTime, r.MCPServers); err != nil {
+			return err
+		}
+		if b.Manifest.RawEvents {
+			if err := writeJSONL(tw, path.Join(dir, rawEventsFile), modTime, r.RawEvents); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finish bundle archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to finish bundle archive: %w", err)
+	}
+	return nil
+}
+
+func writeJSON(tw *tar.Writer, name string, modTime time.Time, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", name, err)
+	}
+	return writeEntry(tw, name, modTime, append(data, '\n'))
+}
+
+func writeJSONL[T any](tw *tar.Writer, name string, modTime time.Time, items []T) error {
+	var data []byte
+	for _, item := range items {
+		line, err := json.Marshal(item)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", name, err)
+		}
+		data = append(append(data, line...), '\n')
+	}
+	return writeEntry(tw, name, modTime, data)
+}
+
+func writeEntry(tw *tar.Writer, name string, modTime time.Time, data []byte) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     0600,
+		Size:     int64(len(data)),
+		ModTime:  modTime,
+		Typeflag: tar.TypeReg,
+	})
+	if err == nil {
+		_, err = tw.Write(data)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// Read decodes a bundle written by Write. Files it does not know are skipped
+// so bundles can gain files without a format change.
+func Read(r io.Reader) (*Bundle, error) {
+	gz, err := gzip.NewReader(bufio.NewReader(r))
+	if err != nil {
+		return nil, fmt.Errorf("%w: not a gzip archive: %w", ErrInvalidBundle, err)
+	}
+	defer func() { _ = gz.Close() }()
+
+	var manifest *Manifest
+	sessions := map[string]*store.SessionRecords{}
+	records := func(id string) *store.SessionRecords {
+		if sessions[id] == nil {
+			sessions[id] = &store.SessionRecords{}
+		}
+		return sessions[id]
+	}
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidBundle, err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if hdr.Size > maxEntryBytes {
+			return nil, fmt.Errorf("%w: %s is larger than %d bytes", ErrInvalidBundle, hdr.Name, maxEntryBytes)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to read %s: %w", ErrInvalidBundle, hdr.Name, err)
+		}
+
+		name := path.Clean(hdr.Name)
+		if name == manifestFile {
+			manifest = &Manifest{}
+			err = json.Unmarshal(data, manifest)
+		} else if dir := path.Dir(name); path.Dir(dir) == sessionsDir {
+			id := path.Base(dir)
+			switch path.Base(name) {
+			case sessionFile:
+				r := records(id)
+				r.Session = &store.Session{}
+				err = json.Unmarshal(data, r.Session)
+				r.Labels = r.Session.Labels
+			case eventsFile:
+				records(id).Events, err = decodeJSONL[*store.ConversationEvent](data)
+			case approvalsFile:
+				records(id).Approvals, err = decodeJSONL[*store.Approval](data)
+			case fileSnapshotsFile:
+				records(id).FileSnapshots, err = decodeJSONL[store.FileSnapshot](data)
+			case mcpServersFile:
+				records(id).MCPServers, err = decodeJSONL[store.MCPServer](data)
+			case rawEventsFile:
+				records(id).RawEvents, err = decodeJSONL[store.RawEvent](data)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: failed to decode %s: %w", ErrInvalidBundle, hdr.Name, err)
+		}
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("%w: missing %s", ErrInvalidBundle, manifestFile)
+	}
+	if manifest.FormatVersion < 1 || manifest.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("%w: unsupported format version %d", ErrInvalidBundle, manifest.FormatVersion)
+	}
+	if len(manifest.Sessions) == 0 {
+		return nil, fmt.Errorf("%w: no sessions", ErrInvalidBundle)
+	}
+
+	bundle := &Bundle{Manifest: *manifest}
+	for _, id := range manifest.Sessions {
+		r := sessions[id]
+		if r == nil || r.Session == nil {
+			return nil, fmt.Errorf("%w: missing %s for session %s", ErrInvalidBundle, sessionFile, id)
+		}
+		if r.Session.ID != id {
+			return nil, fmt.Errorf("%w: session %s is stored under %s", ErrInvalidBundle, r.Session.ID, id)
+		}
+		bundle.Sessions = append(bundle.Sessions, r)
+	}
+	return bundle, nil
+}
+
+func decodeJSONL[T any](data []byte) ([]T, error) {
+	items := []T{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var item T
+		if err := dec.Decode(&item); err == io.EOF {
+			return items, nil
+		} else if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+}
@@ -0,0 +1,263 @@
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// redacted replaces secret values in exported bundles
+const redacted = "[redacted]"
+
+// importedApprovalComment is left on approvals still pending when exported
+const importedApprovalComment = "Session was exported before this approval was resolved"
+
+// ExportOptions configures what an export includes
+type ExportOptions struct {
+	IncludeRawEvents bool
+}
+
+// ImportResult reports the IDs an imported bundle was given
+type ImportResult struct {
+	SessionID     string            // New ID of the session the bundle was exported for
+	RootSessionID string            // New ID of the root of the imported tree
+	Sessions      map[string]string // Bundle session IDs to their new IDs
+}
+
+// Service exports and imports session bundles
+type Service struct {
+	store store.ConversationStore
+	now   func() time.Time
+}
+
+// New creates a new bundle service
+func New(store store.ConversationStore) *Service {
+	return &Service{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Export collects a session and every session in its continuation tree, from
+// the root down, with secrets stripped
+func (s *Service) Export(ctx context.Context, sessionID string, opts ExportOptions) (*Bundle, error) {
+	tree, err := s.lineage(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &Bundle{
+		Manifest: Manifest{
+			FormatVersion: FormatVersion,
+			SchemaVersion: store.LatestSchemaVersion,
+			ExportedAt:    s.now().UTC(),
+			SessionID:     sessionID,
+			RootSessionID: tree[0],
+			Sessions:      tree,
+			RawEvents:     opts.IncludeRawEvents,
+		},
+	}
+	for _, id := range tree {
+		records, err := s.store.GetSessionRecords(ctx, id, opts.IncludeRawEvents)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read session %s: %w", id, err)
+		}
+		if id == tree[0] {
+			// The root's parent, if any, was pruned and is not in the bundle
+			records.Session.ParentSessionID = ""
+		}
+		stripSecrets(records)
+		bundle.Sessions = append(bundle.Sessions, records)
+	}
+
+	slog.Info("exported session bundle",
+		"session_id", sessionID,
+		"root_session_id", tree[0],
+		"sessions", len(tree),
+		"raw_events", opts.IncludeRawEvents)
+	return bundle, nil
+}
+
+// lineage returns the IDs of the continuation tree containing sessionID,
+// breadth first from its root so parents come before their children
+func (s *Service) lineage(ctx context.Context, sessionID string) ([]string, error) {
+	root, err := s.store.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{root.ID: true}
+	for root.ParentSessionID != "" && !seen[root.ParentSessionID] {
+		parent, err := s.store.GetSession(ctx, root.ParentSessionID)
+		if err != nil {
+			// A pruned parent leaves this session as the oldest one available
+			slog.Warn("session parent is missing, exporting from the session",
+				"session_id", root.ID,
+				"parent_session_id", root.ParentSessionID)
+			break
+		}
+		root = parent
+		seen[root.ID] = true
+	}
+
+	sessions, err := s.store.ListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	children := map[string][]string{}
+	for _, session := range sessions {
+		if session.ParentSessionID != "" {
+			children[session.ParentSessionID] = append(children[session.ParentSessionID], session.ID)
+		}
+	}
+
+	tree := []string{root.ID}
+	included := map[string]bool{root.ID: true}
+	for i := 0; i < len(tree); i++ {
+		for _, child := range children[tree[i]] {
+			if !included[child] {
+				included[child] = true
+				tree = append(tree, child)
+			}
+		}
+	}
+	return tree, nil
+}
+
+// stripSecrets removes credentials from exported records. MCP server
+// environments keep their variable names so the configuration stays readable.
+func stripSecrets(r *store.SessionRecords) {
+	r.Session.ProxyAPIKey = ""
+	for i, server := range r.MCPServers {
+		var env map[string]string
+		if server.EnvJSON == "" || json.Unmarshal([]byte(server.EnvJSON), &env) != nil {
+			r.MCPServers[i].EnvJSON = ""
+			continue
+		}
+		for name := range env {
+			env[name] = redacted
+		}
+		data, _ := json.Marshal(env)
+		r.MCPServers[i].EnvJSON = string(data)
+	}
+}
+
+// Import stores the sessions of a bundle under new IDs, keeping their tree.
+// Imported sessions are history: sessions that were still active are marked
+// interrupted and approvals that were pending are denied.
+func (s *Service) Import(ctx context.Context, bundle *Bundle) (*ImportResult, error) {
+	if len(bundle.Sessions) == 0 {
+		return nil, fmt.Errorf("%w: no sessions", ErrInvalidBundle)
+	}
+
+	sessionIDs := map[string]string{}
+	runIDs := map[string]string{}
+	claudeSessionIDs := map[string]string{}
+	approvalIDs := map[string]string{}
+	remap := func(ids map[string]string, id string, newID func() string) string {
+		if id == "" {
+			return ""
+		}
+		if ids[id] == "" {
+			ids[id] = newID()
+		}
+		return ids[id]
+	}
+	newUUID := func() string { return uuid.New().String() }
+	newApprovalID := func() string { return "local-" + uuid.New().String() }
+
+	now := s.now()
+	records := make([]*store.SessionRecords, 0, len(bundle.Sessions))
+	for _, r := range bundle.Sessions {
+		session := *r.Session
+		if _, ok := sessionIDs[session.ID]; ok {
+			return nil, fmt.Errorf("%w: session %s appears twice", ErrInvalidBundle, session.ID)
+		}
+		if session.ParentSessionID != "" && sessionIDs[session.ParentSessionID] == "" {
+			return nil, fmt.Errorf("%w: parent of session %s is not before it in the bundle", ErrInvalidBundle, session.ID)
+		}
+
+		session.ID = remap(sessionIDs, session.ID, newUUID)
+		session.RunID = remap(runIDs, session.RunID, newUUID)
+		session.ClaudeSessionID = remap(claudeSessionIDs, session.ClaudeSessionID, newUUID)
+		session.ParentSessionID = sessionIDs[session.ParentSessionID]
+		session.Labels = nil
+		// Nothing machine-specific or granting permissions carries over
+		session.ProxyAPIKey = ""
+		session.ProjectID = ""
+		session.DangerouslySkipPermissions = false
+		session.DangerouslySkipPermissionsExpiresAt = nil
+		switch session.Status {
+		case store.SessionStatusStarting, store.SessionStatusRunning,
+			store.SessionStatusWaitingInput, store.SessionStatusInterrupting:
+			session.Status = store.SessionStatusInterrupted
+		}
+
+		imported := &store.SessionRecords{
+			Session:       &session,
+			Labels:        r.Labels,
+			Events:        make([]*store.ConversationEvent, 0, len(r.Events)),
+			Approvals:     make([]*store.Approval, 0, len(r.Approvals)),
+			FileSnapshots: make([]store.FileSnapshot, 0, len(r.FileSnapshots)),
+			MCPServers:    make([]store.MCPServer, 0, len(r.MCPServers)),
+			RawEvents:     make([]store.RawEvent, 0, len(r.RawEvents)),
+		}
+		for _, a := range r.Approvals {
+			approval := *a
+			approval.ID = remap(approvalIDs, approval.ID, newApprovalID)
+			approval.RunID = session.RunID
+			approval.SessionID = session.ID
+			if approval.Status == store.ApprovalStatusLocalPending {
+				approval.Status = store.ApprovalStatusLocalDenied
+				approval.Comment = importedApprovalComment
+				approval.RespondedAt = &now
+			}
+			imported.Approvals = append(imported.Approvals, &approval)
+		}
+		for _, e := range r.Events {
+			event := *e
+			event.SessionID = session.ID
+			event.ClaudeSessionID = remap(claudeSessionIDs, event.ClaudeSessionID, newUUID)
+			event.ApprovalID = remap(approvalIDs, event.ApprovalID, newApprovalID)
+			if event.ApprovalStatus == store.ApprovalStatusPending {
+				event.ApprovalStatus = store.ApprovalStatusDenied
+			}
+			imported.Events = append(imported.Events, &event)
+		}
+		for _, snapshot := range r.FileSnapshots {
+			snapshot.SessionID = session.ID
+			imported.FileSnapshots = append(imported.FileSnapshots, snapshot)
+		}
+		for _, server := range r.MCPServers {
+			server.SessionID = session.ID
+			imported.MCPServers = append(imported.MCPServers, server)
+		}
+		for _, event := range r.RawEvents {
+			event.SessionID = session.ID
+			imported.RawEvents = append(imported.RawEvents, event)
+		}
+		records = append(records, imported)
+	}
+
+	if err := s.store.ImportSessionRecords(ctx, records); err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{
+		SessionID:     sessionIDs[bundle.Manifest.SessionID],
+		RootSessionID: records[0].Session.ID,
+		Sessions:      sessionIDs,
+	}
+	if result.SessionID == "" {
+		result.SessionID = result.RootSessionID
+	}
+	slog.Info("imported session bundle",
+		"session_id", result.SessionID,
+		"root_session_id", result.RootSessionID,
+		"sessions", len(records))
+	return result, nil
+}
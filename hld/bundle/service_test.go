@@ -0,0 +1,217 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// seedTree stores a root session continued twice, plus an unrelated session
+func seedTree(t *testing.T, s store.ConversationStore) {
+	t.Helper()
+	ctx := context.Background()
+	created := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	for _, session := range []*store.Session{
+		{ID: "root", ClaudeSessionID: "claude-root", Status: store.SessionStatusCompleted, ProxyEnabled: true, ProxyAPIKey: "sk-secret"},
+		{ID: "child", ClaudeSessionID: "claude-child", ParentSessionID: "root", Status: store.SessionStatusCompleted},
+		{ID: "grandchild", ClaudeSessionID: "claude-grandchild", ParentSessionID: "child", Status: store.SessionStatusWaitingInput},
+		{ID: "unrelated", ClaudeSessionID: "claude-unrelated", Status: store.SessionStatusCompleted},
+	} {
+		session.RunID = "run-" + session.ID
+		session.Query = "query for " + session.ID
+		session.CreatedAt = created
+		session.LastActivityAt = created
+		require.NoError(t, s.CreateSession(ctx, session))
+	}
+	require.NoError(t, s.SetSessionLabels(ctx, "root", []string{"bug"}))
+
+	for _, event := range []*store.ConversationEvent{
+		{SessionID: "root", ClaudeSessionID: "claude-root", EventType: store.EventTypeMessage, Role: "user", Content: "hello"},
+		{SessionID: "child", ClaudeSessionID: "claude-child", EventType: store.EventTypeToolCall, ToolID: "toolu_1", ToolName: "Bash",
+			ToolInputJSON: `{"command":"ls"}`, ApprovalStatus: store.ApprovalStatusApproved, ApprovalID: "local-approved"},
+		{SessionID: "grandchild", ClaudeSessionID: "claude-grandchild", EventType: store.EventTypeToolCall, ToolID: "toolu_2", ToolName: "Bash",
+			ToolInputJSON: `{"command":"rm -rf /"}`, ApprovalStatus: store.ApprovalStatusPending, ApprovalID: "local-pending"},
+	} {
+		require.NoError(t, s.AddConversationEvent(ctx, event))
+	}
+
+	require.NoError(t, s.CreateApproval(ctx, &store.Approval{
+		ID: "local-approved", RunID: "run-child", SessionID: "child", Status: store.ApprovalStatusLocalApproved,
+		CreatedAt: created, ToolName: "Bash", ToolInput: json.RawMessage(`{"command":"ls"}`),
+	}))
+	require.NoError(t, s.CreateApproval(ctx, &store.Approval{
+		ID: "local-pending", RunID: "run-grandchild", SessionID: "grandchild", Status: store.ApprovalStatusLocalPending,
+		CreatedAt: created, ToolName: "Bash", ToolInput: json.RawMessage(`{"command":"rm -rf /"}`),
+	}))
+	require.NoError(t, s.CreateFileSnapshot(ctx, &store.FileSnapshot{
+		ToolID: "toolu_1", SessionID: "child", FilePath: "main.go", Content: "package main",
+	}))
+	require.NoError(t, s.StoreMCPServers(ctx, "root", []store.MCPServer{
+		{Name: "github", Command: "github-mcp", ArgsJSON: `["serve"]`, EnvJSON: `{"GITHUB_TOKEN":"ghp_secret"}`},
+	}))
+	require.NoError(t, s.StoreRawEvent(ctx, "child", `{"type":"assistant"}`))
+}
+
+func newStore(t *testing.T, name string) *store.SQLiteStore {
+	t.Helper()
+	s, err := store.NewSQLiteStore(testutil.DatabasePath(t, name))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	source := newStore(t, "bundle-source")
+	seedTree(t, source)
+
+	// Exporting any session in the tree exports the whole tree
+	bundle, err := New(source).Export(ctx, "child", ExportOptions{IncludeRawEvents: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"root", "child", "grandchild"}, bundle.Manifest.Sessions)
+	assert.Equal(t, "child", bundle.Manifest.SessionID)
+	assert.Equal(t, "root", bundle.Manifest.RootSessionID)
+
+	var archive bytes.Buffer
+	require.NoError(t, bundle.Write(&archive))
+	assert.NotContains(t, archiveText(t, archive.Bytes()), "sk-secret")
+	assert.NotContains(t, archiveText(t, archive.Bytes()), "ghp_secret")
+
+	decoded, err := Read(bytes.NewReader(archive.Bytes()))
+	require.NoError(t, err)
+
+	// Importing into the same database works since everything gets new IDs
+	for _, target := range []*store.SQLiteStore{newStore(t, "bundle-target"), source} {
+		result, err := New(target).Import(ctx, decoded)
+		require.NoError(t, err)
+		require.Len(t, result.Sessions, 3)
+		assert.Equal(t, result.Sessions["child"], result.SessionID)
+		assert.Equal(t, result.Sessions["root"], result.RootSessionID)
+
+		root, err := target.GetSessionRecords(ctx, result.Sessions["root"], true)
+		require.NoError(t, err)
+		assert.NotEqual(t, "root", root.Session.ID)
+		assert.NotEqual(t, "run-root", root.Session.RunID)
+		assert.NotEqual(t, "claude-root", root.Session.ClaudeSessionID)
+		assert.Empty(t, root.Session.ParentSessionID)
+		assert.True(t, root.Session.ProxyEnabled)
+		assert.Empty(t, root.Session.ProxyAPIKey)
+		assert.Equal(t, "query for root", root.Session.Query)
+		assert.Equal(t, []string{"bug"}, root.Labels)
+		require.Len(t, root.MCPServers, 1)
+		assert.JSONEq(t, `{"GITHUB_TOKEN":"[redacted]"}`, root.MCPServers[0].EnvJSON)
+		require.Len(t, root.Events, 1)
+		assert.Equal(t, root.Session.ClaudeSessionID, root.Events[0].ClaudeSessionID)
+
+		child, err := target.GetSessionRecords(ctx, result.Sessions["child"], true)
+		require.NoError(t, err)
+		assert.Equal(t, root.Session.ID, child.Session.ParentSessionID)
+		require.Len(t, child.Approvals, 1)
+		require.Len(t, child.Events, 1)
+		assert.Equal(t, child.Approvals[0].ID, child.Events[0].ApprovalID)
+		assert.NotEqual(t, "local-approved", child.Approvals[0].ID)
+		assert.Equal(t, child.Session.RunID, child.Approvals[0].RunID)
+		assert.Equal(t, "toolu_1", child.Events[0].ToolID)
+		require.Len(t, child.FileSnapshots, 1)
+		assert.Equal(t, "package main", child.FileSnapshots[0].Content)
+		require.Len(t, child.RawEvents, 1)
+
+		// Sessions that were active are imported as history
+		grandchild, err := target.GetSessionRecords(ctx, result.Sessions["grandchild"], false)
+		require.NoError(t, err)
+		assert.Equal(t, child.Session.ID, grandchild.Session.ParentSessionID)
+		assert.Equal(t, store.SessionStatusInterrupted, grandchild.Session.Status)
+		require.Len(t, grandchild.Approvals, 1)
+		assert.Equal(t, store.ApprovalStatusLocalDenied, grandchild.Approvals[0].Status)
+		assert.Equal(t, store.ApprovalStatusDenied, grandchild.Events[0].ApprovalStatus)
+
+		// The continued conversation reads through the imported parents
+		conversation, err := target.GetSessionConversation(ctx, grandchild.Session.ID)
+		require.NoError(t, err)
+		assert.Len(t, conversation, 3)
+	}
+}
+
+func TestExportWithoutRawEvents(t *testing.T) {
+	source := newStore(t, "bundle-no-raw")
+	seedTree(t, source)
+
+	bundle, err := New(source).Export(context.Background(), "unrelated", ExportOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"unrelated"}, bundle.Manifest.Sessions)
+
+	var archive bytes.Buffer
+	require.NoError(t, bundle.Write(&archive))
+	assert.NotContains(t, archiveNames(t, archive.Bytes()), "sessions/unrelated/raw_events.jsonl")
+
+	_, err = New(source).Export(context.Background(), "missing", ExportOptions{})
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestReadRejectsInvalidBundles(t *testing.T) {
+	_, err := Read(bytes.NewReader([]byte("not gzip")))
+	assert.ErrorIs(t, err, ErrInvalidBundle)
+
+	newer := &Bundle{Manifest: Manifest{FormatVersion: FormatVersion + 1, Sessions: []string{"a"}}}
+	var archive bytes.Buffer
+	require.NoError(t, newer.Write(&archive))
+	_, err = Read(&archive)
+	assert.ErrorIs(t, err, ErrInvalidBundle)
+
+	// The manifest lists a session the archive does not hold
+	missing := &Bundle{Manifest: Manifest{FormatVersion: FormatVersion, Sessions: []string{"a"}}}
+	archive.Reset()
+	require.NoError(t, missing.Write(&archive))
+	_, err = Read(&archive)
+	assert.ErrorIs(t, err, ErrInvalidBundle)
+
+	// A session whose parent is not in the bundle would break the tree
+	orphan := &Bundle{
+		Manifest: Manifest{FormatVersion: FormatVersion, Sessions: []string{"a"}},
+		Sessions: []*store.SessionRecords{{Session: &store.Session{ID: "a", ParentSessionID: "elsewhere"}}},
+	}
+	_, err = New(nil).Import(context.Background(), orphan)
+	assert.ErrorIs(t, err, ErrInvalidBundle)
+}
+
+func archiveNames(t *testing.T, data []byte) []string {
+	t.Helper()
+	var names []string
+	forEachEntry(t, data, func(name string, _ []byte) { names = append(names, name) })
+	return names
+}
+
+func archiveText(t *testing.T, data []byte) string {
+	t.Helper()
+	var text bytes.Buffer
+	forEachEntry(t, data, func(_ string, content []byte) { text.Write(content) })
+	return text.String()
+}
+
+func forEachEntry(t *testing.T, data []byte, fn func(name string, content []byte)) {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		fn(hdr.Name, content)
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"fmt"
 	"log/slog"
+	"sort"
 	"sync"
 	"time"
 )
@@ -14,32 +16,113 @@ type eventBus struct {
 	subscribers map[string]*Subscriber
 	mu          sync.RWMutex
 	bufferSize  int
+	subscribed  uint64 // Number of subscriptions ever made
+
+	// publishMu orders publishing, so events are logged and delivered in
+	// sequence order. history and latest are guarded by both mutexes.
+	publishMu sync.Mutex
+	log       EventLog
+	history   *history
+	latest    uint64
 }
 
-// NewEventBus creates a new event bus
+// NewEventBus creates a new event bus that keeps the newest events in memory
 func NewEventBus() EventBus {
 	return &eventBus{
 		subscribers: make(map[string]*Subscriber),
-		bufferSize:  100, // Buffer up to 100 events per subscriber
+		bufferSize:  DefaultBufferSize,
+		history:     newHistory(DefaultHistorySize),
+	}
+}
+
+// NewEventBusWithLog creates a new event bus that stores every event in log,
+// which numbers them, and keeps the newest historySize of them for replay.
+// The kept events are loaded from the log, so subscribers can resume across
+// daemon restarts.
+func NewEventBusWithLog(log EventLog, historySize int) (EventBus, error) {
+	recent, err := log.Recent(historySize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load event log: %w", err)
+	}
+	eb := &eventBus{
+		subscribers: make(map[string]*Subscriber),
+		bufferSize:  DefaultBufferSize,
+		log:         log,
+		history:     newHistory(historySize),
 	}
+	if len(recent) > 0 {
+		// Older events may have been trimmed from the log
+		eb.history.evicted = recent[0].Seq - 1
+		for _, event := range recent {
+			eb.history.push(event)
+		}
+		eb.latest = recent[len(recent)-1].Seq
+	}
+	return eb, nil
 }
 
 // Subscribe creates a new subscription with the given filter
 func (eb *eventBus) Subscribe(ctx context.Context, filter EventFilter) *Subscriber {
+	return eb.SubscribeWith(ctx, filter, SubscribeOptions{})
+}
+
+// SubscribeSince creates a subscription that first receives the kept events
+// after since
+func (eb *eventBus) SubscribeSince(ctx context.Context, filter EventFilter, since uint64) *Subscriber {
+	return eb.SubscribeWith(ctx, filter, SubscribeOptions{Since: &since})
+}
+
+// SubscribeWith creates a subscription with the given options. Replaying and
+// subscribing happen under one lock, so no event is missed or delivered twice
+// in between.
+func (eb *eventBus) SubscribeWith(ctx context.Context, filter EventFilter, opts SubscribeOptions) *Subscriber {
 	eb.mu.Lock()
 	defer eb.mu.Unlock()
 
+	var replay []Event
+	if opts.Since != nil {
+		since := *opts.Since
+		kept, complete := eb.history.since(since)
+		// A sequence past the latest one comes from before a restart that lost
+		// the events published since
+		if !complete || since > eb.latest {
+			gap := NewEvent(ReplayGapPayload{Since: since, FirstSeq: eb.history.first()})
+			gap.Timestamp = time.Now()
+			replay = append(replay, gap)
+		}
+		for _, event := range kept {
+			if eb.matchesFilter(event, filter) {
+				replay = append(replay, event)
+			}
+		}
+	}
+
 	// Create a new context that we control
 	subCtx, cancel := context.WithCancel(ctx)
 
 	sub := &Subscriber{
-		ID:       generateSubscriberID(),
-		Channel:  make(chan Event, eb.bufferSize),
-		Filter:   filter,
-		ctx:      subCtx,
-		cancelFn: cancel,
+		ID:         generateSubscriberID(),
+		Filter:     filter,
+		ctx:        subCtx,
+		cancelFn:   cancel,
+		bufferSize: opts.BufferSize,
+		overflow:   opts.Overflow,
+	}
+	if sub.bufferSize <= 0 {
+		sub.bufferSize = eb.bufferSize
 	}
+	if sub.overflow == "" {
+		sub.overflow = OverflowDropNewest
+	}
+	// Replayed events are buffered even if there are more than fit
+	sub.Channel = make(chan Event, sub.bufferSize+len(replay))
+	for _, event := range replay {
+		sub.Channel <- event
+	}
+	sub.sent = uint64(len(replay))
 
+	eb.subscribed++
+	sub.order = eb.subscribed
 	eb.subscribers[sub.ID] = sub
 
 	// Start a goroutine to clean up when context is done
@@ -54,6 +137,9 @@ func (eb *eventBus) Subscribe(ctx context.Context, filter EventFilter) *Subscrib
 		"filter_types", filter.Types,
 		"filter_session", filter.SessionID,
 		"filter_run_id", filter.RunID,
+		"buffer_size", sub.bufferSize,
+		"overflow", sub.overflow,
+		"replayed", len(replay),
 	)
 
 	return sub
@@ -65,17 +151,21 @@ func (eb *eventBus) Unsubscribe(subscriberID string) {
 	defer eb.mu.Unlock()
 
 	if sub, ok := eb.subscribers[subscriberID]; ok {
-		// Remove from map first to prevent double cleanup
-		delete(eb.subscribers, subscriberID)
+		eb.remove(sub)
+		slog.Debug("event bus unsubscribe", "subscriber_id", subscriberID)
+	}
+}
 
-		// Cancel context (this might trigger the cleanup goroutine)
-		sub.cancelFn()
+// remove drops a subscriber and closes its channel. eb.mu must be held.
+func (eb *eventBus) remove(sub *Subscriber) {
+	// Remove from map first to prevent double cleanup
+	delete(eb.subscribers, sub.ID)
 
-		// Close channel
-		close(sub.Channel)
+	// Cancel context (this might trigger the cleanup goroutine)
+	sub.cancelFn()
 
-		slog.Debug("event bus unsubscribe", "subscriber_id", subscriberID)
-	}
+	// Close channel
+	close(sub.Channel)
 }
 
 // Publish sends an event to all matching subscribers
@@ -86,12 +176,29 @@ func (eb *eventBus) Publish(event Event) {
 		return
 	}
 
-	eb.mu.RLock()
-	defer eb.mu.RUnlock()
+	eb.publishMu.Lock()
+	defer eb.publishMu.Unlock()
 
 	event.Timestamp = time.Now()
+	if eb.log == nil {
+		event.Seq = eb.latest + 1
+	} else if seq, err := eb.log.Append(event); err != nil {
+		// The event is still delivered, it just cannot be replayed
+		slog.Warn("failed to log event", "type", event.Type, "error", err)
+	} else {
+		event.Seq = seq
+	}
+
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+
+	if event.Seq > 0 {
+		eb.latest = event.Seq
+		eb.history.push(event)
+	}
 
 	slog.Debug("publishing event",
+		"seq", event.Seq,
 		"type", event.Type,
 		"data", event.Data,
 		"subscriber_count", len(eb.subscribers),
@@ -101,18 +208,12 @@ func (eb *eventBus) Publish(event Event) {
 	for _, sub := range eb.subscribers {
 		if eb.matchesFilter(event, sub.Filter) {
 			matchedCount++
-			select {
-			case sub.Channel <- event:
-				slog.Debug("event sent to subscriber",
-					"subscriber_id", sub.ID,
-					"event_type", event.Type,
-				)
-			default:
-				// Channel is full, drop the event
-				slog.Warn("dropping event for slow subscriber",
+			if !sub.offer(event) {
+				slog.Warn("disconnecting slow subscriber",
 					"subscriber_id", sub.ID,
 					"event_type", event.Type,
 				)
+				eb.remove(sub)
 			}
 		}
 	}
@@ -185,6 +286,25 @@ func (eb *eventBus) GetSubscriberCount() int {
 	return len(eb.subscribers)
 }
 
+// GetSubscriberStats returns delivery statistics of every subscriber, oldest
+// subscription first
+func (eb *eventBus) GetSubscriberStats() []SubscriberStats {
+	eb.mu.RLock()
+	defer eb.mu.RUnlock()
+
+	subscribers := make([]*Subscriber, 0, len(eb.subscribers))
+	for _, sub := range eb.subscribers {
+		subscribers = append(subscribers, sub)
+	}
+	sort.Slice(subscribers, func(i, j int) bool { return subscribers[i].order < subscribers[j].order })
+
+	stats := make([]SubscriberStats, len(subscribers))
+	for i, sub := range subscribers {
+		stats[i] = sub.Stats()
+	}
+	return stats
+}
+
 // generateSubscriberID creates a unique subscriber ID
 func generateSubscriberID() string {
 	// Use crypto/rand for proper randomness
@@ -2,6 +2,7 @@ package bus
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 	"testing"
 	"time"
@@ -288,3 +289,320 @@ func TestEventBus_ContextCancellation(t *testing.T) {
 		// Channel might be empty but should be closed
 	}
 }
+
+// receive reads the events already waiting on sub's channel
+func receive(sub *Subscriber) []Event {
+	var events []Event
+	for {
+		select {
+		case event, ok := <-sub.Channel:
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		case <-time.After(50 * time.Millisecond):
+			return events
+		}
+	}
+}
+
+func seqs(events []Event) []uint64 {
+	var result []uint64
+	for _, event := range events {
+		result = append(result, event.Seq)
+	}
+	return result
+}
+
+func TestEventBus_SubscribeSince(t *testing.T) {
+	eb := NewEventBus()
+	ctx := context.Background()
+
+	for _, sessionID := range []string{"a", "b", "a"} {
+		eb.Publish(Event{Type: EventSessionStatusChanged, Data: map[string]interface{}{"session_id": sessionID}})
+	}
+
+	// Kept events after the sequence that match the filter are replayed, then
+	// live events follow
+	sub := eb.SubscribeSince(ctx, EventFilter{SessionID: "a"}, 0)
+	eb.Publish(Event{Type: EventSessionStatusChanged, Data: map[string]interface{}{"session_id": "a"}})
+	if got := seqs(receive(sub)); len(got) != 3 || got[0] != 1 || got[1] != 3 || got[2] != 4 {
+		t.Errorf("expected sequences [1 3 4], got %v", got)
+	}
+
+	sub = eb.SubscribeSince(ctx, EventFilter{}, 3)
+	if got := seqs(receive(sub)); len(got) != 1 || got[0] != 4 {
+		t.Errorf("expected sequences [4], got %v", got)
+	}
+}
+
+func TestEventBus_ReplayGap(t *testing.T) {
+	eb := NewEventBus().(*eventBus)
+	eb.history = newHistory(2)
+	ctx := context.Background()
+
+	for range 4 {
+		eb.Publish(Event{Type: EventNewApproval, Data: map[string]interface{}{}})
+	}
+
+	// Events 1 and 2 were evicted
+	events := receive(eb.SubscribeSince(ctx, EventFilter{}, 0))
+	if len(events) != 3 || events[0].Type != EventReplayGap {
+		t.Fatalf("expected a gap and two events, got %v", events)
+	}
+	if first := events[0].Data["first_seq"]; first != uint64(3) {
+		t.Errorf("expected first_seq 3, got %v", first)
+	}
+	if got := seqs(events[1:]); got[0] != 3 || got[1] != 4 {
+		t.Errorf("expected sequences [3 4], got %v", got)
+	}
+
+	// Nothing after event 2 is missing
+	events = receive(eb.SubscribeSince(ctx, EventFilter{}, 2))
+	if got := seqs(events); len(got) != 2 || got[0] != 3 {
+		t.Errorf("expected sequences [3 4] without a gap, got %v", got)
+	}
+
+	// A sequence the bus never reached comes from before a restart
+	events = receive(eb.SubscribeSince(ctx, EventFilter{Types: []EventType{EventNewApproval}}, 100))
+	if len(events) != 1 || events[0].Type != EventReplayGap {
+		t.Errorf("expected only a gap, got %v", events)
+	}
+}
+
+// memoryLog is an EventLog kept in memory
+type memoryLog struct {
+	mu     sync.Mutex
+	events []Event
+	fail   bool
+}
+
+func (l *memoryLog) Append(event Event) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.fail {
+		return 0, context.DeadlineExceeded
+	}
+	event.Seq = uint64(len(l.events) + 1)
+	l.events = append(l.events, event)
+	return event.Seq, nil
+}
+
+func (l *memoryLog) Recent(limit int) ([]Event, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.events[max(len(l.events)-limit, 0):], nil
+}
+
+func TestEventBus_WithLog(t *testing.T) {
+	log := &memoryLog{}
+	ctx := context.Background()
+
+	eb, err := NewEventBusWithLog(log, 2)
+	if err != nil {
+		t.Fatalf("failed to create event bus: %v", err)
+	}
+	for range 3 {
+		eb.Publish(Event{Type: EventNewApproval, Data: map[string]interface{}{}})
+	}
+
+	// A bus created from the same log, as after a restart, resumes from it
+	restarted, err := NewEventBusWithLog(log, 2)
+	if err != nil {
+		t.Fatalf("failed to create event bus: %v", err)
+	}
+	if got := seqs(receive(restarted.SubscribeSince(ctx, EventFilter{}, 1))); len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("expected sequences [2 3], got %v", got)
+	}
+	if events := receive(restarted.SubscribeSince(ctx, EventFilter{}, 0)); len(events) == 0 || events[0].Type != EventReplayGap {
+		t.Errorf("expected a gap for the event no longer loaded, got %v", events)
+	}
+
+	sub := restarted.Subscribe(ctx, EventFilter{})
+	restarted.Publish(Event{Type: EventNewApproval, Data: map[string]interface{}{}})
+	if got := seqs(receive(sub)); len(got) != 1 || got[0] != 4 {
+		t.Errorf("expected sequence 4, got %v", got)
+	}
+
+	// Events the log fails to store are still delivered, without a sequence
+	log.fail = true
+	restarted.Publish(Event{Type: EventNewApproval, Data: map[string]interface{}{}})
+	if got := seqs(receive(sub)); len(got) != 1 || got[0] != 0 {
+		t.Errorf("expected an unsequenced event, got %v", got)
+	}
+}
+
+func TestEventBus_OverflowPolicies(t *testing.T) {
+	ctx := context.Background()
+	publish := func(eb EventBus, sessionIDs ...string) {
+		for _, sessionID := range sessionIDs {
+			eb.Publish(Event{Type: EventSessionStatusChanged, Data: map[string]interface{}{"session_id": sessionID}})
+		}
+	}
+
+	t.Run("drop newest", func(t *testing.T) {
+		eb := NewEventBus()
+		sub := eb.SubscribeWith(ctx, EventFilter{}, SubscribeOptions{BufferSize: 2})
+		publish(eb, "a", "b", "c")
+		if got := seqs(receive(sub)); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+			t.Errorf("expected sequences [1 2], got %v", got)
+		}
+		stats := sub.Stats()
+		if stats.Overflow != OverflowDropNewest || stats.Dropped != 1 || stats.Delivered != 2 || stats.Lag != 0 {
+			t.Errorf("unexpected stats %+v", stats)
+		}
+	})
+
+	t.Run("drop oldest", func(t *testing.T) {
+		eb := NewEventBus()
+		sub := eb.SubscribeWith(ctx, EventFilter{}, SubscribeOptions{BufferSize: 2, Overflow: OverflowDropOldest})
+		publish(eb, "a", "b", "c")
+		if stats := sub.Stats(); stats.Dropped != 1 || stats.Lag != 2 || stats.Delivered != 0 {
+			t.Errorf("unexpected stats %+v", stats)
+		}
+		if got := seqs(receive(sub)); len(got) != 2 || got[0] != 2 || got[1] != 3 {
+			t.Errorf("expected sequences [2 3], got %v", got)
+		}
+	})
+
+	t.Run("coalesce", func(t *testing.T) {
+		eb := NewEventBus()
+		sub := eb.SubscribeWith(ctx, EventFilter{}, SubscribeOptions{BufferSize: 3, Overflow: OverflowCoalesce})
+		// The second status change of a supersedes the first, and with no
+		// event of d to supersede the oldest one, of b, is dropped
+		publish(eb, "a", "b", "c", "a", "d")
+		events := receive(sub)
+		var sessionIDs []string
+		for _, event := range events {
+			sessionIDs = append(sessionIDs, event.Data["session_id"].(string))
+		}
+		if len(sessionIDs) != 3 || sessionIDs[0] != "c" || sessionIDs[1] != "a" || sessionIDs[2] != "d" {
+			t.Errorf("expected sessions [c a d], got %v", sessionIDs)
+		}
+		if got := seqs(events); got[1] != 4 {
+			t.Errorf("expected the newer event of a, got %v", got)
+		}
+		if stats := sub.Stats(); stats.Dropped != 2 || stats.Delivered != 3 {
+			t.Errorf("unexpected stats %+v", stats)
+		}
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		eb := NewEventBus()
+		sub := eb.SubscribeWith(ctx, EventFilter{}, SubscribeOptions{BufferSize: 2, Overflow: OverflowDisconnect})
+		publish(eb, "a", "b", "c")
+		if count := eb.GetSubscriberCount(); count != 0 {
+			t.Errorf("expected the subscriber to be disconnected, %d remain", count)
+		}
+		events := receive(sub)
+		if got := seqs(events); len(got) != 2 {
+			t.Errorf("expected the two buffered events, got %v", got)
+		}
+		select {
+		case _, ok := <-sub.Channel:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		default:
+			t.Error("expected channel to be closed")
+		}
+
+		// Resuming after the last received event misses nothing
+		resumed := eb.SubscribeSince(ctx, EventFilter{}, events[len(events)-1].Seq)
+		if got := seqs(receive(resumed)); len(got) != 1 || got[0] != 3 {
+			t.Errorf("expected sequence [3], got %v", got)
+		}
+	})
+}
+
+func TestEventBus_GetSubscriberStats(t *testing.T) {
+	eb := NewEventBus()
+	ctx := context.Background()
+
+	first := eb.Subscribe(ctx, EventFilter{Types: []EventType{EventNewApproval}})
+	eb.SubscribeWith(ctx, EventFilter{}, SubscribeOptions{BufferSize: 10, Overflow: OverflowCoalesce})
+	eb.Publish(Event{Type: EventNewApproval, Data: map[string]interface{}{}})
+
+	stats := eb.GetSubscriberStats()
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 subscribers, got %d", len(stats))
+	}
+	if stats[0].ID != first.ID || stats[0].BufferSize != DefaultBufferSize || stats[0].Lag != 1 {
+		t.Errorf("unexpected stats of the first subscriber %+v", stats[0])
+	}
+	if stats[1].BufferSize != 10 || stats[1].Overflow != OverflowCoalesce {
+		t.Errorf("unexpected stats of the second subscriber %+v", stats[1])
+	}
+}
+
+func TestNewEvent(t *testing.T) {
+	title := ""
+	event := NewEvent(SessionStatusChangedPayload{SessionID: "sess-1", Title: &title})
+	if event.Type != EventSessionStatusChanged {
+		t.Errorf("expected type %s, got %s", EventSessionStatusChanged, event.Type)
+	}
+	// Empty optional fields are left out, except a title cleared on purpose
+	if len(event.Data) != 2 || event.Data["session_id"] != "sess-1" || event.Data["title"] != "" {
+		t.Errorf("unexpected data %v", event.Data)
+	}
+
+	event = NewEvent(SessionSettingsChangedPayload{
+		SessionID:                  "sess-1",
+		Reason:                     SessionSettingsChangeReasonExpired,
+		DangerouslySkipPermissions: new(bool),
+	})
+	if event.Data["reason"] != "expired" || event.Data["dangerously_skip_permissions"] != false {
+		t.Errorf("unexpected data %v", event.Data)
+	}
+
+	event = NewEvent(SessionStalledPayload{SessionID: "sess-1", IdleMs: 1500})
+	if event.Data["idle_ms"] != int64(1500) {
+		t.Errorf("expected idle_ms to keep its type, got %T", event.Data["idle_ms"])
+	}
+}
+
+func TestDecodePayload(t *testing.T) {
+	published := NewEvent(ApprovalResolvedPayload{
+		ApprovalID:   "appr-1",
+		SessionID:    "sess-1",
+		Approved:     true,
+		ResponseText: "ok",
+		ToolUseID:    "tool-1",
+	})
+
+	// Events published in this process and read from JSON decode alike
+	raw, err := json.Marshal(published)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var received Event
+	if err := json.Unmarshal(raw, &received); err != nil {
+		t.Fatal(err)
+	}
+	for _, event := range []Event{published, received} {
+		payload, err := DecodePayload[ApprovalResolvedPayload](event)
+		if err != nil {
+			t.Fatalf("failed to decode payload: %v", err)
+		}
+		if payload.ApprovalID != "appr-1" || !payload.Approved || payload.ResponseText != "ok" || payload.ToolUseID != "tool-1" {
+			t.Errorf("unexpected payload %+v", payload)
+		}
+	}
+
+	if _, err := DecodePayload[NewApprovalPayload](published); err == nil {
+		t.Error("expected an error decoding the payload of another event type")
+	}
+}
+
+func TestPayloads(t *testing.T) {
+	seen := make(map[EventType]bool)
+	for _, payload := range Payloads() {
+		if seen[payload.Type()] {
+			t.Errorf("duplicate payload for %s", payload.Type())
+		}
+		seen[payload.Type()] = true
+	}
+	if len(seen) != 9 {
+		t.Errorf("expected a payload for each of the 9 event types, got %d", len(seen))
+	}
+}
@@ -0,0 +1,54 @@
+package bus
+
+// DefaultHistorySize is how many of the newest events the bus keeps for
+// subscribers resuming from a sequence number
+const DefaultHistorySize = 1000
+
+// history is a ring of the newest published events
+type history struct {
+	events []Event
+	start  int
+	size   int
+	// evicted is the highest sequence number that is no longer kept
+	evicted uint64
+}
+
+func newHistory(capacity int) *history {
+	return &history{events: make([]Event, max(capacity, 0))}
+}
+
+// push keeps event, evicting the oldest event when the ring is full
+func (h *history) push(event Event) {
+	if len(h.events) == 0 {
+		h.evicted = event.Seq
+		return
+	}
+	if h.size == len(h.events) {
+		h.evicted = h.events[h.start].Seq
+		h.events[h.start] = event
+		h.start = (h.start + 1) % len(h.events)
+		return
+	}
+	h.events[(h.start+h.size)%len(h.events)] = event
+	h.size++
+}
+
+// since returns the kept events with a sequence number above seq, oldest
+// first, and whether they are all the events published after seq
+func (h *history) since(seq uint64) ([]Event, bool) {
+	var events []Event
+	for i := 0; i < h.size; i++ {
+		if event := h.events[(h.start+i)%len(h.events)]; event.Seq > seq {
+			events = append(events, event)
+		}
+	}
+	return events, seq >= h.evicted
+}
+
+// first returns the oldest kept sequence number, or zero if nothing is kept
+func (h *history) first() uint64 {
+	if h.size == 0 {
+		return 0
+	}
+	return h.events[h.start].Seq
+}
@@ -0,0 +1,221 @@
+package bus
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Payload is the typed data of an event. Each event type has its own payload
+// type, which NewEvent turns into an event and DecodePayload reads back. The
+// JSON field names are the keys of Event.Data, and the API publishes their
+// schemas under the payload type's name.
+type Payload interface {
+	Type() EventType
+}
+
+// NewApprovalPayload is the data of EventNewApproval
+type NewApprovalPayload struct {
+	ApprovalID string `json:"approval_id"`
+	SessionID  string `json:"session_id"`
+	ToolName   string `json:"tool_name"`
+}
+
+// ApprovalResolvedPayload is the data of EventApprovalResolved
+type ApprovalResolvedPayload struct {
+	ApprovalID   string `json:"approval_id"`
+	SessionID    string `json:"session_id"`
+	Approved     bool   `json:"approved"`
+	ResponseText string `json:"response_text"`
+	// ToolUseID is set for approvals requested through MCP
+	ToolUseID string `json:"tool_use_id,omitempty"`
+}
+
+// SessionStatusChangedPayload is the data of EventSessionStatusChanged. A
+// status change has both statuses, a title change only Title, and a context
+// size update the unchanged status twice with Reason "token_update".
+type SessionStatusChangedPayload struct {
+	SessionID       string  `json:"session_id"`
+	RunID           string  `json:"run_id,omitempty"`
+	ParentSessionID string  `json:"parent_session_id,omitempty"`
+	OldStatus       string  `json:"old_status,omitempty"`
+	NewStatus       string  `json:"new_status,omitempty"`
+	Title           *string `json:"title,omitempty"`
+	Reason          string  `json:"reason,omitempty"`
+}
+
+// ConversationUpdatedPayload is the data of EventConversationUpdated. Which
+// fields are set depends on EventType: message, thinking, tool_call,
+// tool_result, system or compaction.
+type ConversationUpdatedPayload struct {
+	SessionID         string                 `json:"session_id"`
+	ClaudeSessionID   string                 `json:"claude_session_id"`
+	EventType         string                 `json:"event_type"`
+	ContentType       string                 `json:"content_type"`
+	Subtype           string                 `json:"subtype,omitempty"`
+	Role              string                 `json:"role,omitempty"`
+	Content           string                 `json:"content,omitempty"`
+	ToolID            string                 `json:"tool_id,omitempty"`
+	ToolName          string                 `json:"tool_name,omitempty"`
+	ToolInput         map[string]interface{} `json:"tool_input,omitempty"`
+	ToolResultForID   string                 `json:"tool_result_for_id,omitempty"`
+	ToolResultContent string                 `json:"tool_result_content,omitempty"`
+	ParentToolUseID   string                 `json:"parent_tool_use_id,omitempty"`
+	// Trigger, PreTokens and PostTokens describe a compaction
+	Trigger    string `json:"trigger,omitempty"`
+	PreTokens  *int   `json:"pre_tokens,omitempty"`
+	PostTokens *int   `json:"post_tokens,omitempty"`
+}
+
+// SessionSettingsChangedPayload is the data of EventSessionSettingsChanged.
+// Only the settings that changed are set.
+type SessionSettingsChangedPayload struct {
+	SessionID string `json:"session_id"`
+	RunID     string `json:"run_id,omitempty"`
+	// EventType is "settings_updated" when a client changed the settings
+	EventType                           string                      `json:"event_type,omitempty"`
+	Reason                              SessionSettingsChangeReason `json:"reason,omitempty"`
+	Title                               string                      `json:"title,omitempty"`
+	AutoAcceptEdits                     *bool                       `json:"auto_accept_edits,omitempty"`
+	DangerouslySkipPermissions          *bool                       `json:"dangerously_skip_permissions,omitempty"`
+	DangerouslySkipPermissionsTimeoutMs *int64                      `json:"dangerously_skip_permissions_timeout_ms,omitempty"`
+	StallThresholdMs                    *int64                      `json:"stall_threshold_ms,omitempty"`
+	StallInterruptThresholdMs           *int64                      `json:"stall_interrupt_threshold_ms,omitempty"`
+	// ExpiredAt is when dangerous skip permissions expired, with Reason expired
+	ExpiredAt *time.Time `json:"expired_at,omitempty"`
+}
+
+// SessionStalledPayload is the data of EventSessionStalled
+type SessionStalledPayload struct {
+	SessionID      string    `json:"session_id"`
+	RunID          string    `json:"run_id"`
+	IdleMs         int64     `json:"idle_ms"`
+	ThresholdMs    int64     `json:"threshold_ms"`
+	LastActivityAt time.Time `json:"last_activity_at"`
+	// InterruptThresholdMs is set when the session is interrupted automatically
+	InterruptThresholdMs int64 `json:"interrupt_threshold_ms,omitempty"`
+}
+
+// SessionResourceWarningPayload is the data of EventSessionResourceWarning
+type SessionResourceWarningPayload struct {
+	SessionID string `json:"session_id"`
+	RunID     string `json:"run_id"`
+	// Resource is rss_bytes, open_fds or cpu_percent
+	Resource string  `json:"resource"`
+	Value    float64 `json:"value"`
+	Limit    float64 `json:"limit"`
+}
+
+// BudgetExceededPayload is the data of EventBudgetExceeded
+type BudgetExceededPayload struct {
+	SessionID string `json:"session_id"`
+	RunID     string `json:"run_id"`
+	// PipelineRunID and Step are set for sessions run as a pipeline step
+	PipelineRunID string  `json:"pipeline_run_id,omitempty"`
+	Step          string  `json:"step,omitempty"`
+	CostUSD       float64 `json:"cost_usd"`
+	MaxCostUSD    float64 `json:"max_cost_usd"`
+}
+
+// ReplayGapPayload is the data of EventReplayGap
+type ReplayGapPayload struct {
+	// Since is the sequence the subscriber resumed from
+	Since uint64 `json:"since"`
+	// FirstSeq is the oldest sequence still kept, 0 if none
+	FirstSeq uint64 `json:"first_seq"`
+}
+
+func (NewApprovalPayload) Type() EventType            { return EventNewApproval }
+func (ApprovalResolvedPayload) Type() EventType       { return EventApprovalResolved }
+func (SessionStatusChangedPayload) Type() EventType   { return EventSessionStatusChanged }
+func (ConversationUpdatedPayload) Type() EventType    { return EventConversationUpdated }
+func (SessionSettingsChangedPayload) Type() EventType { return EventSessionSettingsChanged }
+func (SessionStalledPayload) Type() EventType         { return EventSessionStalled }
+func (SessionResourceWarningPayload) Type() EventType { return EventSessionResourceWarning }
+func (BudgetExceededPayload) Type() EventType         { return EventBudgetExceeded }
+func (ReplayGapPayload) Type() EventType              { return EventReplayGap }
+
+// Payloads returns an empty payload of every event type
+func Payloads() []Payload {
+	return []Payload{
+		NewApprovalPayload{},
+		ApprovalResolvedPayload{},
+		SessionStatusChangedPayload{},
+		ConversationUpdatedPayload{},
+		SessionSettingsChangedPayload{},
+		SessionStalledPayload{},
+		SessionResourceWarningPayload{},
+		BudgetExceededPayload{},
+		ReplayGapPayload{},
+	}
+}
+
+// NewEvent creates an event of the payload's type. Data holds the payload's
+// fields by their JSON names with their Go values, leaving out empty optional
+// ones, so it marshals exactly like the payload.
+func NewEvent(payload Payload) Event {
+	return Event{
+		Type: payload.Type(),
+		Data: payloadData(payload),
+	}
+}
+
+// DecodePayload reads the data of an event of T's type, whether it was
+// published in this process or decoded from JSON
+func DecodePayload[T Payload](event Event) (T, error) {
+	var payload T
+	if event.Type != payload.Type() {
+		return payload, fmt.Errorf("event type %q does not have a %T payload", event.Type, payload)
+	}
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return payload, fmt.Errorf("failed to encode %s event data: %w", event.Type, err)
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return payload, fmt.Errorf("failed to decode %s event data: %w", event.Type, err)
+	}
+	return payload, nil
+}
+
+// payloadData converts a payload struct to event data following its JSON tags
+func payloadData(payload Payload) map[string]interface{} {
+	value := reflect.ValueOf(payload)
+	data := make(map[string]interface{}, value.NumField())
+	for i := 0; i < value.NumField(); i++ {
+		name, opts, _ := strings.Cut(value.Type().Field(i).Tag.Get("json"), ",")
+		field := value.Field(i)
+		if opts == "omitempty" && isEmpty(field) {
+			continue
+		}
+		if field.Kind() == reflect.Pointer {
+			if field.IsNil() {
+				data[name] = nil
+				continue
+			}
+			field = field.Elem()
+		}
+		if field.Kind() == reflect.String {
+			// Named string types, like reasons, are plain strings on the wire
+			data[name] = field.String()
+		} else {
+			data[name] = field.Interface()
+		}
+	}
+	return data
+}
+
+// isEmpty reports whether encoding/json's omitempty leaves out a value
+func isEmpty(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return value.IsNil()
+	case reflect.Struct:
+		return false
+	default:
+		return value.IsZero()
+	}
+}
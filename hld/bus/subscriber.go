@@ -0,0 +1,106 @@
+package bus
+
+import "log/slog"
+
+// offer buffers event in the subscriber's channel, applying the overflow
+// policy when the buffer is full. It returns false if the subscriber must be
+// disconnected. The bus lock is held, so offer is the channel's only sender;
+// the subscriber may receive concurrently, which only makes room.
+func (s *Subscriber) offer(event Event) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.Channel) >= s.bufferSize {
+		switch s.overflow {
+		case OverflowDisconnect:
+			return false
+		case OverflowDropOldest:
+			s.dropOldestLocked()
+		case OverflowCoalesce:
+			s.coalesceLocked(event)
+		default:
+			s.dropped++
+			slog.Warn("dropping event for slow subscriber",
+				"subscriber_id", s.ID,
+				"event_type", event.Type,
+				"dropped", s.dropped,
+			)
+			return true
+		}
+	}
+
+	select {
+	case s.Channel <- event:
+		s.sent++
+	default:
+		// Replay can fill the channel past its buffer size, leaving no room
+		s.dropped++
+	}
+	return true
+}
+
+// dropOldestLocked drops the oldest buffered event, unless the subscriber
+// received it first
+func (s *Subscriber) dropOldestLocked() {
+	select {
+	case <-s.Channel:
+		s.dropped++
+		s.discarded++
+	default:
+	}
+}
+
+// coalesceLocked drops the buffered event that event supersedes, the newest
+// one of the same type for the same session, or the oldest buffered event if
+// there is none. The buffer is drained and refilled to do so, keeping the
+// remaining events in order.
+func (s *Subscriber) coalesceLocked(event Event) {
+	var buffered []Event
+	for drained := false; !drained; {
+		select {
+		case queued := <-s.Channel:
+			buffered = append(buffered, queued)
+		default:
+			drained = true
+		}
+	}
+	if len(buffered) == 0 {
+		return
+	}
+
+	superseded := 0
+	sessionID, _ := event.Data["session_id"].(string)
+	for i := len(buffered) - 1; i >= 0; i-- {
+		if buffered[i].Type != event.Type {
+			continue
+		}
+		if queuedSessionID, _ := buffered[i].Data["session_id"].(string); queuedSessionID == sessionID {
+			superseded = i
+			break
+		}
+	}
+	s.dropped++
+	s.discarded++
+	for i, queued := range buffered {
+		if i != superseded {
+			s.Channel <- queued
+		}
+	}
+}
+
+// Stats returns the subscriber's delivery statistics
+func (s *Subscriber) Stats() SubscriberStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lag := len(s.Channel)
+	return SubscriberStats{
+		ID:         s.ID,
+		Filter:     s.Filter,
+		BufferSize: s.bufferSize,
+		Overflow:   s.overflow,
+		Delivered:  s.sent - s.discarded - uint64(lag),
+		Dropped:    s.dropped,
+		Lag:        lag,
+	}
+}
@@ -2,6 +2,7 @@ package bus
 
 import (
 	"context"
+	"sync"
 	"time"
 )
 
@@ -21,6 +22,23 @@ const (
 	// Data includes: session_id, run_id, changed settings, and optional "reason" field
 	// For dangerous skip permissions expiry: reason="expired", expired_at=timestamp
 	EventSessionSettingsChanged EventType = "session_settings_changed"
+	// EventSessionStalled indicates a running session has produced no activity for longer
+	// than its stall threshold. Data includes: session_id, run_id, idle_ms, threshold_ms,
+	// last_activity_at and interrupt_threshold_ms when an automatic interrupt is configured
+	EventSessionStalled EventType = "session_stalled"
+	// EventSessionResourceWarning indicates a session's process tree has crossed a resource
+	// soft limit. Data includes: session_id, run_id, resource (rss_bytes, open_fds or
+	// cpu_percent), value and limit
+	EventSessionResourceWarning EventType = "session_resource_warning"
+	// EventBudgetExceeded indicates a session cost more than its budget allows.
+	// Data includes: session_id, run_id, cost_usd, max_cost_usd and, for
+	// pipeline steps, pipeline_run_id and step
+	EventBudgetExceeded EventType = "budget_exceeded"
+	// EventReplayGap is sent first to a subscriber resuming from a sequence when
+	// events after it are no longer kept, so some were missed. It has no sequence
+	// number and is sent regardless of filter. Data includes: since (the sequence
+	// asked for) and first_seq (the oldest sequence still kept, 0 if none)
+	EventReplayGap EventType = "replay_gap"
 )
 
 // SessionSettingsChangeReason represents reasons for session settings changes
@@ -29,10 +47,14 @@ type SessionSettingsChangeReason string
 const (
 	// SessionSettingsChangeReasonExpired indicates dangerous skip permissions expired due to timeout
 	SessionSettingsChangeReasonExpired SessionSettingsChangeReason = "expired"
+	// SessionSettingsChangeReasonAutoTitle indicates the session was given a generated title
+	SessionSettingsChangeReasonAutoTitle SessionSettingsChangeReason = "auto_title"
 )
 
-// Event represents an event in the system
+// Event represents an event in the system. Seq increases with every published
+// event and is zero for events that were not logged.
 type Event struct {
+	Seq       uint64                 `json:"seq,omitempty"`
 	Type      EventType              `json:"type"`
 	Timestamp time.Time              `json:"timestamp"`
 	Data      map[string]interface{} `json:"data"`
@@ -45,23 +67,102 @@ type EventFilter struct {
 	RunID     string      // Empty means all run IDs
 }
 
-// Subscriber represents a client subscribed to events
+// OverflowPolicy decides what happens to an event for a subscriber whose
+// buffer is full
+type OverflowPolicy string
+
+const (
+	// OverflowDropNewest drops the event being published
+	OverflowDropNewest OverflowPolicy = "drop_newest"
+	// OverflowDropOldest drops the oldest buffered event to make room
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowCoalesce drops the buffered event with the same type and
+	// session_id, which the new event supersedes, or the oldest buffered event
+	// if there is none
+	OverflowCoalesce OverflowPolicy = "coalesce"
+	// OverflowDisconnect ends the subscription and closes its channel. The
+	// subscriber can resume after the last event it received with SubscribeSince.
+	OverflowDisconnect OverflowPolicy = "disconnect"
+)
+
+// IsValid checks if the policy is known. The empty policy is OverflowDropNewest.
+func (p OverflowPolicy) IsValid() bool {
+	switch p {
+	case "", OverflowDropNewest, OverflowDropOldest, OverflowCoalesce, OverflowDisconnect:
+		return true
+	default:
+		return false
+	}
+}
+
+// DefaultBufferSize is how many events a subscriber buffers unless it asks
+// for another size
+const DefaultBufferSize = 100
+
+// SubscribeOptions tune a subscription
+type SubscribeOptions struct {
+	BufferSize int            // Zero means DefaultBufferSize
+	Overflow   OverflowPolicy // Empty means OverflowDropNewest
+	// Since resumes after the event with this sequence number, as SubscribeSince does
+	Since *uint64
+}
+
+// Subscriber represents a client subscribed to events. Each subscriber has
+// its own buffer, so a slow subscriber only ever affects itself.
 type Subscriber struct {
 	ID       string
 	Channel  chan Event
 	Filter   EventFilter
 	ctx      context.Context
 	cancelFn context.CancelFunc
+
+	bufferSize int
+	overflow   OverflowPolicy
+	order      uint64 // Position among all subscriptions of the bus
+
+	mu        sync.Mutex
+	sent      uint64 // Events put in Channel
+	dropped   uint64 // Events dropped or coalesced away
+	discarded uint64 // Events taken back out of Channel by the overflow policy
+}
+
+// SubscriberStats describe how well a subscriber keeps up
+type SubscriberStats struct {
+	ID         string
+	Filter     EventFilter
+	BufferSize int
+	Overflow   OverflowPolicy
+	Delivered  uint64 // Events received from the channel
+	Dropped    uint64 // Events dropped or coalesced away because the buffer was full
+	Lag        int    // Events buffered and not yet received
+}
+
+// EventLog persists published events so they can be replayed after the
+// daemon restarts
+type EventLog interface {
+	// Append stores an event and returns the sequence number it was given
+	Append(event Event) (uint64, error)
+	// Recent returns up to limit of the newest stored events, oldest first
+	Recent(limit int) ([]Event, error)
 }
 
 // EventBus defines the interface for the event bus
 type EventBus interface {
 	// Subscribe creates a new subscription with the given filter
 	Subscribe(ctx context.Context, filter EventFilter) *Subscriber
+	// SubscribeSince creates a subscription that first receives the kept events
+	// with a sequence number above since, preceded by an EventReplayGap event if
+	// some of them are no longer kept
+	SubscribeSince(ctx context.Context, filter EventFilter, since uint64) *Subscriber
+	// SubscribeWith creates a subscription with a buffer size and overflow
+	// policy, optionally resuming from a sequence number
+	SubscribeWith(ctx context.Context, filter EventFilter, opts SubscribeOptions) *Subscriber
 	// Unsubscribe removes a subscription
 	Unsubscribe(subscriberID string)
 	// Publish sends an event to all matching subscribers
 	Publish(event Event)
 	// GetSubscriberCount returns the current number of subscribers
 	GetSubscriberCount() int
+	// GetSubscriberStats returns delivery statistics of every subscriber
+	GetSubscriberStats() []SubscriberStats
 }
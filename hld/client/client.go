@@ -256,6 +256,15 @@ func (c *client) ListSessions() (*rpc.ListSessionsResponse, error) {
 	return &resp, nil
 }
 
+// ListSessionsPage lists sessions matching the request's filter, one page at a time
+func (c *client) ListSessionsPage(req rpc.ListSessionsRequest) (*rpc.ListSessionsResponse, error) {
+	var resp rpc.ListSessionsResponse
+	if err := c.call("listSessions", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetSessionLeaves gets only the leaf sessions (sessions with no children)
 func (c *client) GetSessionLeaves() (*rpc.GetSessionLeavesResponse, error) {
 	var resp rpc.GetSessionLeavesResponse
@@ -340,6 +349,34 @@ func (c *client) GetConversationByClaudeSessionID(claudeSessionID string) (*rpc.
 	return &resp, nil
 }
 
+// GetConversationPage fetches a page of conversation history, or only new
+// events when the request sets SinceSequence
+func (c *client) GetConversationPage(req rpc.GetConversationRequest) (*rpc.GetConversationResponse, error) {
+	var resp rpc.GetConversationResponse
+	if err := c.call("getConversation", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SearchConversations searches message text, tool inputs and tool results
+func (c *client) SearchConversations(req rpc.SearchConversationsRequest) (*rpc.SearchConversationsResponse, error) {
+	var resp rpc.SearchConversationsResponse
+	if err := c.call("searchConversations", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// RunMaintenance prunes data past the retention settings and vacuums the database
+func (c *client) RunMaintenance(req rpc.RunMaintenanceRequest) (*rpc.RunMaintenanceResponse, error) {
+	var resp rpc.RunMaintenanceResponse
+	if err := c.call("runMaintenance", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetSessionState fetches the current state of a session
 func (c *client) GetSessionState(sessionID string) (*rpc.GetSessionStateResponse, error) {
 	req := rpc.GetSessionStateRequest{
@@ -282,3 +282,36 @@ func TestClient_InterruptSession(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "session_id required")
 }
+
+func TestClient_GetConversationPage(t *testing.T) {
+	server, socketPath := newMockRPCServer(t)
+	defer server.stop()
+
+	server.setHandler("getConversation", func(params json.RawMessage) (interface{}, error) {
+		var req rpc.GetConversationRequest
+		if err := json.Unmarshal(params, &req); err != nil {
+			return nil, err
+		}
+		if req.SinceSequence != 4 || req.Limit != 10 {
+			return nil, fmt.Errorf("unexpected page: %+v", req)
+		}
+		return rpc.GetConversationResponse{
+			Events:     []rpc.ConversationEvent{{SessionID: req.SessionID, Sequence: 5}},
+			NextCursor: "next",
+		}, nil
+	})
+
+	server.start()
+	time.Sleep(10 * time.Millisecond)
+
+	c, err := New(socketPath)
+	require.NoError(t, err)
+	defer func() { _ = c.Close() }()
+
+	resp, err := c.GetConversationPage(rpc.GetConversationRequest{SessionID: "sess-1", SinceSequence: 4, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 1)
+	assert.Equal(t, 5, resp.Events[0].Sequence)
+	assert.Equal(t, "next", resp.NextCursor)
+	assert.False(t, resp.HasMore)
+}
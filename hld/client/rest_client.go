@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/humanlayer/humanlayer/hld/api"
@@ -58,13 +60,7 @@ func (c *RESTClient) doRequest(ctx context.Context, method, path string, body in
 	}()
 
 	if resp.StatusCode >= 400 {
-		var errorResp struct {
-			Error api.ErrorDetail `json:"error"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&errorResp); err != nil {
-			return fmt.Errorf("HTTP %d: failed to decode error response", resp.StatusCode)
-		}
-		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, errorResp.Error.Message)
+		return responseError(resp)
 	}
 
 	if result != nil {
@@ -76,6 +72,17 @@ func (c *RESTClient) doRequest(ctx context.Context, method, path string, body in
 	return nil
 }
 
+// responseError converts an error response into an error
+func responseError(resp *http.Response) error {
+	var errorResp struct {
+		Error api.ErrorDetail `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&errorResp); err != nil {
+		return fmt.Errorf("HTTP %d: failed to decode error response", resp.StatusCode)
+	}
+	return fmt.Errorf("HTTP %d: %s", resp.StatusCode, errorResp.Error.Message)
+}
+
 // CreateSession creates a new session
 func (c *RESTClient) CreateSession(ctx context.Context, req api.CreateSessionRequest) (*api.CreateSession201JSONResponse, error) {
 	var resp api.CreateSession201JSONResponse
@@ -91,6 +98,49 @@ func (c *RESTClient) ListSessions(ctx context.Context, leafOnly bool, includeArc
 	return &resp, err
 }
 
+// ListSessionsPage lists sessions matching params, one page at a time. Pass
+// the returned NextCursor back as params.Cursor to fetch the following page.
+func (c *RESTClient) ListSessionsPage(ctx context.Context, params api.ListSessionsParams) (*api.ListSessions200JSONResponse, error) {
+	query := url.Values{}
+	if params.LeavesOnly != nil {
+		query.Set("leavesOnly", strconv.FormatBool(*params.LeavesOnly))
+	}
+	if params.Filter != nil {
+		query.Set("filter", string(*params.Filter))
+	}
+	addQueryValues(query, "status", params.Status)
+	addQueryValues(query, "label", params.Label)
+	addQueryValues(query, "model", params.Model)
+	if params.ProjectId != nil {
+		query.Set("projectId", *params.ProjectId)
+	}
+	if params.CreatedAfter != nil {
+		query.Set("createdAfter", params.CreatedAfter.Format(time.RFC3339Nano))
+	}
+	if params.CreatedBefore != nil {
+		query.Set("createdBefore", params.CreatedBefore.Format(time.RFC3339Nano))
+	}
+	if params.MinCostUsd != nil {
+		query.Set("minCostUsd", strconv.FormatFloat(*params.MinCostUsd, 'f', -1, 64))
+	}
+	if params.MaxCostUsd != nil {
+		query.Set("maxCostUsd", strconv.FormatFloat(*params.MaxCostUsd, 'f', -1, 64))
+	}
+	if params.SavedFilterId != nil {
+		query.Set("savedFilterId", *params.SavedFilterId)
+	}
+	if params.Limit != nil {
+		query.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.Cursor != nil {
+		query.Set("cursor", *params.Cursor)
+	}
+
+	var resp api.ListSessions200JSONResponse
+	err := c.doRequest(ctx, "GET", withQuery("/api/v1/sessions", query), nil, &resp)
+	return &resp, err
+}
+
 // GetSession retrieves a specific session by ID
 func (c *RESTClient) GetSession(ctx context.Context, sessionID string) (*api.GetSession200JSONResponse, error) {
 	var resp api.GetSession200JSONResponse
@@ -126,6 +176,170 @@ func (c *RESTClient) GetSessionMessages(ctx context.Context, sessionID string) (
 	return &resp, err
 }
 
+// GetSessionMessagesPage retrieves a page of conversation history for a
+// session, or with SinceSequence only the events added since the last fetch
+func (c *RESTClient) GetSessionMessagesPage(ctx context.Context, sessionID string, params api.GetSessionMessagesParams) (*api.GetSessionMessages200JSONResponse, error) {
+	query := url.Values{}
+	if params.Limit != nil {
+		query.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.Cursor != nil {
+		query.Set("cursor", *params.Cursor)
+	}
+	if params.SinceSequence != nil {
+		query.Set("since_sequence", strconv.Itoa(*params.SinceSequence))
+	}
+
+	var resp api.GetSessionMessages200JSONResponse
+	err := c.doRequest(ctx, "GET", withQuery("/api/v1/sessions/"+sessionID+"/messages", query), nil, &resp)
+	return &resp, err
+}
+
+// SearchConversations searches message text, tool inputs and tool results
+func (c *RESTClient) SearchConversations(ctx context.Context, params api.SearchConversationsParams) (*api.SearchConversations200JSONResponse, error) {
+	query := url.Values{}
+	query.Set("q", params.Q)
+	addQueryValues(query, "role", params.Role)
+	addQueryValues(query, "eventType", params.EventType)
+	addQueryValues(query, "toolName", params.ToolName)
+	if params.SessionId != nil {
+		query.Set("sessionId", *params.SessionId)
+	}
+	if params.ProjectId != nil {
+		query.Set("projectId", *params.ProjectId)
+	}
+	if params.After != nil {
+		query.Set("after", params.After.Format(time.RFC3339Nano))
+	}
+	if params.Before != nil {
+		query.Set("before", params.Before.Format(time.RFC3339Nano))
+	}
+	if params.Limit != nil {
+		query.Set("limit", strconv.Itoa(*params.Limit))
+	}
+	if params.MatchesPerSession != nil {
+		query.Set("matchesPerSession", strconv.Itoa(*params.MatchesPerSession))
+	}
+
+	var resp api.SearchConversations200JSONResponse
+	err := c.doRequest(ctx, "GET", withQuery("/api/v1/conversations/search", query), nil, &resp)
+	return &resp, err
+}
+
+// RunMaintenance prunes data past the retention settings and vacuums the
+// database, or reports what would be pruned for a dry run
+func (c *RESTClient) RunMaintenance(ctx context.Context, req api.MaintenanceRequest) (*api.RunMaintenance200JSONResponse, error) {
+	var resp api.RunMaintenance200JSONResponse
+	err := c.doRequest(ctx, "POST", "/api/v1/maintenance", req, &resp)
+	return &resp, err
+}
+
+// ExportSessionBundle downloads the bundle archive of a session's
+// continuation tree to w
+func (c *RESTClient) ExportSessionBundle(ctx context.Context, sessionID string, includeRawEvents bool, w io.Writer) error {
+	path := "/api/v1/sessions/" + sessionID + "/bundle"
+	if includeRawEvents {
+		path += "?include_raw_events=true"
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= 400 {
+		return responseError(resp)
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download bundle: %w", err)
+	}
+	return nil
+}
+
+// ExportSessionConversation downloads a session transcript in the given format
+// (markdown, html or jsonl) and writes it to w
+func (c *RESTClient) ExportSessionConversation(ctx context.Context, sessionID, format string, w io.Writer) error {
+	path := "/api/v1/sessions/" + sessionID + "/export"
+	if format != "" {
+		path += "?format=" + url.QueryEscape(format)
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= 400 {
+		return responseError(resp)
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("failed to download transcript: %w", err)
+	}
+	return nil
+}
+
+// ImportSessionBundle uploads a bundle archive and returns the new session IDs
+func (c *RESTClient) ImportSessionBundle(ctx context.Context, archive io.Reader) (*api.ImportSessionBundle201JSONResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/api/v1/sessions/import", archive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/gzip")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= 400 {
+		return nil, responseError(resp)
+	}
+	var result api.ImportSessionBundle201JSONResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &result, nil
+}
+
+// ListBackups lists database backups, newest first
+func (c *RESTClient) ListBackups(ctx context.Context) (*api.ListBackups200JSONResponse, error) {
+	var resp api.ListBackups200JSONResponse
+	err := c.doRequest(ctx, "GET", "/api/v1/backups", nil, &resp)
+	return &resp, err
+}
+
+// CreateBackup backs up the database
+func (c *RESTClient) CreateBackup(ctx context.Context) (*api.CreateBackup201JSONResponse, error) {
+	var resp api.CreateBackup201JSONResponse
+	err := c.doRequest(ctx, "POST", "/api/v1/backups", nil, &resp)
+	return &resp, err
+}
+
+// RestoreBackup stages a backup to replace the database on the next daemon start
+func (c *RESTClient) RestoreBackup(ctx context.Context, name string) (*api.RestoreBackup200JSONResponse, error) {
+	var resp api.RestoreBackup200JSONResponse
+	err := c.doRequest(ctx, "POST", "/api/v1/backups/"+url.PathEscape(name)+"/restore", nil, &resp)
+	return &resp, err
+}
+
 // GetSessionSnapshots retrieves file snapshots for a session
 func (c *RESTClient) GetSessionSnapshots(ctx context.Context, sessionID string) (*api.GetSessionSnapshots200JSONResponse, error) {
 	var resp api.GetSessionSnapshots200JSONResponse
@@ -189,3 +403,21 @@ func (c *RESTClient) GetHealth(ctx context.Context) (*api.HealthResponse, error)
 	err := c.doRequest(ctx, "GET", "/api/v1/health", nil, &resp)
 	return &resp, err
 }
+
+// withQuery appends encoded query parameters to path when there are any
+func withQuery(path string, query url.Values) string {
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
+}
+
+// addQueryValues adds every value of an optional repeated query parameter
+func addQueryValues(query url.Values, key string, values *[]string) {
+	if values == nil {
+		return
+	}
+	for _, v := range *values {
+		query.Add(key, v)
+	}
+}
@@ -18,6 +18,9 @@ type Client interface {
 	// ListSessions lists all active sessions
 	ListSessions() (*rpc.ListSessionsResponse, error)
 
+	// ListSessionsPage lists sessions matching the request's filter, one page at a time
+	ListSessionsPage(req rpc.ListSessionsRequest) (*rpc.ListSessionsResponse, error)
+
 	// GetSessionLeaves gets only the leaf sessions (sessions with no children)
 	GetSessionLeaves() (*rpc.GetSessionLeavesResponse, error)
 
@@ -43,6 +46,16 @@ type Client interface {
 	// GetConversationByClaudeSessionID fetches the conversation history by Claude session ID
 	GetConversationByClaudeSessionID(claudeSessionID string) (*rpc.GetConversationResponse, error)
 
+	// GetConversationPage fetches a page of conversation history, or only new
+	// events when the request sets SinceSequence
+	GetConversationPage(req rpc.GetConversationRequest) (*rpc.GetConversationResponse, error)
+
+	// SearchConversations searches message text, tool inputs and tool results
+	SearchConversations(req rpc.SearchConversationsRequest) (*rpc.SearchConversationsResponse, error)
+
+	// RunMaintenance prunes data past the retention settings and vacuums the database
+	RunMaintenance(req rpc.RunMaintenanceRequest) (*rpc.RunMaintenanceResponse, error)
+
 	// GetSessionState fetches the current state of a session
 	GetSessionState(sessionID string) (*rpc.GetSessionStateResponse, error)
 
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/backup"
+	"github.com/humanlayer/humanlayer/hld/config"
+)
+
+const dbUsage = `Usage: hld db <command> [arguments]
+
+Commands:
+  backup            Back up the database, also while the daemon is running
+  backups           List backups, newest first
+  restore <backup>  Restore a backup by name or path. With the daemon running,
+                    a named backup is staged and applied on its next start.
+  migrate           Apply pending schema migrations
+    --to <version>    Migrate up to this version instead of the latest
+    --dry-run         Show the migrations that would run without applying them
+  rollback          Roll back the latest schema migration
+    --to <version>    Roll back every migration above this version
+    --dry-run         Show the migrations that would be rolled back
+  status            Show applied and pending schema migrations
+  rotate-key        Encrypt stored secrets with a new master key and retire
+                    the previous ones
+`
+
+// errUsage reports arguments that do not match dbUsage
+var errUsage = errors.New("invalid arguments")
+
+// runDB runs an hld db subcommand and returns the process exit code
+func runDB(ctx context.Context, args []string) int {
+	fs := flag.NewFlagSet("db", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprint(os.Stderr, dbUsage) }
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		return 1
+	}
+
+	switch cmd, rest := fs.Arg(0), fs.Args()[1:]; cmd {
+	case "backup", "backups", "restore":
+		err = dbBackups(ctx, cfg, cmd, rest)
+	case "migrate", "rollback", "status":
+		err = dbMigrations(ctx, cfg, cmd, rest)
+	case "rotate-key":
+		err = dbRotateKey(ctx, cfg, rest)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown db command %q\n\n", cmd)
+		err = errUsage
+	}
+	if errors.Is(err, errUsage) {
+		fs.Usage()
+		return 2
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "hld db %s: %v\n", fs.Arg(0), err)
+		return 1
+	}
+	return 0
+}
+
+func dbBackups(ctx context.Context, cfg *config.Config, cmd string, args []string) error {
+	if cfg.DatabaseURL != "" {
+		return fmt.Errorf("%w; use pg_dump and pg_restore for PostgreSQL", backup.ErrUnsupported)
+	}
+	backups := backup.New(cfg.DatabasePath, backup.Config{Dir: cfg.BackupDir, Keep: cfg.BackupKeep})
+
+	switch cmd {
+	case "backup":
+		return dbBackup(ctx, backups)
+	case "backups":
+		return dbListBackups(backups)
+	default:
+		if len(args) != 1 {
+			return errUsage
+		}
+		return dbRestore(ctx, cfg, backups, args[0])
+	}
+}
+
+func dbBackup(ctx context.Context, backups *backup.Service) error {
+	b, err := backups.Create(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Backed up schema version %d to %s (%d bytes)\n", b.SchemaVersion, b.Path, b.SizeBytes)
+	return nil
+}
+
+func dbListBackups(backups *backup.Service) error {
+	list, err := backups.List()
+	if err != nil {
+		return err
+	}
+	if len(list) == 0 {
+		fmt.Printf("No backups in %s\n", backups.Dir())
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tCREATED\tSIZE\tSCHEMA\tREASON")
+	for _, b := range list {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n",
+			b.Name, b.CreatedAt.Local().Format(time.DateTime), b.SizeBytes, b.SchemaVersion, b.Reason)
+	}
+	return w.Flush()
+}
+
+func dbRestore(ctx context.Context, cfg *config.Config, backups *backup.Service, arg string) error {
+	// Accept a backup name from hld db backups, or any database file
+	path := arg
+	named, err := backups.Get(arg)
+	if err == nil {
+		path = named.Path
+	} else if !errors.Is(err, backup.ErrNotFound) {
+		return err
+	}
+
+	if daemonRunning(cfg.SocketPath) {
+		if named == nil {
+			return fmt.Errorf("the daemon is running; stop it to restore %s, or restore a backup by name", arg)
+		}
+		if _, err := backups.StageRestore(ctx, named.Name); err != nil {
+			return err
+		}
+		fmt.Printf("Staged %s; restart the daemon to apply it\n", named.Name)
+		return nil
+	}
+
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("%w: %s", backup.ErrNotFound, arg)
+	}
+	previous, err := backup.Restore(ctx, cfg.DatabasePath, path)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Restored %s from %s\n", cfg.DatabasePath, path)
+	if previous != "" {
+		fmt.Printf("The replaced database was moved to %s\n", previous)
+	}
+	return nil
+}
+
+// daemonRunning reports whether a daemon is accepting connections on the socket
+func daemonRunning(socketPath string) bool {
+	conn, err := net.DialTimeout("unix", socketPath, time.Second)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+	return true
+}
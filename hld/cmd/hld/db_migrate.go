@@ -0,0 +1,217 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/backup"
+	"github.com/humanlayer/humanlayer/hld/config"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// dbMigrations runs hld db migrate, rollback and status against the
+// configured database without opening a store, so they also work on a
+// database newer than this build
+func dbMigrations(ctx context.Context, cfg *config.Config, cmd string, args []string) error {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	to := fs.Int("to", -1, "")
+	dryRun := fs.Bool("dry-run", false, "")
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n\n", err)
+		return errUsage
+	}
+	if fs.NArg() > 0 || (cmd == "status" && fs.NFlag() > 0) {
+		return errUsage
+	}
+
+	var migrator *store.Migrator
+	var err error
+	if cfg.DatabaseURL != "" {
+		migrator, err = store.OpenPostgresMigrator(ctx, cfg.DatabaseURL)
+	} else {
+		migrator, err = store.OpenSQLiteMigrator(cfg.DatabasePath)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() { _ = migrator.Close() }()
+
+	switch cmd {
+	case "status":
+		return dbMigrationStatus(ctx, migrator)
+	case "migrate":
+		target := migrator.Latest()
+		if *to >= 0 {
+			target = *to
+		}
+		return dbMigrate(ctx, cfg, migrator, target, *dryRun)
+	default:
+		target := *to
+		if target < 0 {
+			current, err := migrator.CurrentVersion(ctx)
+			if err != nil {
+				return err
+			}
+			target = previousVersion(migrator, current)
+		}
+		return dbRollback(ctx, cfg, migrator, target, *dryRun)
+	}
+}
+
+// previousVersion is the version a database is at once the migration for
+// current is rolled back
+func previousVersion(migrator *store.Migrator, current int) int {
+	previous := 0
+	for _, m := range migrator.Migrations() {
+		if m.Version < current {
+			previous = m.Version
+		}
+	}
+	return previous
+}
+
+func dbMigrationStatus(ctx context.Context, migrator *store.Migrator) error {
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Schema version %d, this build supports up to %d\n", status.Current, status.Latest)
+	if status.Current > status.Latest {
+		fmt.Println("The database was migrated by a newer build, which the daemon refuses to start with.")
+		fmt.Println("Upgrade hld, or roll back with the newer build's hld db rollback.")
+	}
+	if status.Lock != nil {
+		fmt.Printf("Being migrated by %s since %s\n", status.Lock.Owner, status.Lock.AcquiredAt.Local().Format(time.DateTime))
+	}
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "VERSION\tSTATUS\tAPPLIED\tDESCRIPTION")
+	for _, applied := range status.Applied {
+		state := "applied"
+		switch {
+		case applied.Version > status.Latest:
+			state = "newer"
+		case !applied.Known:
+			state = "unknown"
+		case applied.Modified:
+			state = "modified"
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n",
+			applied.Version, state, applied.AppliedAt.Local().Format(time.DateTime), applied.Description)
+	}
+	for _, pending := range status.Pending {
+		_, _ = fmt.Fprintf(w, "%d\tpending\t-\t%s\n", pending.Version, pending.Description)
+	}
+	return w.Flush()
+}
+
+func dbMigrate(ctx context.Context, cfg *config.Config, migrator *store.Migrator, target int, dryRun bool) error {
+	plan, err := migrator.Plan(ctx, target)
+	if err != nil {
+		return err
+	}
+	if plan.Down {
+		return fmt.Errorf("the database is at version %d, above %d; use hld db rollback", plan.From, target)
+	}
+	if len(plan.Steps) == 0 {
+		fmt.Printf("Schema version %d is up to date\n", plan.From)
+		return nil
+	}
+
+	printPlan(plan, dryRun)
+	if dryRun {
+		return nil
+	}
+	if err := checkDaemonStopped(cfg); err != nil {
+		return err
+	}
+	if cfg.DatabaseURL == "" {
+		backups := backup.New(cfg.DatabasePath, backup.Config{Dir: cfg.BackupDir, Keep: cfg.BackupKeep})
+		if b, err := backups.BeforeMigration(ctx); err != nil {
+			return fmt.Errorf("failed to back up database: %w", err)
+		} else if b != nil {
+			fmt.Printf("Backed up the database to %s\n", b.Path)
+		}
+	}
+
+	if _, err := migrator.Migrate(ctx, target); err != nil {
+		return err
+	}
+	fmt.Printf("Migrated to schema version %d\n", target)
+	return nil
+}
+
+func dbRollback(ctx context.Context, cfg *config.Config, migrator *store.Migrator, target int, dryRun bool) error {
+	plan, err := migrator.Plan(ctx, target)
+	if err != nil {
+		return err
+	}
+	if len(plan.Steps) == 0 {
+		fmt.Printf("Schema version %d, nothing to roll back\n", plan.From)
+		return nil
+	}
+	if !plan.Down {
+		return fmt.Errorf("the database is at version %d, below %d; use hld db migrate", plan.From, target)
+	}
+
+	printPlan(plan, dryRun)
+	if dryRun {
+		return nil
+	}
+	if err := checkDaemonStopped(cfg); err != nil {
+		return err
+	}
+	if cfg.DatabaseURL == "" {
+		backups := backup.New(cfg.DatabasePath, backup.Config{Dir: cfg.BackupDir, Keep: cfg.BackupKeep})
+		b, err := backups.BeforeRollback(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to back up database: %w", err)
+		}
+		fmt.Printf("Backed up the database to %s\n", b.Path)
+	}
+
+	if _, err := migrator.Rollback(ctx, target); err != nil {
+		return err
+	}
+	fmt.Printf("Rolled back to schema version %d\n", target)
+	fmt.Println("This build migrates the database again when the daemon starts, so start one that supports this version.")
+	return nil
+}
+
+func printPlan(plan *store.MigrationPlan, dryRun bool) {
+	verb := "Applying"
+	switch {
+	case plan.Down && dryRun:
+		verb = "Would roll back"
+	case plan.Down:
+		verb = "Rolling back"
+	case dryRun:
+		verb = "Would apply"
+	}
+	noun := "migrations"
+	if len(plan.Steps) == 1 {
+		noun = "migration"
+	}
+	fmt.Printf("%s %d %s, from schema version %d to %d:\n", verb, len(plan.Steps), noun, plan.From, plan.To)
+	for _, m := range plan.Steps {
+		fmt.Printf("  %3d  %s\n", m.Version, m.Description)
+	}
+}
+
+// checkDaemonStopped refuses to change the schema under a running daemon,
+// which would keep using the schema it started with
+func checkDaemonStopped(cfg *config.Config) error {
+	if daemonRunning(cfg.SocketPath) {
+		return errors.New("the daemon is running; stop it first")
+	}
+	return nil
+}
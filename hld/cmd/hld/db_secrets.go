@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/humanlayer/humanlayer/hld/backup"
+	"github.com/humanlayer/humanlayer/hld/config"
+	"github.com/humanlayer/humanlayer/hld/secrets"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// secretStore is a store that can encrypt the secrets it keeps
+type secretStore interface {
+	EncryptSecrets(ctx context.Context, box store.SecretBox) (int, error)
+	Close() error
+}
+
+// dbRotateKey replaces the master key encrypting secrets in the database. The
+// new key is saved next to the old ones before anything is encrypted with it,
+// so an interrupted rotation loses nothing and can simply be run again.
+func dbRotateKey(ctx context.Context, cfg *config.Config, args []string) error {
+	if len(args) > 0 {
+		return errUsage
+	}
+	if err := checkDaemonStopped(cfg); err != nil {
+		return err
+	}
+
+	keys, err := secrets.Open(cfg.SecretKeySource, cfg.SecretKeyFile)
+	if err != nil {
+		return err
+	}
+
+	var st secretStore
+	if cfg.DatabaseURL != "" {
+		st, err = store.NewPostgresStore(cfg.DatabaseURL)
+	} else {
+		backups := backup.New(cfg.DatabasePath, backup.Config{Dir: cfg.BackupDir, Keep: cfg.BackupKeep})
+		if _, err := backups.BeforeMigration(ctx); err != nil {
+			return fmt.Errorf("failed to back up database: %w", err)
+		}
+		st, err = store.NewSQLiteStore(cfg.DatabasePath)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() { _ = st.Close() }()
+
+	box, err := secrets.Rotate(keys)
+	if err != nil {
+		return err
+	}
+	encrypted, err := st.EncryptSecrets(ctx, box)
+	if err != nil {
+		return fmt.Errorf("%w; the previous keys are kept, run hld db rotate-key again", err)
+	}
+	if err := secrets.Retire(keys, box); err != nil {
+		return fmt.Errorf("failed to retire previous keys: %w", err)
+	}
+
+	fmt.Printf("Rotated to key %s in %s and encrypted %d stored secrets with it\n",
+		box.Keys()[0].ID, keys, encrypted)
+	if cfg.DatabaseURL != "" {
+		fmt.Println("Give every daemon sharing this database the new key before starting them.")
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"log/slog"
 	"os"
@@ -9,9 +10,18 @@ import (
 	"syscall"
 
 	"github.com/humanlayer/humanlayer/hld/daemon"
+	"github.com/humanlayer/humanlayer/hld/store"
 )
 
 func main() {
+	// Database subcommands run without starting the daemon
+	if len(os.Args) > 1 && os.Args[1] == "db" {
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		code := runDB(ctx, os.Args[2:])
+		stop()
+		os.Exit(code)
+	}
+
 	// Parse command line flags
 	debug := flag.Bool("debug", false, "Enable debug logging")
 	flag.Parse()
@@ -41,6 +51,9 @@ func main() {
 	d, err := daemon.New()
 	if err != nil {
 		slog.Error("failed to create daemon", "error", err)
+		if errors.Is(err, store.ErrSchemaTooNew) {
+			slog.Error("upgrade hld, or roll the database back with the newer build's hld db rollback; hld db status shows the applied migrations")
+		}
 		os.Exit(1)
 	}
 
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -24,8 +25,28 @@ type Config struct {
 	// Socket configuration
 	SocketPath string `mapstructure:"socket_path"`
 
-	// Database configuration
+	// Database configuration. DatabaseURL selects a PostgreSQL database shared
+	// by several daemons instead of the SQLite database at DatabasePath.
 	DatabasePath string `mapstructure:"database_path"`
+	DatabaseURL  string `mapstructure:"database_url"`
+
+	// Database backups. BackupDir defaults to a backups directory next to the
+	// database and a zero BackupInterval disables scheduled backups.
+	BackupDir      string        `mapstructure:"backup_dir"`
+	BackupKeep     int           `mapstructure:"backup_keep"`
+	BackupInterval time.Duration `mapstructure:"backup_interval"`
+
+	// Master keys for secrets stored in the database, such as proxy API keys.
+	// SecretKeySource is auto, keyring or file, where auto uses SecretKeyFile
+	// if it exists and the OS keyring otherwise. SecretKeyFile defaults to
+	// secret.key next to the database.
+	SecretKeySource string `mapstructure:"secret_key_source"`
+	SecretKeyFile   string `mapstructure:"secret_key_file"`
+
+	// Number of recent events kept in the database for subscribers resuming
+	// after reconnecting. Zero keeps them in memory only, so they are lost
+	// when the daemon restarts.
+	EventLogSize int `mapstructure:"event_log_size"`
 
 	// API configuration (for future phases)
 	APIKey     string `mapstructure:"api_key"`
@@ -43,6 +64,21 @@ type Config struct {
 
 	// Claude configuration
 	ClaudePath string `mapstructure:"claude_path"`
+
+	// Additional agent backends sessions can select by name
+	Runners []RunnerConfig `mapstructure:"runners"`
+}
+
+// RunnerConfig defines an agent backend besides the Claude Code CLI. A runner
+// either runs a command that writes JSON lines to stdout or replays a script.
+type RunnerConfig struct {
+	Name          string            `mapstructure:"name"`
+	Command       string            `mapstructure:"command"`
+	Args          []string          `mapstructure:"args"`
+	Env           map[string]string `mapstructure:"env"`
+	Format        string            `mapstructure:"format"`          // claude-stream-json (default) or agent-jsonl
+	Script        string            `mapstructure:"script"`          // JSON lines file to replay instead of a command
+	ScriptDelayMs int               `mapstructure:"script_delay_ms"` // Pause before each replayed event
 }
 
 // Load loads configuration with priority: flags > env vars > config file > defaults
@@ -65,6 +101,7 @@ func Load() (*Config, error) {
 	// Map environment variables to config keys
 	_ = v.BindEnv("socket_path", "HUMANLAYER_DAEMON_SOCKET")
 	_ = v.BindEnv("database_path", "HUMANLAYER_DATABASE_PATH")
+	_ = v.BindEnv("database_url", "HUMANLAYER_DATABASE_URL")
 	_ = v.BindEnv("api_key", "HUMANLAYER_API_KEY")
 	_ = v.BindEnv("api_base_url", "HUMANLAYER_API_BASE_URL", "HUMANLAYER_API_BASE")
 	_ = v.BindEnv("log_level", "HUMANLAYER_LOG_LEVEL")
@@ -72,6 +109,12 @@ func Load() (*Config, error) {
 	_ = v.BindEnv("http_port", "HUMANLAYER_DAEMON_HTTP_PORT")
 	_ = v.BindEnv("http_host", "HUMANLAYER_DAEMON_HTTP_HOST")
 	_ = v.BindEnv("claude_path", "HUMANLAYER_CLAUDE_PATH")
+	_ = v.BindEnv("backup_dir", "HUMANLAYER_BACKUP_DIR")
+	_ = v.BindEnv("backup_keep", "HUMANLAYER_BACKUP_KEEP")
+	_ = v.BindEnv("backup_interval", "HUMANLAYER_BACKUP_INTERVAL")
+	_ = v.BindEnv("secret_key_source", "HUMANLAYER_SECRET_KEY_SOURCE")
+	_ = v.BindEnv("secret_key_file", "HUMANLAYER_SECRET_KEY_FILE")
+	_ = v.BindEnv("event_log_size", "HUMANLAYER_EVENT_LOG_SIZE")
 
 	// Set defaults
 	setDefaults(v)
@@ -93,6 +136,18 @@ func Load() (*Config, error) {
 	config.SocketPath = expandHome(config.SocketPath)
 	config.DatabasePath = expandHome(config.DatabasePath)
 	config.ClaudePath = expandHome(config.ClaudePath)
+	config.BackupDir = expandHome(config.BackupDir)
+	if config.BackupDir == "" {
+		config.BackupDir = filepath.Join(filepath.Dir(config.DatabasePath), "backups")
+	}
+	config.SecretKeyFile = expandHome(config.SecretKeyFile)
+	if config.SecretKeyFile == "" {
+		config.SecretKeyFile = filepath.Join(filepath.Dir(config.DatabasePath), "secret.key")
+	}
+	for i := range config.Runners {
+		config.Runners[i].Command = expandHome(config.Runners[i].Command)
+		config.Runners[i].Script = expandHome(config.Runners[i].Script)
+	}
 
 	return &config, nil
 }
@@ -112,6 +167,10 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("http_port", port)
 	v.SetDefault("http_host", "127.0.0.1")
 	v.SetDefault("claude_path", DefaultClaudePath)
+	v.SetDefault("backup_keep", 7)
+	v.SetDefault("backup_interval", 24*time.Hour)
+	v.SetDefault("secret_key_source", "auto")
+	v.SetDefault("event_log_size", 1000)
 }
 
 // getDefaultConfigDir returns the default configuration directory
@@ -148,6 +207,17 @@ func (c *Config) Validate() error {
 	if c.SocketPath == "" {
 		return fmt.Errorf("socket path cannot be empty")
 	}
+	if c.BackupKeep < 0 {
+		return fmt.Errorf("backup_keep cannot be negative")
+	}
+	if c.EventLogSize < 0 {
+		return fmt.Errorf("event_log_size cannot be negative")
+	}
+	switch c.SecretKeySource {
+	case "", "auto", "keyring", "file":
+	default:
+		return fmt.Errorf("secret_key_source must be auto, keyring or file, got %q", c.SecretKeySource)
+	}
 	return nil
 }
 
@@ -171,6 +241,7 @@ func Save(cfg *Config) error {
 	// Set the values from the config struct
 	v.Set("socket_path", cfg.SocketPath)
 	v.Set("database_path", cfg.DatabasePath)
+	v.Set("database_url", cfg.DatabaseURL)
 	v.Set("api_key", cfg.APIKey)
 	v.Set("api_base_url", cfg.APIBaseURL)
 	v.Set("log_level", cfg.LogLevel)
@@ -178,6 +249,15 @@ func Save(cfg *Config) error {
 	v.Set("http_port", cfg.HTTPPort)
 	v.Set("http_host", cfg.HTTPHost)
 	v.Set("claude_path", cfg.ClaudePath)
+	v.Set("backup_dir", cfg.BackupDir)
+	v.Set("backup_keep", cfg.BackupKeep)
+	v.Set("backup_interval", cfg.BackupInterval.String())
+	v.Set("secret_key_source", cfg.SecretKeySource)
+	v.Set("secret_key_file", cfg.SecretKeyFile)
+	v.Set("event_log_size", cfg.EventLogSize)
+	if len(cfg.Runners) > 0 {
+		v.Set("runners", cfg.Runners)
+	}
 
 	// Set config file path explicitly
 	configFile := filepath.Join(configDir, "humanlayer.json")
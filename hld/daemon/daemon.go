@@ -7,16 +7,25 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/humanlayer/humanlayer/hld/approval"
+	"github.com/humanlayer/humanlayer/hld/backup"
+	"github.com/humanlayer/humanlayer/hld/batch"
 	"github.com/humanlayer/humanlayer/hld/bus"
 	"github.com/humanlayer/humanlayer/hld/config"
+	"github.com/humanlayer/humanlayer/hld/maintenance"
+	"github.com/humanlayer/humanlayer/hld/pipeline"
 	"github.com/humanlayer/humanlayer/hld/rpc"
+	"github.com/humanlayer/humanlayer/hld/scheduler"
+	"github.com/humanlayer/humanlayer/hld/secrets"
 	"github.com/humanlayer/humanlayer/hld/session"
 	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/humanlayer/humanlayer/hld/templates"
+	"github.com/humanlayer/humanlayer/hld/webhook"
 )
 
 const (
@@ -49,6 +58,89 @@ func getPermissionMonitorInterval() time.Duration {
 	return 30 * time.Second
 }
 
+// getSchedulerInterval returns the interval for checking due session schedules
+func getSchedulerInterval() time.Duration {
+	if intervalStr := os.Getenv("HLD_SCHEDULER_INTERVAL"); intervalStr != "" {
+		if interval, err := time.ParseDuration(intervalStr); err == nil {
+			return interval
+		}
+		slog.Warn("invalid HLD_SCHEDULER_INTERVAL, using default", "value", intervalStr)
+	}
+	return 30 * time.Second
+}
+
+// getPipelineInterval returns the interval for polling running pipelines
+func getPipelineInterval() time.Duration {
+	if intervalStr := os.Getenv("HLD_PIPELINE_INTERVAL"); intervalStr != "" {
+		if interval, err := time.ParseDuration(intervalStr); err == nil {
+			return interval
+		}
+		slog.Warn("invalid HLD_PIPELINE_INTERVAL, using default", "value", intervalStr)
+	}
+	return 10 * time.Second
+}
+
+// getMaintenanceInterval returns the interval between database maintenance
+// passes. Zero disables the background job.
+func getMaintenanceInterval() time.Duration {
+	return getDurationEnv("HLD_MAINTENANCE_INTERVAL", 6*time.Hour)
+}
+
+// getWatchdogConfig returns the stalled session watchdog defaults. The
+// interrupt threshold is disabled unless HLD_STALL_INTERRUPT_THRESHOLD is set.
+func getWatchdogConfig() session.WatchdogConfig {
+	return session.WatchdogConfig{
+		Interval:                getDurationEnv("HLD_WATCHDOG_INTERVAL", 30*time.Second),
+		StallThreshold:          getDurationEnv("HLD_STALL_THRESHOLD", 10*time.Minute),
+		StallInterruptThreshold: getDurationEnv("HLD_STALL_INTERRUPT_THRESHOLD", 0),
+	}
+}
+
+// getDurationEnv parses a duration from the named environment variable,
+// falling back to the default when it is unset or invalid
+func getDurationEnv(name string, fallback time.Duration) time.Duration {
+	if value := os.Getenv(name); value != "" {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+		slog.Warn("invalid "+name+", using default", "value", value)
+	}
+	return fallback
+}
+
+// getResourceMonitorConfig returns the resource accounting interval and soft
+// limits. Limits are disabled unless set.
+func getResourceMonitorConfig() session.ResourceMonitorConfig {
+	return session.ResourceMonitorConfig{
+		Interval: getDurationEnv("HLD_RESOURCE_SAMPLE_INTERVAL", 15*time.Second),
+		Limits: session.ResourceLimits{
+			RSSBytes:   int64(getFloatEnv("HLD_RSS_SOFT_LIMIT_BYTES")),
+			OpenFDs:    int64(getFloatEnv("HLD_FD_SOFT_LIMIT")),
+			CPUPercent: getFloatEnv("HLD_CPU_SOFT_LIMIT_PERCENT"),
+		},
+	}
+}
+
+// getWebhookConfig returns the delivery settings of outbound webhooks
+func getWebhookConfig() webhook.Config {
+	return webhook.Config{
+		Interval: getDurationEnv("HLD_WEBHOOK_INTERVAL", 5*time.Second),
+		Timeout:  getDurationEnv("HLD_WEBHOOK_TIMEOUT", 10*time.Second),
+	}
+}
+
+// getFloatEnv parses a non-negative number from the named environment
+// variable, returning 0 when it is unset or invalid
+func getFloatEnv(name string) float64 {
+	if value := os.Getenv(name); value != "" {
+		if f, err := strconv.ParseFloat(value, 64); err == nil && f >= 0 {
+			return f
+		}
+		slog.Warn("invalid "+name+", ignoring", "value", value)
+	}
+	return 0
+}
+
 // Daemon coordinates all daemon functionality
 type Daemon struct {
 	config            *config.Config
@@ -61,6 +153,15 @@ type Daemon struct {
 	eventBus          bus.EventBus
 	store             store.ConversationStore
 	permissionMonitor *session.PermissionMonitor
+	watchdog          *session.Watchdog
+	resources         *session.ResourceMonitor
+	scheduler         *scheduler.Scheduler
+	pipelines         *pipeline.Runner
+	batches           *batch.Service
+	templates         *templates.Service
+	maintenance       *maintenance.Service
+	backups           *backup.Service
+	webhooks          *webhook.Service
 }
 
 // New creates a new daemon instance
@@ -107,13 +208,61 @@ func New() (*Daemon, error) {
 		}
 	}
 
-	// Create event bus
-	eventBus := bus.NewEventBus()
+	var conversationStore store.ConversationStore
+	var backupService *backup.Service
+	if cfg.DatabaseURL != "" {
+		// Backups of a PostgreSQL database are left to the server's own tools
+		backupService = backup.New("", backup.Config{})
+		pgStore, err := store.NewPostgresStore(cfg.DatabaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create PostgreSQL store: %w", err)
+		}
+		if err := encryptSecrets(cfg, pgStore); err != nil {
+			_ = pgStore.Close()
+			return nil, err
+		}
+		conversationStore = pgStore
+	} else {
+		// Apply a restore staged through the API before the database is opened,
+		// and back up a database that is about to be migrated
+		backupService = backup.New(cfg.DatabasePath, backup.Config{
+			Dir:      cfg.BackupDir,
+			Keep:     cfg.BackupKeep,
+			Interval: cfg.BackupInterval,
+		})
+		if restored, err := backup.ApplyStagedRestore(context.Background(), cfg.DatabasePath); err != nil {
+			slog.Error("failed to apply staged database restore", "error", err)
+		} else if restored {
+			slog.Info("database restored from backup", "path", cfg.DatabasePath)
+		}
+		if _, err := backupService.BeforeMigration(context.Background()); err != nil {
+			slog.Warn("failed to back up database before migration", "error", err)
+		}
 
-	// Initialize SQLite store
-	conversationStore, err := store.NewSQLiteStore(cfg.DatabasePath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create SQLite store: %w", err)
+		// Initialize SQLite store
+		sqliteStore, err := store.NewSQLiteStore(cfg.DatabasePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create SQLite store: %w", err)
+		}
+		// An in-memory database keeps nothing at rest
+		if cfg.DatabasePath != ":memory:" {
+			if err := encryptSecrets(cfg, sqliteStore); err != nil {
+				_ = sqliteStore.Close()
+				return nil, err
+			}
+		}
+		conversationStore = sqliteStore
+	}
+
+	// Create event bus, logging events in the store so subscribers can resume
+	// after reconnecting
+	eventBus := bus.NewEventBus()
+	if cfg.EventLogSize > 0 {
+		eventBus, err = bus.NewEventBusWithLog(&storeEventLog{store: conversationStore, keep: cfg.EventLogSize}, cfg.EventLogSize)
+		if err != nil {
+			_ = conversationStore.Close()
+			return nil, err
+		}
 	}
 
 	// Create session manager with store and config
@@ -128,18 +277,47 @@ func New() (*Daemon, error) {
 	approvalManager := approval.NewManager(conversationStore, eventBus)
 	slog.Debug("local approval manager created successfully")
 
+	// Create session scheduler for recurring sessions
+	sessionScheduler := scheduler.New(conversationStore, sessionManager, getSchedulerInterval())
+
+	// Create pipeline runner for multi-step session pipelines
+	pipelineRunner := pipeline.New(conversationStore, sessionManager, eventBus, getPipelineInterval())
+
+	// Create batch service for launching one prompt across a matrix of configurations
+	batchService := batch.New(conversationStore, sessionManager)
+
+	// Create template service for reusable session configurations
+	templateService := templates.New(conversationStore, sessionManager)
+
+	// Create maintenance service for retention pruning and vacuuming
+	maintenanceService := maintenance.New(conversationStore, getMaintenanceInterval())
+
+	// Create webhook service for posting daemon events to configured URLs
+	webhookService := webhook.New(conversationStore, eventBus, getWebhookConfig())
+
+	// Create resource monitor for session process trees
+	resourceMonitor := session.NewResourceMonitor(sessionManager, conversationStore, eventBus, getResourceMonitorConfig())
+
 	// Create HTTP server (always enabled, port 0 means dynamic allocation)
 	slog.Info("creating HTTP server", "port", cfg.HTTPPort)
-	httpServer := NewHTTPServer(cfg, sessionManager, approvalManager, conversationStore, eventBus)
+	httpServer := NewHTTPServer(cfg, sessionManager, approvalManager, conversationStore, eventBus, sessionScheduler, pipelineRunner, batchService, templateService, maintenanceService, backupService, webhookService)
 
 	return &Daemon{
-		config:     cfg,
-		socketPath: socketPath,
-		sessions:   sessionManager,
-		approvals:  approvalManager,
-		eventBus:   eventBus,
-		store:      conversationStore,
-		httpServer: httpServer,
+		config:      cfg,
+		socketPath:  socketPath,
+		sessions:    sessionManager,
+		approvals:   approvalManager,
+		eventBus:    eventBus,
+		store:       conversationStore,
+		httpServer:  httpServer,
+		scheduler:   sessionScheduler,
+		pipelines:   pipelineRunner,
+		batches:     batchService,
+		templates:   templateService,
+		maintenance: maintenanceService,
+		backups:     backupService,
+		webhooks:    webhookService,
+		resources:   resourceMonitor,
 	}, nil
 }
 
@@ -202,6 +380,13 @@ func (d *Daemon) Run(ctx context.Context) error {
 		// Don't fail startup for this
 	}
 
+	// Group sessions from before projects existed by repository
+	if d.store != nil {
+		if err := session.BackfillProjects(ctx, d.store); err != nil {
+			slog.Warn("failed to assign projects to existing sessions", "error", err)
+		}
+	}
+
 	// Create and start dangerous skip permissions monitor
 	permissionMonitor := session.NewPermissionMonitor(d.store, d.eventBus, getPermissionMonitorInterval())
 	d.permissionMonitor = permissionMonitor
@@ -212,6 +397,44 @@ func (d *Daemon) Run(ctx context.Context) error {
 	}()
 	slog.Info("started dangerous skip permissions expiry monitor")
 
+	// Start stalled session watchdog in background
+	watchdog := session.NewWatchdog(d.sessions, d.store, d.eventBus, getWatchdogConfig())
+	d.watchdog = watchdog
+	go watchdog.Start(ctx)
+
+	// Start session resource monitor in background
+	if d.resources != nil {
+		go d.resources.Start(ctx)
+	}
+
+	// Start session scheduler in background. Its first pass catches up on
+	// runs missed while the daemon was down.
+	if d.scheduler != nil {
+		go d.scheduler.Start(ctx)
+	}
+
+	// Start pipeline runner in background. Its first pass picks up steps that
+	// finished while the daemon was down.
+	if d.pipelines != nil {
+		go d.pipelines.Start(ctx)
+	}
+
+	// Start database maintenance in background
+	if d.maintenance != nil {
+		go d.maintenance.Start(ctx)
+	}
+
+	// Start scheduled database backups in background
+	if d.backups != nil {
+		go d.backups.Start(ctx)
+	}
+
+	// Start webhook delivery in background. Its first pass retries
+	// deliveries left pending when the daemon stopped.
+	if d.webhooks != nil {
+		go d.webhooks.Start(ctx)
+	}
+
 	// Register subscription handlers
 	subscriptionHandlers := rpc.NewSubscriptionHandlers(d.eventBus)
 	d.rpcServer.SetSubscriptionHandlers(subscriptionHandlers)
@@ -225,6 +448,36 @@ func (d *Daemon) Run(ctx context.Context) error {
 	approvalHandlers := rpc.NewApprovalHandlers(d.approvals, d.sessions)
 	approvalHandlers.Register(d.rpcServer)
 
+	// Register schedule handlers
+	if d.scheduler != nil {
+		scheduleHandlers := rpc.NewScheduleHandlers(d.scheduler)
+		scheduleHandlers.Register(d.rpcServer)
+	}
+
+	// Register pipeline handlers
+	if d.pipelines != nil {
+		pipelineHandlers := rpc.NewPipelineHandlers(d.pipelines)
+		pipelineHandlers.Register(d.rpcServer)
+	}
+
+	// Register batch handlers
+	if d.batches != nil {
+		batchHandlers := rpc.NewBatchHandlers(d.batches)
+		batchHandlers.Register(d.rpcServer)
+	}
+
+	// Register template handlers
+	if d.templates != nil {
+		templateHandlers := rpc.NewTemplateHandlers(d.templates)
+		templateHandlers.Register(d.rpcServer)
+	}
+
+	// Register maintenance handlers
+	if d.maintenance != nil {
+		maintenanceHandlers := rpc.NewMaintenanceHandlers(d.maintenance)
+		maintenanceHandlers.Register(d.rpcServer)
+	}
+
 	// Start HTTP server if enabled
 	if d.httpServer != nil {
 		httpCtx, httpCancel := context.WithCancel(ctx)
@@ -387,6 +640,37 @@ func (d *Daemon) markOrphanedSessionsAsFailed(ctx context.Context) error {
 	return nil
 }
 
+// secretStore is a store that can encrypt the secrets it keeps
+type secretStore interface {
+	EncryptSecrets(ctx context.Context, box store.SecretBox) (int, error)
+}
+
+// encryptSecrets loads the master keys and has st encrypt the secrets it
+// writes. Secrets stored before encryption, or with a key retired since, are
+// encrypted with the current key.
+func encryptSecrets(cfg *config.Config, st secretStore) error {
+	keyFile := cfg.SecretKeyFile
+	if keyFile == "" {
+		keyFile = filepath.Join(filepath.Dir(cfg.DatabasePath), "secret.key")
+	}
+	keys, err := secrets.Open(cfg.SecretKeySource, keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to open secret keys: %w", err)
+	}
+	box, err := secrets.Load(keys)
+	if err != nil {
+		return fmt.Errorf("failed to load secret keys from %s: %w", keys, err)
+	}
+	encrypted, err := st.EncryptSecrets(context.Background(), box)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt stored secrets: %w", err)
+	}
+	if encrypted > 0 {
+		slog.Info("encrypted stored secrets", "count", encrypted, "keys", keys.String())
+	}
+	return nil
+}
+
 // expandPath expands ~ to the user's home directory
 func expandPath(path string) string {
 	if len(path) > 0 && path[0] == '~' {
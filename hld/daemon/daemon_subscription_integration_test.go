@@ -257,6 +257,43 @@ func TestDaemonSubscriptionIntegration(t *testing.T) {
 			t.Error("Timeout waiting for event after reconnect")
 		}
 	})
+
+	t.Run("subscription_resume_since", func(t *testing.T) {
+		c, err := client.New(socketPath)
+		if err != nil {
+			t.Fatalf("Failed to create client: %v", err)
+		}
+		defer c.Close()
+
+		for _, status := range []string{"running", "completed"} {
+			daemon.eventBus.Publish(bus.Event{
+				Type: bus.EventSessionStatusChanged,
+				Data: map[string]interface{}{"session_id": "resume-session", "new_status": status},
+			})
+		}
+
+		// Resume after nothing, so both kept events of the session are replayed
+		since := uint64(0)
+		eventChan, err := c.Subscribe(rpc.SubscribeRequest{SessionID: "resume-session", Since: &since})
+		if err != nil {
+			t.Fatalf("Failed to subscribe: %v", err)
+		}
+
+		var seqs []uint64
+		for len(seqs) < 2 {
+			select {
+			case notification := <-eventChan:
+				if notification.Event.Type == bus.EventSessionStatusChanged {
+					seqs = append(seqs, notification.Event.Seq)
+				}
+			case <-time.After(1 * time.Second):
+				t.Fatalf("Timeout waiting for replayed events, got %v", seqs)
+			}
+		}
+		if seqs[0] == 0 || seqs[1] <= seqs[0] {
+			t.Errorf("Expected increasing sequence numbers, got %v", seqs)
+		}
+	})
 }
 
 func TestDaemonMemoryStability(t *testing.T) {
@@ -0,0 +1,77 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+const (
+	// eventLogTimeout bounds how long publishing an event waits on the database
+	eventLogTimeout = 5 * time.Second
+	// eventLogTrimInterval is how many events are appended between trims, so
+	// the log holds at most keep+eventLogTrimInterval-1 events
+	eventLogTrimInterval = 100
+)
+
+// storeEventLog keeps the event bus's log in the daemon's store, trimmed to
+// the newest keep events every eventLogTrimInterval appends. The bus appends
+// one event at a time, so appended needs no lock.
+type storeEventLog struct {
+	store    store.ConversationStore
+	keep     int
+	appended int
+}
+
+func (l *storeEventLog) Append(event bus.Event) (uint64, error) {
+	data, err := json.Marshal(event.Data)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode event data: %w", err)
+	}
+	entry := &store.EventLogEntry{Type: string(event.Type), Data: string(data), CreatedAt: event.Timestamp}
+
+	ctx, cancel := context.WithTimeout(context.Background(), eventLogTimeout)
+	defer cancel()
+	if err := l.store.AppendEventLog(ctx, entry); err != nil {
+		return 0, err
+	}
+
+	// The event is logged even if trimming fails; the next trim catches up
+	l.appended++
+	if l.appended%eventLogTrimInterval == 0 {
+		if _, err := l.store.TrimEventLog(ctx, l.keep); err != nil {
+			slog.Warn("failed to trim event log", "error", err)
+		}
+	}
+	return uint64(entry.Seq), nil
+}
+
+func (l *storeEventLog) Recent(limit int) ([]bus.Event, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), eventLogTimeout)
+	defer cancel()
+	entries, err := l.store.GetRecentEventLog(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]bus.Event, 0, len(entries))
+	for _, entry := range entries {
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(entry.Data), &data); err != nil {
+			slog.Warn("skipping undecodable event log entry", "seq", entry.Seq, "error", err)
+			continue
+		}
+		events = append(events, bus.Event{
+			Seq:       uint64(entry.Seq),
+			Type:      bus.EventType(entry.Type),
+			Timestamp: entry.CreatedAt,
+			Data:      data,
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,39 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreEventLogTrimsInBatches(t *testing.T) {
+	ctx := context.Background()
+	st := store.NewMemoryStore()
+	log := &storeEventLog{store: st, keep: 10}
+
+	event := bus.Event{Type: bus.EventSessionStatusChanged, Data: map[string]interface{}{"session_id": "sess-1"}}
+	for range eventLogTrimInterval - 1 {
+		_, err := log.Append(event)
+		require.NoError(t, err)
+	}
+	entries, err := st.GetRecentEventLog(ctx, 0)
+	require.NoError(t, err)
+	assert.Len(t, entries, eventLogTrimInterval-1, "the log is not trimmed on every append")
+
+	seq, err := log.Append(event)
+	require.NoError(t, err)
+	entries, err = st.GetRecentEventLog(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 10)
+	assert.Equal(t, int64(seq), entries[9].Seq)
+
+	events, err := log.Recent(5)
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+	assert.Equal(t, seq, events[4].Seq)
+	assert.Equal(t, "sess-1", events[4].Data["session_id"])
+}
@@ -15,11 +15,19 @@ import (
 	"github.com/humanlayer/humanlayer/hld/api"
 	"github.com/humanlayer/humanlayer/hld/api/handlers"
 	"github.com/humanlayer/humanlayer/hld/approval"
+	"github.com/humanlayer/humanlayer/hld/backup"
+	"github.com/humanlayer/humanlayer/hld/batch"
+	"github.com/humanlayer/humanlayer/hld/bundle"
 	"github.com/humanlayer/humanlayer/hld/bus"
 	"github.com/humanlayer/humanlayer/hld/config"
+	"github.com/humanlayer/humanlayer/hld/maintenance"
 	"github.com/humanlayer/humanlayer/hld/mcp"
+	"github.com/humanlayer/humanlayer/hld/pipeline"
+	"github.com/humanlayer/humanlayer/hld/scheduler"
 	"github.com/humanlayer/humanlayer/hld/session"
 	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/humanlayer/humanlayer/hld/templates"
+	"github.com/humanlayer/humanlayer/hld/webhook"
 )
 
 // getHTTPShutdownTimeout returns the timeout for HTTP server graceful shutdown
@@ -38,19 +46,27 @@ func getHTTPShutdownTimeout() time.Duration {
 
 // HTTPServer manages the REST API server
 type HTTPServer struct {
-	config           *config.Config
-	router           *gin.Engine
-	sessionManager   session.SessionManager
-	sessionHandlers  *handlers.SessionHandlers
-	approvalHandlers *handlers.ApprovalHandlers
-	fileHandlers     *handlers.FileHandlers
-	sseHandler       *handlers.SSEHandler
-	proxyHandler     *handlers.ProxyHandler
-	configHandler    *handlers.ConfigHandler
-	settingsHandlers *handlers.SettingsHandlers
-	agentHandlers    *handlers.AgentHandlers
-	approvalManager  approval.Manager
-	eventBus         bus.EventBus
+	config              *config.Config
+	router              *gin.Engine
+	sessionManager      session.SessionManager
+	sessionHandlers     *handlers.SessionHandlers
+	approvalHandlers    *handlers.ApprovalHandlers
+	fileHandlers        *handlers.FileHandlers
+	sseHandler          *handlers.SSEHandler
+	proxyHandler        *handlers.ProxyHandler
+	configHandler       *handlers.ConfigHandler
+	settingsHandlers    *handlers.SettingsHandlers
+	agentHandlers       *handlers.AgentHandlers
+	scheduleHandlers    *handlers.ScheduleHandlers
+	pipelineHandlers    *handlers.PipelineHandlers
+	batchHandlers       *handlers.BatchHandlers
+	templateHandlers    *handlers.TemplateHandlers
+	maintenanceHandlers *handlers.MaintenanceHandlers
+	backupHandlers      *handlers.BackupHandlers
+	bundleHandlers      *handlers.BundleHandlers
+	webhookHandlers     *handlers.WebhookHandlers
+	approvalManager     approval.Manager
+	eventBus            bus.EventBus
 
 	serverMu sync.Mutex
 	server   *http.Server
@@ -63,6 +79,13 @@ func NewHTTPServer(
 	approvalManager approval.Manager,
 	conversationStore store.ConversationStore,
 	eventBus bus.EventBus,
+	sessionScheduler *scheduler.Scheduler,
+	pipelineRunner *pipeline.Runner,
+	batches *batch.Service,
+	sessionTemplates *templates.Service,
+	databaseMaintenance *maintenance.Service,
+	databaseBackups *backup.Service,
+	webhooks *webhook.Service,
 ) *HTTPServer {
 	// Set Gin mode to release
 	gin.SetMode(gin.ReleaseMode)
@@ -89,6 +112,7 @@ func NewHTTPServer(
 
 	// Create handlers
 	sessionHandlers := handlers.NewSessionHandlersWithConfig(sessionManager, conversationStore, approvalManager, cfg)
+	sessionHandlers.SetEventBus(eventBus)
 	approvalHandlers := handlers.NewApprovalHandlers(approvalManager, sessionManager)
 	fileHandlers := handlers.NewFileHandlers()
 	sseHandler := handlers.NewSSEHandler(eventBus)
@@ -96,28 +120,59 @@ func NewHTTPServer(
 	configHandler := handlers.NewConfigHandler()
 	settingsHandlers := handlers.NewSettingsHandlers(conversationStore)
 	agentHandlers := handlers.NewAgentHandlers()
+	scheduleHandlers := handlers.NewScheduleHandlers(sessionScheduler)
+	pipelineHandlers := handlers.NewPipelineHandlers(pipelineRunner)
+	batchHandlers := handlers.NewBatchHandlers(batches)
+	templateHandlers := handlers.NewTemplateHandlers(sessionTemplates)
+	maintenanceHandlers := handlers.NewMaintenanceHandlers(databaseMaintenance)
+	backupHandlers := handlers.NewBackupHandlers(databaseBackups)
+	bundleHandlers := handlers.NewBundleHandlers(bundle.New(conversationStore))
+	webhookHandlers := handlers.NewWebhookHandlers(webhooks)
 
 	return &HTTPServer{
-		config:           cfg,
-		router:           router,
-		sessionManager:   sessionManager,
-		sessionHandlers:  sessionHandlers,
-		approvalHandlers: approvalHandlers,
-		fileHandlers:     fileHandlers,
-		sseHandler:       sseHandler,
-		proxyHandler:     proxyHandler,
-		configHandler:    configHandler,
-		settingsHandlers: settingsHandlers,
-		agentHandlers:    agentHandlers,
-		approvalManager:  approvalManager,
-		eventBus:         eventBus,
+		config:              cfg,
+		router:              router,
+		sessionManager:      sessionManager,
+		sessionHandlers:     sessionHandlers,
+		approvalHandlers:    approvalHandlers,
+		fileHandlers:        fileHandlers,
+		sseHandler:          sseHandler,
+		proxyHandler:        proxyHandler,
+		configHandler:       configHandler,
+		settingsHandlers:    settingsHandlers,
+		agentHandlers:       agentHandlers,
+		scheduleHandlers:    scheduleHandlers,
+		pipelineHandlers:    pipelineHandlers,
+		batchHandlers:       batchHandlers,
+		templateHandlers:    templateHandlers,
+		maintenanceHandlers: maintenanceHandlers,
+		backupHandlers:      backupHandlers,
+		bundleHandlers:      bundleHandlers,
+		webhookHandlers:     webhookHandlers,
+		approvalManager:     approvalManager,
+		eventBus:            eventBus,
 	}
 }
 
 // Start starts the HTTP server
 func (s *HTTPServer) Start(ctx context.Context) error {
 	// Create server implementation combining all handlers
-	serverImpl := handlers.NewServerImpl(s.sessionHandlers, s.approvalHandlers, s.fileHandlers, s.sseHandler, s.settingsHandlers, s.agentHandlers)
+	serverImpl := handlers.NewServerImpl(handlers.Handlers{
+		Sessions:    s.sessionHandlers,
+		Approvals:   s.approvalHandlers,
+		Files:       s.fileHandlers,
+		SSE:         s.sseHandler,
+		Settings:    s.settingsHandlers,
+		Agents:      s.agentHandlers,
+		Schedules:   s.scheduleHandlers,
+		Pipelines:   s.pipelineHandlers,
+		Batches:     s.batchHandlers,
+		Templates:   s.templateHandlers,
+		Maintenance: s.maintenanceHandlers,
+		Backups:     s.backupHandlers,
+		Bundles:     s.bundleHandlers,
+		Webhooks:    s.webhookHandlers,
+	})
 
 	// Create strict handler with middleware
 	strictHandler := api.NewStrictHandler(serverImpl, nil)
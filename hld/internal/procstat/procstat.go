@@ -0,0 +1,162 @@
+// Package procstat reads resource usage for process trees from the Linux /proc
+// filesystem. On systems without /proc every read fails with ErrUnsupported.
+package procstat
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupported is returned when the proc filesystem is not available
+var ErrUnsupported = errors.New("proc filesystem not available")
+
+// clockTicks is the kernel's USER_HZ, which is 100 on every mainstream Linux
+// architecture. The stat file reports CPU times in these units.
+const clockTicks = 100
+
+// Usage is the aggregated resource usage of a process and its descendants
+type Usage struct {
+	CPUTime   time.Duration // User plus system CPU time consumed so far, including by exited descendants
+	RSSBytes  int64         // Resident set size
+	OpenFDs   int64         // Open file descriptors, excluding processes we cannot inspect
+	Processes int           // Number of processes in the tree
+}
+
+// Reader reads process information from a proc filesystem mount
+type Reader struct {
+	// Root is the proc mount point, normally /proc
+	Root string
+	// PageSize is the size in bytes of the pages RSS is reported in
+	PageSize int64
+}
+
+// NewReader creates a Reader for the system /proc
+func NewReader() *Reader {
+	return &Reader{Root: "/proc", PageSize: int64(os.Getpagesize())}
+}
+
+// Supported reports whether the proc filesystem can be read
+func (r *Reader) Supported() bool {
+	_, err := os.Stat(filepath.Join(r.Root, "self", "stat"))
+	return err == nil
+}
+
+// procStat holds the fields of /proc/<pid>/stat we care about
+type procStat struct {
+	pid      int
+	ppid     int
+	cpuTicks int64
+	rssPages int64
+}
+
+// TreeUsage returns the combined usage of rootPID and all of its descendants
+func (r *Reader) TreeUsage(rootPID int) (Usage, error) {
+	if !r.Supported() {
+		return Usage{}, ErrUnsupported
+	}
+
+	entries, err := os.ReadDir(r.Root)
+	if err != nil {
+		return Usage{}, fmt.Errorf("failed to list processes: %w", err)
+	}
+
+	stats := make(map[int]procStat)
+	children := make(map[int][]int)
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		stat, err := r.readStat(pid)
+		if err != nil {
+			// Processes can exit between listing and reading
+			continue
+		}
+		stats[pid] = stat
+		children[stat.ppid] = append(children[stat.ppid], pid)
+	}
+
+	if _, ok := stats[rootPID]; !ok {
+		return Usage{}, fmt.Errorf("process %d not found", rootPID)
+	}
+
+	var usage Usage
+	queue := []int{rootPID}
+	for len(queue) > 0 {
+		pid := queue[0]
+		queue = queue[1:]
+
+		stat := stats[pid]
+		usage.Processes++
+		usage.CPUTime += time.Duration(stat.cpuTicks) * time.Second / clockTicks
+		usage.RSSBytes += stat.rssPages * r.PageSize
+		if fds, err := os.ReadDir(filepath.Join(r.Root, strconv.Itoa(pid), "fd")); err == nil {
+			usage.OpenFDs += int64(len(fds))
+		}
+
+		queue = append(queue, children[pid]...)
+	}
+
+	return usage, nil
+}
+
+// readStat parses /proc/<pid>/stat
+func (r *Reader) readStat(pid int) (procStat, error) {
+	data, err := os.ReadFile(filepath.Join(r.Root, strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return procStat{}, err
+	}
+	return parseStat(pid, string(data))
+}
+
+// parseStat parses the contents of a stat file. The command name is wrapped in
+// parentheses and may itself contain spaces or parentheses, so fields are read
+// from after the last closing parenthesis.
+func parseStat(pid int, data string) (procStat, error) {
+	end := strings.LastIndexByte(data, ')')
+	if end < 0 {
+		return procStat{}, fmt.Errorf("malformed stat for process %d", pid)
+	}
+
+	// Fields after the command name start at field 3 (state)
+	fields := strings.Fields(data[end+1:])
+	if len(fields) < 22 {
+		return procStat{}, fmt.Errorf("malformed stat for process %d", pid)
+	}
+
+	ppid, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return procStat{}, fmt.Errorf("invalid ppid for process %d: %w", pid, err)
+	}
+	utime, err := strconv.ParseInt(fields[11], 10, 64)
+	if err != nil {
+		return procStat{}, fmt.Errorf("invalid utime for process %d: %w", pid, err)
+	}
+	stime, err := strconv.ParseInt(fields[12], 10, 64)
+	if err != nil {
+		return procStat{}, fmt.Errorf("invalid stime for process %d: %w", pid, err)
+	}
+	// cutime and cstime hold the CPU time of children the process has waited
+	// for. The kernel adds a child's time there when it is reaped, so the tree
+	// keeps counting short-lived subprocesses such as compilers without
+	// counting any time twice.
+	cutime, err := strconv.ParseInt(fields[13], 10, 64)
+	if err != nil {
+		return procStat{}, fmt.Errorf("invalid cutime for process %d: %w", pid, err)
+	}
+	cstime, err := strconv.ParseInt(fields[14], 10, 64)
+	if err != nil {
+		return procStat{}, fmt.Errorf("invalid cstime for process %d: %w", pid, err)
+	}
+	rss, err := strconv.ParseInt(fields[21], 10, 64)
+	if err != nil {
+		return procStat{}, fmt.Errorf("invalid rss for process %d: %w", pid, err)
+	}
+
+	return procStat{pid: pid, ppid: ppid, cpuTicks: utime + stime + cutime + cstime, rssPages: rss}, nil
+}
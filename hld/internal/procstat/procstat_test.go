@@ -0,0 +1,111 @@
+package procstat
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeProc creates a fake /proc/<pid> entry with the given stat values and
+// number of open file descriptors
+func writeProc(t *testing.T, root string, pid, ppid int, comm string, utime, stime, rssPages int64, fds int) {
+	t.Helper()
+	writeProcWithChildren(t, root, pid, ppid, comm, utime, stime, 0, 0, rssPages, fds)
+}
+
+// writeProcWithChildren is writeProc for a process that has reaped children
+// which used cutime and cstime
+func writeProcWithChildren(t *testing.T, root string, pid, ppid int, comm string, utime, stime, cutime, cstime, rssPages int64, fds int) {
+	t.Helper()
+	dir := filepath.Join(root, strconv.Itoa(pid))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "fd"), 0755))
+
+	stat := fmt.Sprintf("%d (%s) S %d 1 1 0 -1 4194560 100 0 0 0 %d %d %d %d 20 0 1 0 100 1000000 %d 18446744073709551615\n",
+		pid, comm, ppid, utime, stime, cutime, cstime, rssPages)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "stat"), []byte(stat), 0644))
+
+	for i := 0; i < fds; i++ {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "fd", strconv.Itoa(i)), nil, 0644))
+	}
+}
+
+func TestReader_TreeUsage(t *testing.T) {
+	root := t.TempDir()
+	writeProc(t, root, 1, 0, "init", 0, 0, 10, 0)
+	// The claude CLI and its tool subprocesses
+	writeProc(t, root, 100, 1, "claude", 150, 50, 1000, 20)
+	writeProc(t, root, 200, 100, "bash", 10, 10, 100, 3)
+	writeProc(t, root, 300, 200, "go (build) x", 500, 100, 5000, 12)
+	// An unrelated process
+	writeProc(t, root, 400, 1, "other", 999, 999, 9999, 99)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "self"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "self", "stat"), nil, 0644))
+
+	r := &Reader{Root: root, PageSize: 4096}
+	usage, err := r.TreeUsage(100)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, usage.Processes)
+	assert.Equal(t, 8200*time.Millisecond, usage.CPUTime)
+	assert.Equal(t, int64(6100*4096), usage.RSSBytes)
+	assert.Equal(t, int64(35), usage.OpenFDs)
+
+	_, err = r.TreeUsage(999)
+	assert.Error(t, err)
+}
+
+func TestReader_TreeUsageCountsExitedChildren(t *testing.T) {
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "self"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "self", "stat"), nil, 0644))
+	writeProc(t, root, 100, 1, "claude", 150, 50, 1000, 20)
+	writeProc(t, root, 200, 100, "bash", 10, 10, 100, 3)
+	writeProc(t, root, 300, 200, "compile", 500, 100, 5000, 12)
+
+	r := &Reader{Root: root, PageSize: 4096}
+	before, err := r.TreeUsage(100)
+	require.NoError(t, err)
+	assert.Equal(t, 8200*time.Millisecond, before.CPUTime)
+
+	// The compiler exits and bash reaps it, taking on its CPU time
+	require.NoError(t, os.RemoveAll(filepath.Join(root, "300")))
+	writeProcWithChildren(t, root, 200, 100, "bash", 12, 10, 510, 100, 100, 3)
+
+	after, err := r.TreeUsage(100)
+	require.NoError(t, err)
+	assert.Equal(t, 2, after.Processes)
+	assert.GreaterOrEqual(t, after.CPUTime, before.CPUTime)
+	assert.Equal(t, 8320*time.Millisecond, after.CPUTime)
+}
+
+func TestReader_Unsupported(t *testing.T) {
+	r := &Reader{Root: filepath.Join(t.TempDir(), "missing"), PageSize: 4096}
+	assert.False(t, r.Supported())
+	_, err := r.TreeUsage(1)
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestReader_Self(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("requires /proc")
+	}
+	usage, err := NewReader().TreeUsage(os.Getpid())
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, usage.Processes, 1)
+	assert.Greater(t, usage.RSSBytes, int64(0))
+	assert.Greater(t, usage.OpenFDs, int64(0))
+}
+
+func TestParseStat_Malformed(t *testing.T) {
+	_, err := parseStat(1, "1 (truncated")
+	assert.Error(t, err)
+	_, err = parseStat(1, "1 (short) S 0")
+	assert.Error(t, err)
+}
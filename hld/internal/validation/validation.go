@@ -0,0 +1,25 @@
+// Package validation reports invalid user-supplied definitions, such as
+// schedules, pipelines, batch requests, templates and webhooks, so handlers
+// can tell them apart from internal failures.
+package validation
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error is returned when a field of a definition is invalid
+type Error struct {
+	Field   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.Field, e.Message)
+}
+
+// IsError reports whether err is caused by an invalid definition
+func IsError(err error) bool {
+	var validationErr *Error
+	return errors.As(err, &validationErr)
+}
@@ -0,0 +1,116 @@
+// Package maintenance prunes old data from the daemon database according to
+// the user's retention settings and keeps the database file compact.
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// DefaultVacuumPages bounds how many free pages each background pass returns
+// to the filesystem, 64 MiB with the default 4 KiB page size
+const DefaultVacuumPages = 16384
+
+// ErrInvalidVacuumMode is returned for a vacuum mode other than the store's
+// Vacuum constants
+var ErrInvalidVacuumMode = errors.New("invalid vacuum mode")
+
+// Options configures an on-demand maintenance run
+type Options struct {
+	DryRun bool
+	Vacuum string // See the store Vacuum constants, empty means incremental
+}
+
+// Service runs database maintenance in the background and on demand
+type Service struct {
+	store       store.ConversationStore
+	interval    time.Duration
+	vacuumPages int
+
+	// runMu keeps background and on-demand passes from overlapping
+	runMu sync.Mutex
+}
+
+// New creates a maintenance service that runs a pass every interval. A zero
+// interval disables the background job, leaving on-demand runs.
+func New(store store.ConversationStore, interval time.Duration) *Service {
+	return &Service{
+		store:       store,
+		interval:    interval,
+		vacuumPages: DefaultVacuumPages,
+	}
+}
+
+// Start runs a maintenance pass every interval until ctx is cancelled
+func (s *Service) Start(ctx context.Context) {
+	if s.interval <= 0 {
+		slog.Info("database maintenance disabled")
+		return
+	}
+	slog.Info("starting database maintenance", "interval", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("database maintenance shutting down")
+			return
+		case <-ticker.C:
+			if _, err := s.Run(ctx, Options{}); err != nil && ctx.Err() == nil {
+				slog.Error("database maintenance failed", "error", err)
+			}
+		}
+	}
+}
+
+// Run applies the retention settings and vacuums the database. Dry runs
+// report what would be pruned without changing anything.
+func (s *Service) Run(ctx context.Context, opts Options) (*store.MaintenanceReport, error) {
+	switch opts.Vacuum {
+	case "":
+		opts.Vacuum = store.VacuumIncremental
+	case store.VacuumNone, store.VacuumIncremental, store.VacuumFull:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVacuumMode, opts.Vacuum)
+	}
+
+	settings, err := s.store.GetUserSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get retention settings: %w", err)
+	}
+
+	s.runMu.Lock()
+	defer s.runMu.Unlock()
+
+	report, err := s.store.RunMaintenance(ctx, store.MaintenanceOptions{
+		Retention:   settings.RetentionPolicy(),
+		DryRun:      opts.DryRun,
+		Vacuum:      opts.Vacuum,
+		VacuumPages: s.vacuumPages,
+		Analyze:     true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !report.DryRun {
+		slog.Info("database maintenance completed",
+			"raw_events", report.RawEvents.Rows,
+			"archived_sessions", report.ArchivedSessions.Rows,
+			"expired_file_snapshots", report.ExpiredFileSnapshots.Rows,
+			"duplicate_file_snapshots", report.DuplicateFileSnapshots.Rows,
+			"vacuum", report.Vacuum,
+			"reclaimed_bytes", report.ReclaimedBytes,
+			"free_bytes", report.FreeBytes,
+			"duration", report.CompletedAt.Sub(report.StartedAt))
+	}
+	return report, nil
+}
@@ -0,0 +1,76 @@
+package maintenance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestService_RunAppliesRetentionSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := store.NewMockConversationStore(ctrl)
+	s := New(mockStore, 0)
+
+	mockStore.EXPECT().GetUserSettings(gomock.Any()).Return(&store.UserSettings{
+		RawEventRetentionDays:     7,
+		FileSnapshotRetentionDays: 30,
+		DedupeFileSnapshots:       true,
+	}, nil).Times(2)
+
+	// On-demand runs default to an incremental vacuum within the page budget
+	mockStore.EXPECT().RunMaintenance(gomock.Any(), store.MaintenanceOptions{
+		Retention: store.RetentionPolicy{
+			RawEventTTL:         7 * 24 * time.Hour,
+			FileSnapshotTTL:     30 * 24 * time.Hour,
+			DedupeFileSnapshots: true,
+		},
+		Vacuum:      store.VacuumIncremental,
+		VacuumPages: DefaultVacuumPages,
+		Analyze:     true,
+	}).Return(&store.MaintenanceReport{Vacuum: store.VacuumIncremental}, nil)
+
+	report, err := s.Run(context.Background(), Options{})
+	require.NoError(t, err)
+	assert.Equal(t, store.VacuumIncremental, report.Vacuum)
+
+	mockStore.EXPECT().RunMaintenance(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, opts store.MaintenanceOptions) (*store.MaintenanceReport, error) {
+			assert.True(t, opts.DryRun)
+			assert.Equal(t, store.VacuumFull, opts.Vacuum)
+			return &store.MaintenanceReport{DryRun: true, Vacuum: store.VacuumNone}, nil
+		})
+
+	report, err = s.Run(context.Background(), Options{DryRun: true, Vacuum: store.VacuumFull})
+	require.NoError(t, err)
+	assert.True(t, report.DryRun)
+}
+
+func TestService_RunRejectsUnknownVacuumMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	s := New(store.NewMockConversationStore(ctrl), 0)
+
+	_, err := s.Run(context.Background(), Options{Vacuum: "aggressive"})
+	assert.ErrorIs(t, err, ErrInvalidVacuumMode)
+}
+
+func TestService_StartDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	s := New(store.NewMockConversationStore(ctrl), 0)
+
+	// Returns immediately without touching the store
+	done := make(chan struct{})
+	go func() {
+		s.Start(context.Background())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Start did not return for a disabled service")
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/humanlayer/humanlayer/hld/approval"
 	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
@@ -23,12 +24,22 @@ const (
 	sessionIDKey contextKey = "session_id"
 )
 
+// approvalListenerBufferSize is how many approval resolutions the listener
+// buffers before the bus disconnects it and it resumes from the event log
+const approvalListenerBufferSize = 1000
+
 // ApprovalDecision represents the outcome of an approval request
 type ApprovalDecision struct {
 	Approved bool
 	Comment  string
 }
 
+// pendingApproval is an approval request waiting for its decision
+type pendingApproval struct {
+	approvalID string
+	decisions  chan ApprovalDecision
+}
+
 // MCPServer wraps the mark3labs MCP server
 type MCPServer struct {
 	mcpServer        *server.MCPServer
@@ -36,7 +47,7 @@ type MCPServer struct {
 	approvalManager  approval.Manager
 	eventBus         bus.EventBus
 	autoDenyAll      bool
-	pendingApprovals sync.Map // map[string]chan ApprovalDecision
+	pendingApprovals sync.Map // map[string]*pendingApproval
 }
 
 // NewMCPServer creates the full MCP server implementation
@@ -164,13 +175,19 @@ func (s *MCPServer) handleRequestApproval(ctx context.Context, request mcp.CallT
 	}
 
 	// Register for event-driven approval resolution
-	decisionChan := make(chan ApprovalDecision, 1)
-	s.pendingApprovals.Store(toolUseID, decisionChan)
+	pending := &pendingApproval{
+		approvalID: approval.ID,
+		decisions:  make(chan ApprovalDecision, 1),
+	}
+	s.pendingApprovals.Store(toolUseID, pending)
 	defer s.pendingApprovals.Delete(toolUseID)
 
+	// The approval may have been resolved before it was registered
+	s.reconcileApproval(ctx, toolUseID, pending)
+
 	// Wait for approval decision
 	select {
-	case decision := <-decisionChan:
+	case decision := <-pending.decisions:
 		responseData := map[string]interface{}{
 			"behavior": "deny",
 			"message":  decision.Comment,
@@ -219,42 +236,112 @@ func (s *MCPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	s.httpServer.ServeHTTP(w, r)
 }
 
-// listenForApprovalDecisions listens for approval resolution events and notifies waiting handlers
+// listenForApprovalDecisions listens for approval resolution events and notifies waiting handlers.
+// Resolutions must never be lost, so rather than dropping events when it falls
+// behind, the listener is disconnected and resumes after the last event it
+// received, falling back to the stored approvals when the events are gone.
 func (s *MCPServer) listenForApprovalDecisions(ctx context.Context) {
-	sub := s.eventBus.Subscribe(ctx, bus.EventFilter{
-		Types: []bus.EventType{bus.EventApprovalResolved},
-	})
+	opts := bus.SubscribeOptions{
+		BufferSize: approvalListenerBufferSize,
+		Overflow:   bus.OverflowDisconnect,
+	}
+	for {
+		sub := s.eventBus.SubscribeWith(ctx, bus.EventFilter{
+			Types: []bus.EventType{bus.EventApprovalResolved},
+		}, opts)
+		if opts.Since != nil {
+			// Decisions published while disconnected and no longer kept
+			s.reconcileApprovals(ctx)
+		}
 
+		lastSeq, ok := s.receiveApprovalDecisions(ctx, sub)
+		if !ok {
+			return
+		}
+		slog.Warn("MCP approval listener fell behind, resuming", "since", lastSeq)
+		opts.Since = &lastSeq
+	}
+}
+
+// receiveApprovalDecisions delivers the decisions received by sub until it is
+// closed. It returns the sequence of the last event received, and false when
+// the listener is shutting down.
+func (s *MCPServer) receiveApprovalDecisions(ctx context.Context, sub *bus.Subscriber) (uint64, bool) {
+	var lastSeq uint64
 	for {
 		select {
 		case <-ctx.Done():
 			slog.Info("MCP approval listener shutting down")
-			return
+			return 0, false
 		case event, ok := <-sub.Channel:
 			if !ok {
-				slog.Info("MCP approval listener channel closed")
-				return
+				if ctx.Err() != nil {
+					slog.Info("MCP approval listener channel closed")
+					return 0, false
+				}
+				return lastSeq, true
+			}
+			if event.Seq > 0 {
+				lastSeq = event.Seq
+			}
+			if event.Type == bus.EventReplayGap {
+				s.reconcileApprovals(ctx)
+				continue
 			}
-			toolUseID, _ := event.Data["tool_use_id"].(string)
-			approved, _ := event.Data["approved"].(bool)
-			comment, _ := event.Data["response_text"].(string)
 
-			if toolUseID == "" {
+			resolved, err := bus.DecodePayload[bus.ApprovalResolvedPayload](event)
+			if err != nil {
+				slog.Warn("Failed to decode approval resolution", "error", err)
+				continue
+			}
+			if resolved.ToolUseID == "" {
 				continue
 			}
 
 			// Find pending approval channel
-			if ch, ok := s.pendingApprovals.Load(toolUseID); ok {
-				select {
-				case ch.(chan ApprovalDecision) <- ApprovalDecision{
-					Approved: approved,
-					Comment:  comment,
-				}:
-					slog.Info("Sent approval decision", "tool_use_id", toolUseID, "approved", approved)
-				default:
-					slog.Warn("Channel full or closed", "tool_use_id", toolUseID)
-				}
+			if pending, ok := s.pendingApprovals.Load(resolved.ToolUseID); ok {
+				pending.(*pendingApproval).deliver(resolved.ToolUseID, ApprovalDecision{
+					Approved: resolved.Approved,
+					Comment:  resolved.ResponseText,
+				})
 			}
 		}
 	}
 }
+
+// reconcileApprovals delivers the decisions of pending approvals that were
+// resolved without the listener receiving the event
+func (s *MCPServer) reconcileApprovals(ctx context.Context) {
+	s.pendingApprovals.Range(func(key, value any) bool {
+		s.reconcileApproval(ctx, key.(string), value.(*pendingApproval))
+		return true
+	})
+}
+
+// reconcileApproval delivers the decision of a pending approval if it is
+// already resolved
+func (s *MCPServer) reconcileApproval(ctx context.Context, toolUseID string, pending *pendingApproval) {
+	approval, err := s.approvalManager.GetApproval(ctx, pending.approvalID)
+	if err != nil {
+		slog.Warn("Failed to check approval status", "approval_id", pending.approvalID, "error", err)
+		return
+	}
+	switch approval.Status {
+	case store.ApprovalStatusLocalApproved, store.ApprovalStatusLocalDenied:
+		pending.deliver(toolUseID, ApprovalDecision{
+			Approved: approval.Status == store.ApprovalStatusLocalApproved,
+			Comment:  approval.Comment,
+		})
+	}
+}
+
+// deliver sends the decision to the waiting handler. A decision that is
+// already waiting, from the event or a reconciliation, is kept.
+func (p *pendingApproval) deliver(toolUseID string, decision ApprovalDecision) {
+	select {
+	case p.decisions <- decision:
+		slog.Info("Sent approval decision", "tool_use_id", toolUseID, "approved", decision.Approved)
+	default:
+		slog.Debug("Approval decision already sent", "tool_use_id", toolUseID)
+	}
+}
@@ -0,0 +1,115 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/approval"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func approvalRequest(toolUseID string) mcp.CallToolRequest {
+	return mcp.CallToolRequest{
+		Params: mcp.CallToolParams{
+			Name: "request_approval",
+			Arguments: map[string]any{
+				"tool_name":   "Bash",
+				"input":       map[string]any{"command": "ls"},
+				"tool_use_id": toolUseID,
+			},
+		},
+	}
+}
+
+func TestHandleRequestApproval_ResolvedBeforeRegistration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := approval.NewMockManager(ctrl)
+	s := NewMCPServer(mockManager, bus.NewEventBus())
+
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), sessionIDKey, "sess-1"), 5*time.Second)
+	defer cancel()
+
+	// The approval is denied before the handler registers for its decision,
+	// so the event is published while nobody waits for it
+	mockManager.EXPECT().
+		CreateApprovalWithToolUseID(gomock.Any(), "sess-1", "Bash", gomock.Any(), "tool-1").
+		Return(&store.Approval{ID: "appr-1", Status: store.ApprovalStatusLocalPending}, nil)
+	mockManager.EXPECT().
+		GetApproval(gomock.Any(), "appr-1").
+		Return(&store.Approval{ID: "appr-1", Status: store.ApprovalStatusLocalDenied, Comment: "not now"}, nil)
+
+	result, err := s.handleRequestApproval(ctx, approvalRequest("tool-1"))
+	require.NoError(t, err)
+	require.Len(t, result.Content, 1)
+	assert.JSONEq(t, `{"behavior":"deny","message":"not now"}`, result.Content[0].(mcp.TextContent).Text)
+}
+
+func TestListenForApprovalDecisions_ResumesAfterDisconnect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := approval.NewMockManager(ctrl)
+	mockBus := bus.NewMockEventBus(ctrl)
+	s := NewMCPServer(mockManager, mockBus)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pending := &pendingApproval{approvalID: "appr-2", decisions: make(chan ApprovalDecision, 1)}
+	s.pendingApprovals.Store("tool-2", pending)
+
+	// The first subscription receives one event and is then disconnected
+	first := &bus.Subscriber{Channel: make(chan bus.Event, 1)}
+	first.Channel <- bus.Event{Seq: 5, Type: bus.EventApprovalResolved, Data: map[string]interface{}{"tool_use_id": "tool-1"}}
+	close(first.Channel)
+	// The resumed subscription replays the decision published meanwhile
+	resumed := &bus.Subscriber{Channel: make(chan bus.Event, 1)}
+	resumed.Channel <- bus.Event{Seq: 6, Type: bus.EventApprovalResolved, Data: map[string]interface{}{
+		"tool_use_id":   "tool-2",
+		"approved":      true,
+		"response_text": "go ahead",
+	}}
+
+	gomock.InOrder(
+		mockBus.EXPECT().SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ bus.EventFilter, opts bus.SubscribeOptions) *bus.Subscriber {
+				assert.Equal(t, bus.OverflowDisconnect, opts.Overflow)
+				assert.Nil(t, opts.Since)
+				return first
+			}),
+		mockBus.EXPECT().SubscribeWith(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ bus.EventFilter, opts bus.SubscribeOptions) *bus.Subscriber {
+				if assert.NotNil(t, opts.Since) {
+					assert.Equal(t, uint64(5), *opts.Since)
+				}
+				return resumed
+			}),
+	)
+	mockManager.EXPECT().
+		GetApproval(gomock.Any(), "appr-2").
+		Return(&store.Approval{ID: "appr-2", Status: store.ApprovalStatusLocalPending}, nil)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.listenForApprovalDecisions(ctx)
+	}()
+
+	select {
+	case decision := <-pending.decisions:
+		assert.Equal(t, ApprovalDecision{Approved: true, Comment: "go ahead"}, decision)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the approval decision")
+	}
+
+	cancel()
+	<-done
+}
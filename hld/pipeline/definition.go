@@ -0,0 +1,186 @@
+// Package pipeline runs multi-step session pipelines, where each step is a
+// session that either continues the previous step's session or starts fresh
+// with the previous step's result as context.
+package pipeline
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// Step modes
+const (
+	// ModeContinue resumes the previous step's session with the step prompt
+	ModeContinue = "continue"
+	// ModeFresh launches a new session seeded with the previous step's result
+	ModeFresh = "fresh"
+)
+
+// Step conditions, evaluated against the outcome of the previous executed step
+const (
+	WhenSuccess = "success"
+	WhenFailure = "failure"
+	WhenAlways  = "always"
+)
+
+// PreviousResultPlaceholder is replaced with the previous step's result in
+// the prompt of a fresh step
+const PreviousResultPlaceholder = "{{previous_result}}"
+
+// Definition describes a pipeline. Pipeline-level settings are defaults that
+// individual steps can override.
+type Definition struct {
+	Name            string   `json:"name" yaml:"name"`
+	Description     string   `json:"description,omitempty" yaml:"description,omitempty"`
+	WorkingDir      string   `json:"working_dir,omitempty" yaml:"working_dir,omitempty"`
+	Model           string   `json:"model,omitempty" yaml:"model,omitempty"`
+	AllowedTools    []string `json:"allowed_tools,omitempty" yaml:"allowed_tools,omitempty"`
+	AutoAcceptEdits bool     `json:"auto_accept_edits,omitempty" yaml:"auto_accept_edits,omitempty"`
+	Steps           []Step   `json:"steps" yaml:"steps"`
+}
+
+// Step is a single session in a pipeline
+type Step struct {
+	Name               string   `json:"name" yaml:"name"`
+	Prompt             string   `json:"prompt" yaml:"prompt"`
+	Mode               string   `json:"mode,omitempty" yaml:"mode,omitempty"`
+	Model              string   `json:"model,omitempty" yaml:"model,omitempty"`
+	AllowedTools       []string `json:"allowed_tools,omitempty" yaml:"allowed_tools,omitempty"`
+	DisallowedTools    []string `json:"disallowed_tools,omitempty" yaml:"disallowed_tools,omitempty"`
+	MaxTurns           int      `json:"max_turns,omitempty" yaml:"max_turns,omitempty"`
+	MaxCostUSD         float64  `json:"max_cost_usd,omitempty" yaml:"max_cost_usd,omitempty"`
+	SystemPrompt       string   `json:"system_prompt,omitempty" yaml:"system_prompt,omitempty"`
+	AppendSystemPrompt string   `json:"append_system_prompt,omitempty" yaml:"append_system_prompt,omitempty"`
+	// When selects which outcome of the previous step runs this step:
+	// success (default), failure or always
+	When string `json:"when,omitempty" yaml:"when,omitempty"`
+	// OutputMatches additionally requires the previous step's result to match
+	// this regular expression
+	OutputMatches string `json:"output_matches,omitempty" yaml:"output_matches,omitempty"`
+}
+
+// ParseDefinition parses a YAML or JSON pipeline definition, fills in step
+// defaults and validates it
+func ParseDefinition(data []byte) (*Definition, error) {
+	var def Definition
+	if err := yaml.Unmarshal(data, &def); err != nil {
+		return nil, &validation.Error{Field: "definition", Message: err.Error()}
+	}
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// Validate fills in step defaults and checks the definition
+func (d *Definition) Validate() error {
+	if strings.TrimSpace(d.Name) == "" {
+		return &validation.Error{Field: "name", Message: "must not be empty"}
+	}
+	if err := validateModel("model", d.Model); err != nil {
+		return err
+	}
+	if len(d.Steps) == 0 {
+		return &validation.Error{Field: "steps", Message: "must contain at least one step"}
+	}
+
+	for i := range d.Steps {
+		step := &d.Steps[i]
+		field := fmt.Sprintf("steps[%d]", i)
+
+		if step.Name == "" {
+			step.Name = fmt.Sprintf("step-%d", i+1)
+		}
+		if step.Mode == "" {
+			step.Mode = ModeFresh
+		}
+		if step.When == "" {
+			step.When = WhenSuccess
+		}
+
+		if strings.TrimSpace(step.Prompt) == "" {
+			return &validation.Error{Field: field + ".prompt", Message: "must not be empty"}
+		}
+		switch step.Mode {
+		case ModeContinue, ModeFresh:
+		default:
+			return &validation.Error{Field: field + ".mode", Message: fmt.Sprintf("unknown mode %q", step.Mode)}
+		}
+		switch step.When {
+		case WhenSuccess, WhenFailure, WhenAlways:
+		default:
+			return &validation.Error{Field: field + ".when", Message: fmt.Sprintf("unknown condition %q", step.When)}
+		}
+		if step.OutputMatches != "" {
+			if _, err := regexp.Compile(step.OutputMatches); err != nil {
+				return &validation.Error{Field: field + ".output_matches", Message: err.Error()}
+			}
+		}
+		if err := validateModel(field+".model", step.Model); err != nil {
+			return err
+		}
+		if step.MaxTurns < 0 {
+			return &validation.Error{Field: field + ".max_turns", Message: "must not be negative"}
+		}
+		if step.MaxCostUSD < 0 {
+			return &validation.Error{Field: field + ".max_cost_usd", Message: "must not be negative"}
+		}
+	}
+	return nil
+}
+
+// shouldRun reports whether a step's condition holds given the outcome of the
+// previous executed step. The first step always runs.
+func (s *Step) shouldRun(prev *outcome) (bool, string) {
+	if prev == nil {
+		return true, ""
+	}
+
+	switch s.When {
+	case WhenSuccess:
+		if !prev.succeeded {
+			return false, "previous step did not succeed"
+		}
+	case WhenFailure:
+		if prev.succeeded {
+			return false, "previous step succeeded"
+		}
+	}
+
+	if s.OutputMatches != "" {
+		re, err := regexp.Compile(s.OutputMatches)
+		if err != nil {
+			return false, fmt.Sprintf("invalid output_matches: %v", err)
+		}
+		if !re.MatchString(prev.result) {
+			return false, fmt.Sprintf("previous result does not match %q", s.OutputMatches)
+		}
+	}
+	return true, ""
+}
+
+// freshPrompt builds the prompt for a fresh step, substituting the previous
+// step's result or appending it when the prompt has no placeholder
+func (s *Step) freshPrompt(previousResult string) string {
+	if strings.Contains(s.Prompt, PreviousResultPlaceholder) {
+		return strings.ReplaceAll(s.Prompt, PreviousResultPlaceholder, previousResult)
+	}
+	if previousResult == "" {
+		return s.Prompt
+	}
+	return s.Prompt + "\n\nResult of the previous step:\n\n" + previousResult
+}
+
+func validateModel(field, model string) error {
+	switch claudecode.Model(model) {
+	case "", claudecode.ModelOpus, claudecode.ModelSonnet, claudecode.ModelHaiku:
+		return nil
+	default:
+		return &validation.Error{Field: field, Message: fmt.Sprintf("unknown model %q", model)}
+	}
+}
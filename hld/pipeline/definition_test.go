@@ -0,0 +1,114 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDefinition_YAML(t *testing.T) {
+	def, err := ParseDefinition([]byte(`
+name: implement-and-review
+working_dir: /tmp/project
+model: sonnet
+steps:
+  - name: implement
+    prompt: Implement the feature
+    max_cost_usd: 2.5
+  - prompt: Review your changes
+    mode: continue
+    model: opus
+  - name: fix
+    prompt: "Fix this: {{previous_result}}"
+    when: failure
+    output_matches: "(?i)error"
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "implement-and-review", def.Name)
+	require.Len(t, def.Steps, 3)
+	assert.Equal(t, ModeFresh, def.Steps[0].Mode)
+	assert.Equal(t, WhenSuccess, def.Steps[0].When)
+	assert.Equal(t, 2.5, def.Steps[0].MaxCostUSD)
+	assert.Equal(t, "step-2", def.Steps[1].Name)
+	assert.Equal(t, ModeContinue, def.Steps[1].Mode)
+	assert.Equal(t, "opus", def.Steps[1].Model)
+	assert.Equal(t, WhenFailure, def.Steps[2].When)
+}
+
+func TestParseDefinition_JSON(t *testing.T) {
+	def, err := ParseDefinition([]byte(`{"name":"p","steps":[{"prompt":"a"},{"prompt":"b","mode":"continue"}]}`))
+	require.NoError(t, err)
+	require.Len(t, def.Steps, 2)
+	assert.Equal(t, ModeContinue, def.Steps[1].Mode)
+}
+
+func TestParseDefinition_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		field string
+	}{
+		{"malformed", "name: [", "definition"},
+		{"missing name", `{"steps":[{"prompt":"a"}]}`, "name"},
+		{"no steps", `{"name":"p"}`, "steps"},
+		{"empty prompt", `{"name":"p","steps":[{"prompt":" "}]}`, "steps[0].prompt"},
+		{"bad mode", `{"name":"p","steps":[{"prompt":"a","mode":"fork"}]}`, "steps[0].mode"},
+		{"bad when", `{"name":"p","steps":[{"prompt":"a","when":"sometimes"}]}`, "steps[0].when"},
+		{"bad regex", `{"name":"p","steps":[{"prompt":"a","output_matches":"("}]}`, "steps[0].output_matches"},
+		{"bad model", `{"name":"p","steps":[{"prompt":"a","model":"gpt"}]}`, "steps[0].model"},
+		{"negative budget", `{"name":"p","steps":[{"prompt":"a","max_cost_usd":-1}]}`, "steps[0].max_cost_usd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDefinition([]byte(tt.input))
+			require.Error(t, err)
+			var validationErr *validation.Error
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestStep_ShouldRun(t *testing.T) {
+	success := &outcome{succeeded: true, result: "all tests pass"}
+	failure := &outcome{succeeded: false, result: "build error"}
+
+	tests := []struct {
+		name string
+		step Step
+		prev *outcome
+		want bool
+	}{
+		{"first step always runs", Step{When: WhenFailure}, nil, true},
+		{"success after success", Step{When: WhenSuccess}, success, true},
+		{"success after failure", Step{When: WhenSuccess}, failure, false},
+		{"failure after failure", Step{When: WhenFailure}, failure, true},
+		{"failure after success", Step{When: WhenFailure}, success, false},
+		{"always after failure", Step{When: WhenAlways}, failure, true},
+		{"output matches", Step{When: WhenAlways, OutputMatches: "tests pass"}, success, true},
+		{"output does not match", Step{When: WhenAlways, OutputMatches: "^FAIL"}, success, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, reason := tt.step.shouldRun(tt.prev)
+			assert.Equal(t, tt.want, got)
+			if !got {
+				assert.NotEmpty(t, reason)
+			}
+		})
+	}
+}
+
+func TestStep_FreshPrompt(t *testing.T) {
+	step := Step{Prompt: "Review: {{previous_result}}"}
+	assert.Equal(t, "Review: done", step.freshPrompt("done"))
+
+	step = Step{Prompt: "Review the change"}
+	assert.Equal(t, "Review the change", step.freshPrompt(""))
+	assert.Equal(t, "Review the change\n\nResult of the previous step:\n\ndone", step.freshPrompt("done"))
+}
@@ -0,0 +1,514 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// outcome is the result of an executed pipeline step
+type outcome struct {
+	succeeded bool
+	result    string
+	sessionID string
+}
+
+// Runner starts pipeline runs and advances them as their step sessions finish
+type Runner struct {
+	store    store.ConversationStore
+	sessions session.SessionManager
+	eventBus bus.EventBus
+	interval time.Duration
+	now      func() time.Time
+
+	// mu serializes advancing runs with pause and resume requests
+	mu sync.Mutex
+}
+
+// New creates a pipeline runner. Runs are re-checked every interval and
+// whenever a session changes status on the event bus.
+func New(store store.ConversationStore, sessions session.SessionManager, eventBus bus.EventBus, interval time.Duration) *Runner {
+	if interval <= 0 {
+		interval = 10 * time.Second
+	}
+	return &Runner{
+		store:    store,
+		sessions: sessions,
+		eventBus: eventBus,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start advances running pipelines until ctx is cancelled. Failed sessions do
+// not always publish status events, so runs are also polled on a ticker.
+func (r *Runner) Start(ctx context.Context) {
+	slog.Info("starting pipeline runner", "interval", r.interval)
+
+	var events <-chan bus.Event
+	if r.eventBus != nil {
+		sub := r.eventBus.Subscribe(ctx, bus.EventFilter{
+			Types: []bus.EventType{bus.EventSessionStatusChanged},
+		})
+		defer r.eventBus.Unsubscribe(sub.ID)
+		events = sub.Channel
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	r.AdvanceAll(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("pipeline runner shutting down")
+			return
+		case <-ticker.C:
+			r.AdvanceAll(ctx)
+		case _, ok := <-events:
+			if !ok {
+				events = nil
+				continue
+			}
+			r.AdvanceAll(ctx)
+		}
+	}
+}
+
+// AdvanceAll advances every running pipeline
+func (r *Runner) AdvanceAll(ctx context.Context) {
+	if r.store == nil {
+		return
+	}
+
+	runs, err := r.store.ListPipelineRuns(ctx)
+	if err != nil {
+		slog.Error("failed to list pipeline runs", "error", err)
+		return
+	}
+
+	for _, run := range runs {
+		if run.Status != store.PipelineRunStatusRunning {
+			continue
+		}
+		if err := r.Advance(ctx, run.ID); err != nil {
+			slog.Error("failed to advance pipeline run",
+				"pipeline_run_id", run.ID,
+				"name", run.Name,
+				"error", err)
+		}
+	}
+}
+
+// StartRun creates a pipeline run from a validated definition and launches
+// its first step. workingDir overrides the definition's working directory.
+func (r *Runner) StartRun(ctx context.Context, def *Definition, workingDir string) (*store.PipelineRun, error) {
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	if workingDir == "" {
+		workingDir = def.WorkingDir
+	}
+	if workingDir == "" {
+		return nil, &validation.Error{Field: "working_dir", Message: "must be set on the request or the definition"}
+	}
+
+	data, err := json.Marshal(def)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode pipeline definition: %w", err)
+	}
+
+	run := &store.PipelineRun{
+		ID:         uuid.New().String(),
+		Name:       def.Name,
+		Definition: string(data),
+		WorkingDir: workingDir,
+		Status:     store.PipelineRunStatusRunning,
+	}
+	if err := r.store.CreatePipelineRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	slog.Info("started pipeline run",
+		"pipeline_run_id", run.ID,
+		"name", run.Name,
+		"steps", len(def.Steps))
+
+	if err := r.Advance(ctx, run.ID); err != nil {
+		return nil, err
+	}
+	return r.store.GetPipelineRun(ctx, run.ID)
+}
+
+// Pause stops a running pipeline from launching further steps. A step that is
+// already running is left to finish.
+func (r *Runner) Pause(ctx context.Context, id string) (*store.PipelineRun, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run, err := r.store.GetPipelineRun(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if run.Status != store.PipelineRunStatusRunning {
+		return nil, &validation.Error{Field: "status", Message: fmt.Sprintf("cannot pause a %s pipeline run", run.Status)}
+	}
+
+	status := store.PipelineRunStatusPaused
+	if err := r.store.UpdatePipelineRun(ctx, id, store.PipelineRunUpdate{Status: &status}); err != nil {
+		return nil, err
+	}
+	return r.store.GetPipelineRun(ctx, id)
+}
+
+// Resume continues a paused pipeline from where it stopped
+func (r *Runner) Resume(ctx context.Context, id string) (*store.PipelineRun, error) {
+	r.mu.Lock()
+	run, err := r.store.GetPipelineRun(ctx, id)
+	if err != nil {
+		r.mu.Unlock()
+		return nil, err
+	}
+	if run.Status != store.PipelineRunStatusPaused {
+		r.mu.Unlock()
+		return nil, &validation.Error{Field: "status", Message: fmt.Sprintf("cannot resume a %s pipeline run", run.Status)}
+	}
+
+	status := store.PipelineRunStatusRunning
+	err = r.store.UpdatePipelineRun(ctx, id, store.PipelineRunUpdate{Status: &status})
+	r.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Advance(ctx, id); err != nil {
+		return nil, err
+	}
+	return r.store.GetPipelineRun(ctx, id)
+}
+
+// GetRun returns a pipeline run and its recorded steps
+func (r *Runner) GetRun(ctx context.Context, id string) (*store.PipelineRun, []*store.PipelineStep, error) {
+	run, err := r.store.GetPipelineRun(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	steps, err := r.store.GetPipelineSteps(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return run, steps, nil
+}
+
+// ListRuns returns all pipeline runs, newest first
+func (r *Runner) ListRuns(ctx context.Context) ([]*store.PipelineRun, error) {
+	return r.store.ListPipelineRuns(ctx)
+}
+
+// Advance moves a running pipeline forward as far as possible: it records the
+// outcome of a finished step, evaluates the following steps' conditions and
+// launches the next step that should run.
+func (r *Runner) Advance(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	run, err := r.store.GetPipelineRun(ctx, id)
+	if err != nil {
+		return err
+	}
+	if run.Status != store.PipelineRunStatusRunning {
+		return nil
+	}
+
+	var def Definition
+	if err := json.Unmarshal([]byte(run.Definition), &def); err != nil {
+		return r.failRun(ctx, run, fmt.Sprintf("invalid stored definition: %v", err))
+	}
+
+	records, err := r.store.GetPipelineSteps(ctx, run.ID)
+	if err != nil {
+		return err
+	}
+	recorded := make(map[int]*store.PipelineStep, len(records))
+	for _, rec := range records {
+		recorded[rec.StepIndex] = rec
+	}
+
+	var prev *outcome
+	for i := 0; i < run.CurrentStep && i < len(def.Steps); i++ {
+		if rec := recorded[i]; rec != nil {
+			if o := r.outcomeOf(ctx, rec); o != nil {
+				prev = o
+			}
+		}
+	}
+
+	for run.CurrentStep < len(def.Steps) {
+		index := run.CurrentStep
+		step := &def.Steps[index]
+		rec := recorded[index]
+
+		if rec != nil && rec.Status == store.PipelineStepStatusRunning {
+			done, err := r.checkRunningStep(ctx, step, rec)
+			if err != nil {
+				return err
+			}
+			if !done {
+				return nil
+			}
+			if o := r.outcomeOf(ctx, rec); o != nil {
+				prev = o
+			}
+			if err := r.setCurrentStep(ctx, run, index+1); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rec = &store.PipelineStep{
+			RunID:     run.ID,
+			StepIndex: index,
+			Name:      step.Name,
+			Status:    store.PipelineStepStatusPending,
+		}
+
+		if ok, reason := step.shouldRun(prev); !ok {
+			now := r.now()
+			rec.Status = store.PipelineStepStatusSkipped
+			rec.Reason = reason
+			rec.CompletedAt = &now
+			if err := r.store.SavePipelineStep(ctx, rec); err != nil {
+				return err
+			}
+			if err := r.setCurrentStep(ctx, run, index+1); err != nil {
+				return err
+			}
+			continue
+		}
+
+		now := r.now()
+		rec.StartedAt = &now
+		sessionID, err := r.launchStep(ctx, run, &def, step, prev)
+		if err != nil {
+			rec.Status = store.PipelineStepStatusFailed
+			rec.Reason = err.Error()
+			rec.CompletedAt = &now
+			if err := r.store.SavePipelineStep(ctx, rec); err != nil {
+				return err
+			}
+			prev = &outcome{succeeded: false}
+			if err := r.setCurrentStep(ctx, run, index+1); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rec.Status = store.PipelineStepStatusRunning
+		rec.SessionID = sessionID
+		if err := r.store.SavePipelineStep(ctx, rec); err != nil {
+			return err
+		}
+
+		slog.Info("launched pipeline step",
+			"pipeline_run_id", run.ID,
+			"step", step.Name,
+			"mode", step.Mode,
+			"session_id", sessionID)
+		return nil
+	}
+
+	return r.finishRun(ctx, run, prev)
+}
+
+// checkRunningStep records the outcome of a running step once its session has
+// finished. It reports whether the step is done.
+func (r *Runner) checkRunningStep(ctx context.Context, step *Step, rec *store.PipelineStep) (bool, error) {
+	sess, err := r.store.GetSession(ctx, rec.SessionID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get step session: %w", err)
+	}
+
+	overBudget := step.MaxCostUSD > 0 && sess.CostUSD != nil && *sess.CostUSD > step.MaxCostUSD
+
+	switch sess.Status {
+	case store.SessionStatusCompleted, store.SessionStatusFailed,
+		store.SessionStatusInterrupted, store.SessionStatusDiscarded:
+	default:
+		if overBudget && sess.Status != store.SessionStatusInterrupting {
+			slog.Info("interrupting pipeline step over budget",
+				"pipeline_run_id", rec.RunID,
+				"step", rec.Name,
+				"session_id", rec.SessionID,
+				"cost_usd", *sess.CostUSD,
+				"max_cost_usd", step.MaxCostUSD)
+			r.publishBudgetExceeded(step, rec, sess)
+			if err := r.sessions.InterruptSession(ctx, rec.SessionID); err != nil {
+				slog.Warn("failed to interrupt pipeline step",
+					"session_id", rec.SessionID,
+					"error", err)
+			}
+		}
+		return false, nil
+	}
+
+	now := r.now()
+	rec.CompletedAt = &now
+	switch {
+	case overBudget:
+		rec.Status = store.PipelineStepStatusFailed
+		rec.Reason = fmt.Sprintf("cost $%.4f exceeded budget of $%.4f", *sess.CostUSD, step.MaxCostUSD)
+		// A session interrupted over budget was reported when it was interrupted
+		if sess.Status != store.SessionStatusInterrupted {
+			r.publishBudgetExceeded(step, rec, sess)
+		}
+	case sess.Status == store.SessionStatusCompleted:
+		rec.Status = store.PipelineStepStatusCompleted
+	default:
+		rec.Status = store.PipelineStepStatusFailed
+		rec.Reason = fmt.Sprintf("session %s", sess.Status)
+		if sess.ErrorMessage != "" {
+			rec.Reason += ": " + sess.ErrorMessage
+		}
+	}
+
+	if err := r.store.SavePipelineStep(ctx, rec); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// publishBudgetExceeded reports a step session that cost more than the step allows
+func (r *Runner) publishBudgetExceeded(step *Step, rec *store.PipelineStep, sess *store.Session) {
+	if r.eventBus == nil {
+		return
+	}
+	r.eventBus.Publish(bus.NewEvent(bus.BudgetExceededPayload{
+		SessionID:     rec.SessionID,
+		RunID:         sess.RunID,
+		PipelineRunID: rec.RunID,
+		Step:          rec.Name,
+		CostUSD:       *sess.CostUSD,
+		MaxCostUSD:    step.MaxCostUSD,
+	}))
+}
+
+// outcomeOf returns the outcome of an executed step, or nil for steps that
+// were skipped or have not finished
+func (r *Runner) outcomeOf(ctx context.Context, rec *store.PipelineStep) *outcome {
+	switch rec.Status {
+	case store.PipelineStepStatusCompleted, store.PipelineStepStatusFailed:
+	default:
+		return nil
+	}
+
+	o := &outcome{
+		succeeded: rec.Status == store.PipelineStepStatusCompleted,
+		sessionID: rec.SessionID,
+	}
+	if rec.SessionID != "" {
+		if sess, err := r.store.GetSession(ctx, rec.SessionID); err == nil {
+			o.result = sess.ResultContent
+		}
+	}
+	return o
+}
+
+// launchStep starts the session for a step and returns its ID
+func (r *Runner) launchStep(ctx context.Context, run *store.PipelineRun, def *Definition, step *Step, prev *outcome) (string, error) {
+	model := step.Model
+	if model == "" {
+		model = def.Model
+	}
+	allowedTools := step.AllowedTools
+	if len(allowedTools) == 0 {
+		allowedTools = def.AllowedTools
+	}
+
+	if step.Mode == ModeContinue && prev != nil && prev.sessionID != "" {
+		sess, err := r.sessions.ContinueSession(ctx, session.ContinueSessionConfig{
+			ParentSessionID:    prev.sessionID,
+			Query:              step.Prompt,
+			SystemPrompt:       step.SystemPrompt,
+			AppendSystemPrompt: step.AppendSystemPrompt,
+			AllowedTools:       allowedTools,
+			DisallowedTools:    step.DisallowedTools,
+			MaxTurns:           step.MaxTurns,
+			Model:              claudecode.Model(model),
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to continue session: %w", err)
+		}
+		return sess.ID, nil
+	}
+
+	previousResult := ""
+	if prev != nil {
+		previousResult = prev.result
+	}
+
+	sess, err := r.sessions.LaunchSession(ctx, session.LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{
+			Query:              step.freshPrompt(previousResult),
+			Model:              claudecode.Model(model),
+			OutputFormat:       claudecode.OutputStreamJSON,
+			WorkingDir:         run.WorkingDir,
+			MaxTurns:           step.MaxTurns,
+			SystemPrompt:       step.SystemPrompt,
+			AppendSystemPrompt: step.AppendSystemPrompt,
+			AllowedTools:       allowedTools,
+			DisallowedTools:    step.DisallowedTools,
+		},
+		Title:           fmt.Sprintf("%s: %s", def.Name, step.Name),
+		AutoAcceptEdits: def.AutoAcceptEdits,
+	}, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to launch session: %w", err)
+	}
+	return sess.ID, nil
+}
+
+func (r *Runner) setCurrentStep(ctx context.Context, run *store.PipelineRun, index int) error {
+	run.CurrentStep = index
+	return r.store.UpdatePipelineRun(ctx, run.ID, store.PipelineRunUpdate{CurrentStep: &index})
+}
+
+// finishRun marks a run completed when its last executed step succeeded and
+// failed otherwise
+func (r *Runner) finishRun(ctx context.Context, run *store.PipelineRun, last *outcome) error {
+	if last == nil || !last.succeeded {
+		return r.failRun(ctx, run, "last executed step did not succeed")
+	}
+
+	now := r.now()
+	status := store.PipelineRunStatusCompleted
+	if err := r.store.UpdatePipelineRun(ctx, run.ID, store.PipelineRunUpdate{Status: &status, CompletedAt: &now}); err != nil {
+		return err
+	}
+	slog.Info("pipeline run completed", "pipeline_run_id", run.ID, "name", run.Name)
+	return nil
+}
+
+func (r *Runner) failRun(ctx context.Context, run *store.PipelineRun, message string) error {
+	now := r.now()
+	status := store.PipelineRunStatusFailed
+	if err := r.store.UpdatePipelineRun(ctx, run.ID, store.PipelineRunUpdate{
+		Status:       &status,
+		ErrorMessage: &message,
+		CompletedAt:  &now,
+	}); err != nil {
+		return err
+	}
+	slog.Info("pipeline run failed", "pipeline_run_id", run.ID, "name", run.Name, "reason", message)
+	return nil
+}
@@ -0,0 +1,234 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestRunner(t *testing.T) (*Runner, *store.MemoryStore, *session.MockSessionManager) {
+	t.Helper()
+
+	memoryStore := store.NewMemoryStore()
+
+	ctrl := gomock.NewController(t)
+	sessions := session.NewMockSessionManager(ctrl)
+
+	return New(memoryStore, sessions, nil, time.Minute), memoryStore, sessions
+}
+
+func createSession(t *testing.T, s *store.MemoryStore, id string) {
+	t.Helper()
+	require.NoError(t, s.CreateSession(context.Background(), &store.Session{
+		ID: id, RunID: "run-" + id, Query: "q", Status: store.SessionStatusRunning,
+		CreatedAt: time.Now(), LastActivityAt: time.Now(),
+	}))
+}
+
+func finishSession(t *testing.T, s *store.MemoryStore, id, status, result string, cost float64) {
+	t.Helper()
+	require.NoError(t, s.UpdateSession(context.Background(), id, store.SessionUpdate{
+		Status:        &status,
+		ResultContent: &result,
+		CostUSD:       &cost,
+	}))
+}
+
+func TestRunner_ContinueAndConditions(t *testing.T) {
+	r, memoryStore, sessions := newTestRunner(t)
+	ctx := context.Background()
+
+	def := &Definition{
+		Name:  "implement-and-review",
+		Model: "sonnet",
+		Steps: []Step{
+			{Name: "implement", Prompt: "Implement it"},
+			{Name: "review", Prompt: "Review it", Mode: ModeContinue, Model: "opus"},
+			{Name: "fix", Prompt: "Fix it", When: WhenFailure},
+		},
+	}
+
+	sessions.EXPECT().
+		LaunchSession(gomock.Any(), gomock.Any(), false).
+		DoAndReturn(func(_ context.Context, cfg session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+			assert.Equal(t, "Implement it", cfg.Query)
+			assert.Equal(t, "sonnet", string(cfg.Model))
+			assert.Equal(t, "/tmp/project", cfg.WorkingDir)
+			assert.Equal(t, "implement-and-review: implement", cfg.Title)
+			createSession(t, memoryStore, "sess-1")
+			return &session.Session{ID: "sess-1"}, nil
+		})
+
+	run, err := r.StartRun(ctx, def, "/tmp/project")
+	require.NoError(t, err)
+	assert.Equal(t, store.PipelineRunStatusRunning, run.Status)
+
+	// Nothing happens while the first step is still running
+	require.NoError(t, r.Advance(ctx, run.ID))
+
+	sessions.EXPECT().
+		ContinueSession(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req session.ContinueSessionConfig) (*session.Session, error) {
+			assert.Equal(t, "sess-1", req.ParentSessionID)
+			assert.Equal(t, "Review it", req.Query)
+			assert.Equal(t, "opus", string(req.Model))
+			createSession(t, memoryStore, "sess-2")
+			return &session.Session{ID: "sess-2"}, nil
+		})
+
+	finishSession(t, memoryStore, "sess-1", store.SessionStatusCompleted, "implemented", 0.1)
+	require.NoError(t, r.Advance(ctx, run.ID))
+
+	finishSession(t, memoryStore, "sess-2", store.SessionStatusCompleted, "looks good", 0.1)
+	require.NoError(t, r.Advance(ctx, run.ID))
+
+	run, steps, err := r.GetRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, store.PipelineRunStatusCompleted, run.Status)
+	assert.NotNil(t, run.CompletedAt)
+	assert.Equal(t, 3, run.CurrentStep)
+
+	require.Len(t, steps, 3)
+	assert.Equal(t, store.PipelineStepStatusCompleted, steps[0].Status)
+	assert.Equal(t, "sess-1", steps[0].SessionID)
+	assert.Equal(t, store.PipelineStepStatusCompleted, steps[1].Status)
+	assert.Equal(t, "sess-2", steps[1].SessionID)
+	assert.Equal(t, store.PipelineStepStatusSkipped, steps[2].Status)
+	assert.Empty(t, steps[2].SessionID)
+}
+
+func TestRunner_BudgetFailureRunsFailureStep(t *testing.T) {
+	r, memoryStore, sessions := newTestRunner(t)
+	ctx := context.Background()
+
+	def := &Definition{
+		Name:       "budgeted",
+		WorkingDir: "/tmp/project",
+		Steps: []Step{
+			{Name: "attempt", Prompt: "Try it", MaxCostUSD: 0.5},
+			{Name: "report", Prompt: "Summarize why this failed: {{previous_result}}", When: WhenFailure},
+		},
+	}
+
+	gomock.InOrder(
+		sessions.EXPECT().
+			LaunchSession(gomock.Any(), gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, _ session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+				createSession(t, memoryStore, "sess-1")
+				return &session.Session{ID: "sess-1"}, nil
+			}),
+		sessions.EXPECT().
+			LaunchSession(gomock.Any(), gomock.Any(), false).
+			DoAndReturn(func(_ context.Context, cfg session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+				assert.Equal(t, "Summarize why this failed: partial work", cfg.Query)
+				createSession(t, memoryStore, "sess-2")
+				return &session.Session{ID: "sess-2"}, nil
+			}),
+	)
+
+	eventBus := bus.NewEventBus()
+	r.eventBus = eventBus
+	sub := eventBus.Subscribe(ctx, bus.EventFilter{Types: []bus.EventType{bus.EventBudgetExceeded}})
+
+	run, err := r.StartRun(ctx, def, "")
+	require.NoError(t, err)
+	assert.Equal(t, "/tmp/project", run.WorkingDir)
+
+	finishSession(t, memoryStore, "sess-1", store.SessionStatusCompleted, "partial work", 1.25)
+	require.NoError(t, r.Advance(ctx, run.ID))
+
+	select {
+	case event := <-sub.Channel:
+		exceeded, err := bus.DecodePayload[bus.BudgetExceededPayload](event)
+		require.NoError(t, err)
+		assert.Equal(t, bus.BudgetExceededPayload{
+			SessionID: "sess-1", RunID: "run-sess-1", PipelineRunID: run.ID, Step: "attempt",
+			CostUSD: 1.25, MaxCostUSD: 0.5,
+		}, exceeded)
+	case <-time.After(time.Second):
+		t.Fatal("expected a budget_exceeded event")
+	}
+
+	_, steps, err := r.GetRun(ctx, run.ID)
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	assert.Equal(t, store.PipelineStepStatusFailed, steps[0].Status)
+	assert.Contains(t, steps[0].Reason, "exceeded budget")
+	assert.Equal(t, store.PipelineStepStatusRunning, steps[1].Status)
+
+	finishSession(t, memoryStore, "sess-2", store.SessionStatusCompleted, "report", 0.1)
+	require.NoError(t, r.Advance(ctx, run.ID))
+
+	run, err = memoryStore.GetPipelineRun(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, store.PipelineRunStatusCompleted, run.Status)
+}
+
+func TestRunner_PauseAndResume(t *testing.T) {
+	r, memoryStore, sessions := newTestRunner(t)
+	ctx := context.Background()
+
+	def := &Definition{
+		Name: "two-steps",
+		Steps: []Step{
+			{Name: "one", Prompt: "One"},
+			{Name: "two", Prompt: "Two"},
+		},
+	}
+
+	sessions.EXPECT().
+		LaunchSession(gomock.Any(), gomock.Any(), false).
+		DoAndReturn(func(_ context.Context, _ session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+			createSession(t, memoryStore, "sess-1")
+			return &session.Session{ID: "sess-1"}, nil
+		})
+
+	run, err := r.StartRun(ctx, def, "/tmp/project")
+	require.NoError(t, err)
+
+	paused, err := r.Pause(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, store.PipelineRunStatusPaused, paused.Status)
+
+	_, err = r.Pause(ctx, run.ID)
+	assert.True(t, validation.IsError(err))
+
+	// The in-flight step finishes but no further step is launched while paused
+	finishSession(t, memoryStore, "sess-1", store.SessionStatusCompleted, "one done", 0.1)
+	r.AdvanceAll(ctx)
+
+	sessions.EXPECT().
+		LaunchSession(gomock.Any(), gomock.Any(), false).
+		DoAndReturn(func(_ context.Context, cfg session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+			assert.Contains(t, cfg.Query, "one done")
+			createSession(t, memoryStore, "sess-2")
+			return &session.Session{ID: "sess-2"}, nil
+		})
+
+	resumed, err := r.Resume(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, store.PipelineRunStatusRunning, resumed.Status)
+	assert.Equal(t, 1, resumed.CurrentStep)
+
+	_, err = r.Resume(ctx, "missing")
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestRunner_StartRunRequiresWorkingDir(t *testing.T) {
+	r, _, _ := newTestRunner(t)
+
+	_, err := r.StartRun(context.Background(), &Definition{
+		Name:  "p",
+		Steps: []Step{{Prompt: "a"}},
+	}, "")
+	require.Error(t, err)
+	assert.True(t, validation.IsError(err))
+}
@@ -0,0 +1,199 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/batch"
+)
+
+// BatchHandlers provides RPC handlers for batch launches
+type BatchHandlers struct {
+	batches *batch.Service
+}
+
+// NewBatchHandlers creates new batch RPC handlers
+func NewBatchHandlers(batches *batch.Service) *BatchHandlers {
+	return &BatchHandlers{
+		batches: batches,
+	}
+}
+
+// Register registers all batch handlers with the RPC server
+func (h *BatchHandlers) Register(server *Server) {
+	server.Register("launchBatch", h.HandleLaunchBatch)
+	server.Register("listBatches", h.HandleListBatches)
+	server.Register("getBatch", h.HandleGetBatch)
+	server.Register("compareBatch", h.HandleCompareBatch)
+}
+
+// BatchGroup is the RPC representation of a batch group
+type BatchGroup struct {
+	ID           string        `json:"id"`
+	Name         string        `json:"name"`
+	Query        string        `json:"query"`
+	Matrix       batch.Matrix  `json:"matrix"`
+	Status       string        `json:"status"`
+	TotalCostUSD float64       `json:"total_cost_usd"`
+	DurationMS   int64         `json:"duration_ms"`
+	Members      []BatchMember `json:"members"`
+	CreatedAt    string        `json:"created_at"`
+}
+
+// BatchMember is the RPC representation of a batch member
+type BatchMember struct {
+	Index      int              `json:"index"`
+	SessionID  string           `json:"session_id,omitempty"`
+	Label      string           `json:"label"`
+	Model      string           `json:"model,omitempty"`
+	Provider   string           `json:"provider,omitempty"`
+	WorkingDir string           `json:"working_dir,omitempty"`
+	Status     string           `json:"status"`
+	CostUSD    *float64         `json:"cost_usd,omitempty"`
+	DurationMS *int64           `json:"duration_ms,omitempty"`
+	NumTurns   *int             `json:"num_turns,omitempty"`
+	DiffStats  *batch.DiffStats `json:"diff_stats,omitempty"` // Absent from lists
+	Error      string           `json:"error,omitempty"`
+	Result     string           `json:"result,omitempty"`
+}
+
+// LaunchBatchRequest is the request for launching a batch
+type LaunchBatchRequest struct {
+	Name            string       `json:"name,omitempty"`
+	Query           string       `json:"query"`
+	WorkingDir      string       `json:"working_dir,omitempty"`
+	Matrix          batch.Matrix `json:"matrix"`
+	MaxTurns        int          `json:"max_turns,omitempty"`
+	AllowedTools    []string     `json:"allowed_tools,omitempty"`
+	AutoAcceptEdits bool         `json:"auto_accept_edits,omitempty"`
+}
+
+// BatchIDRequest is the request for operations addressing a single batch
+type BatchIDRequest struct {
+	ID string `json:"id"`
+}
+
+// BatchResponse is the response for operations returning a single batch
+type BatchResponse struct {
+	Batch BatchGroup `json:"batch"`
+}
+
+// ListBatchesResponse is the response for listing batches
+type ListBatchesResponse struct {
+	Batches []BatchGroup `json:"batches"`
+}
+
+// CompareBatchResponse is the response for comparing a batch's members
+type CompareBatchResponse struct {
+	Batch             BatchGroup `json:"batch"`
+	CheapestSessionID string     `json:"cheapest_session_id,omitempty"`
+	FastestSessionID  string     `json:"fastest_session_id,omitempty"`
+}
+
+// HandleLaunchBatch handles the LaunchBatch RPC method
+func (h *BatchHandlers) HandleLaunchBatch(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req LaunchBatchRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	group, err := h.batches.Launch(ctx, &batch.Request{
+		Name:            req.Name,
+		Query:           req.Query,
+		WorkingDir:      req.WorkingDir,
+		Matrix:          req.Matrix,
+		MaxTurns:        req.MaxTurns,
+		AllowedTools:    req.AllowedTools,
+		AutoAcceptEdits: req.AutoAcceptEdits,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch batch: %w", err)
+	}
+	return &BatchResponse{Batch: batchGroupToRPC(group)}, nil
+}
+
+// HandleListBatches handles the ListBatches RPC method
+func (h *BatchHandlers) HandleListBatches(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	groups, err := h.batches.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list batches: %w", err)
+	}
+
+	result := make([]BatchGroup, len(groups))
+	for i, group := range groups {
+		result[i] = batchGroupToRPC(group)
+	}
+	return &ListBatchesResponse{Batches: result}, nil
+}
+
+// HandleGetBatch handles the GetBatch RPC method
+func (h *BatchHandlers) HandleGetBatch(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req BatchIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	group, err := h.batches.Get(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batch: %w", err)
+	}
+	return &BatchResponse{Batch: batchGroupToRPC(group)}, nil
+}
+
+// HandleCompareBatch handles the CompareBatch RPC method
+func (h *BatchHandlers) HandleCompareBatch(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req BatchIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	comparison, err := h.batches.Compare(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare batch: %w", err)
+	}
+	return &CompareBatchResponse{
+		Batch:             batchGroupToRPC(comparison.Group),
+		CheapestSessionID: comparison.CheapestSessionID,
+		FastestSessionID:  comparison.FastestSessionID,
+	}, nil
+}
+
+func batchGroupToRPC(group *batch.Group) BatchGroup {
+	result := BatchGroup{
+		ID:           group.ID,
+		Name:         group.Name,
+		Query:        group.Query,
+		Matrix:       group.Matrix,
+		Status:       group.Status,
+		TotalCostUSD: group.TotalCostUSD,
+		DurationMS:   group.DurationMS,
+		Members:      make([]BatchMember, len(group.Members)),
+		CreatedAt:    group.CreatedAt.Format(time.RFC3339),
+	}
+	for i, member := range group.Members {
+		result.Members[i] = BatchMember{
+			Index:      member.Index,
+			SessionID:  member.SessionID,
+			Label:      member.Label,
+			Model:      member.Model,
+			Provider:   member.Provider,
+			WorkingDir: member.WorkingDir,
+			Status:     member.Status,
+			CostUSD:    member.CostUSD,
+			DurationMS: member.DurationMS,
+			NumTurns:   member.NumTurns,
+			DiffStats:  member.DiffStats,
+			Error:      member.Error,
+			Result:     member.Result,
+		}
+	}
+	return result
+}
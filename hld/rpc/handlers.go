@@ -57,6 +57,9 @@ type LaunchSessionRequest struct {
 	Verbose                           bool                  `json:"verbose,omitempty"`
 	DangerouslySkipPermissions        bool                  `json:"dangerously_skip_permissions,omitempty"`
 	DangerouslySkipPermissionsTimeout *int64                `json:"dangerously_skip_permissions_timeout,omitempty"`
+	StallThresholdMs                  *int64                `json:"stall_threshold_ms,omitempty"`
+	StallInterruptThresholdMs         *int64                `json:"stall_interrupt_threshold_ms,omitempty"`
+	Runner                            string                `json:"runner,omitempty"`
 }
 
 // LaunchSessionResponse is the response for launching a new session
@@ -98,6 +101,9 @@ func (h *SessionHandlers) HandleLaunchSession(ctx context.Context, params json.R
 		Title:                             req.Title,
 		DangerouslySkipPermissions:        req.DangerouslySkipPermissions,
 		DangerouslySkipPermissionsTimeout: req.DangerouslySkipPermissionsTimeout,
+		StallThresholdMs:                  req.StallThresholdMs,
+		StallInterruptThresholdMs:         req.StallInterruptThresholdMs,
+		Runner:                            req.Runner,
 	}
 
 	// Parse model if provided
@@ -126,19 +132,24 @@ func (h *SessionHandlers) HandleLaunchSession(ctx context.Context, params json.R
 	}, nil
 }
 
-// ListSessionsRequest is the request for listing sessions
+// ListSessionsRequest is the request for listing sessions. The embedded
+// filter uses the same field names as saved filters.
 type ListSessionsRequest struct {
-	// Could add filters here in the future
+	store.SessionFilter
+	LeavesOnly    bool   `json:"leaves_only,omitempty"`
+	SavedFilterID string `json:"saved_filter_id,omitempty"` // Request fields override the saved filter's
+	Limit         int    `json:"limit,omitempty"`           // Maximum sessions to return, 0 for all
+	Cursor        string `json:"cursor,omitempty"`          // next_cursor of a previous response
 }
 
 // ListSessionsResponse is the response for listing sessions
 type ListSessionsResponse struct {
-	Sessions []session.Info `json:"sessions"`
+	Sessions   []session.Info `json:"sessions"`
+	NextCursor string         `json:"next_cursor,omitempty"` // Set when more sessions remain
 }
 
 // HandleListSessions handles the ListSessions RPC method
 func (h *SessionHandlers) HandleListSessions(ctx context.Context, params json.RawMessage) (interface{}, error) {
-	// Parse request (even though it's empty for now)
 	var req ListSessionsRequest
 	if params != nil {
 		if err := json.Unmarshal(params, &req); err != nil {
@@ -146,11 +157,49 @@ func (h *SessionHandlers) HandleListSessions(ctx context.Context, params json.Ra
 		}
 	}
 
-	// Get all sessions
-	sessions := h.manager.ListSessions()
+	var filter store.SessionFilter
+	if req.SavedFilterID != "" {
+		saved, err := h.store.GetSavedFilter(ctx, req.SavedFilterID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get saved filter: %w", err)
+		}
+		filter = saved.Filter
+	}
+	override := req.SessionFilter
+	override.LeavesOnly = req.LeavesOnly
+	filter = filter.Merge(override)
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	if req.Limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative")
+	}
+	if req.Cursor != "" {
+		after, err := store.ParseSessionCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = after
+	}
+	// Fetch one extra session to learn whether another page follows
+	if req.Limit > 0 {
+		filter.Limit = req.Limit + 1
+	}
+
+	dbSessions, err := h.store.QuerySessions(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	dbSessions, nextCursor := store.PageSessions(dbSessions, req.Limit)
+
+	sessions := make([]session.Info, 0, len(dbSessions))
+	for _, dbSession := range dbSessions {
+		sessions = append(sessions, session.InfoFromStore(dbSession))
+	}
 
 	return &ListSessionsResponse{
-		Sessions: sessions,
+		Sessions:   sessions,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -248,20 +297,37 @@ func (h *SessionHandlers) HandleGetConversation(ctx context.Context, params json
 		return nil, fmt.Errorf("either session_id or claude_session_id is required")
 	}
 
+	if req.Limit < 0 {
+		return nil, fmt.Errorf("limit must not be negative")
+	}
+	page := store.ConversationPage{SinceSequence: req.SinceSequence}
+	if req.Cursor != "" {
+		after, err := store.ParseConversationCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		page.After = after
+	}
+	// Fetch one extra event to learn whether another page follows
+	if req.Limit > 0 {
+		page.Limit = req.Limit + 1
+	}
+
 	var events []*store.ConversationEvent
 	var err error
 
 	if req.ClaudeSessionID != "" {
 		// Get conversation by Claude session ID
-		events, err = h.store.GetConversation(ctx, req.ClaudeSessionID)
+		events, err = h.store.GetConversationPage(ctx, req.ClaudeSessionID, page)
 	} else {
 		// Get conversation by session ID - always returns full history including parents
-		events, err = h.store.GetSessionConversation(ctx, req.SessionID)
+		events, err = h.store.GetSessionConversationPage(ctx, req.SessionID, page)
 	}
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get conversation: %w", err)
 	}
+	events, nextCursor, hasMore := store.PageConversation(events, req.Limit)
 
 	// Convert store events to RPC events
 	rpcEvents := make([]ConversationEvent, len(events))
@@ -284,11 +350,17 @@ func (h *SessionHandlers) HandleGetConversation(ctx context.Context, params json
 			IsCompleted:       event.IsCompleted,
 			ApprovalStatus:    event.ApprovalStatus,
 			ApprovalID:        event.ApprovalID,
+
+			CompactionTrigger:    event.CompactionTrigger,
+			PreCompactionTokens:  event.PreCompactionTokens,
+			PostCompactionTokens: event.PostCompactionTokens,
 		}
 	}
 
 	return &GetConversationResponse{
-		Events: rpcEvents,
+		Events:     rpcEvents,
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
 	}, nil
 }
 
@@ -343,6 +415,90 @@ func (h *SessionHandlers) HandleGetSessionSnapshots(ctx context.Context, params
 	return response, nil
 }
 
+// HandleGetSessionResources handles the GetSessionResources RPC method
+func (h *SessionHandlers) HandleGetSessionResources(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req GetSessionResourcesRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	// Validate required fields
+	if req.SessionID == "" {
+		return nil, fmt.Errorf("session_id is required")
+	}
+	if req.Limit <= 0 {
+		req.Limit = 240
+	}
+
+	sess, err := h.store.GetSession(ctx, req.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	samples, err := h.store.GetResourceSamples(ctx, req.SessionID, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource samples: %w", err)
+	}
+
+	response := &GetSessionResourcesResponse{
+		Resources: session.ResourceUsageFromSession(sess),
+		Samples:   make([]ResourceSampleInfo, 0, len(samples)),
+	}
+	for _, sample := range samples {
+		response.Samples = append(response.Samples, ResourceSampleInfo{
+			SampledAt:    sample.SampledAt.Format(time.RFC3339),
+			CPUTimeMs:    sample.CPUTimeMs,
+			CPUPercent:   sample.CPUPercent,
+			RSSBytes:     sample.RSSBytes,
+			OpenFDs:      sample.OpenFDs,
+			ProcessCount: sample.ProcessCount,
+		})
+	}
+	return response, nil
+}
+
+// HandleGetSessionEffectiveConfig handles the GetSessionEffectiveConfig RPC method
+func (h *SessionHandlers) HandleGetSessionEffectiveConfig(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req GetSessionEffectiveConfigRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	// Validate required fields
+	if req.SessionID == "" {
+		return nil, fmt.Errorf("session_id is required")
+	}
+
+	config, err := h.store.GetSessionEffectiveConfig(ctx, req.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get effective config: %w", err)
+	}
+
+	response := &GetSessionEffectiveConfigResponse{}
+	if config != "" {
+		response.Config = &session.EffectiveConfig{}
+		if err := json.Unmarshal([]byte(config), response.Config); err != nil {
+			return nil, fmt.Errorf("failed to decode effective config: %w", err)
+		}
+	}
+	return response, nil
+}
+
+// HandleValidateProjectConfig handles the ValidateProjectConfig RPC method
+func (h *SessionHandlers) HandleValidateProjectConfig(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req ValidateProjectConfigRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	// Validate required fields
+	if req.WorkingDir == "" {
+		return nil, fmt.Errorf("working_dir is required")
+	}
+
+	return session.ValidateProjectConfig(req.WorkingDir, req.Content)
+}
+
 // HandleGetSessionState handles the GetSessionState RPC method
 func (h *SessionHandlers) HandleGetSessionState(ctx context.Context, params json.RawMessage) (interface{}, error) {
 	var req GetSessionStateRequest
@@ -473,6 +629,43 @@ func (h *SessionHandlers) HandleContinueSession(ctx context.Context, params json
 	}, nil
 }
 
+// HandleCompactSession handles the CompactSession RPC method by continuing the
+// session with a compaction request
+func (h *SessionHandlers) HandleCompactSession(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req CompactSessionRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	// Validate required fields
+	if req.SessionID == "" {
+		return nil, fmt.Errorf("session_id is required")
+	}
+
+	parent, err := h.store.GetSession(ctx, req.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if parent.Status == store.SessionStatusDraft || parent.ClaudeSessionID == "" {
+		return nil, fmt.Errorf("session has no conversation to compact")
+	}
+
+	result, err := h.manager.ContinueSession(ctx, session.ContinueSessionConfig{
+		ParentSessionID: req.SessionID,
+		Query:           session.CompactCommand(req.Instructions),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContinueSessionResponse{
+		SessionID:       result.ID,
+		RunID:           result.RunID,
+		ClaudeSessionID: "", // Will be populated when events stream in
+		ParentSessionID: req.SessionID,
+	}, nil
+}
+
 // HandleInterruptSession handles the InterruptSession RPC method
 func (h *SessionHandlers) HandleInterruptSession(ctx context.Context, params json.RawMessage) (interface{}, error) {
 	var req InterruptSessionRequest
@@ -520,6 +713,11 @@ func (h *SessionHandlers) HandleUpdateSessionSettings(ctx context.Context, param
 		return nil, fmt.Errorf("session_id is required")
 	}
 
+	if (req.StallThresholdMs != nil && *req.StallThresholdMs < 0) ||
+		(req.StallInterruptThresholdMs != nil && *req.StallInterruptThresholdMs < 0) {
+		return nil, fmt.Errorf("stall thresholds must not be negative")
+	}
+
 	// Get current session to verify it exists
 	session, err := h.store.GetSession(ctx, req.SessionID)
 	if err != nil {
@@ -533,6 +731,8 @@ func (h *SessionHandlers) HandleUpdateSessionSettings(ctx context.Context, param
 	update := store.SessionUpdate{
 		AutoAcceptEdits:            req.AutoAcceptEdits,
 		DangerouslySkipPermissions: req.DangerouslySkipPermissions,
+		StallThresholdMs:           req.StallThresholdMs,
+		StallInterruptThresholdMs:  req.StallInterruptThresholdMs,
 	}
 
 	// Handle timeout if dangerously skip permissions is being enabled
@@ -586,25 +786,19 @@ func (h *SessionHandlers) HandleUpdateSessionSettings(ctx context.Context, param
 
 	// Update event publishing
 	if h.eventBus != nil {
-		eventData := map[string]interface{}{
-			"session_id": req.SessionID,
-			"event_type": "settings_updated",
-		}
-
-		if req.AutoAcceptEdits != nil {
-			eventData["auto_accept_edits"] = *req.AutoAcceptEdits
+		payload := bus.SessionSettingsChangedPayload{
+			SessionID:                  req.SessionID,
+			EventType:                  "settings_updated",
+			AutoAcceptEdits:            req.AutoAcceptEdits,
+			StallThresholdMs:           req.StallThresholdMs,
+			StallInterruptThresholdMs:  req.StallInterruptThresholdMs,
+			DangerouslySkipPermissions: req.DangerouslySkipPermissions,
 		}
-		if req.DangerouslySkipPermissions != nil {
-			eventData["dangerously_skip_permissions"] = *req.DangerouslySkipPermissions
-			if *req.DangerouslySkipPermissions && req.DangerouslySkipPermissionsTimeoutMs != nil {
-				eventData["dangerously_skip_permissions_timeout_ms"] = *req.DangerouslySkipPermissionsTimeoutMs
-			}
+		if req.DangerouslySkipPermissions != nil && *req.DangerouslySkipPermissions {
+			payload.DangerouslySkipPermissionsTimeoutMs = req.DangerouslySkipPermissionsTimeoutMs
 		}
 
-		h.eventBus.Publish(bus.Event{
-			Type: bus.EventSessionSettingsChanged,
-			Data: eventData,
-		})
+		h.eventBus.Publish(bus.NewEvent(payload))
 	}
 
 	return UpdateSessionSettingsResponse{Success: true}, nil
@@ -663,13 +857,10 @@ func (h *SessionHandlers) HandleUpdateSessionTitle(ctx context.Context, params j
 
 	// Publish event for UI updates
 	if h.eventBus != nil {
-		h.eventBus.Publish(bus.Event{
-			Type: bus.EventSessionStatusChanged,
-			Data: map[string]interface{}{
-				"session_id": req.SessionID,
-				"title":      req.Title,
-			},
-		})
+		h.eventBus.Publish(bus.NewEvent(bus.SessionStatusChangedPayload{
+			SessionID: req.SessionID,
+			Title:     &req.Title,
+		}))
 	}
 
 	return &UpdateSessionTitleResponse{
@@ -766,8 +957,17 @@ func (h *SessionHandlers) Register(server *Server) {
 	server.Register("getConversation", h.HandleGetConversation)
 	server.Register("getSessionState", h.HandleGetSessionState)
 	server.Register("continueSession", h.HandleContinueSession)
+	server.Register("compactSession", h.HandleCompactSession)
 	server.Register("interruptSession", h.HandleInterruptSession)
 	server.Register("getSessionSnapshots", h.HandleGetSessionSnapshots)
+	server.Register("getSessionResources", h.HandleGetSessionResources)
+	server.Register("getSessionEffectiveConfig", h.HandleGetSessionEffectiveConfig)
+	server.Register("validateProjectConfig", h.HandleValidateProjectConfig)
+	server.Register("listLabels", h.HandleListLabels)
+	server.Register("setSessionLabels", h.HandleSetSessionLabels)
+	server.Register("listProjects", h.HandleListProjects)
+	server.Register("listSavedFilters", h.HandleListSavedFilters)
+	server.Register("searchConversations", h.HandleSearchConversations)
 	server.Register("updateSessionSettings", h.HandleUpdateSessionSettings)
 	server.Register("updateSessionTitle", h.HandleUpdateSessionTitle)
 	server.Register("getRecentPaths", h.HandleGetRecentPaths)
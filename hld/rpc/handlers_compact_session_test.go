@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/approval"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandleCompactSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := session.NewMockSessionManager(ctrl)
+	mockStore := store.NewMockConversationStore(ctrl)
+	handlers := NewSessionHandlers(mockManager, mockStore, approval.NewMockManager(ctrl))
+	ctx := context.Background()
+
+	t.Run("continues with a compact command", func(t *testing.T) {
+		mockStore.EXPECT().GetSession(gomock.Any(), "parent-123").Return(&store.Session{
+			ID:              "parent-123",
+			ClaudeSessionID: "claude-parent",
+			Status:          store.SessionStatusCompleted,
+		}, nil)
+		mockManager.EXPECT().ContinueSession(gomock.Any(), session.ContinueSessionConfig{
+			ParentSessionID: "parent-123",
+			Query:           "/compact keep the test plan",
+		}).Return(&session.Session{ID: "child-456", RunID: "run-child"}, nil)
+
+		resp, err := handlers.HandleCompactSession(ctx, []byte(`{"session_id": "parent-123", "instructions": "keep the test plan"}`))
+		require.NoError(t, err)
+		result := resp.(*ContinueSessionResponse)
+		assert.Equal(t, "child-456", result.SessionID)
+		assert.Equal(t, "parent-123", result.ParentSessionID)
+	})
+
+	t.Run("rejects sessions without a conversation", func(t *testing.T) {
+		mockStore.EXPECT().GetSession(gomock.Any(), "draft-1").Return(&store.Session{
+			ID:     "draft-1",
+			Status: store.SessionStatusDraft,
+		}, nil)
+
+		_, err := handlers.HandleCompactSession(ctx, []byte(`{"session_id": "draft-1"}`))
+		assert.ErrorContains(t, err, "no conversation to compact")
+	})
+
+	t.Run("requires a session id", func(t *testing.T) {
+		_, err := handlers.HandleCompactSession(ctx, []byte(`{}`))
+		assert.ErrorContains(t, err, "session_id is required")
+	})
+}
@@ -56,7 +56,7 @@ func TestHandleGetConversation(t *testing.T) {
 		}
 
 		mockStore.EXPECT().
-			GetSessionConversation(gomock.Any(), sessionID).
+			GetSessionConversationPage(gomock.Any(), sessionID, store.ConversationPage{}).
 			Return(events, nil)
 
 		req := GetConversationRequest{
@@ -92,7 +92,7 @@ func TestHandleGetConversation(t *testing.T) {
 		}
 
 		mockStore.EXPECT().
-			GetConversation(gomock.Any(), claudeSessionID).
+			GetConversationPage(gomock.Any(), claudeSessionID, store.ConversationPage{}).
 			Return(events, nil)
 
 		req := GetConversationRequest{
@@ -109,6 +109,26 @@ func TestHandleGetConversation(t *testing.T) {
 		assert.Equal(t, "user", resp.Events[0].Role)
 	})
 
+	t.Run("incremental fetch", func(t *testing.T) {
+		mockStore.EXPECT().
+			GetSessionConversationPage(gomock.Any(), "sess-123", store.ConversationPage{SinceSequence: 7, Limit: 2}).
+			Return([]*store.ConversationEvent{
+				{ClaudeSessionID: "claude-456", Sequence: 8, EventType: store.EventTypeMessage, CreatedAt: time.Now()},
+				{ClaudeSessionID: "claude-456", Sequence: 9, EventType: store.EventTypeMessage, CreatedAt: time.Now()},
+			}, nil)
+
+		reqJSON, _ := json.Marshal(GetConversationRequest{SessionID: "sess-123", SinceSequence: 7, Limit: 1})
+		result, err := handlers.HandleGetConversation(context.Background(), reqJSON)
+		require.NoError(t, err)
+
+		resp := result.(*GetConversationResponse)
+		require.Len(t, resp.Events, 1)
+		assert.True(t, resp.HasMore)
+		next, err := store.ParseConversationCursor(resp.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, 8, next.Sequence)
+	})
+
 	t.Run("missing both session IDs", func(t *testing.T) {
 		req := GetConversationRequest{}
 		reqJSON, _ := json.Marshal(req)
@@ -485,6 +505,82 @@ func TestHandleGetSessionLeaves(t *testing.T) {
 	})
 }
 
+func TestHandleListSessions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := session.NewMockSessionManager(ctrl)
+	mockStore := store.NewMockConversationStore(ctrl)
+	mockApprovalManager := approval.NewMockManager(ctrl)
+
+	handlers := NewSessionHandlers(mockManager, mockStore, mockApprovalManager)
+
+	t.Run("no parameters list every session", func(t *testing.T) {
+		mockStore.EXPECT().
+			QuerySessions(gomock.Any(), store.SessionFilter{}).
+			Return([]*store.Session{{ID: "sess-1", Status: store.SessionStatusRunning, Labels: []string{"bug"}}}, nil)
+
+		result, err := handlers.HandleListSessions(context.Background(), nil)
+		require.NoError(t, err)
+		resp := result.(*ListSessionsResponse)
+		require.Len(t, resp.Sessions, 1)
+		assert.Equal(t, []string{"bug"}, resp.Sessions[0].Labels)
+	})
+
+	t.Run("filters override the saved filter", func(t *testing.T) {
+		minCost := 1.0
+		mockStore.EXPECT().
+			GetSavedFilter(gomock.Any(), "filter-1").
+			Return(&store.SavedFilter{ID: "filter-1", Filter: store.SessionFilter{
+				Labels:     []string{"bug"},
+				MinCostUSD: &minCost,
+			}}, nil)
+		mockStore.EXPECT().
+			QuerySessions(gomock.Any(), store.SessionFilter{
+				Kind:       store.SessionKindNormal,
+				Labels:     []string{"bug"},
+				Models:     []string{"opus"},
+				MinCostUSD: &minCost,
+				LeavesOnly: true,
+			}).
+			Return([]*store.Session{}, nil)
+
+		params := json.RawMessage(`{"saved_filter_id":"filter-1","kind":"normal","models":["opus"],"leaves_only":true}`)
+		result, err := handlers.HandleListSessions(context.Background(), params)
+		require.NoError(t, err)
+		assert.Empty(t, result.(*ListSessionsResponse).Sessions)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		_, err := handlers.HandleListSessions(context.Background(), json.RawMessage(`{"kind":"deleted"}`))
+		assert.ErrorContains(t, err, "unknown session kind")
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		now := time.Date(2026, 5, 1, 9, 30, 0, 0, time.UTC)
+		after := store.SessionCursor{LastActivityAt: now, ID: "sess-0"}
+		mockStore.EXPECT().
+			QuerySessions(gomock.Any(), store.SessionFilter{After: &after, Limit: 2}).
+			Return([]*store.Session{
+				{ID: "sess-1", LastActivityAt: now.Add(-time.Minute)},
+				{ID: "sess-2", LastActivityAt: now.Add(-2 * time.Minute)},
+			}, nil)
+
+		params, _ := json.Marshal(ListSessionsRequest{Limit: 1, Cursor: after.Encode()})
+		result, err := handlers.HandleListSessions(context.Background(), params)
+		require.NoError(t, err)
+
+		resp := result.(*ListSessionsResponse)
+		require.Len(t, resp.Sessions, 1)
+		next, err := store.ParseSessionCursor(resp.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "sess-1", next.ID)
+
+		_, err = handlers.HandleListSessions(context.Background(), json.RawMessage(`{"cursor":"bogus"}`))
+		assert.ErrorIs(t, err, store.ErrInvalidCursor)
+	})
+}
+
 func TestHandleInterruptSession(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -908,3 +1004,41 @@ func TestHandleUpdateSessionSettings(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to get session")
 	})
 }
+
+func TestHandleSearchConversations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := store.NewMockConversationStore(ctrl)
+	handlers := NewSessionHandlers(session.NewMockSessionManager(ctrl), mockStore, approval.NewMockManager(ctrl))
+
+	t.Run("search with filters", func(t *testing.T) {
+		after := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+		mockStore.EXPECT().
+			SearchConversations(gomock.Any(), store.ConversationSearch{
+				Query:     "payments.go",
+				ToolNames: []string{"Edit"},
+				After:     &after,
+			}).
+			Return([]*store.ConversationSearchResult{{
+				Session: &store.Session{ID: "sess-1", Status: store.SessionStatusCompleted},
+				Matches: []store.ConversationMatch{{EventID: 3, ClaudeSessionID: "claude-1", Sequence: 9, Snippet: "payments.go"}},
+			}}, nil)
+
+		params := json.RawMessage(`{"query":"payments.go","tool_names":["Edit"],"after":"2026-03-01T00:00:00Z"}`)
+		result, err := handlers.HandleSearchConversations(context.Background(), params)
+		require.NoError(t, err)
+
+		resp := result.(*SearchConversationsResponse)
+		require.Len(t, resp.Results, 1)
+		assert.Equal(t, "sess-1", resp.Results[0].Session.ID)
+		assert.Equal(t, 9, resp.Results[0].Matches[0].Sequence)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		_, err := handlers.HandleSearchConversations(context.Background(), json.RawMessage(`{"query":" "}`))
+		assert.ErrorContains(t, err, "search query is required")
+		_, err = handlers.HandleSearchConversations(context.Background(), json.RawMessage(`{"query":"x","after":"yesterday"}`))
+		assert.ErrorContains(t, err, "invalid after")
+	})
+}
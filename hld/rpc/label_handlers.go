@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// HandleListLabels handles the ListLabels RPC method
+func (h *SessionHandlers) HandleListLabels(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	labels, err := h.store.ListLabels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	response := &ListLabelsResponse{Labels: make([]LabelInfo, 0, len(labels))}
+	for _, label := range labels {
+		response.Labels = append(response.Labels, LabelInfo{
+			ID:           label.ID,
+			Name:         label.Name,
+			Color:        label.Color,
+			SessionCount: label.SessionCount,
+			CreatedAt:    label.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return response, nil
+}
+
+// HandleSetSessionLabels handles the SetSessionLabels RPC method
+func (h *SessionHandlers) HandleSetSessionLabels(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req SetSessionLabelsRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	// Validate required fields
+	if req.SessionID == "" {
+		return nil, fmt.Errorf("session_id is required")
+	}
+
+	if err := h.store.SetSessionLabels(ctx, req.SessionID, req.Labels); err != nil {
+		return nil, fmt.Errorf("failed to set session labels: %w", err)
+	}
+
+	labels, err := h.store.GetSessionLabels(ctx, req.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session labels: %w", err)
+	}
+	if labels == nil {
+		labels = []string{}
+	}
+	return &SetSessionLabelsResponse{Labels: labels}, nil
+}
+
+// HandleListProjects handles the ListProjects RPC method
+func (h *SessionHandlers) HandleListProjects(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	projects, err := h.store.ListProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	response := &ListProjectsResponse{Projects: make([]ProjectInfo, 0, len(projects))}
+	for _, project := range projects {
+		info := ProjectInfo{
+			ID:           project.ID,
+			Name:         project.Name,
+			RootPath:     project.RootPath,
+			SessionCount: project.SessionCount,
+		}
+		if project.LastActivityAt != nil {
+			info.LastActivityAt = project.LastActivityAt.Format(time.RFC3339)
+		}
+		response.Projects = append(response.Projects, info)
+	}
+	return response, nil
+}
+
+// HandleListSavedFilters handles the ListSavedFilters RPC method
+func (h *SessionHandlers) HandleListSavedFilters(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	filters, err := h.store.ListSavedFilters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved filters: %w", err)
+	}
+
+	response := &ListSavedFiltersResponse{Filters: make([]SavedFilterInfo, 0, len(filters))}
+	for _, filter := range filters {
+		response.Filters = append(response.Filters, SavedFilterInfo{
+			ID:        filter.ID,
+			Name:      filter.Name,
+			Filter:    filter.Filter,
+			UpdatedAt: filter.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+	return response, nil
+}
@@ -0,0 +1,58 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/humanlayer/humanlayer/hld/maintenance"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// MaintenanceHandlers provides RPC handlers for database maintenance
+type MaintenanceHandlers struct {
+	maintenance *maintenance.Service
+}
+
+// NewMaintenanceHandlers creates new maintenance RPC handlers
+func NewMaintenanceHandlers(maintenance *maintenance.Service) *MaintenanceHandlers {
+	return &MaintenanceHandlers{
+		maintenance: maintenance,
+	}
+}
+
+// Register registers all maintenance handlers with the RPC server
+func (h *MaintenanceHandlers) Register(server *Server) {
+	server.Register("runMaintenance", h.HandleRunMaintenance)
+}
+
+// RunMaintenanceRequest is the request for an on-demand maintenance pass
+type RunMaintenanceRequest struct {
+	DryRun bool   `json:"dry_run,omitempty"`
+	Vacuum string `json:"vacuum,omitempty"` // none, incremental (default) or full
+}
+
+// RunMaintenanceResponse is the response for a maintenance pass
+type RunMaintenanceResponse struct {
+	Report *store.MaintenanceReport `json:"report"`
+}
+
+// HandleRunMaintenance handles the RunMaintenance RPC method
+func (h *MaintenanceHandlers) HandleRunMaintenance(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req RunMaintenanceRequest
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &req); err != nil {
+			return nil, fmt.Errorf("invalid request: %w", err)
+		}
+	}
+
+	report, err := h.maintenance.Run(ctx, maintenance.Options{
+		DryRun: req.DryRun,
+		Vacuum: req.Vacuum,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RunMaintenanceResponse{Report: report}, nil
+}
@@ -0,0 +1,208 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/pipeline"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// PipelineHandlers provides RPC handlers for multi-step session pipelines
+type PipelineHandlers struct {
+	runner *pipeline.Runner
+}
+
+// NewPipelineHandlers creates new pipeline RPC handlers
+func NewPipelineHandlers(runner *pipeline.Runner) *PipelineHandlers {
+	return &PipelineHandlers{
+		runner: runner,
+	}
+}
+
+// Register registers all pipeline handlers with the RPC server
+func (h *PipelineHandlers) Register(server *Server) {
+	server.Register("startPipeline", h.HandleStartPipeline)
+	server.Register("listPipelineRuns", h.HandleListPipelineRuns)
+	server.Register("getPipelineRun", h.HandleGetPipelineRun)
+	server.Register("pausePipelineRun", h.HandlePausePipelineRun)
+	server.Register("resumePipelineRun", h.HandleResumePipelineRun)
+}
+
+// PipelineRun is the RPC representation of a pipeline run
+type PipelineRun struct {
+	ID           string              `json:"id"`
+	Name         string              `json:"name"`
+	Definition   pipeline.Definition `json:"definition"`
+	WorkingDir   string              `json:"working_dir,omitempty"`
+	Status       string              `json:"status"`
+	CurrentStep  int                 `json:"current_step"`
+	ErrorMessage string              `json:"error_message,omitempty"`
+	Steps        []PipelineStep      `json:"steps,omitempty"`
+	CreatedAt    string              `json:"created_at"`
+	UpdatedAt    string              `json:"updated_at"`
+	CompletedAt  string              `json:"completed_at,omitempty"`
+}
+
+// PipelineStep is the RPC representation of a pipeline run step
+type PipelineStep struct {
+	Index       int    `json:"index"`
+	Name        string `json:"name"`
+	SessionID   string `json:"session_id,omitempty"`
+	Status      string `json:"status"`
+	Reason      string `json:"reason,omitempty"`
+	StartedAt   string `json:"started_at,omitempty"`
+	CompletedAt string `json:"completed_at,omitempty"`
+}
+
+// StartPipelineRequest is the request for starting a pipeline run. The
+// definition is given either as an object or as YAML/JSON text.
+type StartPipelineRequest struct {
+	Definition     *pipeline.Definition `json:"definition,omitempty"`
+	DefinitionYAML string               `json:"definition_yaml,omitempty"`
+	WorkingDir     string               `json:"working_dir,omitempty"`
+}
+
+// PipelineRunIDRequest is the request for operations addressing a single pipeline run
+type PipelineRunIDRequest struct {
+	ID string `json:"id"`
+}
+
+// PipelineRunResponse is the response for operations returning a single pipeline run
+type PipelineRunResponse struct {
+	Run PipelineRun `json:"run"`
+}
+
+// ListPipelineRunsResponse is the response for listing pipeline runs
+type ListPipelineRunsResponse struct {
+	Runs []PipelineRun `json:"runs"`
+}
+
+// HandleStartPipeline handles the StartPipeline RPC method
+func (h *PipelineHandlers) HandleStartPipeline(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req StartPipelineRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	def := req.Definition
+	if def == nil {
+		if req.DefinitionYAML == "" {
+			return nil, fmt.Errorf("definition or definition_yaml is required")
+		}
+		parsed, err := pipeline.ParseDefinition([]byte(req.DefinitionYAML))
+		if err != nil {
+			return nil, err
+		}
+		def = parsed
+	}
+
+	run, err := h.runner.StartRun(ctx, def, req.WorkingDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start pipeline: %w", err)
+	}
+	return h.runResponse(ctx, run.ID)
+}
+
+// HandleListPipelineRuns handles the ListPipelineRuns RPC method
+func (h *PipelineHandlers) HandleListPipelineRuns(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	runs, err := h.runner.ListRuns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipeline runs: %w", err)
+	}
+
+	result := make([]PipelineRun, len(runs))
+	for i, run := range runs {
+		result[i] = pipelineRunToRPC(run, nil)
+	}
+	return &ListPipelineRunsResponse{Runs: result}, nil
+}
+
+// HandleGetPipelineRun handles the GetPipelineRun RPC method
+func (h *PipelineHandlers) HandleGetPipelineRun(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req PipelineRunIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	return h.runResponse(ctx, req.ID)
+}
+
+// HandlePausePipelineRun handles the PausePipelineRun RPC method
+func (h *PipelineHandlers) HandlePausePipelineRun(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req PipelineRunIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	if _, err := h.runner.Pause(ctx, req.ID); err != nil {
+		return nil, fmt.Errorf("failed to pause pipeline run: %w", err)
+	}
+	return h.runResponse(ctx, req.ID)
+}
+
+// HandleResumePipelineRun handles the ResumePipelineRun RPC method
+func (h *PipelineHandlers) HandleResumePipelineRun(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req PipelineRunIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	if _, err := h.runner.Resume(ctx, req.ID); err != nil {
+		return nil, fmt.Errorf("failed to resume pipeline run: %w", err)
+	}
+	return h.runResponse(ctx, req.ID)
+}
+
+func (h *PipelineHandlers) runResponse(ctx context.Context, id string) (*PipelineRunResponse, error) {
+	run, steps, err := h.runner.GetRun(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pipeline run: %w", err)
+	}
+	return &PipelineRunResponse{Run: pipelineRunToRPC(run, steps)}, nil
+}
+
+func pipelineRunToRPC(run *store.PipelineRun, steps []*store.PipelineStep) PipelineRun {
+	result := PipelineRun{
+		ID:           run.ID,
+		Name:         run.Name,
+		WorkingDir:   run.WorkingDir,
+		Status:       run.Status,
+		CurrentStep:  run.CurrentStep,
+		ErrorMessage: run.ErrorMessage,
+		CreatedAt:    run.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    run.UpdatedAt.Format(time.RFC3339),
+	}
+	_ = json.Unmarshal([]byte(run.Definition), &result.Definition)
+	if run.CompletedAt != nil {
+		result.CompletedAt = run.CompletedAt.Format(time.RFC3339)
+	}
+
+	for _, step := range steps {
+		rpcStep := PipelineStep{
+			Index:     step.StepIndex,
+			Name:      step.Name,
+			SessionID: step.SessionID,
+			Status:    step.Status,
+			Reason:    step.Reason,
+		}
+		if step.StartedAt != nil {
+			rpcStep.StartedAt = step.StartedAt.Format(time.RFC3339)
+		}
+		if step.CompletedAt != nil {
+			rpcStep.CompletedAt = step.CompletedAt.Format(time.RFC3339)
+		}
+		result.Steps = append(result.Steps, rpcStep)
+	}
+	return result
+}
@@ -0,0 +1,318 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/scheduler"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// ScheduleHandlers provides RPC handlers for recurring session schedules
+type ScheduleHandlers struct {
+	scheduler *scheduler.Scheduler
+}
+
+// NewScheduleHandlers creates new schedule RPC handlers
+func NewScheduleHandlers(scheduler *scheduler.Scheduler) *ScheduleHandlers {
+	return &ScheduleHandlers{
+		scheduler: scheduler,
+	}
+}
+
+// Register registers all schedule handlers with the RPC server
+func (h *ScheduleHandlers) Register(server *Server) {
+	server.Register("createSchedule", h.HandleCreateSchedule)
+	server.Register("listSchedules", h.HandleListSchedules)
+	server.Register("getSchedule", h.HandleGetSchedule)
+	server.Register("updateSchedule", h.HandleUpdateSchedule)
+	server.Register("deleteSchedule", h.HandleDeleteSchedule)
+	server.Register("listScheduleRuns", h.HandleListScheduleRuns)
+	server.Register("triggerSchedule", h.HandleTriggerSchedule)
+}
+
+// Schedule is the RPC representation of a schedule
+type Schedule struct {
+	ID             string                   `json:"id"`
+	Name           string                   `json:"name"`
+	CronExpression string                   `json:"cron_expression"`
+	Timezone       string                   `json:"timezone"`
+	WorkingDir     string                   `json:"working_dir,omitempty"`
+	LaunchConfig   scheduler.LaunchTemplate `json:"launch_config"`
+	ProxyAPIKeySet bool                     `json:"proxy_api_key_set"`
+	OverlapPolicy  string                   `json:"overlap_policy"`
+	CatchUpPolicy  string                   `json:"catch_up_policy"`
+	Enabled        bool                     `json:"enabled"`
+	NextRunAt      string                   `json:"next_run_at,omitempty"`
+	LastRunAt      string                   `json:"last_run_at,omitempty"`
+	CreatedAt      string                   `json:"created_at"`
+	UpdatedAt      string                   `json:"updated_at"`
+}
+
+// ScheduleRun is the RPC representation of a schedule run
+type ScheduleRun struct {
+	ID            int64  `json:"id"`
+	ScheduleID    string `json:"schedule_id"`
+	SessionID     string `json:"session_id,omitempty"`
+	SessionStatus string `json:"session_status,omitempty"`
+	ScheduledFor  string `json:"scheduled_for"`
+	Status        string `json:"status"`
+	Reason        string `json:"reason,omitempty"`
+	CatchUp       bool   `json:"catch_up"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// CreateScheduleRequest is the request for creating a schedule
+type CreateScheduleRequest struct {
+	Name           string                   `json:"name"`
+	CronExpression string                   `json:"cron_expression"`
+	Timezone       string                   `json:"timezone,omitempty"`
+	WorkingDir     string                   `json:"working_dir,omitempty"`
+	LaunchConfig   scheduler.LaunchTemplate `json:"launch_config"`
+	OverlapPolicy  string                   `json:"overlap_policy,omitempty"`
+	CatchUpPolicy  string                   `json:"catch_up_policy,omitempty"`
+	Enabled        *bool                    `json:"enabled,omitempty"`
+}
+
+// ScheduleResponse is the response for schedule operations returning a single schedule
+type ScheduleResponse struct {
+	Schedule Schedule `json:"schedule"`
+}
+
+// ListSchedulesResponse is the response for listing schedules
+type ListSchedulesResponse struct {
+	Schedules []Schedule `json:"schedules"`
+}
+
+// ScheduleIDRequest is the request for operations addressing a single schedule
+type ScheduleIDRequest struct {
+	ID string `json:"id"`
+}
+
+// UpdateScheduleRequest is the request for updating a schedule
+type UpdateScheduleRequest struct {
+	ID             string                    `json:"id"`
+	Name           *string                   `json:"name,omitempty"`
+	CronExpression *string                   `json:"cron_expression,omitempty"`
+	Timezone       *string                   `json:"timezone,omitempty"`
+	WorkingDir     *string                   `json:"working_dir,omitempty"`
+	LaunchConfig   *scheduler.LaunchTemplate `json:"launch_config,omitempty"`
+	OverlapPolicy  *string                   `json:"overlap_policy,omitempty"`
+	CatchUpPolicy  *string                   `json:"catch_up_policy,omitempty"`
+	Enabled        *bool                     `json:"enabled,omitempty"`
+}
+
+// DeleteScheduleResponse is the response for deleting a schedule
+type DeleteScheduleResponse struct {
+	Success bool `json:"success"`
+}
+
+// ListScheduleRunsRequest is the request for a schedule's run history
+type ListScheduleRunsRequest struct {
+	ID    string `json:"id"`
+	Limit int    `json:"limit,omitempty"`
+}
+
+// ListScheduleRunsResponse is the response for a schedule's run history
+type ListScheduleRunsResponse struct {
+	Runs []ScheduleRun `json:"runs"`
+}
+
+// TriggerScheduleResponse is the response for triggering a schedule
+type TriggerScheduleResponse struct {
+	Run ScheduleRun `json:"run"`
+}
+
+// HandleCreateSchedule handles the CreateSchedule RPC method
+func (h *ScheduleHandlers) HandleCreateSchedule(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req CreateScheduleRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	launchConfig, err := json.Marshal(req.LaunchConfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid launch_config: %w", err)
+	}
+
+	sched, err := h.scheduler.CreateSchedule(ctx, &store.Schedule{
+		Name:          req.Name,
+		CronExpr:      req.CronExpression,
+		Timezone:      req.Timezone,
+		WorkingDir:    req.WorkingDir,
+		LaunchConfig:  string(launchConfig),
+		OverlapPolicy: req.OverlapPolicy,
+		CatchUpPolicy: req.CatchUpPolicy,
+		Enabled:       req.Enabled == nil || *req.Enabled,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	return &ScheduleResponse{Schedule: scheduleToRPC(sched)}, nil
+}
+
+// HandleListSchedules handles the ListSchedules RPC method
+func (h *ScheduleHandlers) HandleListSchedules(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	schedules, err := h.scheduler.ListSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	result := make([]Schedule, len(schedules))
+	for i, sched := range schedules {
+		result[i] = scheduleToRPC(sched)
+	}
+	return &ListSchedulesResponse{Schedules: result}, nil
+}
+
+// HandleGetSchedule handles the GetSchedule RPC method
+func (h *ScheduleHandlers) HandleGetSchedule(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req ScheduleIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	sched, err := h.scheduler.GetSchedule(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+	return &ScheduleResponse{Schedule: scheduleToRPC(sched)}, nil
+}
+
+// HandleUpdateSchedule handles the UpdateSchedule RPC method
+func (h *ScheduleHandlers) HandleUpdateSchedule(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req UpdateScheduleRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	updates := store.ScheduleUpdate{
+		Name:          req.Name,
+		CronExpr:      req.CronExpression,
+		Timezone:      req.Timezone,
+		WorkingDir:    req.WorkingDir,
+		OverlapPolicy: req.OverlapPolicy,
+		CatchUpPolicy: req.CatchUpPolicy,
+		Enabled:       req.Enabled,
+	}
+	if req.LaunchConfig != nil {
+		launchConfig, err := json.Marshal(req.LaunchConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid launch_config: %w", err)
+		}
+		encoded := string(launchConfig)
+		updates.LaunchConfig = &encoded
+	}
+
+	sched, err := h.scheduler.UpdateSchedule(ctx, req.ID, updates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update schedule: %w", err)
+	}
+	return &ScheduleResponse{Schedule: scheduleToRPC(sched)}, nil
+}
+
+// HandleDeleteSchedule handles the DeleteSchedule RPC method
+func (h *ScheduleHandlers) HandleDeleteSchedule(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req ScheduleIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	if err := h.scheduler.DeleteSchedule(ctx, req.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete schedule: %w", err)
+	}
+	return &DeleteScheduleResponse{Success: true}, nil
+}
+
+// HandleListScheduleRuns handles the ListScheduleRuns RPC method
+func (h *ScheduleHandlers) HandleListScheduleRuns(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req ListScheduleRunsRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	if req.Limit <= 0 {
+		req.Limit = 50
+	}
+
+	runs, err := h.scheduler.ListRuns(ctx, req.ID, req.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedule runs: %w", err)
+	}
+
+	result := make([]ScheduleRun, len(runs))
+	for i, run := range runs {
+		result[i] = scheduleRunToRPC(run)
+	}
+	return &ListScheduleRunsResponse{Runs: result}, nil
+}
+
+// HandleTriggerSchedule handles the TriggerSchedule RPC method
+func (h *ScheduleHandlers) HandleTriggerSchedule(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req ScheduleIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	run, err := h.scheduler.TriggerSchedule(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to trigger schedule: %w", err)
+	}
+	return &TriggerScheduleResponse{Run: scheduleRunToRPC(run)}, nil
+}
+
+func scheduleToRPC(sched *store.Schedule) Schedule {
+	result := Schedule{
+		ID:             sched.ID,
+		Name:           sched.Name,
+		CronExpression: sched.CronExpr,
+		Timezone:       sched.Timezone,
+		WorkingDir:     sched.WorkingDir,
+		OverlapPolicy:  sched.OverlapPolicy,
+		CatchUpPolicy:  sched.CatchUpPolicy,
+		Enabled:        sched.Enabled,
+		CreatedAt:      sched.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      sched.UpdatedAt.Format(time.RFC3339),
+	}
+	_ = json.Unmarshal([]byte(sched.LaunchConfig), &result.LaunchConfig)
+	result.ProxyAPIKeySet = result.LaunchConfig.ProxyAPIKey != ""
+	result.LaunchConfig.ProxyAPIKey = ""
+	if sched.NextRunAt != nil {
+		result.NextRunAt = sched.NextRunAt.Format(time.RFC3339)
+	}
+	if sched.LastRunAt != nil {
+		result.LastRunAt = sched.LastRunAt.Format(time.RFC3339)
+	}
+	return result
+}
+
+func scheduleRunToRPC(run *store.ScheduleRun) ScheduleRun {
+	return ScheduleRun{
+		ID:            run.ID,
+		ScheduleID:    run.ScheduleID,
+		SessionID:     run.SessionID,
+		SessionStatus: run.SessionStatus,
+		ScheduledFor:  run.ScheduledFor.Format(time.RFC3339),
+		Status:        run.Status,
+		Reason:        run.Reason,
+		CatchUp:       run.CatchUp,
+		CreatedAt:     run.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// HandleSearchConversations handles the SearchConversations RPC method
+func (h *SessionHandlers) HandleSearchConversations(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req SearchConversationsRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	search := store.ConversationSearch{
+		Query:             req.Query,
+		Roles:             req.Roles,
+		EventTypes:        req.EventTypes,
+		ToolNames:         req.ToolNames,
+		SessionID:         req.SessionID,
+		ProjectID:         req.ProjectID,
+		Limit:             req.Limit,
+		MatchesPerSession: req.MatchesPerSession,
+	}
+	var err error
+	if search.After, err = parseOptionalTime("after", req.After); err != nil {
+		return nil, err
+	}
+	if search.Before, err = parseOptionalTime("before", req.Before); err != nil {
+		return nil, err
+	}
+	if err := search.Validate(); err != nil {
+		return nil, err
+	}
+
+	results, err := h.store.SearchConversations(ctx, search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search conversations: %w", err)
+	}
+
+	response := &SearchConversationsResponse{Results: make([]ConversationSearchResult, 0, len(results))}
+	for _, result := range results {
+		matches := make([]ConversationSearchMatch, 0, len(result.Matches))
+		for _, match := range result.Matches {
+			matches = append(matches, ConversationSearchMatch{
+				EventID:         match.EventID,
+				ClaudeSessionID: match.ClaudeSessionID,
+				Sequence:        match.Sequence,
+				EventType:       match.EventType,
+				Role:            match.Role,
+				ToolName:        match.ToolName,
+				CreatedAt:       match.CreatedAt.Format(time.RFC3339),
+				Snippet:         match.Snippet,
+				Highlights:      match.Highlights,
+			})
+		}
+		response.Results = append(response.Results, ConversationSearchResult{
+			Session: session.InfoFromStore(result.Session),
+			Matches: matches,
+		})
+	}
+	return response, nil
+}
+
+// parseOptionalTime parses an optional ISO 8601 request field
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", field, err)
+	}
+	return &t, nil
+}
@@ -33,6 +33,13 @@ type SubscribeRequest struct {
 	EventTypes []string `json:"event_types,omitempty"` // Optional filter by event types
 	SessionID  string   `json:"session_id,omitempty"`  // Optional filter by session
 	RunID      string   `json:"run_id,omitempty"`      // Optional filter by run ID
+	// Since resumes after the event with this sequence number, replaying the
+	// kept events published after it before live ones
+	Since *uint64 `json:"since,omitempty"`
+	// BufferSize and Overflow set how many events are buffered for a slow
+	// client and what happens when they do not fit
+	BufferSize int    `json:"buffer_size,omitempty"`
+	Overflow   string `json:"overflow,omitempty"`
 }
 
 // SubscribeResponse is sent when subscription is established
@@ -78,6 +85,18 @@ func (h *SubscriptionHandlers) SubscribeConn(ctx context.Context, conn net.Conn,
 		}
 	}
 
+	overflow := bus.OverflowPolicy(req.Overflow)
+	if !overflow.IsValid() || req.BufferSize < 0 {
+		resp := &Response{
+			JSONRPC: "2.0",
+			Error: &Error{
+				Code:    InvalidParams,
+				Message: fmt.Sprintf("invalid subscription options: buffer_size %d, overflow %q", req.BufferSize, req.Overflow),
+			},
+		}
+		return sendJSONResponse(conn, resp)
+	}
+
 	// Convert string event types to bus.EventType
 	var eventTypes []bus.EventType
 	for _, t := range req.EventTypes {
@@ -92,7 +111,11 @@ func (h *SubscriptionHandlers) SubscribeConn(ctx context.Context, conn net.Conn,
 	}
 
 	// Subscribe to events
-	sub := h.eventBus.Subscribe(ctx, filter)
+	sub := h.eventBus.SubscribeWith(ctx, filter, bus.SubscribeOptions{
+		BufferSize: req.BufferSize,
+		Overflow:   overflow,
+		Since:      req.Since,
+	})
 	defer func() {
 		slog.Debug("subscription handler cleaning up", "subscription_id", sub.ID)
 		h.eventBus.Unsubscribe(sub.ID)
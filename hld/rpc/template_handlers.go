@@ -0,0 +1,243 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/templates"
+)
+
+// TemplateHandlers provides RPC handlers for session templates
+type TemplateHandlers struct {
+	templates *templates.Service
+}
+
+// NewTemplateHandlers creates new template RPC handlers
+func NewTemplateHandlers(templates *templates.Service) *TemplateHandlers {
+	return &TemplateHandlers{
+		templates: templates,
+	}
+}
+
+// Register registers all template handlers with the RPC server
+func (h *TemplateHandlers) Register(server *Server) {
+	server.Register("createTemplate", h.HandleCreateTemplate)
+	server.Register("listTemplates", h.HandleListTemplates)
+	server.Register("getTemplate", h.HandleGetTemplate)
+	server.Register("updateTemplate", h.HandleUpdateTemplate)
+	server.Register("deleteTemplate", h.HandleDeleteTemplate)
+	server.Register("launchTemplate", h.HandleLaunchTemplate)
+	server.Register("exportTemplate", h.HandleExportTemplate)
+	server.Register("importTemplate", h.HandleImportTemplate)
+}
+
+// SessionTemplate is the RPC representation of a session template. The proxy
+// API key is never returned, only whether one is set.
+type SessionTemplate struct {
+	ID string `json:"id"`
+	templates.Template
+	ProxyAPIKeySet bool     `json:"proxy_api_key_set"`
+	Placeholders   []string `json:"placeholders"`
+	CreatedAt      string   `json:"created_at"`
+	UpdatedAt      string   `json:"updated_at"`
+}
+
+// TemplateIDRequest is the request for operations addressing a single template
+type TemplateIDRequest struct {
+	ID string `json:"id"`
+}
+
+// UpdateTemplateRequest is the request for replacing a template's configuration
+type UpdateTemplateRequest struct {
+	ID       string             `json:"id"`
+	Template templates.Template `json:"template"`
+}
+
+// LaunchTemplateRequest is the request for launching a session from a template
+type LaunchTemplateRequest struct {
+	ID         string            `json:"id"`
+	Variables  map[string]string `json:"variables,omitempty"`
+	WorkingDir string            `json:"working_dir,omitempty"`
+	Title      string            `json:"title,omitempty"`
+}
+
+// LaunchTemplateResponse is the response for launching a session from a template
+type LaunchTemplateResponse struct {
+	SessionID string `json:"session_id"`
+	RunID     string `json:"run_id"`
+}
+
+// ImportTemplateRequest is the request for importing a template file
+type ImportTemplateRequest struct {
+	Content   string `json:"content"`
+	Overwrite bool   `json:"overwrite,omitempty"`
+}
+
+// TemplateResponse is the response for operations returning a single template
+type TemplateResponse struct {
+	Template SessionTemplate `json:"template"`
+}
+
+// ListTemplatesResponse is the response for listing templates
+type ListTemplatesResponse struct {
+	Templates []SessionTemplate `json:"templates"`
+}
+
+// DeleteTemplateResponse is the response for deleting a template
+type DeleteTemplateResponse struct {
+	Success bool `json:"success"`
+}
+
+// ExportTemplateResponse is the response for exporting a template
+type ExportTemplateResponse struct {
+	Filename string `json:"filename"`
+	Content  string `json:"content"`
+}
+
+// HandleCreateTemplate handles the CreateTemplate RPC method
+func (h *TemplateHandlers) HandleCreateTemplate(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req templates.Template
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+
+	tmpl, err := h.templates.Create(ctx, &req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create template: %w", err)
+	}
+	return &TemplateResponse{Template: templateToRPC(tmpl)}, nil
+}
+
+// HandleListTemplates handles the ListTemplates RPC method
+func (h *TemplateHandlers) HandleListTemplates(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	list, err := h.templates.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	result := make([]SessionTemplate, len(list))
+	for i, tmpl := range list {
+		result[i] = templateToRPC(tmpl)
+	}
+	return &ListTemplatesResponse{Templates: result}, nil
+}
+
+// HandleGetTemplate handles the GetTemplate RPC method
+func (h *TemplateHandlers) HandleGetTemplate(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req TemplateIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	tmpl, err := h.templates.Get(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+	return &TemplateResponse{Template: templateToRPC(tmpl)}, nil
+}
+
+// HandleUpdateTemplate handles the UpdateTemplate RPC method
+func (h *TemplateHandlers) HandleUpdateTemplate(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req UpdateTemplateRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	tmpl, err := h.templates.Update(ctx, req.ID, &req.Template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update template: %w", err)
+	}
+	return &TemplateResponse{Template: templateToRPC(tmpl)}, nil
+}
+
+// HandleDeleteTemplate handles the DeleteTemplate RPC method
+func (h *TemplateHandlers) HandleDeleteTemplate(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req TemplateIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	if err := h.templates.Delete(ctx, req.ID); err != nil {
+		return nil, fmt.Errorf("failed to delete template: %w", err)
+	}
+	return &DeleteTemplateResponse{Success: true}, nil
+}
+
+// HandleLaunchTemplate handles the LaunchTemplate RPC method
+func (h *TemplateHandlers) HandleLaunchTemplate(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req LaunchTemplateRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	sess, err := h.templates.Launch(ctx, req.ID, templates.LaunchOptions{
+		Variables:  req.Variables,
+		WorkingDir: req.WorkingDir,
+		Title:      req.Title,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch template: %w", err)
+	}
+	return &LaunchTemplateResponse{SessionID: sess.ID, RunID: sess.RunID}, nil
+}
+
+// HandleExportTemplate handles the ExportTemplate RPC method
+func (h *TemplateHandlers) HandleExportTemplate(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req TemplateIDRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+
+	filename, content, err := h.templates.Export(ctx, req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export template: %w", err)
+	}
+	return &ExportTemplateResponse{Filename: filename, Content: string(content)}, nil
+}
+
+// HandleImportTemplate handles the ImportTemplate RPC method
+func (h *TemplateHandlers) HandleImportTemplate(ctx context.Context, params json.RawMessage) (interface{}, error) {
+	var req ImportTemplateRequest
+	if err := json.Unmarshal(params, &req); err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if req.Content == "" {
+		return nil, fmt.Errorf("content is required")
+	}
+
+	tmpl, err := h.templates.Import(ctx, []byte(req.Content), req.Overwrite)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import template: %w", err)
+	}
+	return &TemplateResponse{Template: templateToRPC(tmpl)}, nil
+}
+
+func templateToRPC(tmpl *templates.Template) SessionTemplate {
+	result := SessionTemplate{
+		ID:             tmpl.ID,
+		Template:       *tmpl,
+		ProxyAPIKeySet: tmpl.ProxyAPIKey != "",
+		Placeholders:   tmpl.Placeholders(),
+		CreatedAt:      tmpl.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      tmpl.UpdatedAt.Format(time.RFC3339),
+	}
+	result.ProxyAPIKey = ""
+	return result
+}
@@ -1,5 +1,10 @@
 package rpc
 
+import (
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
 // HealthCheckRequest is the request for health check RPC
 type HealthCheckRequest struct{}
 
@@ -13,6 +18,9 @@ type HealthCheckResponse struct {
 type GetConversationRequest struct {
 	SessionID       string `json:"session_id,omitempty"`        // Get by session ID
 	ClaudeSessionID string `json:"claude_session_id,omitempty"` // Get by Claude session ID
+	Limit           int    `json:"limit,omitempty"`             // Maximum events to return, 0 for all
+	Cursor          string `json:"cursor,omitempty"`            // next_cursor of a previous response
+	SinceSequence   int    `json:"since_sequence,omitempty"`    // Only newer events of the latest Claude session
 }
 
 // ConversationEvent represents a single event in the conversation
@@ -42,11 +50,18 @@ type ConversationEvent struct {
 	IsCompleted    bool   `json:"is_completed"`
 	ApprovalStatus string `json:"approval_status,omitempty"` // NULL, 'pending', 'approved', 'denied'
 	ApprovalID     string `json:"approval_id,omitempty"`
+
+	// Compaction fields
+	CompactionTrigger    string `json:"compaction_trigger,omitempty"` // manual, auto
+	PreCompactionTokens  *int   `json:"pre_compaction_tokens,omitempty"`
+	PostCompactionTokens *int   `json:"post_compaction_tokens,omitempty"`
 }
 
 // GetConversationResponse is the response for fetching conversation history
 type GetConversationResponse struct {
-	Events []ConversationEvent `json:"events"`
+	Events     []ConversationEvent `json:"events"`
+	NextCursor string              `json:"next_cursor,omitempty"` // Position after the last event, for the next page or polling
+	HasMore    bool                `json:"has_more"`
 }
 
 // GetSessionStateRequest is the request for fetching session state
@@ -108,6 +123,137 @@ type FileSnapshotInfo struct {
 	CreatedAt string `json:"created_at"` // ISO 8601 format
 }
 
+// GetSessionResourcesRequest requests resource usage for a session
+type GetSessionResourcesRequest struct {
+	SessionID string `json:"session_id"`
+	Limit     int    `json:"limit,omitempty"` // Most recent samples to return, defaults to 240
+}
+
+// GetSessionResourcesResponse contains the latest and peak usage of the
+// session's process tree and its recent samples, oldest first
+type GetSessionResourcesResponse struct {
+	Resources *session.ResourceUsage `json:"resources,omitempty"`
+	Samples   []ResourceSampleInfo   `json:"samples"`
+}
+
+// GetSessionEffectiveConfigRequest requests the configuration a session was launched with
+type GetSessionEffectiveConfigRequest struct {
+	SessionID string `json:"session_id"`
+}
+
+// GetSessionEffectiveConfigResponse contains the session's effective
+// configuration, or nil for sessions created before it was recorded
+type GetSessionEffectiveConfigResponse struct {
+	Config *session.EffectiveConfig `json:"config"`
+}
+
+// ValidateProjectConfigRequest requests validation of a project configuration
+type ValidateProjectConfigRequest struct {
+	WorkingDir string  `json:"working_dir"`
+	Content    *string `json:"content,omitempty"` // Validated in place of the file when set
+}
+
+// LabelInfo is a label and the number of sessions carrying it
+type LabelInfo struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Color        string `json:"color,omitempty"`
+	SessionCount int    `json:"session_count"`
+	CreatedAt    string `json:"created_at"` // ISO 8601 format
+}
+
+// ListLabelsResponse is the response for listing labels
+type ListLabelsResponse struct {
+	Labels []LabelInfo `json:"labels"`
+}
+
+// SetSessionLabelsRequest replaces a session's labels, creating missing ones
+type SetSessionLabelsRequest struct {
+	SessionID string   `json:"session_id"`
+	Labels    []string `json:"labels"`
+}
+
+// SetSessionLabelsResponse contains the session's labels after the change
+type SetSessionLabelsResponse struct {
+	Labels []string `json:"labels"`
+}
+
+// ProjectInfo is a group of sessions working in one repository
+type ProjectInfo struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	RootPath       string `json:"root_path"`
+	SessionCount   int    `json:"session_count"`
+	LastActivityAt string `json:"last_activity_at,omitempty"` // ISO 8601 format
+}
+
+// ListProjectsResponse is the response for listing projects
+type ListProjectsResponse struct {
+	Projects []ProjectInfo `json:"projects"`
+}
+
+// SavedFilterInfo is a named session filter
+type SavedFilterInfo struct {
+	ID        string              `json:"id"`
+	Name      string              `json:"name"`
+	Filter    store.SessionFilter `json:"filter"`
+	UpdatedAt string              `json:"updated_at"` // ISO 8601 format
+}
+
+// ListSavedFiltersResponse is the response for listing saved filters
+type ListSavedFiltersResponse struct {
+	Filters []SavedFilterInfo `json:"filters"`
+}
+
+// SearchConversationsRequest is the request for searching conversation content
+type SearchConversationsRequest struct {
+	Query             string   `json:"query"`                 // Every word must match
+	Roles             []string `json:"roles,omitempty"`       // Only events with one of these roles
+	EventTypes        []string `json:"event_types,omitempty"` // Only events of one of these types
+	ToolNames         []string `json:"tool_names,omitempty"`  // Only calls to, and results of, these tools
+	SessionID         string   `json:"session_id,omitempty"`
+	ProjectID         string   `json:"project_id,omitempty"`
+	After             string   `json:"after,omitempty"`  // ISO 8601, events at or after
+	Before            string   `json:"before,omitempty"` // ISO 8601, events before
+	Limit             int      `json:"limit,omitempty"`  // Sessions, default 20
+	MatchesPerSession int      `json:"matches_per_session,omitempty"`
+}
+
+// SearchConversationsResponse is the response for searching conversation content
+type SearchConversationsResponse struct {
+	Results []ConversationSearchResult `json:"results"`
+}
+
+// ConversationSearchResult is a session with its matching events, most recent first
+type ConversationSearchResult struct {
+	Session session.Info              `json:"session"`
+	Matches []ConversationSearchMatch `json:"matches"`
+}
+
+// ConversationSearchMatch is one matching event. claude_session_id and
+// sequence locate it in the conversation returned by getConversation.
+type ConversationSearchMatch struct {
+	EventID         int64             `json:"event_id"`
+	ClaudeSessionID string            `json:"claude_session_id"`
+	Sequence        int               `json:"sequence"`
+	EventType       string            `json:"event_type"`
+	Role            string            `json:"role,omitempty"`
+	ToolName        string            `json:"tool_name,omitempty"`
+	CreatedAt       string            `json:"created_at"` // ISO 8601 format
+	Snippet         string            `json:"snippet"`
+	Highlights      []store.TextRange `json:"highlights"` // Character offsets into snippet
+}
+
+// ResourceSampleInfo is one resource usage sample of a session's process tree
+type ResourceSampleInfo struct {
+	SampledAt    string  `json:"sampled_at"` // ISO 8601 format
+	CPUTimeMs    int64   `json:"cpu_time_ms"`
+	CPUPercent   float64 `json:"cpu_percent"`
+	RSSBytes     int64   `json:"rss_bytes"`
+	OpenFDs      int64   `json:"open_fds"`
+	ProcessCount int     `json:"process_count"`
+}
+
 // ContinueSessionRequest is the request for continuing an existing session
 type ContinueSessionRequest struct {
 	SessionID             string   `json:"session_id"`                       // The session to continue (required)
@@ -135,6 +281,12 @@ type ContinueSessionResponse struct {
 	ParentSessionID string `json:"parent_session_id"` // The parent session ID
 }
 
+// CompactSessionRequest is the request for compacting a session's conversation
+type CompactSessionRequest struct {
+	SessionID    string `json:"session_id"`             // The session to compact (required)
+	Instructions string `json:"instructions,omitempty"` // Optional focus for the summary
+}
+
 // InterruptSessionRequest is the request for interrupting a session
 type InterruptSessionRequest struct {
 	SessionID string `json:"session_id"`
@@ -153,6 +305,8 @@ type UpdateSessionSettingsRequest struct {
 	AutoAcceptEdits                     *bool  `json:"auto_accept_edits,omitempty"`
 	DangerouslySkipPermissions          *bool  `json:"dangerously_skip_permissions,omitempty"`
 	DangerouslySkipPermissionsTimeoutMs *int64 `json:"dangerously_skip_permissions_timeout_ms,omitempty"`
+	StallThresholdMs                    *int64 `json:"stall_threshold_ms,omitempty"`
+	StallInterruptThresholdMs           *int64 `json:"stall_interrupt_threshold_ms,omitempty"`
 }
 
 // UpdateSessionSettingsResponse is the response for updating session settings
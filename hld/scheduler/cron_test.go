@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCron_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"1,,2 * * * *",
+	}
+
+	for _, expr := range tests {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("expected error for %q", expr)
+		}
+	}
+}
+
+func TestCronExpr_Next(t *testing.T) {
+	base := time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC) // Friday
+
+	tests := []struct {
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{"* * * * *", base, base.Add(time.Minute)},
+		{"*/15 * * * *", base, time.Date(2025, 3, 14, 10, 45, 0, 0, time.UTC)},
+		{"0 2 * * *", base, time.Date(2025, 3, 15, 2, 0, 0, 0, time.UTC)},
+		{"@daily", base, time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", base, time.Date(2025, 3, 14, 11, 0, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", base, time.Date(2025, 3, 17, 9, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", base, time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", base, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"30 10 14 3 *", base, time.Date(2026, 3, 14, 10, 30, 0, 0, time.UTC)},
+		{"0 12 29 2 *", base, time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC)},
+		// Day-of-month OR day-of-week when both are restricted
+		{"0 0 20 * 0", base, time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{"5/20 * * * *", base, time.Date(2025, 3, 14, 10, 45, 0, 0, time.UTC)},
+		{"0 8,17 * * *", base, time.Date(2025, 3, 14, 17, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := ParseCron(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCron(%q) failed: %v", tt.expr, err)
+			}
+			if got := c.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronExpr_NextImpossible(t *testing.T) {
+	c, err := ParseCron("0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("ParseCron failed: %v", err)
+	}
+	if got := c.Next(time.Now()); !got.IsZero() {
+		t.Errorf("expected zero time for impossible schedule, got %v", got)
+	}
+}
+
+func TestCronExpr_NextInLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	c, err := ParseCron("0 2 * * *")
+	if err != nil {
+		t.Fatalf("ParseCron failed: %v", err)
+	}
+
+	from := time.Date(2025, 3, 14, 23, 0, 0, 0, time.UTC).In(loc) // 01:00 local
+	want := time.Date(2025, 3, 15, 2, 0, 0, 0, loc)
+	if got := c.Next(from); !got.Equal(want) {
+		t.Errorf("Next = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,532 @@
+// Package scheduler launches sessions on recurring cron schedules.
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+const (
+	// maxCatchUpRuns bounds how many missed occurrences the "all" catch-up
+	// policy replays after downtime
+	maxCatchUpRuns = 10
+
+	// minCatchUpGrace is the minimum lateness after which an occurrence is
+	// treated as missed rather than merely picked up late by the ticker
+	minCatchUpGrace = time.Minute
+)
+
+// LaunchTemplate is the launch configuration stored with a schedule. Every
+// run of the schedule launches a new session from it.
+type LaunchTemplate struct {
+	Query                             string                `json:"query"`
+	Title                             string                `json:"title,omitempty"`
+	Model                             string                `json:"model,omitempty"`
+	MCPConfig                         *claudecode.MCPConfig `json:"mcp_config,omitempty"`
+	PermissionPromptTool              string                `json:"permission_prompt_tool,omitempty"`
+	MaxTurns                          int                   `json:"max_turns,omitempty"`
+	SystemPrompt                      string                `json:"system_prompt,omitempty"`
+	AppendSystemPrompt                string                `json:"append_system_prompt,omitempty"`
+	AllowedTools                      []string              `json:"allowed_tools,omitempty"`
+	DisallowedTools                   []string              `json:"disallowed_tools,omitempty"`
+	AdditionalDirectories             []string              `json:"additional_directories,omitempty"`
+	CustomInstructions                string                `json:"custom_instructions,omitempty"`
+	AutoAcceptEdits                   bool                  `json:"auto_accept_edits,omitempty"`
+	DangerouslySkipPermissions        bool                  `json:"dangerously_skip_permissions,omitempty"`
+	DangerouslySkipPermissionsTimeout *int64                `json:"dangerously_skip_permissions_timeout,omitempty"`
+	ProxyEnabled                      bool                  `json:"proxy_enabled,omitempty"`
+	ProxyBaseURL                      string                `json:"proxy_base_url,omitempty"`
+	ProxyModelOverride                string                `json:"proxy_model_override,omitempty"`
+	ProxyAPIKey                       string                `json:"proxy_api_key,omitempty"`
+	CreateDirectoryIfNotExists        bool                  `json:"create_directory_if_not_exists,omitempty"`
+}
+
+// Scheduler stores schedules and launches sessions when they fire
+type Scheduler struct {
+	store    store.ConversationStore
+	sessions session.SessionManager
+	interval time.Duration
+	now      func() time.Time
+
+	// fireMu serializes firing so overlap checks see the previous run
+	fireMu sync.Mutex
+}
+
+// New creates a new scheduler that checks for due schedules every interval
+func New(store store.ConversationStore, sessions session.SessionManager, interval time.Duration) *Scheduler {
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	return &Scheduler{
+		store:    store,
+		sessions: sessions,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Start runs the scheduler loop until ctx is cancelled. The first check runs
+// immediately so runs missed while the daemon was down are caught up on startup.
+func (s *Scheduler) Start(ctx context.Context) {
+	slog.Info("starting session scheduler", "interval", s.interval)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.RunDue(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("session scheduler shutting down")
+			return
+		case <-ticker.C:
+			s.RunDue(ctx)
+		}
+	}
+}
+
+// RunDue fires every enabled schedule whose next run time has passed
+func (s *Scheduler) RunDue(ctx context.Context) {
+	if s.store == nil {
+		return
+	}
+
+	schedules, err := s.store.ListSchedules(ctx)
+	if err != nil {
+		slog.Error("failed to list schedules", "error", err)
+		return
+	}
+
+	now := s.now()
+	for _, sched := range schedules {
+		if !sched.Enabled || sched.NextRunAt == nil || sched.NextRunAt.After(now) {
+			continue
+		}
+		if err := s.runDueSchedule(ctx, sched, now); err != nil {
+			slog.Error("failed to run schedule",
+				"schedule_id", sched.ID,
+				"name", sched.Name,
+				"error", err)
+		}
+	}
+}
+
+// runDueSchedule fires a schedule whose next run is due, applying the catch-up
+// policy when more than one occurrence was missed, and advances next_run_at
+func (s *Scheduler) runDueSchedule(ctx context.Context, sched *store.Schedule, now time.Time) error {
+	cron, loc, err := parseTiming(sched.CronExpr, sched.Timezone)
+	if err != nil {
+		return err
+	}
+
+	// Collect the occurrences that have come due since next_run_at
+	due := []time.Time{*sched.NextRunAt}
+	for {
+		next := cron.Next(due[len(due)-1].In(loc))
+		if next.IsZero() || next.After(now) {
+			break
+		}
+		due = append(due, next)
+		if len(due) > maxCatchUpRuns {
+			due = due[1:]
+		}
+	}
+
+	grace := 2 * s.interval
+	if grace < minCatchUpGrace {
+		grace = minCatchUpGrace
+	}
+	missed := len(due) > 1 || now.Sub(due[0]) > grace
+
+	switch {
+	case !missed:
+		s.fire(ctx, sched, due[0], false)
+	case sched.CatchUpPolicy == store.ScheduleCatchUpAll:
+		for _, at := range due {
+			s.fire(ctx, sched, at, true)
+		}
+	case sched.CatchUpPolicy == store.ScheduleCatchUpNone:
+		s.recordRun(ctx, &store.ScheduleRun{
+			ScheduleID:   sched.ID,
+			ScheduledFor: due[len(due)-1],
+			Status:       store.ScheduleRunStatusSkipped,
+			Reason:       fmt.Sprintf("missed %d run(s) while the daemon was not running", len(due)),
+			CatchUp:      true,
+		})
+	default:
+		s.fire(ctx, sched, due[len(due)-1], true)
+	}
+
+	update := store.ScheduleUpdate{LastRunAt: &now}
+	if next := cron.Next(now.In(loc)); !next.IsZero() {
+		update.NextRunAt = &next
+	}
+	if err := s.store.UpdateSchedule(ctx, sched.ID, update); err != nil {
+		return fmt.Errorf("failed to advance schedule: %w", err)
+	}
+	return nil
+}
+
+// fire launches one run of a schedule, honouring its overlap policy
+func (s *Scheduler) fire(ctx context.Context, sched *store.Schedule, scheduledFor time.Time, catchUp bool) *store.ScheduleRun {
+	s.fireMu.Lock()
+	defer s.fireMu.Unlock()
+
+	run := &store.ScheduleRun{
+		ScheduleID:   sched.ID,
+		ScheduledFor: scheduledFor,
+		CatchUp:      catchUp,
+	}
+
+	if activeID := s.activeSessionID(ctx, sched.ID); activeID != "" {
+		switch sched.OverlapPolicy {
+		case store.ScheduleOverlapAllow:
+			// Launch alongside the previous session
+		case store.ScheduleOverlapInterrupt:
+			if err := s.sessions.InterruptSession(ctx, activeID); err != nil {
+				slog.Warn("failed to interrupt previous scheduled session",
+					"schedule_id", sched.ID,
+					"session_id", activeID,
+					"error", err)
+			}
+		default:
+			run.Status = store.ScheduleRunStatusSkipped
+			run.Reason = fmt.Sprintf("previous session %s is still active", activeID)
+			s.recordRun(ctx, run)
+			return run
+		}
+	}
+
+	config, err := BuildLaunchConfig(sched, scheduledFor)
+	if err != nil {
+		run.Status = store.ScheduleRunStatusFailed
+		run.Reason = err.Error()
+		s.recordRun(ctx, run)
+		return run
+	}
+
+	sess, err := s.sessions.LaunchSession(ctx, config, false)
+	if err != nil {
+		run.Status = store.ScheduleRunStatusFailed
+		run.Reason = err.Error()
+		s.recordRun(ctx, run)
+		return run
+	}
+
+	run.Status = store.ScheduleRunStatusLaunched
+	run.SessionID = sess.ID
+	s.recordRun(ctx, run)
+
+	slog.Info("launched scheduled session",
+		"schedule_id", sched.ID,
+		"name", sched.Name,
+		"session_id", sess.ID,
+		"scheduled_for", scheduledFor,
+		"catch_up", catchUp)
+	return run
+}
+
+// activeSessionID returns the session from the schedule's most recent launched
+// run if that session is still active
+func (s *Scheduler) activeSessionID(ctx context.Context, scheduleID string) string {
+	runs, err := s.store.ListScheduleRuns(ctx, scheduleID, 20)
+	if err != nil {
+		slog.Warn("failed to load schedule runs", "schedule_id", scheduleID, "error", err)
+		return ""
+	}
+	for _, run := range runs {
+		if run.SessionID == "" {
+			continue
+		}
+		switch run.SessionStatus {
+		case store.SessionStatusStarting, store.SessionStatusRunning,
+			store.SessionStatusWaitingInput, store.SessionStatusInterrupting:
+			return run.SessionID
+		}
+		return ""
+	}
+	return ""
+}
+
+func (s *Scheduler) recordRun(ctx context.Context, run *store.ScheduleRun) {
+	if err := s.store.CreateScheduleRun(ctx, run); err != nil {
+		slog.Error("failed to record schedule run",
+			"schedule_id", run.ScheduleID,
+			"status", run.Status,
+			"error", err)
+	}
+	if run.Status != store.ScheduleRunStatusLaunched {
+		slog.Info("scheduled run did not launch",
+			"schedule_id", run.ScheduleID,
+			"status", run.Status,
+			"reason", run.Reason)
+	}
+}
+
+// CreateSchedule validates a new schedule, fills in defaults and computes its
+// first run time
+func (s *Scheduler) CreateSchedule(ctx context.Context, sched *store.Schedule) (*store.Schedule, error) {
+	if sched.ID == "" {
+		sched.ID = uuid.New().String()
+	}
+	if sched.Timezone == "" {
+		sched.Timezone = "UTC"
+	}
+	if sched.OverlapPolicy == "" {
+		sched.OverlapPolicy = store.ScheduleOverlapSkip
+	}
+	if sched.CatchUpPolicy == "" {
+		sched.CatchUpPolicy = store.ScheduleCatchUpOnce
+	}
+
+	if err := Validate(sched); err != nil {
+		return nil, err
+	}
+	s.scheduleNext(sched)
+
+	if err := s.store.CreateSchedule(ctx, sched); err != nil {
+		return nil, err
+	}
+	return s.store.GetSchedule(ctx, sched.ID)
+}
+
+// UpdateSchedule applies updates to a schedule, re-validating it and
+// recomputing the next run when the timing or enabled state changes
+func (s *Scheduler) UpdateSchedule(ctx context.Context, id string, updates store.ScheduleUpdate) (*store.Schedule, error) {
+	sched, err := s.store.GetSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if updates.Name != nil {
+		sched.Name = *updates.Name
+	}
+	if updates.CronExpr != nil {
+		sched.CronExpr = *updates.CronExpr
+	}
+	if updates.Timezone != nil {
+		sched.Timezone = *updates.Timezone
+	}
+	if updates.WorkingDir != nil {
+		sched.WorkingDir = *updates.WorkingDir
+	}
+	if updates.LaunchConfig != nil {
+		launchConfig, err := keepProxyAPIKey(sched.LaunchConfig, *updates.LaunchConfig)
+		if err != nil {
+			return nil, err
+		}
+		sched.LaunchConfig = launchConfig
+		updates.LaunchConfig = &launchConfig
+	}
+	if updates.OverlapPolicy != nil {
+		sched.OverlapPolicy = *updates.OverlapPolicy
+	}
+	if updates.CatchUpPolicy != nil {
+		sched.CatchUpPolicy = *updates.CatchUpPolicy
+	}
+	if updates.Enabled != nil {
+		sched.Enabled = *updates.Enabled
+	}
+
+	if err := Validate(sched); err != nil {
+		return nil, err
+	}
+
+	// Re-enabling a schedule or changing its timing must not replay runs that
+	// were "missed" under the old definition
+	if updates.CronExpr != nil || updates.Timezone != nil || (updates.Enabled != nil && *updates.Enabled) {
+		s.scheduleNext(sched)
+		updates.NextRunAt = sched.NextRunAt
+	}
+
+	if err := s.store.UpdateSchedule(ctx, id, updates); err != nil {
+		return nil, err
+	}
+	return s.store.GetSchedule(ctx, id)
+}
+
+// TriggerSchedule fires a schedule immediately without changing its next run
+func (s *Scheduler) TriggerSchedule(ctx context.Context, id string) (*store.ScheduleRun, error) {
+	sched, err := s.store.GetSchedule(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.fire(ctx, sched, s.now(), false), nil
+}
+
+// GetSchedule returns a schedule by ID
+func (s *Scheduler) GetSchedule(ctx context.Context, id string) (*store.Schedule, error) {
+	return s.store.GetSchedule(ctx, id)
+}
+
+// ListSchedules returns all schedules
+func (s *Scheduler) ListSchedules(ctx context.Context) ([]*store.Schedule, error) {
+	return s.store.ListSchedules(ctx)
+}
+
+// DeleteSchedule removes a schedule and its run history
+func (s *Scheduler) DeleteSchedule(ctx context.Context, id string) error {
+	return s.store.DeleteSchedule(ctx, id)
+}
+
+// ListRuns returns a schedule's run history, newest first
+func (s *Scheduler) ListRuns(ctx context.Context, id string, limit int) ([]*store.ScheduleRun, error) {
+	if _, err := s.store.GetSchedule(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.store.ListScheduleRuns(ctx, id, limit)
+}
+
+// scheduleNext sets the schedule's next run time relative to now
+func (s *Scheduler) scheduleNext(sched *store.Schedule) {
+	cron, loc, err := parseTiming(sched.CronExpr, sched.Timezone)
+	if err != nil {
+		return
+	}
+	if next := cron.Next(s.now().In(loc)); !next.IsZero() {
+		sched.NextRunAt = &next
+	}
+}
+
+// Validate checks a schedule definition
+func Validate(sched *store.Schedule) error {
+	if sched.Name == "" {
+		return &validation.Error{Field: "name", Message: "must not be empty"}
+	}
+	if _, _, err := parseTiming(sched.CronExpr, sched.Timezone); err != nil {
+		return err
+	}
+
+	switch sched.OverlapPolicy {
+	case store.ScheduleOverlapSkip, store.ScheduleOverlapAllow, store.ScheduleOverlapInterrupt:
+	default:
+		return &validation.Error{Field: "overlap_policy", Message: fmt.Sprintf("unknown policy %q", sched.OverlapPolicy)}
+	}
+
+	switch sched.CatchUpPolicy {
+	case store.ScheduleCatchUpNone, store.ScheduleCatchUpOnce, store.ScheduleCatchUpAll:
+	default:
+		return &validation.Error{Field: "catch_up_policy", Message: fmt.Sprintf("unknown policy %q", sched.CatchUpPolicy)}
+	}
+
+	tmpl, err := decodeTemplate(sched.LaunchConfig)
+	if err != nil {
+		return err
+	}
+	if tmpl.Query == "" {
+		return &validation.Error{Field: "launch_config", Message: "query must not be empty"}
+	}
+	switch claudecode.Model(tmpl.Model) {
+	case "", claudecode.ModelOpus, claudecode.ModelSonnet, claudecode.ModelHaiku:
+	default:
+		return &validation.Error{Field: "launch_config", Message: fmt.Sprintf("unknown model %q", tmpl.Model)}
+	}
+	return nil
+}
+
+// BuildLaunchConfig turns a schedule's launch template into a session launch
+// config. The schedule's working directory overrides the template's, and runs
+// without an explicit title are named after the schedule and occurrence.
+func BuildLaunchConfig(sched *store.Schedule, scheduledFor time.Time) (session.LaunchSessionConfig, error) {
+	tmpl, err := decodeTemplate(sched.LaunchConfig)
+	if err != nil {
+		return session.LaunchSessionConfig{}, err
+	}
+
+	_, loc, err := parseTiming(sched.CronExpr, sched.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	title := tmpl.Title
+	if title == "" {
+		title = fmt.Sprintf("%s (%s)", sched.Name, scheduledFor.In(loc).Format("2006-01-02 15:04"))
+	}
+
+	return session.LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{
+			Query:                 tmpl.Query,
+			Model:                 claudecode.Model(tmpl.Model),
+			OutputFormat:          claudecode.OutputStreamJSON,
+			MCPConfig:             tmpl.MCPConfig,
+			PermissionPromptTool:  tmpl.PermissionPromptTool,
+			WorkingDir:            sched.WorkingDir,
+			MaxTurns:              tmpl.MaxTurns,
+			SystemPrompt:          tmpl.SystemPrompt,
+			AppendSystemPrompt:    tmpl.AppendSystemPrompt,
+			AllowedTools:          tmpl.AllowedTools,
+			DisallowedTools:       tmpl.DisallowedTools,
+			AdditionalDirectories: tmpl.AdditionalDirectories,
+			CustomInstructions:    tmpl.CustomInstructions,
+		},
+		Title:                             title,
+		AutoAcceptEdits:                   tmpl.AutoAcceptEdits,
+		DangerouslySkipPermissions:        tmpl.DangerouslySkipPermissions,
+		DangerouslySkipPermissionsTimeout: tmpl.DangerouslySkipPermissionsTimeout,
+		CreateDirectoryIfNotExists:        tmpl.CreateDirectoryIfNotExists,
+		ProxyEnabled:                      tmpl.ProxyEnabled,
+		ProxyBaseURL:                      tmpl.ProxyBaseURL,
+		ProxyModelOverride:                tmpl.ProxyModelOverride,
+		ProxyAPIKey:                       tmpl.ProxyAPIKey,
+	}, nil
+}
+
+// keepProxyAPIKey carries the stored proxy API key over to an updated launch
+// config without one, since the API never returns the key for clients to send
+// back
+func keepProxyAPIKey(existing, updated string) (string, error) {
+	tmpl, err := decodeTemplate(updated)
+	if err != nil {
+		return "", err
+	}
+	if tmpl.ProxyAPIKey != "" {
+		return updated, nil
+	}
+	previous, err := decodeTemplate(existing)
+	if err != nil || previous.ProxyAPIKey == "" {
+		return updated, nil
+	}
+	tmpl.ProxyAPIKey = previous.ProxyAPIKey
+	encoded, err := json.Marshal(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode launch config: %w", err)
+	}
+	return string(encoded), nil
+}
+
+func decodeTemplate(raw string) (*LaunchTemplate, error) {
+	if raw == "" {
+		return nil, &validation.Error{Field: "launch_config", Message: "must not be empty"}
+	}
+	var tmpl LaunchTemplate
+	if err := json.Unmarshal([]byte(raw), &tmpl); err != nil {
+		return nil, &validation.Error{Field: "launch_config", Message: err.Error()}
+	}
+	return &tmpl, nil
+}
+
+func parseTiming(expr, timezone string) (*CronExpr, *time.Location, error) {
+	cron, err := ParseCron(expr)
+	if err != nil {
+		return nil, nil, &validation.Error{Field: "cron_expr", Message: err.Error()}
+	}
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, &validation.Error{Field: "timezone", Message: err.Error()}
+	}
+	return cron, loc, nil
+}
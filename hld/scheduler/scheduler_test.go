@@ -0,0 +1,235 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestScheduler(t *testing.T, now time.Time) (*Scheduler, *store.SQLiteStore, *session.MockSessionManager) {
+	t.Helper()
+
+	sqliteStore, err := store.NewSQLiteStore(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqliteStore.Close() })
+
+	ctrl := gomock.NewController(t)
+	sessions := session.NewMockSessionManager(ctrl)
+
+	s := New(sqliteStore, sessions, time.Minute)
+	s.now = func() time.Time { return now }
+	return s, sqliteStore, sessions
+}
+
+func launchConfigJSON(t *testing.T, tmpl LaunchTemplate) string {
+	t.Helper()
+	data, err := json.Marshal(tmpl)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestScheduler_CreateScheduleValidation(t *testing.T) {
+	now := time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC)
+	s, _, _ := newTestScheduler(t, now)
+	ctx := context.Background()
+
+	valid := launchConfigJSON(t, LaunchTemplate{Query: "triage dependencies"})
+
+	tests := []struct {
+		name     string
+		schedule store.Schedule
+		field    string
+	}{
+		{"missing name", store.Schedule{CronExpr: "@daily", LaunchConfig: valid}, "name"},
+		{"bad cron", store.Schedule{Name: "n", CronExpr: "nope", LaunchConfig: valid}, "cron_expr"},
+		{"bad timezone", store.Schedule{Name: "n", CronExpr: "@daily", Timezone: "Mars/Olympus", LaunchConfig: valid}, "timezone"},
+		{"bad overlap", store.Schedule{Name: "n", CronExpr: "@daily", OverlapPolicy: "queue", LaunchConfig: valid}, "overlap_policy"},
+		{"bad catch-up", store.Schedule{Name: "n", CronExpr: "@daily", CatchUpPolicy: "some", LaunchConfig: valid}, "catch_up_policy"},
+		{"empty query", store.Schedule{Name: "n", CronExpr: "@daily", LaunchConfig: `{"query":""}`}, "launch_config"},
+		{"bad model", store.Schedule{Name: "n", CronExpr: "@daily", LaunchConfig: `{"query":"q","model":"gpt"}`}, "launch_config"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched := tt.schedule
+			_, err := s.CreateSchedule(ctx, &sched)
+			require.Error(t, err)
+			var validationErr *validation.Error
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestScheduler_CreateScheduleDefaults(t *testing.T) {
+	now := time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC)
+	s, _, _ := newTestScheduler(t, now)
+
+	created, err := s.CreateSchedule(context.Background(), &store.Schedule{
+		Name:         "nightly",
+		CronExpr:     "0 2 * * *",
+		LaunchConfig: launchConfigJSON(t, LaunchTemplate{Query: "q"}),
+		Enabled:      true,
+	})
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "UTC", created.Timezone)
+	assert.Equal(t, store.ScheduleOverlapSkip, created.OverlapPolicy)
+	assert.Equal(t, store.ScheduleCatchUpOnce, created.CatchUpPolicy)
+	require.NotNil(t, created.NextRunAt)
+	assert.True(t, created.NextRunAt.Equal(time.Date(2025, 3, 15, 2, 0, 0, 0, time.UTC)))
+}
+
+func TestScheduler_RunDueLaunchesSession(t *testing.T) {
+	start := time.Date(2025, 3, 14, 1, 59, 0, 0, time.UTC)
+	s, sqliteStore, sessions := newTestScheduler(t, start)
+	ctx := context.Background()
+
+	sched, err := s.CreateSchedule(ctx, &store.Schedule{
+		Name:         "nightly",
+		CronExpr:     "0 2 * * *",
+		WorkingDir:   "/tmp/project",
+		LaunchConfig: launchConfigJSON(t, LaunchTemplate{Query: "triage", Model: "sonnet"}),
+		Enabled:      true,
+	})
+	require.NoError(t, err)
+
+	// Nothing is due yet
+	s.RunDue(ctx)
+
+	// Fire at 02:00:20
+	s.now = func() time.Time { return start.Add(80 * time.Second) }
+
+	sessions.EXPECT().
+		LaunchSession(gomock.Any(), gomock.Any(), false).
+		DoAndReturn(func(_ context.Context, cfg session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+			assert.Equal(t, "triage", cfg.Query)
+			assert.Equal(t, "/tmp/project", cfg.WorkingDir)
+			assert.Equal(t, "sonnet", string(cfg.Model))
+			assert.Equal(t, "nightly (2025-03-14 02:00)", cfg.Title)
+			require.NoError(t, sqliteStore.CreateSession(ctx, &store.Session{
+				ID: "sess-1", RunID: "run-1", Query: cfg.Query, Status: store.SessionStatusRunning,
+				CreatedAt: time.Now(), LastActivityAt: time.Now(),
+			}))
+			return &session.Session{ID: "sess-1", RunID: "run-1"}, nil
+		})
+
+	s.RunDue(ctx)
+
+	runs, err := sqliteStore.ListScheduleRuns(ctx, sched.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, store.ScheduleRunStatusLaunched, runs[0].Status)
+	assert.Equal(t, "sess-1", runs[0].SessionID)
+	assert.Equal(t, store.SessionStatusRunning, runs[0].SessionStatus)
+	assert.False(t, runs[0].CatchUp)
+
+	updated, err := sqliteStore.GetSchedule(ctx, sched.ID)
+	require.NoError(t, err)
+	require.NotNil(t, updated.NextRunAt)
+	assert.True(t, updated.NextRunAt.Equal(time.Date(2025, 3, 15, 2, 0, 0, 0, time.UTC)))
+	require.NotNil(t, updated.LastRunAt)
+
+	// The next day's run overlaps the still-running session and is skipped
+	s.now = func() time.Time { return time.Date(2025, 3, 15, 2, 0, 10, 0, time.UTC) }
+	s.RunDue(ctx)
+
+	runs, err = sqliteStore.ListScheduleRuns(ctx, sched.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, store.ScheduleRunStatusSkipped, runs[0].Status)
+	assert.Contains(t, runs[0].Reason, "sess-1")
+}
+
+func TestScheduler_CatchUpPolicies(t *testing.T) {
+	tests := []struct {
+		policy       string
+		wantLaunches int
+		wantRuns     int
+	}{
+		{store.ScheduleCatchUpNone, 0, 1},
+		{store.ScheduleCatchUpOnce, 1, 1},
+		{store.ScheduleCatchUpAll, 3, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			created := time.Date(2025, 3, 14, 0, 30, 0, 0, time.UTC)
+			s, sqliteStore, sessions := newTestScheduler(t, created)
+			ctx := context.Background()
+
+			sched, err := s.CreateSchedule(ctx, &store.Schedule{
+				Name:          "hourly",
+				CronExpr:      "0 * * * *",
+				LaunchConfig:  launchConfigJSON(t, LaunchTemplate{Query: "q"}),
+				OverlapPolicy: store.ScheduleOverlapAllow,
+				CatchUpPolicy: tt.policy,
+				Enabled:       true,
+			})
+			require.NoError(t, err)
+
+			// Daemon was down from 00:30 until 03:10; 01:00, 02:00 and 03:00 were missed
+			s.now = func() time.Time { return time.Date(2025, 3, 14, 3, 10, 0, 0, time.UTC) }
+
+			launched := 0
+			sessions.EXPECT().
+				LaunchSession(gomock.Any(), gomock.Any(), false).
+				DoAndReturn(func(_ context.Context, _ session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+					launched++
+					return &session.Session{ID: "sess", RunID: "run"}, nil
+				}).
+				AnyTimes()
+
+			s.RunDue(ctx)
+			assert.Equal(t, tt.wantLaunches, launched)
+
+			runs, err := sqliteStore.ListScheduleRuns(ctx, sched.ID, 0)
+			require.NoError(t, err)
+			require.Len(t, runs, tt.wantRuns)
+			for _, run := range runs {
+				assert.True(t, run.CatchUp)
+			}
+			assert.True(t, runs[0].ScheduledFor.Equal(time.Date(2025, 3, 14, 3, 0, 0, 0, time.UTC)))
+
+			updated, err := sqliteStore.GetSchedule(ctx, sched.ID)
+			require.NoError(t, err)
+			assert.True(t, updated.NextRunAt.Equal(time.Date(2025, 3, 14, 4, 0, 0, 0, time.UTC)))
+		})
+	}
+}
+
+func TestScheduler_UpdateRecomputesNextRun(t *testing.T) {
+	now := time.Date(2025, 3, 14, 10, 30, 0, 0, time.UTC)
+	s, _, _ := newTestScheduler(t, now)
+	ctx := context.Background()
+
+	sched, err := s.CreateSchedule(ctx, &store.Schedule{
+		Name:         "nightly",
+		CronExpr:     "0 2 * * *",
+		LaunchConfig: launchConfigJSON(t, LaunchTemplate{Query: "q"}),
+		Enabled:      true,
+	})
+	require.NoError(t, err)
+
+	cron := "0 12 * * *"
+	updated, err := s.UpdateSchedule(ctx, sched.ID, store.ScheduleUpdate{CronExpr: &cron})
+	require.NoError(t, err)
+	assert.Equal(t, cron, updated.CronExpr)
+	assert.True(t, updated.NextRunAt.Equal(time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)))
+
+	bad := "0 25 * * *"
+	_, err = s.UpdateSchedule(ctx, sched.ID, store.ScheduleUpdate{CronExpr: &bad})
+	assert.True(t, validation.IsError(err))
+
+	_, err = s.UpdateSchedule(ctx, "missing", store.ScheduleUpdate{CronExpr: &cron})
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
@@ -0,0 +1,199 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  BackupResponse,
+  BackupsResponse,
+  ErrorResponse,
+  RestoreBackupResponse,
+} from '../models/index';
+import {
+    BackupResponseFromJSON,
+    BackupResponseToJSON,
+    BackupsResponseFromJSON,
+    BackupsResponseToJSON,
+    ErrorResponseFromJSON,
+    ErrorResponseToJSON,
+    RestoreBackupResponseFromJSON,
+    RestoreBackupResponseToJSON,
+} from '../models/index';
+
+export interface RestoreBackupRequest {
+    name: string;
+}
+
+/**
+ * BackupsApi - interface
+ * 
+ * @export
+ * @interface BackupsApiInterface
+ */
+export interface BackupsApiInterface {
+    /**
+     * Write a consistent copy of the database while the daemon keeps running, then delete the oldest backups beyond the configured number to keep. 
+     * @summary Back up the database
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof BackupsApiInterface
+     */
+    createBackupRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BackupResponse>>;
+
+    /**
+     * Write a consistent copy of the database while the daemon keeps running, then delete the oldest backups beyond the configured number to keep. 
+     * Back up the database
+     */
+    createBackup(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BackupResponse>;
+
+    /**
+     * List backups in the backup directory, newest first
+     * @summary List database backups
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof BackupsApiInterface
+     */
+    listBackupsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BackupsResponse>>;
+
+    /**
+     * List backups in the backup directory, newest first
+     * List database backups
+     */
+    listBackups(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BackupsResponse>;
+
+    /**
+     * Validate the backup's schema and stage it to replace the database. The running daemon holds the database open, so the restore is applied the next time the daemon starts. The replaced database is kept next to it with a pre-restore suffix. 
+     * @summary Restore a database backup
+     * @param {string} name Backup file name
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof BackupsApiInterface
+     */
+    restoreBackupRaw(requestParameters: RestoreBackupRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<RestoreBackupResponse>>;
+
+    /**
+     * Validate the backup's schema and stage it to replace the database. The running daemon holds the database open, so the restore is applied the next time the daemon starts. The replaced database is kept next to it with a pre-restore suffix. 
+     * Restore a database backup
+     */
+    restoreBackup(requestParameters: RestoreBackupRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<RestoreBackupResponse>;
+
+}
+
+/**
+ * 
+ */
+export class BackupsApi extends runtime.BaseAPI implements BackupsApiInterface {
+
+    /**
+     * Write a consistent copy of the database while the daemon keeps running, then delete the oldest backups beyond the configured number to keep. 
+     * Back up the database
+     */
+    async createBackupRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BackupResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/backups`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => BackupResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Write a consistent copy of the database while the daemon keeps running, then delete the oldest backups beyond the configured number to keep. 
+     * Back up the database
+     */
+    async createBackup(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BackupResponse> {
+        const response = await this.createBackupRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List backups in the backup directory, newest first
+     * List database backups
+     */
+    async listBackupsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BackupsResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/backups`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => BackupsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * List backups in the backup directory, newest first
+     * List database backups
+     */
+    async listBackups(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BackupsResponse> {
+        const response = await this.listBackupsRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Validate the backup's schema and stage it to replace the database. The running daemon holds the database open, so the restore is applied the next time the daemon starts. The replaced database is kept next to it with a pre-restore suffix. 
+     * Restore a database backup
+     */
+    async restoreBackupRaw(requestParameters: RestoreBackupRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<RestoreBackupResponse>> {
+        if (requestParameters['name'] == null) {
+            throw new runtime.RequiredError(
+                'name',
+                'Required parameter "name" was null or undefined when calling restoreBackup().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/backups/{name}/restore`;
+        urlPath = urlPath.replace(`{${"name"}}`, encodeURIComponent(String(requestParameters['name'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => RestoreBackupResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Validate the backup's schema and stage it to replace the database. The running daemon holds the database open, so the restore is applied the next time the daemon starts. The replaced database is kept next to it with a pre-restore suffix. 
+     * Restore a database backup
+     */
+    async restoreBackup(requestParameters: RestoreBackupRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<RestoreBackupResponse> {
+        const response = await this.restoreBackupRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
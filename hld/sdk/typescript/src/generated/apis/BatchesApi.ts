@@ -0,0 +1,276 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  BatchComparisonResponse,
+  BatchGroupResponse,
+  BatchGroupsResponse,
+  ErrorResponse,
+  LaunchBatchRequest,
+} from '../models/index';
+import {
+    BatchComparisonResponseFromJSON,
+    BatchComparisonResponseToJSON,
+    BatchGroupResponseFromJSON,
+    BatchGroupResponseToJSON,
+    BatchGroupsResponseFromJSON,
+    BatchGroupsResponseToJSON,
+    ErrorResponseFromJSON,
+    ErrorResponseToJSON,
+    LaunchBatchRequestFromJSON,
+    LaunchBatchRequestToJSON,
+} from '../models/index';
+
+export interface CompareBatchRequest {
+    id: string;
+}
+
+export interface GetBatchRequest {
+    id: string;
+}
+
+export interface LaunchBatchOperationRequest {
+    launchBatchRequest: LaunchBatchRequest;
+}
+
+/**
+ * BatchesApi - interface
+ * 
+ * @export
+ * @interface BatchesApiInterface
+ */
+export interface BatchesApiInterface {
+    /**
+     * Line up the members of a batch side by side with their final result text, and identify the cheapest and fastest successful members. 
+     * @summary Compare batch results
+     * @param {string} id Batch group ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof BatchesApiInterface
+     */
+    compareBatchRaw(requestParameters: CompareBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BatchComparisonResponse>>;
+
+    /**
+     * Line up the members of a batch side by side with their final result text, and identify the cheapest and fastest successful members. 
+     * Compare batch results
+     */
+    compareBatch(requestParameters: CompareBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BatchComparisonResponse>;
+
+    /**
+     * A batch group with per-member status, cost, duration and diff stats
+     * @summary Get batch details
+     * @param {string} id Batch group ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof BatchesApiInterface
+     */
+    getBatchRaw(requestParameters: GetBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BatchGroupResponse>>;
+
+    /**
+     * A batch group with per-member status, cost, duration and diff stats
+     * Get batch details
+     */
+    getBatch(requestParameters: GetBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BatchGroupResponse>;
+
+    /**
+     * Launch one prompt across a matrix of models, proxy providers and working directories, creating one session per cell. Cells that fail to launch are recorded on the group without stopping the others. 
+     * @summary Launch a batch
+     * @param {LaunchBatchRequest} launchBatchRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof BatchesApiInterface
+     */
+    launchBatchRaw(requestParameters: LaunchBatchOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BatchGroupResponse>>;
+
+    /**
+     * Launch one prompt across a matrix of models, proxy providers and working directories, creating one session per cell. Cells that fail to launch are recorded on the group without stopping the others. 
+     * Launch a batch
+     */
+    launchBatch(requestParameters: LaunchBatchOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BatchGroupResponse>;
+
+    /**
+     * All batch groups with aggregate status, cost and duration, newest first
+     * @summary List batch launches
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof BatchesApiInterface
+     */
+    listBatchesRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BatchGroupsResponse>>;
+
+    /**
+     * All batch groups with aggregate status, cost and duration, newest first
+     * List batch launches
+     */
+    listBatches(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BatchGroupsResponse>;
+
+}
+
+/**
+ * 
+ */
+export class BatchesApi extends runtime.BaseAPI implements BatchesApiInterface {
+
+    /**
+     * Line up the members of a batch side by side with their final result text, and identify the cheapest and fastest successful members. 
+     * Compare batch results
+     */
+    async compareBatchRaw(requestParameters: CompareBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BatchComparisonResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling compareBatch().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/batches/{id}/compare`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => BatchComparisonResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Line up the members of a batch side by side with their final result text, and identify the cheapest and fastest successful members. 
+     * Compare batch results
+     */
+    async compareBatch(requestParameters: CompareBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BatchComparisonResponse> {
+        const response = await this.compareBatchRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * A batch group with per-member status, cost, duration and diff stats
+     * Get batch details
+     */
+    async getBatchRaw(requestParameters: GetBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BatchGroupResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling getBatch().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/batches/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => BatchGroupResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * A batch group with per-member status, cost, duration and diff stats
+     * Get batch details
+     */
+    async getBatch(requestParameters: GetBatchRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BatchGroupResponse> {
+        const response = await this.getBatchRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Launch one prompt across a matrix of models, proxy providers and working directories, creating one session per cell. Cells that fail to launch are recorded on the group without stopping the others. 
+     * Launch a batch
+     */
+    async launchBatchRaw(requestParameters: LaunchBatchOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BatchGroupResponse>> {
+        if (requestParameters['launchBatchRequest'] == null) {
+            throw new runtime.RequiredError(
+                'launchBatchRequest',
+                'Required parameter "launchBatchRequest" was null or undefined when calling launchBatch().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/batches`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: LaunchBatchRequestToJSON(requestParameters['launchBatchRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => BatchGroupResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Launch one prompt across a matrix of models, proxy providers and working directories, creating one session per cell. Cells that fail to launch are recorded on the group without stopping the others. 
+     * Launch a batch
+     */
+    async launchBatch(requestParameters: LaunchBatchOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BatchGroupResponse> {
+        const response = await this.launchBatchRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * All batch groups with aggregate status, cost and duration, newest first
+     * List batch launches
+     */
+    async listBatchesRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BatchGroupsResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/batches`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => BatchGroupsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * All batch groups with aggregate status, cost and duration, newest first
+     * List batch launches
+     */
+    async listBatches(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BatchGroupsResponse> {
+        const response = await this.listBatchesRaw(initOverrides);
+        return await response.value();
+    }
+
+}
@@ -0,0 +1,569 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  CreateLabelRequest,
+  ErrorResponse,
+  LabelResponse,
+  LabelsResponse,
+  ProjectsResponse,
+  SavedFilterRequest,
+  SavedFilterResponse,
+  SavedFiltersResponse,
+  SessionLabelsResponse,
+  SetSessionLabelsRequest,
+} from '../models/index';
+import {
+    CreateLabelRequestFromJSON,
+    CreateLabelRequestToJSON,
+    ErrorResponseFromJSON,
+    ErrorResponseToJSON,
+    LabelResponseFromJSON,
+    LabelResponseToJSON,
+    LabelsResponseFromJSON,
+    LabelsResponseToJSON,
+    ProjectsResponseFromJSON,
+    ProjectsResponseToJSON,
+    SavedFilterRequestFromJSON,
+    SavedFilterRequestToJSON,
+    SavedFilterResponseFromJSON,
+    SavedFilterResponseToJSON,
+    SavedFiltersResponseFromJSON,
+    SavedFiltersResponseToJSON,
+    SessionLabelsResponseFromJSON,
+    SessionLabelsResponseToJSON,
+    SetSessionLabelsRequestFromJSON,
+    SetSessionLabelsRequestToJSON,
+} from '../models/index';
+
+export interface CreateLabelOperationRequest {
+    createLabelRequest: CreateLabelRequest;
+}
+
+export interface CreateSavedFilterRequest {
+    savedFilterRequest: SavedFilterRequest;
+}
+
+export interface DeleteLabelRequest {
+    id: string;
+}
+
+export interface DeleteSavedFilterRequest {
+    id: string;
+}
+
+export interface SetSessionLabelsOperationRequest {
+    id: string;
+    setSessionLabelsRequest: SetSessionLabelsRequest;
+}
+
+export interface UpdateSavedFilterRequest {
+    id: string;
+    savedFilterRequest: SavedFilterRequest;
+}
+
+/**
+ * LabelsApi - interface
+ * 
+ * @export
+ * @interface LabelsApiInterface
+ */
+export interface LabelsApiInterface {
+    /**
+     * 
+     * @summary Create a label
+     * @param {CreateLabelRequest} createLabelRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof LabelsApiInterface
+     */
+    createLabelRaw(requestParameters: CreateLabelOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<LabelResponse>>;
+
+    /**
+     * Create a label
+     */
+    createLabel(requestParameters: CreateLabelOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<LabelResponse>;
+
+    /**
+     * 
+     * @summary Save a session filter
+     * @param {SavedFilterRequest} savedFilterRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof LabelsApiInterface
+     */
+    createSavedFilterRaw(requestParameters: CreateSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SavedFilterResponse>>;
+
+    /**
+     * Save a session filter
+     */
+    createSavedFilter(requestParameters: CreateSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SavedFilterResponse>;
+
+    /**
+     * Delete a label and remove it from every session
+     * @summary Delete a label
+     * @param {string} id Label ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof LabelsApiInterface
+     */
+    deleteLabelRaw(requestParameters: DeleteLabelRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Delete a label and remove it from every session
+     * Delete a label
+     */
+    deleteLabel(requestParameters: DeleteLabelRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
+    /**
+     * 
+     * @summary Delete a saved session filter
+     * @param {string} id Saved filter ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof LabelsApiInterface
+     */
+    deleteSavedFilterRaw(requestParameters: DeleteSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Delete a saved session filter
+     */
+    deleteSavedFilter(requestParameters: DeleteSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
+    /**
+     * All labels ordered by name, with the number of sessions using each
+     * @summary List labels
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof LabelsApiInterface
+     */
+    listLabelsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<LabelsResponse>>;
+
+    /**
+     * All labels ordered by name, with the number of sessions using each
+     * List labels
+     */
+    listLabels(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<LabelsResponse>;
+
+    /**
+     * Projects group sessions by the repository their working directory belongs to. Most recently active first. 
+     * @summary List projects
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof LabelsApiInterface
+     */
+    listProjectsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ProjectsResponse>>;
+
+    /**
+     * Projects group sessions by the repository their working directory belongs to. Most recently active first. 
+     * List projects
+     */
+    listProjects(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ProjectsResponse>;
+
+    /**
+     * 
+     * @summary List saved session filters
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof LabelsApiInterface
+     */
+    listSavedFiltersRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SavedFiltersResponse>>;
+
+    /**
+     * List saved session filters
+     */
+    listSavedFilters(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SavedFiltersResponse>;
+
+    /**
+     * Set the labels of a session. Labels that do not exist yet are created.
+     * @summary Replace a session's labels
+     * @param {string} id Session ID
+     * @param {SetSessionLabelsRequest} setSessionLabelsRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof LabelsApiInterface
+     */
+    setSessionLabelsRaw(requestParameters: SetSessionLabelsOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionLabelsResponse>>;
+
+    /**
+     * Set the labels of a session. Labels that do not exist yet are created.
+     * Replace a session's labels
+     */
+    setSessionLabels(requestParameters: SetSessionLabelsOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionLabelsResponse>;
+
+    /**
+     * 
+     * @summary Replace a saved session filter
+     * @param {string} id Saved filter ID
+     * @param {SavedFilterRequest} savedFilterRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof LabelsApiInterface
+     */
+    updateSavedFilterRaw(requestParameters: UpdateSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SavedFilterResponse>>;
+
+    /**
+     * Replace a saved session filter
+     */
+    updateSavedFilter(requestParameters: UpdateSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SavedFilterResponse>;
+
+}
+
+/**
+ * 
+ */
+export class LabelsApi extends runtime.BaseAPI implements LabelsApiInterface {
+
+    /**
+     * Create a label
+     */
+    async createLabelRaw(requestParameters: CreateLabelOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<LabelResponse>> {
+        if (requestParameters['createLabelRequest'] == null) {
+            throw new runtime.RequiredError(
+                'createLabelRequest',
+                'Required parameter "createLabelRequest" was null or undefined when calling createLabel().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/labels`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: CreateLabelRequestToJSON(requestParameters['createLabelRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => LabelResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Create a label
+     */
+    async createLabel(requestParameters: CreateLabelOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<LabelResponse> {
+        const response = await this.createLabelRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Save a session filter
+     */
+    async createSavedFilterRaw(requestParameters: CreateSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SavedFilterResponse>> {
+        if (requestParameters['savedFilterRequest'] == null) {
+            throw new runtime.RequiredError(
+                'savedFilterRequest',
+                'Required parameter "savedFilterRequest" was null or undefined when calling createSavedFilter().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/session-filters`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: SavedFilterRequestToJSON(requestParameters['savedFilterRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SavedFilterResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Save a session filter
+     */
+    async createSavedFilter(requestParameters: CreateSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SavedFilterResponse> {
+        const response = await this.createSavedFilterRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Delete a label and remove it from every session
+     * Delete a label
+     */
+    async deleteLabelRaw(requestParameters: DeleteLabelRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling deleteLabel().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/labels/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete a label and remove it from every session
+     * Delete a label
+     */
+    async deleteLabel(requestParameters: DeleteLabelRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.deleteLabelRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Delete a saved session filter
+     */
+    async deleteSavedFilterRaw(requestParameters: DeleteSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling deleteSavedFilter().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/session-filters/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete a saved session filter
+     */
+    async deleteSavedFilter(requestParameters: DeleteSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.deleteSavedFilterRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * All labels ordered by name, with the number of sessions using each
+     * List labels
+     */
+    async listLabelsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<LabelsResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/labels`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => LabelsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * All labels ordered by name, with the number of sessions using each
+     * List labels
+     */
+    async listLabels(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<LabelsResponse> {
+        const response = await this.listLabelsRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Projects group sessions by the repository their working directory belongs to. Most recently active first. 
+     * List projects
+     */
+    async listProjectsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ProjectsResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/projects`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ProjectsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Projects group sessions by the repository their working directory belongs to. Most recently active first. 
+     * List projects
+     */
+    async listProjects(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ProjectsResponse> {
+        const response = await this.listProjectsRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List saved session filters
+     */
+    async listSavedFiltersRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SavedFiltersResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/session-filters`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SavedFiltersResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * List saved session filters
+     */
+    async listSavedFilters(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SavedFiltersResponse> {
+        const response = await this.listSavedFiltersRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Set the labels of a session. Labels that do not exist yet are created.
+     * Replace a session's labels
+     */
+    async setSessionLabelsRaw(requestParameters: SetSessionLabelsOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionLabelsResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling setSessionLabels().'
+            );
+        }
+
+        if (requestParameters['setSessionLabelsRequest'] == null) {
+            throw new runtime.RequiredError(
+                'setSessionLabelsRequest',
+                'Required parameter "setSessionLabelsRequest" was null or undefined when calling setSessionLabels().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/sessions/{id}/labels`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'PUT',
+            headers: headerParameters,
+            query: queryParameters,
+            body: SetSessionLabelsRequestToJSON(requestParameters['setSessionLabelsRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SessionLabelsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Set the labels of a session. Labels that do not exist yet are created.
+     * Replace a session's labels
+     */
+    async setSessionLabels(requestParameters: SetSessionLabelsOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionLabelsResponse> {
+        const response = await this.setSessionLabelsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Replace a saved session filter
+     */
+    async updateSavedFilterRaw(requestParameters: UpdateSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SavedFilterResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling updateSavedFilter().'
+            );
+        }
+
+        if (requestParameters['savedFilterRequest'] == null) {
+            throw new runtime.RequiredError(
+                'savedFilterRequest',
+                'Required parameter "savedFilterRequest" was null or undefined when calling updateSavedFilter().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/session-filters/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'PUT',
+            headers: headerParameters,
+            query: queryParameters,
+            body: SavedFilterRequestToJSON(requestParameters['savedFilterRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SavedFilterResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Replace a saved session filter
+     */
+    async updateSavedFilter(requestParameters: UpdateSavedFilterRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SavedFilterResponse> {
+        const response = await this.updateSavedFilterRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
@@ -0,0 +1,99 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  ErrorResponse,
+  MaintenanceRequest,
+  MaintenanceResponse,
+} from '../models/index';
+import {
+    ErrorResponseFromJSON,
+    ErrorResponseToJSON,
+    MaintenanceRequestFromJSON,
+    MaintenanceRequestToJSON,
+    MaintenanceResponseFromJSON,
+    MaintenanceResponseToJSON,
+} from '../models/index';
+
+export interface RunMaintenanceRequest {
+    maintenanceRequest?: MaintenanceRequest;
+}
+
+/**
+ * MaintenanceApi - interface
+ * 
+ * @export
+ * @interface MaintenanceApiInterface
+ */
+export interface MaintenanceApiInterface {
+    /**
+     * Prune data past the retention settings, vacuum and analyze the database. The daemon also runs an incremental pass periodically. With dry_run the response reports what would be pruned and nothing changes. 
+     * @summary Run database maintenance
+     * @param {MaintenanceRequest} [maintenanceRequest] 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof MaintenanceApiInterface
+     */
+    runMaintenanceRaw(requestParameters: RunMaintenanceRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<MaintenanceResponse>>;
+
+    /**
+     * Prune data past the retention settings, vacuum and analyze the database. The daemon also runs an incremental pass periodically. With dry_run the response reports what would be pruned and nothing changes. 
+     * Run database maintenance
+     */
+    runMaintenance(requestParameters: RunMaintenanceRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<MaintenanceResponse>;
+
+}
+
+/**
+ * 
+ */
+export class MaintenanceApi extends runtime.BaseAPI implements MaintenanceApiInterface {
+
+    /**
+     * Prune data past the retention settings, vacuum and analyze the database. The daemon also runs an incremental pass periodically. With dry_run the response reports what would be pruned and nothing changes. 
+     * Run database maintenance
+     */
+    async runMaintenanceRaw(requestParameters: RunMaintenanceRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<MaintenanceResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/maintenance`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: MaintenanceRequestToJSON(requestParameters['maintenanceRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => MaintenanceResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Prune data past the retention settings, vacuum and analyze the database. The daemon also runs an incremental pass periodically. With dry_run the response reports what would be pruned and nothing changes. 
+     * Run database maintenance
+     */
+    async runMaintenance(requestParameters: RunMaintenanceRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<MaintenanceResponse> {
+        const response = await this.runMaintenanceRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
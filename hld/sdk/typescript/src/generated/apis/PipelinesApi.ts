@@ -0,0 +1,332 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  ErrorResponse,
+  PipelineRunResponse,
+  PipelineRunsResponse,
+  StartPipelineRunRequest,
+} from '../models/index';
+import {
+    ErrorResponseFromJSON,
+    ErrorResponseToJSON,
+    PipelineRunResponseFromJSON,
+    PipelineRunResponseToJSON,
+    PipelineRunsResponseFromJSON,
+    PipelineRunsResponseToJSON,
+    StartPipelineRunRequestFromJSON,
+    StartPipelineRunRequestToJSON,
+} from '../models/index';
+
+export interface GetPipelineRunRequest {
+    id: string;
+}
+
+export interface PausePipelineRunRequest {
+    id: string;
+}
+
+export interface ResumePipelineRunRequest {
+    id: string;
+}
+
+export interface StartPipelineRunOperationRequest {
+    startPipelineRunRequest: StartPipelineRunRequest;
+}
+
+/**
+ * PipelinesApi - interface
+ * 
+ * @export
+ * @interface PipelinesApiInterface
+ */
+export interface PipelinesApiInterface {
+    /**
+     * A pipeline run with its steps and the sessions they launched
+     * @summary Get pipeline run details
+     * @param {string} id Pipeline run ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof PipelinesApiInterface
+     */
+    getPipelineRunRaw(requestParameters: GetPipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunResponse>>;
+
+    /**
+     * A pipeline run with its steps and the sessions they launched
+     * Get pipeline run details
+     */
+    getPipelineRun(requestParameters: GetPipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunResponse>;
+
+    /**
+     * All pipeline runs, newest first
+     * @summary List pipeline runs
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof PipelinesApiInterface
+     */
+    listPipelineRunsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunsResponse>>;
+
+    /**
+     * All pipeline runs, newest first
+     * List pipeline runs
+     */
+    listPipelineRuns(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunsResponse>;
+
+    /**
+     * Stop a running pipeline from launching further steps. A step that is already running is left to finish. 
+     * @summary Pause a pipeline run
+     * @param {string} id Pipeline run ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof PipelinesApiInterface
+     */
+    pausePipelineRunRaw(requestParameters: PausePipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunResponse>>;
+
+    /**
+     * Stop a running pipeline from launching further steps. A step that is already running is left to finish. 
+     * Pause a pipeline run
+     */
+    pausePipelineRun(requestParameters: PausePipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunResponse>;
+
+    /**
+     * Continue a paused pipeline from where it stopped
+     * @summary Resume a pipeline run
+     * @param {string} id Pipeline run ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof PipelinesApiInterface
+     */
+    resumePipelineRunRaw(requestParameters: ResumePipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunResponse>>;
+
+    /**
+     * Continue a paused pipeline from where it stopped
+     * Resume a pipeline run
+     */
+    resumePipelineRun(requestParameters: ResumePipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunResponse>;
+
+    /**
+     * Start a multi-step pipeline. The definition can be given as a JSON object or as YAML/JSON text in definition_yaml. Each step launches a session that either continues the previous step's session or starts fresh with the previous step's result as context. 
+     * @summary Start a pipeline run
+     * @param {StartPipelineRunRequest} startPipelineRunRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof PipelinesApiInterface
+     */
+    startPipelineRunRaw(requestParameters: StartPipelineRunOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunResponse>>;
+
+    /**
+     * Start a multi-step pipeline. The definition can be given as a JSON object or as YAML/JSON text in definition_yaml. Each step launches a session that either continues the previous step's session or starts fresh with the previous step's result as context. 
+     * Start a pipeline run
+     */
+    startPipelineRun(requestParameters: StartPipelineRunOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunResponse>;
+
+}
+
+/**
+ * 
+ */
+export class PipelinesApi extends runtime.BaseAPI implements PipelinesApiInterface {
+
+    /**
+     * A pipeline run with its steps and the sessions they launched
+     * Get pipeline run details
+     */
+    async getPipelineRunRaw(requestParameters: GetPipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling getPipelineRun().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/pipeline-runs/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => PipelineRunResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * A pipeline run with its steps and the sessions they launched
+     * Get pipeline run details
+     */
+    async getPipelineRun(requestParameters: GetPipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunResponse> {
+        const response = await this.getPipelineRunRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * All pipeline runs, newest first
+     * List pipeline runs
+     */
+    async listPipelineRunsRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunsResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/pipeline-runs`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => PipelineRunsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * All pipeline runs, newest first
+     * List pipeline runs
+     */
+    async listPipelineRuns(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunsResponse> {
+        const response = await this.listPipelineRunsRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Stop a running pipeline from launching further steps. A step that is already running is left to finish. 
+     * Pause a pipeline run
+     */
+    async pausePipelineRunRaw(requestParameters: PausePipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling pausePipelineRun().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/pipeline-runs/{id}/pause`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => PipelineRunResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Stop a running pipeline from launching further steps. A step that is already running is left to finish. 
+     * Pause a pipeline run
+     */
+    async pausePipelineRun(requestParameters: PausePipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunResponse> {
+        const response = await this.pausePipelineRunRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Continue a paused pipeline from where it stopped
+     * Resume a pipeline run
+     */
+    async resumePipelineRunRaw(requestParameters: ResumePipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling resumePipelineRun().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/pipeline-runs/{id}/resume`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => PipelineRunResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Continue a paused pipeline from where it stopped
+     * Resume a pipeline run
+     */
+    async resumePipelineRun(requestParameters: ResumePipelineRunRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunResponse> {
+        const response = await this.resumePipelineRunRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Start a multi-step pipeline. The definition can be given as a JSON object or as YAML/JSON text in definition_yaml. Each step launches a session that either continues the previous step's session or starts fresh with the previous step's result as context. 
+     * Start a pipeline run
+     */
+    async startPipelineRunRaw(requestParameters: StartPipelineRunOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<PipelineRunResponse>> {
+        if (requestParameters['startPipelineRunRequest'] == null) {
+            throw new runtime.RequiredError(
+                'startPipelineRunRequest',
+                'Required parameter "startPipelineRunRequest" was null or undefined when calling startPipelineRun().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/pipeline-runs`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: StartPipelineRunRequestToJSON(requestParameters['startPipelineRunRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => PipelineRunResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Start a multi-step pipeline. The definition can be given as a JSON object or as YAML/JSON text in definition_yaml. Each step launches a session that either continues the previous step's session or starts fresh with the previous step's result as context. 
+     * Start a pipeline run
+     */
+    async startPipelineRun(requestParameters: StartPipelineRunOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<PipelineRunResponse> {
+        const response = await this.startPipelineRunRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
@@ -0,0 +1,473 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  CreateScheduleRequest,
+  ErrorResponse,
+  ScheduleResponse,
+  ScheduleRunResponse,
+  ScheduleRunsResponse,
+  SchedulesResponse,
+  UpdateScheduleRequest,
+} from '../models/index';
+import {
+    CreateScheduleRequestFromJSON,
+    CreateScheduleRequestToJSON,
+    ErrorResponseFromJSON,
+    ErrorResponseToJSON,
+    ScheduleResponseFromJSON,
+    ScheduleResponseToJSON,
+    ScheduleRunResponseFromJSON,
+    ScheduleRunResponseToJSON,
+    ScheduleRunsResponseFromJSON,
+    ScheduleRunsResponseToJSON,
+    SchedulesResponseFromJSON,
+    SchedulesResponseToJSON,
+    UpdateScheduleRequestFromJSON,
+    UpdateScheduleRequestToJSON,
+} from '../models/index';
+
+export interface CreateScheduleOperationRequest {
+    createScheduleRequest: CreateScheduleRequest;
+}
+
+export interface DeleteScheduleRequest {
+    id: string;
+}
+
+export interface GetScheduleRequest {
+    id: string;
+}
+
+export interface ListScheduleRunsRequest {
+    id: string;
+    limit?: number;
+}
+
+export interface TriggerScheduleRequest {
+    id: string;
+}
+
+export interface UpdateScheduleOperationRequest {
+    id: string;
+    updateScheduleRequest: UpdateScheduleRequest;
+}
+
+/**
+ * SchedulesApi - interface
+ * 
+ * @export
+ * @interface SchedulesApiInterface
+ */
+export interface SchedulesApiInterface {
+    /**
+     * Create a recurring schedule that launches a new session from the launch config template every time its cron expression fires. 
+     * @summary Create a schedule
+     * @param {CreateScheduleRequest} createScheduleRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SchedulesApiInterface
+     */
+    createScheduleRaw(requestParameters: CreateScheduleOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleResponse>>;
+
+    /**
+     * Create a recurring schedule that launches a new session from the launch config template every time its cron expression fires. 
+     * Create a schedule
+     */
+    createSchedule(requestParameters: CreateScheduleOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleResponse>;
+
+    /**
+     * Delete a schedule and its run history. Sessions it launched are kept.
+     * @summary Delete a schedule
+     * @param {string} id Schedule ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SchedulesApiInterface
+     */
+    deleteScheduleRaw(requestParameters: DeleteScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Delete a schedule and its run history. Sessions it launched are kept.
+     * Delete a schedule
+     */
+    deleteSchedule(requestParameters: DeleteScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
+    /**
+     * 
+     * @summary Get schedule details
+     * @param {string} id Schedule ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SchedulesApiInterface
+     */
+    getScheduleRaw(requestParameters: GetScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleResponse>>;
+
+    /**
+     * Get schedule details
+     */
+    getSchedule(requestParameters: GetScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleResponse>;
+
+    /**
+     * Run history for a schedule, newest first, linking each run to the session it created
+     * @summary List schedule runs
+     * @param {string} id Schedule ID
+     * @param {number} [limit] Maximum number of runs to return
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SchedulesApiInterface
+     */
+    listScheduleRunsRaw(requestParameters: ListScheduleRunsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleRunsResponse>>;
+
+    /**
+     * Run history for a schedule, newest first, linking each run to the session it created
+     * List schedule runs
+     */
+    listScheduleRuns(requestParameters: ListScheduleRunsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleRunsResponse>;
+
+    /**
+     * List all recurring session schedules
+     * @summary List schedules
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SchedulesApiInterface
+     */
+    listSchedulesRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SchedulesResponse>>;
+
+    /**
+     * List all recurring session schedules
+     * List schedules
+     */
+    listSchedules(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SchedulesResponse>;
+
+    /**
+     * Fire a schedule immediately, honouring its overlap policy. The schedule's next regular run time is not changed. 
+     * @summary Run a schedule now
+     * @param {string} id Schedule ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SchedulesApiInterface
+     */
+    triggerScheduleRaw(requestParameters: TriggerScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleRunResponse>>;
+
+    /**
+     * Fire a schedule immediately, honouring its overlap policy. The schedule's next regular run time is not changed. 
+     * Run a schedule now
+     */
+    triggerSchedule(requestParameters: TriggerScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleRunResponse>;
+
+    /**
+     * Update a schedule. Only specified fields will be updated. Changing the cron expression or timezone, or re-enabling the schedule, recomputes the next run time without replaying missed runs. 
+     * @summary Update a schedule
+     * @param {string} id Schedule ID
+     * @param {UpdateScheduleRequest} updateScheduleRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SchedulesApiInterface
+     */
+    updateScheduleRaw(requestParameters: UpdateScheduleOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleResponse>>;
+
+    /**
+     * Update a schedule. Only specified fields will be updated. Changing the cron expression or timezone, or re-enabling the schedule, recomputes the next run time without replaying missed runs. 
+     * Update a schedule
+     */
+    updateSchedule(requestParameters: UpdateScheduleOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleResponse>;
+
+}
+
+/**
+ * 
+ */
+export class SchedulesApi extends runtime.BaseAPI implements SchedulesApiInterface {
+
+    /**
+     * Create a recurring schedule that launches a new session from the launch config template every time its cron expression fires. 
+     * Create a schedule
+     */
+    async createScheduleRaw(requestParameters: CreateScheduleOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleResponse>> {
+        if (requestParameters['createScheduleRequest'] == null) {
+            throw new runtime.RequiredError(
+                'createScheduleRequest',
+                'Required parameter "createScheduleRequest" was null or undefined when calling createSchedule().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/schedules`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: CreateScheduleRequestToJSON(requestParameters['createScheduleRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ScheduleResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Create a recurring schedule that launches a new session from the launch config template every time its cron expression fires. 
+     * Create a schedule
+     */
+    async createSchedule(requestParameters: CreateScheduleOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleResponse> {
+        const response = await this.createScheduleRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Delete a schedule and its run history. Sessions it launched are kept.
+     * Delete a schedule
+     */
+    async deleteScheduleRaw(requestParameters: DeleteScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling deleteSchedule().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/schedules/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete a schedule and its run history. Sessions it launched are kept.
+     * Delete a schedule
+     */
+    async deleteSchedule(requestParameters: DeleteScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.deleteScheduleRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Get schedule details
+     */
+    async getScheduleRaw(requestParameters: GetScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling getSchedule().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/schedules/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ScheduleResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Get schedule details
+     */
+    async getSchedule(requestParameters: GetScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleResponse> {
+        const response = await this.getScheduleRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Run history for a schedule, newest first, linking each run to the session it created
+     * List schedule runs
+     */
+    async listScheduleRunsRaw(requestParameters: ListScheduleRunsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleRunsResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling listScheduleRuns().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/schedules/{id}/runs`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ScheduleRunsResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Run history for a schedule, newest first, linking each run to the session it created
+     * List schedule runs
+     */
+    async listScheduleRuns(requestParameters: ListScheduleRunsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleRunsResponse> {
+        const response = await this.listScheduleRunsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List all recurring session schedules
+     * List schedules
+     */
+    async listSchedulesRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SchedulesResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/schedules`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SchedulesResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * List all recurring session schedules
+     * List schedules
+     */
+    async listSchedules(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SchedulesResponse> {
+        const response = await this.listSchedulesRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Fire a schedule immediately, honouring its overlap policy. The schedule's next regular run time is not changed. 
+     * Run a schedule now
+     */
+    async triggerScheduleRaw(requestParameters: TriggerScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleRunResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling triggerSchedule().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/schedules/{id}/trigger`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ScheduleRunResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Fire a schedule immediately, honouring its overlap policy. The schedule's next regular run time is not changed. 
+     * Run a schedule now
+     */
+    async triggerSchedule(requestParameters: TriggerScheduleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleRunResponse> {
+        const response = await this.triggerScheduleRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Update a schedule. Only specified fields will be updated. Changing the cron expression or timezone, or re-enabling the schedule, recomputes the next run time without replaying missed runs. 
+     * Update a schedule
+     */
+    async updateScheduleRaw(requestParameters: UpdateScheduleOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScheduleResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling updateSchedule().'
+            );
+        }
+
+        if (requestParameters['updateScheduleRequest'] == null) {
+            throw new runtime.RequiredError(
+                'updateScheduleRequest',
+                'Required parameter "updateScheduleRequest" was null or undefined when calling updateSchedule().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/schedules/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'PATCH',
+            headers: headerParameters,
+            query: queryParameters,
+            body: UpdateScheduleRequestToJSON(requestParameters['updateScheduleRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ScheduleResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Update a schedule. Only specified fields will be updated. Changing the cron expression or timezone, or re-enabling the schedule, recomputes the next run time without replaying missed runs. 
+     * Update a schedule
+     */
+    async updateSchedule(requestParameters: UpdateScheduleOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScheduleResponse> {
+        const response = await this.updateScheduleRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
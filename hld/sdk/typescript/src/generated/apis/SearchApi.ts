@@ -0,0 +1,158 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  ConversationSearchResponse,
+  ErrorResponse,
+} from '../models/index';
+import {
+    ConversationSearchResponseFromJSON,
+    ConversationSearchResponseToJSON,
+    ErrorResponseFromJSON,
+    ErrorResponseToJSON,
+} from '../models/index';
+
+export interface SearchConversationsRequest {
+    q: string;
+    role?: Array<string>;
+    eventType?: Array<string>;
+    toolName?: Array<string>;
+    sessionId?: string;
+    projectId?: string;
+    after?: Date;
+    before?: Date;
+    limit?: number;
+    matchesPerSession?: number;
+}
+
+/**
+ * SearchApi - interface
+ * 
+ * @export
+ * @interface SearchApiInterface
+ */
+export interface SearchApiInterface {
+    /**
+     * Full-text search over message text, tool inputs and tool results. Every word of the query must match; words are not parsed as search syntax. Returns the matching sessions, most recent match first, with highlighted snippets and the sequence of each matching event. 
+     * @summary Search conversation content
+     * @param {string} q Words to search for
+     * @param {Array<string>} [role] Only events with one of these roles
+     * @param {Array<string>} [eventType] Only events of one of these types (message, tool_call, tool_result, ...)
+     * @param {Array<string>} [toolName] Only tool calls, and their results, for one of these tools
+     * @param {string} [sessionId] Only events of this session
+     * @param {string} [projectId] Only events of sessions in this project
+     * @param {Date} [after] Only events at or after this time
+     * @param {Date} [before] Only events before this time
+     * @param {number} [limit] Maximum number of sessions to return
+     * @param {number} [matchesPerSession] Maximum number of matching events returned per session
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SearchApiInterface
+     */
+    searchConversationsRaw(requestParameters: SearchConversationsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ConversationSearchResponse>>;
+
+    /**
+     * Full-text search over message text, tool inputs and tool results. Every word of the query must match; words are not parsed as search syntax. Returns the matching sessions, most recent match first, with highlighted snippets and the sequence of each matching event. 
+     * Search conversation content
+     */
+    searchConversations(requestParameters: SearchConversationsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ConversationSearchResponse>;
+
+}
+
+/**
+ * 
+ */
+export class SearchApi extends runtime.BaseAPI implements SearchApiInterface {
+
+    /**
+     * Full-text search over message text, tool inputs and tool results. Every word of the query must match; words are not parsed as search syntax. Returns the matching sessions, most recent match first, with highlighted snippets and the sequence of each matching event. 
+     * Search conversation content
+     */
+    async searchConversationsRaw(requestParameters: SearchConversationsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ConversationSearchResponse>> {
+        if (requestParameters['q'] == null) {
+            throw new runtime.RequiredError(
+                'q',
+                'Required parameter "q" was null or undefined when calling searchConversations().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['q'] != null) {
+            queryParameters['q'] = requestParameters['q'];
+        }
+
+        if (requestParameters['role'] != null) {
+            queryParameters['role'] = requestParameters['role'];
+        }
+
+        if (requestParameters['eventType'] != null) {
+            queryParameters['eventType'] = requestParameters['eventType'];
+        }
+
+        if (requestParameters['toolName'] != null) {
+            queryParameters['toolName'] = requestParameters['toolName'];
+        }
+
+        if (requestParameters['sessionId'] != null) {
+            queryParameters['sessionId'] = requestParameters['sessionId'];
+        }
+
+        if (requestParameters['projectId'] != null) {
+            queryParameters['projectId'] = requestParameters['projectId'];
+        }
+
+        if (requestParameters['after'] != null) {
+            queryParameters['after'] = (requestParameters['after'] as any).toISOString();
+        }
+
+        if (requestParameters['before'] != null) {
+            queryParameters['before'] = (requestParameters['before'] as any).toISOString();
+        }
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        if (requestParameters['matchesPerSession'] != null) {
+            queryParameters['matchesPerSession'] = requestParameters['matchesPerSession'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/conversations/search`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ConversationSearchResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Full-text search over message text, tool inputs and tool results. Every word of the query must match; words are not parsed as search syntax. Returns the matching sessions, most recent match first, with highlighted snippets and the sequence of each matching event. 
+     * Search conversation content
+     */
+    async searchConversations(requestParameters: SearchConversationsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ConversationSearchResponse> {
+        const response = await this.searchConversationsRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
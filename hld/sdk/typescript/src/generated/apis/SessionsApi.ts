@@ -19,22 +19,30 @@ import type {
   BulkArchiveResponse,
   BulkRestoreDraftsRequest,
   BulkRestoreDraftsResponse,
+  CompactSessionRequest,
   ContinueSessionRequest,
   ContinueSessionResponse,
   ConversationResponse,
   CreateSessionRequest,
   CreateSessionResponse,
   DirectoryNotFoundResponse,
+  EffectiveConfigResponse,
   ErrorResponse,
+  ImportSessionBundleResponse,
   InterruptSessionResponse,
   LaunchDraftSessionRequest,
   RecentPathsResponse,
+  SessionResourcesResponse,
   SessionResponse,
   SessionSearchResponse,
   SessionsResponse,
   SlashCommandsResponse,
   SnapshotsResponse,
+  TrustProjectConfigRequest,
+  TrustProjectConfigResponse,
   UpdateSessionRequest,
+  ValidateProjectConfigRequest,
+  ValidateProjectConfigResponse,
 } from '../models/index';
 import {
     BulkArchiveRequestFromJSON,
@@ -45,6 +53,8 @@ import {
     BulkRestoreDraftsRequestToJSON,
     BulkRestoreDraftsResponseFromJSON,
     BulkRestoreDraftsResponseToJSON,
+    CompactSessionRequestFromJSON,
+    CompactSessionRequestToJSON,
     ContinueSessionRequestFromJSON,
     ContinueSessionRequestToJSON,
     ContinueSessionResponseFromJSON,
@@ -57,14 +67,20 @@ import {
     CreateSessionResponseToJSON,
     DirectoryNotFoundResponseFromJSON,
     DirectoryNotFoundResponseToJSON,
+    EffectiveConfigResponseFromJSON,
+    EffectiveConfigResponseToJSON,
     ErrorResponseFromJSON,
     ErrorResponseToJSON,
+    ImportSessionBundleResponseFromJSON,
+    ImportSessionBundleResponseToJSON,
     InterruptSessionResponseFromJSON,
     InterruptSessionResponseToJSON,
     LaunchDraftSessionRequestFromJSON,
     LaunchDraftSessionRequestToJSON,
     RecentPathsResponseFromJSON,
     RecentPathsResponseToJSON,
+    SessionResourcesResponseFromJSON,
+    SessionResourcesResponseToJSON,
     SessionResponseFromJSON,
     SessionResponseToJSON,
     SessionSearchResponseFromJSON,
@@ -75,8 +91,16 @@ import {
     SlashCommandsResponseToJSON,
     SnapshotsResponseFromJSON,
     SnapshotsResponseToJSON,
+    TrustProjectConfigRequestFromJSON,
+    TrustProjectConfigRequestToJSON,
+    TrustProjectConfigResponseFromJSON,
+    TrustProjectConfigResponseToJSON,
     UpdateSessionRequestFromJSON,
     UpdateSessionRequestToJSON,
+    ValidateProjectConfigRequestFromJSON,
+    ValidateProjectConfigRequestToJSON,
+    ValidateProjectConfigResponseFromJSON,
+    ValidateProjectConfigResponseToJSON,
 } from '../models/index';
 
 export interface BulkArchiveSessionsRequest {
@@ -87,6 +111,11 @@ export interface BulkRestoreDraftsOperationRequest {
     bulkRestoreDraftsRequest: BulkRestoreDraftsRequest;
 }
 
+export interface CompactSessionOperationRequest {
+    id: string;
+    compactSessionRequest?: CompactSessionRequest;
+}
+
 export interface ContinueSessionOperationRequest {
     id: string;
     continueSessionRequest: ContinueSessionRequest;
@@ -100,6 +129,18 @@ export interface DeleteDraftSessionRequest {
     id: string;
 }
 
+export interface ExportSessionBundleRequest {
+    id: string;
+    includeRawEvents?: boolean;
+}
+
+export interface ExportSessionConversationRequest {
+    id: string;
+    format?: ExportSessionConversationFormatEnum;
+    maxToolResultBytes?: number;
+    maxToolResultLines?: number;
+}
+
 export interface GetRecentPathsRequest {
     limit?: number;
 }
@@ -108,8 +149,20 @@ export interface GetSessionRequest {
     id: string;
 }
 
+export interface GetSessionEffectiveConfigRequest {
+    id: string;
+}
+
 export interface GetSessionMessagesRequest {
     id: string;
+    limit?: number;
+    cursor?: string;
+    sinceSequence?: number;
+}
+
+export interface GetSessionResourcesRequest {
+    id: string;
+    limit?: number;
 }
 
 export interface GetSessionSnapshotsRequest {
@@ -125,6 +178,10 @@ export interface HardDeleteEmptyDraftSessionRequest {
     id: string;
 }
 
+export interface ImportSessionBundleRequest {
+    body: Blob;
+}
+
 export interface InterruptSessionRequest {
     id: string;
 }
@@ -137,6 +194,17 @@ export interface LaunchDraftSessionOperationRequest {
 export interface ListSessionsRequest {
     leavesOnly?: boolean;
     filter?: ListSessionsFilterEnum;
+    status?: Array<string>;
+    label?: Array<string>;
+    projectId?: string;
+    model?: Array<string>;
+    createdAfter?: Date;
+    createdBefore?: Date;
+    minCostUsd?: number;
+    maxCostUsd?: number;
+    savedFilterId?: string;
+    limit?: number;
+    cursor?: string;
 }
 
 export interface SearchSessionsRequest {
@@ -144,11 +212,19 @@ export interface SearchSessionsRequest {
     limit?: number;
 }
 
+export interface TrustProjectConfigOperationRequest {
+    trustProjectConfigRequest: TrustProjectConfigRequest;
+}
+
 export interface UpdateSessionOperationRequest {
     id: string;
     updateSessionRequest: UpdateSessionRequest;
 }
 
+export interface ValidateProjectConfigOperationRequest {
+    validateProjectConfigRequest: ValidateProjectConfigRequest;
+}
+
 /**
  * SessionsApi - interface
  * 
@@ -188,6 +264,23 @@ export interface SessionsApiInterface {
      */
     bulkRestoreDrafts(requestParameters: BulkRestoreDraftsOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BulkRestoreDraftsResponse>;
 
+    /**
+     * Continue a session with a compaction request, summarizing its conversation to free up context. The compaction is recorded as a compaction event in the new session's conversation. 
+     * @summary Compact a session's conversation
+     * @param {string} id Session ID
+     * @param {CompactSessionRequest} [compactSessionRequest] 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SessionsApiInterface
+     */
+    compactSessionRaw(requestParameters: CompactSessionOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ContinueSessionResponse>>;
+
+    /**
+     * Continue a session with a compaction request, summarizing its conversation to free up context. The compaction is recorded as a compaction event in the new session's conversation. 
+     * Compact a session's conversation
+     */
+    compactSession(requestParameters: CompactSessionOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ContinueSessionResponse>;
+
     /**
      * Create a new session that continues from an existing session, inheriting its conversation history. 
      * @summary Continue or fork a session
@@ -237,6 +330,42 @@ export interface SessionsApiInterface {
      */
     deleteDraftSession(requestParameters: DeleteDraftSessionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
 
+    /**
+     * Download the session together with every session in its continuation tree as a gzipped tar archive. The archive holds the session rows, conversation events, approvals, file snapshots and MCP server configuration, and optionally the raw stream events. Proxy API keys and MCP server environment values are stripped. 
+     * @summary Export a session bundle
+     * @param {string} id Session ID
+     * @param {boolean} [includeRawEvents] Include the raw Claude stream events
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SessionsApiInterface
+     */
+    exportSessionBundleRaw(requestParameters: ExportSessionBundleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Blob>>;
+
+    /**
+     * Download the session together with every session in its continuation tree as a gzipped tar archive. The archive holds the session rows, conversation events, approvals, file snapshots and MCP server configuration, and optionally the raw stream events. Proxy API keys and MCP server environment values are stripped. 
+     * Export a session bundle
+     */
+    exportSessionBundle(requestParameters: ExportSessionBundleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Blob>;
+
+    /**
+     * Render the session's conversation, including the sessions it continues from, as Markdown, a standalone HTML page or normalized JSON Lines. Tool calls, results and thinking are collapsible in Markdown and HTML, edits are shown as diffs and approval decisions are included with their comments. The transcript is streamed as it is rendered. Tool results and written file contents over the limits are truncated. 
+     * @summary Export a session conversation
+     * @param {string} id Session ID
+     * @param {'markdown' | 'html' | 'jsonl'} [format] Transcript format
+     * @param {number} [maxToolResultBytes] Truncate tool results longer than this many bytes. Defaults to 8192, 0 disables the limit.
+     * @param {number} [maxToolResultLines] Truncate tool results longer than this many lines. Defaults to 200, 0 disables the limit.
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SessionsApiInterface
+     */
+    exportSessionConversationRaw(requestParameters: ExportSessionConversationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Blob>>;
+
+    /**
+     * Render the session's conversation, including the sessions it continues from, as Markdown, a standalone HTML page or normalized JSON Lines. Tool calls, results and thinking are collapsible in Markdown and HTML, edits are shown as diffs and approval decisions are included with their comments. The transcript is streamed as it is rendered. Tool results and written file contents over the limits are truncated. 
+     * Export a session conversation
+     */
+    exportSessionConversation(requestParameters: ExportSessionConversationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Blob>;
+
     /**
      * Retrieve recently used working directories for quick access
      * @summary Get recent working directories
@@ -270,9 +399,28 @@ export interface SessionsApiInterface {
     getSession(requestParameters: GetSessionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionResponse>;
 
     /**
-     * Retrieve the full conversation history for a session, including messages, tool calls, and tool results. 
+     * Retrieve the configuration the session was launched with after the project's .humanlayer/project.json was merged into the launch request, and where each value came from. 
+     * @summary Get session effective configuration
+     * @param {string} id Session ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SessionsApiInterface
+     */
+    getSessionEffectiveConfigRaw(requestParameters: GetSessionEffectiveConfigRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<EffectiveConfigResponse>>;
+
+    /**
+     * Retrieve the configuration the session was launched with after the project's .humanlayer/project.json was merged into the launch request, and where each value came from. 
+     * Get session effective configuration
+     */
+    getSessionEffectiveConfig(requestParameters: GetSessionEffectiveConfigRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<EffectiveConfigResponse>;
+
+    /**
+     * Retrieve the full conversation history for a session, including messages, tool calls, and tool results. Long conversations can be fetched in pages with limit and cursor, and clients following a live session can fetch only new events with since_sequence. 
      * @summary Get conversation messages
      * @param {string} id Session ID
+     * @param {number} [limit] Maximum number of events to return. When omitted all events are returned.
+     * @param {string} [cursor] Continue after the last event of a previous page, from its next_cursor
+     * @param {number} [sinceSequence] Only events of the session's own Claude session with a higher sequence number. Ignored when cursor is given. 
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      * @memberof SessionsApiInterface
@@ -280,11 +428,28 @@ export interface SessionsApiInterface {
     getSessionMessagesRaw(requestParameters: GetSessionMessagesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ConversationResponse>>;
 
     /**
-     * Retrieve the full conversation history for a session, including messages, tool calls, and tool results. 
+     * Retrieve the full conversation history for a session, including messages, tool calls, and tool results. Long conversations can be fetched in pages with limit and cursor, and clients following a live session can fetch only new events with since_sequence. 
      * Get conversation messages
      */
     getSessionMessages(requestParameters: GetSessionMessagesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ConversationResponse>;
 
+    /**
+     * Retrieve the latest and peak CPU, memory and file descriptor usage of the session's process tree, along with its recent samples. 
+     * @summary Get session resource usage
+     * @param {string} id Session ID
+     * @param {number} [limit] Maximum number of most recent samples to return
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SessionsApiInterface
+     */
+    getSessionResourcesRaw(requestParameters: GetSessionResourcesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionResourcesResponse>>;
+
+    /**
+     * Retrieve the latest and peak CPU, memory and file descriptor usage of the session's process tree, along with its recent samples. 
+     * Get session resource usage
+     */
+    getSessionResources(requestParameters: GetSessionResourcesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionResourcesResponse>;
+
     /**
      * Retrieve file snapshots captured during the session, showing the state of files at specific points in time. 
      * @summary Get file snapshots
@@ -334,6 +499,22 @@ export interface SessionsApiInterface {
      */
     hardDeleteEmptyDraftSession(requestParameters: HardDeleteEmptyDraftSessionRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
 
+    /**
+     * Import the sessions of a bundle downloaded from exportSessionBundle. Every session, run, Claude session and approval gets a new ID, and the continuation tree is kept. Sessions that were still active are imported as interrupted and pending approvals as denied. 
+     * @summary Import a session bundle
+     * @param {Blob} body 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SessionsApiInterface
+     */
+    importSessionBundleRaw(requestParameters: ImportSessionBundleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ImportSessionBundleResponse>>;
+
+    /**
+     * Import the sessions of a bundle downloaded from exportSessionBundle. Every session, run, Claude session and approval gets a new ID, and the continuation tree is kept. Sessions that were still active are imported as interrupted and pending approvals as denied. 
+     * Import a session bundle
+     */
+    importSessionBundle(requestParameters: ImportSessionBundleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ImportSessionBundleResponse>;
+
     /**
      * Send an interrupt signal to a running session, causing it to complete gracefully. 
      * @summary Interrupt a running session
@@ -372,6 +553,17 @@ export interface SessionsApiInterface {
      * @summary List sessions
      * @param {boolean} [leavesOnly] Return only leaf sessions (sessions with no children)
      * @param {'normal' | 'archived' | 'draft'} [filter] Filter sessions by type
+     * @param {Array<string>} [status] Only sessions with one of these statuses
+     * @param {Array<string>} [label] Only sessions carrying every one of these labels (case-insensitive)
+     * @param {string} [projectId] Only sessions in this project
+     * @param {Array<string>} [model] Only sessions using one of these models, by alias or full model ID
+     * @param {Date} [createdAfter] Only sessions created at or after this time
+     * @param {Date} [createdBefore] Only sessions created before this time
+     * @param {number} [minCostUsd] Only sessions that cost at least this much
+     * @param {number} [maxCostUsd] Only sessions that cost at most this much
+     * @param {string} [savedFilterId] Start from a saved filter. Other filter parameters given in the same request replace the saved filter's value for that field. 
+     * @param {number} [limit] Maximum number of sessions to return. When omitted all matching sessions are returned. 
+     * @param {string} [cursor] Continue after the last session of a previous page, from its next_cursor
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      * @memberof SessionsApiInterface
@@ -385,9 +577,9 @@ export interface SessionsApiInterface {
     listSessions(requestParameters: ListSessionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionsResponse>;
 
     /**
-     * Search for sessions using SQL LIKE queries against the title field. Returns top sessions ordered by last_activity_at descending. Limited to 20 most recently modified sessions for performance. 
-     * @summary Search sessions by title
-     * @param {string} [query] Search query for title matching (uses SQL LIKE)
+     * Search for sessions using SQL LIKE queries across title, summary, and query fields. Only returns "normal" leaf sessions (not archived, not draft, not discarded, no children). Returns top sessions ordered by last_activity_at descending. Limited to 20 most recently modified sessions for performance. 
+     * @summary Search sessions
+     * @param {string} [query] Search query for matching against title, summary, or query fields (uses SQL LIKE)
      * @param {number} [limit] Maximum number of results to return
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
@@ -396,11 +588,27 @@ export interface SessionsApiInterface {
     searchSessionsRaw(requestParameters: SearchSessionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionSearchResponse>>;
 
     /**
-     * Search for sessions using SQL LIKE queries against the title field. Returns top sessions ordered by last_activity_at descending. Limited to 20 most recently modified sessions for performance. 
-     * Search sessions by title
+     * Search for sessions using SQL LIKE queries across title, summary, and query fields. Only returns "normal" leaf sessions (not archived, not draft, not discarded, no children). Returns top sessions ordered by last_activity_at descending. Limited to 20 most recently modified sessions for performance. 
+     * Search sessions
      */
     searchSessions(requestParameters: SearchSessionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionSearchResponse>;
 
+    /**
+     * Record trust in the current content of the .humanlayer/project.json in a working directory, or revoke it. Until a project configuration is trusted, its MCP servers, directories outside the project and auto-accepting edits are ignored. Editing the file revokes its trust. 
+     * @summary Trust a project configuration
+     * @param {TrustProjectConfigRequest} trustProjectConfigRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SessionsApiInterface
+     */
+    trustProjectConfigRaw(requestParameters: TrustProjectConfigOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<TrustProjectConfigResponse>>;
+
+    /**
+     * Record trust in the current content of the .humanlayer/project.json in a working directory, or revoke it. Until a project configuration is trusted, its MCP servers, directories outside the project and auto-accepting edits are ignored. Editing the file revokes its trust. 
+     * Trust a project configuration
+     */
+    trustProjectConfig(requestParameters: TrustProjectConfigOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<TrustProjectConfigResponse>;
+
     /**
      * Update session settings such as auto-accept mode or archived status. Only specified fields will be updated. 
      * @summary Update session settings
@@ -418,6 +626,22 @@ export interface SessionsApiInterface {
      */
     updateSession(requestParameters: UpdateSessionOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionResponse>;
 
+    /**
+     * Check the .humanlayer/project.json in a working directory, or the given content, and report every problem found. 
+     * @summary Validate a project configuration
+     * @param {ValidateProjectConfigRequest} validateProjectConfigRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof SessionsApiInterface
+     */
+    validateProjectConfigRaw(requestParameters: ValidateProjectConfigOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ValidateProjectConfigResponse>>;
+
+    /**
+     * Check the .humanlayer/project.json in a working directory, or the given content, and report every problem found. 
+     * Validate a project configuration
+     */
+    validateProjectConfig(requestParameters: ValidateProjectConfigOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ValidateProjectConfigResponse>;
+
 }
 
 /**
@@ -507,6 +731,48 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
         return await response.value();
     }
 
+    /**
+     * Continue a session with a compaction request, summarizing its conversation to free up context. The compaction is recorded as a compaction event in the new session's conversation. 
+     * Compact a session's conversation
+     */
+    async compactSessionRaw(requestParameters: CompactSessionOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ContinueSessionResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling compactSession().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/sessions/{id}/compact`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: CompactSessionRequestToJSON(requestParameters['compactSessionRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ContinueSessionResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Continue a session with a compaction request, summarizing its conversation to free up context. The compaction is recorded as a compaction event in the new session's conversation. 
+     * Compact a session's conversation
+     */
+    async compactSession(requestParameters: CompactSessionOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ContinueSessionResponse> {
+        const response = await this.compactSessionRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Create a new session that continues from an existing session, inheriting its conversation history. 
      * Continue or fork a session
@@ -635,6 +901,100 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
         await this.deleteDraftSessionRaw(requestParameters, initOverrides);
     }
 
+    /**
+     * Download the session together with every session in its continuation tree as a gzipped tar archive. The archive holds the session rows, conversation events, approvals, file snapshots and MCP server configuration, and optionally the raw stream events. Proxy API keys and MCP server environment values are stripped. 
+     * Export a session bundle
+     */
+    async exportSessionBundleRaw(requestParameters: ExportSessionBundleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Blob>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling exportSessionBundle().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['includeRawEvents'] != null) {
+            queryParameters['include_raw_events'] = requestParameters['includeRawEvents'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/sessions/{id}/bundle`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.BlobApiResponse(response);
+    }
+
+    /**
+     * Download the session together with every session in its continuation tree as a gzipped tar archive. The archive holds the session rows, conversation events, approvals, file snapshots and MCP server configuration, and optionally the raw stream events. Proxy API keys and MCP server environment values are stripped. 
+     * Export a session bundle
+     */
+    async exportSessionBundle(requestParameters: ExportSessionBundleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Blob> {
+        const response = await this.exportSessionBundleRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Render the session's conversation, including the sessions it continues from, as Markdown, a standalone HTML page or normalized JSON Lines. Tool calls, results and thinking are collapsible in Markdown and HTML, edits are shown as diffs and approval decisions are included with their comments. The transcript is streamed as it is rendered. Tool results and written file contents over the limits are truncated. 
+     * Export a session conversation
+     */
+    async exportSessionConversationRaw(requestParameters: ExportSessionConversationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Blob>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling exportSessionConversation().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['format'] != null) {
+            queryParameters['format'] = requestParameters['format'];
+        }
+
+        if (requestParameters['maxToolResultBytes'] != null) {
+            queryParameters['max_tool_result_bytes'] = requestParameters['maxToolResultBytes'];
+        }
+
+        if (requestParameters['maxToolResultLines'] != null) {
+            queryParameters['max_tool_result_lines'] = requestParameters['maxToolResultLines'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/sessions/{id}/export`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.BlobApiResponse(response);
+    }
+
+    /**
+     * Render the session's conversation, including the sessions it continues from, as Markdown, a standalone HTML page or normalized JSON Lines. Tool calls, results and thinking are collapsible in Markdown and HTML, edits are shown as diffs and approval decisions are included with their comments. The transcript is streamed as it is rendered. Tool results and written file contents over the limits are truncated. 
+     * Export a session conversation
+     */
+    async exportSessionConversation(requestParameters: ExportSessionConversationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Blob> {
+        const response = await this.exportSessionConversationRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Retrieve recently used working directories for quick access
      * Get recent working directories
@@ -710,7 +1070,46 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
     }
 
     /**
-     * Retrieve the full conversation history for a session, including messages, tool calls, and tool results. 
+     * Retrieve the configuration the session was launched with after the project's .humanlayer/project.json was merged into the launch request, and where each value came from. 
+     * Get session effective configuration
+     */
+    async getSessionEffectiveConfigRaw(requestParameters: GetSessionEffectiveConfigRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<EffectiveConfigResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling getSessionEffectiveConfig().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/sessions/{id}/effective-config`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => EffectiveConfigResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Retrieve the configuration the session was launched with after the project's .humanlayer/project.json was merged into the launch request, and where each value came from. 
+     * Get session effective configuration
+     */
+    async getSessionEffectiveConfig(requestParameters: GetSessionEffectiveConfigRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<EffectiveConfigResponse> {
+        const response = await this.getSessionEffectiveConfigRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Retrieve the full conversation history for a session, including messages, tool calls, and tool results. Long conversations can be fetched in pages with limit and cursor, and clients following a live session can fetch only new events with since_sequence. 
      * Get conversation messages
      */
     async getSessionMessagesRaw(requestParameters: GetSessionMessagesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ConversationResponse>> {
@@ -723,6 +1122,18 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
 
         const queryParameters: any = {};
 
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        if (requestParameters['cursor'] != null) {
+            queryParameters['cursor'] = requestParameters['cursor'];
+        }
+
+        if (requestParameters['sinceSequence'] != null) {
+            queryParameters['since_sequence'] = requestParameters['sinceSequence'];
+        }
+
         const headerParameters: runtime.HTTPHeaders = {};
 
 
@@ -740,7 +1151,7 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
     }
 
     /**
-     * Retrieve the full conversation history for a session, including messages, tool calls, and tool results. 
+     * Retrieve the full conversation history for a session, including messages, tool calls, and tool results. Long conversations can be fetched in pages with limit and cursor, and clients following a live session can fetch only new events with since_sequence. 
      * Get conversation messages
      */
     async getSessionMessages(requestParameters: GetSessionMessagesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ConversationResponse> {
@@ -748,6 +1159,49 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
         return await response.value();
     }
 
+    /**
+     * Retrieve the latest and peak CPU, memory and file descriptor usage of the session's process tree, along with its recent samples. 
+     * Get session resource usage
+     */
+    async getSessionResourcesRaw(requestParameters: GetSessionResourcesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionResourcesResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling getSessionResources().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/sessions/{id}/resources`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SessionResourcesResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Retrieve the latest and peak CPU, memory and file descriptor usage of the session's process tree, along with its recent samples. 
+     * Get session resource usage
+     */
+    async getSessionResources(requestParameters: GetSessionResourcesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionResourcesResponse> {
+        const response = await this.getSessionResourcesRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Retrieve file snapshots captured during the session, showing the state of files at specific points in time. 
      * Get file snapshots
@@ -871,6 +1325,47 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
         await this.hardDeleteEmptyDraftSessionRaw(requestParameters, initOverrides);
     }
 
+    /**
+     * Import the sessions of a bundle downloaded from exportSessionBundle. Every session, run, Claude session and approval gets a new ID, and the continuation tree is kept. Sessions that were still active are imported as interrupted and pending approvals as denied. 
+     * Import a session bundle
+     */
+    async importSessionBundleRaw(requestParameters: ImportSessionBundleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ImportSessionBundleResponse>> {
+        if (requestParameters['body'] == null) {
+            throw new runtime.RequiredError(
+                'body',
+                'Required parameter "body" was null or undefined when calling importSessionBundle().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/gzip';
+
+
+        let urlPath = `/sessions/import`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: requestParameters['body'] as any,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ImportSessionBundleResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Import the sessions of a bundle downloaded from exportSessionBundle. Every session, run, Claude session and approval gets a new ID, and the continuation tree is kept. Sessions that were still active are imported as interrupted and pending approvals as denied. 
+     * Import a session bundle
+     */
+    async importSessionBundle(requestParameters: ImportSessionBundleRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ImportSessionBundleResponse> {
+        const response = await this.importSessionBundleRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Send an interrupt signal to a running session, causing it to complete gracefully. 
      * Interrupt a running session
@@ -974,6 +1469,50 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
             queryParameters['filter'] = requestParameters['filter'];
         }
 
+        if (requestParameters['status'] != null) {
+            queryParameters['status'] = requestParameters['status'];
+        }
+
+        if (requestParameters['label'] != null) {
+            queryParameters['label'] = requestParameters['label'];
+        }
+
+        if (requestParameters['projectId'] != null) {
+            queryParameters['projectId'] = requestParameters['projectId'];
+        }
+
+        if (requestParameters['model'] != null) {
+            queryParameters['model'] = requestParameters['model'];
+        }
+
+        if (requestParameters['createdAfter'] != null) {
+            queryParameters['createdAfter'] = (requestParameters['createdAfter'] as any).toISOString();
+        }
+
+        if (requestParameters['createdBefore'] != null) {
+            queryParameters['createdBefore'] = (requestParameters['createdBefore'] as any).toISOString();
+        }
+
+        if (requestParameters['minCostUsd'] != null) {
+            queryParameters['minCostUsd'] = requestParameters['minCostUsd'];
+        }
+
+        if (requestParameters['maxCostUsd'] != null) {
+            queryParameters['maxCostUsd'] = requestParameters['maxCostUsd'];
+        }
+
+        if (requestParameters['savedFilterId'] != null) {
+            queryParameters['savedFilterId'] = requestParameters['savedFilterId'];
+        }
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        if (requestParameters['cursor'] != null) {
+            queryParameters['cursor'] = requestParameters['cursor'];
+        }
+
         const headerParameters: runtime.HTTPHeaders = {};
 
 
@@ -999,8 +1538,8 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
     }
 
     /**
-     * Search for sessions using SQL LIKE queries against the title field. Returns top sessions ordered by last_activity_at descending. Limited to 20 most recently modified sessions for performance. 
-     * Search sessions by title
+     * Search for sessions using SQL LIKE queries across title, summary, and query fields. Only returns "normal" leaf sessions (not archived, not draft, not discarded, no children). Returns top sessions ordered by last_activity_at descending. Limited to 20 most recently modified sessions for performance. 
+     * Search sessions
      */
     async searchSessionsRaw(requestParameters: SearchSessionsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionSearchResponse>> {
         const queryParameters: any = {};
@@ -1029,14 +1568,55 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
     }
 
     /**
-     * Search for sessions using SQL LIKE queries against the title field. Returns top sessions ordered by last_activity_at descending. Limited to 20 most recently modified sessions for performance. 
-     * Search sessions by title
+     * Search for sessions using SQL LIKE queries across title, summary, and query fields. Only returns "normal" leaf sessions (not archived, not draft, not discarded, no children). Returns top sessions ordered by last_activity_at descending. Limited to 20 most recently modified sessions for performance. 
+     * Search sessions
      */
     async searchSessions(requestParameters: SearchSessionsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionSearchResponse> {
         const response = await this.searchSessionsRaw(requestParameters, initOverrides);
         return await response.value();
     }
 
+    /**
+     * Record trust in the current content of the .humanlayer/project.json in a working directory, or revoke it. Until a project configuration is trusted, its MCP servers, directories outside the project and auto-accepting edits are ignored. Editing the file revokes its trust. 
+     * Trust a project configuration
+     */
+    async trustProjectConfigRaw(requestParameters: TrustProjectConfigOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<TrustProjectConfigResponse>> {
+        if (requestParameters['trustProjectConfigRequest'] == null) {
+            throw new runtime.RequiredError(
+                'trustProjectConfigRequest',
+                'Required parameter "trustProjectConfigRequest" was null or undefined when calling trustProjectConfig().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/project-config/trust`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: TrustProjectConfigRequestToJSON(requestParameters['trustProjectConfigRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => TrustProjectConfigResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Record trust in the current content of the .humanlayer/project.json in a working directory, or revoke it. Until a project configuration is trusted, its MCP servers, directories outside the project and auto-accepting edits are ignored. Editing the file revokes its trust. 
+     * Trust a project configuration
+     */
+    async trustProjectConfig(requestParameters: TrustProjectConfigOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<TrustProjectConfigResponse> {
+        const response = await this.trustProjectConfigRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
     /**
      * Update session settings such as auto-accept mode or archived status. Only specified fields will be updated. 
      * Update session settings
@@ -1086,8 +1666,58 @@ export class SessionsApi extends runtime.BaseAPI implements SessionsApiInterface
         return await response.value();
     }
 
+    /**
+     * Check the .humanlayer/project.json in a working directory, or the given content, and report every problem found. 
+     * Validate a project configuration
+     */
+    async validateProjectConfigRaw(requestParameters: ValidateProjectConfigOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ValidateProjectConfigResponse>> {
+        if (requestParameters['validateProjectConfigRequest'] == null) {
+            throw new runtime.RequiredError(
+                'validateProjectConfigRequest',
+                'Required parameter "validateProjectConfigRequest" was null or undefined when calling validateProjectConfig().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/project-config/validate`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ValidateProjectConfigRequestToJSON(requestParameters['validateProjectConfigRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ValidateProjectConfigResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Check the .humanlayer/project.json in a working directory, or the given content, and report every problem found. 
+     * Validate a project configuration
+     */
+    async validateProjectConfig(requestParameters: ValidateProjectConfigOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ValidateProjectConfigResponse> {
+        const response = await this.validateProjectConfigRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
 }
 
+/**
+ * @export
+ */
+export const ExportSessionConversationFormatEnum = {
+    Markdown: 'markdown',
+    Html: 'html',
+    Jsonl: 'jsonl'
+} as const;
+export type ExportSessionConversationFormatEnum = typeof ExportSessionConversationFormatEnum[keyof typeof ExportSessionConversationFormatEnum];
 /**
  * @export
  */
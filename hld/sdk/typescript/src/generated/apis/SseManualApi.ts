@@ -16,16 +16,23 @@
 import * as runtime from '../runtime';
 import type {
   EventType,
+  OverflowPolicy,
 } from '../models/index';
 import {
     EventTypeFromJSON,
     EventTypeToJSON,
+    OverflowPolicyFromJSON,
+    OverflowPolicyToJSON,
 } from '../models/index';
 
 export interface StreamEventsRequest {
     eventTypes?: Array<EventType>;
     sessionId?: string;
     runId?: string;
+    since?: number;
+    lastEventID?: string;
+    bufferSize?: number;
+    overflow?: OverflowPolicy;
 }
 
 /**
@@ -36,11 +43,15 @@ export interface StreamEventsRequest {
  */
 export interface SseManualApiInterface {
     /**
-     * Subscribe to real-time events using Server-Sent Events (SSE). This endpoint streams events as they occur in the system.  **Note**: This endpoint uses Server-Sent Events which is not natively supported by OpenAPI 3.1. Client code generation will not work for this endpoint. Manual SSE client implementation is required using: - JavaScript/TypeScript: Native EventSource API - Go: r3labs/sse or similar SSE client library - Other languages: Language-specific SSE client libraries 
+     * Subscribe to real-time events using Server-Sent Events (SSE). This endpoint streams events as they occur in the system.  Every event carries its sequence number as the SSE event ID. A client that reconnects with Last-Event-ID, as EventSource does, or with the since parameter first receives the kept events published after it. If some of them are no longer kept, a replay_gap event comes first and the client should refetch the state it tracks.  Events wait in a buffer of bufferSize events while the client reads them. When it is full, overflow decides what happens: drop_newest drops the new event, drop_oldest the oldest buffered one, coalesce the buffered event of the same type and session the new one supersedes, and disconnect ends the stream so the client resumes from its last event ID.  **Note**: This endpoint uses Server-Sent Events which is not natively supported by OpenAPI 3.1. Client code generation will not work for this endpoint. Manual SSE client implementation is required using: - JavaScript/TypeScript: Native EventSource API - Go: r3labs/sse or similar SSE client library - Other languages: Language-specific SSE client libraries 
      * @summary Server-Sent Events stream
      * @param {Array<EventType>} [eventTypes] Filter by event types
      * @param {string} [sessionId] Filter events by session ID
      * @param {string} [runId] Filter events by run ID
+     * @param {number} [since] Resume after the event with this sequence number
+     * @param {string} [lastEventID] Resume after the event with this sequence number, overriding since
+     * @param {number} [bufferSize] Number of events buffered for a slow client
+     * @param {OverflowPolicy} [overflow] What happens to events that do not fit the buffer
      * @param {*} [options] Override http request option.
      * @throws {RequiredError}
      * @memberof SseManualApiInterface
@@ -48,7 +59,7 @@ export interface SseManualApiInterface {
     streamEventsRaw(requestParameters: StreamEventsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<string>>;
 
     /**
-     * Subscribe to real-time events using Server-Sent Events (SSE). This endpoint streams events as they occur in the system.  **Note**: This endpoint uses Server-Sent Events which is not natively supported by OpenAPI 3.1. Client code generation will not work for this endpoint. Manual SSE client implementation is required using: - JavaScript/TypeScript: Native EventSource API - Go: r3labs/sse or similar SSE client library - Other languages: Language-specific SSE client libraries 
+     * Subscribe to real-time events using Server-Sent Events (SSE). This endpoint streams events as they occur in the system.  Every event carries its sequence number as the SSE event ID. A client that reconnects with Last-Event-ID, as EventSource does, or with the since parameter first receives the kept events published after it. If some of them are no longer kept, a replay_gap event comes first and the client should refetch the state it tracks.  Events wait in a buffer of bufferSize events while the client reads them. When it is full, overflow decides what happens: drop_newest drops the new event, drop_oldest the oldest buffered one, coalesce the buffered event of the same type and session the new one supersedes, and disconnect ends the stream so the client resumes from its last event ID.  **Note**: This endpoint uses Server-Sent Events which is not natively supported by OpenAPI 3.1. Client code generation will not work for this endpoint. Manual SSE client implementation is required using: - JavaScript/TypeScript: Native EventSource API - Go: r3labs/sse or similar SSE client library - Other languages: Language-specific SSE client libraries 
      * Server-Sent Events stream
      */
     streamEvents(requestParameters: StreamEventsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<string>;
@@ -61,7 +72,7 @@ export interface SseManualApiInterface {
 export class SseManualApi extends runtime.BaseAPI implements SseManualApiInterface {
 
     /**
-     * Subscribe to real-time events using Server-Sent Events (SSE). This endpoint streams events as they occur in the system.  **Note**: This endpoint uses Server-Sent Events which is not natively supported by OpenAPI 3.1. Client code generation will not work for this endpoint. Manual SSE client implementation is required using: - JavaScript/TypeScript: Native EventSource API - Go: r3labs/sse or similar SSE client library - Other languages: Language-specific SSE client libraries 
+     * Subscribe to real-time events using Server-Sent Events (SSE). This endpoint streams events as they occur in the system.  Every event carries its sequence number as the SSE event ID. A client that reconnects with Last-Event-ID, as EventSource does, or with the since parameter first receives the kept events published after it. If some of them are no longer kept, a replay_gap event comes first and the client should refetch the state it tracks.  Events wait in a buffer of bufferSize events while the client reads them. When it is full, overflow decides what happens: drop_newest drops the new event, drop_oldest the oldest buffered one, coalesce the buffered event of the same type and session the new one supersedes, and disconnect ends the stream so the client resumes from its last event ID.  **Note**: This endpoint uses Server-Sent Events which is not natively supported by OpenAPI 3.1. Client code generation will not work for this endpoint. Manual SSE client implementation is required using: - JavaScript/TypeScript: Native EventSource API - Go: r3labs/sse or similar SSE client library - Other languages: Language-specific SSE client libraries 
      * Server-Sent Events stream
      */
     async streamEventsRaw(requestParameters: StreamEventsRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<string>> {
@@ -79,8 +90,24 @@ export class SseManualApi extends runtime.BaseAPI implements SseManualApiInterfa
             queryParameters['runId'] = requestParameters['runId'];
         }
 
+        if (requestParameters['since'] != null) {
+            queryParameters['since'] = requestParameters['since'];
+        }
+
+        if (requestParameters['bufferSize'] != null) {
+            queryParameters['bufferSize'] = requestParameters['bufferSize'];
+        }
+
+        if (requestParameters['overflow'] != null) {
+            queryParameters['overflow'] = requestParameters['overflow'];
+        }
+
         const headerParameters: runtime.HTTPHeaders = {};
 
+        if (requestParameters['lastEventID'] != null) {
+            headerParameters['Last-Event-ID'] = String(requestParameters['lastEventID']);
+        }
+
 
         let urlPath = `/stream/events`;
 
@@ -99,7 +126,7 @@ export class SseManualApi extends runtime.BaseAPI implements SseManualApiInterfa
     }
 
     /**
-     * Subscribe to real-time events using Server-Sent Events (SSE). This endpoint streams events as they occur in the system.  **Note**: This endpoint uses Server-Sent Events which is not natively supported by OpenAPI 3.1. Client code generation will not work for this endpoint. Manual SSE client implementation is required using: - JavaScript/TypeScript: Native EventSource API - Go: r3labs/sse or similar SSE client library - Other languages: Language-specific SSE client libraries 
+     * Subscribe to real-time events using Server-Sent Events (SSE). This endpoint streams events as they occur in the system.  Every event carries its sequence number as the SSE event ID. A client that reconnects with Last-Event-ID, as EventSource does, or with the since parameter first receives the kept events published after it. If some of them are no longer kept, a replay_gap event comes first and the client should refetch the state it tracks.  Events wait in a buffer of bufferSize events while the client reads them. When it is full, overflow decides what happens: drop_newest drops the new event, drop_oldest the oldest buffered one, coalesce the buffered event of the same type and session the new one supersedes, and disconnect ends the stream so the client resumes from its last event ID.  **Note**: This endpoint uses Server-Sent Events which is not natively supported by OpenAPI 3.1. Client code generation will not work for this endpoint. Manual SSE client implementation is required using: - JavaScript/TypeScript: Native EventSource API - Go: r3labs/sse or similar SSE client library - Other languages: Language-specific SSE client libraries 
      * Server-Sent Events stream
      */
     async streamEvents(requestParameters: StreamEventsRequest = {}, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<string> {
@@ -0,0 +1,543 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  CreateSessionResponse,
+  ErrorResponse,
+  ImportTemplateRequest,
+  LaunchTemplateRequest,
+  SessionTemplateResponse,
+  SessionTemplateSpec,
+  SessionTemplatesResponse,
+  TemplateExportResponse,
+} from '../models/index';
+import {
+    CreateSessionResponseFromJSON,
+    CreateSessionResponseToJSON,
+    ErrorResponseFromJSON,
+    ErrorResponseToJSON,
+    ImportTemplateRequestFromJSON,
+    ImportTemplateRequestToJSON,
+    LaunchTemplateRequestFromJSON,
+    LaunchTemplateRequestToJSON,
+    SessionTemplateResponseFromJSON,
+    SessionTemplateResponseToJSON,
+    SessionTemplateSpecFromJSON,
+    SessionTemplateSpecToJSON,
+    SessionTemplatesResponseFromJSON,
+    SessionTemplatesResponseToJSON,
+    TemplateExportResponseFromJSON,
+    TemplateExportResponseToJSON,
+} from '../models/index';
+
+export interface CreateTemplateRequest {
+    sessionTemplateSpec: SessionTemplateSpec;
+}
+
+export interface DeleteTemplateRequest {
+    id: string;
+}
+
+export interface ExportTemplateRequest {
+    id: string;
+}
+
+export interface GetTemplateRequest {
+    id: string;
+}
+
+export interface ImportTemplateOperationRequest {
+    importTemplateRequest: ImportTemplateRequest;
+}
+
+export interface LaunchTemplateOperationRequest {
+    id: string;
+    launchTemplateRequest: LaunchTemplateRequest;
+}
+
+export interface UpdateTemplateRequest {
+    id: string;
+    sessionTemplateSpec: SessionTemplateSpec;
+}
+
+/**
+ * TemplatesApi - interface
+ * 
+ * @export
+ * @interface TemplatesApiInterface
+ */
+export interface TemplatesApiInterface {
+    /**
+     * Create a reusable session configuration. Query, title, prompts, custom instructions and directories may contain {{variable}} placeholders that are filled in when a session is launched. 
+     * @summary Create a session template
+     * @param {SessionTemplateSpec} sessionTemplateSpec 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof TemplatesApiInterface
+     */
+    createTemplateRaw(requestParameters: CreateTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplateResponse>>;
+
+    /**
+     * Create a reusable session configuration. Query, title, prompts, custom instructions and directories may contain {{variable}} placeholders that are filled in when a session is launched. 
+     * Create a session template
+     */
+    createTemplate(requestParameters: CreateTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplateResponse>;
+
+    /**
+     * Delete a template. Sessions launched from it are kept.
+     * @summary Delete a session template
+     * @param {string} id Session template ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof TemplatesApiInterface
+     */
+    deleteTemplateRaw(requestParameters: DeleteTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Delete a template. Sessions launched from it are kept.
+     * Delete a session template
+     */
+    deleteTemplate(requestParameters: DeleteTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
+    /**
+     * Export a template as a YAML file that can be committed and shared. The proxy API key is never exported, and MCP server environment and header values are redacted. 
+     * @summary Export a session template
+     * @param {string} id Session template ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof TemplatesApiInterface
+     */
+    exportTemplateRaw(requestParameters: ExportTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<TemplateExportResponse>>;
+
+    /**
+     * Export a template as a YAML file that can be committed and shared. The proxy API key is never exported, and MCP server environment and header values are redacted. 
+     * Export a session template
+     */
+    exportTemplate(requestParameters: ExportTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<TemplateExportResponse>;
+
+    /**
+     * 
+     * @summary Get a session template
+     * @param {string} id Session template ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof TemplatesApiInterface
+     */
+    getTemplateRaw(requestParameters: GetTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplateResponse>>;
+
+    /**
+     * Get a session template
+     */
+    getTemplate(requestParameters: GetTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplateResponse>;
+
+    /**
+     * Import a template from the contents of a YAML or JSON template file. A template with the same name is replaced only when overwrite is set. 
+     * @summary Import a session template
+     * @param {ImportTemplateRequest} importTemplateRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof TemplatesApiInterface
+     */
+    importTemplateRaw(requestParameters: ImportTemplateOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplateResponse>>;
+
+    /**
+     * Import a template from the contents of a YAML or JSON template file. A template with the same name is replaced only when overwrite is set. 
+     * Import a session template
+     */
+    importTemplate(requestParameters: ImportTemplateOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplateResponse>;
+
+    /**
+     * Fill in the template's variables and launch a session. Missing required variables and unknown variables are rejected. 
+     * @summary Launch a session from a template
+     * @param {string} id Session template ID
+     * @param {LaunchTemplateRequest} launchTemplateRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof TemplatesApiInterface
+     */
+    launchTemplateRaw(requestParameters: LaunchTemplateOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<CreateSessionResponse>>;
+
+    /**
+     * Fill in the template's variables and launch a session. Missing required variables and unknown variables are rejected. 
+     * Launch a session from a template
+     */
+    launchTemplate(requestParameters: LaunchTemplateOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<CreateSessionResponse>;
+
+    /**
+     * All session templates ordered by name
+     * @summary List session templates
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof TemplatesApiInterface
+     */
+    listTemplatesRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplatesResponse>>;
+
+    /**
+     * All session templates ordered by name
+     * List session templates
+     */
+    listTemplates(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplatesResponse>;
+
+    /**
+     * Replace a template's configuration. An omitted proxy_api_key keeps the stored key. 
+     * @summary Replace a session template
+     * @param {string} id Session template ID
+     * @param {SessionTemplateSpec} sessionTemplateSpec 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof TemplatesApiInterface
+     */
+    updateTemplateRaw(requestParameters: UpdateTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplateResponse>>;
+
+    /**
+     * Replace a template's configuration. An omitted proxy_api_key keeps the stored key. 
+     * Replace a session template
+     */
+    updateTemplate(requestParameters: UpdateTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplateResponse>;
+
+}
+
+/**
+ * 
+ */
+export class TemplatesApi extends runtime.BaseAPI implements TemplatesApiInterface {
+
+    /**
+     * Create a reusable session configuration. Query, title, prompts, custom instructions and directories may contain {{variable}} placeholders that are filled in when a session is launched. 
+     * Create a session template
+     */
+    async createTemplateRaw(requestParameters: CreateTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplateResponse>> {
+        if (requestParameters['sessionTemplateSpec'] == null) {
+            throw new runtime.RequiredError(
+                'sessionTemplateSpec',
+                'Required parameter "sessionTemplateSpec" was null or undefined when calling createTemplate().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/templates`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: SessionTemplateSpecToJSON(requestParameters['sessionTemplateSpec']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SessionTemplateResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Create a reusable session configuration. Query, title, prompts, custom instructions and directories may contain {{variable}} placeholders that are filled in when a session is launched. 
+     * Create a session template
+     */
+    async createTemplate(requestParameters: CreateTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplateResponse> {
+        const response = await this.createTemplateRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Delete a template. Sessions launched from it are kept.
+     * Delete a session template
+     */
+    async deleteTemplateRaw(requestParameters: DeleteTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling deleteTemplate().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/templates/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete a template. Sessions launched from it are kept.
+     * Delete a session template
+     */
+    async deleteTemplate(requestParameters: DeleteTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.deleteTemplateRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Export a template as a YAML file that can be committed and shared. The proxy API key is never exported, and MCP server environment and header values are redacted. 
+     * Export a session template
+     */
+    async exportTemplateRaw(requestParameters: ExportTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<TemplateExportResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling exportTemplate().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/templates/{id}/export`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => TemplateExportResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Export a template as a YAML file that can be committed and shared. The proxy API key is never exported, and MCP server environment and header values are redacted. 
+     * Export a session template
+     */
+    async exportTemplate(requestParameters: ExportTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<TemplateExportResponse> {
+        const response = await this.exportTemplateRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Get a session template
+     */
+    async getTemplateRaw(requestParameters: GetTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplateResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling getTemplate().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/templates/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SessionTemplateResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Get a session template
+     */
+    async getTemplate(requestParameters: GetTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplateResponse> {
+        const response = await this.getTemplateRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Import a template from the contents of a YAML or JSON template file. A template with the same name is replaced only when overwrite is set. 
+     * Import a session template
+     */
+    async importTemplateRaw(requestParameters: ImportTemplateOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplateResponse>> {
+        if (requestParameters['importTemplateRequest'] == null) {
+            throw new runtime.RequiredError(
+                'importTemplateRequest',
+                'Required parameter "importTemplateRequest" was null or undefined when calling importTemplate().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/templates/import`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: ImportTemplateRequestToJSON(requestParameters['importTemplateRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SessionTemplateResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Import a template from the contents of a YAML or JSON template file. A template with the same name is replaced only when overwrite is set. 
+     * Import a session template
+     */
+    async importTemplate(requestParameters: ImportTemplateOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplateResponse> {
+        const response = await this.importTemplateRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Fill in the template's variables and launch a session. Missing required variables and unknown variables are rejected. 
+     * Launch a session from a template
+     */
+    async launchTemplateRaw(requestParameters: LaunchTemplateOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<CreateSessionResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling launchTemplate().'
+            );
+        }
+
+        if (requestParameters['launchTemplateRequest'] == null) {
+            throw new runtime.RequiredError(
+                'launchTemplateRequest',
+                'Required parameter "launchTemplateRequest" was null or undefined when calling launchTemplate().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/templates/{id}/sessions`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: LaunchTemplateRequestToJSON(requestParameters['launchTemplateRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => CreateSessionResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Fill in the template's variables and launch a session. Missing required variables and unknown variables are rejected. 
+     * Launch a session from a template
+     */
+    async launchTemplate(requestParameters: LaunchTemplateOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<CreateSessionResponse> {
+        const response = await this.launchTemplateRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * All session templates ordered by name
+     * List session templates
+     */
+    async listTemplatesRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplatesResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/templates`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SessionTemplatesResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * All session templates ordered by name
+     * List session templates
+     */
+    async listTemplates(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplatesResponse> {
+        const response = await this.listTemplatesRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Replace a template's configuration. An omitted proxy_api_key keeps the stored key. 
+     * Replace a session template
+     */
+    async updateTemplateRaw(requestParameters: UpdateTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<SessionTemplateResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling updateTemplate().'
+            );
+        }
+
+        if (requestParameters['sessionTemplateSpec'] == null) {
+            throw new runtime.RequiredError(
+                'sessionTemplateSpec',
+                'Required parameter "sessionTemplateSpec" was null or undefined when calling updateTemplate().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/templates/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'PUT',
+            headers: headerParameters,
+            query: queryParameters,
+            body: SessionTemplateSpecToJSON(requestParameters['sessionTemplateSpec']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => SessionTemplateResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Replace a template's configuration. An omitted proxy_api_key keeps the stored key. 
+     * Replace a session template
+     */
+    async updateTemplate(requestParameters: UpdateTemplateRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<SessionTemplateResponse> {
+        const response = await this.updateTemplateRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
@@ -0,0 +1,411 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+import * as runtime from '../runtime';
+import type {
+  CreateWebhookRequest,
+  ErrorResponse,
+  UpdateWebhookRequest,
+  WebhookDeliveriesResponse,
+  WebhookResponse,
+  WebhooksResponse,
+} from '../models/index';
+import {
+    CreateWebhookRequestFromJSON,
+    CreateWebhookRequestToJSON,
+    ErrorResponseFromJSON,
+    ErrorResponseToJSON,
+    UpdateWebhookRequestFromJSON,
+    UpdateWebhookRequestToJSON,
+    WebhookDeliveriesResponseFromJSON,
+    WebhookDeliveriesResponseToJSON,
+    WebhookResponseFromJSON,
+    WebhookResponseToJSON,
+    WebhooksResponseFromJSON,
+    WebhooksResponseToJSON,
+} from '../models/index';
+
+export interface CreateWebhookOperationRequest {
+    createWebhookRequest: CreateWebhookRequest;
+}
+
+export interface DeleteWebhookRequest {
+    id: string;
+}
+
+export interface GetWebhookRequest {
+    id: string;
+}
+
+export interface ListWebhookDeliveriesRequest {
+    id: string;
+    limit?: number;
+}
+
+export interface UpdateWebhookOperationRequest {
+    id: string;
+    updateWebhookRequest: UpdateWebhookRequest;
+}
+
+/**
+ * WebhooksApi - interface
+ * 
+ * @export
+ * @interface WebhooksApiInterface
+ */
+export interface WebhooksApiInterface {
+    /**
+     * Create a webhook that POSTs selected daemon events to a URL. Every request is signed in the X-HumanLayer-Signature header with "sha256=" and the hex HMAC-SHA256 of the X-HumanLayer-Timestamp header, a period and the body, keyed with the webhook's secret. A secret is generated when none is given; the response is the only time it is returned. 
+     * @summary Create a webhook
+     * @param {CreateWebhookRequest} createWebhookRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof WebhooksApiInterface
+     */
+    createWebhookRaw(requestParameters: CreateWebhookOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhookResponse>>;
+
+    /**
+     * Create a webhook that POSTs selected daemon events to a URL. Every request is signed in the X-HumanLayer-Signature header with "sha256=" and the hex HMAC-SHA256 of the X-HumanLayer-Timestamp header, a period and the body, keyed with the webhook's secret. A secret is generated when none is given; the response is the only time it is returned. 
+     * Create a webhook
+     */
+    createWebhook(requestParameters: CreateWebhookOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhookResponse>;
+
+    /**
+     * Delete a webhook and its delivery log. Pending deliveries are not sent.
+     * @summary Delete a webhook
+     * @param {string} id Webhook ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof WebhooksApiInterface
+     */
+    deleteWebhookRaw(requestParameters: DeleteWebhookRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>>;
+
+    /**
+     * Delete a webhook and its delivery log. Pending deliveries are not sent.
+     * Delete a webhook
+     */
+    deleteWebhook(requestParameters: DeleteWebhookRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void>;
+
+    /**
+     * 
+     * @summary Get webhook details
+     * @param {string} id Webhook ID
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof WebhooksApiInterface
+     */
+    getWebhookRaw(requestParameters: GetWebhookRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhookResponse>>;
+
+    /**
+     * Get webhook details
+     */
+    getWebhook(requestParameters: GetWebhookRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhookResponse>;
+
+    /**
+     * Delivery log of a webhook, newest first. Failed attempts are retried with exponential backoff until the delivery runs out of attempts. 
+     * @summary List webhook deliveries
+     * @param {string} id Webhook ID
+     * @param {number} [limit] Maximum number of deliveries to return
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof WebhooksApiInterface
+     */
+    listWebhookDeliveriesRaw(requestParameters: ListWebhookDeliveriesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhookDeliveriesResponse>>;
+
+    /**
+     * Delivery log of a webhook, newest first. Failed attempts are retried with exponential backoff until the delivery runs out of attempts. 
+     * List webhook deliveries
+     */
+    listWebhookDeliveries(requestParameters: ListWebhookDeliveriesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhookDeliveriesResponse>;
+
+    /**
+     * List all outbound webhooks. Secrets are never returned.
+     * @summary List webhooks
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof WebhooksApiInterface
+     */
+    listWebhooksRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhooksResponse>>;
+
+    /**
+     * List all outbound webhooks. Secrets are never returned.
+     * List webhooks
+     */
+    listWebhooks(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhooksResponse>;
+
+    /**
+     * Update a webhook. Only specified fields will be updated.
+     * @summary Update a webhook
+     * @param {string} id Webhook ID
+     * @param {UpdateWebhookRequest} updateWebhookRequest 
+     * @param {*} [options] Override http request option.
+     * @throws {RequiredError}
+     * @memberof WebhooksApiInterface
+     */
+    updateWebhookRaw(requestParameters: UpdateWebhookOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhookResponse>>;
+
+    /**
+     * Update a webhook. Only specified fields will be updated.
+     * Update a webhook
+     */
+    updateWebhook(requestParameters: UpdateWebhookOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhookResponse>;
+
+}
+
+/**
+ * 
+ */
+export class WebhooksApi extends runtime.BaseAPI implements WebhooksApiInterface {
+
+    /**
+     * Create a webhook that POSTs selected daemon events to a URL. Every request is signed in the X-HumanLayer-Signature header with "sha256=" and the hex HMAC-SHA256 of the X-HumanLayer-Timestamp header, a period and the body, keyed with the webhook's secret. A secret is generated when none is given; the response is the only time it is returned. 
+     * Create a webhook
+     */
+    async createWebhookRaw(requestParameters: CreateWebhookOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhookResponse>> {
+        if (requestParameters['createWebhookRequest'] == null) {
+            throw new runtime.RequiredError(
+                'createWebhookRequest',
+                'Required parameter "createWebhookRequest" was null or undefined when calling createWebhook().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/webhooks`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: CreateWebhookRequestToJSON(requestParameters['createWebhookRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => WebhookResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Create a webhook that POSTs selected daemon events to a URL. Every request is signed in the X-HumanLayer-Signature header with "sha256=" and the hex HMAC-SHA256 of the X-HumanLayer-Timestamp header, a period and the body, keyed with the webhook's secret. A secret is generated when none is given; the response is the only time it is returned. 
+     * Create a webhook
+     */
+    async createWebhook(requestParameters: CreateWebhookOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhookResponse> {
+        const response = await this.createWebhookRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Delete a webhook and its delivery log. Pending deliveries are not sent.
+     * Delete a webhook
+     */
+    async deleteWebhookRaw(requestParameters: DeleteWebhookRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling deleteWebhook().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/webhooks/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete a webhook and its delivery log. Pending deliveries are not sent.
+     * Delete a webhook
+     */
+    async deleteWebhook(requestParameters: DeleteWebhookRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.deleteWebhookRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Get webhook details
+     */
+    async getWebhookRaw(requestParameters: GetWebhookRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhookResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling getWebhook().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/webhooks/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => WebhookResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Get webhook details
+     */
+    async getWebhook(requestParameters: GetWebhookRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhookResponse> {
+        const response = await this.getWebhookRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Delivery log of a webhook, newest first. Failed attempts are retried with exponential backoff until the delivery runs out of attempts. 
+     * List webhook deliveries
+     */
+    async listWebhookDeliveriesRaw(requestParameters: ListWebhookDeliveriesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhookDeliveriesResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling listWebhookDeliveries().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['limit'] != null) {
+            queryParameters['limit'] = requestParameters['limit'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/webhooks/{id}/deliveries`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => WebhookDeliveriesResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Delivery log of a webhook, newest first. Failed attempts are retried with exponential backoff until the delivery runs out of attempts. 
+     * List webhook deliveries
+     */
+    async listWebhookDeliveries(requestParameters: ListWebhookDeliveriesRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhookDeliveriesResponse> {
+        const response = await this.listWebhookDeliveriesRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List all outbound webhooks. Secrets are never returned.
+     * List webhooks
+     */
+    async listWebhooksRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhooksResponse>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+
+        let urlPath = `/webhooks`;
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => WebhooksResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * List all outbound webhooks. Secrets are never returned.
+     * List webhooks
+     */
+    async listWebhooks(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhooksResponse> {
+        const response = await this.listWebhooksRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Update a webhook. Only specified fields will be updated.
+     * Update a webhook
+     */
+    async updateWebhookRaw(requestParameters: UpdateWebhookOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WebhookResponse>> {
+        if (requestParameters['id'] == null) {
+            throw new runtime.RequiredError(
+                'id',
+                'Required parameter "id" was null or undefined when calling updateWebhook().'
+            );
+        }
+
+        if (requestParameters['updateWebhookRequest'] == null) {
+            throw new runtime.RequiredError(
+                'updateWebhookRequest',
+                'Required parameter "updateWebhookRequest" was null or undefined when calling updateWebhook().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+
+        let urlPath = `/webhooks/{id}`;
+        urlPath = urlPath.replace(`{${"id"}}`, encodeURIComponent(String(requestParameters['id'])));
+
+        const response = await this.request({
+            path: urlPath,
+            method: 'PATCH',
+            headers: headerParameters,
+            query: queryParameters,
+            body: UpdateWebhookRequestToJSON(requestParameters['updateWebhookRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => WebhookResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Update a webhook. Only specified fields will be updated.
+     * Update a webhook
+     */
+    async updateWebhook(requestParameters: UpdateWebhookOperationRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WebhookResponse> {
+        const response = await this.updateWebhookRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+}
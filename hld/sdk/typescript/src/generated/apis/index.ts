@@ -2,9 +2,18 @@
 /* eslint-disable */
 export * from './AgentsApi';
 export * from './ApprovalsApi';
+export * from './BackupsApi';
+export * from './BatchesApi';
 export * from './FilesApi';
+export * from './LabelsApi';
+export * from './MaintenanceApi';
+export * from './PipelinesApi';
 export * from './ProxyManualApi';
+export * from './SchedulesApi';
+export * from './SearchApi';
 export * from './SessionsApi';
 export * from './SettingsApi';
 export * from './SseManualApi';
 export * from './SystemApi';
+export * from './TemplatesApi';
+export * from './WebhooksApi';
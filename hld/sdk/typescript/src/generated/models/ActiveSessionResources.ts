@@ -0,0 +1,108 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SessionResources } from './SessionResources';
+import {
+    SessionResourcesFromJSON,
+    SessionResourcesFromJSONTyped,
+    SessionResourcesToJSON,
+    SessionResourcesToJSONTyped,
+} from './SessionResources';
+import type { SessionStatus } from './SessionStatus';
+import {
+    SessionStatusFromJSON,
+    SessionStatusFromJSONTyped,
+    SessionStatusToJSON,
+    SessionStatusToJSONTyped,
+} from './SessionStatus';
+
+/**
+ * 
+ * @export
+ * @interface ActiveSessionResources
+ */
+export interface ActiveSessionResources {
+    /**
+     * 
+     * @type {string}
+     * @memberof ActiveSessionResources
+     */
+    sessionId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ActiveSessionResources
+     */
+    title?: string;
+    /**
+     * 
+     * @type {SessionStatus}
+     * @memberof ActiveSessionResources
+     */
+    status: SessionStatus;
+    /**
+     * 
+     * @type {SessionResources}
+     * @memberof ActiveSessionResources
+     */
+    resources?: SessionResources;
+}
+
+
+
+/**
+ * Check if a given object implements the ActiveSessionResources interface.
+ */
+export function instanceOfActiveSessionResources(value: object): value is ActiveSessionResources {
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    return true;
+}
+
+export function ActiveSessionResourcesFromJSON(json: any): ActiveSessionResources {
+    return ActiveSessionResourcesFromJSONTyped(json, false);
+}
+
+export function ActiveSessionResourcesFromJSONTyped(json: any, ignoreDiscriminator: boolean): ActiveSessionResources {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'sessionId': json['session_id'],
+        'title': json['title'] == null ? undefined : json['title'],
+        'status': SessionStatusFromJSON(json['status']),
+        'resources': json['resources'] == null ? undefined : SessionResourcesFromJSON(json['resources']),
+    };
+}
+
+export function ActiveSessionResourcesToJSON(json: any): ActiveSessionResources {
+    return ActiveSessionResourcesToJSONTyped(json, false);
+}
+
+export function ActiveSessionResourcesToJSONTyped(value?: ActiveSessionResources | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'session_id': value['sessionId'],
+        'title': value['title'],
+        'status': SessionStatusToJSON(value['status']),
+        'resources': SessionResourcesToJSON(value['resources']),
+    };
+}
+
@@ -0,0 +1,101 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Data of an approval_resolved event
+ * @export
+ * @interface ApprovalResolvedPayload
+ */
+export interface ApprovalResolvedPayload {
+    /**
+     * 
+     * @type {string}
+     * @memberof ApprovalResolvedPayload
+     */
+    approvalId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ApprovalResolvedPayload
+     */
+    sessionId: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof ApprovalResolvedPayload
+     */
+    approved: boolean;
+    /**
+     * Comment given with the decision
+     * @type {string}
+     * @memberof ApprovalResolvedPayload
+     */
+    responseText: string;
+    /**
+     * Tool use the approval was requested for through MCP
+     * @type {string}
+     * @memberof ApprovalResolvedPayload
+     */
+    toolUseId?: string;
+}
+
+/**
+ * Check if a given object implements the ApprovalResolvedPayload interface.
+ */
+export function instanceOfApprovalResolvedPayload(value: object): value is ApprovalResolvedPayload {
+    if (!('approvalId' in value) || value['approvalId'] === undefined) return false;
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    if (!('approved' in value) || value['approved'] === undefined) return false;
+    if (!('responseText' in value) || value['responseText'] === undefined) return false;
+    return true;
+}
+
+export function ApprovalResolvedPayloadFromJSON(json: any): ApprovalResolvedPayload {
+    return ApprovalResolvedPayloadFromJSONTyped(json, false);
+}
+
+export function ApprovalResolvedPayloadFromJSONTyped(json: any, ignoreDiscriminator: boolean): ApprovalResolvedPayload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'approvalId': json['approval_id'],
+        'sessionId': json['session_id'],
+        'approved': json['approved'],
+        'responseText': json['response_text'],
+        'toolUseId': json['tool_use_id'] == null ? undefined : json['tool_use_id'],
+    };
+}
+
+export function ApprovalResolvedPayloadToJSON(json: any): ApprovalResolvedPayload {
+    return ApprovalResolvedPayloadToJSONTyped(json, false);
+}
+
+export function ApprovalResolvedPayloadToJSONTyped(value?: ApprovalResolvedPayload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'approval_id': value['approvalId'],
+        'session_id': value['sessionId'],
+        'approved': value['approved'],
+        'response_text': value['responseText'],
+        'tool_use_id': value['toolUseId'],
+    };
+}
+
@@ -0,0 +1,56 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * How sessions without a title get one after the first assistant reply.
+ * off leaves them untitled, heuristic extracts a title from the query and
+ * model asks a cheap model, falling back to the heuristic on failure.
+ * @export
+ */
+export const AutoTitleMode = {
+    Off: 'off',
+    Heuristic: 'heuristic',
+    Model: 'model'
+} as const;
+export type AutoTitleMode = typeof AutoTitleMode[keyof typeof AutoTitleMode];
+
+
+export function instanceOfAutoTitleMode(value: any): boolean {
+    for (const key in AutoTitleMode) {
+        if (Object.prototype.hasOwnProperty.call(AutoTitleMode, key)) {
+            if (AutoTitleMode[key as keyof typeof AutoTitleMode] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function AutoTitleModeFromJSON(json: any): AutoTitleMode {
+    return AutoTitleModeFromJSONTyped(json, false);
+}
+
+export function AutoTitleModeFromJSONTyped(json: any, ignoreDiscriminator: boolean): AutoTitleMode {
+    return json as AutoTitleMode;
+}
+
+export function AutoTitleModeToJSON(value?: AutoTitleMode | null): any {
+    return value as any;
+}
+
+export function AutoTitleModeToJSONTyped(value: any, ignoreDiscriminator: boolean): AutoTitleMode {
+    return value as AutoTitleMode;
+}
+
@@ -0,0 +1,110 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface Backup
+ */
+export interface Backup {
+    /**
+     * 
+     * @type {string}
+     * @memberof Backup
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Backup
+     */
+    path: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof Backup
+     */
+    sizeBytes: number;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Backup
+     */
+    createdAt: Date;
+    /**
+     * Why the backup was taken when not on request or schedule
+     * @type {string}
+     * @memberof Backup
+     */
+    reason?: string;
+    /**
+     * Schema version of the backed up database
+     * @type {number}
+     * @memberof Backup
+     */
+    schemaVersion: number;
+}
+
+/**
+ * Check if a given object implements the Backup interface.
+ */
+export function instanceOfBackup(value: object): value is Backup {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('path' in value) || value['path'] === undefined) return false;
+    if (!('sizeBytes' in value) || value['sizeBytes'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    if (!('schemaVersion' in value) || value['schemaVersion'] === undefined) return false;
+    return true;
+}
+
+export function BackupFromJSON(json: any): Backup {
+    return BackupFromJSONTyped(json, false);
+}
+
+export function BackupFromJSONTyped(json: any, ignoreDiscriminator: boolean): Backup {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'],
+        'path': json['path'],
+        'sizeBytes': json['size_bytes'],
+        'createdAt': (new Date(json['created_at'])),
+        'reason': json['reason'] == null ? undefined : json['reason'],
+        'schemaVersion': json['schema_version'],
+    };
+}
+
+export function BackupToJSON(json: any): Backup {
+    return BackupToJSONTyped(json, false);
+}
+
+export function BackupToJSONTyped(value?: Backup | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'path': value['path'],
+        'size_bytes': value['sizeBytes'],
+        'created_at': ((value['createdAt']).toISOString()),
+        'reason': value['reason'],
+        'schema_version': value['schemaVersion'],
+    };
+}
+
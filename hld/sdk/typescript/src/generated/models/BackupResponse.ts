@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Backup } from './Backup';
+import {
+    BackupFromJSON,
+    BackupFromJSONTyped,
+    BackupToJSON,
+    BackupToJSONTyped,
+} from './Backup';
+
+/**
+ * 
+ * @export
+ * @interface BackupResponse
+ */
+export interface BackupResponse {
+    /**
+     * 
+     * @type {Backup}
+     * @memberof BackupResponse
+     */
+    data: Backup;
+}
+
+/**
+ * Check if a given object implements the BackupResponse interface.
+ */
+export function instanceOfBackupResponse(value: object): value is BackupResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function BackupResponseFromJSON(json: any): BackupResponse {
+    return BackupResponseFromJSONTyped(json, false);
+}
+
+export function BackupResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): BackupResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': BackupFromJSON(json['data']),
+    };
+}
+
+export function BackupResponseToJSON(json: any): BackupResponse {
+    return BackupResponseToJSONTyped(json, false);
+}
+
+export function BackupResponseToJSONTyped(value?: BackupResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': BackupToJSON(value['data']),
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Backup } from './Backup';
+import {
+    BackupFromJSON,
+    BackupFromJSONTyped,
+    BackupToJSON,
+    BackupToJSONTyped,
+} from './Backup';
+
+/**
+ * 
+ * @export
+ * @interface BackupsResponse
+ */
+export interface BackupsResponse {
+    /**
+     * 
+     * @type {Array<Backup>}
+     * @memberof BackupsResponse
+     */
+    data: Array<Backup>;
+}
+
+/**
+ * Check if a given object implements the BackupsResponse interface.
+ */
+export function instanceOfBackupsResponse(value: object): value is BackupsResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function BackupsResponseFromJSON(json: any): BackupsResponse {
+    return BackupsResponseFromJSONTyped(json, false);
+}
+
+export function BackupsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): BackupsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(BackupFromJSON)),
+    };
+}
+
+export function BackupsResponseToJSON(json: any): BackupsResponse {
+    return BackupsResponseToJSONTyped(json, false);
+}
+
+export function BackupsResponseToJSONTyped(value?: BackupsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(BackupToJSON)),
+    };
+}
+
@@ -0,0 +1,90 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchGroup } from './BatchGroup';
+import {
+    BatchGroupFromJSON,
+    BatchGroupFromJSONTyped,
+    BatchGroupToJSON,
+    BatchGroupToJSONTyped,
+} from './BatchGroup';
+
+/**
+ * 
+ * @export
+ * @interface BatchComparison
+ */
+export interface BatchComparison {
+    /**
+     * 
+     * @type {BatchGroup}
+     * @memberof BatchComparison
+     */
+    batch: BatchGroup;
+    /**
+     * Cheapest completed member
+     * @type {string}
+     * @memberof BatchComparison
+     */
+    cheapestSessionId?: string;
+    /**
+     * Fastest completed member
+     * @type {string}
+     * @memberof BatchComparison
+     */
+    fastestSessionId?: string;
+}
+
+/**
+ * Check if a given object implements the BatchComparison interface.
+ */
+export function instanceOfBatchComparison(value: object): value is BatchComparison {
+    if (!('batch' in value) || value['batch'] === undefined) return false;
+    return true;
+}
+
+export function BatchComparisonFromJSON(json: any): BatchComparison {
+    return BatchComparisonFromJSONTyped(json, false);
+}
+
+export function BatchComparisonFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchComparison {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'batch': BatchGroupFromJSON(json['batch']),
+        'cheapestSessionId': json['cheapest_session_id'] == null ? undefined : json['cheapest_session_id'],
+        'fastestSessionId': json['fastest_session_id'] == null ? undefined : json['fastest_session_id'],
+    };
+}
+
+export function BatchComparisonToJSON(json: any): BatchComparison {
+    return BatchComparisonToJSONTyped(json, false);
+}
+
+export function BatchComparisonToJSONTyped(value?: BatchComparison | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'batch': BatchGroupToJSON(value['batch']),
+        'cheapest_session_id': value['cheapestSessionId'],
+        'fastest_session_id': value['fastestSessionId'],
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchComparison } from './BatchComparison';
+import {
+    BatchComparisonFromJSON,
+    BatchComparisonFromJSONTyped,
+    BatchComparisonToJSON,
+    BatchComparisonToJSONTyped,
+} from './BatchComparison';
+
+/**
+ * 
+ * @export
+ * @interface BatchComparisonResponse
+ */
+export interface BatchComparisonResponse {
+    /**
+     * 
+     * @type {BatchComparison}
+     * @memberof BatchComparisonResponse
+     */
+    data: BatchComparison;
+}
+
+/**
+ * Check if a given object implements the BatchComparisonResponse interface.
+ */
+export function instanceOfBatchComparisonResponse(value: object): value is BatchComparisonResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function BatchComparisonResponseFromJSON(json: any): BatchComparisonResponse {
+    return BatchComparisonResponseFromJSONTyped(json, false);
+}
+
+export function BatchComparisonResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchComparisonResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': BatchComparisonFromJSON(json['data']),
+    };
+}
+
+export function BatchComparisonResponseToJSON(json: any): BatchComparisonResponse {
+    return BatchComparisonResponseToJSONTyped(json, false);
+}
+
+export function BatchComparisonResponseToJSONTyped(value?: BatchComparisonResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': BatchComparisonToJSON(value['data']),
+    };
+}
+
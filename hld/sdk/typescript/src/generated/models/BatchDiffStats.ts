@@ -0,0 +1,84 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface BatchDiffStats
+ */
+export interface BatchDiffStats {
+    /**
+     * 
+     * @type {number}
+     * @memberof BatchDiffStats
+     */
+    filesChanged: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof BatchDiffStats
+     */
+    linesAdded: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof BatchDiffStats
+     */
+    linesRemoved: number;
+}
+
+/**
+ * Check if a given object implements the BatchDiffStats interface.
+ */
+export function instanceOfBatchDiffStats(value: object): value is BatchDiffStats {
+    if (!('filesChanged' in value) || value['filesChanged'] === undefined) return false;
+    if (!('linesAdded' in value) || value['linesAdded'] === undefined) return false;
+    if (!('linesRemoved' in value) || value['linesRemoved'] === undefined) return false;
+    return true;
+}
+
+export function BatchDiffStatsFromJSON(json: any): BatchDiffStats {
+    return BatchDiffStatsFromJSONTyped(json, false);
+}
+
+export function BatchDiffStatsFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchDiffStats {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'filesChanged': json['files_changed'],
+        'linesAdded': json['lines_added'],
+        'linesRemoved': json['lines_removed'],
+    };
+}
+
+export function BatchDiffStatsToJSON(json: any): BatchDiffStats {
+    return BatchDiffStatsToJSONTyped(json, false);
+}
+
+export function BatchDiffStatsToJSONTyped(value?: BatchDiffStats | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'files_changed': value['filesChanged'],
+        'lines_added': value['linesAdded'],
+        'lines_removed': value['linesRemoved'],
+    };
+}
+
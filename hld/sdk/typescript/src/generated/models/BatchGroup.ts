@@ -0,0 +1,162 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchMatrix } from './BatchMatrix';
+import {
+    BatchMatrixFromJSON,
+    BatchMatrixFromJSONTyped,
+    BatchMatrixToJSON,
+    BatchMatrixToJSONTyped,
+} from './BatchMatrix';
+import type { BatchMember } from './BatchMember';
+import {
+    BatchMemberFromJSON,
+    BatchMemberFromJSONTyped,
+    BatchMemberToJSON,
+    BatchMemberToJSONTyped,
+} from './BatchMember';
+import type { BatchStatus } from './BatchStatus';
+import {
+    BatchStatusFromJSON,
+    BatchStatusFromJSONTyped,
+    BatchStatusToJSON,
+    BatchStatusToJSONTyped,
+} from './BatchStatus';
+
+/**
+ * 
+ * @export
+ * @interface BatchGroup
+ */
+export interface BatchGroup {
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchGroup
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchGroup
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchGroup
+     */
+    query: string;
+    /**
+     * 
+     * @type {BatchMatrix}
+     * @memberof BatchGroup
+     */
+    matrix: BatchMatrix;
+    /**
+     * 
+     * @type {BatchStatus}
+     * @memberof BatchGroup
+     */
+    status: BatchStatus;
+    /**
+     * 
+     * @type {number}
+     * @memberof BatchGroup
+     */
+    totalCostUsd: number;
+    /**
+     * Wall clock time from the first launch to the last completion
+     * @type {number}
+     * @memberof BatchGroup
+     */
+    durationMs: number;
+    /**
+     * 
+     * @type {Array<BatchMember>}
+     * @memberof BatchGroup
+     */
+    members: Array<BatchMember>;
+    /**
+     * 
+     * @type {Date}
+     * @memberof BatchGroup
+     */
+    createdAt: Date;
+}
+
+
+
+/**
+ * Check if a given object implements the BatchGroup interface.
+ */
+export function instanceOfBatchGroup(value: object): value is BatchGroup {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('query' in value) || value['query'] === undefined) return false;
+    if (!('matrix' in value) || value['matrix'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    if (!('totalCostUsd' in value) || value['totalCostUsd'] === undefined) return false;
+    if (!('durationMs' in value) || value['durationMs'] === undefined) return false;
+    if (!('members' in value) || value['members'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    return true;
+}
+
+export function BatchGroupFromJSON(json: any): BatchGroup {
+    return BatchGroupFromJSONTyped(json, false);
+}
+
+export function BatchGroupFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchGroup {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'name': json['name'],
+        'query': json['query'],
+        'matrix': BatchMatrixFromJSON(json['matrix']),
+        'status': BatchStatusFromJSON(json['status']),
+        'totalCostUsd': json['total_cost_usd'],
+        'durationMs': json['duration_ms'],
+        'members': ((json['members'] as Array<any>).map(BatchMemberFromJSON)),
+        'createdAt': (new Date(json['created_at'])),
+    };
+}
+
+export function BatchGroupToJSON(json: any): BatchGroup {
+    return BatchGroupToJSONTyped(json, false);
+}
+
+export function BatchGroupToJSONTyped(value?: BatchGroup | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'query': value['query'],
+        'matrix': BatchMatrixToJSON(value['matrix']),
+        'status': BatchStatusToJSON(value['status']),
+        'total_cost_usd': value['totalCostUsd'],
+        'duration_ms': value['durationMs'],
+        'members': ((value['members'] as Array<any>).map(BatchMemberToJSON)),
+        'created_at': ((value['createdAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchGroup } from './BatchGroup';
+import {
+    BatchGroupFromJSON,
+    BatchGroupFromJSONTyped,
+    BatchGroupToJSON,
+    BatchGroupToJSONTyped,
+} from './BatchGroup';
+
+/**
+ * 
+ * @export
+ * @interface BatchGroupResponse
+ */
+export interface BatchGroupResponse {
+    /**
+     * 
+     * @type {BatchGroup}
+     * @memberof BatchGroupResponse
+     */
+    data: BatchGroup;
+}
+
+/**
+ * Check if a given object implements the BatchGroupResponse interface.
+ */
+export function instanceOfBatchGroupResponse(value: object): value is BatchGroupResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function BatchGroupResponseFromJSON(json: any): BatchGroupResponse {
+    return BatchGroupResponseFromJSONTyped(json, false);
+}
+
+export function BatchGroupResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchGroupResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': BatchGroupFromJSON(json['data']),
+    };
+}
+
+export function BatchGroupResponseToJSON(json: any): BatchGroupResponse {
+    return BatchGroupResponseToJSONTyped(json, false);
+}
+
+export function BatchGroupResponseToJSONTyped(value?: BatchGroupResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': BatchGroupToJSON(value['data']),
+    };
+}
+
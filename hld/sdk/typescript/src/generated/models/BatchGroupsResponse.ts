@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchGroup } from './BatchGroup';
+import {
+    BatchGroupFromJSON,
+    BatchGroupFromJSONTyped,
+    BatchGroupToJSON,
+    BatchGroupToJSONTyped,
+} from './BatchGroup';
+
+/**
+ * 
+ * @export
+ * @interface BatchGroupsResponse
+ */
+export interface BatchGroupsResponse {
+    /**
+     * 
+     * @type {Array<BatchGroup>}
+     * @memberof BatchGroupsResponse
+     */
+    data: Array<BatchGroup>;
+}
+
+/**
+ * Check if a given object implements the BatchGroupsResponse interface.
+ */
+export function instanceOfBatchGroupsResponse(value: object): value is BatchGroupsResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function BatchGroupsResponseFromJSON(json: any): BatchGroupsResponse {
+    return BatchGroupsResponseFromJSONTyped(json, false);
+}
+
+export function BatchGroupsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchGroupsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(BatchGroupFromJSON)),
+    };
+}
+
+export function BatchGroupsResponseToJSON(json: any): BatchGroupsResponse {
+    return BatchGroupsResponseToJSONTyped(json, false);
+}
+
+export function BatchGroupsResponseToJSONTyped(value?: BatchGroupsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(BatchGroupToJSON)),
+    };
+}
+
@@ -0,0 +1,89 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchProvider } from './BatchProvider';
+import {
+    BatchProviderFromJSON,
+    BatchProviderFromJSONTyped,
+    BatchProviderToJSON,
+    BatchProviderToJSONTyped,
+} from './BatchProvider';
+
+/**
+ * 
+ * @export
+ * @interface BatchMatrix
+ */
+export interface BatchMatrix {
+    /**
+     * Claude models to run directly (opus, sonnet or haiku)
+     * @type {Array<string>}
+     * @memberof BatchMatrix
+     */
+    models?: Array<string>;
+    /**
+     * 
+     * @type {Array<BatchProvider>}
+     * @memberof BatchMatrix
+     */
+    providers?: Array<BatchProvider>;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof BatchMatrix
+     */
+    workingDirs?: Array<string>;
+}
+
+/**
+ * Check if a given object implements the BatchMatrix interface.
+ */
+export function instanceOfBatchMatrix(value: object): value is BatchMatrix {
+    return true;
+}
+
+export function BatchMatrixFromJSON(json: any): BatchMatrix {
+    return BatchMatrixFromJSONTyped(json, false);
+}
+
+export function BatchMatrixFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchMatrix {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'models': json['models'] == null ? undefined : json['models'],
+        'providers': json['providers'] == null ? undefined : ((json['providers'] as Array<any>).map(BatchProviderFromJSON)),
+        'workingDirs': json['working_dirs'] == null ? undefined : json['working_dirs'],
+    };
+}
+
+export function BatchMatrixToJSON(json: any): BatchMatrix {
+    return BatchMatrixToJSONTyped(json, false);
+}
+
+export function BatchMatrixToJSONTyped(value?: BatchMatrix | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'models': value['models'],
+        'providers': value['providers'] == null ? undefined : ((value['providers'] as Array<any>).map(BatchProviderToJSON)),
+        'working_dirs': value['workingDirs'],
+    };
+}
+
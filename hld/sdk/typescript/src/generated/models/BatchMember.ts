@@ -0,0 +1,172 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchDiffStats } from './BatchDiffStats';
+import {
+    BatchDiffStatsFromJSON,
+    BatchDiffStatsFromJSONTyped,
+    BatchDiffStatsToJSON,
+    BatchDiffStatsToJSONTyped,
+} from './BatchDiffStats';
+
+/**
+ * 
+ * @export
+ * @interface BatchMember
+ */
+export interface BatchMember {
+    /**
+     * 
+     * @type {number}
+     * @memberof BatchMember
+     */
+    index: number;
+    /**
+     * Session launched for this cell (absent when the launch failed)
+     * @type {string}
+     * @memberof BatchMember
+     */
+    sessionId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchMember
+     */
+    label: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchMember
+     */
+    model?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchMember
+     */
+    provider?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchMember
+     */
+    workingDir?: string;
+    /**
+     * Session status, launch_failed, or missing once the session was deleted
+     * @type {string}
+     * @memberof BatchMember
+     */
+    status: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof BatchMember
+     */
+    costUsd?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof BatchMember
+     */
+    durationMs?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof BatchMember
+     */
+    numTurns?: number;
+    /**
+     * Absent from batch lists, which do not read members' conversations
+     * @type {BatchDiffStats}
+     * @memberof BatchMember
+     */
+    diffStats?: BatchDiffStats;
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchMember
+     */
+    error?: string;
+    /**
+     * Final result text (comparison only)
+     * @type {string}
+     * @memberof BatchMember
+     */
+    result?: string;
+}
+
+/**
+ * Check if a given object implements the BatchMember interface.
+ */
+export function instanceOfBatchMember(value: object): value is BatchMember {
+    if (!('index' in value) || value['index'] === undefined) return false;
+    if (!('label' in value) || value['label'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    return true;
+}
+
+export function BatchMemberFromJSON(json: any): BatchMember {
+    return BatchMemberFromJSONTyped(json, false);
+}
+
+export function BatchMemberFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchMember {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'index': json['index'],
+        'sessionId': json['session_id'] == null ? undefined : json['session_id'],
+        'label': json['label'],
+        'model': json['model'] == null ? undefined : json['model'],
+        'provider': json['provider'] == null ? undefined : json['provider'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'status': json['status'],
+        'costUsd': json['cost_usd'] == null ? undefined : json['cost_usd'],
+        'durationMs': json['duration_ms'] == null ? undefined : json['duration_ms'],
+        'numTurns': json['num_turns'] == null ? undefined : json['num_turns'],
+        'diffStats': json['diff_stats'] == null ? undefined : BatchDiffStatsFromJSON(json['diff_stats']),
+        'error': json['error'] == null ? undefined : json['error'],
+        'result': json['result'] == null ? undefined : json['result'],
+    };
+}
+
+export function BatchMemberToJSON(json: any): BatchMember {
+    return BatchMemberToJSONTyped(json, false);
+}
+
+export function BatchMemberToJSONTyped(value?: BatchMember | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'index': value['index'],
+        'session_id': value['sessionId'],
+        'label': value['label'],
+        'model': value['model'],
+        'provider': value['provider'],
+        'working_dir': value['workingDir'],
+        'status': value['status'],
+        'cost_usd': value['costUsd'],
+        'duration_ms': value['durationMs'],
+        'num_turns': value['numTurns'],
+        'diff_stats': BatchDiffStatsToJSON(value['diffStats']),
+        'error': value['error'],
+        'result': value['result'],
+    };
+}
+
@@ -0,0 +1,91 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface BatchProvider
+ */
+export interface BatchProvider {
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchProvider
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchProvider
+     */
+    baseUrl: string;
+    /**
+     * API key for the provider. Never returned by the API.
+     * @type {string}
+     * @memberof BatchProvider
+     */
+    apiKey?: string;
+    /**
+     * Models to run through this provider; the provider default is used when empty
+     * @type {Array<string>}
+     * @memberof BatchProvider
+     */
+    models?: Array<string>;
+}
+
+/**
+ * Check if a given object implements the BatchProvider interface.
+ */
+export function instanceOfBatchProvider(value: object): value is BatchProvider {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('baseUrl' in value) || value['baseUrl'] === undefined) return false;
+    return true;
+}
+
+export function BatchProviderFromJSON(json: any): BatchProvider {
+    return BatchProviderFromJSONTyped(json, false);
+}
+
+export function BatchProviderFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchProvider {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'],
+        'baseUrl': json['base_url'],
+        'apiKey': json['api_key'] == null ? undefined : json['api_key'],
+        'models': json['models'] == null ? undefined : json['models'],
+    };
+}
+
+export function BatchProviderToJSON(json: any): BatchProvider {
+    return BatchProviderToJSONTyped(json, false);
+}
+
+export function BatchProviderToJSONTyped(value?: BatchProvider | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'base_url': value['baseUrl'],
+        'api_key': value['apiKey'],
+        'models': value['models'],
+    };
+}
+
@@ -0,0 +1,55 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * Aggregate status; partial means every member finished but not all succeeded
+ * @export
+ */
+export const BatchStatus = {
+    Running: 'running',
+    Completed: 'completed',
+    Failed: 'failed',
+    Partial: 'partial'
+} as const;
+export type BatchStatus = typeof BatchStatus[keyof typeof BatchStatus];
+
+
+export function instanceOfBatchStatus(value: any): boolean {
+    for (const key in BatchStatus) {
+        if (Object.prototype.hasOwnProperty.call(BatchStatus, key)) {
+            if (BatchStatus[key as keyof typeof BatchStatus] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function BatchStatusFromJSON(json: any): BatchStatus {
+    return BatchStatusFromJSONTyped(json, false);
+}
+
+export function BatchStatusFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchStatus {
+    return json as BatchStatus;
+}
+
+export function BatchStatusToJSON(value?: BatchStatus | null): any {
+    return value as any;
+}
+
+export function BatchStatusToJSONTyped(value: any, ignoreDiscriminator: boolean): BatchStatus {
+    return value as BatchStatus;
+}
+
@@ -0,0 +1,109 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Data of a budget_exceeded event
+ * @export
+ * @interface BudgetExceededPayload
+ */
+export interface BudgetExceededPayload {
+    /**
+     * 
+     * @type {string}
+     * @memberof BudgetExceededPayload
+     */
+    sessionId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof BudgetExceededPayload
+     */
+    runId: string;
+    /**
+     * Set for sessions run as a pipeline step
+     * @type {string}
+     * @memberof BudgetExceededPayload
+     */
+    pipelineRunId?: string;
+    /**
+     * Pipeline step name, set with pipeline_run_id
+     * @type {string}
+     * @memberof BudgetExceededPayload
+     */
+    step?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof BudgetExceededPayload
+     */
+    costUsd: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof BudgetExceededPayload
+     */
+    maxCostUsd: number;
+}
+
+/**
+ * Check if a given object implements the BudgetExceededPayload interface.
+ */
+export function instanceOfBudgetExceededPayload(value: object): value is BudgetExceededPayload {
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    if (!('runId' in value) || value['runId'] === undefined) return false;
+    if (!('costUsd' in value) || value['costUsd'] === undefined) return false;
+    if (!('maxCostUsd' in value) || value['maxCostUsd'] === undefined) return false;
+    return true;
+}
+
+export function BudgetExceededPayloadFromJSON(json: any): BudgetExceededPayload {
+    return BudgetExceededPayloadFromJSONTyped(json, false);
+}
+
+export function BudgetExceededPayloadFromJSONTyped(json: any, ignoreDiscriminator: boolean): BudgetExceededPayload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'sessionId': json['session_id'],
+        'runId': json['run_id'],
+        'pipelineRunId': json['pipeline_run_id'] == null ? undefined : json['pipeline_run_id'],
+        'step': json['step'] == null ? undefined : json['step'],
+        'costUsd': json['cost_usd'],
+        'maxCostUsd': json['max_cost_usd'],
+    };
+}
+
+export function BudgetExceededPayloadToJSON(json: any): BudgetExceededPayload {
+    return BudgetExceededPayloadToJSONTyped(json, false);
+}
+
+export function BudgetExceededPayloadToJSONTyped(value?: BudgetExceededPayload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'session_id': value['sessionId'],
+        'run_id': value['runId'],
+        'pipeline_run_id': value['pipelineRunId'],
+        'step': value['step'],
+        'cost_usd': value['costUsd'],
+        'max_cost_usd': value['maxCostUsd'],
+    };
+}
+
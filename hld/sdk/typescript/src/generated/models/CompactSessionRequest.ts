@@ -0,0 +1,65 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface CompactSessionRequest
+ */
+export interface CompactSessionRequest {
+    /**
+     * Optional instructions focusing what the summary keeps
+     * @type {string}
+     * @memberof CompactSessionRequest
+     */
+    instructions?: string;
+}
+
+/**
+ * Check if a given object implements the CompactSessionRequest interface.
+ */
+export function instanceOfCompactSessionRequest(value: object): value is CompactSessionRequest {
+    return true;
+}
+
+export function CompactSessionRequestFromJSON(json: any): CompactSessionRequest {
+    return CompactSessionRequestFromJSONTyped(json, false);
+}
+
+export function CompactSessionRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): CompactSessionRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'instructions': json['instructions'] == null ? undefined : json['instructions'],
+    };
+}
+
+export function CompactSessionRequestToJSON(json: any): CompactSessionRequest {
+    return CompactSessionRequestToJSONTyped(json, false);
+}
+
+export function CompactSessionRequestToJSONTyped(value?: CompactSessionRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'instructions': value['instructions'],
+    };
+}
+
@@ -121,6 +121,24 @@ export interface ConversationEvent {
      * @memberof ConversationEvent
      */
     approvalId?: string;
+    /**
+     * What started the compaction, manual or auto (for compaction events)
+     * @type {string}
+     * @memberof ConversationEvent
+     */
+    compactionTrigger?: string;
+    /**
+     * Context size before compaction (for compaction events)
+     * @type {number}
+     * @memberof ConversationEvent
+     */
+    preCompactionTokens?: number;
+    /**
+     * Context size after compaction, once known (for compaction events)
+     * @type {number}
+     * @memberof ConversationEvent
+     */
+    postCompactionTokens?: number;
 }
 
 
@@ -132,7 +150,8 @@ export const ConversationEventEventTypeEnum = {
     ToolCall: 'tool_call',
     ToolResult: 'tool_result',
     System: 'system',
-    Thinking: 'thinking'
+    Thinking: 'thinking',
+    Compaction: 'compaction'
 } as const;
 export type ConversationEventEventTypeEnum = typeof ConversationEventEventTypeEnum[keyof typeof ConversationEventEventTypeEnum];
 
@@ -197,6 +216,9 @@ export function ConversationEventFromJSONTyped(json: any, ignoreDiscriminator: b
         'isCompleted': json['is_completed'] == null ? undefined : json['is_completed'],
         'approvalStatus': json['approval_status'] == null ? undefined : json['approval_status'],
         'approvalId': json['approval_id'] == null ? undefined : json['approval_id'],
+        'compactionTrigger': json['compaction_trigger'] == null ? undefined : json['compaction_trigger'],
+        'preCompactionTokens': json['pre_compaction_tokens'] == null ? undefined : json['pre_compaction_tokens'],
+        'postCompactionTokens': json['post_compaction_tokens'] == null ? undefined : json['post_compaction_tokens'],
     };
 }
 
@@ -228,6 +250,9 @@ export function ConversationEventToJSONTyped(value?: ConversationEvent | null, i
         'is_completed': value['isCompleted'],
         'approval_status': value['approvalStatus'],
         'approval_id': value['approvalId'],
+        'compaction_trigger': value['compactionTrigger'],
+        'pre_compaction_tokens': value['preCompactionTokens'],
+        'post_compaction_tokens': value['postCompactionTokens'],
     };
 }
 
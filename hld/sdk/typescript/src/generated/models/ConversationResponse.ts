@@ -33,6 +33,19 @@ export interface ConversationResponse {
      * @memberof ConversationResponse
      */
     data: Array<ConversationEvent>;
+    /**
+     * Cursor positioned after the last returned event. Present whenever
+     * events were returned, so it can also be used to poll for new events.
+     * @type {string}
+     * @memberof ConversationResponse
+     */
+    nextCursor?: string;
+    /**
+     * Whether more events remain after this page
+     * @type {boolean}
+     * @memberof ConversationResponse
+     */
+    hasMore?: boolean;
 }
 
 /**
@@ -54,6 +67,8 @@ export function ConversationResponseFromJSONTyped(json: any, ignoreDiscriminator
     return {
         
         'data': ((json['data'] as Array<any>).map(ConversationEventFromJSON)),
+        'nextCursor': json['next_cursor'] == null ? undefined : json['next_cursor'],
+        'hasMore': json['has_more'] == null ? undefined : json['has_more'],
     };
 }
 
@@ -69,6 +84,8 @@ export function ConversationResponseToJSONTyped(value?: ConversationResponse | n
     return {
         
         'data': ((value['data'] as Array<any>).map(ConversationEventToJSON)),
+        'next_cursor': value['nextCursor'],
+        'has_more': value['hasMore'],
     };
 }
 
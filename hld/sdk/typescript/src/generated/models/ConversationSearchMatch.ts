@@ -0,0 +1,144 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { TextRange } from './TextRange';
+import {
+    TextRangeFromJSON,
+    TextRangeFromJSONTyped,
+    TextRangeToJSON,
+    TextRangeToJSONTyped,
+} from './TextRange';
+
+/**
+ * 
+ * @export
+ * @interface ConversationSearchMatch
+ */
+export interface ConversationSearchMatch {
+    /**
+     * 
+     * @type {number}
+     * @memberof ConversationSearchMatch
+     */
+    eventId: number;
+    /**
+     * Claude session the event belongs to; with sequence it locates the event in the conversation
+     * @type {string}
+     * @memberof ConversationSearchMatch
+     */
+    claudeSessionId: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof ConversationSearchMatch
+     */
+    sequence: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationSearchMatch
+     */
+    eventType: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationSearchMatch
+     */
+    role?: string;
+    /**
+     * Tool of the call, or for a tool result the tool that produced it
+     * @type {string}
+     * @memberof ConversationSearchMatch
+     */
+    toolName?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ConversationSearchMatch
+     */
+    createdAt: Date;
+    /**
+     * Excerpt of the matching text
+     * @type {string}
+     * @memberof ConversationSearchMatch
+     */
+    snippet: string;
+    /**
+     * Matched words within the snippet
+     * @type {Array<TextRange>}
+     * @memberof ConversationSearchMatch
+     */
+    highlights: Array<TextRange>;
+}
+
+/**
+ * Check if a given object implements the ConversationSearchMatch interface.
+ */
+export function instanceOfConversationSearchMatch(value: object): value is ConversationSearchMatch {
+    if (!('eventId' in value) || value['eventId'] === undefined) return false;
+    if (!('claudeSessionId' in value) || value['claudeSessionId'] === undefined) return false;
+    if (!('sequence' in value) || value['sequence'] === undefined) return false;
+    if (!('eventType' in value) || value['eventType'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    if (!('snippet' in value) || value['snippet'] === undefined) return false;
+    if (!('highlights' in value) || value['highlights'] === undefined) return false;
+    return true;
+}
+
+export function ConversationSearchMatchFromJSON(json: any): ConversationSearchMatch {
+    return ConversationSearchMatchFromJSONTyped(json, false);
+}
+
+export function ConversationSearchMatchFromJSONTyped(json: any, ignoreDiscriminator: boolean): ConversationSearchMatch {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'eventId': json['event_id'],
+        'claudeSessionId': json['claude_session_id'],
+        'sequence': json['sequence'],
+        'eventType': json['event_type'],
+        'role': json['role'] == null ? undefined : json['role'],
+        'toolName': json['tool_name'] == null ? undefined : json['tool_name'],
+        'createdAt': (new Date(json['created_at'])),
+        'snippet': json['snippet'],
+        'highlights': ((json['highlights'] as Array<any>).map(TextRangeFromJSON)),
+    };
+}
+
+export function ConversationSearchMatchToJSON(json: any): ConversationSearchMatch {
+    return ConversationSearchMatchToJSONTyped(json, false);
+}
+
+export function ConversationSearchMatchToJSONTyped(value?: ConversationSearchMatch | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'event_id': value['eventId'],
+        'claude_session_id': value['claudeSessionId'],
+        'sequence': value['sequence'],
+        'event_type': value['eventType'],
+        'role': value['role'],
+        'tool_name': value['toolName'],
+        'created_at': ((value['createdAt']).toISOString()),
+        'snippet': value['snippet'],
+        'highlights': ((value['highlights'] as Array<any>).map(TextRangeToJSON)),
+    };
+}
+
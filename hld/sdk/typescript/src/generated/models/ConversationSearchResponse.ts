@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ConversationSearchResult } from './ConversationSearchResult';
+import {
+    ConversationSearchResultFromJSON,
+    ConversationSearchResultFromJSONTyped,
+    ConversationSearchResultToJSON,
+    ConversationSearchResultToJSONTyped,
+} from './ConversationSearchResult';
+
+/**
+ * 
+ * @export
+ * @interface ConversationSearchResponse
+ */
+export interface ConversationSearchResponse {
+    /**
+     * 
+     * @type {Array<ConversationSearchResult>}
+     * @memberof ConversationSearchResponse
+     */
+    data: Array<ConversationSearchResult>;
+}
+
+/**
+ * Check if a given object implements the ConversationSearchResponse interface.
+ */
+export function instanceOfConversationSearchResponse(value: object): value is ConversationSearchResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function ConversationSearchResponseFromJSON(json: any): ConversationSearchResponse {
+    return ConversationSearchResponseFromJSONTyped(json, false);
+}
+
+export function ConversationSearchResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ConversationSearchResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(ConversationSearchResultFromJSON)),
+    };
+}
+
+export function ConversationSearchResponseToJSON(json: any): ConversationSearchResponse {
+    return ConversationSearchResponseToJSONTyped(json, false);
+}
+
+export function ConversationSearchResponseToJSONTyped(value?: ConversationSearchResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(ConversationSearchResultToJSON)),
+    };
+}
+
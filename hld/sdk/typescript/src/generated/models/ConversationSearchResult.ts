@@ -0,0 +1,90 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ConversationSearchMatch } from './ConversationSearchMatch';
+import {
+    ConversationSearchMatchFromJSON,
+    ConversationSearchMatchFromJSONTyped,
+    ConversationSearchMatchToJSON,
+    ConversationSearchMatchToJSONTyped,
+} from './ConversationSearchMatch';
+import type { Session } from './Session';
+import {
+    SessionFromJSON,
+    SessionFromJSONTyped,
+    SessionToJSON,
+    SessionToJSONTyped,
+} from './Session';
+
+/**
+ * 
+ * @export
+ * @interface ConversationSearchResult
+ */
+export interface ConversationSearchResult {
+    /**
+     * 
+     * @type {Session}
+     * @memberof ConversationSearchResult
+     */
+    session: Session;
+    /**
+     * Matching events, most recent first
+     * @type {Array<ConversationSearchMatch>}
+     * @memberof ConversationSearchResult
+     */
+    matches: Array<ConversationSearchMatch>;
+}
+
+/**
+ * Check if a given object implements the ConversationSearchResult interface.
+ */
+export function instanceOfConversationSearchResult(value: object): value is ConversationSearchResult {
+    if (!('session' in value) || value['session'] === undefined) return false;
+    if (!('matches' in value) || value['matches'] === undefined) return false;
+    return true;
+}
+
+export function ConversationSearchResultFromJSON(json: any): ConversationSearchResult {
+    return ConversationSearchResultFromJSONTyped(json, false);
+}
+
+export function ConversationSearchResultFromJSONTyped(json: any, ignoreDiscriminator: boolean): ConversationSearchResult {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'session': SessionFromJSON(json['session']),
+        'matches': ((json['matches'] as Array<any>).map(ConversationSearchMatchFromJSON)),
+    };
+}
+
+export function ConversationSearchResultToJSON(json: any): ConversationSearchResult {
+    return ConversationSearchResultToJSONTyped(json, false);
+}
+
+export function ConversationSearchResultToJSONTyped(value?: ConversationSearchResult | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'session': SessionToJSON(value['session']),
+        'matches': ((value['matches'] as Array<any>).map(ConversationSearchMatchToJSON)),
+    };
+}
+
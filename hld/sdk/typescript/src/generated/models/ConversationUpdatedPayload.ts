@@ -0,0 +1,218 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Data of a conversation_updated event. Which fields are set depends on
+ * event_type.
+ * @export
+ * @interface ConversationUpdatedPayload
+ */
+export interface ConversationUpdatedPayload {
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    sessionId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    claudeSessionId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    eventType: ConversationUpdatedPayloadEventTypeEnum;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    contentType: ConversationUpdatedPayloadContentTypeEnum;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    subtype?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    role?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    content?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    toolId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    toolName?: string;
+    /**
+     * 
+     * @type {{ [key: string]: any; }}
+     * @memberof ConversationUpdatedPayload
+     */
+    toolInput?: { [key: string]: any; };
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    toolResultForId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    toolResultContent?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    parentToolUseId?: string;
+    /**
+     * What triggered a compaction, auto or manual
+     * @type {string}
+     * @memberof ConversationUpdatedPayload
+     */
+    trigger?: string;
+    /**
+     * Context size before a compaction
+     * @type {number}
+     * @memberof ConversationUpdatedPayload
+     */
+    preTokens?: number;
+    /**
+     * Context size after a compaction
+     * @type {number}
+     * @memberof ConversationUpdatedPayload
+     */
+    postTokens?: number;
+}
+
+
+/**
+ * @export
+ */
+export const ConversationUpdatedPayloadEventTypeEnum = {
+    Message: 'message',
+    Thinking: 'thinking',
+    ToolCall: 'tool_call',
+    ToolResult: 'tool_result',
+    System: 'system',
+    Compaction: 'compaction'
+} as const;
+export type ConversationUpdatedPayloadEventTypeEnum = typeof ConversationUpdatedPayloadEventTypeEnum[keyof typeof ConversationUpdatedPayloadEventTypeEnum];
+
+/**
+ * @export
+ */
+export const ConversationUpdatedPayloadContentTypeEnum = {
+    Text: 'text',
+    Thinking: 'thinking',
+    ToolUse: 'tool_use',
+    ToolResult: 'tool_result',
+    System: 'system',
+    Compaction: 'compaction'
+} as const;
+export type ConversationUpdatedPayloadContentTypeEnum = typeof ConversationUpdatedPayloadContentTypeEnum[keyof typeof ConversationUpdatedPayloadContentTypeEnum];
+
+
+/**
+ * Check if a given object implements the ConversationUpdatedPayload interface.
+ */
+export function instanceOfConversationUpdatedPayload(value: object): value is ConversationUpdatedPayload {
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    if (!('claudeSessionId' in value) || value['claudeSessionId'] === undefined) return false;
+    if (!('eventType' in value) || value['eventType'] === undefined) return false;
+    if (!('contentType' in value) || value['contentType'] === undefined) return false;
+    return true;
+}
+
+export function ConversationUpdatedPayloadFromJSON(json: any): ConversationUpdatedPayload {
+    return ConversationUpdatedPayloadFromJSONTyped(json, false);
+}
+
+export function ConversationUpdatedPayloadFromJSONTyped(json: any, ignoreDiscriminator: boolean): ConversationUpdatedPayload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'sessionId': json['session_id'],
+        'claudeSessionId': json['claude_session_id'],
+        'eventType': json['event_type'],
+        'contentType': json['content_type'],
+        'subtype': json['subtype'] == null ? undefined : json['subtype'],
+        'role': json['role'] == null ? undefined : json['role'],
+        'content': json['content'] == null ? undefined : json['content'],
+        'toolId': json['tool_id'] == null ? undefined : json['tool_id'],
+        'toolName': json['tool_name'] == null ? undefined : json['tool_name'],
+        'toolInput': json['tool_input'] == null ? undefined : json['tool_input'],
+        'toolResultForId': json['tool_result_for_id'] == null ? undefined : json['tool_result_for_id'],
+        'toolResultContent': json['tool_result_content'] == null ? undefined : json['tool_result_content'],
+        'parentToolUseId': json['parent_tool_use_id'] == null ? undefined : json['parent_tool_use_id'],
+        'trigger': json['trigger'] == null ? undefined : json['trigger'],
+        'preTokens': json['pre_tokens'] == null ? undefined : json['pre_tokens'],
+        'postTokens': json['post_tokens'] == null ? undefined : json['post_tokens'],
+    };
+}
+
+export function ConversationUpdatedPayloadToJSON(json: any): ConversationUpdatedPayload {
+    return ConversationUpdatedPayloadToJSONTyped(json, false);
+}
+
+export function ConversationUpdatedPayloadToJSONTyped(value?: ConversationUpdatedPayload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'session_id': value['sessionId'],
+        'claude_session_id': value['claudeSessionId'],
+        'event_type': value['eventType'],
+        'content_type': value['contentType'],
+        'subtype': value['subtype'],
+        'role': value['role'],
+        'content': value['content'],
+        'tool_id': value['toolId'],
+        'tool_name': value['toolName'],
+        'tool_input': value['toolInput'],
+        'tool_result_for_id': value['toolResultForId'],
+        'tool_result_content': value['toolResultContent'],
+        'parent_tool_use_id': value['parentToolUseId'],
+        'trigger': value['trigger'],
+        'pre_tokens': value['preTokens'],
+        'post_tokens': value['postTokens'],
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface CreateLabelRequest
+ */
+export interface CreateLabelRequest {
+    /**
+     * Label name, unique regardless of case
+     * @type {string}
+     * @memberof CreateLabelRequest
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof CreateLabelRequest
+     */
+    color?: string;
+}
+
+/**
+ * Check if a given object implements the CreateLabelRequest interface.
+ */
+export function instanceOfCreateLabelRequest(value: object): value is CreateLabelRequest {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    return true;
+}
+
+export function CreateLabelRequestFromJSON(json: any): CreateLabelRequest {
+    return CreateLabelRequestFromJSONTyped(json, false);
+}
+
+export function CreateLabelRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): CreateLabelRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'],
+        'color': json['color'] == null ? undefined : json['color'],
+    };
+}
+
+export function CreateLabelRequestToJSON(json: any): CreateLabelRequest {
+    return CreateLabelRequestToJSONTyped(json, false);
+}
+
+export function CreateLabelRequestToJSONTyped(value?: CreateLabelRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'color': value['color'],
+    };
+}
+
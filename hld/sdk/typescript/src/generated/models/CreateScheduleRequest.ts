@@ -0,0 +1,148 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ScheduleOverlapPolicy } from './ScheduleOverlapPolicy';
+import {
+    ScheduleOverlapPolicyFromJSON,
+    ScheduleOverlapPolicyFromJSONTyped,
+    ScheduleOverlapPolicyToJSON,
+    ScheduleOverlapPolicyToJSONTyped,
+} from './ScheduleOverlapPolicy';
+import type { ScheduleCatchUpPolicy } from './ScheduleCatchUpPolicy';
+import {
+    ScheduleCatchUpPolicyFromJSON,
+    ScheduleCatchUpPolicyFromJSONTyped,
+    ScheduleCatchUpPolicyToJSON,
+    ScheduleCatchUpPolicyToJSONTyped,
+} from './ScheduleCatchUpPolicy';
+import type { ScheduleLaunchConfig } from './ScheduleLaunchConfig';
+import {
+    ScheduleLaunchConfigFromJSON,
+    ScheduleLaunchConfigFromJSONTyped,
+    ScheduleLaunchConfigToJSON,
+    ScheduleLaunchConfigToJSONTyped,
+} from './ScheduleLaunchConfig';
+
+/**
+ * 
+ * @export
+ * @interface CreateScheduleRequest
+ */
+export interface CreateScheduleRequest {
+    /**
+     * Human-readable schedule name
+     * @type {string}
+     * @memberof CreateScheduleRequest
+     */
+    name: string;
+    /**
+     * Standard 5-field cron expression or @daily-style macro
+     * @type {string}
+     * @memberof CreateScheduleRequest
+     */
+    cronExpression: string;
+    /**
+     * IANA timezone, defaults to UTC
+     * @type {string}
+     * @memberof CreateScheduleRequest
+     */
+    timezone?: string;
+    /**
+     * Working directory for scheduled sessions
+     * @type {string}
+     * @memberof CreateScheduleRequest
+     */
+    workingDir?: string;
+    /**
+     * 
+     * @type {ScheduleLaunchConfig}
+     * @memberof CreateScheduleRequest
+     */
+    launchConfig: ScheduleLaunchConfig;
+    /**
+     * 
+     * @type {ScheduleOverlapPolicy}
+     * @memberof CreateScheduleRequest
+     */
+    overlapPolicy?: ScheduleOverlapPolicy;
+    /**
+     * 
+     * @type {ScheduleCatchUpPolicy}
+     * @memberof CreateScheduleRequest
+     */
+    catchUpPolicy?: ScheduleCatchUpPolicy;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof CreateScheduleRequest
+     */
+    enabled?: boolean;
+}
+
+
+
+/**
+ * Check if a given object implements the CreateScheduleRequest interface.
+ */
+export function instanceOfCreateScheduleRequest(value: object): value is CreateScheduleRequest {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('cronExpression' in value) || value['cronExpression'] === undefined) return false;
+    if (!('launchConfig' in value) || value['launchConfig'] === undefined) return false;
+    return true;
+}
+
+export function CreateScheduleRequestFromJSON(json: any): CreateScheduleRequest {
+    return CreateScheduleRequestFromJSONTyped(json, false);
+}
+
+export function CreateScheduleRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): CreateScheduleRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'],
+        'cronExpression': json['cron_expression'],
+        'timezone': json['timezone'] == null ? undefined : json['timezone'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'launchConfig': ScheduleLaunchConfigFromJSON(json['launch_config']),
+        'overlapPolicy': json['overlap_policy'] == null ? undefined : ScheduleOverlapPolicyFromJSON(json['overlap_policy']),
+        'catchUpPolicy': json['catch_up_policy'] == null ? undefined : ScheduleCatchUpPolicyFromJSON(json['catch_up_policy']),
+        'enabled': json['enabled'] == null ? undefined : json['enabled'],
+    };
+}
+
+export function CreateScheduleRequestToJSON(json: any): CreateScheduleRequest {
+    return CreateScheduleRequestToJSONTyped(json, false);
+}
+
+export function CreateScheduleRequestToJSONTyped(value?: CreateScheduleRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'cron_expression': value['cronExpression'],
+        'timezone': value['timezone'],
+        'working_dir': value['workingDir'],
+        'launch_config': ScheduleLaunchConfigToJSON(value['launchConfig']),
+        'overlap_policy': ScheduleOverlapPolicyToJSON(value['overlapPolicy']),
+        'catch_up_policy': ScheduleCatchUpPolicyToJSON(value['catchUpPolicy']),
+        'enabled': value['enabled'],
+    };
+}
+
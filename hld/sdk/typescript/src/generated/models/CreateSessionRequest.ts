@@ -153,6 +153,24 @@ export interface CreateSessionRequest {
      * @memberof CreateSessionRequest
      */
     proxyApiKey?: string;
+    /**
+     * Idle time in milliseconds before the session is reported as stalled. Null uses the daemon default and 0 disables the check.
+     * @type {number}
+     * @memberof CreateSessionRequest
+     */
+    stallThresholdMs?: number;
+    /**
+     * Idle time in milliseconds before a session is interrupted, whether or not its stall is reported. Null uses the daemon default and 0 disables interrupts.
+     * @type {number}
+     * @memberof CreateSessionRequest
+     */
+    stallInterruptThresholdMs?: number;
+    /**
+     * Runner backend to launch with, as registered in the daemon configuration. Defaults to the Claude Code CLI.
+     * @type {string}
+     * @memberof CreateSessionRequest
+     */
+    runner?: string;
     /**
      * Create session in draft state without launching Claude
      * @type {boolean}
@@ -218,6 +236,9 @@ export function CreateSessionRequestFromJSONTyped(json: any, ignoreDiscriminator
         'proxyBaseUrl': json['proxy_base_url'] == null ? undefined : json['proxy_base_url'],
         'proxyModelOverride': json['proxy_model_override'] == null ? undefined : json['proxy_model_override'],
         'proxyApiKey': json['proxy_api_key'] == null ? undefined : json['proxy_api_key'],
+        'stallThresholdMs': json['stall_threshold_ms'] == null ? undefined : json['stall_threshold_ms'],
+        'stallInterruptThresholdMs': json['stall_interrupt_threshold_ms'] == null ? undefined : json['stall_interrupt_threshold_ms'],
+        'runner': json['runner'] == null ? undefined : json['runner'],
         'draft': json['draft'] == null ? undefined : json['draft'],
         'createDirectoryIfNotExists': json['createDirectoryIfNotExists'] == null ? undefined : json['createDirectoryIfNotExists'],
     };
@@ -255,6 +276,9 @@ export function CreateSessionRequestToJSONTyped(value?: CreateSessionRequest | n
         'proxy_base_url': value['proxyBaseUrl'],
         'proxy_model_override': value['proxyModelOverride'],
         'proxy_api_key': value['proxyApiKey'],
+        'stall_threshold_ms': value['stallThresholdMs'],
+        'stall_interrupt_threshold_ms': value['stallInterruptThresholdMs'],
+        'runner': value['runner'],
         'draft': value['draft'],
         'createDirectoryIfNotExists': value['createDirectoryIfNotExists'],
     };
@@ -0,0 +1,125 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { WebhookEventType } from './WebhookEventType';
+import {
+    WebhookEventTypeFromJSON,
+    WebhookEventTypeFromJSONTyped,
+    WebhookEventTypeToJSON,
+    WebhookEventTypeToJSONTyped,
+} from './WebhookEventType';
+
+/**
+ * 
+ * @export
+ * @interface CreateWebhookRequest
+ */
+export interface CreateWebhookRequest {
+    /**
+     * Human-readable webhook name
+     * @type {string}
+     * @memberof CreateWebhookRequest
+     */
+    name: string;
+    /**
+     * Absolute http or https URL events are POSTed to
+     * @type {string}
+     * @memberof CreateWebhookRequest
+     */
+    url: string;
+    /**
+     * Signing secret, generated when not given
+     * @type {string}
+     * @memberof CreateWebhookRequest
+     */
+    secret?: string;
+    /**
+     * Event types to deliver; empty means all
+     * @type {Array<WebhookEventType>}
+     * @memberof CreateWebhookRequest
+     */
+    eventTypes?: Array<WebhookEventType>;
+    /**
+     * Only deliver events of sessions with all of these labels
+     * @type {Array<string>}
+     * @memberof CreateWebhookRequest
+     */
+    labels?: Array<string>;
+    /**
+     * Only deliver events of sessions in this directory or below it
+     * @type {string}
+     * @memberof CreateWebhookRequest
+     */
+    workingDir?: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof CreateWebhookRequest
+     */
+    enabled?: boolean;
+}
+
+
+
+/**
+ * Check if a given object implements the CreateWebhookRequest interface.
+ */
+export function instanceOfCreateWebhookRequest(value: object): value is CreateWebhookRequest {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('url' in value) || value['url'] === undefined) return false;
+    return true;
+}
+
+export function CreateWebhookRequestFromJSON(json: any): CreateWebhookRequest {
+    return CreateWebhookRequestFromJSONTyped(json, false);
+}
+
+export function CreateWebhookRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): CreateWebhookRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'],
+        'url': json['url'],
+        'secret': json['secret'] == null ? undefined : json['secret'],
+        'eventTypes': json['event_types'] == null ? undefined : ((json['event_types'] as Array<any>).map(WebhookEventTypeFromJSON)),
+        'labels': json['labels'] == null ? undefined : json['labels'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'enabled': json['enabled'] == null ? undefined : json['enabled'],
+    };
+}
+
+export function CreateWebhookRequestToJSON(json: any): CreateWebhookRequest {
+    return CreateWebhookRequestToJSONTyped(json, false);
+}
+
+export function CreateWebhookRequestToJSONTyped(value?: CreateWebhookRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'url': value['url'],
+        'secret': value['secret'],
+        'event_types': value['eventTypes'] == null ? undefined : ((value['eventTypes'] as Array<any>).map(WebhookEventTypeToJSON)),
+        'labels': value['labels'],
+        'working_dir': value['workingDir'],
+        'enabled': value['enabled'],
+    };
+}
+
@@ -13,6 +13,21 @@
  */
 
 import { mapValues } from '../runtime';
+import type { ActiveSessionResources } from './ActiveSessionResources';
+import {
+    ActiveSessionResourcesFromJSON,
+    ActiveSessionResourcesFromJSONTyped,
+    ActiveSessionResourcesToJSON,
+    ActiveSessionResourcesToJSONTyped,
+} from './ActiveSessionResources';
+import type { EventSubscriberStats } from './EventSubscriberStats';
+import {
+    EventSubscriberStatsFromJSON,
+    EventSubscriberStatsFromJSONTyped,
+    EventSubscriberStatsToJSON,
+    EventSubscriberStatsToJSONTyped,
+} from './EventSubscriberStats';
+
 /**
  * 
  * @export
@@ -55,6 +70,18 @@ export interface DebugInfoResponse {
      * @memberof DebugInfoResponse
      */
     lastModified?: Date;
+    /**
+     * Resource usage of active sessions' process trees
+     * @type {Array<ActiveSessionResources>}
+     * @memberof DebugInfoResponse
+     */
+    sessionResources?: Array<ActiveSessionResources>;
+    /**
+     * Delivery statistics of event bus subscribers, oldest first
+     * @type {Array<EventSubscriberStats>}
+     * @memberof DebugInfoResponse
+     */
+    eventSubscribers?: Array<EventSubscriberStats>;
 }
 
 /**
@@ -85,6 +112,8 @@ export function DebugInfoResponseFromJSONTyped(json: any, ignoreDiscriminator: b
         'stats': json['stats'],
         'cliCommand': json['cli_command'],
         'lastModified': json['last_modified'] == null ? undefined : (new Date(json['last_modified'])),
+        'sessionResources': json['session_resources'] == null ? undefined : ((json['session_resources'] as Array<any>).map(ActiveSessionResourcesFromJSON)),
+        'eventSubscribers': json['event_subscribers'] == null ? undefined : ((json['event_subscribers'] as Array<any>).map(EventSubscriberStatsFromJSON)),
     };
 }
 
@@ -105,6 +134,8 @@ export function DebugInfoResponseToJSONTyped(value?: DebugInfoResponse | null, i
         'stats': value['stats'],
         'cli_command': value['cliCommand'],
         'last_modified': value['lastModified'] == null ? undefined : ((value['lastModified']).toISOString()),
+        'session_resources': value['sessionResources'] == null ? undefined : ((value['sessionResources'] as Array<any>).map(ActiveSessionResourcesToJSON)),
+        'event_subscribers': value['eventSubscribers'] == null ? undefined : ((value['eventSubscribers'] as Array<any>).map(EventSubscriberStatsToJSON)),
     };
 }
 
@@ -0,0 +1,190 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ProjectConfigIssue } from './ProjectConfigIssue';
+import {
+    ProjectConfigIssueFromJSON,
+    ProjectConfigIssueFromJSONTyped,
+    ProjectConfigIssueToJSON,
+    ProjectConfigIssueToJSONTyped,
+} from './ProjectConfigIssue';
+
+/**
+ * 
+ * @export
+ * @interface EffectiveConfig
+ */
+export interface EffectiveConfig {
+    /**
+     * Project configuration file merged in, absent when there was none
+     * @type {string}
+     * @memberof EffectiveConfig
+     */
+    projectConfigPath?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EffectiveConfig
+     */
+    model?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EffectiveConfig
+     */
+    appendSystemPrompt?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof EffectiveConfig
+     */
+    allowedTools?: Array<string>;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof EffectiveConfig
+     */
+    disallowedTools?: Array<string>;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof EffectiveConfig
+     */
+    additionalDirectories?: Array<string>;
+    /**
+     * Names of the configured MCP servers
+     * @type {Array<string>}
+     * @memberof EffectiveConfig
+     */
+    mcpServers?: Array<string>;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof EffectiveConfig
+     */
+    autoAcceptEdits: boolean;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof EffectiveConfig
+     */
+    dangerouslySkipPermissions: boolean;
+    /**
+     * 
+     * @type {number}
+     * @memberof EffectiveConfig
+     */
+    maxTurns?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof EffectiveConfig
+     */
+    stallThresholdMs?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof EffectiveConfig
+     */
+    stallInterruptThresholdMs?: number;
+    /**
+     * Where each set field came from, one of request, project or merged
+     * @type {{ [key: string]: string; }}
+     * @memberof EffectiveConfig
+     */
+    sources: { [key: string]: string; };
+    /**
+     * Whether the daemon trusted the project configuration file
+     * @type {boolean}
+     * @memberof EffectiveConfig
+     */
+    projectConfigTrusted?: boolean;
+    /**
+     * Project settings left out because the project configuration is not
+     * trusted: MCP servers, directories outside the project and
+     * auto-accepting edits
+     * @type {Array<ProjectConfigIssue>}
+     * @memberof EffectiveConfig
+     */
+    ignored?: Array<ProjectConfigIssue>;
+}
+
+/**
+ * Check if a given object implements the EffectiveConfig interface.
+ */
+export function instanceOfEffectiveConfig(value: object): value is EffectiveConfig {
+    if (!('autoAcceptEdits' in value) || value['autoAcceptEdits'] === undefined) return false;
+    if (!('dangerouslySkipPermissions' in value) || value['dangerouslySkipPermissions'] === undefined) return false;
+    if (!('sources' in value) || value['sources'] === undefined) return false;
+    return true;
+}
+
+export function EffectiveConfigFromJSON(json: any): EffectiveConfig {
+    return EffectiveConfigFromJSONTyped(json, false);
+}
+
+export function EffectiveConfigFromJSONTyped(json: any, ignoreDiscriminator: boolean): EffectiveConfig {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'projectConfigPath': json['project_config_path'] == null ? undefined : json['project_config_path'],
+        'model': json['model'] == null ? undefined : json['model'],
+        'appendSystemPrompt': json['append_system_prompt'] == null ? undefined : json['append_system_prompt'],
+        'allowedTools': json['allowed_tools'] == null ? undefined : json['allowed_tools'],
+        'disallowedTools': json['disallowed_tools'] == null ? undefined : json['disallowed_tools'],
+        'additionalDirectories': json['additional_directories'] == null ? undefined : json['additional_directories'],
+        'mcpServers': json['mcp_servers'] == null ? undefined : json['mcp_servers'],
+        'autoAcceptEdits': json['auto_accept_edits'],
+        'dangerouslySkipPermissions': json['dangerously_skip_permissions'],
+        'maxTurns': json['max_turns'] == null ? undefined : json['max_turns'],
+        'stallThresholdMs': json['stall_threshold_ms'] == null ? undefined : json['stall_threshold_ms'],
+        'stallInterruptThresholdMs': json['stall_interrupt_threshold_ms'] == null ? undefined : json['stall_interrupt_threshold_ms'],
+        'sources': json['sources'],
+        'projectConfigTrusted': json['project_config_trusted'] == null ? undefined : json['project_config_trusted'],
+        'ignored': json['ignored'] == null ? undefined : ((json['ignored'] as Array<any>).map(ProjectConfigIssueFromJSON)),
+    };
+}
+
+export function EffectiveConfigToJSON(json: any): EffectiveConfig {
+    return EffectiveConfigToJSONTyped(json, false);
+}
+
+export function EffectiveConfigToJSONTyped(value?: EffectiveConfig | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'project_config_path': value['projectConfigPath'],
+        'model': value['model'],
+        'append_system_prompt': value['appendSystemPrompt'],
+        'allowed_tools': value['allowedTools'],
+        'disallowed_tools': value['disallowedTools'],
+        'additional_directories': value['additionalDirectories'],
+        'mcp_servers': value['mcpServers'],
+        'auto_accept_edits': value['autoAcceptEdits'],
+        'dangerously_skip_permissions': value['dangerouslySkipPermissions'],
+        'max_turns': value['maxTurns'],
+        'stall_threshold_ms': value['stallThresholdMs'],
+        'stall_interrupt_threshold_ms': value['stallInterruptThresholdMs'],
+        'sources': value['sources'],
+        'project_config_trusted': value['projectConfigTrusted'],
+        'ignored': value['ignored'] == null ? undefined : ((value['ignored'] as Array<any>).map(ProjectConfigIssueToJSON)),
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { EffectiveConfig } from './EffectiveConfig';
+import {
+    EffectiveConfigFromJSON,
+    EffectiveConfigFromJSONTyped,
+    EffectiveConfigToJSON,
+    EffectiveConfigToJSONTyped,
+} from './EffectiveConfig';
+
+/**
+ * 
+ * @export
+ * @interface EffectiveConfigResponse
+ */
+export interface EffectiveConfigResponse {
+    /**
+     * 
+     * @type {EffectiveConfig}
+     * @memberof EffectiveConfigResponse
+     */
+    data: EffectiveConfig;
+}
+
+/**
+ * Check if a given object implements the EffectiveConfigResponse interface.
+ */
+export function instanceOfEffectiveConfigResponse(value: object): value is EffectiveConfigResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function EffectiveConfigResponseFromJSON(json: any): EffectiveConfigResponse {
+    return EffectiveConfigResponseFromJSONTyped(json, false);
+}
+
+export function EffectiveConfigResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): EffectiveConfigResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': EffectiveConfigFromJSON(json['data']),
+    };
+}
+
+export function EffectiveConfigResponseToJSON(json: any): EffectiveConfigResponse {
+    return EffectiveConfigResponseToJSONTyped(json, false);
+}
+
+export function EffectiveConfigResponseToJSONTyped(value?: EffectiveConfigResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': EffectiveConfigToJSON(value['data']),
+    };
+}
+
@@ -27,6 +27,13 @@ import {
  * @interface Event
  */
 export interface Event {
+    /**
+     * Sequence number, increasing with every event. Absent on replay_gap
+     * events and on events the daemon failed to log.
+     * @type {number}
+     * @memberof Event
+     */
+    seq?: number;
     /**
      * 
      * @type {EventType}
@@ -40,7 +47,15 @@ export interface Event {
      */
     timestamp: Date;
     /**
-     * Event-specific data
+     * Event-specific data. Each event type has its own schema:
+     * NewApprovalPayload for new_approval, ApprovalResolvedPayload for
+     * approval_resolved, SessionStatusChangedPayload for
+     * session_status_changed, ConversationUpdatedPayload for
+     * conversation_updated, SessionSettingsChangedPayload for
+     * session_settings_changed, SessionStalledPayload for
+     * session_stalled, SessionResourceWarningPayload for
+     * session_resource_warning, BudgetExceededPayload for
+     * budget_exceeded and ReplayGapPayload for replay_gap.
      * @type {{ [key: string]: any; }}
      * @memberof Event
      */
@@ -69,6 +84,7 @@ export function EventFromJSONTyped(json: any, ignoreDiscriminator: boolean): Eve
     }
     return {
         
+        'seq': json['seq'] == null ? undefined : json['seq'],
         'type': EventTypeFromJSON(json['type']),
         'timestamp': (new Date(json['timestamp'])),
         'data': json['data'],
@@ -86,6 +102,7 @@ export function EventToJSONTyped(value?: Event | null, ignoreDiscriminator: bool
 
     return {
         
+        'seq': value['seq'],
         'type': EventTypeToJSON(value['type']),
         'timestamp': ((value['timestamp']).toISOString()),
         'data': value['data'],
@@ -0,0 +1,137 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { OverflowPolicy } from './OverflowPolicy';
+import {
+    OverflowPolicyFromJSON,
+    OverflowPolicyFromJSONTyped,
+    OverflowPolicyToJSON,
+    OverflowPolicyToJSONTyped,
+} from './OverflowPolicy';
+
+/**
+ * 
+ * @export
+ * @interface EventSubscriberStats
+ */
+export interface EventSubscriberStats {
+    /**
+     * Subscriber ID
+     * @type {string}
+     * @memberof EventSubscriberStats
+     */
+    id: string;
+    /**
+     * Event types the subscriber receives, all of them if empty
+     * @type {Array<string>}
+     * @memberof EventSubscriberStats
+     */
+    eventTypes?: Array<string>;
+    /**
+     * Session the subscriber receives events of
+     * @type {string}
+     * @memberof EventSubscriberStats
+     */
+    sessionId?: string;
+    /**
+     * Number of events buffered for the subscriber
+     * @type {number}
+     * @memberof EventSubscriberStats
+     */
+    bufferSize: number;
+    /**
+     * 
+     * @type {OverflowPolicy}
+     * @memberof EventSubscriberStats
+     */
+    overflow: OverflowPolicy;
+    /**
+     * Events the subscriber received
+     * @type {number}
+     * @memberof EventSubscriberStats
+     */
+    delivered: number;
+    /**
+     * Events dropped because the subscriber fell behind
+     * @type {number}
+     * @memberof EventSubscriberStats
+     */
+    dropped: number;
+    /**
+     * Events buffered and not yet received
+     * @type {number}
+     * @memberof EventSubscriberStats
+     */
+    lag: number;
+}
+
+
+
+/**
+ * Check if a given object implements the EventSubscriberStats interface.
+ */
+export function instanceOfEventSubscriberStats(value: object): value is EventSubscriberStats {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('bufferSize' in value) || value['bufferSize'] === undefined) return false;
+    if (!('overflow' in value) || value['overflow'] === undefined) return false;
+    if (!('delivered' in value) || value['delivered'] === undefined) return false;
+    if (!('dropped' in value) || value['dropped'] === undefined) return false;
+    if (!('lag' in value) || value['lag'] === undefined) return false;
+    return true;
+}
+
+export function EventSubscriberStatsFromJSON(json: any): EventSubscriberStats {
+    return EventSubscriberStatsFromJSONTyped(json, false);
+}
+
+export function EventSubscriberStatsFromJSONTyped(json: any, ignoreDiscriminator: boolean): EventSubscriberStats {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'eventTypes': json['event_types'] == null ? undefined : json['event_types'],
+        'sessionId': json['session_id'] == null ? undefined : json['session_id'],
+        'bufferSize': json['buffer_size'],
+        'overflow': OverflowPolicyFromJSON(json['overflow']),
+        'delivered': json['delivered'],
+        'dropped': json['dropped'],
+        'lag': json['lag'],
+    };
+}
+
+export function EventSubscriberStatsToJSON(json: any): EventSubscriberStats {
+    return EventSubscriberStatsToJSONTyped(json, false);
+}
+
+export function EventSubscriberStatsToJSONTyped(value?: EventSubscriberStats | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'event_types': value['eventTypes'],
+        'session_id': value['sessionId'],
+        'buffer_size': value['bufferSize'],
+        'overflow': OverflowPolicyToJSON(value['overflow']),
+        'delivered': value['delivered'],
+        'dropped': value['dropped'],
+        'lag': value['lag'],
+    };
+}
+
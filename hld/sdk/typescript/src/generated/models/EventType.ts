@@ -22,7 +22,11 @@ export const EventType = {
     ApprovalResolved: 'approval_resolved',
     SessionStatusChanged: 'session_status_changed',
     ConversationUpdated: 'conversation_updated',
-    SessionSettingsChanged: 'session_settings_changed'
+    SessionSettingsChanged: 'session_settings_changed',
+    SessionStalled: 'session_stalled',
+    SessionResourceWarning: 'session_resource_warning',
+    BudgetExceeded: 'budget_exceeded',
+    ReplayGap: 'replay_gap'
 } as const;
 export type EventType = typeof EventType[keyof typeof EventType];
 
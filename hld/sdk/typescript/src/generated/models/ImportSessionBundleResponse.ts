@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ImportSessionBundleResponseData } from './ImportSessionBundleResponseData';
+import {
+    ImportSessionBundleResponseDataFromJSON,
+    ImportSessionBundleResponseDataFromJSONTyped,
+    ImportSessionBundleResponseDataToJSON,
+    ImportSessionBundleResponseDataToJSONTyped,
+} from './ImportSessionBundleResponseData';
+
+/**
+ * 
+ * @export
+ * @interface ImportSessionBundleResponse
+ */
+export interface ImportSessionBundleResponse {
+    /**
+     * 
+     * @type {ImportSessionBundleResponseData}
+     * @memberof ImportSessionBundleResponse
+     */
+    data: ImportSessionBundleResponseData;
+}
+
+/**
+ * Check if a given object implements the ImportSessionBundleResponse interface.
+ */
+export function instanceOfImportSessionBundleResponse(value: object): value is ImportSessionBundleResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function ImportSessionBundleResponseFromJSON(json: any): ImportSessionBundleResponse {
+    return ImportSessionBundleResponseFromJSONTyped(json, false);
+}
+
+export function ImportSessionBundleResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ImportSessionBundleResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ImportSessionBundleResponseDataFromJSON(json['data']),
+    };
+}
+
+export function ImportSessionBundleResponseToJSON(json: any): ImportSessionBundleResponse {
+    return ImportSessionBundleResponseToJSONTyped(json, false);
+}
+
+export function ImportSessionBundleResponseToJSONTyped(value?: ImportSessionBundleResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ImportSessionBundleResponseDataToJSON(value['data']),
+    };
+}
+
@@ -0,0 +1,84 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ImportSessionBundleResponseData
+ */
+export interface ImportSessionBundleResponseData {
+    /**
+     * New ID of the session the bundle was exported for
+     * @type {string}
+     * @memberof ImportSessionBundleResponseData
+     */
+    sessionId: string;
+    /**
+     * New ID of the root of the imported tree
+     * @type {string}
+     * @memberof ImportSessionBundleResponseData
+     */
+    rootSessionId: string;
+    /**
+     * Session IDs in the bundle mapped to their new IDs
+     * @type {{ [key: string]: string; }}
+     * @memberof ImportSessionBundleResponseData
+     */
+    sessions: { [key: string]: string; };
+}
+
+/**
+ * Check if a given object implements the ImportSessionBundleResponseData interface.
+ */
+export function instanceOfImportSessionBundleResponseData(value: object): value is ImportSessionBundleResponseData {
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    if (!('rootSessionId' in value) || value['rootSessionId'] === undefined) return false;
+    if (!('sessions' in value) || value['sessions'] === undefined) return false;
+    return true;
+}
+
+export function ImportSessionBundleResponseDataFromJSON(json: any): ImportSessionBundleResponseData {
+    return ImportSessionBundleResponseDataFromJSONTyped(json, false);
+}
+
+export function ImportSessionBundleResponseDataFromJSONTyped(json: any, ignoreDiscriminator: boolean): ImportSessionBundleResponseData {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'sessionId': json['session_id'],
+        'rootSessionId': json['root_session_id'],
+        'sessions': json['sessions'],
+    };
+}
+
+export function ImportSessionBundleResponseDataToJSON(json: any): ImportSessionBundleResponseData {
+    return ImportSessionBundleResponseDataToJSONTyped(json, false);
+}
+
+export function ImportSessionBundleResponseDataToJSONTyped(value?: ImportSessionBundleResponseData | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'session_id': value['sessionId'],
+        'root_session_id': value['rootSessionId'],
+        'sessions': value['sessions'],
+    };
+}
+
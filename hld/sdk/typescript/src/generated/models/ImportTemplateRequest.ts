@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ImportTemplateRequest
+ */
+export interface ImportTemplateRequest {
+    /**
+     * Contents of a YAML or JSON template file
+     * @type {string}
+     * @memberof ImportTemplateRequest
+     */
+    content: string;
+    /**
+     * Replace an existing template with the same name
+     * @type {boolean}
+     * @memberof ImportTemplateRequest
+     */
+    overwrite?: boolean;
+}
+
+/**
+ * Check if a given object implements the ImportTemplateRequest interface.
+ */
+export function instanceOfImportTemplateRequest(value: object): value is ImportTemplateRequest {
+    if (!('content' in value) || value['content'] === undefined) return false;
+    return true;
+}
+
+export function ImportTemplateRequestFromJSON(json: any): ImportTemplateRequest {
+    return ImportTemplateRequestFromJSONTyped(json, false);
+}
+
+export function ImportTemplateRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): ImportTemplateRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'content': json['content'],
+        'overwrite': json['overwrite'] == null ? undefined : json['overwrite'],
+    };
+}
+
+export function ImportTemplateRequestToJSON(json: any): ImportTemplateRequest {
+    return ImportTemplateRequestToJSONTyped(json, false);
+}
+
+export function ImportTemplateRequestToJSONTyped(value?: ImportTemplateRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'content': value['content'],
+        'overwrite': value['overwrite'],
+    };
+}
+
@@ -0,0 +1,102 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface Label
+ */
+export interface Label {
+    /**
+     * 
+     * @type {string}
+     * @memberof Label
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Label
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Label
+     */
+    color: string;
+    /**
+     * Number of sessions carrying the label
+     * @type {number}
+     * @memberof Label
+     */
+    sessionCount: number;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Label
+     */
+    createdAt: Date;
+}
+
+/**
+ * Check if a given object implements the Label interface.
+ */
+export function instanceOfLabel(value: object): value is Label {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('color' in value) || value['color'] === undefined) return false;
+    if (!('sessionCount' in value) || value['sessionCount'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    return true;
+}
+
+export function LabelFromJSON(json: any): Label {
+    return LabelFromJSONTyped(json, false);
+}
+
+export function LabelFromJSONTyped(json: any, ignoreDiscriminator: boolean): Label {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'name': json['name'],
+        'color': json['color'],
+        'sessionCount': json['session_count'],
+        'createdAt': (new Date(json['created_at'])),
+    };
+}
+
+export function LabelToJSON(json: any): Label {
+    return LabelToJSONTyped(json, false);
+}
+
+export function LabelToJSONTyped(value?: Label | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'color': value['color'],
+        'session_count': value['sessionCount'],
+        'created_at': ((value['createdAt']).toISOString()),
+    };
+}
+
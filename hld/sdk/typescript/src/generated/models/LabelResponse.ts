@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Label } from './Label';
+import {
+    LabelFromJSON,
+    LabelFromJSONTyped,
+    LabelToJSON,
+    LabelToJSONTyped,
+} from './Label';
+
+/**
+ * 
+ * @export
+ * @interface LabelResponse
+ */
+export interface LabelResponse {
+    /**
+     * 
+     * @type {Label}
+     * @memberof LabelResponse
+     */
+    data: Label;
+}
+
+/**
+ * Check if a given object implements the LabelResponse interface.
+ */
+export function instanceOfLabelResponse(value: object): value is LabelResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function LabelResponseFromJSON(json: any): LabelResponse {
+    return LabelResponseFromJSONTyped(json, false);
+}
+
+export function LabelResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): LabelResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': LabelFromJSON(json['data']),
+    };
+}
+
+export function LabelResponseToJSON(json: any): LabelResponse {
+    return LabelResponseToJSONTyped(json, false);
+}
+
+export function LabelResponseToJSONTyped(value?: LabelResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': LabelToJSON(value['data']),
+    };
+}
+
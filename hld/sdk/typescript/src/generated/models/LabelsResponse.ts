@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Label } from './Label';
+import {
+    LabelFromJSON,
+    LabelFromJSONTyped,
+    LabelToJSON,
+    LabelToJSONTyped,
+} from './Label';
+
+/**
+ * 
+ * @export
+ * @interface LabelsResponse
+ */
+export interface LabelsResponse {
+    /**
+     * 
+     * @type {Array<Label>}
+     * @memberof LabelsResponse
+     */
+    data: Array<Label>;
+}
+
+/**
+ * Check if a given object implements the LabelsResponse interface.
+ */
+export function instanceOfLabelsResponse(value: object): value is LabelsResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function LabelsResponseFromJSON(json: any): LabelsResponse {
+    return LabelsResponseFromJSONTyped(json, false);
+}
+
+export function LabelsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): LabelsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(LabelFromJSON)),
+    };
+}
+
+export function LabelsResponseToJSON(json: any): LabelsResponse {
+    return LabelsResponseToJSONTyped(json, false);
+}
+
+export function LabelsResponseToJSONTyped(value?: LabelsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(LabelToJSON)),
+    };
+}
+
@@ -0,0 +1,123 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchMatrix } from './BatchMatrix';
+import {
+    BatchMatrixFromJSON,
+    BatchMatrixFromJSONTyped,
+    BatchMatrixToJSON,
+    BatchMatrixToJSONTyped,
+} from './BatchMatrix';
+
+/**
+ * 
+ * @export
+ * @interface LaunchBatchRequest
+ */
+export interface LaunchBatchRequest {
+    /**
+     * Batch name, defaults to the start of the query
+     * @type {string}
+     * @memberof LaunchBatchRequest
+     */
+    name?: string;
+    /**
+     * Prompt sent to every member
+     * @type {string}
+     * @memberof LaunchBatchRequest
+     */
+    query: string;
+    /**
+     * Working directory used when the matrix has no working_dirs
+     * @type {string}
+     * @memberof LaunchBatchRequest
+     */
+    workingDir?: string;
+    /**
+     * 
+     * @type {BatchMatrix}
+     * @memberof LaunchBatchRequest
+     */
+    matrix: BatchMatrix;
+    /**
+     * 
+     * @type {number}
+     * @memberof LaunchBatchRequest
+     */
+    maxTurns?: number;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof LaunchBatchRequest
+     */
+    allowedTools?: Array<string>;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof LaunchBatchRequest
+     */
+    autoAcceptEdits?: boolean;
+}
+
+/**
+ * Check if a given object implements the LaunchBatchRequest interface.
+ */
+export function instanceOfLaunchBatchRequest(value: object): value is LaunchBatchRequest {
+    if (!('query' in value) || value['query'] === undefined) return false;
+    if (!('matrix' in value) || value['matrix'] === undefined) return false;
+    return true;
+}
+
+export function LaunchBatchRequestFromJSON(json: any): LaunchBatchRequest {
+    return LaunchBatchRequestFromJSONTyped(json, false);
+}
+
+export function LaunchBatchRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): LaunchBatchRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'query': json['query'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'matrix': BatchMatrixFromJSON(json['matrix']),
+        'maxTurns': json['max_turns'] == null ? undefined : json['max_turns'],
+        'allowedTools': json['allowed_tools'] == null ? undefined : json['allowed_tools'],
+        'autoAcceptEdits': json['auto_accept_edits'] == null ? undefined : json['auto_accept_edits'],
+    };
+}
+
+export function LaunchBatchRequestToJSON(json: any): LaunchBatchRequest {
+    return LaunchBatchRequestToJSONTyped(json, false);
+}
+
+export function LaunchBatchRequestToJSONTyped(value?: LaunchBatchRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'query': value['query'],
+        'working_dir': value['workingDir'],
+        'matrix': BatchMatrixToJSON(value['matrix']),
+        'max_turns': value['maxTurns'],
+        'allowed_tools': value['allowedTools'],
+        'auto_accept_edits': value['autoAcceptEdits'],
+    };
+}
+
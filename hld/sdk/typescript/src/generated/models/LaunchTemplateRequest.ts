@@ -0,0 +1,81 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface LaunchTemplateRequest
+ */
+export interface LaunchTemplateRequest {
+    /**
+     * 
+     * @type {{ [key: string]: string; }}
+     * @memberof LaunchTemplateRequest
+     */
+    variables?: { [key: string]: string; };
+    /**
+     * Overrides the template's working directory
+     * @type {string}
+     * @memberof LaunchTemplateRequest
+     */
+    workingDir?: string;
+    /**
+     * Overrides the template's title
+     * @type {string}
+     * @memberof LaunchTemplateRequest
+     */
+    title?: string;
+}
+
+/**
+ * Check if a given object implements the LaunchTemplateRequest interface.
+ */
+export function instanceOfLaunchTemplateRequest(value: object): value is LaunchTemplateRequest {
+    return true;
+}
+
+export function LaunchTemplateRequestFromJSON(json: any): LaunchTemplateRequest {
+    return LaunchTemplateRequestFromJSONTyped(json, false);
+}
+
+export function LaunchTemplateRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): LaunchTemplateRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'variables': json['variables'] == null ? undefined : json['variables'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'title': json['title'] == null ? undefined : json['title'],
+    };
+}
+
+export function LaunchTemplateRequestToJSON(json: any): LaunchTemplateRequest {
+    return LaunchTemplateRequestToJSONTyped(json, false);
+}
+
+export function LaunchTemplateRequestToJSONTyped(value?: LaunchTemplateRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'variables': value['variables'],
+        'working_dir': value['workingDir'],
+        'title': value['title'],
+    };
+}
+
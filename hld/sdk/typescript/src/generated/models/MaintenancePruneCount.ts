@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface MaintenancePruneCount
+ */
+export interface MaintenancePruneCount {
+    /**
+     * Rows removed, or that would be removed in a dry run
+     * @type {number}
+     * @memberof MaintenancePruneCount
+     */
+    rows: number;
+    /**
+     * Approximate payload size of those rows
+     * @type {number}
+     * @memberof MaintenancePruneCount
+     */
+    bytes: number;
+}
+
+/**
+ * Check if a given object implements the MaintenancePruneCount interface.
+ */
+export function instanceOfMaintenancePruneCount(value: object): value is MaintenancePruneCount {
+    if (!('rows' in value) || value['rows'] === undefined) return false;
+    if (!('bytes' in value) || value['bytes'] === undefined) return false;
+    return true;
+}
+
+export function MaintenancePruneCountFromJSON(json: any): MaintenancePruneCount {
+    return MaintenancePruneCountFromJSONTyped(json, false);
+}
+
+export function MaintenancePruneCountFromJSONTyped(json: any, ignoreDiscriminator: boolean): MaintenancePruneCount {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'rows': json['rows'],
+        'bytes': json['bytes'],
+    };
+}
+
+export function MaintenancePruneCountToJSON(json: any): MaintenancePruneCount {
+    return MaintenancePruneCountToJSONTyped(json, false);
+}
+
+export function MaintenancePruneCountToJSONTyped(value?: MaintenancePruneCount | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'rows': value['rows'],
+        'bytes': value['bytes'],
+    };
+}
+
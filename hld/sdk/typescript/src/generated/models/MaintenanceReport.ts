@@ -0,0 +1,200 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { VacuumMode } from './VacuumMode';
+import {
+    VacuumModeFromJSON,
+    VacuumModeFromJSONTyped,
+    VacuumModeToJSON,
+    VacuumModeToJSONTyped,
+} from './VacuumMode';
+import type { MaintenancePruneCount } from './MaintenancePruneCount';
+import {
+    MaintenancePruneCountFromJSON,
+    MaintenancePruneCountFromJSONTyped,
+    MaintenancePruneCountToJSON,
+    MaintenancePruneCountToJSONTyped,
+} from './MaintenancePruneCount';
+
+/**
+ * 
+ * @export
+ * @interface MaintenanceReport
+ */
+export interface MaintenanceReport {
+    /**
+     * 
+     * @type {boolean}
+     * @memberof MaintenanceReport
+     */
+    dryRun: boolean;
+    /**
+     * 
+     * @type {MaintenancePruneCount}
+     * @memberof MaintenanceReport
+     */
+    rawEvents: MaintenancePruneCount;
+    /**
+     * 
+     * @type {MaintenancePruneCount}
+     * @memberof MaintenanceReport
+     */
+    archivedSessions: MaintenancePruneCount;
+    /**
+     * 
+     * @type {MaintenancePruneCount}
+     * @memberof MaintenanceReport
+     */
+    expiredFileSnapshots: MaintenancePruneCount;
+    /**
+     * 
+     * @type {MaintenancePruneCount}
+     * @memberof MaintenanceReport
+     */
+    duplicateFileSnapshots: MaintenancePruneCount;
+    /**
+     * The database's auto_vacuum mode (none, full or incremental)
+     * @type {string}
+     * @memberof MaintenanceReport
+     */
+    autoVacuum: string;
+    /**
+     * 
+     * @type {VacuumMode}
+     * @memberof MaintenanceReport
+     */
+    vacuum: VacuumMode;
+    /**
+     * Whether planner statistics were refreshed
+     * @type {boolean}
+     * @memberof MaintenanceReport
+     */
+    analyzed: boolean;
+    /**
+     * 
+     * @type {number}
+     * @memberof MaintenanceReport
+     */
+    sizeBeforeBytes: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof MaintenanceReport
+     */
+    sizeAfterBytes: number;
+    /**
+     * Bytes returned to the filesystem
+     * @type {number}
+     * @memberof MaintenanceReport
+     */
+    reclaimedBytes: number;
+    /**
+     * Unused space left inside the database file
+     * @type {number}
+     * @memberof MaintenanceReport
+     */
+    freeBytes: number;
+    /**
+     * 
+     * @type {Date}
+     * @memberof MaintenanceReport
+     */
+    startedAt: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof MaintenanceReport
+     */
+    completedAt: Date;
+}
+
+
+
+/**
+ * Check if a given object implements the MaintenanceReport interface.
+ */
+export function instanceOfMaintenanceReport(value: object): value is MaintenanceReport {
+    if (!('dryRun' in value) || value['dryRun'] === undefined) return false;
+    if (!('rawEvents' in value) || value['rawEvents'] === undefined) return false;
+    if (!('archivedSessions' in value) || value['archivedSessions'] === undefined) return false;
+    if (!('expiredFileSnapshots' in value) || value['expiredFileSnapshots'] === undefined) return false;
+    if (!('duplicateFileSnapshots' in value) || value['duplicateFileSnapshots'] === undefined) return false;
+    if (!('autoVacuum' in value) || value['autoVacuum'] === undefined) return false;
+    if (!('vacuum' in value) || value['vacuum'] === undefined) return false;
+    if (!('analyzed' in value) || value['analyzed'] === undefined) return false;
+    if (!('sizeBeforeBytes' in value) || value['sizeBeforeBytes'] === undefined) return false;
+    if (!('sizeAfterBytes' in value) || value['sizeAfterBytes'] === undefined) return false;
+    if (!('reclaimedBytes' in value) || value['reclaimedBytes'] === undefined) return false;
+    if (!('freeBytes' in value) || value['freeBytes'] === undefined) return false;
+    if (!('startedAt' in value) || value['startedAt'] === undefined) return false;
+    if (!('completedAt' in value) || value['completedAt'] === undefined) return false;
+    return true;
+}
+
+export function MaintenanceReportFromJSON(json: any): MaintenanceReport {
+    return MaintenanceReportFromJSONTyped(json, false);
+}
+
+export function MaintenanceReportFromJSONTyped(json: any, ignoreDiscriminator: boolean): MaintenanceReport {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'dryRun': json['dry_run'],
+        'rawEvents': MaintenancePruneCountFromJSON(json['raw_events']),
+        'archivedSessions': MaintenancePruneCountFromJSON(json['archived_sessions']),
+        'expiredFileSnapshots': MaintenancePruneCountFromJSON(json['expired_file_snapshots']),
+        'duplicateFileSnapshots': MaintenancePruneCountFromJSON(json['duplicate_file_snapshots']),
+        'autoVacuum': json['auto_vacuum'],
+        'vacuum': VacuumModeFromJSON(json['vacuum']),
+        'analyzed': json['analyzed'],
+        'sizeBeforeBytes': json['size_before_bytes'],
+        'sizeAfterBytes': json['size_after_bytes'],
+        'reclaimedBytes': json['reclaimed_bytes'],
+        'freeBytes': json['free_bytes'],
+        'startedAt': (new Date(json['started_at'])),
+        'completedAt': (new Date(json['completed_at'])),
+    };
+}
+
+export function MaintenanceReportToJSON(json: any): MaintenanceReport {
+    return MaintenanceReportToJSONTyped(json, false);
+}
+
+export function MaintenanceReportToJSONTyped(value?: MaintenanceReport | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'dry_run': value['dryRun'],
+        'raw_events': MaintenancePruneCountToJSON(value['rawEvents']),
+        'archived_sessions': MaintenancePruneCountToJSON(value['archivedSessions']),
+        'expired_file_snapshots': MaintenancePruneCountToJSON(value['expiredFileSnapshots']),
+        'duplicate_file_snapshots': MaintenancePruneCountToJSON(value['duplicateFileSnapshots']),
+        'auto_vacuum': value['autoVacuum'],
+        'vacuum': VacuumModeToJSON(value['vacuum']),
+        'analyzed': value['analyzed'],
+        'size_before_bytes': value['sizeBeforeBytes'],
+        'size_after_bytes': value['sizeAfterBytes'],
+        'reclaimed_bytes': value['reclaimedBytes'],
+        'free_bytes': value['freeBytes'],
+        'started_at': ((value['startedAt']).toISOString()),
+        'completed_at': ((value['completedAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { VacuumMode } from './VacuumMode';
+import {
+    VacuumModeFromJSON,
+    VacuumModeFromJSONTyped,
+    VacuumModeToJSON,
+    VacuumModeToJSONTyped,
+} from './VacuumMode';
+
+/**
+ * 
+ * @export
+ * @interface MaintenanceRequest
+ */
+export interface MaintenanceRequest {
+    /**
+     * Report what would be pruned without changing anything
+     * @type {boolean}
+     * @memberof MaintenanceRequest
+     */
+    dryRun?: boolean;
+    /**
+     * 
+     * @type {VacuumMode}
+     * @memberof MaintenanceRequest
+     */
+    vacuum?: VacuumMode;
+}
+
+
+
+/**
+ * Check if a given object implements the MaintenanceRequest interface.
+ */
+export function instanceOfMaintenanceRequest(value: object): value is MaintenanceRequest {
+    return true;
+}
+
+export function MaintenanceRequestFromJSON(json: any): MaintenanceRequest {
+    return MaintenanceRequestFromJSONTyped(json, false);
+}
+
+export function MaintenanceRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): MaintenanceRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'dryRun': json['dry_run'] == null ? undefined : json['dry_run'],
+        'vacuum': json['vacuum'] == null ? undefined : VacuumModeFromJSON(json['vacuum']),
+    };
+}
+
+export function MaintenanceRequestToJSON(json: any): MaintenanceRequest {
+    return MaintenanceRequestToJSONTyped(json, false);
+}
+
+export function MaintenanceRequestToJSONTyped(value?: MaintenanceRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'dry_run': value['dryRun'],
+        'vacuum': VacuumModeToJSON(value['vacuum']),
+    };
+}
+
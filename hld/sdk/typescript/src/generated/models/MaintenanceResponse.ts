@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { MaintenanceReport } from './MaintenanceReport';
+import {
+    MaintenanceReportFromJSON,
+    MaintenanceReportFromJSONTyped,
+    MaintenanceReportToJSON,
+    MaintenanceReportToJSONTyped,
+} from './MaintenanceReport';
+
+/**
+ * 
+ * @export
+ * @interface MaintenanceResponse
+ */
+export interface MaintenanceResponse {
+    /**
+     * 
+     * @type {MaintenanceReport}
+     * @memberof MaintenanceResponse
+     */
+    data: MaintenanceReport;
+}
+
+/**
+ * Check if a given object implements the MaintenanceResponse interface.
+ */
+export function instanceOfMaintenanceResponse(value: object): value is MaintenanceResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function MaintenanceResponseFromJSON(json: any): MaintenanceResponse {
+    return MaintenanceResponseFromJSONTyped(json, false);
+}
+
+export function MaintenanceResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): MaintenanceResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': MaintenanceReportFromJSON(json['data']),
+    };
+}
+
+export function MaintenanceResponseToJSON(json: any): MaintenanceResponse {
+    return MaintenanceResponseToJSONTyped(json, false);
+}
+
+export function MaintenanceResponseToJSONTyped(value?: MaintenanceResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': MaintenanceReportToJSON(value['data']),
+    };
+}
+
@@ -0,0 +1,84 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Data of a new_approval event
+ * @export
+ * @interface NewApprovalPayload
+ */
+export interface NewApprovalPayload {
+    /**
+     * 
+     * @type {string}
+     * @memberof NewApprovalPayload
+     */
+    approvalId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof NewApprovalPayload
+     */
+    sessionId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof NewApprovalPayload
+     */
+    toolName: string;
+}
+
+/**
+ * Check if a given object implements the NewApprovalPayload interface.
+ */
+export function instanceOfNewApprovalPayload(value: object): value is NewApprovalPayload {
+    if (!('approvalId' in value) || value['approvalId'] === undefined) return false;
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    if (!('toolName' in value) || value['toolName'] === undefined) return false;
+    return true;
+}
+
+export function NewApprovalPayloadFromJSON(json: any): NewApprovalPayload {
+    return NewApprovalPayloadFromJSONTyped(json, false);
+}
+
+export function NewApprovalPayloadFromJSONTyped(json: any, ignoreDiscriminator: boolean): NewApprovalPayload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'approvalId': json['approval_id'],
+        'sessionId': json['session_id'],
+        'toolName': json['tool_name'],
+    };
+}
+
+export function NewApprovalPayloadToJSON(json: any): NewApprovalPayload {
+    return NewApprovalPayloadToJSONTyped(json, false);
+}
+
+export function NewApprovalPayloadToJSONTyped(value?: NewApprovalPayload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'approval_id': value['approvalId'],
+        'session_id': value['sessionId'],
+        'tool_name': value['toolName'],
+    };
+}
+
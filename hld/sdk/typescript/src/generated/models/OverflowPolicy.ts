@@ -0,0 +1,55 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * What happens to an event that does not fit a subscriber's buffer
+ * @export
+ */
+export const OverflowPolicy = {
+    DropNewest: 'drop_newest',
+    DropOldest: 'drop_oldest',
+    Coalesce: 'coalesce',
+    Disconnect: 'disconnect'
+} as const;
+export type OverflowPolicy = typeof OverflowPolicy[keyof typeof OverflowPolicy];
+
+
+export function instanceOfOverflowPolicy(value: any): boolean {
+    for (const key in OverflowPolicy) {
+        if (Object.prototype.hasOwnProperty.call(OverflowPolicy, key)) {
+            if (OverflowPolicy[key as keyof typeof OverflowPolicy] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function OverflowPolicyFromJSON(json: any): OverflowPolicy {
+    return OverflowPolicyFromJSONTyped(json, false);
+}
+
+export function OverflowPolicyFromJSONTyped(json: any, ignoreDiscriminator: boolean): OverflowPolicy {
+    return json as OverflowPolicy;
+}
+
+export function OverflowPolicyToJSON(value?: OverflowPolicy | null): any {
+    return value as any;
+}
+
+export function OverflowPolicyToJSONTyped(value: any, ignoreDiscriminator: boolean): OverflowPolicy {
+    return value as OverflowPolicy;
+}
+
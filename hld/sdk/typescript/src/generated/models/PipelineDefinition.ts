@@ -0,0 +1,123 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { PipelineStepDefinition } from './PipelineStepDefinition';
+import {
+    PipelineStepDefinitionFromJSON,
+    PipelineStepDefinitionFromJSONTyped,
+    PipelineStepDefinitionToJSON,
+    PipelineStepDefinitionToJSONTyped,
+} from './PipelineStepDefinition';
+
+/**
+ * Multi-step pipeline. Pipeline-level settings are defaults for every step.
+ * @export
+ * @interface PipelineDefinition
+ */
+export interface PipelineDefinition {
+    /**
+     * 
+     * @type {string}
+     * @memberof PipelineDefinition
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof PipelineDefinition
+     */
+    description?: string;
+    /**
+     * Default working directory for the pipeline's sessions
+     * @type {string}
+     * @memberof PipelineDefinition
+     */
+    workingDir?: string;
+    /**
+     * Default model for steps (opus, sonnet or haiku)
+     * @type {string}
+     * @memberof PipelineDefinition
+     */
+    model?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof PipelineDefinition
+     */
+    allowedTools?: Array<string>;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof PipelineDefinition
+     */
+    autoAcceptEdits?: boolean;
+    /**
+     * 
+     * @type {Array<PipelineStepDefinition>}
+     * @memberof PipelineDefinition
+     */
+    steps: Array<PipelineStepDefinition>;
+}
+
+/**
+ * Check if a given object implements the PipelineDefinition interface.
+ */
+export function instanceOfPipelineDefinition(value: object): value is PipelineDefinition {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('steps' in value) || value['steps'] === undefined) return false;
+    return true;
+}
+
+export function PipelineDefinitionFromJSON(json: any): PipelineDefinition {
+    return PipelineDefinitionFromJSONTyped(json, false);
+}
+
+export function PipelineDefinitionFromJSONTyped(json: any, ignoreDiscriminator: boolean): PipelineDefinition {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'model': json['model'] == null ? undefined : json['model'],
+        'allowedTools': json['allowed_tools'] == null ? undefined : json['allowed_tools'],
+        'autoAcceptEdits': json['auto_accept_edits'] == null ? undefined : json['auto_accept_edits'],
+        'steps': ((json['steps'] as Array<any>).map(PipelineStepDefinitionFromJSON)),
+    };
+}
+
+export function PipelineDefinitionToJSON(json: any): PipelineDefinition {
+    return PipelineDefinitionToJSONTyped(json, false);
+}
+
+export function PipelineDefinitionToJSONTyped(value?: PipelineDefinition | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'description': value['description'],
+        'working_dir': value['workingDir'],
+        'model': value['model'],
+        'allowed_tools': value['allowedTools'],
+        'auto_accept_edits': value['autoAcceptEdits'],
+        'steps': ((value['steps'] as Array<any>).map(PipelineStepDefinitionToJSON)),
+    };
+}
+
@@ -0,0 +1,177 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { PipelineRunStatus } from './PipelineRunStatus';
+import {
+    PipelineRunStatusFromJSON,
+    PipelineRunStatusFromJSONTyped,
+    PipelineRunStatusToJSON,
+    PipelineRunStatusToJSONTyped,
+} from './PipelineRunStatus';
+import type { PipelineStep } from './PipelineStep';
+import {
+    PipelineStepFromJSON,
+    PipelineStepFromJSONTyped,
+    PipelineStepToJSON,
+    PipelineStepToJSONTyped,
+} from './PipelineStep';
+import type { PipelineDefinition } from './PipelineDefinition';
+import {
+    PipelineDefinitionFromJSON,
+    PipelineDefinitionFromJSONTyped,
+    PipelineDefinitionToJSON,
+    PipelineDefinitionToJSONTyped,
+} from './PipelineDefinition';
+
+/**
+ * 
+ * @export
+ * @interface PipelineRun
+ */
+export interface PipelineRun {
+    /**
+     * 
+     * @type {string}
+     * @memberof PipelineRun
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof PipelineRun
+     */
+    name: string;
+    /**
+     * 
+     * @type {PipelineDefinition}
+     * @memberof PipelineRun
+     */
+    definition: PipelineDefinition;
+    /**
+     * 
+     * @type {string}
+     * @memberof PipelineRun
+     */
+    workingDir?: string;
+    /**
+     * 
+     * @type {PipelineRunStatus}
+     * @memberof PipelineRun
+     */
+    status: PipelineRunStatus;
+    /**
+     * Index of the step being executed or evaluated next
+     * @type {number}
+     * @memberof PipelineRun
+     */
+    currentStep: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof PipelineRun
+     */
+    errorMessage?: string;
+    /**
+     * 
+     * @type {Array<PipelineStep>}
+     * @memberof PipelineRun
+     */
+    steps: Array<PipelineStep>;
+    /**
+     * 
+     * @type {Date}
+     * @memberof PipelineRun
+     */
+    createdAt: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof PipelineRun
+     */
+    updatedAt: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof PipelineRun
+     */
+    completedAt?: Date;
+}
+
+
+
+/**
+ * Check if a given object implements the PipelineRun interface.
+ */
+export function instanceOfPipelineRun(value: object): value is PipelineRun {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('definition' in value) || value['definition'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    if (!('currentStep' in value) || value['currentStep'] === undefined) return false;
+    if (!('steps' in value) || value['steps'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    if (!('updatedAt' in value) || value['updatedAt'] === undefined) return false;
+    return true;
+}
+
+export function PipelineRunFromJSON(json: any): PipelineRun {
+    return PipelineRunFromJSONTyped(json, false);
+}
+
+export function PipelineRunFromJSONTyped(json: any, ignoreDiscriminator: boolean): PipelineRun {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'name': json['name'],
+        'definition': PipelineDefinitionFromJSON(json['definition']),
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'status': PipelineRunStatusFromJSON(json['status']),
+        'currentStep': json['current_step'],
+        'errorMessage': json['error_message'] == null ? undefined : json['error_message'],
+        'steps': ((json['steps'] as Array<any>).map(PipelineStepFromJSON)),
+        'createdAt': (new Date(json['created_at'])),
+        'updatedAt': (new Date(json['updated_at'])),
+        'completedAt': json['completed_at'] == null ? undefined : (new Date(json['completed_at'])),
+    };
+}
+
+export function PipelineRunToJSON(json: any): PipelineRun {
+    return PipelineRunToJSONTyped(json, false);
+}
+
+export function PipelineRunToJSONTyped(value?: PipelineRun | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'definition': PipelineDefinitionToJSON(value['definition']),
+        'working_dir': value['workingDir'],
+        'status': PipelineRunStatusToJSON(value['status']),
+        'current_step': value['currentStep'],
+        'error_message': value['errorMessage'],
+        'steps': ((value['steps'] as Array<any>).map(PipelineStepToJSON)),
+        'created_at': ((value['createdAt']).toISOString()),
+        'updated_at': ((value['updatedAt']).toISOString()),
+        'completed_at': value['completedAt'] == null ? undefined : ((value['completedAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { PipelineRun } from './PipelineRun';
+import {
+    PipelineRunFromJSON,
+    PipelineRunFromJSONTyped,
+    PipelineRunToJSON,
+    PipelineRunToJSONTyped,
+} from './PipelineRun';
+
+/**
+ * 
+ * @export
+ * @interface PipelineRunResponse
+ */
+export interface PipelineRunResponse {
+    /**
+     * 
+     * @type {PipelineRun}
+     * @memberof PipelineRunResponse
+     */
+    data: PipelineRun;
+}
+
+/**
+ * Check if a given object implements the PipelineRunResponse interface.
+ */
+export function instanceOfPipelineRunResponse(value: object): value is PipelineRunResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function PipelineRunResponseFromJSON(json: any): PipelineRunResponse {
+    return PipelineRunResponseFromJSONTyped(json, false);
+}
+
+export function PipelineRunResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): PipelineRunResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': PipelineRunFromJSON(json['data']),
+    };
+}
+
+export function PipelineRunResponseToJSON(json: any): PipelineRunResponse {
+    return PipelineRunResponseToJSONTyped(json, false);
+}
+
+export function PipelineRunResponseToJSONTyped(value?: PipelineRunResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': PipelineRunToJSON(value['data']),
+    };
+}
+
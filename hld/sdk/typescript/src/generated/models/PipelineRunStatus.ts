@@ -0,0 +1,55 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * 
+ * @export
+ */
+export const PipelineRunStatus = {
+    Running: 'running',
+    Paused: 'paused',
+    Completed: 'completed',
+    Failed: 'failed'
+} as const;
+export type PipelineRunStatus = typeof PipelineRunStatus[keyof typeof PipelineRunStatus];
+
+
+export function instanceOfPipelineRunStatus(value: any): boolean {
+    for (const key in PipelineRunStatus) {
+        if (Object.prototype.hasOwnProperty.call(PipelineRunStatus, key)) {
+            if (PipelineRunStatus[key as keyof typeof PipelineRunStatus] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function PipelineRunStatusFromJSON(json: any): PipelineRunStatus {
+    return PipelineRunStatusFromJSONTyped(json, false);
+}
+
+export function PipelineRunStatusFromJSONTyped(json: any, ignoreDiscriminator: boolean): PipelineRunStatus {
+    return json as PipelineRunStatus;
+}
+
+export function PipelineRunStatusToJSON(value?: PipelineRunStatus | null): any {
+    return value as any;
+}
+
+export function PipelineRunStatusToJSONTyped(value: any, ignoreDiscriminator: boolean): PipelineRunStatus {
+    return value as PipelineRunStatus;
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { PipelineRun } from './PipelineRun';
+import {
+    PipelineRunFromJSON,
+    PipelineRunFromJSONTyped,
+    PipelineRunToJSON,
+    PipelineRunToJSONTyped,
+} from './PipelineRun';
+
+/**
+ * 
+ * @export
+ * @interface PipelineRunsResponse
+ */
+export interface PipelineRunsResponse {
+    /**
+     * 
+     * @type {Array<PipelineRun>}
+     * @memberof PipelineRunsResponse
+     */
+    data: Array<PipelineRun>;
+}
+
+/**
+ * Check if a given object implements the PipelineRunsResponse interface.
+ */
+export function instanceOfPipelineRunsResponse(value: object): value is PipelineRunsResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function PipelineRunsResponseFromJSON(json: any): PipelineRunsResponse {
+    return PipelineRunsResponseFromJSONTyped(json, false);
+}
+
+export function PipelineRunsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): PipelineRunsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(PipelineRunFromJSON)),
+    };
+}
+
+export function PipelineRunsResponseToJSON(json: any): PipelineRunsResponse {
+    return PipelineRunsResponseToJSONTyped(json, false);
+}
+
+export function PipelineRunsResponseToJSONTyped(value?: PipelineRunsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(PipelineRunToJSON)),
+    };
+}
+
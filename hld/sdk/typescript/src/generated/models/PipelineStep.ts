@@ -0,0 +1,126 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { PipelineStepStatus } from './PipelineStepStatus';
+import {
+    PipelineStepStatusFromJSON,
+    PipelineStepStatusFromJSONTyped,
+    PipelineStepStatusToJSON,
+    PipelineStepStatusToJSONTyped,
+} from './PipelineStepStatus';
+
+/**
+ * 
+ * @export
+ * @interface PipelineStep
+ */
+export interface PipelineStep {
+    /**
+     * 
+     * @type {number}
+     * @memberof PipelineStep
+     */
+    index: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof PipelineStep
+     */
+    name: string;
+    /**
+     * Session launched for this step
+     * @type {string}
+     * @memberof PipelineStep
+     */
+    sessionId?: string;
+    /**
+     * 
+     * @type {PipelineStepStatus}
+     * @memberof PipelineStep
+     */
+    status: PipelineStepStatus;
+    /**
+     * Why the step was skipped or failed
+     * @type {string}
+     * @memberof PipelineStep
+     */
+    reason?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof PipelineStep
+     */
+    startedAt?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof PipelineStep
+     */
+    completedAt?: Date;
+}
+
+
+
+/**
+ * Check if a given object implements the PipelineStep interface.
+ */
+export function instanceOfPipelineStep(value: object): value is PipelineStep {
+    if (!('index' in value) || value['index'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    return true;
+}
+
+export function PipelineStepFromJSON(json: any): PipelineStep {
+    return PipelineStepFromJSONTyped(json, false);
+}
+
+export function PipelineStepFromJSONTyped(json: any, ignoreDiscriminator: boolean): PipelineStep {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'index': json['index'],
+        'name': json['name'],
+        'sessionId': json['session_id'] == null ? undefined : json['session_id'],
+        'status': PipelineStepStatusFromJSON(json['status']),
+        'reason': json['reason'] == null ? undefined : json['reason'],
+        'startedAt': json['started_at'] == null ? undefined : (new Date(json['started_at'])),
+        'completedAt': json['completed_at'] == null ? undefined : (new Date(json['completed_at'])),
+    };
+}
+
+export function PipelineStepToJSON(json: any): PipelineStep {
+    return PipelineStepToJSONTyped(json, false);
+}
+
+export function PipelineStepToJSONTyped(value?: PipelineStep | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'index': value['index'],
+        'name': value['name'],
+        'session_id': value['sessionId'],
+        'status': PipelineStepStatusToJSON(value['status']),
+        'reason': value['reason'],
+        'started_at': value['startedAt'] == null ? undefined : ((value['startedAt']).toISOString()),
+        'completed_at': value['completedAt'] == null ? undefined : ((value['completedAt']).toISOString()),
+    };
+}
+
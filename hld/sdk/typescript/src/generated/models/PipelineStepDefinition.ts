@@ -0,0 +1,176 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface PipelineStepDefinition
+ */
+export interface PipelineStepDefinition {
+    /**
+     * Step name, defaults to step-N
+     * @type {string}
+     * @memberof PipelineStepDefinition
+     */
+    name?: string;
+    /**
+     * Prompt for the step. Fresh steps replace {{previous_result}} with the
+     * previous step's result, or append the result when the placeholder is absent.
+     * @type {string}
+     * @memberof PipelineStepDefinition
+     */
+    prompt: string;
+    /**
+     * Continue the previous step's session or start a new one
+     * @type {string}
+     * @memberof PipelineStepDefinition
+     */
+    mode?: PipelineStepDefinitionModeEnum;
+    /**
+     * Model override for this step (opus, sonnet or haiku)
+     * @type {string}
+     * @memberof PipelineStepDefinition
+     */
+    model?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof PipelineStepDefinition
+     */
+    allowedTools?: Array<string>;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof PipelineStepDefinition
+     */
+    disallowedTools?: Array<string>;
+    /**
+     * 
+     * @type {number}
+     * @memberof PipelineStepDefinition
+     */
+    maxTurns?: number;
+    /**
+     * Budget for the step; exceeding it interrupts the session and fails the step
+     * @type {number}
+     * @memberof PipelineStepDefinition
+     */
+    maxCostUsd?: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof PipelineStepDefinition
+     */
+    systemPrompt?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof PipelineStepDefinition
+     */
+    appendSystemPrompt?: string;
+    /**
+     * Which outcome of the previous executed step runs this step
+     * @type {string}
+     * @memberof PipelineStepDefinition
+     */
+    when?: PipelineStepDefinitionWhenEnum;
+    /**
+     * Regular expression the previous step's result must match
+     * @type {string}
+     * @memberof PipelineStepDefinition
+     */
+    outputMatches?: string;
+}
+
+
+/**
+ * @export
+ */
+export const PipelineStepDefinitionModeEnum = {
+    Continue: 'continue',
+    Fresh: 'fresh'
+} as const;
+export type PipelineStepDefinitionModeEnum = typeof PipelineStepDefinitionModeEnum[keyof typeof PipelineStepDefinitionModeEnum];
+
+/**
+ * @export
+ */
+export const PipelineStepDefinitionWhenEnum = {
+    Success: 'success',
+    Failure: 'failure',
+    Always: 'always'
+} as const;
+export type PipelineStepDefinitionWhenEnum = typeof PipelineStepDefinitionWhenEnum[keyof typeof PipelineStepDefinitionWhenEnum];
+
+
+/**
+ * Check if a given object implements the PipelineStepDefinition interface.
+ */
+export function instanceOfPipelineStepDefinition(value: object): value is PipelineStepDefinition {
+    if (!('prompt' in value) || value['prompt'] === undefined) return false;
+    return true;
+}
+
+export function PipelineStepDefinitionFromJSON(json: any): PipelineStepDefinition {
+    return PipelineStepDefinitionFromJSONTyped(json, false);
+}
+
+export function PipelineStepDefinitionFromJSONTyped(json: any, ignoreDiscriminator: boolean): PipelineStepDefinition {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'prompt': json['prompt'],
+        'mode': json['mode'] == null ? undefined : json['mode'],
+        'model': json['model'] == null ? undefined : json['model'],
+        'allowedTools': json['allowed_tools'] == null ? undefined : json['allowed_tools'],
+        'disallowedTools': json['disallowed_tools'] == null ? undefined : json['disallowed_tools'],
+        'maxTurns': json['max_turns'] == null ? undefined : json['max_turns'],
+        'maxCostUsd': json['max_cost_usd'] == null ? undefined : json['max_cost_usd'],
+        'systemPrompt': json['system_prompt'] == null ? undefined : json['system_prompt'],
+        'appendSystemPrompt': json['append_system_prompt'] == null ? undefined : json['append_system_prompt'],
+        'when': json['when'] == null ? undefined : json['when'],
+        'outputMatches': json['output_matches'] == null ? undefined : json['output_matches'],
+    };
+}
+
+export function PipelineStepDefinitionToJSON(json: any): PipelineStepDefinition {
+    return PipelineStepDefinitionToJSONTyped(json, false);
+}
+
+export function PipelineStepDefinitionToJSONTyped(value?: PipelineStepDefinition | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'prompt': value['prompt'],
+        'mode': value['mode'],
+        'model': value['model'],
+        'allowed_tools': value['allowedTools'],
+        'disallowed_tools': value['disallowedTools'],
+        'max_turns': value['maxTurns'],
+        'max_cost_usd': value['maxCostUsd'],
+        'system_prompt': value['systemPrompt'],
+        'append_system_prompt': value['appendSystemPrompt'],
+        'when': value['when'],
+        'output_matches': value['outputMatches'],
+    };
+}
+
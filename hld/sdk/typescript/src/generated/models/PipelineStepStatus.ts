@@ -0,0 +1,56 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * 
+ * @export
+ */
+export const PipelineStepStatus = {
+    Pending: 'pending',
+    Running: 'running',
+    Completed: 'completed',
+    Failed: 'failed',
+    Skipped: 'skipped'
+} as const;
+export type PipelineStepStatus = typeof PipelineStepStatus[keyof typeof PipelineStepStatus];
+
+
+export function instanceOfPipelineStepStatus(value: any): boolean {
+    for (const key in PipelineStepStatus) {
+        if (Object.prototype.hasOwnProperty.call(PipelineStepStatus, key)) {
+            if (PipelineStepStatus[key as keyof typeof PipelineStepStatus] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function PipelineStepStatusFromJSON(json: any): PipelineStepStatus {
+    return PipelineStepStatusFromJSONTyped(json, false);
+}
+
+export function PipelineStepStatusFromJSONTyped(json: any, ignoreDiscriminator: boolean): PipelineStepStatus {
+    return json as PipelineStepStatus;
+}
+
+export function PipelineStepStatusToJSON(value?: PipelineStepStatus | null): any {
+    return value as any;
+}
+
+export function PipelineStepStatusToJSONTyped(value: any, ignoreDiscriminator: boolean): PipelineStepStatus {
+    return value as PipelineStepStatus;
+}
+
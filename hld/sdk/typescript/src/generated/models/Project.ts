@@ -0,0 +1,110 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface Project
+ */
+export interface Project {
+    /**
+     * 
+     * @type {string}
+     * @memberof Project
+     */
+    id: string;
+    /**
+     * Name of the repository root directory
+     * @type {string}
+     * @memberof Project
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Project
+     */
+    rootPath: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof Project
+     */
+    sessionCount: number;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Project
+     */
+    lastActivityAt?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Project
+     */
+    createdAt: Date;
+}
+
+/**
+ * Check if a given object implements the Project interface.
+ */
+export function instanceOfProject(value: object): value is Project {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('rootPath' in value) || value['rootPath'] === undefined) return false;
+    if (!('sessionCount' in value) || value['sessionCount'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    return true;
+}
+
+export function ProjectFromJSON(json: any): Project {
+    return ProjectFromJSONTyped(json, false);
+}
+
+export function ProjectFromJSONTyped(json: any, ignoreDiscriminator: boolean): Project {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'name': json['name'],
+        'rootPath': json['root_path'],
+        'sessionCount': json['session_count'],
+        'lastActivityAt': json['last_activity_at'] == null ? undefined : (new Date(json['last_activity_at'])),
+        'createdAt': (new Date(json['created_at'])),
+    };
+}
+
+export function ProjectToJSON(json: any): Project {
+    return ProjectToJSONTyped(json, false);
+}
+
+export function ProjectToJSONTyped(value?: Project | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'root_path': value['rootPath'],
+        'session_count': value['sessionCount'],
+        'last_activity_at': value['lastActivityAt'] == null ? undefined : ((value['lastActivityAt']).toISOString()),
+        'created_at': ((value['createdAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ProjectConfigIssue
+ */
+export interface ProjectConfigIssue {
+    /**
+     * Path of the invalid field, empty for problems with the whole file
+     * @type {string}
+     * @memberof ProjectConfigIssue
+     */
+    field: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof ProjectConfigIssue
+     */
+    message: string;
+}
+
+/**
+ * Check if a given object implements the ProjectConfigIssue interface.
+ */
+export function instanceOfProjectConfigIssue(value: object): value is ProjectConfigIssue {
+    if (!('field' in value) || value['field'] === undefined) return false;
+    if (!('message' in value) || value['message'] === undefined) return false;
+    return true;
+}
+
+export function ProjectConfigIssueFromJSON(json: any): ProjectConfigIssue {
+    return ProjectConfigIssueFromJSONTyped(json, false);
+}
+
+export function ProjectConfigIssueFromJSONTyped(json: any, ignoreDiscriminator: boolean): ProjectConfigIssue {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'field': json['field'],
+        'message': json['message'],
+    };
+}
+
+export function ProjectConfigIssueToJSON(json: any): ProjectConfigIssue {
+    return ProjectConfigIssueToJSONTyped(json, false);
+}
+
+export function ProjectConfigIssueToJSONTyped(value?: ProjectConfigIssue | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'field': value['field'],
+        'message': value['message'],
+    };
+}
+
@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ProjectConfigTrust
+ */
+export interface ProjectConfigTrust {
+    /**
+     * Project configuration file the trust applies to
+     * @type {string}
+     * @memberof ProjectConfigTrust
+     */
+    path: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof ProjectConfigTrust
+     */
+    trusted: boolean;
+    /**
+     * SHA-256 of the trusted file content; editing the file revokes trust
+     * @type {string}
+     * @memberof ProjectConfigTrust
+     */
+    digest?: string;
+}
+
+/**
+ * Check if a given object implements the ProjectConfigTrust interface.
+ */
+export function instanceOfProjectConfigTrust(value: object): value is ProjectConfigTrust {
+    if (!('path' in value) || value['path'] === undefined) return false;
+    if (!('trusted' in value) || value['trusted'] === undefined) return false;
+    return true;
+}
+
+export function ProjectConfigTrustFromJSON(json: any): ProjectConfigTrust {
+    return ProjectConfigTrustFromJSONTyped(json, false);
+}
+
+export function ProjectConfigTrustFromJSONTyped(json: any, ignoreDiscriminator: boolean): ProjectConfigTrust {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'path': json['path'],
+        'trusted': json['trusted'],
+        'digest': json['digest'] == null ? undefined : json['digest'],
+    };
+}
+
+export function ProjectConfigTrustToJSON(json: any): ProjectConfigTrust {
+    return ProjectConfigTrustToJSONTyped(json, false);
+}
+
+export function ProjectConfigTrustToJSONTyped(value?: ProjectConfigTrust | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'path': value['path'],
+        'trusted': value['trusted'],
+        'digest': value['digest'],
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Project } from './Project';
+import {
+    ProjectFromJSON,
+    ProjectFromJSONTyped,
+    ProjectToJSON,
+    ProjectToJSONTyped,
+} from './Project';
+
+/**
+ * 
+ * @export
+ * @interface ProjectsResponse
+ */
+export interface ProjectsResponse {
+    /**
+     * 
+     * @type {Array<Project>}
+     * @memberof ProjectsResponse
+     */
+    data: Array<Project>;
+}
+
+/**
+ * Check if a given object implements the ProjectsResponse interface.
+ */
+export function instanceOfProjectsResponse(value: object): value is ProjectsResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function ProjectsResponseFromJSON(json: any): ProjectsResponse {
+    return ProjectsResponseFromJSONTyped(json, false);
+}
+
+export function ProjectsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ProjectsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(ProjectFromJSON)),
+    };
+}
+
+export function ProjectsResponseToJSON(json: any): ProjectsResponse {
+    return ProjectsResponseToJSONTyped(json, false);
+}
+
+export function ProjectsResponseToJSONTyped(value?: ProjectsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(ProjectToJSON)),
+    };
+}
+
@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Data of a replay_gap event
+ * @export
+ * @interface ReplayGapPayload
+ */
+export interface ReplayGapPayload {
+    /**
+     * Sequence the subscriber resumed from
+     * @type {number}
+     * @memberof ReplayGapPayload
+     */
+    since: number;
+    /**
+     * Oldest sequence still kept, 0 if none
+     * @type {number}
+     * @memberof ReplayGapPayload
+     */
+    firstSeq: number;
+}
+
+/**
+ * Check if a given object implements the ReplayGapPayload interface.
+ */
+export function instanceOfReplayGapPayload(value: object): value is ReplayGapPayload {
+    if (!('since' in value) || value['since'] === undefined) return false;
+    if (!('firstSeq' in value) || value['firstSeq'] === undefined) return false;
+    return true;
+}
+
+export function ReplayGapPayloadFromJSON(json: any): ReplayGapPayload {
+    return ReplayGapPayloadFromJSONTyped(json, false);
+}
+
+export function ReplayGapPayloadFromJSONTyped(json: any, ignoreDiscriminator: boolean): ReplayGapPayload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'since': json['since'],
+        'firstSeq': json['first_seq'],
+    };
+}
+
+export function ReplayGapPayloadToJSON(json: any): ReplayGapPayload {
+    return ReplayGapPayloadToJSONTyped(json, false);
+}
+
+export function ReplayGapPayloadToJSONTyped(value?: ReplayGapPayload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'since': value['since'],
+        'first_seq': value['firstSeq'],
+    };
+}
+
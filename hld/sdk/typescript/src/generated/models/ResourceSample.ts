@@ -0,0 +1,111 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ResourceSample
+ */
+export interface ResourceSample {
+    /**
+     * 
+     * @type {Date}
+     * @memberof ResourceSample
+     */
+    sampledAt: Date;
+    /**
+     * 
+     * @type {number}
+     * @memberof ResourceSample
+     */
+    cpuTimeMs: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ResourceSample
+     */
+    cpuPercent: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ResourceSample
+     */
+    rssBytes: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof ResourceSample
+     */
+    openFds: number;
+    /**
+     * Number of processes in the tree
+     * @type {number}
+     * @memberof ResourceSample
+     */
+    processCount: number;
+}
+
+/**
+ * Check if a given object implements the ResourceSample interface.
+ */
+export function instanceOfResourceSample(value: object): value is ResourceSample {
+    if (!('sampledAt' in value) || value['sampledAt'] === undefined) return false;
+    if (!('cpuTimeMs' in value) || value['cpuTimeMs'] === undefined) return false;
+    if (!('cpuPercent' in value) || value['cpuPercent'] === undefined) return false;
+    if (!('rssBytes' in value) || value['rssBytes'] === undefined) return false;
+    if (!('openFds' in value) || value['openFds'] === undefined) return false;
+    if (!('processCount' in value) || value['processCount'] === undefined) return false;
+    return true;
+}
+
+export function ResourceSampleFromJSON(json: any): ResourceSample {
+    return ResourceSampleFromJSONTyped(json, false);
+}
+
+export function ResourceSampleFromJSONTyped(json: any, ignoreDiscriminator: boolean): ResourceSample {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'sampledAt': (new Date(json['sampled_at'])),
+        'cpuTimeMs': json['cpu_time_ms'],
+        'cpuPercent': json['cpu_percent'],
+        'rssBytes': json['rss_bytes'],
+        'openFds': json['open_fds'],
+        'processCount': json['process_count'],
+    };
+}
+
+export function ResourceSampleToJSON(json: any): ResourceSample {
+    return ResourceSampleToJSONTyped(json, false);
+}
+
+export function ResourceSampleToJSONTyped(value?: ResourceSample | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'sampled_at': ((value['sampledAt']).toISOString()),
+        'cpu_time_ms': value['cpuTimeMs'],
+        'cpu_percent': value['cpuPercent'],
+        'rss_bytes': value['rssBytes'],
+        'open_fds': value['openFds'],
+        'process_count': value['processCount'],
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { RestoreBackupResponseData } from './RestoreBackupResponseData';
+import {
+    RestoreBackupResponseDataFromJSON,
+    RestoreBackupResponseDataFromJSONTyped,
+    RestoreBackupResponseDataToJSON,
+    RestoreBackupResponseDataToJSONTyped,
+} from './RestoreBackupResponseData';
+
+/**
+ * 
+ * @export
+ * @interface RestoreBackupResponse
+ */
+export interface RestoreBackupResponse {
+    /**
+     * 
+     * @type {RestoreBackupResponseData}
+     * @memberof RestoreBackupResponse
+     */
+    data: RestoreBackupResponseData;
+}
+
+/**
+ * Check if a given object implements the RestoreBackupResponse interface.
+ */
+export function instanceOfRestoreBackupResponse(value: object): value is RestoreBackupResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function RestoreBackupResponseFromJSON(json: any): RestoreBackupResponse {
+    return RestoreBackupResponseFromJSONTyped(json, false);
+}
+
+export function RestoreBackupResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): RestoreBackupResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': RestoreBackupResponseDataFromJSON(json['data']),
+    };
+}
+
+export function RestoreBackupResponseToJSON(json: any): RestoreBackupResponse {
+    return RestoreBackupResponseToJSONTyped(json, false);
+}
+
+export function RestoreBackupResponseToJSONTyped(value?: RestoreBackupResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': RestoreBackupResponseDataToJSON(value['data']),
+    };
+}
+
@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Backup } from './Backup';
+import {
+    BackupFromJSON,
+    BackupFromJSONTyped,
+    BackupToJSON,
+    BackupToJSONTyped,
+} from './Backup';
+
+/**
+ * 
+ * @export
+ * @interface RestoreBackupResponseData
+ */
+export interface RestoreBackupResponseData {
+    /**
+     * 
+     * @type {Backup}
+     * @memberof RestoreBackupResponseData
+     */
+    backup: Backup;
+    /**
+     * The restore takes effect when the daemon next starts
+     * @type {boolean}
+     * @memberof RestoreBackupResponseData
+     */
+    restartRequired: boolean;
+}
+
+/**
+ * Check if a given object implements the RestoreBackupResponseData interface.
+ */
+export function instanceOfRestoreBackupResponseData(value: object): value is RestoreBackupResponseData {
+    if (!('backup' in value) || value['backup'] === undefined) return false;
+    if (!('restartRequired' in value) || value['restartRequired'] === undefined) return false;
+    return true;
+}
+
+export function RestoreBackupResponseDataFromJSON(json: any): RestoreBackupResponseData {
+    return RestoreBackupResponseDataFromJSONTyped(json, false);
+}
+
+export function RestoreBackupResponseDataFromJSONTyped(json: any, ignoreDiscriminator: boolean): RestoreBackupResponseData {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'backup': BackupFromJSON(json['backup']),
+        'restartRequired': json['restart_required'],
+    };
+}
+
+export function RestoreBackupResponseDataToJSON(json: any): RestoreBackupResponseData {
+    return RestoreBackupResponseDataToJSONTyped(json, false);
+}
+
+export function RestoreBackupResponseDataToJSONTyped(value?: RestoreBackupResponseData | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'backup': BackupToJSON(value['backup']),
+        'restart_required': value['restartRequired'],
+    };
+}
+
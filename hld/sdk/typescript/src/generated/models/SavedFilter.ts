@@ -0,0 +1,110 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SessionFilter } from './SessionFilter';
+import {
+    SessionFilterFromJSON,
+    SessionFilterFromJSONTyped,
+    SessionFilterToJSON,
+    SessionFilterToJSONTyped,
+} from './SessionFilter';
+
+/**
+ * 
+ * @export
+ * @interface SavedFilter
+ */
+export interface SavedFilter {
+    /**
+     * 
+     * @type {string}
+     * @memberof SavedFilter
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SavedFilter
+     */
+    name: string;
+    /**
+     * 
+     * @type {SessionFilter}
+     * @memberof SavedFilter
+     */
+    filter: SessionFilter;
+    /**
+     * 
+     * @type {Date}
+     * @memberof SavedFilter
+     */
+    createdAt: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof SavedFilter
+     */
+    updatedAt: Date;
+}
+
+/**
+ * Check if a given object implements the SavedFilter interface.
+ */
+export function instanceOfSavedFilter(value: object): value is SavedFilter {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('filter' in value) || value['filter'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    if (!('updatedAt' in value) || value['updatedAt'] === undefined) return false;
+    return true;
+}
+
+export function SavedFilterFromJSON(json: any): SavedFilter {
+    return SavedFilterFromJSONTyped(json, false);
+}
+
+export function SavedFilterFromJSONTyped(json: any, ignoreDiscriminator: boolean): SavedFilter {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'name': json['name'],
+        'filter': SessionFilterFromJSON(json['filter']),
+        'createdAt': (new Date(json['created_at'])),
+        'updatedAt': (new Date(json['updated_at'])),
+    };
+}
+
+export function SavedFilterToJSON(json: any): SavedFilter {
+    return SavedFilterToJSONTyped(json, false);
+}
+
+export function SavedFilterToJSONTyped(value?: SavedFilter | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'filter': SessionFilterToJSON(value['filter']),
+        'created_at': ((value['createdAt']).toISOString()),
+        'updated_at': ((value['updatedAt']).toISOString()),
+    };
+}
+
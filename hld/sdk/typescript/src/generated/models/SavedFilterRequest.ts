@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SessionFilter } from './SessionFilter';
+import {
+    SessionFilterFromJSON,
+    SessionFilterFromJSONTyped,
+    SessionFilterToJSON,
+    SessionFilterToJSONTyped,
+} from './SessionFilter';
+
+/**
+ * 
+ * @export
+ * @interface SavedFilterRequest
+ */
+export interface SavedFilterRequest {
+    /**
+     * 
+     * @type {string}
+     * @memberof SavedFilterRequest
+     */
+    name: string;
+    /**
+     * 
+     * @type {SessionFilter}
+     * @memberof SavedFilterRequest
+     */
+    filter: SessionFilter;
+}
+
+/**
+ * Check if a given object implements the SavedFilterRequest interface.
+ */
+export function instanceOfSavedFilterRequest(value: object): value is SavedFilterRequest {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('filter' in value) || value['filter'] === undefined) return false;
+    return true;
+}
+
+export function SavedFilterRequestFromJSON(json: any): SavedFilterRequest {
+    return SavedFilterRequestFromJSONTyped(json, false);
+}
+
+export function SavedFilterRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): SavedFilterRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'],
+        'filter': SessionFilterFromJSON(json['filter']),
+    };
+}
+
+export function SavedFilterRequestToJSON(json: any): SavedFilterRequest {
+    return SavedFilterRequestToJSONTyped(json, false);
+}
+
+export function SavedFilterRequestToJSONTyped(value?: SavedFilterRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'filter': SessionFilterToJSON(value['filter']),
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SavedFilter } from './SavedFilter';
+import {
+    SavedFilterFromJSON,
+    SavedFilterFromJSONTyped,
+    SavedFilterToJSON,
+    SavedFilterToJSONTyped,
+} from './SavedFilter';
+
+/**
+ * 
+ * @export
+ * @interface SavedFilterResponse
+ */
+export interface SavedFilterResponse {
+    /**
+     * 
+     * @type {SavedFilter}
+     * @memberof SavedFilterResponse
+     */
+    data: SavedFilter;
+}
+
+/**
+ * Check if a given object implements the SavedFilterResponse interface.
+ */
+export function instanceOfSavedFilterResponse(value: object): value is SavedFilterResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function SavedFilterResponseFromJSON(json: any): SavedFilterResponse {
+    return SavedFilterResponseFromJSONTyped(json, false);
+}
+
+export function SavedFilterResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): SavedFilterResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': SavedFilterFromJSON(json['data']),
+    };
+}
+
+export function SavedFilterResponseToJSON(json: any): SavedFilterResponse {
+    return SavedFilterResponseToJSONTyped(json, false);
+}
+
+export function SavedFilterResponseToJSONTyped(value?: SavedFilterResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': SavedFilterToJSON(value['data']),
+    };
+}
+
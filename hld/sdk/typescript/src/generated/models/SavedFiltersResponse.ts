@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SavedFilter } from './SavedFilter';
+import {
+    SavedFilterFromJSON,
+    SavedFilterFromJSONTyped,
+    SavedFilterToJSON,
+    SavedFilterToJSONTyped,
+} from './SavedFilter';
+
+/**
+ * 
+ * @export
+ * @interface SavedFiltersResponse
+ */
+export interface SavedFiltersResponse {
+    /**
+     * 
+     * @type {Array<SavedFilter>}
+     * @memberof SavedFiltersResponse
+     */
+    data: Array<SavedFilter>;
+}
+
+/**
+ * Check if a given object implements the SavedFiltersResponse interface.
+ */
+export function instanceOfSavedFiltersResponse(value: object): value is SavedFiltersResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function SavedFiltersResponseFromJSON(json: any): SavedFiltersResponse {
+    return SavedFiltersResponseFromJSONTyped(json, false);
+}
+
+export function SavedFiltersResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): SavedFiltersResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(SavedFilterFromJSON)),
+    };
+}
+
+export function SavedFiltersResponseToJSON(json: any): SavedFiltersResponse {
+    return SavedFiltersResponseToJSONTyped(json, false);
+}
+
+export function SavedFiltersResponseToJSONTyped(value?: SavedFiltersResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(SavedFilterToJSON)),
+    };
+}
+
@@ -0,0 +1,204 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ScheduleOverlapPolicy } from './ScheduleOverlapPolicy';
+import {
+    ScheduleOverlapPolicyFromJSON,
+    ScheduleOverlapPolicyFromJSONTyped,
+    ScheduleOverlapPolicyToJSON,
+    ScheduleOverlapPolicyToJSONTyped,
+} from './ScheduleOverlapPolicy';
+import type { ScheduleCatchUpPolicy } from './ScheduleCatchUpPolicy';
+import {
+    ScheduleCatchUpPolicyFromJSON,
+    ScheduleCatchUpPolicyFromJSONTyped,
+    ScheduleCatchUpPolicyToJSON,
+    ScheduleCatchUpPolicyToJSONTyped,
+} from './ScheduleCatchUpPolicy';
+import type { ScheduleLaunchConfig } from './ScheduleLaunchConfig';
+import {
+    ScheduleLaunchConfigFromJSON,
+    ScheduleLaunchConfigFromJSONTyped,
+    ScheduleLaunchConfigToJSON,
+    ScheduleLaunchConfigToJSONTyped,
+} from './ScheduleLaunchConfig';
+
+/**
+ * 
+ * @export
+ * @interface Schedule
+ */
+export interface Schedule {
+    /**
+     * Schedule ID
+     * @type {string}
+     * @memberof Schedule
+     */
+    id: string;
+    /**
+     * Human-readable schedule name
+     * @type {string}
+     * @memberof Schedule
+     */
+    name: string;
+    /**
+     * Standard 5-field cron expression or @daily-style macro
+     * @type {string}
+     * @memberof Schedule
+     */
+    cronExpression: string;
+    /**
+     * IANA timezone the cron expression is evaluated in
+     * @type {string}
+     * @memberof Schedule
+     */
+    timezone: string;
+    /**
+     * Working directory for scheduled sessions
+     * @type {string}
+     * @memberof Schedule
+     */
+    workingDir?: string;
+    /**
+     * 
+     * @type {ScheduleLaunchConfig}
+     * @memberof Schedule
+     */
+    launchConfig: ScheduleLaunchConfig;
+    /**
+     * Whether the launch config has a proxy API key. The key itself is never returned.
+     * @type {boolean}
+     * @memberof Schedule
+     */
+    proxyApiKeySet: boolean;
+    /**
+     * 
+     * @type {ScheduleOverlapPolicy}
+     * @memberof Schedule
+     */
+    overlapPolicy: ScheduleOverlapPolicy;
+    /**
+     * 
+     * @type {ScheduleCatchUpPolicy}
+     * @memberof Schedule
+     */
+    catchUpPolicy: ScheduleCatchUpPolicy;
+    /**
+     * Whether the schedule fires
+     * @type {boolean}
+     * @memberof Schedule
+     */
+    enabled: boolean;
+    /**
+     * Next time the schedule will fire
+     * @type {Date}
+     * @memberof Schedule
+     */
+    nextRunAt?: Date;
+    /**
+     * Last time the schedule fired
+     * @type {Date}
+     * @memberof Schedule
+     */
+    lastRunAt?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Schedule
+     */
+    createdAt: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Schedule
+     */
+    updatedAt: Date;
+}
+
+
+
+/**
+ * Check if a given object implements the Schedule interface.
+ */
+export function instanceOfSchedule(value: object): value is Schedule {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('cronExpression' in value) || value['cronExpression'] === undefined) return false;
+    if (!('timezone' in value) || value['timezone'] === undefined) return false;
+    if (!('launchConfig' in value) || value['launchConfig'] === undefined) return false;
+    if (!('proxyApiKeySet' in value) || value['proxyApiKeySet'] === undefined) return false;
+    if (!('overlapPolicy' in value) || value['overlapPolicy'] === undefined) return false;
+    if (!('catchUpPolicy' in value) || value['catchUpPolicy'] === undefined) return false;
+    if (!('enabled' in value) || value['enabled'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    if (!('updatedAt' in value) || value['updatedAt'] === undefined) return false;
+    return true;
+}
+
+export function ScheduleFromJSON(json: any): Schedule {
+    return ScheduleFromJSONTyped(json, false);
+}
+
+export function ScheduleFromJSONTyped(json: any, ignoreDiscriminator: boolean): Schedule {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'name': json['name'],
+        'cronExpression': json['cron_expression'],
+        'timezone': json['timezone'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'launchConfig': ScheduleLaunchConfigFromJSON(json['launch_config']),
+        'proxyApiKeySet': json['proxy_api_key_set'],
+        'overlapPolicy': ScheduleOverlapPolicyFromJSON(json['overlap_policy']),
+        'catchUpPolicy': ScheduleCatchUpPolicyFromJSON(json['catch_up_policy']),
+        'enabled': json['enabled'],
+        'nextRunAt': json['next_run_at'] == null ? undefined : (new Date(json['next_run_at'])),
+        'lastRunAt': json['last_run_at'] == null ? undefined : (new Date(json['last_run_at'])),
+        'createdAt': (new Date(json['created_at'])),
+        'updatedAt': (new Date(json['updated_at'])),
+    };
+}
+
+export function ScheduleToJSON(json: any): Schedule {
+    return ScheduleToJSONTyped(json, false);
+}
+
+export function ScheduleToJSONTyped(value?: Schedule | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'cron_expression': value['cronExpression'],
+        'timezone': value['timezone'],
+        'working_dir': value['workingDir'],
+        'launch_config': ScheduleLaunchConfigToJSON(value['launchConfig']),
+        'proxy_api_key_set': value['proxyApiKeySet'],
+        'overlap_policy': ScheduleOverlapPolicyToJSON(value['overlapPolicy']),
+        'catch_up_policy': ScheduleCatchUpPolicyToJSON(value['catchUpPolicy']),
+        'enabled': value['enabled'],
+        'next_run_at': value['nextRunAt'] == null ? undefined : ((value['nextRunAt']).toISOString()),
+        'last_run_at': value['lastRunAt'] == null ? undefined : ((value['lastRunAt']).toISOString()),
+        'created_at': ((value['createdAt']).toISOString()),
+        'updated_at': ((value['updatedAt']).toISOString()),
+    };
+}
+
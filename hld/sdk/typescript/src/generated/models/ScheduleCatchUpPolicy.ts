@@ -0,0 +1,57 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * What to do with runs missed while the daemon was not running:
+ * - none: drop them, record a skipped run
+ * - once: launch a single run for all missed occurrences
+ * - all: launch every missed occurrence (at most 10)
+ * @export
+ */
+export const ScheduleCatchUpPolicy = {
+    None: 'none',
+    Once: 'once',
+    All: 'all'
+} as const;
+export type ScheduleCatchUpPolicy = typeof ScheduleCatchUpPolicy[keyof typeof ScheduleCatchUpPolicy];
+
+
+export function instanceOfScheduleCatchUpPolicy(value: any): boolean {
+    for (const key in ScheduleCatchUpPolicy) {
+        if (Object.prototype.hasOwnProperty.call(ScheduleCatchUpPolicy, key)) {
+            if (ScheduleCatchUpPolicy[key as keyof typeof ScheduleCatchUpPolicy] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function ScheduleCatchUpPolicyFromJSON(json: any): ScheduleCatchUpPolicy {
+    return ScheduleCatchUpPolicyFromJSONTyped(json, false);
+}
+
+export function ScheduleCatchUpPolicyFromJSONTyped(json: any, ignoreDiscriminator: boolean): ScheduleCatchUpPolicy {
+    return json as ScheduleCatchUpPolicy;
+}
+
+export function ScheduleCatchUpPolicyToJSON(value?: ScheduleCatchUpPolicy | null): any {
+    return value as any;
+}
+
+export function ScheduleCatchUpPolicyToJSONTyped(value: any, ignoreDiscriminator: boolean): ScheduleCatchUpPolicy {
+    return value as ScheduleCatchUpPolicy;
+}
+
@@ -0,0 +1,228 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { MCPConfig } from './MCPConfig';
+import {
+    MCPConfigFromJSON,
+    MCPConfigFromJSONTyped,
+    MCPConfigToJSON,
+    MCPConfigToJSONTyped,
+} from './MCPConfig';
+
+/**
+ * Launch config template used for every session a schedule creates
+ * @export
+ * @interface ScheduleLaunchConfig
+ */
+export interface ScheduleLaunchConfig {
+    /**
+     * Query sent to Claude on every run
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    query: string;
+    /**
+     * Session title. Defaults to the schedule name and run time.
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    title?: string;
+    /**
+     * Model to use for the session (opus, sonnet or haiku)
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    model?: string;
+    /**
+     * 
+     * @type {MCPConfig}
+     * @memberof ScheduleLaunchConfig
+     */
+    mcpConfig?: MCPConfig;
+    /**
+     * MCP tool for permission prompts
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    permissionPromptTool?: string;
+    /**
+     * Maximum conversation turns
+     * @type {number}
+     * @memberof ScheduleLaunchConfig
+     */
+    maxTurns?: number;
+    /**
+     * Override system prompt
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    systemPrompt?: string;
+    /**
+     * Text to append to system prompt
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    appendSystemPrompt?: string;
+    /**
+     * Whitelist of allowed tools
+     * @type {Array<string>}
+     * @memberof ScheduleLaunchConfig
+     */
+    allowedTools?: Array<string>;
+    /**
+     * Blacklist of disallowed tools
+     * @type {Array<string>}
+     * @memberof ScheduleLaunchConfig
+     */
+    disallowedTools?: Array<string>;
+    /**
+     * Additional directories Claude can access
+     * @type {Array<string>}
+     * @memberof ScheduleLaunchConfig
+     */
+    additionalDirectories?: Array<string>;
+    /**
+     * Custom instructions for Claude
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    customInstructions?: string;
+    /**
+     * Enable auto-accept for edit tools
+     * @type {boolean}
+     * @memberof ScheduleLaunchConfig
+     */
+    autoAcceptEdits?: boolean;
+    /**
+     * Launch sessions with dangerously skip permissions enabled
+     * @type {boolean}
+     * @memberof ScheduleLaunchConfig
+     */
+    dangerouslySkipPermissions?: boolean;
+    /**
+     * Optional timeout in milliseconds for dangerously skip permissions
+     * @type {number}
+     * @memberof ScheduleLaunchConfig
+     */
+    dangerouslySkipPermissionsTimeout?: number;
+    /**
+     * Enable proxy routing for scheduled sessions
+     * @type {boolean}
+     * @memberof ScheduleLaunchConfig
+     */
+    proxyEnabled?: boolean;
+    /**
+     * Base URL for proxy service
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    proxyBaseUrl?: string;
+    /**
+     * Model identifier for proxy routing
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    proxyModelOverride?: string;
+    /**
+     * API key for proxy authentication. Schedules returned by the API
+     * leave it out and set proxy_api_key_set instead, and an update
+     * without it keeps the stored key.
+     * @type {string}
+     * @memberof ScheduleLaunchConfig
+     */
+    proxyApiKey?: string;
+    /**
+     * Create the working directory if it does not exist
+     * @type {boolean}
+     * @memberof ScheduleLaunchConfig
+     */
+    createDirectoryIfNotExists?: boolean;
+}
+
+/**
+ * Check if a given object implements the ScheduleLaunchConfig interface.
+ */
+export function instanceOfScheduleLaunchConfig(value: object): value is ScheduleLaunchConfig {
+    if (!('query' in value) || value['query'] === undefined) return false;
+    return true;
+}
+
+export function ScheduleLaunchConfigFromJSON(json: any): ScheduleLaunchConfig {
+    return ScheduleLaunchConfigFromJSONTyped(json, false);
+}
+
+export function ScheduleLaunchConfigFromJSONTyped(json: any, ignoreDiscriminator: boolean): ScheduleLaunchConfig {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'query': json['query'],
+        'title': json['title'] == null ? undefined : json['title'],
+        'model': json['model'] == null ? undefined : json['model'],
+        'mcpConfig': json['mcp_config'] == null ? undefined : MCPConfigFromJSON(json['mcp_config']),
+        'permissionPromptTool': json['permission_prompt_tool'] == null ? undefined : json['permission_prompt_tool'],
+        'maxTurns': json['max_turns'] == null ? undefined : json['max_turns'],
+        'systemPrompt': json['system_prompt'] == null ? undefined : json['system_prompt'],
+        'appendSystemPrompt': json['append_system_prompt'] == null ? undefined : json['append_system_prompt'],
+        'allowedTools': json['allowed_tools'] == null ? undefined : json['allowed_tools'],
+        'disallowedTools': json['disallowed_tools'] == null ? undefined : json['disallowed_tools'],
+        'additionalDirectories': json['additional_directories'] == null ? undefined : json['additional_directories'],
+        'customInstructions': json['custom_instructions'] == null ? undefined : json['custom_instructions'],
+        'autoAcceptEdits': json['auto_accept_edits'] == null ? undefined : json['auto_accept_edits'],
+        'dangerouslySkipPermissions': json['dangerously_skip_permissions'] == null ? undefined : json['dangerously_skip_permissions'],
+        'dangerouslySkipPermissionsTimeout': json['dangerously_skip_permissions_timeout'] == null ? undefined : json['dangerously_skip_permissions_timeout'],
+        'proxyEnabled': json['proxy_enabled'] == null ? undefined : json['proxy_enabled'],
+        'proxyBaseUrl': json['proxy_base_url'] == null ? undefined : json['proxy_base_url'],
+        'proxyModelOverride': json['proxy_model_override'] == null ? undefined : json['proxy_model_override'],
+        'proxyApiKey': json['proxy_api_key'] == null ? undefined : json['proxy_api_key'],
+        'createDirectoryIfNotExists': json['create_directory_if_not_exists'] == null ? undefined : json['create_directory_if_not_exists'],
+    };
+}
+
+export function ScheduleLaunchConfigToJSON(json: any): ScheduleLaunchConfig {
+    return ScheduleLaunchConfigToJSONTyped(json, false);
+}
+
+export function ScheduleLaunchConfigToJSONTyped(value?: ScheduleLaunchConfig | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'query': value['query'],
+        'title': value['title'],
+        'model': value['model'],
+        'mcp_config': MCPConfigToJSON(value['mcpConfig']),
+        'permission_prompt_tool': value['permissionPromptTool'],
+        'max_turns': value['maxTurns'],
+        'system_prompt': value['systemPrompt'],
+        'append_system_prompt': value['appendSystemPrompt'],
+        'allowed_tools': value['allowedTools'],
+        'disallowed_tools': value['disallowedTools'],
+        'additional_directories': value['additionalDirectories'],
+        'custom_instructions': value['customInstructions'],
+        'auto_accept_edits': value['autoAcceptEdits'],
+        'dangerously_skip_permissions': value['dangerouslySkipPermissions'],
+        'dangerously_skip_permissions_timeout': value['dangerouslySkipPermissionsTimeout'],
+        'proxy_enabled': value['proxyEnabled'],
+        'proxy_base_url': value['proxyBaseUrl'],
+        'proxy_model_override': value['proxyModelOverride'],
+        'proxy_api_key': value['proxyApiKey'],
+        'create_directory_if_not_exists': value['createDirectoryIfNotExists'],
+    };
+}
+
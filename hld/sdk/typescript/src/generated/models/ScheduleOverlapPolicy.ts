@@ -0,0 +1,57 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * What to do when the schedule fires while its previous session is still active:
+ * - skip: do not launch, record a skipped run
+ * - allow: launch alongside the previous session
+ * - interrupt: interrupt the previous session, then launch
+ * @export
+ */
+export const ScheduleOverlapPolicy = {
+    Skip: 'skip',
+    Allow: 'allow',
+    Interrupt: 'interrupt'
+} as const;
+export type ScheduleOverlapPolicy = typeof ScheduleOverlapPolicy[keyof typeof ScheduleOverlapPolicy];
+
+
+export function instanceOfScheduleOverlapPolicy(value: any): boolean {
+    for (const key in ScheduleOverlapPolicy) {
+        if (Object.prototype.hasOwnProperty.call(ScheduleOverlapPolicy, key)) {
+            if (ScheduleOverlapPolicy[key as keyof typeof ScheduleOverlapPolicy] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function ScheduleOverlapPolicyFromJSON(json: any): ScheduleOverlapPolicy {
+    return ScheduleOverlapPolicyFromJSONTyped(json, false);
+}
+
+export function ScheduleOverlapPolicyFromJSONTyped(json: any, ignoreDiscriminator: boolean): ScheduleOverlapPolicy {
+    return json as ScheduleOverlapPolicy;
+}
+
+export function ScheduleOverlapPolicyToJSON(value?: ScheduleOverlapPolicy | null): any {
+    return value as any;
+}
+
+export function ScheduleOverlapPolicyToJSONTyped(value: any, ignoreDiscriminator: boolean): ScheduleOverlapPolicy {
+    return value as ScheduleOverlapPolicy;
+}
+
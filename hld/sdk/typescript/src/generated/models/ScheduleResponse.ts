@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Schedule } from './Schedule';
+import {
+    ScheduleFromJSON,
+    ScheduleFromJSONTyped,
+    ScheduleToJSON,
+    ScheduleToJSONTyped,
+} from './Schedule';
+
+/**
+ * 
+ * @export
+ * @interface ScheduleResponse
+ */
+export interface ScheduleResponse {
+    /**
+     * 
+     * @type {Schedule}
+     * @memberof ScheduleResponse
+     */
+    data: Schedule;
+}
+
+/**
+ * Check if a given object implements the ScheduleResponse interface.
+ */
+export function instanceOfScheduleResponse(value: object): value is ScheduleResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function ScheduleResponseFromJSON(json: any): ScheduleResponse {
+    return ScheduleResponseFromJSONTyped(json, false);
+}
+
+export function ScheduleResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ScheduleResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ScheduleFromJSON(json['data']),
+    };
+}
+
+export function ScheduleResponseToJSON(json: any): ScheduleResponse {
+    return ScheduleResponseToJSONTyped(json, false);
+}
+
+export function ScheduleResponseToJSONTyped(value?: ScheduleResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ScheduleToJSON(value['data']),
+    };
+}
+
@@ -0,0 +1,155 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SessionStatus } from './SessionStatus';
+import {
+    SessionStatusFromJSON,
+    SessionStatusFromJSONTyped,
+    SessionStatusToJSON,
+    SessionStatusToJSONTyped,
+} from './SessionStatus';
+
+/**
+ * 
+ * @export
+ * @interface ScheduleRun
+ */
+export interface ScheduleRun {
+    /**
+     * 
+     * @type {number}
+     * @memberof ScheduleRun
+     */
+    id: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof ScheduleRun
+     */
+    scheduleId: string;
+    /**
+     * Session created by this run (absent for skipped or failed runs)
+     * @type {string}
+     * @memberof ScheduleRun
+     */
+    sessionId?: string;
+    /**
+     * 
+     * @type {SessionStatus}
+     * @memberof ScheduleRun
+     */
+    sessionStatus?: SessionStatus;
+    /**
+     * Occurrence this run was fired for
+     * @type {Date}
+     * @memberof ScheduleRun
+     */
+    scheduledFor: Date;
+    /**
+     * 
+     * @type {string}
+     * @memberof ScheduleRun
+     */
+    status: ScheduleRunStatusEnum;
+    /**
+     * Why the run was skipped or failed
+     * @type {string}
+     * @memberof ScheduleRun
+     */
+    reason?: string;
+    /**
+     * Whether the run made up for occurrences missed during downtime
+     * @type {boolean}
+     * @memberof ScheduleRun
+     */
+    catchUp: boolean;
+    /**
+     * 
+     * @type {Date}
+     * @memberof ScheduleRun
+     */
+    createdAt: Date;
+}
+
+
+/**
+ * @export
+ */
+export const ScheduleRunStatusEnum = {
+    Launched: 'launched',
+    Skipped: 'skipped',
+    Failed: 'failed'
+} as const;
+export type ScheduleRunStatusEnum = typeof ScheduleRunStatusEnum[keyof typeof ScheduleRunStatusEnum];
+
+
+/**
+ * Check if a given object implements the ScheduleRun interface.
+ */
+export function instanceOfScheduleRun(value: object): value is ScheduleRun {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('scheduleId' in value) || value['scheduleId'] === undefined) return false;
+    if (!('scheduledFor' in value) || value['scheduledFor'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    if (!('catchUp' in value) || value['catchUp'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    return true;
+}
+
+export function ScheduleRunFromJSON(json: any): ScheduleRun {
+    return ScheduleRunFromJSONTyped(json, false);
+}
+
+export function ScheduleRunFromJSONTyped(json: any, ignoreDiscriminator: boolean): ScheduleRun {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'scheduleId': json['schedule_id'],
+        'sessionId': json['session_id'] == null ? undefined : json['session_id'],
+        'sessionStatus': json['session_status'] == null ? undefined : SessionStatusFromJSON(json['session_status']),
+        'scheduledFor': (new Date(json['scheduled_for'])),
+        'status': json['status'],
+        'reason': json['reason'] == null ? undefined : json['reason'],
+        'catchUp': json['catch_up'],
+        'createdAt': (new Date(json['created_at'])),
+    };
+}
+
+export function ScheduleRunToJSON(json: any): ScheduleRun {
+    return ScheduleRunToJSONTyped(json, false);
+}
+
+export function ScheduleRunToJSONTyped(value?: ScheduleRun | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'schedule_id': value['scheduleId'],
+        'session_id': value['sessionId'],
+        'session_status': SessionStatusToJSON(value['sessionStatus']),
+        'scheduled_for': ((value['scheduledFor']).toISOString()),
+        'status': value['status'],
+        'reason': value['reason'],
+        'catch_up': value['catchUp'],
+        'created_at': ((value['createdAt']).toISOString()),
+    };
+}
+
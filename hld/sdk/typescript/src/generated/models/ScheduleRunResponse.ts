@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ScheduleRun } from './ScheduleRun';
+import {
+    ScheduleRunFromJSON,
+    ScheduleRunFromJSONTyped,
+    ScheduleRunToJSON,
+    ScheduleRunToJSONTyped,
+} from './ScheduleRun';
+
+/**
+ * 
+ * @export
+ * @interface ScheduleRunResponse
+ */
+export interface ScheduleRunResponse {
+    /**
+     * 
+     * @type {ScheduleRun}
+     * @memberof ScheduleRunResponse
+     */
+    data: ScheduleRun;
+}
+
+/**
+ * Check if a given object implements the ScheduleRunResponse interface.
+ */
+export function instanceOfScheduleRunResponse(value: object): value is ScheduleRunResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function ScheduleRunResponseFromJSON(json: any): ScheduleRunResponse {
+    return ScheduleRunResponseFromJSONTyped(json, false);
+}
+
+export function ScheduleRunResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ScheduleRunResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ScheduleRunFromJSON(json['data']),
+    };
+}
+
+export function ScheduleRunResponseToJSON(json: any): ScheduleRunResponse {
+    return ScheduleRunResponseToJSONTyped(json, false);
+}
+
+export function ScheduleRunResponseToJSONTyped(value?: ScheduleRunResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ScheduleRunToJSON(value['data']),
+    };
+}
+
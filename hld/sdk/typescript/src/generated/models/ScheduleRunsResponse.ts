@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ScheduleRun } from './ScheduleRun';
+import {
+    ScheduleRunFromJSON,
+    ScheduleRunFromJSONTyped,
+    ScheduleRunToJSON,
+    ScheduleRunToJSONTyped,
+} from './ScheduleRun';
+
+/**
+ * 
+ * @export
+ * @interface ScheduleRunsResponse
+ */
+export interface ScheduleRunsResponse {
+    /**
+     * 
+     * @type {Array<ScheduleRun>}
+     * @memberof ScheduleRunsResponse
+     */
+    data: Array<ScheduleRun>;
+}
+
+/**
+ * Check if a given object implements the ScheduleRunsResponse interface.
+ */
+export function instanceOfScheduleRunsResponse(value: object): value is ScheduleRunsResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function ScheduleRunsResponseFromJSON(json: any): ScheduleRunsResponse {
+    return ScheduleRunsResponseFromJSONTyped(json, false);
+}
+
+export function ScheduleRunsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ScheduleRunsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(ScheduleRunFromJSON)),
+    };
+}
+
+export function ScheduleRunsResponseToJSON(json: any): ScheduleRunsResponse {
+    return ScheduleRunsResponseToJSONTyped(json, false);
+}
+
+export function ScheduleRunsResponseToJSONTyped(value?: ScheduleRunsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(ScheduleRunToJSON)),
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Schedule } from './Schedule';
+import {
+    ScheduleFromJSON,
+    ScheduleFromJSONTyped,
+    ScheduleToJSON,
+    ScheduleToJSONTyped,
+} from './Schedule';
+
+/**
+ * 
+ * @export
+ * @interface SchedulesResponse
+ */
+export interface SchedulesResponse {
+    /**
+     * 
+     * @type {Array<Schedule>}
+     * @memberof SchedulesResponse
+     */
+    data: Array<Schedule>;
+}
+
+/**
+ * Check if a given object implements the SchedulesResponse interface.
+ */
+export function instanceOfSchedulesResponse(value: object): value is SchedulesResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function SchedulesResponseFromJSON(json: any): SchedulesResponse {
+    return SchedulesResponseFromJSONTyped(json, false);
+}
+
+export function SchedulesResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): SchedulesResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(ScheduleFromJSON)),
+    };
+}
+
+export function SchedulesResponseToJSON(json: any): SchedulesResponse {
+    return SchedulesResponseToJSONTyped(json, false);
+}
+
+export function SchedulesResponseToJSONTyped(value?: SchedulesResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(ScheduleToJSON)),
+    };
+}
+
@@ -13,6 +13,13 @@
  */
 
 import { mapValues } from '../runtime';
+import type { SessionResources } from './SessionResources';
+import {
+    SessionResourcesFromJSON,
+    SessionResourcesFromJSONTyped,
+    SessionResourcesToJSON,
+    SessionResourcesToJSONTyped,
+} from './SessionResources';
 import type { SessionStatus } from './SessionStatus';
 import {
     SessionStatusFromJSON,
@@ -213,12 +220,54 @@ export interface Session {
      * @memberof Session
      */
     proxyModelOverride?: string;
+    /**
+     * Whether a proxy API key is stored. The key itself is never returned.
+     * @type {boolean}
+     * @memberof Session
+     */
+    proxyApiKeySet: boolean;
     /**
      * JSON blob of editor state for draft sessions
      * @type {string}
      * @memberof Session
      */
     editorState?: string;
+    /**
+     * Idle time in milliseconds before the session is reported as stalled. Null uses the daemon default and 0 disables the check.
+     * @type {number}
+     * @memberof Session
+     */
+    stallThresholdMs?: number;
+    /**
+     * Idle time in milliseconds before a session is interrupted, whether or not its stall is reported. Null uses the daemon default and 0 disables interrupts.
+     * @type {number}
+     * @memberof Session
+     */
+    stallInterruptThresholdMs?: number;
+    /**
+     * 
+     * @type {SessionResources}
+     * @memberof Session
+     */
+    resources?: SessionResources;
+    /**
+     * Runner backend the session was launched with. Empty means the Claude Code CLI.
+     * @type {string}
+     * @memberof Session
+     */
+    runner?: string;
+    /**
+     * Names of the labels attached to the session
+     * @type {Array<string>}
+     * @memberof Session
+     */
+    labels?: Array<string>;
+    /**
+     * Project the session's working directory belongs to
+     * @type {string}
+     * @memberof Session
+     */
+    projectId?: string;
 }
 
 
@@ -233,6 +282,7 @@ export function instanceOfSession(value: object): value is Session {
     if (!('query' in value) || value['query'] === undefined) return false;
     if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
     if (!('lastActivityAt' in value) || value['lastActivityAt'] === undefined) return false;
+    if (!('proxyApiKeySet' in value) || value['proxyApiKeySet'] === undefined) return false;
     return true;
 }
 
@@ -277,7 +327,14 @@ export function SessionFromJSONTyped(json: any, ignoreDiscriminator: boolean): S
         'proxyEnabled': json['proxy_enabled'] == null ? undefined : json['proxy_enabled'],
         'proxyBaseUrl': json['proxy_base_url'] == null ? undefined : json['proxy_base_url'],
         'proxyModelOverride': json['proxy_model_override'] == null ? undefined : json['proxy_model_override'],
+        'proxyApiKeySet': json['proxy_api_key_set'],
         'editorState': json['editor_state'] == null ? undefined : json['editor_state'],
+        'stallThresholdMs': json['stall_threshold_ms'] == null ? undefined : json['stall_threshold_ms'],
+        'stallInterruptThresholdMs': json['stall_interrupt_threshold_ms'] == null ? undefined : json['stall_interrupt_threshold_ms'],
+        'resources': json['resources'] == null ? undefined : SessionResourcesFromJSON(json['resources']),
+        'runner': json['runner'] == null ? undefined : json['runner'],
+        'labels': json['labels'] == null ? undefined : json['labels'],
+        'projectId': json['project_id'] == null ? undefined : json['project_id'],
     };
 }
 
@@ -323,7 +380,14 @@ export function SessionToJSONTyped(value?: Session | null, ignoreDiscriminator:
         'proxy_enabled': value['proxyEnabled'],
         'proxy_base_url': value['proxyBaseUrl'],
         'proxy_model_override': value['proxyModelOverride'],
+        'proxy_api_key_set': value['proxyApiKeySet'],
         'editor_state': value['editorState'],
+        'stall_threshold_ms': value['stallThresholdMs'],
+        'stall_interrupt_threshold_ms': value['stallInterruptThresholdMs'],
+        'resources': SessionResourcesToJSON(value['resources']),
+        'runner': value['runner'],
+        'labels': value['labels'],
+        'project_id': value['projectId'],
     };
 }
 
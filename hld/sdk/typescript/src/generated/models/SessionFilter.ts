@@ -0,0 +1,141 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Criteria a session must all meet. Omitted fields do not filter.
+ * @export
+ * @interface SessionFilter
+ */
+export interface SessionFilter {
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionFilter
+     */
+    kind?: SessionFilterKindEnum;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof SessionFilter
+     */
+    statuses?: Array<string>;
+    /**
+     * Sessions must carry every label
+     * @type {Array<string>}
+     * @memberof SessionFilter
+     */
+    labels?: Array<string>;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionFilter
+     */
+    projectId?: string;
+    /**
+     * Model aliases or full model IDs
+     * @type {Array<string>}
+     * @memberof SessionFilter
+     */
+    models?: Array<string>;
+    /**
+     * 
+     * @type {Date}
+     * @memberof SessionFilter
+     */
+    createdAfter?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof SessionFilter
+     */
+    createdBefore?: Date;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionFilter
+     */
+    minCostUsd?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionFilter
+     */
+    maxCostUsd?: number;
+}
+
+
+/**
+ * @export
+ */
+export const SessionFilterKindEnum = {
+    Normal: 'normal',
+    Archived: 'archived',
+    Draft: 'draft'
+} as const;
+export type SessionFilterKindEnum = typeof SessionFilterKindEnum[keyof typeof SessionFilterKindEnum];
+
+
+/**
+ * Check if a given object implements the SessionFilter interface.
+ */
+export function instanceOfSessionFilter(value: object): value is SessionFilter {
+    return true;
+}
+
+export function SessionFilterFromJSON(json: any): SessionFilter {
+    return SessionFilterFromJSONTyped(json, false);
+}
+
+export function SessionFilterFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionFilter {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'kind': json['kind'] == null ? undefined : json['kind'],
+        'statuses': json['statuses'] == null ? undefined : json['statuses'],
+        'labels': json['labels'] == null ? undefined : json['labels'],
+        'projectId': json['project_id'] == null ? undefined : json['project_id'],
+        'models': json['models'] == null ? undefined : json['models'],
+        'createdAfter': json['created_after'] == null ? undefined : (new Date(json['created_after'])),
+        'createdBefore': json['created_before'] == null ? undefined : (new Date(json['created_before'])),
+        'minCostUsd': json['min_cost_usd'] == null ? undefined : json['min_cost_usd'],
+        'maxCostUsd': json['max_cost_usd'] == null ? undefined : json['max_cost_usd'],
+    };
+}
+
+export function SessionFilterToJSON(json: any): SessionFilter {
+    return SessionFilterToJSONTyped(json, false);
+}
+
+export function SessionFilterToJSONTyped(value?: SessionFilter | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'kind': value['kind'],
+        'statuses': value['statuses'],
+        'labels': value['labels'],
+        'project_id': value['projectId'],
+        'models': value['models'],
+        'created_after': value['createdAfter'] == null ? undefined : ((value['createdAfter']).toISOString()),
+        'created_before': value['createdBefore'] == null ? undefined : ((value['createdBefore']).toISOString()),
+        'min_cost_usd': value['minCostUsd'],
+        'max_cost_usd': value['maxCostUsd'],
+    };
+}
+
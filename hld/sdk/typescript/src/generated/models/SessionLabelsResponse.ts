@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface SessionLabelsResponse
+ */
+export interface SessionLabelsResponse {
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof SessionLabelsResponse
+     */
+    data: Array<string>;
+}
+
+/**
+ * Check if a given object implements the SessionLabelsResponse interface.
+ */
+export function instanceOfSessionLabelsResponse(value: object): value is SessionLabelsResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function SessionLabelsResponseFromJSON(json: any): SessionLabelsResponse {
+    return SessionLabelsResponseFromJSONTyped(json, false);
+}
+
+export function SessionLabelsResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionLabelsResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': json['data'],
+    };
+}
+
+export function SessionLabelsResponseToJSON(json: any): SessionLabelsResponse {
+    return SessionLabelsResponseToJSONTyped(json, false);
+}
+
+export function SessionLabelsResponseToJSONTyped(value?: SessionLabelsResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': value['data'],
+    };
+}
+
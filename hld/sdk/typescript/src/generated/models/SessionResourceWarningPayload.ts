@@ -0,0 +1,114 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Data of a session_resource_warning event
+ * @export
+ * @interface SessionResourceWarningPayload
+ */
+export interface SessionResourceWarningPayload {
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionResourceWarningPayload
+     */
+    sessionId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionResourceWarningPayload
+     */
+    runId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionResourceWarningPayload
+     */
+    resource: SessionResourceWarningPayloadResourceEnum;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionResourceWarningPayload
+     */
+    value: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionResourceWarningPayload
+     */
+    limit: number;
+}
+
+
+/**
+ * @export
+ */
+export const SessionResourceWarningPayloadResourceEnum = {
+    RssBytes: 'rss_bytes',
+    OpenFds: 'open_fds',
+    CpuPercent: 'cpu_percent'
+} as const;
+export type SessionResourceWarningPayloadResourceEnum = typeof SessionResourceWarningPayloadResourceEnum[keyof typeof SessionResourceWarningPayloadResourceEnum];
+
+
+/**
+ * Check if a given object implements the SessionResourceWarningPayload interface.
+ */
+export function instanceOfSessionResourceWarningPayload(value: object): value is SessionResourceWarningPayload {
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    if (!('runId' in value) || value['runId'] === undefined) return false;
+    if (!('resource' in value) || value['resource'] === undefined) return false;
+    if (!('value' in value) || value['value'] === undefined) return false;
+    if (!('limit' in value) || value['limit'] === undefined) return false;
+    return true;
+}
+
+export function SessionResourceWarningPayloadFromJSON(json: any): SessionResourceWarningPayload {
+    return SessionResourceWarningPayloadFromJSONTyped(json, false);
+}
+
+export function SessionResourceWarningPayloadFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionResourceWarningPayload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'sessionId': json['session_id'],
+        'runId': json['run_id'],
+        'resource': json['resource'],
+        'value': json['value'],
+        'limit': json['limit'],
+    };
+}
+
+export function SessionResourceWarningPayloadToJSON(json: any): SessionResourceWarningPayload {
+    return SessionResourceWarningPayloadToJSONTyped(json, false);
+}
+
+export function SessionResourceWarningPayloadToJSONTyped(value?: SessionResourceWarningPayload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'session_id': value['sessionId'],
+        'run_id': value['runId'],
+        'resource': value['resource'],
+        'value': value['value'],
+        'limit': value['limit'],
+    };
+}
+
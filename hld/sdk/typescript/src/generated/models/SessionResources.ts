@@ -0,0 +1,129 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Latest and peak resource usage of the session's process tree, absent until first sampled
+ * @export
+ * @interface SessionResources
+ */
+export interface SessionResources {
+    /**
+     * Cumulative CPU time of the process tree
+     * @type {number}
+     * @memberof SessionResources
+     */
+    cpuTimeMs: number;
+    /**
+     * CPU use since the previous sample, 100 per fully used core
+     * @type {number}
+     * @memberof SessionResources
+     */
+    cpuPercent: number;
+    /**
+     * Resident memory of the process tree
+     * @type {number}
+     * @memberof SessionResources
+     */
+    rssBytes: number;
+    /**
+     * Open file descriptors across the process tree
+     * @type {number}
+     * @memberof SessionResources
+     */
+    openFds: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionResources
+     */
+    peakCpuPercent: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionResources
+     */
+    peakRssBytes: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionResources
+     */
+    peakOpenFds: number;
+    /**
+     * When the latest sample was taken
+     * @type {Date}
+     * @memberof SessionResources
+     */
+    sampledAt: Date;
+}
+
+/**
+ * Check if a given object implements the SessionResources interface.
+ */
+export function instanceOfSessionResources(value: object): value is SessionResources {
+    if (!('cpuTimeMs' in value) || value['cpuTimeMs'] === undefined) return false;
+    if (!('cpuPercent' in value) || value['cpuPercent'] === undefined) return false;
+    if (!('rssBytes' in value) || value['rssBytes'] === undefined) return false;
+    if (!('openFds' in value) || value['openFds'] === undefined) return false;
+    if (!('peakCpuPercent' in value) || value['peakCpuPercent'] === undefined) return false;
+    if (!('peakRssBytes' in value) || value['peakRssBytes'] === undefined) return false;
+    if (!('peakOpenFds' in value) || value['peakOpenFds'] === undefined) return false;
+    if (!('sampledAt' in value) || value['sampledAt'] === undefined) return false;
+    return true;
+}
+
+export function SessionResourcesFromJSON(json: any): SessionResources {
+    return SessionResourcesFromJSONTyped(json, false);
+}
+
+export function SessionResourcesFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionResources {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'cpuTimeMs': json['cpu_time_ms'],
+        'cpuPercent': json['cpu_percent'],
+        'rssBytes': json['rss_bytes'],
+        'openFds': json['open_fds'],
+        'peakCpuPercent': json['peak_cpu_percent'],
+        'peakRssBytes': json['peak_rss_bytes'],
+        'peakOpenFds': json['peak_open_fds'],
+        'sampledAt': (new Date(json['sampled_at'])),
+    };
+}
+
+export function SessionResourcesToJSON(json: any): SessionResources {
+    return SessionResourcesToJSONTyped(json, false);
+}
+
+export function SessionResourcesToJSONTyped(value?: SessionResources | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'cpu_time_ms': value['cpuTimeMs'],
+        'cpu_percent': value['cpuPercent'],
+        'rss_bytes': value['rssBytes'],
+        'open_fds': value['openFds'],
+        'peak_cpu_percent': value['peakCpuPercent'],
+        'peak_rss_bytes': value['peakRssBytes'],
+        'peak_open_fds': value['peakOpenFds'],
+        'sampled_at': ((value['sampledAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SessionResourcesResponseData } from './SessionResourcesResponseData';
+import {
+    SessionResourcesResponseDataFromJSON,
+    SessionResourcesResponseDataFromJSONTyped,
+    SessionResourcesResponseDataToJSON,
+    SessionResourcesResponseDataToJSONTyped,
+} from './SessionResourcesResponseData';
+
+/**
+ * 
+ * @export
+ * @interface SessionResourcesResponse
+ */
+export interface SessionResourcesResponse {
+    /**
+     * 
+     * @type {SessionResourcesResponseData}
+     * @memberof SessionResourcesResponse
+     */
+    data: SessionResourcesResponseData;
+}
+
+/**
+ * Check if a given object implements the SessionResourcesResponse interface.
+ */
+export function instanceOfSessionResourcesResponse(value: object): value is SessionResourcesResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function SessionResourcesResponseFromJSON(json: any): SessionResourcesResponse {
+    return SessionResourcesResponseFromJSONTyped(json, false);
+}
+
+export function SessionResourcesResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionResourcesResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': SessionResourcesResponseDataFromJSON(json['data']),
+    };
+}
+
+export function SessionResourcesResponseToJSON(json: any): SessionResourcesResponse {
+    return SessionResourcesResponseToJSONTyped(json, false);
+}
+
+export function SessionResourcesResponseToJSONTyped(value?: SessionResourcesResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': SessionResourcesResponseDataToJSON(value['data']),
+    };
+}
+
@@ -0,0 +1,89 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SessionResources } from './SessionResources';
+import {
+    SessionResourcesFromJSON,
+    SessionResourcesFromJSONTyped,
+    SessionResourcesToJSON,
+    SessionResourcesToJSONTyped,
+} from './SessionResources';
+import type { ResourceSample } from './ResourceSample';
+import {
+    ResourceSampleFromJSON,
+    ResourceSampleFromJSONTyped,
+    ResourceSampleToJSON,
+    ResourceSampleToJSONTyped,
+} from './ResourceSample';
+
+/**
+ * 
+ * @export
+ * @interface SessionResourcesResponseData
+ */
+export interface SessionResourcesResponseData {
+    /**
+     * 
+     * @type {SessionResources}
+     * @memberof SessionResourcesResponseData
+     */
+    resources?: SessionResources;
+    /**
+     * 
+     * @type {Array<ResourceSample>}
+     * @memberof SessionResourcesResponseData
+     */
+    samples: Array<ResourceSample>;
+}
+
+/**
+ * Check if a given object implements the SessionResourcesResponseData interface.
+ */
+export function instanceOfSessionResourcesResponseData(value: object): value is SessionResourcesResponseData {
+    if (!('samples' in value) || value['samples'] === undefined) return false;
+    return true;
+}
+
+export function SessionResourcesResponseDataFromJSON(json: any): SessionResourcesResponseData {
+    return SessionResourcesResponseDataFromJSONTyped(json, false);
+}
+
+export function SessionResourcesResponseDataFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionResourcesResponseData {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'resources': json['resources'] == null ? undefined : SessionResourcesFromJSON(json['resources']),
+        'samples': ((json['samples'] as Array<any>).map(ResourceSampleFromJSON)),
+    };
+}
+
+export function SessionResourcesResponseDataToJSON(json: any): SessionResourcesResponseData {
+    return SessionResourcesResponseDataToJSONTyped(json, false);
+}
+
+export function SessionResourcesResponseDataToJSONTyped(value?: SessionResourcesResponseData | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'resources': SessionResourcesToJSON(value['resources']),
+        'samples': ((value['samples'] as Array<any>).map(ResourceSampleToJSON)),
+    };
+}
+
@@ -0,0 +1,157 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Data of a session_settings_changed event, holding only the settings that changed
+ * @export
+ * @interface SessionSettingsChangedPayload
+ */
+export interface SessionSettingsChangedPayload {
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionSettingsChangedPayload
+     */
+    sessionId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionSettingsChangedPayload
+     */
+    runId?: string;
+    /**
+     * settings_updated when a client changed the settings
+     * @type {string}
+     * @memberof SessionSettingsChangedPayload
+     */
+    eventType?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionSettingsChangedPayload
+     */
+    reason?: SessionSettingsChangedPayloadReasonEnum;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionSettingsChangedPayload
+     */
+    title?: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof SessionSettingsChangedPayload
+     */
+    autoAcceptEdits?: boolean;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof SessionSettingsChangedPayload
+     */
+    dangerouslySkipPermissions?: boolean;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionSettingsChangedPayload
+     */
+    dangerouslySkipPermissionsTimeoutMs?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionSettingsChangedPayload
+     */
+    stallThresholdMs?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionSettingsChangedPayload
+     */
+    stallInterruptThresholdMs?: number;
+    /**
+     * When dangerous skip permissions expired, with reason expired
+     * @type {Date}
+     * @memberof SessionSettingsChangedPayload
+     */
+    expiredAt?: Date;
+}
+
+
+/**
+ * @export
+ */
+export const SessionSettingsChangedPayloadReasonEnum = {
+    Expired: 'expired',
+    AutoTitle: 'auto_title'
+} as const;
+export type SessionSettingsChangedPayloadReasonEnum = typeof SessionSettingsChangedPayloadReasonEnum[keyof typeof SessionSettingsChangedPayloadReasonEnum];
+
+
+/**
+ * Check if a given object implements the SessionSettingsChangedPayload interface.
+ */
+export function instanceOfSessionSettingsChangedPayload(value: object): value is SessionSettingsChangedPayload {
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    return true;
+}
+
+export function SessionSettingsChangedPayloadFromJSON(json: any): SessionSettingsChangedPayload {
+    return SessionSettingsChangedPayloadFromJSONTyped(json, false);
+}
+
+export function SessionSettingsChangedPayloadFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionSettingsChangedPayload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'sessionId': json['session_id'],
+        'runId': json['run_id'] == null ? undefined : json['run_id'],
+        'eventType': json['event_type'] == null ? undefined : json['event_type'],
+        'reason': json['reason'] == null ? undefined : json['reason'],
+        'title': json['title'] == null ? undefined : json['title'],
+        'autoAcceptEdits': json['auto_accept_edits'] == null ? undefined : json['auto_accept_edits'],
+        'dangerouslySkipPermissions': json['dangerously_skip_permissions'] == null ? undefined : json['dangerously_skip_permissions'],
+        'dangerouslySkipPermissionsTimeoutMs': json['dangerously_skip_permissions_timeout_ms'] == null ? undefined : json['dangerously_skip_permissions_timeout_ms'],
+        'stallThresholdMs': json['stall_threshold_ms'] == null ? undefined : json['stall_threshold_ms'],
+        'stallInterruptThresholdMs': json['stall_interrupt_threshold_ms'] == null ? undefined : json['stall_interrupt_threshold_ms'],
+        'expiredAt': json['expired_at'] == null ? undefined : (new Date(json['expired_at'])),
+    };
+}
+
+export function SessionSettingsChangedPayloadToJSON(json: any): SessionSettingsChangedPayload {
+    return SessionSettingsChangedPayloadToJSONTyped(json, false);
+}
+
+export function SessionSettingsChangedPayloadToJSONTyped(value?: SessionSettingsChangedPayload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'session_id': value['sessionId'],
+        'run_id': value['runId'],
+        'event_type': value['eventType'],
+        'reason': value['reason'],
+        'title': value['title'],
+        'auto_accept_edits': value['autoAcceptEdits'],
+        'dangerously_skip_permissions': value['dangerouslySkipPermissions'],
+        'dangerously_skip_permissions_timeout_ms': value['dangerouslySkipPermissionsTimeoutMs'],
+        'stall_threshold_ms': value['stallThresholdMs'],
+        'stall_interrupt_threshold_ms': value['stallInterruptThresholdMs'],
+        'expired_at': value['expiredAt'] == null ? undefined : ((value['expiredAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,110 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Data of a session_stalled event
+ * @export
+ * @interface SessionStalledPayload
+ */
+export interface SessionStalledPayload {
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionStalledPayload
+     */
+    sessionId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionStalledPayload
+     */
+    runId: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionStalledPayload
+     */
+    idleMs: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionStalledPayload
+     */
+    thresholdMs: number;
+    /**
+     * 
+     * @type {Date}
+     * @memberof SessionStalledPayload
+     */
+    lastActivityAt: Date;
+    /**
+     * Set when the session is interrupted automatically
+     * @type {number}
+     * @memberof SessionStalledPayload
+     */
+    interruptThresholdMs?: number;
+}
+
+/**
+ * Check if a given object implements the SessionStalledPayload interface.
+ */
+export function instanceOfSessionStalledPayload(value: object): value is SessionStalledPayload {
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    if (!('runId' in value) || value['runId'] === undefined) return false;
+    if (!('idleMs' in value) || value['idleMs'] === undefined) return false;
+    if (!('thresholdMs' in value) || value['thresholdMs'] === undefined) return false;
+    if (!('lastActivityAt' in value) || value['lastActivityAt'] === undefined) return false;
+    return true;
+}
+
+export function SessionStalledPayloadFromJSON(json: any): SessionStalledPayload {
+    return SessionStalledPayloadFromJSONTyped(json, false);
+}
+
+export function SessionStalledPayloadFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionStalledPayload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'sessionId': json['session_id'],
+        'runId': json['run_id'],
+        'idleMs': json['idle_ms'],
+        'thresholdMs': json['threshold_ms'],
+        'lastActivityAt': (new Date(json['last_activity_at'])),
+        'interruptThresholdMs': json['interrupt_threshold_ms'] == null ? undefined : json['interrupt_threshold_ms'],
+    };
+}
+
+export function SessionStalledPayloadToJSON(json: any): SessionStalledPayload {
+    return SessionStalledPayloadToJSONTyped(json, false);
+}
+
+export function SessionStalledPayloadToJSONTyped(value?: SessionStalledPayload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'session_id': value['sessionId'],
+        'run_id': value['runId'],
+        'idle_ms': value['idleMs'],
+        'threshold_ms': value['thresholdMs'],
+        'last_activity_at': ((value['lastActivityAt']).toISOString()),
+        'interrupt_threshold_ms': value['interruptThresholdMs'],
+    };
+}
+
@@ -0,0 +1,126 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Data of a session_status_changed event. A status change has both
+ * statuses, a title change only title, and a context size update the
+ * unchanged status twice with reason token_update.
+ * @export
+ * @interface SessionStatusChangedPayload
+ */
+export interface SessionStatusChangedPayload {
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionStatusChangedPayload
+     */
+    sessionId: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionStatusChangedPayload
+     */
+    runId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionStatusChangedPayload
+     */
+    parentSessionId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionStatusChangedPayload
+     */
+    oldStatus?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionStatusChangedPayload
+     */
+    newStatus?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionStatusChangedPayload
+     */
+    title?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionStatusChangedPayload
+     */
+    reason?: SessionStatusChangedPayloadReasonEnum;
+}
+
+
+/**
+ * @export
+ */
+export const SessionStatusChangedPayloadReasonEnum = {
+    TokenUpdate: 'token_update'
+} as const;
+export type SessionStatusChangedPayloadReasonEnum = typeof SessionStatusChangedPayloadReasonEnum[keyof typeof SessionStatusChangedPayloadReasonEnum];
+
+
+/**
+ * Check if a given object implements the SessionStatusChangedPayload interface.
+ */
+export function instanceOfSessionStatusChangedPayload(value: object): value is SessionStatusChangedPayload {
+    if (!('sessionId' in value) || value['sessionId'] === undefined) return false;
+    return true;
+}
+
+export function SessionStatusChangedPayloadFromJSON(json: any): SessionStatusChangedPayload {
+    return SessionStatusChangedPayloadFromJSONTyped(json, false);
+}
+
+export function SessionStatusChangedPayloadFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionStatusChangedPayload {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'sessionId': json['session_id'],
+        'runId': json['run_id'] == null ? undefined : json['run_id'],
+        'parentSessionId': json['parent_session_id'] == null ? undefined : json['parent_session_id'],
+        'oldStatus': json['old_status'] == null ? undefined : json['old_status'],
+        'newStatus': json['new_status'] == null ? undefined : json['new_status'],
+        'title': json['title'] == null ? undefined : json['title'],
+        'reason': json['reason'] == null ? undefined : json['reason'],
+    };
+}
+
+export function SessionStatusChangedPayloadToJSON(json: any): SessionStatusChangedPayload {
+    return SessionStatusChangedPayloadToJSONTyped(json, false);
+}
+
+export function SessionStatusChangedPayloadToJSONTyped(value?: SessionStatusChangedPayload | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'session_id': value['sessionId'],
+        'run_id': value['runId'],
+        'parent_session_id': value['parentSessionId'],
+        'old_status': value['oldStatus'],
+        'new_status': value['newStatus'],
+        'title': value['title'],
+        'reason': value['reason'],
+    };
+}
+
@@ -0,0 +1,287 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { TemplateVariable } from './TemplateVariable';
+import {
+    TemplateVariableFromJSON,
+    TemplateVariableFromJSONTyped,
+    TemplateVariableToJSON,
+    TemplateVariableToJSONTyped,
+} from './TemplateVariable';
+import type { TemplateMCPServer } from './TemplateMCPServer';
+import {
+    TemplateMCPServerFromJSON,
+    TemplateMCPServerFromJSONTyped,
+    TemplateMCPServerToJSON,
+    TemplateMCPServerToJSONTyped,
+} from './TemplateMCPServer';
+
+/**
+ * 
+ * @export
+ * @interface SessionTemplate
+ */
+export interface SessionTemplate {
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    id: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    description?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    query: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    title?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    model?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    workingDir?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    systemPrompt?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    appendSystemPrompt?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    customInstructions?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof SessionTemplate
+     */
+    allowedTools?: Array<string>;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof SessionTemplate
+     */
+    disallowedTools?: Array<string>;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof SessionTemplate
+     */
+    additionalDirectories?: Array<string>;
+    /**
+     * 
+     * @type {{ [key: string]: TemplateMCPServer; }}
+     * @memberof SessionTemplate
+     */
+    mcpServers?: { [key: string]: TemplateMCPServer; };
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionTemplate
+     */
+    maxTurns?: number;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof SessionTemplate
+     */
+    autoAcceptEdits?: boolean;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof SessionTemplate
+     */
+    dangerouslySkipPermissions?: boolean;
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionTemplate
+     */
+    dangerouslySkipPermissionsTimeout?: number;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof SessionTemplate
+     */
+    proxyEnabled?: boolean;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    proxyBaseUrl?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplate
+     */
+    proxyModelOverride?: string;
+    /**
+     * Whether a proxy API key is stored. The key itself is never returned.
+     * @type {boolean}
+     * @memberof SessionTemplate
+     */
+    proxyApiKeySet: boolean;
+    /**
+     * 
+     * @type {Array<TemplateVariable>}
+     * @memberof SessionTemplate
+     */
+    variables?: Array<TemplateVariable>;
+    /**
+     * Names of all placeholders used by the template
+     * @type {Array<string>}
+     * @memberof SessionTemplate
+     */
+    placeholders: Array<string>;
+    /**
+     * 
+     * @type {Date}
+     * @memberof SessionTemplate
+     */
+    createdAt: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof SessionTemplate
+     */
+    updatedAt: Date;
+}
+
+/**
+ * Check if a given object implements the SessionTemplate interface.
+ */
+export function instanceOfSessionTemplate(value: object): value is SessionTemplate {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('query' in value) || value['query'] === undefined) return false;
+    if (!('proxyApiKeySet' in value) || value['proxyApiKeySet'] === undefined) return false;
+    if (!('placeholders' in value) || value['placeholders'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    if (!('updatedAt' in value) || value['updatedAt'] === undefined) return false;
+    return true;
+}
+
+export function SessionTemplateFromJSON(json: any): SessionTemplate {
+    return SessionTemplateFromJSONTyped(json, false);
+}
+
+export function SessionTemplateFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionTemplate {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'name': json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'query': json['query'],
+        'title': json['title'] == null ? undefined : json['title'],
+        'model': json['model'] == null ? undefined : json['model'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'systemPrompt': json['system_prompt'] == null ? undefined : json['system_prompt'],
+        'appendSystemPrompt': json['append_system_prompt'] == null ? undefined : json['append_system_prompt'],
+        'customInstructions': json['custom_instructions'] == null ? undefined : json['custom_instructions'],
+        'allowedTools': json['allowed_tools'] == null ? undefined : json['allowed_tools'],
+        'disallowedTools': json['disallowed_tools'] == null ? undefined : json['disallowed_tools'],
+        'additionalDirectories': json['additional_directories'] == null ? undefined : json['additional_directories'],
+        'mcpServers': json['mcp_servers'] == null ? undefined : (mapValues(json['mcp_servers'], TemplateMCPServerFromJSON)),
+        'maxTurns': json['max_turns'] == null ? undefined : json['max_turns'],
+        'autoAcceptEdits': json['auto_accept_edits'] == null ? undefined : json['auto_accept_edits'],
+        'dangerouslySkipPermissions': json['dangerously_skip_permissions'] == null ? undefined : json['dangerously_skip_permissions'],
+        'dangerouslySkipPermissionsTimeout': json['dangerously_skip_permissions_timeout'] == null ? undefined : json['dangerously_skip_permissions_timeout'],
+        'proxyEnabled': json['proxy_enabled'] == null ? undefined : json['proxy_enabled'],
+        'proxyBaseUrl': json['proxy_base_url'] == null ? undefined : json['proxy_base_url'],
+        'proxyModelOverride': json['proxy_model_override'] == null ? undefined : json['proxy_model_override'],
+        'proxyApiKeySet': json['proxy_api_key_set'],
+        'variables': json['variables'] == null ? undefined : ((json['variables'] as Array<any>).map(TemplateVariableFromJSON)),
+        'placeholders': json['placeholders'],
+        'createdAt': (new Date(json['created_at'])),
+        'updatedAt': (new Date(json['updated_at'])),
+    };
+}
+
+export function SessionTemplateToJSON(json: any): SessionTemplate {
+    return SessionTemplateToJSONTyped(json, false);
+}
+
+export function SessionTemplateToJSONTyped(value?: SessionTemplate | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'description': value['description'],
+        'query': value['query'],
+        'title': value['title'],
+        'model': value['model'],
+        'working_dir': value['workingDir'],
+        'system_prompt': value['systemPrompt'],
+        'append_system_prompt': value['appendSystemPrompt'],
+        'custom_instructions': value['customInstructions'],
+        'allowed_tools': value['allowedTools'],
+        'disallowed_tools': value['disallowedTools'],
+        'additional_directories': value['additionalDirectories'],
+        'mcp_servers': value['mcpServers'] == null ? undefined : (mapValues(value['mcpServers'], TemplateMCPServerToJSON)),
+        'max_turns': value['maxTurns'],
+        'auto_accept_edits': value['autoAcceptEdits'],
+        'dangerously_skip_permissions': value['dangerouslySkipPermissions'],
+        'dangerously_skip_permissions_timeout': value['dangerouslySkipPermissionsTimeout'],
+        'proxy_enabled': value['proxyEnabled'],
+        'proxy_base_url': value['proxyBaseUrl'],
+        'proxy_model_override': value['proxyModelOverride'],
+        'proxy_api_key_set': value['proxyApiKeySet'],
+        'variables': value['variables'] == null ? undefined : ((value['variables'] as Array<any>).map(TemplateVariableToJSON)),
+        'placeholders': value['placeholders'],
+        'created_at': ((value['createdAt']).toISOString()),
+        'updated_at': ((value['updatedAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SessionTemplate } from './SessionTemplate';
+import {
+    SessionTemplateFromJSON,
+    SessionTemplateFromJSONTyped,
+    SessionTemplateToJSON,
+    SessionTemplateToJSONTyped,
+} from './SessionTemplate';
+
+/**
+ * 
+ * @export
+ * @interface SessionTemplateResponse
+ */
+export interface SessionTemplateResponse {
+    /**
+     * 
+     * @type {SessionTemplate}
+     * @memberof SessionTemplateResponse
+     */
+    data: SessionTemplate;
+}
+
+/**
+ * Check if a given object implements the SessionTemplateResponse interface.
+ */
+export function instanceOfSessionTemplateResponse(value: object): value is SessionTemplateResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function SessionTemplateResponseFromJSON(json: any): SessionTemplateResponse {
+    return SessionTemplateResponseFromJSONTyped(json, false);
+}
+
+export function SessionTemplateResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionTemplateResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': SessionTemplateFromJSON(json['data']),
+    };
+}
+
+export function SessionTemplateResponseToJSON(json: any): SessionTemplateResponse {
+    return SessionTemplateResponseToJSONTyped(json, false);
+}
+
+export function SessionTemplateResponseToJSONTyped(value?: SessionTemplateResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': SessionTemplateToJSON(value['data']),
+    };
+}
+
@@ -0,0 +1,250 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { TemplateVariable } from './TemplateVariable';
+import {
+    TemplateVariableFromJSON,
+    TemplateVariableFromJSONTyped,
+    TemplateVariableToJSON,
+    TemplateVariableToJSONTyped,
+} from './TemplateVariable';
+import type { TemplateMCPServer } from './TemplateMCPServer';
+import {
+    TemplateMCPServerFromJSON,
+    TemplateMCPServerFromJSONTyped,
+    TemplateMCPServerToJSON,
+    TemplateMCPServerToJSONTyped,
+} from './TemplateMCPServer';
+
+/**
+ * 
+ * @export
+ * @interface SessionTemplateSpec
+ */
+export interface SessionTemplateSpec {
+    /**
+     * Unique template name
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    description?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    query: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    title?: string;
+    /**
+     * Claude model (opus, sonnet or haiku)
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    model?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    workingDir?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    systemPrompt?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    appendSystemPrompt?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    customInstructions?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof SessionTemplateSpec
+     */
+    allowedTools?: Array<string>;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof SessionTemplateSpec
+     */
+    disallowedTools?: Array<string>;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof SessionTemplateSpec
+     */
+    additionalDirectories?: Array<string>;
+    /**
+     * 
+     * @type {{ [key: string]: TemplateMCPServer; }}
+     * @memberof SessionTemplateSpec
+     */
+    mcpServers?: { [key: string]: TemplateMCPServer; };
+    /**
+     * 
+     * @type {number}
+     * @memberof SessionTemplateSpec
+     */
+    maxTurns?: number;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof SessionTemplateSpec
+     */
+    autoAcceptEdits?: boolean;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof SessionTemplateSpec
+     */
+    dangerouslySkipPermissions?: boolean;
+    /**
+     * Timeout in milliseconds
+     * @type {number}
+     * @memberof SessionTemplateSpec
+     */
+    dangerouslySkipPermissionsTimeout?: number;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof SessionTemplateSpec
+     */
+    proxyEnabled?: boolean;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    proxyBaseUrl?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    proxyModelOverride?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof SessionTemplateSpec
+     */
+    proxyApiKey?: string;
+    /**
+     * Declared variables. Undeclared placeholders are required variables.
+     * @type {Array<TemplateVariable>}
+     * @memberof SessionTemplateSpec
+     */
+    variables?: Array<TemplateVariable>;
+}
+
+/**
+ * Check if a given object implements the SessionTemplateSpec interface.
+ */
+export function instanceOfSessionTemplateSpec(value: object): value is SessionTemplateSpec {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('query' in value) || value['query'] === undefined) return false;
+    return true;
+}
+
+export function SessionTemplateSpecFromJSON(json: any): SessionTemplateSpec {
+    return SessionTemplateSpecFromJSONTyped(json, false);
+}
+
+export function SessionTemplateSpecFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionTemplateSpec {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'query': json['query'],
+        'title': json['title'] == null ? undefined : json['title'],
+        'model': json['model'] == null ? undefined : json['model'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'systemPrompt': json['system_prompt'] == null ? undefined : json['system_prompt'],
+        'appendSystemPrompt': json['append_system_prompt'] == null ? undefined : json['append_system_prompt'],
+        'customInstructions': json['custom_instructions'] == null ? undefined : json['custom_instructions'],
+        'allowedTools': json['allowed_tools'] == null ? undefined : json['allowed_tools'],
+        'disallowedTools': json['disallowed_tools'] == null ? undefined : json['disallowed_tools'],
+        'additionalDirectories': json['additional_directories'] == null ? undefined : json['additional_directories'],
+        'mcpServers': json['mcp_servers'] == null ? undefined : (mapValues(json['mcp_servers'], TemplateMCPServerFromJSON)),
+        'maxTurns': json['max_turns'] == null ? undefined : json['max_turns'],
+        'autoAcceptEdits': json['auto_accept_edits'] == null ? undefined : json['auto_accept_edits'],
+        'dangerouslySkipPermissions': json['dangerously_skip_permissions'] == null ? undefined : json['dangerously_skip_permissions'],
+        'dangerouslySkipPermissionsTimeout': json['dangerously_skip_permissions_timeout'] == null ? undefined : json['dangerously_skip_permissions_timeout'],
+        'proxyEnabled': json['proxy_enabled'] == null ? undefined : json['proxy_enabled'],
+        'proxyBaseUrl': json['proxy_base_url'] == null ? undefined : json['proxy_base_url'],
+        'proxyModelOverride': json['proxy_model_override'] == null ? undefined : json['proxy_model_override'],
+        'proxyApiKey': json['proxy_api_key'] == null ? undefined : json['proxy_api_key'],
+        'variables': json['variables'] == null ? undefined : ((json['variables'] as Array<any>).map(TemplateVariableFromJSON)),
+    };
+}
+
+export function SessionTemplateSpecToJSON(json: any): SessionTemplateSpec {
+    return SessionTemplateSpecToJSONTyped(json, false);
+}
+
+export function SessionTemplateSpecToJSONTyped(value?: SessionTemplateSpec | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'description': value['description'],
+        'query': value['query'],
+        'title': value['title'],
+        'model': value['model'],
+        'working_dir': value['workingDir'],
+        'system_prompt': value['systemPrompt'],
+        'append_system_prompt': value['appendSystemPrompt'],
+        'custom_instructions': value['customInstructions'],
+        'allowed_tools': value['allowedTools'],
+        'disallowed_tools': value['disallowedTools'],
+        'additional_directories': value['additionalDirectories'],
+        'mcp_servers': value['mcpServers'] == null ? undefined : (mapValues(value['mcpServers'], TemplateMCPServerToJSON)),
+        'max_turns': value['maxTurns'],
+        'auto_accept_edits': value['autoAcceptEdits'],
+        'dangerously_skip_permissions': value['dangerouslySkipPermissions'],
+        'dangerously_skip_permissions_timeout': value['dangerouslySkipPermissionsTimeout'],
+        'proxy_enabled': value['proxyEnabled'],
+        'proxy_base_url': value['proxyBaseUrl'],
+        'proxy_model_override': value['proxyModelOverride'],
+        'proxy_api_key': value['proxyApiKey'],
+        'variables': value['variables'] == null ? undefined : ((value['variables'] as Array<any>).map(TemplateVariableToJSON)),
+    };
+}
+
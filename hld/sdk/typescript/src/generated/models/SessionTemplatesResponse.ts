@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { SessionTemplate } from './SessionTemplate';
+import {
+    SessionTemplateFromJSON,
+    SessionTemplateFromJSONTyped,
+    SessionTemplateToJSON,
+    SessionTemplateToJSONTyped,
+} from './SessionTemplate';
+
+/**
+ * 
+ * @export
+ * @interface SessionTemplatesResponse
+ */
+export interface SessionTemplatesResponse {
+    /**
+     * 
+     * @type {Array<SessionTemplate>}
+     * @memberof SessionTemplatesResponse
+     */
+    data: Array<SessionTemplate>;
+}
+
+/**
+ * Check if a given object implements the SessionTemplatesResponse interface.
+ */
+export function instanceOfSessionTemplatesResponse(value: object): value is SessionTemplatesResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function SessionTemplatesResponseFromJSON(json: any): SessionTemplatesResponse {
+    return SessionTemplatesResponseFromJSONTyped(json, false);
+}
+
+export function SessionTemplatesResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): SessionTemplatesResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(SessionTemplateFromJSON)),
+    };
+}
+
+export function SessionTemplatesResponseToJSON(json: any): SessionTemplatesResponse {
+    return SessionTemplatesResponseToJSONTyped(json, false);
+}
+
+export function SessionTemplatesResponseToJSONTyped(value?: SessionTemplatesResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(SessionTemplateToJSON)),
+    };
+}
+
@@ -46,6 +46,12 @@ export interface SessionsResponse {
      * @memberof SessionsResponse
      */
     counts?: SessionsResponseCounts;
+    /**
+     * Cursor for the next page, present only when more sessions remain
+     * @type {string}
+     * @memberof SessionsResponse
+     */
+    nextCursor?: string;
 }
 
 /**
@@ -68,6 +74,7 @@ export function SessionsResponseFromJSONTyped(json: any, ignoreDiscriminator: bo
         
         'data': ((json['data'] as Array<any>).map(SessionFromJSON)),
         'counts': json['counts'] == null ? undefined : SessionsResponseCountsFromJSON(json['counts']),
+        'nextCursor': json['next_cursor'] == null ? undefined : json['next_cursor'],
     };
 }
 
@@ -84,6 +91,7 @@ export function SessionsResponseToJSONTyped(value?: SessionsResponse | null, ign
         
         'data': ((value['data'] as Array<any>).map(SessionToJSON)),
         'counts': SessionsResponseCountsToJSON(value['counts']),
+        'next_cursor': value['nextCursor'],
     };
 }
 
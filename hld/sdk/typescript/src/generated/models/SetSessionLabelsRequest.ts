@@ -0,0 +1,66 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface SetSessionLabelsRequest
+ */
+export interface SetSessionLabelsRequest {
+    /**
+     * Label names replacing the session's current labels
+     * @type {Array<string>}
+     * @memberof SetSessionLabelsRequest
+     */
+    labels: Array<string>;
+}
+
+/**
+ * Check if a given object implements the SetSessionLabelsRequest interface.
+ */
+export function instanceOfSetSessionLabelsRequest(value: object): value is SetSessionLabelsRequest {
+    if (!('labels' in value) || value['labels'] === undefined) return false;
+    return true;
+}
+
+export function SetSessionLabelsRequestFromJSON(json: any): SetSessionLabelsRequest {
+    return SetSessionLabelsRequestFromJSONTyped(json, false);
+}
+
+export function SetSessionLabelsRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): SetSessionLabelsRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'labels': json['labels'],
+    };
+}
+
+export function SetSessionLabelsRequestToJSON(json: any): SetSessionLabelsRequest {
+    return SetSessionLabelsRequestToJSONTyped(json, false);
+}
+
+export function SetSessionLabelsRequestToJSONTyped(value?: SetSessionLabelsRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'labels': value['labels'],
+    };
+}
+
@@ -0,0 +1,89 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { PipelineDefinition } from './PipelineDefinition';
+import {
+    PipelineDefinitionFromJSON,
+    PipelineDefinitionFromJSONTyped,
+    PipelineDefinitionToJSON,
+    PipelineDefinitionToJSONTyped,
+} from './PipelineDefinition';
+
+/**
+ * 
+ * @export
+ * @interface StartPipelineRunRequest
+ */
+export interface StartPipelineRunRequest {
+    /**
+     * 
+     * @type {PipelineDefinition}
+     * @memberof StartPipelineRunRequest
+     */
+    definition?: PipelineDefinition;
+    /**
+     * Pipeline definition as YAML or JSON text, used when definition is absent
+     * @type {string}
+     * @memberof StartPipelineRunRequest
+     */
+    definitionYaml?: string;
+    /**
+     * Overrides the definition's working directory
+     * @type {string}
+     * @memberof StartPipelineRunRequest
+     */
+    workingDir?: string;
+}
+
+/**
+ * Check if a given object implements the StartPipelineRunRequest interface.
+ */
+export function instanceOfStartPipelineRunRequest(value: object): value is StartPipelineRunRequest {
+    return true;
+}
+
+export function StartPipelineRunRequestFromJSON(json: any): StartPipelineRunRequest {
+    return StartPipelineRunRequestFromJSONTyped(json, false);
+}
+
+export function StartPipelineRunRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): StartPipelineRunRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'definition': json['definition'] == null ? undefined : PipelineDefinitionFromJSON(json['definition']),
+        'definitionYaml': json['definition_yaml'] == null ? undefined : json['definition_yaml'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+    };
+}
+
+export function StartPipelineRunRequestToJSON(json: any): StartPipelineRunRequest {
+    return StartPipelineRunRequestToJSONTyped(json, false);
+}
+
+export function StartPipelineRunRequestToJSONTyped(value?: StartPipelineRunRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'definition': PipelineDefinitionToJSON(value['definition']),
+        'definition_yaml': value['definitionYaml'],
+        'working_dir': value['workingDir'],
+    };
+}
+
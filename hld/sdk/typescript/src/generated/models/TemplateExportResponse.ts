@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { TemplateExportResponseData } from './TemplateExportResponseData';
+import {
+    TemplateExportResponseDataFromJSON,
+    TemplateExportResponseDataFromJSONTyped,
+    TemplateExportResponseDataToJSON,
+    TemplateExportResponseDataToJSONTyped,
+} from './TemplateExportResponseData';
+
+/**
+ * 
+ * @export
+ * @interface TemplateExportResponse
+ */
+export interface TemplateExportResponse {
+    /**
+     * 
+     * @type {TemplateExportResponseData}
+     * @memberof TemplateExportResponse
+     */
+    data: TemplateExportResponseData;
+}
+
+/**
+ * Check if a given object implements the TemplateExportResponse interface.
+ */
+export function instanceOfTemplateExportResponse(value: object): value is TemplateExportResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function TemplateExportResponseFromJSON(json: any): TemplateExportResponse {
+    return TemplateExportResponseFromJSONTyped(json, false);
+}
+
+export function TemplateExportResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): TemplateExportResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': TemplateExportResponseDataFromJSON(json['data']),
+    };
+}
+
+export function TemplateExportResponseToJSON(json: any): TemplateExportResponse {
+    return TemplateExportResponseToJSONTyped(json, false);
+}
+
+export function TemplateExportResponseToJSONTyped(value?: TemplateExportResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': TemplateExportResponseDataToJSON(value['data']),
+    };
+}
+
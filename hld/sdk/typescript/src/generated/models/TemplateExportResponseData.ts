@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface TemplateExportResponseData
+ */
+export interface TemplateExportResponseData {
+    /**
+     * 
+     * @type {string}
+     * @memberof TemplateExportResponseData
+     */
+    filename: string;
+    /**
+     * YAML template file contents
+     * @type {string}
+     * @memberof TemplateExportResponseData
+     */
+    content: string;
+}
+
+/**
+ * Check if a given object implements the TemplateExportResponseData interface.
+ */
+export function instanceOfTemplateExportResponseData(value: object): value is TemplateExportResponseData {
+    if (!('filename' in value) || value['filename'] === undefined) return false;
+    if (!('content' in value) || value['content'] === undefined) return false;
+    return true;
+}
+
+export function TemplateExportResponseDataFromJSON(json: any): TemplateExportResponseData {
+    return TemplateExportResponseDataFromJSONTyped(json, false);
+}
+
+export function TemplateExportResponseDataFromJSONTyped(json: any, ignoreDiscriminator: boolean): TemplateExportResponseData {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'filename': json['filename'],
+        'content': json['content'],
+    };
+}
+
+export function TemplateExportResponseDataToJSON(json: any): TemplateExportResponseData {
+    return TemplateExportResponseDataToJSONTyped(json, false);
+}
+
+export function TemplateExportResponseDataToJSONTyped(value?: TemplateExportResponseData | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'filename': value['filename'],
+        'content': value['content'],
+    };
+}
+
@@ -0,0 +1,105 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface TemplateMCPServer
+ */
+export interface TemplateMCPServer {
+    /**
+     * 
+     * @type {string}
+     * @memberof TemplateMCPServer
+     */
+    command?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof TemplateMCPServer
+     */
+    args?: Array<string>;
+    /**
+     * 
+     * @type {{ [key: string]: string; }}
+     * @memberof TemplateMCPServer
+     */
+    env?: { [key: string]: string; };
+    /**
+     * Server type (http for HTTP servers, omit for stdio)
+     * @type {string}
+     * @memberof TemplateMCPServer
+     */
+    type?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof TemplateMCPServer
+     */
+    url?: string;
+    /**
+     * 
+     * @type {{ [key: string]: string; }}
+     * @memberof TemplateMCPServer
+     */
+    headers?: { [key: string]: string; };
+}
+
+/**
+ * Check if a given object implements the TemplateMCPServer interface.
+ */
+export function instanceOfTemplateMCPServer(value: object): value is TemplateMCPServer {
+    return true;
+}
+
+export function TemplateMCPServerFromJSON(json: any): TemplateMCPServer {
+    return TemplateMCPServerFromJSONTyped(json, false);
+}
+
+export function TemplateMCPServerFromJSONTyped(json: any, ignoreDiscriminator: boolean): TemplateMCPServer {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'command': json['command'] == null ? undefined : json['command'],
+        'args': json['args'] == null ? undefined : json['args'],
+        'env': json['env'] == null ? undefined : json['env'],
+        'type': json['type'] == null ? undefined : json['type'],
+        'url': json['url'] == null ? undefined : json['url'],
+        'headers': json['headers'] == null ? undefined : json['headers'],
+    };
+}
+
+export function TemplateMCPServerToJSON(json: any): TemplateMCPServer {
+    return TemplateMCPServerToJSONTyped(json, false);
+}
+
+export function TemplateMCPServerToJSONTyped(value?: TemplateMCPServer | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'command': value['command'],
+        'args': value['args'],
+        'env': value['env'],
+        'type': value['type'],
+        'url': value['url'],
+        'headers': value['headers'],
+    };
+}
+
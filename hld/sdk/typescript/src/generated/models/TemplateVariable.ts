@@ -0,0 +1,90 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface TemplateVariable
+ */
+export interface TemplateVariable {
+    /**
+     * 
+     * @type {string}
+     * @memberof TemplateVariable
+     */
+    name: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof TemplateVariable
+     */
+    description?: string;
+    /**
+     * Value used when the launch request does not supply one
+     * @type {string}
+     * @memberof TemplateVariable
+     */
+    _default?: string;
+    /**
+     * Whether the launch request must supply a value when there is no default
+     * @type {boolean}
+     * @memberof TemplateVariable
+     */
+    required?: boolean;
+}
+
+/**
+ * Check if a given object implements the TemplateVariable interface.
+ */
+export function instanceOfTemplateVariable(value: object): value is TemplateVariable {
+    if (!('name' in value) || value['name'] === undefined) return false;
+    return true;
+}
+
+export function TemplateVariableFromJSON(json: any): TemplateVariable {
+    return TemplateVariableFromJSONTyped(json, false);
+}
+
+export function TemplateVariableFromJSONTyped(json: any, ignoreDiscriminator: boolean): TemplateVariable {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+        '_default': json['default'] == null ? undefined : json['default'],
+        'required': json['required'] == null ? undefined : json['required'],
+    };
+}
+
+export function TemplateVariableToJSON(json: any): TemplateVariable {
+    return TemplateVariableToJSONTyped(json, false);
+}
+
+export function TemplateVariableToJSONTyped(value?: TemplateVariable | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'description': value['description'],
+        'default': value['_default'],
+        'required': value['required'],
+    };
+}
+
@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * Half-open range of character (Unicode code point) offsets
+ * @export
+ * @interface TextRange
+ */
+export interface TextRange {
+    /**
+     * 
+     * @type {number}
+     * @memberof TextRange
+     */
+    start: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof TextRange
+     */
+    end: number;
+}
+
+/**
+ * Check if a given object implements the TextRange interface.
+ */
+export function instanceOfTextRange(value: object): value is TextRange {
+    if (!('start' in value) || value['start'] === undefined) return false;
+    if (!('end' in value) || value['end'] === undefined) return false;
+    return true;
+}
+
+export function TextRangeFromJSON(json: any): TextRange {
+    return TextRangeFromJSONTyped(json, false);
+}
+
+export function TextRangeFromJSONTyped(json: any, ignoreDiscriminator: boolean): TextRange {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'start': json['start'],
+        'end': json['end'],
+    };
+}
+
+export function TextRangeToJSON(json: any): TextRange {
+    return TextRangeToJSONTyped(json, false);
+}
+
+export function TextRangeToJSONTyped(value?: TextRange | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'start': value['start'],
+        'end': value['end'],
+    };
+}
+
@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface TrustProjectConfigRequest
+ */
+export interface TrustProjectConfigRequest {
+    /**
+     * Project directory whose configuration is trusted
+     * @type {string}
+     * @memberof TrustProjectConfigRequest
+     */
+    workingDir: string;
+    /**
+     * True to trust the file as it is now, false to revoke trust
+     * @type {boolean}
+     * @memberof TrustProjectConfigRequest
+     */
+    trusted: boolean;
+}
+
+/**
+ * Check if a given object implements the TrustProjectConfigRequest interface.
+ */
+export function instanceOfTrustProjectConfigRequest(value: object): value is TrustProjectConfigRequest {
+    if (!('workingDir' in value) || value['workingDir'] === undefined) return false;
+    if (!('trusted' in value) || value['trusted'] === undefined) return false;
+    return true;
+}
+
+export function TrustProjectConfigRequestFromJSON(json: any): TrustProjectConfigRequest {
+    return TrustProjectConfigRequestFromJSONTyped(json, false);
+}
+
+export function TrustProjectConfigRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): TrustProjectConfigRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'workingDir': json['working_dir'],
+        'trusted': json['trusted'],
+    };
+}
+
+export function TrustProjectConfigRequestToJSON(json: any): TrustProjectConfigRequest {
+    return TrustProjectConfigRequestToJSONTyped(json, false);
+}
+
+export function TrustProjectConfigRequestToJSONTyped(value?: TrustProjectConfigRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'working_dir': value['workingDir'],
+        'trusted': value['trusted'],
+    };
+}
+
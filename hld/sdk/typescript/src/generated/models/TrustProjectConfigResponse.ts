@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ProjectConfigTrust } from './ProjectConfigTrust';
+import {
+    ProjectConfigTrustFromJSON,
+    ProjectConfigTrustFromJSONTyped,
+    ProjectConfigTrustToJSON,
+    ProjectConfigTrustToJSONTyped,
+} from './ProjectConfigTrust';
+
+/**
+ * 
+ * @export
+ * @interface TrustProjectConfigResponse
+ */
+export interface TrustProjectConfigResponse {
+    /**
+     * 
+     * @type {ProjectConfigTrust}
+     * @memberof TrustProjectConfigResponse
+     */
+    data: ProjectConfigTrust;
+}
+
+/**
+ * Check if a given object implements the TrustProjectConfigResponse interface.
+ */
+export function instanceOfTrustProjectConfigResponse(value: object): value is TrustProjectConfigResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function TrustProjectConfigResponseFromJSON(json: any): TrustProjectConfigResponse {
+    return TrustProjectConfigResponseFromJSONTyped(json, false);
+}
+
+export function TrustProjectConfigResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): TrustProjectConfigResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ProjectConfigTrustFromJSON(json['data']),
+    };
+}
+
+export function TrustProjectConfigResponseToJSON(json: any): TrustProjectConfigResponse {
+    return TrustProjectConfigResponseToJSONTyped(json, false);
+}
+
+export function TrustProjectConfigResponseToJSONTyped(value?: TrustProjectConfigResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ProjectConfigTrustToJSON(value['data']),
+    };
+}
+
@@ -0,0 +1,145 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ScheduleOverlapPolicy } from './ScheduleOverlapPolicy';
+import {
+    ScheduleOverlapPolicyFromJSON,
+    ScheduleOverlapPolicyFromJSONTyped,
+    ScheduleOverlapPolicyToJSON,
+    ScheduleOverlapPolicyToJSONTyped,
+} from './ScheduleOverlapPolicy';
+import type { ScheduleCatchUpPolicy } from './ScheduleCatchUpPolicy';
+import {
+    ScheduleCatchUpPolicyFromJSON,
+    ScheduleCatchUpPolicyFromJSONTyped,
+    ScheduleCatchUpPolicyToJSON,
+    ScheduleCatchUpPolicyToJSONTyped,
+} from './ScheduleCatchUpPolicy';
+import type { ScheduleLaunchConfig } from './ScheduleLaunchConfig';
+import {
+    ScheduleLaunchConfigFromJSON,
+    ScheduleLaunchConfigFromJSONTyped,
+    ScheduleLaunchConfigToJSON,
+    ScheduleLaunchConfigToJSONTyped,
+} from './ScheduleLaunchConfig';
+
+/**
+ * 
+ * @export
+ * @interface UpdateScheduleRequest
+ */
+export interface UpdateScheduleRequest {
+    /**
+     * 
+     * @type {string}
+     * @memberof UpdateScheduleRequest
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof UpdateScheduleRequest
+     */
+    cronExpression?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof UpdateScheduleRequest
+     */
+    timezone?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof UpdateScheduleRequest
+     */
+    workingDir?: string;
+    /**
+     * 
+     * @type {ScheduleLaunchConfig}
+     * @memberof UpdateScheduleRequest
+     */
+    launchConfig?: ScheduleLaunchConfig;
+    /**
+     * 
+     * @type {ScheduleOverlapPolicy}
+     * @memberof UpdateScheduleRequest
+     */
+    overlapPolicy?: ScheduleOverlapPolicy;
+    /**
+     * 
+     * @type {ScheduleCatchUpPolicy}
+     * @memberof UpdateScheduleRequest
+     */
+    catchUpPolicy?: ScheduleCatchUpPolicy;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof UpdateScheduleRequest
+     */
+    enabled?: boolean;
+}
+
+
+
+/**
+ * Check if a given object implements the UpdateScheduleRequest interface.
+ */
+export function instanceOfUpdateScheduleRequest(value: object): value is UpdateScheduleRequest {
+    return true;
+}
+
+export function UpdateScheduleRequestFromJSON(json: any): UpdateScheduleRequest {
+    return UpdateScheduleRequestFromJSONTyped(json, false);
+}
+
+export function UpdateScheduleRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): UpdateScheduleRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'cronExpression': json['cron_expression'] == null ? undefined : json['cron_expression'],
+        'timezone': json['timezone'] == null ? undefined : json['timezone'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'launchConfig': json['launch_config'] == null ? undefined : ScheduleLaunchConfigFromJSON(json['launch_config']),
+        'overlapPolicy': json['overlap_policy'] == null ? undefined : ScheduleOverlapPolicyFromJSON(json['overlap_policy']),
+        'catchUpPolicy': json['catch_up_policy'] == null ? undefined : ScheduleCatchUpPolicyFromJSON(json['catch_up_policy']),
+        'enabled': json['enabled'] == null ? undefined : json['enabled'],
+    };
+}
+
+export function UpdateScheduleRequestToJSON(json: any): UpdateScheduleRequest {
+    return UpdateScheduleRequestToJSONTyped(json, false);
+}
+
+export function UpdateScheduleRequestToJSONTyped(value?: UpdateScheduleRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'cron_expression': value['cronExpression'],
+        'timezone': value['timezone'],
+        'working_dir': value['workingDir'],
+        'launch_config': ScheduleLaunchConfigToJSON(value['launchConfig']),
+        'overlap_policy': ScheduleOverlapPolicyToJSON(value['overlapPolicy']),
+        'catch_up_policy': ScheduleCatchUpPolicyToJSON(value['catchUpPolicy']),
+        'enabled': value['enabled'],
+    };
+}
+
@@ -99,6 +99,18 @@ export interface UpdateSessionRequest {
      * @memberof UpdateSessionRequest
      */
     additionalDirectories?: Array<string>;
+    /**
+     * Idle time in milliseconds before the session is reported as stalled. Null uses the daemon default and 0 disables the check.
+     * @type {number}
+     * @memberof UpdateSessionRequest
+     */
+    stallThresholdMs?: number;
+    /**
+     * Idle time in milliseconds before a session is interrupted, whether or not its stall is reported. Null uses the daemon default and 0 disables interrupts.
+     * @type {number}
+     * @memberof UpdateSessionRequest
+     */
+    stallInterruptThresholdMs?: number;
     /**
      * Update the working directory for the session
      * @type {string}
@@ -150,6 +162,8 @@ export function UpdateSessionRequestFromJSONTyped(json: any, ignoreDiscriminator
         'proxyModelOverride': json['proxy_model_override'] == null ? undefined : json['proxy_model_override'],
         'proxyApiKey': json['proxy_api_key'] == null ? undefined : json['proxy_api_key'],
         'additionalDirectories': json['additional_directories'] == null ? undefined : json['additional_directories'],
+        'stallThresholdMs': json['stall_threshold_ms'] == null ? undefined : json['stall_threshold_ms'],
+        'stallInterruptThresholdMs': json['stall_interrupt_threshold_ms'] == null ? undefined : json['stall_interrupt_threshold_ms'],
         'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
         'editorState': json['editor_state'] == null ? undefined : json['editor_state'],
         'status': json['status'] == null ? undefined : SessionStatusFromJSON(json['status']),
@@ -179,6 +193,8 @@ export function UpdateSessionRequestToJSONTyped(value?: UpdateSessionRequest | n
         'proxy_model_override': value['proxyModelOverride'],
         'proxy_api_key': value['proxyApiKey'],
         'additional_directories': value['additionalDirectories'],
+        'stall_threshold_ms': value['stallThresholdMs'],
+        'stall_interrupt_threshold_ms': value['stallInterruptThresholdMs'],
         'working_dir': value['workingDir'],
         'editor_state': value['editorState'],
         'status': SessionStatusToJSON(value['status']),
@@ -13,6 +13,14 @@
  */
 
 import { mapValues } from '../runtime';
+import type { AutoTitleMode } from './AutoTitleMode';
+import {
+    AutoTitleModeFromJSON,
+    AutoTitleModeFromJSONTyped,
+    AutoTitleModeToJSON,
+    AutoTitleModeToJSONTyped,
+} from './AutoTitleMode';
+
 /**
  * 
  * @export
@@ -31,8 +39,46 @@ export interface UpdateUserSettingsRequest {
      * @memberof UpdateUserSettingsRequest
      */
     optInTelemetry?: boolean;
+    /**
+     * 
+     * @type {AutoTitleMode}
+     * @memberof UpdateUserSettingsRequest
+     */
+    autoTitleMode?: AutoTitleMode;
+    /**
+     * Model used when auto_title_mode is model. Empty uses haiku.
+     * @type {string}
+     * @memberof UpdateUserSettingsRequest
+     */
+    autoTitleModel?: string;
+    /**
+     * Days to keep raw stream events. 0 keeps them forever.
+     * @type {number}
+     * @memberof UpdateUserSettingsRequest
+     */
+    rawEventRetentionDays?: number;
+    /**
+     * Days after their last activity to delete archived sessions. 0 keeps them forever.
+     * @type {number}
+     * @memberof UpdateUserSettingsRequest
+     */
+    archivedSessionRetentionDays?: number;
+    /**
+     * Days to keep file snapshots. 0 keeps them forever.
+     * @type {number}
+     * @memberof UpdateUserSettingsRequest
+     */
+    fileSnapshotRetentionDays?: number;
+    /**
+     * Drop file snapshots identical to the next snapshot of the same file
+     * @type {boolean}
+     * @memberof UpdateUserSettingsRequest
+     */
+    dedupeFileSnapshots?: boolean;
 }
 
+
+
 /**
  * Check if a given object implements the UpdateUserSettingsRequest interface.
  */
@@ -52,6 +98,12 @@ export function UpdateUserSettingsRequestFromJSONTyped(json: any, ignoreDiscrimi
         
         'advancedProviders': json['advanced_providers'] == null ? undefined : json['advanced_providers'],
         'optInTelemetry': json['opt_in_telemetry'] == null ? undefined : json['opt_in_telemetry'],
+        'autoTitleMode': json['auto_title_mode'] == null ? undefined : AutoTitleModeFromJSON(json['auto_title_mode']),
+        'autoTitleModel': json['auto_title_model'] == null ? undefined : json['auto_title_model'],
+        'rawEventRetentionDays': json['raw_event_retention_days'] == null ? undefined : json['raw_event_retention_days'],
+        'archivedSessionRetentionDays': json['archived_session_retention_days'] == null ? undefined : json['archived_session_retention_days'],
+        'fileSnapshotRetentionDays': json['file_snapshot_retention_days'] == null ? undefined : json['file_snapshot_retention_days'],
+        'dedupeFileSnapshots': json['dedupe_file_snapshots'] == null ? undefined : json['dedupe_file_snapshots'],
     };
 }
 
@@ -68,6 +120,12 @@ export function UpdateUserSettingsRequestToJSONTyped(value?: UpdateUserSettingsR
         
         'advanced_providers': value['advancedProviders'],
         'opt_in_telemetry': value['optInTelemetry'],
+        'auto_title_mode': AutoTitleModeToJSON(value['autoTitleMode']),
+        'auto_title_model': value['autoTitleModel'],
+        'raw_event_retention_days': value['rawEventRetentionDays'],
+        'archived_session_retention_days': value['archivedSessionRetentionDays'],
+        'file_snapshot_retention_days': value['fileSnapshotRetentionDays'],
+        'dedupe_file_snapshots': value['dedupeFileSnapshots'],
     };
 }
 
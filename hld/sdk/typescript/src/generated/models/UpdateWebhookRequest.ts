@@ -0,0 +1,123 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { WebhookEventType } from './WebhookEventType';
+import {
+    WebhookEventTypeFromJSON,
+    WebhookEventTypeFromJSONTyped,
+    WebhookEventTypeToJSON,
+    WebhookEventTypeToJSONTyped,
+} from './WebhookEventType';
+
+/**
+ * 
+ * @export
+ * @interface UpdateWebhookRequest
+ */
+export interface UpdateWebhookRequest {
+    /**
+     * 
+     * @type {string}
+     * @memberof UpdateWebhookRequest
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof UpdateWebhookRequest
+     */
+    url?: string;
+    /**
+     * New signing secret
+     * @type {string}
+     * @memberof UpdateWebhookRequest
+     */
+    secret?: string;
+    /**
+     * 
+     * @type {Array<WebhookEventType>}
+     * @memberof UpdateWebhookRequest
+     */
+    eventTypes?: Array<WebhookEventType>;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof UpdateWebhookRequest
+     */
+    labels?: Array<string>;
+    /**
+     * 
+     * @type {string}
+     * @memberof UpdateWebhookRequest
+     */
+    workingDir?: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof UpdateWebhookRequest
+     */
+    enabled?: boolean;
+}
+
+
+
+/**
+ * Check if a given object implements the UpdateWebhookRequest interface.
+ */
+export function instanceOfUpdateWebhookRequest(value: object): value is UpdateWebhookRequest {
+    return true;
+}
+
+export function UpdateWebhookRequestFromJSON(json: any): UpdateWebhookRequest {
+    return UpdateWebhookRequestFromJSONTyped(json, false);
+}
+
+export function UpdateWebhookRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): UpdateWebhookRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'url': json['url'] == null ? undefined : json['url'],
+        'secret': json['secret'] == null ? undefined : json['secret'],
+        'eventTypes': json['event_types'] == null ? undefined : ((json['event_types'] as Array<any>).map(WebhookEventTypeFromJSON)),
+        'labels': json['labels'] == null ? undefined : json['labels'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'enabled': json['enabled'] == null ? undefined : json['enabled'],
+    };
+}
+
+export function UpdateWebhookRequestToJSON(json: any): UpdateWebhookRequest {
+    return UpdateWebhookRequestToJSONTyped(json, false);
+}
+
+export function UpdateWebhookRequestToJSONTyped(value?: UpdateWebhookRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'name': value['name'],
+        'url': value['url'],
+        'secret': value['secret'],
+        'event_types': value['eventTypes'] == null ? undefined : ((value['eventTypes'] as Array<any>).map(WebhookEventTypeToJSON)),
+        'labels': value['labels'],
+        'working_dir': value['workingDir'],
+        'enabled': value['enabled'],
+    };
+}
+
@@ -13,6 +13,14 @@
  */
 
 import { mapValues } from '../runtime';
+import type { AutoTitleMode } from './AutoTitleMode';
+import {
+    AutoTitleModeFromJSON,
+    AutoTitleModeFromJSONTyped,
+    AutoTitleModeToJSON,
+    AutoTitleModeToJSONTyped,
+} from './AutoTitleMode';
+
 /**
  * 
  * @export
@@ -31,6 +39,42 @@ export interface UserSettings {
      * @memberof UserSettings
      */
     optInTelemetry?: boolean;
+    /**
+     * 
+     * @type {AutoTitleMode}
+     * @memberof UserSettings
+     */
+    autoTitleMode: AutoTitleMode;
+    /**
+     * Model used when auto_title_mode is model. Empty uses haiku.
+     * @type {string}
+     * @memberof UserSettings
+     */
+    autoTitleModel: string;
+    /**
+     * Days to keep raw stream events. 0 keeps them forever.
+     * @type {number}
+     * @memberof UserSettings
+     */
+    rawEventRetentionDays: number;
+    /**
+     * Days after their last activity to delete archived sessions. 0 keeps them forever.
+     * @type {number}
+     * @memberof UserSettings
+     */
+    archivedSessionRetentionDays: number;
+    /**
+     * Days to keep file snapshots. 0 keeps them forever.
+     * @type {number}
+     * @memberof UserSettings
+     */
+    fileSnapshotRetentionDays: number;
+    /**
+     * Drop file snapshots identical to the next snapshot of the same file
+     * @type {boolean}
+     * @memberof UserSettings
+     */
+    dedupeFileSnapshots: boolean;
     /**
      * 
      * @type {Date}
@@ -45,11 +89,19 @@ export interface UserSettings {
     updatedAt: Date;
 }
 
+
+
 /**
  * Check if a given object implements the UserSettings interface.
  */
 export function instanceOfUserSettings(value: object): value is UserSettings {
     if (!('advancedProviders' in value) || value['advancedProviders'] === undefined) return false;
+    if (!('autoTitleMode' in value) || value['autoTitleMode'] === undefined) return false;
+    if (!('autoTitleModel' in value) || value['autoTitleModel'] === undefined) return false;
+    if (!('rawEventRetentionDays' in value) || value['rawEventRetentionDays'] === undefined) return false;
+    if (!('archivedSessionRetentionDays' in value) || value['archivedSessionRetentionDays'] === undefined) return false;
+    if (!('fileSnapshotRetentionDays' in value) || value['fileSnapshotRetentionDays'] === undefined) return false;
+    if (!('dedupeFileSnapshots' in value) || value['dedupeFileSnapshots'] === undefined) return false;
     if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
     if (!('updatedAt' in value) || value['updatedAt'] === undefined) return false;
     return true;
@@ -67,6 +119,12 @@ export function UserSettingsFromJSONTyped(json: any, ignoreDiscriminator: boolea
         
         'advancedProviders': json['advanced_providers'],
         'optInTelemetry': json['opt_in_telemetry'] == null ? undefined : json['opt_in_telemetry'],
+        'autoTitleMode': AutoTitleModeFromJSON(json['auto_title_mode']),
+        'autoTitleModel': json['auto_title_model'],
+        'rawEventRetentionDays': json['raw_event_retention_days'],
+        'archivedSessionRetentionDays': json['archived_session_retention_days'],
+        'fileSnapshotRetentionDays': json['file_snapshot_retention_days'],
+        'dedupeFileSnapshots': json['dedupe_file_snapshots'],
         'createdAt': (new Date(json['created_at'])),
         'updatedAt': (new Date(json['updated_at'])),
     };
@@ -85,6 +143,12 @@ export function UserSettingsToJSONTyped(value?: UserSettings | null, ignoreDiscr
         
         'advanced_providers': value['advancedProviders'],
         'opt_in_telemetry': value['optInTelemetry'],
+        'auto_title_mode': AutoTitleModeToJSON(value['autoTitleMode']),
+        'auto_title_model': value['autoTitleModel'],
+        'raw_event_retention_days': value['rawEventRetentionDays'],
+        'archived_session_retention_days': value['archivedSessionRetentionDays'],
+        'file_snapshot_retention_days': value['fileSnapshotRetentionDays'],
+        'dedupe_file_snapshots': value['dedupeFileSnapshots'],
         'created_at': ((value['createdAt']).toISOString()),
         'updated_at': ((value['updatedAt']).toISOString()),
     };
@@ -0,0 +1,57 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * incremental returns free pages to the filesystem in small steps and
+ * only works once the database uses incremental auto-vacuum. full
+ * rebuilds the file, blocking writes while it runs, and switches the
+ * database to incremental auto-vacuum.
+ * @export
+ */
+export const VacuumMode = {
+    None: 'none',
+    Incremental: 'incremental',
+    Full: 'full'
+} as const;
+export type VacuumMode = typeof VacuumMode[keyof typeof VacuumMode];
+
+
+export function instanceOfVacuumMode(value: any): boolean {
+    for (const key in VacuumMode) {
+        if (Object.prototype.hasOwnProperty.call(VacuumMode, key)) {
+            if (VacuumMode[key as keyof typeof VacuumMode] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function VacuumModeFromJSON(json: any): VacuumMode {
+    return VacuumModeFromJSONTyped(json, false);
+}
+
+export function VacuumModeFromJSONTyped(json: any, ignoreDiscriminator: boolean): VacuumMode {
+    return json as VacuumMode;
+}
+
+export function VacuumModeToJSON(value?: VacuumMode | null): any {
+    return value as any;
+}
+
+export function VacuumModeToJSONTyped(value: any, ignoreDiscriminator: boolean): VacuumMode {
+    return value as VacuumMode;
+}
+
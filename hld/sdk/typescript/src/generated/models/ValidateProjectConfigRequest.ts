@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ValidateProjectConfigRequest
+ */
+export interface ValidateProjectConfigRequest {
+    /**
+     * Project directory whose configuration is validated
+     * @type {string}
+     * @memberof ValidateProjectConfigRequest
+     */
+    workingDir: string;
+    /**
+     * Configuration to validate instead of the file on disk
+     * @type {string}
+     * @memberof ValidateProjectConfigRequest
+     */
+    content?: string;
+}
+
+/**
+ * Check if a given object implements the ValidateProjectConfigRequest interface.
+ */
+export function instanceOfValidateProjectConfigRequest(value: object): value is ValidateProjectConfigRequest {
+    if (!('workingDir' in value) || value['workingDir'] === undefined) return false;
+    return true;
+}
+
+export function ValidateProjectConfigRequestFromJSON(json: any): ValidateProjectConfigRequest {
+    return ValidateProjectConfigRequestFromJSONTyped(json, false);
+}
+
+export function ValidateProjectConfigRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): ValidateProjectConfigRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'workingDir': json['working_dir'],
+        'content': json['content'] == null ? undefined : json['content'],
+    };
+}
+
+export function ValidateProjectConfigRequestToJSON(json: any): ValidateProjectConfigRequest {
+    return ValidateProjectConfigRequestToJSONTyped(json, false);
+}
+
+export function ValidateProjectConfigRequestToJSONTyped(value?: ValidateProjectConfigRequest | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'working_dir': value['workingDir'],
+        'content': value['content'],
+    };
+}
+
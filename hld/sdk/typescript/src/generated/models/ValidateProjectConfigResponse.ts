@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ValidateProjectConfigResponseData } from './ValidateProjectConfigResponseData';
+import {
+    ValidateProjectConfigResponseDataFromJSON,
+    ValidateProjectConfigResponseDataFromJSONTyped,
+    ValidateProjectConfigResponseDataToJSON,
+    ValidateProjectConfigResponseDataToJSONTyped,
+} from './ValidateProjectConfigResponseData';
+
+/**
+ * 
+ * @export
+ * @interface ValidateProjectConfigResponse
+ */
+export interface ValidateProjectConfigResponse {
+    /**
+     * 
+     * @type {ValidateProjectConfigResponseData}
+     * @memberof ValidateProjectConfigResponse
+     */
+    data: ValidateProjectConfigResponseData;
+}
+
+/**
+ * Check if a given object implements the ValidateProjectConfigResponse interface.
+ */
+export function instanceOfValidateProjectConfigResponse(value: object): value is ValidateProjectConfigResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function ValidateProjectConfigResponseFromJSON(json: any): ValidateProjectConfigResponse {
+    return ValidateProjectConfigResponseFromJSONTyped(json, false);
+}
+
+export function ValidateProjectConfigResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): ValidateProjectConfigResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ValidateProjectConfigResponseDataFromJSON(json['data']),
+    };
+}
+
+export function ValidateProjectConfigResponseToJSON(json: any): ValidateProjectConfigResponse {
+    return ValidateProjectConfigResponseToJSONTyped(json, false);
+}
+
+export function ValidateProjectConfigResponseToJSONTyped(value?: ValidateProjectConfigResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ValidateProjectConfigResponseDataToJSON(value['data']),
+    };
+}
+
@@ -0,0 +1,110 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ProjectConfigIssue } from './ProjectConfigIssue';
+import {
+    ProjectConfigIssueFromJSON,
+    ProjectConfigIssueFromJSONTyped,
+    ProjectConfigIssueToJSON,
+    ProjectConfigIssueToJSONTyped,
+} from './ProjectConfigIssue';
+
+/**
+ * 
+ * @export
+ * @interface ValidateProjectConfigResponseData
+ */
+export interface ValidateProjectConfigResponseData {
+    /**
+     * Where the project configuration is read from
+     * @type {string}
+     * @memberof ValidateProjectConfigResponseData
+     */
+    path: string;
+    /**
+     * Whether a configuration file exists at path
+     * @type {boolean}
+     * @memberof ValidateProjectConfigResponseData
+     */
+    _exists: boolean;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof ValidateProjectConfigResponseData
+     */
+    valid: boolean;
+    /**
+     * Whether the file on disk is trusted as it is
+     * @type {boolean}
+     * @memberof ValidateProjectConfigResponseData
+     */
+    trusted: boolean;
+    /**
+     * 
+     * @type {Array<ProjectConfigIssue>}
+     * @memberof ValidateProjectConfigResponseData
+     */
+    issues: Array<ProjectConfigIssue>;
+}
+
+/**
+ * Check if a given object implements the ValidateProjectConfigResponseData interface.
+ */
+export function instanceOfValidateProjectConfigResponseData(value: object): value is ValidateProjectConfigResponseData {
+    if (!('path' in value) || value['path'] === undefined) return false;
+    if (!('_exists' in value) || value['_exists'] === undefined) return false;
+    if (!('valid' in value) || value['valid'] === undefined) return false;
+    if (!('trusted' in value) || value['trusted'] === undefined) return false;
+    if (!('issues' in value) || value['issues'] === undefined) return false;
+    return true;
+}
+
+export function ValidateProjectConfigResponseDataFromJSON(json: any): ValidateProjectConfigResponseData {
+    return ValidateProjectConfigResponseDataFromJSONTyped(json, false);
+}
+
+export function ValidateProjectConfigResponseDataFromJSONTyped(json: any, ignoreDiscriminator: boolean): ValidateProjectConfigResponseData {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'path': json['path'],
+        '_exists': json['exists'],
+        'valid': json['valid'],
+        'trusted': json['trusted'],
+        'issues': ((json['issues'] as Array<any>).map(ProjectConfigIssueFromJSON)),
+    };
+}
+
+export function ValidateProjectConfigResponseDataToJSON(json: any): ValidateProjectConfigResponseData {
+    return ValidateProjectConfigResponseDataToJSONTyped(json, false);
+}
+
+export function ValidateProjectConfigResponseDataToJSONTyped(value?: ValidateProjectConfigResponseData | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'path': value['path'],
+        'exists': value['_exists'],
+        'valid': value['valid'],
+        'trusted': value['trusted'],
+        'issues': ((value['issues'] as Array<any>).map(ProjectConfigIssueToJSON)),
+    };
+}
+
@@ -0,0 +1,155 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { WebhookEventType } from './WebhookEventType';
+import {
+    WebhookEventTypeFromJSON,
+    WebhookEventTypeFromJSONTyped,
+    WebhookEventTypeToJSON,
+    WebhookEventTypeToJSONTyped,
+} from './WebhookEventType';
+
+/**
+ * 
+ * @export
+ * @interface Webhook
+ */
+export interface Webhook {
+    /**
+     * Webhook ID
+     * @type {string}
+     * @memberof Webhook
+     */
+    id: string;
+    /**
+     * Human-readable webhook name
+     * @type {string}
+     * @memberof Webhook
+     */
+    name: string;
+    /**
+     * URL events are POSTed to
+     * @type {string}
+     * @memberof Webhook
+     */
+    url: string;
+    /**
+     * Signing secret, only returned when the webhook is created
+     * @type {string}
+     * @memberof Webhook
+     */
+    secret?: string;
+    /**
+     * Event types to deliver; empty means all
+     * @type {Array<WebhookEventType>}
+     * @memberof Webhook
+     */
+    eventTypes: Array<WebhookEventType>;
+    /**
+     * Only deliver events of sessions with all of these labels
+     * @type {Array<string>}
+     * @memberof Webhook
+     */
+    labels: Array<string>;
+    /**
+     * Only deliver events of sessions in this directory or below it
+     * @type {string}
+     * @memberof Webhook
+     */
+    workingDir?: string;
+    /**
+     * Whether events are delivered
+     * @type {boolean}
+     * @memberof Webhook
+     */
+    enabled: boolean;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Webhook
+     */
+    createdAt: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Webhook
+     */
+    updatedAt: Date;
+}
+
+
+
+/**
+ * Check if a given object implements the Webhook interface.
+ */
+export function instanceOfWebhook(value: object): value is Webhook {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('name' in value) || value['name'] === undefined) return false;
+    if (!('url' in value) || value['url'] === undefined) return false;
+    if (!('eventTypes' in value) || value['eventTypes'] === undefined) return false;
+    if (!('labels' in value) || value['labels'] === undefined) return false;
+    if (!('enabled' in value) || value['enabled'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    if (!('updatedAt' in value) || value['updatedAt'] === undefined) return false;
+    return true;
+}
+
+export function WebhookFromJSON(json: any): Webhook {
+    return WebhookFromJSONTyped(json, false);
+}
+
+export function WebhookFromJSONTyped(json: any, ignoreDiscriminator: boolean): Webhook {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'name': json['name'],
+        'url': json['url'],
+        'secret': json['secret'] == null ? undefined : json['secret'],
+        'eventTypes': ((json['event_types'] as Array<any>).map(WebhookEventTypeFromJSON)),
+        'labels': json['labels'],
+        'workingDir': json['working_dir'] == null ? undefined : json['working_dir'],
+        'enabled': json['enabled'],
+        'createdAt': (new Date(json['created_at'])),
+        'updatedAt': (new Date(json['updated_at'])),
+    };
+}
+
+export function WebhookToJSON(json: any): Webhook {
+    return WebhookToJSONTyped(json, false);
+}
+
+export function WebhookToJSONTyped(value?: Webhook | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'url': value['url'],
+        'secret': value['secret'],
+        'event_types': ((value['eventTypes'] as Array<any>).map(WebhookEventTypeToJSON)),
+        'labels': value['labels'],
+        'working_dir': value['workingDir'],
+        'enabled': value['enabled'],
+        'created_at': ((value['createdAt']).toISOString()),
+        'updated_at': ((value['updatedAt']).toISOString()),
+    };
+}
+
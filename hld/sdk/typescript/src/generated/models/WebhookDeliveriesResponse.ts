@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { WebhookDelivery } from './WebhookDelivery';
+import {
+    WebhookDeliveryFromJSON,
+    WebhookDeliveryFromJSONTyped,
+    WebhookDeliveryToJSON,
+    WebhookDeliveryToJSONTyped,
+} from './WebhookDelivery';
+
+/**
+ * 
+ * @export
+ * @interface WebhookDeliveriesResponse
+ */
+export interface WebhookDeliveriesResponse {
+    /**
+     * 
+     * @type {Array<WebhookDelivery>}
+     * @memberof WebhookDeliveriesResponse
+     */
+    data: Array<WebhookDelivery>;
+}
+
+/**
+ * Check if a given object implements the WebhookDeliveriesResponse interface.
+ */
+export function instanceOfWebhookDeliveriesResponse(value: object): value is WebhookDeliveriesResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function WebhookDeliveriesResponseFromJSON(json: any): WebhookDeliveriesResponse {
+    return WebhookDeliveriesResponseFromJSONTyped(json, false);
+}
+
+export function WebhookDeliveriesResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): WebhookDeliveriesResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(WebhookDeliveryFromJSON)),
+    };
+}
+
+export function WebhookDeliveriesResponseToJSON(json: any): WebhookDeliveriesResponse {
+    return WebhookDeliveriesResponseToJSONTyped(json, false);
+}
+
+export function WebhookDeliveriesResponseToJSONTyped(value?: WebhookDeliveriesResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(WebhookDeliveryToJSON)),
+    };
+}
+
@@ -0,0 +1,180 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { WebhookEventType } from './WebhookEventType';
+import {
+    WebhookEventTypeFromJSON,
+    WebhookEventTypeFromJSONTyped,
+    WebhookEventTypeToJSON,
+    WebhookEventTypeToJSONTyped,
+} from './WebhookEventType';
+
+/**
+ * 
+ * @export
+ * @interface WebhookDelivery
+ */
+export interface WebhookDelivery {
+    /**
+     * Delivery ID, sent in the X-HumanLayer-Delivery header
+     * @type {number}
+     * @memberof WebhookDelivery
+     */
+    id: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof WebhookDelivery
+     */
+    webhookId: string;
+    /**
+     * 
+     * @type {WebhookEventType}
+     * @memberof WebhookDelivery
+     */
+    eventType: WebhookEventType;
+    /**
+     * Sequence number of the event the delivery was made from
+     * @type {number}
+     * @memberof WebhookDelivery
+     */
+    eventSeq?: number;
+    /**
+     * JSON body posted to the webhook
+     * @type {{ [key: string]: any; }}
+     * @memberof WebhookDelivery
+     */
+    payload: { [key: string]: any; };
+    /**
+     * 
+     * @type {string}
+     * @memberof WebhookDelivery
+     */
+    status: WebhookDeliveryStatusEnum;
+    /**
+     * Number of attempts made so far
+     * @type {number}
+     * @memberof WebhookDelivery
+     */
+    attempts: number;
+    /**
+     * When a pending delivery is tried next
+     * @type {Date}
+     * @memberof WebhookDelivery
+     */
+    nextAttemptAt?: Date;
+    /**
+     * HTTP status of the last attempt, absent if there was no response
+     * @type {number}
+     * @memberof WebhookDelivery
+     */
+    responseStatus?: number;
+    /**
+     * Why the last attempt failed
+     * @type {string}
+     * @memberof WebhookDelivery
+     */
+    error?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof WebhookDelivery
+     */
+    createdAt: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof WebhookDelivery
+     */
+    deliveredAt?: Date;
+}
+
+
+/**
+ * @export
+ */
+export const WebhookDeliveryStatusEnum = {
+    Pending: 'pending',
+    Delivered: 'delivered',
+    Failed: 'failed'
+} as const;
+export type WebhookDeliveryStatusEnum = typeof WebhookDeliveryStatusEnum[keyof typeof WebhookDeliveryStatusEnum];
+
+
+/**
+ * Check if a given object implements the WebhookDelivery interface.
+ */
+export function instanceOfWebhookDelivery(value: object): value is WebhookDelivery {
+    if (!('id' in value) || value['id'] === undefined) return false;
+    if (!('webhookId' in value) || value['webhookId'] === undefined) return false;
+    if (!('eventType' in value) || value['eventType'] === undefined) return false;
+    if (!('payload' in value) || value['payload'] === undefined) return false;
+    if (!('status' in value) || value['status'] === undefined) return false;
+    if (!('attempts' in value) || value['attempts'] === undefined) return false;
+    if (!('createdAt' in value) || value['createdAt'] === undefined) return false;
+    return true;
+}
+
+export function WebhookDeliveryFromJSON(json: any): WebhookDelivery {
+    return WebhookDeliveryFromJSONTyped(json, false);
+}
+
+export function WebhookDeliveryFromJSONTyped(json: any, ignoreDiscriminator: boolean): WebhookDelivery {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'],
+        'webhookId': json['webhook_id'],
+        'eventType': WebhookEventTypeFromJSON(json['event_type']),
+        'eventSeq': json['event_seq'] == null ? undefined : json['event_seq'],
+        'payload': json['payload'],
+        'status': json['status'],
+        'attempts': json['attempts'],
+        'nextAttemptAt': json['next_attempt_at'] == null ? undefined : (new Date(json['next_attempt_at'])),
+        'responseStatus': json['response_status'] == null ? undefined : json['response_status'],
+        'error': json['error'] == null ? undefined : json['error'],
+        'createdAt': (new Date(json['created_at'])),
+        'deliveredAt': json['delivered_at'] == null ? undefined : (new Date(json['delivered_at'])),
+    };
+}
+
+export function WebhookDeliveryToJSON(json: any): WebhookDelivery {
+    return WebhookDeliveryToJSONTyped(json, false);
+}
+
+export function WebhookDeliveryToJSONTyped(value?: WebhookDelivery | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'id': value['id'],
+        'webhook_id': value['webhookId'],
+        'event_type': WebhookEventTypeToJSON(value['eventType']),
+        'event_seq': value['eventSeq'],
+        'payload': value['payload'],
+        'status': value['status'],
+        'attempts': value['attempts'],
+        'next_attempt_at': value['nextAttemptAt'] == null ? undefined : ((value['nextAttemptAt']).toISOString()),
+        'response_status': value['responseStatus'],
+        'error': value['error'],
+        'created_at': ((value['createdAt']).toISOString()),
+        'delivered_at': value['deliveredAt'] == null ? undefined : ((value['deliveredAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,57 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+
+/**
+ * Type of event delivered to webhooks
+ * @export
+ */
+export const WebhookEventType = {
+    NewApproval: 'new_approval',
+    ApprovalResolved: 'approval_resolved',
+    SessionCompleted: 'session_completed',
+    SessionFailed: 'session_failed',
+    SessionStalled: 'session_stalled',
+    BudgetExceeded: 'budget_exceeded'
+} as const;
+export type WebhookEventType = typeof WebhookEventType[keyof typeof WebhookEventType];
+
+
+export function instanceOfWebhookEventType(value: any): boolean {
+    for (const key in WebhookEventType) {
+        if (Object.prototype.hasOwnProperty.call(WebhookEventType, key)) {
+            if (WebhookEventType[key as keyof typeof WebhookEventType] === value) {
+                return true;
+            }
+        }
+    }
+    return false;
+}
+
+export function WebhookEventTypeFromJSON(json: any): WebhookEventType {
+    return WebhookEventTypeFromJSONTyped(json, false);
+}
+
+export function WebhookEventTypeFromJSONTyped(json: any, ignoreDiscriminator: boolean): WebhookEventType {
+    return json as WebhookEventType;
+}
+
+export function WebhookEventTypeToJSON(value?: WebhookEventType | null): any {
+    return value as any;
+}
+
+export function WebhookEventTypeToJSONTyped(value: any, ignoreDiscriminator: boolean): WebhookEventType {
+    return value as WebhookEventType;
+}
+
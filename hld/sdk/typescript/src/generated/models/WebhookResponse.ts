@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Webhook } from './Webhook';
+import {
+    WebhookFromJSON,
+    WebhookFromJSONTyped,
+    WebhookToJSON,
+    WebhookToJSONTyped,
+} from './Webhook';
+
+/**
+ * 
+ * @export
+ * @interface WebhookResponse
+ */
+export interface WebhookResponse {
+    /**
+     * 
+     * @type {Webhook}
+     * @memberof WebhookResponse
+     */
+    data: Webhook;
+}
+
+/**
+ * Check if a given object implements the WebhookResponse interface.
+ */
+export function instanceOfWebhookResponse(value: object): value is WebhookResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function WebhookResponseFromJSON(json: any): WebhookResponse {
+    return WebhookResponseFromJSONTyped(json, false);
+}
+
+export function WebhookResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): WebhookResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': WebhookFromJSON(json['data']),
+    };
+}
+
+export function WebhookResponseToJSON(json: any): WebhookResponse {
+    return WebhookResponseToJSONTyped(json, false);
+}
+
+export function WebhookResponseToJSONTyped(value?: WebhookResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': WebhookToJSON(value['data']),
+    };
+}
+
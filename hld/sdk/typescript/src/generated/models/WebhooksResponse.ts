@@ -0,0 +1,74 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * HumanLayer Daemon REST API
+ * REST API for HumanLayer daemon operations, providing session management, approval workflows, and real-time event streaming capabilities. 
+ *
+ * The version of the OpenAPI document: 1.0.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Webhook } from './Webhook';
+import {
+    WebhookFromJSON,
+    WebhookFromJSONTyped,
+    WebhookToJSON,
+    WebhookToJSONTyped,
+} from './Webhook';
+
+/**
+ * 
+ * @export
+ * @interface WebhooksResponse
+ */
+export interface WebhooksResponse {
+    /**
+     * 
+     * @type {Array<Webhook>}
+     * @memberof WebhooksResponse
+     */
+    data: Array<Webhook>;
+}
+
+/**
+ * Check if a given object implements the WebhooksResponse interface.
+ */
+export function instanceOfWebhooksResponse(value: object): value is WebhooksResponse {
+    if (!('data' in value) || value['data'] === undefined) return false;
+    return true;
+}
+
+export function WebhooksResponseFromJSON(json: any): WebhooksResponse {
+    return WebhooksResponseFromJSONTyped(json, false);
+}
+
+export function WebhooksResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): WebhooksResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'data': ((json['data'] as Array<any>).map(WebhookFromJSON)),
+    };
+}
+
+export function WebhooksResponseToJSON(json: any): WebhooksResponse {
+    return WebhooksResponseToJSONTyped(json, false);
+}
+
+export function WebhooksResponseToJSONTyped(value?: WebhooksResponse | null, ignoreDiscriminator: boolean = false): any {
+    if (value == null) {
+        return value;
+    }
+
+    return {
+        
+        'data': ((value['data'] as Array<any>).map(WebhookToJSON)),
+    };
+}
+
@@ -1,30 +1,55 @@
 /* tslint:disable */
 /* eslint-disable */
+export * from './ActiveSessionResources';
 export * from './Agent';
 export * from './Approval';
+export * from './ApprovalResolvedPayload';
 export * from './ApprovalResponse';
 export * from './ApprovalStatus';
 export * from './ApprovalsResponse';
+export * from './AutoTitleMode';
+export * from './Backup';
+export * from './BackupResponse';
+export * from './BackupsResponse';
+export * from './BatchComparison';
+export * from './BatchComparisonResponse';
+export * from './BatchDiffStats';
+export * from './BatchGroup';
+export * from './BatchGroupResponse';
+export * from './BatchGroupsResponse';
+export * from './BatchMatrix';
+export * from './BatchMember';
+export * from './BatchProvider';
+export * from './BatchStatus';
+export * from './BudgetExceededPayload';
 export * from './BulkArchiveRequest';
 export * from './BulkArchiveResponse';
 export * from './BulkArchiveResponseData';
 export * from './BulkRestoreDraftsRequest';
 export * from './BulkRestoreDraftsResponse';
 export * from './BulkRestoreDraftsResponseData';
+export * from './CompactSessionRequest';
 export * from './ConfigResponse';
 export * from './ContinueSessionRequest';
 export * from './ContinueSessionResponse';
 export * from './ContinueSessionResponseData';
 export * from './ConversationEvent';
 export * from './ConversationResponse';
+export * from './ConversationSearchMatch';
+export * from './ConversationSearchResponse';
+export * from './ConversationSearchResult';
+export * from './ConversationUpdatedPayload';
 export * from './CreateApprovalRequest';
 export * from './CreateApprovalResponse';
 export * from './CreateApprovalResponseData';
 export * from './CreateDirectory200Response';
 export * from './CreateDirectoryRequest';
+export * from './CreateLabelRequest';
+export * from './CreateScheduleRequest';
 export * from './CreateSessionRequest';
 export * from './CreateSessionResponse';
 export * from './CreateSessionResponseData';
+export * from './CreateWebhookRequest';
 export * from './DebugInfoResponse';
 export * from './DecideApprovalRequest';
 export * from './DecideApprovalResponse';
@@ -32,9 +57,12 @@ export * from './DecideApprovalResponseData';
 export * from './DirectoryNotFoundResponse';
 export * from './DiscoverAgents200Response';
 export * from './DiscoverAgentsRequest';
+export * from './EffectiveConfig';
+export * from './EffectiveConfigResponse';
 export * from './ErrorDetail';
 export * from './ErrorResponse';
 export * from './Event';
+export * from './EventSubscriberStats';
 export * from './EventType';
 export * from './FileMatch';
 export * from './FileSnapshot';
@@ -43,27 +71,104 @@ export * from './FuzzySearchFilesResponse';
 export * from './HealthResponse';
 export * from './HealthResponseDependencies';
 export * from './HealthResponseDependenciesClaude';
+export * from './ImportSessionBundleResponse';
+export * from './ImportSessionBundleResponseData';
+export * from './ImportTemplateRequest';
 export * from './InterruptSessionResponse';
 export * from './InterruptSessionResponseData';
+export * from './Label';
+export * from './LabelResponse';
+export * from './LabelsResponse';
+export * from './LaunchBatchRequest';
 export * from './LaunchDraftSessionRequest';
+export * from './LaunchTemplateRequest';
 export * from './MCPConfig';
 export * from './MCPServer';
+export * from './MaintenancePruneCount';
+export * from './MaintenanceReport';
+export * from './MaintenanceRequest';
+export * from './MaintenanceResponse';
+export * from './NewApprovalPayload';
+export * from './OverflowPolicy';
+export * from './PipelineDefinition';
+export * from './PipelineRun';
+export * from './PipelineRunResponse';
+export * from './PipelineRunStatus';
+export * from './PipelineRunsResponse';
+export * from './PipelineStep';
+export * from './PipelineStepDefinition';
+export * from './PipelineStepStatus';
+export * from './Project';
+export * from './ProjectConfigIssue';
+export * from './ProjectConfigTrust';
+export * from './ProjectsResponse';
 export * from './RecentPath';
 export * from './RecentPathsResponse';
+export * from './ReplayGapPayload';
+export * from './ResourceSample';
+export * from './RestoreBackupResponse';
+export * from './RestoreBackupResponseData';
+export * from './SavedFilter';
+export * from './SavedFilterRequest';
+export * from './SavedFilterResponse';
+export * from './SavedFiltersResponse';
+export * from './Schedule';
+export * from './ScheduleCatchUpPolicy';
+export * from './ScheduleLaunchConfig';
+export * from './ScheduleOverlapPolicy';
+export * from './ScheduleResponse';
+export * from './ScheduleRun';
+export * from './ScheduleRunResponse';
+export * from './ScheduleRunsResponse';
+export * from './SchedulesResponse';
 export * from './SearchMetadata';
 export * from './Session';
+export * from './SessionFilter';
+export * from './SessionLabelsResponse';
+export * from './SessionResourceWarningPayload';
+export * from './SessionResources';
+export * from './SessionResourcesResponse';
+export * from './SessionResourcesResponseData';
 export * from './SessionResponse';
 export * from './SessionSearchResponse';
+export * from './SessionSettingsChangedPayload';
+export * from './SessionStalledPayload';
 export * from './SessionStatus';
+export * from './SessionStatusChangedPayload';
+export * from './SessionTemplate';
+export * from './SessionTemplateResponse';
+export * from './SessionTemplateSpec';
+export * from './SessionTemplatesResponse';
 export * from './SessionsResponse';
 export * from './SessionsResponseCounts';
+export * from './SetSessionLabelsRequest';
 export * from './SlashCommand';
 export * from './SlashCommandsResponse';
 export * from './SnapshotsResponse';
+export * from './StartPipelineRunRequest';
+export * from './TemplateExportResponse';
+export * from './TemplateExportResponseData';
+export * from './TemplateMCPServer';
+export * from './TemplateVariable';
+export * from './TextRange';
+export * from './TrustProjectConfigRequest';
+export * from './TrustProjectConfigResponse';
 export * from './UpdateConfigRequest';
+export * from './UpdateScheduleRequest';
 export * from './UpdateSessionRequest';
 export * from './UpdateUserSettingsRequest';
+export * from './UpdateWebhookRequest';
 export * from './UserSettings';
 export * from './UserSettingsResponse';
+export * from './VacuumMode';
 export * from './ValidateDirectoryRequest';
 export * from './ValidateDirectoryResponse';
+export * from './ValidateProjectConfigRequest';
+export * from './ValidateProjectConfigResponse';
+export * from './ValidateProjectConfigResponseData';
+export * from './Webhook';
+export * from './WebhookDeliveriesResponse';
+export * from './WebhookDelivery';
+export * from './WebhookEventType';
+export * from './WebhookResponse';
+export * from './WebhooksResponse';
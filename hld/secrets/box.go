@@ -0,0 +1,167 @@
+// Package secrets encrypts the secrets the daemon keeps in its database, such
+// as proxy API keys, with master keys held in the OS keyring or a key file.
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// sealedPrefix starts every sealed value, followed by the ID of the key that
+// sealed it and the base64 encoded nonce and ciphertext
+const sealedPrefix = "enc:v1:"
+
+// keySize is the size of an AES-256 key
+const keySize = 32
+
+var (
+	// ErrUnknownKey is returned for a value sealed with a key the box does not hold
+	ErrUnknownKey = errors.New("value was sealed with an unknown key")
+
+	// ErrMalformed is returned for a sealed value that cannot be decoded
+	ErrMalformed = errors.New("malformed sealed value")
+)
+
+// Key is a master key. Its ID is derived from the key, so the same key always
+// has the same ID.
+type Key struct {
+	ID     string
+	secret []byte
+}
+
+// NewKey generates a random key
+func NewKey() (Key, error) {
+	secret := make([]byte, keySize)
+	if _, err := rand.Read(secret); err != nil {
+		return Key{}, fmt.Errorf("failed to generate key: %w", err)
+	}
+	return newKey(secret), nil
+}
+
+func newKey(secret []byte) Key {
+	sum := sha256.Sum256(secret)
+	return Key{ID: hex.EncodeToString(sum[:4]), secret: secret}
+}
+
+// ParseKeys decodes keys written by FormatKeys, current key first
+func ParseKeys(data string) ([]Key, error) {
+	fields := strings.FieldsFunc(data, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r' || r == ' ' || r == '\t'
+	})
+	keys := make([]Key, 0, len(fields))
+	for _, field := range fields {
+		secret, err := base64.StdEncoding.DecodeString(field)
+		if err != nil || len(secret) != keySize {
+			return nil, fmt.Errorf("invalid key %d: expected %d base64 encoded bytes", len(keys)+1, keySize)
+		}
+		keys = append(keys, newKey(secret))
+	}
+	return keys, nil
+}
+
+// FormatKeys encodes keys as base64, separated by sep
+func FormatKeys(keys []Key, sep string) string {
+	encoded := make([]string, len(keys))
+	for i, key := range keys {
+		encoded[i] = base64.StdEncoding.EncodeToString(key.secret)
+	}
+	return strings.Join(encoded, sep)
+}
+
+// Box seals values with its current key and opens values sealed with any of
+// its keys, so values sealed before a key rotation stay readable until they
+// are sealed again.
+type Box struct {
+	keys  []Key
+	aeads map[string]cipher.AEAD
+}
+
+// NewBox returns a box sealing with the first key
+func NewBox(keys ...Key) (*Box, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("a box needs at least one key")
+	}
+	box := &Box{keys: keys, aeads: make(map[string]cipher.AEAD, len(keys))}
+	for _, key := range keys {
+		block, err := aes.NewCipher(key.secret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", key.ID, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", key.ID, err)
+		}
+		box.aeads[key.ID] = aead
+	}
+	return box, nil
+}
+
+// Keys returns the box's keys, current key first
+func (b *Box) Keys() []Key {
+	return b.keys
+}
+
+// KeyID returns the ID of the current key
+func (b *Box) KeyID() string {
+	return b.keys[0].ID
+}
+
+// Seal encrypts plaintext with the current key. The empty string stays empty,
+// since it stands for no secret.
+func (b *Box) Seal(plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	key := b.keys[0]
+	aead := b.aeads[key.ID]
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(key.ID))
+	return sealedPrefix + key.ID + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a value returned by Seal. Values that were never sealed are
+// returned as they are, so secrets stored before encryption stay readable.
+func (b *Box) Open(value string) (string, error) {
+	if !IsSealed(value) {
+		return value, nil
+	}
+	keyID, encoded, ok := strings.Cut(strings.TrimPrefix(value, sealedPrefix), ":")
+	if !ok {
+		return "", ErrMalformed
+	}
+	aead, ok := b.aeads[keyID]
+	if !ok {
+		return "", fmt.Errorf("%w %s", ErrUnknownKey, keyID)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrMalformed
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, []byte(keyID))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt value sealed with key %s: %w", keyID, err)
+	}
+	return string(plaintext), nil
+}
+
+// Current reports whether value needs no sealing again: it is empty or sealed
+// with the current key
+func (b *Box) Current(value string) bool {
+	return value == "" || strings.HasPrefix(value, sealedPrefix+b.keys[0].ID+":")
+}
+
+// IsSealed reports whether value was returned by Seal
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
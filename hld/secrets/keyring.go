@@ -0,0 +1,151 @@
+package secrets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+const (
+	keyringService = "humanlayer-daemon"
+	keyringAccount = "secret-encryption-keys"
+	keyringLabel   = "HumanLayer daemon secret encryption keys"
+)
+
+// ErrKeyringUnavailable is returned when the OS has no keyring the daemon can use
+var ErrKeyringUnavailable = errors.New("no OS keyring available: needs the security tool on macOS or secret-tool with a D-Bus session on Linux")
+
+// Keyring keeps keys in the OS keyring through its command line tool, the
+// login keychain's security on macOS and libsecret's secret-tool on Linux
+type Keyring struct {
+	Service string
+	Account string
+
+	goos string
+	// run executes a command with stdin and returns its stdout and exit code
+	run func(stdin string, name string, args ...string) (string, int, error)
+}
+
+// NewKeyring returns the keyring of the OS the daemon runs on
+func NewKeyring() *Keyring {
+	return &Keyring{Service: keyringService, Account: keyringAccount, goos: runtime.GOOS, run: runCommand}
+}
+
+// Available reports whether the keyring's command line tool can be used
+func (k *Keyring) Available() bool {
+	switch k.goos {
+	case "darwin":
+		_, err := exec.LookPath("security")
+		return err == nil
+	case "linux":
+		_, err := exec.LookPath("secret-tool")
+		return err == nil && os.Getenv("DBUS_SESSION_BUS_ADDRESS") != ""
+	default:
+		return false
+	}
+}
+
+// Load reads the keys stored in the keyring, returning ErrNoKeys when there
+// are none
+func (k *Keyring) Load() ([]Key, error) {
+	var out string
+	var code int
+	var err error
+	switch k.goos {
+	case "darwin":
+		out, code, err = k.run("", "security", "find-generic-password", "-s", k.Service, "-a", k.Account, "-w")
+		// 44 is errSecItemNotFound
+		if code == 44 {
+			return nil, ErrNoKeys
+		}
+	case "linux":
+		out, code, err = k.run("", "secret-tool", "lookup", "service", k.Service, "account", k.Account)
+		// secret-tool exits with 1 and prints nothing for a missing item
+		if code == 1 && out == "" {
+			return nil, ErrNoKeys
+		}
+	default:
+		return nil, ErrKeyringUnavailable
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys from keyring: %w", err)
+	}
+	keys, err := ParseKeys(out)
+	if err != nil {
+		return nil, fmt.Errorf("keyring: %w", err)
+	}
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+	return keys, nil
+}
+
+// Save stores keys comma separated, since security prints values containing
+// newlines hex encoded. The keys are written to the tool's stdin, never its
+// arguments, which other local users can read while it runs.
+func (k *Keyring) Save(keys []Key) error {
+	value := FormatKeys(keys, ",")
+	var err error
+	switch k.goos {
+	case "darwin":
+		// security only takes the password as an argument, so run it
+		// interactively and send the command on stdin. -U updates an existing
+		// item instead of failing.
+		command := strings.Join([]string{"add-generic-password", "-U",
+			"-s", quoteSecurityArg(k.Service), "-a", quoteSecurityArg(k.Account),
+			"-l", quoteSecurityArg(keyringLabel), "-w", quoteSecurityArg(value)}, " ")
+		if _, _, err = k.run(command+"\n", "security", "-i"); err == nil {
+			// Interactive mode exits successfully even when a command fails,
+			// so read the item back
+			err = k.verifySaved(keys)
+		}
+	case "linux":
+		_, _, err = k.run(value, "secret-tool", "store", "--label="+keyringLabel,
+			"service", k.Service, "account", k.Account)
+	default:
+		return ErrKeyringUnavailable
+	}
+	if err != nil {
+		return fmt.Errorf("failed to store keys in keyring: %w", err)
+	}
+	return nil
+}
+
+// verifySaved checks the keyring holds keys
+func (k *Keyring) verifySaved(keys []Key) error {
+	stored, err := k.Load()
+	if err != nil {
+		return err
+	}
+	if FormatKeys(stored, ",") != FormatKeys(keys, ",") {
+		return errors.New("keyring item does not hold the saved keys")
+	}
+	return nil
+}
+
+// quoteSecurityArg quotes an argument of a command sent to security -i
+func quoteSecurityArg(arg string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(arg) + `"`
+}
+
+func (k *Keyring) String() string {
+	return "OS keyring item " + k.Service + "/" + k.Account
+}
+
+func runCommand(stdin string, name string, args ...string) (string, int, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return stdout.String(), exitErr.ExitCode(), fmt.Errorf("%s: %w: %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), 0, err
+}
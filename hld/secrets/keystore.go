@@ -0,0 +1,203 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Key sources accepted by Load
+const (
+	SourceAuto    = "auto"
+	SourceKeyring = "keyring"
+	SourceFile    = "file"
+)
+
+// ErrNoKeys is returned by a KeyStore holding no keys yet
+var ErrNoKeys = errors.New("no keys stored")
+
+// KeyStore persists master keys, current key first
+type KeyStore interface {
+	Load() ([]Key, error)
+	Save(keys []Key) error
+	String() string
+}
+
+// FileKeyStore keeps keys in a file readable only by its owner, one base64
+// encoded key per line
+type FileKeyStore struct {
+	Path string
+}
+
+func (f *FileKeyStore) Load() ([]Key, error) {
+	data, err := os.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoKeys
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	if info, err := os.Stat(f.Path); err == nil && info.Mode().Perm()&0o077 != 0 {
+		slog.Warn("key file is readable by other users", "path", f.Path, "mode", info.Mode().Perm())
+	}
+	keys, err := ParseKeys(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Path, err)
+	}
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+	return keys, nil
+}
+
+// Save replaces the file atomically, so a crash never leaves it half written
+func (f *FileKeyStore) Save(keys []Key) error {
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0o700); err != nil {
+		return fmt.Errorf("failed to create key directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if err := tmp.Chmod(0o600); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if _, err := tmp.WriteString(FormatKeys(keys, "\n") + "\n"); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), f.Path); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	return nil
+}
+
+func (f *FileKeyStore) String() string {
+	return "key file " + f.Path
+}
+
+// Open returns the key store for source. SourceAuto uses keyFile if it exists
+// and the OS keyring otherwise, falling back to keyFile where there is no
+// keyring.
+func Open(source, keyFile string) (KeyStore, error) {
+	file := &FileKeyStore{Path: keyFile}
+	switch strings.ToLower(source) {
+	case SourceFile:
+		return file, nil
+	case SourceKeyring:
+		keyring := NewKeyring()
+		if !keyring.Available() {
+			return nil, ErrKeyringUnavailable
+		}
+		return keyring, nil
+	case SourceAuto, "":
+		if _, err := os.Stat(keyFile); err == nil {
+			return file, nil
+		}
+		if keyring := NewKeyring(); keyring.Available() {
+			return &fallbackKeyStore{primary: keyring, fallback: file}, nil
+		}
+		return file, nil
+	default:
+		return nil, fmt.Errorf("unknown secret key source %q", source)
+	}
+}
+
+// Load opens the box holding the keys in ks, generating and saving a first
+// key if it holds none
+func Load(ks KeyStore) (*Box, error) {
+	keys, err := ks.Load()
+	if errors.Is(err, ErrNoKeys) {
+		key, err := NewKey()
+		if err != nil {
+			return nil, err
+		}
+		if err := ks.Save([]Key{key}); err != nil {
+			return nil, err
+		}
+		slog.Info("generated secret encryption key", "store", ks.String(), "key_id", key.ID)
+		keys = []Key{key}
+	} else if err != nil {
+		return nil, err
+	}
+	return NewBox(keys...)
+}
+
+// Rotate saves a new current key in front of the keys in ks and returns a box
+// holding all of them. Values sealed with the previous keys stay readable
+// until Retire drops those keys, which must wait until every value is sealed
+// again with the new key.
+func Rotate(ks KeyStore) (*Box, error) {
+	keys, err := ks.Load()
+	if err != nil && !errors.Is(err, ErrNoKeys) {
+		return nil, err
+	}
+	key, err := NewKey()
+	if err != nil {
+		return nil, err
+	}
+	keys = append([]Key{key}, keys...)
+	if err := ks.Save(keys); err != nil {
+		return nil, err
+	}
+	return NewBox(keys...)
+}
+
+// Retire drops every key but box's current key from ks
+func Retire(ks KeyStore, box *Box) error {
+	return ks.Save(box.Keys()[:1])
+}
+
+// fallbackKeyStore uses the keyring, and the key file when the keyring turns
+// out to be unusable, such as a locked keyring without a prompt to unlock it
+type fallbackKeyStore struct {
+	primary  KeyStore
+	fallback KeyStore
+	failed   bool
+}
+
+func (f *fallbackKeyStore) Load() ([]Key, error) {
+	if !f.failed {
+		keys, err := f.primary.Load()
+		if err == nil || errors.Is(err, ErrNoKeys) {
+			return keys, err
+		}
+		f.useFallback(err)
+	}
+	return f.fallback.Load()
+}
+
+func (f *fallbackKeyStore) Save(keys []Key) error {
+	if !f.failed {
+		err := f.primary.Save(keys)
+		if err == nil {
+			return nil
+		}
+		f.useFallback(err)
+	}
+	return f.fallback.Save(keys)
+}
+
+func (f *fallbackKeyStore) useFallback(err error) {
+	slog.Warn("OS keyring unusable, using key file instead", "error", err, "store", f.fallback.String())
+	f.failed = true
+}
+
+func (f *fallbackKeyStore) String() string {
+	if f.failed {
+		return f.fallback.String()
+	}
+	return f.primary.String()
+}
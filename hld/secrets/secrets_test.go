@@ -0,0 +1,235 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBox(t *testing.T, keys ...Key) *Box {
+	t.Helper()
+	if len(keys) == 0 {
+		key, err := NewKey()
+		require.NoError(t, err)
+		keys = []Key{key}
+	}
+	box, err := NewBox(keys...)
+	require.NoError(t, err)
+	return box
+}
+
+func TestBoxSealOpen(t *testing.T) {
+	box := newTestBox(t)
+
+	sealed, err := box.Seal("sk-or-secret")
+	require.NoError(t, err)
+	assert.True(t, IsSealed(sealed))
+	assert.NotContains(t, sealed, "sk-or-secret")
+	assert.True(t, box.Current(sealed))
+
+	again, err := box.Seal("sk-or-secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, sealed, again, "every seal uses a fresh nonce")
+
+	opened, err := box.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "sk-or-secret", opened)
+
+	// Values stored before encryption and empty values pass through
+	opened, err = box.Open("plaintext-key")
+	require.NoError(t, err)
+	assert.Equal(t, "plaintext-key", opened)
+	assert.False(t, box.Current("plaintext-key"))
+	empty, err := box.Seal("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+	assert.True(t, box.Current(""))
+}
+
+func TestBoxRejectsTamperingAndUnknownKeys(t *testing.T) {
+	box := newTestBox(t)
+	sealed, err := box.Seal("sk-or-secret")
+	require.NoError(t, err)
+
+	_, err = newTestBox(t).Open(sealed)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+
+	tampered := sealed[:len(sealed)-4] + "AAA="
+	_, err = box.Open(tampered)
+	assert.Error(t, err)
+
+	_, err = box.Open(sealedPrefix + "nokeyid")
+	assert.ErrorIs(t, err, ErrMalformed)
+}
+
+func TestParseFormatKeys(t *testing.T) {
+	a, err := NewKey()
+	require.NoError(t, err)
+	b, err := NewKey()
+	require.NoError(t, err)
+
+	for _, sep := range []string{"\n", ","} {
+		keys, err := ParseKeys(FormatKeys([]Key{a, b}, sep) + "\n")
+		require.NoError(t, err)
+		require.Len(t, keys, 2)
+		assert.Equal(t, a.ID, keys[0].ID)
+		assert.Equal(t, b.ID, keys[1].ID)
+	}
+
+	_, err = ParseKeys("not-a-key")
+	assert.Error(t, err)
+}
+
+func TestFileKeyStoreRotation(t *testing.T) {
+	ks := &FileKeyStore{Path: filepath.Join(t.TempDir(), "keys", "secret.key")}
+
+	// The first load generates a key, later loads return the same one
+	box, err := Load(ks)
+	require.NoError(t, err)
+	info, err := os.Stat(ks.Path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+	reloaded, err := Load(ks)
+	require.NoError(t, err)
+	assert.Equal(t, box.Keys()[0].ID, reloaded.Keys()[0].ID)
+
+	sealed, err := box.Seal("sk-or-secret")
+	require.NoError(t, err)
+
+	// After rotating, old values open but are no longer current
+	rotated, err := Rotate(ks)
+	require.NoError(t, err)
+	require.Len(t, rotated.Keys(), 2)
+	assert.False(t, rotated.Current(sealed))
+	opened, err := rotated.Open(sealed)
+	require.NoError(t, err)
+	assert.Equal(t, "sk-or-secret", opened)
+	resealed, err := rotated.Seal(opened)
+	require.NoError(t, err)
+
+	require.NoError(t, Retire(ks, rotated))
+	retired, err := Load(ks)
+	require.NoError(t, err)
+	require.Len(t, retired.Keys(), 1)
+	assert.Equal(t, rotated.Keys()[0].ID, retired.Keys()[0].ID)
+	_, err = retired.Open(sealed)
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	opened, err = retired.Open(resealed)
+	require.NoError(t, err)
+	assert.Equal(t, "sk-or-secret", opened)
+}
+
+// fakeKeyring stands in for the keyring command line tools
+type fakeKeyring struct {
+	value  string
+	stored bool
+	broken bool
+	// dropWrites makes security -i ignore commands, as it does failed ones
+	dropWrites bool
+	calls      []string
+}
+
+func (f *fakeKeyring) run(stdin string, name string, args ...string) (string, int, error) {
+	f.calls = append(f.calls, name+" "+strings.Join(args, " "))
+	if f.broken {
+		return "", 1, assert.AnError
+	}
+	switch args[0] {
+	case "find-generic-password", "lookup":
+		if !f.stored {
+			code := 1
+			if name == "security" {
+				code = 44
+			}
+			return "", code, assert.AnError
+		}
+		return f.value + "\n", 0, nil
+	case "-i":
+		// security -i reads add-generic-password ... -w "<value>" from stdin
+		command := strings.TrimSuffix(stdin, "\n")
+		_, value, ok := strings.Cut(command, ` -w "`)
+		if !strings.HasPrefix(command, "add-generic-password ") || !ok || f.dropWrites {
+			return "", 0, nil
+		}
+		f.value, f.stored = strings.TrimSuffix(value, `"`), true
+		return "", 0, nil
+	default:
+		f.value, f.stored = stdin, true
+		return "", 0, nil
+	}
+}
+
+func TestKeyring(t *testing.T) {
+	for _, goos := range []string{"darwin", "linux"} {
+		t.Run(goos, func(t *testing.T) {
+			fake := &fakeKeyring{}
+			keyring := &Keyring{Service: keyringService, Account: keyringAccount, goos: goos, run: fake.run}
+
+			box, err := Load(keyring)
+			require.NoError(t, err)
+			assert.NotContains(t, fake.value, "\n")
+
+			rotated, err := Rotate(keyring)
+			require.NoError(t, err)
+			assert.Equal(t, box.Keys()[0].ID, rotated.Keys()[1].ID)
+			keys, err := keyring.Load()
+			require.NoError(t, err)
+			assert.Len(t, keys, 2)
+
+			// Keys must never be passed as arguments, where ps shows them
+			for _, key := range keys {
+				encoded := FormatKeys([]Key{key}, "")
+				for _, call := range fake.calls {
+					assert.NotContains(t, call, encoded)
+				}
+			}
+		})
+	}
+
+	dropped := &fakeKeyring{dropWrites: true}
+	keyring := &Keyring{Service: keyringService, Account: keyringAccount, goos: "darwin", run: dropped.run}
+	key, err := NewKey()
+	require.NoError(t, err)
+	assert.Error(t, keyring.Save([]Key{key}))
+
+	_, err = (&Keyring{goos: "plan9"}).Load()
+	assert.ErrorIs(t, err, ErrKeyringUnavailable)
+}
+
+func TestFallbackToKeyFile(t *testing.T) {
+	fake := &fakeKeyring{broken: true}
+	file := &FileKeyStore{Path: filepath.Join(t.TempDir(), "secret.key")}
+	ks := &fallbackKeyStore{
+		primary:  &Keyring{Service: keyringService, Account: keyringAccount, goos: "linux", run: fake.run},
+		fallback: file,
+	}
+
+	box, err := Load(ks)
+	require.NoError(t, err)
+	keys, err := file.Load()
+	require.NoError(t, err)
+	assert.Equal(t, box.Keys()[0].ID, keys[0].ID)
+	assert.Equal(t, file.String(), ks.String())
+}
+
+func TestOpenKeyStore(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "secret.key")
+
+	ks, err := Open(SourceFile, keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, &FileKeyStore{Path: keyFile}, ks)
+
+	// An existing key file wins over the keyring
+	_, err = Load(ks)
+	require.NoError(t, err)
+	ks, err = Open(SourceAuto, keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, &FileKeyStore{Path: keyFile}, ks)
+
+	_, err = Open("vault", keyFile)
+	assert.Error(t, err)
+}
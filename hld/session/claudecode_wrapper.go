@@ -17,6 +17,9 @@ type ClaudeSession interface {
 	// GetID returns the session ID
 	GetID() string
 
+	// GetPID returns the process ID of the claude process, or 0 if unknown
+	GetPID() int
+
 	// Wait blocks until the session completes and returns the result
 	Wait() (*claudecode.Result, error)
 
@@ -49,6 +52,11 @@ func (w *ClaudeSessionWrapper) GetID() string {
 	return w.session.ID
 }
 
+// GetPID implements the ClaudeSession interface
+func (w *ClaudeSessionWrapper) GetPID() int {
+	return w.session.PID()
+}
+
 // Wait implements the ClaudeSession interface
 func (w *ClaudeSessionWrapper) Wait() (*claudecode.Result, error) {
 	return w.session.Wait()
@@ -0,0 +1,182 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// compactSummaryPrefix starts the synthetic user message Claude Code sends with
+// a compaction summary. It is used when the message is not flagged as one.
+const compactSummaryPrefix = "This session is being continued from a previous conversation"
+
+// pendingCompaction tracks a recorded compaction boundary until its summary
+// and post-compaction context size are known
+type pendingCompaction struct {
+	eventID         int64
+	summaryRecorded bool
+	tokensRecorded  bool
+}
+
+// CompactCommand returns the query that asks Claude Code to compact a
+// conversation, optionally focused by instructions
+func CompactCommand(instructions string) string {
+	instructions = strings.TrimSpace(instructions)
+	if instructions == "" {
+		return "/compact"
+	}
+	return "/compact " + instructions
+}
+
+// recordCompactionBoundary stores a compaction event and resets the session's
+// context tracking, which no longer reflects what is in the context window
+func (m *Manager) recordCompactionBoundary(ctx context.Context, sessionID, claudeSessionID string, event claudecode.StreamEvent) error {
+	convEvent := &store.ConversationEvent{
+		SessionID:       sessionID,
+		ClaudeSessionID: claudeSessionID,
+		EventType:       store.EventTypeCompaction,
+		Role:            "system",
+		ParentToolUseID: event.ParentToolUseID,
+	}
+	if meta := event.CompactMetadata; meta != nil {
+		convEvent.CompactionTrigger = meta.Trigger
+		preTokens := meta.PreTokens
+		convEvent.PreCompactionTokens = &preTokens
+		if meta.PostTokens > 0 {
+			postTokens := meta.PostTokens
+			convEvent.PostCompactionTokens = &postTokens
+		}
+	}
+	if err := m.store.AddConversationEvent(ctx, convEvent); err != nil {
+		return err
+	}
+
+	m.pendingCompactions.Store(sessionID, &pendingCompaction{
+		eventID:        convEvent.ID,
+		tokensRecorded: convEvent.PostCompactionTokens != nil,
+	})
+
+	// Until the next turn reports usage, the post-compaction size is the best
+	// known context size. Zero means unknown.
+	effective := 0
+	if convEvent.PostCompactionTokens != nil {
+		effective = *convEvent.PostCompactionTokens
+	}
+	if err := m.store.UpdateSession(ctx, sessionID, store.SessionUpdate{EffectiveContextTokens: &effective}); err != nil {
+		slog.Error("failed to reset context tokens after compaction",
+			"session_id", sessionID,
+			"error", err)
+	}
+
+	slog.Info("session conversation compacted",
+		"session_id", sessionID,
+		"trigger", convEvent.CompactionTrigger,
+		"pre_tokens", convEvent.PreCompactionTokens)
+
+	if m.eventBus != nil {
+		m.eventBus.Publish(bus.NewEvent(bus.ConversationUpdatedPayload{
+			SessionID:       sessionID,
+			ClaudeSessionID: claudeSessionID,
+			EventType:       store.EventTypeCompaction,
+			ContentType:     "compaction",
+			Trigger:         convEvent.CompactionTrigger,
+			ParentToolUseID: event.ParentToolUseID,
+			PreTokens:       convEvent.PreCompactionTokens,
+			PostTokens:      convEvent.PostCompactionTokens,
+		}))
+		m.publishTokenUpdate(ctx, sessionID)
+	}
+
+	return nil
+}
+
+// recordCompactionSummary attaches a compaction summary message to the
+// pending compaction event instead of storing it as a user message. It reports
+// whether the event was a compaction summary.
+func (m *Manager) recordCompactionSummary(ctx context.Context, sessionID, claudeSessionID string, event claudecode.StreamEvent) (bool, error) {
+	value, ok := m.pendingCompactions.Load(sessionID)
+	if !ok {
+		return false, nil
+	}
+	pending := value.(*pendingCompaction)
+	if pending.summaryRecorded {
+		return false, nil
+	}
+
+	var texts []string
+	for _, content := range event.Message.Content {
+		if content.Type == "text" {
+			texts = append(texts, content.Text)
+		}
+	}
+	summary := strings.Join(texts, "\n")
+	if !event.IsCompactSummary && !strings.HasPrefix(summary, compactSummaryPrefix) {
+		return false, nil
+	}
+
+	if err := m.store.UpdateCompactionEvent(ctx, pending.eventID, store.CompactionUpdate{Summary: &summary}); err != nil {
+		return true, err
+	}
+	pending.summaryRecorded = true
+	m.finishCompaction(sessionID, pending)
+
+	if m.eventBus != nil {
+		m.eventBus.Publish(bus.NewEvent(bus.ConversationUpdatedPayload{
+			SessionID:       sessionID,
+			ClaudeSessionID: claudeSessionID,
+			EventType:       store.EventTypeCompaction,
+			Content:         summary,
+			ContentType:     "compaction",
+		}))
+	}
+	return true, nil
+}
+
+// recordPostCompactionTokens records the first context size reported after a
+// compaction whose metadata did not include one
+func (m *Manager) recordPostCompactionTokens(ctx context.Context, sessionID string, effective int) {
+	value, ok := m.pendingCompactions.Load(sessionID)
+	if !ok {
+		return
+	}
+	pending := value.(*pendingCompaction)
+	if pending.tokensRecorded {
+		return
+	}
+
+	if err := m.store.UpdateCompactionEvent(ctx, pending.eventID, store.CompactionUpdate{PostCompactionTokens: &effective}); err != nil {
+		slog.Error("failed to record post-compaction tokens",
+			"session_id", sessionID,
+			"error", err)
+		return
+	}
+	pending.tokensRecorded = true
+	m.finishCompaction(sessionID, pending)
+}
+
+// finishCompaction stops tracking a compaction once it is complete
+func (m *Manager) finishCompaction(sessionID string, pending *pendingCompaction) {
+	if pending.summaryRecorded && pending.tokensRecorded {
+		m.pendingCompactions.CompareAndDelete(sessionID, pending)
+	}
+}
+
+// publishTokenUpdate notifies the UI that a session's token counts changed
+func (m *Manager) publishTokenUpdate(ctx context.Context, sessionID string) {
+	// The UI needs "new_status" field even though we're not changing status
+	currentStatus := "running"
+	if session, _ := m.store.GetSession(ctx, sessionID); session != nil && session.Status != "" {
+		currentStatus = session.Status
+	}
+
+	m.eventBus.Publish(bus.NewEvent(bus.SessionStatusChangedPayload{
+		SessionID: sessionID,
+		NewStatus: currentStatus, // Required by UI handler
+		OldStatus: currentStatus, // Status isn't changing, just tokens
+		Reason:    "token_update",
+	}))
+}
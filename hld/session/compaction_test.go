@@ -0,0 +1,122 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_ProcessCompaction(t *testing.T) {
+	ctx := context.Background()
+	testStore, err := store.NewSQLiteStore(":memory:")
+	require.NoError(t, err)
+	defer func() { _ = testStore.Close() }()
+
+	manager, err := NewManager(bus.NewEventBus(), testStore, "")
+	require.NoError(t, err)
+
+	const sessionID, claudeSessionID = "sess-compact", "claude-compact"
+	require.NoError(t, testStore.CreateSession(ctx, &store.Session{
+		ID:              sessionID,
+		RunID:           "run-compact",
+		ClaudeSessionID: claudeSessionID,
+		Status:          store.SessionStatusRunning,
+		CreatedAt:       time.Now(),
+		LastActivityAt:  time.Now(),
+	}))
+
+	assistantUsage := func(input, cacheRead int) claudecode.StreamEvent {
+		return claudecode.StreamEvent{
+			Type:      "assistant",
+			SessionID: claudeSessionID,
+			Message: &claudecode.Message{
+				Role:    "assistant",
+				Content: []claudecode.Content{{Type: "text", Text: "ok"}},
+				Usage:   &claudecode.Usage{InputTokens: input, CacheReadInputTokens: cacheRead},
+			},
+		}
+	}
+
+	// A long conversation before compaction
+	require.NoError(t, manager.processStreamEvent(ctx, sessionID, claudeSessionID, assistantUsage(1000, 150000)))
+	sess, err := testStore.GetSession(ctx, sessionID)
+	require.NoError(t, err)
+	require.NotNil(t, sess.EffectiveContextTokens)
+	assert.Equal(t, 151000, *sess.EffectiveContextTokens)
+
+	// The CLI compacts automatically
+	require.NoError(t, manager.processStreamEvent(ctx, sessionID, claudeSessionID, claudecode.StreamEvent{
+		Type:            "system",
+		Subtype:         "compact_boundary",
+		SessionID:       claudeSessionID,
+		CompactMetadata: &claudecode.CompactMetadata{Trigger: "auto", PreTokens: 151000},
+	}))
+
+	// Context tracking no longer reports the pre-compaction size
+	sess, err = testStore.GetSession(ctx, sessionID)
+	require.NoError(t, err)
+	require.NotNil(t, sess.EffectiveContextTokens)
+	assert.Equal(t, 0, *sess.EffectiveContextTokens)
+
+	// The summary arrives as a synthetic user message with string content
+	summary := compactSummaryPrefix + " that ran out of context. Summary: fixed the parser."
+	require.NoError(t, manager.processStreamEvent(ctx, sessionID, claudeSessionID, claudecode.StreamEvent{
+		Type:      "user",
+		SessionID: claudeSessionID,
+		Message: &claudecode.Message{
+			Role:    "user",
+			Content: []claudecode.Content{{Type: "text", Text: summary}},
+		},
+	}))
+
+	// The next turn reports the compacted context size
+	require.NoError(t, manager.processStreamEvent(ctx, sessionID, claudeSessionID, assistantUsage(500, 12000)))
+
+	events, err := testStore.GetConversation(ctx, claudeSessionID)
+	require.NoError(t, err)
+
+	var compactions []*store.ConversationEvent
+	for _, event := range events {
+		if event.EventType == store.EventTypeCompaction {
+			compactions = append(compactions, event)
+		}
+		// The summary is not stored as a user message
+		if event.EventType == store.EventTypeMessage {
+			assert.NotEqual(t, summary, event.Content)
+		}
+	}
+	require.Len(t, compactions, 1)
+	compaction := compactions[0]
+	assert.Equal(t, "auto", compaction.CompactionTrigger)
+	assert.Equal(t, summary, compaction.Content)
+	require.NotNil(t, compaction.PreCompactionTokens)
+	assert.Equal(t, 151000, *compaction.PreCompactionTokens)
+	require.NotNil(t, compaction.PostCompactionTokens)
+	assert.Equal(t, 12500, *compaction.PostCompactionTokens)
+
+	sess, err = testStore.GetSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, 12500, *sess.EffectiveContextTokens)
+
+	// Once complete, later usage does not change the compaction event
+	require.NoError(t, manager.processStreamEvent(ctx, sessionID, claudeSessionID, assistantUsage(800, 20000)))
+	events, err = testStore.GetConversation(ctx, claudeSessionID)
+	require.NoError(t, err)
+	for _, event := range events {
+		if event.EventType == store.EventTypeCompaction {
+			assert.Equal(t, 12500, *event.PostCompactionTokens)
+		}
+	}
+}
+
+func TestCompactCommand(t *testing.T) {
+	assert.Equal(t, "/compact", CompactCommand(""))
+	assert.Equal(t, "/compact", CompactCommand("  "))
+	assert.Equal(t, "/compact keep the API design decisions", CompactCommand(" keep the API design decisions "))
+}
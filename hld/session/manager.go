@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -26,10 +27,13 @@ type Manager struct {
 	claudeClientErr    error              // Store initialization error
 	claudePath         string             // Configured Claude path
 	lastCheckedPath    string             // Last path we checked
+	runners            *RunnerRegistry    // Backends sessions can be launched with
+	titles             *TitleGenerator    // Titles untitled sessions after their first reply
 	eventBus           bus.EventBus
 	store              store.ConversationStore
 	approvalReconciler ApprovalReconciler
 	pendingQueries     sync.Map // map[sessionID]query - stores queries waiting for Claude session ID
+	pendingCompactions sync.Map // map[sessionID]*pendingCompaction - compactions awaiting their summary or post-compaction tokens
 	socketPath         string   // Daemon socket path for MCP servers
 	httpPort           int      // HTTP server port for proxy endpoint
 }
@@ -50,7 +54,10 @@ func NewManager(eventBus bus.EventBus, store store.ConversationStore, socketPath
 		eventBus:        eventBus,
 		store:           store,
 		socketPath:      socketPath,
+		runners:         NewRunnerRegistry(),
 	}
+	m.runners.Register(&claudeRunner{manager: m})
+	m.titles = NewTitleGenerator(store, eventBus, m.getClaudeClient)
 
 	// Try to initialize Claude client but don't fail if unavailable
 	m.initializeClaudeClient()
@@ -76,6 +83,16 @@ func NewManagerWithConfig(eventBus bus.EventBus, store store.ConversationStore,
 		store:           store,
 		socketPath:      socketPath,
 		claudePath:      cfg.ClaudePath, // Use configured Claude path
+		runners:         NewRunnerRegistry(),
+	}
+	m.runners.Register(&claudeRunner{manager: m})
+	m.titles = NewTitleGenerator(store, eventBus, m.getClaudeClient)
+	for _, runnerCfg := range cfg.Runners {
+		runner, err := NewRunnerFromConfig(runnerCfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid runner configuration: %w", err)
+		}
+		m.runners.Register(runner)
 	}
 
 	// Try to initialize Claude client but don't fail if unavailable
@@ -104,6 +121,28 @@ func (m *Manager) SetHTTPPort(port int) {
 	slog.Debug("HTTP port set for proxy endpoint", "port", port)
 }
 
+// RegisterRunner makes a runner backend available to sessions
+func (m *Manager) RegisterRunner(runner Runner) {
+	m.runners.Register(runner)
+}
+
+// RunnerNames returns the names of the registered runner backends
+func (m *Manager) RunnerNames() []string {
+	return m.runners.Names()
+}
+
+// getRunner returns the named runner if it can launch sessions
+func (m *Manager) getRunner(name string) (Runner, error) {
+	runner, err := m.runners.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := runner.Available(); err != nil {
+		return nil, err
+	}
+	return runner, nil
+}
+
 // initializeClaudeClient attempts to create or reinitialize the Claude client
 // Note: Using mutex instead of sync.Once to support reinitialization
 func (m *Manager) initializeClaudeClient() {
@@ -180,11 +219,18 @@ func (m *Manager) getClaudeClient() (*claudecode.Client, error) {
 // LaunchSession starts a new Claude Code session
 // TODO(0): Consider whether we need to support non-draft session creation directly in daemon post-implementation
 func (m *Manager) LaunchSession(ctx context.Context, config LaunchSessionConfig, isDraft bool) (*Session, error) {
-	// Get Claude client (will attempt initialization if needed)
-	client, err := m.getClaudeClient()
+	// Resolve the runner backend (will attempt Claude initialization if needed)
+	runner, err := m.getRunner(config.Runner)
 	if err != nil {
 		return nil, fmt.Errorf("cannot launch session: %w", err)
 	}
+
+	// Merge the project configuration from the working directory
+	effective, err := m.applyProjectConfig(ctx, &config)
+	if err != nil {
+		return nil, fmt.Errorf("cannot launch session: %w", err)
+	}
+
 	// Generate unique IDs
 	sessionID := uuid.New().String()
 	runID := uuid.New().String()
@@ -364,10 +410,28 @@ func (m *Manager) LaunchSession(ctx context.Context, config LaunchSessionConfig,
 		dbSession.ProxyAPIKey = config.ProxyAPIKey
 	}
 
+	// Handle stall watchdog thresholds from config
+	dbSession.StallThresholdMs = config.StallThresholdMs
+	dbSession.StallInterruptThresholdMs = config.StallInterruptThresholdMs
+	dbSession.Runner = config.Runner
+	dbSession.ProjectID = m.resolveProject(ctx, dbSession.WorkingDir)
+
 	if err := m.store.CreateSession(ctx, dbSession); err != nil {
 		return nil, fmt.Errorf("failed to store session in database: %w", err)
 	}
 
+	// Record the configuration the session runs with, including injected MCP servers
+	effective.MCPServers = effective.MCPServers[:0]
+	for name := range claudeConfig.MCPConfig.MCPServers {
+		effective.MCPServers = append(effective.MCPServers, name)
+	}
+	sort.Strings(effective.MCPServers)
+	if effectiveJSON, err := json.Marshal(effective); err != nil {
+		slog.Error("failed to encode effective config", "error", err)
+	} else if err := m.store.SetSessionEffectiveConfig(ctx, sessionID, string(effectiveJSON)); err != nil {
+		slog.Error("failed to store effective config", "error", err)
+	}
+
 	// Store MCP servers if configured
 	if claudeConfig.MCPConfig != nil && len(claudeConfig.MCPConfig.MCPServers) > 0 {
 		servers, err := store.MCPServersFromConfig(sessionID, claudeConfig.MCPConfig.MCPServers)
@@ -451,19 +515,17 @@ func (m *Manager) LaunchSession(ctx context.Context, config LaunchSessionConfig,
 		"mcp_servers_detail", mcpServersDetail)
 
 	// Launch Claude session (without daemon-level settings)
-	claudeSession, err := client.Launch(claudeConfig)
+	wrappedSession, err := runner.Launch(claudeConfig)
 	if err != nil {
 		slog.Error("failed to launch Claude session",
 			"session_id", sessionID,
+			"runner", runner.Name(),
 			"error", err,
 			"config", fmt.Sprintf("%+v", claudeConfig))
 		m.updateSessionStatus(ctx, sessionID, StatusFailed, err.Error())
 		return nil, fmt.Errorf("failed to launch Claude session: %w", err)
 	}
 
-	// Wrap the session for storage
-	wrappedSession := NewClaudeSessionWrapper(claudeSession)
-
 	// Store active Claude process
 	m.mu.Lock()
 	m.activeProcesses[sessionID] = wrappedSession
@@ -483,15 +545,12 @@ func (m *Manager) LaunchSession(ctx context.Context, config LaunchSessionConfig,
 
 	// Publish status change event
 	if m.eventBus != nil {
-		event := bus.Event{
-			Type: bus.EventSessionStatusChanged,
-			Data: map[string]interface{}{
-				"session_id": sessionID,
-				"run_id":     runID,
-				"old_status": string(StatusStarting),
-				"new_status": string(StatusRunning),
-			},
-		}
+		event := bus.NewEvent(bus.SessionStatusChangedPayload{
+			SessionID: sessionID,
+			RunID:     runID,
+			OldStatus: string(StatusStarting),
+			NewStatus: string(StatusRunning),
+		})
 		slog.Info("publishing session status changed event",
 			"session_id", sessionID,
 			"run_id", runID,
@@ -662,15 +721,12 @@ eventLoop:
 		}
 		// Publish status change event
 		if m.eventBus != nil {
-			m.eventBus.Publish(bus.Event{
-				Type: bus.EventSessionStatusChanged,
-				Data: map[string]interface{}{
-					"session_id": sessionID,
-					"run_id":     runID,
-					"old_status": string(StatusInterrupting),
-					"new_status": string(StatusInterrupted),
-				},
-			})
+			m.eventBus.Publish(bus.NewEvent(bus.SessionStatusChangedPayload{
+				SessionID: sessionID,
+				RunID:     runID,
+				OldStatus: string(StatusInterrupting),
+				NewStatus: string(StatusInterrupted),
+			}))
 		}
 	} else if err != nil {
 		slog.Error("claude process failed",
@@ -712,15 +768,12 @@ eventLoop:
 
 		// Publish status change event
 		if m.eventBus != nil {
-			event := bus.Event{
-				Type: bus.EventSessionStatusChanged,
-				Data: map[string]interface{}{
-					"session_id": sessionID,
-					"run_id":     runID,
-					"old_status": string(StatusRunning),
-					"new_status": string(StatusCompleted),
-				},
-			}
+			event := bus.NewEvent(bus.SessionStatusChangedPayload{
+				SessionID: sessionID,
+				RunID:     runID,
+				OldStatus: string(StatusRunning),
+				NewStatus: string(StatusCompleted),
+			})
 			slog.Info("publishing session completion event",
 				"session_id", sessionID,
 				"run_id", runID,
@@ -754,6 +807,8 @@ eventLoop:
 
 	// Clean up any pending queries that weren't injected
 	m.pendingQueries.Delete(sessionID)
+	m.pendingCompactions.Delete(sessionID)
+	m.titles.Forget(sessionID)
 }
 
 // updateSessionStatus updates the status of a session in the database
@@ -778,12 +833,30 @@ func (m *Manager) updateSessionStatus(ctx context.Context, sessionID string, sta
 		// Clean up any pending queries
 		m.pendingQueries.Delete(sessionID)
 	}
+
+	// Read the old status first so the status change event can carry it
+	var old *store.Session
+	if m.eventBus != nil {
+		var err error
+		if old, err = m.store.GetSession(ctx, sessionID); err != nil {
+			slog.Warn("failed to read session before status update", "session_id", sessionID, "error", err)
+		}
+	}
+
 	if err := m.store.UpdateSession(ctx, sessionID, update); err != nil {
 		slog.Error("failed to update session status in database", "error", err)
+		return
 	}
 
-	// Note: We can't publish status change events without knowing the old status
-	// This would require a database read. For now, we'll skip the event.
+	if old != nil && old.Status != dbStatus {
+		m.eventBus.Publish(bus.NewEvent(bus.SessionStatusChangedPayload{
+			SessionID:       sessionID,
+			RunID:           old.RunID,
+			ParentSessionID: old.ParentSessionID,
+			OldStatus:       old.Status,
+			NewStatus:       dbStatus,
+		}))
+	}
 }
 
 // GetSessionInfo returns session info from the database by ID
@@ -794,7 +867,31 @@ func (m *Manager) GetSessionInfo(sessionID string) (*Info, error) {
 		return nil, fmt.Errorf("session not found: %w", err)
 	}
 
-	info := &Info{
+	info := InfoFromStore(dbSession)
+	return &info, nil
+}
+
+// ListSessions returns all sessions from the database
+func (m *Manager) ListSessions() []Info {
+	ctx := context.Background()
+	dbSessions, err := m.store.ListSessions(ctx)
+	if err != nil {
+		slog.Error("failed to list sessions from database", "error", err)
+		return []Info{}
+	}
+
+	// Convert database sessions to Info
+	infos := make([]Info, 0, len(dbSessions))
+	for _, dbSession := range dbSessions {
+		infos = append(infos, InfoFromStore(dbSession))
+	}
+
+	return infos
+}
+
+// InfoFromStore converts a database session to session info
+func InfoFromStore(dbSession *store.Session) Info {
+	info := Info{
 		ID:                                  dbSession.ID,
 		RunID:                               dbSession.RunID,
 		ClaudeSessionID:                     dbSession.ClaudeSessionID,
@@ -811,15 +908,24 @@ func (m *Manager) GetSessionInfo(sessionID string) (*Info, error) {
 		WorkingDir:                          dbSession.WorkingDir,
 		AutoAcceptEdits:                     dbSession.AutoAcceptEdits,
 		Archived:                            dbSession.Archived,
-		EditorState:                         dbSession.EditorState,
 		DangerouslySkipPermissions:          dbSession.DangerouslySkipPermissions,
 		DangerouslySkipPermissionsExpiresAt: dbSession.DangerouslySkipPermissionsExpiresAt,
+		EditorState:                         dbSession.EditorState,
 		ProxyEnabled:                        dbSession.ProxyEnabled,
 		ProxyBaseURL:                        dbSession.ProxyBaseURL,
 		ProxyModelOverride:                  dbSession.ProxyModelOverride,
 		ProxyAPIKey:                         dbSession.ProxyAPIKey,
+		ProxyAPIKeySet:                      dbSession.ProxyAPIKey != "",
+		StallThresholdMs:                    dbSession.StallThresholdMs,
+		StallInterruptThresholdMs:           dbSession.StallInterruptThresholdMs,
+		Resources:                           ResourceUsageFromSession(dbSession),
+		Runner:                              dbSession.Runner,
+		ProjectID:                           dbSession.ProjectID,
+		Labels:                              dbSession.Labels,
 	}
 
+	// Set end time if completed
+	// TODO: Make these two fields match (JsonRPC name and sqlite storage name)
 	if dbSession.CompletedAt != nil {
 		info.EndTime = dbSession.CompletedAt
 	}
@@ -850,83 +956,7 @@ func (m *Manager) GetSessionInfo(sessionID string) (*Info, error) {
 		info.Result = result
 	}
 
-	return info, nil
-}
-
-// ListSessions returns all sessions from the database
-func (m *Manager) ListSessions() []Info {
-	ctx := context.Background()
-	dbSessions, err := m.store.ListSessions(ctx)
-	if err != nil {
-		slog.Error("failed to list sessions from database", "error", err)
-		return []Info{}
-	}
-
-	// Convert database sessions to Info
-	infos := make([]Info, 0, len(dbSessions))
-	for _, dbSession := range dbSessions {
-		info := Info{
-			ID:                                  dbSession.ID,
-			RunID:                               dbSession.RunID,
-			ClaudeSessionID:                     dbSession.ClaudeSessionID,
-			ParentSessionID:                     dbSession.ParentSessionID,
-			Status:                              Status(dbSession.Status),
-			StartTime:                           dbSession.CreatedAt,
-			LastActivityAt:                      dbSession.LastActivityAt,
-			Error:                               dbSession.ErrorMessage,
-			Query:                               dbSession.Query,
-			Summary:                             dbSession.Summary,
-			Title:                               dbSession.Title,
-			Model:                               dbSession.Model,
-			ModelID:                             dbSession.ModelID,
-			WorkingDir:                          dbSession.WorkingDir,
-			AutoAcceptEdits:                     dbSession.AutoAcceptEdits,
-			Archived:                            dbSession.Archived,
-			DangerouslySkipPermissions:          dbSession.DangerouslySkipPermissions,
-			DangerouslySkipPermissionsExpiresAt: dbSession.DangerouslySkipPermissionsExpiresAt,
-			EditorState:                         dbSession.EditorState,
-			ProxyEnabled:                        dbSession.ProxyEnabled,
-			ProxyBaseURL:                        dbSession.ProxyBaseURL,
-			ProxyModelOverride:                  dbSession.ProxyModelOverride,
-			ProxyAPIKey:                         dbSession.ProxyAPIKey,
-		}
-
-		// Set end time if completed
-		// TODO: Make these two fields match (JsonRPC name and sqlite storage name)
-		if dbSession.CompletedAt != nil {
-			info.EndTime = dbSession.CompletedAt
-		}
-
-		// Populate Result field if we have result data
-		if dbSession.ResultContent != "" || dbSession.NumTurns != nil || dbSession.CostUSD != nil || dbSession.DurationMS != nil {
-			result := &claudecode.Result{
-				Type:      "result",
-				Subtype:   "session_completed",
-				Result:    dbSession.ResultContent,
-				SessionID: dbSession.ClaudeSessionID, // Use Claude session ID for consistency
-			}
-
-			if dbSession.CostUSD != nil {
-				result.CostUSD = *dbSession.CostUSD
-			}
-			if dbSession.NumTurns != nil {
-				result.NumTurns = *dbSession.NumTurns
-			}
-			if dbSession.DurationMS != nil {
-				result.DurationMS = *dbSession.DurationMS
-			}
-			if dbSession.ErrorMessage != "" {
-				result.Error = dbSession.ErrorMessage
-				result.IsError = true
-			}
-
-			info.Result = result
-		}
-
-		infos = append(infos, info)
-	}
-
-	return infos
+	return info
 }
 
 // updateSessionActivity updates the last_activity_at timestamp for a session
@@ -977,35 +1007,20 @@ func (m *Manager) processStreamEvent(ctx context.Context, sessionID string, clau
 				LastActivityAt:           &now,
 			}
 
+			// The first usage after a compaction is the compacted context size
+			m.recordPostCompactionTokens(ctx, sessionID, effective)
+
 			if err := m.store.UpdateSession(ctx, sessionID, update); err != nil {
 				slog.Error("failed to update token usage",
 					"session_id", sessionID,
 					"error", err)
 			} else {
 				// Publish event to notify UI about token update
-				// The UI needs "new_status" field even though we're not changing status
 				if m.eventBus != nil {
-					// Get current session to include current status
-					session, _ := m.store.GetSession(ctx, sessionID)
-					currentStatus := "running"
-					if session != nil && session.Status != "" {
-						currentStatus = session.Status
-					}
-
 					slog.Debug("Publishing token update event",
 						"session_id", sessionID,
-						"status", currentStatus,
 						"effective_tokens", effective)
-
-					m.eventBus.Publish(bus.Event{
-						Type: bus.EventSessionStatusChanged,
-						Data: map[string]interface{}{
-							"session_id": sessionID,
-							"new_status": currentStatus, // Required by UI handler
-							"old_status": currentStatus, // Status isn't changing, just tokens
-							"reason":     "token_update",
-						},
-					})
+					m.publishTokenUpdate(ctx, sessionID)
 				}
 			}
 		}
@@ -1036,19 +1051,18 @@ func (m *Manager) processStreamEvent(ctx context.Context, sessionID string, clau
 
 			// Publish conversation updated event
 			if m.eventBus != nil {
-				m.eventBus.Publish(bus.Event{
-					Type: bus.EventConversationUpdated,
-					Data: map[string]interface{}{
-						"session_id":         sessionID,
-						"claude_session_id":  claudeSessionID,
-						"event_type":         "system",
-						"subtype":            event.Subtype,
-						"content":            fmt.Sprintf("Session created with ID: %s", event.SessionID),
-						"content_type":       "system",
-						"parent_tool_use_id": event.ParentToolUseID,
-					},
-				})
+				m.eventBus.Publish(bus.NewEvent(bus.ConversationUpdatedPayload{
+					SessionID:       sessionID,
+					ClaudeSessionID: claudeSessionID,
+					EventType:       "system",
+					Subtype:         event.Subtype,
+					Content:         fmt.Sprintf("Session created with ID: %s", event.SessionID),
+					ContentType:     "system",
+					ParentToolUseID: event.ParentToolUseID,
+				}))
 			}
+		case "compact_boundary":
+			return m.recordCompactionBoundary(ctx, sessionID, claudeSessionID, event)
 		case "init":
 			// Check if we need to populate the model
 			session, err := m.store.GetSession(ctx, sessionID)
@@ -1113,6 +1127,13 @@ func (m *Manager) processStreamEvent(ctx context.Context, sessionID string, clau
 		// Other system events can be added as needed
 
 	case "assistant", "user":
+		// The summary of a compaction arrives as a synthetic user message
+		if event.Message != nil && event.Message.Role == "user" {
+			if handled, err := m.recordCompactionSummary(ctx, sessionID, claudeSessionID, event); handled || err != nil {
+				return err
+			}
+		}
+
 		// Messages contain the actual content
 		if event.Message != nil {
 			// Token usage is already processed at the top of this function
@@ -1136,20 +1157,22 @@ func (m *Manager) processStreamEvent(ctx context.Context, sessionID string, clau
 					// Update session activity timestamp for text messages
 					m.updateSessionActivity(ctx, sessionID)
 
+					// The first reply is enough context to title the session
+					if event.Message.Role == "assistant" && event.ParentToolUseID == "" {
+						m.titles.Observe(sessionID, content.Text)
+					}
+
 					// Publish conversation updated event
 					if m.eventBus != nil {
-						m.eventBus.Publish(bus.Event{
-							Type: bus.EventConversationUpdated,
-							Data: map[string]interface{}{
-								"session_id":         sessionID,
-								"claude_session_id":  claudeSessionID,
-								"event_type":         "message",
-								"role":               event.Message.Role,
-								"content":            content.Text,
-								"content_type":       "text",
-								"parent_tool_use_id": event.ParentToolUseID,
-							},
-						})
+						m.eventBus.Publish(bus.NewEvent(bus.ConversationUpdatedPayload{
+							SessionID:       sessionID,
+							ClaudeSessionID: claudeSessionID,
+							EventType:       "message",
+							Role:            event.Message.Role,
+							Content:         content.Text,
+							ContentType:     "text",
+							ParentToolUseID: event.ParentToolUseID,
+						}))
 					}
 
 				case "tool_use":
@@ -1184,19 +1207,16 @@ func (m *Manager) processStreamEvent(ctx context.Context, sessionID string, clau
 							toolInput = nil // Don't include invalid JSON
 						}
 
-						m.eventBus.Publish(bus.Event{
-							Type: bus.EventConversationUpdated,
-							Data: map[string]interface{}{
-								"session_id":         sessionID,
-								"claude_session_id":  claudeSessionID,
-								"event_type":         "tool_call",
-								"tool_id":            content.ID,
-								"tool_name":          content.Name,
-								"tool_input":         toolInput,
-								"parent_tool_use_id": event.ParentToolUseID,
-								"content_type":       "tool_use",
-							},
-						})
+						m.eventBus.Publish(bus.NewEvent(bus.ConversationUpdatedPayload{
+							SessionID:       sessionID,
+							ClaudeSessionID: claudeSessionID,
+							EventType:       "tool_call",
+							ToolID:          content.ID,
+							ToolName:        content.Name,
+							ToolInput:       toolInput,
+							ParentToolUseID: event.ParentToolUseID,
+							ContentType:     "tool_use",
+						}))
 					}
 
 				case "tool_result":
@@ -1224,18 +1244,15 @@ func (m *Manager) processStreamEvent(ctx context.Context, sessionID string, clau
 
 					// Publish conversation updated event
 					if m.eventBus != nil {
-						m.eventBus.Publish(bus.Event{
-							Type: bus.EventConversationUpdated,
-							Data: map[string]interface{}{
-								"session_id":          sessionID,
-								"claude_session_id":   claudeSessionID,
-								"event_type":          "tool_result",
-								"tool_result_for_id":  content.ToolUseID,
-								"tool_result_content": content.Content.Value,
-								"content_type":        "tool_result",
-								"parent_tool_use_id":  event.ParentToolUseID,
-							},
-						})
+						m.eventBus.Publish(bus.NewEvent(bus.ConversationUpdatedPayload{
+							SessionID:         sessionID,
+							ClaudeSessionID:   claudeSessionID,
+							EventType:         "tool_result",
+							ToolResultForID:   content.ToolUseID,
+							ToolResultContent: content.Content.Value,
+							ContentType:       "tool_result",
+							ParentToolUseID:   event.ParentToolUseID,
+						}))
 					}
 
 					// Mark the corresponding tool call as completed
@@ -1266,18 +1283,15 @@ func (m *Manager) processStreamEvent(ctx context.Context, sessionID string, clau
 
 					// Publish conversation updated event
 					if m.eventBus != nil {
-						m.eventBus.Publish(bus.Event{
-							Type: bus.EventConversationUpdated,
-							Data: map[string]interface{}{
-								"session_id":         sessionID,
-								"claude_session_id":  claudeSessionID,
-								"event_type":         "thinking",
-								"role":               event.Message.Role,
-								"content":            content.Thinking,
-								"content_type":       "thinking",
-								"parent_tool_use_id": event.ParentToolUseID,
-							},
-						})
+						m.eventBus.Publish(bus.NewEvent(bus.ConversationUpdatedPayload{
+							SessionID:       sessionID,
+							ClaudeSessionID: claudeSessionID,
+							EventType:       "thinking",
+							Role:            event.Message.Role,
+							Content:         content.Thinking,
+							ContentType:     "thinking",
+							ParentToolUseID: event.ParentToolUseID,
+						}))
 					}
 				}
 			}
@@ -1605,6 +1619,9 @@ func (m *Manager) ContinueSession(ctx context.Context, req ContinueSessionConfig
 	if req.MaxTurns > 0 {
 		config.MaxTurns = req.MaxTurns
 	}
+	if req.Model != "" {
+		config.Model = req.Model
+	}
 
 	// Create new session with parent reference
 	sessionID := uuid.New().String()
@@ -1628,6 +1645,11 @@ func (m *Manager) ContinueSession(ctx context.Context, req ContinueSessionConfig
 
 	// Inherit title from parent session
 	dbSession.Title = parentSession.Title
+	// Inherit stall watchdog thresholds from parent
+	dbSession.StallThresholdMs = parentSession.StallThresholdMs
+	dbSession.StallInterruptThresholdMs = parentSession.StallInterruptThresholdMs
+	// Resuming needs the backend that holds the conversation
+	dbSession.Runner = parentSession.Runner
 	// Explicitly ensure inherited values are stored (in case NewSessionFromConfig didn't capture them)
 	if dbSession.Model == "" && parentSession.Model != "" {
 		dbSession.Model = parentSession.Model
@@ -1635,6 +1657,11 @@ func (m *Manager) ContinueSession(ctx context.Context, req ContinueSessionConfig
 	if dbSession.WorkingDir == "" && parentSession.WorkingDir != "" {
 		dbSession.WorkingDir = parentSession.WorkingDir
 	}
+	// Continued sessions stay in their parent's project
+	dbSession.ProjectID = parentSession.ProjectID
+	if dbSession.ProjectID == "" {
+		dbSession.ProjectID = m.resolveProject(ctx, dbSession.WorkingDir)
+	}
 
 	// Inherit proxy configuration from parent or use provided values
 	if req.ProxyEnabled || parentSession.ProxyEnabled {
@@ -1732,6 +1759,15 @@ func (m *Manager) ContinueSession(ctx context.Context, req ContinueSessionConfig
 		}
 	}
 
+	// Continued sessions run with the parent's effective configuration
+	if effective, err := m.store.GetSessionEffectiveConfig(ctx, req.ParentSessionID); err != nil {
+		slog.Warn("failed to get parent effective config", "error", err)
+	} else if effective != "" {
+		if err := m.store.SetSessionEffectiveConfig(ctx, sessionID, effective); err != nil {
+			slog.Error("failed to store effective config", "error", err)
+		}
+	}
+
 	// Set permission prompt tool to use the injected MCP server
 	if config.PermissionPromptTool == "" {
 		config.PermissionPromptTool = "mcp__codelayer__request_permission"
@@ -1766,8 +1802,8 @@ func (m *Manager) ContinueSession(ctx context.Context, req ContinueSessionConfig
 			"has_openrouter_key", os.Getenv("OPENROUTER_API_KEY") != "")
 	}
 
-	// Get Claude client (will attempt initialization if needed)
-	client, err := m.getClaudeClient()
+	// Resolve the parent's runner backend (will attempt Claude initialization if needed)
+	runner, err := m.getRunner(parentSession.Runner)
 	if err != nil {
 		return nil, fmt.Errorf("cannot continue session: %w", err)
 	}
@@ -1783,7 +1819,7 @@ func (m *Manager) ContinueSession(ctx context.Context, req ContinueSessionConfig
 		"proxy_base_url", dbSession.ProxyBaseURL,
 		"proxy_model", dbSession.ProxyModelOverride)
 
-	claudeSession, err := client.Launch(config)
+	wrappedSession, err := runner.Launch(config)
 	if err != nil {
 		slog.Error("failed to resume Claude session from failed parent",
 			"session_id", sessionID,
@@ -1795,9 +1831,6 @@ func (m *Manager) ContinueSession(ctx context.Context, req ContinueSessionConfig
 		return nil, fmt.Errorf("failed to launch resumed Claude session: %w", err)
 	}
 
-	// Wrap the session for storage
-	wrappedSession := NewClaudeSessionWrapper(claudeSession)
-
 	// Store active Claude process
 	m.mu.Lock()
 	m.activeProcesses[sessionID] = wrappedSession
@@ -1816,16 +1849,13 @@ func (m *Manager) ContinueSession(ctx context.Context, req ContinueSessionConfig
 
 	// Publish status change event
 	if m.eventBus != nil {
-		m.eventBus.Publish(bus.Event{
-			Type: bus.EventSessionStatusChanged,
-			Data: map[string]interface{}{
-				"session_id":        sessionID,
-				"run_id":            runID,
-				"parent_session_id": req.ParentSessionID,
-				"old_status":        string(StatusStarting),
-				"new_status":        string(StatusRunning),
-			},
-		})
+		m.eventBus.Publish(bus.NewEvent(bus.SessionStatusChangedPayload{
+			SessionID:       sessionID,
+			RunID:           runID,
+			ParentSessionID: req.ParentSessionID,
+			OldStatus:       string(StatusStarting),
+			NewStatus:       string(StatusRunning),
+		}))
 	}
 
 	// Store query for injection after Claude session ID is captured
@@ -1908,14 +1938,11 @@ func (m *Manager) InterruptSession(ctx context.Context, sessionID string) error
 
 	// Publish status change event
 	if m.eventBus != nil {
-		m.eventBus.Publish(bus.Event{
-			Type: bus.EventSessionStatusChanged,
-			Data: map[string]interface{}{
-				"session_id": sessionID,
-				"old_status": string(StatusRunning),
-				"new_status": string(StatusInterrupting),
-			},
-		})
+		m.eventBus.Publish(bus.NewEvent(bus.SessionStatusChangedPayload{
+			SessionID: sessionID,
+			OldStatus: string(StatusRunning),
+			NewStatus: string(StatusInterrupting),
+		}))
 	}
 
 	return nil
@@ -1923,8 +1950,8 @@ func (m *Manager) InterruptSession(ctx context.Context, sessionID string) error
 
 // launchDraftWithConfig launches a draft session using the existing launch flow
 func (m *Manager) launchDraftWithConfig(ctx context.Context, sessionID, runID string, config LaunchSessionConfig) error {
-	// Get Claude client (will attempt initialization if needed)
-	client, err := m.getClaudeClient()
+	// Resolve the runner backend (will attempt Claude initialization if needed)
+	runner, err := m.getRunner(config.Runner)
 	if err != nil {
 		return fmt.Errorf("cannot launch session: %w", err)
 	}
@@ -2004,18 +2031,16 @@ func (m *Manager) launchDraftWithConfig(ctx context.Context, sessionID, runID st
 		"query", claudeConfig.Query,
 		"working_dir", claudeConfig.WorkingDir)
 
-	claudeSession, err := client.Launch(claudeConfig)
+	wrappedSession, err := runner.Launch(claudeConfig)
 	if err != nil {
 		slog.Error("failed to launch Claude session from draft",
 			"session_id", sessionID,
+			"runner", runner.Name(),
 			"error", err)
 		m.updateSessionStatus(ctx, sessionID, StatusFailed, err.Error())
 		return fmt.Errorf("failed to launch Claude session: %w", err)
 	}
 
-	// Wrap the session for storage
-	wrappedSession := NewClaudeSessionWrapper(claudeSession)
-
 	// Store active Claude process
 	m.mu.Lock()
 	m.activeProcesses[sessionID] = wrappedSession
@@ -2035,16 +2060,12 @@ func (m *Manager) launchDraftWithConfig(ctx context.Context, sessionID, runID st
 
 	// Publish status change event
 	if m.eventBus != nil {
-		event := bus.Event{
-			Type: bus.EventSessionStatusChanged,
-			Data: map[string]interface{}{
-				"session_id": sessionID,
-				"run_id":     runID,
-				"old_status": string(StatusStarting),
-				"new_status": string(StatusRunning),
-			},
-		}
-		m.eventBus.Publish(event)
+		m.eventBus.Publish(bus.NewEvent(bus.SessionStatusChangedPayload{
+			SessionID: sessionID,
+			RunID:     runID,
+			OldStatus: string(StatusStarting),
+			NewStatus: string(StatusRunning),
+		}))
 	}
 
 	// Store query for injection after Claude session ID is captured
@@ -2254,6 +2275,7 @@ func (m *Manager) LaunchDraftSession(ctx context.Context, sessionID string, prom
 		ProxyBaseURL:               sess.ProxyBaseURL,
 		ProxyModelOverride:         sess.ProxyModelOverride,
 		ProxyAPIKey:                sess.ProxyAPIKey,
+		Runner:                     sess.Runner,
 	}
 
 	// If dangerously skip permissions has an expiry, calculate the timeout
@@ -2457,6 +2479,11 @@ func (m *Manager) UpdateSessionSettings(ctx context.Context, sessionID string, u
 			"session_id", sessionID,
 			"additional_directories", *updates.AdditionalDirectories)
 	}
+	// Moving a session to another directory can move it to another project
+	if updates.WorkingDir != nil && updates.ProjectID == nil {
+		projectID := m.resolveProject(ctx, *updates.WorkingDir)
+		updates.ProjectID = &projectID
+	}
 	// First update the store
 	if err := m.store.UpdateSession(ctx, sessionID, updates); err != nil {
 		return err
@@ -2465,15 +2492,27 @@ func (m *Manager) UpdateSessionSettings(ctx context.Context, sessionID string, u
 	// If auto-accept edits was updated, publish the settings changed event
 	if updates.AutoAcceptEdits != nil {
 		if m.eventBus != nil {
-			m.eventBus.Publish(bus.Event{
-				Type: bus.EventSessionSettingsChanged,
-				Data: map[string]interface{}{
-					"session_id":        sessionID,
-					"auto_accept_edits": *updates.AutoAcceptEdits,
-				},
-			})
+			m.eventBus.Publish(bus.NewEvent(bus.SessionSettingsChangedPayload{
+				SessionID:       sessionID,
+				AutoAcceptEdits: updates.AutoAcceptEdits,
+			}))
 		}
 	}
 
 	return nil
 }
+
+// ActiveProcessIDs returns the process ID of each running claude process by
+// session ID. Processes that have not started yet are omitted.
+func (m *Manager) ActiveProcessIDs() map[string]int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	pids := make(map[string]int, len(m.activeProcesses))
+	for sessionID, claudeSession := range m.activeProcesses {
+		if pid := claudeSession.GetPID(); pid > 0 {
+			pids[sessionID] = pid
+		}
+	}
+	return pids
+}
@@ -244,6 +244,8 @@ func TestContinueSession_AllowsFailedSessionWithValidRequirements(t *testing.T)
 			return nil
 		})
 	mockStore.EXPECT().StoreMCPServers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStore.EXPECT().GetSessionEffectiveConfig(gomock.Any(), gomock.Any()).Return("", nil).AnyTimes()
+	mockStore.EXPECT().UpsertProject(gomock.Any(), gomock.Any()).Return(&store.Project{ID: "project-1"}, nil).AnyTimes()
 	mockStore.EXPECT().UpdateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	req := ContinueSessionConfig{
@@ -313,6 +315,10 @@ func TestLaunchSession_SetsMCPEnvironment(t *testing.T) {
 			return nil
 		})
 
+	// Record the effective config
+	mockStore.EXPECT().SetSessionEffectiveConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mockStore.EXPECT().UpsertProject(gomock.Any(), gomock.Any()).Return(&store.Project{ID: "project-1"}, nil)
+
 	// Update session to running
 	mockStore.EXPECT().UpdateSession(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
@@ -459,6 +465,8 @@ func TestContinueSession_CreatesNewSessionWithParentReference(t *testing.T) {
 
 	// Expect MCP servers to be stored (may or may not be called)
 	mockStore.EXPECT().StoreMCPServers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStore.EXPECT().GetSessionEffectiveConfig(gomock.Any(), gomock.Any()).Return("", nil).AnyTimes()
+	mockStore.EXPECT().UpsertProject(gomock.Any(), gomock.Any()).Return(&store.Project{ID: "project-1"}, nil).AnyTimes()
 
 	// Expect status update to running (we can't test the full flow without mocking Claude client)
 	// May be called twice if Claude fails to launch in background
@@ -551,6 +559,8 @@ func TestContinueSession_HandlesOptionalOverrides(t *testing.T) {
 
 	// Expect MCP servers to be stored (if MCPConfig override is provided)
 	mockStore.EXPECT().StoreMCPServers(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockStore.EXPECT().GetSessionEffectiveConfig(gomock.Any(), gomock.Any()).Return("", nil).AnyTimes()
+	mockStore.EXPECT().UpsertProject(gomock.Any(), gomock.Any()).Return(&store.Project{ID: "project-1"}, nil).AnyTimes()
 
 	// Expect status update
 	// May be called twice if Claude fails to launch in background
@@ -626,6 +636,50 @@ func TestInterruptSession(t *testing.T) {
 	}
 }
 
+func TestUpdateSessionStatus_PublishesFailure(t *testing.T) {
+	ctx := context.Background()
+	memoryStore := store.NewMemoryStore()
+	eventBus := bus.NewEventBus()
+	manager, err := NewManager(eventBus, memoryStore, "")
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	if err := memoryStore.CreateSession(ctx, &store.Session{
+		ID: "sess-1", RunID: "run-1", Status: store.SessionStatusRunning,
+	}); err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+	sub := eventBus.Subscribe(ctx, bus.EventFilter{Types: []bus.EventType{bus.EventSessionStatusChanged}})
+
+	manager.updateSessionStatus(ctx, "sess-1", StatusFailed, "process exited")
+
+	select {
+	case event := <-sub.Channel:
+		changed, err := bus.DecodePayload[bus.SessionStatusChangedPayload](event)
+		if err != nil {
+			t.Fatalf("Failed to decode event: %v", err)
+		}
+		want := bus.SessionStatusChangedPayload{
+			SessionID: "sess-1", RunID: "run-1",
+			OldStatus: store.SessionStatusRunning, NewStatus: string(StatusFailed),
+		}
+		if changed != want {
+			t.Errorf("Expected %+v, got %+v", want, changed)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected a session status change event")
+	}
+
+	sess, err := memoryStore.GetSession(ctx, "sess-1")
+	if err != nil {
+		t.Fatalf("Failed to get session: %v", err)
+	}
+	if sess.Status != string(StatusFailed) || sess.ErrorMessage != "process exited" {
+		t.Errorf("Expected failed session with error, got status %q error %q", sess.Status, sess.ErrorMessage)
+	}
+}
+
 func TestContinueSession_InterruptsRunningSession(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
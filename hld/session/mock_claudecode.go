@@ -68,6 +68,20 @@ func (mr *MockClaudeSessionMockRecorder) GetID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetID", reflect.TypeOf((*MockClaudeSession)(nil).GetID))
 }
 
+// GetPID mocks base method.
+func (m *MockClaudeSession) GetPID() int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPID")
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// GetPID indicates an expected call of GetPID.
+func (mr *MockClaudeSessionMockRecorder) GetPID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPID", reflect.TypeOf((*MockClaudeSession)(nil).GetPID))
+}
+
 // Interrupt mocks base method.
 func (m *MockClaudeSession) Interrupt() error {
 	m.ctrl.T.Helper()
@@ -95,18 +95,14 @@ func (pm *PermissionMonitor) disableDangerouslySkipPermissions(ctx context.Conte
 
 	// Publish event to notify clients
 	if pm.eventBus != nil {
-		event := bus.Event{
-			Type:      bus.EventSessionSettingsChanged,
-			Timestamp: time.Now(),
-			Data: map[string]interface{}{
-				"session_id":                   session.ID,
-				"run_id":                       session.RunID,
-				"dangerously_skip_permissions": false,
-				"reason":                       string(bus.SessionSettingsChangeReasonExpired),
-				"expired_at":                   session.DangerouslySkipPermissionsExpiresAt,
-			},
-		}
-		pm.eventBus.Publish(event)
+		disabled := false
+		pm.eventBus.Publish(bus.NewEvent(bus.SessionSettingsChangedPayload{
+			SessionID:                  session.ID,
+			RunID:                      session.RunID,
+			DangerouslySkipPermissions: &disabled,
+			Reason:                     bus.SessionSettingsChangeReasonExpired,
+			ExpiredAt:                  session.DangerouslySkipPermissionsExpiresAt,
+		}))
 	}
 
 	slog.Info("disabled expired dangerous skip permissions",
@@ -0,0 +1,583 @@
+package session
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+const (
+	// ProjectConfigDir is the directory in a repository holding its agent policy
+	ProjectConfigDir = ".humanlayer"
+
+	// ProjectConfigFile is the project configuration file in ProjectConfigDir
+	ProjectConfigFile = "project.json"
+)
+
+// Sources of effective configuration values
+const (
+	ConfigSourceRequest = "request" // Set by the launch request
+	ConfigSourceProject = "project" // Set by the project configuration file
+	ConfigSourceMerged  = "merged"  // Combined from the request and the project
+)
+
+// ProjectConfig is a repository's agent policy, read from
+// .humanlayer/project.json in a session's working directory. It is merged
+// into every session launched there:
+//
+//   - model and allowed_tools apply when the launch request does not set them
+//   - append_system_prompt is added before the request's appended prompt
+//   - disallowed_tools, additional_directories and mcp_servers are combined
+//     with the request's, and the request wins when a server name is in both
+//   - approvals.auto_accept_edits turns auto-accept on for new sessions, and
+//     approvals.allow_dangerously_skip_permissions set to false rejects
+//     launches that bypass approvals
+//   - budgets.max_turns caps the request's max turns, and the stall
+//     thresholds apply when the request does not set them
+//
+// A repository is not trusted by being cloned, so mcp_servers, additional
+// directories outside the project and approvals.auto_accept_edits only take
+// effect once the daemon has recorded trust in the file's exact content.
+type ProjectConfig struct {
+	Model                 string                          `json:"model,omitempty"`
+	AppendSystemPrompt    string                          `json:"append_system_prompt,omitempty"`
+	AllowedTools          []string                        `json:"allowed_tools,omitempty"`
+	DisallowedTools       []string                        `json:"disallowed_tools,omitempty"`
+	AdditionalDirectories []string                        `json:"additional_directories,omitempty"` // Relative paths are resolved against the project root
+	MCPServers            map[string]claudecode.MCPServer `json:"mcp_servers,omitempty"`
+	Approvals             ProjectApprovals                `json:"approvals"`
+	Budgets               ProjectBudgets                  `json:"budgets"`
+
+	Digest string `json:"-"` // Identifies the file content trust is recorded for
+}
+
+// ProjectApprovals are the approval rules for a project's sessions
+type ProjectApprovals struct {
+	AutoAcceptEdits                 bool  `json:"auto_accept_edits,omitempty"`
+	AllowDangerouslySkipPermissions *bool `json:"allow_dangerously_skip_permissions,omitempty"` // Unset allows it
+}
+
+// ProjectBudgets limit how long a project's sessions may run
+type ProjectBudgets struct {
+	MaxTurns                  int    `json:"max_turns,omitempty"`
+	StallThresholdMs          *int64 `json:"stall_threshold_ms,omitempty"`
+	StallInterruptThresholdMs *int64 `json:"stall_interrupt_threshold_ms,omitempty"`
+}
+
+// ProjectConfigIssue is a single problem found in a project configuration file
+type ProjectConfigIssue struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ProjectConfigError is returned when a project configuration file cannot be
+// used. It lists every problem found.
+type ProjectConfigError struct {
+	Path   string
+	Issues []ProjectConfigIssue
+}
+
+func (e *ProjectConfigError) Error() string {
+	messages := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		if issue.Field == "" {
+			messages = append(messages, issue.Message)
+		} else {
+			messages = append(messages, fmt.Sprintf("%s: %s", issue.Field, issue.Message))
+		}
+	}
+	return fmt.Sprintf("invalid project config %s: %s", e.Path, strings.Join(messages, "; "))
+}
+
+// IsProjectConfigError reports whether err is caused by an invalid project
+// configuration file
+func IsProjectConfigError(err error) bool {
+	var configErr *ProjectConfigError
+	return errors.As(err, &configErr)
+}
+
+// ProjectConfigPath returns where the project configuration for a working
+// directory is read from
+func ProjectConfigPath(workingDir string) string {
+	return filepath.Join(workingDir, ProjectConfigDir, ProjectConfigFile)
+}
+
+// LoadProjectConfig reads and validates the project configuration for a
+// working directory. It returns nil without an error when there is none.
+func LoadProjectConfig(workingDir string) (*ProjectConfig, error) {
+	path := ProjectConfigPath(workingDir)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read project config: %w", err)
+	}
+	return ParseProjectConfig(path, data)
+}
+
+// ParseProjectConfig parses and validates project configuration file content.
+// Unknown fields are rejected so typos do not silently drop policy.
+func ParseProjectConfig(path string, data []byte) (*ProjectConfig, error) {
+	var config ProjectConfig
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, &ProjectConfigError{Path: path, Issues: []ProjectConfigIssue{{Message: jsonErrorMessage(data, err)}}}
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, &ProjectConfigError{Path: path, Issues: []ProjectConfigIssue{{Message: "unexpected content after the configuration object"}}}
+	}
+	if issues := config.Validate(); len(issues) > 0 {
+		return nil, &ProjectConfigError{Path: path, Issues: issues}
+	}
+	config.Digest = ProjectConfigDigest(data)
+	return &config, nil
+}
+
+// ProjectConfigDigest identifies project configuration file content, so trust
+// in one version of a file does not carry over to later edits
+func ProjectConfigDigest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// jsonErrorMessage adds the line number to JSON syntax errors
+func jsonErrorMessage(data []byte, err error) string {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		line := bytes.Count(data[:syntaxErr.Offset], []byte("\n")) + 1
+		return fmt.Sprintf("line %d: %v", line, err)
+	}
+	return err.Error()
+}
+
+// Validate returns every problem with the configuration
+func (c *ProjectConfig) Validate() []ProjectConfigIssue {
+	var issues []ProjectConfigIssue
+	add := func(field, format string, args ...interface{}) {
+		issues = append(issues, ProjectConfigIssue{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch claudecode.Model(c.Model) {
+	case "", claudecode.ModelOpus, claudecode.ModelSonnet, claudecode.ModelHaiku:
+	default:
+		add("model", "unknown model %q", c.Model)
+	}
+
+	disallowed := make(map[string]bool, len(c.DisallowedTools))
+	for i, tool := range c.DisallowedTools {
+		if strings.TrimSpace(tool) == "" {
+			add(fmt.Sprintf("disallowed_tools[%d]", i), "must not be empty")
+		}
+		disallowed[tool] = true
+	}
+	for i, tool := range c.AllowedTools {
+		field := fmt.Sprintf("allowed_tools[%d]", i)
+		if strings.TrimSpace(tool) == "" {
+			add(field, "must not be empty")
+		} else if disallowed[tool] {
+			add(field, "%q is also disallowed", tool)
+		}
+	}
+	for i, dir := range c.AdditionalDirectories {
+		if strings.TrimSpace(dir) == "" {
+			add(fmt.Sprintf("additional_directories[%d]", i), "must not be empty")
+		}
+	}
+
+	for _, name := range sortedKeys(c.MCPServers) {
+		server := c.MCPServers[name]
+		field := "mcp_servers." + name
+		switch {
+		case name == "codelayer":
+			add(field, "the codelayer server is provided by the daemon")
+		case server.Type == "http" && server.URL == "":
+			add(field, "http servers must have a url")
+		case server.Type != "" && server.Type != "http":
+			add(field, "unknown server type %q", server.Type)
+		case server.Command == "" && server.URL == "":
+			add(field, "must have a command or a url")
+		}
+	}
+
+	if c.Budgets.MaxTurns < 0 {
+		add("budgets.max_turns", "must not be negative")
+	}
+	if c.Budgets.StallThresholdMs != nil && *c.Budgets.StallThresholdMs < 0 {
+		add("budgets.stall_threshold_ms", "must not be negative")
+	}
+	if c.Budgets.StallInterruptThresholdMs != nil && *c.Budgets.StallInterruptThresholdMs < 0 {
+		add("budgets.stall_interrupt_threshold_ms", "must not be negative")
+	}
+	return issues
+}
+
+// ProjectConfigValidation is the result of validating a project configuration
+type ProjectConfigValidation struct {
+	Path   string               `json:"path"`
+	Exists bool                 `json:"exists"`
+	Valid  bool                 `json:"valid"`
+	Issues []ProjectConfigIssue `json:"issues"`
+}
+
+// ValidateProjectConfig checks the project configuration for a working
+// directory. When content is given it is validated in place of the file.
+func ValidateProjectConfig(workingDir string, content *string) (*ProjectConfigValidation, error) {
+	path := ProjectConfigPath(expandHome(workingDir))
+	result := &ProjectConfigValidation{Path: path, Issues: []ProjectConfigIssue{}}
+
+	var data []byte
+	if content != nil {
+		data = []byte(*content)
+		if _, err := os.Stat(path); err == nil {
+			result.Exists = true
+		}
+	} else {
+		var err error
+		data, err = os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			// No configuration is valid, launches use the request unchanged
+			result.Valid = true
+			return result, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read project config: %w", err)
+		}
+		result.Exists = true
+	}
+
+	var configErr *ProjectConfigError
+	if _, err := ParseProjectConfig(path, data); errors.As(err, &configErr) {
+		result.Issues = configErr.Issues
+	} else if err != nil {
+		return nil, err
+	}
+	result.Valid = len(result.Issues) == 0
+	return result, nil
+}
+
+// EffectiveConfig is the configuration a session was launched with after the
+// project configuration was merged into the launch request. Sources records
+// where each set field came from.
+type EffectiveConfig struct {
+	ProjectConfigPath          string            `json:"project_config_path,omitempty"`
+	Model                      string            `json:"model,omitempty"`
+	AppendSystemPrompt         string            `json:"append_system_prompt,omitempty"`
+	AllowedTools               []string          `json:"allowed_tools,omitempty"`
+	DisallowedTools            []string          `json:"disallowed_tools,omitempty"`
+	AdditionalDirectories      []string          `json:"additional_directories,omitempty"`
+	MCPServers                 []string          `json:"mcp_servers,omitempty"` // Names only, server settings may hold secrets
+	AutoAcceptEdits            bool              `json:"auto_accept_edits"`
+	DangerouslySkipPermissions bool              `json:"dangerously_skip_permissions"`
+	MaxTurns                   int               `json:"max_turns,omitempty"`
+	StallThresholdMs           *int64            `json:"stall_threshold_ms,omitempty"`
+	StallInterruptThresholdMs  *int64            `json:"stall_interrupt_threshold_ms,omitempty"`
+	Sources                    map[string]string `json:"sources"`
+
+	// ProjectConfigTrusted reports whether the project configuration was
+	// trusted, and Ignored lists the settings left out because it was not
+	ProjectConfigTrusted bool                 `json:"project_config_trusted"`
+	Ignored              []ProjectConfigIssue `json:"ignored,omitempty"`
+}
+
+// Reasons settings of an untrusted project configuration are ignored
+const (
+	untrustedMCPServer      = "MCP servers from an untrusted project configuration are ignored"
+	untrustedDirectory      = "directories outside the project from an untrusted project configuration are ignored"
+	untrustedAutoAcceptEdit = "auto-accepting edits from an untrusted project configuration is ignored"
+)
+
+// MergeProjectConfig applies a project configuration to a launch request in
+// place and returns the resulting effective configuration. project may be
+// nil, in which case the request is recorded unchanged. Unless trusted, the
+// project's MCP servers, directories outside projectRoot and auto-accept are
+// ignored and listed as such.
+func MergeProjectConfig(config *LaunchSessionConfig, project *ProjectConfig, projectRoot string, trusted bool) (*EffectiveConfig, error) {
+	sources := make(map[string]string)
+	source := func(field string, fromRequest, fromProject bool) {
+		switch {
+		case fromRequest && fromProject:
+			sources[field] = ConfigSourceMerged
+		case fromRequest:
+			sources[field] = ConfigSourceRequest
+		case fromProject:
+			sources[field] = ConfigSourceProject
+		}
+	}
+
+	var ignored []ProjectConfigIssue
+	ignore := func(field, message string) {
+		ignored = append(ignored, ProjectConfigIssue{Field: field, Message: message})
+	}
+
+	if project == nil {
+		project = &ProjectConfig{}
+	}
+
+	if project.Approvals.AllowDangerouslySkipPermissions != nil &&
+		!*project.Approvals.AllowDangerouslySkipPermissions && config.DangerouslySkipPermissions {
+		return nil, &ProjectConfigError{
+			Path:   ProjectConfigPath(projectRoot),
+			Issues: []ProjectConfigIssue{{Field: "approvals.allow_dangerously_skip_permissions", Message: "this project does not allow bypassing approvals"}},
+		}
+	}
+
+	// Model and allowed tools: the request wins
+	source("model", config.Model != "", config.Model == "" && project.Model != "")
+	if config.Model == "" {
+		config.Model = claudecode.Model(project.Model)
+	}
+	source("allowed_tools", len(config.AllowedTools) > 0, len(config.AllowedTools) == 0 && len(project.AllowedTools) > 0)
+	if len(config.AllowedTools) == 0 && len(project.AllowedTools) > 0 {
+		config.AllowedTools = append([]string(nil), project.AllowedTools...)
+	}
+
+	// The project's appended prompt comes first so the request can refine it
+	source("append_system_prompt", config.AppendSystemPrompt != "", project.AppendSystemPrompt != "")
+	switch {
+	case project.AppendSystemPrompt == "":
+	case config.AppendSystemPrompt == "":
+		config.AppendSystemPrompt = project.AppendSystemPrompt
+	default:
+		config.AppendSystemPrompt = project.AppendSystemPrompt + "\n\n" + config.AppendSystemPrompt
+	}
+
+	// Disallowed tools and additional directories accumulate
+	source("disallowed_tools", len(config.DisallowedTools) > 0, len(project.DisallowedTools) > 0)
+	config.DisallowedTools = appendUnique(config.DisallowedTools, project.DisallowedTools...)
+
+	projectDirs := make([]string, 0, len(project.AdditionalDirectories))
+	for i, dir := range project.AdditionalDirectories {
+		if dir == "~" || strings.HasPrefix(dir, "~/") {
+			dir = expandHome(dir)
+		} else if !filepath.IsAbs(dir) {
+			dir = filepath.Join(projectRoot, dir)
+		}
+		dir = filepath.Clean(dir)
+		if !trusted && !withinDir(projectRoot, dir) {
+			ignore(fmt.Sprintf("additional_directories[%d]", i), untrustedDirectory)
+			continue
+		}
+		projectDirs = append(projectDirs, dir)
+	}
+	source("additional_directories", len(config.AdditionalDirectories) > 0, len(projectDirs) > 0)
+	config.AdditionalDirectories = appendUnique(config.AdditionalDirectories, projectDirs...)
+
+	// MCP servers merge by name, the request's definition wins. The merge
+	// works on copies so the caller's config and map are left untouched.
+	projectServers := project.MCPServers
+	if !trusted {
+		for _, name := range sortedKeys(projectServers) {
+			ignore("mcp_servers."+name, untrustedMCPServer)
+		}
+		projectServers = nil
+	}
+	requestServers := config.MCPConfig != nil && len(config.MCPConfig.MCPServers) > 0
+	source("mcp_servers", requestServers, len(projectServers) > 0)
+	if len(projectServers) > 0 {
+		mcpConfig := claudecode.MCPConfig{}
+		if config.MCPConfig != nil {
+			mcpConfig = *config.MCPConfig
+		}
+		servers := make(map[string]claudecode.MCPServer, len(mcpConfig.MCPServers)+len(projectServers))
+		for name, server := range mcpConfig.MCPServers {
+			servers[name] = server
+		}
+		for name, server := range projectServers {
+			if _, exists := servers[name]; !exists {
+				servers[name] = server
+			}
+		}
+		mcpConfig.MCPServers = servers
+		config.MCPConfig = &mcpConfig
+	}
+
+	projectAutoAccept := project.Approvals.AutoAcceptEdits
+	if projectAutoAccept && !trusted {
+		ignore("approvals.auto_accept_edits", untrustedAutoAcceptEdit)
+		projectAutoAccept = false
+	}
+	source("auto_accept_edits", config.AutoAcceptEdits, projectAutoAccept)
+	config.AutoAcceptEdits = config.AutoAcceptEdits || projectAutoAccept
+	source("dangerously_skip_permissions", config.DangerouslySkipPermissions, false)
+
+	// The project's max turns is a ceiling
+	if limit := project.Budgets.MaxTurns; limit > 0 {
+		source("max_turns", config.MaxTurns > 0 && config.MaxTurns <= limit, config.MaxTurns == 0 || config.MaxTurns > limit)
+		if config.MaxTurns == 0 || config.MaxTurns > limit {
+			config.MaxTurns = limit
+		}
+	} else {
+		source("max_turns", config.MaxTurns > 0, false)
+	}
+
+	source("stall_threshold_ms", config.StallThresholdMs != nil, config.StallThresholdMs == nil && project.Budgets.StallThresholdMs != nil)
+	if config.StallThresholdMs == nil {
+		config.StallThresholdMs = project.Budgets.StallThresholdMs
+	}
+	source("stall_interrupt_threshold_ms", config.StallInterruptThresholdMs != nil, config.StallInterruptThresholdMs == nil && project.Budgets.StallInterruptThresholdMs != nil)
+	if config.StallInterruptThresholdMs == nil {
+		config.StallInterruptThresholdMs = project.Budgets.StallInterruptThresholdMs
+	}
+
+	effective := &EffectiveConfig{
+		Model:                      string(config.Model),
+		AppendSystemPrompt:         config.AppendSystemPrompt,
+		AllowedTools:               config.AllowedTools,
+		DisallowedTools:            config.DisallowedTools,
+		AdditionalDirectories:      config.AdditionalDirectories,
+		AutoAcceptEdits:            config.AutoAcceptEdits,
+		DangerouslySkipPermissions: config.DangerouslySkipPermissions,
+		MaxTurns:                   config.MaxTurns,
+		StallThresholdMs:           config.StallThresholdMs,
+		StallInterruptThresholdMs:  config.StallInterruptThresholdMs,
+		Sources:                    sources,
+		ProjectConfigTrusted:       trusted,
+		Ignored:                    ignored,
+	}
+	if config.MCPConfig != nil {
+		for name := range config.MCPConfig.MCPServers {
+			effective.MCPServers = append(effective.MCPServers, name)
+		}
+		sort.Strings(effective.MCPServers)
+	}
+	return effective, nil
+}
+
+// applyProjectConfig merges the project configuration from the launch's
+// working directory into the config
+func (m *Manager) applyProjectConfig(ctx context.Context, config *LaunchSessionConfig) (*EffectiveConfig, error) {
+	// Launches without a working directory run in the daemon's
+	workingDir := config.WorkingDir
+	if workingDir == "" {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return MergeProjectConfig(config, nil, "", false)
+		}
+		workingDir = cwd
+	}
+	workingDir = projectRoot(workingDir)
+
+	project, err := LoadProjectConfig(workingDir)
+	if err != nil {
+		return nil, err
+	}
+	trusted, err := projectConfigTrusted(ctx, m.store, workingDir, project)
+	if err != nil {
+		return nil, err
+	}
+	effective, err := MergeProjectConfig(config, project, workingDir, trusted)
+	if err != nil {
+		return nil, err
+	}
+	if project != nil {
+		effective.ProjectConfigPath = ProjectConfigPath(workingDir)
+	}
+	return effective, nil
+}
+
+// ProjectConfigTrust is the trust recorded for a project's configuration
+type ProjectConfigTrust struct {
+	Path    string `json:"path"`
+	Trusted bool   `json:"trusted"`
+	Digest  string `json:"digest,omitempty"` // Digest of the trusted file content
+}
+
+// TrustProjectConfig records trust in the current content of the project
+// configuration for a working directory, or revokes it. Only valid files can
+// be trusted, and editing a trusted file revokes its trust.
+func TrustProjectConfig(ctx context.Context, s store.ConversationStore, workingDir string, trusted bool) (*ProjectConfigTrust, error) {
+	root := projectRoot(workingDir)
+	result := &ProjectConfigTrust{Path: ProjectConfigPath(root)}
+	if !trusted {
+		if err := s.DeleteProjectConfigTrust(ctx, root); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	project, err := LoadProjectConfig(root)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, &ProjectConfigError{Path: result.Path, Issues: []ProjectConfigIssue{{Message: "there is no project configuration to trust"}}}
+	}
+	if err := s.SetProjectConfigTrust(ctx, root, project.Digest); err != nil {
+		return nil, err
+	}
+	result.Trusted = true
+	result.Digest = project.Digest
+	return result, nil
+}
+
+// ProjectConfigTrusted reports whether the project configuration for a
+// working directory is trusted as it is on disk
+func ProjectConfigTrusted(ctx context.Context, s store.ConversationStore, workingDir string) (bool, error) {
+	root := projectRoot(workingDir)
+	project, err := LoadProjectConfig(root)
+	if err != nil && !IsProjectConfigError(err) {
+		return false, err
+	}
+	return projectConfigTrusted(ctx, s, root, project)
+}
+
+func projectConfigTrusted(ctx context.Context, s store.ConversationStore, root string, project *ProjectConfig) (bool, error) {
+	if project == nil {
+		return false, nil
+	}
+	digest, err := s.GetProjectConfigTrust(ctx, root)
+	if err != nil {
+		return false, fmt.Errorf("failed to check project config trust: %w", err)
+	}
+	return digest != "" && digest == project.Digest, nil
+}
+
+// projectRoot is the key trust is recorded under for a working directory
+func projectRoot(workingDir string) string {
+	return filepath.Clean(expandHome(workingDir))
+}
+
+// withinDir reports whether path is dir or inside it
+func withinDir(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// sortedKeys returns the names of servers in order
+func sortedKeys(servers map[string]claudecode.MCPServer) []string {
+	names := make([]string, 0, len(servers))
+	for name := range servers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// appendUnique appends the values not already in list
+func appendUnique(list []string, values ...string) []string {
+	seen := make(map[string]bool, len(list)+len(values))
+	for _, value := range list {
+		seen[value] = true
+	}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			list = append(list, value)
+		}
+	}
+	return list
+}
@@ -0,0 +1,299 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeProjectConfig(t *testing.T, dir, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ProjectConfigDir), 0755))
+	require.NoError(t, os.WriteFile(ProjectConfigPath(dir), []byte(content), 0644))
+}
+
+func TestParseProjectConfig(t *testing.T) {
+	config, err := ParseProjectConfig("project.json", []byte(`{
+		"model": "sonnet",
+		"disallowed_tools": ["WebFetch"],
+		"mcp_servers": {"linear": {"command": "npx", "args": ["linear-mcp"]}},
+		"approvals": {"auto_accept_edits": true},
+		"budgets": {"max_turns": 20}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "sonnet", config.Model)
+	assert.Equal(t, "npx", config.MCPServers["linear"].Command)
+	assert.True(t, config.Approvals.AutoAcceptEdits)
+	assert.Equal(t, 20, config.Budgets.MaxTurns)
+
+	// Every problem is reported
+	_, err = ParseProjectConfig("project.json", []byte(`{
+		"model": "gpt",
+		"allowed_tools": ["Bash"],
+		"disallowed_tools": ["Bash"],
+		"mcp_servers": {"codelayer": {"command": "x"}, "remote": {"type": "http"}},
+		"budgets": {"max_turns": -1}
+	}`))
+	var configErr *ProjectConfigError
+	require.ErrorAs(t, err, &configErr)
+	fields := make([]string, 0, len(configErr.Issues))
+	for _, issue := range configErr.Issues {
+		fields = append(fields, issue.Field)
+	}
+	assert.Equal(t, []string{"model", "allowed_tools[0]", "mcp_servers.codelayer", "mcp_servers.remote", "budgets.max_turns"}, fields)
+
+	_, err = ParseProjectConfig("project.json", []byte(`{"modle": "opus"}`))
+	require.ErrorAs(t, err, &configErr)
+	assert.Contains(t, configErr.Issues[0].Message, "modle")
+
+	_, err = ParseProjectConfig("project.json", []byte("{\n  \"model\": \"opus\",\n}"))
+	require.ErrorAs(t, err, &configErr)
+	assert.Contains(t, configErr.Issues[0].Message, "line 3")
+}
+
+func TestMergeProjectConfig(t *testing.T) {
+	thirty := int64(30000)
+	project := &ProjectConfig{
+		Model:                 "opus",
+		AppendSystemPrompt:    "Follow CONTRIBUTING.md.",
+		AllowedTools:          []string{"Read", "Edit"},
+		DisallowedTools:       []string{"WebFetch"},
+		AdditionalDirectories: []string{"../shared", "/opt/docs"},
+		MCPServers: map[string]claudecode.MCPServer{
+			"linear": {Command: "npx"},
+			"github": {Command: "project-github"},
+		},
+		Approvals: ProjectApprovals{AutoAcceptEdits: true},
+		Budgets:   ProjectBudgets{MaxTurns: 10, StallThresholdMs: &thirty},
+	}
+
+	t.Run("project fills in unset fields", func(t *testing.T) {
+		config := LaunchSessionConfig{SessionConfig: claudecode.SessionConfig{Query: "q"}}
+		effective, err := MergeProjectConfig(&config, project, "/src/app", true)
+		require.NoError(t, err)
+
+		assert.Equal(t, claudecode.ModelOpus, config.Model)
+		assert.Equal(t, "Follow CONTRIBUTING.md.", config.AppendSystemPrompt)
+		assert.Equal(t, []string{"Read", "Edit"}, config.AllowedTools)
+		assert.Equal(t, []string{"/src/shared", "/opt/docs"}, config.AdditionalDirectories)
+		assert.True(t, config.AutoAcceptEdits)
+		assert.Equal(t, 10, config.MaxTurns)
+		assert.Equal(t, &thirty, config.StallThresholdMs)
+		assert.Equal(t, []string{"github", "linear"}, effective.MCPServers)
+		assert.Equal(t, ConfigSourceProject, effective.Sources["model"])
+		assert.Equal(t, ConfigSourceProject, effective.Sources["max_turns"])
+	})
+
+	t.Run("request takes precedence", func(t *testing.T) {
+		ten := int64(10000)
+		requestServers := map[string]claudecode.MCPServer{"github": {Command: "request-github"}}
+		config := LaunchSessionConfig{
+			SessionConfig: claudecode.SessionConfig{
+				Query:              "q",
+				Model:              claudecode.ModelHaiku,
+				AppendSystemPrompt: "Be brief.",
+				AllowedTools:       []string{"Read"},
+				DisallowedTools:    []string{"Bash", "WebFetch"},
+				MaxTurns:           50,
+				MCPConfig:          &claudecode.MCPConfig{MCPServers: requestServers},
+			},
+			StallThresholdMs: &ten,
+		}
+		effective, err := MergeProjectConfig(&config, project, "/src/app", true)
+		require.NoError(t, err)
+
+		assert.Equal(t, claudecode.ModelHaiku, config.Model)
+		assert.Equal(t, []string{"Read"}, config.AllowedTools)
+		assert.Equal(t, "Follow CONTRIBUTING.md.\n\nBe brief.", config.AppendSystemPrompt)
+		assert.Equal(t, []string{"Bash", "WebFetch"}, config.DisallowedTools)
+		assert.Equal(t, "request-github", config.MCPConfig.MCPServers["github"].Command)
+		assert.Equal(t, "npx", config.MCPConfig.MCPServers["linear"].Command)
+		assert.Len(t, requestServers, 1, "the request's servers are copied, not merged into")
+		assert.Equal(t, &ten, config.StallThresholdMs)
+		// The project's max turns is a ceiling
+		assert.Equal(t, 10, config.MaxTurns)
+
+		assert.Equal(t, ConfigSourceRequest, effective.Sources["model"])
+		assert.Equal(t, ConfigSourceMerged, effective.Sources["append_system_prompt"])
+		assert.Equal(t, ConfigSourceMerged, effective.Sources["disallowed_tools"])
+		assert.Equal(t, ConfigSourceProject, effective.Sources["max_turns"])
+		assert.Equal(t, ConfigSourceRequest, effective.Sources["stall_threshold_ms"])
+	})
+
+	t.Run("untrusted projects cannot widen access", func(t *testing.T) {
+		config := LaunchSessionConfig{SessionConfig: claudecode.SessionConfig{Query: "q"}}
+		effective, err := MergeProjectConfig(&config, project, "/src/app", false)
+		require.NoError(t, err)
+
+		assert.Nil(t, config.MCPConfig)
+		assert.Empty(t, config.AdditionalDirectories)
+		assert.False(t, config.AutoAcceptEdits)
+		// Settings that only narrow what the session may do still apply
+		assert.Equal(t, []string{"WebFetch"}, config.DisallowedTools)
+		assert.Equal(t, 10, config.MaxTurns)
+
+		assert.False(t, effective.ProjectConfigTrusted)
+		fields := make([]string, 0, len(effective.Ignored))
+		for _, issue := range effective.Ignored {
+			fields = append(fields, issue.Field)
+		}
+		assert.Equal(t, []string{
+			"additional_directories[0]", "additional_directories[1]",
+			"mcp_servers.github", "mcp_servers.linear", "approvals.auto_accept_edits",
+		}, fields)
+
+		// Directories inside the project need no trust
+		inside := &ProjectConfig{AdditionalDirectories: []string{"docs", "/src/app/vendor"}}
+		config = LaunchSessionConfig{}
+		effective, err = MergeProjectConfig(&config, inside, "/src/app", false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/src/app/docs", "/src/app/vendor"}, config.AdditionalDirectories)
+		assert.Empty(t, effective.Ignored)
+	})
+
+	t.Run("projects can forbid bypassing approvals", func(t *testing.T) {
+		forbid := false
+		strict := &ProjectConfig{Approvals: ProjectApprovals{AllowDangerouslySkipPermissions: &forbid}}
+
+		config := LaunchSessionConfig{DangerouslySkipPermissions: true}
+		_, err := MergeProjectConfig(&config, strict, "/src/app", false)
+		assert.True(t, IsProjectConfigError(err))
+
+		config = LaunchSessionConfig{}
+		_, err = MergeProjectConfig(&config, strict, "/src/app", false)
+		assert.NoError(t, err)
+	})
+
+	t.Run("without a project the request is unchanged", func(t *testing.T) {
+		config := LaunchSessionConfig{SessionConfig: claudecode.SessionConfig{Query: "q", MaxTurns: 3}}
+		effective, err := MergeProjectConfig(&config, nil, "/src/app", false)
+		require.NoError(t, err)
+		assert.Equal(t, 3, config.MaxTurns)
+		assert.Nil(t, config.MCPConfig)
+		assert.Equal(t, map[string]string{"max_turns": ConfigSourceRequest}, effective.Sources)
+	})
+}
+
+func TestValidateProjectConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	result, err := ValidateProjectConfig(dir, nil)
+	require.NoError(t, err)
+	assert.False(t, result.Exists)
+	assert.True(t, result.Valid)
+	assert.Equal(t, ProjectConfigPath(dir), result.Path)
+
+	writeProjectConfig(t, dir, `{"budgets": {"max_turns": -5}}`)
+	result, err = ValidateProjectConfig(dir, nil)
+	require.NoError(t, err)
+	assert.True(t, result.Exists)
+	assert.False(t, result.Valid)
+	require.Len(t, result.Issues, 1)
+	assert.Equal(t, "budgets.max_turns", result.Issues[0].Field)
+
+	// Content is checked in place of the file
+	content := `{"model": "haiku"}`
+	result, err = ValidateProjectConfig(dir, &content)
+	require.NoError(t, err)
+	assert.True(t, result.Exists)
+	assert.True(t, result.Valid)
+}
+
+func TestManager_LaunchMergesProjectConfig(t *testing.T) {
+	ctx := context.Background()
+	testStore, err := store.NewSQLiteStore(testutil.DatabasePath(t, "project-config"))
+	require.NoError(t, err)
+	defer func() { _ = testStore.Close() }()
+
+	manager, err := NewManager(bus.NewEventBus(), testStore, "")
+	require.NoError(t, err)
+	manager.RegisterRunner(NewScriptedRunner("scripted", []claudecode.StreamEvent{
+		{Type: "system", Subtype: "init"},
+		{Type: "result", Subtype: "success", Result: "done"},
+	}, 0))
+
+	dir := t.TempDir()
+	writeProjectConfig(t, dir, `{
+		"model": "sonnet",
+		"disallowed_tools": ["WebFetch"],
+		"approvals": {"auto_accept_edits": true, "allow_dangerously_skip_permissions": false}
+	}`)
+
+	// Until the project is trusted it cannot turn on auto-accept
+	sess, err := manager.LaunchSession(ctx, LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{Query: "tidy up", WorkingDir: dir},
+		Runner:        "scripted",
+	}, false)
+	require.NoError(t, err)
+	dbSession, err := testStore.GetSession(ctx, sess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "sonnet", dbSession.Model)
+	assert.False(t, dbSession.AutoAcceptEdits)
+
+	trust, err := TrustProjectConfig(ctx, testStore, dir, true)
+	require.NoError(t, err)
+	assert.True(t, trust.Trusted)
+	trusted, err := ProjectConfigTrusted(ctx, testStore, dir)
+	require.NoError(t, err)
+	assert.True(t, trusted)
+
+	sess, err = manager.LaunchSession(ctx, LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{Query: "tidy up", WorkingDir: dir},
+		Runner:        "scripted",
+	}, false)
+	require.NoError(t, err)
+
+	dbSession, err = testStore.GetSession(ctx, sess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "sonnet", dbSession.Model)
+	assert.Contains(t, dbSession.DisallowedTools, "WebFetch")
+	assert.True(t, dbSession.AutoAcceptEdits)
+
+	recorded, err := testStore.GetSessionEffectiveConfig(ctx, sess.ID)
+	require.NoError(t, err)
+	var effective EffectiveConfig
+	require.NoError(t, json.Unmarshal([]byte(recorded), &effective))
+	assert.Equal(t, ProjectConfigPath(dir), effective.ProjectConfigPath)
+	assert.Equal(t, ConfigSourceProject, effective.Sources["model"])
+	assert.Contains(t, effective.MCPServers, "codelayer")
+	assert.True(t, effective.ProjectConfigTrusted)
+
+	require.Eventually(t, func() bool {
+		dbSession, err := testStore.GetSession(ctx, sess.ID)
+		return err == nil && dbSession.Status == store.SessionStatusCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The project forbids bypassing approvals
+	_, err = manager.LaunchSession(ctx, LaunchSessionConfig{
+		SessionConfig:              claudecode.SessionConfig{Query: "yolo", WorkingDir: dir},
+		DangerouslySkipPermissions: true,
+		Runner:                     "scripted",
+	}, false)
+	assert.True(t, IsProjectConfigError(err))
+
+	// Editing the file revokes its trust
+	writeProjectConfig(t, dir, `{"approvals": {"auto_accept_edits": true}, "model": "opus"}`)
+	trusted, err = ProjectConfigTrusted(ctx, testStore, dir)
+	require.NoError(t, err)
+	assert.False(t, trusted)
+
+	// A broken project file blocks launches with every problem listed
+	writeProjectConfig(t, dir, `{"model": "gpt", "budgets": {"max_turns": -1}}`)
+	_, err = manager.LaunchSession(ctx, LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{Query: "tidy up", WorkingDir: dir},
+		Runner:        "scripted",
+	}, false)
+	require.True(t, IsProjectConfigError(err))
+	assert.ErrorContains(t, err, "model: unknown model")
+	assert.ErrorContains(t, err, "budgets.max_turns: must not be negative")
+}
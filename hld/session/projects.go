@@ -0,0 +1,66 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// FindProjectRoot returns the root of the repository containing dir, found by
+// walking up to the nearest .git entry. Directories outside a repository are
+// their own root.
+func FindProjectRoot(dir string) string {
+	dir = filepath.Clean(dir)
+	for current := dir; ; {
+		if _, err := os.Stat(filepath.Join(current, ".git")); err == nil {
+			return current
+		}
+		parent := filepath.Dir(current)
+		if parent == current {
+			return dir
+		}
+		current = parent
+	}
+}
+
+// resolveProject returns the ID of the project workingDir belongs to, creating
+// the project on first use. Failures are logged and leave the session unassigned.
+func (m *Manager) resolveProject(ctx context.Context, workingDir string) string {
+	if workingDir == "" {
+		return ""
+	}
+	project, err := m.store.UpsertProject(ctx, FindProjectRoot(workingDir))
+	if err != nil {
+		slog.Warn("failed to resolve session project", "working_dir", workingDir, "error", err)
+		return ""
+	}
+	return project.ID
+}
+
+// BackfillProjects assigns a project to every session that has a working
+// directory but no project, such as sessions created before projects existed
+func BackfillProjects(ctx context.Context, s store.ConversationStore) error {
+	dirs, err := s.GetUnassignedWorkingDirs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range dirs {
+		project, err := s.UpsertProject(ctx, FindProjectRoot(dir))
+		if err != nil {
+			return fmt.Errorf("failed to create project for %s: %w", dir, err)
+		}
+		if err := s.AssignProjectByWorkingDir(ctx, dir, project.ID); err != nil {
+			return err
+		}
+	}
+
+	if len(dirs) > 0 {
+		slog.Info("assigned projects to existing sessions", "working_dirs", len(dirs))
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindProjectRoot(t *testing.T) {
+	root := t.TempDir()
+	repo := filepath.Join(root, "repo")
+	nested := filepath.Join(repo, "web", "src")
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, ".git"), 0755))
+	require.NoError(t, os.MkdirAll(nested, 0755))
+
+	// Worktrees have a .git file rather than a directory
+	worktree := filepath.Join(root, "worktree")
+	require.NoError(t, os.MkdirAll(worktree, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: ../repo/.git/worktrees/wt\n"), 0644))
+
+	plain := filepath.Join(root, "scratch")
+	require.NoError(t, os.MkdirAll(plain, 0755))
+
+	assert.Equal(t, repo, FindProjectRoot(repo))
+	assert.Equal(t, repo, FindProjectRoot(nested+"/"))
+	assert.Equal(t, worktree, FindProjectRoot(worktree))
+	assert.Equal(t, plain, FindProjectRoot(plain))
+}
+
+func TestManager_AssignsProjects(t *testing.T) {
+	ctx := context.Background()
+	s, err := store.NewSQLiteStore(testutil.DatabasePath(t, "projects"))
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	repo := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, ".git"), 0755))
+	subdir := filepath.Join(repo, "docs")
+	require.NoError(t, os.MkdirAll(subdir, 0755))
+
+	// Sessions from before projects existed are grouped on startup
+	now := time.Now()
+	require.NoError(t, s.CreateSession(ctx, &store.Session{
+		ID: "old", RunID: "run-old", WorkingDir: subdir, Status: store.SessionStatusCompleted,
+		CreatedAt: now, LastActivityAt: now,
+	}))
+	require.NoError(t, BackfillProjects(ctx, s))
+	old, err := s.GetSession(ctx, "old")
+	require.NoError(t, err)
+	require.NotEmpty(t, old.ProjectID)
+
+	manager, err := NewManager(bus.NewEventBus(), s, "")
+	require.NoError(t, err)
+	manager.RegisterRunner(NewScriptedRunner("scripted", []claudecode.StreamEvent{
+		{Type: "system", Subtype: "init"},
+		{Type: "result", Subtype: "success", Result: "done"},
+	}, 0))
+
+	sess, err := manager.LaunchSession(ctx, LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{Query: "update the readme", WorkingDir: repo},
+		Runner:        "scripted",
+	}, false)
+	require.NoError(t, err)
+
+	dbSession, err := s.GetSession(ctx, sess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, old.ProjectID, dbSession.ProjectID)
+
+	projects, err := s.ListProjects(ctx)
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, repo, projects[0].RootPath)
+	assert.Equal(t, 2, projects[0].SessionCount)
+
+	// Changing the working directory moves the session to the new project
+	elsewhere := t.TempDir()
+	require.NoError(t, manager.UpdateSessionSettings(ctx, "old", store.SessionUpdate{WorkingDir: &elsewhere}))
+	moved, err := s.GetSession(ctx, "old")
+	require.NoError(t, err)
+	assert.NotEqual(t, old.ProjectID, moved.ProjectID)
+	assert.NotEmpty(t, moved.ProjectID)
+
+	require.Eventually(t, func() bool {
+		dbSession, err := s.GetSession(ctx, sess.ID)
+		return err == nil && dbSession.Status == store.SessionStatusCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,220 @@
+package session
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/procstat"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// ResourceLimits are soft limits on a session's process tree. Crossing one
+// publishes a warning but never stops the session. Zero disables a limit.
+type ResourceLimits struct {
+	RSSBytes   int64   // Resident memory across the tree
+	OpenFDs    int64   // Open file descriptors across the tree
+	CPUPercent float64 // CPU use between samples, 100 per fully used core
+}
+
+// ResourceMonitorConfig holds the resource accounting settings
+type ResourceMonitorConfig struct {
+	Interval time.Duration // How often process trees are sampled
+	Limits   ResourceLimits
+}
+
+// ProcessLister provides the process IDs of running sessions
+type ProcessLister interface {
+	ActiveProcessIDs() map[string]int
+}
+
+// ResourceMonitor samples CPU time, memory and open file descriptors for the
+// process tree of every running session
+type ResourceMonitor struct {
+	processes ProcessLister
+	store     store.ConversationStore
+	eventBus  bus.EventBus
+	config    ResourceMonitorConfig
+	usage     func(pid int) (procstat.Usage, error)
+	now       func() time.Time
+
+	mu       sync.Mutex
+	previous map[string]previousSample  // Session ID to its last sample, for CPU percentages
+	warned   map[string]map[string]bool // Session ID to the resources currently over their limit
+}
+
+type previousSample struct {
+	cpuTime   time.Duration
+	sampledAt time.Time
+}
+
+// NewResourceMonitor creates a new resource monitor reading from /proc
+func NewResourceMonitor(processes ProcessLister, store store.ConversationStore, eventBus bus.EventBus, config ResourceMonitorConfig) *ResourceMonitor {
+	if config.Interval <= 0 {
+		config.Interval = 15 * time.Second
+	}
+	return &ResourceMonitor{
+		processes: processes,
+		store:     store,
+		eventBus:  eventBus,
+		config:    config,
+		usage:     procstat.NewReader().TreeUsage,
+		now:       time.Now,
+		previous:  make(map[string]previousSample),
+		warned:    make(map[string]map[string]bool),
+	}
+}
+
+// Start samples running sessions on every interval until ctx is cancelled. It
+// returns immediately when the proc filesystem is not available.
+func (r *ResourceMonitor) Start(ctx context.Context) {
+	if !procstat.NewReader().Supported() {
+		slog.Info("resource accounting disabled, /proc is not available")
+		return
+	}
+
+	slog.Info("starting session resource monitor",
+		"interval", r.config.Interval,
+		"rss_limit_bytes", r.config.Limits.RSSBytes,
+		"open_fds_limit", r.config.Limits.OpenFDs,
+		"cpu_percent_limit", r.config.Limits.CPUPercent)
+
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("session resource monitor shutting down")
+			return
+		case <-ticker.C:
+			r.Sample(ctx)
+		}
+	}
+}
+
+// Sample records the current usage of each running session's process tree
+// and publishes warnings for newly crossed soft limits
+func (r *ResourceMonitor) Sample(ctx context.Context) {
+	// Guard against nil store (can happen during shutdown)
+	if r.store == nil {
+		return
+	}
+
+	pids := r.processes.ActiveProcessIDs()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for sessionID, pid := range pids {
+		usage, err := r.usage(pid)
+		if err != nil {
+			// The process may have exited since it was listed
+			slog.Debug("failed to read session resource usage",
+				"session_id", sessionID,
+				"pid", pid,
+				"error", err)
+			continue
+		}
+
+		now := r.now()
+		sample := &store.ResourceSample{
+			SessionID:    sessionID,
+			SampledAt:    now,
+			CPUTimeMs:    usage.CPUTime.Milliseconds(),
+			RSSBytes:     usage.RSSBytes,
+			OpenFDs:      usage.OpenFDs,
+			ProcessCount: usage.Processes,
+		}
+		if prev, ok := r.previous[sessionID]; ok {
+			sample.CPUPercent = cpuPercent(usage.CPUTime-prev.cpuTime, now.Sub(prev.sampledAt))
+		}
+		r.previous[sessionID] = previousSample{cpuTime: usage.CPUTime, sampledAt: now}
+
+		if err := r.store.RecordResourceSample(ctx, sample); err != nil {
+			slog.Error("failed to record resource sample",
+				"session_id", sessionID,
+				"error", err)
+			continue
+		}
+
+		r.checkLimits(ctx, sample)
+	}
+
+	// Forget sessions that are no longer running
+	for sessionID := range r.previous {
+		if _, ok := pids[sessionID]; !ok {
+			delete(r.previous, sessionID)
+			delete(r.warned, sessionID)
+		}
+	}
+}
+
+// checkLimits publishes a warning for each limit the sample newly exceeds. A
+// warning fires again only after usage has dropped back under the limit.
+func (r *ResourceMonitor) checkLimits(ctx context.Context, sample *store.ResourceSample) {
+	limits := r.config.Limits
+	checks := []struct {
+		resource string
+		value    float64
+		limit    float64
+	}{
+		{"rss_bytes", float64(sample.RSSBytes), float64(limits.RSSBytes)},
+		{"open_fds", float64(sample.OpenFDs), float64(limits.OpenFDs)},
+		{"cpu_percent", sample.CPUPercent, limits.CPUPercent},
+	}
+
+	warned := r.warned[sample.SessionID]
+	if warned == nil {
+		warned = make(map[string]bool)
+		r.warned[sample.SessionID] = warned
+	}
+
+	for _, check := range checks {
+		if check.limit <= 0 {
+			continue
+		}
+		if check.value <= check.limit {
+			warned[check.resource] = false
+			continue
+		}
+		if warned[check.resource] {
+			continue
+		}
+		warned[check.resource] = true
+
+		slog.Warn("session exceeded resource soft limit",
+			"session_id", sample.SessionID,
+			"resource", check.resource,
+			"value", check.value,
+			"limit", check.limit)
+
+		if r.eventBus == nil {
+			continue
+		}
+		var runID string
+		if sess, err := r.store.GetSession(ctx, sample.SessionID); err == nil {
+			runID = sess.RunID
+		}
+		r.eventBus.Publish(bus.NewEvent(bus.SessionResourceWarningPayload{
+			SessionID: sample.SessionID,
+			RunID:     runID,
+			Resource:  check.resource,
+			Value:     check.value,
+			Limit:     check.limit,
+		}))
+	}
+}
+
+// cpuPercent converts CPU time used over a wall clock interval to a percentage
+// where 100 is one fully used core. The tree's CPU time only goes down when a
+// descendant outlives its parent and is reparented outside the tree, which
+// reads as idle.
+func cpuPercent(cpu, wall time.Duration) float64 {
+	if wall <= 0 || cpu <= 0 {
+		return 0
+	}
+	return float64(cpu) / float64(wall) * 100
+}
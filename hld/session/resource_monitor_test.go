@@ -0,0 +1,138 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/procstat"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProcessLister map[string]int
+
+func (f fakeProcessLister) ActiveProcessIDs() map[string]int {
+	return f
+}
+
+func TestResourceMonitor_Sample(t *testing.T) {
+	ctx := context.Background()
+	s, err := store.NewSQLiteStore(":memory:")
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	now := time.Now()
+	for _, id := range []string{"build", "idle"} {
+		require.NoError(t, s.CreateSession(ctx, &store.Session{
+			ID:             id,
+			RunID:          "run-" + id,
+			Status:         store.SessionStatusRunning,
+			CreatedAt:      now,
+			LastActivityAt: now,
+		}))
+	}
+
+	eventBus := bus.NewEventBus()
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sub := eventBus.Subscribe(subCtx, bus.EventFilter{Types: []bus.EventType{bus.EventSessionResourceWarning}})
+
+	processes := fakeProcessLister{"build": 100, "idle": 200, "exited": 300}
+	usage := map[int]procstat.Usage{
+		100: {CPUTime: 2 * time.Second, RSSBytes: 512 << 20, OpenFDs: 40, Processes: 3},
+		200: {CPUTime: time.Second, RSSBytes: 100 << 20, OpenFDs: 20, Processes: 1},
+	}
+
+	m := NewResourceMonitor(processes, s, eventBus, ResourceMonitorConfig{
+		Limits: ResourceLimits{RSSBytes: 1 << 30, OpenFDs: 100, CPUPercent: 150},
+	})
+	m.usage = func(pid int) (procstat.Usage, error) {
+		if u, ok := usage[pid]; ok {
+			return u, nil
+		}
+		return procstat.Usage{}, fmt.Errorf("process %d not found", pid)
+	}
+	m.now = func() time.Time { return now }
+
+	m.Sample(ctx)
+
+	sess, err := s.GetSession(ctx, "build")
+	require.NoError(t, err)
+	resources := ResourceUsageFromSession(sess)
+	require.NotNil(t, resources)
+	assert.Equal(t, int64(2000), resources.CPUTimeMs)
+	assert.Equal(t, int64(512<<20), resources.RSSBytes)
+	// The first sample has no interval to measure CPU use over
+	assert.Equal(t, 0.0, resources.CPUPercent)
+
+	// Ten seconds later the build is using four cores and 2GB
+	now = now.Add(10 * time.Second)
+	usage[100] = procstat.Usage{CPUTime: 42 * time.Second, RSSBytes: 2 << 30, OpenFDs: 60, Processes: 9}
+	usage[200] = procstat.Usage{CPUTime: 1100 * time.Millisecond, RSSBytes: 100 << 20, OpenFDs: 20, Processes: 1}
+	m.Sample(ctx)
+
+	warnings := make(map[string]bus.Event)
+	for len(warnings) < 2 {
+		select {
+		case event := <-sub.Channel:
+			assert.Equal(t, "build", event.Data["session_id"])
+			assert.Equal(t, "run-build", event.Data["run_id"])
+			warnings[event.Data["resource"].(string)] = event
+		case <-time.After(time.Second):
+			t.Fatalf("expected 2 resource warnings, got %d", len(warnings))
+		}
+	}
+	assert.Contains(t, warnings, "rss_bytes")
+	assert.Contains(t, warnings, "cpu_percent")
+	assert.InDelta(t, 400.0, warnings["cpu_percent"].Data["value"], 0.001)
+
+	sess, err = s.GetSession(ctx, "build")
+	require.NoError(t, err)
+	assert.InDelta(t, 400.0, sess.CPUPercent, 0.001)
+	assert.Equal(t, int64(2<<30), sess.PeakRSSBytes)
+	assert.Equal(t, int64(60), sess.PeakOpenFDs)
+
+	sess, err = s.GetSession(ctx, "idle")
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, sess.CPUPercent, 0.001)
+
+	samples, err := s.GetResourceSamples(ctx, "build", 0)
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	assert.Equal(t, 9, samples[1].ProcessCount)
+
+	// Staying over a limit does not repeat the warning
+	now = now.Add(10 * time.Second)
+	usage[100] = procstat.Usage{CPUTime: 43 * time.Second, RSSBytes: 2 << 30, OpenFDs: 60, Processes: 9}
+	m.Sample(ctx)
+	select {
+	case event := <-sub.Channel:
+		t.Fatalf("unexpected repeated warning for %v", event.Data["resource"])
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Dropping under the limit and crossing it again warns again
+	now = now.Add(10 * time.Second)
+	usage[100] = procstat.Usage{CPUTime: 44 * time.Second, RSSBytes: 256 << 20, OpenFDs: 60, Processes: 2}
+	m.Sample(ctx)
+	now = now.Add(10 * time.Second)
+	usage[100] = procstat.Usage{CPUTime: 45 * time.Second, RSSBytes: 3 << 30, OpenFDs: 60, Processes: 2}
+	m.Sample(ctx)
+	select {
+	case event := <-sub.Channel:
+		assert.Equal(t, "rss_bytes", event.Data["resource"])
+	case <-time.After(time.Second):
+		t.Fatal("expected a new warning after crossing the limit again")
+	}
+}
+
+func TestCPUPercent(t *testing.T) {
+	assert.Equal(t, 0.0, cpuPercent(time.Second, 0))
+	assert.Equal(t, 0.0, cpuPercent(-time.Second, time.Second))
+	assert.Equal(t, 50.0, cpuPercent(time.Second, 2*time.Second))
+	assert.Equal(t, 200.0, cpuPercent(4*time.Second, 2*time.Second))
+}
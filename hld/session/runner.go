@@ -0,0 +1,129 @@
+package session
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	hldconfig "github.com/humanlayer/humanlayer/hld/config"
+)
+
+// DefaultRunner is the name of the runner backed by the Claude Code CLI. It
+// is used for sessions that do not declare a runner.
+const DefaultRunner = "claude"
+
+// ErrUnknownRunner is returned when a session names a runner that is not
+// registered
+var ErrUnknownRunner = errors.New("unknown runner")
+
+// Runner launches agent processes for sessions. Every runner reports its
+// output as Claude Code stream events, so approvals, snapshots and the event
+// bus work the same whichever backend a session uses.
+type Runner interface {
+	// Name identifies the runner in session configuration
+	Name() string
+
+	// Available reports why the runner cannot launch sessions, or nil if it can
+	Available() error
+
+	// Launch starts a session process with the given configuration
+	Launch(config claudecode.SessionConfig) (ClaudeSession, error)
+}
+
+// RunnerRegistry holds the runners sessions can be launched with
+type RunnerRegistry struct {
+	mu      sync.RWMutex
+	runners map[string]Runner
+}
+
+// NewRunnerRegistry creates an empty runner registry
+func NewRunnerRegistry() *RunnerRegistry {
+	return &RunnerRegistry{runners: make(map[string]Runner)}
+}
+
+// Register adds a runner, replacing any runner with the same name
+func (r *RunnerRegistry) Register(runner Runner) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.runners[runner.Name()] = runner
+}
+
+// Get returns the runner with the given name. An empty name selects the
+// default runner.
+func (r *RunnerRegistry) Get(name string) (Runner, error) {
+	if name == "" {
+		name = DefaultRunner
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	runner, ok := r.runners[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRunner, name)
+	}
+	return runner, nil
+}
+
+// Names returns the names of all registered runners in sorted order
+func (r *RunnerRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.runners))
+	for name := range r.runners {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// claudeRunner launches sessions with the Claude Code CLI
+type claudeRunner struct {
+	manager *Manager
+}
+
+// Name implements the Runner interface
+func (r *claudeRunner) Name() string {
+	return DefaultRunner
+}
+
+// Available implements the Runner interface
+func (r *claudeRunner) Available() error {
+	_, err := r.manager.getClaudeClient()
+	return err
+}
+
+// Launch implements the Runner interface
+func (r *claudeRunner) Launch(config claudecode.SessionConfig) (ClaudeSession, error) {
+	client, err := r.manager.getClaudeClient()
+	if err != nil {
+		return nil, err
+	}
+	claudeSession, err := client.Launch(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewClaudeSessionWrapper(claudeSession), nil
+}
+
+// NewRunnerFromConfig creates the runner described by daemon configuration
+func NewRunnerFromConfig(cfg hldconfig.RunnerConfig) (Runner, error) {
+	if cfg.Name == "" {
+		return nil, fmt.Errorf("runner name is required")
+	}
+	if cfg.Name == DefaultRunner {
+		return nil, fmt.Errorf("runner name %q is reserved", DefaultRunner)
+	}
+	if cfg.Script != "" {
+		return LoadScriptedRunner(cfg.Name, cfg.Script, cfg.Format, time.Duration(cfg.ScriptDelayMs)*time.Millisecond)
+	}
+	return NewCommandRunner(CommandRunnerConfig{
+		Name:    cfg.Name,
+		Command: cfg.Command,
+		Args:    cfg.Args,
+		Format:  cfg.Format,
+		Env:     cfg.Env,
+	})
+}
@@ -0,0 +1,176 @@
+package session
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+)
+
+// Output formats understood by command runners
+const (
+	// FormatClaudeStreamJSON is Claude Code's stream-json output, for CLIs
+	// that emit the same events
+	FormatClaudeStreamJSON = "claude-stream-json"
+
+	// FormatAgentJSONL is a minimal line-oriented format for other agent CLIs,
+	// see agentJSONLAdapter
+	FormatAgentJSONL = "agent-jsonl"
+)
+
+// EventAdapter converts lines of a runner's output to Claude Code stream
+// events. Adapters are created per session and may keep state between lines.
+type EventAdapter interface {
+	// Adapt converts one line to zero or more events
+	Adapt(line []byte) ([]claudecode.StreamEvent, error)
+}
+
+// NewEventAdapter creates an adapter for the given output format
+func NewEventAdapter(format string) (EventAdapter, error) {
+	switch format {
+	case FormatClaudeStreamJSON, "":
+		return claudeStreamAdapter{}, nil
+	case FormatAgentJSONL:
+		return &agentJSONLAdapter{}, nil
+	default:
+		return nil, fmt.Errorf("unknown runner output format: %s", format)
+	}
+}
+
+// claudeStreamAdapter decodes lines that are already Claude Code stream events
+type claudeStreamAdapter struct{}
+
+// Adapt implements the EventAdapter interface
+func (claudeStreamAdapter) Adapt(line []byte) ([]claudecode.StreamEvent, error) {
+	var event claudecode.StreamEvent
+	if err := json.Unmarshal(line, &event); err != nil {
+		return nil, err
+	}
+	return []claudecode.StreamEvent{event}, nil
+}
+
+// agentLine is one line of the agent-jsonl format:
+//
+//	{"type": "start", "session_id": "abc", "model": "m"}
+//	{"type": "message", "role": "assistant", "text": "Looking at the tests"}
+//	{"type": "thinking", "text": "The failure is in the parser"}
+//	{"type": "tool_call", "id": "t1", "name": "Bash", "input": {"command": "make test"}}
+//	{"type": "tool_result", "id": "t1", "output": "ok", "is_error": false}
+//	{"type": "usage", "input_tokens": 1200, "output_tokens": 300}
+//	{"type": "end", "result": "Fixed the parser", "is_error": false, "cost_usd": 0.02, "num_turns": 3}
+//
+// Tool names should match Claude Code's where the tools are equivalent so
+// that approvals and file snapshots apply to them.
+type agentLine struct {
+	Type         string                 `json:"type"`
+	SessionID    string                 `json:"session_id"`
+	Model        string                 `json:"model"`
+	Role         string                 `json:"role"`
+	Text         string                 `json:"text"`
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Input        map[string]interface{} `json:"input"`
+	Output       string                 `json:"output"`
+	IsError      bool                   `json:"is_error"`
+	InputTokens  int                    `json:"input_tokens"`
+	OutputTokens int                    `json:"output_tokens"`
+	Result       string                 `json:"result"`
+	CostUSD      float64                `json:"cost_usd"`
+	NumTurns     int                    `json:"num_turns"`
+}
+
+// agentJSONLAdapter maps the agent-jsonl format onto Claude Code events. A
+// session ID is generated when the agent does not report one.
+type agentJSONLAdapter struct {
+	sessionID string
+	started   bool
+}
+
+// Adapt implements the EventAdapter interface
+func (a *agentJSONLAdapter) Adapt(raw []byte) ([]claudecode.StreamEvent, error) {
+	var line agentLine
+	if err := json.Unmarshal(raw, &line); err != nil {
+		return nil, err
+	}
+
+	var events []claudecode.StreamEvent
+	if !a.started {
+		a.started = true
+		a.sessionID = line.SessionID
+		if a.sessionID == "" {
+			a.sessionID = uuid.New().String()
+		}
+		events = append(events, claudecode.StreamEvent{
+			Type:      "system",
+			Subtype:   "init",
+			SessionID: a.sessionID,
+			Model:     line.Model,
+		})
+		if line.Type == "start" {
+			return events, nil
+		}
+	}
+
+	switch line.Type {
+	case "start":
+		// Only the first start line describes the session
+	case "message":
+		role := line.Role
+		if role == "" {
+			role = "assistant"
+		}
+		events = append(events, a.message(role, claudecode.Content{Type: "text", Text: line.Text}))
+	case "thinking":
+		events = append(events, a.message("assistant", claudecode.Content{Type: "thinking", Thinking: line.Text}))
+	case "tool_call":
+		events = append(events, a.message("assistant", claudecode.Content{
+			Type:  "tool_use",
+			ID:    line.ID,
+			Name:  line.Name,
+			Input: line.Input,
+		}))
+	case "tool_result":
+		events = append(events, a.message("user", claudecode.Content{
+			Type:      "tool_result",
+			ToolUseID: line.ID,
+			Content:   claudecode.ContentField{Value: line.Output},
+		}))
+	case "usage":
+		event := a.message("assistant")
+		event.Message.Usage = &claudecode.Usage{
+			InputTokens:  line.InputTokens,
+			OutputTokens: line.OutputTokens,
+		}
+		events = append(events, event)
+	case "end":
+		subtype := "success"
+		if line.IsError {
+			subtype = "error"
+		}
+		events = append(events, claudecode.StreamEvent{
+			Type:      "result",
+			Subtype:   subtype,
+			SessionID: a.sessionID,
+			Result:    line.Result,
+			IsError:   line.IsError,
+			CostUSD:   line.CostUSD,
+			NumTurns:  line.NumTurns,
+		})
+	default:
+		return nil, fmt.Errorf("unknown agent event type: %s", line.Type)
+	}
+	return events, nil
+}
+
+func (a *agentJSONLAdapter) message(role string, content ...claudecode.Content) claudecode.StreamEvent {
+	return claudecode.StreamEvent{
+		Type:      role,
+		SessionID: a.sessionID,
+		Message: &claudecode.Message{
+			ID:      uuid.New().String(),
+			Role:    role,
+			Content: content,
+		},
+	}
+}
@@ -0,0 +1,304 @@
+package session
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+)
+
+// CommandRunnerConfig describes an agent CLI that reports its progress as
+// JSON lines on stdout
+type CommandRunnerConfig struct {
+	Name    string            // Runner name sessions select it by
+	Command string            // Executable to run
+	Args    []string          // Arguments, see expandRunnerArgs for placeholders
+	Format  string            // Output format, FormatClaudeStreamJSON by default
+	Env     map[string]string // Extra environment for every session
+}
+
+// CommandRunner launches sessions with an external agent CLI
+type CommandRunner struct {
+	config CommandRunnerConfig
+}
+
+// NewCommandRunner creates a runner for an agent CLI
+func NewCommandRunner(config CommandRunnerConfig) (*CommandRunner, error) {
+	if config.Name == "" {
+		return nil, fmt.Errorf("runner name is required")
+	}
+	if config.Command == "" {
+		return nil, fmt.Errorf("runner %s: command is required", config.Name)
+	}
+	if _, err := NewEventAdapter(config.Format); err != nil {
+		return nil, fmt.Errorf("runner %s: %w", config.Name, err)
+	}
+	return &CommandRunner{config: config}, nil
+}
+
+// Name implements the Runner interface
+func (r *CommandRunner) Name() string {
+	return r.config.Name
+}
+
+// Available implements the Runner interface
+func (r *CommandRunner) Available() error {
+	if _, err := exec.LookPath(r.config.Command); err != nil {
+		return fmt.Errorf("runner %s: %w", r.config.Name, err)
+	}
+	return nil
+}
+
+// Launch implements the Runner interface
+func (r *CommandRunner) Launch(config claudecode.SessionConfig) (ClaudeSession, error) {
+	adapter, err := NewEventAdapter(r.config.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(r.config.Command, expandRunnerArgs(r.config.Args, config)...)
+	if config.WorkingDir != "" {
+		cmd.Dir = expandHome(config.WorkingDir)
+	}
+
+	env, err := runnerEnv(r.config.Env, config)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Env = append(os.Environ(), env...)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stdout pipe: %w", err)
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create stderr pipe: %w", err)
+	}
+
+	slog.Info("starting runner process",
+		"runner", r.config.Name,
+		"command", r.config.Command,
+		"working_dir", cmd.Dir)
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start %s: %w", r.config.Name, err)
+	}
+
+	session := &commandSession{
+		name:    r.config.Name,
+		cmd:     cmd,
+		adapter: adapter,
+		events:  make(chan claudecode.StreamEvent, 100),
+		done:    make(chan struct{}),
+	}
+	go session.run(stdout, stderr)
+	return session, nil
+}
+
+// expandRunnerArgs substitutes {query}, {model}, {working_dir} and
+// {resume_session_id} in runner arguments. The query is appended as the last
+// argument when no argument refers to it.
+func expandRunnerArgs(args []string, config claudecode.SessionConfig) []string {
+	replacer := strings.NewReplacer(
+		"{query}", config.Query,
+		"{model}", string(config.Model),
+		"{working_dir}", config.WorkingDir,
+		"{resume_session_id}", config.SessionID,
+	)
+
+	expanded := make([]string, 0, len(args)+1)
+	hasQuery := false
+	for _, arg := range args {
+		if strings.Contains(arg, "{query}") {
+			hasQuery = true
+		}
+		expanded = append(expanded, replacer.Replace(arg))
+	}
+	if !hasQuery && config.Query != "" {
+		expanded = append(expanded, config.Query)
+	}
+	return expanded
+}
+
+// runnerEnv builds the environment for a runner process. The MCP configuration
+// and permission prompt tool are passed on so agents can route approvals
+// through the daemon the same way Claude Code does.
+func runnerEnv(runnerEnv map[string]string, config claudecode.SessionConfig) ([]string, error) {
+	var env []string
+	for key, value := range runnerEnv {
+		env = append(env, fmt.Sprintf("%s=%s", key, value))
+	}
+	for key, value := range config.Env {
+		env = append(env, fmt.Sprintf("%s=%s", key, value))
+	}
+	if config.MCPConfig != nil {
+		mcpJSON, err := json.Marshal(config.MCPConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal MCP config: %w", err)
+		}
+		env = append(env, "HUMANLAYER_MCP_CONFIG="+string(mcpJSON))
+	}
+	if config.PermissionPromptTool != "" {
+		env = append(env, "HUMANLAYER_PERMISSION_PROMPT_TOOL="+config.PermissionPromptTool)
+	}
+	return env, nil
+}
+
+// expandHome resolves a leading ~ and makes the path absolute
+func expandHome(path string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		return filepath.Clean(abs)
+	}
+	return path
+}
+
+// commandSession is a running agent CLI process
+type commandSession struct {
+	name    string
+	cmd     *exec.Cmd
+	adapter EventAdapter
+	events  chan claudecode.StreamEvent
+	done    chan struct{}
+
+	mu     sync.RWMutex
+	id     string
+	result *claudecode.Result
+	err    error
+}
+
+// run reads the process output until it exits
+func (s *commandSession) run(stdout, stderr io.Reader) {
+	var stderrBuf strings.Builder
+	stderrDone := make(chan struct{})
+	go func() {
+		defer close(stderrDone)
+		_, _ = io.Copy(&stderrBuf, stderr)
+	}()
+
+	scanner := bufio.NewScanner(stdout)
+	scanner.Buffer(make([]byte, 0), 10*1024*1024) // Same 10MB line limit as Claude Code output
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		events, err := s.adapter.Adapt(line)
+		if err != nil {
+			slog.Warn("failed to adapt runner output, dropping it",
+				"runner", s.name,
+				"error", err,
+				"line", string(line))
+			continue
+		}
+		for _, event := range events {
+			s.record(event)
+			s.events <- event
+		}
+	}
+	scanErr := scanner.Err()
+	close(s.events)
+
+	<-stderrDone
+	waitErr := s.cmd.Wait()
+
+	s.mu.Lock()
+	switch {
+	case waitErr != nil && stderrBuf.Len() > 0:
+		s.err = fmt.Errorf("%w: %s", waitErr, strings.TrimSpace(stderrBuf.String()))
+	case waitErr != nil:
+		s.err = waitErr
+	case scanErr != nil:
+		s.err = fmt.Errorf("stream parsing failed: %w", scanErr)
+	}
+	s.mu.Unlock()
+
+	close(s.done)
+}
+
+// record keeps the session ID and final result from the event stream
+func (s *commandSession) record(event claudecode.StreamEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.id == "" && event.SessionID != "" {
+		s.id = event.SessionID
+	}
+	if event.Type == "result" {
+		s.result = &claudecode.Result{
+			Type:       event.Type,
+			Subtype:    event.Subtype,
+			CostUSD:    event.CostUSD,
+			IsError:    event.IsError,
+			DurationMS: event.DurationMS,
+			NumTurns:   event.NumTurns,
+			Result:     event.Result,
+			SessionID:  event.SessionID,
+			Usage:      event.Usage,
+			Error:      event.Error,
+		}
+	}
+}
+
+// Interrupt implements the ClaudeSession interface
+func (s *commandSession) Interrupt() error {
+	if s.cmd.Process != nil {
+		return s.cmd.Process.Signal(syscall.SIGINT)
+	}
+	return nil
+}
+
+// Kill implements the ClaudeSession interface
+func (s *commandSession) Kill() error {
+	if s.cmd.Process != nil {
+		return s.cmd.Process.Kill()
+	}
+	return nil
+}
+
+// GetID implements the ClaudeSession interface
+func (s *commandSession) GetID() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.id
+}
+
+// GetPID implements the ClaudeSession interface
+func (s *commandSession) GetPID() int {
+	if s.cmd.Process != nil {
+		return s.cmd.Process.Pid
+	}
+	return 0
+}
+
+// Wait implements the ClaudeSession interface
+func (s *commandSession) Wait() (*claudecode.Result, error) {
+	<-s.done
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.err != nil && s.result == nil {
+		return nil, fmt.Errorf("%s process failed: %w", s.name, s.err)
+	}
+	return s.result, nil
+}
+
+// GetEvents implements the ClaudeSession interface
+func (s *commandSession) GetEvents() <-chan claudecode.StreamEvent {
+	return s.events
+}
+
+// Ensure commandSession implements ClaudeSession
+var _ ClaudeSession = (*commandSession)(nil)
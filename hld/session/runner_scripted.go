@@ -0,0 +1,164 @@
+package session
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+)
+
+// ScriptedRunner replays a fixed sequence of events for every session. It
+// exercises the daemon end to end without an agent CLI or model access.
+type ScriptedRunner struct {
+	name   string
+	events []claudecode.StreamEvent
+	delay  time.Duration // Pause before each event
+}
+
+// NewScriptedRunner creates a runner that replays events. Each session gets a
+// fresh session ID in place of any IDs in the script.
+func NewScriptedRunner(name string, events []claudecode.StreamEvent, delay time.Duration) *ScriptedRunner {
+	return &ScriptedRunner{name: name, events: events, delay: delay}
+}
+
+// LoadScriptedRunner creates a scripted runner from a file of JSON lines in
+// the given output format
+func LoadScriptedRunner(name, path, format string, delay time.Duration) (*ScriptedRunner, error) {
+	adapter, err := NewEventAdapter(format)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open runner script: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var events []claudecode.StreamEvent
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0), 10*1024*1024)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		adapted, err := adapter.Adapt(scanner.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("runner script %s line %d: %w", path, lineNum, err)
+		}
+		events = append(events, adapted...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read runner script: %w", err)
+	}
+	return NewScriptedRunner(name, events, delay), nil
+}
+
+// Name implements the Runner interface
+func (r *ScriptedRunner) Name() string {
+	return r.name
+}
+
+// Available implements the Runner interface
+func (r *ScriptedRunner) Available() error {
+	return nil
+}
+
+// Launch implements the Runner interface
+func (r *ScriptedRunner) Launch(config claudecode.SessionConfig) (ClaudeSession, error) {
+	session := &scriptedSession{
+		id:     uuid.New().String(),
+		events: make(chan claudecode.StreamEvent, 100),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go session.play(r.events, r.delay)
+	return session, nil
+}
+
+// scriptedSession replays a script until it ends or is stopped
+type scriptedSession struct {
+	id     string
+	events chan claudecode.StreamEvent
+	stop   chan struct{}
+	done   chan struct{}
+
+	stopOnce sync.Once
+	result   *claudecode.Result
+}
+
+func (s *scriptedSession) play(script []claudecode.StreamEvent, delay time.Duration) {
+	defer close(s.done)
+	defer close(s.events)
+
+	for _, event := range script {
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-s.stop:
+				return
+			}
+		}
+
+		event.SessionID = s.id
+		if event.Type == "result" {
+			s.result = &claudecode.Result{
+				Type:       event.Type,
+				Subtype:    event.Subtype,
+				CostUSD:    event.CostUSD,
+				IsError:    event.IsError,
+				DurationMS: event.DurationMS,
+				NumTurns:   event.NumTurns,
+				Result:     event.Result,
+				SessionID:  event.SessionID,
+				Usage:      event.Usage,
+				Error:      event.Error,
+			}
+		}
+
+		select {
+		case s.events <- event:
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// Interrupt implements the ClaudeSession interface
+func (s *scriptedSession) Interrupt() error {
+	s.stopOnce.Do(func() { close(s.stop) })
+	return nil
+}
+
+// Kill implements the ClaudeSession interface
+func (s *scriptedSession) Kill() error {
+	return s.Interrupt()
+}
+
+// GetID implements the ClaudeSession interface
+func (s *scriptedSession) GetID() string {
+	return s.id
+}
+
+// GetPID implements the ClaudeSession interface. Scripts run in process.
+func (s *scriptedSession) GetPID() int {
+	return 0
+}
+
+// Wait implements the ClaudeSession interface
+func (s *scriptedSession) Wait() (*claudecode.Result, error) {
+	<-s.done
+	return s.result, nil
+}
+
+// GetEvents implements the ClaudeSession interface
+func (s *scriptedSession) GetEvents() <-chan claudecode.StreamEvent {
+	return s.events
+}
+
+// Ensure scriptedSession implements ClaudeSession
+var _ ClaudeSession = (*scriptedSession)(nil)
@@ -0,0 +1,214 @@
+package session
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunnerRegistry(t *testing.T) {
+	registry := NewRunnerRegistry()
+	registry.Register(NewScriptedRunner(DefaultRunner, nil, 0))
+	registry.Register(NewScriptedRunner("replay", nil, 0))
+
+	runner, err := registry.Get("")
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRunner, runner.Name())
+
+	runner, err = registry.Get("replay")
+	require.NoError(t, err)
+	assert.Equal(t, "replay", runner.Name())
+
+	_, err = registry.Get("missing")
+	assert.ErrorIs(t, err, ErrUnknownRunner)
+
+	assert.Equal(t, []string{"claude", "replay"}, registry.Names())
+}
+
+func TestAgentJSONLAdapter(t *testing.T) {
+	adapter, err := NewEventAdapter(FormatAgentJSONL)
+	require.NoError(t, err)
+
+	adapt := func(line string) []claudecode.StreamEvent {
+		events, err := adapter.Adapt([]byte(line))
+		require.NoError(t, err)
+		return events
+	}
+
+	events := adapt(`{"type": "start", "session_id": "agent-1", "model": "m1"}`)
+	require.Len(t, events, 1)
+	assert.Equal(t, "system", events[0].Type)
+	assert.Equal(t, "init", events[0].Subtype)
+	assert.Equal(t, "agent-1", events[0].SessionID)
+	assert.Equal(t, "m1", events[0].Model)
+
+	events = adapt(`{"type": "tool_call", "id": "t1", "name": "Bash", "input": {"command": "ls"}}`)
+	require.Len(t, events, 1)
+	assert.Equal(t, "assistant", events[0].Type)
+	assert.Equal(t, "agent-1", events[0].SessionID)
+	require.Len(t, events[0].Message.Content, 1)
+	assert.Equal(t, "tool_use", events[0].Message.Content[0].Type)
+	assert.Equal(t, "Bash", events[0].Message.Content[0].Name)
+	assert.Equal(t, "ls", events[0].Message.Content[0].Input["command"])
+
+	events = adapt(`{"type": "tool_result", "id": "t1", "output": "main.go"}`)
+	require.Len(t, events, 1)
+	assert.Equal(t, "user", events[0].Type)
+	assert.Equal(t, "t1", events[0].Message.Content[0].ToolUseID)
+	assert.Equal(t, "main.go", events[0].Message.Content[0].Content.Value)
+
+	events = adapt(`{"type": "end", "result": "done", "cost_usd": 0.5, "num_turns": 2}`)
+	require.Len(t, events, 1)
+	assert.Equal(t, "result", events[0].Type)
+	assert.Equal(t, "success", events[0].Subtype)
+	assert.Equal(t, "done", events[0].Result)
+	assert.Equal(t, 0.5, events[0].CostUSD)
+
+	_, err = adapter.Adapt([]byte(`{"type": "teleport"}`))
+	assert.Error(t, err)
+
+	// Agents that never announce a session get a generated ID
+	adapter, err = NewEventAdapter(FormatAgentJSONL)
+	require.NoError(t, err)
+	events, err = adapter.Adapt([]byte(`{"type": "message", "text": "hi"}`))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "init", events[0].Subtype)
+	assert.NotEmpty(t, events[0].SessionID)
+	assert.Equal(t, events[0].SessionID, events[1].SessionID)
+	assert.Equal(t, "hi", events[1].Message.Content[0].Text)
+}
+
+func TestCommandRunner(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "agent.sh")
+	require.NoError(t, os.WriteFile(script, []byte(`#!/bin/sh
+echo '{"type": "start", "session_id": "agent-cmd"}'
+echo "{\"type\": \"message\", \"text\": \"$1 in $(basename "$PWD") via $AGENT_MODE\"}"
+echo '{"type": "end", "result": "finished", "num_turns": 1}'
+`), 0755))
+
+	runner, err := NewCommandRunner(CommandRunnerConfig{
+		Name:    "agent",
+		Command: script,
+		Format:  FormatAgentJSONL,
+		Env:     map[string]string{"AGENT_MODE": "test"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, runner.Available())
+
+	session, err := runner.Launch(claudecode.SessionConfig{Query: "fix it", WorkingDir: dir})
+	require.NoError(t, err)
+
+	var events []claudecode.StreamEvent
+	for event := range session.GetEvents() {
+		events = append(events, event)
+	}
+	result, err := session.Wait()
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "finished", result.Result)
+	assert.Equal(t, "agent-cmd", session.GetID())
+
+	require.Len(t, events, 3)
+	assert.Equal(t, "fix it in "+filepath.Base(dir)+" via test", events[1].Message.Content[0].Text)
+
+	// A failing process without a result reports its stderr
+	failing := filepath.Join(dir, "failing.sh")
+	require.NoError(t, os.WriteFile(failing, []byte("#!/bin/sh\necho 'model unavailable' >&2\nexit 3\n"), 0755))
+	runner, err = NewCommandRunner(CommandRunnerConfig{Name: "failing", Command: failing})
+	require.NoError(t, err)
+	session, err = runner.Launch(claudecode.SessionConfig{Query: "hi"})
+	require.NoError(t, err)
+	for range session.GetEvents() {
+	}
+	_, err = session.Wait()
+	assert.ErrorContains(t, err, "model unavailable")
+
+	_, err = NewCommandRunner(CommandRunnerConfig{Name: "bad", Command: script, Format: "xml"})
+	assert.Error(t, err)
+}
+
+func TestExpandRunnerArgs(t *testing.T) {
+	config := claudecode.SessionConfig{Query: "hello", Model: claudecode.ModelSonnet, SessionID: "prev"}
+
+	assert.Equal(t, []string{"--json", "hello"}, expandRunnerArgs([]string{"--json"}, config))
+	assert.Equal(t,
+		[]string{"-p", "hello", "--model", "sonnet", "--resume=prev"},
+		expandRunnerArgs([]string{"-p", "{query}", "--model", "{model}", "--resume={resume_session_id}"}, config))
+}
+
+func TestManager_LaunchWithScriptedRunner(t *testing.T) {
+	ctx := context.Background()
+	testStore, err := store.NewSQLiteStore(testutil.DatabasePath(t, "scripted-runner"))
+	require.NoError(t, err)
+	defer func() { _ = testStore.Close() }()
+
+	eventBus := bus.NewEventBus()
+	manager, err := NewManager(eventBus, testStore, "")
+	require.NoError(t, err)
+
+	manager.RegisterRunner(NewScriptedRunner("scripted", []claudecode.StreamEvent{
+		{Type: "system", Subtype: "init", SessionID: "ignored"},
+		{Type: "assistant", Message: &claudecode.Message{
+			ID:      "msg-1",
+			Role:    "assistant",
+			Content: []claudecode.Content{{Type: "tool_use", ID: "tool-1", Name: "Bash", Input: map[string]interface{}{"command": "go test"}}},
+		}},
+		{Type: "user", Message: &claudecode.Message{
+			Role:    "user",
+			Content: []claudecode.Content{{Type: "tool_result", ToolUseID: "tool-1", Content: claudecode.ContentField{Value: "PASS"}}},
+		}},
+		{Type: "result", Subtype: "success", Result: "All tests pass", NumTurns: 1, CostUSD: 0.01},
+	}, 0))
+
+	_, err = manager.LaunchSession(ctx, LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{Query: "run the tests", WorkingDir: t.TempDir()},
+		Runner:        "unknown",
+	}, false)
+	assert.ErrorIs(t, err, ErrUnknownRunner)
+
+	sess, err := manager.LaunchSession(ctx, LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{Query: "run the tests", WorkingDir: t.TempDir()},
+		Runner:        "scripted",
+	}, false)
+	require.NoError(t, err)
+
+	// The result event marks the session completed before the runner's exit
+	// records the result text, so wait for both
+	var dbSession *store.Session
+	require.Eventually(t, func() bool {
+		dbSession, err = testStore.GetSession(ctx, sess.ID)
+		return err == nil && dbSession.Status == store.SessionStatusCompleted && dbSession.ResultContent != ""
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, "scripted", dbSession.Runner)
+	assert.Equal(t, "All tests pass", dbSession.ResultContent)
+	require.NotEmpty(t, dbSession.ClaudeSessionID)
+	assert.NotEqual(t, "ignored", dbSession.ClaudeSessionID)
+
+	events, err := testStore.GetConversation(ctx, dbSession.ClaudeSessionID)
+	require.NoError(t, err)
+	var toolCall *store.ConversationEvent
+	for _, event := range events {
+		if event.EventType == store.EventTypeToolCall {
+			toolCall = event
+		}
+	}
+	require.NotNil(t, toolCall)
+	assert.Equal(t, "Bash", toolCall.ToolName)
+	assert.True(t, toolCall.IsCompleted)
+
+	info, err := manager.GetSessionInfo(sess.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "scripted", info.Runner)
+}
@@ -0,0 +1,317 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+const (
+	// maxTitleLength is the longest generated title in runes
+	maxTitleLength = 60
+
+	// titleModelTimeout bounds the one-shot model call for a title
+	titleModelTimeout = 60 * time.Second
+)
+
+// titleFillers are conversational lead-ins that say nothing about the task
+var titleFillers = []string{
+	"ok so", "okay so", "alright so", "so", "ok", "okay", "alright", "hey", "hi", "hello",
+	"please", "pls", "can you", "could you", "would you", "will you",
+	"i need you to", "i want you to", "i'd like you to", "i would like you to",
+	"i need to", "i want to", "we need to", "help me", "let's", "lets", "go ahead and",
+}
+
+// TitleGenerator gives untitled sessions a title once the first assistant
+// turn arrives, using the mode from user settings
+type TitleGenerator struct {
+	store    store.ConversationStore
+	eventBus bus.EventBus
+	client   func() (*claudecode.Client, error) // Claude client for TitleModeModel
+	timeout  time.Duration
+
+	started sync.Map // Session IDs a title has been attempted for
+}
+
+// NewTitleGenerator creates a title generator. client provides the Claude
+// client for model generated titles.
+func NewTitleGenerator(store store.ConversationStore, eventBus bus.EventBus, client func() (*claudecode.Client, error)) *TitleGenerator {
+	return &TitleGenerator{
+		store:    store,
+		eventBus: eventBus,
+		client:   client,
+		timeout:  titleModelTimeout,
+	}
+}
+
+// Observe is called with each assistant reply. The first reply for a session
+// starts title generation in the background.
+func (g *TitleGenerator) Observe(sessionID, reply string) {
+	if g == nil {
+		return
+	}
+	if _, loaded := g.started.LoadOrStore(sessionID, true); loaded {
+		return
+	}
+	go func() {
+		if err := g.Generate(context.Background(), sessionID, reply); err != nil {
+			slog.Warn("failed to generate session title",
+				"session_id", sessionID,
+				"error", err)
+		}
+	}()
+}
+
+// Forget drops the record of a session once it has finished
+func (g *TitleGenerator) Forget(sessionID string) {
+	if g == nil {
+		return
+	}
+	g.started.Delete(sessionID)
+}
+
+// Generate sets a title for the session if it has none and titles are enabled
+func (g *TitleGenerator) Generate(ctx context.Context, sessionID, reply string) error {
+	sess, err := g.store.GetSession(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+	if sess.Title != "" {
+		return nil
+	}
+
+	settings, err := g.store.GetUserSettings(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get user settings: %w", err)
+	}
+
+	var title string
+	switch settings.AutoTitleMode {
+	case store.TitleModeOff:
+		return nil
+	case store.TitleModeModel:
+		title, err = g.modelTitle(sess.Query, reply, settings.AutoTitleModel)
+		if err != nil {
+			slog.Warn("model title generation failed, extracting title from query",
+				"session_id", sessionID,
+				"error", err)
+			title = ExtractTitle(sess.Query)
+		}
+	default:
+		title = ExtractTitle(sess.Query)
+	}
+	if title == "" {
+		return nil
+	}
+
+	// The user may have named the session while the title was generated
+	sess, err = g.store.GetSession(ctx, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+	if sess.Title != "" {
+		return nil
+	}
+
+	if err := g.store.UpdateSession(ctx, sessionID, store.SessionUpdate{Title: &title}); err != nil {
+		return fmt.Errorf("failed to update session title: %w", err)
+	}
+
+	slog.Info("generated session title",
+		"session_id", sessionID,
+		"mode", settings.AutoTitleMode,
+		"title", title)
+
+	if g.eventBus != nil {
+		g.eventBus.Publish(bus.NewEvent(bus.SessionSettingsChangedPayload{
+			SessionID: sessionID,
+			RunID:     sess.RunID,
+			Title:     title,
+			Reason:    bus.SessionSettingsChangeReasonAutoTitle,
+		}))
+	}
+	return nil
+}
+
+// modelTitle asks a cheap model for a title with a one-shot Claude call
+func (g *TitleGenerator) modelTitle(query, reply, model string) (string, error) {
+	if g.client == nil {
+		return "", fmt.Errorf("claude client not available")
+	}
+	client, err := g.client()
+	if err != nil {
+		return "", err
+	}
+
+	config := claudecode.SessionConfig{
+		Query:        titlePrompt(query, reply),
+		Model:        claudecode.ModelHaiku,
+		OutputFormat: claudecode.OutputJSON,
+		MaxTurns:     1,
+		// Keep title requests out of the project's conversation history
+		WorkingDir: os.TempDir(),
+	}
+	if model != "" {
+		config.Model = claudecode.Model(model)
+	}
+
+	claudeSession, err := client.Launch(config)
+	if err != nil {
+		return "", err
+	}
+
+	type waitResult struct {
+		result *claudecode.Result
+		err    error
+	}
+	done := make(chan waitResult, 1)
+	go func() {
+		result, err := claudeSession.Wait()
+		done <- waitResult{result, err}
+	}()
+
+	select {
+	case <-time.After(g.timeout):
+		_ = claudeSession.Kill()
+		return "", fmt.Errorf("title generation timed out after %s", g.timeout)
+	case res := <-done:
+		if res.err != nil {
+			return "", res.err
+		}
+		if res.result == nil || res.result.IsError {
+			return "", fmt.Errorf("title generation returned no result")
+		}
+		title := cleanModelTitle(res.result.Result)
+		if title == "" {
+			return "", fmt.Errorf("title generation returned an empty title")
+		}
+		return title, nil
+	}
+}
+
+// titlePrompt builds the request for a model generated title
+func titlePrompt(query, reply string) string {
+	return fmt.Sprintf(`Write a title of at most 6 words for this coding session. Reply with the title only, no quotes or punctuation at the end.
+
+<request>
+%s
+</request>
+
+<first_reply>
+%s
+</first_reply>`, truncateRunes(query, 2000), truncateRunes(reply, 1000))
+}
+
+// cleanModelTitle reduces a model reply to a single title line
+func cleanModelTitle(reply string) string {
+	reply = strings.TrimSpace(reply)
+	if line, _, found := strings.Cut(reply, "\n"); found {
+		reply = line
+	}
+	reply = strings.TrimSpace(strings.TrimPrefix(reply, "Title:"))
+	reply = strings.Trim(reply, "\"'`*# ")
+	return finishTitle(reply)
+}
+
+// ExtractTitle derives a title from a query without a model call. It takes
+// the first sentence with content, drops conversational filler and cuts it
+// at a word boundary.
+func ExtractTitle(query string) string {
+	for _, sentence := range splitSentences(query) {
+		if title := finishTitle(stripFillers(sentence)); title != "" {
+			return title
+		}
+	}
+	return CalculateSummary(query)
+}
+
+// splitSentences splits text into whitespace normalized sentences and lines
+func splitSentences(text string) []string {
+	var sentences []string
+	var current strings.Builder
+	flush := func() {
+		if sentence := strings.Join(strings.Fields(current.String()), " "); sentence != "" {
+			sentences = append(sentences, sentence)
+		}
+		current.Reset()
+	}
+
+	runes := []rune(text)
+	for i, r := range runes {
+		if r == '\n' {
+			flush()
+			continue
+		}
+		current.WriteRune(r)
+		// A sentence ends at punctuation followed by whitespace or the end
+		if (r == '.' || r == '?' || r == '!') && (i+1 == len(runes) || unicode.IsSpace(runes[i+1])) {
+			flush()
+		}
+	}
+	flush()
+	return sentences
+}
+
+// stripFillers removes leading filler phrases until none remain
+func stripFillers(sentence string) string {
+	for {
+		stripped := false
+		for _, filler := range titleFillers {
+			if len(sentence) < len(filler) || !strings.EqualFold(sentence[:len(filler)], filler) {
+				continue
+			}
+			rest := sentence[len(filler):]
+			// Only strip whole words
+			if rest != "" {
+				next, _ := utf8.DecodeRuneInString(rest)
+				if unicode.IsLetter(next) || unicode.IsDigit(next) {
+					continue
+				}
+			}
+			sentence = strings.TrimLeft(rest, " ,.!:;-")
+			stripped = true
+			break
+		}
+		if !stripped {
+			return sentence
+		}
+	}
+}
+
+// finishTitle trims trailing punctuation, capitalizes and shortens a title
+func finishTitle(title string) string {
+	title = strings.TrimRight(strings.TrimSpace(title), " .,;:!")
+	if title == "" {
+		return ""
+	}
+
+	if utf8.RuneCountInString(title) > maxTitleLength {
+		runes := []rune(title)[:maxTitleLength]
+		cut := string(runes)
+		if i := strings.LastIndex(cut, " "); i > maxTitleLength/2 {
+			cut = cut[:i]
+		}
+		title = strings.TrimRight(cut, " .,;:!")
+	}
+
+	first, size := utf8.DecodeRuneInString(title)
+	return string(unicode.ToUpper(first)) + title[size:]
+}
+
+// truncateRunes shortens text to at most n runes
+func truncateRunes(text string, n int) string {
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	return string([]rune(text)[:n])
+}
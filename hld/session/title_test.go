@@ -0,0 +1,174 @@
+package session
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractTitle(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"ok so I need you to look at the thing in the parser. It keeps failing", "Look at the thing in the parser"},
+		{"hey\n\ncan you fix the flaky websocket test?", "Fix the flaky websocket test?"},
+		{"Please, add retries to the webhook sender!", "Add retries to the webhook sender"},
+		{"solve the okay-ish bug", "Solve the okay-ish bug"},
+		{"refactor the session manager so that launching, continuing and launching drafts share one code path", "Refactor the session manager so that launching, continuing"},
+		{"hi", "hi"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExtractTitle(tt.query))
+		})
+	}
+}
+
+func TestCleanModelTitle(t *testing.T) {
+	assert.Equal(t, "Fix flaky parser test", cleanModelTitle("\"Fix flaky parser test.\"\n"))
+	assert.Equal(t, "Add webhook retries", cleanModelTitle("Title: Add webhook retries\nThis covers..."))
+	assert.Equal(t, "", cleanModelTitle("  "))
+}
+
+// writeFakeClaude writes a claude stand-in running script. It lingers after
+// the script so the client reads its output before the pipes close.
+func writeFakeClaude(t *testing.T, script string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "claude")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script+"\nstatus=$?\nsleep 0.2\nexit $status\n"), 0755))
+	return path
+}
+
+func TestTitleGenerator(t *testing.T) {
+	ctx := context.Background()
+	s, err := store.NewSQLiteStore(testutil.DatabasePath(t, "titles"))
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	createSession := func(id, title string) {
+		require.NoError(t, s.CreateSession(ctx, &store.Session{
+			ID:             id,
+			RunID:          "run-" + id,
+			Query:          "ok so can you make the parser handle tabs",
+			Title:          title,
+			Status:         store.SessionStatusRunning,
+			CreatedAt:      time.Now(),
+			LastActivityAt: time.Now(),
+		}))
+	}
+	setMode := func(mode, model string) {
+		settings, err := s.GetUserSettings(ctx)
+		require.NoError(t, err)
+		settings.AutoTitleMode = mode
+		settings.AutoTitleModel = model
+		require.NoError(t, s.UpdateUserSettings(ctx, *settings))
+	}
+	title := func(id string) string {
+		sess, err := s.GetSession(ctx, id)
+		require.NoError(t, err)
+		return sess.Title
+	}
+
+	fakeClaude := writeFakeClaude(t, `echo '{"type":"result","subtype":"success","result":"\"Handle tabs in parser\"","session_id":"title-1"}'`)
+	eventBus := bus.NewEventBus()
+	generator := NewTitleGenerator(s, eventBus, func() (*claudecode.Client, error) {
+		return claudecode.NewClientWithPath(fakeClaude), nil
+	})
+
+	t.Run("heuristic is the default", func(t *testing.T) {
+		settings, err := s.GetUserSettings(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, store.TitleModeHeuristic, settings.AutoTitleMode)
+
+		createSession("heuristic", "")
+		require.NoError(t, generator.Generate(ctx, "heuristic", "Sure, looking at the lexer"))
+		assert.Equal(t, "Make the parser handle tabs", title("heuristic"))
+	})
+
+	t.Run("user titles are kept", func(t *testing.T) {
+		createSession("named", "Tab support")
+		require.NoError(t, generator.Generate(ctx, "named", "Sure"))
+		assert.Equal(t, "Tab support", title("named"))
+	})
+
+	t.Run("off leaves sessions untitled", func(t *testing.T) {
+		setMode(store.TitleModeOff, "")
+		createSession("off", "")
+		require.NoError(t, generator.Generate(ctx, "off", "Sure"))
+		assert.Equal(t, "", title("off"))
+	})
+
+	t.Run("model titles come from claude", func(t *testing.T) {
+		setMode(store.TitleModeModel, "")
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		sub := eventBus.Subscribe(subCtx, bus.EventFilter{Types: []bus.EventType{bus.EventSessionSettingsChanged}})
+
+		createSession("model", "")
+		require.NoError(t, generator.Generate(ctx, "model", "Sure, looking at the lexer"))
+		assert.Equal(t, "Handle tabs in parser", title("model"))
+
+		select {
+		case event := <-sub.Channel:
+			assert.Equal(t, "model", event.Data["session_id"])
+			assert.Equal(t, "Handle tabs in parser", event.Data["title"])
+			assert.Equal(t, "auto_title", event.Data["reason"])
+		case <-time.After(time.Second):
+			t.Fatal("expected a settings changed event")
+		}
+	})
+
+	t.Run("model failures fall back to the heuristic", func(t *testing.T) {
+		failing := writeFakeClaude(t, "echo 'rate limited' >&2\nfalse")
+		generator := NewTitleGenerator(s, nil, func() (*claudecode.Client, error) {
+			return claudecode.NewClientWithPath(failing), nil
+		})
+		createSession("fallback", "")
+		require.NoError(t, generator.Generate(ctx, "fallback", "Sure"))
+		assert.Equal(t, "Make the parser handle tabs", title("fallback"))
+	})
+}
+
+func TestManager_TitlesAfterFirstReply(t *testing.T) {
+	ctx := context.Background()
+	s, err := store.NewSQLiteStore(testutil.DatabasePath(t, "manager-titles"))
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	manager, err := NewManager(bus.NewEventBus(), s, "")
+	require.NoError(t, err)
+	manager.RegisterRunner(NewScriptedRunner("scripted", []claudecode.StreamEvent{
+		{Type: "system", Subtype: "init"},
+		{Type: "assistant", Message: &claudecode.Message{
+			ID:      "msg-1",
+			Role:    "assistant",
+			Content: []claudecode.Content{{Type: "text", Text: "I'll look at the retry loop."}},
+		}},
+		{Type: "result", Subtype: "success", Result: "done"},
+	}, 0))
+
+	sess, err := manager.LaunchSession(ctx, LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{
+			Query:      "hey so the webhook sender never retries. fix that",
+			WorkingDir: t.TempDir(),
+		},
+		Runner: "scripted",
+	}, false)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		dbSession, err := s.GetSession(ctx, sess.ID)
+		return err == nil && dbSession.Title == "The webhook sender never retries"
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -66,7 +66,45 @@ type Info struct {
 	ProxyEnabled                        bool               `json:"proxy_enabled"`
 	ProxyBaseURL                        string             `json:"proxy_base_url,omitempty"`
 	ProxyModelOverride                  string             `json:"proxy_model_override,omitempty"`
-	ProxyAPIKey                         string             `json:"proxy_api_key,omitempty"`
+	ProxyAPIKey                         string             `json:"-"` // Never serialized, see ProxyAPIKeySet
+	ProxyAPIKeySet                      bool               `json:"proxy_api_key_set"`
+	StallThresholdMs                    *int64             `json:"stall_threshold_ms,omitempty"`
+	StallInterruptThresholdMs           *int64             `json:"stall_interrupt_threshold_ms,omitempty"`
+	Resources                           *ResourceUsage     `json:"resources,omitempty"`
+	Runner                              string             `json:"runner,omitempty"`
+	ProjectID                           string             `json:"project_id,omitempty"`
+	Labels                              []string           `json:"labels,omitempty"`
+}
+
+// ResourceUsage is the resource usage of a session's process tree: the claude
+// CLI and every tool subprocess it spawned
+type ResourceUsage struct {
+	CPUTimeMs      int64     `json:"cpu_time_ms"`
+	CPUPercent     float64   `json:"cpu_percent"`
+	RSSBytes       int64     `json:"rss_bytes"`
+	OpenFDs        int64     `json:"open_fds"`
+	PeakCPUPercent float64   `json:"peak_cpu_percent"`
+	PeakRSSBytes   int64     `json:"peak_rss_bytes"`
+	PeakOpenFDs    int64     `json:"peak_open_fds"`
+	SampledAt      time.Time `json:"sampled_at"`
+}
+
+// ResourceUsageFromSession returns the session's latest sampled usage and
+// peaks, or nil if it has never been sampled
+func ResourceUsageFromSession(s *store.Session) *ResourceUsage {
+	if s.ResourcesSampledAt == nil {
+		return nil
+	}
+	return &ResourceUsage{
+		CPUTimeMs:      s.CPUTimeMs,
+		CPUPercent:     s.CPUPercent,
+		RSSBytes:       s.RSSBytes,
+		OpenFDs:        s.OpenFDs,
+		PeakCPUPercent: s.PeakCPUPercent,
+		PeakRSSBytes:   s.PeakRSSBytes,
+		PeakOpenFDs:    s.PeakOpenFDs,
+		SampledAt:      *s.ResourcesSampledAt,
+	}
 }
 
 // LaunchSessionConfig contains the configuration for launching a new session
@@ -83,6 +121,11 @@ type LaunchSessionConfig struct {
 	ProxyBaseURL       string // Proxy base URL
 	ProxyModelOverride string // Model to use with proxy
 	ProxyAPIKey        string // API key for proxy service
+	// Stall watchdog thresholds in milliseconds, nil uses the daemon default and 0 disables
+	StallThresholdMs          *int64
+	StallInterruptThresholdMs *int64
+	// Runner backend to launch with, empty for Claude Code
+	Runner string
 }
 
 // ContinueSessionConfig contains the configuration for continuing a session
@@ -98,6 +141,7 @@ type ContinueSessionConfig struct {
 	AdditionalDirectories []string              // Optional additional directories override
 	CustomInstructions    string                // Optional custom instructions
 	MaxTurns              int                   // Optional max turns override
+	Model                 claudecode.Model      // Optional model override
 	ProxyEnabled          bool                  // Whether proxy is enabled
 	ProxyBaseURL          string                // Proxy base URL
 	ProxyModelOverride    string                // Model to use with proxy
@@ -196,6 +240,11 @@ func SessionToInfo(s store.Session) Info {
 		ProxyBaseURL:                        s.ProxyBaseURL,
 		ProxyModelOverride:                  s.ProxyModelOverride,
 		ProxyAPIKey:                         s.ProxyAPIKey,
+		ProxyAPIKeySet:                      s.ProxyAPIKey != "",
+		StallThresholdMs:                    s.StallThresholdMs,
+		StallInterruptThresholdMs:           s.StallInterruptThresholdMs,
+		Resources:                           ResourceUsageFromSession(&s),
+		Runner:                              s.Runner,
 		// Note: CLICommand is not stored in database, it's a build-time constant
 	}
 
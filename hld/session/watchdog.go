@@ -0,0 +1,202 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// WatchdogConfig holds the daemon-wide stall watchdog defaults. Sessions can
+// override both thresholds; a threshold of zero disables that stage.
+type WatchdogConfig struct {
+	Interval                time.Duration // How often active sessions are checked
+	StallThreshold          time.Duration // Idle time after which a session is reported as stalled
+	StallInterruptThreshold time.Duration // Idle time after which a session is interrupted, reported or not
+}
+
+// Watchdog detects running sessions whose process is alive but has produced no
+// activity for too long, such as a Bash tool waiting on stdin or a stuck MCP
+// server. Sessions waiting for approval are never considered stalled.
+type Watchdog struct {
+	sessions SessionManager
+	store    store.ConversationStore
+	eventBus bus.EventBus
+	config   WatchdogConfig
+	now      func() time.Time
+
+	mu          sync.Mutex
+	reported    map[string]time.Time // Session ID to the last activity time its stall was reported for
+	interrupted map[string]time.Time // Session ID to the last activity time it was interrupted for
+}
+
+// NewWatchdog creates a new stall watchdog
+func NewWatchdog(sessions SessionManager, store store.ConversationStore, eventBus bus.EventBus, config WatchdogConfig) *Watchdog {
+	if config.Interval <= 0 {
+		config.Interval = 30 * time.Second
+	}
+	return &Watchdog{
+		sessions:    sessions,
+		store:       store,
+		eventBus:    eventBus,
+		config:      config,
+		now:         time.Now,
+		reported:    make(map[string]time.Time),
+		interrupted: make(map[string]time.Time),
+	}
+}
+
+// Start checks active sessions on every interval until ctx is cancelled
+func (w *Watchdog) Start(ctx context.Context) {
+	slog.Info("starting stalled session watchdog",
+		"interval", w.config.Interval,
+		"stall_threshold", w.config.StallThreshold,
+		"interrupt_threshold", w.config.StallInterruptThreshold)
+
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("stalled session watchdog shutting down")
+			return
+		case <-ticker.C:
+			w.Check(ctx)
+		}
+	}
+}
+
+// Check reports newly stalled sessions and interrupts those idle past their
+// interrupt threshold. Each stage fires once per period of inactivity.
+func (w *Watchdog) Check(ctx context.Context) {
+	// Guard against nil store (can happen during shutdown)
+	if w.store == nil {
+		return
+	}
+
+	sessions, err := w.store.GetSessionsByStatus(ctx, []string{
+		store.SessionStatusStarting,
+		store.SessionStatusRunning,
+	})
+	if err != nil {
+		slog.Error("failed to query active sessions for stall check", "error", err)
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	active := make(map[string]bool, len(sessions))
+	now := w.now()
+	for _, sess := range sessions {
+		active[sess.ID] = true
+
+		idle := now.Sub(sess.LastActivityAt)
+
+		// The stages are independent: either may be disabled, and the
+		// interrupt may come before the stall report
+		threshold := effectiveThreshold(sess.StallThresholdMs, w.config.StallThreshold)
+		if threshold > 0 && idle >= threshold && !w.reported[sess.ID].Equal(sess.LastActivityAt) {
+			w.reported[sess.ID] = sess.LastActivityAt
+			w.reportStall(ctx, sess, idle, threshold)
+		}
+
+		interruptThreshold := effectiveThreshold(sess.StallInterruptThresholdMs, w.config.StallInterruptThreshold)
+		if interruptThreshold > 0 && idle >= interruptThreshold && !w.interrupted[sess.ID].Equal(sess.LastActivityAt) {
+			w.interrupted[sess.ID] = sess.LastActivityAt
+			w.interrupt(ctx, sess, idle)
+		}
+	}
+
+	// Forget sessions that are no longer active
+	for id := range w.reported {
+		if !active[id] {
+			delete(w.reported, id)
+		}
+	}
+	for id := range w.interrupted {
+		if !active[id] {
+			delete(w.interrupted, id)
+		}
+	}
+}
+
+func (w *Watchdog) reportStall(ctx context.Context, sess *store.Session, idle, threshold time.Duration) {
+	interruptThreshold := effectiveThreshold(sess.StallInterruptThresholdMs, w.config.StallInterruptThreshold)
+
+	slog.Warn("session stalled",
+		"session_id", sess.ID,
+		"idle", idle.Round(time.Second),
+		"threshold", threshold)
+
+	if w.eventBus != nil {
+		payload := bus.SessionStalledPayload{
+			SessionID:      sess.ID,
+			RunID:          sess.RunID,
+			IdleMs:         idle.Milliseconds(),
+			ThresholdMs:    threshold.Milliseconds(),
+			LastActivityAt: sess.LastActivityAt,
+		}
+		if interruptThreshold > 0 {
+			payload.InterruptThresholdMs = interruptThreshold.Milliseconds()
+		}
+		w.eventBus.Publish(bus.NewEvent(payload))
+	}
+
+	w.recordReason(ctx, sess, fmt.Sprintf("Session stalled: no activity for %s", idle.Round(time.Second)))
+}
+
+func (w *Watchdog) interrupt(ctx context.Context, sess *store.Session, idle time.Duration) {
+	slog.Warn("interrupting stalled session",
+		"session_id", sess.ID,
+		"idle", idle.Round(time.Second))
+
+	w.recordReason(ctx, sess, fmt.Sprintf("Session interrupted by watchdog: no activity for %s", idle.Round(time.Second)))
+
+	if err := w.sessions.InterruptSession(ctx, sess.ID); err != nil {
+		slog.Error("failed to interrupt stalled session",
+			"session_id", sess.ID,
+			"error", err)
+	}
+}
+
+// recordReason adds a system event explaining the watchdog's action to the
+// session's conversation
+func (w *Watchdog) recordReason(ctx context.Context, sess *store.Session, content string) {
+	event := &store.ConversationEvent{
+		SessionID:       sess.ID,
+		ClaudeSessionID: sess.ClaudeSessionID,
+		EventType:       store.EventTypeSystem,
+		Role:            "system",
+		Content:         content,
+	}
+	if err := w.store.AddConversationEvent(ctx, event); err != nil {
+		slog.Error("failed to record watchdog event",
+			"session_id", sess.ID,
+			"error", err)
+		return
+	}
+
+	if w.eventBus != nil {
+		w.eventBus.Publish(bus.NewEvent(bus.ConversationUpdatedPayload{
+			SessionID:       sess.ID,
+			ClaudeSessionID: sess.ClaudeSessionID,
+			EventType:       "system",
+			Content:         content,
+			ContentType:     "system",
+		}))
+	}
+}
+
+// effectiveThreshold returns the session's threshold when set, otherwise the default
+func effectiveThreshold(sessionMs *int64, fallback time.Duration) time.Duration {
+	if sessionMs != nil {
+		return time.Duration(*sessionMs) * time.Millisecond
+	}
+	return fallback
+}
@@ -0,0 +1,123 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWatchdog_Check(t *testing.T) {
+	ctx := context.Background()
+	s, err := store.NewSQLiteStore(":memory:")
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	now := time.Now()
+	disabled := int64(0)
+	shortThreshold := int64((2 * time.Minute).Milliseconds())
+	sessions := []*store.Session{
+		// Idle for 20 minutes, past both default thresholds
+		{ID: "stuck", RunID: "run-stuck", ClaudeSessionID: "claude-stuck", Status: store.SessionStatusRunning, LastActivityAt: now.Add(-20 * time.Minute)},
+		// Idle for 12 minutes, stalled but not yet interrupted
+		{ID: "slow", RunID: "run-slow", ClaudeSessionID: "claude-slow", Status: store.SessionStatusRunning, LastActivityAt: now.Add(-12 * time.Minute)},
+		// Recently active
+		{ID: "busy", RunID: "run-busy", Status: store.SessionStatusRunning, LastActivityAt: now.Add(-time.Minute)},
+		// Waiting on an approval is not a stall
+		{ID: "waiting", RunID: "run-waiting", Status: store.SessionStatusWaitingInput, LastActivityAt: now.Add(-time.Hour)},
+		// The session opted out of the watchdog
+		{ID: "opted-out", RunID: "run-opted-out", Status: store.SessionStatusRunning, LastActivityAt: now.Add(-time.Hour), StallThresholdMs: &disabled, StallInterruptThresholdMs: &disabled},
+		// Stall reports are off, but the interrupt still applies
+		{ID: "unreported", RunID: "run-unreported", ClaudeSessionID: "claude-unreported", Status: store.SessionStatusRunning, LastActivityAt: now.Add(-20 * time.Minute), StallThresholdMs: &disabled},
+		// An interrupt threshold below the stall threshold is not delayed by it
+		{ID: "impatient", RunID: "run-impatient", Status: store.SessionStatusRunning, LastActivityAt: now.Add(-3 * time.Minute), StallInterruptThresholdMs: &shortThreshold},
+		// A per-session threshold below the default
+		{ID: "strict", RunID: "run-strict", Status: store.SessionStatusStarting, LastActivityAt: now.Add(-3 * time.Minute), StallThresholdMs: &shortThreshold},
+	}
+	for _, sess := range sessions {
+		sess.CreatedAt = now.Add(-time.Hour)
+		require.NoError(t, s.CreateSession(ctx, sess))
+	}
+
+	ctrl := gomock.NewController(t)
+	manager := NewMockSessionManager(ctrl)
+	manager.EXPECT().InterruptSession(gomock.Any(), "stuck").Return(nil).Times(1)
+	manager.EXPECT().InterruptSession(gomock.Any(), "unreported").Return(nil).Times(1)
+	manager.EXPECT().InterruptSession(gomock.Any(), "impatient").Return(nil).Times(1)
+
+	eventBus := bus.NewEventBus()
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sub := eventBus.Subscribe(subCtx, bus.EventFilter{Types: []bus.EventType{bus.EventSessionStalled}})
+
+	w := NewWatchdog(manager, s, eventBus, WatchdogConfig{
+		StallThreshold:          10 * time.Minute,
+		StallInterruptThreshold: 15 * time.Minute,
+	})
+	w.now = func() time.Time { return now }
+
+	w.Check(ctx)
+
+	stalled := make(map[string]bus.Event)
+	for len(stalled) < 3 {
+		select {
+		case event := <-sub.Channel:
+			stalled[event.Data["session_id"].(string)] = event
+		case <-time.After(time.Second):
+			t.Fatalf("expected 3 stall events, got %d", len(stalled))
+		}
+	}
+	assert.Contains(t, stalled, "stuck")
+	assert.Contains(t, stalled, "slow")
+	assert.Contains(t, stalled, "strict")
+	assert.Equal(t, int64((10 * time.Minute).Milliseconds()), stalled["slow"].Data["threshold_ms"])
+	assert.Equal(t, int64((15 * time.Minute).Milliseconds()), stalled["slow"].Data["interrupt_threshold_ms"])
+	assert.Equal(t, shortThreshold, stalled["strict"].Data["threshold_ms"])
+
+	// The reasons are recorded in the conversation
+	events, err := s.GetConversation(ctx, "claude-stuck")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, store.EventTypeSystem, events[0].EventType)
+	assert.Equal(t, "Session stalled: no activity for 20m0s", events[0].Content)
+	assert.Equal(t, "Session interrupted by watchdog: no activity for 20m0s", events[1].Content)
+	events, err = s.GetConversation(ctx, "claude-unreported")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "Session interrupted by watchdog: no activity for 20m0s", events[0].Content)
+
+	// A second check without new activity does not repeat anything
+	w.Check(ctx)
+	select {
+	case event := <-sub.Channel:
+		t.Fatalf("unexpected repeated stall event for %v", event.Data["session_id"])
+	case <-time.After(50 * time.Millisecond):
+	}
+	events, err = s.GetConversation(ctx, "claude-slow")
+	require.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	// Activity followed by another stall is reported again
+	later := now.Add(-11 * time.Minute)
+	require.NoError(t, s.UpdateSession(ctx, "slow", store.SessionUpdate{LastActivityAt: &later}))
+	w.Check(ctx)
+	select {
+	case event := <-sub.Channel:
+		assert.Equal(t, "slow", event.Data["session_id"])
+	case <-time.After(time.Second):
+		t.Fatal("expected a new stall event after renewed activity")
+	}
+}
+
+func TestEffectiveThreshold(t *testing.T) {
+	override := int64(5000)
+	zero := int64(0)
+	assert.Equal(t, time.Minute, effectiveThreshold(nil, time.Minute))
+	assert.Equal(t, 5*time.Second, effectiveThreshold(&override, time.Minute))
+	assert.Equal(t, time.Duration(0), effectiveThreshold(&zero, time.Minute))
+}
@@ -0,0 +1,31 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/humanlayer/humanlayer/hld/store/storetest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLiteConformance(t *testing.T) {
+	storetest.Run(t, func(t *testing.T) store.ConversationStore {
+		s, err := store.NewSQLiteStore(testutil.DatabasePath(t, "conformance"))
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = s.Close() })
+		return s
+	})
+}
+
+func TestMemoryConformance(t *testing.T) {
+	newStore := func(t *testing.T) store.ConversationStore { return store.NewMemoryStore() }
+	storetest.Run(t, newStore)
+	storetest.RunConcurrent(t, newStore)
+}
+
+func TestPostgresConformance(t *testing.T) {
+	newStore := func(t *testing.T) store.ConversationStore { return store.NewTestPostgresStore(t) }
+	storetest.Run(t, newStore)
+	storetest.RunConcurrent(t, newStore)
+}
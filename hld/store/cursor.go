@@ -0,0 +1,111 @@
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SessionCursor is the position of a session in the session list, which is
+// ordered by last activity and then ID, newest first
+type SessionCursor struct {
+	LastActivityAt time.Time `json:"t"`
+	ID             string    `json:"id"`
+}
+
+// ConversationCursor is the position of an event in a conversation. Sequences
+// restart for every Claude session, so both are needed to locate an event in
+// a conversation that spans continued sessions.
+type ConversationCursor struct {
+	ClaudeSessionID string `json:"c"`
+	Sequence        int    `json:"s"`
+}
+
+// ConversationPage selects a window of a conversation
+type ConversationPage struct {
+	// After skips every event up to and including the cursor position
+	After *ConversationCursor
+	// SinceSequence returns only events of the session's own Claude session
+	// with a higher sequence, for clients polling a live conversation. It is
+	// ignored when After is set.
+	SinceSequence int
+	// Limit caps the number of events returned, 0 for no limit
+	Limit int
+}
+
+// Encode returns the opaque string form of the cursor handed to clients
+func (c SessionCursor) Encode() string {
+	return encodeCursor(c)
+}
+
+// Encode returns the opaque string form of the cursor handed to clients
+func (c ConversationCursor) Encode() string {
+	return encodeCursor(c)
+}
+
+// ParseSessionCursor decodes a cursor produced by SessionCursor.Encode
+func ParseSessionCursor(s string) (*SessionCursor, error) {
+	var cursor SessionCursor
+	if err := decodeCursor(s, &cursor); err != nil {
+		return nil, err
+	}
+	if cursor.ID == "" || cursor.LastActivityAt.IsZero() {
+		return nil, fmt.Errorf("%w: missing position", ErrInvalidCursor)
+	}
+	return &cursor, nil
+}
+
+// ParseConversationCursor decodes a cursor produced by ConversationCursor.Encode
+func ParseConversationCursor(s string) (*ConversationCursor, error) {
+	var cursor ConversationCursor
+	if err := decodeCursor(s, &cursor); err != nil {
+		return nil, err
+	}
+	if cursor.ClaudeSessionID == "" {
+		return nil, fmt.Errorf("%w: missing position", ErrInvalidCursor)
+	}
+	return &cursor, nil
+}
+
+// PageSessions trims sessions fetched with a limit of limit+1 to limit and
+// returns the cursor for the next page, empty when this is the last page
+func PageSessions(sessions []*Session, limit int) ([]*Session, string) {
+	if limit <= 0 || len(sessions) <= limit {
+		return sessions, ""
+	}
+	sessions = sessions[:limit]
+	last := sessions[limit-1]
+	return sessions, SessionCursor{LastActivityAt: last.LastActivityAt, ID: last.ID}.Encode()
+}
+
+// PageConversation trims events fetched with a limit of limit+1 to limit. It
+// returns the cursor after the last event kept, which can be used to poll for
+// new events even on the last page, and whether more events remain.
+func PageConversation(events []*ConversationEvent, limit int) ([]*ConversationEvent, string, bool) {
+	hasMore := limit > 0 && len(events) > limit
+	if hasMore {
+		events = events[:limit]
+	}
+	if len(events) == 0 {
+		return events, "", false
+	}
+	last := events[len(events)-1]
+	return events, ConversationCursor{ClaudeSessionID: last.ClaudeSessionID, Sequence: last.Sequence}.Encode(), hasMore
+}
+
+func encodeCursor(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+	}
+	return nil
+}
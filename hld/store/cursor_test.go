@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerySessionsPagination(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-session-pages"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	// Two pairs share an activity time so the ID tie-break is exercised, and
+	// one session is stored with a different zone offset
+	activity := map[string]time.Time{
+		"a": base,
+		"b": base.Add(-time.Minute),
+		"c": base.Add(-time.Minute),
+		"d": base.Add(-2 * time.Minute).In(time.FixedZone("PDT", -7*3600)),
+		"e": base.Add(-3 * time.Minute),
+		"f": base.Add(-3 * time.Minute),
+		"g": base.Add(-4 * time.Minute),
+	}
+	for id, at := range activity {
+		require.NoError(t, store.CreateSession(ctx, &Session{
+			ID: id, RunID: "run-" + id, Status: SessionStatusCompleted, CreatedAt: at, LastActivityAt: at,
+		}))
+	}
+
+	var seen []string
+	filter := SessionFilter{Limit: 3}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 5, "pagination did not terminate")
+		fetch := filter
+		fetch.Limit = filter.Limit + 1
+		sessions, err := store.QuerySessions(ctx, fetch)
+		require.NoError(t, err)
+
+		page, next := PageSessions(sessions, filter.Limit)
+		seen = append(seen, sessionIDs(page)...)
+		if next == "" {
+			break
+		}
+		filter.After, err = ParseSessionCursor(next)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{"a", "c", "b", "d", "f", "e", "g"}, seen)
+
+	// Filters still apply alongside the cursor
+	sessions, err := store.QuerySessions(ctx, SessionFilter{
+		After:    &SessionCursor{LastActivityAt: activity["c"], ID: "c"},
+		Statuses: []string{SessionStatusCompleted},
+		Limit:    2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "d"}, sessionIDs(sessions))
+
+	_, err = ParseSessionCursor("not a cursor!")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = ParseSessionCursor(ConversationCursor{ClaudeSessionID: "x"}.Encode())
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestSessionConversationPagination(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-conversation-pages"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, store.CreateSession(ctx, &Session{
+		ID: "parent", RunID: "run-parent", ClaudeSessionID: "claude-parent",
+		Status: SessionStatusCompleted, CreatedAt: now, LastActivityAt: now,
+	}))
+	require.NoError(t, store.CreateSession(ctx, &Session{
+		ID: "child", RunID: "run-child", ClaudeSessionID: "claude-child", ParentSessionID: "parent",
+		Status: SessionStatusRunning, CreatedAt: now, LastActivityAt: now,
+	}))
+
+	added := map[string]int{}
+	addEvents := func(sessionID, claudeSessionID string, n int) {
+		for i := 0; i < n; i++ {
+			added[sessionID]++
+			require.NoError(t, store.AddConversationEvent(ctx, &ConversationEvent{
+				SessionID:       sessionID,
+				ClaudeSessionID: claudeSessionID,
+				EventType:       EventTypeMessage,
+				Role:            "assistant",
+				Content:         fmt.Sprintf("%s-%d", sessionID, added[sessionID]),
+			}))
+		}
+	}
+	addEvents("parent", "claude-parent", 3)
+	addEvents("child", "claude-child", 4)
+
+	contents := func(events []*ConversationEvent) []string {
+		out := make([]string, 0, len(events))
+		for _, e := range events {
+			out = append(out, e.Content)
+		}
+		return out
+	}
+
+	// Walking the whole chain page by page crosses the session boundary
+	var seen []string
+	page := ConversationPage{Limit: 2}
+	for {
+		fetch := page
+		fetch.Limit = page.Limit + 1
+		events, err := store.GetSessionConversationPage(ctx, "child", fetch)
+		require.NoError(t, err)
+
+		events, next, hasMore := PageConversation(events, page.Limit)
+		seen = append(seen, contents(events)...)
+		if !hasMore {
+			break
+		}
+		page.After, err = ParseConversationCursor(next)
+		require.NoError(t, err)
+	}
+	all, err := store.GetSessionConversation(ctx, "child")
+	require.NoError(t, err)
+	assert.Equal(t, contents(all), seen)
+	assert.Len(t, seen, 7)
+
+	// since_sequence polls the session's own Claude session
+	events, err := store.GetSessionConversationPage(ctx, "child", ConversationPage{SinceSequence: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"child-3", "child-4"}, contents(events))
+
+	events, err = store.GetConversationPage(ctx, "claude-parent", ConversationPage{SinceSequence: 1, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"parent-2"}, contents(events))
+
+	// The last page still hands out a cursor for picking up new events
+	_, next, hasMore := PageConversation(all, 0)
+	assert.False(t, hasMore)
+	after, err := ParseConversationCursor(next)
+	require.NoError(t, err)
+	addEvents("child", "claude-child", 1)
+	events, err = store.GetSessionConversationPage(ctx, "child", ConversationPage{After: after})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"child-5"}, contents(events))
+
+	_, err = store.GetSessionConversationPage(ctx, "parent", ConversationPage{After: after})
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = store.GetSessionConversationPage(ctx, "missing", ConversationPage{})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
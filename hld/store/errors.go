@@ -15,6 +15,13 @@ var (
 
 	// ErrInvalidStatus is returned when an invalid status is provided
 	ErrInvalidStatus = errors.New("invalid status")
+
+	// ErrAlreadyExists is returned when creating an entity whose unique name is taken
+	ErrAlreadyExists = errors.New("already exists")
+
+	// ErrInvalidCursor is returned when a pagination cursor cannot be decoded
+	// or does not belong to the listing it was passed to
+	ErrInvalidCursor = errors.New("invalid cursor")
 )
 
 // NotFoundError wraps ErrNotFound with additional context
@@ -44,3 +51,17 @@ func (e *AlreadyDecidedError) Error() string {
 func (e *AlreadyDecidedError) Unwrap() error {
 	return ErrAlreadyDecided
 }
+
+// AlreadyExistsError wraps ErrAlreadyExists with additional context
+type AlreadyExistsError struct {
+	Type string // e.g., "session template"
+	Name string
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("%s already exists: %s", e.Type, e.Name)
+}
+
+func (e *AlreadyExistsError) Unwrap() error {
+	return ErrAlreadyExists
+}
@@ -0,0 +1,5 @@
+package store
+
+// NewTestPostgresStore lets the conformance tests, which live outside the
+// package, open a throwaway PostgreSQL store
+var NewTestPostgresStore = newTestPostgresStore
@@ -0,0 +1,1071 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryStore implements ConversationStore in memory with the semantics of
+// SQLiteStore, for tests that need a real store without a database file.
+// Foreign keys, unique names and status checks of the SQLite schema are
+// enforced, and values are copied in and out so callers cannot change stored
+// rows behind the store's back.
+type MemoryStore struct {
+	mu sync.Mutex
+
+	sessions         map[string]*Session
+	effectiveConfigs map[string]string
+	projectTrust     map[string]string    // Project root to trusted config digest
+	events           []*ConversationEvent // In ID order
+	mcpServers       []MCPServer
+	rawEvents        []RawEvent
+	approvals        []*Approval // In insertion order
+	fileSnapshots    []FileSnapshot
+	settings         *UserSettings // nil until first updated
+
+	schedules     map[string]*Schedule
+	scheduleRuns  []*ScheduleRun
+	pipelineRuns  map[string]*PipelineRun
+	pipelineSteps []*PipelineStep
+	batchGroups   map[string]*BatchGroup
+	batchMembers  []*BatchMember
+	templates     map[string]*SessionTemplate
+	samples       []*ResourceSample
+	eventLog      []*EventLogEntry
+
+	webhooks          map[string]*Webhook
+	webhookDeliveries []*WebhookDelivery // In ID order
+
+	labels        map[string]*Label
+	sessionLabels map[string]map[string]bool // Session ID to label IDs
+	projects      map[string]*Project
+	savedFilters  map[string]*memorySavedFilter
+
+	lastID int64 // Shared by every table with integer IDs, which only need to increase
+}
+
+var _ ConversationStore = (*MemoryStore)(nil)
+
+// NewMemoryStore returns an empty store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		sessions:         make(map[string]*Session),
+		effectiveConfigs: make(map[string]string),
+		projectTrust:     make(map[string]string),
+		schedules:        make(map[string]*Schedule),
+		webhooks:         make(map[string]*Webhook),
+		pipelineRuns:     make(map[string]*PipelineRun),
+		batchGroups:      make(map[string]*BatchGroup),
+		templates:        make(map[string]*SessionTemplate),
+		labels:           make(map[string]*Label),
+		sessionLabels:    make(map[string]map[string]bool),
+		projects:         make(map[string]*Project),
+		savedFilters:     make(map[string]*memorySavedFilter),
+	}
+}
+
+// errForeignKey matches the error SQLite returns for a row referencing a
+// missing parent
+var errForeignKey = errors.New("FOREIGN KEY constraint failed")
+
+// errCheckConstraint matches the error SQLite returns for a value outside a
+// column's allowed set
+var errCheckConstraint = errors.New("CHECK constraint failed")
+
+func (s *MemoryStore) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+// Close implements ConversationStore. The store stays usable afterwards.
+func (s *MemoryStore) Close() error {
+	return nil
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func cloneSession(session *Session) *Session {
+	c := *session
+	c.CompletedAt = clonePtr(session.CompletedAt)
+	c.CostUSD = clonePtr(session.CostUSD)
+	c.InputTokens = clonePtr(session.InputTokens)
+	c.OutputTokens = clonePtr(session.OutputTokens)
+	c.CacheCreationInputTokens = clonePtr(session.CacheCreationInputTokens)
+	c.CacheReadInputTokens = clonePtr(session.CacheReadInputTokens)
+	c.EffectiveContextTokens = clonePtr(session.EffectiveContextTokens)
+	c.DurationMS = clonePtr(session.DurationMS)
+	c.NumTurns = clonePtr(session.NumTurns)
+	c.DangerouslySkipPermissionsExpiresAt = clonePtr(session.DangerouslySkipPermissionsExpiresAt)
+	c.DangerouslySkipPermissionsTimeoutMs = clonePtr(session.DangerouslySkipPermissionsTimeoutMs)
+	c.EditorState = clonePtr(session.EditorState)
+	c.StallThresholdMs = clonePtr(session.StallThresholdMs)
+	c.StallInterruptThresholdMs = clonePtr(session.StallInterruptThresholdMs)
+	c.ResourcesSampledAt = clonePtr(session.ResourcesSampledAt)
+	c.Labels = nil
+	return &c
+}
+
+func cloneEvent(event *ConversationEvent) *ConversationEvent {
+	c := *event
+	c.PreCompactionTokens = clonePtr(event.PreCompactionTokens)
+	c.PostCompactionTokens = clonePtr(event.PostCompactionTokens)
+	return &c
+}
+
+func cloneApproval(approval *Approval) *Approval {
+	c := *approval
+	c.ToolUseID = clonePtr(approval.ToolUseID)
+	c.RespondedAt = clonePtr(approval.RespondedAt)
+	c.ToolInput = append(json.RawMessage(nil), approval.ToolInput...)
+	return &c
+}
+
+// CreateSession creates a new session. Like SQLiteStore it stores only the
+// launch configuration and state, not results or resource usage.
+func (s *MemoryStore) CreateSession(ctx context.Context, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[session.ID]; ok {
+		return fmt.Errorf("failed to create session: %w", &AlreadyExistsError{Type: "session", Name: session.ID})
+	}
+	for _, existing := range s.sessions {
+		if existing.RunID == session.RunID {
+			return fmt.Errorf("failed to create session: %w", &AlreadyExistsError{Type: "session run", Name: session.RunID})
+		}
+	}
+
+	stored := cloneSession(session)
+	stored.CompletedAt = nil
+	stored.CostUSD = nil
+	stored.InputTokens = nil
+	stored.OutputTokens = nil
+	stored.CacheCreationInputTokens = nil
+	stored.CacheReadInputTokens = nil
+	stored.EffectiveContextTokens = nil
+	stored.DurationMS = nil
+	stored.NumTurns = nil
+	stored.ResultContent = ""
+	stored.ErrorMessage = ""
+	stored.CPUTimeMs, stored.CPUPercent, stored.RSSBytes, stored.OpenFDs = 0, 0, 0, 0
+	stored.PeakCPUPercent, stored.PeakRSSBytes, stored.PeakOpenFDs = 0, 0, 0
+	stored.ResourcesSampledAt = nil
+	s.sessions[session.ID] = stored
+	return nil
+}
+
+// UpdateSession updates session fields
+func (s *MemoryStore) UpdateSession(ctx context.Context, sessionID string, updates SessionUpdate) error {
+	if updates == (SessionUpdate{}) {
+		// No fields to update is OK - this is a no-op
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[sessionID]
+	if !ok {
+		return fmt.Errorf("session not found: %s", sessionID)
+	}
+
+	if updates.LastActivityAt != nil {
+		session.LastActivityAt = *updates.LastActivityAt
+	}
+	if updates.ClaudeSessionID != nil {
+		session.ClaudeSessionID = *updates.ClaudeSessionID
+	}
+	if updates.Query != nil {
+		session.Query = *updates.Query
+	}
+	if updates.Status != nil {
+		session.Status = *updates.Status
+	}
+	if updates.CompletedAt != nil {
+		session.CompletedAt = clonePtr(updates.CompletedAt)
+	}
+	if updates.CostUSD != nil {
+		session.CostUSD = clonePtr(updates.CostUSD)
+	}
+	if updates.InputTokens != nil {
+		session.InputTokens = clonePtr(updates.InputTokens)
+	}
+	if updates.OutputTokens != nil {
+		session.OutputTokens = clonePtr(updates.OutputTokens)
+	}
+	if updates.CacheCreationInputTokens != nil {
+		session.CacheCreationInputTokens = clonePtr(updates.CacheCreationInputTokens)
+	}
+	if updates.CacheReadInputTokens != nil {
+		session.CacheReadInputTokens = clonePtr(updates.CacheReadInputTokens)
+	}
+	if updates.EffectiveContextTokens != nil {
+		session.EffectiveContextTokens = clonePtr(updates.EffectiveContextTokens)
+	}
+	if updates.DurationMS != nil {
+		session.DurationMS = clonePtr(updates.DurationMS)
+	}
+	if updates.NumTurns != nil {
+		session.NumTurns = clonePtr(updates.NumTurns)
+	}
+	if updates.ResultContent != nil {
+		session.ResultContent = *updates.ResultContent
+	}
+	if updates.ErrorMessage != nil {
+		session.ErrorMessage = *updates.ErrorMessage
+	}
+	if updates.Summary != nil {
+		session.Summary = *updates.Summary
+	}
+	if updates.Title != nil {
+		session.Title = *updates.Title
+	}
+	if updates.AutoAcceptEdits != nil {
+		session.AutoAcceptEdits = *updates.AutoAcceptEdits
+	}
+	if updates.DangerouslySkipPermissions != nil {
+		session.DangerouslySkipPermissions = *updates.DangerouslySkipPermissions
+	}
+	if updates.DangerouslySkipPermissionsExpiresAt != nil {
+		session.DangerouslySkipPermissionsExpiresAt = clonePtr(*updates.DangerouslySkipPermissionsExpiresAt)
+	}
+	if updates.DangerouslySkipPermissionsTimeoutMs != nil {
+		session.DangerouslySkipPermissionsTimeoutMs = clonePtr(updates.DangerouslySkipPermissionsTimeoutMs)
+	}
+	if updates.Model != nil {
+		session.Model = *updates.Model
+	}
+	if updates.ModelID != nil {
+		session.ModelID = *updates.ModelID
+	}
+	if updates.Archived != nil {
+		session.Archived = *updates.Archived
+	}
+	if updates.ProxyEnabled != nil {
+		session.ProxyEnabled = *updates.ProxyEnabled
+	}
+	if updates.ProxyBaseURL != nil {
+		session.ProxyBaseURL = *updates.ProxyBaseURL
+	}
+	if updates.ProxyModelOverride != nil {
+		session.ProxyModelOverride = *updates.ProxyModelOverride
+	}
+	if updates.ProxyAPIKey != nil {
+		session.ProxyAPIKey = *updates.ProxyAPIKey
+	}
+	if updates.AdditionalDirectories != nil {
+		session.AdditionalDirectories = *updates.AdditionalDirectories
+	}
+	if updates.WorkingDir != nil {
+		session.WorkingDir = *updates.WorkingDir
+	}
+	if updates.EditorState != nil {
+		session.EditorState = clonePtr(updates.EditorState)
+	}
+	if updates.StallThresholdMs != nil {
+		session.StallThresholdMs = clonePtr(updates.StallThresholdMs)
+	}
+	if updates.StallInterruptThresholdMs != nil {
+		session.StallInterruptThresholdMs = clonePtr(updates.StallInterruptThresholdMs)
+	}
+	if updates.ProjectID != nil {
+		session.ProjectID = *updates.ProjectID
+	}
+	return nil
+}
+
+// HardDeleteSession permanently deletes a session. Like SQLite it fails while
+// conversation events, approvals or other rows still reference the session.
+func (s *MemoryStore) HardDeleteSession(ctx context.Context, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[sessionID]; !ok {
+		return sql.ErrNoRows
+	}
+	if s.sessionReferenced(sessionID) {
+		return fmt.Errorf("failed to delete session: %w", errForeignKey)
+	}
+	s.deleteSessionLocked(sessionID)
+	return nil
+}
+
+// sessionReferenced reports whether rows that do not cascade refer to the session
+func (s *MemoryStore) sessionReferenced(sessionID string) bool {
+	for _, event := range s.events {
+		if event.SessionID == sessionID {
+			return true
+		}
+	}
+	for _, server := range s.mcpServers {
+		if server.SessionID == sessionID {
+			return true
+		}
+	}
+	for _, event := range s.rawEvents {
+		if event.SessionID == sessionID {
+			return true
+		}
+	}
+	for _, approval := range s.approvals {
+		if approval.SessionID == sessionID {
+			return true
+		}
+	}
+	for _, snapshot := range s.fileSnapshots {
+		if snapshot.SessionID == sessionID {
+			return true
+		}
+	}
+	return false
+}
+
+// deleteSessionLocked removes a session with its cascading rows
+func (s *MemoryStore) deleteSessionLocked(sessionID string) {
+	delete(s.sessions, sessionID)
+	delete(s.effectiveConfigs, sessionID)
+	delete(s.sessionLabels, sessionID)
+	samples := s.samples[:0]
+	for _, sample := range s.samples {
+		if sample.SessionID != sessionID {
+			samples = append(samples, sample)
+		}
+	}
+	s.samples = samples
+}
+
+// sessionCopy returns a copy of a stored session with its labels attached
+func (s *MemoryStore) sessionCopy(session *Session) *Session {
+	c := cloneSession(session)
+	c.Labels = s.labelNamesLocked(session.ID)
+	return c
+}
+
+// GetSession retrieves a session by ID
+func (s *MemoryStore) GetSession(ctx context.Context, sessionID string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[sessionID]
+	if !ok {
+		return nil, &NotFoundError{Type: "session", ID: sessionID}
+	}
+	return cloneSession(session), nil
+}
+
+// GetSessionByRunID retrieves a session by its run_id
+func (s *MemoryStore) GetSessionByRunID(ctx context.Context, runID string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, session := range s.sessions {
+		if session.RunID == runID {
+			return cloneSession(session), nil
+		}
+	}
+	return nil, nil
+}
+
+// ListSessions retrieves all sessions
+func (s *MemoryStore) ListSessions(ctx context.Context) ([]*Session, error) {
+	return s.QuerySessions(ctx, SessionFilter{})
+}
+
+// sortedSessions returns the stored sessions matching keep, most recently
+// active first
+func (s *MemoryStore) sortedSessions(keep func(*Session) bool) []*Session {
+	var sessions []*Session
+	for _, session := range s.sessions {
+		if keep(session) {
+			sessions = append(sessions, session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		if !sessions[i].LastActivityAt.Equal(sessions[j].LastActivityAt) {
+			return sessions[i].LastActivityAt.After(sessions[j].LastActivityAt)
+		}
+		return sessions[i].ID > sessions[j].ID
+	})
+	return sessions
+}
+
+// hasChildren reports whether any session continues from sessionID
+func (s *MemoryStore) hasChildren(sessionID string) bool {
+	for _, session := range s.sessions {
+		if session.ParentSessionID == sessionID {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold reports whether substr is within text, ignoring case like
+// SQLite's LIKE
+func containsFold(text, substr string) bool {
+	return strings.Contains(strings.ToLower(text), strings.ToLower(substr))
+}
+
+// SearchSessionsByTitle searches leaf sessions by title, summary or query
+func (s *MemoryStore) SearchSessionsByTitle(ctx context.Context, query string, limit int) ([]*Session, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions := s.sortedSessions(func(session *Session) bool {
+		if session.Archived || session.Status == SessionStatusDraft || session.Status == SessionStatusDiscarded {
+			return false
+		}
+		if query != "" && !containsFold(session.Title, query) && !containsFold(session.Summary, query) &&
+			!containsFold(session.Query, query) {
+			return false
+		}
+		return !s.hasChildren(session.ID)
+	})
+
+	// Like the SQL stores, only the 20 most recent sessions are considered
+	sessions = sessions[:min(len(sessions), 20, limit)]
+	results := make([]*Session, len(sessions))
+	for i, session := range sessions {
+		results[i] = cloneSession(session)
+	}
+	return results, nil
+}
+
+// GetExpiredDangerousPermissionsSessions returns sessions where dangerous permissions have expired
+func (s *MemoryStore) GetExpiredDangerousPermissionsSessions(ctx context.Context) ([]*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var sessions []*Session
+	for _, session := range s.sessions {
+		switch session.Status {
+		case SessionStatusRunning, SessionStatusWaitingInput, SessionStatusStarting:
+		default:
+			continue
+		}
+		if session.DangerouslySkipPermissions && session.DangerouslySkipPermissionsExpiresAt != nil &&
+			session.DangerouslySkipPermissionsExpiresAt.Before(now) {
+			sessions = append(sessions, cloneSession(session))
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].DangerouslySkipPermissionsExpiresAt.Before(*sessions[j].DangerouslySkipPermissionsExpiresAt)
+	})
+	return sessions, nil
+}
+
+// GetSessionsByStatus retrieves all sessions in any of the given statuses,
+// oldest activity first
+func (s *MemoryStore) GetSessionsByStatus(ctx context.Context, statuses []string) ([]*Session, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions := []*Session{}
+	for _, session := range s.sessions {
+		for _, status := range statuses {
+			if session.Status == status {
+				sessions = append(sessions, cloneSession(session))
+				break
+			}
+		}
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].LastActivityAt.Before(sessions[j].LastActivityAt)
+	})
+	return sessions, nil
+}
+
+// GetRecentWorkingDirs retrieves recently used working directories
+func (s *MemoryStore) GetRecentWorkingDirs(ctx context.Context, limit int) ([]RecentPath, error) {
+	if limit <= 0 {
+		limit = 20 // Default to 20 recent paths
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byPath := make(map[string]*RecentPath)
+	var paths []RecentPath
+	for _, session := range s.sessions {
+		if session.WorkingDir == "" || session.WorkingDir == "." {
+			continue
+		}
+		p, ok := byPath[session.WorkingDir]
+		if !ok {
+			p = &RecentPath{Path: session.WorkingDir}
+			byPath[session.WorkingDir] = p
+		}
+		p.UsageCount++
+		if session.LastActivityAt.After(p.LastUsed) {
+			p.LastUsed = session.LastActivityAt
+		}
+	}
+	for _, p := range byPath {
+		p.LastUsed = p.LastUsed.UTC().Truncate(time.Second)
+		paths = append(paths, *p)
+	}
+	sort.Slice(paths, func(i, j int) bool { return paths[i].LastUsed.After(paths[j].LastUsed) })
+	if len(paths) > limit {
+		paths = paths[:limit]
+	}
+	return paths, nil
+}
+
+// GetUserSettings retrieves the user settings
+func (s *MemoryStore) GetUserSettings(ctx context.Context) (*UserSettings, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.settings == nil {
+		return &UserSettings{
+			AdvancedProviders:     false,
+			AutoTitleMode:         TitleModeHeuristic,
+			RawEventRetentionDays: DefaultRawEventRetentionDays,
+			DedupeFileSnapshots:   true,
+			CreatedAt:             time.Now(),
+			UpdatedAt:             time.Now(),
+		}, nil
+	}
+	settings := *s.settings
+	settings.OptInTelemetry = clonePtr(s.settings.OptInTelemetry)
+	return &settings, nil
+}
+
+// UpdateUserSettings updates the user settings
+func (s *MemoryStore) UpdateUserSettings(ctx context.Context, settings UserSettings) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	if s.settings == nil {
+		s.settings = &UserSettings{CreatedAt: now}
+	}
+	createdAt := s.settings.CreatedAt
+	*s.settings = settings
+	s.settings.OptInTelemetry = clonePtr(settings.OptInTelemetry)
+	s.settings.CreatedAt = createdAt
+	s.settings.UpdatedAt = now
+	return nil
+}
+
+// AddConversationEvent adds a new conversation event, assigning its ID and
+// its sequence within the Claude session
+func (s *MemoryStore) AddConversationEvent(ctx context.Context, event *ConversationEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[event.SessionID]; !ok {
+		return fmt.Errorf("failed to add conversation event: %w", errForeignKey)
+	}
+
+	maxSeq := 0
+	for _, existing := range s.events {
+		if existing.ClaudeSessionID == event.ClaudeSessionID && existing.Sequence > maxSeq {
+			maxSeq = existing.Sequence
+		}
+	}
+	event.Sequence = maxSeq + 1
+	event.ID = s.nextID()
+	event.CreatedAt = time.Now().UTC()
+
+	s.events = append(s.events, cloneEvent(event))
+	return nil
+}
+
+// GetConversation retrieves all events for a Claude session
+func (s *MemoryStore) GetConversation(ctx context.Context, claudeSessionID string) ([]*ConversationEvent, error) {
+	return s.GetConversationPage(ctx, claudeSessionID, ConversationPage{})
+}
+
+// GetConversationPage retrieves a window of the events for a Claude session
+func (s *MemoryStore) GetConversationPage(ctx context.Context, claudeSessionID string, page ConversationPage) ([]*ConversationEvent, error) {
+	if page.After == nil && page.SinceSequence > 0 {
+		page.After = &ConversationCursor{ClaudeSessionID: claudeSessionID, Sequence: page.SinceSequence}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.queryConversation([]string{claudeSessionID}, page)
+}
+
+// UpdateCompactionEvent fills in a compaction event's summary or post-compaction token count
+func (s *MemoryStore) UpdateCompactionEvent(ctx context.Context, eventID int64, updates CompactionUpdate) error {
+	if updates.Summary == nil && updates.PostCompactionTokens == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, event := range s.events {
+		if event.ID != eventID || event.EventType != EventTypeCompaction {
+			continue
+		}
+		if updates.Summary != nil {
+			event.Content = *updates.Summary
+		}
+		if updates.PostCompactionTokens != nil {
+			event.PostCompactionTokens = clonePtr(updates.PostCompactionTokens)
+		}
+		return nil
+	}
+	return &NotFoundError{Type: "compaction event", ID: fmt.Sprintf("%d", eventID)}
+}
+
+// GetSessionConversation retrieves all events for a session including parent history
+func (s *MemoryStore) GetSessionConversation(ctx context.Context, sessionID string) ([]*ConversationEvent, error) {
+	return s.GetSessionConversationPage(ctx, sessionID, ConversationPage{})
+}
+
+// GetSessionConversationPage retrieves a window of the events for a session
+// including parent history
+func (s *MemoryStore) GetSessionConversationPage(ctx context.Context, sessionID string, page ConversationPage) ([]*ConversationEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	claudeSessionIDs, err := s.sessionClaudeChain(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(claudeSessionIDs) == 0 {
+		return []*ConversationEvent{}, nil
+	}
+
+	// Only the session's own Claude session can still grow, so polling by
+	// sequence skips the parent history entirely
+	if page.After == nil && page.SinceSequence > 0 {
+		page.After = &ConversationCursor{
+			ClaudeSessionID: claudeSessionIDs[len(claudeSessionIDs)-1],
+			Sequence:        page.SinceSequence,
+		}
+	}
+	return s.queryConversation(claudeSessionIDs, page)
+}
+
+// sessionClaudeChain walks up the parent chain of a session and returns the
+// Claude session IDs of it and its ancestors, oldest first
+func (s *MemoryStore) sessionClaudeChain(sessionID string) ([]string, error) {
+	claudeSessionIDs := []string{}
+	currentID := sessionID
+	isFirstSession := true
+
+	for currentID != "" {
+		session, ok := s.sessions[currentID]
+		if !ok {
+			if isFirstSession {
+				return nil, &NotFoundError{Type: "session", ID: sessionID}
+			}
+			// A missing parent ends the chain
+			break
+		}
+		isFirstSession = false
+
+		if session.ClaudeSessionID != "" {
+			claudeSessionIDs = append([]string{session.ClaudeSessionID}, claudeSessionIDs...)
+		}
+		currentID = session.ParentSessionID
+	}
+
+	return claudeSessionIDs, nil
+}
+
+// queryConversation retrieves the events of the given Claude sessions in
+// chronological order, treating them as one conversation in list order
+func (s *MemoryStore) queryConversation(claudeSessionIDs []string, page ConversationPage) ([]*ConversationEvent, error) {
+	if page.After != nil {
+		// Everything in Claude sessions before the cursor's has already been seen
+		idx := -1
+		for i, id := range claudeSessionIDs {
+			if id == page.After.ClaudeSessionID {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, fmt.Errorf("%w: not part of this conversation", ErrInvalidCursor)
+		}
+		claudeSessionIDs = claudeSessionIDs[idx:]
+	}
+
+	position := make(map[string]int, len(claudeSessionIDs))
+	for i, id := range claudeSessionIDs {
+		if _, ok := position[id]; !ok {
+			position[id] = i
+		}
+	}
+
+	events := []*ConversationEvent{}
+	for _, event := range s.events {
+		if _, ok := position[event.ClaudeSessionID]; !ok {
+			continue
+		}
+		if page.After != nil && event.ClaudeSessionID == page.After.ClaudeSessionID && event.Sequence <= page.After.Sequence {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	// Parent events come before child events
+	sort.SliceStable(events, func(i, j int) bool {
+		pi, pj := position[events[i].ClaudeSessionID], position[events[j].ClaudeSessionID]
+		if pi != pj {
+			return pi < pj
+		}
+		return events[i].Sequence < events[j].Sequence
+	})
+	if page.Limit > 0 && len(events) > page.Limit {
+		events = events[:page.Limit]
+	}
+
+	for i, event := range events {
+		events[i] = cloneEvent(event)
+	}
+	return events, nil
+}
+
+// pendingToolCall returns the most recent uncompleted call of the tool in the
+// session, optionally only one not yet correlated with an approval
+func (s *MemoryStore) pendingToolCall(sessionID, toolName string, uncorrelated bool) *ConversationEvent {
+	var found *ConversationEvent
+	for _, event := range s.events {
+		if event.SessionID != sessionID || event.ToolName != toolName ||
+			event.EventType != EventTypeToolCall || event.IsCompleted {
+			continue
+		}
+		if uncorrelated && event.ApprovalStatus != "" {
+			continue
+		}
+		if found == nil || event.Sequence >= found.Sequence {
+			found = event
+		}
+	}
+	return found
+}
+
+// GetPendingToolCall finds the most recent uncompleted tool call for a given session and tool name
+func (s *MemoryStore) GetPendingToolCall(ctx context.Context, sessionID string, toolName string) (*ConversationEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event := s.pendingToolCall(sessionID, toolName, false); event != nil {
+		return cloneEvent(event), nil
+	}
+	return nil, nil // No pending tool call found
+}
+
+// GetUncorrelatedPendingToolCall finds the most recent uncompleted tool call without approval correlation
+func (s *MemoryStore) GetUncorrelatedPendingToolCall(ctx context.Context, sessionID string, toolName string) (*ConversationEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event := s.pendingToolCall(sessionID, toolName, true); event != nil {
+		return cloneEvent(event), nil
+	}
+	return nil, nil // No pending tool call found
+}
+
+// GetPendingToolCalls finds all uncompleted tool calls for a given session
+func (s *MemoryStore) GetPendingToolCalls(ctx context.Context, sessionID string) ([]*ConversationEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []*ConversationEvent
+	for _, event := range s.events {
+		if event.SessionID == sessionID && event.EventType == EventTypeToolCall && !event.IsCompleted {
+			events = append(events, cloneEvent(event))
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Sequence > events[j].Sequence })
+	return events, nil
+}
+
+// GetToolCallByID retrieves a specific tool call by its ID
+func (s *MemoryStore) GetToolCallByID(ctx context.Context, toolID string) (*ConversationEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, event := range s.events {
+		if event.ToolID == toolID && event.EventType == EventTypeToolCall {
+			return cloneEvent(event), nil
+		}
+	}
+	return nil, nil // Tool call not found
+}
+
+// MarkToolCallCompleted marks a tool call as completed when its result is received
+func (s *MemoryStore) MarkToolCallCompleted(ctx context.Context, toolID string, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	marked := false
+	for _, event := range s.events {
+		if event.ToolID == toolID && event.SessionID == sessionID && event.EventType == EventTypeToolCall {
+			event.IsCompleted = true
+			marked = true
+		}
+	}
+	if !marked {
+		slog.Debug("no matching tool call found to mark completed",
+			"tool_id", toolID,
+			"session_id", sessionID)
+	}
+	return nil
+}
+
+// CorrelateApproval correlates an approval with the most recent pending tool
+// call, unless that call already has an approval
+func (s *MemoryStore) CorrelateApproval(ctx context.Context, sessionID string, toolName string, approvalID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event := s.pendingToolCall(sessionID, toolName, false)
+	if event == nil {
+		slog.Debug("no matching tool call found for approval",
+			"session_id", sessionID,
+			"tool_name", toolName,
+			"approval_id", approvalID)
+		return nil // Not an error - approval might be for a different session
+	}
+	if event.ApprovalStatus != "" {
+		slog.Warn("tool call already has approval status",
+			"tool_id", event.ToolID,
+			"existing_status", event.ApprovalStatus,
+			"approval_id", approvalID)
+		return nil
+	}
+
+	event.ApprovalStatus = ApprovalStatusPending
+	event.ApprovalID = approvalID
+	return nil
+}
+
+// LinkConversationEventToApprovalUsingToolID correlates an approval with a specific tool call by tool_id
+func (s *MemoryStore) LinkConversationEventToApprovalUsingToolID(ctx context.Context, sessionID string, toolID string, approvalID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	linked := false
+	for _, event := range s.events {
+		if event.SessionID == sessionID && event.ToolID == toolID && event.EventType == EventTypeToolCall &&
+			!event.IsCompleted && event.ApprovalStatus == "" {
+			event.ApprovalStatus = ApprovalStatusPending
+			event.ApprovalID = approvalID
+			linked = true
+		}
+	}
+	if !linked {
+		slog.Debug("no matching tool call found for approval by tool_id",
+			"session_id", sessionID,
+			"tool_id", toolID,
+			"approval_id", approvalID)
+	}
+	return nil
+}
+
+// UpdateApprovalStatus updates the status of an approval
+func (s *MemoryStore) UpdateApprovalStatus(ctx context.Context, approvalID string, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, event := range s.events {
+		if event.ApprovalID != approvalID {
+			continue
+		}
+		// Resolved never overwrites an approved or denied decision
+		if status == ApprovalStatusResolved && event.ApprovalStatus != ApprovalStatusPending {
+			continue
+		}
+		event.ApprovalStatus = status
+	}
+	return nil
+}
+
+// StoreMCPServers stores MCP server configurations
+func (s *MemoryStore) StoreMCPServers(ctx context.Context, sessionID string, servers []MCPServer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(servers) == 0 {
+		return nil
+	}
+	if _, ok := s.sessions[sessionID]; !ok {
+		return fmt.Errorf("failed to insert MCP server: %w", errForeignKey)
+	}
+	for _, server := range servers {
+		server.ID = s.nextID()
+		server.SessionID = sessionID
+		s.mcpServers = append(s.mcpServers, server)
+	}
+	return nil
+}
+
+// GetMCPServers retrieves MCP servers for a session
+func (s *MemoryStore) GetMCPServers(ctx context.Context, sessionID string) ([]MCPServer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var servers []MCPServer
+	for _, server := range s.mcpServers {
+		if server.SessionID == sessionID {
+			servers = append(servers, server)
+		}
+	}
+	return servers, nil
+}
+
+// StoreRawEvent stores a raw event for debugging
+func (s *MemoryStore) StoreRawEvent(ctx context.Context, sessionID string, eventJSON string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[sessionID]; !ok {
+		return fmt.Errorf("failed to store raw event: %w", errForeignKey)
+	}
+	s.rawEvents = append(s.rawEvents, RawEvent{
+		ID: s.nextID(), SessionID: sessionID, EventJSON: eventJSON, CreatedAt: time.Now().UTC(),
+	})
+	return nil
+}
+
+// CreateApproval creates a new approval
+func (s *MemoryStore) CreateApproval(ctx context.Context, approval *Approval) error {
+	if !approval.Status.IsValid() {
+		return fmt.Errorf("invalid approval status: %s", approval.Status)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[approval.SessionID]; !ok {
+		return fmt.Errorf("failed to create approval: %w", errForeignKey)
+	}
+	if s.findApproval(approval.ID) != nil {
+		return fmt.Errorf("failed to create approval: %w", &AlreadyExistsError{Type: "approval", Name: approval.ID})
+	}
+
+	stored := cloneApproval(approval)
+	stored.RespondedAt = nil
+	s.approvals = append(s.approvals, stored)
+	return nil
+}
+
+func (s *MemoryStore) findApproval(id string) *Approval {
+	for _, approval := range s.approvals {
+		if approval.ID == id {
+			return approval
+		}
+	}
+	return nil
+}
+
+// sessionApprovals returns the session's approvals matching keep, oldest first
+func (s *MemoryStore) sessionApprovals(sessionID string, keep func(*Approval) bool) []*Approval {
+	var approvals []*Approval
+	for _, approval := range s.approvals {
+		if approval.SessionID == sessionID && keep(approval) {
+			approvals = append(approvals, cloneApproval(approval))
+		}
+	}
+	sort.SliceStable(approvals, func(i, j int) bool { return approvals[i].CreatedAt.Before(approvals[j].CreatedAt) })
+	return approvals
+}
+
+// GetApproval retrieves an approval by ID
+func (s *MemoryStore) GetApproval(ctx context.Context, id string) (*Approval, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	approval := s.findApproval(id)
+	if approval == nil {
+		return nil, &NotFoundError{Type: "approval", ID: id}
+	}
+	return cloneApproval(approval), nil
+}
+
+// GetPendingApprovals retrieves all pending approvals for a session
+func (s *MemoryStore) GetPendingApprovals(ctx context.Context, sessionID string) ([]*Approval, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sessionApprovals(sessionID, func(approval *Approval) bool {
+		return approval.Status == ApprovalStatusLocalPending
+	}), nil
+}
+
+// UpdateApprovalResponse records the decision on a pending approval
+func (s *MemoryStore) UpdateApprovalResponse(ctx context.Context, id string, status ApprovalStatus, comment string) error {
+	if !status.IsValid() {
+		return fmt.Errorf("invalid approval status: %s", status)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	approval := s.findApproval(id)
+	if approval == nil {
+		return &NotFoundError{Type: "approval", ID: id}
+	}
+	if approval.Status != ApprovalStatusLocalPending {
+		return &AlreadyDecidedError{ID: id, Status: approval.Status.String()}
+	}
+
+	now := time.Now().UTC()
+	approval.Status = status
+	approval.Comment = comment
+	approval.RespondedAt = &now
+	return nil
+}
+
+// CreateFileSnapshot stores a new file snapshot
+func (s *MemoryStore) CreateFileSnapshot(ctx context.Context, snapshot *FileSnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[snapshot.SessionID]; !ok {
+		return errForeignKey
+	}
+	stored := *snapshot
+	stored.ID = s.nextID()
+	stored.CreatedAt = time.Now().UTC()
+	s.fileSnapshots = append(s.fileSnapshots, stored)
+	return nil
+}
+
+// GetFileSnapshots retrieves all snapshots for a session, newest first
+func (s *MemoryStore) GetFileSnapshots(ctx context.Context, sessionID string) ([]FileSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var snapshots []FileSnapshot
+	for i := len(s.fileSnapshots) - 1; i >= 0; i-- {
+		if s.fileSnapshots[i].SessionID == sessionID {
+			snapshots = append(snapshots, s.fileSnapshots[i])
+		}
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// CreateBatchGroup creates a new batch group
+func (s *MemoryStore) CreateBatchGroup(ctx context.Context, group *BatchGroup) error {
+	if group.CreatedAt.IsZero() {
+		group.CreatedAt = time.Now().UTC()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.batchGroups[group.ID]; ok {
+		return fmt.Errorf("failed to create batch group: %w", &AlreadyExistsError{Type: "batch group", Name: group.ID})
+	}
+	stored := *group
+	s.batchGroups[group.ID] = &stored
+	return nil
+}
+
+// GetBatchGroup retrieves a batch group by ID
+func (s *MemoryStore) GetBatchGroup(ctx context.Context, id string) (*BatchGroup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	group, ok := s.batchGroups[id]
+	if !ok {
+		return nil, &NotFoundError{Type: "batch group", ID: id}
+	}
+	c := *group
+	return &c, nil
+}
+
+// ListBatchGroups retrieves all batch groups, newest first
+func (s *MemoryStore) ListBatchGroups(ctx context.Context) ([]*BatchGroup, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var groups []*BatchGroup
+	for _, group := range s.batchGroups {
+		c := *group
+		groups = append(groups, &c)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if !groups[i].CreatedAt.Equal(groups[j].CreatedAt) {
+			return groups[i].CreatedAt.After(groups[j].CreatedAt)
+		}
+		return groups[i].ID < groups[j].ID
+	})
+	return groups, nil
+}
+
+// AddBatchMember records a member of a batch group
+func (s *MemoryStore) AddBatchMember(ctx context.Context, member *BatchMember) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.batchGroups[member.GroupID]; !ok {
+		return fmt.Errorf("failed to add batch member: %w", errForeignKey)
+	}
+	for _, existing := range s.batchMembers {
+		if existing.GroupID == member.GroupID && existing.MemberIndex == member.MemberIndex {
+			return fmt.Errorf("failed to add batch member: %w",
+				&AlreadyExistsError{Type: "batch member", Name: fmt.Sprintf("%s/%d", member.GroupID, member.MemberIndex)})
+		}
+	}
+	stored := *member
+	s.batchMembers = append(s.batchMembers, &stored)
+	return nil
+}
+
+// GetBatchMembers retrieves the members of a batch group in launch order
+func (s *MemoryStore) GetBatchMembers(ctx context.Context, groupID string) ([]*BatchMember, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var members []*BatchMember
+	for _, member := range s.batchMembers {
+		if member.GroupID == groupID {
+			c := *member
+			members = append(members, &c)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].MemberIndex < members[j].MemberIndex })
+	return members, nil
+}
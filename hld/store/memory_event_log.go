@@ -0,0 +1,54 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// AppendEventLog stores an entry, setting its Seq
+func (s *MemoryStore) AppendEventLog(ctx context.Context, entry *EventLogEntry) error {
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry.Seq = s.nextID()
+	stored := *entry
+	stored.CreatedAt = entry.CreatedAt.UTC()
+	s.eventLog = append(s.eventLog, &stored)
+	return nil
+}
+
+// TrimEventLog deletes all but the newest keep entries and returns how many
+// it deleted. A keep of zero or less keeps every entry.
+func (s *MemoryStore) TrimEventLog(ctx context.Context, keep int) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if keep <= 0 || len(s.eventLog) <= keep {
+		return 0, nil
+	}
+	deleted := len(s.eventLog) - keep
+	s.eventLog = append([]*EventLogEntry(nil), s.eventLog[deleted:]...)
+	return int64(deleted), nil
+}
+
+// GetRecentEventLog returns the newest limit entries, oldest first. A limit
+// of zero or less returns every entry.
+func (s *MemoryStore) GetRecentEventLog(ctx context.Context, limit int) ([]*EventLogEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := s.eventLog
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	result := make([]*EventLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		c := *entry
+		result = append(result, &c)
+	}
+	return result, nil
+}
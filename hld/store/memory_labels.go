@@ -0,0 +1,222 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// labelByName finds a label by name, ignoring case like the SQLite schema
+func (s *MemoryStore) labelByName(name string) *Label {
+	for _, label := range s.labels {
+		if strings.EqualFold(label.Name, name) {
+			return label
+		}
+	}
+	return nil
+}
+
+// hasLabelLocked reports whether the session has the label named name
+func (s *MemoryStore) hasLabelLocked(sessionID, name string) bool {
+	label := s.labelByName(name)
+	return label != nil && s.sessionLabels[sessionID][label.ID]
+}
+
+// labelNamesLocked returns the session's label names in case-insensitive order
+func (s *MemoryStore) labelNamesLocked(sessionID string) []string {
+	var names []string
+	for labelID := range s.sessionLabels[sessionID] {
+		names = append(names, s.labels[labelID].Name)
+	}
+	sortFold(names)
+	return names
+}
+
+func sortFold(names []string) {
+	sort.Slice(names, func(i, j int) bool { return strings.ToLower(names[i]) < strings.ToLower(names[j]) })
+}
+
+// CreateLabel creates a new label. Names are unique regardless of case.
+func (s *MemoryStore) CreateLabel(ctx context.Context, label *Label) error {
+	if label.CreatedAt.IsZero() {
+		label.CreatedAt = time.Now().UTC()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.labels[label.ID]; ok || s.labelByName(label.Name) != nil {
+		return &AlreadyExistsError{Type: "label", Name: label.Name}
+	}
+	s.labels[label.ID] = &Label{ID: label.ID, Name: label.Name, Color: label.Color, CreatedAt: label.CreatedAt.UTC()}
+	return nil
+}
+
+// ListLabels retrieves all labels with the number of sessions using each
+func (s *MemoryStore) ListLabels(ctx context.Context) ([]*Label, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var labels []*Label
+	for _, stored := range s.labels {
+		label := *stored
+		for _, ids := range s.sessionLabels {
+			if ids[label.ID] {
+				label.SessionCount++
+			}
+		}
+		labels = append(labels, &label)
+	}
+	sort.Slice(labels, func(i, j int) bool { return strings.ToLower(labels[i].Name) < strings.ToLower(labels[j].Name) })
+	return labels, nil
+}
+
+// DeleteLabel removes a label from every session and deletes it
+func (s *MemoryStore) DeleteLabel(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.labels[id]; !ok {
+		return &NotFoundError{Type: "label", ID: id}
+	}
+	delete(s.labels, id)
+	for _, ids := range s.sessionLabels {
+		delete(ids, id)
+	}
+	return nil
+}
+
+// SetSessionLabels replaces a session's labels, creating labels that do not exist yet
+func (s *MemoryStore) SetSessionLabels(ctx context.Context, sessionID string, names []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[sessionID]; !ok {
+		return &NotFoundError{Type: "session", ID: sessionID}
+	}
+	delete(s.sessionLabels, sessionID)
+	s.addSessionLabelsLocked(sessionID, names)
+	return nil
+}
+
+// addSessionLabelsLocked attaches labels by name, creating labels that do not exist
+func (s *MemoryStore) addSessionLabelsLocked(sessionID string, names []string) {
+	now := time.Now().UTC()
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		label := s.labelByName(name)
+		if label == nil {
+			label = &Label{ID: uuid.New().String(), Name: name, CreatedAt: now}
+			s.labels[label.ID] = label
+		}
+		if s.sessionLabels[sessionID] == nil {
+			s.sessionLabels[sessionID] = make(map[string]bool)
+		}
+		s.sessionLabels[sessionID][label.ID] = true
+	}
+}
+
+// GetSessionLabels retrieves the label names attached to a session
+func (s *MemoryStore) GetSessionLabels(ctx context.Context, sessionID string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.labelNamesLocked(sessionID), nil
+}
+
+// UpsertProject returns the project rooted at rootPath, creating it if needed.
+// The project is named after the root directory.
+func (s *MemoryStore) UpsertProject(ctx context.Context, rootPath string) (*Project, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, project := range s.projects {
+		if project.RootPath == rootPath {
+			return &Project{ID: project.ID, RootPath: project.RootPath, Name: project.Name, CreatedAt: project.CreatedAt}, nil
+		}
+	}
+
+	project := &Project{
+		ID:        uuid.New().String(),
+		RootPath:  rootPath,
+		Name:      filepath.Base(rootPath),
+		CreatedAt: time.Now().UTC(),
+	}
+	s.projects[project.ID] = project
+	c := *project
+	return &c, nil
+}
+
+// ListProjects retrieves all projects, most recently active first
+func (s *MemoryStore) ListProjects(ctx context.Context) ([]*Project, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var projects []*Project
+	for _, stored := range s.projects {
+		project := &Project{ID: stored.ID, RootPath: stored.RootPath, Name: stored.Name, CreatedAt: stored.CreatedAt}
+		for _, session := range s.sessions {
+			if session.ProjectID != project.ID {
+				continue
+			}
+			project.SessionCount++
+			lastActivity := session.LastActivityAt.UTC().Truncate(time.Second)
+			if project.LastActivityAt == nil || lastActivity.After(*project.LastActivityAt) {
+				project.LastActivityAt = &lastActivity
+			}
+		}
+		projects = append(projects, project)
+	}
+
+	// Projects without sessions sort last, as NULLs do in SQLite
+	sort.Slice(projects, func(i, j int) bool {
+		ai, aj := projects[i].LastActivityAt, projects[j].LastActivityAt
+		switch {
+		case ai != nil && aj != nil && !ai.Equal(*aj):
+			return ai.After(*aj)
+		case (ai == nil) != (aj == nil):
+			return ai != nil
+		}
+		return projects[i].Name < projects[j].Name
+	})
+	return projects, nil
+}
+
+// GetUnassignedWorkingDirs lists the distinct working directories of sessions
+// that have no project yet
+func (s *MemoryStore) GetUnassignedWorkingDirs(ctx context.Context) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool)
+	var dirs []string
+	for _, session := range s.sessions {
+		if session.ProjectID == "" && session.WorkingDir != "" && !seen[session.WorkingDir] {
+			seen[session.WorkingDir] = true
+			dirs = append(dirs, session.WorkingDir)
+		}
+	}
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+// AssignProjectByWorkingDir sets the project of unassigned sessions in workingDir
+func (s *MemoryStore) AssignProjectByWorkingDir(ctx context.Context, workingDir string, projectID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, session := range s.sessions {
+		if session.WorkingDir == workingDir && session.ProjectID == "" {
+			session.ProjectID = projectID
+		}
+	}
+	return nil
+}
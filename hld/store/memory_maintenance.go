@@ -0,0 +1,195 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RunMaintenance prunes data past the retention policy. There is no file to
+// vacuum or analyze, so sizes stay zero and no vacuum is reported. Dry runs
+// only count what would be pruned.
+func (s *MemoryStore) RunMaintenance(ctx context.Context, opts MaintenanceOptions) (*MaintenanceReport, error) {
+	switch opts.Vacuum {
+	case "", VacuumNone, VacuumIncremental, VacuumFull:
+	default:
+		return nil, fmt.Errorf("unknown vacuum mode: %s", opts.Vacuum)
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	report := &MaintenanceReport{
+		DryRun:     opts.DryRun,
+		Vacuum:     VacuumNone,
+		AutoVacuum: VacuumNone,
+		Analyzed:   opts.Analyze && !opts.DryRun,
+		StartedAt:  time.Now(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	retention := opts.Retention
+	if retention.RawEventTTL > 0 {
+		cutoff := now.Add(-retention.RawEventTTL)
+		kept := s.rawEvents[:0:0]
+		for _, event := range s.rawEvents {
+			if event.CreatedAt.Before(cutoff) {
+				report.RawEvents.Rows++
+				report.RawEvents.Bytes += int64(len(event.EventJSON))
+				if !opts.DryRun {
+					continue
+				}
+			}
+			kept = append(kept, event)
+		}
+		s.rawEvents = kept
+	}
+	if retention.ArchivedSessionTTL > 0 {
+		report.ArchivedSessions = s.pruneArchivedSessions(now.Add(-retention.ArchivedSessionTTL), opts.DryRun)
+	}
+	if retention.FileSnapshotTTL > 0 {
+		cutoff := now.Add(-retention.FileSnapshotTTL)
+		report.ExpiredFileSnapshots = s.pruneFileSnapshots(func(i int) bool {
+			return s.fileSnapshots[i].CreatedAt.Before(cutoff)
+		}, opts.DryRun)
+	}
+	if retention.DedupeFileSnapshots {
+		// Readers use the latest snapshot of each file, so of several identical
+		// snapshots in a row only the last one is kept
+		report.DuplicateFileSnapshots = s.pruneFileSnapshots(func(i int) bool {
+			snapshot := s.fileSnapshots[i]
+			for _, next := range s.fileSnapshots[i+1:] {
+				if next.SessionID == snapshot.SessionID && next.FilePath == snapshot.FilePath {
+					return next.Content == snapshot.Content
+				}
+			}
+			return false
+		}, opts.DryRun)
+	}
+
+	report.CompletedAt = time.Now()
+	return report, nil
+}
+
+// pruneFileSnapshots removes the snapshots at the indexes expired reports.
+// All indexes are judged before any snapshot is removed.
+func (s *MemoryStore) pruneFileSnapshots(expired func(i int) bool, dryRun bool) PruneCount {
+	var count PruneCount
+	var kept []FileSnapshot
+	for i, snapshot := range s.fileSnapshots {
+		if expired(i) {
+			count.Rows++
+			count.Bytes += int64(len(snapshot.Content))
+			if !dryRun {
+				continue
+			}
+		}
+		kept = append(kept, snapshot)
+	}
+	s.fileSnapshots = kept
+	return count
+}
+
+// pruneArchivedSessions deletes archived sessions inactive since before
+// cutoff, along with everything they own. A session that is still the parent
+// of a session being kept stays, so continued conversations never lose their
+// history, and so does a session a batch, schedule run or pipeline step still
+// points at.
+func (s *MemoryStore) pruneArchivedSessions(cutoff time.Time, dryRun bool) PruneCount {
+	expired := make(map[string]bool)
+	for id, session := range s.sessions {
+		lastActivity := session.LastActivityAt
+		if lastActivity.IsZero() {
+			lastActivity = session.CreatedAt
+		}
+		if session.Archived && lastActivity.Before(cutoff) {
+			expired[id] = true
+		}
+	}
+
+	// Batch, schedule and pipeline records keep the sessions they launched
+	for _, member := range s.batchMembers {
+		delete(expired, member.SessionID)
+	}
+	for _, run := range s.scheduleRuns {
+		delete(expired, run.SessionID)
+	}
+	for _, step := range s.pipelineSteps {
+		delete(expired, step.SessionID)
+	}
+
+	// Keeping a session keeps its parent, which may in turn keep its own parent
+	for changed := true; changed; {
+		changed = false
+		for id, session := range s.sessions {
+			if !expired[id] && expired[session.ParentSessionID] {
+				delete(expired, session.ParentSessionID)
+				changed = true
+			}
+		}
+	}
+
+	var count PruneCount
+	count.Rows = int64(len(expired))
+	for _, event := range s.events {
+		if expired[event.SessionID] {
+			count.Bytes += int64(len(event.Content) + len(event.ToolInputJSON) + len(event.ToolResultContent))
+		}
+	}
+	for _, event := range s.rawEvents {
+		if expired[event.SessionID] {
+			count.Bytes += int64(len(event.EventJSON))
+		}
+	}
+	for _, snapshot := range s.fileSnapshots {
+		if expired[snapshot.SessionID] {
+			count.Bytes += int64(len(snapshot.Content))
+		}
+	}
+	if dryRun || len(expired) == 0 {
+		return count
+	}
+
+	events := s.events[:0]
+	for _, event := range s.events {
+		if !expired[event.SessionID] {
+			events = append(events, event)
+		}
+	}
+	s.events = events
+	approvals := s.approvals[:0]
+	for _, approval := range s.approvals {
+		if !expired[approval.SessionID] {
+			approvals = append(approvals, approval)
+		}
+	}
+	s.approvals = approvals
+	var servers []MCPServer
+	for _, server := range s.mcpServers {
+		if !expired[server.SessionID] {
+			servers = append(servers, server)
+		}
+	}
+	s.mcpServers = servers
+	var rawEvents []RawEvent
+	for _, event := range s.rawEvents {
+		if !expired[event.SessionID] {
+			rawEvents = append(rawEvents, event)
+		}
+	}
+	s.rawEvents = rawEvents
+	var snapshots []FileSnapshot
+	for _, snapshot := range s.fileSnapshots {
+		if !expired[snapshot.SessionID] {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	s.fileSnapshots = snapshots
+	for id := range expired {
+		s.deleteSessionLocked(id)
+	}
+	return count
+}
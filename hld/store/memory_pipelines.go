@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+func clonePipelineRun(run *PipelineRun) *PipelineRun {
+	c := *run
+	c.CompletedAt = clonePtr(run.CompletedAt)
+	return &c
+}
+
+func clonePipelineStep(step *PipelineStep) *PipelineStep {
+	c := *step
+	c.StartedAt = clonePtr(step.StartedAt)
+	c.CompletedAt = clonePtr(step.CompletedAt)
+	return &c
+}
+
+func validPipelineRunStatus(status string) bool {
+	switch status {
+	case PipelineRunStatusRunning, PipelineRunStatusPaused, PipelineRunStatusCompleted, PipelineRunStatusFailed:
+		return true
+	}
+	return false
+}
+
+// CreatePipelineRun creates a new pipeline run
+func (s *MemoryStore) CreatePipelineRun(ctx context.Context, run *PipelineRun) error {
+	now := time.Now().UTC()
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = now
+	}
+	if run.UpdatedAt.IsZero() {
+		run.UpdatedAt = now
+	}
+	if !validPipelineRunStatus(run.Status) {
+		return fmt.Errorf("failed to create pipeline run: %w", errCheckConstraint)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.pipelineRuns[run.ID]; ok {
+		return fmt.Errorf("failed to create pipeline run: %w", &AlreadyExistsError{Type: "pipeline run", Name: run.ID})
+	}
+	s.pipelineRuns[run.ID] = clonePipelineRun(run)
+	return nil
+}
+
+// GetPipelineRun retrieves a pipeline run by ID
+func (s *MemoryStore) GetPipelineRun(ctx context.Context, id string) (*PipelineRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.pipelineRuns[id]
+	if !ok {
+		return nil, &NotFoundError{Type: "pipeline run", ID: id}
+	}
+	return clonePipelineRun(run), nil
+}
+
+// ListPipelineRuns retrieves all pipeline runs, newest first
+func (s *MemoryStore) ListPipelineRuns(ctx context.Context) ([]*PipelineRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var runs []*PipelineRun
+	for _, run := range s.pipelineRuns {
+		runs = append(runs, clonePipelineRun(run))
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		if !runs[i].CreatedAt.Equal(runs[j].CreatedAt) {
+			return runs[i].CreatedAt.After(runs[j].CreatedAt)
+		}
+		return runs[i].ID < runs[j].ID
+	})
+	return runs, nil
+}
+
+// UpdatePipelineRun updates the specified pipeline run fields
+func (s *MemoryStore) UpdatePipelineRun(ctx context.Context, id string, updates PipelineRunUpdate) error {
+	if updates == (PipelineRunUpdate{}) {
+		return nil
+	}
+	if updates.Status != nil && !validPipelineRunStatus(*updates.Status) {
+		return fmt.Errorf("failed to update pipeline run: %w", errCheckConstraint)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.pipelineRuns[id]
+	if !ok {
+		return &NotFoundError{Type: "pipeline run", ID: id}
+	}
+	if updates.Status != nil {
+		run.Status = *updates.Status
+	}
+	if updates.CurrentStep != nil {
+		run.CurrentStep = *updates.CurrentStep
+	}
+	if updates.ErrorMessage != nil {
+		run.ErrorMessage = *updates.ErrorMessage
+	}
+	if updates.CompletedAt != nil {
+		completedAt := updates.CompletedAt.UTC()
+		run.CompletedAt = &completedAt
+	}
+	run.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// SavePipelineStep inserts or replaces the state of a pipeline step
+func (s *MemoryStore) SavePipelineStep(ctx context.Context, step *PipelineStep) error {
+	switch step.Status {
+	case PipelineStepStatusPending, PipelineStepStatusRunning, PipelineStepStatusCompleted,
+		PipelineStepStatusFailed, PipelineStepStatusSkipped:
+	default:
+		return fmt.Errorf("failed to save pipeline step: %w", errCheckConstraint)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.pipelineRuns[step.RunID]; !ok {
+		return fmt.Errorf("failed to save pipeline step: %w", errForeignKey)
+	}
+	for i, existing := range s.pipelineSteps {
+		if existing.RunID == step.RunID && existing.StepIndex == step.StepIndex {
+			s.pipelineSteps[i] = clonePipelineStep(step)
+			return nil
+		}
+	}
+	s.pipelineSteps = append(s.pipelineSteps, clonePipelineStep(step))
+	return nil
+}
+
+// GetPipelineSteps retrieves the recorded steps of a pipeline run in order
+func (s *MemoryStore) GetPipelineSteps(ctx context.Context, runID string) ([]*PipelineStep, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var steps []*PipelineStep
+	for _, step := range s.pipelineSteps {
+		if step.RunID == runID {
+			steps = append(steps, clonePipelineStep(step))
+		}
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i].StepIndex < steps[j].StepIndex })
+	return steps, nil
+}
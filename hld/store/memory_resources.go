@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+	"time"
+)
+
+// RecordResourceSample stores a sample, updates the session's latest usage and
+// peaks, and trims the session's sample history
+func (s *MemoryStore) RecordResourceSample(ctx context.Context, sample *ResourceSample) error {
+	if sample.SampledAt.IsZero() {
+		sample.SampledAt = time.Now()
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[sample.SessionID]
+	if !ok {
+		return &NotFoundError{Type: "session", ID: sample.SessionID}
+	}
+	sampledAt := sample.SampledAt.UTC()
+	session.CPUTimeMs = sample.CPUTimeMs
+	session.CPUPercent = sample.CPUPercent
+	session.RSSBytes = sample.RSSBytes
+	session.OpenFDs = sample.OpenFDs
+	session.PeakCPUPercent = max(session.PeakCPUPercent, sample.CPUPercent)
+	session.PeakRSSBytes = max(session.PeakRSSBytes, sample.RSSBytes)
+	session.PeakOpenFDs = max(session.PeakOpenFDs, sample.OpenFDs)
+	session.ResourcesSampledAt = &sampledAt
+
+	sample.ID = s.nextID()
+	stored := *sample
+	stored.SampledAt = sampledAt
+	s.samples = append(s.samples, &stored)
+
+	kept := 0
+	for i := len(s.samples) - 1; i >= 0; i-- {
+		if s.samples[i].SessionID != sample.SessionID {
+			continue
+		}
+		if kept++; kept > maxResourceSamplesPerSession {
+			s.samples = append(s.samples[:i], s.samples[i+1:]...)
+		}
+	}
+	return nil
+}
+
+// GetResourceSamples returns a session's most recent samples, oldest first.
+// A limit of zero or less returns every stored sample.
+func (s *MemoryStore) GetResourceSamples(ctx context.Context, sessionID string, limit int) ([]*ResourceSample, error) {
+	if limit <= 0 {
+		limit = maxResourceSamplesPerSession
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var samples []*ResourceSample
+	for _, sample := range s.samples {
+		if sample.SessionID == sessionID {
+			c := *sample
+			samples = append(samples, &c)
+		}
+	}
+	if len(samples) > limit {
+		samples = samples[len(samples)-limit:]
+	}
+	return samples, nil
+}
+
+// SetSessionEffectiveConfig records the JSON encoded configuration a session
+// was launched with after project configuration was merged in
+func (s *MemoryStore) SetSessionEffectiveConfig(ctx context.Context, sessionID string, config string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[sessionID]; !ok {
+		return &NotFoundError{Type: "session", ID: sessionID}
+	}
+	s.effectiveConfigs[sessionID] = config
+	return nil
+}
+
+// GetSessionEffectiveConfig returns the session's recorded effective
+// configuration, or an empty string for sessions created before it was recorded
+func (s *MemoryStore) GetSessionEffectiveConfig(ctx context.Context, sessionID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.sessions[sessionID]; !ok {
+		return "", &NotFoundError{Type: "session", ID: sessionID}
+	}
+	return s.effectiveConfigs[sessionID], nil
+}
+
+// SetProjectConfigTrust trusts the project configuration with the given
+// digest for a project root, replacing any earlier trust
+func (s *MemoryStore) SetProjectConfigTrust(ctx context.Context, projectRoot string, digest string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.projectTrust[projectRoot] = digest
+	return nil
+}
+
+// DeleteProjectConfigTrust revokes trust in a project root's configuration
+func (s *MemoryStore) DeleteProjectConfigTrust(ctx context.Context, projectRoot string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.projectTrust, projectRoot)
+	return nil
+}
+
+// GetProjectConfigTrust returns the digest of the trusted configuration for a
+// project root, or an empty string when none is trusted
+func (s *MemoryStore) GetProjectConfigTrust(ctx context.Context, projectRoot string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.projectTrust[projectRoot], nil
+}
@@ -0,0 +1,211 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+func cloneSchedule(schedule *Schedule) *Schedule {
+	c := *schedule
+	c.NextRunAt = clonePtr(schedule.NextRunAt)
+	c.LastRunAt = clonePtr(schedule.LastRunAt)
+	return &c
+}
+
+func validSchedulePolicies(overlap, catchUp string) bool {
+	switch overlap {
+	case ScheduleOverlapSkip, ScheduleOverlapAllow, ScheduleOverlapInterrupt:
+	default:
+		return false
+	}
+	switch catchUp {
+	case ScheduleCatchUpNone, ScheduleCatchUpOnce, ScheduleCatchUpAll:
+		return true
+	}
+	return false
+}
+
+// CreateSchedule creates a new schedule
+func (s *MemoryStore) CreateSchedule(ctx context.Context, schedule *Schedule) error {
+	now := time.Now().UTC()
+	if schedule.CreatedAt.IsZero() {
+		schedule.CreatedAt = now
+	}
+	if schedule.UpdatedAt.IsZero() {
+		schedule.UpdatedAt = now
+	}
+	if !validSchedulePolicies(schedule.OverlapPolicy, schedule.CatchUpPolicy) {
+		return fmt.Errorf("failed to create schedule: %w", errCheckConstraint)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.schedules[schedule.ID]; ok {
+		return fmt.Errorf("failed to create schedule: %w", &AlreadyExistsError{Type: "schedule", Name: schedule.ID})
+	}
+	s.schedules[schedule.ID] = cloneSchedule(schedule)
+	return nil
+}
+
+// GetSchedule retrieves a schedule by ID
+func (s *MemoryStore) GetSchedule(ctx context.Context, id string) (*Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, ok := s.schedules[id]
+	if !ok {
+		return nil, &NotFoundError{Type: "schedule", ID: id}
+	}
+	return cloneSchedule(schedule), nil
+}
+
+// ListSchedules retrieves all schedules ordered by name
+func (s *MemoryStore) ListSchedules(ctx context.Context) ([]*Schedule, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var schedules []*Schedule
+	for _, schedule := range s.schedules {
+		schedules = append(schedules, cloneSchedule(schedule))
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		if schedules[i].Name != schedules[j].Name {
+			return schedules[i].Name < schedules[j].Name
+		}
+		return schedules[i].CreatedAt.Before(schedules[j].CreatedAt)
+	})
+	return schedules, nil
+}
+
+// UpdateSchedule updates the specified schedule fields
+func (s *MemoryStore) UpdateSchedule(ctx context.Context, id string, updates ScheduleUpdate) error {
+	if updates == (ScheduleUpdate{}) {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.schedules[id]
+	if !ok {
+		return &NotFoundError{Type: "schedule", ID: id}
+	}
+
+	schedule := cloneSchedule(stored)
+	if updates.Name != nil {
+		schedule.Name = *updates.Name
+	}
+	if updates.CronExpr != nil {
+		schedule.CronExpr = *updates.CronExpr
+	}
+	if updates.Timezone != nil {
+		schedule.Timezone = *updates.Timezone
+	}
+	if updates.WorkingDir != nil {
+		schedule.WorkingDir = *updates.WorkingDir
+	}
+	if updates.LaunchConfig != nil {
+		schedule.LaunchConfig = *updates.LaunchConfig
+	}
+	if updates.OverlapPolicy != nil {
+		schedule.OverlapPolicy = *updates.OverlapPolicy
+	}
+	if updates.CatchUpPolicy != nil {
+		schedule.CatchUpPolicy = *updates.CatchUpPolicy
+	}
+	if updates.Enabled != nil {
+		schedule.Enabled = *updates.Enabled
+	}
+	if updates.NextRunAt != nil {
+		next := updates.NextRunAt.UTC()
+		schedule.NextRunAt = &next
+	}
+	if updates.LastRunAt != nil {
+		last := updates.LastRunAt.UTC()
+		schedule.LastRunAt = &last
+	}
+	if !validSchedulePolicies(schedule.OverlapPolicy, schedule.CatchUpPolicy) {
+		return fmt.Errorf("failed to update schedule: %w", errCheckConstraint)
+	}
+	schedule.UpdatedAt = time.Now().UTC()
+
+	s.schedules[id] = schedule
+	return nil
+}
+
+// DeleteSchedule deletes a schedule and its run history. Sessions launched by
+// the schedule are kept.
+func (s *MemoryStore) DeleteSchedule(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.schedules[id]; !ok {
+		return &NotFoundError{Type: "schedule", ID: id}
+	}
+	delete(s.schedules, id)
+
+	runs := s.scheduleRuns[:0]
+	for _, run := range s.scheduleRuns {
+		if run.ScheduleID != id {
+			runs = append(runs, run)
+		}
+	}
+	s.scheduleRuns = runs
+	return nil
+}
+
+// CreateScheduleRun records a schedule firing
+func (s *MemoryStore) CreateScheduleRun(ctx context.Context, run *ScheduleRun) error {
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = time.Now().UTC()
+	}
+	switch run.Status {
+	case ScheduleRunStatusLaunched, ScheduleRunStatusSkipped, ScheduleRunStatusFailed:
+	default:
+		return fmt.Errorf("failed to create schedule run: %w", errCheckConstraint)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.schedules[run.ScheduleID]; !ok {
+		return fmt.Errorf("failed to create schedule run: %w", errForeignKey)
+	}
+	run.ID = s.nextID()
+	stored := *run
+	stored.SessionStatus = ""
+	s.scheduleRuns = append(s.scheduleRuns, &stored)
+	return nil
+}
+
+// ListScheduleRuns retrieves the most recent runs of a schedule, newest first.
+// A limit of 0 or less returns all runs.
+func (s *MemoryStore) ListScheduleRuns(ctx context.Context, scheduleID string, limit int) ([]*ScheduleRun, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var runs []*ScheduleRun
+	for _, stored := range s.scheduleRuns {
+		if stored.ScheduleID != scheduleID {
+			continue
+		}
+		run := *stored
+		if session, ok := s.sessions[run.SessionID]; ok {
+			run.SessionStatus = session.Status
+		}
+		runs = append(runs, &run)
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		if !runs[i].ScheduledFor.Equal(runs[j].ScheduledFor) {
+			return runs[i].ScheduledFor.After(runs[j].ScheduledFor)
+		}
+		return runs[i].ID > runs[j].ID
+	})
+	if limit > 0 && len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs, nil
+}
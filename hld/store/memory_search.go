@@ -0,0 +1,128 @@
+package store
+
+import (
+	"context"
+	"sort"
+)
+
+// searchToolName is the tool an event belongs to: its own tool name for tool
+// calls and the originating call's for tool results
+func (s *MemoryStore) searchToolName(event *ConversationEvent) string {
+	if event.ToolName != "" {
+		return event.ToolName
+	}
+	for _, call := range s.events {
+		if call.SessionID == event.SessionID && call.ToolID == event.ToolResultForID &&
+			call.EventType == EventTypeToolCall {
+			return call.ToolName
+		}
+	}
+	return ""
+}
+
+// searchMatches reports whether event matches search, using substring
+// matching like SQLiteStore without FTS5
+func (s *MemoryStore) searchMatches(event *ConversationEvent, search ConversationSearch, terms []string) bool {
+	for _, term := range terms {
+		if !containsFold(event.Content, term) && !containsFold(event.ToolInputJSON, term) &&
+			!containsFold(event.ToolResultContent, term) {
+			return false
+		}
+	}
+	if len(search.Roles) > 0 && !containsString(search.Roles, event.Role) {
+		return false
+	}
+	if len(search.EventTypes) > 0 && !containsString(search.EventTypes, event.EventType) {
+		return false
+	}
+	if len(search.ToolNames) > 0 && !containsString(search.ToolNames, s.searchToolName(event)) {
+		return false
+	}
+	if search.SessionID != "" && event.SessionID != search.SessionID {
+		return false
+	}
+	if search.ProjectID != "" {
+		session, ok := s.sessions[event.SessionID]
+		if !ok || session.ProjectID != search.ProjectID {
+			return false
+		}
+	}
+	if search.After != nil && event.CreatedAt.Before(*search.After) {
+		return false
+	}
+	if search.Before != nil && !event.CreatedAt.Before(*search.Before) {
+		return false
+	}
+	return true
+}
+
+// SearchConversations finds the sessions whose conversations match search,
+// ordered by their most recent matching event
+func (s *MemoryStore) SearchConversations(ctx context.Context, search ConversationSearch) ([]*ConversationSearchResult, error) {
+	if err := search.Validate(); err != nil {
+		return nil, err
+	}
+	if search.Limit <= 0 {
+		search.Limit = defaultSearchLimit
+	}
+	if search.Limit > maxSearchLimit {
+		search.Limit = maxSearchLimit
+	}
+	if search.MatchesPerSession <= 0 {
+		search.MatchesPerSession = defaultSearchMatchesPerSession
+	}
+	if search.MatchesPerSession > maxSearchMatchesPerSession {
+		search.MatchesPerSession = maxSearchMatchesPerSession
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Walking events newest first yields each session's most recent matches
+	// first and orders sessions by their most recent match
+	terms := searchTerms(search.Query)
+	var sessionIDs []string
+	matches := make(map[string][]ConversationMatch)
+	for i := len(s.events) - 1; i >= 0; i-- {
+		event := s.events[i]
+		if !s.searchMatches(event, search, terms) {
+			continue
+		}
+		sessionMatches, seen := matches[event.SessionID]
+		if !seen {
+			if len(sessionIDs) == search.Limit {
+				continue
+			}
+			sessionIDs = append(sessionIDs, event.SessionID)
+		}
+		if len(sessionMatches) == search.MatchesPerSession {
+			continue
+		}
+
+		match := ConversationMatch{
+			EventID:         event.ID,
+			ClaudeSessionID: event.ClaudeSessionID,
+			Sequence:        event.Sequence,
+			EventType:       event.EventType,
+			Role:            event.Role,
+			ToolName:        s.searchToolName(event),
+			CreatedAt:       event.CreatedAt,
+		}
+		match.Snippet, match.Highlights = buildSnippet(terms, event.Content, event.ToolInputJSON, event.ToolResultContent)
+		matches[event.SessionID] = append(sessionMatches, match)
+	}
+
+	results := make([]*ConversationSearchResult, 0, len(sessionIDs))
+	for _, id := range sessionIDs {
+		// Events can outlive a hard-deleted session
+		session, ok := s.sessions[id]
+		if !ok {
+			continue
+		}
+		results = append(results, &ConversationSearchResult{Session: s.sessionCopy(session), Matches: matches[id]})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Matches[0].EventID > results[j].Matches[0].EventID
+	})
+	return results, nil
+}
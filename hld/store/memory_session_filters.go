@@ -0,0 +1,248 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// memorySavedFilter keeps the filter JSON encoded, as the SQL stores do, so
+// fields that are not part of a saved filter are dropped the same way
+type memorySavedFilter struct {
+	SavedFilter
+	encoded []byte
+}
+
+// sessionOfKind reports whether a session is of kind, or of any kind when
+// kind is empty
+func sessionOfKind(session *Session, kind string) bool {
+	switch kind {
+	case SessionKindNormal:
+		return !session.Archived && session.Status != SessionStatusDraft && session.Status != SessionStatusDiscarded
+	case SessionKindArchived:
+		return session.Archived
+	case SessionKindDraft:
+		return !session.Archived && session.Status == SessionStatusDraft
+	}
+	return true
+}
+
+// matchesFilter reports whether a stored session matches filter
+func (s *MemoryStore) matchesFilter(session *Session, filter SessionFilter) bool {
+	if !sessionOfKind(session, filter.Kind) {
+		return false
+	}
+	if len(filter.Statuses) > 0 && !containsString(filter.Statuses, session.Status) {
+		return false
+	}
+	for _, label := range filter.Labels {
+		if !s.hasLabelLocked(session.ID, label) {
+			return false
+		}
+	}
+	if filter.ProjectID != "" && session.ProjectID != filter.ProjectID {
+		return false
+	}
+	if len(filter.Models) > 0 && !containsString(filter.Models, session.Model) && !containsString(filter.Models, session.ModelID) {
+		return false
+	}
+	if filter.CreatedAfter != nil && session.CreatedAt.Before(*filter.CreatedAfter) {
+		return false
+	}
+	if filter.CreatedBefore != nil && !session.CreatedAt.Before(*filter.CreatedBefore) {
+		return false
+	}
+	cost := 0.0
+	if session.CostUSD != nil {
+		cost = *session.CostUSD
+	}
+	if filter.MinCostUSD != nil && cost < *filter.MinCostUSD {
+		return false
+	}
+	if filter.MaxCostUSD != nil && cost > *filter.MaxCostUSD {
+		return false
+	}
+	if filter.LeavesOnly && s.hasChildren(session.ID) {
+		return false
+	}
+	if filter.After != nil {
+		after := filter.After
+		if session.LastActivityAt.After(after.LastActivityAt) {
+			return false
+		}
+		if session.LastActivityAt.Equal(after.LastActivityAt) && session.ID >= after.ID {
+			return false
+		}
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// QuerySessions retrieves the sessions matching filter, most recently active first
+func (s *MemoryStore) QuerySessions(ctx context.Context, filter SessionFilter) ([]*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	matched := s.sortedSessions(func(session *Session) bool { return s.matchesFilter(session, filter) })
+	if filter.Limit > 0 && len(matched) > filter.Limit {
+		matched = matched[:filter.Limit]
+	}
+
+	var sessions []*Session
+	for _, session := range matched {
+		sessions = append(sessions, s.sessionCopy(session))
+	}
+	return sessions, nil
+}
+
+// CountSessionsByKind counts the sessions matching filter for each session
+// kind. The filter's own kind is ignored.
+func (s *MemoryStore) CountSessionsByKind(ctx context.Context, filter SessionFilter) (*SessionCounts, error) {
+	filter.Kind = ""
+	filter.After = nil
+	filter.Limit = 0
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var counts SessionCounts
+	for _, session := range s.sessions {
+		if !s.matchesFilter(session, filter) {
+			continue
+		}
+		if sessionOfKind(session, SessionKindNormal) {
+			counts.Normal++
+		}
+		if sessionOfKind(session, SessionKindArchived) {
+			counts.Archived++
+		}
+		if sessionOfKind(session, SessionKindDraft) {
+			counts.Draft++
+		}
+	}
+	return &counts, nil
+}
+
+// CreateSavedFilter stores a new named session filter
+func (s *MemoryStore) CreateSavedFilter(ctx context.Context, filter *SavedFilter) error {
+	now := time.Now().UTC()
+	if filter.CreatedAt.IsZero() {
+		filter.CreatedAt = now
+	}
+	if filter.UpdatedAt.IsZero() {
+		filter.UpdatedAt = now
+	}
+
+	encoded, err := json.Marshal(filter.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to encode saved filter: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.savedFilters[filter.ID]; ok || s.savedFilterNameTaken(filter.Name, filter.ID) {
+		return &AlreadyExistsError{Type: "saved filter", Name: filter.Name}
+	}
+	s.savedFilters[filter.ID] = &memorySavedFilter{
+		SavedFilter: SavedFilter{
+			ID: filter.ID, Name: filter.Name,
+			CreatedAt: filter.CreatedAt.UTC(), UpdatedAt: filter.UpdatedAt.UTC(),
+		},
+		encoded: encoded,
+	}
+	return nil
+}
+
+func (s *MemoryStore) savedFilterNameTaken(name, exceptID string) bool {
+	for id, existing := range s.savedFilters {
+		if id != exceptID && existing.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *memorySavedFilter) decode() (*SavedFilter, error) {
+	filter := f.SavedFilter
+	filter.Filter = SessionFilter{}
+	if err := json.Unmarshal(f.encoded, &filter.Filter); err != nil {
+		return nil, fmt.Errorf("failed to decode saved filter %s: %w", filter.ID, err)
+	}
+	return &filter, nil
+}
+
+// GetSavedFilter retrieves a saved filter by ID
+func (s *MemoryStore) GetSavedFilter(ctx context.Context, id string) (*SavedFilter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	filter, ok := s.savedFilters[id]
+	if !ok {
+		return nil, &NotFoundError{Type: "saved filter", ID: id}
+	}
+	return filter.decode()
+}
+
+// ListSavedFilters retrieves all saved filters ordered by name
+func (s *MemoryStore) ListSavedFilters(ctx context.Context) ([]*SavedFilter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var filters []*SavedFilter
+	for _, stored := range s.savedFilters {
+		filter, err := stored.decode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved filter: %w", err)
+		}
+		filters = append(filters, filter)
+	}
+	sort.Slice(filters, func(i, j int) bool { return filters[i].Name < filters[j].Name })
+	return filters, nil
+}
+
+// UpdateSavedFilter replaces a saved filter's name and criteria
+func (s *MemoryStore) UpdateSavedFilter(ctx context.Context, filter *SavedFilter) error {
+	encoded, err := json.Marshal(filter.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to encode saved filter: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	filter.UpdatedAt = time.Now().UTC()
+	if s.savedFilterNameTaken(filter.Name, filter.ID) {
+		return &AlreadyExistsError{Type: "saved filter", Name: filter.Name}
+	}
+	stored, ok := s.savedFilters[filter.ID]
+	if !ok {
+		return &NotFoundError{Type: "saved filter", ID: filter.ID}
+	}
+	stored.Name = filter.Name
+	stored.UpdatedAt = filter.UpdatedAt
+	stored.encoded = encoded
+	return nil
+}
+
+// DeleteSavedFilter removes a saved filter
+func (s *MemoryStore) DeleteSavedFilter(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.savedFilters[id]; !ok {
+		return &NotFoundError{Type: "saved filter", ID: id}
+	}
+	delete(s.savedFilters, id)
+	return nil
+}
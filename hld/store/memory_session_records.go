@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetSessionRecords reads every row stored for a session. Raw events are
+// only read when requested since they dwarf the rest.
+func (s *MemoryStore) GetSessionRecords(ctx context.Context, sessionID string, includeRawEvents bool) (*SessionRecords, error) {
+	session, err := s.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	records := &SessionRecords{Session: session}
+
+	if records.Labels, err = s.GetSessionLabels(ctx, sessionID); err != nil {
+		return nil, err
+	}
+	if records.FileSnapshots, err = s.GetFileSnapshots(ctx, sessionID); err != nil {
+		return nil, fmt.Errorf("failed to get file snapshots: %w", err)
+	}
+	if records.MCPServers, err = s.GetMCPServers(ctx, sessionID); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	records.Events = []*ConversationEvent{}
+	for _, event := range s.events {
+		if event.SessionID == sessionID {
+			records.Events = append(records.Events, cloneEvent(event))
+		}
+	}
+	records.Approvals = s.sessionApprovals(sessionID, func(*Approval) bool { return true })
+	if records.Approvals == nil {
+		records.Approvals = []*Approval{}
+	}
+	if includeRawEvents {
+		records.RawEvents = []RawEvent{}
+		for _, event := range s.rawEvents {
+			if event.SessionID == sessionID {
+				records.RawEvents = append(records.RawEvents, event)
+			}
+		}
+	}
+	return records, nil
+}
+
+// ImportSessionRecords inserts the records of several sessions at once,
+// keeping their IDs and timestamps. Parents must come before the sessions
+// that continue from them. Row IDs of events, snapshots, MCP servers and raw
+// events are assigned anew. Nothing is stored if any record is rejected.
+func (s *MemoryStore) ImportSessionRecords(ctx context.Context, records []*SessionRecords) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	imported := make(map[string]bool)
+	runIDs := make(map[string]bool)
+	approvalIDs := make(map[string]bool)
+	for _, r := range records {
+		if err := s.checkImport(r, imported, runIDs, approvalIDs); err != nil {
+			return fmt.Errorf("failed to import session %s: %w", r.Session.ID, err)
+		}
+	}
+
+	for _, r := range records {
+		s.sessions[r.Session.ID] = cloneSession(r.Session)
+		s.addSessionLabelsLocked(r.Session.ID, r.Labels)
+		for _, event := range r.Events {
+			stored := cloneEvent(event)
+			stored.ID = s.nextID()
+			stored.CreatedAt = event.CreatedAt.UTC()
+			s.events = append(s.events, stored)
+		}
+		for _, approval := range r.Approvals {
+			s.approvals = append(s.approvals, cloneApproval(approval))
+		}
+		for _, snapshot := range r.FileSnapshots {
+			snapshot.ID = s.nextID()
+			snapshot.CreatedAt = snapshot.CreatedAt.UTC()
+			s.fileSnapshots = append(s.fileSnapshots, snapshot)
+		}
+		for _, server := range r.MCPServers {
+			server.ID = s.nextID()
+			s.mcpServers = append(s.mcpServers, server)
+		}
+		for _, event := range r.RawEvents {
+			event.ID = s.nextID()
+			event.CreatedAt = event.CreatedAt.UTC()
+			s.rawEvents = append(s.rawEvents, event)
+		}
+	}
+	return nil
+}
+
+// checkImport rejects the records SQLite's constraints would reject, given
+// the sessions already accepted from the same import
+func (s *MemoryStore) checkImport(r *SessionRecords, imported, runIDs, approvalIDs map[string]bool) error {
+	session := r.Session
+	if _, ok := s.sessions[session.ID]; ok || imported[session.ID] {
+		return fmt.Errorf("failed to insert session: %w", &AlreadyExistsError{Type: "session", Name: session.ID})
+	}
+	taken := runIDs[session.RunID]
+	for _, existing := range s.sessions {
+		taken = taken || existing.RunID == session.RunID
+	}
+	if taken {
+		return fmt.Errorf("failed to insert session: %w", &AlreadyExistsError{Type: "session run", Name: session.RunID})
+	}
+	if session.ProjectID != "" {
+		if _, ok := s.projects[session.ProjectID]; !ok {
+			return fmt.Errorf("failed to insert session: %w", errForeignKey)
+		}
+	}
+	imported[session.ID] = true
+	runIDs[session.RunID] = true
+	known := func(sessionID string) bool {
+		_, ok := s.sessions[sessionID]
+		return ok || imported[sessionID]
+	}
+
+	for _, event := range r.Events {
+		if !known(event.SessionID) {
+			return fmt.Errorf("failed to insert conversation event: %w", errForeignKey)
+		}
+	}
+	for _, approval := range r.Approvals {
+		if !approval.Status.IsValid() {
+			return fmt.Errorf("invalid approval status: %s", approval.Status)
+		}
+		if !known(approval.SessionID) {
+			return fmt.Errorf("failed to insert approval: %w", errForeignKey)
+		}
+		if s.findApproval(approval.ID) != nil || approvalIDs[approval.ID] {
+			return fmt.Errorf("failed to insert approval: %w", &AlreadyExistsError{Type: "approval", Name: approval.ID})
+		}
+		approvalIDs[approval.ID] = true
+	}
+	for _, snapshot := range r.FileSnapshots {
+		if !known(snapshot.SessionID) {
+			return fmt.Errorf("failed to insert file snapshot: %w", errForeignKey)
+		}
+	}
+	for _, server := range r.MCPServers {
+		if !known(server.SessionID) {
+			return fmt.Errorf("failed to insert MCP server: %w", errForeignKey)
+		}
+	}
+	for _, event := range r.RawEvents {
+		if !known(event.SessionID) {
+			return fmt.Errorf("failed to insert raw event: %w", errForeignKey)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// templateNameTaken reports whether a template other than exceptID uses name
+func (s *MemoryStore) templateNameTaken(name, exceptID string) bool {
+	for id, template := range s.templates {
+		if id != exceptID && template.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateSessionTemplate creates a new session template
+func (s *MemoryStore) CreateSessionTemplate(ctx context.Context, template *SessionTemplate) error {
+	now := time.Now().UTC()
+	if template.CreatedAt.IsZero() {
+		template.CreatedAt = now
+	}
+	if template.UpdatedAt.IsZero() {
+		template.UpdatedAt = now
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.templates[template.ID]; ok || s.templateNameTaken(template.Name, template.ID) {
+		return &AlreadyExistsError{Type: "session template", Name: template.Name}
+	}
+	stored := *template
+	s.templates[template.ID] = &stored
+	return nil
+}
+
+// GetSessionTemplate retrieves a session template by ID
+func (s *MemoryStore) GetSessionTemplate(ctx context.Context, id string) (*SessionTemplate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, ok := s.templates[id]
+	if !ok {
+		return nil, &NotFoundError{Type: "session template", ID: id}
+	}
+	c := *template
+	return &c, nil
+}
+
+// GetSessionTemplateByName retrieves a session template by its unique name
+func (s *MemoryStore) GetSessionTemplateByName(ctx context.Context, name string) (*SessionTemplate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, template := range s.templates {
+		if template.Name == name {
+			c := *template
+			return &c, nil
+		}
+	}
+	return nil, &NotFoundError{Type: "session template", ID: name}
+}
+
+// ListSessionTemplates retrieves all session templates ordered by name
+func (s *MemoryStore) ListSessionTemplates(ctx context.Context) ([]*SessionTemplate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var templates []*SessionTemplate
+	for _, template := range s.templates {
+		c := *template
+		templates = append(templates, &c)
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+	return templates, nil
+}
+
+// UpdateSessionTemplate updates the specified session template fields
+func (s *MemoryStore) UpdateSessionTemplate(ctx context.Context, id string, updates SessionTemplateUpdate) error {
+	if updates == (SessionTemplateUpdate{}) {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	template, ok := s.templates[id]
+	if !ok {
+		return &NotFoundError{Type: "session template", ID: id}
+	}
+	if updates.Name != nil && s.templateNameTaken(*updates.Name, id) {
+		return &AlreadyExistsError{Type: "session template", Name: *updates.Name}
+	}
+	if updates.Name != nil {
+		template.Name = *updates.Name
+	}
+	if updates.Description != nil {
+		template.Description = *updates.Description
+	}
+	if updates.Config != nil {
+		template.Config = *updates.Config
+	}
+	template.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// DeleteSessionTemplate deletes a session template
+func (s *MemoryStore) DeleteSessionTemplate(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.templates[id]; !ok {
+		return &NotFoundError{Type: "session template", ID: id}
+	}
+	delete(s.templates, id)
+	return nil
+}
@@ -0,0 +1,256 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+)
+
+func cloneWebhook(webhook *Webhook) *Webhook {
+	c := *webhook
+	c.EventTypes = append([]string{}, webhook.EventTypes...)
+	c.Labels = append([]string{}, webhook.Labels...)
+	return &c
+}
+
+func cloneWebhookDelivery(delivery *WebhookDelivery) *WebhookDelivery {
+	c := *delivery
+	c.NextAttemptAt = clonePtr(delivery.NextAttemptAt)
+	c.DeliveredAt = clonePtr(delivery.DeliveredAt)
+	return &c
+}
+
+func validWebhookDeliveryStatus(status string) bool {
+	switch status {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusDelivered, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+// CreateWebhook creates a new webhook
+func (s *MemoryStore) CreateWebhook(ctx context.Context, webhook *Webhook) error {
+	now := time.Now().UTC()
+	if webhook.CreatedAt.IsZero() {
+		webhook.CreatedAt = now
+	}
+	if webhook.UpdatedAt.IsZero() {
+		webhook.UpdatedAt = now
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.webhooks[webhook.ID]; ok {
+		return fmt.Errorf("failed to create webhook: %w", &AlreadyExistsError{Type: "webhook", Name: webhook.ID})
+	}
+	s.webhooks[webhook.ID] = cloneWebhook(webhook)
+	return nil
+}
+
+// GetWebhook retrieves a webhook by ID
+func (s *MemoryStore) GetWebhook(ctx context.Context, id string) (*Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	webhook, ok := s.webhooks[id]
+	if !ok {
+		return nil, &NotFoundError{Type: "webhook", ID: id}
+	}
+	return cloneWebhook(webhook), nil
+}
+
+// ListWebhooks retrieves all webhooks ordered by name
+func (s *MemoryStore) ListWebhooks(ctx context.Context) ([]*Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var webhooks []*Webhook
+	for _, webhook := range s.webhooks {
+		webhooks = append(webhooks, cloneWebhook(webhook))
+	}
+	sort.Slice(webhooks, func(i, j int) bool {
+		if webhooks[i].Name != webhooks[j].Name {
+			return webhooks[i].Name < webhooks[j].Name
+		}
+		return webhooks[i].CreatedAt.Before(webhooks[j].CreatedAt)
+	})
+	return webhooks, nil
+}
+
+// UpdateWebhook updates the specified webhook fields
+func (s *MemoryStore) UpdateWebhook(ctx context.Context, id string, updates WebhookUpdate) error {
+	if updates == (WebhookUpdate{}) {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.webhooks[id]
+	if !ok {
+		return &NotFoundError{Type: "webhook", ID: id}
+	}
+
+	webhook := cloneWebhook(stored)
+	if updates.Name != nil {
+		webhook.Name = *updates.Name
+	}
+	if updates.URL != nil {
+		webhook.URL = *updates.URL
+	}
+	if updates.Secret != nil {
+		webhook.Secret = *updates.Secret
+	}
+	if updates.EventTypes != nil {
+		webhook.EventTypes = append([]string{}, (*updates.EventTypes)...)
+	}
+	if updates.Labels != nil {
+		webhook.Labels = append([]string{}, (*updates.Labels)...)
+	}
+	if updates.WorkingDir != nil {
+		webhook.WorkingDir = *updates.WorkingDir
+	}
+	if updates.Enabled != nil {
+		webhook.Enabled = *updates.Enabled
+	}
+	webhook.UpdatedAt = time.Now().UTC()
+
+	s.webhooks[id] = webhook
+	return nil
+}
+
+// DeleteWebhook deletes a webhook and its delivery log
+func (s *MemoryStore) DeleteWebhook(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.webhooks[id]; !ok {
+		return &NotFoundError{Type: "webhook", ID: id}
+	}
+	delete(s.webhooks, id)
+
+	s.webhookDeliveries = slices.DeleteFunc(s.webhookDeliveries, func(delivery *WebhookDelivery) bool {
+		return delivery.WebhookID == id
+	})
+	return nil
+}
+
+// CreateWebhookDelivery records a delivery, setting its ID, and deletes all
+// but the newest keep finished deliveries of the webhook. A keep of zero or
+// less keeps every delivery.
+func (s *MemoryStore) CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery, keep int) error {
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = time.Now().UTC()
+	}
+	if !validWebhookDeliveryStatus(delivery.Status) {
+		return fmt.Errorf("failed to create webhook delivery: %w", errCheckConstraint)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.webhooks[delivery.WebhookID]; !ok {
+		return fmt.Errorf("failed to create webhook delivery: %w", errForeignKey)
+	}
+	delivery.ID = s.nextID()
+	s.webhookDeliveries = append(s.webhookDeliveries, cloneWebhookDelivery(delivery))
+
+	if keep > 0 {
+		finished := 0
+		for i := len(s.webhookDeliveries) - 1; i >= 0; i-- {
+			stored := s.webhookDeliveries[i]
+			if stored.WebhookID != delivery.WebhookID || stored.Status == WebhookDeliveryStatusPending {
+				continue
+			}
+			finished++
+			if finished > keep {
+				s.webhookDeliveries = slices.Delete(s.webhookDeliveries, i, i+1)
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateWebhookDelivery updates the specified delivery fields
+func (s *MemoryStore) UpdateWebhookDelivery(ctx context.Context, id int64, updates WebhookDeliveryUpdate) error {
+	if updates.Status != nil && !validWebhookDeliveryStatus(*updates.Status) {
+		return fmt.Errorf("failed to update webhook delivery: %w", errCheckConstraint)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, stored := range s.webhookDeliveries {
+		if stored.ID != id {
+			continue
+		}
+		delivery := cloneWebhookDelivery(stored)
+		if updates.Status != nil {
+			delivery.Status = *updates.Status
+		}
+		if updates.Attempts != nil {
+			delivery.Attempts = *updates.Attempts
+		}
+		if updates.NextAttemptAt != nil {
+			next := updates.NextAttemptAt.UTC()
+			delivery.NextAttemptAt = &next
+		}
+		if updates.ResponseStatus != nil {
+			delivery.ResponseStatus = *updates.ResponseStatus
+		}
+		if updates.Error != nil {
+			delivery.Error = *updates.Error
+		}
+		if updates.DeliveredAt != nil {
+			delivered := updates.DeliveredAt.UTC()
+			delivery.DeliveredAt = &delivered
+		}
+		s.webhookDeliveries[i] = delivery
+		return nil
+	}
+	return &NotFoundError{Type: "webhook delivery", ID: fmt.Sprint(id)}
+}
+
+// ListWebhookDeliveries retrieves the most recent deliveries of a webhook,
+// newest first. A limit of 0 or less returns all deliveries.
+func (s *MemoryStore) ListWebhookDeliveries(ctx context.Context, webhookID string, limit int) ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deliveries []*WebhookDelivery
+	for i := len(s.webhookDeliveries) - 1; i >= 0; i-- {
+		if s.webhookDeliveries[i].WebhookID != webhookID {
+			continue
+		}
+		deliveries = append(deliveries, cloneWebhookDelivery(s.webhookDeliveries[i]))
+		if limit > 0 && len(deliveries) == limit {
+			break
+		}
+	}
+	return deliveries, nil
+}
+
+// GetDueWebhookDeliveries retrieves pending deliveries whose next attempt is
+// at or before now, oldest first. A limit of 0 or less returns all of them.
+func (s *MemoryStore) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deliveries []*WebhookDelivery
+	for _, stored := range s.webhookDeliveries {
+		if stored.Status != WebhookDeliveryStatusPending || stored.NextAttemptAt == nil || stored.NextAttemptAt.After(now) {
+			continue
+		}
+		deliveries = append(deliveries, cloneWebhookDelivery(stored))
+	}
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].NextAttemptAt.Before(*deliveries[j].NextAttemptAt)
+	})
+	if limit > 0 && len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
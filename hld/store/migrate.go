@@ -0,0 +1,491 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Migrations live in numbered files, NNN_name.up.sql with an optional
+// NNN_name.down.sql, one directory per database. The first line of an up
+// script is a comment describing the migration.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+var (
+	sqliteMigrations   = mustLoadMigrations(migrationFiles, "migrations/sqlite")
+	postgresMigrations = mustLoadMigrations(migrationFiles, "migrations/postgres")
+)
+
+var (
+	// ErrSchemaTooNew is returned when a database was migrated by a newer build
+	ErrSchemaTooNew = errors.New("database schema is newer than this build")
+
+	// ErrIrreversibleMigration is returned when a rollback would undo a
+	// migration that has no down script
+	ErrIrreversibleMigration = errors.New("migration cannot be rolled back")
+
+	// ErrMigrationLocked is returned when another process holds the migration
+	// lock for longer than the migrator is willing to wait
+	ErrMigrationLocked = errors.New("database is being migrated by another process")
+)
+
+// SchemaTooNewError wraps ErrSchemaTooNew with the versions involved
+type SchemaTooNewError struct {
+	Version int // the database's schema version
+	Latest  int // the newest version this build knows
+}
+
+func (e *SchemaTooNewError) Error() string {
+	return fmt.Sprintf("database schema version %d is newer than this build supports (%d)", e.Version, e.Latest)
+}
+
+func (e *SchemaTooNewError) Unwrap() error {
+	return ErrSchemaTooNew
+}
+
+// Migration is one numbered schema change. Up moves a database from the
+// previous version to Version and Down moves it back. A migration without a
+// down script cannot be rolled back.
+type Migration struct {
+	Version     int
+	Name        string
+	Description string
+	Up          string
+	Down        string
+	Checksum    string // hex SHA-256 of the up script
+}
+
+// Reversible reports whether the migration can be rolled back
+func (m Migration) Reversible() bool {
+	return m.Down != ""
+}
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// loadMigrations reads the migrations in dir, ordered by version
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		match := migrationFileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected file in %s: %s", dir, entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has files named both %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d has no up script", m.Version)
+		}
+		firstLine, _, _ := strings.Cut(m.Up, "\n")
+		if !strings.HasPrefix(firstLine, "-- ") {
+			return nil, fmt.Errorf("migration %d does not start with a description comment", m.Version)
+		}
+		m.Description = strings.TrimSpace(strings.TrimPrefix(firstLine, "-- "))
+		sum := sha256.Sum256([]byte(m.Up))
+		m.Checksum = hex.EncodeToString(sum[:])
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+func mustLoadMigrations(fsys fs.FS, dir string) []Migration {
+	migrations, err := loadMigrations(fsys, dir)
+	if err != nil {
+		panic(fmt.Sprintf("invalid migrations in %s: %v", dir, err))
+	}
+	return migrations
+}
+
+// MigrationPlan lists the migrations that move a database from one version to
+// another, in the order they run
+type MigrationPlan struct {
+	From  int
+	To    int
+	Down  bool
+	Steps []Migration
+}
+
+// AppliedMigration is a row of the schema_version table
+type AppliedMigration struct {
+	Version     int
+	Description string
+	AppliedAt   time.Time
+	Checksum    string // empty for migrations applied before checksums were recorded
+
+	// Known is false for versions this build has no migration for, which
+	// were applied by a newer build or predate the migration files
+	Known bool
+
+	// Modified is set when the migration's up script changed after it was applied
+	Modified bool
+}
+
+// MigrationLock describes the holder of the migration lock
+type MigrationLock struct {
+	Owner      string
+	AcquiredAt time.Time
+}
+
+// MigrationStatus describes where a database stands relative to this build
+type MigrationStatus struct {
+	Current int
+	Latest  int
+	Applied []AppliedMigration
+	Pending []Migration
+	Lock    *MigrationLock // nil when no migration is running
+}
+
+// migrationDialect holds what differs between databases when migrating
+type migrationDialect interface {
+	// lock takes the migration lock, which is held on conn until release is called
+	lock(ctx context.Context, conn *sql.Conn, timeout time.Duration) (release func(), err error)
+
+	// lockHolder describes the current holder of the lock, if it can tell
+	lockHolder(ctx context.Context, q rowQuerier) (*MigrationLock, error)
+
+	// prepare creates the tables that track migrations
+	prepare(ctx context.Context, conn *sql.Conn) error
+
+	// hasColumn reports whether table exists and has column
+	hasColumn(ctx context.Context, q rowQuerier, table, column string) (bool, error)
+
+	// exec runs the up or down script of migration version inside tx
+	exec(ctx context.Context, tx *sql.Tx, version int, script string) error
+
+	// bind rewrites ? placeholders for the database
+	bind(query string) string
+}
+
+// rowQuerier is satisfied by *sql.DB, *sql.Conn and *sql.Tx
+type rowQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// defaultMigrationLockTimeout is how long a migrator waits for another one
+// to finish before giving up
+const defaultMigrationLockTimeout = 30 * time.Second
+
+// Migrator moves a database between schema versions. Stores use it to bring
+// a database up to date when they open it, and hld db uses it to inspect,
+// plan, apply and roll back migrations without starting the daemon.
+//
+// Versions are tracked in schema_version. A database is at the highest
+// version recorded there, and every migration above it is pending.
+type Migrator struct {
+	db          *sql.DB
+	dialect     migrationDialect
+	migrations  []Migration
+	lockTimeout time.Duration
+}
+
+func newMigrator(db *sql.DB, dialect migrationDialect, migrations []Migration) *Migrator {
+	return &Migrator{db: db, dialect: dialect, migrations: migrations, lockTimeout: defaultMigrationLockTimeout}
+}
+
+// Close closes the migrator's database connection
+func (m *Migrator) Close() error {
+	return m.db.Close()
+}
+
+// Latest returns the version the newest migration brings a database to
+func (m *Migrator) Latest() int {
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Migrations returns every migration this build knows, oldest first
+func (m *Migrator) Migrations() []Migration {
+	return append([]Migration(nil), m.migrations...)
+}
+
+// migration returns the migration for version, if this build has one
+func (m *Migrator) migration(version int) (Migration, bool) {
+	i := sort.Search(len(m.migrations), func(i int) bool { return m.migrations[i].Version >= version })
+	if i < len(m.migrations) && m.migrations[i].Version == version {
+		return m.migrations[i], true
+	}
+	return Migration{}, false
+}
+
+// CurrentVersion returns the database's schema version, zero for a database
+// that was never migrated
+func (m *Migrator) CurrentVersion(ctx context.Context) (int, error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return 0, err
+	}
+	return currentVersion(applied), nil
+}
+
+func currentVersion(applied []AppliedMigration) int {
+	if len(applied) == 0 {
+		return 0
+	}
+	return applied[len(applied)-1].Version
+}
+
+// applied reads schema_version, oldest first. A database without the table
+// has no migrations applied.
+func (m *Migrator) applied(ctx context.Context, q rowQuerier) ([]AppliedMigration, error) {
+	exists, err := m.dialect.hasColumn(ctx, q, "schema_version", "version")
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	checksum := "NULL"
+	if exists, err := m.dialect.hasColumn(ctx, q, "schema_version", "checksum"); err != nil {
+		return nil, err
+	} else if exists {
+		checksum = "checksum"
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT version, COALESCE(description, ''), applied_at, `+checksum+`
+		FROM schema_version ORDER BY version
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema versions: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var applied []AppliedMigration
+	for rows.Next() {
+		var a AppliedMigration
+		var appliedAt sql.NullTime
+		var sum sql.NullString
+		if err := rows.Scan(&a.Version, &a.Description, &appliedAt, &sum); err != nil {
+			return nil, fmt.Errorf("failed to scan schema version: %w", err)
+		}
+		a.AppliedAt = appliedAt.Time
+		a.Checksum = sum.String
+		if known, ok := m.migration(a.Version); ok {
+			a.Known = true
+			a.Modified = a.Checksum != "" && a.Checksum != known.Checksum
+		}
+		applied = append(applied, a)
+	}
+	return applied, rows.Err()
+}
+
+// Status reports the applied and pending migrations without changing the database
+func (m *Migrator) Status(ctx context.Context) (*MigrationStatus, error) {
+	applied, err := m.applied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	status := &MigrationStatus{
+		Current: currentVersion(applied),
+		Latest:  m.Latest(),
+		Applied: applied,
+		Pending: []Migration{},
+	}
+	for _, migration := range m.migrations {
+		if migration.Version > status.Current {
+			status.Pending = append(status.Pending, migration)
+		}
+	}
+	if status.Lock, err = m.dialect.lockHolder(ctx, m.db); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Plan lists the migrations that would move the database to target, without
+// changing it. A target below the current version plans a rollback.
+func (m *Migrator) Plan(ctx context.Context, target int) (*MigrationPlan, error) {
+	current, err := m.CurrentVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m.plan(current, target)
+}
+
+func (m *Migrator) plan(current, target int) (*MigrationPlan, error) {
+	latest := m.Latest()
+	if current > latest {
+		return nil, &SchemaTooNewError{Version: current, Latest: latest}
+	}
+	if target < 0 || target > latest {
+		return nil, fmt.Errorf("target version %d is outside this build's range (0 to %d)", target, latest)
+	}
+
+	plan := &MigrationPlan{From: current, To: target, Down: target < current, Steps: []Migration{}}
+	if !plan.Down {
+		for _, migration := range m.migrations {
+			if migration.Version > current && migration.Version <= target {
+				plan.Steps = append(plan.Steps, migration)
+			}
+		}
+		return plan, nil
+	}
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		migration := m.migrations[i]
+		if migration.Version > current || migration.Version <= target {
+			continue
+		}
+		if !migration.Reversible() {
+			return nil, fmt.Errorf("%w: migration %d (%s) has no down script",
+				ErrIrreversibleMigration, migration.Version, migration.Description)
+		}
+		plan.Steps = append(plan.Steps, migration)
+	}
+	return plan, nil
+}
+
+// Migrate applies the pending migrations up to target, each in its own
+// transaction, while holding the migration lock. It refuses databases newer
+// than this build and targets below the current version.
+func (m *Migrator) Migrate(ctx context.Context, target int) (*MigrationPlan, error) {
+	return m.run(ctx, target, false)
+}
+
+// Rollback runs the down scripts of the migrations above target, newest
+// first. It fails before changing anything when one of them has no down script.
+func (m *Migrator) Rollback(ctx context.Context, target int) (*MigrationPlan, error) {
+	return m.run(ctx, target, true)
+}
+
+func (m *Migrator) run(ctx context.Context, target int, down bool) (*MigrationPlan, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	release, err := m.dialect.lock(ctx, conn, m.lockTimeout)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := m.dialect.prepare(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	// The version is read again under the lock, since another process may
+	// have migrated the database while this one waited
+	applied, err := m.applied(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := m.plan(currentVersion(applied), target)
+	if err != nil {
+		return nil, err
+	}
+	if plan.Down != down && len(plan.Steps) > 0 {
+		if down {
+			return nil, fmt.Errorf("target version %d is above the current version %d", target, plan.From)
+		}
+		return nil, fmt.Errorf("target version %d is below the current version %d; roll back instead", target, plan.From)
+	}
+	if err := m.recordChecksums(ctx, conn, applied); err != nil {
+		return nil, err
+	}
+
+	for _, migration := range plan.Steps {
+		if err := m.step(ctx, conn, migration, down); err != nil {
+			return nil, fmt.Errorf("migration %d failed: %w", migration.Version, err)
+		}
+		if down {
+			slog.Info("Rolled back migration", "version", migration.Version, "description", migration.Description)
+		} else {
+			slog.Info("Applied migration", "version", migration.Version, "description", migration.Description)
+		}
+	}
+	return plan, nil
+}
+
+// recordChecksums fills in the checksums of migrations applied before they
+// were recorded, and warns about migrations changed since they were applied.
+// A changed migration is not an error, since nothing the user can do would
+// fix it, but it means the schema may differ from what this build expects.
+func (m *Migrator) recordChecksums(ctx context.Context, conn *sql.Conn, applied []AppliedMigration) error {
+	for _, a := range applied {
+		migration, ok := m.migration(a.Version)
+		if !ok {
+			continue
+		}
+		if a.Modified {
+			slog.Warn("Migration changed since it was applied",
+				"version", a.Version, "description", a.Description,
+				"applied_checksum", a.Checksum, "checksum", migration.Checksum)
+			continue
+		}
+		if a.Checksum == "" {
+			_, err := conn.ExecContext(ctx, m.dialect.bind(`UPDATE schema_version SET checksum = ? WHERE version = ?`),
+				migration.Checksum, a.Version)
+			if err != nil {
+				return fmt.Errorf("failed to record checksum of migration %d: %w", a.Version, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Migrator) step(ctx context.Context, conn *sql.Conn, migration Migration, down bool) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if down {
+		if err := m.dialect.exec(ctx, tx, migration.Version, migration.Down); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, m.dialect.bind(`DELETE FROM schema_version WHERE version = ?`), migration.Version)
+	} else {
+		if err := m.dialect.exec(ctx, tx, migration.Version, migration.Up); err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, m.dialect.bind(`
+			INSERT INTO schema_version (version, description, checksum) VALUES (?, ?, ?)
+		`), migration.Version, migration.Description, migration.Checksum)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to record schema version: %w", err)
+	}
+	return tx.Commit()
+}
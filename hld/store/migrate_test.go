@@ -0,0 +1,304 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationFiles(t *testing.T) {
+	require.NotEmpty(t, sqliteMigrations)
+	assert.Equal(t, LatestSchemaVersion, sqliteMigrations[len(sqliteMigrations)-1].Version)
+	assert.Equal(t, 5, latestPostgresSchemaVersion())
+
+	for _, migrations := range [][]Migration{sqliteMigrations, postgresMigrations} {
+		for i, m := range migrations {
+			assert.NotEmpty(t, m.Description, "migration %d", m.Version)
+			assert.Len(t, m.Checksum, 64, "migration %d", m.Version)
+			if i > 0 {
+				assert.Greater(t, m.Version, migrations[i-1].Version)
+			}
+		}
+	}
+
+	// Every migration since schema validation started requiring version 19
+	// can be rolled back
+	for _, m := range sqliteMigrations {
+		assert.Equal(t, m.Version > 19, m.Reversible(), "migration %d", m.Version)
+	}
+}
+
+func TestSplitSQLStatements(t *testing.T) {
+	script := `-- description
+CREATE TABLE a (x TEXT DEFAULT ';'); -- trailing; comment
+/* block; comment */ INSERT INTO a VALUES ('it''s; fine');
+;
+UPDATE "odd;name" SET x = '--not a comment'`
+
+	assert.Equal(t, []string{
+		"CREATE TABLE a (x TEXT DEFAULT ';')",
+		"INSERT INTO a VALUES ('it''s; fine')",
+		`UPDATE "odd;name" SET x = '--not a comment'`,
+	}, splitSQLStatements(script))
+	assert.Empty(t, splitSQLStatements("-- only a comment\n"))
+
+	triggers := `CREATE TRIGGER t_ai AFTER INSERT ON a BEGIN
+	UPDATE a SET x = CASE WHEN new.x = ';' THEN 'semi' ELSE new.x END WHERE rowid = new.rowid;
+	INSERT INTO log VALUES ('end;');
+END;
+create temp trigger t_ad after delete on a begin delete from log; end;
+SELECT 1 AS begin_end`
+	assert.Equal(t, []string{
+		`CREATE TRIGGER t_ai AFTER INSERT ON a BEGIN
+	UPDATE a SET x = CASE WHEN new.x = ';' THEN 'semi' ELSE new.x END WHERE rowid = new.rowid;
+	INSERT INTO log VALUES ('end;');
+END`,
+		"create temp trigger t_ad after delete on a begin delete from log; end",
+		"SELECT 1 AS begin_end",
+	}, splitSQLStatements(triggers))
+}
+
+func TestSQLiteMigrationErrors(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", testutil.DatabasePath(t, "sqlite-migration-errors"))
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	migrations := []Migration{
+		{Version: 1, Up: "-- Create table\nCREATE TABLE a (x TEXT, y TEXT);"},
+		// Legacy migrations only add the columns that are missing
+		{Version: 2, Up: "-- Add columns\nALTER TABLE a ADD COLUMN y TEXT;\nALTER TABLE a ADD COLUMN z TEXT;"},
+		{Version: 23, Up: `-- Add trigger
+CREATE TABLE log (x TEXT);
+CREATE TRIGGER a_ai AFTER INSERT ON a BEGIN
+	INSERT INTO log VALUES (CASE WHEN new.x IS NULL THEN 'none' ELSE new.x END);
+END;`},
+		{Version: 24, Up: "-- Add columns again\nALTER TABLE a ADD COLUMN w TEXT;\nALTER TABLE a ADD COLUMN z TEXT;"},
+	}
+	migrator := newMigrator(db, sqliteMigrationDialect{}, migrations)
+
+	// A later migration adding a column that exists fails as a whole
+	_, err = migrator.Migrate(ctx, 24)
+	require.ErrorContains(t, err, "duplicate column name: z")
+	version, err := migrator.CurrentVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 23, version)
+	var columns int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info('a') WHERE name = 'w'`).Scan(&columns))
+	assert.Zero(t, columns)
+
+	_, err = db.Exec(`INSERT INTO a (x) VALUES ('inserted')`)
+	require.NoError(t, err)
+	var logged string
+	require.NoError(t, db.QueryRow(`SELECT x FROM log`).Scan(&logged))
+	assert.Equal(t, "inserted", logged)
+}
+
+// schemaSnapshot describes every table's columns and every index, leaving
+// out the tables that track migrations
+func schemaSnapshot(t *testing.T, db *sql.DB) string {
+	t.Helper()
+	rows, err := db.Query(`
+		SELECT type, name FROM sqlite_master
+		WHERE name NOT IN ('schema_version', 'schema_migration_lock') AND name NOT LIKE 'sqlite_%'
+		ORDER BY type, name
+	`)
+	require.NoError(t, err)
+	var objects []string
+	for rows.Next() {
+		var typ, name string
+		require.NoError(t, rows.Scan(&typ, &name))
+		objects = append(objects, typ+" "+name)
+	}
+	require.NoError(t, rows.Close())
+
+	var b strings.Builder
+	for _, object := range objects {
+		b.WriteString(object + "\n")
+		typ, name, _ := strings.Cut(object, " ")
+		if typ != "table" {
+			continue
+		}
+		columns, err := db.Query(`SELECT name, type, "notnull", COALESCE(dflt_value, '') FROM pragma_table_info(?)`, name)
+		require.NoError(t, err)
+		for columns.Next() {
+			var column, columnType, dflt string
+			var notNull bool
+			require.NoError(t, columns.Scan(&column, &columnType, &notNull, &dflt))
+			fmt.Fprintf(&b, "  %s %s notnull=%t default=%s\n", column, columnType, notNull, dflt)
+		}
+		require.NoError(t, columns.Close())
+	}
+	return b.String()
+}
+
+func TestSQLiteRollbackAndMigrate(t *testing.T) {
+	ctx := context.Background()
+	dbPath := testutil.DatabasePath(t, "sqlite-rollback")
+	s, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	fresh := schemaSnapshot(t, s.db)
+	require.NoError(t, s.CreateSession(ctx, &Session{ID: "sess-1", RunID: "run-1", Status: SessionStatusRunning}))
+	require.NoError(t, s.Close())
+
+	migrator, err := OpenSQLiteMigrator(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = migrator.Close() }()
+
+	// Planning changes nothing
+	plan, err := migrator.Plan(ctx, 30)
+	require.NoError(t, err)
+	assert.True(t, plan.Down)
+	require.Len(t, plan.Steps, LatestSchemaVersion-30)
+	assert.Equal(t, LatestSchemaVersion, plan.Steps[0].Version)
+	version, err := migrator.CurrentVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, LatestSchemaVersion, version)
+
+	_, err = migrator.Migrate(ctx, 30)
+	assert.ErrorContains(t, err, "roll back instead")
+
+	plan, err = migrator.Rollback(ctx, 19)
+	require.NoError(t, err)
+	assert.Len(t, plan.Steps, LatestSchemaVersion-19)
+	version, err = migrator.CurrentVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 19, version)
+	var tables int
+	require.NoError(t, migrator.db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE name IN ('labels', 'schedules')`).Scan(&tables))
+	assert.Zero(t, tables)
+
+	// Rolling back through a migration without a down script changes nothing
+	_, err = migrator.Rollback(ctx, 10)
+	assert.ErrorIs(t, err, ErrIrreversibleMigration)
+	version, err = migrator.CurrentVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 19, version)
+
+	status, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 19, status.Current)
+	assert.Len(t, status.Pending, LatestSchemaVersion-19)
+	assert.Nil(t, status.Lock)
+
+	plan, err = migrator.Migrate(ctx, LatestSchemaVersion)
+	require.NoError(t, err)
+	assert.False(t, plan.Down)
+	assert.Len(t, plan.Steps, LatestSchemaVersion-19)
+
+	// Opening the store rebuilds the search index dropped with migration 35.
+	// Data outside the rolled back migrations survives the round trip.
+	reopened, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = reopened.Close() }()
+	assert.Equal(t, fresh, schemaSnapshot(t, reopened.db))
+	session, err := reopened.GetSession(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Equal(t, "run-1", session.RunID)
+}
+
+func TestSQLiteRefusesNewerSchema(t *testing.T) {
+	dbPath := testutil.DatabasePath(t, "sqlite-newer")
+	s, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	_, err = s.db.Exec(`INSERT INTO schema_version (version, description) VALUES (?, 'from the future')`,
+		LatestSchemaVersion+1)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	_, err = NewSQLiteStore(dbPath)
+	require.ErrorIs(t, err, ErrSchemaTooNew)
+	assert.Contains(t, err.Error(), "newer than this build supports")
+
+	migrator, err := OpenSQLiteMigrator(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = migrator.Close() }()
+	_, err = migrator.Plan(context.Background(), LatestSchemaVersion)
+	assert.ErrorIs(t, err, ErrSchemaTooNew)
+
+	// Status still works, so the user can see what the newer build applied
+	status, err := migrator.Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, LatestSchemaVersion+1, status.Current)
+	assert.False(t, status.Applied[len(status.Applied)-1].Known)
+	assert.Empty(t, status.Pending)
+}
+
+func TestSQLiteMigrationChecksums(t *testing.T) {
+	ctx := context.Background()
+	dbPath := testutil.DatabasePath(t, "sqlite-checksums")
+	s, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+
+	// Databases migrated before checksums were recorded get them on next open
+	_, err = s.db.Exec(`UPDATE schema_version SET checksum = NULL`)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+	s, err = NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	var missing int
+	require.NoError(t, s.db.QueryRow(`SELECT COUNT(*) FROM schema_version WHERE checksum IS NULL`).Scan(&missing))
+	assert.Zero(t, missing)
+
+	// A migration changed after it was applied is reported, not fatal
+	_, err = s.db.Exec(`UPDATE schema_version SET checksum = 'changed' WHERE version = 30`)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+	s, err = NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	status, err := NewSQLiteMigrator(s.db).Status(ctx)
+	require.NoError(t, err)
+	for _, applied := range status.Applied {
+		assert.Equal(t, applied.Version == 30, applied.Modified, "version %d", applied.Version)
+	}
+}
+
+func TestSQLiteMigrationLock(t *testing.T) {
+	ctx := context.Background()
+	dbPath := testutil.DatabasePath(t, "sqlite-lock")
+	s, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	migrator, err := OpenSQLiteMigrator(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = migrator.Close() }()
+	migrator.lockTimeout = 200 * time.Millisecond
+	_, err = migrator.Rollback(ctx, 35)
+	require.NoError(t, err)
+
+	_, err = migrator.db.Exec(`INSERT INTO schema_migration_lock (id, owner, acquired_at) VALUES (1, 'other', ?)`,
+		time.Now().UTC())
+	require.NoError(t, err)
+
+	status, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, status.Lock)
+	assert.Equal(t, "other", status.Lock.Owner)
+
+	_, err = migrator.Migrate(ctx, LatestSchemaVersion)
+	assert.ErrorIs(t, err, ErrMigrationLocked)
+	version, err := migrator.CurrentVersion(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 35, version)
+
+	// A lock left behind by a crashed process is taken over
+	_, err = migrator.db.Exec(`UPDATE schema_migration_lock SET acquired_at = ?`,
+		time.Now().Add(-2*sqliteMigrationLockStale).UTC())
+	require.NoError(t, err)
+	_, err = migrator.Migrate(ctx, LatestSchemaVersion)
+	require.NoError(t, err)
+	status, err = migrator.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, LatestSchemaVersion, status.Current)
+	assert.Nil(t, status.Lock)
+}
@@ -215,7 +215,7 @@ func TestMigration18Healing(t *testing.T) {
 				var version int
 				err = db.QueryRow("SELECT MAX(version) FROM schema_version").Scan(&version)
 				require.NoError(t, err)
-				assert.Equal(t, 22, version, "Database should be at version 22")
+				assert.Equal(t, 40, version, "Database should be at version 40")
 
 				t.Logf("After migration - user_settings exists: %d, additional_directories exists: %d, version: %d",
 					userSettingsExists, additionalDirsExists, version)
@@ -236,7 +236,7 @@ func TestMigration18Idempotency(t *testing.T) {
 	var version int
 	err = db.QueryRow("SELECT MAX(version) FROM schema_version").Scan(&version)
 	require.NoError(t, err)
-	assert.Equal(t, 22, version, "Should be at version 22")
+	assert.Equal(t, 40, version, "Should be at version 40")
 
 	// Try to manually run migration 18 logic again (simulating idempotency)
 	// This would happen if someone ran the migration twice
@@ -515,10 +515,10 @@ func TestAllMigrationStates(t *testing.T) {
 				// Verify final state
 				db = s.GetDB()
 
-				// Check final version is 22
+				// Check final version is 40
 				err = db.QueryRow("SELECT MAX(version) FROM schema_version").Scan(&currentVersion)
 				require.NoError(t, err)
-				assert.Equal(t, 22, currentVersion, "Should be at version 22 after all migrations")
+				assert.Equal(t, 40, currentVersion, "Should be at version 40 after all migrations")
 
 				// Verify both critical components exist
 				var userSettingsExists int
@@ -537,7 +537,7 @@ func TestAllMigrationStates(t *testing.T) {
 				require.NoError(t, err)
 				assert.Equal(t, 1, additionalDirsExists, "additional_directories column should exist")
 
-				t.Logf("Successfully migrated from version %d to 22", targetVersion)
+				t.Logf("Successfully migrated from version %d to 40", targetVersion)
 			}
 		})
 	}
@@ -564,9 +564,13 @@ func TestMigrationFromBuggyState17(t *testing.T) {
 	var version int
 	err = db.QueryRow("SELECT MAX(version) FROM schema_version").Scan(&version)
 	require.NoError(t, err)
-	require.Equal(t, 22, version, "Fresh database should be at version 22")
+	require.Equal(t, 40, version, "Fresh database should be at version 40")
+
+	// Roll back to the last version of builds that ran migration 18, whose
+	// migrations only added missing columns, then simulate the buggy state by:
+	_, err = store.NewSQLiteMigrator(db).Rollback(context.Background(), 22)
+	require.NoError(t, err)
 
-	// Now simulate the buggy state by:
 	// 1. Remove migration 17 and 18 records
 	_, err = db.Exec(`DELETE FROM schema_version WHERE version >= 17`)
 	require.NoError(t, err)
@@ -608,7 +612,7 @@ func TestMigrationFromBuggyState17(t *testing.T) {
 
 	err = db.QueryRow("SELECT MAX(version) FROM schema_version").Scan(&version)
 	require.NoError(t, err)
-	assert.Equal(t, 22, version, "Should be at version 22 after healing")
+	assert.Equal(t, 40, version, "Should be at version 40 after healing")
 
 	// Both components should exist
 	err = db.QueryRow(`
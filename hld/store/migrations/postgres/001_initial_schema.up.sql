@@ -0,0 +1,315 @@
+-- Initial schema, equivalent to SQLite schema version 36
+-- Postgres support started from the SQLite schema at version 36, so this
+-- creates that schema in one go rather than replaying its history.
+
+CREATE TABLE projects (
+	id TEXT PRIMARY KEY,
+	root_path TEXT NOT NULL UNIQUE,
+	name TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE sessions (
+	id TEXT PRIMARY KEY,
+	run_id TEXT NOT NULL UNIQUE,
+	claude_session_id TEXT,
+	parent_session_id TEXT,
+
+	-- Launch configuration
+	query TEXT NOT NULL,
+	summary TEXT,
+	title TEXT DEFAULT '',
+	model TEXT,
+	model_id TEXT,
+	working_dir TEXT,
+	max_turns INTEGER,
+	system_prompt TEXT,
+	append_system_prompt TEXT,
+	custom_instructions TEXT,
+	permission_prompt_tool TEXT,
+	allowed_tools TEXT,
+	disallowed_tools TEXT,
+	additional_directories TEXT,
+	runner TEXT NOT NULL DEFAULT '',
+	effective_config TEXT NOT NULL DEFAULT '',
+	project_id TEXT REFERENCES projects(id) ON DELETE SET NULL,
+
+	-- Runtime status
+	status TEXT NOT NULL DEFAULT 'starting',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	last_activity_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	completed_at TIMESTAMPTZ,
+
+	-- Results
+	cost_usd DOUBLE PRECISION,
+	input_tokens INTEGER,
+	output_tokens INTEGER,
+	cache_creation_input_tokens INTEGER,
+	cache_read_input_tokens INTEGER,
+	effective_context_tokens INTEGER,
+	duration_ms BIGINT,
+	num_turns INTEGER,
+	result_content TEXT,
+	error_message TEXT,
+
+	-- Session settings
+	auto_accept_edits BOOLEAN NOT NULL DEFAULT FALSE,
+	dangerously_skip_permissions BOOLEAN NOT NULL DEFAULT FALSE,
+	dangerously_skip_permissions_expires_at TIMESTAMPTZ,
+	dangerously_skip_permissions_timeout_ms BIGINT,
+	archived BOOLEAN NOT NULL DEFAULT FALSE,
+	editor_state TEXT,
+	stall_threshold_ms BIGINT,
+	stall_interrupt_threshold_ms BIGINT,
+
+	-- Proxy configuration
+	proxy_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+	proxy_base_url TEXT DEFAULT '',
+	proxy_model_override TEXT DEFAULT '',
+	proxy_api_key TEXT DEFAULT '',
+
+	-- Resource usage
+	cpu_time_ms BIGINT NOT NULL DEFAULT 0,
+	cpu_percent DOUBLE PRECISION NOT NULL DEFAULT 0,
+	rss_bytes BIGINT NOT NULL DEFAULT 0,
+	open_fds BIGINT NOT NULL DEFAULT 0,
+	peak_cpu_percent DOUBLE PRECISION NOT NULL DEFAULT 0,
+	peak_rss_bytes BIGINT NOT NULL DEFAULT 0,
+	peak_open_fds BIGINT NOT NULL DEFAULT 0,
+	resources_sampled_at TIMESTAMPTZ
+);
+CREATE INDEX idx_sessions_claude ON sessions(claude_session_id);
+CREATE INDEX idx_sessions_status ON sessions(status);
+CREATE INDEX idx_sessions_parent ON sessions(parent_session_id);
+CREATE INDEX idx_sessions_archived ON sessions(archived);
+CREATE INDEX idx_sessions_title ON sessions(title);
+CREATE INDEX idx_sessions_project ON sessions(project_id);
+CREATE INDEX idx_sessions_activity_order ON sessions(last_activity_at DESC, id DESC);
+
+CREATE TABLE conversation_events (
+	id BIGSERIAL PRIMARY KEY,
+	session_id TEXT NOT NULL REFERENCES sessions(id),
+	claude_session_id TEXT,
+	sequence INTEGER NOT NULL,
+	event_type TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	-- Message fields
+	role TEXT,
+	content TEXT,
+
+	-- Tool call fields
+	tool_id TEXT,
+	tool_name TEXT,
+	tool_input_json TEXT,
+	parent_tool_use_id TEXT,
+
+	-- Tool result fields
+	tool_result_for_id TEXT,
+	tool_result_content TEXT,
+
+	-- Tool call completion and approval tracking
+	is_completed BOOLEAN NOT NULL DEFAULT FALSE,
+	approval_status TEXT,
+	approval_id TEXT,
+
+	-- Compaction fields
+	compaction_trigger TEXT,
+	pre_compaction_tokens INTEGER,
+	post_compaction_tokens INTEGER
+);
+CREATE INDEX idx_conversation_claude_session ON conversation_events(claude_session_id, sequence);
+CREATE INDEX idx_conversation_session ON conversation_events(session_id, sequence);
+CREATE INDEX idx_conversation_approval ON conversation_events(approval_id);
+CREATE INDEX idx_conversation_pending_approvals
+	ON conversation_events(approval_status) WHERE approval_status = 'pending';
+CREATE INDEX idx_conversation_parent_tool
+	ON conversation_events(parent_tool_use_id) WHERE parent_tool_use_id IS NOT NULL;
+
+CREATE TABLE mcp_servers (
+	id BIGSERIAL PRIMARY KEY,
+	session_id TEXT NOT NULL REFERENCES sessions(id),
+	name TEXT NOT NULL,
+	command TEXT NOT NULL,
+	args_json TEXT,
+	env_json TEXT
+);
+CREATE INDEX idx_mcp_servers_session ON mcp_servers(session_id);
+
+CREATE TABLE raw_events (
+	id BIGSERIAL PRIMARY KEY,
+	session_id TEXT NOT NULL REFERENCES sessions(id),
+	event_json TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_raw_events_session ON raw_events(session_id, created_at);
+
+CREATE TABLE approvals (
+	id TEXT PRIMARY KEY,
+	run_id TEXT NOT NULL,
+	session_id TEXT NOT NULL REFERENCES sessions(id),
+	tool_use_id TEXT,
+	status TEXT NOT NULL CHECK (status IN ('pending', 'approved', 'denied')),
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	responded_at TIMESTAMPTZ,
+	tool_name TEXT NOT NULL,
+	tool_input TEXT NOT NULL,
+	comment TEXT
+);
+CREATE INDEX idx_approvals_pending ON approvals(status) WHERE status = 'pending';
+CREATE INDEX idx_approvals_session ON approvals(session_id);
+CREATE INDEX idx_approvals_run_id ON approvals(run_id);
+CREATE INDEX idx_approvals_tool_use_id ON approvals(tool_use_id) WHERE tool_use_id IS NOT NULL;
+
+CREATE TABLE file_snapshots (
+	id BIGSERIAL PRIMARY KEY,
+	tool_id TEXT NOT NULL,
+	session_id TEXT NOT NULL REFERENCES sessions(id),
+	file_path TEXT NOT NULL,
+	content TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_snapshots_session_path ON file_snapshots(session_id, file_path);
+CREATE INDEX idx_snapshots_tool ON file_snapshots(tool_id);
+
+CREATE TABLE user_settings (
+	id INTEGER PRIMARY KEY CHECK (id = 1),
+	advanced_providers BOOLEAN NOT NULL DEFAULT FALSE,
+	opt_in_telemetry BOOLEAN DEFAULT NULL,
+	auto_title_mode TEXT NOT NULL DEFAULT 'heuristic',
+	auto_title_model TEXT NOT NULL DEFAULT '',
+	raw_event_retention_days INTEGER NOT NULL DEFAULT 30,
+	archived_session_retention_days INTEGER NOT NULL DEFAULT 0,
+	file_snapshot_retention_days INTEGER NOT NULL DEFAULT 0,
+	dedupe_file_snapshots BOOLEAN NOT NULL DEFAULT TRUE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO user_settings (id) VALUES (1);
+
+CREATE TABLE schedules (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	cron_expr TEXT NOT NULL,
+	timezone TEXT NOT NULL DEFAULT 'UTC',
+	working_dir TEXT,
+	launch_config TEXT NOT NULL,
+	overlap_policy TEXT NOT NULL DEFAULT 'skip'
+		CHECK (overlap_policy IN ('skip', 'allow', 'interrupt')),
+	catch_up_policy TEXT NOT NULL DEFAULT 'once'
+		CHECK (catch_up_policy IN ('none', 'once', 'all')),
+	enabled BOOLEAN NOT NULL DEFAULT TRUE,
+	next_run_at TIMESTAMPTZ,
+	last_run_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_schedules_next_run ON schedules(next_run_at) WHERE enabled;
+
+CREATE TABLE schedule_runs (
+	id BIGSERIAL PRIMARY KEY,
+	schedule_id TEXT NOT NULL REFERENCES schedules(id) ON DELETE CASCADE,
+	session_id TEXT,
+	scheduled_for TIMESTAMPTZ NOT NULL,
+	status TEXT NOT NULL CHECK (status IN ('launched', 'skipped', 'failed')),
+	reason TEXT,
+	catch_up BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_schedule_runs_schedule ON schedule_runs(schedule_id, scheduled_for DESC);
+
+CREATE TABLE pipeline_runs (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	definition TEXT NOT NULL,
+	working_dir TEXT,
+	status TEXT NOT NULL DEFAULT 'running'
+		CHECK (status IN ('running', 'paused', 'completed', 'failed')),
+	current_step INTEGER NOT NULL DEFAULT 0,
+	error_message TEXT,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	completed_at TIMESTAMPTZ
+);
+CREATE INDEX idx_pipeline_runs_status ON pipeline_runs(status);
+
+CREATE TABLE pipeline_steps (
+	run_id TEXT NOT NULL REFERENCES pipeline_runs(id) ON DELETE CASCADE,
+	step_index INTEGER NOT NULL,
+	name TEXT NOT NULL,
+	session_id TEXT,
+	status TEXT NOT NULL DEFAULT 'pending'
+		CHECK (status IN ('pending', 'running', 'completed', 'failed', 'skipped')),
+	reason TEXT,
+	started_at TIMESTAMPTZ,
+	completed_at TIMESTAMPTZ,
+	PRIMARY KEY (run_id, step_index)
+);
+CREATE INDEX idx_pipeline_steps_session ON pipeline_steps(session_id);
+
+CREATE TABLE batch_groups (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	query TEXT NOT NULL,
+	matrix TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE batch_members (
+	group_id TEXT NOT NULL REFERENCES batch_groups(id) ON DELETE CASCADE,
+	member_index INTEGER NOT NULL,
+	session_id TEXT,
+	label TEXT NOT NULL,
+	model TEXT,
+	provider TEXT,
+	working_dir TEXT,
+	error_message TEXT,
+	PRIMARY KEY (group_id, member_index)
+);
+CREATE INDEX idx_batch_members_session ON batch_members(session_id);
+
+CREATE TABLE session_templates (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE,
+	description TEXT,
+	config TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE session_resource_samples (
+	id BIGSERIAL PRIMARY KEY,
+	session_id TEXT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+	sampled_at TIMESTAMPTZ NOT NULL,
+	cpu_time_ms BIGINT NOT NULL,
+	cpu_percent DOUBLE PRECISION NOT NULL,
+	rss_bytes BIGINT NOT NULL,
+	open_fds BIGINT NOT NULL,
+	process_count INTEGER NOT NULL
+);
+CREATE INDEX idx_resource_samples_session ON session_resource_samples(session_id, sampled_at);
+
+-- Label names are unique regardless of case, like COLLATE NOCASE in SQLite
+CREATE TABLE labels (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	color TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX idx_labels_name ON labels(lower(name));
+
+CREATE TABLE session_labels (
+	session_id TEXT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+	label_id TEXT NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (session_id, label_id)
+);
+CREATE INDEX idx_session_labels_label ON session_labels(label_id);
+
+CREATE TABLE saved_filters (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE,
+	filter TEXT NOT NULL DEFAULT '{}',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,7 @@
+-- Add event_log table of recent bus events for replay to reconnecting subscribers
+CREATE TABLE IF NOT EXISTS event_log (
+	seq BIGSERIAL PRIMARY KEY,
+	type TEXT NOT NULL,
+	data TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,7 @@
+-- Add project_config_trust table recording which project configuration files
+-- may add MCP servers, outside directories and approval relaxations
+CREATE TABLE IF NOT EXISTS project_config_trust (
+	project_root TEXT PRIMARY KEY,
+	digest TEXT NOT NULL,
+	trusted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
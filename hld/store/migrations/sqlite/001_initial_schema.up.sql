@@ -0,0 +1,132 @@
+-- Initial schema with conversation events
+CREATE TABLE IF NOT EXISTS sessions (
+	id TEXT PRIMARY KEY,
+	run_id TEXT NOT NULL UNIQUE,
+	claude_session_id TEXT,
+	parent_session_id TEXT,
+
+	-- Launch configuration
+	query TEXT NOT NULL,
+	summary TEXT,
+	model TEXT,
+	working_dir TEXT,
+	max_turns INTEGER,
+	system_prompt TEXT,
+	append_system_prompt TEXT,
+	custom_instructions TEXT,
+	permission_prompt_tool TEXT,
+	allowed_tools TEXT,
+	disallowed_tools TEXT,
+
+	-- Runtime status
+	status TEXT NOT NULL DEFAULT 'starting',
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	last_activity_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	completed_at TIMESTAMP,
+
+	-- Results
+	cost_usd REAL,
+	duration_ms INTEGER,
+	num_turns INTEGER,
+	result_content TEXT,
+	error_message TEXT,
+
+	-- Session settings
+	auto_accept_edits BOOLEAN DEFAULT 0,
+	dangerously_skip_permissions BOOLEAN DEFAULT 0,
+	dangerously_skip_permissions_expires_at TIMESTAMP,
+
+	-- Archival
+	archived BOOLEAN DEFAULT FALSE,
+
+	-- Additional directories for --add-dir support
+	additional_directories TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_sessions_claude ON sessions(claude_session_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_status ON sessions(status);
+CREATE INDEX IF NOT EXISTS idx_sessions_run_id ON sessions(run_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_parent ON sessions(parent_session_id);
+
+-- Single conversation events table
+CREATE TABLE IF NOT EXISTS conversation_events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	session_id TEXT NOT NULL,
+	claude_session_id TEXT,
+	sequence INTEGER NOT NULL,
+	event_type TEXT NOT NULL,
+
+	-- Common fields
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	-- Message fields
+	role TEXT,
+	content TEXT,
+
+	-- Tool call fields
+	tool_id TEXT,
+	tool_name TEXT,
+	tool_input_json TEXT,
+
+	-- Tool result fields
+	tool_result_for_id TEXT,
+	tool_result_content TEXT,
+
+	-- Tool call completion and approval tracking
+	is_completed BOOLEAN DEFAULT FALSE,  -- TRUE when tool result received
+	approval_status TEXT,        -- NULL, 'pending', 'approved', 'denied'
+	approval_id TEXT,           -- HumanLayer approval ID when correlated
+
+	FOREIGN KEY (session_id) REFERENCES sessions(id)
+);
+CREATE INDEX IF NOT EXISTS idx_conversation_claude_session ON conversation_events(claude_session_id, sequence);
+CREATE INDEX IF NOT EXISTS idx_conversation_session ON conversation_events(session_id, sequence);
+CREATE INDEX IF NOT EXISTS idx_conversation_approval ON conversation_events(approval_id);
+CREATE INDEX IF NOT EXISTS idx_conversation_pending_approvals
+	ON conversation_events(approval_status)
+	WHERE approval_status = 'pending';
+
+-- MCP servers configuration
+CREATE TABLE IF NOT EXISTS mcp_servers (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	session_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	command TEXT NOT NULL,
+	args_json TEXT,
+	env_json TEXT,
+
+	FOREIGN KEY (session_id) REFERENCES sessions(id)
+);
+CREATE INDEX IF NOT EXISTS idx_mcp_servers_session ON mcp_servers(session_id);
+
+-- Raw events for debugging
+CREATE TABLE IF NOT EXISTS raw_events (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	session_id TEXT NOT NULL,
+	event_json TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	FOREIGN KEY (session_id) REFERENCES sessions(id)
+);
+CREATE INDEX IF NOT EXISTS idx_raw_events_session ON raw_events(session_id, created_at);
+
+-- Approvals table for local approvals
+CREATE TABLE IF NOT EXISTS approvals (
+	id TEXT PRIMARY KEY,
+	run_id TEXT NOT NULL,
+	session_id TEXT NOT NULL,
+	status TEXT NOT NULL CHECK (status IN ('pending', 'approved', 'denied')),
+	created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	responded_at DATETIME,
+
+	-- Tool approval fields
+	tool_name TEXT NOT NULL,
+	tool_input TEXT NOT NULL, -- JSON
+
+	-- Response fields
+	comment TEXT, -- For denial reasons or approval notes
+
+	FOREIGN KEY (session_id) REFERENCES sessions(id)
+);
+CREATE INDEX IF NOT EXISTS idx_approvals_pending ON approvals(status) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_approvals_session ON approvals(session_id);
+CREATE INDEX IF NOT EXISTS idx_approvals_run_id ON approvals(run_id);
@@ -0,0 +1,5 @@
+-- Add permission_prompt_tool, append_system_prompt, allowed_tools, disallowed_tools fields
+ALTER TABLE sessions ADD COLUMN permission_prompt_tool TEXT;
+ALTER TABLE sessions ADD COLUMN append_system_prompt TEXT;
+ALTER TABLE sessions ADD COLUMN allowed_tools TEXT;
+ALTER TABLE sessions ADD COLUMN disallowed_tools TEXT;
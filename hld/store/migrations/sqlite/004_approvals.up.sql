@@ -0,0 +1,21 @@
+-- Add approvals table for local approvals
+CREATE TABLE IF NOT EXISTS approvals (
+	id TEXT PRIMARY KEY,
+	run_id TEXT NOT NULL,
+	session_id TEXT NOT NULL,
+	status TEXT NOT NULL CHECK (status IN ('pending', 'approved', 'denied')),
+	created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	responded_at DATETIME,
+
+	-- Tool approval fields
+	tool_name TEXT NOT NULL,
+	tool_input TEXT NOT NULL, -- JSON
+
+	-- Response fields
+	comment TEXT, -- For denial reasons or approval notes
+
+	FOREIGN KEY (session_id) REFERENCES sessions(id)
+);
+CREATE INDEX IF NOT EXISTS idx_approvals_pending ON approvals(status) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_approvals_session ON approvals(session_id);
+CREATE INDEX IF NOT EXISTS idx_approvals_run_id ON approvals(run_id);
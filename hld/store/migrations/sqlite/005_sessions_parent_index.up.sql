@@ -0,0 +1,2 @@
+-- Add index on parent_session_id for efficient tree queries
+CREATE INDEX IF NOT EXISTS idx_sessions_parent ON sessions(parent_session_id);
@@ -0,0 +1,5 @@
+-- Add parent_tool_use_id for sub-task tracking
+ALTER TABLE conversation_events ADD COLUMN parent_tool_use_id TEXT;
+CREATE INDEX IF NOT EXISTS idx_conversation_parent_tool
+	ON conversation_events(parent_tool_use_id)
+	WHERE parent_tool_use_id IS NOT NULL;
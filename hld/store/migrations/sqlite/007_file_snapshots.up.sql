@@ -0,0 +1,15 @@
+-- Add file_snapshots table for Read operation tracking
+CREATE TABLE IF NOT EXISTS file_snapshots (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	tool_id TEXT NOT NULL,
+	session_id TEXT NOT NULL,
+	file_path TEXT NOT NULL, -- Relative path from tool call
+	content TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	FOREIGN KEY (session_id) REFERENCES sessions(id)
+);
+CREATE INDEX IF NOT EXISTS idx_snapshots_session_path
+	ON file_snapshots(session_id, file_path);
+CREATE INDEX IF NOT EXISTS idx_snapshots_tool
+	ON file_snapshots(tool_id);
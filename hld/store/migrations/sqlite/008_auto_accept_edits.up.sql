@@ -0,0 +1,2 @@
+-- Add auto_accept_edits for session-level edit auto-approval
+ALTER TABLE sessions ADD COLUMN auto_accept_edits BOOLEAN DEFAULT 0;
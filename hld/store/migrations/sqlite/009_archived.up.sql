@@ -0,0 +1,3 @@
+-- Add archived field to sessions table for hiding old sessions
+ALTER TABLE sessions ADD COLUMN archived BOOLEAN DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS idx_sessions_archived ON sessions(archived);
@@ -0,0 +1,2 @@
+-- Add title column to sessions table
+ALTER TABLE sessions ADD COLUMN title TEXT DEFAULT '';
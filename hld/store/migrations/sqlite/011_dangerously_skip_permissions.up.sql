@@ -0,0 +1,3 @@
+-- Add dangerously skip permissions with timeout support
+ALTER TABLE sessions ADD COLUMN dangerously_skip_permissions BOOLEAN DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN dangerously_skip_permissions_expires_at TIMESTAMP;
@@ -0,0 +1,2 @@
+-- Add model_id column for full model identifier
+ALTER TABLE sessions ADD COLUMN model_id TEXT;
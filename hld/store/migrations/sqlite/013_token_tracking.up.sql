@@ -0,0 +1,6 @@
+-- Add detailed token tracking fields (input, output, cache, effective context)
+ALTER TABLE sessions ADD COLUMN input_tokens INTEGER;
+ALTER TABLE sessions ADD COLUMN output_tokens INTEGER;
+ALTER TABLE sessions ADD COLUMN cache_creation_input_tokens INTEGER;
+ALTER TABLE sessions ADD COLUMN cache_read_input_tokens INTEGER;
+ALTER TABLE sessions ADD COLUMN effective_context_tokens INTEGER;
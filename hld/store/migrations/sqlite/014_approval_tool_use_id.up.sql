@@ -0,0 +1,21 @@
+-- Add tool_use_id column to approvals table for direct correlation
+ALTER TABLE approvals ADD COLUMN tool_use_id TEXT;
+CREATE INDEX IF NOT EXISTS idx_approvals_tool_use_id
+	ON approvals(tool_use_id)
+	WHERE tool_use_id IS NOT NULL;
+
+-- Approvals correlated before this migration take the tool ID of their event
+UPDATE approvals
+SET tool_use_id = (
+	SELECT ce.tool_id
+	FROM conversation_events ce
+	WHERE ce.approval_id = approvals.id
+	AND ce.tool_id IS NOT NULL
+	LIMIT 1
+)
+WHERE EXISTS (
+	SELECT 1
+	FROM conversation_events ce
+	WHERE ce.approval_id = approvals.id
+	AND ce.tool_id IS NOT NULL
+);
@@ -0,0 +1,5 @@
+-- Add proxy configuration columns for model customization
+ALTER TABLE sessions ADD COLUMN proxy_enabled BOOLEAN DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN proxy_base_url TEXT DEFAULT '';
+ALTER TABLE sessions ADD COLUMN proxy_model_override TEXT DEFAULT '';
+ALTER TABLE sessions ADD COLUMN proxy_api_key TEXT DEFAULT '';
@@ -0,0 +1,10 @@
+-- Add user settings table for advanced providers preference
+CREATE TABLE IF NOT EXISTS user_settings (
+	id INTEGER PRIMARY KEY CHECK (id = 1), -- Singleton row
+	advanced_providers BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO user_settings (id, advanced_providers)
+VALUES (1, FALSE)
+ON CONFLICT(id) DO NOTHING;
@@ -0,0 +1,13 @@
+-- Healing migration to fix schema inconsistencies from migration reordering
+-- Migration 17 was skipped when migrations were reordered, which left some
+-- databases without the user settings table or additional_directories.
+CREATE TABLE IF NOT EXISTS user_settings (
+	id INTEGER PRIMARY KEY CHECK (id = 1),
+	advanced_providers BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+INSERT INTO user_settings (id, advanced_providers)
+VALUES (1, FALSE)
+ON CONFLICT(id) DO NOTHING;
+ALTER TABLE sessions ADD COLUMN additional_directories TEXT;
@@ -0,0 +1,3 @@
+-- Add opt_in_telemetry column for error reporting consent
+-- NULL means the user has not been asked yet
+ALTER TABLE user_settings ADD COLUMN opt_in_telemetry BOOLEAN DEFAULT NULL;
@@ -0,0 +1,2 @@
+-- Add editor_state column for draft session editor persistence
+ALTER TABLE sessions ADD COLUMN editor_state TEXT;
@@ -0,0 +1 @@
+ALTER TABLE sessions DROP COLUMN dangerously_skip_permissions_timeout_ms;
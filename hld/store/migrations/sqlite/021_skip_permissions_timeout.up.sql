@@ -0,0 +1,2 @@
+-- Add dangerously_skip_permissions_timeout_ms column to store bypass permissions timeout duration
+ALTER TABLE sessions ADD COLUMN dangerously_skip_permissions_timeout_ms INTEGER;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_sessions_last_activity;
+DROP INDEX IF EXISTS idx_sessions_title;
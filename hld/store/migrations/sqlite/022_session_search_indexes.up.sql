@@ -0,0 +1,4 @@
+-- Add indexes for session search performance
+-- A B-tree index on title helps LIKE 'prefix%' patterns
+CREATE INDEX IF NOT EXISTS idx_sessions_title ON sessions(title);
+CREATE INDEX IF NOT EXISTS idx_sessions_last_activity ON sessions(last_activity_at DESC);
@@ -0,0 +1,35 @@
+-- Add schedules and schedule_runs tables for recurring sessions
+CREATE TABLE IF NOT EXISTS schedules (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	cron_expr TEXT NOT NULL,
+	timezone TEXT NOT NULL DEFAULT 'UTC',
+	working_dir TEXT,
+	launch_config TEXT NOT NULL, -- JSON launch config template
+	overlap_policy TEXT NOT NULL DEFAULT 'skip'
+		CHECK (overlap_policy IN ('skip', 'allow', 'interrupt')),
+	catch_up_policy TEXT NOT NULL DEFAULT 'once'
+		CHECK (catch_up_policy IN ('none', 'once', 'all')),
+	enabled BOOLEAN NOT NULL DEFAULT 1,
+	next_run_at TIMESTAMP,
+	last_run_at TIMESTAMP,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_schedules_next_run
+	ON schedules(next_run_at) WHERE enabled = 1;
+
+CREATE TABLE IF NOT EXISTS schedule_runs (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	schedule_id TEXT NOT NULL,
+	session_id TEXT,
+	scheduled_for TIMESTAMP NOT NULL,
+	status TEXT NOT NULL CHECK (status IN ('launched', 'skipped', 'failed')),
+	reason TEXT,
+	catch_up BOOLEAN NOT NULL DEFAULT 0,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+	FOREIGN KEY (schedule_id) REFERENCES schedules(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_schedule_runs_schedule
+	ON schedule_runs(schedule_id, scheduled_for DESC);
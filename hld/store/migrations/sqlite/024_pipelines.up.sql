@@ -0,0 +1,31 @@
+-- Add pipeline_runs and pipeline_steps tables for multi-step pipelines
+CREATE TABLE IF NOT EXISTS pipeline_runs (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	definition TEXT NOT NULL, -- JSON pipeline definition snapshot
+	working_dir TEXT,
+	status TEXT NOT NULL DEFAULT 'running'
+		CHECK (status IN ('running', 'paused', 'completed', 'failed')),
+	current_step INTEGER NOT NULL DEFAULT 0,
+	error_message TEXT,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	completed_at TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_pipeline_runs_status ON pipeline_runs(status);
+
+CREATE TABLE IF NOT EXISTS pipeline_steps (
+	run_id TEXT NOT NULL,
+	step_index INTEGER NOT NULL,
+	name TEXT NOT NULL,
+	session_id TEXT,
+	status TEXT NOT NULL DEFAULT 'pending'
+		CHECK (status IN ('pending', 'running', 'completed', 'failed', 'skipped')),
+	reason TEXT,
+	started_at TIMESTAMP,
+	completed_at TIMESTAMP,
+
+	PRIMARY KEY (run_id, step_index),
+	FOREIGN KEY (run_id) REFERENCES pipeline_runs(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_pipeline_steps_session ON pipeline_steps(session_id);
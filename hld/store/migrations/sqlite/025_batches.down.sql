@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS batch_members;
+DROP TABLE IF EXISTS batch_groups;
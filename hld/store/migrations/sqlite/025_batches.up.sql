@@ -0,0 +1,23 @@
+-- Add batch_groups and batch_members tables for batch launches
+CREATE TABLE IF NOT EXISTS batch_groups (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	query TEXT NOT NULL,
+	matrix TEXT NOT NULL, -- JSON launch matrix without proxy API keys
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS batch_members (
+	group_id TEXT NOT NULL,
+	member_index INTEGER NOT NULL,
+	session_id TEXT,
+	label TEXT NOT NULL,
+	model TEXT,
+	provider TEXT,
+	working_dir TEXT,
+	error_message TEXT,
+
+	PRIMARY KEY (group_id, member_index),
+	FOREIGN KEY (group_id) REFERENCES batch_groups(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_batch_members_session ON batch_members(session_id);
@@ -0,0 +1,9 @@
+-- Add session_templates table for server-side session templates
+CREATE TABLE IF NOT EXISTS session_templates (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE,
+	description TEXT,
+	config TEXT NOT NULL, -- JSON template configuration
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,2 @@
+ALTER TABLE sessions DROP COLUMN stall_interrupt_threshold_ms;
+ALTER TABLE sessions DROP COLUMN stall_threshold_ms;
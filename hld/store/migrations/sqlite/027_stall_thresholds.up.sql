@@ -0,0 +1,3 @@
+-- Add stall watchdog threshold columns to sessions
+ALTER TABLE sessions ADD COLUMN stall_threshold_ms INTEGER;
+ALTER TABLE sessions ADD COLUMN stall_interrupt_threshold_ms INTEGER;
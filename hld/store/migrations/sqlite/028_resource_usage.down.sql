@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS session_resource_samples;
+ALTER TABLE sessions DROP COLUMN resources_sampled_at;
+ALTER TABLE sessions DROP COLUMN peak_open_fds;
+ALTER TABLE sessions DROP COLUMN peak_rss_bytes;
+ALTER TABLE sessions DROP COLUMN peak_cpu_percent;
+ALTER TABLE sessions DROP COLUMN open_fds;
+ALTER TABLE sessions DROP COLUMN rss_bytes;
+ALTER TABLE sessions DROP COLUMN cpu_percent;
+ALTER TABLE sessions DROP COLUMN cpu_time_ms;
@@ -0,0 +1,22 @@
+-- Add resource usage columns and session_resource_samples table
+ALTER TABLE sessions ADD COLUMN cpu_time_ms INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN cpu_percent REAL NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN rss_bytes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN open_fds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN peak_cpu_percent REAL NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN peak_rss_bytes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN peak_open_fds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN resources_sampled_at DATETIME;
+
+CREATE TABLE IF NOT EXISTS session_resource_samples (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	session_id TEXT NOT NULL,
+	sampled_at DATETIME NOT NULL,
+	cpu_time_ms INTEGER NOT NULL,
+	cpu_percent REAL NOT NULL,
+	rss_bytes INTEGER NOT NULL,
+	open_fds INTEGER NOT NULL,
+	process_count INTEGER NOT NULL,
+	FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_resource_samples_session ON session_resource_samples(session_id, sampled_at);
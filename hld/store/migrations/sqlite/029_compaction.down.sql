@@ -0,0 +1,3 @@
+ALTER TABLE conversation_events DROP COLUMN post_compaction_tokens;
+ALTER TABLE conversation_events DROP COLUMN pre_compaction_tokens;
+ALTER TABLE conversation_events DROP COLUMN compaction_trigger;
@@ -0,0 +1,4 @@
+-- Add compaction columns to conversation_events
+ALTER TABLE conversation_events ADD COLUMN compaction_trigger TEXT;
+ALTER TABLE conversation_events ADD COLUMN pre_compaction_tokens INTEGER;
+ALTER TABLE conversation_events ADD COLUMN post_compaction_tokens INTEGER;
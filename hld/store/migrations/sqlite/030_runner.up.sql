@@ -0,0 +1,3 @@
+-- Add runner column for pluggable agent backends
+-- Empty means the default Claude Code runner
+ALTER TABLE sessions ADD COLUMN runner TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+ALTER TABLE user_settings DROP COLUMN auto_title_model;
+ALTER TABLE user_settings DROP COLUMN auto_title_mode;
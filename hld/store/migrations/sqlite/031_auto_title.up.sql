@@ -0,0 +1,3 @@
+-- Add auto title settings to user_settings
+ALTER TABLE user_settings ADD COLUMN auto_title_mode TEXT NOT NULL DEFAULT 'heuristic';
+ALTER TABLE user_settings ADD COLUMN auto_title_model TEXT NOT NULL DEFAULT '';
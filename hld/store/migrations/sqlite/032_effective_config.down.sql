@@ -0,0 +1 @@
+ALTER TABLE sessions DROP COLUMN effective_config;
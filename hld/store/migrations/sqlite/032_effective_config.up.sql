@@ -0,0 +1,2 @@
+-- Add effective_config column to sessions for merged project configuration
+ALTER TABLE sessions ADD COLUMN effective_config TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_sessions_project;
+ALTER TABLE sessions DROP COLUMN project_id;
+DROP TABLE IF EXISTS saved_filters;
+DROP TABLE IF EXISTS projects;
+DROP TABLE IF EXISTS session_labels;
+DROP TABLE IF EXISTS labels;
@@ -0,0 +1,35 @@
+-- Add labels, projects and saved session filters
+CREATE TABLE IF NOT EXISTS labels (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+	color TEXT NOT NULL DEFAULT '',
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS session_labels (
+	session_id TEXT NOT NULL,
+	label_id TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (session_id, label_id),
+	FOREIGN KEY (session_id) REFERENCES sessions(id) ON DELETE CASCADE,
+	FOREIGN KEY (label_id) REFERENCES labels(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_session_labels_label ON session_labels(label_id);
+
+CREATE TABLE IF NOT EXISTS projects (
+	id TEXT PRIMARY KEY,
+	root_path TEXT NOT NULL UNIQUE,
+	name TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS saved_filters (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE,
+	filter TEXT NOT NULL DEFAULT '{}',
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE sessions ADD COLUMN project_id TEXT REFERENCES projects(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_sessions_project ON sessions(project_id);
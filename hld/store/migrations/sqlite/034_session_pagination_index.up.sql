@@ -0,0 +1,5 @@
+-- Add session pagination index
+-- Timestamps are stored with their zone offset, so the list orders by
+-- julianday rather than the raw text
+CREATE INDEX IF NOT EXISTS idx_sessions_activity_order
+	ON sessions(julianday(last_activity_at) DESC, id DESC);
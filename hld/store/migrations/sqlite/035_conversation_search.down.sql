@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS conversation_events_fts_ai;
+DROP TRIGGER IF EXISTS conversation_events_fts_ad;
+DROP TRIGGER IF EXISTS conversation_events_fts_au;
+DROP TABLE IF EXISTS conversation_events_fts;
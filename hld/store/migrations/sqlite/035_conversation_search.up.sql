@@ -0,0 +1,3 @@
+-- Add full-text search index over conversation content
+-- The index depends on whether the build has FTS5 as well as on the schema
+-- version, so ensureSearchIndex creates and backfills it on every start.
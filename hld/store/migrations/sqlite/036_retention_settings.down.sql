@@ -0,0 +1,4 @@
+ALTER TABLE user_settings DROP COLUMN dedupe_file_snapshots;
+ALTER TABLE user_settings DROP COLUMN file_snapshot_retention_days;
+ALTER TABLE user_settings DROP COLUMN archived_session_retention_days;
+ALTER TABLE user_settings DROP COLUMN raw_event_retention_days;
@@ -0,0 +1,6 @@
+-- Add retention settings to user_settings
+-- The raw event default matches DefaultRawEventRetentionDays
+ALTER TABLE user_settings ADD COLUMN raw_event_retention_days INTEGER NOT NULL DEFAULT 30;
+ALTER TABLE user_settings ADD COLUMN archived_session_retention_days INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE user_settings ADD COLUMN file_snapshot_retention_days INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE user_settings ADD COLUMN dedupe_file_snapshots BOOLEAN NOT NULL DEFAULT 1;
@@ -0,0 +1,8 @@
+-- Add event_log table of recent bus events for replay to reconnecting subscribers
+-- AUTOINCREMENT keeps sequence numbers of trimmed events from being reused
+CREATE TABLE IF NOT EXISTS event_log (
+	seq INTEGER PRIMARY KEY AUTOINCREMENT,
+	type TEXT NOT NULL,
+	data TEXT NOT NULL,
+	created_at DATETIME NOT NULL
+);
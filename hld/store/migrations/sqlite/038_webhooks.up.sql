@@ -0,0 +1,34 @@
+-- Add webhooks and webhook_deliveries tables for outbound event webhooks
+CREATE TABLE IF NOT EXISTS webhooks (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	url TEXT NOT NULL,
+	secret TEXT NOT NULL,
+	event_types TEXT NOT NULL DEFAULT '[]', -- JSON array, empty means all
+	labels TEXT NOT NULL DEFAULT '[]', -- JSON array, sessions must have all of them
+	working_dir TEXT,
+	enabled BOOLEAN NOT NULL DEFAULT 1,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	webhook_id TEXT NOT NULL,
+	event_type TEXT NOT NULL,
+	event_seq INTEGER NOT NULL DEFAULT 0,
+	payload TEXT NOT NULL,
+	status TEXT NOT NULL CHECK (status IN ('pending', 'delivered', 'failed')),
+	attempts INTEGER NOT NULL DEFAULT 0,
+	next_attempt_at TIMESTAMP,
+	response_status INTEGER,
+	error TEXT,
+	created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	delivered_at TIMESTAMP,
+
+	FOREIGN KEY (webhook_id) REFERENCES webhooks(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook
+	ON webhook_deliveries(webhook_id, id DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due
+	ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
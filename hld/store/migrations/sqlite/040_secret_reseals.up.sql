@@ -0,0 +1,6 @@
+-- Add secret_reseals table recording the master keys every stored secret has
+-- been sealed with, so startup only rescans secrets after a key change
+CREATE TABLE IF NOT EXISTS secret_reseals (
+	key_id TEXT PRIMARY KEY,
+	completed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,1438 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// PostgresStore implements ConversationStore using PostgreSQL. It lets several
+// daemons share one database, so anything SQLite serialized through its single
+// writer is guarded by row or advisory locks here.
+type PostgresStore struct {
+	db pgDB
+
+	secrets secretCodec
+}
+
+var _ ConversationStore = (*PostgresStore)(nil)
+
+// NewPostgresStore connects to the database at databaseURL and migrates it to
+// the latest schema. databaseURL is a libpq style URL or keyword/value string.
+func NewPostgresStore(databaseURL string) (*PostgresStore, error) {
+	db, err := sql.Open("pgx", databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	store := &PostgresStore{db: pgDB{db}}
+
+	if err := store.applyMigrations(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to apply migrations: %w", err)
+	}
+
+	slog.Info("PostgreSQL store initialized", "database", postgresDatabaseName(ctx, db))
+	return store, nil
+}
+
+// postgresDatabaseName names the connected database for logs, which must never
+// include the connection URL since it may carry a password
+func postgresDatabaseName(ctx context.Context, db *sql.DB) string {
+	var name string
+	if err := db.QueryRowContext(ctx, "SELECT current_database()").Scan(&name); err != nil {
+		return ""
+	}
+	return name
+}
+
+// pgDB runs queries written with SQLite style ? placeholders against
+// Postgres, so both stores can share query builders
+type pgDB struct {
+	*sql.DB
+}
+
+func (db pgDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return db.DB.ExecContext(ctx, rebind(query), args...)
+}
+
+func (db pgDB) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return db.DB.QueryContext(ctx, rebind(query), args...)
+}
+
+func (db pgDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return db.DB.QueryRowContext(ctx, rebind(query), args...)
+}
+
+func (db pgDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (pgTx, error) {
+	tx, err := db.DB.BeginTx(ctx, opts)
+	return pgTx{tx}, err
+}
+
+// pgTx is the transaction counterpart of pgDB
+type pgTx struct {
+	*sql.Tx
+}
+
+func (tx pgTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return tx.Tx.ExecContext(ctx, rebind(query), args...)
+}
+
+func (tx pgTx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return tx.Tx.QueryContext(ctx, rebind(query), args...)
+}
+
+func (tx pgTx) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	return tx.Tx.QueryRowContext(ctx, rebind(query), args...)
+}
+
+// rebind rewrites ? placeholders to Postgres' numbered $n form. Question marks
+// inside string literals are left alone.
+func rebind(query string) string {
+	if !strings.Contains(query, "?") {
+		return query
+	}
+
+	var b strings.Builder
+	b.Grow(len(query) + 16)
+	n := 0
+	inString := false
+	for _, r := range query {
+		switch {
+		case r == '\'':
+			inString = !inString
+		case r == '?' && !inString:
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isPostgresUniqueViolation reports whether err is a Postgres unique constraint failure
+func isPostgresUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// Close closes the database connection pool
+func (s *PostgresStore) Close() error {
+	return s.db.Close()
+}
+
+// EncryptSecrets makes the store encrypt the secrets it writes with box, and
+// encrypts the secrets stored in plaintext or with a retired key. Stored
+// secrets are only scanned the first time a key is used. It returns how many
+// stored secrets it encrypted. Call it before the store is used.
+func (s *PostgresStore) EncryptSecrets(ctx context.Context, box SecretBox) (int, error) {
+	s.secrets = secretCodec{box: box}
+	return resealSecretsOnce(ctx, s.db, box)
+}
+
+// CreateSession creates a new session
+func (s *PostgresStore) CreateSession(ctx context.Context, session *Session) error {
+	proxyAPIKey, err := s.secrets.seal(session.ProxyAPIKey)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO sessions (
+			id, run_id, claude_session_id, parent_session_id,
+			query, summary, title, model, model_id, working_dir, max_turns, system_prompt, append_system_prompt, custom_instructions,
+			permission_prompt_tool, allowed_tools, disallowed_tools,
+			status, created_at, last_activity_at, auto_accept_edits, archived, dangerously_skip_permissions, dangerously_skip_permissions_expires_at,
+			dangerously_skip_permissions_timeout_ms,
+			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state,
+			stall_threshold_ms, stall_interrupt_threshold_ms, runner, project_id
+		) VALUES (` + placeholders(34) + `, NULLIF(?, ''))
+	`
+
+	_, err = s.db.ExecContext(ctx, query,
+		session.ID, session.RunID, session.ClaudeSessionID, session.ParentSessionID,
+		session.Query, session.Summary, session.Title, session.Model, session.ModelID, session.WorkingDir, session.MaxTurns,
+		session.SystemPrompt, session.AppendSystemPrompt, session.CustomInstructions,
+		session.PermissionPromptTool, session.AllowedTools, session.DisallowedTools,
+		session.Status, session.CreatedAt, session.LastActivityAt, session.AutoAcceptEdits, session.Archived,
+		session.DangerouslySkipPermissions, session.DangerouslySkipPermissionsExpiresAt,
+		session.DangerouslySkipPermissionsTimeoutMs,
+		session.ProxyEnabled, session.ProxyBaseURL, session.ProxyModelOverride, proxyAPIKey,
+		session.AdditionalDirectories, session.EditorState,
+		session.StallThresholdMs, session.StallInterruptThresholdMs, session.Runner, session.ProjectID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	return nil
+}
+
+// UpdateSession updates session fields
+func (s *PostgresStore) UpdateSession(ctx context.Context, sessionID string, updates SessionUpdate) error {
+	setParts := []string{}
+	args := []interface{}{}
+	set := func(column string, value interface{}) {
+		setParts = append(setParts, column+" = ?")
+		args = append(args, value)
+	}
+
+	if updates.LastActivityAt != nil {
+		set("last_activity_at", *updates.LastActivityAt)
+	}
+	if updates.ClaudeSessionID != nil {
+		set("claude_session_id", *updates.ClaudeSessionID)
+	}
+	if updates.Query != nil {
+		set("query", *updates.Query)
+	}
+	if updates.Status != nil {
+		set("status", *updates.Status)
+	}
+	if updates.CompletedAt != nil {
+		set("completed_at", *updates.CompletedAt)
+	}
+	if updates.CostUSD != nil {
+		set("cost_usd", *updates.CostUSD)
+	}
+	if updates.InputTokens != nil {
+		set("input_tokens", *updates.InputTokens)
+	}
+	if updates.OutputTokens != nil {
+		set("output_tokens", *updates.OutputTokens)
+	}
+	if updates.CacheCreationInputTokens != nil {
+		set("cache_creation_input_tokens", *updates.CacheCreationInputTokens)
+	}
+	if updates.CacheReadInputTokens != nil {
+		set("cache_read_input_tokens", *updates.CacheReadInputTokens)
+	}
+	if updates.EffectiveContextTokens != nil {
+		set("effective_context_tokens", *updates.EffectiveContextTokens)
+	}
+	if updates.DurationMS != nil {
+		set("duration_ms", *updates.DurationMS)
+	}
+	if updates.NumTurns != nil {
+		set("num_turns", *updates.NumTurns)
+	}
+	if updates.ResultContent != nil {
+		set("result_content", *updates.ResultContent)
+	}
+	if updates.ErrorMessage != nil {
+		set("error_message", *updates.ErrorMessage)
+	}
+	if updates.Summary != nil {
+		set("summary", *updates.Summary)
+	}
+	if updates.Title != nil {
+		set("title", *updates.Title)
+	}
+	if updates.AutoAcceptEdits != nil {
+		set("auto_accept_edits", *updates.AutoAcceptEdits)
+	}
+	if updates.DangerouslySkipPermissions != nil {
+		set("dangerously_skip_permissions", *updates.DangerouslySkipPermissions)
+	}
+	if updates.DangerouslySkipPermissionsExpiresAt != nil {
+		// A nil inner pointer clears the expiry
+		set("dangerously_skip_permissions_expires_at", *updates.DangerouslySkipPermissionsExpiresAt)
+	}
+	if updates.DangerouslySkipPermissionsTimeoutMs != nil {
+		set("dangerously_skip_permissions_timeout_ms", *updates.DangerouslySkipPermissionsTimeoutMs)
+	}
+	if updates.Model != nil {
+		set("model", *updates.Model)
+	}
+	if updates.ModelID != nil {
+		set("model_id", *updates.ModelID)
+	}
+	if updates.Archived != nil {
+		set("archived", *updates.Archived)
+	}
+	if updates.ProxyEnabled != nil {
+		set("proxy_enabled", *updates.ProxyEnabled)
+	}
+	if updates.ProxyBaseURL != nil {
+		set("proxy_base_url", *updates.ProxyBaseURL)
+	}
+	if updates.ProxyModelOverride != nil {
+		set("proxy_model_override", *updates.ProxyModelOverride)
+	}
+	if updates.ProxyAPIKey != nil {
+		proxyAPIKey, err := s.secrets.seal(*updates.ProxyAPIKey)
+		if err != nil {
+			return err
+		}
+		set("proxy_api_key", proxyAPIKey)
+	}
+	if updates.AdditionalDirectories != nil {
+		set("additional_directories", *updates.AdditionalDirectories)
+	}
+	if updates.WorkingDir != nil {
+		set("working_dir", *updates.WorkingDir)
+	}
+	if updates.EditorState != nil {
+		set("editor_state", *updates.EditorState)
+	}
+	if updates.StallThresholdMs != nil {
+		set("stall_threshold_ms", *updates.StallThresholdMs)
+	}
+	if updates.StallInterruptThresholdMs != nil {
+		set("stall_interrupt_threshold_ms", *updates.StallInterruptThresholdMs)
+	}
+	if updates.ProjectID != nil {
+		setParts = append(setParts, "project_id = NULLIF(?, '')")
+		args = append(args, *updates.ProjectID)
+	}
+
+	if len(setParts) == 0 {
+		// No fields to update is OK - this is a no-op
+		return nil
+	}
+
+	query := "UPDATE sessions SET " + strings.Join(setParts, ", ") + " WHERE id = ?"
+	args = append(args, sessionID)
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("session not found: %s", sessionID)
+	}
+	return nil
+}
+
+// HardDeleteSession permanently deletes a session from the database
+func (s *PostgresStore) HardDeleteSession(ctx context.Context, sessionID string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM sessions WHERE id = ?`, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+const sessionColumns = `
+	id, run_id, claude_session_id, parent_session_id,
+	query, summary, title, model, model_id, working_dir, max_turns, system_prompt, append_system_prompt, custom_instructions,
+	permission_prompt_tool, allowed_tools, disallowed_tools,
+	status, created_at, last_activity_at, completed_at,
+	cost_usd, input_tokens, output_tokens, cache_creation_input_tokens, cache_read_input_tokens, effective_context_tokens,
+	duration_ms, num_turns, result_content, error_message, auto_accept_edits, archived,
+	dangerously_skip_permissions, dangerously_skip_permissions_expires_at, dangerously_skip_permissions_timeout_ms,
+	proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state,
+	stall_threshold_ms, stall_interrupt_threshold_ms,
+	cpu_time_ms, cpu_percent, rss_bytes, open_fds, peak_cpu_percent, peak_rss_bytes, peak_open_fds, resources_sampled_at,
+	runner, COALESCE(project_id, '')
+`
+
+// scanSession scans a session row selected with sessionColumns
+func (s *PostgresStore) scanSession(row interface{ Scan(...any) error }) (*Session, error) {
+	var session Session
+	var claudeSessionID, parentSessionID, summary, title, model, modelID, workingDir, systemPrompt, appendSystemPrompt, customInstructions sql.NullString
+	var permissionPromptTool, allowedTools, disallowedTools sql.NullString
+	var maxTurns sql.NullInt64
+	var completedAt sql.NullTime
+	var costUSD sql.NullFloat64
+	var inputTokens, outputTokens, cacheCreationInputTokens, cacheReadInputTokens, effectiveContextTokens sql.NullInt64
+	var durationMS, numTurns sql.NullInt64
+	var resultContent, errorMessage sql.NullString
+	var autoAcceptEdits, archived, dangerouslySkipPermissions sql.NullBool
+	var dangerouslySkipPermissionsExpiresAt sql.NullTime
+	var dangerouslySkipPermissionsTimeoutMs sql.NullInt64
+	var proxyEnabled sql.NullBool
+	var proxyBaseURL, proxyModelOverride, proxyAPIKey sql.NullString
+	var additionalDirectories sql.NullString
+	var editorState sql.NullString
+	var stallThresholdMs, stallInterruptThresholdMs sql.NullInt64
+	var resourcesSampledAt sql.NullTime
+
+	err := row.Scan(
+		&session.ID, &session.RunID, &claudeSessionID, &parentSessionID,
+		&session.Query, &summary, &title, &model, &modelID, &workingDir, &maxTurns,
+		&systemPrompt, &appendSystemPrompt, &customInstructions,
+		&permissionPromptTool, &allowedTools, &disallowedTools,
+		&session.Status, &session.CreatedAt, &session.LastActivityAt, &completedAt,
+		&costUSD, &inputTokens, &outputTokens, &cacheCreationInputTokens, &cacheReadInputTokens, &effectiveContextTokens,
+		&durationMS, &numTurns, &resultContent, &errorMessage, &autoAcceptEdits,
+		&archived, &dangerouslySkipPermissions, &dangerouslySkipPermissionsExpiresAt, &dangerouslySkipPermissionsTimeoutMs,
+		&proxyEnabled, &proxyBaseURL, &proxyModelOverride, &proxyAPIKey, &additionalDirectories, &editorState,
+		&stallThresholdMs, &stallInterruptThresholdMs,
+		&session.CPUTimeMs, &session.CPUPercent, &session.RSSBytes, &session.OpenFDs,
+		&session.PeakCPUPercent, &session.PeakRSSBytes, &session.PeakOpenFDs, &resourcesSampledAt, &session.Runner, &session.ProjectID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	session.ClaudeSessionID = claudeSessionID.String
+	session.ParentSessionID = parentSessionID.String
+	session.Summary = summary.String
+	session.Title = title.String
+	session.Model = model.String
+	session.ModelID = modelID.String
+	session.WorkingDir = workingDir.String
+	session.MaxTurns = int(maxTurns.Int64)
+	session.SystemPrompt = systemPrompt.String
+	session.AppendSystemPrompt = appendSystemPrompt.String
+	session.CustomInstructions = customInstructions.String
+	session.PermissionPromptTool = permissionPromptTool.String
+	session.AllowedTools = allowedTools.String
+	session.DisallowedTools = disallowedTools.String
+	session.ResultContent = resultContent.String
+	session.ErrorMessage = errorMessage.String
+	if completedAt.Valid {
+		session.CompletedAt = &completedAt.Time
+	}
+	if costUSD.Valid {
+		session.CostUSD = &costUSD.Float64
+	}
+	session.InputTokens = nullIntPtr(inputTokens)
+	session.OutputTokens = nullIntPtr(outputTokens)
+	session.CacheCreationInputTokens = nullIntPtr(cacheCreationInputTokens)
+	session.CacheReadInputTokens = nullIntPtr(cacheReadInputTokens)
+	session.EffectiveContextTokens = nullIntPtr(effectiveContextTokens)
+	session.DurationMS = nullIntPtr(durationMS)
+	session.NumTurns = nullIntPtr(numTurns)
+
+	session.AutoAcceptEdits = autoAcceptEdits.Valid && autoAcceptEdits.Bool
+	session.Archived = archived.Valid && archived.Bool
+	session.DangerouslySkipPermissions = dangerouslySkipPermissions.Valid && dangerouslySkipPermissions.Bool
+	if dangerouslySkipPermissionsExpiresAt.Valid {
+		session.DangerouslySkipPermissionsExpiresAt = &dangerouslySkipPermissionsExpiresAt.Time
+	}
+	if dangerouslySkipPermissionsTimeoutMs.Valid {
+		session.DangerouslySkipPermissionsTimeoutMs = &dangerouslySkipPermissionsTimeoutMs.Int64
+	}
+
+	session.ProxyEnabled = proxyEnabled.Valid && proxyEnabled.Bool
+	session.ProxyBaseURL = proxyBaseURL.String
+	session.ProxyModelOverride = proxyModelOverride.String
+	session.ProxyAPIKey = s.secrets.open(proxyAPIKey.String)
+	session.AdditionalDirectories = additionalDirectories.String
+
+	if editorState.Valid {
+		session.EditorState = &editorState.String
+	}
+	if stallThresholdMs.Valid {
+		session.StallThresholdMs = &stallThresholdMs.Int64
+	}
+	if stallInterruptThresholdMs.Valid {
+		session.StallInterruptThresholdMs = &stallInterruptThresholdMs.Int64
+	}
+	if resourcesSampledAt.Valid {
+		session.ResourcesSampledAt = &resourcesSampledAt.Time
+	}
+
+	return &session, nil
+}
+
+func nullIntPtr(v sql.NullInt64) *int {
+	if !v.Valid {
+		return nil
+	}
+	n := int(v.Int64)
+	return &n
+}
+
+// querySessions runs a query selecting sessionColumns
+func (s *PostgresStore) querySessions(ctx context.Context, query string, args ...interface{}) ([]*Session, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var sessions []*Session
+	for rows.Next() {
+		session, err := s.scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// GetSession retrieves a session by ID
+func (s *PostgresStore) GetSession(ctx context.Context, sessionID string) (*Session, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+sessionColumns+` FROM sessions WHERE id = ?`, sessionID)
+	session, err := s.scanSession(row)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "session", ID: sessionID}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	return session, nil
+}
+
+// GetSessionByRunID retrieves a session by its run_id
+func (s *PostgresStore) GetSessionByRunID(ctx context.Context, runID string) (*Session, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+sessionColumns+` FROM sessions WHERE run_id = ?`, runID)
+	session, err := s.scanSession(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session by run_id: %w", err)
+	}
+	return session, nil
+}
+
+// ListSessions retrieves all sessions
+func (s *PostgresStore) ListSessions(ctx context.Context) ([]*Session, error) {
+	return s.QuerySessions(ctx, SessionFilter{})
+}
+
+// QuerySessions retrieves the sessions matching filter, most recently active first
+func (s *PostgresStore) QuerySessions(ctx context.Context, filter SessionFilter) ([]*Session, error) {
+	where, whereArgs := postgresSessionFilterWhere(filter)
+	query := `SELECT ` + sessionColumns + ` FROM sessions ` + where + ` ORDER BY last_activity_at DESC, id DESC`
+	args := whereArgs
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
+
+	sessions, err := s.querySessions(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	// A page holds only some of the matching sessions, so load labels for those alone
+	if filter.Limit > 0 {
+		where = "WHERE id IN (" + placeholders(len(sessions)) + ")"
+		whereArgs = make([]interface{}, len(sessions))
+		for i, session := range sessions {
+			whereArgs[i] = session.ID
+		}
+	}
+	if err := s.attachSessionLabels(ctx, sessions, where, whereArgs); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// SearchSessionsByTitle searches leaf sessions by title, summary or query
+func (s *PostgresStore) SearchSessionsByTitle(ctx context.Context, query string, limit int) ([]*Session, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+
+	// Only leaf sessions that are not archived, draft or discarded
+	sqlQuery := `
+		SELECT ` + sessionColumns + `
+		FROM sessions
+		WHERE NOT EXISTS (
+			SELECT 1 FROM sessions children
+			WHERE children.parent_session_id = sessions.id
+		)
+		AND NOT archived
+		AND status != 'draft'
+		AND status != 'discarded'`
+
+	var args []interface{}
+
+	// The UI shows title || summary || query, so search all three. ILIKE
+	// matches SQLite's case-insensitive LIKE.
+	if query != "" {
+		sqlQuery += " AND (title ILIKE ? OR summary ILIKE ? OR query ILIKE ?)"
+		args = append(args, "%"+query+"%", "%"+query+"%", "%"+query+"%")
+	}
+
+	// Look at the 20 most recent sessions for performance, then apply the
+	// user's limit
+	sqlQuery += `
+		ORDER BY last_activity_at DESC
+		LIMIT 20`
+
+	sessions, err := s.querySessions(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("search sessions query: %w", err)
+	}
+	if len(sessions) > limit {
+		sessions = sessions[:limit]
+	}
+	return sessions, nil
+}
+
+// GetExpiredDangerousPermissionsSessions returns sessions where dangerous permissions have expired
+func (s *PostgresStore) GetExpiredDangerousPermissionsSessions(ctx context.Context) ([]*Session, error) {
+	sessions, err := s.querySessions(ctx, `
+		SELECT `+sessionColumns+`
+		FROM sessions
+		WHERE dangerously_skip_permissions
+			AND dangerously_skip_permissions_expires_at IS NOT NULL
+			AND dangerously_skip_permissions_expires_at < ?
+			AND status IN ('running', 'waiting_input', 'starting')
+		ORDER BY dangerously_skip_permissions_expires_at ASC
+	`, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query expired dangerous skip permissions sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// GetSessionsByStatus retrieves all sessions in any of the given statuses,
+// oldest activity first
+func (s *PostgresStore) GetSessionsByStatus(ctx context.Context, statuses []string) ([]*Session, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, len(statuses))
+	for i, status := range statuses {
+		args[i] = status
+	}
+
+	sessions, err := s.querySessions(ctx,
+		`SELECT `+sessionColumns+` FROM sessions WHERE status IN (`+placeholders(len(statuses))+`) ORDER BY last_activity_at ASC`,
+		args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions by status: %w", err)
+	}
+	if sessions == nil {
+		sessions = []*Session{}
+	}
+	return sessions, nil
+}
+
+// GetRecentWorkingDirs retrieves recently used working directories
+func (s *PostgresStore) GetRecentWorkingDirs(ctx context.Context, limit int) ([]RecentPath, error) {
+	if limit <= 0 {
+		limit = 20 // Default to 20 recent paths
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT working_dir, MAX(last_activity_at), COUNT(*)
+		FROM sessions
+		WHERE working_dir IS NOT NULL
+			AND working_dir != ''
+			AND working_dir != '.'
+		GROUP BY working_dir
+		ORDER BY MAX(last_activity_at) DESC
+		LIMIT ?
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query recent paths: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var paths []RecentPath
+	for rows.Next() {
+		var p RecentPath
+		if err := rows.Scan(&p.Path, &p.LastUsed, &p.UsageCount); err != nil {
+			return nil, fmt.Errorf("scan recent path: %w", err)
+		}
+		p.LastUsed = p.LastUsed.UTC().Truncate(time.Second)
+		paths = append(paths, p)
+	}
+
+	return paths, rows.Err()
+}
+
+// GetUserSettings retrieves the user settings from the database
+func (s *PostgresStore) GetUserSettings(ctx context.Context) (*UserSettings, error) {
+	var settings UserSettings
+	err := s.db.QueryRowContext(ctx, `
+		SELECT advanced_providers, opt_in_telemetry, auto_title_mode, auto_title_model,
+			raw_event_retention_days, archived_session_retention_days, file_snapshot_retention_days,
+			dedupe_file_snapshots, created_at, updated_at
+		FROM user_settings WHERE id = 1
+	`).Scan(&settings.AdvancedProviders, &settings.OptInTelemetry, &settings.AutoTitleMode, &settings.AutoTitleModel,
+		&settings.RawEventRetentionDays, &settings.ArchivedSessionRetentionDays, &settings.FileSnapshotRetentionDays,
+		&settings.DedupeFileSnapshots, &settings.CreatedAt, &settings.UpdatedAt)
+
+	if err == sql.ErrNoRows {
+		return &UserSettings{
+			AdvancedProviders:     false,
+			AutoTitleMode:         TitleModeHeuristic,
+			RawEventRetentionDays: DefaultRawEventRetentionDays,
+			DedupeFileSnapshots:   true,
+			CreatedAt:             time.Now(),
+			UpdatedAt:             time.Now(),
+		}, nil
+	}
+	return &settings, err
+}
+
+// UpdateUserSettings updates the user settings in the database
+func (s *PostgresStore) UpdateUserSettings(ctx context.Context, settings UserSettings) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE user_settings
+		SET advanced_providers = ?, opt_in_telemetry = ?, auto_title_mode = ?, auto_title_model = ?,
+			raw_event_retention_days = ?, archived_session_retention_days = ?, file_snapshot_retention_days = ?,
+			dedupe_file_snapshots = ?, updated_at = CURRENT_TIMESTAMP
+		WHERE id = 1
+	`, settings.AdvancedProviders, settings.OptInTelemetry, settings.AutoTitleMode, settings.AutoTitleModel,
+		settings.RawEventRetentionDays, settings.ArchivedSessionRetentionDays, settings.FileSnapshotRetentionDays,
+		settings.DedupeFileSnapshots)
+	return err
+}
+
+// AddConversationEvent adds a new conversation event
+func (s *PostgresStore) AddConversationEvent(ctx context.Context, event *ConversationEvent) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Read committed transactions would both see the same MAX(sequence), so
+	// writers of one Claude session take turns until commit
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext(?))`, event.ClaudeSessionID); err != nil {
+		return fmt.Errorf("failed to lock conversation: %w", err)
+	}
+
+	var maxSeq sql.NullInt64
+	err = tx.QueryRowContext(ctx,
+		"SELECT MAX(sequence) FROM conversation_events WHERE claude_session_id = ?",
+		event.ClaudeSessionID,
+	).Scan(&maxSeq)
+	if err != nil {
+		return fmt.Errorf("failed to get max sequence: %w", err)
+	}
+	event.Sequence = int(maxSeq.Int64) + 1
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO conversation_events (
+			session_id, claude_session_id, sequence, event_type,
+			role, content,
+			tool_id, tool_name, tool_input_json, parent_tool_use_id,
+			tool_result_for_id, tool_result_content,
+			is_completed, approval_status, approval_id,
+			compaction_trigger, pre_compaction_tokens, post_compaction_tokens
+		) VALUES (`+placeholders(18)+`)
+		RETURNING id, created_at
+	`,
+		event.SessionID, event.ClaudeSessionID, event.Sequence, event.EventType,
+		event.Role, event.Content,
+		event.ToolID, event.ToolName, event.ToolInputJSON, event.ParentToolUseID,
+		event.ToolResultForID, event.ToolResultContent,
+		event.IsCompleted, event.ApprovalStatus, event.ApprovalID,
+		event.CompactionTrigger, event.PreCompactionTokens, event.PostCompactionTokens,
+	).Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to add conversation event: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+const conversationEventColumns = `
+	id, session_id, claude_session_id, sequence, event_type, created_at,
+	role, content,
+	tool_id, tool_name, tool_input_json, parent_tool_use_id,
+	tool_result_for_id, tool_result_content,
+	is_completed, approval_status, approval_id,
+	compaction_trigger, pre_compaction_tokens, post_compaction_tokens
+`
+
+// scanConversationEvent scans an event row selected with conversationEventColumns
+func scanConversationEvent(row interface{ Scan(...any) error }) (*ConversationEvent, error) {
+	event := &ConversationEvent{}
+	var claudeSessionID, role, content, toolID, toolName, toolInputJSON, parentToolUseID sql.NullString
+	var toolResultForID, toolResultContent, approvalStatus, approvalID sql.NullString
+	var isCompleted sql.NullBool
+	var compactionTrigger sql.NullString
+	var preCompactionTokens, postCompactionTokens sql.NullInt64
+	err := row.Scan(
+		&event.ID, &event.SessionID, &claudeSessionID,
+		&event.Sequence, &event.EventType, &event.CreatedAt,
+		&role, &content,
+		&toolID, &toolName, &toolInputJSON, &parentToolUseID,
+		&toolResultForID, &toolResultContent,
+		&isCompleted, &approvalStatus, &approvalID,
+		&compactionTrigger, &preCompactionTokens, &postCompactionTokens,
+	)
+	if err != nil {
+		return nil, err
+	}
+	event.ClaudeSessionID = claudeSessionID.String
+	event.Role = role.String
+	event.Content = content.String
+	event.ToolID = toolID.String
+	event.ToolName = toolName.String
+	event.ToolInputJSON = toolInputJSON.String
+	event.ParentToolUseID = parentToolUseID.String
+	event.ToolResultForID = toolResultForID.String
+	event.ToolResultContent = toolResultContent.String
+	event.IsCompleted = isCompleted.Valid && isCompleted.Bool
+	event.ApprovalStatus = approvalStatus.String
+	event.ApprovalID = approvalID.String
+	scanCompactionFields(event, compactionTrigger, preCompactionTokens, postCompactionTokens)
+	return event, nil
+}
+
+// queryEvents runs a query selecting conversationEventColumns
+func (s *PostgresStore) queryEvents(ctx context.Context, query string, args ...interface{}) ([]*ConversationEvent, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	events := []*ConversationEvent{}
+	for rows.Next() {
+		event, err := scanConversationEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate events: %w", err)
+	}
+	return events, nil
+}
+
+// GetConversation retrieves all events for a Claude session
+func (s *PostgresStore) GetConversation(ctx context.Context, claudeSessionID string) ([]*ConversationEvent, error) {
+	return s.GetConversationPage(ctx, claudeSessionID, ConversationPage{})
+}
+
+// GetConversationPage retrieves a window of the events for a Claude session
+func (s *PostgresStore) GetConversationPage(ctx context.Context, claudeSessionID string, page ConversationPage) ([]*ConversationEvent, error) {
+	if page.After == nil && page.SinceSequence > 0 {
+		page.After = &ConversationCursor{ClaudeSessionID: claudeSessionID, Sequence: page.SinceSequence}
+	}
+	return s.queryConversation(ctx, []string{claudeSessionID}, page)
+}
+
+// UpdateCompactionEvent fills in a compaction event's summary or post-compaction token count
+func (s *PostgresStore) UpdateCompactionEvent(ctx context.Context, eventID int64, updates CompactionUpdate) error {
+	setParts := []string{}
+	args := []interface{}{}
+
+	if updates.Summary != nil {
+		setParts = append(setParts, "content = ?")
+		args = append(args, *updates.Summary)
+	}
+	if updates.PostCompactionTokens != nil {
+		setParts = append(setParts, "post_compaction_tokens = ?")
+		args = append(args, *updates.PostCompactionTokens)
+	}
+	if len(setParts) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf(`UPDATE conversation_events SET %s WHERE id = ? AND event_type = ?`, strings.Join(setParts, ", "))
+	args = append(args, eventID, EventTypeCompaction)
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update compaction event: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "compaction event", ID: fmt.Sprintf("%d", eventID)}
+	}
+	return nil
+}
+
+// GetSessionConversation retrieves all events for a session including parent history
+func (s *PostgresStore) GetSessionConversation(ctx context.Context, sessionID string) ([]*ConversationEvent, error) {
+	return s.GetSessionConversationPage(ctx, sessionID, ConversationPage{})
+}
+
+// GetSessionConversationPage retrieves a window of the events for a session
+// including parent history
+func (s *PostgresStore) GetSessionConversationPage(ctx context.Context, sessionID string, page ConversationPage) ([]*ConversationEvent, error) {
+	claudeSessionIDs, err := s.sessionClaudeChain(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(claudeSessionIDs) == 0 {
+		return []*ConversationEvent{}, nil
+	}
+
+	// Only the session's own Claude session can still grow, so polling by
+	// sequence skips the parent history entirely
+	if page.After == nil && page.SinceSequence > 0 {
+		page.After = &ConversationCursor{
+			ClaudeSessionID: claudeSessionIDs[len(claudeSessionIDs)-1],
+			Sequence:        page.SinceSequence,
+		}
+	}
+	return s.queryConversation(ctx, claudeSessionIDs, page)
+}
+
+// sessionClaudeChain walks up the parent chain of a session and returns the
+// Claude session IDs of it and its ancestors, oldest first
+func (s *PostgresStore) sessionClaudeChain(ctx context.Context, sessionID string) ([]string, error) {
+	claudeSessionIDs := []string{}
+	currentID := sessionID
+	isFirstSession := true
+
+	for currentID != "" {
+		var claudeSessionID, parentID sql.NullString
+		err := s.db.QueryRowContext(ctx,
+			"SELECT claude_session_id, parent_session_id FROM sessions WHERE id = ?",
+			currentID,
+		).Scan(&claudeSessionID, &parentID)
+		if err == sql.ErrNoRows {
+			if isFirstSession {
+				return nil, &NotFoundError{Type: "session", ID: sessionID}
+			}
+			// A missing parent ends the chain
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session: %w", err)
+		}
+		isFirstSession = false
+
+		if claudeSessionID.String != "" {
+			claudeSessionIDs = append([]string{claudeSessionID.String}, claudeSessionIDs...)
+		}
+		currentID = parentID.String
+	}
+
+	return claudeSessionIDs, nil
+}
+
+// queryConversation retrieves the events of the given Claude sessions in
+// chronological order, treating them as one conversation in list order
+func (s *PostgresStore) queryConversation(ctx context.Context, claudeSessionIDs []string, page ConversationPage) ([]*ConversationEvent, error) {
+	conditions := []string{}
+	var args []interface{}
+
+	if page.After != nil {
+		// Everything in Claude sessions before the cursor's has already been seen
+		idx := -1
+		for i, id := range claudeSessionIDs {
+			if id == page.After.ClaudeSessionID {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, fmt.Errorf("%w: not part of this conversation", ErrInvalidCursor)
+		}
+		claudeSessionIDs = claudeSessionIDs[idx:]
+		conditions = append(conditions, "(claude_session_id != ? OR sequence > ?)")
+		args = append(args, page.After.ClaudeSessionID, page.After.Sequence)
+	}
+
+	conditions = append(conditions, "claude_session_id IN ("+placeholders(len(claudeSessionIDs))+")")
+	for _, id := range claudeSessionIDs {
+		args = append(args, id)
+	}
+
+	// Parent events come before child events
+	orderCases := make([]string, len(claudeSessionIDs))
+	for i := range claudeSessionIDs {
+		orderCases[i] = fmt.Sprintf("WHEN claude_session_id = ? THEN %d", i)
+		args = append(args, claudeSessionIDs[i])
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM conversation_events
+		WHERE %s
+		ORDER BY CASE %s END, sequence
+	`, conversationEventColumns, strings.Join(conditions, " AND "), strings.Join(orderCases, " "))
+	if page.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, page.Limit)
+	}
+
+	events, err := s.queryEvents(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conversation: %w", err)
+	}
+	return events, nil
+}
+
+// GetPendingToolCall finds the most recent uncompleted tool call for a given session and tool name
+func (s *PostgresStore) GetPendingToolCall(ctx context.Context, sessionID string, toolName string) (*ConversationEvent, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT `+conversationEventColumns+`
+		FROM conversation_events
+		WHERE tool_name = ?
+		  AND session_id = ?
+		  AND event_type = 'tool_call'
+		  AND NOT is_completed
+		ORDER BY sequence DESC
+		LIMIT 1
+	`, toolName, sessionID)
+	event, err := scanConversationEvent(row)
+	if err == sql.ErrNoRows {
+		return nil, nil // No pending tool call found
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending tool call: %w", err)
+	}
+	return event, nil
+}
+
+// GetUncorrelatedPendingToolCall finds the most recent uncompleted tool call without approval correlation
+func (s *PostgresStore) GetUncorrelatedPendingToolCall(ctx context.Context, sessionID string, toolName string) (*ConversationEvent, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT `+conversationEventColumns+`
+		FROM conversation_events
+		WHERE tool_name = ?
+		  AND session_id = ?
+		  AND event_type = 'tool_call'
+		  AND NOT is_completed
+		  AND COALESCE(approval_status, '') = ''
+		ORDER BY sequence DESC
+		LIMIT 1
+	`, toolName, sessionID)
+	event, err := scanConversationEvent(row)
+	if err == sql.ErrNoRows {
+		return nil, nil // No pending tool call found
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get uncorrelated pending tool call: %w", err)
+	}
+	return event, nil
+}
+
+// GetPendingToolCalls finds all uncompleted tool calls for a given session
+func (s *PostgresStore) GetPendingToolCalls(ctx context.Context, sessionID string) ([]*ConversationEvent, error) {
+	events, err := s.queryEvents(ctx, `
+		SELECT `+conversationEventColumns+`
+		FROM conversation_events
+		WHERE session_id = ?
+		  AND event_type = 'tool_call'
+		  AND NOT is_completed
+		ORDER BY sequence DESC
+	`, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending tool calls: %w", err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	return events, nil
+}
+
+// GetToolCallByID retrieves a specific tool call by its ID
+func (s *PostgresStore) GetToolCallByID(ctx context.Context, toolID string) (*ConversationEvent, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT `+conversationEventColumns+`
+		FROM conversation_events
+		WHERE tool_id = ?
+		  AND event_type = 'tool_call'
+		LIMIT 1
+	`, toolID)
+	event, err := scanConversationEvent(row)
+	if err == sql.ErrNoRows {
+		return nil, nil // Tool call not found
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tool call by ID: %w", err)
+	}
+	return event, nil
+}
+
+// MarkToolCallCompleted marks a tool call as completed when its result is received
+func (s *PostgresStore) MarkToolCallCompleted(ctx context.Context, toolID string, sessionID string) error {
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE conversation_events
+		SET is_completed = TRUE
+		WHERE tool_id = ?
+		  AND session_id = ?
+		  AND event_type = 'tool_call'
+	`, toolID, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to mark tool call completed: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		slog.Debug("no matching tool call found to mark completed",
+			"tool_id", toolID,
+			"session_id", sessionID)
+	}
+	return nil
+}
+
+// CorrelateApproval correlates an approval with the most recent pending tool
+// call. The tool call row stays locked until the approval is recorded, so
+// concurrent approvals for the same tool never claim the same call.
+func (s *PostgresStore) CorrelateApproval(ctx context.Context, sessionID string, toolName string, approvalID string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var eventID int64
+	var toolID, approvalStatus sql.NullString
+	err = tx.QueryRowContext(ctx, `
+		SELECT id, tool_id, approval_status
+		FROM conversation_events
+		WHERE tool_name = ?
+		  AND session_id = ?
+		  AND event_type = 'tool_call'
+		  AND NOT is_completed
+		ORDER BY sequence DESC
+		LIMIT 1
+		FOR UPDATE
+	`, toolName, sessionID).Scan(&eventID, &toolID, &approvalStatus)
+	if err == sql.ErrNoRows {
+		slog.Debug("no matching tool call found for approval",
+			"session_id", sessionID,
+			"tool_name", toolName,
+			"approval_id", approvalID)
+		return nil // Not an error - approval might be for a different session
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find pending tool call: %w", err)
+	}
+
+	if approvalStatus.String != "" {
+		slog.Warn("tool call already has approval status",
+			"tool_id", toolID.String,
+			"existing_status", approvalStatus.String,
+			"approval_id", approvalID)
+		return nil
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE conversation_events
+		SET approval_status = 'pending', approval_id = ?
+		WHERE id = ?
+	`, approvalID, eventID)
+	if err != nil {
+		return fmt.Errorf("failed to correlate approval: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit approval correlation: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	slog.Info("updated tool call with approval",
+		"event_id", eventID,
+		"rows_affected", rows)
+	return nil
+}
+
+// LinkConversationEventToApprovalUsingToolID correlates an approval with a specific tool call by tool_id
+func (s *PostgresStore) LinkConversationEventToApprovalUsingToolID(ctx context.Context, sessionID string, toolID string, approvalID string) error {
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE conversation_events
+		SET approval_status = 'pending', approval_id = ?
+		WHERE session_id = ?
+		  AND tool_id = ?
+		  AND event_type = 'tool_call'
+		  AND NOT is_completed
+		  AND COALESCE(approval_status, '') = ''
+	`, approvalID, sessionID, toolID)
+	if err != nil {
+		return fmt.Errorf("failed to correlate approval by tool_id: %w", err)
+	}
+
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		slog.Debug("no matching tool call found for approval by tool_id",
+			"session_id", sessionID,
+			"tool_id", toolID,
+			"approval_id", approvalID)
+		return nil // Not an error
+	}
+
+	slog.Info("correlated approval with tool call by tool_id",
+		"tool_id", toolID,
+		"session_id", sessionID,
+		"approval_id", approvalID,
+		"rows_affected", rows)
+	return nil
+}
+
+// UpdateApprovalStatus updates the status of an approval
+func (s *PostgresStore) UpdateApprovalStatus(ctx context.Context, approvalID string, status string) error {
+	// Resolved never overwrites an approved or denied decision
+	if status == ApprovalStatusResolved {
+		_, err := s.db.ExecContext(ctx, `
+			UPDATE conversation_events
+			SET approval_status = ?
+			WHERE approval_id = ? AND approval_status = ?
+		`, status, approvalID, ApprovalStatusPending)
+		if err != nil {
+			return fmt.Errorf("failed to update approval status: %w", err)
+		}
+		return nil
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE conversation_events
+		SET approval_status = ?
+		WHERE approval_id = ?
+	`, status, approvalID)
+	if err != nil {
+		return fmt.Errorf("failed to update approval status: %w", err)
+	}
+	return nil
+}
+
+// StoreMCPServers stores MCP server configurations
+func (s *PostgresStore) StoreMCPServers(ctx context.Context, sessionID string, servers []MCPServer) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, server := range servers {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO mcp_servers (session_id, name, command, args_json, env_json)
+			VALUES (?, ?, ?, ?, ?)
+		`, sessionID, server.Name, server.Command, server.ArgsJSON, server.EnvJSON)
+		if err != nil {
+			return fmt.Errorf("failed to insert MCP server: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetMCPServers retrieves MCP servers for a session
+func (s *PostgresStore) GetMCPServers(ctx context.Context, sessionID string) ([]MCPServer, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, session_id, name, command, COALESCE(args_json, ''), COALESCE(env_json, '')
+		FROM mcp_servers
+		WHERE session_id = ?
+		ORDER BY id
+	`, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get MCP servers: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var servers []MCPServer
+	for rows.Next() {
+		var server MCPServer
+		err := rows.Scan(
+			&server.ID, &server.SessionID, &server.Name,
+			&server.Command, &server.ArgsJSON, &server.EnvJSON,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan MCP server: %w", err)
+		}
+		servers = append(servers, server)
+	}
+	return servers, rows.Err()
+}
+
+// StoreRawEvent stores a raw event for debugging
+func (s *PostgresStore) StoreRawEvent(ctx context.Context, sessionID string, eventJSON string) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO raw_events (session_id, event_json) VALUES (?, ?)
+	`, sessionID, eventJSON)
+	if err != nil {
+		return fmt.Errorf("failed to store raw event: %w", err)
+	}
+	return nil
+}
+
+// CreateApproval creates a new approval
+func (s *PostgresStore) CreateApproval(ctx context.Context, approval *Approval) error {
+	if !approval.Status.IsValid() {
+		return fmt.Errorf("invalid approval status: %s", approval.Status)
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO approvals (
+			id, run_id, session_id, tool_use_id, status, created_at,
+			tool_name, tool_input, comment
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`,
+		approval.ID, approval.RunID, approval.SessionID, approval.ToolUseID, approval.Status.String(), approval.CreatedAt,
+		approval.ToolName, string(approval.ToolInput), approval.Comment,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create approval: %w", err)
+	}
+	return nil
+}
+
+const approvalColumns = `
+	id, run_id, session_id, tool_use_id, status, created_at, responded_at,
+	tool_name, tool_input, comment
+`
+
+// scanApproval scans an approval row selected with approvalColumns
+func scanApproval(row interface{ Scan(...any) error }) (*Approval, error) {
+	var approval Approval
+	var toolUseID, comment sql.NullString
+	var respondedAt sql.NullTime
+	var statusStr, toolInputStr string
+
+	err := row.Scan(
+		&approval.ID, &approval.RunID, &approval.SessionID, &toolUseID, &statusStr,
+		&approval.CreatedAt, &respondedAt,
+		&approval.ToolName, &toolInputStr, &comment,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	approval.Status = ApprovalStatus(statusStr)
+	if !approval.Status.IsValid() {
+		return nil, fmt.Errorf("invalid approval status in database: %s", statusStr)
+	}
+	if toolUseID.Valid {
+		approval.ToolUseID = &toolUseID.String
+	}
+	if respondedAt.Valid {
+		approval.RespondedAt = &respondedAt.Time
+	}
+	approval.Comment = comment.String
+	approval.ToolInput = json.RawMessage(toolInputStr)
+	return &approval, nil
+}
+
+// queryApprovals runs a query selecting approvalColumns
+func (s *PostgresStore) queryApprovals(ctx context.Context, query string, args ...interface{}) ([]*Approval, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var approvals []*Approval
+	for rows.Next() {
+		approval, err := scanApproval(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan approval: %w", err)
+		}
+		approvals = append(approvals, approval)
+	}
+	return approvals, rows.Err()
+}
+
+// GetApproval retrieves an approval by ID
+func (s *PostgresStore) GetApproval(ctx context.Context, id string) (*Approval, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+approvalColumns+` FROM approvals WHERE id = ?`, id)
+	approval, err := scanApproval(row)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "approval", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approval: %w", err)
+	}
+	return approval, nil
+}
+
+// GetPendingApprovals retrieves all pending approvals for a session
+func (s *PostgresStore) GetPendingApprovals(ctx context.Context, sessionID string) ([]*Approval, error) {
+	approvals, err := s.queryApprovals(ctx, `
+		SELECT `+approvalColumns+`
+		FROM approvals
+		WHERE session_id = ? AND status = ?
+		ORDER BY created_at ASC
+	`, sessionID, ApprovalStatusLocalPending.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending approvals: %w", err)
+	}
+	return approvals, nil
+}
+
+// UpdateApprovalResponse records the decision on a pending approval. The
+// update only applies while the approval is pending, so of two concurrent
+// decisions exactly one wins and the other gets AlreadyDecidedError.
+func (s *PostgresStore) UpdateApprovalResponse(ctx context.Context, id string, status ApprovalStatus, comment string) error {
+	if !status.IsValid() {
+		return fmt.Errorf("invalid approval status: %s", status)
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE approvals
+		SET status = ?, comment = ?, responded_at = CURRENT_TIMESTAMP
+		WHERE id = ? AND status = ?
+	`, status.String(), comment, id, ApprovalStatusLocalPending.String())
+	if err != nil {
+		return fmt.Errorf("failed to update approval response: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	approval, err := s.GetApproval(ctx, id)
+	if err != nil {
+		return err
+	}
+	return &AlreadyDecidedError{ID: id, Status: approval.Status.String()}
+}
+
+// CreateFileSnapshot stores a new file snapshot
+func (s *PostgresStore) CreateFileSnapshot(ctx context.Context, snapshot *FileSnapshot) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO file_snapshots (tool_id, session_id, file_path, content) VALUES (?, ?, ?, ?)
+	`, snapshot.ToolID, snapshot.SessionID, snapshot.FilePath, snapshot.Content)
+	return err
+}
+
+// GetFileSnapshots retrieves all snapshots for a session
+func (s *PostgresStore) GetFileSnapshots(ctx context.Context, sessionID string) ([]FileSnapshot, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, tool_id, session_id, file_path, content, created_at
+		FROM file_snapshots
+		WHERE session_id = ?
+		ORDER BY created_at DESC, id DESC
+	`, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var snapshots []FileSnapshot
+	for rows.Next() {
+		var snapshot FileSnapshot
+		if err := rows.Scan(&snapshot.ID, &snapshot.ToolID, &snapshot.SessionID, &snapshot.FilePath,
+			&snapshot.Content, &snapshot.CreatedAt); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, rows.Err()
+}
@@ -0,0 +1,113 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// CreateBatchGroup creates a new batch group
+func (s *PostgresStore) CreateBatchGroup(ctx context.Context, group *BatchGroup) error {
+	if group.CreatedAt.IsZero() {
+		group.CreatedAt = time.Now().UTC()
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO batch_groups (id, name, query, matrix, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, group.ID, group.Name, group.Query, group.Matrix, group.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to create batch group: %w", err)
+	}
+	return nil
+}
+
+// GetBatchGroup retrieves a batch group by ID
+func (s *PostgresStore) GetBatchGroup(ctx context.Context, id string) (*BatchGroup, error) {
+	var group BatchGroup
+	err := s.db.QueryRowContext(ctx, `
+		SELECT id, name, query, matrix, created_at FROM batch_groups WHERE id = ?
+	`, id).Scan(&group.ID, &group.Name, &group.Query, &group.Matrix, &group.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "batch group", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batch group: %w", err)
+	}
+	return &group, nil
+}
+
+// ListBatchGroups retrieves all batch groups, newest first
+func (s *PostgresStore) ListBatchGroups(ctx context.Context) ([]*BatchGroup, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, name, query, matrix, created_at FROM batch_groups ORDER BY created_at DESC, id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list batch groups: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var groups []*BatchGroup
+	for rows.Next() {
+		var group BatchGroup
+		if err := rows.Scan(&group.ID, &group.Name, &group.Query, &group.Matrix, &group.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan batch group: %w", err)
+		}
+		groups = append(groups, &group)
+	}
+	return groups, rows.Err()
+}
+
+// AddBatchMember records a member of a batch group
+func (s *PostgresStore) AddBatchMember(ctx context.Context, member *BatchMember) error {
+	var sessionID sql.NullString
+	if member.SessionID != "" {
+		sessionID = sql.NullString{String: member.SessionID, Valid: true}
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO batch_members (
+			group_id, member_index, session_id, label, model, provider, working_dir, error_message
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, member.GroupID, member.MemberIndex, sessionID, member.Label, member.Model,
+		member.Provider, member.WorkingDir, member.ErrorMessage,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add batch member: %w", err)
+	}
+	return nil
+}
+
+// GetBatchMembers retrieves the members of a batch group in launch order
+func (s *PostgresStore) GetBatchMembers(ctx context.Context, groupID string) ([]*BatchMember, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT group_id, member_index, session_id, label, model, provider, working_dir, error_message
+		FROM batch_members
+		WHERE group_id = ?
+		ORDER BY member_index
+	`, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get batch members: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var members []*BatchMember
+	for rows.Next() {
+		var member BatchMember
+		var sessionID, model, provider, workingDir, errorMessage sql.NullString
+
+		if err := rows.Scan(&member.GroupID, &member.MemberIndex, &sessionID, &member.Label,
+			&model, &provider, &workingDir, &errorMessage); err != nil {
+			return nil, fmt.Errorf("failed to scan batch member: %w", err)
+		}
+
+		member.SessionID = sessionID.String
+		member.Model = model.String
+		member.Provider = provider.String
+		member.WorkingDir = workingDir.String
+		member.ErrorMessage = errorMessage.String
+		members = append(members, &member)
+	}
+	return members, rows.Err()
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// SetSessionEffectiveConfig records the JSON encoded configuration a session
+// was launched with after project configuration was merged in
+func (s *PostgresStore) SetSessionEffectiveConfig(ctx context.Context, sessionID string, config string) error {
+	result, err := s.db.ExecContext(ctx, `UPDATE sessions SET effective_config = ? WHERE id = ?`, config, sessionID)
+	if err != nil {
+		return fmt.Errorf("failed to set session effective config: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "session", ID: sessionID}
+	}
+	return nil
+}
+
+// GetSessionEffectiveConfig returns the session's recorded effective
+// configuration, or an empty string for sessions created before it was recorded
+func (s *PostgresStore) GetSessionEffectiveConfig(ctx context.Context, sessionID string) (string, error) {
+	var config string
+	err := s.db.QueryRowContext(ctx, `SELECT effective_config FROM sessions WHERE id = ?`, sessionID).Scan(&config)
+	if err == sql.ErrNoRows {
+		return "", &NotFoundError{Type: "session", ID: sessionID}
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get session effective config: %w", err)
+	}
+	return config, nil
+}
+
+// SetProjectConfigTrust trusts the project configuration with the given
+// digest for a project root, replacing any earlier trust
+func (s *PostgresStore) SetProjectConfigTrust(ctx context.Context, projectRoot string, digest string) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO project_config_trust (project_root, digest, trusted_at)
+		VALUES (?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT (project_root) DO UPDATE SET digest = EXCLUDED.digest, trusted_at = EXCLUDED.trusted_at
+	`, projectRoot, digest)
+	if err != nil {
+		return fmt.Errorf("failed to set project config trust: %w", err)
+	}
+	return nil
+}
+
+// DeleteProjectConfigTrust revokes trust in a project root's configuration
+func (s *PostgresStore) DeleteProjectConfigTrust(ctx context.Context, projectRoot string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM project_config_trust WHERE project_root = ?`, projectRoot); err != nil {
+		return fmt.Errorf("failed to delete project config trust: %w", err)
+	}
+	return nil
+}
+
+// GetProjectConfigTrust returns the digest of the trusted configuration for a
+// project root, or an empty string when none is trusted
+func (s *PostgresStore) GetProjectConfigTrust(ctx context.Context, projectRoot string) (string, error) {
+	var digest string
+	err := s.db.QueryRowContext(ctx, `SELECT digest FROM project_config_trust WHERE project_root = ?`, projectRoot).Scan(&digest)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get project config trust: %w", err)
+	}
+	return digest, nil
+}
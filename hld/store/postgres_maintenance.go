@@ -0,0 +1,338 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// RunMaintenance prunes data past the retention policy, then optionally
+// vacuums and analyzes the database. Dry runs only count what would be pruned.
+func (s *PostgresStore) RunMaintenance(ctx context.Context, opts MaintenanceOptions) (*MaintenanceReport, error) {
+	switch opts.Vacuum {
+	case "":
+		opts.Vacuum = VacuumNone
+	case VacuumNone, VacuumIncremental, VacuumFull:
+	default:
+		return nil, fmt.Errorf("unknown vacuum mode: %s", opts.Vacuum)
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	report := &MaintenanceReport{
+		DryRun:    opts.DryRun,
+		Vacuum:    VacuumNone,
+		StartedAt: time.Now(),
+	}
+
+	size, err := s.databaseSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.SizeBeforeBytes = size
+
+	retention := opts.Retention
+	if retention.RawEventTTL > 0 {
+		report.RawEvents, err = s.pruneRows(ctx, "raw_events", "LENGTH(event_json)",
+			`SELECT id FROM raw_events WHERE created_at < ?`,
+			[]interface{}{now.Add(-retention.RawEventTTL).UTC()}, opts.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prune raw events: %w", err)
+		}
+	}
+	if retention.ArchivedSessionTTL > 0 {
+		report.ArchivedSessions, err = s.pruneArchivedSessions(ctx, now.Add(-retention.ArchivedSessionTTL), opts.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prune archived sessions: %w", err)
+		}
+	}
+	if retention.FileSnapshotTTL > 0 {
+		report.ExpiredFileSnapshots, err = s.pruneRows(ctx, "file_snapshots", "LENGTH(content)",
+			`SELECT id FROM file_snapshots WHERE created_at < ?`,
+			[]interface{}{now.Add(-retention.FileSnapshotTTL).UTC()}, opts.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prune file snapshots: %w", err)
+		}
+	}
+	if retention.DedupeFileSnapshots {
+		// Readers use the latest snapshot of each file, so of several identical
+		// snapshots in a row only the last one is kept
+		report.DuplicateFileSnapshots, err = s.pruneRows(ctx, "file_snapshots", "LENGTH(content)", `
+			SELECT f.id FROM file_snapshots f
+			WHERE f.content = (
+				SELECT n.content FROM file_snapshots n
+				WHERE n.session_id = f.session_id AND n.file_path = f.file_path AND n.id > f.id
+				ORDER BY n.id LIMIT 1
+			)`, nil, opts.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dedupe file snapshots: %w", err)
+		}
+	}
+
+	if !opts.DryRun {
+		if report.Vacuum, err = s.vacuum(ctx, opts.Vacuum); err != nil {
+			return nil, err
+		}
+		if opts.Analyze {
+			if _, err := s.db.ExecContext(ctx, "ANALYZE"); err != nil {
+				return nil, fmt.Errorf("failed to analyze database: %w", err)
+			}
+			report.Analyzed = true
+		}
+	}
+
+	if report.AutoVacuum, err = s.autoVacuumMode(ctx); err != nil {
+		return nil, err
+	}
+	if size, err = s.databaseSize(ctx); err != nil {
+		return nil, err
+	}
+	report.SizeAfterBytes = size
+	if report.SizeBeforeBytes > size {
+		report.ReclaimedBytes = report.SizeBeforeBytes - size
+	}
+	report.CompletedAt = time.Now()
+
+	return report, nil
+}
+
+// pruneRows deletes the rows of table whose ids selection returns, in batches.
+// sizeExpr estimates each row's payload for the report.
+func (s *PostgresStore) pruneRows(ctx context.Context, table, sizeExpr, selection string, args []interface{}, dryRun bool) (PruneCount, error) {
+	var count PruneCount
+	err := s.db.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT COUNT(*), COALESCE(SUM(%s), 0) FROM %s WHERE id IN (%s)
+	`, sizeExpr, table, selection), args...).Scan(&count.Rows, &count.Bytes)
+	if err != nil {
+		return count, fmt.Errorf("failed to count %s: %w", table, err)
+	}
+	if dryRun || count.Rows == 0 {
+		return count, nil
+	}
+
+	deleteQuery := fmt.Sprintf(`DELETE FROM %s WHERE id IN (SELECT id FROM (%s) batch LIMIT %d)`, table, selection, pruneBatchSize)
+	var deleted int64
+	for {
+		result, err := s.db.ExecContext(ctx, deleteQuery, args...)
+		if err != nil {
+			return count, fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return count, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		deleted += n
+		if n < pruneBatchSize {
+			break
+		}
+	}
+	count.Rows = deleted
+
+	return count, nil
+}
+
+// pruneArchivedSessions deletes archived sessions inactive since before cutoff,
+// along with everything they own
+func (s *PostgresStore) pruneArchivedSessions(ctx context.Context, cutoff time.Time, dryRun bool) (PruneCount, error) {
+	var count PruneCount
+	ids, err := s.expiredArchivedSessions(ctx, cutoff)
+	if err != nil {
+		return count, err
+	}
+
+	for start := 0; start < len(ids); start += pruneBatchSize {
+		batch := ids[start:min(start+pruneBatchSize, len(ids))]
+		marks := placeholders(len(batch))
+		args := make([]interface{}, len(batch))
+		for i, id := range batch {
+			args[i] = id
+		}
+
+		var bytes int64
+		payloadArgs := append(append(append([]interface{}{}, args...), args...), args...)
+		err := s.db.QueryRowContext(ctx, fmt.Sprintf(sessionPayloadQuery, marks), payloadArgs...).Scan(&bytes)
+		if err != nil {
+			return count, fmt.Errorf("failed to measure archived sessions: %w", err)
+		}
+		count.Bytes += bytes
+		if dryRun {
+			count.Rows += int64(len(batch))
+			continue
+		}
+
+		deleted, err := s.deleteSessions(ctx, marks, args)
+		if err != nil {
+			return count, err
+		}
+		count.Rows += deleted
+	}
+
+	return count, nil
+}
+
+// expiredArchivedSessions lists archived sessions inactive since before
+// cutoff. A session that is still the parent of a session being kept stays,
+// so continued conversations never lose their history, and so does a session
+// a batch, schedule run or pipeline step still points at.
+func (s *PostgresStore) expiredArchivedSessions(ctx context.Context, cutoff time.Time) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id FROM sessions
+		WHERE archived AND COALESCE(last_activity_at, created_at) < ?
+	`, cutoff.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archived sessions: %w", err)
+	}
+	expired := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		expired[id] = true
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(expired) == 0 {
+		return nil, nil
+	}
+
+	// Batch, schedule and pipeline records keep the sessions they launched
+	for _, table := range sessionReferenceTables {
+		rows, err = s.db.QueryContext(ctx, fmt.Sprintf(`
+			SELECT DISTINCT session_id FROM %s WHERE session_id IS NOT NULL
+		`, table))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s sessions: %w", table, err)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				_ = rows.Close()
+				return nil, fmt.Errorf("failed to scan %s session: %w", table, err)
+			}
+			delete(expired, id)
+		}
+		_ = rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err = s.db.QueryContext(ctx, `
+		SELECT id, parent_session_id FROM sessions
+		WHERE parent_session_id IS NOT NULL AND parent_session_id != ''
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session parents: %w", err)
+	}
+	parents := make(map[string]string)
+	for rows.Next() {
+		var id, parentID string
+		if err := rows.Scan(&id, &parentID); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan session parent: %w", err)
+		}
+		parents[id] = parentID
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Keeping a session keeps its parent, which may in turn keep its own parent
+	for changed := true; changed; {
+		changed = false
+		for id, parentID := range parents {
+			if !expired[id] && expired[parentID] {
+				delete(expired, parentID)
+				changed = true
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(expired))
+	for id := range expired {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// deleteSessions deletes the sessions matching the placeholders and their data
+// in one transaction
+func (s *PostgresStore) deleteSessions(ctx context.Context, marks string, args []interface{}) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, table := range sessionDataTables {
+		query := fmt.Sprintf(`DELETE FROM %s WHERE session_id IN (%s)`, table, marks)
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return 0, fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+	}
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM sessions WHERE id IN (%s)`, marks), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit session deletion: %w", err)
+	}
+	return deleted, nil
+}
+
+// vacuum runs the requested vacuum and reports the one that ran. A plain
+// VACUUM makes dead rows reusable, which is the closest PostgreSQL has to an
+// incremental vacuum; VACUUM FULL rewrites every table and returns the space
+// to the operating system.
+func (s *PostgresStore) vacuum(ctx context.Context, mode string) (string, error) {
+	var statement string
+	switch mode {
+	case VacuumFull:
+		statement = "VACUUM FULL"
+	case VacuumIncremental:
+		statement = "VACUUM"
+	default:
+		return VacuumNone, nil
+	}
+	if _, err := s.db.ExecContext(ctx, statement); err != nil {
+		return VacuumNone, fmt.Errorf("failed to vacuum database: %w", err)
+	}
+	return mode, nil
+}
+
+// autoVacuumMode reports whether the autovacuum daemon is on, as incremental,
+// or none
+func (s *PostgresStore) autoVacuumMode(ctx context.Context) (string, error) {
+	var setting string
+	if err := s.db.QueryRowContext(ctx, "SHOW autovacuum").Scan(&setting); err != nil {
+		return "", fmt.Errorf("failed to read autovacuum: %w", err)
+	}
+	if setting == "on" {
+		return VacuumIncremental, nil
+	}
+	return VacuumNone, nil
+}
+
+// databaseSize returns the size of the database in bytes. PostgreSQL keeps
+// no count of free space comparable to SQLite's freelist.
+func (s *PostgresStore) databaseSize(ctx context.Context) (int64, error) {
+	var size int64
+	if err := s.db.QueryRowContext(ctx, "SELECT pg_database_size(current_database())").Scan(&size); err != nil {
+		return 0, fmt.Errorf("failed to read database size: %w", err)
+	}
+	return size, nil
+}
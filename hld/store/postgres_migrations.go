@@ -0,0 +1,129 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// postgresMigrationLock is the advisory lock key held while migrating, so
+// daemons starting against the same database migrate one at a time
+const postgresMigrationLock = 0x686c6400
+
+// NewPostgresMigrator returns a migrator for the PostgreSQL database db
+func NewPostgresMigrator(db *sql.DB) *Migrator {
+	return newMigrator(db, postgresMigrationDialect{}, postgresMigrations)
+}
+
+// OpenPostgresMigrator connects to the database at databaseURL for migrating
+// it without opening a store
+func OpenPostgresMigrator(ctx context.Context, databaseURL string) (*Migrator, error) {
+	db, err := sql.Open("pgx", databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return NewPostgresMigrator(db), nil
+}
+
+// latestPostgresSchemaVersion is the version the last Postgres migration brings a database to
+func latestPostgresSchemaVersion() int {
+	return postgresMigrations[len(postgresMigrations)-1].Version
+}
+
+// applyMigrations brings the database to the latest schema. It refuses
+// databases migrated by a newer build.
+func (s *PostgresStore) applyMigrations(ctx context.Context) error {
+	_, err := NewPostgresMigrator(s.db.DB).Migrate(ctx, latestPostgresSchemaVersion())
+	return err
+}
+
+// postgresMigrationDialect migrates PostgreSQL databases under a session
+// level advisory lock
+type postgresMigrationDialect struct{}
+
+func (postgresMigrationDialect) bind(query string) string {
+	return rebind(query)
+}
+
+func (postgresMigrationDialect) hasColumn(ctx context.Context, q rowQuerier, table, column string) (bool, error) {
+	var count int
+	err := q.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM information_schema.columns
+		WHERE table_schema = current_schema() AND table_name = $1 AND column_name = $2
+	`, table, column).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for %s.%s: %w", table, column, err)
+	}
+	return count > 0, nil
+}
+
+func (postgresMigrationDialect) prepare(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_version (
+			version INTEGER PRIMARY KEY,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			description TEXT
+		);
+		ALTER TABLE schema_version ADD COLUMN IF NOT EXISTS checksum TEXT;
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_version table: %w", err)
+	}
+	return nil
+}
+
+// lock waits for the advisory lock for as long as the context allows. Session
+// level advisory locks belong to the connection, so the lock is taken and
+// released on the one used for migrating.
+func (postgresMigrationDialect) lock(ctx context.Context, conn *sql.Conn, timeout time.Duration) (func(), error) {
+	lockCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if _, err := conn.ExecContext(lockCtx, "SELECT pg_advisory_lock($1)", postgresMigrationLock); err != nil {
+		if lockCtx.Err() == context.DeadlineExceeded {
+			return nil, ErrMigrationLocked
+		}
+		return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	return func() {
+		_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", postgresMigrationLock)
+	}, nil
+}
+
+// lockHolder reports the backend holding the advisory lock. Postgres does
+// not record when an advisory lock was taken, so the backend's start is used.
+func (postgresMigrationDialect) lockHolder(ctx context.Context, q rowQuerier) (*MigrationLock, error) {
+	var lock MigrationLock
+	var pid int
+	var addr sql.NullString
+	err := q.QueryRowContext(ctx, `
+		SELECT a.pid, host(a.client_addr), a.backend_start
+		FROM pg_locks l JOIN pg_stat_activity a ON a.pid = l.pid
+		WHERE l.locktype = 'advisory' AND l.granted
+			AND l.classid::bigint = $1::bigint >> 32 AND l.objid::bigint = $1::bigint & 4294967295 AND l.objsubid = 1
+		LIMIT 1
+	`, postgresMigrationLock).Scan(&pid, &addr, &lock.AcquiredAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migration lock: %w", err)
+	}
+	lock.Owner = fmt.Sprintf("backend pid %d", pid)
+	if addr.Valid {
+		lock.Owner += " from " + addr.String
+	}
+	return &lock, nil
+}
+
+// exec runs the script at once. Without arguments statements go through the
+// simple query protocol, which accepts several statements at once.
+func (postgresMigrationDialect) exec(ctx context.Context, tx *sql.Tx, _ int, script string) error {
+	_, err := tx.ExecContext(ctx, script)
+	return err
+}
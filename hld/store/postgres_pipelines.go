@@ -0,0 +1,175 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CreatePipelineRun creates a new pipeline run
+func (s *PostgresStore) CreatePipelineRun(ctx context.Context, run *PipelineRun) error {
+	now := time.Now().UTC()
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = now
+	}
+	if run.UpdatedAt.IsZero() {
+		run.UpdatedAt = now
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO pipeline_runs (
+			id, name, definition, working_dir, status, current_step,
+			error_message, created_at, updated_at, completed_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, run.ID, run.Name, run.Definition, run.WorkingDir, run.Status, run.CurrentStep,
+		run.ErrorMessage, run.CreatedAt.UTC(), run.UpdatedAt.UTC(), utcTimePtr(run.CompletedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create pipeline run: %w", err)
+	}
+	return nil
+}
+
+// GetPipelineRun retrieves a pipeline run by ID
+func (s *PostgresStore) GetPipelineRun(ctx context.Context, id string) (*PipelineRun, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+pipelineRunColumns+` FROM pipeline_runs WHERE id = ?`, id)
+	run, err := scanPipelineRun(row)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "pipeline run", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pipeline run: %w", err)
+	}
+	return run, nil
+}
+
+// ListPipelineRuns retrieves all pipeline runs, newest first
+func (s *PostgresStore) ListPipelineRuns(ctx context.Context) ([]*PipelineRun, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+pipelineRunColumns+` FROM pipeline_runs ORDER BY created_at DESC, id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipeline runs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var runs []*PipelineRun
+	for rows.Next() {
+		run, err := scanPipelineRun(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pipeline run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+// UpdatePipelineRun updates the specified pipeline run fields
+func (s *PostgresStore) UpdatePipelineRun(ctx context.Context, id string, updates PipelineRunUpdate) error {
+	setParts := []string{}
+	args := []interface{}{}
+
+	if updates.Status != nil {
+		setParts = append(setParts, "status = ?")
+		args = append(args, *updates.Status)
+	}
+	if updates.CurrentStep != nil {
+		setParts = append(setParts, "current_step = ?")
+		args = append(args, *updates.CurrentStep)
+	}
+	if updates.ErrorMessage != nil {
+		setParts = append(setParts, "error_message = ?")
+		args = append(args, *updates.ErrorMessage)
+	}
+	if updates.CompletedAt != nil {
+		setParts = append(setParts, "completed_at = ?")
+		args = append(args, updates.CompletedAt.UTC())
+	}
+
+	if len(setParts) == 0 {
+		return nil
+	}
+
+	setParts = append(setParts, "updated_at = ?")
+	args = append(args, time.Now().UTC())
+	args = append(args, id)
+
+	query := fmt.Sprintf("UPDATE pipeline_runs SET %s WHERE id = ?", strings.Join(setParts, ", "))
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update pipeline run: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "pipeline run", ID: id}
+	}
+	return nil
+}
+
+// SavePipelineStep inserts or replaces the state of a pipeline step
+func (s *PostgresStore) SavePipelineStep(ctx context.Context, step *PipelineStep) error {
+	var sessionID sql.NullString
+	if step.SessionID != "" {
+		sessionID = sql.NullString{String: step.SessionID, Valid: true}
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO pipeline_steps (
+			run_id, step_index, name, session_id, status, reason, started_at, completed_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (run_id, step_index) DO UPDATE SET
+			name = excluded.name,
+			session_id = excluded.session_id,
+			status = excluded.status,
+			reason = excluded.reason,
+			started_at = excluded.started_at,
+			completed_at = excluded.completed_at
+	`, step.RunID, step.StepIndex, step.Name, sessionID, step.Status, step.Reason,
+		utcTimePtr(step.StartedAt), utcTimePtr(step.CompletedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save pipeline step: %w", err)
+	}
+	return nil
+}
+
+// GetPipelineSteps retrieves the recorded steps of a pipeline run in order
+func (s *PostgresStore) GetPipelineSteps(ctx context.Context, runID string) ([]*PipelineStep, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT run_id, step_index, name, session_id, status, reason, started_at, completed_at
+		FROM pipeline_steps
+		WHERE run_id = ?
+		ORDER BY step_index
+	`, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pipeline steps: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var steps []*PipelineStep
+	for rows.Next() {
+		var step PipelineStep
+		var sessionID, reason sql.NullString
+		var startedAt, completedAt sql.NullTime
+
+		if err := rows.Scan(&step.RunID, &step.StepIndex, &step.Name, &sessionID, &step.Status,
+			&reason, &startedAt, &completedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan pipeline step: %w", err)
+		}
+
+		step.SessionID = sessionID.String
+		step.Reason = reason.String
+		if startedAt.Valid {
+			step.StartedAt = &startedAt.Time
+		}
+		if completedAt.Valid {
+			step.CompletedAt = &completedAt.Time
+		}
+		steps = append(steps, &step)
+	}
+	return steps, rows.Err()
+}
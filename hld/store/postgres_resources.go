@@ -0,0 +1,108 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RecordResourceSample stores a sample, updates the session's latest usage and
+// peaks, and trims the session's sample history
+func (s *PostgresStore) RecordResourceSample(ctx context.Context, sample *ResourceSample) error {
+	if sample.SampledAt.IsZero() {
+		sample.SampledAt = time.Now()
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE sessions SET
+			cpu_time_ms = ?, cpu_percent = ?, rss_bytes = ?, open_fds = ?,
+			peak_cpu_percent = GREATEST(peak_cpu_percent, ?),
+			peak_rss_bytes = GREATEST(peak_rss_bytes, ?),
+			peak_open_fds = GREATEST(peak_open_fds, ?),
+			resources_sampled_at = ?
+		WHERE id = ?
+	`, sample.CPUTimeMs, sample.CPUPercent, sample.RSSBytes, sample.OpenFDs,
+		sample.CPUPercent, sample.RSSBytes, sample.OpenFDs,
+		sample.SampledAt.UTC(), sample.SessionID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update session resource usage: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "session", ID: sample.SessionID}
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO session_resource_samples (
+			session_id, sampled_at, cpu_time_ms, cpu_percent, rss_bytes, open_fds, process_count
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`, sample.SessionID, sample.SampledAt.UTC(), sample.CPUTimeMs, sample.CPUPercent,
+		sample.RSSBytes, sample.OpenFDs, sample.ProcessCount,
+	).Scan(&sample.ID)
+	if err != nil {
+		return fmt.Errorf("failed to insert resource sample: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM session_resource_samples
+		WHERE session_id = ? AND id NOT IN (
+			SELECT id FROM session_resource_samples
+			WHERE session_id = ?
+			ORDER BY id DESC
+			LIMIT ?
+		)
+	`, sample.SessionID, sample.SessionID, maxResourceSamplesPerSession)
+	if err != nil {
+		return fmt.Errorf("failed to trim resource samples: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// GetResourceSamples returns a session's most recent samples, oldest first.
+// A limit of zero or less returns every stored sample.
+func (s *PostgresStore) GetResourceSamples(ctx context.Context, sessionID string, limit int) ([]*ResourceSample, error) {
+	if limit <= 0 {
+		limit = maxResourceSamplesPerSession
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, session_id, sampled_at, cpu_time_ms, cpu_percent, rss_bytes, open_fds, process_count
+		FROM (
+			SELECT * FROM session_resource_samples
+			WHERE session_id = ?
+			ORDER BY id DESC
+			LIMIT ?
+		) recent
+		ORDER BY id ASC
+	`, sessionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query resource samples: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var samples []*ResourceSample
+	for rows.Next() {
+		var sample ResourceSample
+		if err := rows.Scan(&sample.ID, &sample.SessionID, &sample.SampledAt, &sample.CPUTimeMs,
+			&sample.CPUPercent, &sample.RSSBytes, &sample.OpenFDs, &sample.ProcessCount); err != nil {
+			return nil, fmt.Errorf("failed to scan resource sample: %w", err)
+		}
+		samples = append(samples, &sample)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate resource samples: %w", err)
+	}
+	return samples, nil
+}
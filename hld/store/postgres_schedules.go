@@ -0,0 +1,227 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CreateSchedule creates a new schedule
+func (s *PostgresStore) CreateSchedule(ctx context.Context, schedule *Schedule) error {
+	now := time.Now().UTC()
+	if schedule.CreatedAt.IsZero() {
+		schedule.CreatedAt = now
+	}
+	if schedule.UpdatedAt.IsZero() {
+		schedule.UpdatedAt = now
+	}
+
+	launchConfig, err := s.secrets.sealJSON(schedule.LaunchConfig, proxyAPIKeyField)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO schedules (
+			id, name, cron_expr, timezone, working_dir, launch_config,
+			overlap_policy, catch_up_policy, enabled, next_run_at, last_run_at,
+			created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, schedule.ID, schedule.Name, schedule.CronExpr, schedule.Timezone, schedule.WorkingDir,
+		launchConfig, schedule.OverlapPolicy, schedule.CatchUpPolicy, schedule.Enabled,
+		utcTimePtr(schedule.NextRunAt), utcTimePtr(schedule.LastRunAt),
+		schedule.CreatedAt.UTC(), schedule.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+	return nil
+}
+
+// GetSchedule retrieves a schedule by ID
+func (s *PostgresStore) GetSchedule(ctx context.Context, id string) (*Schedule, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+scheduleColumns+` FROM schedules WHERE id = ?`, id)
+	schedule, err := scanSchedule(row, s.secrets)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "schedule", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// ListSchedules retrieves all schedules ordered by name
+func (s *PostgresStore) ListSchedules(ctx context.Context) ([]*Schedule, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+scheduleColumns+` FROM schedules ORDER BY name, created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var schedules []*Schedule
+	for rows.Next() {
+		schedule, err := scanSchedule(rows, s.secrets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schedule: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, rows.Err()
+}
+
+// UpdateSchedule updates the specified schedule fields
+func (s *PostgresStore) UpdateSchedule(ctx context.Context, id string, updates ScheduleUpdate) error {
+	setParts := []string{}
+	args := []interface{}{}
+
+	if updates.Name != nil {
+		setParts = append(setParts, "name = ?")
+		args = append(args, *updates.Name)
+	}
+	if updates.CronExpr != nil {
+		setParts = append(setParts, "cron_expr = ?")
+		args = append(args, *updates.CronExpr)
+	}
+	if updates.Timezone != nil {
+		setParts = append(setParts, "timezone = ?")
+		args = append(args, *updates.Timezone)
+	}
+	if updates.WorkingDir != nil {
+		setParts = append(setParts, "working_dir = ?")
+		args = append(args, *updates.WorkingDir)
+	}
+	if updates.LaunchConfig != nil {
+		launchConfig, err := s.secrets.sealJSON(*updates.LaunchConfig, proxyAPIKeyField)
+		if err != nil {
+			return err
+		}
+		setParts = append(setParts, "launch_config = ?")
+		args = append(args, launchConfig)
+	}
+	if updates.OverlapPolicy != nil {
+		setParts = append(setParts, "overlap_policy = ?")
+		args = append(args, *updates.OverlapPolicy)
+	}
+	if updates.CatchUpPolicy != nil {
+		setParts = append(setParts, "catch_up_policy = ?")
+		args = append(args, *updates.CatchUpPolicy)
+	}
+	if updates.Enabled != nil {
+		setParts = append(setParts, "enabled = ?")
+		args = append(args, *updates.Enabled)
+	}
+	if updates.NextRunAt != nil {
+		setParts = append(setParts, "next_run_at = ?")
+		args = append(args, updates.NextRunAt.UTC())
+	}
+	if updates.LastRunAt != nil {
+		setParts = append(setParts, "last_run_at = ?")
+		args = append(args, updates.LastRunAt.UTC())
+	}
+
+	if len(setParts) == 0 {
+		return nil
+	}
+
+	setParts = append(setParts, "updated_at = ?")
+	args = append(args, time.Now().UTC())
+	args = append(args, id)
+
+	query := fmt.Sprintf("UPDATE schedules SET %s WHERE id = ?", strings.Join(setParts, ", "))
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "schedule", ID: id}
+	}
+	return nil
+}
+
+// DeleteSchedule deletes a schedule and, through the cascade, its run
+// history. Sessions launched by the schedule are kept.
+func (s *PostgresStore) DeleteSchedule(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM schedules WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "schedule", ID: id}
+	}
+	return nil
+}
+
+// CreateScheduleRun records a schedule firing
+func (s *PostgresStore) CreateScheduleRun(ctx context.Context, run *ScheduleRun) error {
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = time.Now().UTC()
+	}
+
+	var sessionID sql.NullString
+	if run.SessionID != "" {
+		sessionID = sql.NullString{String: run.SessionID, Valid: true}
+	}
+
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO schedule_runs (schedule_id, session_id, scheduled_for, status, reason, catch_up, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`, run.ScheduleID, sessionID, run.ScheduledFor.UTC(), run.Status, run.Reason, run.CatchUp, run.CreatedAt.UTC()).Scan(&run.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create schedule run: %w", err)
+	}
+	return nil
+}
+
+// ListScheduleRuns retrieves the most recent runs of a schedule, newest first.
+// A limit of 0 or less returns all runs.
+func (s *PostgresStore) ListScheduleRuns(ctx context.Context, scheduleID string, limit int) ([]*ScheduleRun, error) {
+	query := `
+		SELECT r.id, r.schedule_id, r.session_id, r.scheduled_for, r.status, r.reason,
+			r.catch_up, r.created_at, s.status
+		FROM schedule_runs r
+		LEFT JOIN sessions s ON s.id = r.session_id
+		WHERE r.schedule_id = ?
+		ORDER BY r.scheduled_for DESC, r.id DESC
+	`
+	args := []interface{}{scheduleID}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedule runs: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var runs []*ScheduleRun
+	for rows.Next() {
+		var run ScheduleRun
+		var sessionID, reason, sessionStatus sql.NullString
+		if err := rows.Scan(
+			&run.ID, &run.ScheduleID, &sessionID, &run.ScheduledFor, &run.Status, &reason,
+			&run.CatchUp, &run.CreatedAt, &sessionStatus,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan schedule run: %w", err)
+		}
+		run.SessionID = sessionID.String
+		run.Reason = reason.String
+		run.SessionStatus = sessionStatus.String
+		runs = append(runs, &run)
+	}
+	return runs, rows.Err()
+}
@@ -0,0 +1,179 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// searchSource returns the conditions selecting the conversation_events rows,
+// aliased e, that match search. PostgreSQL search is substring matching with
+// ILIKE, the same as SQLite builds without FTS5.
+func (s *PostgresStore) searchSource(search ConversationSearch) ([]string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	for _, term := range searchTerms(search.Query) {
+		conditions = append(conditions, `(e.content ILIKE ? ESCAPE '\'
+			OR e.tool_input_json ILIKE ? ESCAPE '\'
+			OR e.tool_result_content ILIKE ? ESCAPE '\')`)
+		pattern := "%" + escapeLike(term) + "%"
+		args = append(args, pattern, pattern, pattern)
+	}
+
+	if len(search.Roles) > 0 {
+		conditions = append(conditions, "e.role IN ("+placeholders(len(search.Roles))+")")
+		for _, role := range search.Roles {
+			args = append(args, role)
+		}
+	}
+	if len(search.EventTypes) > 0 {
+		conditions = append(conditions, "e.event_type IN ("+placeholders(len(search.EventTypes))+")")
+		for _, eventType := range search.EventTypes {
+			args = append(args, eventType)
+		}
+	}
+	if len(search.ToolNames) > 0 {
+		conditions = append(conditions, searchToolNameExpr+" IN ("+placeholders(len(search.ToolNames))+")")
+		for _, name := range search.ToolNames {
+			args = append(args, name)
+		}
+	}
+	if search.SessionID != "" {
+		conditions = append(conditions, "e.session_id = ?")
+		args = append(args, search.SessionID)
+	}
+	if search.ProjectID != "" {
+		conditions = append(conditions, "e.session_id IN (SELECT id FROM sessions WHERE project_id = ?)")
+		args = append(args, search.ProjectID)
+	}
+	if search.After != nil {
+		conditions = append(conditions, "e.created_at >= ?")
+		args = append(args, search.After.UTC())
+	}
+	if search.Before != nil {
+		conditions = append(conditions, "e.created_at < ?")
+		args = append(args, search.Before.UTC())
+	}
+
+	return conditions, args
+}
+
+// SearchConversations finds the sessions whose conversations match search,
+// ordered by their most recent matching event
+func (s *PostgresStore) SearchConversations(ctx context.Context, search ConversationSearch) ([]*ConversationSearchResult, error) {
+	if err := search.Validate(); err != nil {
+		return nil, err
+	}
+	if search.Limit <= 0 {
+		search.Limit = defaultSearchLimit
+	}
+	if search.Limit > maxSearchLimit {
+		search.Limit = maxSearchLimit
+	}
+	if search.MatchesPerSession <= 0 {
+		search.MatchesPerSession = defaultSearchMatchesPerSession
+	}
+	if search.MatchesPerSession > maxSearchMatchesPerSession {
+		search.MatchesPerSession = maxSearchMatchesPerSession
+	}
+
+	conditions, args := s.searchSource(search)
+
+	// Event IDs increase with insertion, so the highest matching ID is the
+	// session's most recent match
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT e.session_id FROM conversation_events e
+		WHERE `+strings.Join(conditions, " AND ")+`
+		GROUP BY e.session_id
+		ORDER BY MAX(e.id) DESC
+		LIMIT ?
+	`, append(args, search.Limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search conversations: %w", err)
+	}
+	var sessionIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan search result: %w", err)
+		}
+		sessionIDs = append(sessionIDs, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search conversations: %w", err)
+	}
+	if len(sessionIDs) == 0 {
+		return []*ConversationSearchResult{}, nil
+	}
+
+	matches, err := s.searchMatches(ctx, search, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*ConversationSearchResult, 0, len(sessionIDs))
+	for _, id := range sessionIDs {
+		session, err := s.GetSession(ctx, id)
+		if err != nil {
+			// Events can outlive a hard-deleted session
+			if errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		results = append(results, &ConversationSearchResult{Session: session, Matches: matches[id]})
+	}
+	return results, nil
+}
+
+// searchMatches loads the most recent matching events of each session
+func (s *PostgresStore) searchMatches(ctx context.Context, search ConversationSearch, sessionIDs []string) (map[string][]ConversationMatch, error) {
+	conditions, args := s.searchSource(search)
+	conditions = append(conditions, "e.session_id IN ("+placeholders(len(sessionIDs))+")")
+	for _, id := range sessionIDs {
+		args = append(args, id)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, session_id, claude_session_id, sequence, event_type, role, tool_name, created_at,
+			content, tool_input_json, tool_result_content
+		FROM (
+			SELECT e.id, e.session_id, COALESCE(e.claude_session_id, '') AS claude_session_id, e.sequence,
+				e.event_type, COALESCE(e.role, '') AS role, `+searchToolNameExpr+` AS tool_name, e.created_at,
+				e.content, e.tool_input_json, e.tool_result_content,
+				ROW_NUMBER() OVER (PARTITION BY e.session_id ORDER BY e.id DESC) AS match_number
+			FROM conversation_events e
+			WHERE `+strings.Join(conditions, " AND ")+`
+		) ranked
+		WHERE match_number <= ?
+		ORDER BY session_id, id DESC
+	`, append(args, search.MatchesPerSession)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load search matches: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	terms := searchTerms(search.Query)
+	matches := make(map[string][]ConversationMatch)
+	for rows.Next() {
+		var sessionID string
+		var match ConversationMatch
+		var content, toolInput, toolResult sql.NullString
+		err := rows.Scan(&match.EventID, &sessionID, &match.ClaudeSessionID, &match.Sequence,
+			&match.EventType, &match.Role, &match.ToolName, &match.CreatedAt, &content, &toolInput, &toolResult)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search match: %w", err)
+		}
+		match.Snippet, match.Highlights = buildSnippet(terms, content.String, toolInput.String, toolResult.String)
+		matches[sessionID] = append(matches[sessionID], match)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load search matches: %w", err)
+	}
+	return matches, nil
+}
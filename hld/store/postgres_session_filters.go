@@ -0,0 +1,262 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// postgresSessionKindCondition returns the SQL condition selecting sessions of kind
+func postgresSessionKindCondition(kind string) string {
+	switch kind {
+	case SessionKindNormal:
+		return "NOT archived AND status NOT IN ('draft', 'discarded')"
+	case SessionKindArchived:
+		return "archived"
+	case SessionKindDraft:
+		return "NOT archived AND status = 'draft'"
+	}
+	return ""
+}
+
+// postgresSessionFilterConditions builds the WHERE conditions for filter
+// against the sessions table
+func postgresSessionFilterConditions(filter SessionFilter) ([]string, []interface{}) {
+	var conditions []string
+	var args []interface{}
+
+	if condition := postgresSessionKindCondition(filter.Kind); condition != "" {
+		conditions = append(conditions, condition)
+	}
+	if len(filter.Statuses) > 0 {
+		conditions = append(conditions, "status IN ("+placeholders(len(filter.Statuses))+")")
+		for _, status := range filter.Statuses {
+			args = append(args, status)
+		}
+	}
+	for _, label := range filter.Labels {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM session_labels sl JOIN labels l ON l.id = sl.label_id
+			WHERE sl.session_id = sessions.id AND lower(l.name) = lower(?)
+		)`)
+		args = append(args, label)
+	}
+	if filter.ProjectID != "" {
+		conditions = append(conditions, "project_id = ?")
+		args = append(args, filter.ProjectID)
+	}
+	if len(filter.Models) > 0 {
+		marks := placeholders(len(filter.Models))
+		conditions = append(conditions, "(model IN ("+marks+") OR model_id IN ("+marks+"))")
+		for range 2 {
+			for _, model := range filter.Models {
+				args = append(args, model)
+			}
+		}
+	}
+	if filter.CreatedAfter != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, filter.CreatedAfter.UTC())
+	}
+	if filter.CreatedBefore != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, filter.CreatedBefore.UTC())
+	}
+	if filter.MinCostUSD != nil {
+		conditions = append(conditions, "COALESCE(cost_usd, 0) >= ?")
+		args = append(args, *filter.MinCostUSD)
+	}
+	if filter.MaxCostUSD != nil {
+		conditions = append(conditions, "COALESCE(cost_usd, 0) <= ?")
+		args = append(args, *filter.MaxCostUSD)
+	}
+	if filter.LeavesOnly {
+		conditions = append(conditions, "NOT EXISTS (SELECT 1 FROM sessions child WHERE child.parent_session_id = sessions.id)")
+	}
+	if filter.After != nil {
+		after := filter.After.LastActivityAt.UTC()
+		conditions = append(conditions, "(last_activity_at < ? OR (last_activity_at = ? AND id < ?))")
+		args = append(args, after, after, filter.After.ID)
+	}
+
+	return conditions, args
+}
+
+// postgresSessionFilterWhere returns the WHERE clause for filter, empty if it matches everything
+func postgresSessionFilterWhere(filter SessionFilter) (string, []interface{}) {
+	conditions, args := postgresSessionFilterConditions(filter)
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// attachSessionLabels loads the labels of the sessions selected by where
+func (s *PostgresStore) attachSessionLabels(ctx context.Context, sessions []*Session, where string, args []interface{}) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT sl.session_id, l.name
+		FROM session_labels sl JOIN labels l ON l.id = sl.label_id
+		WHERE sl.session_id IN (SELECT id FROM sessions `+where+`)
+		ORDER BY lower(l.name)
+	`, args...)
+	if err != nil {
+		return fmt.Errorf("failed to load session labels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	labels := make(map[string][]string)
+	for rows.Next() {
+		var sessionID, name string
+		if err := rows.Scan(&sessionID, &name); err != nil {
+			return fmt.Errorf("failed to scan session label: %w", err)
+		}
+		labels[sessionID] = append(labels[sessionID], name)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to load session labels: %w", err)
+	}
+
+	for _, session := range sessions {
+		session.Labels = labels[session.ID]
+	}
+	return nil
+}
+
+// CountSessionsByKind counts the sessions matching filter for each session
+// kind. The filter's own kind is ignored.
+func (s *PostgresStore) CountSessionsByKind(ctx context.Context, filter SessionFilter) (*SessionCounts, error) {
+	filter.Kind = ""
+	filter.After = nil
+	filter.Limit = 0
+	where, args := postgresSessionFilterWhere(filter)
+
+	var counts SessionCounts
+	err := s.db.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT
+			COUNT(*) FILTER (WHERE %s),
+			COUNT(*) FILTER (WHERE %s),
+			COUNT(*) FILTER (WHERE %s)
+		FROM sessions %s
+	`, postgresSessionKindCondition(SessionKindNormal), postgresSessionKindCondition(SessionKindArchived),
+		postgresSessionKindCondition(SessionKindDraft), where), args...).Scan(&counts.Normal, &counts.Archived, &counts.Draft)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count sessions: %w", err)
+	}
+	return &counts, nil
+}
+
+// CreateSavedFilter stores a new named session filter
+func (s *PostgresStore) CreateSavedFilter(ctx context.Context, filter *SavedFilter) error {
+	now := time.Now().UTC()
+	if filter.CreatedAt.IsZero() {
+		filter.CreatedAt = now
+	}
+	if filter.UpdatedAt.IsZero() {
+		filter.UpdatedAt = now
+	}
+
+	encoded, err := json.Marshal(filter.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to encode saved filter: %w", err)
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO saved_filters (id, name, filter, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, filter.ID, filter.Name, string(encoded), filter.CreatedAt.UTC(), filter.UpdatedAt.UTC())
+	if isPostgresUniqueViolation(err) {
+		return &AlreadyExistsError{Type: "saved filter", Name: filter.Name}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create saved filter: %w", err)
+	}
+	return nil
+}
+
+// GetSavedFilter retrieves a saved filter by ID
+func (s *PostgresStore) GetSavedFilter(ctx context.Context, id string) (*SavedFilter, error) {
+	row := s.db.QueryRowContext(ctx, `
+		SELECT id, name, filter, created_at, updated_at FROM saved_filters WHERE id = ?
+	`, id)
+	filter, err := scanSavedFilter(row)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "saved filter", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get saved filter: %w", err)
+	}
+	return filter, nil
+}
+
+// ListSavedFilters retrieves all saved filters ordered by name
+func (s *PostgresStore) ListSavedFilters(ctx context.Context) ([]*SavedFilter, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, name, filter, created_at, updated_at FROM saved_filters ORDER BY name
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved filters: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var filters []*SavedFilter
+	for rows.Next() {
+		filter, err := scanSavedFilter(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved filter: %w", err)
+		}
+		filters = append(filters, filter)
+	}
+	return filters, rows.Err()
+}
+
+// UpdateSavedFilter replaces a saved filter's name and criteria
+func (s *PostgresStore) UpdateSavedFilter(ctx context.Context, filter *SavedFilter) error {
+	encoded, err := json.Marshal(filter.Filter)
+	if err != nil {
+		return fmt.Errorf("failed to encode saved filter: %w", err)
+	}
+
+	filter.UpdatedAt = time.Now().UTC()
+	result, err := s.db.ExecContext(ctx, `
+		UPDATE saved_filters SET name = ?, filter = ?, updated_at = ? WHERE id = ?
+	`, filter.Name, string(encoded), filter.UpdatedAt, filter.ID)
+	if isPostgresUniqueViolation(err) {
+		return &AlreadyExistsError{Type: "saved filter", Name: filter.Name}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update saved filter: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "saved filter", ID: filter.ID}
+	}
+	return nil
+}
+
+// DeleteSavedFilter removes a saved filter
+func (s *PostgresStore) DeleteSavedFilter(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM saved_filters WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved filter: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "saved filter", ID: id}
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+package store
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetSessionRecords reads every row stored for a session. Raw events are
+// only read when requested since they dwarf the rest.
+func (s *PostgresStore) GetSessionRecords(ctx context.Context, sessionID string, includeRawEvents bool) (*SessionRecords, error) {
+	session, err := s.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	records := &SessionRecords{Session: session}
+
+	if records.Labels, err = s.GetSessionLabels(ctx, sessionID); err != nil {
+		return nil, err
+	}
+	if records.Events, err = s.queryEvents(ctx, `
+		SELECT `+conversationEventColumns+` FROM conversation_events WHERE session_id = ? ORDER BY id
+	`, sessionID); err != nil {
+		return nil, fmt.Errorf("failed to get conversation events: %w", err)
+	}
+	approvals, err := s.queryApprovals(ctx, `
+		SELECT `+approvalColumns+` FROM approvals WHERE session_id = ? ORDER BY created_at ASC
+	`, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get approvals: %w", err)
+	}
+	records.Approvals = append([]*Approval{}, approvals...)
+	if records.FileSnapshots, err = s.GetFileSnapshots(ctx, sessionID); err != nil {
+		return nil, fmt.Errorf("failed to get file snapshots: %w", err)
+	}
+	if records.MCPServers, err = s.GetMCPServers(ctx, sessionID); err != nil {
+		return nil, err
+	}
+	if includeRawEvents {
+		if records.RawEvents, err = s.sessionRawEvents(ctx, sessionID); err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+// sessionRawEvents returns a session's raw stream events in arrival order
+func (s *PostgresStore) sessionRawEvents(ctx context.Context, sessionID string) ([]RawEvent, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, session_id, event_json, created_at
+		FROM raw_events
+		WHERE session_id = ?
+		ORDER BY id
+	`, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get raw events: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	events := []RawEvent{}
+	for rows.Next() {
+		var event RawEvent
+		if err := rows.Scan(&event.ID, &event.SessionID, &event.EventJSON, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan raw event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate raw events: %w", err)
+	}
+	return events, nil
+}
+
+// ImportSessionRecords inserts the records of several sessions in one
+// transaction, keeping their IDs and timestamps. Parents must come before the
+// sessions that continue from them. Row IDs of events, snapshots, MCP servers
+// and raw events are assigned anew.
+func (s *PostgresStore) ImportSessionRecords(ctx context.Context, records []*SessionRecords) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, r := range records {
+		if err := postgresImportSession(ctx, tx, r, s.secrets); err != nil {
+			return fmt.Errorf("failed to import session %s: %w", r.Session.ID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit imported sessions: %w", err)
+	}
+	return nil
+}
+
+func postgresImportSession(ctx context.Context, tx pgTx, r *SessionRecords, secrets secretCodec) error {
+	session := r.Session
+	proxyAPIKey, err := secrets.seal(session.ProxyAPIKey)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO sessions (
+			id, run_id, claude_session_id, parent_session_id,
+			query, summary, title, model, model_id, working_dir, max_turns, system_prompt, append_system_prompt, custom_instructions,
+			permission_prompt_tool, allowed_tools, disallowed_tools,
+			status, created_at, last_activity_at, completed_at,
+			cost_usd, input_tokens, output_tokens, cache_creation_input_tokens, cache_read_input_tokens, effective_context_tokens,
+			duration_ms, num_turns, result_content, error_message, auto_accept_edits, archived,
+			dangerously_skip_permissions, dangerously_skip_permissions_expires_at, dangerously_skip_permissions_timeout_ms,
+			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state,
+			stall_threshold_ms, stall_interrupt_threshold_ms,
+			cpu_time_ms, cpu_percent, rss_bytes, open_fds, peak_cpu_percent, peak_rss_bytes, peak_open_fds, resources_sampled_at,
+			runner, project_id
+		) VALUES (`+placeholders(53)+`, NULLIF(?, ''))
+	`,
+		session.ID, session.RunID, session.ClaudeSessionID, session.ParentSessionID,
+		session.Query, session.Summary, session.Title, session.Model, session.ModelID, session.WorkingDir, session.MaxTurns,
+		session.SystemPrompt, session.AppendSystemPrompt, session.CustomInstructions,
+		session.PermissionPromptTool, session.AllowedTools, session.DisallowedTools,
+		session.Status, session.CreatedAt, session.LastActivityAt, session.CompletedAt,
+		session.CostUSD, session.InputTokens, session.OutputTokens, session.CacheCreationInputTokens,
+		session.CacheReadInputTokens, session.EffectiveContextTokens,
+		session.DurationMS, session.NumTurns, session.ResultContent, session.ErrorMessage,
+		session.AutoAcceptEdits, session.Archived,
+		session.DangerouslySkipPermissions, session.DangerouslySkipPermissionsExpiresAt,
+		session.DangerouslySkipPermissionsTimeoutMs,
+		session.ProxyEnabled, session.ProxyBaseURL, session.ProxyModelOverride, proxyAPIKey,
+		session.AdditionalDirectories, session.EditorState,
+		session.StallThresholdMs, session.StallInterruptThresholdMs,
+		session.CPUTimeMs, session.CPUPercent, session.RSSBytes, session.OpenFDs,
+		session.PeakCPUPercent, session.PeakRSSBytes, session.PeakOpenFDs, session.ResourcesSampledAt,
+		session.Runner, session.ProjectID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert session: %w", err)
+	}
+
+	if err := postgresAddSessionLabels(ctx, tx, session.ID, r.Labels); err != nil {
+		return err
+	}
+
+	for _, event := range r.Events {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO conversation_events (
+				session_id, claude_session_id, sequence, event_type, created_at,
+				role, content,
+				tool_id, tool_name, tool_input_json, parent_tool_use_id,
+				tool_result_for_id, tool_result_content,
+				is_completed, approval_status, approval_id,
+				compaction_trigger, pre_compaction_tokens, post_compaction_tokens
+			) VALUES (`+placeholders(19)+`)
+		`,
+			event.SessionID, event.ClaudeSessionID, event.Sequence, event.EventType, event.CreatedAt.UTC(),
+			event.Role, event.Content,
+			event.ToolID, event.ToolName, event.ToolInputJSON, event.ParentToolUseID,
+			event.ToolResultForID, event.ToolResultContent,
+			event.IsCompleted, event.ApprovalStatus, event.ApprovalID,
+			event.CompactionTrigger, event.PreCompactionTokens, event.PostCompactionTokens,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert conversation event: %w", err)
+		}
+	}
+
+	for _, approval := range r.Approvals {
+		if !approval.Status.IsValid() {
+			return fmt.Errorf("invalid approval status: %s", approval.Status)
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO approvals (
+				id, run_id, session_id, tool_use_id, status, created_at, responded_at,
+				tool_name, tool_input, comment
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`,
+			approval.ID, approval.RunID, approval.SessionID, approval.ToolUseID, approval.Status.String(),
+			approval.CreatedAt, approval.RespondedAt,
+			approval.ToolName, string(approval.ToolInput), approval.Comment,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to insert approval: %w", err)
+		}
+	}
+
+	for _, snapshot := range r.FileSnapshots {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO file_snapshots (tool_id, session_id, file_path, content, created_at)
+			VALUES (?, ?, ?, ?, ?)
+		`, snapshot.ToolID, snapshot.SessionID, snapshot.FilePath, snapshot.Content, snapshot.CreatedAt.UTC())
+		if err != nil {
+			return fmt.Errorf("failed to insert file snapshot: %w", err)
+		}
+	}
+
+	for _, server := range r.MCPServers {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO mcp_servers (session_id, name, command, args_json, env_json)
+			VALUES (?, ?, ?, ?, ?)
+		`, server.SessionID, server.Name, server.Command, server.ArgsJSON, server.EnvJSON)
+		if err != nil {
+			return fmt.Errorf("failed to insert MCP server: %w", err)
+		}
+	}
+
+	for _, event := range r.RawEvents {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO raw_events (session_id, event_json, created_at) VALUES (?, ?, ?)
+		`, event.SessionID, event.EventJSON, event.CreatedAt.UTC())
+		if err != nil {
+			return fmt.Errorf("failed to insert raw event: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPostgresStore opens a store in a fresh schema of the database named
+// by HUMANLAYER_TEST_POSTGRES_URL and drops the schema afterwards. Tests are
+// skipped when the variable is unset.
+func newTestPostgresStore(t *testing.T) *PostgresStore {
+	t.Helper()
+	databaseURL := os.Getenv("HUMANLAYER_TEST_POSTGRES_URL")
+	if databaseURL == "" {
+		t.Skip("HUMANLAYER_TEST_POSTGRES_URL not set")
+	}
+
+	admin, err := sql.Open("pgx", databaseURL)
+	require.NoError(t, err)
+	defer func() { _ = admin.Close() }()
+
+	schema := "hld_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	_, err = admin.Exec("CREATE SCHEMA " + schema)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		cleanup, err := sql.Open("pgx", databaseURL)
+		if err != nil {
+			return
+		}
+		defer func() { _ = cleanup.Close() }()
+		_, _ = cleanup.Exec("DROP SCHEMA " + schema + " CASCADE")
+	})
+
+	u, err := url.Parse(databaseURL)
+	require.NoError(t, err)
+	query := u.Query()
+	query.Set("search_path", schema)
+	u.RawQuery = query.Encode()
+
+	s, err := NewPostgresStore(u.String())
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+func TestPostgresRefusesNewerSchema(t *testing.T) {
+	s := newTestPostgresStore(t)
+	ctx := context.Background()
+
+	_, err := s.db.ExecContext(ctx, `INSERT INTO schema_version (version, description) VALUES (?, ?)`,
+		latestPostgresSchemaVersion()+1, "from a newer build")
+	require.NoError(t, err)
+
+	err = s.applyMigrations(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "newer than this build supports")
+}
+
+func TestRebind(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"SELECT 1", "SELECT 1"},
+		{"SELECT * FROM t WHERE a = ? AND b = ?", "SELECT * FROM t WHERE a = $1 AND b = $2"},
+		{"UPDATE t SET a = '?' WHERE b = ?", "UPDATE t SET a = '?' WHERE b = $1"},
+		{`SELECT * FROM t WHERE a LIKE ? ESCAPE '\' AND b = ?`, `SELECT * FROM t WHERE a LIKE $1 ESCAPE '\' AND b = $2`},
+		{"SELECT 'it''s ?', ?", "SELECT 'it''s ?', $1"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, rebind(tt.query), tt.query)
+	}
+}
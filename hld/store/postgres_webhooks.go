@@ -0,0 +1,212 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CreateWebhook creates a new webhook
+func (s *PostgresStore) CreateWebhook(ctx context.Context, webhook *Webhook) error {
+	now := time.Now().UTC()
+	if webhook.CreatedAt.IsZero() {
+		webhook.CreatedAt = now
+	}
+	if webhook.UpdatedAt.IsZero() {
+		webhook.UpdatedAt = now
+	}
+
+	secret, err := s.secrets.seal(webhook.Secret)
+	if err != nil {
+		return err
+	}
+	eventTypes, labels, err := encodeWebhookLists(webhook.EventTypes, webhook.Labels)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO webhooks (
+			id, name, url, secret, event_types, labels, working_dir, enabled,
+			created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, webhook.ID, webhook.Name, webhook.URL, secret, eventTypes, labels, webhook.WorkingDir,
+		webhook.Enabled, webhook.CreatedAt.UTC(), webhook.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return nil
+}
+
+// GetWebhook retrieves a webhook by ID
+func (s *PostgresStore) GetWebhook(ctx context.Context, id string) (*Webhook, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE id = ?`, id)
+	webhook, err := scanWebhook(row, s.secrets)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "webhook", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return webhook, nil
+}
+
+// ListWebhooks retrieves all webhooks ordered by name
+func (s *PostgresStore) ListWebhooks(ctx context.Context) ([]*Webhook, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+webhookColumns+` FROM webhooks ORDER BY name, created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var webhooks []*Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows, s.secrets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+// UpdateWebhook updates the specified webhook fields
+func (s *PostgresStore) UpdateWebhook(ctx context.Context, id string, updates WebhookUpdate) error {
+	setParts, args, err := webhookUpdateSet(updates, s.secrets)
+	if err != nil {
+		return err
+	}
+	if len(setParts) == 0 {
+		return nil
+	}
+
+	setParts = append(setParts, "updated_at = ?")
+	args = append(args, time.Now().UTC())
+	args = append(args, id)
+
+	query := fmt.Sprintf("UPDATE webhooks SET %s WHERE id = ?", strings.Join(setParts, ", "))
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "webhook", ID: id}
+	}
+	return nil
+}
+
+// DeleteWebhook deletes a webhook and, by cascade, its delivery log
+func (s *PostgresStore) DeleteWebhook(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM webhooks WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "webhook", ID: id}
+	}
+	return nil
+}
+
+// CreateWebhookDelivery records a delivery, setting its ID, and deletes all
+// but the newest keep finished deliveries of the webhook. A keep of zero or
+// less keeps every delivery.
+func (s *PostgresStore) CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery, keep int) error {
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = time.Now().UTC()
+	}
+
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO webhook_deliveries (
+			webhook_id, event_type, event_seq, payload, status, attempts, next_attempt_at,
+			response_status, error, created_at, delivered_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		RETURNING id
+	`, delivery.WebhookID, delivery.EventType, delivery.EventSeq, delivery.Payload, delivery.Status,
+		delivery.Attempts, utcTimePtr(delivery.NextAttemptAt), delivery.ResponseStatus, delivery.Error,
+		delivery.CreatedAt.UTC(), utcTimePtr(delivery.DeliveredAt),
+	).Scan(&delivery.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	if keep > 0 {
+		if _, err := s.db.ExecContext(ctx, trimWebhookDeliveriesQuery, delivery.WebhookID, delivery.WebhookID, keep); err != nil {
+			return fmt.Errorf("failed to trim webhook deliveries: %w", err)
+		}
+	}
+	return nil
+}
+
+// UpdateWebhookDelivery updates the specified delivery fields
+func (s *PostgresStore) UpdateWebhookDelivery(ctx context.Context, id int64, updates WebhookDeliveryUpdate) error {
+	setParts, args := webhookDeliveryUpdateSet(updates)
+	if len(setParts) == 0 {
+		return nil
+	}
+	args = append(args, id)
+
+	query := fmt.Sprintf("UPDATE webhook_deliveries SET %s WHERE id = ?", strings.Join(setParts, ", "))
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "webhook delivery", ID: fmt.Sprint(id)}
+	}
+	return nil
+}
+
+// ListWebhookDeliveries retrieves the most recent deliveries of a webhook,
+// newest first. A limit of 0 or less returns all deliveries.
+func (s *PostgresStore) ListWebhookDeliveries(ctx context.Context, webhookID string, limit int) ([]*WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries WHERE webhook_id = ? ORDER BY id DESC`
+	args := []interface{}{webhookID}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	return scanWebhookDeliveries(rows)
+}
+
+// GetDueWebhookDeliveries retrieves pending deliveries whose next attempt is
+// at or before now, oldest first. A limit of 0 or less returns all of them.
+func (s *PostgresStore) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= ?
+		ORDER BY next_attempt_at, id`
+	args := []interface{}{now.UTC()}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due webhook deliveries: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	return scanWebhookDeliveries(rows)
+}
@@ -0,0 +1,218 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// SecretBox encrypts the secrets a store keeps at rest. Open returns values
+// that were never sealed as they are. *secrets.Box implements it.
+type SecretBox interface {
+	KeyID() string
+	Seal(plaintext string) (string, error)
+	Open(value string) (string, error)
+	Current(value string) bool
+}
+
+// secretColumn is a column holding a secret, either the column itself or the
+// jsonField of the JSON document it holds
+type secretColumn struct {
+	table     string
+	column    string
+	jsonField string
+}
+
+// proxyAPIKeyField is the launch configuration field of template and schedule
+// configs holding the proxy API key
+const proxyAPIKeyField = "proxy_api_key"
+
+// secretColumns lists every secret the SQL stores keep
+var secretColumns = []secretColumn{
+	{table: "sessions", column: "proxy_api_key"},
+	{table: "session_templates", column: "config", jsonField: proxyAPIKeyField},
+	{table: "schedules", column: "launch_config", jsonField: proxyAPIKeyField},
+	{table: "webhooks", column: "secret"},
+}
+
+// secretCodec seals secrets on their way into the database and opens them on
+// their way out. Without a box secrets are stored as they are.
+type secretCodec struct {
+	box SecretBox
+}
+
+func (c secretCodec) seal(value string) (string, error) {
+	if c.box == nil || value == "" {
+		return value, nil
+	}
+	sealed, err := c.box.Seal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	return sealed, nil
+}
+
+// open returns the empty string for a secret that cannot be decrypted, such as
+// one sealed with a key that was lost, so one bad value does not fail whole
+// listings. Callers see the secret as not set.
+func (c secretCodec) open(value string) string {
+	if c.box == nil || value == "" {
+		return value
+	}
+	opened, err := c.box.Open(value)
+	if err != nil {
+		slog.Warn("failed to decrypt stored secret, treating it as not set", "error", err)
+		return ""
+	}
+	return opened
+}
+
+// sealJSON seals field of the JSON object doc
+func (c secretCodec) sealJSON(doc, field string) (string, error) {
+	return c.mapJSON(doc, field, c.seal)
+}
+
+// openJSON opens field of the JSON object doc
+func (c secretCodec) openJSON(doc, field string) string {
+	opened, _ := c.mapJSON(doc, field, func(value string) (string, error) { return c.open(value), nil })
+	return opened
+}
+
+// mapJSON replaces the string field of the JSON object doc with fn's result.
+// Documents that are not objects or lack the field are returned unchanged.
+func (c secretCodec) mapJSON(doc, field string, fn func(string) (string, error)) (string, error) {
+	if c.box == nil || doc == "" {
+		return doc, nil
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(doc), &object); err != nil {
+		return doc, nil
+	}
+	var value string
+	if raw, ok := object[field]; !ok || json.Unmarshal(raw, &value) != nil || value == "" {
+		return doc, nil
+	}
+	mapped, err := fn(value)
+	if err != nil {
+		return "", err
+	}
+	if mapped == value {
+		return doc, nil
+	}
+	if mapped == "" {
+		delete(object, field)
+	} else {
+		encoded, _ := json.Marshal(mapped)
+		object[field] = encoded
+	}
+	result, err := json.Marshal(object)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode %s: %w", field, err)
+	}
+	return string(result), nil
+}
+
+// secretQuerier is the part of *sql.DB and pgDB resealing needs
+type secretQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// resealSecretsOnce reseals the stored secrets unless that was already done
+// with the box's current key. Once a key has resealed everything, every secret
+// written since was sealed with it, so only a key change needs another scan.
+func resealSecretsOnce(ctx context.Context, db secretQuerier, box SecretBox) (int, error) {
+	var done int
+	err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM secret_reseals WHERE key_id = ?`, box.KeyID()).Scan(&done)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read secret reseals: %w", err)
+	}
+	if done > 0 {
+		return 0, nil
+	}
+
+	resealed, err := resealSecrets(ctx, db, box)
+	if err != nil {
+		return resealed, err
+	}
+	if _, err := db.ExecContext(ctx, `
+		INSERT INTO secret_reseals (key_id, completed_at) VALUES (?, ?)
+		ON CONFLICT (key_id) DO NOTHING
+	`, box.KeyID(), time.Now().UTC()); err != nil {
+		return resealed, fmt.Errorf("failed to record secret reseal: %w", err)
+	}
+	return resealed, nil
+}
+
+// resealSecrets seals every stored secret that is in plaintext or sealed with
+// a key other than the box's current one, and returns how many it sealed.
+// Each row is updated only if it still holds the value that was read, so
+// resealing is safe to run while the store is in use and to run again.
+func resealSecrets(ctx context.Context, db secretQuerier, box SecretBox) (int, error) {
+	codec := secretCodec{box: box}
+	resealed := 0
+	for _, sc := range secretColumns {
+		rows, err := db.QueryContext(ctx, fmt.Sprintf(
+			`SELECT id, %s FROM %s WHERE %s IS NOT NULL AND %s <> ''`, sc.column, sc.table, sc.column, sc.column))
+		if err != nil {
+			return resealed, fmt.Errorf("failed to read %s.%s: %w", sc.table, sc.column, err)
+		}
+		type pending struct{ id, old, new string }
+		var updates []pending
+		for rows.Next() {
+			var id, value string
+			if err := rows.Scan(&id, &value); err != nil {
+				_ = rows.Close()
+				return resealed, fmt.Errorf("failed to read %s.%s: %w", sc.table, sc.column, err)
+			}
+			sealed, err := resealValue(codec, value, sc.jsonField)
+			if err != nil {
+				slog.Warn("failed to encrypt stored secret", "table", sc.table, "id", id, "error", err)
+				continue
+			}
+			if sealed != value {
+				updates = append(updates, pending{id: id, old: value, new: sealed})
+			}
+		}
+		if err := rows.Err(); err != nil {
+			_ = rows.Close()
+			return resealed, fmt.Errorf("failed to read %s.%s: %w", sc.table, sc.column, err)
+		}
+		_ = rows.Close()
+
+		for _, u := range updates {
+			result, err := db.ExecContext(ctx, fmt.Sprintf(
+				`UPDATE %s SET %s = ? WHERE id = ? AND %s = ?`, sc.table, sc.column, sc.column), u.new, u.id, u.old)
+			if err != nil {
+				return resealed, fmt.Errorf("failed to encrypt %s.%s: %w", sc.table, sc.column, err)
+			}
+			if n, _ := result.RowsAffected(); n > 0 {
+				resealed++
+			}
+		}
+	}
+	return resealed, nil
+}
+
+// resealValue seals value, or its jsonField, with the current key unless it
+// already is. Secrets that cannot be decrypted are left alone.
+func resealValue(codec secretCodec, value, jsonField string) (string, error) {
+	reseal := func(secret string) (string, error) {
+		if codec.box.Current(secret) {
+			return secret, nil
+		}
+		plaintext, err := codec.box.Open(secret)
+		if err != nil {
+			return "", err
+		}
+		return codec.box.Seal(plaintext)
+	}
+	if jsonField == "" {
+		return reseal(value)
+	}
+	return codec.mapJSON(value, jsonField, reseal)
+}
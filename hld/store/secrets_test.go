@@ -0,0 +1,165 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storedSecrets returns the raw values of every secret column
+func storedSecrets(t *testing.T, s *SQLiteStore) []string {
+	t.Helper()
+	var values []string
+	for _, sc := range secretColumns {
+		rows, err := s.db.Query(`SELECT ` + sc.column + ` FROM ` + sc.table + ` WHERE ` + sc.column + ` <> ''`)
+		require.NoError(t, err)
+		for rows.Next() {
+			var value string
+			require.NoError(t, rows.Scan(&value))
+			values = append(values, value)
+		}
+		require.NoError(t, rows.Close())
+	}
+	return values
+}
+
+func newSecretBox(t *testing.T, keys ...secrets.Key) *secrets.Box {
+	t.Helper()
+	if len(keys) == 0 {
+		key, err := secrets.NewKey()
+		require.NoError(t, err)
+		keys = []secrets.Key{key}
+	}
+	box, err := secrets.NewBox(keys...)
+	require.NoError(t, err)
+	return box
+}
+
+func TestSQLiteEncryptSecrets(t *testing.T) {
+	ctx := context.Background()
+	dbPath := testutil.DatabasePath(t, "sqlite-secrets")
+	s, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+
+	// Secrets written before encryption is enabled are stored in plaintext
+	require.NoError(t, s.CreateSession(ctx, &Session{
+		ID: "sess-1", RunID: "run-1", Status: SessionStatusRunning, ProxyAPIKey: "sk-or-session",
+	}))
+	require.NoError(t, s.CreateSessionTemplate(ctx, &SessionTemplate{
+		ID: "tmpl-1", Name: "review", Config: `{"query":"review","proxy_api_key":"sk-or-template"}`,
+	}))
+	require.NoError(t, s.CreateSchedule(ctx, &Schedule{
+		ID: "sched-1", Name: "nightly", CronExpr: "0 2 * * *",
+		LaunchConfig:  `{"query":"triage","proxy_api_key":"sk-or-schedule"}`,
+		OverlapPolicy: ScheduleOverlapSkip, CatchUpPolicy: ScheduleCatchUpOnce,
+	}))
+	require.NoError(t, s.CreateSessionTemplate(ctx, &SessionTemplate{ID: "tmpl-2", Name: "keyless", Config: `{}`}))
+	require.NoError(t, s.CreateWebhook(ctx, &Webhook{ID: "hook-1", Name: "chat", URL: "http://localhost", Secret: "sk-or-webhook"}))
+	assert.Contains(t, storedSecrets(t, s), "sk-or-session")
+
+	// Enabling encryption encrypts them, once
+	box := newSecretBox(t)
+	encrypted, err := s.EncryptSecrets(ctx, box)
+	require.NoError(t, err)
+	assert.Equal(t, 4, encrypted)
+	encrypted, err = s.EncryptSecrets(ctx, box)
+	require.NoError(t, err)
+	assert.Zero(t, encrypted)
+	for _, value := range storedSecrets(t, s) {
+		assert.NotContains(t, value, "sk-or-")
+	}
+
+	session, err := s.GetSession(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Equal(t, "sk-or-session", session.ProxyAPIKey)
+	template, err := s.GetSessionTemplate(ctx, "tmpl-1")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"query":"review","proxy_api_key":"sk-or-template"}`, template.Config)
+	template, err = s.GetSessionTemplate(ctx, "tmpl-2")
+	require.NoError(t, err)
+	assert.Equal(t, `{}`, template.Config)
+	schedules, err := s.ListSchedules(ctx)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"query":"triage","proxy_api_key":"sk-or-schedule"}`, schedules[0].LaunchConfig)
+	webhook, err := s.GetWebhook(ctx, "hook-1")
+	require.NoError(t, err)
+	assert.Equal(t, "sk-or-webhook", webhook.Secret)
+
+	// New and updated secrets are encrypted as they are written
+	key := "sk-or-updated"
+	require.NoError(t, s.UpdateSession(ctx, "sess-1", SessionUpdate{ProxyAPIKey: &key}))
+	require.NoError(t, s.CreateSession(ctx, &Session{
+		ID: "sess-2", RunID: "run-2", Status: SessionStatusRunning, ProxyAPIKey: "sk-or-new",
+	}))
+	for _, value := range storedSecrets(t, s) {
+		assert.NotContains(t, value, "sk-or-")
+	}
+	session, err = s.GetSession(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Equal(t, "sk-or-updated", session.ProxyAPIKey)
+
+	// Rotating seals everything again with the new key
+	next, err := secrets.NewKey()
+	require.NoError(t, err)
+	rotated := newSecretBox(t, next, box.Keys()[0])
+	encrypted, err = s.EncryptSecrets(ctx, rotated)
+	require.NoError(t, err)
+	assert.Equal(t, 5, encrypted)
+	require.NoError(t, s.Close())
+
+	// Without the key that sealed them, secrets read as not set
+	s, err = NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+	encrypted, err = s.EncryptSecrets(ctx, newSecretBox(t, next))
+	require.NoError(t, err)
+	assert.Zero(t, encrypted)
+	sessions, err := s.ListSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	for _, session := range sessions {
+		assert.NotEmpty(t, session.ProxyAPIKey)
+	}
+	encrypted, err = s.EncryptSecrets(ctx, newSecretBox(t))
+	require.NoError(t, err)
+	assert.Zero(t, encrypted)
+	sessions, err = s.ListSessions(ctx)
+	require.NoError(t, err)
+	for _, session := range sessions {
+		assert.Empty(t, session.ProxyAPIKey)
+	}
+}
+
+func TestSQLiteEncryptSecretsOncePerKey(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-secrets-once"))
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	box := newSecretBox(t)
+	encrypted, err := s.EncryptSecrets(ctx, box)
+	require.NoError(t, err)
+	assert.Zero(t, encrypted)
+
+	// Stored secrets are not scanned again for a key that already sealed them
+	_, err = s.db.Exec(`INSERT INTO webhooks (id, name, url, secret) VALUES ('hook-1', 'chat', 'http://localhost', 'sk-or-webhook')`)
+	require.NoError(t, err)
+	encrypted, err = s.EncryptSecrets(ctx, box)
+	require.NoError(t, err)
+	assert.Zero(t, encrypted)
+	assert.Contains(t, storedSecrets(t, s), "sk-or-webhook")
+
+	// A new key scans them again
+	next, err := secrets.NewKey()
+	require.NoError(t, err)
+	encrypted, err = s.EncryptSecrets(ctx, newSecretBox(t, next, box.Keys()[0]))
+	require.NoError(t, err)
+	assert.Equal(t, 1, encrypted)
+	for _, value := range storedSecrets(t, s) {
+		assert.NotContains(t, value, "sk-or-")
+	}
+}
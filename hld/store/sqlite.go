@@ -16,9 +16,19 @@ import (
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// LatestSchemaVersion is the schema version the migrations in
+// migrations/sqlite bring a database to. Databases with a newer version were
+// written by a newer build.
+const LatestSchemaVersion = 40
+
 // SQLiteStore implements ConversationStore using SQLite
 type SQLiteStore struct {
 	db *sql.DB
+
+	// fullTextSearch is set when SQLite has FTS5 and conversation search uses the index
+	fullTextSearch bool
+
+	secrets secretCodec
 }
 
 // GetDB returns the underlying database connection for testing purposes
@@ -42,6 +52,13 @@ func NewSQLiteStore(dbPath string) (*SQLiteStore, error) {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	// Incremental auto-vacuum lets maintenance shrink the file in small steps.
+	// It only applies to new databases, existing ones switch on a full VACUUM.
+	if _, err := db.Exec("PRAGMA auto_vacuum = INCREMENTAL"); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to enable incremental auto-vacuum: %w", err)
+	}
+
 	// Enable foreign keys and WAL mode for better concurrency
 	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
 		_ = db.Close()
@@ -54,16 +71,18 @@ func NewSQLiteStore(dbPath string) (*SQLiteStore, error) {
 
 	store := &SQLiteStore{db: db}
 
-	// Initialize schema
-	if err := store.initSchema(); err != nil {
+	// Migrations create the schema of a new database too. A database written
+	// by a newer build is refused rather than run against a schema this build
+	// does not know.
+	if _, err := NewSQLiteMigrator(db).Migrate(context.Background(), LatestSchemaVersion); err != nil {
 		_ = db.Close()
-		return nil, fmt.Errorf("failed to initialize schema: %w", err)
+		return nil, fmt.Errorf("failed to apply migrations: %w", err)
 	}
 
-	// Apply migrations (this must be called AFTER initSchema for both new and existing databases)
-	if err := store.applyMigrations(); err != nil {
+	// The search index depends on the build as well as the schema version
+	if err := store.ensureSearchIndex(); err != nil {
 		_ = db.Close()
-		return nil, fmt.Errorf("failed to apply migrations: %w", err)
+		return nil, fmt.Errorf("failed to prepare search index: %w", err)
 	}
 
 	// Validate schema is in expected state
@@ -76,1050 +95,6 @@ func NewSQLiteStore(dbPath string) (*SQLiteStore, error) {
 	return store, nil
 }
 
-// initSchema creates the database schema if it doesn't exist
-func (s *SQLiteStore) initSchema() error {
-	schema := `
-	-- Sessions table (metadata and configuration)
-	CREATE TABLE IF NOT EXISTS sessions (
-		id TEXT PRIMARY KEY,
-		run_id TEXT NOT NULL UNIQUE,
-		claude_session_id TEXT,
-		parent_session_id TEXT,
-
-		-- Launch configuration
-		query TEXT NOT NULL,
-		summary TEXT,
-		model TEXT,
-		working_dir TEXT,
-		max_turns INTEGER,
-		system_prompt TEXT,
-		append_system_prompt TEXT,
-		custom_instructions TEXT,
-		permission_prompt_tool TEXT,
-		allowed_tools TEXT,
-		disallowed_tools TEXT,
-
-		-- Runtime status
-		status TEXT NOT NULL DEFAULT 'starting',
-		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-		last_activity_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-		completed_at TIMESTAMP,
-
-		-- Results
-		cost_usd REAL,
-		duration_ms INTEGER,
-		num_turns INTEGER,
-		result_content TEXT,
-		error_message TEXT,
-
-		-- Session settings
-		auto_accept_edits BOOLEAN DEFAULT 0,
-		dangerously_skip_permissions BOOLEAN DEFAULT 0,
-		dangerously_skip_permissions_expires_at TIMESTAMP,
-
-		-- Archival
-		archived BOOLEAN DEFAULT FALSE,
-
-		-- Additional directories for --add-dir support
-		additional_directories TEXT
-	);
-	CREATE INDEX IF NOT EXISTS idx_sessions_claude ON sessions(claude_session_id);
-	CREATE INDEX IF NOT EXISTS idx_sessions_status ON sessions(status);
-	CREATE INDEX IF NOT EXISTS idx_sessions_run_id ON sessions(run_id);
-	CREATE INDEX IF NOT EXISTS idx_sessions_parent ON sessions(parent_session_id);
-
-	-- Single conversation events table
-	CREATE TABLE IF NOT EXISTS conversation_events (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		session_id TEXT NOT NULL,
-		claude_session_id TEXT,
-		sequence INTEGER NOT NULL,
-		event_type TEXT NOT NULL,
-
-		-- Common fields
-		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-
-		-- Message fields
-		role TEXT,
-		content TEXT,
-
-		-- Tool call fields
-		tool_id TEXT,
-		tool_name TEXT,
-		tool_input_json TEXT,
-
-		-- Tool result fields
-		tool_result_for_id TEXT,
-		tool_result_content TEXT,
-
-		-- Tool call completion and approval tracking
-		is_completed BOOLEAN DEFAULT FALSE,  -- TRUE when tool result received
-		approval_status TEXT,        -- NULL, 'pending', 'approved', 'denied'
-		approval_id TEXT,           -- HumanLayer approval ID when correlated
-
-		FOREIGN KEY (session_id) REFERENCES sessions(id)
-	);
-	CREATE INDEX IF NOT EXISTS idx_conversation_claude_session ON conversation_events(claude_session_id, sequence);
-	CREATE INDEX IF NOT EXISTS idx_conversation_session ON conversation_events(session_id, sequence);
-	CREATE INDEX IF NOT EXISTS idx_conversation_approval ON conversation_events(approval_id);
-	CREATE INDEX IF NOT EXISTS idx_conversation_pending_approvals
-		ON conversation_events(approval_status)
-		WHERE approval_status = 'pending';
-
-	-- MCP servers configuration
-	CREATE TABLE IF NOT EXISTS mcp_servers (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		session_id TEXT NOT NULL,
-		name TEXT NOT NULL,
-		command TEXT NOT NULL,
-		args_json TEXT,
-		env_json TEXT,
-
-		FOREIGN KEY (session_id) REFERENCES sessions(id)
-	);
-	CREATE INDEX IF NOT EXISTS idx_mcp_servers_session ON mcp_servers(session_id);
-
-	-- Raw events for debugging
-	CREATE TABLE IF NOT EXISTS raw_events (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		session_id TEXT NOT NULL,
-		event_json TEXT NOT NULL,
-		created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-
-		FOREIGN KEY (session_id) REFERENCES sessions(id)
-	);
-	CREATE INDEX IF NOT EXISTS idx_raw_events_session ON raw_events(session_id, created_at);
-
-	-- Schema versioning
-	CREATE TABLE IF NOT EXISTS schema_version (
-		version INTEGER PRIMARY KEY,
-		applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-		description TEXT
-	);
-
-	-- Approvals table for local approvals
-	CREATE TABLE IF NOT EXISTS approvals (
-		id TEXT PRIMARY KEY,
-		run_id TEXT NOT NULL,
-		session_id TEXT NOT NULL,
-		status TEXT NOT NULL CHECK (status IN ('pending', 'approved', 'denied')),
-		created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-		responded_at DATETIME,
-
-		-- Tool approval fields
-		tool_name TEXT NOT NULL,
-		tool_input TEXT NOT NULL, -- JSON
-
-		-- Response fields
-		comment TEXT, -- For denial reasons or approval notes
-
-		FOREIGN KEY (session_id) REFERENCES sessions(id)
-	);
-	CREATE INDEX IF NOT EXISTS idx_approvals_pending ON approvals(status) WHERE status = 'pending';
-	CREATE INDEX IF NOT EXISTS idx_approvals_session ON approvals(session_id);
-	CREATE INDEX IF NOT EXISTS idx_approvals_run_id ON approvals(run_id);
-	`
-
-	if _, err := s.db.Exec(schema); err != nil {
-		return fmt.Errorf("failed to create schema: %w", err)
-	}
-
-	// Record initial schema version for new databases only
-	// Migration system will handle upgrading existing databases
-	_, err := s.db.Exec(`
-		INSERT OR IGNORE INTO schema_version (version, description)
-		VALUES (1, 'Initial schema with conversation events')
-	`)
-	return err
-}
-
-// applyMigrations applies any pending database migrations
-func (s *SQLiteStore) applyMigrations() error {
-	// Get current schema version
-	var currentVersion int
-	err := s.db.QueryRow("SELECT MAX(version) FROM schema_version").Scan(&currentVersion)
-	if err != nil {
-		return fmt.Errorf("failed to get current schema version: %w", err)
-	}
-
-	// Migration 2: Added constraint to ensure only resumable sessions can be parent sessions
-	// (This migration already exists in production databases)
-
-	// Migration 3: Add missing permission and tool fields
-	if currentVersion < 3 {
-		slog.Info("Applying migration 3: Add permission and tool fields")
-
-		// Check if columns already exist (they might be in the schema for new databases)
-		var columnCount int
-		err = s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('sessions')
-			WHERE name IN ('permission_prompt_tool', 'append_system_prompt', 'allowed_tools', 'disallowed_tools')
-		`).Scan(&columnCount)
-		if err != nil {
-			return fmt.Errorf("failed to check existing columns: %w", err)
-		}
-
-		// Only add columns if they don't exist
-		if columnCount < 4 {
-			// SQLite requires separate ALTER TABLE statements
-			alterations := []struct {
-				column string
-				sql    string
-			}{
-				{"permission_prompt_tool", "ALTER TABLE sessions ADD COLUMN permission_prompt_tool TEXT"},
-				{"append_system_prompt", "ALTER TABLE sessions ADD COLUMN append_system_prompt TEXT"},
-				{"allowed_tools", "ALTER TABLE sessions ADD COLUMN allowed_tools TEXT"},
-				{"disallowed_tools", "ALTER TABLE sessions ADD COLUMN disallowed_tools TEXT"},
-			}
-
-			for _, alt := range alterations {
-				// Check if this specific column exists
-				var exists int
-				err = s.db.QueryRow(`
-					SELECT COUNT(*) FROM pragma_table_info('sessions') WHERE name = ?
-				`, alt.column).Scan(&exists)
-				if err != nil {
-					return fmt.Errorf("failed to check column %s: %w", alt.column, err)
-				}
-
-				if exists == 0 {
-					_, err := s.db.Exec(alt.sql)
-					if err != nil {
-						return fmt.Errorf("failed to add column %s: %w", alt.column, err)
-					}
-				}
-			}
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (3, 'Add permission_prompt_tool, append_system_prompt, allowed_tools, disallowed_tools fields')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 3: %w", err)
-		}
-
-		slog.Info("Migration 3 applied successfully")
-	}
-
-	// Migration 4: Add approvals table for local approvals
-	if currentVersion < 4 {
-		slog.Info("Applying migration 4: Add approvals table for local approvals")
-
-		// Create the approvals table
-		_, err = s.db.Exec(`
-			CREATE TABLE IF NOT EXISTS approvals (
-				id TEXT PRIMARY KEY,
-				run_id TEXT NOT NULL,
-				session_id TEXT NOT NULL,
-				status TEXT NOT NULL CHECK (status IN ('pending', 'approved', 'denied')),
-				created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
-				responded_at DATETIME,
-
-				-- Tool approval fields
-				tool_name TEXT NOT NULL,
-				tool_input TEXT NOT NULL, -- JSON
-
-				-- Response fields
-				comment TEXT, -- For denial reasons or approval notes
-
-				FOREIGN KEY (session_id) REFERENCES sessions(id)
-			);
-			CREATE INDEX IF NOT EXISTS idx_approvals_pending ON approvals(status) WHERE status = 'pending';
-			CREATE INDEX IF NOT EXISTS idx_approvals_session ON approvals(session_id);
-			CREATE INDEX IF NOT EXISTS idx_approvals_run_id ON approvals(run_id);
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to create approvals table: %w", err)
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (4, 'Add approvals table for local approvals')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 4: %w", err)
-		}
-
-		slog.Info("Migration 4 applied successfully")
-	}
-
-	// Migration 5: Add index on parent_session_id for efficient tree queries
-	if currentVersion < 5 {
-		slog.Info("Applying migration 5: Add index on parent_session_id")
-
-		// Create index on parent_session_id for efficient child queries
-		_, err = s.db.Exec(`
-			CREATE INDEX IF NOT EXISTS idx_sessions_parent ON sessions(parent_session_id)
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to create parent_session_id index: %w", err)
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (5, 'Add index on parent_session_id for efficient tree queries')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 5: %w", err)
-		}
-
-		slog.Info("Migration 5 applied successfully")
-	}
-
-	// Migration 6: Add parent_tool_use_id for sub-task tracking
-	if currentVersion < 6 {
-		slog.Info("Applying migration 6: Add parent_tool_use_id for sub-task tracking")
-
-		// Check if column already exists (it might be in the schema for new databases)
-		var columnExists int
-		err = s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('conversation_events')
-			WHERE name = 'parent_tool_use_id'
-		`).Scan(&columnExists)
-		if err != nil {
-			return fmt.Errorf("failed to check parent_tool_use_id column: %w", err)
-		}
-
-		// Only add column if it doesn't exist
-		if columnExists == 0 {
-			_, err = s.db.Exec(`
-				ALTER TABLE conversation_events
-				ADD COLUMN parent_tool_use_id TEXT
-			`)
-			if err != nil {
-				return fmt.Errorf("failed to add parent_tool_use_id column: %w", err)
-			}
-		}
-
-		// Create index for efficient parent queries
-		_, err = s.db.Exec(`
-			CREATE INDEX IF NOT EXISTS idx_conversation_parent_tool
-			ON conversation_events(parent_tool_use_id)
-			WHERE parent_tool_use_id IS NOT NULL
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to create parent_tool_use_id index: %w", err)
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (6, 'Add parent_tool_use_id for sub-task tracking')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 6: %w", err)
-		}
-
-		slog.Info("Migration 6 applied successfully")
-	}
-
-	// Migration 7: Add file_snapshots table for Read operation tracking
-	if currentVersion < 7 {
-		slog.Info("Applying migration 7: Add file_snapshots table")
-
-		_, err = s.db.Exec(`
-			CREATE TABLE IF NOT EXISTS file_snapshots (
-				id INTEGER PRIMARY KEY AUTOINCREMENT,
-				tool_id TEXT NOT NULL,
-				session_id TEXT NOT NULL,
-				file_path TEXT NOT NULL, -- Relative path from tool call
-				content TEXT NOT NULL,
-				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-
-				FOREIGN KEY (session_id) REFERENCES sessions(id)
-			);
-			CREATE INDEX IF NOT EXISTS idx_snapshots_session_path
-				ON file_snapshots(session_id, file_path);
-			CREATE INDEX IF NOT EXISTS idx_snapshots_tool
-				ON file_snapshots(tool_id);
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to create file_snapshots table: %w", err)
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (7, 'Add file_snapshots table for Read operation tracking')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 7: %w", err)
-		}
-
-		slog.Info("Migration 7 applied successfully")
-	}
-
-	// Migration 8: Add auto_accept_edits for session-level edit auto-approval
-	if currentVersion < 8 {
-		slog.Info("Applying migration 8: Add auto_accept_edits column")
-
-		// Check if column already exists (it might be in the schema for new databases)
-		var columnExists int
-		err = s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('sessions')
-			WHERE name = 'auto_accept_edits'
-		`).Scan(&columnExists)
-		if err != nil {
-			return fmt.Errorf("failed to check auto_accept_edits column: %w", err)
-		}
-
-		// Only add column if it doesn't exist
-		if columnExists == 0 {
-			_, err = s.db.Exec(`
-				ALTER TABLE sessions
-				ADD COLUMN auto_accept_edits BOOLEAN DEFAULT 0
-			`)
-			if err != nil {
-				return fmt.Errorf("failed to add auto_accept_edits column: %w", err)
-			}
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (8, 'Add auto_accept_edits for session-level edit auto-approval')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 8: %w", err)
-		}
-
-		slog.Info("Migration 8 applied successfully")
-	}
-
-	// Migration 9: Add archived field to sessions table
-	if currentVersion < 9 {
-		slog.Info("Applying migration 9: Add archived field to sessions table")
-
-		// Check if column already exists
-		var columnExists int
-		err = s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('sessions')
-			WHERE name = 'archived'
-		`).Scan(&columnExists)
-		if err != nil {
-			return fmt.Errorf("failed to check archived column: %w", err)
-		}
-
-		// Only add column if it doesn't exist
-		if columnExists == 0 {
-			_, err = s.db.Exec(`
-				ALTER TABLE sessions
-				ADD COLUMN archived BOOLEAN DEFAULT FALSE
-			`)
-			if err != nil {
-				return fmt.Errorf("failed to add archived column: %w", err)
-			}
-		}
-
-		// Add index for efficient filtering
-		_, err = s.db.Exec(`
-			CREATE INDEX IF NOT EXISTS idx_sessions_archived
-			ON sessions(archived)
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to create archived index: %w", err)
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (9, 'Add archived field to sessions table for hiding old sessions')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 9: %w", err)
-		}
-
-		slog.Info("Migration 9 applied successfully")
-	}
-
-	// Migration 10: Add title column to sessions table
-	if currentVersion < 10 {
-		slog.Info("Applying migration 10: Add title column to sessions table")
-
-		// Check if column already exists for idempotency
-		var columnExists int
-		err = s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('sessions')
-			WHERE name = 'title'
-		`).Scan(&columnExists)
-		if err != nil {
-			return fmt.Errorf("checking for title column: %w", err)
-		}
-
-		if columnExists == 0 {
-			_, err = s.db.Exec(`
-				ALTER TABLE sessions
-				ADD COLUMN title TEXT DEFAULT ''
-			`)
-			if err != nil {
-				return fmt.Errorf("adding title column: %w", err)
-			}
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (10, 'Add title column to sessions table')
-		`)
-		if err != nil {
-			return fmt.Errorf("recording migration 10: %w", err)
-		}
-
-		slog.Info("Migration 10 applied successfully")
-	}
-
-	// Migration 11: Add dangerously skip permissions with timeout support
-	if currentVersion < 11 {
-		slog.Info("Applying migration 11: Add dangerously skip permissions columns")
-
-		// Check if columns already exist
-		var skipPermissionsExists, expiryExists int
-		err = s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('sessions')
-			WHERE name = 'dangerously_skip_permissions'
-		`).Scan(&skipPermissionsExists)
-		if err != nil {
-			return fmt.Errorf("failed to check dangerously_skip_permissions column: %w", err)
-		}
-
-		err = s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('sessions')
-			WHERE name = 'dangerously_skip_permissions_expires_at'
-		`).Scan(&expiryExists)
-		if err != nil {
-			return fmt.Errorf("failed to check dangerously_skip_permissions_expires_at column: %w", err)
-		}
-
-		// Add columns if they don't exist
-		if skipPermissionsExists == 0 {
-			_, err = s.db.Exec(`
-				ALTER TABLE sessions
-				ADD COLUMN dangerously_skip_permissions BOOLEAN DEFAULT 0
-			`)
-			if err != nil {
-				return fmt.Errorf("failed to add dangerously_skip_permissions column: %w", err)
-			}
-		}
-
-		if expiryExists == 0 {
-			_, err = s.db.Exec(`
-				ALTER TABLE sessions
-				ADD COLUMN dangerously_skip_permissions_expires_at TIMESTAMP
-			`)
-			if err != nil {
-				return fmt.Errorf("failed to add dangerously_skip_permissions_expires_at column: %w", err)
-			}
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (11, 'Add dangerously skip permissions with timeout support')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 11: %w", err)
-		}
-
-		slog.Info("Migration 11 applied successfully")
-	}
-
-	// Migration 12: Add model_id column for storing full model identifier
-	if currentVersion < 12 {
-		slog.Info("Applying migration 12: Add model_id column")
-
-		// Check if model_id column exists
-		var modelIdExists int
-		err := s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('sessions') WHERE name = 'model_id'
-		`).Scan(&modelIdExists)
-		if err != nil {
-			return fmt.Errorf("failed to check for model_id column: %w", err)
-		}
-
-		if modelIdExists == 0 {
-			_, err = s.db.Exec(`
-				ALTER TABLE sessions
-				ADD COLUMN model_id TEXT
-			`)
-			if err != nil {
-				return fmt.Errorf("failed to add model_id column: %w", err)
-			}
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (12, 'Add model_id column for full model identifier')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 12: %w", err)
-		}
-
-		slog.Info("Migration 12 applied successfully")
-	}
-
-	// Migration 13: Add detailed token tracking fields
-	if currentVersion < 13 {
-		slog.Info("Applying migration 13: Add detailed token tracking fields")
-
-		// Check if columns already exist
-		columnChecks := []string{
-			"input_tokens",
-			"output_tokens",
-			"cache_creation_input_tokens",
-			"cache_read_input_tokens",
-			"effective_context_tokens",
-		}
-
-		for _, column := range columnChecks {
-			var columnExists int
-			err = s.db.QueryRow(`
-				SELECT COUNT(*) FROM pragma_table_info('sessions')
-				WHERE name = ?
-			`, column).Scan(&columnExists)
-			if err != nil {
-				return fmt.Errorf("failed to check %s column: %w", column, err)
-			}
-
-			// Only add column if it doesn't exist
-			if columnExists == 0 {
-				_, err = s.db.Exec(fmt.Sprintf(`
-					ALTER TABLE sessions
-					ADD COLUMN %s INTEGER
-				`, column))
-				if err != nil {
-					return fmt.Errorf("failed to add %s column: %w", column, err)
-				}
-			}
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (13, 'Add detailed token tracking fields (input, output, cache, effective context)')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 13: %w", err)
-		}
-
-		slog.Info("Migration 13 applied successfully")
-	}
-
-	// Migration 14: Add tool_use_id column to approvals table
-	if currentVersion < 14 {
-		slog.Info("Applying migration 14: Add tool_use_id column to approvals table")
-
-		// Check if column already exists for idempotency
-		var columnExists int
-		err = s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('approvals')
-			WHERE name = 'tool_use_id'
-		`).Scan(&columnExists)
-		if err != nil {
-			return fmt.Errorf("failed to check tool_use_id column: %w", err)
-		}
-
-		// Only add column if it doesn't exist
-		if columnExists == 0 {
-			_, err = s.db.Exec(`
-				ALTER TABLE approvals
-				ADD COLUMN tool_use_id TEXT
-			`)
-			if err != nil {
-				return fmt.Errorf("failed to add tool_use_id column: %w", err)
-			}
-		}
-
-		// Create index for efficient lookups
-		_, err = s.db.Exec(`
-			CREATE INDEX IF NOT EXISTS idx_approvals_tool_use_id
-			ON approvals(tool_use_id)
-			WHERE tool_use_id IS NOT NULL
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to create tool_use_id index: %w", err)
-		}
-
-		// Update existing approvals to populate tool_use_id from correlated events
-		_, err = s.db.Exec(`
-			UPDATE approvals
-			SET tool_use_id = (
-				SELECT ce.tool_id
-				FROM conversation_events ce
-				WHERE ce.approval_id = approvals.id
-				AND ce.tool_id IS NOT NULL
-				LIMIT 1
-			)
-			WHERE EXISTS (
-				SELECT 1
-				FROM conversation_events ce
-				WHERE ce.approval_id = approvals.id
-				AND ce.tool_id IS NOT NULL
-			)
-		`)
-		if err != nil {
-			slog.Warn("Failed to populate tool_use_id for existing approvals (non-critical)", "error", err)
-			// This is non-critical as existing approvals may not have correlation
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (14, 'Add tool_use_id column to approvals table for direct correlation')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 14: %w", err)
-		}
-
-		slog.Info("Migration 14 applied successfully")
-	}
-
-	// Migration 15: Add proxy configuration columns for model customization
-	if currentVersion < 15 {
-		slog.Info("Applying migration 15: Add proxy configuration columns")
-
-		// Check if columns already exist for idempotency
-		columnsToAdd := []struct {
-			name         string
-			sqlType      string
-			defaultValue string
-		}{
-			{"proxy_enabled", "BOOLEAN", "0"},
-			{"proxy_base_url", "TEXT", "''"},
-			{"proxy_model_override", "TEXT", "''"},
-			{"proxy_api_key", "TEXT", "''"},
-		}
-
-		for _, col := range columnsToAdd {
-			var columnExists int
-			err = s.db.QueryRow(`
-				SELECT COUNT(*) FROM pragma_table_info('sessions')
-				WHERE name = ?
-			`, col.name).Scan(&columnExists)
-			if err != nil {
-				return fmt.Errorf("failed to check column %s: %w", col.name, err)
-			}
-
-			if columnExists == 0 {
-				_, err = s.db.Exec(fmt.Sprintf(`
-					ALTER TABLE sessions
-					ADD COLUMN %s %s DEFAULT %s
-				`, col.name, col.sqlType, col.defaultValue))
-				if err != nil {
-					return fmt.Errorf("failed to add column %s: %w", col.name, err)
-				}
-			}
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (15, 'Add proxy configuration columns for model customization')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 15: %w", err)
-		}
-
-		slog.Info("Migration 15 applied successfully")
-	}
-
-	// Migration 16: Add user settings table for preferences
-	if currentVersion < 16 {
-		slog.Info("Applying migration 16: Add user settings table")
-
-		_, err := s.db.Exec(`
-			CREATE TABLE IF NOT EXISTS user_settings (
-				id INTEGER PRIMARY KEY CHECK (id = 1), -- Singleton row
-				advanced_providers BOOLEAN NOT NULL DEFAULT FALSE,
-				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
-			)
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to create user_settings table: %w", err)
-		}
-
-		// Insert default row
-		_, err = s.db.Exec(`
-			INSERT INTO user_settings (id, advanced_providers)
-			VALUES (1, FALSE)
-			ON CONFLICT(id) DO NOTHING
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to insert default user settings: %w", err)
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (16, 'Add user settings table for advanced providers preference')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 16: %w", err)
-		}
-
-		slog.Info("Migration 16 applied successfully")
-	}
-
-	// Migration 17 was skipped due to migration reordering issues
-	// Migration 18: Healing migration to fix schema inconsistencies from migration reordering
-	if currentVersion < 18 {
-		slog.Info("Applying migration 18: Healing migration for schema consistency")
-
-		// Track what we fix for monitoring
-		var fixedUserSettings bool
-		var fixedAdditionalDirs bool
-		var userSettingsError error
-		var additionalDirsError error
-
-		// Check and fix user_settings table independently
-		var tableExists int
-		err := s.db.QueryRow(`
-			SELECT COUNT(*) FROM sqlite_master
-			WHERE type='table' AND name='user_settings'
-		`).Scan(&tableExists)
-		if err != nil {
-			return fmt.Errorf("failed to check user_settings table existence: %w", err)
-		}
-
-		if tableExists == 0 {
-			slog.Warn("Migration 18: user_settings table missing, creating it")
-			_, userSettingsError = s.db.Exec(`
-				CREATE TABLE IF NOT EXISTS user_settings (
-					id INTEGER PRIMARY KEY CHECK (id = 1),
-					advanced_providers BOOLEAN NOT NULL DEFAULT FALSE,
-					created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-					updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
-				)
-			`)
-			if userSettingsError != nil {
-				slog.Error("Failed to create user_settings table", "error", userSettingsError)
-			} else {
-				// Insert default row
-				_, err = s.db.Exec(`
-					INSERT INTO user_settings (id, advanced_providers)
-					VALUES (1, FALSE)
-					ON CONFLICT(id) DO NOTHING
-				`)
-				if err != nil {
-					slog.Error("Failed to insert default user settings", "error", err)
-				} else {
-					fixedUserSettings = true
-				}
-			}
-		} else {
-			slog.Info("Migration 18: user_settings table already exists")
-		}
-
-		// Check and fix additional_directories column independently
-		var columnExists int
-		err = s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('sessions')
-			WHERE name = 'additional_directories'
-		`).Scan(&columnExists)
-		if err != nil {
-			return fmt.Errorf("failed to check additional_directories column: %w", err)
-		}
-
-		if columnExists == 0 {
-			slog.Warn("Migration 18: additional_directories column missing, adding it")
-			_, additionalDirsError = s.db.Exec(`
-				ALTER TABLE sessions
-				ADD COLUMN additional_directories TEXT
-			`)
-			if additionalDirsError != nil {
-				slog.Error("Failed to add additional_directories column", "error", additionalDirsError)
-			} else {
-				fixedAdditionalDirs = true
-			}
-		} else {
-			slog.Info("Migration 18: additional_directories column already exists")
-		}
-
-		// Log detailed results
-		if fixedUserSettings || fixedAdditionalDirs {
-			slog.Info("Migration 18: Fixed schema inconsistencies",
-				"fixed_user_settings", fixedUserSettings,
-				"fixed_additional_directories", fixedAdditionalDirs)
-		} else {
-			slog.Info("Migration 18: Schema already consistent, no fixes needed")
-		}
-
-		// Return any critical errors
-		if userSettingsError != nil {
-			return fmt.Errorf("migration 18 failed to create user_settings: %w", userSettingsError)
-		}
-		if additionalDirsError != nil {
-			return fmt.Errorf("migration 18 failed to add additional_directories: %w", additionalDirsError)
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (18, 'Healing migration to fix schema inconsistencies from migration reordering')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 18: %w", err)
-		}
-
-		slog.Info("Migration 18 applied successfully")
-	}
-
-	// Migration 19: Add opt_in_telemetry column for error reporting consent
-	if currentVersion < 19 {
-		slog.Info("Applying migration 19: Add opt_in_telemetry column for error reporting consent")
-
-		// Check if opt_in_telemetry column already exists for idempotency
-		var optInTelemetryExists int
-		err := s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('user_settings')
-			WHERE name = 'opt_in_telemetry'
-		`).Scan(&optInTelemetryExists)
-		if err != nil {
-			return fmt.Errorf("failed to check opt_in_telemetry column: %w", err)
-		}
-
-		if optInTelemetryExists == 0 {
-			// Add opt_in_telemetry column with NULL default (unset state)
-			_, err := s.db.Exec(`
-				ALTER TABLE user_settings
-				ADD COLUMN opt_in_telemetry BOOLEAN DEFAULT NULL
-			`)
-			if err != nil {
-				return fmt.Errorf("failed to add opt_in_telemetry column: %w", err)
-			}
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (?, ?)
-		`, 19, "Add opt_in_telemetry column for error reporting consent")
-		if err != nil {
-			return fmt.Errorf("failed to record migration 19: %w", err)
-		}
-
-		slog.Info("Migration 19 applied successfully")
-	}
-
-	// Migration 20: Add editor_state column for draft sessions
-	if currentVersion < 20 {
-		slog.Info("Applying migration 20: Adding editor_state column to sessions table")
-
-		// Add editor_state column to sessions table
-		_, err := s.db.Exec(`
-			ALTER TABLE sessions ADD COLUMN editor_state TEXT
-		`)
-		if err != nil {
-			// Check if column already exists (for idempotency)
-			var columnCount int
-			err = s.db.QueryRow(`
-				SELECT COUNT(*) FROM pragma_table_info('sessions')
-				WHERE name = 'editor_state'
-			`).Scan(&columnCount)
-			if err != nil {
-				return fmt.Errorf("failed to check for editor_state column: %w", err)
-			}
-			if columnCount == 0 {
-				return fmt.Errorf("failed to add editor_state column: %w", err)
-			}
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (?, ?)
-		`, 20, "Add editor_state column for draft session editor persistence")
-		if err != nil {
-			return fmt.Errorf("failed to record migration 20: %w", err)
-		}
-
-		slog.Info("Migration 20 applied successfully")
-	}
-
-	// Migration 21: Add dangerously_skip_permissions_timeout_ms column to store timeout duration
-	if currentVersion < 21 {
-		slog.Info("Applying migration 21: Adding dangerously_skip_permissions_timeout_ms column to sessions table")
-
-		// Check if column already exists for idempotency
-		var columnExists int
-		err := s.db.QueryRow(`
-			SELECT COUNT(*) FROM pragma_table_info('sessions')
-			WHERE name = 'dangerously_skip_permissions_timeout_ms'
-		`).Scan(&columnExists)
-		if err != nil {
-			return fmt.Errorf("failed to check dangerously_skip_permissions_timeout_ms column: %w", err)
-		}
-
-		if columnExists == 0 {
-			// Add column to sessions table
-			_, err = s.db.Exec(`
-				ALTER TABLE sessions
-				ADD COLUMN dangerously_skip_permissions_timeout_ms INTEGER
-			`)
-			if err != nil {
-				return fmt.Errorf("failed to add dangerously_skip_permissions_timeout_ms column: %w", err)
-			}
-			slog.Info("Added dangerously_skip_permissions_timeout_ms column")
-		} else {
-			slog.Info("dangerously_skip_permissions_timeout_ms column already exists")
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (21, 'Add dangerously_skip_permissions_timeout_ms column to store bypass permissions timeout duration')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 21: %w", err)
-		}
-
-		slog.Info("Migration 21 applied successfully")
-	}
-
-	// Migration 22: Add indexes for session search performance
-	if currentVersion < 22 {
-		slog.Info("Applying migration 22: Add indexes for session search performance")
-
-		// Add index on title for LIKE queries
-		// Using a regular B-tree index which helps with LIKE 'prefix%' patterns
-		_, err := s.db.Exec(`
-			CREATE INDEX IF NOT EXISTS idx_sessions_title
-			ON sessions(title)
-		`)
-		if err != nil {
-			return fmt.Errorf("migration 22 failed to create title index: %w", err)
-		}
-
-		// Also add index on last_activity_at if not exists
-		// This helps with ORDER BY performance
-		_, err = s.db.Exec(`
-			CREATE INDEX IF NOT EXISTS idx_sessions_last_activity
-			ON sessions(last_activity_at DESC)
-		`)
-		if err != nil {
-			return fmt.Errorf("migration 22 failed to create last_activity index: %w", err)
-		}
-
-		// Record migration
-		_, err = s.db.Exec(`
-			INSERT INTO schema_version (version, description)
-			VALUES (22, 'Add indexes for session search performance')
-		`)
-		if err != nil {
-			return fmt.Errorf("failed to record migration 22: %w", err)
-		}
-
-		slog.Info("Migration 22 applied successfully")
-	}
-
-	return nil
-}
-
 // validateSchema ensures the database schema is in the expected state
 func (s *SQLiteStore) validateSchema() error {
 	// Validate user_settings table exists
@@ -1171,8 +146,22 @@ func (s *SQLiteStore) Close() error {
 	return s.db.Close()
 }
 
+// EncryptSecrets makes the store encrypt the secrets it writes with box, and
+// encrypts the secrets stored in plaintext or with a retired key. Stored
+// secrets are only scanned the first time a key is used. It returns how many
+// stored secrets it encrypted. Call it before the store is used.
+func (s *SQLiteStore) EncryptSecrets(ctx context.Context, box SecretBox) (int, error) {
+	s.secrets = secretCodec{box: box}
+	return resealSecretsOnce(ctx, s.db, box)
+}
+
 // CreateSession creates a new session
 func (s *SQLiteStore) CreateSession(ctx context.Context, session *Session) error {
+	proxyAPIKey, err := s.secrets.seal(session.ProxyAPIKey)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		INSERT INTO sessions (
 			id, run_id, claude_session_id, parent_session_id,
@@ -1180,11 +169,12 @@ func (s *SQLiteStore) CreateSession(ctx context.Context, session *Session) error
 			permission_prompt_tool, allowed_tools, disallowed_tools,
 			status, created_at, last_activity_at, auto_accept_edits, archived, dangerously_skip_permissions, dangerously_skip_permissions_expires_at,
 			dangerously_skip_permissions_timeout_ms,
-			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state,
+			stall_threshold_ms, stall_interrupt_threshold_ms, runner, project_id
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NULLIF(?, ''))
 	`
 
-	_, err := s.db.ExecContext(ctx, query,
+	_, err = s.db.ExecContext(ctx, query,
 		session.ID, session.RunID, session.ClaudeSessionID, session.ParentSessionID,
 		session.Query, session.Summary, session.Title, session.Model, session.ModelID, session.WorkingDir, session.MaxTurns,
 		session.SystemPrompt, session.AppendSystemPrompt, session.CustomInstructions,
@@ -1192,8 +182,9 @@ func (s *SQLiteStore) CreateSession(ctx context.Context, session *Session) error
 		session.Status, session.CreatedAt, session.LastActivityAt, session.AutoAcceptEdits, session.Archived,
 		session.DangerouslySkipPermissions, session.DangerouslySkipPermissionsExpiresAt,
 		session.DangerouslySkipPermissionsTimeoutMs,
-		session.ProxyEnabled, session.ProxyBaseURL, session.ProxyModelOverride, session.ProxyAPIKey,
+		session.ProxyEnabled, session.ProxyBaseURL, session.ProxyModelOverride, proxyAPIKey,
 		session.AdditionalDirectories, session.EditorState,
+		session.StallThresholdMs, session.StallInterruptThresholdMs, session.Runner, session.ProjectID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create session: %w", err)
@@ -1321,8 +312,12 @@ func (s *SQLiteStore) UpdateSession(ctx context.Context, sessionID string, updat
 		args = append(args, *updates.ProxyModelOverride)
 	}
 	if updates.ProxyAPIKey != nil {
+		proxyAPIKey, err := s.secrets.seal(*updates.ProxyAPIKey)
+		if err != nil {
+			return err
+		}
 		setParts = append(setParts, "proxy_api_key = ?")
-		args = append(args, *updates.ProxyAPIKey)
+		args = append(args, proxyAPIKey)
 	}
 	if updates.AdditionalDirectories != nil {
 		setParts = append(setParts, "additional_directories = ?")
@@ -1336,6 +331,18 @@ func (s *SQLiteStore) UpdateSession(ctx context.Context, sessionID string, updat
 		setParts = append(setParts, "editor_state = ?")
 		args = append(args, *updates.EditorState)
 	}
+	if updates.StallThresholdMs != nil {
+		setParts = append(setParts, "stall_threshold_ms = ?")
+		args = append(args, *updates.StallThresholdMs)
+	}
+	if updates.StallInterruptThresholdMs != nil {
+		setParts = append(setParts, "stall_interrupt_threshold_ms = ?")
+		args = append(args, *updates.StallInterruptThresholdMs)
+	}
+	if updates.ProjectID != nil {
+		setParts = append(setParts, "project_id = NULLIF(?, '')")
+		args = append(args, *updates.ProjectID)
+	}
 
 	if len(setParts) == 0 {
 		// No fields to update is OK - this is a no-op
@@ -1394,7 +401,10 @@ func (s *SQLiteStore) GetSession(ctx context.Context, sessionID string) (*Sessio
 			cost_usd, input_tokens, output_tokens, cache_creation_input_tokens, cache_read_input_tokens, effective_context_tokens,
 			duration_ms, num_turns, result_content, error_message, auto_accept_edits, archived,
 			dangerously_skip_permissions, dangerously_skip_permissions_expires_at, dangerously_skip_permissions_timeout_ms,
-			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state
+			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state,
+			stall_threshold_ms, stall_interrupt_threshold_ms,
+			cpu_time_ms, cpu_percent, rss_bytes, open_fds, peak_cpu_percent, peak_rss_bytes, peak_open_fds, resources_sampled_at,
+			runner, COALESCE(project_id, '')
 		FROM sessions WHERE id = ?
 	`
 
@@ -1413,6 +423,8 @@ func (s *SQLiteStore) GetSession(ctx context.Context, sessionID string) (*Sessio
 	var proxyBaseURL, proxyModelOverride, proxyAPIKey sql.NullString
 	var additionalDirectories sql.NullString
 	var editorState sql.NullString
+	var stallThresholdMs, stallInterruptThresholdMs sql.NullInt64
+	var resourcesSampledAt sql.NullTime
 
 	err := s.db.QueryRowContext(ctx, query, sessionID).Scan(
 		&session.ID, &session.RunID, &claudeSessionID, &parentSessionID,
@@ -1424,9 +436,12 @@ func (s *SQLiteStore) GetSession(ctx context.Context, sessionID string) (*Sessio
 		&durationMS, &numTurns, &resultContent, &errorMessage, &session.AutoAcceptEdits,
 		&archived, &session.DangerouslySkipPermissions, &dangerouslySkipPermissionsExpiresAt, &dangerouslySkipPermissionsTimeoutMs,
 		&proxyEnabled, &proxyBaseURL, &proxyModelOverride, &proxyAPIKey, &additionalDirectories, &editorState,
+		&stallThresholdMs, &stallInterruptThresholdMs,
+		&session.CPUTimeMs, &session.CPUPercent, &session.RSSBytes, &session.OpenFDs,
+		&session.PeakCPUPercent, &session.PeakRSSBytes, &session.PeakOpenFDs, &resourcesSampledAt, &session.Runner, &session.ProjectID,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("session not found: %s", sessionID)
+		return nil, &NotFoundError{Type: "session", ID: sessionID}
 	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get session: %w", err)
@@ -1500,7 +515,7 @@ func (s *SQLiteStore) GetSession(ctx context.Context, sessionID string) (*Sessio
 	session.ProxyEnabled = proxyEnabled.Valid && proxyEnabled.Bool
 	session.ProxyBaseURL = proxyBaseURL.String
 	session.ProxyModelOverride = proxyModelOverride.String
-	session.ProxyAPIKey = proxyAPIKey.String
+	session.ProxyAPIKey = s.secrets.open(proxyAPIKey.String)
 
 	// Handle additional directories
 	session.AdditionalDirectories = additionalDirectories.String
@@ -1509,6 +524,15 @@ func (s *SQLiteStore) GetSession(ctx context.Context, sessionID string) (*Sessio
 	if editorState.Valid {
 		session.EditorState = &editorState.String
 	}
+	if stallThresholdMs.Valid {
+		session.StallThresholdMs = &stallThresholdMs.Int64
+	}
+	if stallInterruptThresholdMs.Valid {
+		session.StallInterruptThresholdMs = &stallInterruptThresholdMs.Int64
+	}
+	if resourcesSampledAt.Valid {
+		session.ResourcesSampledAt = &resourcesSampledAt.Time
+	}
 
 	return &session, nil
 }
@@ -1523,7 +547,10 @@ func (s *SQLiteStore) GetSessionByRunID(ctx context.Context, runID string) (*Ses
 			cost_usd, input_tokens, output_tokens, cache_creation_input_tokens, cache_read_input_tokens, effective_context_tokens,
 			duration_ms, num_turns, result_content, error_message, auto_accept_edits, archived,
 			dangerously_skip_permissions, dangerously_skip_permissions_expires_at, dangerously_skip_permissions_timeout_ms,
-			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state
+			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state,
+			stall_threshold_ms, stall_interrupt_threshold_ms,
+			cpu_time_ms, cpu_percent, rss_bytes, open_fds, peak_cpu_percent, peak_rss_bytes, peak_open_fds, resources_sampled_at,
+			runner, COALESCE(project_id, '')
 		FROM sessions
 		WHERE run_id = ?
 	`
@@ -1543,6 +570,8 @@ func (s *SQLiteStore) GetSessionByRunID(ctx context.Context, runID string) (*Ses
 	var proxyBaseURL, proxyModelOverride, proxyAPIKey sql.NullString
 	var additionalDirectories sql.NullString
 	var editorState sql.NullString
+	var stallThresholdMs, stallInterruptThresholdMs sql.NullInt64
+	var resourcesSampledAt sql.NullTime
 
 	err := s.db.QueryRowContext(ctx, query, runID).Scan(
 		&session.ID, &session.RunID, &claudeSessionID, &parentSessionID,
@@ -1554,6 +583,9 @@ func (s *SQLiteStore) GetSessionByRunID(ctx context.Context, runID string) (*Ses
 		&durationMS, &numTurns, &resultContent, &errorMessage, &session.AutoAcceptEdits,
 		&archived, &session.DangerouslySkipPermissions, &dangerouslySkipPermissionsExpiresAt, &dangerouslySkipPermissionsTimeoutMs,
 		&proxyEnabled, &proxyBaseURL, &proxyModelOverride, &proxyAPIKey, &additionalDirectories, &editorState,
+		&stallThresholdMs, &stallInterruptThresholdMs,
+		&session.CPUTimeMs, &session.CPUPercent, &session.RSSBytes, &session.OpenFDs,
+		&session.PeakCPUPercent, &session.PeakRSSBytes, &session.PeakOpenFDs, &resourcesSampledAt, &session.Runner, &session.ProjectID,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil // No session found
@@ -1630,7 +662,7 @@ func (s *SQLiteStore) GetSessionByRunID(ctx context.Context, runID string) (*Ses
 	session.ProxyEnabled = proxyEnabled.Valid && proxyEnabled.Bool
 	session.ProxyBaseURL = proxyBaseURL.String
 	session.ProxyModelOverride = proxyModelOverride.String
-	session.ProxyAPIKey = proxyAPIKey.String
+	session.ProxyAPIKey = s.secrets.open(proxyAPIKey.String)
 
 	// Handle additional directories
 	session.AdditionalDirectories = additionalDirectories.String
@@ -1639,12 +671,27 @@ func (s *SQLiteStore) GetSessionByRunID(ctx context.Context, runID string) (*Ses
 	if editorState.Valid {
 		session.EditorState = &editorState.String
 	}
+	if stallThresholdMs.Valid {
+		session.StallThresholdMs = &stallThresholdMs.Int64
+	}
+	if stallInterruptThresholdMs.Valid {
+		session.StallInterruptThresholdMs = &stallInterruptThresholdMs.Int64
+	}
+	if resourcesSampledAt.Valid {
+		session.ResourcesSampledAt = &resourcesSampledAt.Time
+	}
 
 	return &session, nil
 }
 
 // ListSessions retrieves all sessions
 func (s *SQLiteStore) ListSessions(ctx context.Context) ([]*Session, error) {
+	return s.QuerySessions(ctx, SessionFilter{})
+}
+
+// QuerySessions retrieves the sessions matching filter, most recently active first
+func (s *SQLiteStore) QuerySessions(ctx context.Context, filter SessionFilter) ([]*Session, error) {
+	where, whereArgs := sessionFilterWhere(filter)
 	query := `
 		SELECT id, run_id, claude_session_id, parent_session_id,
 			query, summary, title, model, model_id, working_dir, max_turns, system_prompt, append_system_prompt, custom_instructions,
@@ -1653,12 +700,21 @@ func (s *SQLiteStore) ListSessions(ctx context.Context) ([]*Session, error) {
 			cost_usd, input_tokens, output_tokens, cache_creation_input_tokens, cache_read_input_tokens, effective_context_tokens,
 		duration_ms, num_turns, result_content, error_message, auto_accept_edits, archived,
 			dangerously_skip_permissions, dangerously_skip_permissions_expires_at, dangerously_skip_permissions_timeout_ms,
-			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state
+			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state,
+			stall_threshold_ms, stall_interrupt_threshold_ms,
+			cpu_time_ms, cpu_percent, rss_bytes, open_fds, peak_cpu_percent, peak_rss_bytes, peak_open_fds, resources_sampled_at,
+			runner, COALESCE(project_id, '')
 		FROM sessions
-		ORDER BY last_activity_at DESC
+		` + where + `
+		ORDER BY julianday(last_activity_at) DESC, id DESC
 	`
+	args := whereArgs
+	if filter.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, filter.Limit)
+	}
 
-	rows, err := s.db.QueryContext(ctx, query)
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list sessions: %w", err)
 	}
@@ -1681,6 +737,8 @@ func (s *SQLiteStore) ListSessions(ctx context.Context) ([]*Session, error) {
 		var proxyBaseURL, proxyModelOverride, proxyAPIKey sql.NullString
 		var additionalDirectories sql.NullString
 		var editorState sql.NullString
+		var stallThresholdMs, stallInterruptThresholdMs sql.NullInt64
+		var resourcesSampledAt sql.NullTime
 
 		err := rows.Scan(
 			&session.ID, &session.RunID, &claudeSessionID, &parentSessionID,
@@ -1692,6 +750,9 @@ func (s *SQLiteStore) ListSessions(ctx context.Context) ([]*Session, error) {
 			&durationMS, &numTurns, &resultContent, &errorMessage, &session.AutoAcceptEdits,
 			&archived, &session.DangerouslySkipPermissions, &dangerouslySkipPermissionsExpiresAt, &dangerouslySkipPermissionsTimeoutMs,
 			&proxyEnabled, &proxyBaseURL, &proxyModelOverride, &proxyAPIKey, &additionalDirectories, &editorState,
+			&stallThresholdMs, &stallInterruptThresholdMs,
+			&session.CPUTimeMs, &session.CPUPercent, &session.RSSBytes, &session.OpenFDs,
+			&session.PeakCPUPercent, &session.PeakRSSBytes, &session.PeakOpenFDs, &resourcesSampledAt, &session.Runner, &session.ProjectID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan session: %w", err)
@@ -1767,7 +828,7 @@ func (s *SQLiteStore) ListSessions(ctx context.Context) ([]*Session, error) {
 		session.ProxyEnabled = proxyEnabled.Valid && proxyEnabled.Bool
 		session.ProxyBaseURL = proxyBaseURL.String
 		session.ProxyModelOverride = proxyModelOverride.String
-		session.ProxyAPIKey = proxyAPIKey.String
+		session.ProxyAPIKey = s.secrets.open(proxyAPIKey.String)
 
 		// Handle additional directories
 		session.AdditionalDirectories = additionalDirectories.String
@@ -1776,9 +837,33 @@ func (s *SQLiteStore) ListSessions(ctx context.Context) ([]*Session, error) {
 		if editorState.Valid {
 			session.EditorState = &editorState.String
 		}
+		if stallThresholdMs.Valid {
+			session.StallThresholdMs = &stallThresholdMs.Int64
+		}
+		if stallInterruptThresholdMs.Valid {
+			session.StallInterruptThresholdMs = &stallInterruptThresholdMs.Int64
+		}
+		if resourcesSampledAt.Valid {
+			session.ResourcesSampledAt = &resourcesSampledAt.Time
+		}
 
 		sessions = append(sessions, &session)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate sessions: %w", err)
+	}
+
+	// A page holds only some of the matching sessions, so load labels for those alone
+	if filter.Limit > 0 {
+		where = "WHERE id IN (" + placeholders(len(sessions)) + ")"
+		whereArgs = make([]interface{}, len(sessions))
+		for i, session := range sessions {
+			whereArgs[i] = session.ID
+		}
+	}
+	if err := s.attachSessionLabels(ctx, sessions, where, whereArgs); err != nil {
+		return nil, err
+	}
 
 	return sessions, nil
 }
@@ -1804,7 +889,10 @@ func (s *SQLiteStore) SearchSessionsByTitle(ctx context.Context, query string, l
 			cost_usd, input_tokens, output_tokens, cache_creation_input_tokens, cache_read_input_tokens, effective_context_tokens,
 			duration_ms, num_turns, result_content, error_message, auto_accept_edits, archived,
 			dangerously_skip_permissions, dangerously_skip_permissions_expires_at, dangerously_skip_permissions_timeout_ms,
-			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state
+			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state,
+			stall_threshold_ms, stall_interrupt_threshold_ms,
+			cpu_time_ms, cpu_percent, rss_bytes, open_fds, peak_cpu_percent, peak_rss_bytes, peak_open_fds, resources_sampled_at,
+			runner, COALESCE(project_id, '')
 		FROM sessions
 		WHERE 1=1
 		AND NOT EXISTS (
@@ -1854,6 +942,8 @@ func (s *SQLiteStore) SearchSessionsByTitle(ctx context.Context, query string, l
 		var proxyBaseURL, proxyModelOverride, proxyAPIKey sql.NullString
 		var additionalDirectories sql.NullString
 		var editorState sql.NullString
+		var stallThresholdMs, stallInterruptThresholdMs sql.NullInt64
+		var resourcesSampledAt sql.NullTime
 
 		err := rows.Scan(
 			&session.ID, &session.RunID, &claudeSessionID, &parentSessionID,
@@ -1865,6 +955,9 @@ func (s *SQLiteStore) SearchSessionsByTitle(ctx context.Context, query string, l
 			&durationMS, &numTurns, &resultContent, &errorMessage, &session.AutoAcceptEdits,
 			&archived, &session.DangerouslySkipPermissions, &dangerouslySkipPermissionsExpiresAt, &dangerouslySkipPermissionsTimeoutMs,
 			&proxyEnabled, &proxyBaseURL, &proxyModelOverride, &proxyAPIKey, &additionalDirectories, &editorState,
+			&stallThresholdMs, &stallInterruptThresholdMs,
+			&session.CPUTimeMs, &session.CPUPercent, &session.RSSBytes, &session.OpenFDs,
+			&session.PeakCPUPercent, &session.PeakRSSBytes, &session.PeakOpenFDs, &resourcesSampledAt, &session.Runner, &session.ProjectID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan session: %w", err)
@@ -1940,7 +1033,7 @@ func (s *SQLiteStore) SearchSessionsByTitle(ctx context.Context, query string, l
 		session.ProxyEnabled = proxyEnabled.Valid && proxyEnabled.Bool
 		session.ProxyBaseURL = proxyBaseURL.String
 		session.ProxyModelOverride = proxyModelOverride.String
-		session.ProxyAPIKey = proxyAPIKey.String
+		session.ProxyAPIKey = s.secrets.open(proxyAPIKey.String)
 
 		// Handle additional directories
 		session.AdditionalDirectories = additionalDirectories.String
@@ -1949,6 +1042,15 @@ func (s *SQLiteStore) SearchSessionsByTitle(ctx context.Context, query string, l
 		if editorState.Valid {
 			session.EditorState = &editorState.String
 		}
+		if stallThresholdMs.Valid {
+			session.StallThresholdMs = &stallThresholdMs.Int64
+		}
+		if stallInterruptThresholdMs.Valid {
+			session.StallInterruptThresholdMs = &stallInterruptThresholdMs.Int64
+		}
+		if resourcesSampledAt.Valid {
+			session.ResourcesSampledAt = &resourcesSampledAt.Time
+		}
 
 		sessions = append(sessions, &session)
 	}
@@ -1976,7 +1078,10 @@ func (s *SQLiteStore) GetExpiredDangerousPermissionsSessions(ctx context.Context
 			cost_usd, input_tokens, output_tokens, cache_creation_input_tokens, cache_read_input_tokens, effective_context_tokens,
 		duration_ms, num_turns, result_content, error_message, auto_accept_edits, archived,
 			dangerously_skip_permissions, dangerously_skip_permissions_expires_at, dangerously_skip_permissions_timeout_ms,
-			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state
+			proxy_enabled, proxy_base_url, proxy_model_override, proxy_api_key, additional_directories, editor_state,
+			stall_threshold_ms, stall_interrupt_threshold_ms,
+			cpu_time_ms, cpu_percent, rss_bytes, open_fds, peak_cpu_percent, peak_rss_bytes, peak_open_fds, resources_sampled_at,
+			runner, COALESCE(project_id, '')
 		FROM sessions
 		WHERE dangerously_skip_permissions = 1
 			AND dangerously_skip_permissions_expires_at IS NOT NULL
@@ -2008,6 +1113,8 @@ func (s *SQLiteStore) GetExpiredDangerousPermissionsSessions(ctx context.Context
 		var proxyBaseURL, proxyModelOverride, proxyAPIKey sql.NullString
 		var additionalDirectories sql.NullString
 		var editorState sql.NullString
+		var stallThresholdMs, stallInterruptThresholdMs sql.NullInt64
+		var resourcesSampledAt sql.NullTime
 
 		err := rows.Scan(
 			&session.ID, &session.RunID, &claudeSessionID, &parentSessionID,
@@ -2019,6 +1126,9 @@ func (s *SQLiteStore) GetExpiredDangerousPermissionsSessions(ctx context.Context
 			&durationMS, &numTurns, &resultContent, &errorMessage, &session.AutoAcceptEdits,
 			&archived, &session.DangerouslySkipPermissions, &dangerouslySkipPermissionsExpiresAt, &dangerouslySkipPermissionsTimeoutMs,
 			&proxyEnabled, &proxyBaseURL, &proxyModelOverride, &proxyAPIKey, &additionalDirectories, &editorState,
+			&stallThresholdMs, &stallInterruptThresholdMs,
+			&session.CPUTimeMs, &session.CPUPercent, &session.RSSBytes, &session.OpenFDs,
+			&session.PeakCPUPercent, &session.PeakRSSBytes, &session.PeakOpenFDs, &resourcesSampledAt, &session.Runner, &session.ProjectID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan session: %w", err)
@@ -2094,7 +1204,7 @@ func (s *SQLiteStore) GetExpiredDangerousPermissionsSessions(ctx context.Context
 		session.ProxyEnabled = proxyEnabled.Valid && proxyEnabled.Bool
 		session.ProxyBaseURL = proxyBaseURL.String
 		session.ProxyModelOverride = proxyModelOverride.String
-		session.ProxyAPIKey = proxyAPIKey.String
+		session.ProxyAPIKey = s.secrets.open(proxyAPIKey.String)
 
 		// Handle additional directories
 		session.AdditionalDirectories = additionalDirectories.String
@@ -2103,6 +1213,15 @@ func (s *SQLiteStore) GetExpiredDangerousPermissionsSessions(ctx context.Context
 		if editorState.Valid {
 			session.EditorState = &editorState.String
 		}
+		if stallThresholdMs.Valid {
+			session.StallThresholdMs = &stallThresholdMs.Int64
+		}
+		if stallInterruptThresholdMs.Valid {
+			session.StallInterruptThresholdMs = &stallInterruptThresholdMs.Int64
+		}
+		if resourcesSampledAt.Valid {
+			session.ResourcesSampledAt = &resourcesSampledAt.Time
+		}
 
 		sessions = append(sessions, &session)
 	}
@@ -2114,6 +1233,50 @@ func (s *SQLiteStore) GetExpiredDangerousPermissionsSessions(ctx context.Context
 	return sessions, nil
 }
 
+// GetSessionsByStatus retrieves all sessions in any of the given statuses,
+// oldest activity first
+func (s *SQLiteStore) GetSessionsByStatus(ctx context.Context, statuses []string) ([]*Session, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(statuses)), ", ")
+	args := make([]interface{}, len(statuses))
+	for i, status := range statuses {
+		args[i] = status
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT id FROM sessions WHERE status IN ("+placeholders+") ORDER BY last_activity_at ASC",
+		args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions by status: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan session id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to query sessions by status: %w", err)
+	}
+
+	sessions := make([]*Session, 0, len(ids))
+	for _, id := range ids {
+		session, err := s.GetSession(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
 // GetRecentWorkingDirs retrieves recently used working directories
 func (s *SQLiteStore) GetRecentWorkingDirs(ctx context.Context, limit int) ([]RecentPath, error) {
 	if limit <= 0 {
@@ -2161,16 +1324,23 @@ func (s *SQLiteStore) GetRecentWorkingDirs(ctx context.Context, limit int) ([]Re
 func (s *SQLiteStore) GetUserSettings(ctx context.Context) (*UserSettings, error) {
 	var settings UserSettings
 	err := s.db.QueryRowContext(ctx, `
-		SELECT advanced_providers, opt_in_telemetry, created_at, updated_at
+		SELECT advanced_providers, opt_in_telemetry, auto_title_mode, auto_title_model,
+			raw_event_retention_days, archived_session_retention_days, file_snapshot_retention_days,
+			dedupe_file_snapshots, created_at, updated_at
 		FROM user_settings WHERE id = 1
-	`).Scan(&settings.AdvancedProviders, &settings.OptInTelemetry, &settings.CreatedAt, &settings.UpdatedAt)
+	`).Scan(&settings.AdvancedProviders, &settings.OptInTelemetry, &settings.AutoTitleMode, &settings.AutoTitleModel,
+		&settings.RawEventRetentionDays, &settings.ArchivedSessionRetentionDays, &settings.FileSnapshotRetentionDays,
+		&settings.DedupeFileSnapshots, &settings.CreatedAt, &settings.UpdatedAt)
 
 	if err == sql.ErrNoRows {
 		// Return defaults if not found (for backwards compatibility)
 		return &UserSettings{
-			AdvancedProviders: false,
-			CreatedAt:         time.Now(),
-			UpdatedAt:         time.Now(),
+			AdvancedProviders:     false,
+			AutoTitleMode:         TitleModeHeuristic,
+			RawEventRetentionDays: DefaultRawEventRetentionDays,
+			DedupeFileSnapshots:   true,
+			CreatedAt:             time.Now(),
+			UpdatedAt:             time.Now(),
 		}, nil
 	}
 	return &settings, err
@@ -2180,9 +1350,13 @@ func (s *SQLiteStore) GetUserSettings(ctx context.Context) (*UserSettings, error
 func (s *SQLiteStore) UpdateUserSettings(ctx context.Context, settings UserSettings) error {
 	_, err := s.db.ExecContext(ctx, `
 		UPDATE user_settings
-		SET advanced_providers = ?, opt_in_telemetry = ?, updated_at = CURRENT_TIMESTAMP
+		SET advanced_providers = ?, opt_in_telemetry = ?, auto_title_mode = ?, auto_title_model = ?,
+			raw_event_retention_days = ?, archived_session_retention_days = ?, file_snapshot_retention_days = ?,
+			dedupe_file_snapshots = ?, updated_at = CURRENT_TIMESTAMP
 		WHERE id = 1
-	`, settings.AdvancedProviders, settings.OptInTelemetry)
+	`, settings.AdvancedProviders, settings.OptInTelemetry, settings.AutoTitleMode, settings.AutoTitleModel,
+		settings.RawEventRetentionDays, settings.ArchivedSessionRetentionDays, settings.FileSnapshotRetentionDays,
+		settings.DedupeFileSnapshots)
 	return err
 }
 
@@ -2213,8 +1387,9 @@ func (s *SQLiteStore) AddConversationEvent(ctx context.Context, event *Conversat
 			role, content,
 			tool_id, tool_name, tool_input_json, parent_tool_use_id,
 			tool_result_for_id, tool_result_content,
-			is_completed, approval_status, approval_id
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			is_completed, approval_status, approval_id,
+			compaction_trigger, pre_compaction_tokens, post_compaction_tokens
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	result, err := tx.ExecContext(ctx, query,
@@ -2223,6 +1398,7 @@ func (s *SQLiteStore) AddConversationEvent(ctx context.Context, event *Conversat
 		event.ToolID, event.ToolName, event.ToolInputJSON, event.ParentToolUseID,
 		event.ToolResultForID, event.ToolResultContent,
 		event.IsCompleted, event.ApprovalStatus, event.ApprovalID,
+		event.CompactionTrigger, event.PreCompactionTokens, event.PostCompactionTokens,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to add conversation event: %w", err)
@@ -2238,46 +1414,95 @@ func (s *SQLiteStore) AddConversationEvent(ctx context.Context, event *Conversat
 
 // GetConversation retrieves all events for a Claude session
 func (s *SQLiteStore) GetConversation(ctx context.Context, claudeSessionID string) ([]*ConversationEvent, error) {
-	query := `
-		SELECT id, session_id, claude_session_id, sequence, event_type, created_at,
-			role, content,
-			tool_id, tool_name, tool_input_json, parent_tool_use_id,
-			tool_result_for_id, tool_result_content,
-			is_completed, approval_status, approval_id
-		FROM conversation_events
-		WHERE claude_session_id = ?
-		ORDER BY sequence
-	`
+	return s.GetConversationPage(ctx, claudeSessionID, ConversationPage{})
+}
 
-	rows, err := s.db.QueryContext(ctx, query, claudeSessionID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get conversation: %w", err)
+// GetConversationPage retrieves a window of the events for a Claude session
+func (s *SQLiteStore) GetConversationPage(ctx context.Context, claudeSessionID string, page ConversationPage) ([]*ConversationEvent, error) {
+	if page.After == nil && page.SinceSequence > 0 {
+		page.After = &ConversationCursor{ClaudeSessionID: claudeSessionID, Sequence: page.SinceSequence}
 	}
-	defer func() { _ = rows.Close() }()
+	return s.queryConversation(ctx, []string{claudeSessionID}, page)
+}
 
-	var events []*ConversationEvent
-	for rows.Next() {
-		event := &ConversationEvent{}
-		err := rows.Scan(
-			&event.ID, &event.SessionID, &event.ClaudeSessionID,
-			&event.Sequence, &event.EventType, &event.CreatedAt,
-			&event.Role, &event.Content,
-			&event.ToolID, &event.ToolName, &event.ToolInputJSON, &event.ParentToolUseID,
-			&event.ToolResultForID, &event.ToolResultContent,
-			&event.IsCompleted, &event.ApprovalStatus, &event.ApprovalID,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan event: %w", err)
-		}
-		events = append(events, event)
+// scanCompactionFields copies nullable compaction columns onto an event
+func scanCompactionFields(event *ConversationEvent, trigger sql.NullString, preTokens, postTokens sql.NullInt64) {
+	event.CompactionTrigger = trigger.String
+	if preTokens.Valid {
+		tokens := int(preTokens.Int64)
+		event.PreCompactionTokens = &tokens
+	}
+	if postTokens.Valid {
+		tokens := int(postTokens.Int64)
+		event.PostCompactionTokens = &tokens
 	}
+}
 
-	return events, nil
+// UpdateCompactionEvent fills in a compaction event's summary or post-compaction token count
+func (s *SQLiteStore) UpdateCompactionEvent(ctx context.Context, eventID int64, updates CompactionUpdate) error {
+	setParts := []string{}
+	args := []interface{}{}
+
+	if updates.Summary != nil {
+		setParts = append(setParts, "content = ?")
+		args = append(args, *updates.Summary)
+	}
+	if updates.PostCompactionTokens != nil {
+		setParts = append(setParts, "post_compaction_tokens = ?")
+		args = append(args, *updates.PostCompactionTokens)
+	}
+	if len(setParts) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf(`UPDATE conversation_events SET %s WHERE id = ? AND event_type = ?`, strings.Join(setParts, ", "))
+	args = append(args, eventID, EventTypeCompaction)
+
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update compaction event: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "compaction event", ID: fmt.Sprintf("%d", eventID)}
+	}
+	return nil
 }
 
 // GetSessionConversation retrieves all events for a session including parent history
 func (s *SQLiteStore) GetSessionConversation(ctx context.Context, sessionID string) ([]*ConversationEvent, error) {
-	// Walk up the parent chain to get all related claude session IDs
+	return s.GetSessionConversationPage(ctx, sessionID, ConversationPage{})
+}
+
+// GetSessionConversationPage retrieves a window of the events for a session
+// including parent history
+func (s *SQLiteStore) GetSessionConversationPage(ctx context.Context, sessionID string, page ConversationPage) ([]*ConversationEvent, error) {
+	claudeSessionIDs, err := s.sessionClaudeChain(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(claudeSessionIDs) == 0 {
+		// No claude sessions yet, return empty
+		return []*ConversationEvent{}, nil
+	}
+
+	// Only the session's own Claude session can still grow, so polling by
+	// sequence skips the parent history entirely
+	if page.After == nil && page.SinceSequence > 0 {
+		page.After = &ConversationCursor{
+			ClaudeSessionID: claudeSessionIDs[len(claudeSessionIDs)-1],
+			Sequence:        page.SinceSequence,
+		}
+	}
+	return s.queryConversation(ctx, claudeSessionIDs, page)
+}
+
+// sessionClaudeChain walks up the parent chain of a session and returns the
+// Claude session IDs of it and its ancestors, oldest first
+func (s *SQLiteStore) sessionClaudeChain(ctx context.Context, sessionID string) ([]string, error) {
 	claudeSessionIDs := []string{}
 	currentID := sessionID
 	isFirstSession := true
@@ -2294,7 +1519,7 @@ func (s *SQLiteStore) GetSessionConversation(ctx context.Context, sessionID stri
 			if err == sql.ErrNoRows {
 				// If the requested session doesn't exist, return error
 				if isFirstSession {
-					return nil, fmt.Errorf("session not found: %s", sessionID)
+					return nil, &NotFoundError{Type: "session", ID: sessionID}
 				}
 				// Otherwise, parent not found, just stop walking
 				break
@@ -2316,20 +1541,38 @@ func (s *SQLiteStore) GetSessionConversation(ctx context.Context, sessionID stri
 		}
 	}
 
-	if len(claudeSessionIDs) == 0 {
-		// No claude sessions yet, return empty
-		return []*ConversationEvent{}, nil
+	return claudeSessionIDs, nil
+}
+
+// queryConversation retrieves the events of the given Claude sessions in
+// chronological order, treating them as one conversation in list order
+func (s *SQLiteStore) queryConversation(ctx context.Context, claudeSessionIDs []string, page ConversationPage) ([]*ConversationEvent, error) {
+	conditions := []string{}
+	var args []interface{}
+
+	if page.After != nil {
+		// Everything in Claude sessions before the cursor's has already been seen
+		idx := -1
+		for i, id := range claudeSessionIDs {
+			if id == page.After.ClaudeSessionID {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return nil, fmt.Errorf("%w: not part of this conversation", ErrInvalidCursor)
+		}
+		claudeSessionIDs = claudeSessionIDs[idx:]
+		conditions = append(conditions, "(claude_session_id != ? OR sequence > ?)")
+		args = append(args, page.After.ClaudeSessionID, page.After.Sequence)
 	}
 
-	// Get all events for all claude session IDs in chronological order
-	placeholders := make([]string, len(claudeSessionIDs))
-	args := make([]interface{}, len(claudeSessionIDs))
-	for i, id := range claudeSessionIDs {
-		placeholders[i] = "?"
-		args[i] = id
+	conditions = append(conditions, "claude_session_id IN ("+placeholders(len(claudeSessionIDs))+")")
+	for _, id := range claudeSessionIDs {
+		args = append(args, id)
 	}
 
-	// Build query that orders by the position in the claude session ID list first
+	// Order by the position in the claude session ID list first
 	// This ensures parent events come before child events
 	orderCases := make([]string, len(claudeSessionIDs))
 	for i := range claudeSessionIDs {
@@ -2342,13 +1585,18 @@ func (s *SQLiteStore) GetSessionConversation(ctx context.Context, sessionID stri
 			role, content,
 			tool_id, tool_name, tool_input_json, parent_tool_use_id,
 			tool_result_for_id, tool_result_content,
-			is_completed, approval_status, approval_id
+			is_completed, approval_status, approval_id,
+			compaction_trigger, pre_compaction_tokens, post_compaction_tokens
 		FROM conversation_events
-		WHERE claude_session_id IN (%s)
+		WHERE %s
 		ORDER BY
 			CASE %s END,
 			sequence
-	`, strings.Join(placeholders, ","), strings.Join(orderCases, " "))
+	`, strings.Join(conditions, " AND "), strings.Join(orderCases, " "))
+	if page.Limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, page.Limit)
+	}
 
 	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -2356,9 +1604,11 @@ func (s *SQLiteStore) GetSessionConversation(ctx context.Context, sessionID stri
 	}
 	defer func() { _ = rows.Close() }()
 
-	var events []*ConversationEvent
+	events := []*ConversationEvent{}
 	for rows.Next() {
 		event := &ConversationEvent{}
+		var compactionTrigger sql.NullString
+		var preCompactionTokens, postCompactionTokens sql.NullInt64
 		err := rows.Scan(
 			&event.ID, &event.SessionID, &event.ClaudeSessionID,
 			&event.Sequence, &event.EventType, &event.CreatedAt,
@@ -2366,12 +1616,17 @@ func (s *SQLiteStore) GetSessionConversation(ctx context.Context, sessionID stri
 			&event.ToolID, &event.ToolName, &event.ToolInputJSON, &event.ParentToolUseID,
 			&event.ToolResultForID, &event.ToolResultContent,
 			&event.IsCompleted, &event.ApprovalStatus, &event.ApprovalID,
+			&compactionTrigger, &preCompactionTokens, &postCompactionTokens,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan event: %w", err)
 		}
+		scanCompactionFields(event, compactionTrigger, preCompactionTokens, postCompactionTokens)
 		events = append(events, event)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate events: %w", err)
+	}
 
 	return events, nil
 }
@@ -2581,11 +1836,11 @@ func (s *SQLiteStore) CorrelateApproval(ctx context.Context, sessionID string, t
 		"tool_name", toolName,
 		"approval_id", approvalID)
 
-	// Update the found event
+	// Update the found event unless a concurrent correlation got there first
 	updateQuery := `
 		UPDATE conversation_events
 		SET approval_status = 'pending', approval_id = ?
-		WHERE id = ?
+		WHERE id = ? AND COALESCE(approval_status, '') = ''
 	`
 
 	result, err := s.db.ExecContext(ctx, updateQuery, approvalID, toolCall.ID)
@@ -0,0 +1,98 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+)
+
+// BackupDatabase writes a consistent copy of the database at dbPath to dest
+// with VACUUM INTO. It is safe while the daemon is writing to the database,
+// and the copy is compacted. dest must not exist yet.
+func BackupDatabase(ctx context.Context, dbPath, dest string) error {
+	if _, err := os.Stat(dbPath); err != nil {
+		return fmt.Errorf("failed to find database: %w", err)
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	// Write to a temporary name so an interrupted backup never looks complete
+	partial := dest + ".partial"
+	_ = os.Remove(partial)
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", partial); err != nil {
+		_ = os.Remove(partial)
+		return fmt.Errorf("failed to back up database: %w", err)
+	}
+	if err := os.Rename(partial, dest); err != nil {
+		_ = os.Remove(partial)
+		return fmt.Errorf("failed to finish backup: %w", err)
+	}
+	return nil
+}
+
+// DatabaseSchemaVersion reads the schema version of the database at path
+// without migrating it. It returns 0 for a database without a schema yet.
+func DatabaseSchemaVersion(ctx context.Context, path string) (int, error) {
+	if _, err := os.Stat(path); err != nil {
+		return 0, fmt.Errorf("failed to find database: %w", err)
+	}
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open database: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	var tables int
+	err = db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'schema_version'
+	`).Scan(&tables)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read schema: %w", err)
+	}
+	if tables == 0 {
+		return 0, nil
+	}
+
+	var version sql.NullInt64
+	if err := db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_version").Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	return int(version.Int64), nil
+}
+
+// ValidateDatabase checks that the database at path passes schema validation
+// and is not newer than this build, without migrating it. It returns the
+// database's schema version.
+func ValidateDatabase(ctx context.Context, path string) (int, error) {
+	version, err := DatabaseSchemaVersion(ctx, path)
+	if err != nil {
+		return 0, err
+	}
+	if version > LatestSchemaVersion {
+		return version, &SchemaTooNewError{Version: version, Latest: LatestSchemaVersion}
+	}
+
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return version, fmt.Errorf("failed to open database: %w", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	var integrity string
+	if err := db.QueryRowContext(ctx, "PRAGMA quick_check").Scan(&integrity); err != nil {
+		return version, fmt.Errorf("failed to check database integrity: %w", err)
+	}
+	if integrity != "ok" {
+		return version, fmt.Errorf("database integrity check failed: %s", integrity)
+	}
+
+	store := &SQLiteStore{db: db}
+	if err := store.validateSchema(); err != nil {
+		return version, err
+	}
+	return version, nil
+}
@@ -0,0 +1,67 @@
+package store
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupDatabase(t *testing.T) {
+	dbPath := testutil.DatabasePath(t, "sqlite-backup")
+	store, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	require.NoError(t, store.CreateSession(ctx, &Session{ID: "sess-1", RunID: "run-1", Status: SessionStatusRunning}))
+
+	// The copy is taken while the store still holds the database open
+	dest := filepath.Join(t.TempDir(), "copy.db")
+	require.NoError(t, BackupDatabase(ctx, dbPath, dest))
+
+	version, err := ValidateDatabase(ctx, dest)
+	require.NoError(t, err)
+	assert.Equal(t, LatestSchemaVersion, version)
+
+	copied, err := NewSQLiteStore(dest)
+	require.NoError(t, err)
+	defer func() { _ = copied.Close() }()
+	session, err := copied.GetSession(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Equal(t, "run-1", session.RunID)
+}
+
+func TestValidateDatabase(t *testing.T) {
+	ctx := context.Background()
+	dbPath := testutil.DatabasePath(t, "sqlite-validate")
+	store, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+
+	t.Run("rejects a newer schema", func(t *testing.T) {
+		_, err := store.db.Exec(`INSERT INTO schema_version (version, description) VALUES (?, 'from the future')`,
+			LatestSchemaVersion+1)
+		require.NoError(t, err)
+
+		version, err := ValidateDatabase(ctx, dbPath)
+		assert.Error(t, err)
+		assert.Equal(t, LatestSchemaVersion+1, version)
+	})
+
+	t.Run("rejects a database without the schema", func(t *testing.T) {
+		_, err := store.db.Exec(`DROP TABLE user_settings`)
+		require.NoError(t, err)
+		_, err = store.db.Exec(`DELETE FROM schema_version WHERE version > ?`, LatestSchemaVersion)
+		require.NoError(t, err)
+
+		_, err = ValidateDatabase(ctx, dbPath)
+		assert.ErrorContains(t, err, "user_settings table missing")
+	})
+	require.NoError(t, store.Close())
+
+	_, err = ValidateDatabase(ctx, filepath.Join(t.TempDir(), "missing.db"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchGroupCRUD(t *testing.T) {
+	dbPath := testutil.DatabasePath(t, "sqlite-batches")
+	store, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+
+	require.NoError(t, store.CreateBatchGroup(ctx, &BatchGroup{
+		ID:     "batch-1",
+		Name:   "model eval",
+		Query:  "Fix the flaky test",
+		Matrix: `{"models":["sonnet"]}`,
+	}))
+
+	got, err := store.GetBatchGroup(ctx, "batch-1")
+	require.NoError(t, err)
+	assert.Equal(t, "model eval", got.Name)
+	assert.Equal(t, "Fix the flaky test", got.Query)
+	assert.False(t, got.CreatedAt.IsZero())
+
+	require.NoError(t, store.AddBatchMember(ctx, &BatchMember{
+		GroupID: "batch-1", MemberIndex: 1, Label: "openrouter/openai/gpt-4o",
+		Model: "openai/gpt-4o", Provider: "openrouter", ErrorMessage: "launch failed",
+	}))
+	require.NoError(t, store.AddBatchMember(ctx, &BatchMember{
+		GroupID: "batch-1", MemberIndex: 0, SessionID: "sess-1", Label: "sonnet",
+		Model: "sonnet", WorkingDir: "/tmp/project",
+	}))
+
+	members, err := store.GetBatchMembers(ctx, "batch-1")
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "sess-1", members[0].SessionID)
+	assert.Equal(t, "/tmp/project", members[0].WorkingDir)
+	assert.Empty(t, members[1].SessionID)
+	assert.Equal(t, "openrouter", members[1].Provider)
+	assert.Equal(t, "launch failed", members[1].ErrorMessage)
+
+	groups, err := store.ListBatchGroups(ctx)
+	require.NoError(t, err)
+	assert.Len(t, groups, 1)
+
+	_, err = store.GetBatchGroup(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
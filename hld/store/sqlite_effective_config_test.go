@@ -0,0 +1,41 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionEffectiveConfig(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-effective-config"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, store.CreateSession(ctx, &Session{
+		ID:             "sess-1",
+		RunID:          "run-1",
+		Status:         SessionStatusRunning,
+		CreatedAt:      now,
+		LastActivityAt: now,
+	}))
+
+	// Nothing is recorded until the session's config is set
+	config, err := store.GetSessionEffectiveConfig(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Empty(t, config)
+
+	require.NoError(t, store.SetSessionEffectiveConfig(ctx, "sess-1", `{"model":"opus","sources":{"model":"project"}}`))
+	config, err = store.GetSessionEffectiveConfig(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"opus","sources":{"model":"project"}}`, config)
+
+	_, err = store.GetSessionEffectiveConfig(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.SetSessionEffectiveConfig(ctx, "missing", "{}"), ErrNotFound)
+}
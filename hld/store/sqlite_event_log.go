@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// AppendEventLog stores an entry, setting its Seq
+func (s *SQLiteStore) AppendEventLog(ctx context.Context, entry *EventLogEntry) error {
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		INSERT INTO event_log (type, data, created_at) VALUES (?, ?, ?)
+	`, entry.Type, entry.Data, entry.CreatedAt.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to insert event log entry: %w", err)
+	}
+	if entry.Seq, err = result.LastInsertId(); err != nil {
+		return fmt.Errorf("failed to get event log sequence: %w", err)
+	}
+	return nil
+}
+
+// TrimEventLog deletes all but the newest keep entries and returns how many
+// it deleted. A keep of zero or less keeps every entry.
+func (s *SQLiteStore) TrimEventLog(ctx context.Context, keep int) (int64, error) {
+	if keep <= 0 {
+		return 0, nil
+	}
+	result, err := s.db.ExecContext(ctx, `
+		DELETE FROM event_log
+		WHERE seq <= (SELECT MAX(seq) FROM event_log) - ?
+	`, keep)
+	if err != nil {
+		return 0, fmt.Errorf("failed to trim event log: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return deleted, nil
+}
+
+// GetRecentEventLog returns the newest limit entries, oldest first. A limit
+// of zero or less returns every entry.
+func (s *SQLiteStore) GetRecentEventLog(ctx context.Context, limit int) ([]*EventLogEntry, error) {
+	if limit <= 0 {
+		limit = -1
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT seq, type, data, created_at FROM (
+			SELECT * FROM event_log ORDER BY seq DESC LIMIT ?
+		)
+		ORDER BY seq ASC
+	`, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query event log: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var entries []*EventLogEntry
+	for rows.Next() {
+		var entry EventLogEntry
+		if err := rows.Scan(&entry.Seq, &entry.Type, &entry.Data, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan event log entry: %w", err)
+		}
+		entries = append(entries, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate event log: %w", err)
+	}
+	return entries, nil
+}
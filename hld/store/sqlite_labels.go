@@ -0,0 +1,243 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateLabel creates a new label. Names are unique regardless of case.
+func (s *SQLiteStore) CreateLabel(ctx context.Context, label *Label) error {
+	if label.CreatedAt.IsZero() {
+		label.CreatedAt = time.Now().UTC()
+	}
+
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO labels (id, name, color, created_at) VALUES (?, ?, ?, ?)
+	`, label.ID, label.Name, label.Color, label.CreatedAt.UTC())
+	if isUniqueViolation(err) {
+		return &AlreadyExistsError{Type: "label", Name: label.Name}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create label: %w", err)
+	}
+	return nil
+}
+
+// ListLabels retrieves all labels with the number of sessions using each
+func (s *SQLiteStore) ListLabels(ctx context.Context) ([]*Label, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT l.id, l.name, l.color, l.created_at, COUNT(sl.session_id)
+		FROM labels l LEFT JOIN session_labels sl ON sl.label_id = l.id
+		GROUP BY l.id
+		ORDER BY l.name COLLATE NOCASE
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var labels []*Label
+	for rows.Next() {
+		var label Label
+		if err := rows.Scan(&label.ID, &label.Name, &label.Color, &label.CreatedAt, &label.SessionCount); err != nil {
+			return nil, fmt.Errorf("failed to scan label: %w", err)
+		}
+		labels = append(labels, &label)
+	}
+	return labels, rows.Err()
+}
+
+// DeleteLabel removes a label from every session and deletes it
+func (s *SQLiteStore) DeleteLabel(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM labels WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "label", ID: id}
+	}
+	return nil
+}
+
+// SetSessionLabels replaces a session's labels, creating labels that do not exist yet
+func (s *SQLiteStore) SetSessionLabels(ctx context.Context, sessionID string, names []string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var exists int
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM sessions WHERE id = ?`, sessionID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check session: %w", err)
+	}
+	if exists == 0 {
+		return &NotFoundError{Type: "session", ID: sessionID}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM session_labels WHERE session_id = ?`, sessionID); err != nil {
+		return fmt.Errorf("failed to clear session labels: %w", err)
+	}
+
+	if err := addSessionLabels(ctx, tx, sessionID, names); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit session labels: %w", err)
+	}
+	return nil
+}
+
+// addSessionLabels attaches labels by name, creating labels that do not exist
+func addSessionLabels(ctx context.Context, tx *sql.Tx, sessionID string, names []string) error {
+	now := time.Now().UTC()
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		var labelID string
+		err := tx.QueryRowContext(ctx, `SELECT id FROM labels WHERE name = ?`, name).Scan(&labelID)
+		if err == sql.ErrNoRows {
+			labelID = uuid.New().String()
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO labels (id, name, created_at) VALUES (?, ?, ?)
+			`, labelID, name, now)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to resolve label %q: %w", name, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT OR IGNORE INTO session_labels (session_id, label_id, created_at) VALUES (?, ?, ?)
+		`, sessionID, labelID, now)
+		if err != nil {
+			return fmt.Errorf("failed to add label %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// GetSessionLabels retrieves the label names attached to a session
+func (s *SQLiteStore) GetSessionLabels(ctx context.Context, sessionID string) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT l.name
+		FROM session_labels sl JOIN labels l ON l.id = sl.label_id
+		WHERE sl.session_id = ?
+		ORDER BY l.name COLLATE NOCASE
+	`, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session labels: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan session label: %w", err)
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+// UpsertProject returns the project rooted at rootPath, creating it if needed.
+// The project is named after the root directory.
+func (s *SQLiteStore) UpsertProject(ctx context.Context, rootPath string) (*Project, error) {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO projects (id, root_path, name, created_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT(root_path) DO NOTHING
+	`, uuid.New().String(), rootPath, filepath.Base(rootPath), time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	var project Project
+	err = s.db.QueryRowContext(ctx, `
+		SELECT id, root_path, name, created_at FROM projects WHERE root_path = ?
+	`, rootPath).Scan(&project.ID, &project.RootPath, &project.Name, &project.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	return &project, nil
+}
+
+// ListProjects retrieves all projects, most recently active first
+func (s *SQLiteStore) ListProjects(ctx context.Context) ([]*Project, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT p.id, p.root_path, p.name, p.created_at, COUNT(s.id), datetime(MAX(s.last_activity_at))
+		FROM projects p LEFT JOIN sessions s ON s.project_id = p.id
+		GROUP BY p.id
+		ORDER BY MAX(s.last_activity_at) DESC, p.name
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var projects []*Project
+	for rows.Next() {
+		var project Project
+		var lastActivity sql.NullString
+		if err := rows.Scan(&project.ID, &project.RootPath, &project.Name, &project.CreatedAt,
+			&project.SessionCount, &lastActivity); err != nil {
+			return nil, fmt.Errorf("failed to scan project: %w", err)
+		}
+		if lastActivity.Valid {
+			if t, err := time.Parse("2006-01-02 15:04:05", lastActivity.String); err == nil {
+				project.LastActivityAt = &t
+			}
+		}
+		projects = append(projects, &project)
+	}
+	return projects, rows.Err()
+}
+
+// GetUnassignedWorkingDirs lists the distinct working directories of sessions
+// that have no project yet
+func (s *SQLiteStore) GetUnassignedWorkingDirs(ctx context.Context) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT DISTINCT working_dir FROM sessions
+		WHERE project_id IS NULL AND working_dir IS NOT NULL AND working_dir != ''
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unassigned working directories: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var dirs []string
+	for rows.Next() {
+		var dir string
+		if err := rows.Scan(&dir); err != nil {
+			return nil, fmt.Errorf("failed to scan working directory: %w", err)
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs, rows.Err()
+}
+
+// AssignProjectByWorkingDir sets the project of unassigned sessions in workingDir
+func (s *SQLiteStore) AssignProjectByWorkingDir(ctx context.Context, workingDir string, projectID string) error {
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE sessions SET project_id = ? WHERE working_dir = ? AND project_id IS NULL
+	`, projectID, workingDir)
+	if err != nil {
+		return fmt.Errorf("failed to assign project: %w", err)
+	}
+	return nil
+}
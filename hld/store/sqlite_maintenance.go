@@ -0,0 +1,453 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+)
+
+// pruneBatchSize bounds each delete so maintenance never holds the write lock
+// for long while sessions are streaming events
+const pruneBatchSize = 1000
+
+// sessionDataTables hold rows owned by a session and are cleared before the
+// session itself is deleted
+var sessionDataTables = []string{
+	"conversation_events",
+	"mcp_servers",
+	"raw_events",
+	"approvals",
+	"file_snapshots",
+	"session_resource_samples",
+	"session_labels",
+}
+
+// sessionReferenceTables point at sessions they launched. Their sessions are
+// kept by pruning so batch, schedule and pipeline history stays loadable.
+var sessionReferenceTables = []string{
+	"batch_members",
+	"schedule_runs",
+	"pipeline_steps",
+}
+
+// sessionPayloadQuery approximates the stored size of the listed sessions'
+// conversations, raw events and file snapshots
+const sessionPayloadQuery = `
+	SELECT
+		(SELECT COALESCE(SUM(LENGTH(COALESCE(content, '')) + LENGTH(COALESCE(tool_input_json, ''))
+			+ LENGTH(COALESCE(tool_result_content, ''))), 0)
+			FROM conversation_events WHERE session_id IN (%[1]s))
+		+ (SELECT COALESCE(SUM(LENGTH(event_json)), 0) FROM raw_events WHERE session_id IN (%[1]s))
+		+ (SELECT COALESCE(SUM(LENGTH(content)), 0) FROM file_snapshots WHERE session_id IN (%[1]s))
+`
+
+// RunMaintenance prunes data past the retention policy, then optionally
+// vacuums and analyzes the database. Dry runs only count what would be pruned.
+func (s *SQLiteStore) RunMaintenance(ctx context.Context, opts MaintenanceOptions) (*MaintenanceReport, error) {
+	switch opts.Vacuum {
+	case "":
+		opts.Vacuum = VacuumNone
+	case VacuumNone, VacuumIncremental, VacuumFull:
+	default:
+		return nil, fmt.Errorf("unknown vacuum mode: %s", opts.Vacuum)
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	report := &MaintenanceReport{
+		DryRun:    opts.DryRun,
+		Vacuum:    VacuumNone,
+		StartedAt: time.Now(),
+	}
+
+	size, _, err := s.databaseSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.SizeBeforeBytes = size
+
+	retention := opts.Retention
+	if retention.RawEventTTL > 0 {
+		report.RawEvents, err = s.pruneRows(ctx, "raw_events", "LENGTH(event_json)",
+			`SELECT id FROM raw_events WHERE julianday(created_at) < julianday(?)`,
+			[]interface{}{sqliteTimestamp(now.Add(-retention.RawEventTTL))}, opts.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prune raw events: %w", err)
+		}
+	}
+	if retention.ArchivedSessionTTL > 0 {
+		report.ArchivedSessions, err = s.pruneArchivedSessions(ctx, now.Add(-retention.ArchivedSessionTTL), opts.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prune archived sessions: %w", err)
+		}
+	}
+	if retention.FileSnapshotTTL > 0 {
+		report.ExpiredFileSnapshots, err = s.pruneRows(ctx, "file_snapshots", "LENGTH(content)",
+			`SELECT id FROM file_snapshots WHERE julianday(created_at) < julianday(?)`,
+			[]interface{}{sqliteTimestamp(now.Add(-retention.FileSnapshotTTL))}, opts.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prune file snapshots: %w", err)
+		}
+	}
+	if retention.DedupeFileSnapshots {
+		// Readers use the latest snapshot of each file, so of several identical
+		// snapshots in a row only the last one is kept
+		report.DuplicateFileSnapshots, err = s.pruneRows(ctx, "file_snapshots", "LENGTH(content)", `
+			SELECT f.id FROM file_snapshots f
+			WHERE f.content = (
+				SELECT n.content FROM file_snapshots n
+				WHERE n.session_id = f.session_id AND n.file_path = f.file_path AND n.id > f.id
+				ORDER BY n.id LIMIT 1
+			)`, nil, opts.DryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dedupe file snapshots: %w", err)
+		}
+	}
+
+	if !opts.DryRun {
+		if report.Vacuum, err = s.vacuum(ctx, opts.Vacuum, opts.VacuumPages); err != nil {
+			return nil, err
+		}
+		if opts.Analyze {
+			if err := s.analyze(ctx); err != nil {
+				return nil, err
+			}
+			report.Analyzed = true
+		}
+	}
+
+	if report.AutoVacuum, err = s.autoVacuumMode(ctx); err != nil {
+		return nil, err
+	}
+	size, free, err := s.databaseSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.SizeAfterBytes = size
+	report.FreeBytes = free
+	if report.SizeBeforeBytes > size {
+		report.ReclaimedBytes = report.SizeBeforeBytes - size
+	}
+	report.CompletedAt = time.Now()
+
+	return report, nil
+}
+
+// sqliteTimestamp formats t like CURRENT_TIMESTAMP so it compares with
+// column defaults
+func sqliteTimestamp(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04:05")
+}
+
+// pruneRows deletes the rows of table whose ids selection returns, in batches.
+// sizeExpr estimates each row's payload for the report.
+func (s *SQLiteStore) pruneRows(ctx context.Context, table, sizeExpr, selection string, args []interface{}, dryRun bool) (PruneCount, error) {
+	var count PruneCount
+	err := s.db.QueryRowContext(ctx, fmt.Sprintf(`
+		SELECT COUNT(*), COALESCE(SUM(%s), 0) FROM %s WHERE id IN (%s)
+	`, sizeExpr, table, selection), args...).Scan(&count.Rows, &count.Bytes)
+	if err != nil {
+		return count, fmt.Errorf("failed to count %s: %w", table, err)
+	}
+	if dryRun || count.Rows == 0 {
+		return count, nil
+	}
+
+	deleteQuery := fmt.Sprintf(`DELETE FROM %s WHERE id IN (SELECT id FROM (%s) LIMIT %d)`, table, selection, pruneBatchSize)
+	var deleted int64
+	for {
+		result, err := s.db.ExecContext(ctx, deleteQuery, args...)
+		if err != nil {
+			return count, fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return count, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		deleted += n
+		if n < pruneBatchSize {
+			break
+		}
+	}
+	count.Rows = deleted
+
+	return count, nil
+}
+
+// pruneArchivedSessions deletes archived sessions inactive since before cutoff,
+// along with everything they own
+func (s *SQLiteStore) pruneArchivedSessions(ctx context.Context, cutoff time.Time, dryRun bool) (PruneCount, error) {
+	var count PruneCount
+	ids, err := s.expiredArchivedSessions(ctx, cutoff)
+	if err != nil {
+		return count, err
+	}
+
+	for start := 0; start < len(ids); start += pruneBatchSize {
+		batch := ids[start:min(start+pruneBatchSize, len(ids))]
+		placeholders := strings.TrimSuffix(strings.Repeat("?,", len(batch)), ",")
+		args := make([]interface{}, len(batch))
+		for i, id := range batch {
+			args[i] = id
+		}
+
+		var bytes int64
+		payloadArgs := append(append(append([]interface{}{}, args...), args...), args...)
+		err := s.db.QueryRowContext(ctx, fmt.Sprintf(sessionPayloadQuery, placeholders), payloadArgs...).Scan(&bytes)
+		if err != nil {
+			return count, fmt.Errorf("failed to measure archived sessions: %w", err)
+		}
+		count.Bytes += bytes
+		if dryRun {
+			count.Rows += int64(len(batch))
+			continue
+		}
+
+		deleted, err := s.deleteSessions(ctx, placeholders, args)
+		if err != nil {
+			return count, err
+		}
+		count.Rows += deleted
+	}
+
+	return count, nil
+}
+
+// expiredArchivedSessions lists archived sessions inactive since before
+// cutoff. A session that is still the parent of a session being kept stays,
+// so continued conversations never lose their history, and so does a session
+// a batch, schedule run or pipeline step still points at.
+func (s *SQLiteStore) expiredArchivedSessions(ctx context.Context, cutoff time.Time) ([]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id FROM sessions
+		WHERE archived = 1 AND julianday(COALESCE(last_activity_at, created_at)) < julianday(?)
+	`, cutoff.UTC().Format(time.RFC3339Nano))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archived sessions: %w", err)
+	}
+	expired := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		expired[id] = true
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(expired) == 0 {
+		return nil, nil
+	}
+
+	// Batch, schedule and pipeline records keep the sessions they launched
+	for _, table := range sessionReferenceTables {
+		rows, err = s.db.QueryContext(ctx, fmt.Sprintf(`
+			SELECT DISTINCT session_id FROM %s WHERE session_id IS NOT NULL
+		`, table))
+		if err != nil {
+			return nil, fmt.Errorf("failed to query %s sessions: %w", table, err)
+		}
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				_ = rows.Close()
+				return nil, fmt.Errorf("failed to scan %s session: %w", table, err)
+			}
+			delete(expired, id)
+		}
+		_ = rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err = s.db.QueryContext(ctx, `
+		SELECT id, parent_session_id FROM sessions
+		WHERE parent_session_id IS NOT NULL AND parent_session_id != ''
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query session parents: %w", err)
+	}
+	parents := make(map[string]string)
+	for rows.Next() {
+		var id, parentID string
+		if err := rows.Scan(&id, &parentID); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan session parent: %w", err)
+		}
+		parents[id] = parentID
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Keeping a session keeps its parent, which may in turn keep its own parent
+	for changed := true; changed; {
+		changed = false
+		for id, parentID := range parents {
+			if !expired[id] && expired[parentID] {
+				delete(expired, parentID)
+				changed = true
+			}
+		}
+	}
+
+	ids := make([]string, 0, len(expired))
+	for id := range expired {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// deleteSessions deletes the sessions matching the placeholders and their data
+// in one transaction
+func (s *SQLiteStore) deleteSessions(ctx context.Context, placeholders string, args []interface{}) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, table := range sessionDataTables {
+		query := fmt.Sprintf(`DELETE FROM %s WHERE session_id IN (%s)`, table, placeholders)
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return 0, fmt.Errorf("failed to delete from %s: %w", table, err)
+		}
+	}
+	result, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM sessions WHERE id IN (%s)`, placeholders), args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete sessions: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit session deletion: %w", err)
+	}
+	return deleted, nil
+}
+
+// vacuum runs the requested vacuum and reports the one that ran. Incremental
+// vacuum is skipped until a full vacuum has switched the database over.
+func (s *SQLiteStore) vacuum(ctx context.Context, mode string, pages int) (string, error) {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return VacuumNone, fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	switch mode {
+	case VacuumFull:
+		// Changing auto_vacuum on an existing database takes effect on the
+		// next VACUUM run on the same connection
+		if _, err := conn.ExecContext(ctx, "PRAGMA auto_vacuum = INCREMENTAL"); err != nil {
+			return VacuumNone, fmt.Errorf("failed to enable incremental auto-vacuum: %w", err)
+		}
+		if _, err := conn.ExecContext(ctx, "VACUUM"); err != nil {
+			return VacuumNone, fmt.Errorf("failed to vacuum database: %w", err)
+		}
+
+	case VacuumIncremental:
+		autoVacuum, err := s.autoVacuumMode(ctx)
+		if err != nil {
+			return VacuumNone, err
+		}
+		if autoVacuum != VacuumIncremental {
+			slog.Info("skipping incremental vacuum, run a full vacuum to enable it", "auto_vacuum", autoVacuum)
+			return VacuumNone, nil
+		}
+		// The pragma frees one page per step, so the result has to be drained
+		rows, err := conn.QueryContext(ctx, fmt.Sprintf("PRAGMA incremental_vacuum(%d)", max(pages, 0)))
+		if err != nil {
+			return VacuumNone, fmt.Errorf("failed to run incremental vacuum: %w", err)
+		}
+		for rows.Next() {
+			// Each row is a page returned to the filesystem
+		}
+		_ = rows.Close()
+		if err := rows.Err(); err != nil {
+			return VacuumNone, fmt.Errorf("failed to run incremental vacuum: %w", err)
+		}
+
+	default:
+		return VacuumNone, nil
+	}
+
+	// Freed pages only leave the file once the WAL is checkpointed
+	if _, err := conn.ExecContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)"); err != nil {
+		return mode, fmt.Errorf("failed to checkpoint WAL: %w", err)
+	}
+	return mode, nil
+}
+
+// analyze refreshes query planner statistics, sampling large indexes rather
+// than reading them in full
+func (s *SQLiteStore) analyze(ctx context.Context) error {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if _, err := conn.ExecContext(ctx, "PRAGMA analysis_limit = 1000"); err != nil {
+		return fmt.Errorf("failed to set analysis limit: %w", err)
+	}
+	if _, err := conn.ExecContext(ctx, "ANALYZE"); err != nil {
+		return fmt.Errorf("failed to analyze database: %w", err)
+	}
+	return nil
+}
+
+// autoVacuumMode reports the database's auto_vacuum setting as none, full or
+// incremental. Connections cache the setting until they next read the
+// schema, so the schema is read first on the same connection.
+func (s *SQLiteStore) autoVacuumMode(ctx context.Context) (string, error) {
+	conn, err := s.db.Conn(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	var tables, mode int
+	if err := conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master").Scan(&tables); err != nil {
+		return "", fmt.Errorf("failed to read schema: %w", err)
+	}
+	if err := conn.QueryRowContext(ctx, "PRAGMA auto_vacuum").Scan(&mode); err != nil {
+		return "", fmt.Errorf("failed to read auto_vacuum: %w", err)
+	}
+	switch mode {
+	case 1:
+		return VacuumFull, nil
+	case 2:
+		return VacuumIncremental, nil
+	default:
+		return VacuumNone, nil
+	}
+}
+
+// databaseSize returns the size of the database and of its unused pages in bytes
+func (s *SQLiteStore) databaseSize(ctx context.Context) (size int64, free int64, err error) {
+	var pageCount, pageSize, freePages int64
+	if err := s.db.QueryRowContext(ctx, "PRAGMA page_count").Scan(&pageCount); err != nil {
+		return 0, 0, fmt.Errorf("failed to read page count: %w", err)
+	}
+	if err := s.db.QueryRowContext(ctx, "PRAGMA page_size").Scan(&pageSize); err != nil {
+		return 0, 0, fmt.Errorf("failed to read page size: %w", err)
+	}
+	if err := s.db.QueryRowContext(ctx, "PRAGMA freelist_count").Scan(&freePages); err != nil {
+		return 0, 0, fmt.Errorf("failed to read freelist count: %w", err)
+	}
+	return pageCount * pageSize, freePages * pageSize, nil
+}
@@ -0,0 +1,177 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunMaintenance(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-maintenance"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	now := time.Now()
+	old := now.Add(-90 * 24 * time.Hour)
+
+	for _, s := range []*Session{
+		{ID: "expired", LastActivityAt: old, Archived: true},
+		{ID: "expired-parent", LastActivityAt: old, Archived: true},
+		{ID: "kept-child", ParentSessionID: "expired-parent", LastActivityAt: old},
+		{ID: "recently-archived", LastActivityAt: now, Archived: true},
+		{ID: "active", LastActivityAt: now},
+	} {
+		s.RunID = "run-" + s.ID
+		s.Status = SessionStatusCompleted
+		s.CreatedAt = s.LastActivityAt
+		require.NoError(t, store.CreateSession(ctx, s))
+	}
+
+	// Give the expired session data in every table it owns
+	require.NoError(t, store.AddConversationEvent(ctx, &ConversationEvent{
+		SessionID: "expired", ClaudeSessionID: "claude-expired", EventType: EventTypeMessage, Role: "user", Content: "hello",
+	}))
+	require.NoError(t, store.CreateApproval(ctx, &Approval{
+		ID: "approval-1", RunID: "run-expired", SessionID: "expired", Status: ApprovalStatusLocalApproved,
+		CreatedAt: old, ToolName: "Bash", ToolInput: json.RawMessage(`{}`),
+	}))
+	require.NoError(t, store.SetSessionLabels(ctx, "expired", []string{"old"}))
+
+	for _, sessionID := range []string{"expired", "active", "active"} {
+		require.NoError(t, store.StoreRawEvent(ctx, sessionID, `{"type":"assistant"}`))
+	}
+	require.NoError(t, store.StoreRawEvent(ctx, "active", `{"type":"result"}`))
+	_, err = store.db.Exec(`UPDATE raw_events SET created_at = ? WHERE event_json = '{"type":"assistant"}'`,
+		sqliteTimestamp(old))
+	require.NoError(t, err)
+
+	// The same file read repeatedly, changing once in between
+	for _, content := range []string{"v1", "v1", "v2", "v1"} {
+		require.NoError(t, store.CreateFileSnapshot(ctx, &FileSnapshot{
+			ToolID: "tool-" + content, SessionID: "active", FilePath: "main.go", Content: content,
+		}))
+	}
+
+	policy := RetentionPolicy{
+		RawEventTTL:         30 * 24 * time.Hour,
+		ArchivedSessionTTL:  30 * 24 * time.Hour,
+		DedupeFileSnapshots: true,
+	}
+
+	t.Run("dry run changes nothing", func(t *testing.T) {
+		report, err := store.RunMaintenance(ctx, MaintenanceOptions{Retention: policy, DryRun: true, Vacuum: VacuumFull})
+		require.NoError(t, err)
+		assert.True(t, report.DryRun)
+		assert.Equal(t, int64(3), report.RawEvents.Rows)
+		assert.Equal(t, int64(1), report.ArchivedSessions.Rows)
+		assert.Equal(t, int64(1), report.DuplicateFileSnapshots.Rows)
+		assert.Equal(t, int64(2), report.DuplicateFileSnapshots.Bytes)
+		assert.Equal(t, VacuumNone, report.Vacuum)
+		assert.False(t, report.Analyzed)
+
+		var rawEvents int
+		require.NoError(t, store.db.QueryRow(`SELECT COUNT(*) FROM raw_events`).Scan(&rawEvents))
+		assert.Equal(t, 4, rawEvents)
+		_, err = store.GetSession(ctx, "expired")
+		assert.NoError(t, err)
+	})
+
+	t.Run("incremental vacuum needs a full vacuum first", func(t *testing.T) {
+		// Databases created before incremental auto-vacuum was enabled
+		conn, err := store.db.Conn(ctx)
+		require.NoError(t, err)
+		_, err = conn.ExecContext(ctx, "PRAGMA auto_vacuum = NONE")
+		require.NoError(t, err)
+		_, err = conn.ExecContext(ctx, "VACUUM")
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+
+		report, err := store.RunMaintenance(ctx, MaintenanceOptions{Vacuum: VacuumIncremental})
+		require.NoError(t, err)
+		assert.Equal(t, VacuumNone, report.Vacuum)
+		assert.Equal(t, VacuumNone, report.AutoVacuum)
+	})
+
+	t.Run("prunes past retention", func(t *testing.T) {
+		report, err := store.RunMaintenance(ctx, MaintenanceOptions{Retention: policy, Vacuum: VacuumFull, Analyze: true})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), report.RawEvents.Rows)
+		assert.Equal(t, int64(1), report.ArchivedSessions.Rows)
+		assert.Equal(t, int64(1), report.DuplicateFileSnapshots.Rows)
+		assert.Equal(t, VacuumFull, report.Vacuum)
+		assert.Equal(t, VacuumIncremental, report.AutoVacuum)
+		assert.True(t, report.Analyzed)
+		assert.Positive(t, report.SizeAfterBytes)
+
+		_, err = store.GetSession(ctx, "expired")
+		assert.Error(t, err)
+		// A parent stays while a kept session continues from it
+		for _, id := range []string{"expired-parent", "kept-child", "recently-archived", "active"} {
+			_, err := store.GetSession(ctx, id)
+			assert.NoError(t, err, id)
+		}
+
+		var rawEvents, approvals int
+		require.NoError(t, store.db.QueryRow(`SELECT COUNT(*) FROM raw_events`).Scan(&rawEvents))
+		assert.Equal(t, 1, rawEvents)
+		require.NoError(t, store.db.QueryRow(`SELECT COUNT(*) FROM approvals`).Scan(&approvals))
+		assert.Zero(t, approvals)
+
+		snapshots, err := store.GetFileSnapshots(ctx, "active")
+		require.NoError(t, err)
+		contents := make([]string, 0, len(snapshots))
+		for _, s := range snapshots {
+			contents = append(contents, s.Content)
+		}
+		assert.ElementsMatch(t, []string{"v1", "v2", "v1"}, contents)
+	})
+
+	t.Run("incremental vacuum after switching over", func(t *testing.T) {
+		report, err := store.RunMaintenance(ctx, MaintenanceOptions{Retention: policy, Vacuum: VacuumIncremental, VacuumPages: 100})
+		require.NoError(t, err)
+		assert.Equal(t, VacuumIncremental, report.Vacuum)
+		assert.Zero(t, report.RawEvents.Rows)
+	})
+
+	t.Run("snapshot ttl", func(t *testing.T) {
+		report, err := store.RunMaintenance(ctx, MaintenanceOptions{
+			Retention: RetentionPolicy{FileSnapshotTTL: time.Hour},
+			Now:       now.Add(2 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), report.ExpiredFileSnapshots.Rows)
+	})
+
+	_, err = store.RunMaintenance(ctx, MaintenanceOptions{Vacuum: "sometimes"})
+	assert.Error(t, err)
+}
+
+func TestRetentionSettings(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-retention-settings"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	settings, err := store.GetUserSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRawEventRetentionDays, settings.RawEventRetentionDays)
+	assert.Zero(t, settings.ArchivedSessionRetentionDays)
+	assert.True(t, settings.DedupeFileSnapshots)
+
+	settings.ArchivedSessionRetentionDays = 14
+	settings.DedupeFileSnapshots = false
+	require.NoError(t, store.UpdateUserSettings(ctx, *settings))
+
+	settings, err = store.GetUserSettings(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, RetentionPolicy{
+		RawEventTTL:        time.Duration(DefaultRawEventRetentionDays) * 24 * time.Hour,
+		ArchivedSessionTTL: 14 * 24 * time.Hour,
+	}, settings.RetentionPolicy())
+}
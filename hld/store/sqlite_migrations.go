@@ -0,0 +1,305 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// sqliteMigrationLockStale is how old a migration lock must be before it is
+// taken as left behind by a process that crashed while migrating
+const sqliteMigrationLockStale = 10 * time.Minute
+
+const sqliteMigrationLockPoll = 100 * time.Millisecond
+
+// NewSQLiteMigrator returns a migrator for the SQLite database db
+func NewSQLiteMigrator(db *sql.DB) *Migrator {
+	return newMigrator(db, sqliteMigrationDialect{}, sqliteMigrations)
+}
+
+// OpenSQLiteMigrator opens the SQLite database at path for migrating it
+// without opening a store. The database must exist.
+func OpenSQLiteMigrator(path string) (*Migrator, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("failed to find database: %w", err)
+	}
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to enable foreign keys: %w", err)
+	}
+	return NewSQLiteMigrator(db), nil
+}
+
+// sqliteMigrationDialect migrates SQLite databases. SQLite has no advisory
+// locks, so the migration lock is a row in schema_migration_lock.
+//
+// Databases have been migrated by several generations of code, and some
+// columns added by the legacy migrations (up to sqliteLegacyMigrations) were
+// also part of the initial schema at times, so those migrations skip adding a
+// column that already exists. Every other failed statement, in any migration,
+// fails the migration.
+type sqliteMigrationDialect struct{}
+
+func (sqliteMigrationDialect) bind(query string) string {
+	return query
+}
+
+func (sqliteMigrationDialect) hasColumn(ctx context.Context, q rowQuerier, table, column string) (bool, error) {
+	var count int
+	err := q.QueryRowContext(ctx, `SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, table, column).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for %s.%s: %w", table, column, err)
+	}
+	return count > 0, nil
+}
+
+func (d sqliteMigrationDialect) prepare(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_version (
+			version INTEGER PRIMARY KEY,
+			applied_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			description TEXT,
+			checksum TEXT
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_version table: %w", err)
+	}
+
+	// Databases created before checksums were recorded lack the column
+	exists, err := d.hasColumn(ctx, conn, "schema_version", "checksum")
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if _, err := conn.ExecContext(ctx, `ALTER TABLE schema_version ADD COLUMN checksum TEXT`); err != nil {
+			return fmt.Errorf("failed to add checksum column: %w", err)
+		}
+	}
+	return nil
+}
+
+func (sqliteMigrationDialect) createLockTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migration_lock (
+			id INTEGER PRIMARY KEY CHECK (id = 1), -- Singleton row
+			owner TEXT NOT NULL,
+			acquired_at TIMESTAMP NOT NULL
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create migration lock table: %w", err)
+	}
+	return nil
+}
+
+func (d sqliteMigrationDialect) lock(ctx context.Context, conn *sql.Conn, timeout time.Duration) (func(), error) {
+	if err := d.createLockTable(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	hostname, _ := os.Hostname()
+	owner := fmt.Sprintf("%s pid %d (%s)", hostname, os.Getpid(), uuid.New().String()[:8])
+	deadline := time.Now().Add(timeout)
+	for {
+		result, err := conn.ExecContext(ctx, `
+			INSERT INTO schema_migration_lock (id, owner, acquired_at) VALUES (1, ?, ?)
+			ON CONFLICT(id) DO NOTHING
+		`, owner, time.Now().UTC())
+		if err != nil {
+			return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		if acquired, _ := result.RowsAffected(); acquired == 1 {
+			return func() {
+				_, _ = conn.ExecContext(context.Background(),
+					`DELETE FROM schema_migration_lock WHERE id = 1 AND owner = ?`, owner)
+			}, nil
+		}
+
+		holder, err := d.lockHolder(ctx, conn)
+		if err != nil {
+			return nil, err
+		}
+		if holder == nil {
+			continue
+		}
+		if time.Since(holder.AcquiredAt) > sqliteMigrationLockStale {
+			slog.Warn("Taking over stale migration lock", "owner", holder.Owner, "acquired_at", holder.AcquiredAt)
+			_, err := conn.ExecContext(ctx, `DELETE FROM schema_migration_lock WHERE id = 1 AND owner = ?`, holder.Owner)
+			if err != nil {
+				return nil, fmt.Errorf("failed to release stale migration lock: %w", err)
+			}
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("%w: locked by %s since %s", ErrMigrationLocked,
+				holder.Owner, holder.AcquiredAt.Local().Format(time.DateTime))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(sqliteMigrationLockPoll):
+		}
+	}
+}
+
+func (d sqliteMigrationDialect) lockHolder(ctx context.Context, q rowQuerier) (*MigrationLock, error) {
+	exists, err := d.hasColumn(ctx, q, "schema_migration_lock", "owner")
+	if err != nil || !exists {
+		return nil, err
+	}
+	var lock MigrationLock
+	err = q.QueryRowContext(ctx, `SELECT owner, acquired_at FROM schema_migration_lock WHERE id = 1`).
+		Scan(&lock.Owner, &lock.AcquiredAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migration lock: %w", err)
+	}
+	return &lock, nil
+}
+
+// sqliteLegacyMigrations is the last migration that predates migration
+// files. Databases created by builds of that time got every column from the
+// initial schema, and these migrations only added the columns that were
+// missing.
+const sqliteLegacyMigrations = 22
+
+var sqliteAddColumn = regexp.MustCompile(`(?i)^ALTER\s+TABLE\s+(\w+)\s+ADD\s+COLUMN\s+(\w+)`)
+
+// exec runs a script's statements one by one, so errors name the statement
+// that failed. Any error fails the migration, except that legacy migrations
+// skip adding columns the table already has.
+func (d sqliteMigrationDialect) exec(ctx context.Context, tx *sql.Tx, version int, script string) error {
+	for _, statement := range splitSQLStatements(script) {
+		if match := sqliteAddColumn.FindStringSubmatch(statement); match != nil && version <= sqliteLegacyMigrations {
+			exists, err := d.hasColumn(ctx, tx, match[1], match[2])
+			if err != nil {
+				return err
+			}
+			if exists {
+				slog.Debug("Column already in place, skipping", "table", match[1], "column", match[2])
+				continue
+			}
+		}
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("%w\n%s", err, statement)
+		}
+	}
+	return nil
+}
+
+// splitSQLStatements splits a script into its statements, leaving out
+// comments and empty statements. It understands quoted strings and
+// identifiers, and keeps the BEGIN ... END body of a CREATE TRIGGER in one
+// statement, counting CASE ... END expressions inside it.
+func splitSQLStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+	// words holds the first words of the current statement, to spot triggers
+	var words []string
+	inBody := false
+	caseDepth := 0
+	flush := func() {
+		if statement := strings.TrimSpace(current.String()); statement != "" {
+			statements = append(statements, statement)
+		}
+		current.Reset()
+		words = words[:0]
+		inBody = false
+		caseDepth = 0
+	}
+
+	for i := 0; i < len(script); i++ {
+		c := script[i]
+		switch {
+		case c == '-' && strings.HasPrefix(script[i:], "--"):
+			end := strings.IndexByte(script[i:], '\n')
+			if end < 0 {
+				i = len(script)
+			} else {
+				i += end
+				current.WriteByte('\n')
+			}
+		case c == '/' && strings.HasPrefix(script[i:], "/*"):
+			end := strings.Index(script[i+2:], "*/")
+			if end < 0 {
+				i = len(script)
+			} else {
+				i += end + 3
+			}
+			current.WriteByte(' ')
+		case c == '\'' || c == '"':
+			end := i + 1
+			for end < len(script) {
+				if script[end] == c {
+					// A doubled quote is an escaped quote
+					if end+1 < len(script) && script[end+1] == c {
+						end += 2
+						continue
+					}
+					break
+				}
+				end++
+			}
+			current.WriteString(script[i:min(end+1, len(script))])
+			i = end
+		case c == ';' && inBody:
+			current.WriteByte(c)
+		case c == ';':
+			flush()
+		case isSQLWordByte(c) && (i == 0 || !isSQLWordByte(script[i-1])):
+			end := i
+			for end < len(script) && isSQLWordByte(script[end]) {
+				end++
+			}
+			word := strings.ToUpper(script[i:end])
+			current.WriteString(script[i:end])
+			i = end - 1
+
+			if len(words) < 3 {
+				words = append(words, word)
+			}
+			switch {
+			case word == "BEGIN" && !inBody && isCreateTrigger(words):
+				inBody = true
+			case word == "CASE" && inBody:
+				caseDepth++
+			case word == "END" && inBody && caseDepth > 0:
+				caseDepth--
+			case word == "END" && inBody:
+				inBody = false
+			}
+		default:
+			current.WriteByte(c)
+		}
+	}
+	flush()
+	return statements
+}
+
+func isSQLWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// isCreateTrigger reports whether a statement's first words create a trigger
+func isCreateTrigger(words []string) bool {
+	if len(words) < 2 || words[0] != "CREATE" {
+		return false
+	}
+	return words[1] == "TRIGGER" || (len(words) > 2 && (words[1] == "TEMP" || words[1] == "TEMPORARY") && words[2] == "TRIGGER")
+}
@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelineRunCRUD(t *testing.T) {
+	dbPath := testutil.DatabasePath(t, "sqlite-pipelines")
+	store, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+
+	run := &PipelineRun{
+		ID:         "pipe-1",
+		Name:       "implement-and-review",
+		Definition: `{"name":"implement-and-review","steps":[{"prompt":"a"},{"prompt":"b"}]}`,
+		WorkingDir: "/tmp/project",
+		Status:     PipelineRunStatusRunning,
+	}
+	require.NoError(t, store.CreatePipelineRun(ctx, run))
+
+	got, err := store.GetPipelineRun(ctx, "pipe-1")
+	require.NoError(t, err)
+	assert.Equal(t, "implement-and-review", got.Name)
+	assert.Equal(t, "/tmp/project", got.WorkingDir)
+	assert.Equal(t, PipelineRunStatusRunning, got.Status)
+	assert.Equal(t, 0, got.CurrentStep)
+	assert.Nil(t, got.CompletedAt)
+
+	started := time.Now()
+	require.NoError(t, store.SavePipelineStep(ctx, &PipelineStep{
+		RunID: "pipe-1", StepIndex: 0, Name: "step-1", SessionID: "sess-1",
+		Status: PipelineStepStatusRunning, StartedAt: &started,
+	}))
+
+	// Saving the same step again updates it in place
+	completed := time.Now()
+	require.NoError(t, store.SavePipelineStep(ctx, &PipelineStep{
+		RunID: "pipe-1", StepIndex: 0, Name: "step-1", SessionID: "sess-1",
+		Status: PipelineStepStatusCompleted, StartedAt: &started, CompletedAt: &completed,
+	}))
+	require.NoError(t, store.SavePipelineStep(ctx, &PipelineStep{
+		RunID: "pipe-1", StepIndex: 1, Name: "step-2",
+		Status: PipelineStepStatusSkipped, Reason: "previous step succeeded",
+	}))
+
+	steps, err := store.GetPipelineSteps(ctx, "pipe-1")
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	assert.Equal(t, PipelineStepStatusCompleted, steps[0].Status)
+	assert.Equal(t, "sess-1", steps[0].SessionID)
+	require.NotNil(t, steps[0].CompletedAt)
+	assert.Empty(t, steps[1].SessionID)
+	assert.Equal(t, "previous step succeeded", steps[1].Reason)
+
+	status := PipelineRunStatusCompleted
+	step := 2
+	require.NoError(t, store.UpdatePipelineRun(ctx, "pipe-1", PipelineRunUpdate{
+		Status: &status, CurrentStep: &step, CompletedAt: &completed,
+	}))
+	got, err = store.GetPipelineRun(ctx, "pipe-1")
+	require.NoError(t, err)
+	assert.Equal(t, PipelineRunStatusCompleted, got.Status)
+	assert.Equal(t, 2, got.CurrentStep)
+	assert.NotNil(t, got.CompletedAt)
+
+	runs, err := store.ListPipelineRuns(ctx)
+	require.NoError(t, err)
+	assert.Len(t, runs, 1)
+
+	_, err = store.GetPipelineRun(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.UpdatePipelineRun(ctx, "missing", PipelineRunUpdate{Status: &status}), ErrNotFound)
+}
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceSamples(t *testing.T) {
+	dbPath := testutil.DatabasePath(t, "sqlite-resources")
+	store, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, store.CreateSession(ctx, &Session{
+		ID:             "sess-1",
+		RunID:          "run-1",
+		Status:         SessionStatusRunning,
+		CreatedAt:      now,
+		LastActivityAt: now,
+	}))
+
+	// Unsampled sessions report no usage
+	sess, err := store.GetSession(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Nil(t, sess.ResourcesSampledAt)
+
+	samples := []*ResourceSample{
+		{SessionID: "sess-1", SampledAt: now, CPUTimeMs: 1000, CPUPercent: 50, RSSBytes: 100 << 20, OpenFDs: 30, ProcessCount: 2},
+		{SessionID: "sess-1", SampledAt: now.Add(15 * time.Second), CPUTimeMs: 31000, CPUPercent: 200, RSSBytes: 900 << 20, OpenFDs: 25, ProcessCount: 6},
+		{SessionID: "sess-1", SampledAt: now.Add(30 * time.Second), CPUTimeMs: 32000, CPUPercent: 6.5, RSSBytes: 200 << 20, OpenFDs: 40, ProcessCount: 2},
+	}
+	for _, sample := range samples {
+		require.NoError(t, store.RecordResourceSample(ctx, sample))
+		assert.NotZero(t, sample.ID)
+	}
+
+	sess, err = store.GetSession(ctx, "sess-1")
+	require.NoError(t, err)
+	require.NotNil(t, sess.ResourcesSampledAt)
+	assert.WithinDuration(t, now.Add(30*time.Second), *sess.ResourcesSampledAt, time.Second)
+	assert.Equal(t, int64(32000), sess.CPUTimeMs)
+	assert.Equal(t, 6.5, sess.CPUPercent)
+	assert.Equal(t, int64(200<<20), sess.RSSBytes)
+	assert.Equal(t, int64(40), sess.OpenFDs)
+	assert.Equal(t, 200.0, sess.PeakCPUPercent)
+	assert.Equal(t, int64(900<<20), sess.PeakRSSBytes)
+	assert.Equal(t, int64(40), sess.PeakOpenFDs)
+
+	got, err := store.GetResourceSamples(ctx, "sess-1", 2)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(31000), got[0].CPUTimeMs)
+	assert.Equal(t, 6, got[0].ProcessCount)
+	assert.Equal(t, int64(32000), got[1].CPUTimeMs)
+
+	got, err = store.GetResourceSamples(ctx, "sess-1", 0)
+	require.NoError(t, err)
+	assert.Len(t, got, 3)
+
+	err = store.RecordResourceSample(ctx, &ResourceSample{SessionID: "missing"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScheduleCRUD(t *testing.T) {
+	dbPath := testutil.DatabasePath(t, "sqlite-schedules")
+	store, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	next := time.Date(2025, 3, 15, 2, 0, 0, 0, time.UTC)
+
+	sched := &Schedule{
+		ID:            "sched-1",
+		Name:          "nightly",
+		CronExpr:      "0 2 * * *",
+		Timezone:      "UTC",
+		WorkingDir:    "/tmp/project",
+		LaunchConfig:  `{"query":"triage"}`,
+		OverlapPolicy: ScheduleOverlapSkip,
+		CatchUpPolicy: ScheduleCatchUpOnce,
+		Enabled:       true,
+		NextRunAt:     &next,
+	}
+	require.NoError(t, store.CreateSchedule(ctx, sched))
+
+	got, err := store.GetSchedule(ctx, "sched-1")
+	require.NoError(t, err)
+	assert.Equal(t, "nightly", got.Name)
+	assert.Equal(t, "/tmp/project", got.WorkingDir)
+	assert.True(t, got.Enabled)
+	require.NotNil(t, got.NextRunAt)
+	assert.True(t, got.NextRunAt.Equal(next))
+	assert.Nil(t, got.LastRunAt)
+
+	disabled := false
+	name := "renamed"
+	require.NoError(t, store.UpdateSchedule(ctx, "sched-1", ScheduleUpdate{Name: &name, Enabled: &disabled}))
+	got, err = store.GetSchedule(ctx, "sched-1")
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", got.Name)
+	assert.False(t, got.Enabled)
+
+	// Runs are returned newest first, joined with the session's status
+	require.NoError(t, store.CreateSession(ctx, &Session{
+		ID: "sess-1", RunID: "run-1", Query: "triage", Status: SessionStatusCompleted,
+		CreatedAt: time.Now(), LastActivityAt: time.Now(),
+	}))
+	require.NoError(t, store.CreateScheduleRun(ctx, &ScheduleRun{
+		ScheduleID: "sched-1", SessionID: "sess-1", ScheduledFor: next, Status: ScheduleRunStatusLaunched,
+	}))
+	require.NoError(t, store.CreateScheduleRun(ctx, &ScheduleRun{
+		ScheduleID: "sched-1", ScheduledFor: next.Add(24 * time.Hour), Status: ScheduleRunStatusSkipped,
+		Reason: "previous session still active",
+	}))
+
+	runs, err := store.ListScheduleRuns(ctx, "sched-1", 0)
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+	assert.Equal(t, ScheduleRunStatusSkipped, runs[0].Status)
+	assert.Empty(t, runs[0].SessionID)
+	assert.Equal(t, "sess-1", runs[1].SessionID)
+	assert.Equal(t, SessionStatusCompleted, runs[1].SessionStatus)
+
+	runs, err = store.ListScheduleRuns(ctx, "sched-1", 1)
+	require.NoError(t, err)
+	assert.Len(t, runs, 1)
+
+	// Deleting removes run history but keeps launched sessions
+	require.NoError(t, store.DeleteSchedule(ctx, "sched-1"))
+	_, err = store.GetSchedule(ctx, "sched-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	runs, err = store.ListScheduleRuns(ctx, "sched-1", 0)
+	require.NoError(t, err)
+	assert.Empty(t, runs)
+	_, err = store.GetSession(ctx, "sess-1")
+	assert.NoError(t, err)
+
+	assert.ErrorIs(t, store.DeleteSchedule(ctx, "sched-1"), ErrNotFound)
+	assert.ErrorIs(t, store.UpdateSchedule(ctx, "sched-1", ScheduleUpdate{Name: &name}), ErrNotFound)
+}
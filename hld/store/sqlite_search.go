@@ -0,0 +1,482 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Conversation search uses an FTS5 index when the SQLite driver is built with
+// FTS5 (the sqlite_fts5 build tag) and falls back to substring matching
+// otherwise. The index is an external-content table over conversation_events
+// kept current by triggers.
+const (
+	searchIndexTable = "conversation_events_fts"
+
+	// FTS5 marks matches with char(1) and char(2), which cannot collide with
+	// conversation text; the marks are turned into highlight ranges
+	snippetOpen  = '\x01'
+	snippetClose = '\x02'
+	snippetTrim  = "…"
+
+	// Context kept around the first match by substring snippets, in runes
+	snippetContext = 60
+
+	defaultSearchLimit             = 20
+	maxSearchLimit                 = 100
+	defaultSearchMatchesPerSession = 3
+	maxSearchMatchesPerSession     = 20
+)
+
+var searchIndexTriggers = map[string]string{
+	searchIndexTable + "_ai": `
+		CREATE TRIGGER IF NOT EXISTS conversation_events_fts_ai AFTER INSERT ON conversation_events BEGIN
+			INSERT INTO conversation_events_fts(rowid, content, tool_input_json, tool_result_content)
+			VALUES (new.id, new.content, new.tool_input_json, new.tool_result_content);
+		END`,
+	searchIndexTable + "_ad": `
+		CREATE TRIGGER IF NOT EXISTS conversation_events_fts_ad AFTER DELETE ON conversation_events BEGIN
+			INSERT INTO conversation_events_fts(conversation_events_fts, rowid, content, tool_input_json, tool_result_content)
+			VALUES ('delete', old.id, old.content, old.tool_input_json, old.tool_result_content);
+		END`,
+	searchIndexTable + "_au": `
+		CREATE TRIGGER IF NOT EXISTS conversation_events_fts_au
+		AFTER UPDATE OF content, tool_input_json, tool_result_content ON conversation_events BEGIN
+			INSERT INTO conversation_events_fts(conversation_events_fts, rowid, content, tool_input_json, tool_result_content)
+			VALUES ('delete', old.id, old.content, old.tool_input_json, old.tool_result_content);
+			INSERT INTO conversation_events_fts(rowid, content, tool_input_json, tool_result_content)
+			VALUES (new.id, new.content, new.tool_input_json, new.tool_result_content);
+		END`,
+}
+
+// ensureSearchIndex brings the conversation search index in line with the
+// running build. A database can be opened by builds with and without FTS5, so
+// this runs on every start: without FTS5 the triggers are dropped so writes
+// keep working, and with FTS5 a missing index or trigger set is rebuilt from
+// conversation_events.
+func (s *SQLiteStore) ensureSearchIndex() error {
+	var enabled bool
+	if err := s.db.QueryRow(`SELECT sqlite_compileoption_used('ENABLE_FTS5')`).Scan(&enabled); err != nil {
+		return fmt.Errorf("failed to check for FTS5 support: %w", err)
+	}
+	s.fullTextSearch = enabled
+
+	var triggers int
+	err := s.db.QueryRow(`
+		SELECT COUNT(*) FROM sqlite_master WHERE type = 'trigger' AND tbl_name = 'conversation_events' AND name LIKE ?
+	`, searchIndexTable+"_%").Scan(&triggers)
+	if err != nil {
+		return fmt.Errorf("failed to check search index triggers: %w", err)
+	}
+
+	if !enabled {
+		if triggers > 0 {
+			slog.Warn("SQLite was built without FTS5, disabling the conversation search index")
+			for name := range searchIndexTriggers {
+				if _, err := s.db.Exec("DROP TRIGGER IF EXISTS " + name); err != nil {
+					return fmt.Errorf("failed to drop search index trigger: %w", err)
+				}
+			}
+		}
+		return nil
+	}
+	if triggers == len(searchIndexTriggers) {
+		return nil
+	}
+
+	// The index is missing or was not kept current, so build it from scratch
+	start := time.Now()
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.Exec(`
+		CREATE VIRTUAL TABLE IF NOT EXISTS conversation_events_fts USING fts5(
+			content, tool_input_json, tool_result_content,
+			content = 'conversation_events',
+			content_rowid = 'id',
+			tokenize = 'unicode61 remove_diacritics 2'
+		)
+	`)
+	if err != nil {
+		return fmt.Errorf("failed to create search index: %w", err)
+	}
+	for _, trigger := range searchIndexTriggers {
+		if _, err := tx.Exec(trigger); err != nil {
+			return fmt.Errorf("failed to create search index trigger: %w", err)
+		}
+	}
+	if _, err := tx.Exec(`INSERT INTO conversation_events_fts(conversation_events_fts) VALUES ('rebuild')`); err != nil {
+		return fmt.Errorf("failed to backfill search index: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit search index: %w", err)
+	}
+
+	slog.Info("Built conversation search index", "duration", time.Since(start))
+	return nil
+}
+
+// searchTerms splits a plain text query into the words that must all match
+func searchTerms(query string) []string {
+	var terms []string
+	for _, term := range strings.Fields(query) {
+		if term = strings.Trim(term, `"`); term != "" {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// ftsQuery quotes every term so user input is never parsed as FTS5 syntax.
+// A quoted term is a phrase, so "payments.go" matches the tokens payments
+// and go next to each other.
+func ftsQuery(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = `"` + strings.ReplaceAll(term, `"`, `""`) + `"`
+	}
+	return strings.Join(quoted, " ")
+}
+
+func escapeLike(term string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(term)
+}
+
+// searchToolNameExpr is the tool an event belongs to: its own tool name for
+// tool calls and the originating call's for tool results
+const searchToolNameExpr = `COALESCE(NULLIF(e.tool_name, ''), (
+	SELECT c.tool_name FROM conversation_events c
+	WHERE c.session_id = e.session_id AND c.tool_id = e.tool_result_for_id AND c.event_type = 'tool_call'
+	LIMIT 1
+), '')`
+
+// searchSource returns the FROM clause and conditions selecting the events
+// that match search
+func (s *SQLiteStore) searchSource(search ConversationSearch) (string, []string, []interface{}) {
+	terms := searchTerms(search.Query)
+	var from string
+	var conditions []string
+	var args []interface{}
+
+	if s.fullTextSearch {
+		from = `conversation_events_fts JOIN conversation_events e ON e.id = conversation_events_fts.rowid`
+		conditions = append(conditions, "conversation_events_fts MATCH ?")
+		args = append(args, ftsQuery(terms))
+	} else {
+		from = `conversation_events e`
+		for _, term := range terms {
+			conditions = append(conditions, `(e.content LIKE ? ESCAPE '\'
+				OR e.tool_input_json LIKE ? ESCAPE '\'
+				OR e.tool_result_content LIKE ? ESCAPE '\')`)
+			pattern := "%" + escapeLike(term) + "%"
+			args = append(args, pattern, pattern, pattern)
+		}
+	}
+
+	if len(search.Roles) > 0 {
+		conditions = append(conditions, "e.role IN ("+placeholders(len(search.Roles))+")")
+		for _, role := range search.Roles {
+			args = append(args, role)
+		}
+	}
+	if len(search.EventTypes) > 0 {
+		conditions = append(conditions, "e.event_type IN ("+placeholders(len(search.EventTypes))+")")
+		for _, eventType := range search.EventTypes {
+			args = append(args, eventType)
+		}
+	}
+	if len(search.ToolNames) > 0 {
+		conditions = append(conditions, searchToolNameExpr+" IN ("+placeholders(len(search.ToolNames))+")")
+		for _, name := range search.ToolNames {
+			args = append(args, name)
+		}
+	}
+	if search.SessionID != "" {
+		conditions = append(conditions, "e.session_id = ?")
+		args = append(args, search.SessionID)
+	}
+	if search.ProjectID != "" {
+		conditions = append(conditions, "e.session_id IN (SELECT id FROM sessions WHERE project_id = ?)")
+		args = append(args, search.ProjectID)
+	}
+	if search.After != nil {
+		conditions = append(conditions, "julianday(e.created_at) >= julianday(?)")
+		args = append(args, search.After.UTC().Format(time.RFC3339Nano))
+	}
+	if search.Before != nil {
+		conditions = append(conditions, "julianday(e.created_at) < julianday(?)")
+		args = append(args, search.Before.UTC().Format(time.RFC3339Nano))
+	}
+
+	return from, conditions, args
+}
+
+// SearchConversations finds the sessions whose conversations match search,
+// ordered by their most recent matching event
+func (s *SQLiteStore) SearchConversations(ctx context.Context, search ConversationSearch) ([]*ConversationSearchResult, error) {
+	if err := search.Validate(); err != nil {
+		return nil, err
+	}
+	if search.Limit <= 0 {
+		search.Limit = defaultSearchLimit
+	}
+	if search.Limit > maxSearchLimit {
+		search.Limit = maxSearchLimit
+	}
+	if search.MatchesPerSession <= 0 {
+		search.MatchesPerSession = defaultSearchMatchesPerSession
+	}
+	if search.MatchesPerSession > maxSearchMatchesPerSession {
+		search.MatchesPerSession = maxSearchMatchesPerSession
+	}
+
+	from, conditions, args := s.searchSource(search)
+	where := strings.Join(conditions, " AND ")
+
+	// Event IDs increase with insertion, so the highest matching ID is the
+	// session's most recent match
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT e.session_id FROM `+from+`
+		WHERE `+where+`
+		GROUP BY e.session_id
+		ORDER BY MAX(e.id) DESC
+		LIMIT ?
+	`, append(args, search.Limit)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search conversations: %w", err)
+	}
+	var sessionIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed to scan search result: %w", err)
+		}
+		sessionIDs = append(sessionIDs, id)
+	}
+	_ = rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search conversations: %w", err)
+	}
+	if len(sessionIDs) == 0 {
+		return []*ConversationSearchResult{}, nil
+	}
+
+	matches, err := s.searchMatches(ctx, search, sessionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*ConversationSearchResult, 0, len(sessionIDs))
+	for _, id := range sessionIDs {
+		session, err := s.GetSession(ctx, id)
+		if err != nil {
+			// Events can outlive a hard-deleted session
+			if errors.Is(err, sql.ErrNoRows) {
+				continue
+			}
+			return nil, err
+		}
+		results = append(results, &ConversationSearchResult{Session: session, Matches: matches[id]})
+	}
+	return results, nil
+}
+
+// searchMatches loads the most recent matching events of each session
+func (s *SQLiteStore) searchMatches(ctx context.Context, search ConversationSearch, sessionIDs []string) (map[string][]ConversationMatch, error) {
+	from, conditions, args := s.searchSource(search)
+	conditions = append(conditions, "e.session_id IN ("+placeholders(len(sessionIDs))+")")
+	for _, id := range sessionIDs {
+		args = append(args, id)
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT id, session_id, claude_session_id, sequence, event_type, role, tool_name, created_at,
+			content, tool_input_json, tool_result_content
+		FROM (
+			SELECT e.id, e.session_id, COALESCE(e.claude_session_id, '') AS claude_session_id, e.sequence,
+				e.event_type, COALESCE(e.role, '') AS role, `+searchToolNameExpr+` AS tool_name, e.created_at,
+				e.content, e.tool_input_json, e.tool_result_content,
+				ROW_NUMBER() OVER (PARTITION BY e.session_id ORDER BY e.id DESC) AS match_number
+			FROM `+from+`
+			WHERE `+strings.Join(conditions, " AND ")+`
+		)
+		WHERE match_number <= ?
+		ORDER BY session_id, id DESC
+	`, append(args, search.MatchesPerSession)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load search matches: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	terms := searchTerms(search.Query)
+	matches := make(map[string][]ConversationMatch)
+	var eventIDs []interface{}
+	for rows.Next() {
+		var sessionID string
+		var match ConversationMatch
+		var content, toolInput, toolResult sql.NullString
+		err := rows.Scan(&match.EventID, &sessionID, &match.ClaudeSessionID, &match.Sequence,
+			&match.EventType, &match.Role, &match.ToolName, &match.CreatedAt, &content, &toolInput, &toolResult)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search match: %w", err)
+		}
+		if !s.fullTextSearch {
+			match.Snippet, match.Highlights = buildSnippet(terms, content.String, toolInput.String, toolResult.String)
+		}
+		matches[sessionID] = append(matches[sessionID], match)
+		eventIDs = append(eventIDs, match.EventID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load search matches: %w", err)
+	}
+
+	if s.fullTextSearch && len(eventIDs) > 0 {
+		snippets, err := s.searchSnippets(ctx, terms, eventIDs)
+		if err != nil {
+			return nil, err
+		}
+		for _, sessionMatches := range matches {
+			for i := range sessionMatches {
+				sessionMatches[i].Snippet, sessionMatches[i].Highlights = parseSnippet(snippets[sessionMatches[i].EventID])
+			}
+		}
+	}
+	return matches, nil
+}
+
+// searchSnippets asks FTS5 for the best excerpt of each event. snippet() only
+// works in a plain full-text query, so it cannot be part of the match query.
+func (s *SQLiteStore) searchSnippets(ctx context.Context, terms []string, eventIDs []interface{}) (map[int64]string, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT rowid, snippet(conversation_events_fts, -1, char(1), char(2), '`+snippetTrim+`', 24)
+		FROM conversation_events_fts
+		WHERE conversation_events_fts MATCH ? AND rowid IN (`+placeholders(len(eventIDs))+`)
+	`, append([]interface{}{ftsQuery(terms)}, eventIDs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load search snippets: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	snippets := make(map[int64]string, len(eventIDs))
+	for rows.Next() {
+		var id int64
+		var snippet sql.NullString
+		if err := rows.Scan(&id, &snippet); err != nil {
+			return nil, fmt.Errorf("failed to scan search snippet: %w", err)
+		}
+		snippets[id] = snippet.String
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to load search snippets: %w", err)
+	}
+	return snippets, nil
+}
+
+// parseSnippet strips the markers from an FTS5 snippet and records the
+// marked ranges
+func parseSnippet(marked string) (string, []TextRange) {
+	var b strings.Builder
+	var highlights []TextRange
+	offset, start := 0, -1
+	for _, r := range marked {
+		switch r {
+		case snippetOpen:
+			start = offset
+		case snippetClose:
+			if start >= 0 {
+				highlights = append(highlights, TextRange{Start: start, End: offset})
+				start = -1
+			}
+		default:
+			b.WriteRune(r)
+			offset++
+		}
+	}
+	return b.String(), highlights
+}
+
+// buildSnippet excerpts the first of texts containing a term around its
+// first match and highlights every term occurrence in the excerpt. Matching
+// is case-insensitive for ASCII, like SQLite's LIKE.
+func buildSnippet(terms []string, texts ...string) (string, []TextRange) {
+	for _, text := range texts {
+		lower := []rune(strings.ToLower(text))
+		runes := []rune(text)
+		if len(lower) != len(runes) {
+			// Lowercasing changed the length, so offsets would not line up
+			lower = runes
+		}
+
+		first := -1
+		for _, term := range terms {
+			if i := runeIndex(lower, []rune(strings.ToLower(term))); i >= 0 && (first < 0 || i < first) {
+				first = i
+			}
+		}
+		if first < 0 {
+			continue
+		}
+
+		start := max(first-snippetContext, 0)
+		end := min(first+snippetContext*2, len(runes))
+		snippet := string(runes[start:end])
+		shift := 0
+		if start > 0 {
+			snippet = snippetTrim + snippet
+			shift = utf8.RuneCountInString(snippetTrim)
+		}
+		if end < len(runes) {
+			snippet += snippetTrim
+		}
+
+		var highlights []TextRange
+		window := lower[start:end]
+		for i := 0; i < len(window); {
+			matched := 0
+			for _, term := range terms {
+				termRunes := []rune(strings.ToLower(term))
+				if n := len(termRunes); n > matched && hasRunePrefix(window[i:], termRunes) {
+					matched = n
+				}
+			}
+			if matched == 0 {
+				i++
+				continue
+			}
+			highlights = append(highlights, TextRange{Start: shift + i, End: shift + i + matched})
+			i += matched
+		}
+		return snippet, highlights
+	}
+	return "", nil
+}
+
+func runeIndex(haystack, needle []rune) int {
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		if hasRunePrefix(haystack[i:], needle) {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasRunePrefix(s, prefix []rune) bool {
+	if len(prefix) == 0 || len(s) < len(prefix) {
+		return false
+	}
+	for i, r := range prefix {
+		if s[i] != r {
+			return false
+		}
+	}
+	return true
+}
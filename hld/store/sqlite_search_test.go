@@ -0,0 +1,179 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The search tests run against whichever search mode the build provides, so
+// they only rely on behaviour shared by FTS5 and substring matching. Run them
+// with -tags sqlite_fts5 to cover the index.
+func TestSearchConversations(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-search"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	now := time.Now()
+	project, err := store.UpsertProject(ctx, "/src/shop")
+	require.NoError(t, err)
+
+	for _, s := range []*Session{
+		{ID: "checkout", RunID: "run-1", ClaudeSessionID: "claude-1", ProjectID: project.ID, Title: "Fix checkout"},
+		{ID: "docs", RunID: "run-2", ClaudeSessionID: "claude-2", Title: "Write docs"},
+	} {
+		s.Status = SessionStatusCompleted
+		s.CreatedAt, s.LastActivityAt = now, now
+		require.NoError(t, store.CreateSession(ctx, s))
+	}
+
+	add := func(event *ConversationEvent) *ConversationEvent {
+		event.ClaudeSessionID = map[string]string{"checkout": "claude-1", "docs": "claude-2"}[event.SessionID]
+		require.NoError(t, store.AddConversationEvent(ctx, event))
+		return event
+	}
+	add(&ConversationEvent{SessionID: "checkout", EventType: EventTypeMessage, Role: "user",
+		Content: "The refund flow double charges customers, please investigate"})
+	add(&ConversationEvent{SessionID: "checkout", EventType: EventTypeToolCall, Role: "assistant",
+		ToolID: "tool-1", ToolName: "Edit", ToolInputJSON: `{"file_path":"/src/shop/payments.go","old_string":"charge()"}`})
+	add(&ConversationEvent{SessionID: "checkout", EventType: EventTypeToolResult, Role: "user",
+		ToolResultForID: "tool-1", ToolResultContent: "Updated payments.go with idempotent refund handling"})
+	add(&ConversationEvent{SessionID: "docs", EventType: EventTypeMessage, Role: "assistant",
+		Content: "Documented how refund requests reach payments.go"})
+	compaction := add(&ConversationEvent{SessionID: "docs", EventType: EventTypeCompaction, Role: "system"})
+
+	sessionOrder := func(results []*ConversationSearchResult) []string {
+		ids := make([]string, 0, len(results))
+		for _, r := range results {
+			ids = append(ids, r.Session.ID)
+		}
+		return ids
+	}
+
+	t.Run("most recent match first", func(t *testing.T) {
+		results, err := store.SearchConversations(ctx, ConversationSearch{Query: "payments.go"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"docs", "checkout"}, sessionOrder(results))
+		assert.Equal(t, "Write docs", results[0].Session.Title)
+
+		// Matches carry anchors into the conversation, newest first
+		require.Len(t, results[1].Matches, 2)
+		assert.Equal(t, 3, results[1].Matches[0].Sequence)
+		assert.Equal(t, "claude-1", results[1].Matches[0].ClaudeSessionID)
+		assert.Equal(t, 2, results[1].Matches[1].Sequence)
+	})
+
+	t.Run("snippets highlight the match", func(t *testing.T) {
+		results, err := store.SearchConversations(ctx, ConversationSearch{Query: "double", SessionID: "checkout"})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		match := results[0].Matches[0]
+		assert.Contains(t, match.Snippet, "double charges")
+		require.Len(t, match.Highlights, 1)
+		runes := []rune(match.Snippet)
+		assert.Equal(t, "double", string(runes[match.Highlights[0].Start:match.Highlights[0].End]))
+	})
+
+	t.Run("every word must match", func(t *testing.T) {
+		results, err := store.SearchConversations(ctx, ConversationSearch{Query: "refund customers"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"checkout"}, sessionOrder(results))
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			search ConversationSearch
+			want   []string
+		}{
+			{"role", ConversationSearch{Query: "refund", Roles: []string{"assistant"}}, []string{"docs"}},
+			{"event type", ConversationSearch{Query: "refund", EventTypes: []string{EventTypeToolResult}}, []string{"checkout"}},
+			{"tool results match their call's tool", ConversationSearch{Query: "idempotent", ToolNames: []string{"Edit"}}, []string{"checkout"}},
+			{"other tool", ConversationSearch{Query: "payments.go", ToolNames: []string{"Read"}}, []string{}},
+			{"project", ConversationSearch{Query: "refund", ProjectID: project.ID}, []string{"checkout"}},
+			{"time window", ConversationSearch{Query: "refund", After: ptr(now.Add(time.Hour))}, []string{}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				results, err := store.SearchConversations(ctx, tt.search)
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, sessionOrder(results))
+			})
+		}
+	})
+
+	t.Run("updated content is searchable", func(t *testing.T) {
+		summary := "Summary mentions the ledger reconciliation"
+		require.NoError(t, store.UpdateCompactionEvent(ctx, compaction.ID, CompactionUpdate{Summary: &summary}))
+		results, err := store.SearchConversations(ctx, ConversationSearch{Query: "reconciliation"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"docs"}, sessionOrder(results))
+	})
+
+	t.Run("query syntax is treated as text", func(t *testing.T) {
+		for _, query := range []string{`refund OR "`, `NEAR(refund`, `100%`, `*`} {
+			_, err := store.SearchConversations(ctx, ConversationSearch{Query: query})
+			assert.NoError(t, err, query)
+		}
+		_, err := store.SearchConversations(ctx, ConversationSearch{Query: `  "" `})
+		assert.Error(t, err)
+	})
+}
+
+func TestSearchIndexFollowsBuild(t *testing.T) {
+	dbPath := testutil.DatabasePath(t, "sqlite-search-index")
+	store, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, store.CreateSession(ctx, &Session{
+		ID: "s1", RunID: "r1", Status: SessionStatusCompleted, CreatedAt: now, LastActivityAt: now,
+	}))
+	require.NoError(t, store.AddConversationEvent(ctx, &ConversationEvent{
+		SessionID: "s1", ClaudeSessionID: "c1", EventType: EventTypeMessage, Role: "user", Content: "hello world",
+	}))
+
+	// Simulate a database last written by a build without FTS5: the triggers
+	// are gone and the index missed later writes
+	for name := range searchIndexTriggers {
+		_, err := store.db.Exec("DROP TRIGGER IF EXISTS " + name)
+		require.NoError(t, err)
+	}
+	require.NoError(t, store.AddConversationEvent(ctx, &ConversationEvent{
+		SessionID: "s1", ClaudeSessionID: "c1", EventType: EventTypeMessage, Role: "assistant", Content: "goodbye moon",
+	}))
+	require.NoError(t, store.Close())
+
+	store, err = NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	results, err := store.SearchConversations(ctx, ConversationSearch{Query: "moon"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, 2, results[0].Matches[0].Sequence)
+}
+
+func TestSnippets(t *testing.T) {
+	snippet, highlights := parseSnippet("…fixed \x01payments\x02.\x01go\x02 today")
+	assert.Equal(t, "…fixed payments.go today", snippet)
+	assert.Equal(t, []TextRange{{Start: 7, End: 15}, {Start: 16, End: 18}}, highlights)
+
+	long := strings.Repeat("x", 100) + " Refund here " + strings.Repeat("y", 200)
+	snippet, highlights = buildSnippet([]string{"refund"}, "", long)
+	assert.True(t, strings.HasPrefix(snippet, "…"))
+	assert.True(t, strings.HasSuffix(snippet, "…"))
+	require.Len(t, highlights, 1)
+	assert.Equal(t, "Refund", string([]rune(snippet)[highlights[0].Start:highlights[0].End]))
+
+	snippet, highlights = buildSnippet([]string{"absent"}, "text")
+	assert.Empty(t, snippet)
+	assert.Empty(t, highlights)
+}
@@ -0,0 +1,263 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sessionIDs(sessions []*Session) []string {
+	ids := make([]string, 0, len(sessions))
+	for _, s := range sessions {
+		ids = append(ids, s.ID)
+	}
+	return ids
+}
+
+func TestQuerySessions(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-session-filters"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.Local)
+	project, err := store.UpsertProject(ctx, "/src/app")
+	require.NoError(t, err)
+
+	create := func(id string, age time.Duration, mutate func(*Session)) {
+		cost := 0.0
+		session := &Session{
+			ID:             id,
+			RunID:          "run-" + id,
+			Status:         SessionStatusCompleted,
+			Model:          "sonnet",
+			CreatedAt:      base.Add(-age),
+			LastActivityAt: base.Add(-age),
+			CostUSD:        &cost,
+		}
+		if mutate != nil {
+			mutate(session)
+		}
+		require.NoError(t, store.CreateSession(ctx, session))
+		if session.CostUSD != nil {
+			require.NoError(t, store.UpdateSession(ctx, id, SessionUpdate{CostUSD: session.CostUSD}))
+		}
+	}
+
+	create("cheap", 4*time.Hour, func(s *Session) { s.ProjectID = project.ID })
+	create("pricey", 3*time.Hour, func(s *Session) {
+		cost := 4.5
+		s.CostUSD = &cost
+		s.Model = "opus"
+		s.ModelID = "claude-opus-4-1-20250805"
+		s.ProjectID = project.ID
+	})
+	create("running", 2*time.Hour, func(s *Session) { s.Status = SessionStatusRunning })
+	create("draft", time.Hour, func(s *Session) { s.Status = SessionStatusDraft })
+	create("archived", 30*time.Minute, func(s *Session) { s.Archived = true })
+	create("child", 10*time.Minute, func(s *Session) { s.ParentSessionID = "cheap" })
+
+	require.NoError(t, store.SetSessionLabels(ctx, "cheap", []string{"bug", "urgent"}))
+	require.NoError(t, store.SetSessionLabels(ctx, "pricey", []string{"Bug"}))
+
+	tests := []struct {
+		name   string
+		filter SessionFilter
+		want   []string
+	}{
+		{"everything", SessionFilter{}, []string{"child", "archived", "draft", "running", "pricey", "cheap"}},
+		{"normal", SessionFilter{Kind: SessionKindNormal}, []string{"child", "running", "pricey", "cheap"}},
+		{"archived", SessionFilter{Kind: SessionKindArchived}, []string{"archived"}},
+		{"draft", SessionFilter{Kind: SessionKindDraft}, []string{"draft"}},
+		{"leaves", SessionFilter{Kind: SessionKindNormal, LeavesOnly: true}, []string{"child", "running", "pricey"}},
+		{"status", SessionFilter{Statuses: []string{SessionStatusRunning, SessionStatusDraft}}, []string{"draft", "running"}},
+		{"label ignores case", SessionFilter{Labels: []string{"BUG"}}, []string{"pricey", "cheap"}},
+		{"all labels must match", SessionFilter{Labels: []string{"bug", "urgent"}}, []string{"cheap"}},
+		{"project", SessionFilter{ProjectID: project.ID}, []string{"pricey", "cheap"}},
+		{"model alias", SessionFilter{Models: []string{"opus"}}, []string{"pricey"}},
+		{"model id", SessionFilter{Models: []string{"claude-opus-4-1-20250805"}}, []string{"pricey"}},
+		{"min cost", SessionFilter{MinCostUSD: ptr(1.0)}, []string{"pricey"}},
+		{"max cost", SessionFilter{MaxCostUSD: ptr(1.0), Kind: SessionKindNormal}, []string{"child", "running", "cheap"}},
+		{"created range", SessionFilter{
+			CreatedAfter:  ptr(base.Add(-3 * time.Hour).UTC()),
+			CreatedBefore: ptr(base.Add(-time.Hour)),
+		}, []string{"running", "pricey"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessions, err := store.QuerySessions(ctx, tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, sessionIDs(sessions))
+		})
+	}
+
+	sessions, err := store.QuerySessions(ctx, SessionFilter{Labels: []string{"urgent"}})
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, []string{"bug", "urgent"}, sessions[0].Labels)
+	assert.Equal(t, project.ID, sessions[0].ProjectID)
+
+	counts, err := store.CountSessionsByKind(ctx, SessionFilter{Kind: SessionKindDraft, LeavesOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, SessionCounts{Normal: 3, Archived: 1, Draft: 1}, *counts)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestSessionLabels(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-labels"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, store.CreateSession(ctx, &Session{
+		ID: "sess-1", RunID: "run-1", Status: SessionStatusRunning, CreatedAt: now, LastActivityAt: now,
+	}))
+
+	require.NoError(t, store.CreateLabel(ctx, &Label{ID: "label-1", Name: "bug", Color: "#ff0000"}))
+	err = store.CreateLabel(ctx, &Label{ID: "label-2", Name: "Bug"})
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+
+	// Existing labels are reused regardless of case and new ones are created
+	require.NoError(t, store.SetSessionLabels(ctx, "sess-1", []string{"BUG", "docs", " ", "docs"}))
+	names, err := store.GetSessionLabels(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bug", "docs"}, names)
+
+	labels, err := store.ListLabels(ctx)
+	require.NoError(t, err)
+	require.Len(t, labels, 2)
+	assert.Equal(t, "#ff0000", labels[0].Color)
+	assert.Equal(t, 1, labels[0].SessionCount)
+
+	// Deleting a label detaches it from its sessions
+	require.NoError(t, store.DeleteLabel(ctx, "label-1"))
+	names, err = store.GetSessionLabels(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"docs"}, names)
+	assert.ErrorIs(t, store.DeleteLabel(ctx, "label-1"), ErrNotFound)
+
+	require.NoError(t, store.SetSessionLabels(ctx, "sess-1", nil))
+	names, err = store.GetSessionLabels(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	assert.ErrorIs(t, store.SetSessionLabels(ctx, "missing", []string{"bug"}), ErrNotFound)
+}
+
+func TestProjects(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-projects"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	now := time.Now()
+	for _, s := range []*Session{
+		{ID: "a", RunID: "run-a", WorkingDir: "/src/app", CreatedAt: now, LastActivityAt: now},
+		{ID: "b", RunID: "run-b", WorkingDir: "/src/app", CreatedAt: now, LastActivityAt: now},
+		{ID: "c", RunID: "run-c", WorkingDir: "/src/app/web", CreatedAt: now, LastActivityAt: now},
+		{ID: "d", RunID: "run-d", CreatedAt: now, LastActivityAt: now},
+	} {
+		s.Status = SessionStatusCompleted
+		require.NoError(t, store.CreateSession(ctx, s))
+	}
+
+	dirs, err := store.GetUnassignedWorkingDirs(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"/src/app", "/src/app/web"}, dirs)
+
+	project, err := store.UpsertProject(ctx, "/src/app")
+	require.NoError(t, err)
+	assert.Equal(t, "app", project.Name)
+	again, err := store.UpsertProject(ctx, "/src/app")
+	require.NoError(t, err)
+	assert.Equal(t, project.ID, again.ID)
+
+	require.NoError(t, store.AssignProjectByWorkingDir(ctx, "/src/app", project.ID))
+	require.NoError(t, store.AssignProjectByWorkingDir(ctx, "/src/app/web", project.ID))
+	dirs, err = store.GetUnassignedWorkingDirs(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, dirs)
+
+	_, err = store.UpsertProject(ctx, "/src/empty")
+	require.NoError(t, err)
+
+	projects, err := store.ListProjects(ctx)
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	assert.Equal(t, "/src/app", projects[0].RootPath)
+	assert.Equal(t, 3, projects[0].SessionCount)
+	assert.NotNil(t, projects[0].LastActivityAt)
+	assert.Equal(t, 0, projects[1].SessionCount)
+	assert.Nil(t, projects[1].LastActivityAt)
+
+	// Moving a session to another project
+	other, err := store.UpsertProject(ctx, "/src/empty")
+	require.NoError(t, err)
+	require.NoError(t, store.UpdateSession(ctx, "c", SessionUpdate{ProjectID: &other.ID}))
+	sess, err := store.GetSession(ctx, "c")
+	require.NoError(t, err)
+	assert.Equal(t, other.ID, sess.ProjectID)
+}
+
+func TestSavedFilters(t *testing.T) {
+	store, err := NewSQLiteStore(testutil.DatabasePath(t, "sqlite-saved-filters"))
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+	minCost := 2.5
+	saved := &SavedFilter{
+		ID:   "filter-1",
+		Name: "Expensive bugs",
+		Filter: SessionFilter{
+			Labels:     []string{"bug"},
+			MinCostUSD: &minCost,
+			LeavesOnly: true,
+		},
+	}
+	require.NoError(t, store.CreateSavedFilter(ctx, saved))
+	assert.ErrorIs(t, store.CreateSavedFilter(ctx, &SavedFilter{ID: "filter-2", Name: "Expensive bugs"}), ErrAlreadyExists)
+
+	got, err := store.GetSavedFilter(ctx, "filter-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bug"}, got.Filter.Labels)
+	assert.Equal(t, 2.5, *got.Filter.MinCostUSD)
+	// Leaves-only is a view option and is not saved
+	assert.False(t, got.Filter.LeavesOnly)
+
+	got.Name = "Pricey bugs"
+	got.Filter.Statuses = []string{SessionStatusFailed}
+	require.NoError(t, store.UpdateSavedFilter(ctx, got))
+
+	filters, err := store.ListSavedFilters(ctx)
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	assert.Equal(t, "Pricey bugs", filters[0].Name)
+	assert.Equal(t, []string{SessionStatusFailed}, filters[0].Filter.Statuses)
+
+	require.NoError(t, store.DeleteSavedFilter(ctx, "filter-1"))
+	_, err = store.GetSavedFilter(ctx, "filter-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.UpdateSavedFilter(ctx, got), ErrNotFound)
+	assert.ErrorIs(t, store.DeleteSavedFilter(ctx, "filter-1"), ErrNotFound)
+}
+
+func TestSessionFilterMerge(t *testing.T) {
+	saved := SessionFilter{Statuses: []string{"running"}, Labels: []string{"bug"}, ProjectID: "p1"}
+	merged := saved.Merge(SessionFilter{Labels: []string{"docs"}, Kind: SessionKindNormal, LeavesOnly: true})
+	assert.Equal(t, SessionFilter{
+		Kind:       SessionKindNormal,
+		Statuses:   []string{"running"},
+		Labels:     []string{"docs"},
+		ProjectID:  "p1",
+		LeavesOnly: true,
+	}, merged)
+}
@@ -0,0 +1,172 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// isUniqueViolation reports whether err is a SQLite unique constraint failure
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
+}
+
+// CreateSessionTemplate creates a new session template
+func (s *SQLiteStore) CreateSessionTemplate(ctx context.Context, template *SessionTemplate) error {
+	now := time.Now().UTC()
+	if template.CreatedAt.IsZero() {
+		template.CreatedAt = now
+	}
+	if template.UpdatedAt.IsZero() {
+		template.UpdatedAt = now
+	}
+
+	config, err := s.secrets.sealJSON(template.Config, proxyAPIKeyField)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO session_templates (id, name, description, config, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`, template.ID, template.Name, template.Description, config,
+		template.CreatedAt.UTC(), template.UpdatedAt.UTC(),
+	)
+	if isUniqueViolation(err) {
+		return &AlreadyExistsError{Type: "session template", Name: template.Name}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create session template: %w", err)
+	}
+	return nil
+}
+
+const sessionTemplateColumns = `id, name, description, config, created_at, updated_at`
+
+func scanSessionTemplate(row interface{ Scan(...any) error }, secrets secretCodec) (*SessionTemplate, error) {
+	var template SessionTemplate
+	var description sql.NullString
+	if err := row.Scan(&template.ID, &template.Name, &description, &template.Config,
+		&template.CreatedAt, &template.UpdatedAt); err != nil {
+		return nil, err
+	}
+	template.Description = description.String
+	template.Config = secrets.openJSON(template.Config, proxyAPIKeyField)
+	return &template, nil
+}
+
+// GetSessionTemplate retrieves a session template by ID
+func (s *SQLiteStore) GetSessionTemplate(ctx context.Context, id string) (*SessionTemplate, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+sessionTemplateColumns+` FROM session_templates WHERE id = ?`, id)
+	template, err := scanSessionTemplate(row, s.secrets)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "session template", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session template: %w", err)
+	}
+	return template, nil
+}
+
+// GetSessionTemplateByName retrieves a session template by its unique name
+func (s *SQLiteStore) GetSessionTemplateByName(ctx context.Context, name string) (*SessionTemplate, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+sessionTemplateColumns+` FROM session_templates WHERE name = ?`, name)
+	template, err := scanSessionTemplate(row, s.secrets)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "session template", ID: name}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session template: %w", err)
+	}
+	return template, nil
+}
+
+// ListSessionTemplates retrieves all session templates ordered by name
+func (s *SQLiteStore) ListSessionTemplates(ctx context.Context) ([]*SessionTemplate, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+sessionTemplateColumns+` FROM session_templates ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session templates: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var templates []*SessionTemplate
+	for rows.Next() {
+		template, err := scanSessionTemplate(rows, s.secrets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan session template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+	return templates, rows.Err()
+}
+
+// UpdateSessionTemplate updates the specified session template fields
+func (s *SQLiteStore) UpdateSessionTemplate(ctx context.Context, id string, updates SessionTemplateUpdate) error {
+	setParts := []string{}
+	args := []interface{}{}
+
+	if updates.Name != nil {
+		setParts = append(setParts, "name = ?")
+		args = append(args, *updates.Name)
+	}
+	if updates.Description != nil {
+		setParts = append(setParts, "description = ?")
+		args = append(args, *updates.Description)
+	}
+	if updates.Config != nil {
+		config, err := s.secrets.sealJSON(*updates.Config, proxyAPIKeyField)
+		if err != nil {
+			return err
+		}
+		setParts = append(setParts, "config = ?")
+		args = append(args, config)
+	}
+
+	if len(setParts) == 0 {
+		return nil
+	}
+
+	setParts = append(setParts, "updated_at = ?")
+	args = append(args, time.Now().UTC())
+	args = append(args, id)
+
+	query := fmt.Sprintf("UPDATE session_templates SET %s WHERE id = ?", strings.Join(setParts, ", "))
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if isUniqueViolation(err) {
+		return &AlreadyExistsError{Type: "session template", Name: *updates.Name}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update session template: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "session template", ID: id}
+	}
+	return nil
+}
+
+// DeleteSessionTemplate deletes a session template
+func (s *SQLiteStore) DeleteSessionTemplate(ctx context.Context, id string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM session_templates WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete session template: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "session template", ID: id}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"context"
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionTemplateCRUD(t *testing.T) {
+	dbPath := testutil.DatabasePath(t, "sqlite-templates")
+	store, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+
+	require.NoError(t, store.CreateSessionTemplate(ctx, &SessionTemplate{
+		ID:          "tmpl-1",
+		Name:        "fix-issue",
+		Description: "Fix a GitHub issue",
+		Config:      `{"query":"Fix issue {{issue}}"}`,
+	}))
+
+	got, err := store.GetSessionTemplate(ctx, "tmpl-1")
+	require.NoError(t, err)
+	assert.Equal(t, "fix-issue", got.Name)
+	assert.Equal(t, "Fix a GitHub issue", got.Description)
+
+	got, err = store.GetSessionTemplateByName(ctx, "fix-issue")
+	require.NoError(t, err)
+	assert.Equal(t, "tmpl-1", got.ID)
+
+	// Names are unique
+	err = store.CreateSessionTemplate(ctx, &SessionTemplate{ID: "tmpl-2", Name: "fix-issue", Config: `{}`})
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+
+	require.NoError(t, store.CreateSessionTemplate(ctx, &SessionTemplate{ID: "tmpl-2", Name: "review", Config: `{}`}))
+	name := "review"
+	assert.ErrorIs(t, store.UpdateSessionTemplate(ctx, "tmpl-1", SessionTemplateUpdate{Name: &name}), ErrAlreadyExists)
+
+	config := `{"query":"Fix {{issue}} quickly"}`
+	require.NoError(t, store.UpdateSessionTemplate(ctx, "tmpl-1", SessionTemplateUpdate{Config: &config}))
+	got, err = store.GetSessionTemplate(ctx, "tmpl-1")
+	require.NoError(t, err)
+	assert.Equal(t, config, got.Config)
+
+	templates, err := store.ListSessionTemplates(ctx)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "fix-issue", templates[0].Name)
+
+	require.NoError(t, store.DeleteSessionTemplate(ctx, "tmpl-1"))
+	_, err = store.GetSessionTemplate(ctx, "tmpl-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.DeleteSessionTemplate(ctx, "tmpl-1"), ErrNotFound)
+}
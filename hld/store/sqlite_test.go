@@ -588,6 +588,71 @@ func TestGetToolCallByID(t *testing.T) {
 	})
 }
 
+func TestCompactionEvents(t *testing.T) {
+	dbPath := testutil.DatabasePath(t, "sqlite-compaction")
+	store, err := NewSQLiteStore(dbPath)
+	require.NoError(t, err)
+	defer func() { _ = store.Close() }()
+
+	ctx := context.Background()
+
+	session := &Session{
+		ID:              "test-session",
+		RunID:           "test-run",
+		ClaudeSessionID: "claude-session-1",
+		Query:           "Test query",
+		Status:          SessionStatusRunning,
+		CreatedAt:       time.Now(),
+		LastActivityAt:  time.Now(),
+	}
+	require.NoError(t, store.CreateSession(ctx, session))
+
+	preTokens := 155000
+	compaction := &ConversationEvent{
+		SessionID:           session.ID,
+		ClaudeSessionID:     session.ClaudeSessionID,
+		EventType:           EventTypeCompaction,
+		Role:                "system",
+		CompactionTrigger:   "auto",
+		PreCompactionTokens: &preTokens,
+	}
+	require.NoError(t, store.AddConversationEvent(ctx, compaction))
+
+	events, err := store.GetConversation(ctx, session.ClaudeSessionID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "auto", events[0].CompactionTrigger)
+	require.NotNil(t, events[0].PreCompactionTokens)
+	require.Equal(t, preTokens, *events[0].PreCompactionTokens)
+	require.Nil(t, events[0].PostCompactionTokens)
+
+	summary := "Summary of the earlier conversation"
+	postTokens := 18000
+	require.NoError(t, store.UpdateCompactionEvent(ctx, compaction.ID, CompactionUpdate{
+		Summary:              &summary,
+		PostCompactionTokens: &postTokens,
+	}))
+
+	events, err = store.GetSessionConversation(ctx, session.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, summary, events[0].Content)
+	require.NotNil(t, events[0].PostCompactionTokens)
+	require.Equal(t, postTokens, *events[0].PostCompactionTokens)
+
+	// Only compaction events can be updated
+	message := &ConversationEvent{
+		SessionID:       session.ID,
+		ClaudeSessionID: session.ClaudeSessionID,
+		EventType:       EventTypeMessage,
+		Role:            "user",
+		Content:         "hello",
+	}
+	require.NoError(t, store.AddConversationEvent(ctx, message))
+	err = store.UpdateCompactionEvent(ctx, message.ID, CompactionUpdate{Summary: &summary})
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
 func TestFileSnapshots(t *testing.T) {
 	// Create temp database
 	dbPath := testutil.DatabasePath(t, "sqlite-snapshots")
@@ -0,0 +1,404 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CreateWebhook creates a new webhook
+func (s *SQLiteStore) CreateWebhook(ctx context.Context, webhook *Webhook) error {
+	now := time.Now().UTC()
+	if webhook.CreatedAt.IsZero() {
+		webhook.CreatedAt = now
+	}
+	if webhook.UpdatedAt.IsZero() {
+		webhook.UpdatedAt = now
+	}
+
+	secret, err := s.secrets.seal(webhook.Secret)
+	if err != nil {
+		return err
+	}
+	eventTypes, labels, err := encodeWebhookLists(webhook.EventTypes, webhook.Labels)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `
+		INSERT INTO webhooks (
+			id, name, url, secret, event_types, labels, working_dir, enabled,
+			created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, webhook.ID, webhook.Name, webhook.URL, secret, eventTypes, labels, webhook.WorkingDir,
+		webhook.Enabled, webhook.CreatedAt.UTC(), webhook.UpdatedAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return nil
+}
+
+const webhookColumns = `
+	id, name, url, secret, event_types, labels, working_dir, enabled,
+	created_at, updated_at
+`
+
+// encodeWebhookLists encodes a webhook's event types and labels for storage
+func encodeWebhookLists(eventTypes, labels []string) (string, string, error) {
+	encodedTypes, err := encodeStringList(eventTypes)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode webhook event types: %w", err)
+	}
+	encodedLabels, err := encodeStringList(labels)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode webhook labels: %w", err)
+	}
+	return encodedTypes, encodedLabels, nil
+}
+
+func encodeStringList(values []string) (string, error) {
+	if values == nil {
+		values = []string{}
+	}
+	encoded, err := json.Marshal(values)
+	return string(encoded), err
+}
+
+// scanWebhook scans a webhook row selected with webhookColumns
+func scanWebhook(row interface{ Scan(...any) error }, secrets secretCodec) (*Webhook, error) {
+	var webhook Webhook
+	var eventTypes, labels string
+	var workingDir sql.NullString
+
+	err := row.Scan(
+		&webhook.ID, &webhook.Name, &webhook.URL, &webhook.Secret, &eventTypes, &labels,
+		&workingDir, &webhook.Enabled, &webhook.CreatedAt, &webhook.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook.Secret = secrets.open(webhook.Secret)
+	webhook.WorkingDir = workingDir.String
+	if err := json.Unmarshal([]byte(eventTypes), &webhook.EventTypes); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook event types: %w", err)
+	}
+	if err := json.Unmarshal([]byte(labels), &webhook.Labels); err != nil {
+		return nil, fmt.Errorf("failed to decode webhook labels: %w", err)
+	}
+	return &webhook, nil
+}
+
+// GetWebhook retrieves a webhook by ID
+func (s *SQLiteStore) GetWebhook(ctx context.Context, id string) (*Webhook, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+webhookColumns+` FROM webhooks WHERE id = ?`, id)
+	webhook, err := scanWebhook(row, s.secrets)
+	if err == sql.ErrNoRows {
+		return nil, &NotFoundError{Type: "webhook", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return webhook, nil
+}
+
+// ListWebhooks retrieves all webhooks ordered by name
+func (s *SQLiteStore) ListWebhooks(ctx context.Context) ([]*Webhook, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+webhookColumns+` FROM webhooks ORDER BY name, created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var webhooks []*Webhook
+	for rows.Next() {
+		webhook, err := scanWebhook(rows, s.secrets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+// webhookUpdateSet builds the SET clause and arguments of a webhook update
+func webhookUpdateSet(updates WebhookUpdate, secrets secretCodec) ([]string, []interface{}, error) {
+	setParts := []string{}
+	args := []interface{}{}
+
+	if updates.Name != nil {
+		setParts = append(setParts, "name = ?")
+		args = append(args, *updates.Name)
+	}
+	if updates.URL != nil {
+		setParts = append(setParts, "url = ?")
+		args = append(args, *updates.URL)
+	}
+	if updates.Secret != nil {
+		secret, err := secrets.seal(*updates.Secret)
+		if err != nil {
+			return nil, nil, err
+		}
+		setParts = append(setParts, "secret = ?")
+		args = append(args, secret)
+	}
+	if updates.EventTypes != nil {
+		encoded, err := encodeStringList(*updates.EventTypes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode webhook event types: %w", err)
+		}
+		setParts = append(setParts, "event_types = ?")
+		args = append(args, encoded)
+	}
+	if updates.Labels != nil {
+		encoded, err := encodeStringList(*updates.Labels)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to encode webhook labels: %w", err)
+		}
+		setParts = append(setParts, "labels = ?")
+		args = append(args, encoded)
+	}
+	if updates.WorkingDir != nil {
+		setParts = append(setParts, "working_dir = ?")
+		args = append(args, *updates.WorkingDir)
+	}
+	if updates.Enabled != nil {
+		setParts = append(setParts, "enabled = ?")
+		args = append(args, *updates.Enabled)
+	}
+	return setParts, args, nil
+}
+
+// UpdateWebhook updates the specified webhook fields
+func (s *SQLiteStore) UpdateWebhook(ctx context.Context, id string, updates WebhookUpdate) error {
+	setParts, args, err := webhookUpdateSet(updates, s.secrets)
+	if err != nil {
+		return err
+	}
+	if len(setParts) == 0 {
+		return nil
+	}
+
+	setParts = append(setParts, "updated_at = ?")
+	args = append(args, time.Now().UTC())
+	args = append(args, id)
+
+	query := fmt.Sprintf("UPDATE webhooks SET %s WHERE id = ?", strings.Join(setParts, ", "))
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "webhook", ID: id}
+	}
+	return nil
+}
+
+// DeleteWebhook deletes a webhook and its delivery log
+func (s *SQLiteStore) DeleteWebhook(ctx context.Context, id string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM webhook_deliveries WHERE webhook_id = ?`, id); err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM webhooks WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "webhook", ID: id}
+	}
+
+	return tx.Commit()
+}
+
+// trimWebhookDeliveriesQuery deletes all but the newest finished deliveries
+// of a webhook. Pending deliveries are never trimmed.
+const trimWebhookDeliveriesQuery = `
+	DELETE FROM webhook_deliveries
+	WHERE webhook_id = ? AND status <> 'pending' AND id NOT IN (
+		SELECT id FROM webhook_deliveries
+		WHERE webhook_id = ? AND status <> 'pending'
+		ORDER BY id DESC LIMIT ?
+	)
+`
+
+// CreateWebhookDelivery records a delivery, setting its ID, and deletes all
+// but the newest keep finished deliveries of the webhook. A keep of zero or
+// less keeps every delivery.
+func (s *SQLiteStore) CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery, keep int) error {
+	if delivery.CreatedAt.IsZero() {
+		delivery.CreatedAt = time.Now().UTC()
+	}
+
+	result, err := s.db.ExecContext(ctx, `
+		INSERT INTO webhook_deliveries (
+			webhook_id, event_type, event_seq, payload, status, attempts, next_attempt_at,
+			response_status, error, created_at, delivered_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, delivery.WebhookID, delivery.EventType, delivery.EventSeq, delivery.Payload, delivery.Status,
+		delivery.Attempts, utcTimePtr(delivery.NextAttemptAt), delivery.ResponseStatus, delivery.Error,
+		delivery.CreatedAt.UTC(), utcTimePtr(delivery.DeliveredAt),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get webhook delivery ID: %w", err)
+	}
+	delivery.ID = id
+
+	if keep > 0 {
+		if _, err := s.db.ExecContext(ctx, trimWebhookDeliveriesQuery, delivery.WebhookID, delivery.WebhookID, keep); err != nil {
+			return fmt.Errorf("failed to trim webhook deliveries: %w", err)
+		}
+	}
+	return nil
+}
+
+// webhookDeliveryUpdateSet builds the SET clause and arguments of a delivery update
+func webhookDeliveryUpdateSet(updates WebhookDeliveryUpdate) ([]string, []interface{}) {
+	setParts := []string{}
+	args := []interface{}{}
+
+	if updates.Status != nil {
+		setParts = append(setParts, "status = ?")
+		args = append(args, *updates.Status)
+	}
+	if updates.Attempts != nil {
+		setParts = append(setParts, "attempts = ?")
+		args = append(args, *updates.Attempts)
+	}
+	if updates.NextAttemptAt != nil {
+		setParts = append(setParts, "next_attempt_at = ?")
+		args = append(args, updates.NextAttemptAt.UTC())
+	}
+	if updates.ResponseStatus != nil {
+		setParts = append(setParts, "response_status = ?")
+		args = append(args, *updates.ResponseStatus)
+	}
+	if updates.Error != nil {
+		setParts = append(setParts, "error = ?")
+		args = append(args, *updates.Error)
+	}
+	if updates.DeliveredAt != nil {
+		setParts = append(setParts, "delivered_at = ?")
+		args = append(args, updates.DeliveredAt.UTC())
+	}
+	return setParts, args
+}
+
+// UpdateWebhookDelivery updates the specified delivery fields
+func (s *SQLiteStore) UpdateWebhookDelivery(ctx context.Context, id int64, updates WebhookDeliveryUpdate) error {
+	setParts, args := webhookDeliveryUpdateSet(updates)
+	if len(setParts) == 0 {
+		return nil
+	}
+	args = append(args, id)
+
+	query := fmt.Sprintf("UPDATE webhook_deliveries SET %s WHERE id = ?", strings.Join(setParts, ", "))
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return &NotFoundError{Type: "webhook delivery", ID: fmt.Sprint(id)}
+	}
+	return nil
+}
+
+const webhookDeliveryColumns = `
+	id, webhook_id, event_type, event_seq, payload, status, attempts, next_attempt_at,
+	response_status, error, created_at, delivered_at
+`
+
+// scanWebhookDeliveries scans delivery rows selected with webhookDeliveryColumns
+func scanWebhookDeliveries(rows *sql.Rows) ([]*WebhookDelivery, error) {
+	var deliveries []*WebhookDelivery
+	for rows.Next() {
+		var delivery WebhookDelivery
+		var responseStatus sql.NullInt64
+		var deliveryError sql.NullString
+		var nextAttemptAt, deliveredAt sql.NullTime
+		if err := rows.Scan(
+			&delivery.ID, &delivery.WebhookID, &delivery.EventType, &delivery.EventSeq, &delivery.Payload,
+			&delivery.Status, &delivery.Attempts, &nextAttemptAt, &responseStatus, &deliveryError,
+			&delivery.CreatedAt, &deliveredAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		delivery.ResponseStatus = int(responseStatus.Int64)
+		delivery.Error = deliveryError.String
+		if nextAttemptAt.Valid {
+			delivery.NextAttemptAt = &nextAttemptAt.Time
+		}
+		if deliveredAt.Valid {
+			delivery.DeliveredAt = &deliveredAt.Time
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+	return deliveries, rows.Err()
+}
+
+// ListWebhookDeliveries retrieves the most recent deliveries of a webhook,
+// newest first. A limit of 0 or less returns all deliveries.
+func (s *SQLiteStore) ListWebhookDeliveries(ctx context.Context, webhookID string, limit int) ([]*WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries WHERE webhook_id = ? ORDER BY id DESC`
+	args := []interface{}{webhookID}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	return scanWebhookDeliveries(rows)
+}
+
+// GetDueWebhookDeliveries retrieves pending deliveries whose next attempt is
+// at or before now, oldest first. A limit of 0 or less returns all of them.
+func (s *SQLiteStore) GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM webhook_deliveries
+		WHERE status = 'pending' AND next_attempt_at <= ?
+		ORDER BY next_attempt_at, id`
+	args := []interface{}{now.UTC()}
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due webhook deliveries: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	return scanWebhookDeliveries(rows)
+}
@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
@@ -18,13 +19,18 @@ type ConversationStore interface {
 	GetSessionByRunID(ctx context.Context, runID string) (*Session, error)
 	ListSessions(ctx context.Context) ([]*Session, error)
 	SearchSessionsByTitle(ctx context.Context, query string, limit int) ([]*Session, error)
+	SearchConversations(ctx context.Context, search ConversationSearch) ([]*ConversationSearchResult, error)
 	// GetExpiredDangerousPermissionsSessions returns sessions where dangerous permissions have expired
 	GetExpiredDangerousPermissionsSessions(ctx context.Context) ([]*Session, error)
+	GetSessionsByStatus(ctx context.Context, statuses []string) ([]*Session, error)
 
 	// Conversation operations
 	AddConversationEvent(ctx context.Context, event *ConversationEvent) error
 	GetConversation(ctx context.Context, claudeSessionID string) ([]*ConversationEvent, error)
 	GetSessionConversation(ctx context.Context, sessionID string) ([]*ConversationEvent, error)
+	GetConversationPage(ctx context.Context, claudeSessionID string, page ConversationPage) ([]*ConversationEvent, error)
+	GetSessionConversationPage(ctx context.Context, sessionID string, page ConversationPage) ([]*ConversationEvent, error)
+	UpdateCompactionEvent(ctx context.Context, eventID int64, updates CompactionUpdate) error
 
 	// Tool call operations
 	GetPendingToolCall(ctx context.Context, sessionID string, toolName string) (*ConversationEvent, error)
@@ -59,18 +65,208 @@ type ConversationStore interface {
 	GetUserSettings(ctx context.Context) (*UserSettings, error)
 	UpdateUserSettings(ctx context.Context, settings UserSettings) error
 
+	// Schedule operations
+	CreateSchedule(ctx context.Context, schedule *Schedule) error
+	GetSchedule(ctx context.Context, id string) (*Schedule, error)
+	ListSchedules(ctx context.Context) ([]*Schedule, error)
+	UpdateSchedule(ctx context.Context, id string, updates ScheduleUpdate) error
+	DeleteSchedule(ctx context.Context, id string) error
+	CreateScheduleRun(ctx context.Context, run *ScheduleRun) error
+	ListScheduleRuns(ctx context.Context, scheduleID string, limit int) ([]*ScheduleRun, error)
+
+	// Webhook operations. Creating a delivery trims the webhook's finished
+	// deliveries to its newest keep.
+	CreateWebhook(ctx context.Context, webhook *Webhook) error
+	GetWebhook(ctx context.Context, id string) (*Webhook, error)
+	ListWebhooks(ctx context.Context) ([]*Webhook, error)
+	UpdateWebhook(ctx context.Context, id string, updates WebhookUpdate) error
+	DeleteWebhook(ctx context.Context, id string) error
+	CreateWebhookDelivery(ctx context.Context, delivery *WebhookDelivery, keep int) error
+	UpdateWebhookDelivery(ctx context.Context, id int64, updates WebhookDeliveryUpdate) error
+	ListWebhookDeliveries(ctx context.Context, webhookID string, limit int) ([]*WebhookDelivery, error)
+	GetDueWebhookDeliveries(ctx context.Context, now time.Time, limit int) ([]*WebhookDelivery, error)
+
+	// Pipeline run operations
+	CreatePipelineRun(ctx context.Context, run *PipelineRun) error
+	GetPipelineRun(ctx context.Context, id string) (*PipelineRun, error)
+	ListPipelineRuns(ctx context.Context) ([]*PipelineRun, error)
+	UpdatePipelineRun(ctx context.Context, id string, updates PipelineRunUpdate) error
+	SavePipelineStep(ctx context.Context, step *PipelineStep) error
+	GetPipelineSteps(ctx context.Context, runID string) ([]*PipelineStep, error)
+
+	// Batch group operations
+	CreateBatchGroup(ctx context.Context, group *BatchGroup) error
+	GetBatchGroup(ctx context.Context, id string) (*BatchGroup, error)
+	ListBatchGroups(ctx context.Context) ([]*BatchGroup, error)
+	AddBatchMember(ctx context.Context, member *BatchMember) error
+	GetBatchMembers(ctx context.Context, groupID string) ([]*BatchMember, error)
+
+	// Session template operations
+	CreateSessionTemplate(ctx context.Context, template *SessionTemplate) error
+	GetSessionTemplate(ctx context.Context, id string) (*SessionTemplate, error)
+	GetSessionTemplateByName(ctx context.Context, name string) (*SessionTemplate, error)
+	ListSessionTemplates(ctx context.Context) ([]*SessionTemplate, error)
+	UpdateSessionTemplate(ctx context.Context, id string, updates SessionTemplateUpdate) error
+	DeleteSessionTemplate(ctx context.Context, id string) error
+
+	// Resource accounting operations
+	RecordResourceSample(ctx context.Context, sample *ResourceSample) error
+	GetResourceSamples(ctx context.Context, sessionID string, limit int) ([]*ResourceSample, error)
+
+	// Session organisation: filtered listing, labels, projects and saved filters
+	QuerySessions(ctx context.Context, filter SessionFilter) ([]*Session, error)
+	CountSessionsByKind(ctx context.Context, filter SessionFilter) (*SessionCounts, error)
+	ListLabels(ctx context.Context) ([]*Label, error)
+	CreateLabel(ctx context.Context, label *Label) error
+	DeleteLabel(ctx context.Context, id string) error
+	SetSessionLabels(ctx context.Context, sessionID string, names []string) error
+	GetSessionLabels(ctx context.Context, sessionID string) ([]string, error)
+	UpsertProject(ctx context.Context, rootPath string) (*Project, error)
+	ListProjects(ctx context.Context) ([]*Project, error)
+	GetUnassignedWorkingDirs(ctx context.Context) ([]string, error)
+	AssignProjectByWorkingDir(ctx context.Context, workingDir string, projectID string) error
+	CreateSavedFilter(ctx context.Context, filter *SavedFilter) error
+	GetSavedFilter(ctx context.Context, id string) (*SavedFilter, error)
+	ListSavedFilters(ctx context.Context) ([]*SavedFilter, error)
+	UpdateSavedFilter(ctx context.Context, filter *SavedFilter) error
+	DeleteSavedFilter(ctx context.Context, id string) error
+
+	// Effective launch configuration, stored as JSON when a session is created
+	SetSessionEffectiveConfig(ctx context.Context, sessionID string, config string) error
+	GetSessionEffectiveConfig(ctx context.Context, sessionID string) (string, error)
+
+	// Project configuration trust, recorded per project root as the digest of
+	// the trusted configuration file. Getting an untrusted root returns "".
+	SetProjectConfigTrust(ctx context.Context, projectRoot string, digest string) error
+	DeleteProjectConfigTrust(ctx context.Context, projectRoot string) error
+	GetProjectConfigTrust(ctx context.Context, projectRoot string) (string, error)
+
+	// Event log of the newest bus events. Appending does not trim the log;
+	// TrimEventLog deletes all but its newest keep entries.
+	AppendEventLog(ctx context.Context, entry *EventLogEntry) error
+	TrimEventLog(ctx context.Context, keep int) (int64, error)
+	GetRecentEventLog(ctx context.Context, limit int) ([]*EventLogEntry, error)
+
+	// Database maintenance: retention pruning, vacuum and statistics
+	RunMaintenance(ctx context.Context, opts MaintenanceOptions) (*MaintenanceReport, error)
+
+	// Session records for moving sessions between databases
+	GetSessionRecords(ctx context.Context, sessionID string, includeRawEvents bool) (*SessionRecords, error)
+	ImportSessionRecords(ctx context.Context, records []*SessionRecords) error
+
 	// Database lifecycle
 	Close() error
 }
 
 // UserSettings represents user preferences
 type UserSettings struct {
-	AdvancedProviders bool      `json:"advanced_providers"`
-	OptInTelemetry    *bool     `json:"opt_in_telemetry"` // Pointer to handle NULL (unset)
-	CreatedAt         time.Time `json:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at"`
+	AdvancedProviders bool   `json:"advanced_providers"`
+	OptInTelemetry    *bool  `json:"opt_in_telemetry"` // Pointer to handle NULL (unset)
+	AutoTitleMode     string `json:"auto_title_mode"`  // How untitled sessions get a title, see TitleMode constants
+	AutoTitleModel    string `json:"auto_title_model"` // Model for TitleModeModel, empty for the default
+
+	// Retention applied by database maintenance. Zero days keeps data forever.
+	RawEventRetentionDays        int  `json:"raw_event_retention_days"`
+	ArchivedSessionRetentionDays int  `json:"archived_session_retention_days"`
+	FileSnapshotRetentionDays    int  `json:"file_snapshot_retention_days"`
+	DedupeFileSnapshots          bool `json:"dedupe_file_snapshots"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// DefaultRawEventRetentionDays is how long raw stream events are kept unless
+// the user changes it. Other data is kept forever by default.
+const DefaultRawEventRetentionDays = 30
+
+// RetentionPolicy converts the retention settings into a policy for RunMaintenance
+func (s UserSettings) RetentionPolicy() RetentionPolicy {
+	days := func(n int) time.Duration { return time.Duration(n) * 24 * time.Hour }
+	return RetentionPolicy{
+		RawEventTTL:         days(s.RawEventRetentionDays),
+		ArchivedSessionTTL:  days(s.ArchivedSessionRetentionDays),
+		FileSnapshotTTL:     days(s.FileSnapshotRetentionDays),
+		DedupeFileSnapshots: s.DedupeFileSnapshots,
+	}
+}
+
+// RetentionPolicy says which data database maintenance prunes. A zero TTL
+// keeps that data forever.
+type RetentionPolicy struct {
+	RawEventTTL         time.Duration
+	ArchivedSessionTTL  time.Duration // Measured from the session's last activity
+	FileSnapshotTTL     time.Duration
+	DedupeFileSnapshots bool // Drop snapshots identical to the next one taken of the same file
+}
+
+// Vacuum modes for RunMaintenance
+const (
+	VacuumNone        = "none"
+	VacuumIncremental = "incremental" // Returns free pages to the filesystem, needs auto_vacuum=incremental
+	VacuumFull        = "full"        // Rebuilds the file and switches it to incremental auto-vacuum
+)
+
+// MaintenanceOptions configures a RunMaintenance pass
+type MaintenanceOptions struct {
+	Retention   RetentionPolicy
+	DryRun      bool   // Report what would be pruned without changing anything
+	Vacuum      string // See the Vacuum constants, empty means VacuumNone
+	VacuumPages int    // Page budget for an incremental vacuum, 0 frees every page
+	Analyze     bool   // Refresh query planner statistics with a bounded ANALYZE
+	Now         time.Time
+}
+
+// PruneCount is what maintenance removed, or would remove in a dry run, from
+// one kind of data
+type PruneCount struct {
+	Rows  int64 `json:"rows"`
+	Bytes int64 `json:"bytes"` // Approximate payload size of the rows
+}
+
+// MaintenanceReport summarizes a RunMaintenance pass
+type MaintenanceReport struct {
+	DryRun                 bool       `json:"dry_run"`
+	RawEvents              PruneCount `json:"raw_events"`
+	ArchivedSessions       PruneCount `json:"archived_sessions"`
+	ExpiredFileSnapshots   PruneCount `json:"expired_file_snapshots"`
+	DuplicateFileSnapshots PruneCount `json:"duplicate_file_snapshots"`
+	AutoVacuum             string     `json:"auto_vacuum"` // The database's auto_vacuum mode after the pass
+	Vacuum                 string     `json:"vacuum"`      // The vacuum that ran
+	Analyzed               bool       `json:"analyzed"`
+	SizeBeforeBytes        int64      `json:"size_before_bytes"`
+	SizeAfterBytes         int64      `json:"size_after_bytes"`
+	ReclaimedBytes         int64      `json:"reclaimed_bytes"`
+	FreeBytes              int64      `json:"free_bytes"` // Unused pages still inside the file
+	StartedAt              time.Time  `json:"started_at"`
+	CompletedAt            time.Time  `json:"completed_at"`
+}
+
+// SessionRecords holds every row stored for one session
+type SessionRecords struct {
+	Session       *Session
+	Labels        []string
+	Events        []*ConversationEvent
+	Approvals     []*Approval
+	FileSnapshots []FileSnapshot
+	MCPServers    []MCPServer
+	RawEvents     []RawEvent // Only when requested
 }
 
+// RawEvent is a Claude stream event stored verbatim for debugging
+type RawEvent struct {
+	ID        int64
+	SessionID string
+	EventJSON string
+	CreatedAt time.Time
+}
+
+// Automatic session title modes
+const (
+	TitleModeOff       = "off"       // Sessions keep the title the user gives them
+	TitleModeHeuristic = "heuristic" // Titles are extracted from the query locally
+	TitleModeModel     = "model"     // Titles are written by a one-shot model call
+)
+
 // Session represents a Claude Code session
 type Session struct {
 	ID                                  string
@@ -119,6 +315,30 @@ type Session struct {
 
 	// Editor state for draft sessions (JSON blob)
 	EditorState *string `db:"editor_state"`
+
+	// Stall watchdog thresholds, nil uses the daemon default and 0 disables
+	StallThresholdMs          *int64 `db:"stall_threshold_ms"`
+	StallInterruptThresholdMs *int64 `db:"stall_interrupt_threshold_ms"`
+
+	// Resource usage of the session's process tree from the latest sample, and
+	// the peaks across all samples. ResourcesSampledAt is nil until sampled.
+	CPUTimeMs          int64      `db:"cpu_time_ms"`
+	CPUPercent         float64    `db:"cpu_percent"`
+	RSSBytes           int64      `db:"rss_bytes"`
+	OpenFDs            int64      `db:"open_fds"`
+	PeakCPUPercent     float64    `db:"peak_cpu_percent"`
+	PeakRSSBytes       int64      `db:"peak_rss_bytes"`
+	PeakOpenFDs        int64      `db:"peak_open_fds"`
+	ResourcesSampledAt *time.Time `db:"resources_sampled_at"`
+
+	// Runner backend the session was launched with, empty for Claude Code
+	Runner string `db:"runner"`
+
+	// Project the session's working directory belongs to, empty if unassigned
+	ProjectID string `db:"project_id"`
+
+	// Label names, populated by QuerySessions
+	Labels []string
 }
 
 // SessionUpdate contains fields that can be updated
@@ -157,6 +377,11 @@ type SessionUpdate struct {
 	WorkingDir *string `db:"working_dir"`
 	// Editor state field (JSON blob)
 	EditorState *string `db:"editor_state"`
+	// Stall watchdog thresholds
+	StallThresholdMs          *int64 `db:"stall_threshold_ms"`
+	StallInterruptThresholdMs *int64 `db:"stall_interrupt_threshold_ms"`
+	// Project, set when the working directory changes
+	ProjectID *string `db:"project_id"`
 }
 
 // ConversationEvent represents a single event in a conversation
@@ -165,7 +390,7 @@ type ConversationEvent struct {
 	SessionID       string
 	ClaudeSessionID string
 	Sequence        int
-	EventType       string // 'message', 'tool_call', 'tool_result', 'system'
+	EventType       string // 'message', 'tool_call', 'tool_result', 'system', 'thinking', 'compaction'
 	CreatedAt       time.Time
 
 	// Message fields
@@ -186,6 +411,18 @@ type ConversationEvent struct {
 	IsCompleted    bool   // TRUE when tool result received
 	ApprovalStatus string // NULL, 'pending', 'approved', 'denied'
 	ApprovalID     string // HumanLayer approval ID when correlated
+
+	// Compaction fields, Content holds the summary
+	CompactionTrigger    string // auto, manual
+	PreCompactionTokens  *int   // Context size before compaction
+	PostCompactionTokens *int   // Context size after compaction, nil until known
+}
+
+// CompactionUpdate contains compaction event fields that can be filled in
+// after the compaction boundary is recorded
+type CompactionUpdate struct {
+	Summary              *string
+	PostCompactionTokens *int
 }
 
 // FileSnapshot represents a snapshot of file content at Read time
@@ -254,6 +491,7 @@ const (
 	EventTypeToolResult = "tool_result"
 	EventTypeSystem     = "system"
 	EventTypeThinking   = "thinking"
+	EventTypeCompaction = "compaction"
 )
 
 // RecentPath represents a recently used working directory
@@ -284,6 +522,245 @@ const (
 	SessionStatusDiscarded    = "discarded"    // Draft session was discarded by the user
 )
 
+// Schedule represents a recurring session launch
+type Schedule struct {
+	ID            string
+	Name          string
+	CronExpr      string
+	Timezone      string // IANA name, e.g. "Europe/Berlin"; empty means UTC
+	WorkingDir    string
+	LaunchConfig  string // JSON-encoded launch config template
+	OverlapPolicy string // skip, allow or interrupt
+	CatchUpPolicy string // none, once or all
+	Enabled       bool
+	NextRunAt     *time.Time
+	LastRunAt     *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// ScheduleUpdate contains schedule fields that can be updated
+type ScheduleUpdate struct {
+	Name          *string
+	CronExpr      *string
+	Timezone      *string
+	WorkingDir    *string
+	LaunchConfig  *string
+	OverlapPolicy *string
+	CatchUpPolicy *string
+	Enabled       *bool
+	NextRunAt     *time.Time
+	LastRunAt     *time.Time
+}
+
+// ScheduleRun records a single firing of a schedule
+type ScheduleRun struct {
+	ID           int64
+	ScheduleID   string
+	SessionID    string // Empty when the run was skipped or failed to launch
+	ScheduledFor time.Time
+	Status       string // launched, skipped or failed
+	Reason       string // Why the run was skipped or failed
+	CatchUp      bool   // TRUE when fired to make up for missed runs
+	CreatedAt    time.Time
+
+	// Read-only, joined from the sessions table
+	SessionStatus string
+}
+
+// Schedule overlap policies decide what happens when a schedule fires while
+// the session from its previous run is still active
+const (
+	ScheduleOverlapSkip      = "skip"      // Don't launch a new session
+	ScheduleOverlapAllow     = "allow"     // Launch alongside the previous session
+	ScheduleOverlapInterrupt = "interrupt" // Interrupt the previous session, then launch
+)
+
+// Schedule catch-up policies decide what happens to runs missed while the
+// daemon was not running
+const (
+	ScheduleCatchUpNone = "none" // Drop missed runs
+	ScheduleCatchUpOnce = "once" // Fire a single run for all missed occurrences
+	ScheduleCatchUpAll  = "all"  // Fire every missed occurrence (bounded)
+)
+
+// ScheduleRun status constants
+const (
+	ScheduleRunStatusLaunched = "launched"
+	ScheduleRunStatusSkipped  = "skipped"
+	ScheduleRunStatusFailed   = "failed"
+)
+
+// Webhook posts selected daemon events to a URL
+type Webhook struct {
+	ID         string
+	Name       string
+	URL        string
+	Secret     string   // Key of the HMAC signature of every delivery
+	EventTypes []string // Webhook event types to deliver; empty means all
+	Labels     []string // Sessions must have all of these labels
+	WorkingDir string   // Sessions must run in this directory or below it; empty means any
+	Enabled    bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// WebhookUpdate contains webhook fields that can be updated
+type WebhookUpdate struct {
+	Name       *string
+	URL        *string
+	Secret     *string
+	EventTypes *[]string
+	Labels     *[]string
+	WorkingDir *string
+	Enabled    *bool
+}
+
+// WebhookDelivery records an event sent, or to be sent, to a webhook
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      string
+	EventType      string
+	EventSeq       int64  // Sequence number of the bus event, 0 if it was not logged
+	Payload        string // JSON request body
+	Status         string // pending, delivered or failed
+	Attempts       int
+	NextAttemptAt  *time.Time // When a pending delivery is tried next
+	ResponseStatus int        // HTTP status of the last attempt, 0 if there was no response
+	Error          string     // Why the last attempt failed
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// WebhookDeliveryUpdate contains delivery fields that can be updated
+type WebhookDeliveryUpdate struct {
+	Status         *string
+	Attempts       *int
+	NextAttemptAt  *time.Time
+	ResponseStatus *int
+	Error          *string
+	DeliveredAt    *time.Time
+}
+
+// WebhookDelivery status constants
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusDelivered = "delivered"
+	WebhookDeliveryStatusFailed    = "failed"
+)
+
+// PipelineRun is a single execution of a multi-step pipeline definition
+type PipelineRun struct {
+	ID           string
+	Name         string
+	Definition   string // JSON-encoded pipeline definition snapshot
+	WorkingDir   string
+	Status       string // running, paused, completed or failed
+	CurrentStep  int    // Index of the step being executed or evaluated next
+	ErrorMessage string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	CompletedAt  *time.Time
+}
+
+// PipelineRunUpdate contains pipeline run fields that can be updated
+type PipelineRunUpdate struct {
+	Status       *string
+	CurrentStep  *int
+	ErrorMessage *string
+	CompletedAt  *time.Time
+}
+
+// PipelineStep links a pipeline run step to the session that executed it
+type PipelineStep struct {
+	RunID       string
+	StepIndex   int
+	Name        string
+	SessionID   string // Empty until the step's session is launched
+	Status      string // pending, running, completed, failed or skipped
+	Reason      string // Why the step was skipped or failed
+	StartedAt   *time.Time
+	CompletedAt *time.Time
+}
+
+// PipelineRun status constants
+const (
+	PipelineRunStatusRunning   = "running"
+	PipelineRunStatusPaused    = "paused"
+	PipelineRunStatusCompleted = "completed"
+	PipelineRunStatusFailed    = "failed"
+)
+
+// PipelineStep status constants
+const (
+	PipelineStepStatusPending   = "pending"
+	PipelineStepStatusRunning   = "running"
+	PipelineStepStatusCompleted = "completed"
+	PipelineStepStatusFailed    = "failed"
+	PipelineStepStatusSkipped   = "skipped"
+)
+
+// BatchGroup is a set of sessions launched from one prompt across a matrix of
+// models, proxy providers and working directories
+type BatchGroup struct {
+	ID        string
+	Name      string
+	Query     string
+	Matrix    string // JSON-encoded matrix, with proxy API keys removed
+	CreatedAt time.Time
+}
+
+// BatchMember is one cell of a batch group and the session launched for it
+type BatchMember struct {
+	GroupID      string
+	MemberIndex  int
+	SessionID    string // Empty when the launch failed
+	Label        string
+	Model        string
+	Provider     string
+	WorkingDir   string
+	ErrorMessage string // Why the session could not be launched
+}
+
+// SessionTemplate is a reusable session configuration whose text fields may
+// contain {{variables}}
+type SessionTemplate struct {
+	ID          string
+	Name        string // Unique
+	Description string
+	Config      string // JSON-encoded template configuration
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// SessionTemplateUpdate contains session template fields that can be updated
+type SessionTemplateUpdate struct {
+	Name        *string
+	Description *string
+	Config      *string
+}
+
+// ResourceSample is one measurement of a session's process tree
+type ResourceSample struct {
+	ID           int64
+	SessionID    string
+	SampledAt    time.Time
+	CPUTimeMs    int64   // Cumulative CPU time of the tree
+	CPUPercent   float64 // CPU use since the previous sample, 100 per fully used core
+	RSSBytes     int64
+	OpenFDs      int64
+	ProcessCount int
+}
+
+// EventLogEntry is a bus event kept so subscribers can resume after
+// reconnecting. Seq is assigned by the store and increases with every entry.
+type EventLogEntry struct {
+	Seq       int64
+	Type      string
+	Data      string // JSON encoded event data
+	CreatedAt time.Time
+}
+
 // Helper functions for converting between store types and Claude types
 
 // NewSessionFromConfig creates a Session from Claude SessionConfig
@@ -318,3 +795,171 @@ func NewSessionFromConfig(id, runID string, config claudecode.SessionConfig) *Se
 
 	return session
 }
+
+// Session kinds used by the session list's filter
+const (
+	SessionKindNormal   = "normal"   // Not archived, draft or discarded
+	SessionKindArchived = "archived" // Archived sessions
+	SessionKindDraft    = "draft"    // Drafts that are not archived
+)
+
+// SessionFilter restricts which sessions QuerySessions returns. Zero values
+// do not filter. It is stored as JSON in saved filters.
+type SessionFilter struct {
+	Kind          string     `json:"kind,omitempty"` // One of the SessionKind constants
+	Statuses      []string   `json:"statuses,omitempty"`
+	Labels        []string   `json:"labels,omitempty"` // Label names, sessions must have all of them
+	ProjectID     string     `json:"project_id,omitempty"`
+	Models        []string   `json:"models,omitempty"` // Matches the model alias or the full model ID
+	CreatedAfter  *time.Time `json:"created_after,omitempty"`
+	CreatedBefore *time.Time `json:"created_before,omitempty"`
+	MinCostUSD    *float64   `json:"min_cost_usd,omitempty"`
+	MaxCostUSD    *float64   `json:"max_cost_usd,omitempty"`
+
+	// LeavesOnly excludes sessions that have been continued. It and the
+	// pagination fields are view options rather than part of a saved filter.
+	LeavesOnly bool           `json:"-"`
+	After      *SessionCursor `json:"-"` // Only sessions listed after this position
+	Limit      int            `json:"-"` // Maximum number of sessions, 0 for no limit
+}
+
+// Validate rejects filters that can never match
+func (f SessionFilter) Validate() error {
+	switch f.Kind {
+	case "", SessionKindNormal, SessionKindArchived, SessionKindDraft:
+	default:
+		return fmt.Errorf("unknown session kind %q", f.Kind)
+	}
+	if f.MinCostUSD != nil && f.MaxCostUSD != nil && *f.MinCostUSD > *f.MaxCostUSD {
+		return fmt.Errorf("min_cost_usd is greater than max_cost_usd")
+	}
+	if f.CreatedAfter != nil && f.CreatedBefore != nil && !f.CreatedAfter.Before(*f.CreatedBefore) {
+		return fmt.Errorf("created_after must be before created_before")
+	}
+	return nil
+}
+
+// Merge returns the filter with every field set in override replacing its own
+func (f SessionFilter) Merge(override SessionFilter) SessionFilter {
+	if override.Kind != "" {
+		f.Kind = override.Kind
+	}
+	if len(override.Statuses) > 0 {
+		f.Statuses = override.Statuses
+	}
+	if len(override.Labels) > 0 {
+		f.Labels = override.Labels
+	}
+	if override.ProjectID != "" {
+		f.ProjectID = override.ProjectID
+	}
+	if len(override.Models) > 0 {
+		f.Models = override.Models
+	}
+	if override.CreatedAfter != nil {
+		f.CreatedAfter = override.CreatedAfter
+	}
+	if override.CreatedBefore != nil {
+		f.CreatedBefore = override.CreatedBefore
+	}
+	if override.MinCostUSD != nil {
+		f.MinCostUSD = override.MinCostUSD
+	}
+	if override.MaxCostUSD != nil {
+		f.MaxCostUSD = override.MaxCostUSD
+	}
+	f.LeavesOnly = override.LeavesOnly
+	f.After = override.After
+	f.Limit = override.Limit
+	return f
+}
+
+// SessionCounts are the number of sessions of each kind matching a filter
+type SessionCounts struct {
+	Normal   int
+	Archived int
+	Draft    int
+}
+
+// Label is a user-defined tag that can be attached to any number of sessions
+type Label struct {
+	ID           string
+	Name         string // Unique, compared case-insensitively
+	Color        string
+	CreatedAt    time.Time
+	SessionCount int // Populated by ListLabels
+}
+
+// Project groups the sessions working in one repository, identified by the
+// repository's root directory
+type Project struct {
+	ID             string
+	RootPath       string
+	Name           string
+	CreatedAt      time.Time
+	SessionCount   int        // Populated by ListProjects
+	LastActivityAt *time.Time // Populated by ListProjects
+}
+
+// SavedFilter is a named session filter stored on the server
+type SavedFilter struct {
+	ID        string
+	Name      string
+	Filter    SessionFilter
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ConversationSearch describes a search over conversation content: message
+// text, tool inputs and tool results
+type ConversationSearch struct {
+	Query      string     // Plain text, every word must match
+	Roles      []string   // Only events with one of these roles
+	EventTypes []string   // Only events of one of these types
+	ToolNames  []string   // Only tool calls, and their results, for one of these tools
+	SessionID  string     // Only events of this session
+	ProjectID  string     // Only events of sessions in this project
+	After      *time.Time // Only events at or after this time
+	Before     *time.Time // Only events before this time
+
+	Limit             int // Maximum sessions to return
+	MatchesPerSession int // Maximum matching events returned per session
+}
+
+// Validate rejects searches that can never match
+func (q ConversationSearch) Validate() error {
+	if len(searchTerms(q.Query)) == 0 {
+		return fmt.Errorf("search query is required")
+	}
+	if q.After != nil && q.Before != nil && !q.After.Before(*q.Before) {
+		return fmt.Errorf("after must be earlier than before")
+	}
+	return nil
+}
+
+// ConversationSearchResult is a session with the events that matched a
+// search, most recent match first
+type ConversationSearchResult struct {
+	Session *Session
+	Matches []ConversationMatch
+}
+
+// ConversationMatch is a conversation event that matched a search. Sequence
+// and ClaudeSessionID anchor the match within the conversation.
+type ConversationMatch struct {
+	EventID         int64
+	ClaudeSessionID string
+	Sequence        int
+	EventType       string
+	Role            string
+	ToolName        string // For tool results, the name of the tool that produced them
+	CreatedAt       time.Time
+	Snippet         string      // Excerpt of the matching text
+	Highlights      []TextRange // Matched words within Snippet
+}
+
+// TextRange is a half-open range of character (rune) offsets into a string
+type TextRange struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
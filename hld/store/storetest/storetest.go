@@ -0,0 +1,799 @@
+// Package storetest is the conformance suite every store.ConversationStore
+// implementation must pass, so the daemon behaves the same whichever store it
+// runs on.
+package storetest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// NewStore returns an empty, migrated store that is closed when the test ends
+type NewStore func(t *testing.T) store.ConversationStore
+
+// Run checks the behaviour callers rely on from every ConversationStore
+func Run(t *testing.T, newStore NewStore) {
+	t.Run("Sessions", func(t *testing.T) { testSessions(t, newStore(t)) })
+	t.Run("ConversationSequences", func(t *testing.T) { testConversationSequences(t, newStore(t)) })
+	t.Run("ForeignKeys", func(t *testing.T) { testForeignKeys(t, newStore(t)) })
+	t.Run("PendingToolCalls", func(t *testing.T) { testPendingToolCalls(t, newStore(t)) })
+	t.Run("ApprovalCorrelation", func(t *testing.T) { testApprovalCorrelation(t, newStore(t)) })
+	t.Run("ApprovalResponses", func(t *testing.T) { testApprovalResponses(t, newStore(t)) })
+	t.Run("Labels", func(t *testing.T) { testLabels(t, newStore(t)) })
+	t.Run("UserSettings", func(t *testing.T) { testUserSettings(t, newStore(t)) })
+	t.Run("ProjectConfigTrust", func(t *testing.T) { testProjectConfigTrust(t, newStore(t)) })
+	t.Run("Templates", func(t *testing.T) { testTemplates(t, newStore(t)) })
+	t.Run("Search", func(t *testing.T) { testSearch(t, newStore(t)) })
+	t.Run("Maintenance", func(t *testing.T) { testMaintenance(t, newStore(t)) })
+	t.Run("EventLog", func(t *testing.T) { testEventLog(t, newStore(t)) })
+	t.Run("Webhooks", func(t *testing.T) { testWebhooks(t, newStore(t)) })
+	t.Run("SessionRecords", func(t *testing.T) { testSessionRecords(t, newStore(t), newStore(t)) })
+}
+
+// RunConcurrent checks that concurrent writers, as several daemons sharing a
+// database would be, cannot corrupt sequences or approval correlation
+func RunConcurrent(t *testing.T, newStore NewStore) {
+	t.Run("Sequences", func(t *testing.T) { testConcurrentSequences(t, newStore(t)) })
+	t.Run("Correlation", func(t *testing.T) { testConcurrentCorrelation(t, newStore(t)) })
+}
+
+func testSessions(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	maxTurns := 5
+	require.NoError(t, s.CreateSession(ctx, &store.Session{
+		ID: "sess-1", RunID: "run-1", Query: "fix the build", Model: "sonnet",
+		WorkingDir: "/src/app", MaxTurns: maxTurns, Status: store.SessionStatusRunning,
+		CreatedAt: now, LastActivityAt: now, AutoAcceptEdits: true,
+	}))
+
+	got, err := s.GetSession(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Equal(t, "run-1", got.RunID)
+	assert.Equal(t, "fix the build", got.Query)
+	assert.Equal(t, maxTurns, got.MaxTurns)
+	assert.True(t, got.AutoAcceptEdits)
+	assert.False(t, got.Archived)
+	assert.WithinDuration(t, now, got.CreatedAt, time.Second)
+
+	byRun, err := s.GetSessionByRunID(ctx, "run-1")
+	require.NoError(t, err)
+	require.NotNil(t, byRun)
+	assert.Equal(t, "sess-1", byRun.ID)
+
+	_, err = s.GetSession(ctx, "missing")
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+	byRun, err = s.GetSessionByRunID(ctx, "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, byRun)
+
+	// Run IDs are unique
+	assert.Error(t, s.CreateSession(ctx, &store.Session{
+		ID: "sess-2", RunID: "run-1", Status: store.SessionStatusRunning, CreatedAt: now, LastActivityAt: now,
+	}))
+
+	// Callers cannot change stored sessions through returned values
+	got.Title = "changed"
+	got, err = s.GetSession(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Empty(t, got.Title)
+
+	title := "Fix the build"
+	status := store.SessionStatusCompleted
+	cost := 0.25
+	require.NoError(t, s.UpdateSession(ctx, "sess-1", store.SessionUpdate{Title: &title, Status: &status, CostUSD: &cost}))
+	got, err = s.GetSession(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Equal(t, title, got.Title)
+	assert.Equal(t, store.SessionStatusCompleted, got.Status)
+	require.NotNil(t, got.CostUSD)
+	assert.InDelta(t, cost, *got.CostUSD, 1e-9)
+	assert.Error(t, s.UpdateSession(ctx, "missing", store.SessionUpdate{Title: &title}))
+
+	running, err := s.GetSessionsByStatus(ctx, []string{store.SessionStatusRunning})
+	require.NoError(t, err)
+	assert.NotNil(t, running)
+	assert.Empty(t, running)
+	completed, err := s.GetSessionsByStatus(ctx, []string{store.SessionStatusCompleted})
+	require.NoError(t, err)
+	assert.Len(t, completed, 1)
+
+	require.NoError(t, s.HardDeleteSession(ctx, "sess-1"))
+	_, err = s.GetSession(ctx, "sess-1")
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+}
+
+func testConversationSequences(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, s, "sess-1", "claude-1")
+
+	for i := 1; i <= 3; i++ {
+		event := &store.ConversationEvent{
+			SessionID: "sess-1", ClaudeSessionID: "claude-1",
+			EventType: store.EventTypeMessage, Role: "assistant", Content: "message",
+		}
+		require.NoError(t, s.AddConversationEvent(ctx, event))
+		assert.Equal(t, i, event.Sequence)
+		assert.NotZero(t, event.ID)
+	}
+
+	// Sequences are per Claude session, so a resumed session starts over
+	other := &store.ConversationEvent{
+		SessionID: "sess-1", ClaudeSessionID: "claude-2",
+		EventType: store.EventTypeMessage, Role: "user", Content: "continue",
+	}
+	require.NoError(t, s.AddConversationEvent(ctx, other))
+	assert.Equal(t, 1, other.Sequence)
+
+	events, err := s.GetConversation(ctx, "claude-1")
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	for i, event := range events {
+		assert.Equal(t, i+1, event.Sequence)
+	}
+}
+
+func testForeignKeys(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+
+	// Rows owned by a session cannot exist without it
+	assert.Error(t, s.AddConversationEvent(ctx, &store.ConversationEvent{
+		SessionID: "missing", ClaudeSessionID: "claude-1", EventType: store.EventTypeMessage,
+	}))
+	assert.Error(t, s.CreateApproval(ctx, &store.Approval{
+		ID: "approval-1", RunID: "run-missing", SessionID: "missing",
+		Status: store.ApprovalStatusLocalPending, CreatedAt: time.Now(), ToolName: "Bash", ToolInput: json.RawMessage(`{}`),
+	}))
+
+	// and keep it from being deleted
+	createSession(t, s, "sess-1", "claude-1")
+	addToolCall(t, s, "sess-1", "claude-1", "tool-1", "Bash")
+	assert.Error(t, s.HardDeleteSession(ctx, "sess-1"))
+	_, err := s.GetSession(ctx, "sess-1")
+	assert.NoError(t, err)
+}
+
+func testPendingToolCalls(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, s, "sess-1", "claude-1")
+
+	pending, err := s.GetPendingToolCall(ctx, "sess-1", "Bash")
+	require.NoError(t, err)
+	assert.Nil(t, pending)
+
+	addToolCall(t, s, "sess-1", "claude-1", "tool-1", "Bash")
+	addToolCall(t, s, "sess-1", "claude-1", "tool-2", "Bash")
+	addToolCall(t, s, "sess-1", "claude-1", "tool-3", "Edit")
+
+	// The most recent uncompleted call of the tool is the pending one
+	pending, err = s.GetPendingToolCall(ctx, "sess-1", "Bash")
+	require.NoError(t, err)
+	require.NotNil(t, pending)
+	assert.Equal(t, "tool-2", pending.ToolID)
+
+	require.NoError(t, s.MarkToolCallCompleted(ctx, "tool-2", "sess-1"))
+	pending, err = s.GetPendingToolCall(ctx, "sess-1", "Bash")
+	require.NoError(t, err)
+	require.NotNil(t, pending)
+	assert.Equal(t, "tool-1", pending.ToolID)
+
+	calls, err := s.GetPendingToolCalls(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Len(t, calls, 2)
+
+	call, err := s.GetToolCallByID(ctx, "tool-2")
+	require.NoError(t, err)
+	require.NotNil(t, call)
+	assert.True(t, call.IsCompleted)
+	call, err = s.GetToolCallByID(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, call)
+}
+
+func testApprovalCorrelation(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, s, "sess-1", "claude-1")
+	addToolCall(t, s, "sess-1", "claude-1", "tool-1", "Bash")
+	addToolCall(t, s, "sess-1", "claude-1", "tool-2", "Bash")
+
+	// An approval for a tool with no pending call is not an error
+	require.NoError(t, s.CorrelateApproval(ctx, "sess-1", "Write", "approval-0"))
+
+	require.NoError(t, s.CorrelateApproval(ctx, "sess-1", "Bash", "approval-1"))
+	call, err := s.GetToolCallByID(ctx, "tool-2")
+	require.NoError(t, err)
+	assert.Equal(t, "pending", call.ApprovalStatus)
+	assert.Equal(t, "approval-1", call.ApprovalID)
+
+	// A call keeps the first approval correlated with it
+	require.NoError(t, s.CorrelateApproval(ctx, "sess-1", "Bash", "approval-2"))
+	call, err = s.GetToolCallByID(ctx, "tool-2")
+	require.NoError(t, err)
+	assert.Equal(t, "approval-1", call.ApprovalID)
+
+	uncorrelated, err := s.GetUncorrelatedPendingToolCall(ctx, "sess-1", "Bash")
+	require.NoError(t, err)
+	require.NotNil(t, uncorrelated)
+	assert.Equal(t, "tool-1", uncorrelated.ToolID)
+
+	require.NoError(t, s.LinkConversationEventToApprovalUsingToolID(ctx, "sess-1", "tool-1", "approval-3"))
+	uncorrelated, err = s.GetUncorrelatedPendingToolCall(ctx, "sess-1", "Bash")
+	require.NoError(t, err)
+	assert.Nil(t, uncorrelated)
+
+	require.NoError(t, s.UpdateApprovalStatus(ctx, "approval-1", "approved"))
+	call, err = s.GetToolCallByID(ctx, "tool-2")
+	require.NoError(t, err)
+	assert.Equal(t, "approved", call.ApprovalStatus)
+}
+
+func testApprovalResponses(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, s, "sess-1", "claude-1")
+
+	require.NoError(t, s.CreateApproval(ctx, &store.Approval{
+		ID: "approval-1", RunID: "run-sess-1", SessionID: "sess-1",
+		Status: store.ApprovalStatusLocalPending, CreatedAt: time.Now(),
+		ToolName: "Bash", ToolInput: json.RawMessage(`{"command":"ls"}`),
+	}))
+
+	approvals, err := s.GetPendingApprovals(ctx, "sess-1")
+	require.NoError(t, err)
+	require.Len(t, approvals, 1)
+	assert.JSONEq(t, `{"command":"ls"}`, string(approvals[0].ToolInput))
+
+	require.NoError(t, s.UpdateApprovalResponse(ctx, "approval-1", store.ApprovalStatusLocalDenied, "not now"))
+	approval, err := s.GetApproval(ctx, "approval-1")
+	require.NoError(t, err)
+	assert.Equal(t, store.ApprovalStatusLocalDenied, approval.Status)
+	assert.Equal(t, "not now", approval.Comment)
+	assert.NotNil(t, approval.RespondedAt)
+
+	err = s.UpdateApprovalResponse(ctx, "approval-1", store.ApprovalStatusLocalApproved, "")
+	assert.True(t, errors.Is(err, store.ErrAlreadyDecided), "got %v", err)
+	err = s.UpdateApprovalResponse(ctx, "missing", store.ApprovalStatusLocalApproved, "")
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+
+	approvals, err = s.GetPendingApprovals(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Empty(t, approvals)
+}
+
+func testLabels(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, s, "sess-1", "claude-1")
+
+	require.NoError(t, s.CreateLabel(ctx, &store.Label{ID: "label-1", Name: "Bug", Color: "red"}))
+	err := s.CreateLabel(ctx, &store.Label{ID: "label-2", Name: "bug"})
+	assert.True(t, errors.Is(err, store.ErrAlreadyExists), "got %v", err)
+
+	// Names match existing labels regardless of case
+	require.NoError(t, s.SetSessionLabels(ctx, "sess-1", []string{"BUG", "feature"}))
+	names, err := s.GetSessionLabels(ctx, "sess-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bug", "feature"}, names)
+
+	labels, err := s.ListLabels(ctx)
+	require.NoError(t, err)
+	require.Len(t, labels, 2)
+	assert.Equal(t, "Bug", labels[0].Name)
+	assert.Equal(t, 1, labels[0].SessionCount)
+
+	sessions, err := s.QuerySessions(ctx, store.SessionFilter{Labels: []string{"bug"}})
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "sess-1", sessions[0].ID)
+}
+
+func testUserSettings(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+
+	settings, err := s.GetUserSettings(ctx)
+	require.NoError(t, err)
+	assert.False(t, settings.AdvancedProviders)
+	assert.Nil(t, settings.OptInTelemetry)
+	assert.Equal(t, store.TitleModeHeuristic, settings.AutoTitleMode)
+	assert.Equal(t, store.DefaultRawEventRetentionDays, settings.RawEventRetentionDays)
+	assert.True(t, settings.DedupeFileSnapshots)
+
+	optIn := true
+	settings.AdvancedProviders = true
+	settings.OptInTelemetry = &optIn
+	settings.ArchivedSessionRetentionDays = 90
+	require.NoError(t, s.UpdateUserSettings(ctx, *settings))
+
+	settings, err = s.GetUserSettings(ctx)
+	require.NoError(t, err)
+	assert.True(t, settings.AdvancedProviders)
+	require.NotNil(t, settings.OptInTelemetry)
+	assert.True(t, *settings.OptInTelemetry)
+	assert.Equal(t, 90, settings.ArchivedSessionRetentionDays)
+}
+
+func testProjectConfigTrust(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+
+	digest, err := s.GetProjectConfigTrust(ctx, "/src/app")
+	require.NoError(t, err)
+	assert.Empty(t, digest)
+
+	require.NoError(t, s.SetProjectConfigTrust(ctx, "/src/app", "digest-1"))
+	require.NoError(t, s.SetProjectConfigTrust(ctx, "/src/app", "digest-2"))
+	digest, err = s.GetProjectConfigTrust(ctx, "/src/app")
+	require.NoError(t, err)
+	assert.Equal(t, "digest-2", digest)
+
+	require.NoError(t, s.DeleteProjectConfigTrust(ctx, "/src/app"))
+	digest, err = s.GetProjectConfigTrust(ctx, "/src/app")
+	require.NoError(t, err)
+	assert.Empty(t, digest)
+}
+
+func testEventLog(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+
+	entries, err := s.GetRecentEventLog(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	var seqs []int64
+	for i := range 5 {
+		entry := &store.EventLogEntry{Type: "session_status_changed", Data: fmt.Sprintf(`{"i":%d}`, i)}
+		require.NoError(t, s.AppendEventLog(ctx, entry))
+		if len(seqs) > 0 {
+			assert.Greater(t, entry.Seq, seqs[len(seqs)-1])
+		}
+		seqs = append(seqs, entry.Seq)
+	}
+	entries, err = s.GetRecentEventLog(ctx, 0)
+	require.NoError(t, err)
+	assert.Len(t, entries, 5)
+
+	// Trimming keeps the newest three entries
+	deleted, err := s.TrimEventLog(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+	deleted, err = s.TrimEventLog(ctx, 3)
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+	entries, err = s.GetRecentEventLog(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		assert.Equal(t, seqs[i+2], entry.Seq)
+		assert.Equal(t, "session_status_changed", entry.Type)
+		assert.JSONEq(t, fmt.Sprintf(`{"i":%d}`, i+2), entry.Data)
+		assert.False(t, entry.CreatedAt.IsZero())
+	}
+
+	entries, err = s.GetRecentEventLog(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, seqs[3], entries[0].Seq)
+	assert.Equal(t, seqs[4], entries[1].Seq)
+}
+
+func testWebhooks(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+
+	require.NoError(t, s.CreateWebhook(ctx, &store.Webhook{
+		ID: "hook-1", Name: "chat", URL: "http://localhost/chat", Secret: "s3cret",
+		EventTypes: []string{"new_approval"}, Labels: []string{"prod"}, WorkingDir: "/repo", Enabled: true,
+	}))
+	require.NoError(t, s.CreateWebhook(ctx, &store.Webhook{ID: "hook-2", Name: "ci", URL: "http://localhost/ci", Secret: "other"}))
+
+	webhook, err := s.GetWebhook(ctx, "hook-1")
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", webhook.Secret)
+	assert.Equal(t, []string{"new_approval"}, webhook.EventTypes)
+	assert.Equal(t, []string{"prod"}, webhook.Labels)
+	assert.Equal(t, "/repo", webhook.WorkingDir)
+	assert.True(t, webhook.Enabled)
+	_, err = s.GetWebhook(ctx, "missing")
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+
+	webhooks, err := s.ListWebhooks(ctx)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2)
+	assert.Equal(t, "chat", webhooks[0].Name)
+	assert.Equal(t, "ci", webhooks[1].Name)
+	assert.Empty(t, webhooks[1].EventTypes)
+
+	eventTypes := []string{}
+	enabled := false
+	require.NoError(t, s.UpdateWebhook(ctx, "hook-1", store.WebhookUpdate{EventTypes: &eventTypes, Enabled: &enabled}))
+	webhook, err = s.GetWebhook(ctx, "hook-1")
+	require.NoError(t, err)
+	assert.Empty(t, webhook.EventTypes)
+	assert.Equal(t, []string{"prod"}, webhook.Labels)
+	assert.False(t, webhook.Enabled)
+	err = s.UpdateWebhook(ctx, "missing", store.WebhookUpdate{Enabled: &enabled})
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+
+	// Deliveries are listed newest first, and creating one trims the finished
+	// deliveries of its webhook while keeping pending ones
+	now := time.Now().UTC().Truncate(time.Second)
+	pending := &store.WebhookDelivery{
+		WebhookID: "hook-1", EventType: "new_approval", EventSeq: 7, Payload: `{"n":0}`,
+		Status: store.WebhookDeliveryStatusPending, NextAttemptAt: &now,
+	}
+	require.NoError(t, s.CreateWebhookDelivery(ctx, pending, 2))
+	var ids []int64
+	for i := 1; i <= 3; i++ {
+		delivery := &store.WebhookDelivery{
+			WebhookID: "hook-1", EventType: "session_completed", Payload: fmt.Sprintf(`{"n":%d}`, i),
+			Status: store.WebhookDeliveryStatusDelivered, Attempts: 1, ResponseStatus: 200, DeliveredAt: &now,
+		}
+		require.NoError(t, s.CreateWebhookDelivery(ctx, delivery, 2))
+		ids = append(ids, delivery.ID)
+	}
+	require.NoError(t, s.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		WebhookID: "hook-2", EventType: "new_approval", Payload: `{}`, Status: store.WebhookDeliveryStatusFailed,
+	}, 2))
+	err = s.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		WebhookID: "missing", EventType: "new_approval", Payload: `{}`, Status: store.WebhookDeliveryStatusPending,
+	}, 2)
+	assert.Error(t, err)
+
+	deliveries, err := s.ListWebhookDeliveries(ctx, "hook-1", 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+	assert.Equal(t, ids[2], deliveries[0].ID)
+	assert.Equal(t, ids[1], deliveries[1].ID)
+	assert.Equal(t, pending.ID, deliveries[2].ID)
+	assert.Equal(t, 200, deliveries[0].ResponseStatus)
+	require.NotNil(t, deliveries[0].DeliveredAt)
+	assert.Equal(t, int64(7), deliveries[2].EventSeq)
+	assert.JSONEq(t, `{"n":0}`, deliveries[2].Payload)
+
+	deliveries, err = s.ListWebhookDeliveries(ctx, "hook-1", 1)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, ids[2], deliveries[0].ID)
+
+	// Only pending deliveries that are due are returned for sending
+	due, err := s.GetDueWebhookDeliveries(ctx, now.Add(-time.Minute), 0)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+	due, err = s.GetDueWebhookDeliveries(ctx, now, 0)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, pending.ID, due[0].ID)
+
+	later := now.Add(time.Minute)
+	attempts := 1
+	status := 503
+	message := "503 Service Unavailable"
+	require.NoError(t, s.UpdateWebhookDelivery(ctx, pending.ID, store.WebhookDeliveryUpdate{
+		Attempts: &attempts, NextAttemptAt: &later, ResponseStatus: &status, Error: &message,
+	}))
+	due, err = s.GetDueWebhookDeliveries(ctx, now, 0)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+	due, err = s.GetDueWebhookDeliveries(ctx, later, 0)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, 1, due[0].Attempts)
+	assert.Equal(t, 503, due[0].ResponseStatus)
+	assert.Equal(t, message, due[0].Error)
+	err = s.UpdateWebhookDelivery(ctx, -1, store.WebhookDeliveryUpdate{Attempts: &attempts})
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+
+	// Deleting a webhook deletes its delivery log
+	require.NoError(t, s.DeleteWebhook(ctx, "hook-1"))
+	deliveries, err = s.ListWebhookDeliveries(ctx, "hook-1", 0)
+	require.NoError(t, err)
+	assert.Empty(t, deliveries)
+	err = s.DeleteWebhook(ctx, "hook-1")
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+}
+
+func testTemplates(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+
+	require.NoError(t, s.CreateSessionTemplate(ctx, &store.SessionTemplate{ID: "tmpl-1", Name: "review", Config: `{}`}))
+	require.NoError(t, s.CreateSessionTemplate(ctx, &store.SessionTemplate{ID: "tmpl-2", Name: "deploy", Config: `{}`}))
+	err := s.CreateSessionTemplate(ctx, &store.SessionTemplate{ID: "tmpl-3", Name: "review", Config: `{}`})
+	assert.True(t, errors.Is(err, store.ErrAlreadyExists), "got %v", err)
+
+	template, err := s.GetSessionTemplateByName(ctx, "review")
+	require.NoError(t, err)
+	assert.Equal(t, "tmpl-1", template.ID)
+	_, err = s.GetSessionTemplateByName(ctx, "missing")
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+
+	templates, err := s.ListSessionTemplates(ctx)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "deploy", templates[0].Name)
+
+	name := "deploy"
+	err = s.UpdateSessionTemplate(ctx, "tmpl-1", store.SessionTemplateUpdate{Name: &name})
+	assert.True(t, errors.Is(err, store.ErrAlreadyExists), "got %v", err)
+	err = s.UpdateSessionTemplate(ctx, "missing", store.SessionTemplateUpdate{Name: &name})
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+
+	require.NoError(t, s.DeleteSessionTemplate(ctx, "tmpl-2"))
+	err = s.DeleteSessionTemplate(ctx, "tmpl-2")
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+}
+
+func testSearch(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, s, "sess-1", "claude-1")
+	createSession(t, s, "sess-2", "claude-2")
+	addMessage(t, s, "sess-1", "claude-1", "user", "deploy the payments service")
+	addMessage(t, s, "sess-1", "claude-1", "assistant", "the payments service is deployed")
+	addMessage(t, s, "sess-2", "claude-2", "user", "refund the payments of yesterday")
+
+	_, err := s.SearchConversations(ctx, store.ConversationSearch{Query: "  "})
+	assert.Error(t, err)
+
+	// Sessions are ordered by their most recent match, and matches within a
+	// session are newest first
+	results, err := s.SearchConversations(ctx, store.ConversationSearch{Query: "payments"})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "sess-2", results[0].Session.ID)
+	assert.Equal(t, "sess-1", results[1].Session.ID)
+	require.Len(t, results[1].Matches, 2)
+	assert.Equal(t, "assistant", results[1].Matches[0].Role)
+	assert.Greater(t, results[1].Matches[0].EventID, results[1].Matches[1].EventID)
+	assert.NotEmpty(t, results[1].Matches[0].Snippet)
+
+	// Every word must match
+	results, err = s.SearchConversations(ctx, store.ConversationSearch{Query: "payments refund"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "sess-2", results[0].Session.ID)
+
+	results, err = s.SearchConversations(ctx, store.ConversationSearch{
+		Query: "payments", Roles: []string{"user"}, MatchesPerSession: 1, Limit: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Len(t, results[0].Matches, 1)
+
+	results, err = s.SearchConversations(ctx, store.ConversationSearch{Query: "payments", SessionID: "sess-1"})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "sess-1", results[0].Session.ID)
+
+	results, err = s.SearchConversations(ctx, store.ConversationSearch{Query: "invoices"})
+	require.NoError(t, err)
+	assert.NotNil(t, results)
+	assert.Empty(t, results)
+}
+
+func testMaintenance(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, s, "sess-1", "claude-1")
+	require.NoError(t, s.StoreRawEvent(ctx, "sess-1", `{"type":"system"}`))
+
+	_, err := s.RunMaintenance(ctx, store.MaintenanceOptions{Vacuum: "sometimes"})
+	assert.Error(t, err)
+
+	later := time.Now().Add(48 * time.Hour)
+	opts := store.MaintenanceOptions{
+		Retention: store.RetentionPolicy{RawEventTTL: 24 * time.Hour},
+		DryRun:    true,
+		Now:       later,
+	}
+	report, err := s.RunMaintenance(ctx, opts)
+	require.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, int64(1), report.RawEvents.Rows)
+	assert.Equal(t, int64(len(`{"type":"system"}`)), report.RawEvents.Bytes)
+
+	// A dry run changes nothing
+	opts.DryRun = false
+	report, err = s.RunMaintenance(ctx, opts)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), report.RawEvents.Rows)
+
+	report, err = s.RunMaintenance(ctx, opts)
+	require.NoError(t, err)
+	assert.Zero(t, report.RawEvents.Rows)
+
+	// Raw events within the TTL are kept
+	require.NoError(t, s.StoreRawEvent(ctx, "sess-1", `{"type":"system"}`))
+	report, err = s.RunMaintenance(ctx, store.MaintenanceOptions{
+		Retention: store.RetentionPolicy{RawEventTTL: 24 * time.Hour},
+	})
+	require.NoError(t, err)
+	assert.Zero(t, report.RawEvents.Rows)
+
+	// Archived sessions are pruned unless a batch still points at them
+	archived := true
+	createSession(t, s, "sess-2", "claude-2")
+	require.NoError(t, s.UpdateSession(ctx, "sess-1", store.SessionUpdate{Archived: &archived}))
+	require.NoError(t, s.UpdateSession(ctx, "sess-2", store.SessionUpdate{Archived: &archived}))
+	require.NoError(t, s.CreateBatchGroup(ctx, &store.BatchGroup{
+		ID: "batch-1", Name: "compare", Query: "fix the build", Matrix: "{}", CreatedAt: time.Now(),
+	}))
+	require.NoError(t, s.AddBatchMember(ctx, &store.BatchMember{
+		GroupID: "batch-1", MemberIndex: 0, SessionID: "sess-2", Label: "sonnet",
+	}))
+	report, err = s.RunMaintenance(ctx, store.MaintenanceOptions{
+		Retention: store.RetentionPolicy{ArchivedSessionTTL: 24 * time.Hour},
+		Now:       later,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), report.ArchivedSessions.Rows)
+	_, err = s.GetSession(ctx, "sess-1")
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+	_, err = s.GetSession(ctx, "sess-2")
+	require.NoError(t, err)
+	members, err := s.GetBatchMembers(ctx, "batch-1")
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	assert.Equal(t, "sess-2", members[0].SessionID)
+}
+
+func testSessionRecords(t *testing.T, source, target store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, source, "sess-1", "claude-1")
+	require.NoError(t, source.SetSessionLabels(ctx, "sess-1", []string{"release"}))
+	addToolCall(t, source, "sess-1", "claude-1", "tool-1", "Bash")
+	addMessage(t, source, "sess-1", "claude-1", "assistant", "done")
+	require.NoError(t, source.CreateApproval(ctx, &store.Approval{
+		ID: "approval-1", RunID: "run-sess-1", SessionID: "sess-1",
+		Status: store.ApprovalStatusLocalPending, CreatedAt: time.Now(),
+		ToolName: "Bash", ToolInput: json.RawMessage(`{"command":"ls"}`),
+	}))
+	require.NoError(t, source.CreateFileSnapshot(ctx, &store.FileSnapshot{
+		ToolID: "tool-1", SessionID: "sess-1", FilePath: "main.go", Content: "package main",
+	}))
+	require.NoError(t, source.StoreMCPServers(ctx, "sess-1", []store.MCPServer{{Name: "files", Command: "mcp-files"}}))
+	require.NoError(t, source.StoreRawEvent(ctx, "sess-1", `{"type":"system"}`))
+
+	records, err := source.GetSessionRecords(ctx, "sess-1", false)
+	require.NoError(t, err)
+	assert.Nil(t, records.RawEvents)
+	records, err = source.GetSessionRecords(ctx, "sess-1", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"release"}, records.Labels)
+	assert.Len(t, records.Events, 2)
+	assert.Len(t, records.Approvals, 1)
+	assert.Len(t, records.FileSnapshots, 1)
+	assert.Len(t, records.MCPServers, 1)
+	assert.Len(t, records.RawEvents, 1)
+
+	_, err = source.GetSessionRecords(ctx, "missing", false)
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+
+	require.NoError(t, target.ImportSessionRecords(ctx, []*store.SessionRecords{records}))
+	imported, err := target.GetSessionRecords(ctx, "sess-1", true)
+	require.NoError(t, err)
+	assert.Equal(t, records.Session.RunID, imported.Session.RunID)
+	assert.Equal(t, records.Labels, imported.Labels)
+	require.Len(t, imported.Events, 2)
+	for i, event := range imported.Events {
+		assert.Equal(t, records.Events[i].Sequence, event.Sequence)
+		assert.Equal(t, records.Events[i].ToolID, event.ToolID)
+		assert.Equal(t, records.Events[i].Content, event.Content)
+	}
+	require.Len(t, imported.Approvals, 1)
+	assert.Equal(t, "approval-1", imported.Approvals[0].ID)
+	assert.Len(t, imported.FileSnapshots, 1)
+	assert.Len(t, imported.MCPServers, 1)
+	assert.Len(t, imported.RawEvents, 1)
+
+	// Imports are all or nothing
+	now := time.Now().UTC()
+	fresh := &store.SessionRecords{Session: &store.Session{
+		ID: "sess-2", RunID: "run-sess-2", Status: store.SessionStatusCompleted, CreatedAt: now, LastActivityAt: now,
+	}}
+	assert.Error(t, target.ImportSessionRecords(ctx, []*store.SessionRecords{fresh, records}))
+	_, err = target.GetSession(ctx, "sess-2")
+	assert.True(t, errors.Is(err, store.ErrNotFound), "got %v", err)
+}
+
+func testConcurrentSequences(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, s, "sess-1", "claude-1")
+
+	const writers = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, writers)
+	sequences := make(chan int, writers)
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			event := &store.ConversationEvent{
+				SessionID: "sess-1", ClaudeSessionID: "claude-1",
+				EventType: store.EventTypeMessage, Role: "assistant", Content: "message",
+			}
+			if err := s.AddConversationEvent(ctx, event); err != nil {
+				errs <- err
+				return
+			}
+			sequences <- event.Sequence
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	close(sequences)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	seen := make(map[int]bool)
+	for seq := range sequences {
+		assert.False(t, seen[seq], "sequence %d assigned twice", seq)
+		seen[seq] = true
+	}
+	for seq := 1; seq <= writers; seq++ {
+		assert.True(t, seen[seq], "sequence %d missing", seq)
+	}
+}
+
+func testConcurrentCorrelation(t *testing.T, s store.ConversationStore) {
+	ctx := context.Background()
+	createSession(t, s, "sess-1", "claude-1")
+	addToolCall(t, s, "sess-1", "claude-1", "tool-1", "Bash")
+
+	// Several daemons racing to correlate approvals with one tool call must
+	// leave it with exactly one of them, never overwritten by a later one
+	const approvals = 10
+	var wg sync.WaitGroup
+	for i := 0; i < approvals; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, s.CorrelateApproval(ctx, "sess-1", "Bash", fmt.Sprintf("approval-%d", i)))
+		}(i)
+	}
+	wg.Wait()
+
+	call, err := s.GetToolCallByID(ctx, "tool-1")
+	require.NoError(t, err)
+	assert.Equal(t, "pending", call.ApprovalStatus)
+	assert.Regexp(t, `^approval-\d+$`, call.ApprovalID)
+
+	events, err := s.GetSessionConversation(ctx, "sess-1")
+	require.NoError(t, err)
+	linked := 0
+	for _, event := range events {
+		if event.ApprovalID != "" {
+			linked++
+		}
+	}
+	assert.Equal(t, 1, linked)
+}
+
+func createSession(t *testing.T, s store.ConversationStore, sessionID, claudeSessionID string) {
+	t.Helper()
+	now := time.Now().UTC()
+	require.NoError(t, s.CreateSession(context.Background(), &store.Session{
+		ID: sessionID, RunID: "run-" + sessionID, ClaudeSessionID: claudeSessionID,
+		Status: store.SessionStatusRunning, CreatedAt: now, LastActivityAt: now,
+	}))
+}
+
+func addToolCall(t *testing.T, s store.ConversationStore, sessionID, claudeSessionID, toolID, toolName string) {
+	t.Helper()
+	require.NoError(t, s.AddConversationEvent(context.Background(), &store.ConversationEvent{
+		SessionID: sessionID, ClaudeSessionID: claudeSessionID, EventType: store.EventTypeToolCall,
+		Role: "assistant", ToolID: toolID, ToolName: toolName, ToolInputJSON: `{}`,
+	}))
+}
+
+func addMessage(t *testing.T, s store.ConversationStore, sessionID, claudeSessionID, role, content string) {
+	t.Helper()
+	require.NoError(t, s.AddConversationEvent(context.Background(), &store.ConversationEvent{
+		SessionID: sessionID, ClaudeSessionID: claudeSessionID, EventType: store.EventTypeMessage,
+		Role: role, Content: content,
+	}))
+}
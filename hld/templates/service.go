@@ -0,0 +1,246 @@
+package templates
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// LaunchOptions are the per-launch inputs for a template
+type LaunchOptions struct {
+	Variables  map[string]string
+	WorkingDir string // Overrides the template's working directory
+	Title      string // Overrides the template's title
+}
+
+// Service manages session templates and launches sessions from them
+type Service struct {
+	store    store.ConversationStore
+	sessions session.SessionManager
+}
+
+// New creates a new template service
+func New(store store.ConversationStore, sessions session.SessionManager) *Service {
+	return &Service{
+		store:    store,
+		sessions: sessions,
+	}
+}
+
+// Create validates and stores a new template
+func (s *Service) Create(ctx context.Context, tmpl *Template) (*Template, error) {
+	if err := tmpl.Validate(); err != nil {
+		return nil, err
+	}
+
+	config, err := json.Marshal(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode template: %w", err)
+	}
+	record := &store.SessionTemplate{
+		ID:          uuid.New().String(),
+		Name:        tmpl.Name,
+		Description: tmpl.Description,
+		Config:      string(config),
+	}
+	if err := s.store.CreateSessionTemplate(ctx, record); err != nil {
+		return nil, err
+	}
+
+	slog.Info("created session template",
+		"template_id", record.ID,
+		"name", record.Name)
+
+	return s.Get(ctx, record.ID)
+}
+
+// Get returns a template by ID
+func (s *Service) Get(ctx context.Context, id string) (*Template, error) {
+	record, err := s.store.GetSessionTemplate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return decode(record)
+}
+
+// List returns all templates ordered by name
+func (s *Service) List(ctx context.Context) ([]*Template, error) {
+	records, err := s.store.ListSessionTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*Template, 0, len(records))
+	for _, record := range records {
+		tmpl, err := decode(record)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, tmpl)
+	}
+	return result, nil
+}
+
+// Update replaces a template's configuration. An empty proxy API key keeps
+// the stored key, since API responses and exports never include it.
+func (s *Service) Update(ctx context.Context, id string, tmpl *Template) (*Template, error) {
+	existing, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tmpl.Validate(); err != nil {
+		return nil, err
+	}
+
+	updated := *tmpl
+	if updated.ProxyAPIKey == "" {
+		updated.ProxyAPIKey = existing.ProxyAPIKey
+	}
+	config, err := json.Marshal(&updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode template: %w", err)
+	}
+	configStr := string(config)
+
+	if err := s.store.UpdateSessionTemplate(ctx, id, store.SessionTemplateUpdate{
+		Name:        &updated.Name,
+		Description: &updated.Description,
+		Config:      &configStr,
+	}); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, id)
+}
+
+// Delete removes a template. Sessions launched from it are unaffected.
+func (s *Service) Delete(ctx context.Context, id string) error {
+	return s.store.DeleteSessionTemplate(ctx, id)
+}
+
+// Launch renders the template with the given variables and launches a session
+func (s *Service) Launch(ctx context.Context, id string, opts LaunchOptions) (*session.Session, error) {
+	tmpl, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := tmpl.Render(opts.Variables)
+	if err != nil {
+		return nil, err
+	}
+	if opts.WorkingDir != "" {
+		rendered.WorkingDir = opts.WorkingDir
+	}
+	if opts.Title != "" {
+		rendered.Title = opts.Title
+	}
+	if rendered.WorkingDir == "" {
+		return nil, &validation.Error{Field: "working_dir", Message: "must be set by the template or the launch request"}
+	}
+
+	sess, err := s.sessions.LaunchSession(ctx, rendered.launchConfig(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("launched session from template",
+		"template_id", id,
+		"session_id", sess.ID)
+
+	return sess, nil
+}
+
+// Export returns the template as a YAML file and a suggested file name
+func (s *Service) Export(ctx context.Context, id string) (filename string, content []byte, err error) {
+	tmpl, err := s.Get(ctx, id)
+	if err != nil {
+		return "", nil, err
+	}
+	content, err = tmpl.Marshal()
+	if err != nil {
+		return "", nil, err
+	}
+	return fileName(tmpl.Name), content, nil
+}
+
+// Import parses a YAML or JSON template file and stores it. A template with
+// the same name is replaced when overwrite is set and is otherwise an error.
+func (s *Service) Import(ctx context.Context, data []byte, overwrite bool) (*Template, error) {
+	tmpl, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.store.GetSessionTemplateByName(ctx, tmpl.Name)
+	switch {
+	case errors.Is(err, store.ErrNotFound):
+		return s.Create(ctx, tmpl)
+	case err != nil:
+		return nil, err
+	case !overwrite:
+		return nil, &store.AlreadyExistsError{Type: "session template", Name: tmpl.Name}
+	default:
+		return s.Update(ctx, existing.ID, tmpl)
+	}
+}
+
+func decode(record *store.SessionTemplate) (*Template, error) {
+	var tmpl Template
+	if err := json.Unmarshal([]byte(record.Config), &tmpl); err != nil {
+		return nil, fmt.Errorf("failed to decode session template %s: %w", record.ID, err)
+	}
+	tmpl.ID = record.ID
+	tmpl.Name = record.Name
+	tmpl.Description = record.Description
+	tmpl.CreatedAt = record.CreatedAt
+	tmpl.UpdatedAt = record.UpdatedAt
+	return &tmpl, nil
+}
+
+func (t *Template) launchConfig() session.LaunchSessionConfig {
+	return session.LaunchSessionConfig{
+		SessionConfig: claudecode.SessionConfig{
+			Query:                 t.Query,
+			Model:                 claudecode.Model(t.Model),
+			OutputFormat:          claudecode.OutputStreamJSON,
+			MCPConfig:             t.mcpConfig(),
+			WorkingDir:            t.WorkingDir,
+			MaxTurns:              t.MaxTurns,
+			SystemPrompt:          t.SystemPrompt,
+			AppendSystemPrompt:    t.AppendSystemPrompt,
+			AllowedTools:          t.AllowedTools,
+			DisallowedTools:       t.DisallowedTools,
+			AdditionalDirectories: t.AdditionalDirectories,
+			CustomInstructions:    t.CustomInstructions,
+		},
+		Title:                             t.Title,
+		AutoAcceptEdits:                   t.AutoAcceptEdits,
+		DangerouslySkipPermissions:        t.DangerouslySkipPermissions,
+		DangerouslySkipPermissionsTimeout: t.DangerouslySkipPermissionsTimeout,
+		ProxyEnabled:                      t.ProxyEnabled,
+		ProxyBaseURL:                      t.ProxyBaseURL,
+		ProxyModelOverride:                t.ProxyModelOverride,
+		ProxyAPIKey:                       t.ProxyAPIKey,
+	}
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// fileName derives a file name for an exported template from its name
+func fileName(name string) string {
+	base := strings.Trim(unsafeFileChars.ReplaceAllString(strings.ToLower(name), "-"), "-.")
+	if base == "" {
+		base = "template"
+	}
+	return base + ".yaml"
+}
@@ -0,0 +1,145 @@
+package templates
+
+import (
+	"context"
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/session"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestService(t *testing.T) (*Service, *session.MockSessionManager) {
+	t.Helper()
+	sessions := session.NewMockSessionManager(gomock.NewController(t))
+	return New(store.NewMemoryStore(), sessions), sessions
+}
+
+func TestService_CreateAndLaunch(t *testing.T) {
+	svc, sessions := newTestService(t)
+	ctx := context.Background()
+
+	tmpl, err := svc.Create(ctx, &Template{
+		Name:            "fix-issue",
+		Query:           "Fix issue {{issue}}",
+		Title:           "Issue {{issue}}",
+		Model:           "opus",
+		WorkingDir:      "/src/project",
+		DisallowedTools: []string{"Bash"},
+		MCPServers:      map[string]MCPServer{"linear": {Command: "npx"}},
+		AutoAcceptEdits: true,
+		ProxyEnabled:    true,
+		ProxyBaseURL:    "https://openrouter.ai/api/v1",
+		ProxyAPIKey:     "sk-secret",
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, tmpl.ID)
+
+	// Names are unique
+	_, err = svc.Create(ctx, &Template{Name: "fix-issue", Query: "q"})
+	assert.ErrorIs(t, err, store.ErrAlreadyExists)
+
+	sessions.EXPECT().
+		LaunchSession(gomock.Any(), gomock.Any(), false).
+		DoAndReturn(func(_ context.Context, cfg session.LaunchSessionConfig, _ bool) (*session.Session, error) {
+			assert.Equal(t, "Fix issue 42", cfg.Query)
+			assert.Equal(t, "Issue 42", cfg.Title)
+			assert.Equal(t, "opus", string(cfg.Model))
+			assert.Equal(t, "/tmp/override", cfg.WorkingDir)
+			assert.Equal(t, []string{"Bash"}, cfg.DisallowedTools)
+			require.NotNil(t, cfg.MCPConfig)
+			assert.Equal(t, "npx", cfg.MCPConfig.MCPServers["linear"].Command)
+			assert.True(t, cfg.AutoAcceptEdits)
+			assert.Equal(t, "sk-secret", cfg.ProxyAPIKey)
+			return &session.Session{ID: "sess-1"}, nil
+		})
+
+	sess, err := svc.Launch(ctx, tmpl.ID, LaunchOptions{
+		Variables:  map[string]string{"issue": "42"},
+		WorkingDir: "/tmp/override",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "sess-1", sess.ID)
+
+	// Missing variables are rejected before anything is launched
+	_, err = svc.Launch(ctx, tmpl.ID, LaunchOptions{})
+	assert.True(t, validation.IsError(err))
+
+	_, err = svc.Launch(ctx, "missing", LaunchOptions{})
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestService_UpdateKeepsProxyAPIKey(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	tmpl, err := svc.Create(ctx, &Template{
+		Name:         "proxied",
+		Query:        "q",
+		ProxyEnabled: true,
+		ProxyBaseURL: "https://openrouter.ai/api/v1",
+		ProxyAPIKey:  "sk-secret",
+	})
+	require.NoError(t, err)
+
+	updated, err := svc.Update(ctx, tmpl.ID, &Template{
+		Name:         "proxied-renamed",
+		Description:  "now described",
+		Query:        "new query",
+		ProxyEnabled: true,
+		ProxyBaseURL: "https://openrouter.ai/api/v1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "proxied-renamed", updated.Name)
+	assert.Equal(t, "now described", updated.Description)
+	assert.Equal(t, "new query", updated.Query)
+	assert.Equal(t, "sk-secret", updated.ProxyAPIKey)
+
+	require.NoError(t, svc.Delete(ctx, tmpl.ID))
+	_, err = svc.Get(ctx, tmpl.ID)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestService_ExportImport(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	tmpl, err := svc.Create(ctx, &Template{
+		Name:        "Nightly Review",
+		Description: "Review the day's commits",
+		Query:       "Review commits since {{since}}",
+		Variables:   []Variable{{Name: "since", Default: "yesterday"}},
+		ProxyAPIKey: "sk-secret",
+	})
+	require.NoError(t, err)
+
+	filename, content, err := svc.Export(ctx, tmpl.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "nightly-review.yaml", filename)
+	assert.Contains(t, string(content), "Review commits since {{since}}")
+	assert.NotContains(t, string(content), "sk-secret")
+
+	// Importing a template with a taken name requires overwrite
+	_, err = svc.Import(ctx, content, false)
+	assert.ErrorIs(t, err, store.ErrAlreadyExists)
+
+	imported, err := svc.Import(ctx, content, true)
+	require.NoError(t, err)
+	assert.Equal(t, tmpl.ID, imported.ID)
+	assert.Equal(t, "sk-secret", imported.ProxyAPIKey)
+
+	imported, err = svc.Import(ctx, []byte("name: other\nquery: hello\n"), false)
+	require.NoError(t, err)
+	assert.NotEqual(t, tmpl.ID, imported.ID)
+
+	list, err := svc.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "Nightly Review", list[0].Name)
+
+	_, err = svc.Import(ctx, []byte("query: no name\n"), false)
+	assert.True(t, validation.IsError(err))
+}
@@ -0,0 +1,263 @@
+// Package templates stores reusable session launch configurations. Templates
+// may contain {{variable}} placeholders that are filled in when a session is
+// launched from them, and can be exported to and imported from YAML files so
+// they can be shared in git.
+package templates
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	claudecode "github.com/humanlayer/humanlayer/claudecode-go"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"gopkg.in/yaml.v3"
+)
+
+// placeholderPattern matches {{name}} placeholders, allowing surrounding spaces
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+var variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Variable declares a placeholder that can be filled in at launch time.
+// Placeholders used in a template but not declared are treated as required
+// variables without a default.
+type Variable struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Default     string `json:"default,omitempty" yaml:"default,omitempty"`
+	Required    bool   `json:"required,omitempty" yaml:"required,omitempty"`
+}
+
+// MCPServer mirrors claudecode.MCPServer with YAML tags for template files
+type MCPServer struct {
+	Command string            `json:"command,omitempty" yaml:"command,omitempty"`
+	Args    []string          `json:"args,omitempty" yaml:"args,omitempty"`
+	Env     map[string]string `json:"env,omitempty" yaml:"env,omitempty"`
+	Type    string            `json:"type,omitempty" yaml:"type,omitempty"`
+	URL     string            `json:"url,omitempty" yaml:"url,omitempty"`
+	Headers map[string]string `json:"headers,omitempty" yaml:"headers,omitempty"`
+}
+
+// Template is a reusable session launch configuration
+type Template struct {
+	ID                                string               `json:"-" yaml:"-"`
+	Name                              string               `json:"name" yaml:"name"`
+	Description                       string               `json:"description,omitempty" yaml:"description,omitempty"`
+	Query                             string               `json:"query" yaml:"query"`
+	Title                             string               `json:"title,omitempty" yaml:"title,omitempty"`
+	Model                             string               `json:"model,omitempty" yaml:"model,omitempty"`
+	WorkingDir                        string               `json:"working_dir,omitempty" yaml:"working_dir,omitempty"`
+	SystemPrompt                      string               `json:"system_prompt,omitempty" yaml:"system_prompt,omitempty"`
+	AppendSystemPrompt                string               `json:"append_system_prompt,omitempty" yaml:"append_system_prompt,omitempty"`
+	CustomInstructions                string               `json:"custom_instructions,omitempty" yaml:"custom_instructions,omitempty"`
+	AllowedTools                      []string             `json:"allowed_tools,omitempty" yaml:"allowed_tools,omitempty"`
+	DisallowedTools                   []string             `json:"disallowed_tools,omitempty" yaml:"disallowed_tools,omitempty"`
+	AdditionalDirectories             []string             `json:"additional_directories,omitempty" yaml:"additional_directories,omitempty"`
+	MCPServers                        map[string]MCPServer `json:"mcp_servers,omitempty" yaml:"mcp_servers,omitempty"`
+	MaxTurns                          int                  `json:"max_turns,omitempty" yaml:"max_turns,omitempty"`
+	AutoAcceptEdits                   bool                 `json:"auto_accept_edits,omitempty" yaml:"auto_accept_edits,omitempty"`
+	DangerouslySkipPermissions        bool                 `json:"dangerously_skip_permissions,omitempty" yaml:"dangerously_skip_permissions,omitempty"`
+	DangerouslySkipPermissionsTimeout *int64               `json:"dangerously_skip_permissions_timeout,omitempty" yaml:"dangerously_skip_permissions_timeout,omitempty"`
+	ProxyEnabled                      bool                 `json:"proxy_enabled,omitempty" yaml:"proxy_enabled,omitempty"`
+	ProxyBaseURL                      string               `json:"proxy_base_url,omitempty" yaml:"proxy_base_url,omitempty"`
+	ProxyModelOverride                string               `json:"proxy_model_override,omitempty" yaml:"proxy_model_override,omitempty"`
+	ProxyAPIKey                       string               `json:"proxy_api_key,omitempty" yaml:"proxy_api_key,omitempty"`
+	Variables                         []Variable           `json:"variables,omitempty" yaml:"variables,omitempty"`
+	CreatedAt                         time.Time            `json:"-" yaml:"-"`
+	UpdatedAt                         time.Time            `json:"-" yaml:"-"`
+}
+
+// Parse parses a YAML or JSON template file and validates it
+func Parse(data []byte) (*Template, error) {
+	var tmpl Template
+	if err := yaml.Unmarshal(data, &tmpl); err != nil {
+		return nil, &validation.Error{Field: "template", Message: err.Error()}
+	}
+	if err := tmpl.Validate(); err != nil {
+		return nil, err
+	}
+	return &tmpl, nil
+}
+
+// redacted replaces secret values in exported templates
+const redacted = "[redacted]"
+
+// Marshal encodes the template as YAML for sharing. The proxy API key is a
+// secret and is never exported, and MCP server environments and headers keep
+// their names with the values redacted, as in session bundles.
+func (t *Template) Marshal() ([]byte, error) {
+	exported := *t
+	exported.ProxyAPIKey = ""
+	if len(t.MCPServers) > 0 {
+		exported.MCPServers = make(map[string]MCPServer, len(t.MCPServers))
+		for name, server := range t.MCPServers {
+			server.Env = redactValues(server.Env)
+			server.Headers = redactValues(server.Headers)
+			exported.MCPServers[name] = server
+		}
+	}
+	data, err := yaml.Marshal(&exported)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode template: %w", err)
+	}
+	return data, nil
+}
+
+// redactValues returns a copy of values with every value redacted
+func redactValues(values map[string]string) map[string]string {
+	if values == nil {
+		return nil
+	}
+	result := make(map[string]string, len(values))
+	for name := range values {
+		result[name] = redacted
+	}
+	return result
+}
+
+// Validate checks the template's fields and variable declarations
+func (t *Template) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return &validation.Error{Field: "name", Message: "must not be empty"}
+	}
+	if strings.TrimSpace(t.Query) == "" {
+		return &validation.Error{Field: "query", Message: "must not be empty"}
+	}
+	switch claudecode.Model(t.Model) {
+	case "", claudecode.ModelOpus, claudecode.ModelSonnet, claudecode.ModelHaiku:
+	default:
+		return &validation.Error{Field: "model", Message: fmt.Sprintf("unknown model %q", t.Model)}
+	}
+	if t.MaxTurns < 0 {
+		return &validation.Error{Field: "max_turns", Message: "must not be negative"}
+	}
+	if t.ProxyEnabled && t.ProxyBaseURL == "" {
+		return &validation.Error{Field: "proxy_base_url", Message: "must be set when the proxy is enabled"}
+	}
+	for name, server := range t.MCPServers {
+		if server.Command == "" && server.URL == "" {
+			return &validation.Error{Field: "mcp_servers." + name, Message: "must have a command or a url"}
+		}
+	}
+
+	declared := make(map[string]bool)
+	for i, v := range t.Variables {
+		field := fmt.Sprintf("variables[%d]", i)
+		if !variableNamePattern.MatchString(v.Name) {
+			return &validation.Error{Field: field + ".name", Message: fmt.Sprintf("%q is not a valid variable name", v.Name)}
+		}
+		if declared[v.Name] {
+			return &validation.Error{Field: field + ".name", Message: fmt.Sprintf("duplicate variable %q", v.Name)}
+		}
+		declared[v.Name] = true
+	}
+	return nil
+}
+
+// templatedFields returns pointers to every field that may contain placeholders
+func (t *Template) templatedFields() []*string {
+	fields := []*string{
+		&t.Query,
+		&t.Title,
+		&t.WorkingDir,
+		&t.SystemPrompt,
+		&t.AppendSystemPrompt,
+		&t.CustomInstructions,
+	}
+	for i := range t.AdditionalDirectories {
+		fields = append(fields, &t.AdditionalDirectories[i])
+	}
+	return fields
+}
+
+// Placeholders returns the names of all placeholders used in the template, sorted
+func (t *Template) Placeholders() []string {
+	seen := make(map[string]bool)
+	for _, field := range t.templatedFields() {
+		for _, match := range placeholderPattern.FindAllStringSubmatch(*field, -1) {
+			seen[match[1]] = true
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EffectiveVariables returns the declared variables followed by any
+// undeclared placeholders, which are required
+func (t *Template) EffectiveVariables() []Variable {
+	declared := make(map[string]bool)
+	variables := append([]Variable(nil), t.Variables...)
+	for _, v := range t.Variables {
+		declared[v.Name] = true
+	}
+	for _, name := range t.Placeholders() {
+		if !declared[name] {
+			variables = append(variables, Variable{Name: name, Required: true})
+		}
+	}
+	return variables
+}
+
+// Render returns a copy of the template with every placeholder replaced.
+// Supplied values override defaults; unknown values and missing required
+// variables are rejected.
+func (t *Template) Render(values map[string]string) (*Template, error) {
+	resolved := make(map[string]string)
+	known := make(map[string]bool)
+	var missing []string
+	for _, v := range t.EffectiveVariables() {
+		known[v.Name] = true
+		if value, ok := values[v.Name]; ok {
+			resolved[v.Name] = value
+			continue
+		}
+		if v.Default != "" || !v.Required {
+			resolved[v.Name] = v.Default
+			continue
+		}
+		missing = append(missing, v.Name)
+	}
+
+	var unknown []string
+	for name := range values {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, &validation.Error{Field: "variables", Message: "unknown variables: " + strings.Join(unknown, ", ")}
+	}
+	if len(missing) > 0 {
+		return nil, &validation.Error{Field: "variables", Message: "missing required variables: " + strings.Join(missing, ", ")}
+	}
+
+	rendered := *t
+	rendered.AdditionalDirectories = append([]string(nil), t.AdditionalDirectories...)
+	for _, field := range rendered.templatedFields() {
+		*field = placeholderPattern.ReplaceAllStringFunc(*field, func(match string) string {
+			return resolved[placeholderPattern.FindStringSubmatch(match)[1]]
+		})
+	}
+	return &rendered, nil
+}
+
+// mcpConfig converts the template's MCP servers for launching
+func (t *Template) mcpConfig() *claudecode.MCPConfig {
+	if len(t.MCPServers) == 0 {
+		return nil
+	}
+	config := &claudecode.MCPConfig{MCPServers: make(map[string]claudecode.MCPServer, len(t.MCPServers))}
+	for name, server := range t.MCPServers {
+		config.MCPServers[name] = claudecode.MCPServer(server)
+	}
+	return config
+}
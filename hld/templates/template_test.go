@@ -0,0 +1,132 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tmpl, err := Parse([]byte(`
+name: fix-issue
+query: "Fix issue #{{issue}} in {{ repo }}"
+model: sonnet
+working_dir: ~/src/{{repo}}
+allowed_tools: [Read, Edit]
+mcp_servers:
+  linear:
+    command: npx
+    args: ["-y", "linear-mcp"]
+variables:
+  - name: repo
+    default: humanlayer
+`))
+	require.NoError(t, err)
+	assert.Equal(t, "fix-issue", tmpl.Name)
+	assert.Equal(t, []string{"Read", "Edit"}, tmpl.AllowedTools)
+	assert.Equal(t, "npx", tmpl.MCPServers["linear"].Command)
+	assert.Equal(t, []string{"issue", "repo"}, tmpl.Placeholders())
+
+	variables := tmpl.EffectiveVariables()
+	require.Len(t, variables, 2)
+	assert.Equal(t, Variable{Name: "repo", Default: "humanlayer"}, variables[0])
+	assert.Equal(t, Variable{Name: "issue", Required: true}, variables[1])
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		tmpl  Template
+		field string
+	}{
+		{"empty name", Template{Query: "q"}, "name"},
+		{"empty query", Template{Name: "t"}, "query"},
+		{"unknown model", Template{Name: "t", Query: "q", Model: "gpt-4o"}, "model"},
+		{"proxy without url", Template{Name: "t", Query: "q", ProxyEnabled: true}, "proxy_base_url"},
+		{"mcp server without command", Template{Name: "t", Query: "q", MCPServers: map[string]MCPServer{"x": {}}}, "mcp_servers.x"},
+		{"bad variable name", Template{Name: "t", Query: "q", Variables: []Variable{{Name: "1x"}}}, "variables[0].name"},
+		{"duplicate variable", Template{Name: "t", Query: "q", Variables: []Variable{{Name: "a"}, {Name: "a"}}}, "variables[1].name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tmpl.Validate()
+			var validationErr *validation.Error
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.field, validationErr.Field)
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	tmpl := &Template{
+		Name:                  "review",
+		Query:                 "Review {{branch}} against {{base}}",
+		Title:                 "Review {{branch}}",
+		AdditionalDirectories: []string{"/src/{{base}}"},
+		Variables: []Variable{
+			{Name: "base", Default: "main"},
+			{Name: "notes"},
+		},
+	}
+
+	rendered, err := tmpl.Render(map[string]string{"branch": "feature/x"})
+	require.NoError(t, err)
+	assert.Equal(t, "Review feature/x against main", rendered.Query)
+	assert.Equal(t, "Review feature/x", rendered.Title)
+	assert.Equal(t, []string{"/src/main"}, rendered.AdditionalDirectories)
+	// The original template is untouched
+	assert.Equal(t, []string{"/src/{{base}}"}, tmpl.AdditionalDirectories)
+
+	rendered, err = tmpl.Render(map[string]string{"branch": "b", "base": "release"})
+	require.NoError(t, err)
+	assert.Equal(t, "Review b against release", rendered.Query)
+
+	_, err = tmpl.Render(nil)
+	require.True(t, validation.IsError(err))
+	assert.Contains(t, err.Error(), "missing required variables: branch")
+
+	_, err = tmpl.Render(map[string]string{"branch": "b", "typo": "x"})
+	require.True(t, validation.IsError(err))
+	assert.Contains(t, err.Error(), "unknown variables: typo")
+}
+
+func TestMarshalOmitsSecrets(t *testing.T) {
+	tmpl := &Template{
+		Name:         "proxied",
+		Query:        "q",
+		ProxyEnabled: true,
+		ProxyBaseURL: "https://openrouter.ai/api/v1",
+		ProxyAPIKey:  "sk-secret",
+		MCPServers: map[string]MCPServer{
+			"linear": {Command: "npx", Env: map[string]string{"LINEAR_API_KEY": "lin-secret"}},
+			"search": {Type: "http", URL: "https://mcp.example.com", Headers: map[string]string{"Authorization": "Bearer tok-secret"}},
+		},
+	}
+
+	data, err := tmpl.Marshal()
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "sk-secret")
+	assert.NotContains(t, string(data), "lin-secret")
+	assert.NotContains(t, string(data), "tok-secret")
+
+	parsed, err := Parse(data)
+	require.NoError(t, err)
+	assert.Equal(t, "https://openrouter.ai/api/v1", parsed.ProxyBaseURL)
+	assert.Empty(t, parsed.ProxyAPIKey)
+	assert.Equal(t, map[string]string{"LINEAR_API_KEY": redacted}, parsed.MCPServers["linear"].Env)
+	assert.Equal(t, map[string]string{"Authorization": redacted}, parsed.MCPServers["search"].Headers)
+
+	// The template itself is unchanged
+	assert.Equal(t, "sk-secret", tmpl.ProxyAPIKey)
+	assert.Equal(t, "lin-secret", tmpl.MCPServers["linear"].Env["LINEAR_API_KEY"])
+	assert.Equal(t, "Bearer tok-secret", tmpl.MCPServers["search"].Headers["Authorization"])
+}
+
+func TestFileName(t *testing.T) {
+	assert.Equal(t, "fix-issue.yaml", fileName("Fix Issue"))
+	assert.Equal(t, "a-b.yaml", fileName("../a/b"))
+	assert.Equal(t, "template.yaml", fileName("!!!"))
+}
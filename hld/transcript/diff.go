@@ -0,0 +1,102 @@
+package transcript
+
+import "strings"
+
+// Diff line operations
+const (
+	diffContext = ' '
+	diffAdd     = '+'
+	diffRemove  = '-'
+	diffHunk    = '@' // Separates the edits of a MultiEdit
+)
+
+// diffLine is one line of a rendered edit
+type diffLine struct {
+	Op   byte
+	Text string
+}
+
+// maxDiffCells bounds the work spent aligning a single edit's lines. Larger
+// edits are shown as removing every old line and adding every new one after
+// the common prefix and suffix.
+const maxDiffCells = 250000
+
+// lineDiff returns a line diff from oldText to newText
+func lineDiff(oldText, newText string) []diffLine {
+	oldLines := splitLines(oldText)
+	newLines := splitLines(newText)
+
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]diffLine, 0, len(oldLines)+len(newLines))
+	for _, line := range oldLines[:prefix] {
+		lines = append(lines, diffLine{Op: diffContext, Text: line})
+	}
+	lines = append(lines, alignLines(oldLines[prefix:len(oldLines)-suffix], newLines[prefix:len(newLines)-suffix])...)
+	for _, line := range oldLines[len(oldLines)-suffix:] {
+		lines = append(lines, diffLine{Op: diffContext, Text: line})
+	}
+	return lines
+}
+
+// alignLines diffs two blocks through their longest common subsequence
+func alignLines(a, b []string) []diffLine {
+	if len(a)*len(b) > maxDiffCells {
+		lines := make([]diffLine, 0, len(a)+len(b))
+		for _, line := range a {
+			lines = append(lines, diffLine{Op: diffRemove, Text: line})
+		}
+		for _, line := range b {
+			lines = append(lines, diffLine{Op: diffAdd, Text: line})
+		}
+		return lines
+	}
+
+	// lcs[i][j] is the common subsequence length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]diffLine, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{Op: diffContext, Text: a[i]})
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{Op: diffRemove, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{Op: diffAdd, Text: b[j]})
+			j++
+		}
+	}
+	return lines
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
@@ -0,0 +1,152 @@
+package transcript
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// htmlStyle is inlined so the document renders without anything else
+const htmlStyle = `
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; max-width: 960px; margin: 2rem auto; padding: 0 1rem; color: #1f2328; line-height: 1.5; }
+h1 { font-size: 1.6rem; }
+dl.meta { display: grid; grid-template-columns: max-content auto; gap: 0.2rem 1rem; color: #59636e; }
+dl.meta dt { font-weight: 600; }
+dl.meta dd { margin: 0; }
+.event { margin: 1rem 0; }
+.role { font-weight: 600; margin-bottom: 0.25rem; }
+.message { white-space: pre-wrap; }
+.user .message { background: #f6f8fa; border-radius: 6px; padding: 0.75rem; }
+details { border: 1px solid #d1d9e0; border-radius: 6px; padding: 0.5rem 0.75rem; }
+summary { cursor: pointer; font-family: ui-monospace, SFMono-Regular, Menlo, monospace; font-size: 0.9rem; }
+pre { background: #f6f8fa; padding: 0.75rem; overflow-x: auto; font-size: 0.85rem; }
+pre.diff .add { background: #dafbe1; display: block; }
+pre.diff .del { background: #ffebe9; display: block; }
+pre.diff .hunk { color: #59636e; display: block; }
+.thinking { color: #59636e; font-style: italic; white-space: pre-wrap; }
+.badge { border-radius: 1rem; padding: 0 0.5rem; font-size: 0.8rem; font-family: sans-serif; }
+.badge.approved, .badge.resolved { background: #dafbe1; color: #116329; }
+.badge.denied { background: #ffebe9; color: #a40e26; }
+.badge.pending { background: #fff8c5; color: #7d4e00; }
+.comment { border-left: 3px solid #d1d9e0; margin: 0.5rem 0 0; padding-left: 0.75rem; color: #59636e; }
+.note, .system, .compaction { color: #59636e; font-size: 0.9rem; }
+`
+
+// htmlRenderer writes a standalone HTML document
+type htmlRenderer struct{}
+
+func (htmlRenderer) header(w *bufio.Writer, session *store.Session) error {
+	heading := html.EscapeString(title(session))
+	fmt.Fprintf(w, "<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n<style>%s</style>\n</head>\n<body>\n", heading, htmlStyle)
+	fmt.Fprintf(w, "<h1>%s</h1>\n<dl class=\"meta\">\n", heading)
+	writeMeta(w, "Session", session.ID)
+	writeMeta(w, "Model", session.Model)
+	writeMeta(w, "Working directory", session.WorkingDir)
+	writeMeta(w, "Status", session.Status)
+	if session.CostUSD != nil {
+		writeMeta(w, "Cost", fmt.Sprintf("$%.2f", *session.CostUSD))
+	}
+	_, err := w.WriteString("</dl>\n")
+	return err
+}
+
+func writeMeta(w *bufio.Writer, name, value string) {
+	if value != "" {
+		fmt.Fprintf(w, "<dt>%s</dt><dd>%s</dd>\n", name, html.EscapeString(value))
+	}
+}
+
+func (htmlRenderer) entry(w *bufio.Writer, e *entry) error {
+	switch e.Type {
+	case store.EventTypeMessage:
+		if strings.TrimSpace(e.Content) == "" {
+			return nil
+		}
+		role, class := "Assistant", "assistant"
+		if e.Role == "user" {
+			role, class = "User", "user"
+		}
+		fmt.Fprintf(w, "<div class=\"event %s\"><div class=\"role\">%s</div><div class=\"message\">%s</div></div>\n",
+			class, role, html.EscapeString(strings.TrimSpace(e.Content)))
+
+	case store.EventTypeToolCall:
+		w.WriteString("<div class=\"event tool-call\"><details><summary>")
+		fmt.Fprintf(w, "Tool: %s", html.EscapeString(e.ToolName))
+		if e.summary != "" {
+			fmt.Fprintf(w, " — %s", html.EscapeString(e.summary))
+		}
+		if e.Approval != nil {
+			status := html.EscapeString(e.Approval.Status)
+			fmt.Fprintf(w, " <span class=\"badge %s\">%s</span>", status, status)
+		}
+		w.WriteString("</summary>\n")
+		switch {
+		case e.diff != nil:
+			writeHTMLDiff(w, e.diff)
+			if e.diffCut != nil {
+				fmt.Fprintf(w, "<p class=\"note\">%s</p>\n", truncationNote(e.diffCut))
+			}
+		case e.Input != nil:
+			fmt.Fprintf(w, "<pre>%s</pre>\n", html.EscapeString(prettyJSON(e.Input)))
+		}
+		w.WriteString("</details>")
+		if e.Approval != nil && e.Approval.Comment != "" {
+			fmt.Fprintf(w, "<p class=\"comment\">%s</p>", html.EscapeString(e.Approval.Comment))
+		}
+		w.WriteString("</div>\n")
+
+	case store.EventTypeToolResult:
+		w.WriteString("<div class=\"event tool-result\"><details><summary>Result")
+		if e.ToolName != "" {
+			fmt.Fprintf(w, ": %s", html.EscapeString(e.ToolName))
+		}
+		fmt.Fprintf(w, "</summary>\n<pre>%s</pre>\n", html.EscapeString(e.Content))
+		if e.Truncated != nil {
+			fmt.Fprintf(w, "<p class=\"note\">%s</p>\n", truncationNote(e.Truncated))
+		}
+		w.WriteString("</details></div>\n")
+
+	case store.EventTypeThinking:
+		fmt.Fprintf(w, "<div class=\"event\"><details><summary>Thinking</summary>\n<div class=\"thinking\">%s</div>\n</details></div>\n",
+			html.EscapeString(strings.TrimSpace(e.Content)))
+
+	case store.EventTypeCompaction:
+		w.WriteString("<div class=\"event compaction\">Conversation compacted")
+		if c := e.Compaction; c != nil && c.PreTokens != nil && c.PostTokens != nil {
+			fmt.Fprintf(w, " from %d to %d tokens", *c.PreTokens, *c.PostTokens)
+		}
+		w.WriteString("</div>\n")
+
+	case store.EventTypeSystem:
+		if strings.TrimSpace(e.Content) == "" {
+			return nil
+		}
+		fmt.Fprintf(w, "<div class=\"event system\">%s</div>\n", html.EscapeString(strings.TrimSpace(e.Content)))
+	}
+	return nil
+}
+
+func writeHTMLDiff(w *bufio.Writer, lines []diffLine) {
+	w.WriteString("<pre class=\"diff\">")
+	for _, line := range lines {
+		switch line.Op {
+		case diffHunk:
+			w.WriteString("<span class=\"hunk\">@@</span>")
+		case diffAdd:
+			fmt.Fprintf(w, "<span class=\"add\">+%s</span>", html.EscapeString(line.Text))
+		case diffRemove:
+			fmt.Fprintf(w, "<span class=\"del\">-%s</span>", html.EscapeString(line.Text))
+		default:
+			fmt.Fprintf(w, " %s\n", html.EscapeString(line.Text))
+		}
+	}
+	w.WriteString("</pre>\n")
+}
+
+func (htmlRenderer) footer(w *bufio.Writer) error {
+	_, err := w.WriteString("</body>\n</html>\n")
+	return err
+}
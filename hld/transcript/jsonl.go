@@ -0,0 +1,59 @@
+package transcript
+
+import (
+	"bufio"
+	"encoding/json"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// jsonlRecordSession is the type of the first JSON Lines record. The records
+// after it are conversation entries typed by their event type.
+const jsonlRecordSession = "session"
+
+type sessionRecord struct {
+	Type            string    `json:"type"`
+	SessionID       string    `json:"session_id"`
+	ParentSessionID string    `json:"parent_session_id,omitempty"`
+	Title           string    `json:"title"`
+	Model           string    `json:"model,omitempty"`
+	WorkingDir      string    `json:"working_dir,omitempty"`
+	Status          string    `json:"status"`
+	CostUSD         *float64  `json:"cost_usd,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// jsonlRenderer writes one JSON object per line
+type jsonlRenderer struct{}
+
+func (jsonlRenderer) header(w *bufio.Writer, session *store.Session) error {
+	return writeJSONLine(w, sessionRecord{
+		Type:            jsonlRecordSession,
+		SessionID:       session.ID,
+		ParentSessionID: session.ParentSessionID,
+		Title:           title(session),
+		Model:           session.Model,
+		WorkingDir:      session.WorkingDir,
+		Status:          session.Status,
+		CostUSD:         session.CostUSD,
+		CreatedAt:       session.CreatedAt,
+	})
+}
+
+func (jsonlRenderer) entry(w *bufio.Writer, e *entry) error {
+	return writeJSONLine(w, e)
+}
+
+func (jsonlRenderer) footer(*bufio.Writer) error {
+	return nil
+}
+
+func writeJSONLine(w *bufio.Writer, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	w.Write(data)
+	return w.WriteByte('\n')
+}
@@ -0,0 +1,172 @@
+package transcript
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// markdownRenderer writes GitHub flavored Markdown. Tool calls, their results
+// and thinking are folded into <details> blocks so a transcript pasted into a
+// pull request description reads as the conversation.
+type markdownRenderer struct{}
+
+func (markdownRenderer) header(w *bufio.Writer, session *store.Session) error {
+	fmt.Fprintf(w, "# %s\n\n", title(session))
+	fmt.Fprintf(w, "- **Session:** `%s`\n", session.ID)
+	if session.Model != "" {
+		fmt.Fprintf(w, "- **Model:** %s\n", session.Model)
+	}
+	if session.WorkingDir != "" {
+		fmt.Fprintf(w, "- **Working directory:** `%s`\n", session.WorkingDir)
+	}
+	fmt.Fprintf(w, "- **Status:** %s\n", session.Status)
+	if session.CostUSD != nil {
+		fmt.Fprintf(w, "- **Cost:** $%.2f\n", *session.CostUSD)
+	}
+	_, err := w.WriteString("\n")
+	return err
+}
+
+func (markdownRenderer) entry(w *bufio.Writer, e *entry) error {
+	switch e.Type {
+	case store.EventTypeMessage:
+		if strings.TrimSpace(e.Content) == "" {
+			return nil
+		}
+		role := "Assistant"
+		if e.Role == "user" {
+			role = "User"
+		}
+		fmt.Fprintf(w, "**%s**\n\n%s\n\n", role, strings.TrimSpace(e.Content))
+
+	case store.EventTypeToolCall:
+		summary := "Tool: " + e.ToolName
+		if e.summary != "" {
+			summary += " — " + e.summary
+		}
+		if e.Approval != nil {
+			summary += " (" + e.Approval.Status + ")"
+		}
+		fmt.Fprintf(w, "<details>\n<summary>%s</summary>\n\n", escapeHTMLText(summary))
+		switch {
+		case e.diff != nil:
+			writeFenced(w, "diff", diffText(e.diff))
+			if e.diffCut != nil {
+				writeTruncationNote(w, e.diffCut)
+			}
+		case e.Input != nil:
+			writeFenced(w, "json", prettyJSON(e.Input))
+		}
+		w.WriteString("</details>\n\n")
+		if e.Approval != nil && e.Approval.Comment != "" {
+			fmt.Fprintf(w, "> **%s:** %s\n\n", capitalize(e.Approval.Status), quoteLines(e.Approval.Comment))
+		}
+
+	case store.EventTypeToolResult:
+		summary := "Result"
+		if e.ToolName != "" {
+			summary += ": " + e.ToolName
+		}
+		fmt.Fprintf(w, "<details>\n<summary>%s</summary>\n\n", escapeHTMLText(summary))
+		writeFenced(w, "", e.Content)
+		if e.Truncated != nil {
+			writeTruncationNote(w, e.Truncated)
+		}
+		w.WriteString("</details>\n\n")
+
+	case store.EventTypeThinking:
+		w.WriteString("<details>\n<summary>Thinking</summary>\n\n")
+		fmt.Fprintf(w, "%s\n\n</details>\n\n", strings.TrimSpace(e.Content))
+
+	case store.EventTypeCompaction:
+		w.WriteString("> **Conversation compacted**")
+		if c := e.Compaction; c != nil && c.PreTokens != nil && c.PostTokens != nil {
+			fmt.Fprintf(w, " from %d to %d tokens", *c.PreTokens, *c.PostTokens)
+		}
+		w.WriteString("\n\n")
+
+	case store.EventTypeSystem:
+		if strings.TrimSpace(e.Content) == "" {
+			return nil
+		}
+		fmt.Fprintf(w, "_%s_\n\n", strings.TrimSpace(e.Content))
+	}
+	return nil
+}
+
+func (markdownRenderer) footer(*bufio.Writer) error {
+	return nil
+}
+
+// writeFenced writes text as a code block whose fence is longer than any run
+// of backticks in the text, so the text cannot close it early
+func writeFenced(w *bufio.Writer, lang, text string) {
+	longest, run := 0, 0
+	for i := 0; i < len(text); i++ {
+		if text[i] == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	fence := strings.Repeat("`", max(3, longest+1))
+	fmt.Fprintf(w, "%s%s\n%s\n%s\n\n", fence, lang, strings.TrimSuffix(text, "\n"), fence)
+}
+
+func writeTruncationNote(w *bufio.Writer, t *truncation) {
+	fmt.Fprintf(w, "_%s_\n\n", truncationNote(t))
+}
+
+func truncationNote(t *truncation) string {
+	if t.OmittedLines > 0 {
+		return fmt.Sprintf("… %d more lines (%d bytes) truncated", t.OmittedLines, t.OmittedBytes)
+	}
+	return fmt.Sprintf("… %d more bytes truncated", t.OmittedBytes)
+}
+
+// diffText renders diff lines in unified diff style
+func diffText(lines []diffLine) string {
+	var b strings.Builder
+	for _, line := range lines {
+		if line.Op == diffHunk {
+			b.WriteString("@@\n")
+			continue
+		}
+		b.WriteByte(line.Op)
+		b.WriteString(line.Text)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func prettyJSON(data json.RawMessage) string {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return string(data)
+	}
+	pretty, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return string(data)
+	}
+	return string(pretty)
+}
+
+// escapeHTMLText escapes the characters Markdown would read as HTML inside a
+// <summary> element
+var escapeHTMLText = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace
+
+func quoteLines(text string) string {
+	return strings.ReplaceAll(strings.TrimSpace(text), "\n", "\n> ")
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
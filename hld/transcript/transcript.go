@@ -0,0 +1,348 @@
+// Package transcript renders a session's conversation as Markdown, standalone
+// HTML or normalized JSON Lines. Events are read from the store a page at a
+// time and written as they are rendered, so long sessions never have to fit
+// in memory.
+package transcript
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// Output formats
+const (
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatJSONL    = "jsonl"
+)
+
+// Default truncation limits for tool results
+const (
+	DefaultMaxToolResultBytes = 8 * 1024
+	DefaultMaxToolResultLines = 200
+)
+
+// pageSize is the number of events read from the store at a time
+const pageSize = 500
+
+// ErrInvalidFormat is returned for a format other than the Format constants
+var ErrInvalidFormat = errors.New("invalid transcript format")
+
+// Options configures how a transcript is rendered
+type Options struct {
+	Format string // See the Format constants, empty means Markdown
+
+	// Tool results and written file contents longer than these limits are cut
+	// short with a note of how much was left out. Zero disables a limit.
+	MaxToolResultBytes int
+	MaxToolResultLines int
+}
+
+// DefaultOptions returns Markdown output with the default truncation limits
+func DefaultOptions() Options {
+	return Options{
+		Format:             FormatMarkdown,
+		MaxToolResultBytes: DefaultMaxToolResultBytes,
+		MaxToolResultLines: DefaultMaxToolResultLines,
+	}
+}
+
+// FileExtension returns the file name extension for a format's output
+func FileExtension(format string) string {
+	switch format {
+	case FormatHTML:
+		return "html"
+	case FormatJSONL:
+		return "jsonl"
+	default:
+		return "md"
+	}
+}
+
+// renderer writes one output format
+type renderer interface {
+	header(w *bufio.Writer, session *store.Session) error
+	entry(w *bufio.Writer, e *entry) error
+	footer(w *bufio.Writer) error
+}
+
+// Write renders the conversation of a session, including the sessions it
+// continues from, to w
+func Write(ctx context.Context, conversations store.ConversationStore, w io.Writer, sessionID string, opts Options) error {
+	var r renderer
+	switch opts.Format {
+	case "", FormatMarkdown:
+		r = &markdownRenderer{}
+	case FormatHTML:
+		r = &htmlRenderer{}
+	case FormatJSONL:
+		r = &jsonlRenderer{}
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidFormat, opts.Format)
+	}
+
+	session, err := conversations.GetSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	if err := r.header(bw, session); err != nil {
+		return err
+	}
+
+	n := normalizer{store: conversations, opts: opts, toolNames: map[string]string{}}
+	page := store.ConversationPage{Limit: pageSize}
+	for {
+		events, err := conversations.GetSessionConversationPage(ctx, sessionID, page)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			e, err := n.normalize(ctx, event)
+			if err != nil {
+				return err
+			}
+			if err := r.entry(bw, e); err != nil {
+				return err
+			}
+		}
+		if len(events) < pageSize {
+			break
+		}
+		last := events[len(events)-1]
+		page.After = &store.ConversationCursor{ClaudeSessionID: last.ClaudeSessionID, Sequence: last.Sequence}
+
+		// Hand finished pages to the reader while the next one loads
+		if err := bw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if err := r.footer(bw); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// entry is a conversation event prepared for rendering. It is also the JSON
+// Lines record, so its field names are part of the export format.
+type entry struct {
+	Type            string          `json:"type"`
+	Timestamp       time.Time       `json:"timestamp"`
+	ClaudeSessionID string          `json:"claude_session_id"`
+	Sequence        int             `json:"sequence"`
+	Role            string          `json:"role,omitempty"`
+	Content         string          `json:"content,omitempty"`
+	ToolID          string          `json:"tool_id,omitempty"`
+	ToolName        string          `json:"tool_name,omitempty"`
+	ParentToolUseID string          `json:"parent_tool_use_id,omitempty"`
+	Input           json.RawMessage `json:"input,omitempty"`
+	Approval        *approval       `json:"approval,omitempty"`
+	Compaction      *compaction     `json:"compaction,omitempty"`
+	Truncated       *truncation     `json:"truncated,omitempty"`
+
+	// Rendering aids, not exported as JSON
+	summary string     // Short description of a tool call
+	diff    []diffLine // Change made by an edit tool
+	diffCut *truncation
+}
+
+type approval struct {
+	Status  string `json:"status"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type compaction struct {
+	Trigger    string `json:"trigger,omitempty"`
+	PreTokens  *int   `json:"pre_tokens,omitempty"`
+	PostTokens *int   `json:"post_tokens,omitempty"`
+}
+
+// truncation records how much of a text was left out
+type truncation struct {
+	OmittedBytes int `json:"omitted_bytes"`
+	OmittedLines int `json:"omitted_lines"`
+}
+
+// normalizer turns stored events into entries
+type normalizer struct {
+	store     store.ConversationStore
+	opts      Options
+	toolNames map[string]string // Tool call IDs to tool names, for labelling results
+}
+
+func (n *normalizer) normalize(ctx context.Context, event *store.ConversationEvent) (*entry, error) {
+	e := &entry{
+		Type:            event.EventType,
+		Timestamp:       event.CreatedAt,
+		ClaudeSessionID: event.ClaudeSessionID,
+		Sequence:        event.Sequence,
+		Role:            event.Role,
+		Content:         event.Content,
+		ParentToolUseID: event.ParentToolUseID,
+	}
+
+	switch event.EventType {
+	case store.EventTypeToolCall:
+		e.ToolID = event.ToolID
+		e.ToolName = event.ToolName
+		if json.Valid([]byte(event.ToolInputJSON)) {
+			e.Input = json.RawMessage(event.ToolInputJSON)
+		}
+		n.toolNames[event.ToolID] = event.ToolName
+		e.summary = toolSummary(event.ToolName, event.ToolInputJSON)
+		e.diff, e.diffCut = n.editDiff(event.ToolName, event.ToolInputJSON)
+
+		if event.ApprovalStatus != "" {
+			e.Approval = &approval{Status: event.ApprovalStatus}
+			if event.ApprovalID != "" {
+				a, err := n.store.GetApproval(ctx, event.ApprovalID)
+				if err != nil && !errors.Is(err, store.ErrNotFound) {
+					return nil, err
+				}
+				if a != nil {
+					e.Approval.Comment = a.Comment
+				}
+			}
+		}
+
+	case store.EventTypeToolResult:
+		e.ToolID = event.ToolResultForID
+		e.ToolName = n.toolNames[event.ToolResultForID]
+		e.Content, e.Truncated = truncate(event.ToolResultContent, n.opts.MaxToolResultBytes, n.opts.MaxToolResultLines)
+
+	case store.EventTypeCompaction:
+		e.Compaction = &compaction{
+			Trigger:    event.CompactionTrigger,
+			PreTokens:  event.PreCompactionTokens,
+			PostTokens: event.PostCompactionTokens,
+		}
+	}
+	return e, nil
+}
+
+// editDiff renders the change an edit tool call makes. Written file contents
+// count as tool output for truncation.
+func (n *normalizer) editDiff(toolName, inputJSON string) ([]diffLine, *truncation) {
+	var input struct {
+		OldString string `json:"old_string"`
+		NewString string `json:"new_string"`
+		Content   string `json:"content"`
+		Edits     []struct {
+			OldString string `json:"old_string"`
+			NewString string `json:"new_string"`
+		} `json:"edits"`
+	}
+	switch toolName {
+	case "Edit", "MultiEdit", "Write":
+		if err := json.Unmarshal([]byte(inputJSON), &input); err != nil {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	switch toolName {
+	case "Edit":
+		return lineDiff(input.OldString, input.NewString), nil
+	case "MultiEdit":
+		var lines []diffLine
+		for i, edit := range input.Edits {
+			if i > 0 {
+				lines = append(lines, diffLine{Op: diffHunk})
+			}
+			lines = append(lines, lineDiff(edit.OldString, edit.NewString)...)
+		}
+		return lines, nil
+	default:
+		content, cut := truncate(input.Content, n.opts.MaxToolResultBytes, n.opts.MaxToolResultLines)
+		return lineDiff("", content), cut
+	}
+}
+
+// toolSummary describes a tool call in a few words, such as the command a
+// Bash call ran or the file an edit changed
+func toolSummary(toolName, inputJSON string) string {
+	var input map[string]any
+	if err := json.Unmarshal([]byte(inputJSON), &input); err != nil {
+		return ""
+	}
+	for _, key := range []string{"command", "file_path", "notebook_path", "pattern", "url", "query", "description", "prompt"} {
+		if value, ok := input[key].(string); ok && value != "" {
+			line, _, _ := strings.Cut(strings.TrimSpace(value), "\n")
+			if utf8.RuneCountInString(line) > 80 {
+				line = string([]rune(line)[:79]) + "…"
+			}
+			return line
+		}
+	}
+	return ""
+}
+
+// truncate shortens text to at most maxLines lines and maxBytes bytes,
+// cutting at a character boundary. Zero disables a limit.
+func truncate(text string, maxBytes, maxLines int) (string, *truncation) {
+	kept := text
+	if maxLines > 0 {
+		if idx := nthIndex(kept, '\n', maxLines); idx >= 0 && idx < len(kept)-1 {
+			kept = kept[:idx+1]
+		}
+	}
+	if maxBytes > 0 && len(kept) > maxBytes {
+		cut := maxBytes
+		for cut > 0 && !utf8.RuneStart(kept[cut]) {
+			cut--
+		}
+		kept = kept[:cut]
+	}
+	if len(kept) == len(text) {
+		return text, nil
+	}
+	return kept, &truncation{
+		OmittedBytes: len(text) - len(kept),
+		OmittedLines: countLines(text) - countLines(kept),
+	}
+}
+
+// countLines counts lines, including a last line without a newline
+func countLines(s string) int {
+	n := strings.Count(s, "\n")
+	if s != "" && !strings.HasSuffix(s, "\n") {
+		n++
+	}
+	return n
+}
+
+// nthIndex returns the index of the nth occurrence of c in s, or -1
+func nthIndex(s string, c byte, n int) int {
+	for i := 0; i < len(s); i++ {
+		if s[i] == c {
+			n--
+			if n == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// title returns a heading for the session
+func title(session *store.Session) string {
+	for _, candidate := range []string{session.Title, session.Summary, session.Query} {
+		line, _, _ := strings.Cut(strings.TrimSpace(candidate), "\n")
+		if line != "" {
+			return line
+		}
+	}
+	return "Session " + session.ID
+}
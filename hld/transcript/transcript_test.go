@@ -0,0 +1,203 @@
+package transcript
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/internal/testutil"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStore(t *testing.T) *store.SQLiteStore {
+	t.Helper()
+	s, err := store.NewSQLiteStore(testutil.DatabasePath(t, "transcript"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close() })
+	return s
+}
+
+// seedSession stores a session continuing from a parent, with one of each
+// kind of event
+func seedSession(t *testing.T, s store.ConversationStore) {
+	t.Helper()
+	ctx := context.Background()
+	for _, session := range []*store.Session{
+		{ID: "parent", RunID: "run-parent", ClaudeSessionID: "claude-parent", Query: "Fix the <b>build</b>", Status: store.SessionStatusCompleted},
+		{ID: "child", RunID: "run-child", ClaudeSessionID: "claude-child", ParentSessionID: "parent", Query: "Fix the <b>build</b>",
+			Model: "sonnet", WorkingDir: "/src/app", Status: store.SessionStatusCompleted},
+	} {
+		session.CreatedAt = time.Now()
+		session.LastActivityAt = session.CreatedAt
+		require.NoError(t, s.CreateSession(ctx, session))
+	}
+	require.NoError(t, s.CreateApproval(ctx, &store.Approval{
+		ID: "local-denied", RunID: "run-child", SessionID: "child", Status: store.ApprovalStatusLocalDenied,
+		CreatedAt: time.Now(), ToolName: "Bash", ToolInput: json.RawMessage(`{"command":"rm -rf /"}`), Comment: "Too destructive",
+	}))
+
+	pre, post := 150000, 20000
+	for _, event := range []*store.ConversationEvent{
+		{SessionID: "parent", ClaudeSessionID: "claude-parent", EventType: store.EventTypeMessage, Role: "user", Content: "Fix the <b>build</b>"},
+		{SessionID: "parent", ClaudeSessionID: "claude-parent", EventType: store.EventTypeThinking, Role: "assistant", Content: "The import is wrong."},
+		{SessionID: "parent", ClaudeSessionID: "claude-parent", EventType: store.EventTypeToolCall, ToolID: "toolu_edit", ToolName: "Edit",
+			ToolInputJSON:  `{"file_path":"main.go","old_string":"package main\nimport \"fmt\"\n","new_string":"package main\nimport \"os\"\n"}`,
+			ApprovalStatus: store.ApprovalStatusApproved},
+		{SessionID: "parent", ClaudeSessionID: "claude-parent", EventType: store.EventTypeToolResult, ToolResultForID: "toolu_edit",
+			ToolResultContent: "edited"},
+		{SessionID: "child", ClaudeSessionID: "claude-child", EventType: store.EventTypeCompaction, CompactionTrigger: "auto",
+			PreCompactionTokens: &pre, PostCompactionTokens: &post},
+		{SessionID: "child", ClaudeSessionID: "claude-child", EventType: store.EventTypeToolCall, ToolID: "toolu_bash", ToolName: "Bash",
+			ToolInputJSON: `{"command":"rm -rf /"}`, ApprovalStatus: store.ApprovalStatusDenied, ApprovalID: "local-denied"},
+		{SessionID: "child", ClaudeSessionID: "claude-child", EventType: store.EventTypeToolResult, ToolResultForID: "toolu_bash",
+			ToolResultContent: strings.Repeat("line of output\n", 300)},
+		{SessionID: "child", ClaudeSessionID: "claude-child", EventType: store.EventTypeMessage, Role: "assistant", Content: "Done, see ```go``` fences."},
+	} {
+		require.NoError(t, s.AddConversationEvent(ctx, event))
+	}
+}
+
+func render(t *testing.T, s store.ConversationStore, opts Options) string {
+	t.Helper()
+	var out bytes.Buffer
+	require.NoError(t, Write(context.Background(), s, &out, "child", opts))
+	return out.String()
+}
+
+func TestMarkdown(t *testing.T) {
+	s := newStore(t)
+	seedSession(t, s)
+
+	out := render(t, s, DefaultOptions())
+	assert.True(t, strings.HasPrefix(out, "# Fix the <b>build</b>\n"))
+	assert.Contains(t, out, "- **Model:** sonnet\n")
+	assert.Contains(t, out, "**User**\n\nFix the <b>build</b>\n")
+	assert.Contains(t, out, "<summary>Thinking</summary>\n\nThe import is wrong.\n")
+	assert.Contains(t, out, "<summary>Tool: Edit — main.go (approved)</summary>")
+	assert.Contains(t, out, "```diff\n package main\n-import \"fmt\"\n+import \"os\"\n```")
+	assert.Contains(t, out, "<summary>Result: Edit</summary>")
+	assert.Contains(t, out, "> **Conversation compacted** from 150000 to 20000 tokens")
+	assert.Contains(t, out, "<summary>Tool: Bash — rm -rf / (denied)</summary>")
+	assert.Contains(t, out, "> **Denied:** Too destructive")
+	assert.Contains(t, out, "… 100 more lines (1500 bytes) truncated")
+	assert.Contains(t, out, "Done, see ```go``` fences.")
+
+	// Events of the parent session come first
+	assert.Less(t, strings.Index(out, "Tool: Edit"), strings.Index(out, "Tool: Bash"))
+}
+
+func TestHTML(t *testing.T) {
+	s := newStore(t)
+	seedSession(t, s)
+
+	out := render(t, s, Options{Format: FormatHTML})
+	assert.True(t, strings.HasPrefix(out, "<!DOCTYPE html>"))
+	assert.True(t, strings.HasSuffix(out, "</html>\n"))
+	assert.Contains(t, out, "<h1>Fix the &lt;b&gt;build&lt;/b&gt;</h1>")
+	assert.NotContains(t, out, "<b>build")
+	assert.Contains(t, out, `<span class="del">-import &#34;fmt&#34;</span><span class="add">+import &#34;os&#34;</span>`)
+	assert.Contains(t, out, `<span class="badge denied">denied</span>`)
+	assert.Contains(t, out, `<p class="comment">Too destructive</p>`)
+	assert.Contains(t, out, `<div class="thinking">The import is wrong.</div>`)
+
+	// Without limits nothing is truncated
+	assert.NotContains(t, out, "truncated")
+	assert.Equal(t, 300, strings.Count(out, "line of output"))
+}
+
+func TestJSONL(t *testing.T) {
+	s := newStore(t)
+	seedSession(t, s)
+
+	out := render(t, s, Options{Format: FormatJSONL, MaxToolResultLines: 10})
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	require.Len(t, lines, 9)
+
+	var session map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &session))
+	assert.Equal(t, "session", session["type"])
+	assert.Equal(t, "child", session["session_id"])
+	assert.Equal(t, "parent", session["parent_session_id"])
+
+	var call entry
+	require.NoError(t, json.Unmarshal([]byte(lines[6]), &call))
+	assert.Equal(t, store.EventTypeToolCall, call.Type)
+	assert.Equal(t, "claude-child", call.ClaudeSessionID)
+	assert.JSONEq(t, `{"command":"rm -rf /"}`, string(call.Input))
+	require.NotNil(t, call.Approval)
+	assert.Equal(t, approval{Status: store.ApprovalStatusDenied, Comment: "Too destructive"}, *call.Approval)
+
+	var result entry
+	require.NoError(t, json.Unmarshal([]byte(lines[7]), &result))
+	assert.Equal(t, "Bash", result.ToolName)
+	assert.Equal(t, "toolu_bash", result.ToolID)
+	assert.Equal(t, 10, strings.Count(result.Content, "\n"))
+	assert.Equal(t, &truncation{OmittedBytes: 290 * 15, OmittedLines: 290}, result.Truncated)
+}
+
+func TestWritePagesThroughLongSessions(t *testing.T) {
+	s := newStore(t)
+	ctx := context.Background()
+	require.NoError(t, s.CreateSession(ctx, &store.Session{
+		ID: "long", RunID: "run-long", ClaudeSessionID: "claude-long", Status: store.SessionStatusCompleted, CreatedAt: time.Now(), LastActivityAt: time.Now(),
+	}))
+	total := pageSize*2 + 7
+	for i := range total {
+		require.NoError(t, s.AddConversationEvent(ctx, &store.ConversationEvent{
+			SessionID: "long", ClaudeSessionID: "claude-long", EventType: store.EventTypeMessage, Role: "assistant", Content: fmt.Sprintf("message %d", i),
+		}))
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, Write(ctx, s, &out, "long", Options{Format: FormatJSONL}))
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	require.Len(t, lines, total+1)
+	assert.Contains(t, lines[total], fmt.Sprintf(`"content":"message %d"`, total-1))
+}
+
+func TestWriteErrors(t *testing.T) {
+	s := newStore(t)
+	err := Write(context.Background(), s, &bytes.Buffer{}, "child", Options{Format: "pdf"})
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	err = Write(context.Background(), s, &bytes.Buffer{}, "missing", DefaultOptions())
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestTruncate(t *testing.T) {
+	text, cut := truncate("short", 100, 10)
+	assert.Equal(t, "short", text)
+	assert.Nil(t, cut)
+
+	text, cut = truncate("a\nb\nc\nd", 0, 2)
+	assert.Equal(t, "a\nb\n", text)
+	assert.Equal(t, &truncation{OmittedBytes: 3, OmittedLines: 2}, cut)
+
+	// Multi-byte characters are not split
+	text, cut = truncate("héllo", 2, 0)
+	assert.Equal(t, "h", text)
+	assert.Equal(t, 5, cut.OmittedBytes)
+}
+
+func TestLineDiff(t *testing.T) {
+	lines := lineDiff("a\nb\nc\nd\n", "a\nc\nx\nd\n")
+	assert.Equal(t, " a\n-b\n c\n+x\n d\n", diffText(lines))
+
+	// Writes to new files are all additions
+	assert.Equal(t, "+one\n+two\n", diffText(lineDiff("", "one\ntwo")))
+}
+
+func TestFencesOutlastContent(t *testing.T) {
+	var out bytes.Buffer
+	w := bufio.NewWriter(&out)
+	writeFenced(w, "", "has ```` four")
+	require.NoError(t, w.Flush())
+	assert.Equal(t, "`````\nhas ```` four\n`````\n\n", out.String())
+}
@@ -0,0 +1,417 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+const (
+	// listenBufferSize is how many bus events wait for the dispatcher before
+	// it falls behind and resumes from the event log
+	listenBufferSize = 256
+
+	// sendBatchSize bounds how many due deliveries one pass sends
+	sendBatchSize = 100
+)
+
+// sourceEventTypes are the bus events webhook events are made from
+var sourceEventTypes = []bus.EventType{
+	bus.EventNewApproval,
+	bus.EventApprovalResolved,
+	bus.EventSessionStatusChanged,
+	bus.EventSessionStalled,
+	bus.EventBudgetExceeded,
+}
+
+// Payload is the JSON body posted to webhooks
+type Payload struct {
+	Event     string                 `json:"event"`
+	Seq       uint64                 `json:"seq,omitempty"`
+	Timestamp time.Time              `json:"timestamp"`
+	Data      map[string]interface{} `json:"data"`
+	Session   *Session               `json:"session,omitempty"`
+}
+
+// Session describes the session an event is about
+type Session struct {
+	ID           string   `json:"id"`
+	RunID        string   `json:"run_id"`
+	Title        string   `json:"title,omitempty"`
+	Status       string   `json:"status"`
+	WorkingDir   string   `json:"working_dir,omitempty"`
+	Labels       []string `json:"labels,omitempty"`
+	ErrorMessage string   `json:"error_message,omitempty"`
+}
+
+// Start listens for events and sends deliveries until ctx is cancelled.
+// Deliveries still pending from before a restart are sent first.
+func (s *Service) Start(ctx context.Context) {
+	slog.Info("starting webhook dispatcher", "interval", s.config.Interval)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.listen(ctx)
+	}()
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	s.SendDue(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			<-done
+			slog.Info("webhook dispatcher shutting down")
+			return
+		case <-ticker.C:
+			s.SendDue(ctx)
+		case <-s.wake:
+			s.SendDue(ctx)
+		}
+	}
+}
+
+// listen queues deliveries for bus events until ctx is cancelled. A listener
+// that falls behind is disconnected and resumes after the last event it got.
+func (s *Service) listen(ctx context.Context) {
+	if s.eventBus == nil {
+		return
+	}
+	opts := bus.SubscribeOptions{
+		BufferSize: listenBufferSize,
+		Overflow:   bus.OverflowDisconnect,
+	}
+	for {
+		sub := s.eventBus.SubscribeWith(ctx, bus.EventFilter{Types: sourceEventTypes}, opts)
+		lastSeq, ok := s.receive(ctx, sub)
+		if !ok {
+			return
+		}
+		slog.Warn("webhook dispatcher fell behind, resuming", "since", lastSeq)
+		if lastSeq > 0 {
+			opts.Since = &lastSeq
+		}
+	}
+}
+
+// receive queues deliveries for the events received by sub until it is
+// closed. It returns the sequence of the last event received, and false when
+// the dispatcher is shutting down.
+func (s *Service) receive(ctx context.Context, sub *bus.Subscriber) (uint64, bool) {
+	var lastSeq uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return 0, false
+		case event, ok := <-sub.Channel:
+			if !ok {
+				if ctx.Err() != nil {
+					return 0, false
+				}
+				return lastSeq, true
+			}
+			if event.Seq > 0 {
+				lastSeq = event.Seq
+			}
+			if event.Type == bus.EventReplayGap {
+				slog.Warn("webhook dispatcher missed events that are no longer kept", "data", event.Data)
+				continue
+			}
+			s.dispatch(ctx, event)
+		}
+	}
+}
+
+// classify returns the webhook event type a bus event is delivered as and the
+// session it is about. The type is empty for events webhooks do not receive.
+func classify(event bus.Event) (string, string, error) {
+	switch event.Type {
+	case bus.EventNewApproval:
+		p, err := bus.DecodePayload[bus.NewApprovalPayload](event)
+		return EventNewApproval, p.SessionID, err
+	case bus.EventApprovalResolved:
+		p, err := bus.DecodePayload[bus.ApprovalResolvedPayload](event)
+		return EventApprovalResolved, p.SessionID, err
+	case bus.EventSessionStatusChanged:
+		p, err := bus.DecodePayload[bus.SessionStatusChangedPayload](event)
+		if err != nil || p.OldStatus == p.NewStatus {
+			return "", "", err
+		}
+		switch p.NewStatus {
+		case string(store.SessionStatusCompleted):
+			return EventSessionCompleted, p.SessionID, nil
+		case string(store.SessionStatusFailed):
+			return EventSessionFailed, p.SessionID, nil
+		}
+	case bus.EventSessionStalled:
+		p, err := bus.DecodePayload[bus.SessionStalledPayload](event)
+		return EventSessionStalled, p.SessionID, err
+	case bus.EventBudgetExceeded:
+		p, err := bus.DecodePayload[bus.BudgetExceededPayload](event)
+		return EventBudgetExceeded, p.SessionID, err
+	}
+	return "", "", nil
+}
+
+// dispatch queues a delivery of event to every enabled webhook it matches
+func (s *Service) dispatch(ctx context.Context, event bus.Event) {
+	eventType, sessionID, err := classify(event)
+	if err != nil {
+		slog.Warn("failed to decode event for webhooks", "event_type", event.Type, "error", err)
+		return
+	}
+	if eventType == "" {
+		return
+	}
+
+	webhooks, err := s.store.ListWebhooks(ctx)
+	if err != nil {
+		slog.Error("failed to list webhooks", "error", err)
+		return
+	}
+
+	var sess *Session
+	var body []byte
+	loaded, queued := false, false
+	for _, webhook := range webhooks {
+		if !webhook.Enabled || (len(webhook.EventTypes) > 0 && !slices.Contains(webhook.EventTypes, eventType)) {
+			continue
+		}
+		if !loaded {
+			sess = s.loadSession(ctx, sessionID)
+			loaded = true
+		}
+		if !matchesSession(webhook, sess) {
+			continue
+		}
+
+		if body == nil {
+			body, err = json.Marshal(Payload{
+				Event:     eventType,
+				Seq:       event.Seq,
+				Timestamp: event.Timestamp,
+				Data:      event.Data,
+				Session:   sess,
+			})
+			if err != nil {
+				slog.Error("failed to encode webhook payload", "event_type", eventType, "error", err)
+				return
+			}
+		}
+
+		now := s.now()
+		delivery := &store.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventType:     eventType,
+			EventSeq:      int64(event.Seq),
+			Payload:       string(body),
+			Status:        store.WebhookDeliveryStatusPending,
+			NextAttemptAt: &now,
+		}
+		if err := s.store.CreateWebhookDelivery(ctx, delivery, s.config.KeepDeliveries); err != nil {
+			slog.Error("failed to queue webhook delivery",
+				"webhook_id", webhook.ID,
+				"event_type", eventType,
+				"error", err)
+			continue
+		}
+		queued = true
+	}
+
+	if queued {
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// loadSession returns the session an event is about, or nil if it has none
+// or it cannot be read
+func (s *Service) loadSession(ctx context.Context, sessionID string) *Session {
+	if sessionID == "" {
+		return nil
+	}
+	sess, err := s.store.GetSession(ctx, sessionID)
+	if err != nil {
+		slog.Warn("failed to get session for webhooks", "session_id", sessionID, "error", err)
+		return nil
+	}
+	labels, err := s.store.GetSessionLabels(ctx, sessionID)
+	if err != nil {
+		slog.Warn("failed to get session labels for webhooks", "session_id", sessionID, "error", err)
+		return nil
+	}
+	return &Session{
+		ID:           sess.ID,
+		RunID:        sess.RunID,
+		Title:        sess.Title,
+		Status:       sess.Status,
+		WorkingDir:   sess.WorkingDir,
+		Labels:       labels,
+		ErrorMessage: sess.ErrorMessage,
+	}
+}
+
+// matchesSession reports whether a webhook's session filters accept sess.
+// Webhooks with filters never match events without a session.
+func matchesSession(webhook *store.Webhook, sess *Session) bool {
+	if len(webhook.Labels) == 0 && webhook.WorkingDir == "" {
+		return true
+	}
+	if sess == nil {
+		return false
+	}
+	for _, label := range webhook.Labels {
+		if !slices.Contains(sess.Labels, label) {
+			return false
+		}
+	}
+	return webhook.WorkingDir == "" || withinDir(sess.WorkingDir, webhook.WorkingDir)
+}
+
+// withinDir reports whether dir is root or below it
+func withinDir(dir, root string) bool {
+	if dir == "" {
+		return false
+	}
+	rel, err := filepath.Rel(filepath.Clean(root), filepath.Clean(dir))
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// SendDue sends every pending delivery whose next attempt is due
+func (s *Service) SendDue(ctx context.Context) {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	deliveries, err := s.store.GetDueWebhookDeliveries(ctx, s.now(), sendBatchSize)
+	if err != nil {
+		slog.Error("failed to get due webhook deliveries", "error", err)
+		return
+	}
+
+	webhooks := make(map[string]*store.Webhook)
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			webhook, err = s.store.GetWebhook(ctx, delivery.WebhookID)
+			if err != nil {
+				slog.Error("failed to get webhook", "webhook_id", delivery.WebhookID, "error", err)
+				continue
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+		s.attempt(ctx, webhook, delivery)
+	}
+}
+
+// attempt sends a delivery once and records the outcome. A failed attempt is
+// retried with exponential backoff until the delivery runs out of attempts.
+func (s *Service) attempt(ctx context.Context, webhook *store.Webhook, delivery *store.WebhookDelivery) {
+	if !webhook.Enabled {
+		// Deliveries queued before the webhook was disabled are not sent
+		status := store.WebhookDeliveryStatusFailed
+		message := "webhook is disabled"
+		if err := s.store.UpdateWebhookDelivery(ctx, delivery.ID, store.WebhookDeliveryUpdate{
+			Status: &status,
+			Error:  &message,
+		}); err != nil {
+			slog.Error("failed to record webhook delivery", "delivery_id", delivery.ID, "error", err)
+		}
+		return
+	}
+
+	statusCode, err := s.post(ctx, webhook, delivery)
+	if ctx.Err() != nil {
+		// Shutting down; the delivery is retried after the restart
+		return
+	}
+
+	attempts := delivery.Attempts + 1
+	updates := store.WebhookDeliveryUpdate{Attempts: &attempts, ResponseStatus: &statusCode}
+
+	now := s.now()
+	if err == nil {
+		status := store.WebhookDeliveryStatusDelivered
+		noError := ""
+		updates.Status = &status
+		updates.Error = &noError
+		updates.DeliveredAt = &now
+	} else {
+		message := err.Error()
+		updates.Error = &message
+		if attempts >= s.config.MaxAttempts {
+			status := store.WebhookDeliveryStatusFailed
+			updates.Status = &status
+		} else {
+			next := now.Add(s.backoff(attempts))
+			updates.NextAttemptAt = &next
+		}
+		slog.Warn("webhook delivery failed",
+			"webhook_id", webhook.ID,
+			"delivery_id", delivery.ID,
+			"event_type", delivery.EventType,
+			"attempt", attempts,
+			"error", err)
+	}
+
+	if err := s.store.UpdateWebhookDelivery(ctx, delivery.ID, updates); err != nil {
+		slog.Error("failed to record webhook delivery", "delivery_id", delivery.ID, "error", err)
+	}
+}
+
+// post sends a delivery's payload, signed with the webhook's secret, and
+// returns the response status. Responses other than 2xx are errors.
+func (s *Service) post(ctx context.Context, webhook *store.Webhook, delivery *store.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+	timestamp := s.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, timestamp, []byte(delivery.Payload)))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns how long to wait after the given number of failed attempts
+func (s *Service) backoff(attempts int) time.Duration {
+	wait := s.config.InitialBackoff
+	for i := 1; i < attempts && wait < s.config.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, s.config.MaxBackoff)
+}
@@ -0,0 +1,247 @@
+// Package webhook posts daemon events, such as new approvals and finished
+// sessions, to configured URLs.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/store"
+)
+
+// Event types a webhook can subscribe to
+const (
+	EventNewApproval      = "new_approval"
+	EventApprovalResolved = "approval_resolved"
+	EventSessionCompleted = "session_completed"
+	EventSessionFailed    = "session_failed"
+	EventSessionStalled   = "session_stalled"
+	EventBudgetExceeded   = "budget_exceeded"
+)
+
+// EventTypes returns every event type a webhook can subscribe to
+func EventTypes() []string {
+	return []string{
+		EventNewApproval,
+		EventApprovalResolved,
+		EventSessionCompleted,
+		EventSessionFailed,
+		EventSessionStalled,
+		EventBudgetExceeded,
+	}
+}
+
+// Request headers of every delivery
+const (
+	HeaderEvent     = "X-HumanLayer-Event"
+	HeaderDelivery  = "X-HumanLayer-Delivery"
+	HeaderTimestamp = "X-HumanLayer-Timestamp"
+	HeaderSignature = "X-HumanLayer-Signature"
+)
+
+// Config tunes delivery. Zero fields take their defaults.
+type Config struct {
+	Interval       time.Duration // How often due retries are sent, default 5s
+	Timeout        time.Duration // Timeout of each request, default 10s
+	MaxAttempts    int           // Attempts before a delivery fails, default 8
+	InitialBackoff time.Duration // Wait after the first failed attempt, doubling after each, default 10s
+	MaxBackoff     time.Duration // Longest wait between attempts, default 1h
+	KeepDeliveries int           // Finished deliveries kept per webhook, default 200
+}
+
+func (c Config) withDefaults() Config {
+	if c.Interval <= 0 {
+		c.Interval = 5 * time.Second
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Second
+	}
+	if c.MaxAttempts <= 0 {
+		c.MaxAttempts = 8
+	}
+	if c.InitialBackoff <= 0 {
+		c.InitialBackoff = 10 * time.Second
+	}
+	if c.MaxBackoff <= 0 {
+		c.MaxBackoff = time.Hour
+	}
+	if c.KeepDeliveries <= 0 {
+		c.KeepDeliveries = 200
+	}
+	return c
+}
+
+// Service stores webhooks and delivers events to them
+type Service struct {
+	store    store.ConversationStore
+	eventBus bus.EventBus
+	client   *http.Client
+	config   Config
+	now      func() time.Time
+
+	// wake asks the delivery loop to send due deliveries now
+	wake chan struct{}
+	// sendMu serializes sending so a delivery is never sent twice at once
+	sendMu sync.Mutex
+}
+
+// New creates a new webhook service
+func New(store store.ConversationStore, eventBus bus.EventBus, config Config) *Service {
+	config = config.withDefaults()
+	return &Service{
+		store:    store,
+		eventBus: eventBus,
+		client:   &http.Client{Timeout: config.Timeout},
+		config:   config,
+		now:      time.Now,
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// CreateWebhook validates a new webhook and fills in defaults. A webhook
+// without a secret is given a random one.
+func (s *Service) CreateWebhook(ctx context.Context, webhook *store.Webhook) (*store.Webhook, error) {
+	if webhook.ID == "" {
+		webhook.ID = uuid.New().String()
+	}
+	if webhook.Secret == "" {
+		secret, err := NewSecret()
+		if err != nil {
+			return nil, err
+		}
+		webhook.Secret = secret
+	}
+
+	if err := Validate(webhook); err != nil {
+		return nil, err
+	}
+	if err := s.store.CreateWebhook(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return s.store.GetWebhook(ctx, webhook.ID)
+}
+
+// UpdateWebhook applies updates to a webhook, re-validating it
+func (s *Service) UpdateWebhook(ctx context.Context, id string, updates store.WebhookUpdate) (*store.Webhook, error) {
+	webhook, err := s.store.GetWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if updates.Name != nil {
+		webhook.Name = *updates.Name
+	}
+	if updates.URL != nil {
+		webhook.URL = *updates.URL
+	}
+	if updates.Secret != nil {
+		webhook.Secret = *updates.Secret
+	}
+	if updates.EventTypes != nil {
+		webhook.EventTypes = *updates.EventTypes
+	}
+	if updates.Labels != nil {
+		webhook.Labels = *updates.Labels
+	}
+	if updates.WorkingDir != nil {
+		webhook.WorkingDir = *updates.WorkingDir
+	}
+	if updates.Enabled != nil {
+		webhook.Enabled = *updates.Enabled
+	}
+
+	if err := Validate(webhook); err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateWebhook(ctx, id, updates); err != nil {
+		return nil, err
+	}
+	return s.store.GetWebhook(ctx, id)
+}
+
+// GetWebhook returns a webhook by ID
+func (s *Service) GetWebhook(ctx context.Context, id string) (*store.Webhook, error) {
+	return s.store.GetWebhook(ctx, id)
+}
+
+// ListWebhooks returns all webhooks
+func (s *Service) ListWebhooks(ctx context.Context) ([]*store.Webhook, error) {
+	return s.store.ListWebhooks(ctx)
+}
+
+// DeleteWebhook removes a webhook and its delivery log
+func (s *Service) DeleteWebhook(ctx context.Context, id string) error {
+	return s.store.DeleteWebhook(ctx, id)
+}
+
+// ListDeliveries returns a webhook's delivery log, newest first
+func (s *Service) ListDeliveries(ctx context.Context, id string, limit int) ([]*store.WebhookDelivery, error) {
+	if _, err := s.store.GetWebhook(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.store.ListWebhookDeliveries(ctx, id, limit)
+}
+
+// Validate checks a webhook definition
+func Validate(webhook *store.Webhook) error {
+	if webhook.Name == "" {
+		return &validation.Error{Field: "name", Message: "must not be empty"}
+	}
+
+	u, err := url.Parse(webhook.URL)
+	if err != nil {
+		return &validation.Error{Field: "url", Message: err.Error()}
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &validation.Error{Field: "url", Message: "must be an absolute http or https URL"}
+	}
+
+	if webhook.Secret == "" {
+		return &validation.Error{Field: "secret", Message: "must not be empty"}
+	}
+	for _, eventType := range webhook.EventTypes {
+		if !slices.Contains(EventTypes(), eventType) {
+			return &validation.Error{Field: "event_types", Message: fmt.Sprintf("unknown event type %q", eventType)}
+		}
+	}
+	for _, label := range webhook.Labels {
+		if label == "" {
+			return &validation.Error{Field: "labels", Message: "must not contain empty labels"}
+		}
+	}
+	return nil
+}
+
+// NewSecret returns a random signing secret
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature header value of a request body sent at
+// timestamp, in Unix seconds: "sha256=" followed by the hex HMAC-SHA256 of
+// the timestamp, a period and the body, keyed with the webhook's secret.
+// Receivers compute the same value to check a request came from the daemon.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
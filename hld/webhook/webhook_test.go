@@ -0,0 +1,316 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/humanlayer/humanlayer/hld/bus"
+	"github.com/humanlayer/humanlayer/hld/internal/validation"
+	"github.com/humanlayer/humanlayer/hld/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// receiver is a local stand-in for a webhook endpoint. It answers with the
+// queued statuses in turn, then with 200.
+type receiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []*http.Request
+	bodies   [][]byte
+	statuses []int
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	r := &receiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, body)
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) received() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+func newTestService(t *testing.T) (*Service, *store.MemoryStore) {
+	t.Helper()
+	s := store.NewMemoryStore()
+	return New(s, nil, Config{}), s
+}
+
+func createSession(t *testing.T, s store.ConversationStore, id, workingDir string, labels ...string) {
+	t.Helper()
+	ctx := context.Background()
+	require.NoError(t, s.CreateSession(ctx, &store.Session{
+		ID: id, RunID: "run-" + id, Title: "Fix the build", Status: store.SessionStatusRunning, WorkingDir: workingDir,
+	}))
+	require.NoError(t, s.SetSessionLabels(ctx, id, labels))
+}
+
+func TestCreateWebhook(t *testing.T) {
+	svc, _ := newTestService(t)
+	ctx := context.Background()
+
+	webhook, err := svc.CreateWebhook(ctx, &store.Webhook{Name: "chat", URL: "https://chat.example.com/hook", Enabled: true})
+	require.NoError(t, err)
+	assert.NotEmpty(t, webhook.ID)
+	assert.Len(t, webhook.Secret, 64, "a secret is generated when none is given")
+
+	for name, hook := range map[string]*store.Webhook{
+		"no name":            {URL: "https://example.com"},
+		"relative url":       {Name: "x", URL: "/hook"},
+		"other scheme":       {Name: "x", URL: "ftp://example.com/hook"},
+		"unknown event type": {Name: "x", URL: "https://example.com", EventTypes: []string{"session_exploded"}},
+		"empty label":        {Name: "x", URL: "https://example.com", Labels: []string{""}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.CreateWebhook(ctx, hook)
+			assert.True(t, validation.IsError(err), "got %v", err)
+		})
+	}
+
+	emptySecret := ""
+	_, err = svc.UpdateWebhook(ctx, webhook.ID, store.WebhookUpdate{Secret: &emptySecret})
+	assert.True(t, validation.IsError(err), "got %v", err)
+	_, err = svc.ListDeliveries(ctx, "missing", 10)
+	assert.ErrorIs(t, err, store.ErrNotFound)
+}
+
+func TestDeliverSignedEvent(t *testing.T) {
+	svc, s := newTestService(t)
+	ctx := context.Background()
+	r := newReceiver(t)
+	createSession(t, s, "sess-1", "/repo", "prod")
+
+	webhook, err := svc.CreateWebhook(ctx, &store.Webhook{Name: "chat", URL: r.URL, Secret: "s3cret", Enabled: true})
+	require.NoError(t, err)
+
+	event := bus.NewEvent(bus.NewApprovalPayload{ApprovalID: "appr-1", SessionID: "sess-1", ToolName: "Bash"})
+	event.Seq = 42
+	event.Timestamp = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	svc.dispatch(ctx, event)
+	svc.SendDue(ctx)
+
+	require.Equal(t, 1, r.received())
+	r.mu.Lock()
+	req, body := r.requests[0], r.bodies[0]
+	r.mu.Unlock()
+	assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	assert.Equal(t, EventNewApproval, req.Header.Get(HeaderEvent))
+	timestamp, err := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, Sign("s3cret", timestamp, body), req.Header.Get(HeaderSignature))
+	assert.NotEqual(t, Sign("other", timestamp, body), req.Header.Get(HeaderSignature))
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, EventNewApproval, payload.Event)
+	assert.Equal(t, uint64(42), payload.Seq)
+	assert.Equal(t, "appr-1", payload.Data["approval_id"])
+	require.NotNil(t, payload.Session)
+	assert.Equal(t, "Fix the build", payload.Session.Title)
+	assert.Equal(t, []string{"prod"}, payload.Session.Labels)
+
+	deliveries, err := svc.ListDeliveries(ctx, webhook.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, req.Header.Get(HeaderDelivery), strconv.FormatInt(deliveries[0].ID, 10))
+	assert.Equal(t, store.WebhookDeliveryStatusDelivered, deliveries[0].Status)
+	assert.Equal(t, 1, deliveries[0].Attempts)
+	assert.Equal(t, http.StatusOK, deliveries[0].ResponseStatus)
+	assert.Equal(t, int64(42), deliveries[0].EventSeq)
+	assert.NotNil(t, deliveries[0].DeliveredAt)
+}
+
+func TestDispatchFilters(t *testing.T) {
+	svc, s := newTestService(t)
+	ctx := context.Background()
+	createSession(t, s, "sess-prod", "/repo/api", "prod", "team-a")
+	createSession(t, s, "sess-dev", "/repository", "dev")
+
+	hooks := map[string]*store.Webhook{}
+	for _, hook := range []*store.Webhook{
+		{Name: "all"},
+		{Name: "failures", EventTypes: []string{EventSessionFailed, EventBudgetExceeded}},
+		{Name: "prod", Labels: []string{"prod", "team-a"}},
+		{Name: "repo", WorkingDir: "/repo"},
+		{Name: "disabled"},
+	} {
+		hook.URL = "http://localhost/" + hook.Name
+		hook.Enabled = hook.Name != "disabled"
+		created, err := svc.CreateWebhook(ctx, hook)
+		require.NoError(t, err)
+		hooks[hook.Name] = created
+	}
+
+	status := func(sessionID, oldStatus, newStatus string) bus.Event {
+		return bus.NewEvent(bus.SessionStatusChangedPayload{SessionID: sessionID, OldStatus: oldStatus, NewStatus: newStatus})
+	}
+	for _, event := range []bus.Event{
+		status("sess-prod", "running", "completed"),
+		status("sess-dev", "running", "failed"),
+		bus.NewEvent(bus.BudgetExceededPayload{SessionID: "sess-prod", CostUSD: 2, MaxCostUSD: 1}),
+		// Not delivered: other statuses, unchanged statuses and other event types
+		status("sess-prod", "starting", "running"),
+		status("sess-prod", "completed", "completed"),
+		bus.NewEvent(bus.ConversationUpdatedPayload{SessionID: "sess-prod"}),
+	} {
+		svc.dispatch(ctx, event)
+	}
+
+	delivered := func(name string) []string {
+		deliveries, err := s.ListWebhookDeliveries(ctx, hooks[name].ID, 0)
+		require.NoError(t, err)
+		var eventTypes []string
+		for i := len(deliveries) - 1; i >= 0; i-- {
+			eventTypes = append(eventTypes, deliveries[i].EventType)
+		}
+		return eventTypes
+	}
+	assert.Equal(t, []string{EventSessionCompleted, EventSessionFailed, EventBudgetExceeded}, delivered("all"))
+	assert.Equal(t, []string{EventSessionFailed, EventBudgetExceeded}, delivered("failures"))
+	assert.Equal(t, []string{EventSessionCompleted, EventBudgetExceeded}, delivered("prod"))
+	assert.Equal(t, []string{EventSessionCompleted, EventBudgetExceeded}, delivered("repo"))
+	assert.Empty(t, delivered("disabled"))
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	svc, s := newTestService(t)
+	ctx := context.Background()
+	svc.config.MaxAttempts = 3
+	now := time.Now().UTC()
+	svc.now = func() time.Time { return now }
+
+	r := newReceiver(t, http.StatusInternalServerError, http.StatusBadGateway)
+	webhook, err := svc.CreateWebhook(ctx, &store.Webhook{Name: "ci", URL: r.URL, Enabled: true})
+	require.NoError(t, err)
+	svc.dispatch(ctx, bus.NewEvent(bus.NewApprovalPayload{ApprovalID: "appr-1", SessionID: "sess-1", ToolName: "Bash"}))
+
+	latest := func() *store.WebhookDelivery {
+		deliveries, err := s.ListWebhookDeliveries(ctx, webhook.ID, 1)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		return deliveries[0]
+	}
+
+	// The first attempt fails and is retried after the initial backoff
+	svc.SendDue(ctx)
+	delivery := latest()
+	assert.Equal(t, store.WebhookDeliveryStatusPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, delivery.ResponseStatus)
+	assert.Contains(t, delivery.Error, "500")
+	require.NotNil(t, delivery.NextAttemptAt)
+	assert.WithinDuration(t, now.Add(10*time.Second), *delivery.NextAttemptAt, time.Millisecond)
+
+	// Nothing is sent before the retry is due
+	svc.SendDue(ctx)
+	assert.Equal(t, 1, r.received())
+
+	// The second failure doubles the backoff, and the third attempt succeeds
+	now = now.Add(10 * time.Second)
+	svc.SendDue(ctx)
+	delivery = latest()
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.WithinDuration(t, now.Add(20*time.Second), *delivery.NextAttemptAt, time.Millisecond)
+	now = now.Add(20 * time.Second)
+	svc.SendDue(ctx)
+	delivery = latest()
+	assert.Equal(t, store.WebhookDeliveryStatusDelivered, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Empty(t, delivery.Error)
+	assert.Equal(t, 3, r.received())
+
+	// A delivery that runs out of attempts fails
+	r.mu.Lock()
+	r.statuses = []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError}
+	r.mu.Unlock()
+	svc.dispatch(ctx, bus.NewEvent(bus.NewApprovalPayload{ApprovalID: "appr-2", SessionID: "sess-1", ToolName: "Bash"}))
+	for range 3 {
+		svc.SendDue(ctx)
+		now = now.Add(time.Hour)
+	}
+	delivery = latest()
+	assert.Equal(t, store.WebhookDeliveryStatusFailed, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	svc.SendDue(ctx)
+	assert.Equal(t, 6, r.received())
+}
+
+func TestBackoff(t *testing.T) {
+	svc := New(nil, nil, Config{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second})
+	var waits []time.Duration
+	for attempts := 1; attempts <= 5; attempts++ {
+		waits = append(waits, svc.backoff(attempts))
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, waits)
+}
+
+func TestStartDeliversBusEvents(t *testing.T) {
+	s := store.NewMemoryStore()
+	eventBus := bus.NewEventBus()
+	svc := New(s, eventBus, Config{Interval: time.Hour})
+	r := newReceiver(t)
+	createSession(t, s, "sess-1", "/repo")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A delivery left pending by a previous run is sent on startup
+	webhook, err := svc.CreateWebhook(ctx, &store.Webhook{Name: "chat", URL: r.URL, Enabled: true})
+	require.NoError(t, err)
+	past := time.Now().Add(-time.Minute)
+	require.NoError(t, s.CreateWebhookDelivery(ctx, &store.WebhookDelivery{
+		WebhookID: webhook.ID, EventType: EventSessionStalled, Payload: `{}`,
+		Status: store.WebhookDeliveryStatusPending, NextAttemptAt: &past,
+	}, 0))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		svc.Start(ctx)
+	}()
+	require.Eventually(t, func() bool { return r.received() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return eventBus.GetSubscriberCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	// New events are sent without waiting for the next interval
+	eventBus.Publish(bus.NewEvent(bus.SessionStatusChangedPayload{SessionID: "sess-1", OldStatus: "running", NewStatus: "failed"}))
+	require.Eventually(t, func() bool { return r.received() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	r.mu.Lock()
+	assert.Equal(t, EventSessionFailed, r.requests[1].Header.Get(HeaderEvent))
+	r.mu.Unlock()
+
+	cancel()
+	<-done
+}
+
+func TestWithinDir(t *testing.T) {
+	assert.True(t, withinDir("/repo", "/repo"))
+	assert.True(t, withinDir("/repo/api", "/repo/"))
+	assert.True(t, withinDir("/repo", "/"))
+	assert.False(t, withinDir("/repository", "/repo"))
+	assert.False(t, withinDir("/", "/repo"))
+	assert.False(t, withinDir("", "/repo"))
+}